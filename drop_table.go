@@ -0,0 +1,183 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// dropTableCheck returns an error naming the views, and the triggers of other tables, that depend on the tables a
+// DROP TABLE statement drops, when the engine restricts dropping them. Otherwise, the views reading the tables are kept
+// but can't be used until the tables are created again, as MySQL does. The triggers of the dropped tables themselves
+// are always dropped along with them.
+func (e *Engine) dropTableCheck(ctx *sql.Context, node sql.Node) error {
+	dropTable, ok := node.(*plan.DropTable)
+	if !ok || !e.RestrictDropTableDependents {
+		return nil
+	}
+
+	type droppedTable struct {
+		db   sql.Database
+		name string
+	}
+	var tables []droppedTable
+	dropped := make(map[string]struct{})
+	for _, t := range dropTable.Tables {
+		var db sql.Database
+		var name string
+		switch t := t.(type) {
+		case *plan.UnresolvedTable:
+			dbName := t.Database().Name()
+			if dbName == "" {
+				dbName = ctx.GetCurrentDatabase()
+			}
+			if dbName == "" {
+				continue
+			}
+			// missing tables and databases are reported when the statement is run
+			var err error
+			db, err = e.Analyzer.Catalog.Database(ctx, dbName)
+			if err != nil {
+				continue
+			}
+			if _, ok, err := db.GetTableInsensitive(ctx, t.Name()); err != nil || !ok {
+				continue
+			}
+			name = t.Name()
+		case *plan.ResolvedTable:
+			db, name = t.Database, t.Name()
+		default:
+			continue
+		}
+		tables = append(tables, droppedTable{db: db, name: name})
+		dropped[droppedTableKey(db.Name(), name)] = struct{}{}
+	}
+
+	for _, t := range tables {
+		dependents, err := e.tableDependents(ctx, t.db, t.name, dropped)
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return sql.ErrDropTableRestricted.New(t.name, strings.Join(dependents, ", "))
+		}
+	}
+	return nil
+}
+
+func droppedTableKey(dbName, tableName string) string {
+	return strings.ToLower(dbName) + "." + strings.ToLower(tableName)
+}
+
+// tableDependents returns the descriptions of the views of all databases that read the table given, and of the
+// triggers whose bodies read it, in order. Views and triggers of other databases read it when they name it qualified
+// with its database. Triggers defined on one of the |dropped| tables are dropped along with their table, and so
+// aren't dependents.
+func (e *Engine) tableDependents(ctx *sql.Context, db sql.Database, tableName string, dropped map[string]struct{}) ([]string, error) {
+	var dependents []string
+	for _, depDb := range e.Analyzer.Catalog.AllDatabases(ctx) {
+		// the dependents of other databases are named with their database
+		describe := func(kind, name string) string {
+			if strings.EqualFold(depDb.Name(), db.Name()) {
+				return fmt.Sprintf("%s `%s`", kind, name)
+			}
+			return fmt.Sprintf("%s `%s`.`%s`", kind, depDb.Name(), name)
+		}
+
+		if tdb, ok := depDb.(sql.TriggerDatabase); ok {
+			triggers, err := tdb.GetTriggers(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, trigger := range triggers {
+				stmt, err := sqlparser.Parse(trigger.CreateStatement)
+				if err != nil {
+					return nil, err
+				}
+				ddl, ok := stmt.(*sqlparser.DDL)
+				if !ok || ddl.TriggerSpec == nil {
+					return nil, sql.ErrTriggerCreateStatementInvalid.New(trigger.CreateStatement)
+				}
+				if _, ok := dropped[droppedTableKey(depDb.Name(), ddl.Table.Name.String())]; ok {
+					continue
+				}
+				reads, err := readsTable(ddl.TriggerSpec.Body, depDb.Name(), db.Name(), tableName)
+				if err != nil {
+					return nil, err
+				}
+				if reads {
+					dependents = append(dependents, describe("trigger", trigger.Name))
+				}
+			}
+		}
+
+		var views []sql.ViewDefinition
+		if vdb, ok := depDb.(sql.ViewDatabase); ok {
+			dbViews, err := vdb.AllViews(ctx)
+			if err != nil {
+				return nil, err
+			}
+			views = append(views, dbViews...)
+		}
+		for _, view := range ctx.GetViewRegistry().ViewsInDatabase(depDb.Name()) {
+			views = append(views, sql.ViewDefinition{Name: view.Name(), TextDefinition: view.TextDefinition()})
+		}
+		for _, view := range views {
+			stmt, err := sqlparser.Parse(view.TextDefinition)
+			if err != nil {
+				return nil, err
+			}
+			reads, err := readsTable(stmt, depDb.Name(), db.Name(), tableName)
+			if err != nil {
+				return nil, err
+			}
+			if reads {
+				dependents = append(dependents, describe("view", view.Name))
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents, nil
+}
+
+// readsTable returns whether the statement given, from the database |stmtDb|, reads the table given of the database
+// |dbName|. The qualifiers of column names aren't considered, since they may be aliases, and the tables they name are
+// read elsewhere in the statement.
+func readsTable(stmt sqlparser.SQLNode, stmtDb, dbName, tableName string) (bool, error) {
+	reads := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			return false, nil
+		case sqlparser.TableName:
+			qualifier := node.Qualifier.String()
+			if qualifier == "" {
+				qualifier = stmtDb
+			}
+			if strings.EqualFold(node.Name.String(), tableName) && strings.EqualFold(qualifier, dbName) {
+				reads = true
+			}
+		}
+		return !reads, nil
+	}, stmt)
+	return reads, err
+}
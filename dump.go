@@ -0,0 +1,375 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// dumpInsertSize is the size in bytes after which the rows of a table are dumped in a new INSERT statement, which is
+// the default net_buffer_length of mysqldump.
+const dumpInsertSize = 1046528
+
+var _ sql.DatabaseDumper = (*Engine)(nil)
+
+// DumpDatabase implements sql.DatabaseDumper. The dump is made of the statements creating the tables of the database,
+// the INSERT statements of their rows, and the statements creating its views, triggers and, with the Routines option,
+// stored procedures. The names in the statements aren't qualified, so that the dump can be restored in any database.
+// The database is read with the privileges of the session of |ctx|, within its transaction, or in a read-only
+// transaction started for the dump when it has none.
+func (e *Engine) DumpDatabase(ctx *sql.Context, dbName string, w io.Writer, opts sql.DumpOptions) (err error) {
+	db, err := e.Analyzer.Catalog.Database(ctx, dbName)
+	if err != nil {
+		return err
+	}
+
+	if ctx.GetTransaction() == nil {
+		if ts, ok := ctx.Session.(sql.TransactionSession); ok {
+			tx, err := ts.StartTransaction(ctx, sql.ReadOnly)
+			if err != nil {
+				return err
+			}
+			ctx.SetTransaction(tx)
+			defer func() {
+				ctx.SetTransaction(nil)
+				if commitErr := ts.CommitTransaction(ctx, tx); err == nil {
+					err = commitErr
+				}
+			}()
+		}
+	}
+
+	d := &dumper{engine: e, ctx: ctx, database: db, db: db.Name(), w: bufio.NewWriter(w), opts: opts}
+	if err = d.dump(); err != nil {
+		return err
+	}
+	return d.w.Flush()
+}
+
+// dumper writes the dump of a database.
+type dumper struct {
+	engine   *Engine
+	ctx      *sql.Context
+	database sql.Database
+	db       string
+	w        *bufio.Writer
+	opts     sql.DumpOptions
+}
+
+func (d *dumper) dump() error {
+	rows, err := d.queryRows(fmt.Sprintf("SHOW FULL TABLES FROM %s", sql.QuoteIdentifier(d.db)))
+	if err != nil {
+		return err
+	}
+	var tables, views []string
+	for _, row := range rows {
+		if row[1] == "VIEW" {
+			views = append(views, row[0].(string))
+		} else {
+			tables = append(tables, row[0].(string))
+		}
+	}
+	sort.Strings(tables)
+	sort.Strings(views)
+
+	d.writeHeader()
+	for _, table := range tables {
+		if !d.opts.NoCreateInfo {
+			if err = d.dumpTableStructure(table); err != nil {
+				return err
+			}
+		}
+		if !d.opts.NoData {
+			if err = d.dumpTableData(table); err != nil {
+				return err
+			}
+		}
+	}
+	if !d.opts.NoCreateInfo {
+		for _, view := range views {
+			if err = d.dumpView(view); err != nil {
+				return err
+			}
+		}
+		if err = d.dumpTriggers(); err != nil {
+			return err
+		}
+		if d.opts.Routines {
+			if err = d.dumpProcedures(); err != nil {
+				return err
+			}
+		}
+	}
+	d.writeFooter()
+	return nil
+}
+
+// query runs the statement given. Its process isn't tracked on its own, since it's part of the dump.
+func (d *dumper) query(query string) (sql.Schema, sql.RowIter, error) {
+	parsed, err := parse.Parse(d.ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	analyzed, err := d.engine.Analyzer.Analyze(d.ctx, parsed, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if qp, ok := analyzed.(*plan.QueryProcess); ok {
+		analyzed = qp.Child()
+	}
+	iter, err := d.engine.Analyzer.ExecBuilder.Build(d.ctx, analyzed, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return analyzed.Schema(), rowexec.AddExpressionCloser(analyzed, iter), nil
+}
+
+func (d *dumper) queryRows(query string) ([]sql.Row, error) {
+	sch, iter, err := d.query(query)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(d.ctx, sch, iter)
+}
+
+func (d *dumper) qualified(name string) string {
+	return sql.QuoteIdentifier(d.db) + "." + sql.QuoteIdentifier(name)
+}
+
+// comment writes a comment block of the mysqldump format.
+func (d *dumper) comment(text string) {
+	if d.opts.MySQLDump {
+		fmt.Fprintf(d.w, "\n--\n-- %s\n--\n\n", text)
+	}
+}
+
+func (d *dumper) writeHeader() {
+	if d.opts.MySQLDump {
+		version := ""
+		if _, val, ok := sql.SystemVariables.GetGlobal("version"); ok {
+			version, _ = val.(string)
+		}
+		fmt.Fprintf(d.w, "-- MySQL dump\n--\n-- Database: %s\n", d.db)
+		fmt.Fprintf(d.w, "-- ------------------------------------------------------\n-- Server version\t%s\n\n", version)
+		d.w.WriteString("/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;\n")
+		d.w.WriteString("/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;\n")
+		d.w.WriteString("/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;\n")
+		d.w.WriteString("/*!50503 SET NAMES utf8mb4 */;\n")
+		d.w.WriteString("/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;\n")
+		d.w.WriteString("/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;\n")
+		d.w.WriteString("/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;\n")
+		d.w.WriteString("/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;\n")
+		return
+	}
+	// Tables may reference the ones created after them
+	d.w.WriteString("SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0;\n")
+}
+
+func (d *dumper) writeFooter() {
+	if d.opts.MySQLDump {
+		d.w.WriteString("\n/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;\n")
+		d.w.WriteString("/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;\n")
+		d.w.WriteString("/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */;\n")
+		d.w.WriteString("/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;\n")
+		d.w.WriteString("/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;\n")
+		d.w.WriteString("/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;\n")
+		d.w.WriteString("/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */;\n")
+		fmt.Fprintf(d.w, "\n-- Dump completed on %s\n", d.ctx.QueryTime().Format("2006-01-02 15:04:05"))
+		return
+	}
+	d.w.WriteString("SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS;\n")
+}
+
+func (d *dumper) dumpTableStructure(table string) error {
+	rows, err := d.queryRows(fmt.Sprintf("SHOW CREATE TABLE %s", d.qualified(table)))
+	if err != nil {
+		return err
+	}
+	d.comment(fmt.Sprintf("Table structure for table %s", sql.QuoteIdentifier(table)))
+	if d.opts.AddDropTable {
+		fmt.Fprintf(d.w, "DROP TABLE IF EXISTS %s;\n", sql.QuoteIdentifier(table))
+	}
+	fmt.Fprintf(d.w, "%s;\n", rows[0][1])
+	return nil
+}
+
+func (d *dumper) dumpTableData(table string) error {
+	tbl, _, err := d.engine.Analyzer.Catalog.Table(d.ctx, d.db, table)
+	if err != nil {
+		return err
+	}
+	// Generated columns are computed again when the rows are restored, and invisible ones are only inserted when named
+	var columns []string
+	var sch sql.Schema
+	named := false
+	for _, col := range tbl.Schema() {
+		if col.Generated != nil {
+			named = true
+			continue
+		}
+		named = named || col.Invisible
+		columns = append(columns, sql.QuoteIdentifier(col.Name))
+		sch = append(sch, col)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s VALUES ", sql.QuoteIdentifier(table))
+	if named {
+		insert = fmt.Sprintf("INSERT INTO %s (%s) VALUES ", sql.QuoteIdentifier(table), strings.Join(columns, ","))
+	}
+
+	_, iter, err := d.query(fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ","), d.qualified(table)))
+	if err != nil {
+		return err
+	}
+	defer iter.Close(d.ctx)
+
+	d.comment(fmt.Sprintf("Dumping data for table %s", sql.QuoteIdentifier(table)))
+	if d.opts.MySQLDump {
+		fmt.Fprintf(d.w, "LOCK TABLES %s WRITE;\n", sql.QuoteIdentifier(table))
+		fmt.Fprintf(d.w, "/*!40000 ALTER TABLE %s DISABLE KEYS */;\n", sql.QuoteIdentifier(table))
+	}
+	var stmt bytes.Buffer
+	for {
+		row, err := iter.Next(d.ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if stmt.Len() == 0 {
+			stmt.WriteString(insert)
+		} else {
+			stmt.WriteByte(',')
+		}
+		stmt.WriteByte('(')
+		for i, v := range row {
+			if i > 0 {
+				stmt.WriteByte(',')
+			}
+			if err = writeDumpValue(d.ctx, &stmt, sch[i].Type, v); err != nil {
+				return err
+			}
+		}
+		stmt.WriteByte(')')
+		if stmt.Len() >= dumpInsertSize {
+			stmt.WriteString(";\n")
+			d.w.Write(stmt.Bytes())
+			stmt.Reset()
+		}
+	}
+	if stmt.Len() > 0 {
+		stmt.WriteString(";\n")
+		d.w.Write(stmt.Bytes())
+	}
+	if d.opts.MySQLDump {
+		fmt.Fprintf(d.w, "/*!40000 ALTER TABLE %s ENABLE KEYS */;\nUNLOCK TABLES;\n", sql.QuoteIdentifier(table))
+	}
+	return nil
+}
+
+// writeDumpValue writes the literal of the value given, of the type given. Binary values are written in hexadecimal,
+// like mysqldump does with --hex-blob, so that the dump is valid text.
+func writeDumpValue(ctx *sql.Context, buf *bytes.Buffer, typ sql.Type, v interface{}) error {
+	if v == nil {
+		buf.WriteString("NULL")
+		return nil
+	}
+	val, err := typ.SQL(ctx, nil, v)
+	if err != nil {
+		return err
+	}
+	if types.IsBinaryType(typ) {
+		if len(val.Raw()) == 0 {
+			buf.WriteString("''")
+			return nil
+		}
+		buf.WriteString("0x")
+		buf.WriteString(hex.EncodeToString(val.Raw()))
+		return nil
+	}
+	val.EncodeSQL(buf)
+	return nil
+}
+
+func (d *dumper) dumpView(view string) error {
+	rows, err := d.queryRows(fmt.Sprintf("SHOW CREATE VIEW %s", d.qualified(view)))
+	if err != nil {
+		return err
+	}
+	d.comment(fmt.Sprintf("Final view structure for view %s", sql.QuoteIdentifier(view)))
+	if d.opts.AddDropTable {
+		fmt.Fprintf(d.w, "DROP VIEW IF EXISTS %s;\n", sql.QuoteIdentifier(view))
+	}
+	fmt.Fprintf(d.w, "%s;\n", rows[0][1])
+	return nil
+}
+
+func (d *dumper) dumpTriggers() error {
+	triggers, err := d.queryRows(fmt.Sprintf("SHOW TRIGGERS FROM %s", sql.QuoteIdentifier(d.db)))
+	if err != nil {
+		return err
+	}
+	for _, trigger := range triggers {
+		rows, err := d.queryRows(fmt.Sprintf("SHOW CREATE TRIGGER %s", d.qualified(trigger[0].(string))))
+		if err != nil {
+			return err
+		}
+		d.comment(fmt.Sprintf("Trigger %s on table %s", sql.QuoteIdentifier(trigger[0].(string)), sql.QuoteIdentifier(trigger[2].(string))))
+		d.writeCompoundStatement(rows[0][2].(string))
+	}
+	return nil
+}
+
+func (d *dumper) dumpProcedures() error {
+	spd, ok := d.database.(sql.StoredProcedureDatabase)
+	if !ok {
+		return nil
+	}
+	procedures, err := spd.GetStoredProcedures(d.ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(procedures, func(i, j int) bool {
+		return procedures[i].Name < procedures[j].Name
+	})
+	for _, procedure := range procedures {
+		d.comment(fmt.Sprintf("Procedure %s", sql.QuoteIdentifier(procedure.Name)))
+		d.writeCompoundStatement(procedure.CreateStatement)
+	}
+	return nil
+}
+
+// writeCompoundStatement writes a statement whose body may contain semicolons, which the mysqldump format delimits
+// with ;; instead.
+func (d *dumper) writeCompoundStatement(stmt string) {
+	if d.opts.MySQLDump {
+		fmt.Fprintf(d.w, "DELIMITER ;;\n%s ;;\nDELIMITER ;\n", stmt)
+		return
+	}
+	fmt.Fprintf(d.w, "%s;\n", stmt)
+}
@@ -16,7 +16,9 @@ package sqle
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -29,8 +31,10 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/queries"
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	_ "github.com/dolthub/go-mysql-server/sql/variables"
 )
 
@@ -56,6 +60,37 @@ type Config struct {
 	// disabled, and including any users here will enable authentication. All users in this list will have full access.
 	// This field is only temporary, and will be removed as development on users and authentication continues.
 	TemporaryUsers []TemporaryUser
+	// QueryCacheSize is the number of result sets kept by the engine's query cache, which caches the results of
+	// SELECT statements for the sessions whose query_cache_type isn't OFF. The cache is disabled when it's 0.
+	QueryCacheSize int
+	// QueryCacheRowLimit is the maximum number of rows of the result sets kept by the query cache. Defaults to 1000.
+	QueryCacheRowLimit int
+	// EnableReturning allows the RETURNING clause on INSERT, REPLACE and DELETE statements, as MariaDB does, which
+	// makes them return a result set of the rows they write rather than the number of rows they affect.
+	EnableReturning bool
+	// EnableDatabaseComments allows the COMMENT option on CREATE and ALTER DATABASE statements, as MariaDB does. The
+	// comment is kept by the databases implementing sql.CommentedDatabase and shown in SHOW CREATE DATABASE and
+	// information_schema.schemata.
+	EnableDatabaseComments bool
+	// QueryRewriters inspect and may rewrite or reject the statements run by the engine before they're analyzed. They
+	// are applied in order.
+	QueryRewriters []QueryRewriter
+	// RestrictDropTableDependents makes DROP TABLE fail with an error naming the views, and the triggers of other
+	// tables, of any database that read the tables it drops. This deliberately inverts MySQL's behavior, which is the default: the views
+	// reading the dropped tables are kept but can't be used until the tables are created again. The triggers defined on
+	// the dropped tables are dropped along with them either way.
+	RestrictDropTableDependents bool
+	// AutocommitRetries is the number of times a statement run in its own autocommit transaction is retried when it
+	// fails with sql.ErrSerializationFailure, as integrators using optimistic concurrency control report conflicting
+	// commits. Statements returning a result set aren't retried, since their rows are streamed as they're read.
+	AutocommitRetries int
+	// IndexLookupCacheSize is the number of index lookups whose rows are kept by the engine's index lookup cache, which
+	// caches the rows of the lookups of read-only queries, such as the point lookups of the inner side of lookup joins.
+	// The cache is disabled when it's 0. See sql.IndexLookupCache.
+	IndexLookupCacheSize int
+	// IndexLookupCacheRowLimit is the maximum number of rows of the lookups kept by the index lookup cache. Defaults to
+	// 100.
+	IndexLookupCacheRowLimit int
 }
 
 // TemporaryUser is a user that will be added to the engine. This is for temporary use while the remaining features
@@ -67,13 +102,20 @@ type TemporaryUser struct {
 
 // PreparedDataCache manages all the prepared data for every session for every query for an engine
 type PreparedDataCache struct {
-	data map[uint32]map[string]sql.Node
+	data map[uint32]map[string]preparedStmt
 	mu   *sync.Mutex
 }
 
+// preparedStmt is the plan of a prepared statement, along with the current database of the session when it was
+// prepared. As in MySQL, the statement keeps it as its default database even if the session changes databases after.
+type preparedStmt struct {
+	node     sql.Node
+	database string
+}
+
 func NewPreparedDataCache() *PreparedDataCache {
 	return &PreparedDataCache{
-		data: make(map[uint32]map[string]sql.Node),
+		data: make(map[uint32]map[string]preparedStmt),
 		mu:   &sync.Mutex{},
 	}
 }
@@ -85,16 +127,36 @@ func (p *PreparedDataCache) GetCachedStmt(sessId uint32, query string) (sql.Node
 	defer p.mu.Unlock()
 	if sessData, ok := p.data[sessId]; ok {
 		data, ok := sessData[query]
-		return data, ok
+		return data.node, ok
 	}
 	return nil, false
 }
 
+// GetCachedStmtDatabase returns the default database of the prepared statement associated with the ctx.SessionId and
+// query, which is empty if it wasn't recorded. It returns "", false if the query does not exist.
+func (p *PreparedDataCache) GetCachedStmtDatabase(sessId uint32, query string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sessData, ok := p.data[sessId]; ok {
+		data, ok := sessData[query]
+		return data.database, ok
+	}
+	return "", false
+}
+
 // GetSessionData returns all the prepared queries for a particular session
 func (p *PreparedDataCache) GetSessionData(sessId uint32) map[string]sql.Node {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return p.data[sessId]
+	sessData, ok := p.data[sessId]
+	if !ok {
+		return nil
+	}
+	nodes := make(map[string]sql.Node, len(sessData))
+	for query, data := range sessData {
+		nodes[query] = data.node
+	}
+	return nodes
 }
 
 // DeleteSessionData clears a session along with all prepared queries for that session
@@ -104,14 +166,25 @@ func (p *PreparedDataCache) DeleteSessionData(sessId uint32) {
 	delete(p.data, sessId)
 }
 
-// CacheStmt saves the prepared node and associates a ctx.SessionId and query to it
+// CacheStmt saves the prepared node and associates a ctx.SessionId and query to it. The statement has no default
+// database of its own, and is run in the current database of the session.
 func (p *PreparedDataCache) CacheStmt(sessId uint32, query string, node sql.Node) {
+	p.cacheStmt(sessId, query, preparedStmt{node: node})
+}
+
+// CachePreparedStmt saves the prepared node and associates the session of the context and query to it, along with the
+// current database of the session, which remains the default database of the statement.
+func (p *PreparedDataCache) CachePreparedStmt(ctx *sql.Context, query string, node sql.Node) {
+	p.cacheStmt(ctx.Session.ID(), query, preparedStmt{node: node, database: ctx.GetCurrentDatabase()})
+}
+
+func (p *PreparedDataCache) cacheStmt(sessId uint32, query string, stmt preparedStmt) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	if _, ok := p.data[sessId]; !ok {
-		p.data[sessId] = make(map[string]sql.Node)
+		p.data[sessId] = make(map[string]preparedStmt)
 	}
-	p.data[sessId][query] = node
+	p.data[sessId][query] = stmt
 }
 
 // UncacheStmt removes the prepared node associated with a ctx.SessionId and query to it
@@ -126,16 +199,26 @@ func (p *PreparedDataCache) UncacheStmt(sessId uint32, query string) {
 
 // Engine is a SQL engine.
 type Engine struct {
-	Analyzer          *analyzer.Analyzer
-	LS                *sql.LockSubsystem
-	ProcessList       sql.ProcessList
-	MemoryManager     *sql.MemoryManager
-	BackgroundThreads *sql.BackgroundThreads
-	IsReadOnly        bool
-	IsServerLocked    bool
-	PreparedDataCache *PreparedDataCache
-	mu                *sync.Mutex
-	Version           sql.AnalyzerVersion
+	Analyzer                    *analyzer.Analyzer
+	LS                          *sql.LockSubsystem
+	ProcessList                 sql.ProcessList
+	MemoryManager               *sql.MemoryManager
+	BackgroundThreads           *sql.BackgroundThreads
+	IsReadOnly                  bool
+	IsServerLocked              bool
+	PreparedDataCache           *PreparedDataCache
+	QueryCache                  *QueryCache
+	IndexLookupCache            *sql.IndexLookupCache
+	EnableReturning             bool
+	EnableDatabaseComments      bool
+	QueryRewriters              []QueryRewriter
+	RestrictDropTableDependents bool
+	AutocommitRetries           int
+	// RowEvents publishes the row changes of statements and committed transactions to the consumers subscribed with
+	// SubscribeRowEvents and to the hooks registered with OnStatementComplete and OnTransactionCommit
+	RowEvents *sql.RowEventPublisher
+	mu        *sync.Mutex
+	Version   sql.AnalyzerVersion
 }
 
 type ColumnWithRawDefault struct {
@@ -164,22 +247,41 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	})
 	a.Catalog.RegisterFunction(emptyCtx, function.GetLockingFuncs(ls)...)
 
+	var queryCache *QueryCache
+	if cfg.QueryCacheSize > 0 {
+		queryCache = NewQueryCache(cfg.QueryCacheSize, cfg.QueryCacheRowLimit)
+	}
+	var lookupCache *sql.IndexLookupCache
+	if cfg.IndexLookupCacheSize > 0 {
+		lookupCache = sql.NewIndexLookupCache(cfg.IndexLookupCacheSize, cfg.IndexLookupCacheRowLimit)
+	}
+
 	version := sql.VersionStable
 	if ExperimentalGMS {
 		version = sql.VersionExperimental
 	}
-	return &Engine{
-		Analyzer:          a,
-		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
-		ProcessList:       NewProcessList(),
-		LS:                ls,
-		BackgroundThreads: sql.NewBackgroundThreads(),
-		IsReadOnly:        cfg.IsReadOnly,
-		IsServerLocked:    cfg.IsServerLocked,
-		PreparedDataCache: NewPreparedDataCache(),
-		mu:                &sync.Mutex{},
-		Version:           version,
+	e := &Engine{
+		Analyzer:                    a,
+		MemoryManager:               sql.NewMemoryManager(sql.ProcessMemory),
+		ProcessList:                 NewProcessList(),
+		LS:                          ls,
+		BackgroundThreads:           sql.NewBackgroundThreads(),
+		IsReadOnly:                  cfg.IsReadOnly,
+		IsServerLocked:              cfg.IsServerLocked,
+		PreparedDataCache:           NewPreparedDataCache(),
+		QueryCache:                  queryCache,
+		IndexLookupCache:            lookupCache,
+		EnableReturning:             cfg.EnableReturning,
+		EnableDatabaseComments:      cfg.EnableDatabaseComments,
+		QueryRewriters:              cfg.QueryRewriters,
+		RestrictDropTableDependents: cfg.RestrictDropTableDependents,
+		AutocommitRetries:           cfg.AutocommitRetries,
+		RowEvents:                   sql.NewRowEventPublisher(),
+		mu:                          &sync.Mutex{},
+		Version:                     version,
 	}
+	a.Catalog.DatabaseDumper = e
+	return e
 }
 
 // NewDefault creates a new default Engine.
@@ -201,18 +303,25 @@ func (e *Engine) AnalyzeQuery(
 		ctx.Version = e.Version
 	}
 
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
 	var parsed sql.Node
-	var err error
 	switch ctx.Version {
 	case sql.VersionExperimental:
 		parsed, err = planbuilder.Parse(ctx, e.Analyzer.Catalog, query)
 		if err != nil {
 			ctx.Version = sql.VersionStable
-			parsed, err = parse.Parse(ctx, query)
+			parsed, err = parse.ParseWithOptions(ctx, query, e.ParserOptions())
 		}
 	default:
-		parsed, err = parse.Parse(ctx, query)
+		parsed, err = parse.ParseWithOptions(ctx, query, e.ParserOptions())
+	}
+	if err != nil {
+		return nil, err
 	}
+	parsed, err = e.rewriteNode(ctx, query, parsed)
 	if err != nil {
 		return nil, err
 	}
@@ -225,20 +334,37 @@ func (e *Engine) PrepareQuery(
 	ctx *sql.Context,
 	query string,
 ) (sql.Node, error) {
-	parsed, err := parse.Parse(ctx, query)
+	// prepared statements are cached by the text of the query as rewritten, which is the one they're executed with
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parse.ParseWithOptions(ctx, query, e.ParserOptions())
 	if err != nil {
 		return nil, err
 	}
+	if parsed, err = e.rewriteNode(ctx, query, parsed); err != nil {
+		return nil, err
+	}
+	if err = e.returningCheck(parsed); err != nil {
+		return nil, err
+	}
 
 	node, err := e.Analyzer.PrepareQuery(ctx, parsed, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	e.PreparedDataCache.CacheStmt(ctx.Session.ID(), query, node)
+	e.PreparedDataCache.CachePreparedStmt(ctx, query, node)
 	return node, nil
 }
 
+// QueryDigest returns the digest of the query given, which is the same for the queries that differ only by their
+// literal values, whitespace and comments.
+func (e *Engine) QueryDigest(query string) queries.Digest {
+	return queries.NewDigest(query)
+}
+
 // Query executes a query.
 func (e *Engine) Query(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, error) {
 	return e.QueryWithBindings(ctx, query, nil)
@@ -250,8 +376,46 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, bindings map[
 }
 
 // QueryNodeWithBindings executes the query given with the bindings provided. If parsed is non-nil, it will be used
-// instead of parsing the query from text.
+// instead of parsing the query from text. An error returned by the query, either right away or while iterating over
+// its rows, is also added to the session's warnings with the Error level, as SHOW ERRORS shows.
 func (e *Engine) QueryNodeWithBindings(ctx *sql.Context, query string, parsed sql.Node, bindings map[string]sql.Expression) (sql.Schema, sql.RowIter, error) {
+	retries := e.autocommitRetries(ctx)
+	for attempt := 0; ; attempt++ {
+		sch, iter, err := e.queryNodeWithBindings(ctx, query, parsed, bindings)
+		// the statement is run to completion, which commits its transaction, to retry it if the commit conflicts
+		if err == nil && attempt < retries && types.IsOkResultSchema(sch) {
+			var rows []sql.Row
+			if rows, err = sql.RowIterToRows(ctx, sch, iter); err == nil {
+				iter = sql.RowsToRowIter(rows...)
+			}
+		}
+		if err != nil && attempt < retries && sql.ErrSerializationFailure.Is(err) {
+			ctx.GetLogger().Debugf("retrying statement after transaction conflict: %s", err)
+			if err = rollbackAutocommitTransaction(ctx); err == nil {
+				continue
+			}
+		}
+		if err != nil {
+			warnError(ctx, err)
+			return nil, nil, err
+		}
+		return sch, &errorWarningIter{iter: iter}, nil
+	}
+}
+
+// autocommitRetries returns the number of times the statement about to be run is retried when its transaction fails
+// to commit with a conflict, which is 0 unless it's run in its own autocommit transaction.
+func (e *Engine) autocommitRetries(ctx *sql.Context) int {
+	if e.AutocommitRetries <= 0 || ctx.GetTransaction() != nil || ctx.GetIgnoreAutoCommit() {
+		return 0
+	}
+	if autocommit, err := plan.IsSessionAutocommit(ctx); err != nil || !autocommit {
+		return 0
+	}
+	return e.AutocommitRetries
+}
+
+func (e *Engine) queryNodeWithBindings(ctx *sql.Context, query string, parsed sql.Node, bindings map[string]sql.Expression) (sql.Schema, sql.RowIter, error) {
 	var (
 		analyzed sql.Node
 		iter     sql.RowIter
@@ -261,22 +425,50 @@ func (e *Engine) QueryNodeWithBindings(ctx *sql.Context, query string, parsed sq
 	if ctx.Version == sql.VersionUnknown {
 		ctx.Version = e.Version
 	}
+	ctx.ApplyOpts(sql.WithRowEventPublisher(e.RowEvents))
+
+	// The warnings of the previous statement are kept until this one is parsed, since diagnostics statements show them
+	prevWarnings := len(ctx.Session.Warnings())
 
 	if parsed == nil {
+		query, err = e.RewriteQuery(ctx, query)
+		if err != nil {
+			clearPreviousWarnings(ctx, prevWarnings)
+			return nil, nil, err
+		}
 		switch ctx.Version {
 		case sql.VersionExperimental:
 			parsed, err = planbuilder.Parse(ctx, e.Analyzer.Catalog, query)
 			if err != nil {
 				ctx.Version = sql.VersionStable
-				parsed, err = parse.Parse(ctx, query)
+				parsed, err = parse.ParseWithOptions(ctx, query, e.ParserOptions())
 			}
 		default:
-			parsed, err = parse.Parse(ctx, query)
+			parsed, err = parse.ParseWithOptions(ctx, query, e.ParserOptions())
 		}
 		if err != nil {
+			clearPreviousWarnings(ctx, prevWarnings)
 			return nil, nil, err
 		}
 	}
+	parsed, err = e.rewriteNode(ctx, query, parsed)
+	if err != nil {
+		clearPreviousWarnings(ctx, prevWarnings)
+		return nil, nil, err
+	}
+	ctx.ApplyOpts(sql.WithStatement(isReadOnlyStatement(parsed, query)))
+
+	if !plan.IsDiagnosticsStatement(parsed) {
+		clearPreviousWarnings(ctx, prevWarnings)
+	}
+
+	defer e.usePreparedStmtDatabase(ctx, query)()
+
+	sql.IncrementStatusVariable(ctx, "Questions", 1)
+	sql.IncrementStatusVariable(ctx, "Queries", 1)
+	if name := plan.StatementStatusVariable(parsed); name != "" {
+		sql.IncrementStatusVariable(ctx, name, 1)
+	}
 
 	// Give the integrator a chance to reject the session before proceeding
 	err = ctx.Session.ValidateSession(ctx)
@@ -284,11 +476,26 @@ func (e *Engine) QueryNodeWithBindings(ctx *sql.Context, query string, parsed sq
 		return nil, nil, err
 	}
 
+	err = expiredPasswordCheck(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = e.readOnlyCheck(parsed)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	err = e.returningCheck(parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = e.dropTableCheck(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = e.beginTransaction(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -308,6 +515,32 @@ func (e *Engine) QueryNodeWithBindings(ctx *sql.Context, query string, parsed sq
 		return nil, nil, err
 	}
 
+	var cacheKey string
+	var cacheVersions []string
+	if e.QueryCache != nil && len(bindings) == 0 {
+		var rows []sql.Row
+		var cached bool
+		rows, cacheKey, cacheVersions, cached, err = e.QueryCache.query(ctx, query, analyzed)
+		if err == nil && cached {
+			analyzed, err = withQueryCacheResults(analyzed, rows)
+			cacheKey = ""
+		}
+		if err != nil {
+			err2 := clearAutocommitTransaction(ctx)
+			if err2 != nil {
+				err = errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+			}
+
+			return nil, nil, err
+		}
+	}
+
+	if e.IndexLookupCache != nil && isReadOnlyPlan(analyzed) {
+		ctx.ApplyOpts(sql.WithIndexLookupCache(e.IndexLookupCache.ForQuery()))
+	} else {
+		ctx.ApplyOpts(sql.WithIndexLookupCache(nil))
+	}
+
 	iter, err = e.Analyzer.ExecBuilder.Build(ctx, analyzed, nil)
 	if err != nil {
 		err2 := clearAutocommitTransaction(ctx)
@@ -318,10 +551,76 @@ func (e *Engine) QueryNodeWithBindings(ctx *sql.Context, query string, parsed sq
 		return nil, nil, err
 	}
 	iter = rowexec.AddExpressionCloser(analyzed, iter)
+	if cacheKey != "" {
+		iter = &queryCacheIter{
+			iter:     iter,
+			cache:    e.QueryCache,
+			key:      cacheKey,
+			versions: cacheVersions,
+			schema:   analyzed.Schema(),
+		}
+	}
 
 	return analyzed.Schema(), iter, nil
 }
 
+// clearPreviousWarnings removes the oldest |count| warnings from the session, which are the ones left by the previous
+// statement, and keeps any raised since.
+func clearPreviousWarnings(ctx *sql.Context, count int) {
+	if count == 0 {
+		return
+	}
+	// Warnings are returned from the most recent
+	warnings := ctx.Session.Warnings()
+	ctx.Session.ClearWarnings()
+	for i := len(warnings) - count - 1; i >= 0; i-- {
+		ctx.Session.Warn(warnings[i])
+	}
+}
+
+// warnError adds the error given to the session's warnings with the Error level.
+func warnError(ctx *sql.Context, err error) {
+	if ctx.Session == nil {
+		return
+	}
+	sqlErr := sql.CastSQLError(err)
+	ctx.Error(sqlErr.Num, "%s", sqlErr.Message)
+}
+
+// errorWarningIter wraps the row iterator of a query to add any error it returns to the session's warnings.
+type errorWarningIter struct {
+	iter sql.RowIter
+}
+
+var _ sql.RowBatchIter = (*errorWarningIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (i *errorWarningIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil && err != io.EOF {
+		warnError(ctx, err)
+	}
+	return row, err
+}
+
+// NextBatch implements the interface sql.RowBatchIter.
+func (i *errorWarningIter) NextBatch(ctx *sql.Context, batch []sql.Row) (int, error) {
+	n, err := sql.NextRowBatch(ctx, i.iter, batch)
+	if err != nil && err != io.EOF {
+		warnError(ctx, err)
+	}
+	return n, err
+}
+
+// Close implements the interface sql.RowIter.
+func (i *errorWarningIter) Close(ctx *sql.Context) error {
+	err := i.iter.Close(ctx)
+	if err != nil {
+		warnError(ctx, err)
+	}
+	return err
+}
+
 // clearAutocommitTransaction unsets the transaction from the current session if it is an implicitly
 // created autocommit transaction. This enables the next request to have an autocommit transaction
 // correctly started.
@@ -348,11 +647,51 @@ func clearAutocommitTransaction(ctx *sql.Context) error {
 	return nil
 }
 
+// rollbackAutocommitTransaction rolls back the autocommit transaction of a statement that failed to commit, so that
+// the statement is retried in a new one.
+func rollbackAutocommitTransaction(ctx *sql.Context) error {
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return nil
+	}
+	if ts, ok := ctx.Session.(sql.TransactionSession); ok {
+		if err := ts.Rollback(ctx, tx); err != nil {
+			return err
+		}
+	}
+	ctx.RowEvents().Rollback(ctx.ID())
+	ctx.SetTransaction(nil)
+	return nil
+}
+
 // CloseSession deletes session specific prepared statement data
 func (e *Engine) CloseSession(connID uint32) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.PreparedDataCache.DeleteSessionData(connID)
+	e.RowEvents.Rollback(connID)
+	if p, ok := e.Analyzer.Catalog.Provider.(sql.SessionDatabaseProvider); ok {
+		p.CloseSession(connID)
+	}
+}
+
+// OnStatementComplete registers a hook that's called with the number of rows of each table that a statement changed,
+// once the statement completes, and returns a function that removes it. See sql.RowEventPublisher.OnStatementComplete.
+func (e *Engine) OnStatementComplete(hook sql.RowChangeHook) (remove func()) {
+	return e.RowEvents.OnStatementComplete(hook)
+}
+
+// OnTransactionCommit registers a hook that's called with the number of rows of each table that a transaction
+// changed, once the transaction commits, and returns a function that removes it. See
+// sql.RowEventPublisher.OnTransactionCommit.
+func (e *Engine) OnTransactionCommit(hook sql.RowChangeHook) (remove func()) {
+	return e.RowEvents.OnTransactionCommit(hook)
+}
+
+// SubscribeRowEvents registers the consumer given to receive the row changes of every transaction committed from now
+// on, in commit order, and returns a function that unregisters it. See sql.RowEventPublisher.
+func (e *Engine) SubscribeRowEvents(consumer sql.RowEventConsumer) (unsubscribe func()) {
+	return e.RowEvents.Subscribe(consumer)
 }
 
 // Count number of BindVars in given tree
@@ -391,7 +730,7 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 		if err != nil {
 			return nil, err
 		}
-		e.PreparedDataCache.CacheStmt(ctx.Session.ID(), n.Name, analyzedChild)
+		e.PreparedDataCache.CachePreparedStmt(ctx, n.Name, analyzedChild)
 		return parsed, nil
 	case *plan.ExecuteQuery:
 		// replace execute query node with the one prepared
@@ -399,6 +738,7 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 		if !ok {
 			return nil, sql.ErrUnknownPreparedStatement.New(n.Name)
 		}
+		defer e.usePreparedStmtDatabase(ctx, n.Name)()
 
 		// number of BindVars provided must match number of BindVars expected
 		if countBindVars(p) != len(n.BindVars) {
@@ -459,6 +799,21 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 	return analyzed, nil
 }
 
+// usePreparedStmtDatabase sets the current database of the session to the default database of the prepared statement
+// given, if any, and returns a function restoring it. The statement is analyzed with the database it was prepared in,
+// while the functions it calls when run, such as DATABASE(), see the current database of the session.
+func (e *Engine) usePreparedStmtDatabase(ctx *sql.Context, name string) func() {
+	db, ok := e.PreparedDataCache.GetCachedStmtDatabase(ctx.Session.ID(), name)
+	prevDb := ctx.GetCurrentDatabase()
+	if !ok || db == "" || db == prevDb {
+		return func() {}
+	}
+	ctx.SetCurrentDatabase(db)
+	return func() {
+		ctx.SetCurrentDatabase(prevDb)
+	}
+}
+
 func (e *Engine) analyzePreparedQuery(ctx *sql.Context, query string, analyzed sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
 	ctx.GetLogger().Tracef("optimizing prepared plan for query: %s", query)
 
@@ -531,7 +886,7 @@ func (e *Engine) readOnlyCheck(node sql.Node) error {
 	}
 	switch node.(type) {
 	case
-		*plan.DeleteFrom, *plan.InsertInto, *plan.Update, *plan.LockTables, *plan.UnlockTables:
+		*plan.DeleteFrom, *plan.InsertInto, *plan.Update, *plan.LockTables, *plan.UnlockTables, *plan.Returning:
 		if e.IsReadOnly {
 			return sql.ErrReadOnly.New()
 		} else if e.IsServerLocked {
@@ -541,6 +896,87 @@ func (e *Engine) readOnlyCheck(node sql.Node) error {
 	return nil
 }
 
+// expiredPasswordCheck returns an error if the password of the session user has expired and the query doesn't change
+// it, since such sessions are restricted to the statements that let the user change their password.
+func expiredPasswordCheck(ctx *sql.Context, node sql.Node) error {
+	if !ctx.Session.Client().PasswordExpired {
+		return nil
+	}
+	switch node.(type) {
+	case *plan.AlterUser, *plan.Set:
+		return nil
+	}
+	return sql.ErrMustChangePassword.New()
+}
+
+// isReadOnlyPlan returns whether the plan |n| given only reads tables, so that the rows of its index lookups can't
+// change while it runs and may be cached. The statements committing implicitly all write, as do the ones reading or
+// setting the next value of a sequence.
+func isReadOnlyPlan(n sql.Node) bool {
+	readOnly := true
+	var inspectNode func(n sql.Node) bool
+	inspectExpr := func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery:
+			transform.Inspect(e.Query, inspectNode)
+		case *function.NextVal, *function.SetVal:
+			readOnly = false
+		case *expression.UnresolvedFunction:
+			if name := strings.ToLower(e.Name()); name == "nextval" || name == "setval" {
+				readOnly = false
+			}
+		}
+		return readOnly
+	}
+	inspectNode = func(n sql.Node) bool {
+		if plan.ImplicitCommitOf(n) != plan.NoImplicitCommit {
+			readOnly = false
+		}
+		switch n.(type) {
+		case *plan.CreateTable, *plan.DeleteFrom, *plan.InsertInto, *plan.Update, *plan.Returning, *plan.Call,
+			*plan.UnlockTables:
+			readOnly = false
+		}
+		if ex, ok := n.(sql.Expressioner); ok && readOnly {
+			for _, e := range ex.Expressions() {
+				transform.InspectExpr(e, func(e sql.Expression) bool {
+					return !inspectExpr(e)
+				})
+			}
+		}
+		return readOnly
+	}
+	transform.Inspect(n, inspectNode)
+	return readOnly
+}
+
+// isReadOnlyStatement returns whether the statement |query| parsed as |n| only reads tables. Executing a prepared
+// statement isn't, since the statement it runs isn't known until it's analyzed, and neither are locking reads, which
+// lock the rows they read for the writes that follow them.
+func isReadOnlyStatement(n sql.Node, query string) bool {
+	switch n.(type) {
+	case *plan.ExecuteQuery, *plan.PrepareQuery, *plan.DeallocateQuery:
+		return false
+	}
+	return isReadOnlyPlan(n) && !hasLockingRead(query)
+}
+
+// ParserOptions returns the extensions of the parser enabled for the statements the engine runs.
+func (e *Engine) ParserOptions() parse.ParserOptions {
+	return parse.ParserOptions{
+		EnableReturning:        e.EnableReturning,
+		EnableDatabaseComments: e.EnableDatabaseComments,
+	}
+}
+
+// returningCheck returns an error for a statement with a RETURNING clause, unless the engine allows them.
+func (e *Engine) returningCheck(node sql.Node) error {
+	if _, ok := node.(*plan.Returning); ok && !e.EnableReturning {
+		return sql.ErrUnsupportedFeature.New("RETURNING clause")
+	}
+	return nil
+}
+
 // ResolveDefaults takes in a schema, along with each column's default value in a string form, and returns the schema
 // with the default values parsed and resolved.
 func ResolveDefaults(tableName string, schema []*ColumnWithRawDefault) (sql.Schema, error) {
@@ -0,0 +1,64 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package sqle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+func TestIsReadOnlyStatement(t *testing.T) {
+	tests := []struct {
+		query    string
+		readOnly bool
+	}{
+		{"SELECT * FROM t", true},
+		{"SELECT a FROM t WHERE b IN (SELECT b FROM u)", true},
+		{"SHOW TABLES", true},
+		{"SELECT LASTVAL(seq)", true},
+		{"INSERT INTO t VALUES (1)", false},
+		{"CREATE TEMPORARY TABLE tt (i int)", false},
+		{"ALTER TABLE t AUTO_INCREMENT = 10", false},
+		{"ALTER TABLE t ALTER COLUMN a SET DEFAULT 1", false},
+		{"ALTER TABLE t ALTER COLUMN a DROP DEFAULT", false},
+		{"ALTER TABLE t COMMENT 'commented'", false},
+		{"CREATE SEQUENCE db.seq", false},
+		{"ALTER SEQUENCE db.seq RESTART WITH 5", false},
+		{"DROP SEQUENCE db.seq", false},
+		{"ALTER USER u ACCOUNT LOCK", false},
+		{"SELECT NEXTVAL(seq)", false},
+		{"SELECT NEXT VALUE FOR seq", false},
+		{"SELECT SETVAL(seq, 10)", false},
+		{"SELECT a FROM t WHERE b IN (SELECT NEXTVAL(seq))", false},
+		{"SELECT * FROM t FOR UPDATE", false},
+		{"SELECT * FROM t LOCK IN SHARE MODE", false},
+		{"SELECT a FROM t WHERE b IN (SELECT b FROM u FOR UPDATE)", false},
+		{"START TRANSACTION", false},
+		{"LOCK TABLES t READ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			n, err := parse.Parse(ctx, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.readOnly, isReadOnlyStatement(n, tt.query))
+		})
+	}
+}
@@ -15,16 +15,22 @@
 package enginetest_test
 
 import (
+	"bytes"
 	"context"
 	sql2 "database/sql"
 	"fmt"
 	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,9 +47,12 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -78,6 +87,10 @@ func TestWarnings(t *testing.T) {
 	})
 }
 
+func TestWarningScripts(t *testing.T) {
+	enginetest.TestWarningScripts(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestClearWarnings(t *testing.T) {
 	enginetest.TestClearWarnings(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -203,6 +216,553 @@ func (l *lockableTable) Unlock(ctx *sql.Context, id uint32) error {
 	return nil
 }
 
+// TestLockTablesTransaction tests that the writes made while holding table locks are committed by UNLOCK TABLES rather
+// than after each statement, as they are when mysqldump output is restored.
+func TestLockTablesTransaction(t *testing.T) {
+	db := memory.NewDatabase("db")
+	t1 := newLockableTable(memory.NewTable("t1", sql.PrimaryKeySchema{}, db.GetForeignKeyCollection()))
+	t2 := memory.NewTable("t2", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t2", PrimaryKey: true},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("t1", t1)
+	db.AddTable("t2", t2)
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), new(sqle.Config))
+	defer e.Close()
+
+	sess := &transactionSession{BaseSession: sql.NewBaseSession()}
+	query := func(q string) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase("db")
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+	}
+
+	query("insert into t2 values (1)")
+	require.Equal(t, 1, sess.commits)
+
+	query("lock tables t1 write")
+	query("insert into t2 values (2)")
+	query("insert into t2 values (3)")
+	require.Equal(t, 1, sess.commits)
+	require.True(t, sess.GetIgnoreAutoCommit())
+
+	query("unlock tables")
+	require.Equal(t, 2, sess.commits)
+	require.False(t, sess.GetIgnoreAutoCommit())
+
+	query("insert into t2 values (4)")
+	require.Equal(t, 3, sess.commits)
+}
+
+// TestAutocommitRetries tests that statements run in their own autocommit transaction are retried when their commit
+// conflicts, as reported by integrators using optimistic concurrency control.
+func TestAutocommitRetries(t *testing.T) {
+	db := memory.NewDatabase("db")
+	tbl := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", PrimaryKey: true},
+		{Name: "j", Type: types.Int64, Source: "t"},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("t", tbl)
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{AutocommitRetries: 2})
+	defer e.Close()
+
+	sess := &transactionSession{BaseSession: sql.NewBaseSession()}
+	query := func(q string) ([]sql.Row, error) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase("db")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+
+	_, err := query("insert into t values (1, 1)")
+	require.NoError(t, err)
+	require.Equal(t, 1, sess.commits)
+
+	sess.conflicts = 2
+	_, err = query("update t set j = 2 where i = 1")
+	require.NoError(t, err)
+	require.Equal(t, 4, sess.commits)
+	require.Equal(t, 2, sess.rollbacks)
+	rows, err := query("select * from t")
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{int64(1), int64(2)}}, rows)
+
+	sess.conflicts = 3
+	_, err = query("update t set j = 3 where i = 1")
+	require.True(t, sql.ErrSerializationFailure.Is(err), "unexpected error %v", err)
+	require.Equal(t, 8, sess.commits)
+	require.Equal(t, 4, sess.rollbacks)
+
+	e.AutocommitRetries = 0
+	sess.conflicts = 1
+	_, err = query("update t set j = 4 where i = 1")
+	require.True(t, sql.ErrSerializationFailure.Is(err), "unexpected error %v", err)
+	require.Equal(t, 9, sess.commits)
+	require.Equal(t, 4, sess.rollbacks)
+}
+
+// TestAtomicDDL tests that DDL statements commit the transaction in progress and are run in a transaction of their
+// own, which is rolled back if they fail, unless the session runs them in the surrounding transaction.
+func TestAtomicDDL(t *testing.T) {
+	db := memory.NewDatabase("db")
+	tbl := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", PrimaryKey: true},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("t", tbl)
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), new(sqle.Config))
+	defer e.Close()
+
+	var sess sql.Session
+	query := func(q string) error {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase("db")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		return err
+	}
+
+	t.Run("implicit commits", func(t *testing.T) {
+		ts := &transactionSession{BaseSession: sql.NewBaseSession()}
+		sess = ts
+		require.NoError(t, query("create table t1 (i int primary key)"))
+		require.Equal(t, 1, ts.commits)
+
+		require.NoError(t, query("start transaction"))
+		require.NoError(t, query("insert into t values (1)"))
+		require.Equal(t, 2, ts.commits)
+		require.NoError(t, query("create table t2 (i int primary key)"))
+		require.Equal(t, 4, ts.commits)
+		require.False(t, ts.GetIgnoreAutoCommit())
+		require.NoError(t, query("insert into t values (2)"))
+		require.Equal(t, 5, ts.commits)
+
+		require.Error(t, query("create table t2 (i int primary key)"))
+		require.Equal(t, 5, ts.commits)
+		require.Equal(t, 1, ts.rollbacks)
+	})
+
+	t.Run("transactional DDL", func(t *testing.T) {
+		ts := &transactionalDDLSession{transactionSession: &transactionSession{BaseSession: sql.NewBaseSession()}}
+		sess = ts
+		require.NoError(t, query("start transaction"))
+		require.NoError(t, query("insert into t values (3)"))
+		require.Equal(t, 1, ts.commits)
+		require.NoError(t, query("create table t3 (i int primary key)"))
+		require.Equal(t, 1, ts.commits)
+		require.True(t, ts.GetIgnoreAutoCommit())
+		require.NoError(t, query("commit"))
+		require.Equal(t, 2, ts.commits)
+	})
+}
+
+// TestImplicitCommits tests that the statements that cause an implicit commit in MySQL commit the transaction in
+// progress, as documented in https://dev.mysql.com/doc/refman/8.0/en/implicit-commit.html, and that other statements
+// don't.
+func TestImplicitCommits(t *testing.T) {
+	tests := []struct {
+		setup  []string
+		query  string
+		commit bool
+		// err is whether the statement fails, which commits the transaction in progress all the same
+		err bool
+	}{
+		// Data definition statements
+		{query: "create database db2", commit: true},
+		{query: "alter database db collate utf8mb4_bin", commit: true},
+		{setup: []string{"create database db2"}, query: "drop database db2", commit: true},
+		{query: "create table t2 (i int primary key)", commit: true},
+		{query: "alter table t add column j int", commit: true},
+		{query: "rename table t to t2", commit: true},
+		{query: "truncate table t", commit: true},
+		{query: "drop table t", commit: true},
+		{query: "create index idx on t (i)", commit: true},
+		{query: "create view v as select 1", commit: true},
+		{setup: []string{"create view v as select 1"}, query: "drop view v", commit: true},
+		{query: "create procedure p() select 1", commit: true},
+		{setup: []string{"create procedure p() select 1"}, query: "drop procedure p", commit: true},
+		{query: "create trigger trg before insert on t for each row set new.i = new.i + 1", commit: true},
+		{query: "create table t (i int primary key)", commit: true, err: true},
+		// the memory database doesn't support temporary tables, but the statement still doesn't commit
+		{query: "create temporary table tt (i int primary key)", commit: false, err: true},
+
+		// Statements that implicitly use or modify the tables of the mysql database
+		{query: "create user u1", commit: true},
+		{setup: []string{"create user u1"}, query: "alter user u1 account lock", commit: true},
+		{setup: []string{"create user u1"}, query: "alter user u1 identified by 'pw'", commit: true},
+		{setup: []string{"create user u1"}, query: "rename user u1 to u2", commit: true, err: true},
+		{setup: []string{"create user u1"}, query: "drop user u1", commit: true},
+		{query: "create role r1", commit: true},
+		{setup: []string{"create user u1"}, query: "grant select on *.* to u1", commit: true},
+		{setup: []string{"create user u1"}, query: "revoke select on *.* from u1", commit: true},
+
+		// Administrative statements
+		{query: "analyze table t", commit: true},
+		{query: "check table t", commit: true},
+		{query: "optimize table t", commit: true},
+		{query: "flush privileges", commit: true},
+
+		// Replication control statements
+		{query: "start replica", commit: true, err: true},
+		{query: "stop replica", commit: true, err: true},
+
+		// Transaction-control and locking statements
+		{query: "start transaction", commit: true},
+		{query: "begin", commit: true},
+		{query: "lock tables t write", commit: true},
+		{query: "unlock tables", commit: false},
+		{query: "set autocommit = 1", commit: false},
+
+		// Other statements
+		{query: "select * from t", commit: false},
+		{query: "insert into t values (2)", commit: false},
+		{query: "update t set i = 3", commit: false},
+		{query: "set @x = 1", commit: false},
+		{query: "savepoint s1", commit: false},
+		{query: "show tables", commit: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			db := memory.NewDatabase("db")
+			pro := memory.NewDBProvider(db)
+			e := sqle.NewDefault(pro)
+			defer e.Close()
+			e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+			e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+			sess := &transactionSession{
+				BaseSession: sql.NewBaseSessionWithClientServer("", sql.Client{User: "root", Address: "localhost"}, 1),
+			}
+			query := func(q string) error {
+				ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+				ctx.SetCurrentDatabase("db")
+				sch, iter, err := e.Query(ctx, q)
+				if err != nil {
+					return err
+				}
+				_, err = sql.RowIterToRows(ctx, sch, iter)
+				return err
+			}
+
+			require.NoError(t, query("create table t (i int primary key)"))
+			for _, q := range tt.setup {
+				require.NoError(t, query(q), "error running query %s", q)
+			}
+			require.NoError(t, query("start transaction"))
+			require.NoError(t, query("insert into t values (1)"))
+
+			commits := sess.commits
+			err := query(tt.query)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.commit, sess.commits > commits, "commits before: %d, after: %d", commits, sess.commits)
+		})
+	}
+}
+
+// TestRowEvents tests that the row changes of committed transactions are delivered to the consumers subscribed to the
+// engine, without the changes of rolled back transactions, failed statements and savepoints rolled back to.
+func TestRowEvents(t *testing.T) {
+	db := memory.NewDatabase("db")
+	db.EnablePrimaryKeyIndexes()
+	sch := sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", PrimaryKey: true},
+		{Name: "j", Type: types.Int64, Source: "t", Nullable: true},
+	}
+	tbl := memory.NewTable("t", sql.NewPrimaryKeySchema(sch), db.GetForeignKeyCollection())
+	db.AddTable("t", tbl)
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), new(sqle.Config))
+	defer e.Close()
+
+	var txs []sql.RowEventTransaction
+	unsubscribe := e.SubscribeRowEvents(sql.RowEventConsumerFunc(func(tx sql.RowEventTransaction) {
+		txs = append(txs, tx)
+	}))
+
+	sess := &transactionSession{BaseSession: sql.NewBaseSession()}
+	query := func(sess sql.Session, q string) error {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase("db")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		return err
+	}
+	events := func() [][]sql.RowEvent {
+		var events [][]sql.RowEvent
+		for _, tx := range txs {
+			events = append(events, tx.Events)
+		}
+		txs = nil
+		return events
+	}
+	insert := func(i, j int64) sql.RowEvent {
+		return sql.RowEvent{Type: sql.RowEventType_Insert, Database: "db", Table: "t", Schema: sch, After: sql.Row{i, j}}
+	}
+
+	require.NoError(t, query(sess, "insert into t values (1, 1), (2, 2)"))
+	require.Equal(t, [][]sql.RowEvent{{insert(1, 1), insert(2, 2)}}, events())
+
+	for _, q := range []string{
+		"start transaction",
+		"update t set j = 10 where i = 1",
+		"savepoint s1",
+		"delete from t where i = 2",
+		"rollback to savepoint s1",
+		"insert into t values (3, 3)",
+	} {
+		require.NoError(t, query(sess, q))
+	}
+	require.Empty(t, events())
+	require.NoError(t, query(sess, "commit"))
+	require.Equal(t, [][]sql.RowEvent{{
+		{Type: sql.RowEventType_Update, Database: "db", Table: "t", Schema: sch, Before: sql.Row{int64(1), int64(1)}, After: sql.Row{int64(1), int64(10)}},
+		insert(3, 3),
+	}}, events())
+
+	require.NoError(t, query(sess, "start transaction"))
+	require.NoError(t, query(sess, "insert into t values (4, 4)"))
+	require.NoError(t, query(sess, "rollback"))
+	require.Empty(t, events())
+
+	require.Error(t, query(sess, "insert into t values (5, 5), (1, 1)"))
+	require.Empty(t, events())
+	require.NoError(t, query(sess, "insert ignore into t values (6, 6), (1, 1)"))
+	require.Equal(t, [][]sql.RowEvent{{insert(6, 6)}}, events())
+
+	// without transactions, the changes of each statement are delivered once it's done
+	require.NoError(t, query(sql.NewBaseSession(), "delete from t where i = 6"))
+	require.Equal(t, [][]sql.RowEvent{{
+		{Type: sql.RowEventType_Delete, Database: "db", Table: "t", Schema: sch, Before: sql.Row{int64(6), int64(6)}},
+	}}, events())
+
+	// the changes of foreign key referential actions come before the change that caused them
+	base := sql.NewBaseSession()
+	require.NoError(t, query(base, "create table p (i bigint primary key)"))
+	require.NoError(t, query(base, "create table c (k bigint primary key, i bigint, foreign key (i) references p (i) on delete cascade)"))
+	require.NoError(t, query(base, "insert into p values (3)"))
+	require.NoError(t, query(base, "insert into c values (1, 3)"))
+	events()
+	require.NoError(t, query(base, "delete from p where i = 3"))
+	txEvents := events()
+	require.Len(t, txEvents, 1)
+	require.Len(t, txEvents[0], 2)
+	require.Equal(t, sql.RowEventType_Delete, txEvents[0][0].Type)
+	require.Equal(t, "c", txEvents[0][0].Table)
+	require.Equal(t, sql.Row{int64(1), int64(3)}, txEvents[0][0].Before)
+	require.Equal(t, sql.RowEventType_Delete, txEvents[0][1].Type)
+	require.Equal(t, "p", txEvents[0][1].Table)
+	require.Equal(t, sql.Row{int64(3)}, txEvents[0][1].Before)
+
+	// the updates of a join are published with the table of each row
+	require.NoError(t, query(base, "insert into p values (4)"))
+	require.NoError(t, query(base, "insert into c values (1, 4)"))
+	events()
+	require.NoError(t, query(base, "update p join c as cc on p.i = cc.i set cc.k = 2"))
+	txEvents = events()
+	require.Len(t, txEvents, 1)
+	require.Len(t, txEvents[0], 1)
+	require.Equal(t, sql.RowEventType_Update, txEvents[0][0].Type)
+	require.Equal(t, "db", txEvents[0][0].Database)
+	require.Equal(t, "c", txEvents[0][0].Table)
+	require.Equal(t, sql.Row{int64(1), int64(4)}, txEvents[0][0].Before)
+	require.Equal(t, sql.Row{int64(2), int64(4)}, txEvents[0][0].After)
+
+	// deleting every row publishes each deleted row, rather than running as a truncate
+	require.NoError(t, query(sess, "delete from t"))
+	require.Equal(t, [][]sql.RowEvent{{
+		{Type: sql.RowEventType_Delete, Database: "db", Table: "t", Schema: sch, Before: sql.Row{int64(1), int64(10)}},
+		{Type: sql.RowEventType_Delete, Database: "db", Table: "t", Schema: sch, Before: sql.Row{int64(3), int64(3)}},
+		{Type: sql.RowEventType_Delete, Database: "db", Table: "t", Schema: sch, Before: sql.Row{int64(4), int64(4)}},
+	}}, events())
+	require.NoError(t, query(sess, "insert into t values (8, 8), (9, 9)"))
+	events()
+	require.NoError(t, query(sess, "truncate table t"))
+	require.Equal(t, [][]sql.RowEvent{{
+		{Type: sql.RowEventType_Truncate, Database: "db", Table: "t", Schema: sch, Rows: 2},
+	}}, events())
+
+	unsubscribe()
+	require.NoError(t, query(sess, "insert into t values (7, 7)"))
+	require.Empty(t, events())
+}
+
+// TestRowChangeHooks tests that the hooks registered with the engine are called with the rows that each statement and
+// transaction changed, with the statements' hooks called before the transaction commits.
+func TestRowChangeHooks(t *testing.T) {
+	db := memory.NewDatabase("db")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", PrimaryKey: true},
+	}), db.GetForeignKeyCollection()))
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), new(sqle.Config))
+	defer e.Close()
+
+	sess := &transactionSession{BaseSession: sql.NewBaseSession()}
+	var calls []string
+	e.OnStatementComplete(func(ctx *sql.Context, changes []sql.TableRowChanges) {
+		calls = append(calls, fmt.Sprintf("statement %v after %d commits", changes, sess.commits))
+	})
+	e.OnTransactionCommit(func(ctx *sql.Context, changes []sql.TableRowChanges) {
+		calls = append(calls, fmt.Sprintf("commit %v after %d commits", changes, sess.commits))
+	})
+	query := func(q string) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase("db")
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+	}
+
+	query("insert into t values (1), (2)")
+	query("select * from t")
+	require.Equal(t, []string{
+		"statement [{db t 2 0 0}] after 0 commits",
+		"commit [{db t 2 0 0}] after 1 commits",
+	}, calls)
+
+	calls = nil
+	query("start transaction")
+	query("update t set i = 3 where i = 1")
+	query("delete from t where i = 2")
+	query("commit")
+	require.Equal(t, []string{
+		"statement [{db t 0 1 0}] after 3 commits",
+		"statement [{db t 0 0 1}] after 3 commits",
+		"commit [{db t 0 1 1}] after 4 commits",
+	}, calls)
+
+	// deleting every row and truncating count the rows removed
+	query("insert into t values (4), (5)")
+	calls = nil
+	query("delete from t")
+	require.Equal(t, []string{
+		"statement [{db t 0 0 3}] after 5 commits",
+		"commit [{db t 0 0 3}] after 6 commits",
+	}, calls)
+
+	query("insert into t values (6), (7), (8)")
+	calls = nil
+	query("truncate table t")
+	require.Equal(t, []string{
+		"statement [{db t 0 0 3}] after 7 commits",
+		"commit [{db t 0 0 3}] after 8 commits",
+	}, calls)
+}
+
+// TestSessionStateChanges tests that changes of the current database and of system and user variables are tracked as
+// the session_track_* system variables control.
+func TestSessionStateChanges(t *testing.T) {
+	db := memory.NewDatabase("db")
+	db2 := memory.NewDatabase("db2")
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db, db2)), new(sqle.Config))
+	defer e.Close()
+
+	sess := sql.NewBaseSession()
+	sess.SetCurrentDatabase("db")
+	query := func(q string) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+	}
+	require.Equal(t, []sql.SessionStateChange{{Type: mysql.SessionTrackSchema, Value: "db"}}, sess.SessionStateChanges())
+
+	query("set autocommit = 0")
+	require.Equal(t, []sql.SessionStateChange{
+		{Type: mysql.SessionTrackSystemVariables, Name: "autocommit", Value: "0"},
+	}, sess.SessionStateChanges())
+
+	query("set sql_mode = ''")
+	require.Empty(t, sess.SessionStateChanges())
+
+	query("set session_track_system_variables = '*', session_track_state_change = on")
+	query("use db2")
+	query("set sql_mode = 'ANSI_QUOTES'")
+	require.Equal(t, []sql.SessionStateChange{
+		{Type: mysql.SessionTrackSystemVariables, Name: "session_track_system_variables", Value: "*"},
+		{Type: mysql.SessionTrackSystemVariables, Name: "session_track_state_change", Value: "1"},
+		{Type: mysql.SessionTrackSystemVariables, Name: "sql_mode", Value: "ANSI_QUOTES"},
+		{Type: mysql.SessionTrackSchema, Value: "db2"},
+		{Type: mysql.SessionTrackStateChange, Value: "1"},
+	}, sess.SessionStateChanges())
+
+	query("set @x = 1")
+	require.Equal(t, []sql.SessionStateChange{{Type: mysql.SessionTrackStateChange, Value: "1"}}, sess.SessionStateChanges())
+
+	query("select 1")
+	require.Empty(t, sess.SessionStateChanges())
+}
+
+type transactionSession struct {
+	*sql.BaseSession
+	commits   int
+	rollbacks int
+	// conflicts is the number of commits left to fail with sql.ErrSerializationFailure
+	conflicts int
+}
+
+var _ sql.TransactionSession = (*transactionSession)(nil)
+
+type testTransaction struct{}
+
+func (testTransaction) String() string   { return "testTransaction" }
+func (testTransaction) IsReadOnly() bool { return false }
+
+func (s *transactionSession) StartTransaction(ctx *sql.Context, tCharacteristic sql.TransactionCharacteristic) (sql.Transaction, error) {
+	return testTransaction{}, nil
+}
+
+func (s *transactionSession) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error {
+	s.commits++
+	if s.conflicts > 0 {
+		s.conflicts--
+		return sql.ErrSerializationFailure.New("concurrent write to table t")
+	}
+	return nil
+}
+
+func (s *transactionSession) Rollback(ctx *sql.Context, transaction sql.Transaction) error {
+	s.rollbacks++
+	return nil
+}
+
+func (s *transactionSession) CreateSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
+	return nil
+}
+
+func (s *transactionSession) RollbackToSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
+	return nil
+}
+
+func (s *transactionSession) ReleaseSavepoint(ctx *sql.Context, transaction sql.Transaction, name string) error {
+	return nil
+}
+
+type transactionalDDLSession struct {
+	*transactionSession
+}
+
+var _ sql.TransactionalDDLSession = (*transactionalDDLSession)(nil)
+
+func (s *transactionalDDLSession) SupportsTransactionalDDL(ctx *sql.Context) bool {
+	return true
+}
+
 type analyzerTestCase struct {
 	name          string
 	query         string
@@ -771,191 +1331,663 @@ func TestTableFunctions(t *testing.T) {
 	}
 }
 
-func TestExternalProcedures(t *testing.T) {
-	harness := enginetest.NewDefaultMemoryHarness()
-	harness.Setup(setup.MydbData)
-	for _, script := range queries.ExternalProcedureTests {
-		func() {
-			e, err := harness.NewEngine(t)
-			require.NoError(t, err)
-			defer func() {
-				_ = e.Close()
-			}()
-			enginetest.TestScriptWithEngine(t, e, harness, script)
-		}()
-	}
-}
-
-func TestCallAsOf(t *testing.T) {
-	harness := enginetest.NewDefaultMemoryHarness()
-	enginetest.CreateVersionedTestData(t, harness)
+func TestLateralTableFunctions(t *testing.T) {
 	var scripts = []queries.ScriptTest{
 		{
-			Name: "AS OF propagates to nested CALLs",
+			Name: "table function arguments referencing the tables before it",
 			SetUpScript: []string{
-				"CREATE PROCEDURE p1() BEGIN CALL p2(); END",
-				"CREATE PROCEDURE p1a() BEGIN CALL p2() AS OF '2019-01-01'; END",
-				"CREATE PROCEDURE p1b() BEGIN CALL p2a(); END",
-				"CREATE PROCEDURE p2() BEGIN SELECT * FROM myhistorytable; END",
-				"CREATE PROCEDURE p2a() BEGIN SELECT * FROM myhistorytable AS OF '2019-01-02'; END",
+				"create table lens (id int primary key, n int)",
+				"insert into lens values (1, 0), (2, 1), (3, 3), (4, null)",
 			},
 			Assertions: []queries.ScriptTestAssertion{
 				{
-					Query: "CALL p1();",
-					Expected: []sql.Row{
-						{int64(1), "first row, 3", "1"},
-						{int64(2), "second row, 3", "2"},
-						{int64(3), "third row, 3", "3"},
-					},
-				},
-				{
-					Query: "CALL p1a();",
-					Expected: []sql.Row{
-						{int64(1), "first row, 1"},
-						{int64(2), "second row, 1"},
-						{int64(3), "third row, 1"},
-					},
-				},
-				{
-					Query: "CALL p1b();",
-					Expected: []sql.Row{
-						{int64(1), "first row, 2"},
-						{int64(2), "second row, 2"},
-						{int64(3), "third row, 2"},
-					},
+					Query:    "select id, x from lens, sequence_table('x', lens.n) seq order by id, x",
+					Expected: []sql.Row{{2, 0}, {3, 0}, {3, 1}, {3, 2}},
 				},
 				{
-					Query: "CALL p2();",
-					Expected: []sql.Row{
-						{int64(1), "first row, 3", "1"},
-						{int64(2), "second row, 3", "2"},
-						{int64(3), "third row, 3", "3"},
-					},
+					Query:    "select id, seq.x from lens join sequence_table('x', n + 1) seq on seq.x > 0 order by id, x",
+					Expected: []sql.Row{{2, 1}, {3, 1}, {3, 2}, {3, 3}},
 				},
 				{
-					Query: "CALL p2a();",
-					Expected: []sql.Row{
-						{int64(1), "first row, 2"},
-						{int64(2), "second row, 2"},
-						{int64(3), "third row, 2"},
-					},
+					Query:    "select id, x from lens left join sequence_table('x', n) seq on x < 10 order by id, x",
+					Expected: []sql.Row{{1, nil}, {2, 0}, {3, 0}, {3, 1}, {3, 2}, {4, nil}},
 				},
 				{
-					Query: "CALL p1() AS OF '2019-01-01';",
-					Expected: []sql.Row{
-						{int64(1), "first row, 1"},
-						{int64(2), "second row, 1"},
-						{int64(3), "third row, 1"},
-					},
+					Query:    "select id, (select count(*) from sequence_table('x', lens.n)) from lens order by id",
+					Expected: []sql.Row{{1, 0}, {2, 1}, {3, 3}, {4, 0}},
 				},
 				{
-					Query: "CALL p1a() AS OF '2019-01-03';",
-					Expected: []sql.Row{
-						{int64(1), "first row, 1"},
-						{int64(2), "second row, 1"},
-						{int64(3), "third row, 1"},
-					},
+					Query:       "select * from lens, simple_table_function(lens.n)",
+					ExpectedErr: sql.ErrTableFunctionNotLateral,
 				},
+			},
+		},
+		{
+			Name: "table function arguments with bind variables",
+			Assertions: []queries.ScriptTestAssertion{
 				{
-					Query: "CALL p1b() AS OF '2019-01-03';",
-					Expected: []sql.Row{
-						{int64(1), "first row, 2"},
-						{int64(2), "second row, 2"},
-						{int64(3), "third row, 2"},
-					},
+					Query:    "prepare s from 'select x from sequence_table(''x'', ?) seq order by x'",
+					Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
 				},
 				{
-					Query: "CALL p2() AS OF '2019-01-01';",
-					Expected: []sql.Row{
-						{int64(1), "first row, 1"},
-						{int64(2), "second row, 1"},
-						{int64(3), "third row, 1"},
-					},
+					Query:    "set @n = 3",
+					Expected: []sql.Row{{}},
 				},
 				{
-					Query: "CALL p2a() AS OF '2019-01-03';",
-					Expected: []sql.Row{
-						{int64(1), "first row, 2"},
-						{int64(2), "second row, 2"},
-						{int64(3), "third row, 2"},
-					},
+					Query:    "execute s using @n",
+					Expected: []sql.Row{{0}, {1}, {2}},
 				},
 			},
 		},
 	}
 
+	harness := enginetest.NewMemoryHarness("", 1, testNumPartitions, true, nil).WithVersion(sql.VersionExperimental)
+	harness.Setup(setup.MydbData)
 	for _, script := range scripts {
 		func() {
 			e, err := harness.NewEngine(t)
 			require.NoError(t, err)
-			defer func() {
-				_ = e.Close()
-			}()
+			defer e.Close()
+			ctx := harness.NewContext()
+			e.Analyzer.Catalog.RegisterTableFunction(ctx, SimpleTableFunction{}, memory.IntSequenceTable{})
 			enginetest.TestScriptWithEngine(t, e, harness, script)
 		}()
 	}
 }
 
-func TestCollationCoercion(t *testing.T) {
-	harness := enginetest.NewDefaultMemoryHarness()
+func TestDeferredTableFunctions(t *testing.T) {
+	harness := enginetest.NewMemoryHarness("", 1, testNumPartitions, true, nil).WithVersion(sql.VersionExperimental)
 	harness.Setup(setup.MydbData)
-	engine, err := harness.NewEngine(t)
+	e, err := harness.NewEngine(t)
 	require.NoError(t, err)
-	defer engine.Close()
+	defer e.Close()
+	e.Analyzer.Catalog.RegisterTableFunction(harness.NewContext(), RepeatTableFunction{})
 
+	query := "select value from repeat_table(?, ? + 1) rt"
 	ctx := harness.NewContext()
-	ctx.SetCurrentDatabase("mydb")
+	parsed, err := planbuilder.Parse(ctx, e.Analyzer.Catalog, query)
+	require.NoError(t, err)
+	var deferred bool
+	transform.Inspect(parsed, func(n sql.Node) bool {
+		_, ok := n.(*plan.DeferredTableFunction)
+		deferred = deferred || ok
+		return true
+	})
+	require.True(t, deferred)
 
-	for _, statement := range queries.CollationCoercionSetup {
-		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	for _, test := range []struct {
+		value    sql.Expression
+		n        sql.Expression
+		expected []sql.Row
+	}{
+		{
+			value:    expression.NewLiteral("a", types.LongText),
+			n:        expression.NewLiteral(int8(2), types.Int8),
+			expected: []sql.Row{{"a"}, {"a"}, {"a"}},
+		},
+		{
+			value:    expression.NewLiteral(int64(12), types.Int64),
+			n:        expression.NewLiteral(int8(0), types.Int8),
+			expected: []sql.Row{{"12"}},
+		},
+	} {
+		ctx := harness.NewContext()
+		sch, iter, err := e.QueryWithBindings(ctx, query, map[string]sql.Expression{"v1": test.value, "v2": test.n})
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, rows)
 	}
 
-	for _, test := range queries.CollationCoercionTests {
-		coercibilityQuery := fmt.Sprintf(`SELECT COERCIBILITY(%s) FROM temp_tbl LIMIT 1;`, test.Parameters)
-		collationQuery := fmt.Sprintf(`SELECT COLLATION(%s) FROM temp_tbl LIMIT 1;`, test.Parameters)
-		for i, query := range []string{coercibilityQuery, collationQuery} {
-			t.Run(query, func(t *testing.T) {
-				sch, iter, err := engine.Query(ctx, query)
-				if test.Error {
-					if err == nil {
-						_, err := sql.RowIterToRows(ctx, sch, iter)
-						require.Error(t, err)
-					} else {
-						require.Error(t, err)
-					}
-				} else {
-					require.NoError(t, err)
-					rows, err := sql.RowIterToRows(ctx, sch, iter)
-					require.NoError(t, err)
-					require.Equal(t, 1, len(rows))
-					require.Equal(t, 1, len(rows[0]))
-					if i == 0 {
-						num, _, err := types.Int64.Convert(rows[0][0])
-						require.NoError(t, err)
-						require.Equal(t, test.Coercibility, num.(int64))
-					} else {
-						str, _, err := types.LongText.Convert(rows[0][0])
-						require.NoError(t, err)
-						require.Equal(t, test.Collation.Name(), str.(string))
-					}
-				}
-			})
-		}
-	}
+	_, err = planbuilder.Parse(harness.NewContext(), e.Analyzer.Catalog, "select * from (select 'a' s) sq, repeat_table(sq.s, ?) rt")
+	require.True(t, sql.ErrTableFunctionNotLateral.Is(err))
 }
 
-func TestRegex(t *testing.T) {
+func TestQueryCache(t *testing.T) {
 	harness := enginetest.NewDefaultMemoryHarness()
-	harness.Setup(setup.SimpleSetup...)
-	engine, err := harness.NewEngine(t)
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
 	require.NoError(t, err)
-	defer engine.Close()
+	defer e.Close()
+	e.QueryCache = sqle.NewQueryCache(2, 3)
 
 	ctx := enginetest.NewContext(harness)
-	for _, tt := range queries.RegexTests {
-		t.Run(tt.Query, func(t *testing.T) {
-			if harness.SkipQueryTest(tt.Query) {
-				t.Skipf("Skipping query plan for %s", tt.Query)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	hits := func() int64 {
+		_, val, ok := sql.StatusVariables.GetGlobal("Qcache_hits")
+		require.True(t, ok)
+		return val.(int64)
+	}
+
+	query("create table t (i int primary key, s varchar(10))")
+	query("insert into t values (1, 'a'), (2, 'b')")
+
+	// the cache is off by default
+	start := hits()
+	require.Equal(t, []sql.Row{{int64(2)}}, query("select count(*) from t"))
+	require.Equal(t, 0, e.QueryCache.Len())
+
+	query("set query_cache_type = 'ON'")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}}, query("select * from t order by i"))
+	require.Equal(t, 1, e.QueryCache.Len())
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}}, query("select * from t order by i"))
+	require.Equal(t, start+1, hits())
+
+	// changes to the table invalidate its cached results
+	query("insert into t values (3, 'c')")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}, {int32(3), "c"}}, query("select * from t order by i"))
+	require.Equal(t, start+1, hits())
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}, {int32(3), "c"}}, query("select * from t order by i"))
+	require.Equal(t, start+2, hits())
+	query("update t set s = 'z' where i = 3")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}, {int32(3), "z"}}, query("select * from t order by i"))
+	require.Equal(t, start+2, hits())
+
+	// result sets larger than the row limit and non-deterministic queries aren't cached
+	query("insert into t values (4, 'd')")
+	e.QueryCache.Clear()
+	query("select * from t")
+	query("select i, rand() from t where i = 1")
+	query("select now() from t where i = 1")
+	require.Equal(t, 0, e.QueryCache.Len())
+
+	// SQL_NO_CACHE and DEMAND
+	query("select sql_no_cache s from t where i = 1")
+	require.Equal(t, 0, e.QueryCache.Len())
+	query("set query_cache_type = 'DEMAND'")
+	query("select s from t where i = 2")
+	require.Equal(t, 0, e.QueryCache.Len())
+	query("select sql_cache s from t where i = 2")
+	require.Equal(t, 1, e.QueryCache.Len())
+	require.Equal(t, []sql.Row{{"b"}}, query("select sql_cache s from t where i = 2"))
+	require.Equal(t, start+3, hits())
+
+	// the least recently used results are evicted first
+	query("select sql_cache s from t where i = 1")
+	query("select sql_cache s from t where i = 3")
+	require.Equal(t, 2, e.QueryCache.Len())
+	query("select sql_cache s from t where i = 2")
+	require.Equal(t, start+3, hits())
+}
+
+func TestIndexLookupCache(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+	e.IndexLookupCache = sql.NewIndexLookupCache(10, 10)
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	query("create table t (i int primary key, s varchar(10))")
+	query("create table u (j int)")
+	query("insert into t values (1, 'a'), (2, 'b')")
+	query("insert into u values (1), (2), (1), (1)")
+	require.Equal(t, 0, e.IndexLookupCache.Len())
+
+	readKeys := func() int64 {
+		_, val, ok := sql.StatusVariables.GetGlobal("Handler_read_key")
+		require.True(t, ok)
+		return val.(int64)
+	}
+
+	// the repeated lookups of a lookup join are only read once per query
+	join := "select /*+ LOOKUP_JOIN(u,t) */ u.j, t.s from u join t on u.j = t.i order by u.j, t.s"
+	start := readKeys()
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(1), "a"}, {int32(1), "a"}, {int32(2), "b"}}, query(join))
+	require.Equal(t, start+2, readKeys())
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(1), "a"}, {int32(1), "a"}, {int32(2), "b"}}, query(join))
+	require.Equal(t, start+4, readKeys())
+	require.Equal(t, 0, e.IndexLookupCache.Len())
+
+	// static lookups are kept across queries
+	require.Equal(t, []sql.Row{{"a"}}, query("select s from t where i = 1"))
+	require.Equal(t, 1, e.IndexLookupCache.Len())
+	start = readKeys()
+	require.Equal(t, []sql.Row{{"a"}}, query("select s from t where i = 1"))
+	require.Equal(t, start, readKeys())
+
+	// changes to the table invalidate its cached lookups
+	query("update t set s = 'z' where i = 1")
+	require.Equal(t, []sql.Row{{"z"}}, query("select s from t where i = 1"))
+	require.Equal(t, []sql.Row{{int32(1), "z"}, {int32(1), "z"}, {int32(1), "z"}, {int32(2), "b"}}, query(join))
+	query("delete from t where i = 2")
+	require.Equal(t, []sql.Row{{int32(1), "z"}, {int32(1), "z"}, {int32(1), "z"}}, query(join))
+
+	// the lookups of statements that write aren't cached
+	e.IndexLookupCache.Clear()
+	query("insert into u select t.i from u join t on u.j = t.i")
+	require.Equal(t, 0, e.IndexLookupCache.Len())
+}
+
+func TestLookupJoinBatching(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	readKeys := func() int64 {
+		_, val, ok := sql.StatusVariables.GetGlobal("Handler_read_key")
+		require.True(t, ok)
+		return val.(int64)
+	}
+
+	query("create table t (i int primary key, s varchar(10))")
+	query("create table u (n int primary key, j int)")
+	query("insert into t values (1, 'a'), (2, 'b'), (3, 'c')")
+	query("insert into u with recursive r (n) as (select 1 union all select n + 1 from r where n < 300) select n, n % 5 from r")
+
+	// the lookups of each batch of outer rows are made once per distinct key
+	start := readKeys()
+	rows := query("select /*+ LOOKUP_JOIN(u,t) */ u.n, u.j, t.s from u left join t on u.j = t.i order by u.n")
+	require.Equal(t, start+15, readKeys())
+	require.Len(t, rows, 300)
+	for _, row := range rows {
+		switch row[1] {
+		case int32(1), int32(2), int32(3):
+			require.Equal(t, string(rune('a'+row[1].(int32)-1)), row[2])
+		default:
+			require.Nil(t, row[2])
+		}
+	}
+
+	require.Equal(t, []sql.Row{{int64(180)}}, query("select /*+ LOOKUP_JOIN(u,t) */ count(*) from u join t on u.j = t.i"))
+}
+
+func TestHashJoinBloomFilter(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	readRows := func() int64 {
+		_, val, ok := sql.StatusVariables.GetGlobal("Handler_read_rnd_next")
+		require.True(t, ok)
+		return val.(int64)
+	}
+
+	query("create table d (id int primary key, name varchar(10))")
+	query("create table f (n int primary key, d_id int)")
+	query("insert into d values (1, 'one'), (2, 'two')")
+	query("insert into f with recursive r (n) as (select 1 union all select n + 1 from r where n < 1000) select n, n % 100 from r")
+
+	// the rows of the probe side whose keys aren't in the build side are mostly skipped by its table scan
+	start := readRows()
+	require.Equal(t, []sql.Row{{float64(10), float64(10)}}, query(
+		"select /*+ JOIN_ORDER(f,d) HASH_JOIN(f,d) */ sum(d.id = 1), sum(d.id = 2) from f join d on f.d_id = d.id"))
+	require.Less(t, readRows()-start, int64(100))
+
+	// rows of the probe side of outer joins aren't skipped
+	require.Equal(t, []sql.Row{{int64(1000), int64(20)}}, query(
+		"select /*+ JOIN_ORDER(f,d) HASH_JOIN(f,d) */ count(*), count(d.id) from f left join d on f.d_id = d.id"))
+}
+
+func TestAdaptiveJoin(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	explain := func(q string) string {
+		var sb strings.Builder
+		for _, row := range query(q) {
+			sb.WriteString(row[0].(string))
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
+	query("create table t (i int primary key, s varchar(10))")
+	query("create table u (n int primary key, j int)")
+	query("insert into t values (1, 'a'), (2, 'b')")
+	query("insert into u with recursive r (n) as (select 1 union all select n + 1 from r where n < 30) select n, n % 5 from r")
+
+	// disabled by default
+	require.NotContains(t, explain("explain select u.n, t.s from u join t on u.j = t.i"), "AdaptiveJoin")
+
+	query("set adaptive_join_threshold = 10")
+	require.Contains(t, explain("explain select u.n, t.s from u join t on u.j = t.i"), "AdaptiveJoin(threshold: 10)")
+
+	// the lookup join is chosen when the outer side has few rows, and the hash join otherwise
+	require.Contains(t, explain("explain analyze select u.n, t.s from u join t on u.j = t.i where u.n < 5"),
+		"chosen: LookupJoin (left rows: 4)")
+	require.Contains(t, explain("explain analyze select u.n, t.s from u join t on u.j = t.i"),
+		"chosen: HashJoin (left rows: > 10)")
+
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}},
+		query("select u.n, t.s from u join t on u.j = t.i where u.n < 5 order by 1"))
+	require.Equal(t, []sql.Row{{int64(12)}}, query("select count(*) from u join t on u.j = t.i"))
+	require.Equal(t, []sql.Row{{int64(30), int64(12)}},
+		query("select count(*), count(t.i) from u left join t on u.j = t.i"))
+}
+
+func TestInListSemiJoin(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	explain := func(q string) string {
+		var sb strings.Builder
+		for _, row := range query(q) {
+			sb.WriteString(row[0].(string))
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
+	query("create table t (i int primary key, j tinyint, key (j))")
+	query("insert into t with recursive r (n) as (select 1 union all select n + 1 from r where n < 100) select n, n % 10 from r")
+
+	// short lists are index lookups of a range per value
+	plan := explain("explain select i from t where i in (1, 3, 5)")
+	require.Contains(t, plan, "filters: [{[1, 1]}, {[3, 3]}, {[5, 5]}]")
+	require.NotContains(t, plan, "SemiHashJoin")
+
+	query("set in_list_semi_join_threshold = 3")
+	plan = explain("explain select i from t where i in (1, 3, 5, 7) and j > 2")
+	require.Contains(t, plan, "SemiHashJoin")
+	require.Contains(t, plan, "IndexedTableAccess(t)")
+	require.Contains(t, plan, "HashLookup")
+
+	require.Equal(t, []sql.Row{{int32(3)}, {int32(5)}, {int32(7)}},
+		query("select i from t where i in (1, 3, 5, 7, null, 1000000000000) and j > 2 order by 1"))
+	require.Equal(t, []sql.Row{{int64(20)}}, query("select count(*) from t where j in (1, 3, 300, 2.5, -1)"))
+	require.Equal(t, []sql.Row{{int32(10)}}, query("select i from t where i in (10, 10.5, 10.0, 100.25) and i < 100"))
+	require.Equal(t, []sql.Row{{int64(0)}}, query("select count(*) from t where j in (300, 400, 500, null)"))
+
+	query("set in_list_semi_join_threshold = 0")
+	require.NotContains(t, explain("explain select i from t where i in (1, 3, 5, 7)"), "SemiHashJoin")
+}
+
+func TestPreparedStatementDatabase(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+	e.QueryCache = sqle.NewQueryCache(10, 10)
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	query("create database db2")
+	query("create table mydb.t (i int primary key)")
+	query("create table db2.t (i int primary key)")
+	query("insert into mydb.t values (1)")
+	query("insert into db2.t values (2)")
+	query("set query_cache_type = 'ON'")
+
+	// statements prepared by the server protocol keep the database they were prepared in
+	_, err = e.PrepareQuery(ctx, "select * from t")
+	require.NoError(t, err)
+	db, ok := e.PreparedDataCache.GetCachedStmtDatabase(ctx.Session.ID(), "select * from t")
+	require.True(t, ok)
+	require.Equal(t, "mydb", db)
+	query("use db2")
+	require.Equal(t, []sql.Row{{int32(1)}}, query("select * from t"))
+	require.Equal(t, "db2", ctx.GetCurrentDatabase())
+
+	// once deallocated, the statement is run in the current database, without the results cached for the other one
+	e.PreparedDataCache.UncacheStmt(ctx.Session.ID(), "select * from t")
+	require.Equal(t, []sql.Row{{int32(2)}}, query("select * from t"))
+
+	// and so do the statements of PREPARE
+	query("use mydb")
+	query("prepare s from 'select i, database() from t'")
+	query("use db2")
+	require.Equal(t, []sql.Row{{int32(1), "db2"}}, query("execute s"))
+	require.Equal(t, "db2", ctx.GetCurrentDatabase())
+}
+
+func TestExternalProcedures(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.ExternalProcedureTests {
+		func() {
+			e, err := harness.NewEngine(t)
+			require.NoError(t, err)
+			defer func() {
+				_ = e.Close()
+			}()
+			enginetest.TestScriptWithEngine(t, e, harness, script)
+		}()
+	}
+}
+
+func TestCallAsOf(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	enginetest.CreateVersionedTestData(t, harness)
+	var scripts = []queries.ScriptTest{
+		{
+			Name: "AS OF propagates to nested CALLs",
+			SetUpScript: []string{
+				"CREATE PROCEDURE p1() BEGIN CALL p2(); END",
+				"CREATE PROCEDURE p1a() BEGIN CALL p2() AS OF '2019-01-01'; END",
+				"CREATE PROCEDURE p1b() BEGIN CALL p2a(); END",
+				"CREATE PROCEDURE p2() BEGIN SELECT * FROM myhistorytable; END",
+				"CREATE PROCEDURE p2a() BEGIN SELECT * FROM myhistorytable AS OF '2019-01-02'; END",
+			},
+			Assertions: []queries.ScriptTestAssertion{
+				{
+					Query: "CALL p1();",
+					Expected: []sql.Row{
+						{int64(1), "first row, 3", "1"},
+						{int64(2), "second row, 3", "2"},
+						{int64(3), "third row, 3", "3"},
+					},
+				},
+				{
+					Query: "CALL p1a();",
+					Expected: []sql.Row{
+						{int64(1), "first row, 1"},
+						{int64(2), "second row, 1"},
+						{int64(3), "third row, 1"},
+					},
+				},
+				{
+					Query: "CALL p1b();",
+					Expected: []sql.Row{
+						{int64(1), "first row, 2"},
+						{int64(2), "second row, 2"},
+						{int64(3), "third row, 2"},
+					},
+				},
+				{
+					Query: "CALL p2();",
+					Expected: []sql.Row{
+						{int64(1), "first row, 3", "1"},
+						{int64(2), "second row, 3", "2"},
+						{int64(3), "third row, 3", "3"},
+					},
+				},
+				{
+					Query: "CALL p2a();",
+					Expected: []sql.Row{
+						{int64(1), "first row, 2"},
+						{int64(2), "second row, 2"},
+						{int64(3), "third row, 2"},
+					},
+				},
+				{
+					Query: "CALL p1() AS OF '2019-01-01';",
+					Expected: []sql.Row{
+						{int64(1), "first row, 1"},
+						{int64(2), "second row, 1"},
+						{int64(3), "third row, 1"},
+					},
+				},
+				{
+					Query: "CALL p1a() AS OF '2019-01-03';",
+					Expected: []sql.Row{
+						{int64(1), "first row, 1"},
+						{int64(2), "second row, 1"},
+						{int64(3), "third row, 1"},
+					},
+				},
+				{
+					Query: "CALL p1b() AS OF '2019-01-03';",
+					Expected: []sql.Row{
+						{int64(1), "first row, 2"},
+						{int64(2), "second row, 2"},
+						{int64(3), "third row, 2"},
+					},
+				},
+				{
+					Query: "CALL p2() AS OF '2019-01-01';",
+					Expected: []sql.Row{
+						{int64(1), "first row, 1"},
+						{int64(2), "second row, 1"},
+						{int64(3), "third row, 1"},
+					},
+				},
+				{
+					Query: "CALL p2a() AS OF '2019-01-03';",
+					Expected: []sql.Row{
+						{int64(1), "first row, 2"},
+						{int64(2), "second row, 2"},
+						{int64(3), "third row, 2"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, script := range scripts {
+		func() {
+			e, err := harness.NewEngine(t)
+			require.NoError(t, err)
+			defer func() {
+				_ = e.Close()
+			}()
+			enginetest.TestScriptWithEngine(t, e, harness, script)
+		}()
+	}
+}
+
+func TestCollationCoercion(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer engine.Close()
+
+	ctx := harness.NewContext()
+	ctx.SetCurrentDatabase("mydb")
+
+	for _, statement := range queries.CollationCoercionSetup {
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	}
+
+	for _, test := range queries.CollationCoercionTests {
+		coercibilityQuery := fmt.Sprintf(`SELECT COERCIBILITY(%s) FROM temp_tbl LIMIT 1;`, test.Parameters)
+		collationQuery := fmt.Sprintf(`SELECT COLLATION(%s) FROM temp_tbl LIMIT 1;`, test.Parameters)
+		for i, query := range []string{coercibilityQuery, collationQuery} {
+			t.Run(query, func(t *testing.T) {
+				sch, iter, err := engine.Query(ctx, query)
+				if test.Error {
+					if err == nil {
+						_, err := sql.RowIterToRows(ctx, sch, iter)
+						require.Error(t, err)
+					} else {
+						require.Error(t, err)
+					}
+				} else {
+					require.NoError(t, err)
+					rows, err := sql.RowIterToRows(ctx, sch, iter)
+					require.NoError(t, err)
+					require.Equal(t, 1, len(rows))
+					require.Equal(t, 1, len(rows[0]))
+					if i == 0 {
+						num, _, err := types.Int64.Convert(rows[0][0])
+						require.NoError(t, err)
+						require.Equal(t, test.Coercibility, num.(int64))
+					} else {
+						str, _, err := types.LongText.Convert(rows[0][0])
+						require.NoError(t, err)
+						require.Equal(t, test.Collation.Name(), str.(string))
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestRegex(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.SimpleSetup...)
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer engine.Close()
+
+	ctx := enginetest.NewContext(harness)
+	for _, tt := range queries.RegexTests {
+		t.Run(tt.Query, func(t *testing.T) {
+			if harness.SkipQueryTest(tt.Query) {
+				t.Skipf("Skipping query plan for %s", tt.Query)
 			}
 			if tt.ExpectedErr == nil {
 				enginetest.TestQueryWithContext(t, ctx, engine, harness, tt.Query, tt.Expected, nil, nil)
@@ -969,212 +2001,1396 @@ func TestRegex(t *testing.T) {
 			}
 		})
 	}
-	// We force garbage collection twice as we have two levels of finalizers on our regex objects, and we want to make
-	// sure that neither of them panic.
-	runtime.GC()
-	runtime.GC()
+	// We force garbage collection twice as we have two levels of finalizers on our regex objects, and we want to make
+	// sure that neither of them panic.
+	runtime.GC()
+	runtime.GC()
+}
+
+var _ sql.TableFunction = (*SimpleTableFunction)(nil)
+var _ sql.CollationCoercible = (*SimpleTableFunction)(nil)
+var _ sql.ExecSourceRel = (*SimpleTableFunction)(nil)
+
+// SimpleTableFunction an extremely simple implementation of TableFunction for testing.
+// When evaluated, returns a single row: {"foo", 123}
+type SimpleTableFunction struct {
+	returnedResults bool
+}
+
+func (s SimpleTableFunction) NewInstance(_ *sql.Context, _ sql.Database, _ []sql.Expression) (sql.Node, error) {
+	return SimpleTableFunction{}, nil
+}
+
+func (s SimpleTableFunction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if s.returnedResults == true {
+		return nil, io.EOF
+	}
+	s.returnedResults = true
+	return &SimpleTableFunctionRowIter{}, nil
+}
+
+func (s SimpleTableFunction) Resolved() bool {
+	return true
+}
+
+func (s SimpleTableFunction) String() string {
+	return "SimpleTableFunction"
+}
+
+func (s SimpleTableFunction) Schema() sql.Schema {
+	schema := []*sql.Column{
+		&sql.Column{
+			Name: "one",
+			Type: types.TinyText,
+		},
+		&sql.Column{
+			Name: "two",
+			Type: types.Int64,
+		},
+	}
+
+	return schema
+}
+
+func (s SimpleTableFunction) Children() []sql.Node {
+	return []sql.Node{}
+}
+
+func (s SimpleTableFunction) WithChildren(_ ...sql.Node) (sql.Node, error) {
+	return s, nil
+}
+
+func (s SimpleTableFunction) CheckPrivileges(_ *sql.Context, _ sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (SimpleTableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (s SimpleTableFunction) Expressions() []sql.Expression {
+	return []sql.Expression{}
+}
+
+func (s SimpleTableFunction) WithExpressions(e ...sql.Expression) (sql.Node, error) {
+	return s, nil
+}
+
+func (s SimpleTableFunction) Database() sql.Database {
+	return nil
+}
+
+func (s SimpleTableFunction) WithDatabase(_ sql.Database) (sql.Node, error) {
+	return s, nil
+}
+
+func (s SimpleTableFunction) Name() string {
+	return "simple_table_function"
+}
+
+func (s SimpleTableFunction) Description() string {
+	return "SimpleTableFunction"
+}
+
+var _ sql.RowIter = (*SimpleTableFunctionRowIter)(nil)
+
+type SimpleTableFunctionRowIter struct {
+	returnedResults bool
+}
+
+func (itr *SimpleTableFunctionRowIter) Next(_ *sql.Context) (sql.Row, error) {
+	if itr.returnedResults {
+		return nil, io.EOF
+	}
+
+	itr.returnedResults = true
+	return sql.Row{"foo", 123}, nil
+}
+
+func (itr *SimpleTableFunctionRowIter) Close(_ *sql.Context) error {
+	return nil
+}
+
+var _ sql.TableFunction = (*RepeatTableFunction)(nil)
+var _ sql.ExecSourceRel = (*RepeatTableFunction)(nil)
+
+// RepeatTableFunction is a table function for testing that only accepts literal arguments.
+// repeat_table(value, n) returns n rows with the text value.
+type RepeatTableFunction struct {
+	value interface{}
+	n     int64
+}
+
+func (r RepeatTableFunction) NewInstance(_ *sql.Context, _ sql.Database, args []sql.Expression) (sql.Node, error) {
+	if len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(r.Name(), 2, len(args))
+	}
+	var values [2]interface{}
+	for i, arg := range args {
+		lit, ok := arg.(*expression.Literal)
+		if !ok {
+			return nil, fmt.Errorf("repeat_table expects literal arguments, got %s", arg)
+		}
+		values[i] = lit.Value()
+	}
+	n, _, err := types.Int64.Convert(values[1])
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		n = int64(0)
+	}
+	return RepeatTableFunction{value: values[0], n: n.(int64)}, nil
+}
+
+func (r RepeatTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	value, _, err := types.LongText.Convert(r.value)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]sql.Row, r.n)
+	for i := range rows {
+		rows[i] = sql.Row{value}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (r RepeatTableFunction) Resolved() bool {
+	return true
+}
+
+func (r RepeatTableFunction) String() string {
+	return fmt.Sprintf("repeat_table(%v, %d)", r.value, r.n)
+}
+
+func (r RepeatTableFunction) Schema() sql.Schema {
+	return sql.Schema{{Name: "value", Type: types.LongText, Nullable: true}}
+}
+
+func (r RepeatTableFunction) Children() []sql.Node {
+	return nil
+}
+
+func (r RepeatTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(r, children...)
+}
+
+func (r RepeatTableFunction) CheckPrivileges(_ *sql.Context, _ sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+func (r RepeatTableFunction) Expressions() []sql.Expression {
+	return nil
+}
+
+func (r RepeatTableFunction) WithExpressions(e ...sql.Expression) (sql.Node, error) {
+	return r, nil
+}
+
+func (r RepeatTableFunction) Database() sql.Database {
+	return nil
+}
+
+func (r RepeatTableFunction) WithDatabase(_ sql.Database) (sql.Node, error) {
+	return r, nil
+}
+
+func (r RepeatTableFunction) Name() string {
+	return "repeat_table"
+}
+
+var _ sql.FunctionProvider = (*TestProvider)(nil)
+
+type TestProvider struct {
+	sql.MutableDatabaseProvider
+	tableFunctions map[string]sql.TableFunction
+}
+
+func NewTestProvider(dbProvider *sql.MutableDatabaseProvider, tf ...sql.TableFunction) *TestProvider {
+	tfs := make(map[string]sql.TableFunction)
+	for _, tf := range tf {
+		tfs[strings.ToLower(tf.Name())] = tf
+	}
+	return &TestProvider{
+		*dbProvider,
+		tfs,
+	}
+}
+
+func (t TestProvider) Function(_ *sql.Context, name string) (sql.Function, error) {
+	return nil, sql.ErrFunctionNotFound.New(name)
+}
+
+func (t TestProvider) TableFunction(_ *sql.Context, name string) (sql.TableFunction, error) {
+	if tf, ok := t.tableFunctions[strings.ToLower(name)]; ok {
+		return tf, nil
+	}
+
+	return nil, sql.ErrTableFunctionNotFound.New(name)
+}
+
+func TestTimestampBindingsCanBeConverted(t *testing.T) {
+	db, close := newDatabase()
+	defer close()
+
+	_, err := db.Exec("CREATE TABLE mytable (t TIMESTAMP)")
+	require.NoError(t, err)
+
+	// All we are doing in this test is ensuring that writing a timestamp to the
+	// database does not throw an error.
+	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", time.Now())
+	require.NoError(t, err)
+}
+
+func TestTimestampBindingsCanBeCompared(t *testing.T) {
+	db, close := newDatabase()
+	defer close()
+
+	_, err := db.Exec("CREATE TABLE mytable (t TIMESTAMP)")
+	require.NoError(t, err)
+
+	// We'll insert both of these timestamps and then try and filter them.
+	t0 := time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(1 * time.Minute)
+
+	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", t0)
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", t1)
+	require.NoError(t, err)
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(1) FROM mytable WHERE t > ?", t0).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+// TestLongDataBindings checks that long parameters sent with COM_STMT_SEND_LONG_DATA are bound as a whole. The command
+// is entirely handled by the vitess listener, which gathers the data before COM_STMT_EXECUTE reaches the handler, so
+// this only tests that behavior of vitess from a client.
+func TestLongDataBindings(t *testing.T) {
+	db, close := newDatabase()
+	defer close()
+
+	_, err := db.Exec("CREATE TABLE mytable (pk INT PRIMARY KEY, b LONGBLOB)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO mytable VALUES (1, NULL)")
+	require.NoError(t, err)
+
+	// the driver sends parameters longer than half its max packet size of 4MiB with COM_STMT_SEND_LONG_DATA
+	blob := make([]byte, 3<<20)
+	for i := range blob {
+		blob[i] = byte(i)
+	}
+	_, err = db.Exec("UPDATE mytable SET b = ? WHERE pk = 1", blob)
+	require.NoError(t, err)
+
+	var read []byte
+	err = db.QueryRow("SELECT b FROM mytable WHERE pk = 1").Scan(&read)
+	require.NoError(t, err)
+	require.Equal(t, blob, read)
+}
+
+func TestWideRows(t *testing.T) {
+	db, close := newDatabase()
+	defer close()
+
+	// rows longer than 16MiB are split across several packets in both directions
+	_, err := db.Exec("CREATE TABLE mytable (pk INT PRIMARY KEY, a LONGBLOB, b LONGBLOB)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO mytable VALUES (1, repeat('a', 16777200), repeat('b', 16777215))")
+	require.NoError(t, err)
+
+	var a, b []byte
+	require.NoError(t, db.QueryRow("SELECT a, b FROM mytable").Scan(&a, &b))
+	require.Equal(t, bytes.Repeat([]byte("a"), 16777200), a)
+	require.Equal(t, bytes.Repeat([]byte("b"), 16777215), b)
+
+	// over the binary protocol
+	require.NoError(t, db.QueryRow("SELECT a, b FROM mytable WHERE pk = ?", 1).Scan(&a, &b))
+	require.Equal(t, bytes.Repeat([]byte("a"), 16777200), a)
+	require.Equal(t, bytes.Repeat([]byte("b"), 16777215), b)
+
+	// a row packet of exactly 16MiB - 1 bytes is followed by an empty packet
+	require.NoError(t, db.QueryRow("SELECT repeat('x', 16777215 - 4 - 2), 'y'").Scan(&a, &b))
+	require.Len(t, a, 16777209)
+	require.Equal(t, []byte("y"), b)
+}
+
+// blobValueTable is a table that returns the BLOB and TEXT values of the table it wraps as sql.BlobValues, counting
+// how many times they're read.
+type blobValueTable struct {
+	sql.Table
+	reads *int32
+}
+
+func (t blobValueTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	return blobValueIter{RowIter: iter, schema: t.Schema(), reads: t.reads}, nil
+}
+
+type blobValueIter struct {
+	sql.RowIter
+	schema sql.Schema
+	reads  *int32
+}
+
+func (i blobValueIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row = row.Copy()
+	for j, v := range row {
+		if v == nil || !types.IsTextBlob(i.schema[j].Type) {
+			continue
+		}
+		b, _, err := types.LongBlob.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		row[j] = countingBlobValue{b: b.([]byte), reads: i.reads}
+	}
+	return row, nil
+}
+
+type countingBlobValue struct {
+	b     []byte
+	reads *int32
+}
+
+func (v countingBlobValue) Size() int64 {
+	return int64(len(v.b))
+}
+
+func (v countingBlobValue) NewReader() (io.ReadCloser, error) {
+	atomic.AddInt32(v.reads, 1)
+	return io.NopCloser(bytes.NewReader(v.b)), nil
+}
+
+func TestBlobValues(t *testing.T) {
+	var reads int32
+	blobs := memory.NewTable("blobs", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "blobs", PrimaryKey: true},
+		{Name: "b", Type: types.LongBlob, Source: "blobs", Nullable: true},
+		{Name: "t", Type: types.LongText, Source: "blobs", Nullable: true},
+	}), nil)
+	blob := make([]byte, 3<<20)
+	for i := range blob {
+		blob[i] = byte(i)
+	}
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, blobs.Insert(ctx, sql.Row{int64(1), blob, "text value"}))
+	require.NoError(t, blobs.Insert(ctx, sql.Row{int64(2), nil, nil}))
+
+	mydb := memory.NewDatabase("mydb")
+	mydb.AddTable("blobs", blobValueTable{Table: blobs, reads: &reads})
+	db, close := newDatabaseWith(mydb)
+	defer close()
+
+	var b []byte
+	var text string
+	require.NoError(t, db.QueryRow("SELECT b, t FROM blobs WHERE pk = 1").Scan(&b, &text))
+	require.Equal(t, blob, b)
+	require.Equal(t, "text value", text)
+	require.NotZero(t, atomic.LoadInt32(&reads))
+
+	// over the binary protocol
+	require.NoError(t, db.QueryRow("SELECT b, t FROM blobs WHERE pk = ?", 1).Scan(&b, &text))
+	require.Equal(t, blob, b)
+	require.Equal(t, "text value", text)
+
+	var length int64
+	require.NoError(t, db.QueryRow("SELECT length(b) FROM blobs WHERE pk = 1").Scan(&length))
+	require.Equal(t, int64(len(blob)), length)
+
+	// LONGBLOB round trip through storage
+	_, err := db.Exec("CREATE TABLE copied (pk INT PRIMARY KEY, b LONGBLOB, t LONGTEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO copied SELECT * FROM blobs")
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow("SELECT b, t FROM copied WHERE pk = 1").Scan(&b, &text))
+	require.Equal(t, blob, b)
+	require.Equal(t, "text value", text)
+
+	var nullBlob []byte
+	require.NoError(t, db.QueryRow("SELECT b FROM copied WHERE pk = 2").Scan(&nullBlob))
+	require.Nil(t, nullBlob)
+}
+
+func newDatabase() (*sql2.DB, func()) {
+	return newDatabaseWith(memory.NewDatabase("mydb"))
+}
+
+func newDatabaseWith(mydb sql.Database) (*sql2.DB, func()) {
+	// Grab an empty port so that tests do not fail if a specific port is already in use
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		panic(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	if err = listener.Close(); err != nil {
+		panic(err)
+	}
+
+	provider := sql.NewDatabaseProvider(
+		mydb,
+	)
+	engine := sqle.New(analyzer.NewDefault(provider), &sqle.Config{
+		IncludeRootAccount: true,
+	})
+	cfg := server.Config{
+		Protocol: "tcp",
+		Address:  fmt.Sprintf("localhost:%d", port),
+	}
+	srv, err := server.NewDefaultServer(cfg, engine)
+	if err != nil {
+		panic(err)
+	}
+	go srv.Start()
+
+	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
+	if err != nil {
+		panic(err)
+	}
+	return db, func() { srv.Close() }
+}
+
+func TestAnsiQuotesViews(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) []sql.Row {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	query("create table t (i int primary key, s varchar(10))")
+	query("insert into t values (1, 'a'), (2, 'b')")
+	query(`create view v1 as select i, "x" as s from t where s = "b"`)
+
+	query("set sql_mode = 'ANSI_QUOTES'")
+	require.Equal(t, []sql.Row{{"b"}}, query(`select "s" from "t" where "i" = 2`))
+	require.Equal(t, []sql.Row{{int32(2), "x"}}, query("select * from v1"))
+	query(`create view "v2" as select "i", 'y' as "s" from "t" where "s" = 'a'`)
+
+	query("set sql_mode = ''")
+	require.Equal(t, []sql.Row{{int32(2), "x"}}, query("select * from v1"))
+	require.Equal(t, []sql.Row{{int32(1), "y"}}, query("select * from v2"))
+	require.Equal(t, []sql.Row{{"v1", "select i, 'x' as s from t where s = 'b'"}},
+		query("select table_name, view_definition from information_schema.views where table_name = 'v1'"))
+}
+
+func TestReturning(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) ([]sql.Row, error) {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+
+	mustQuery("create table t (i int primary key auto_increment, s varchar(10), n int default 10)")
+
+	// the clause is a syntax error unless the engine enables it
+	_, err = query("insert into t (s) values ('a') returning i")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	require.Equal(t, []sql.Row{{int64(0)}}, mustQuery("select count(*) from t"))
+
+	// columns named returning aren't mistaken for the clause, whether it's enabled or not
+	mustQuery("create table r (pk int primary key, returning int)")
+	for _, enabled := range []bool{false, true} {
+		e.EnableReturning = enabled
+		mustQuery("delete from r")
+		mustQuery("insert into r values (1, 5), (2, 6)")
+		mustQuery("insert into r select pk + 10, returning from r")
+		mustQuery("insert into r values (1, 0) on duplicate key update returning = 9")
+		mustQuery("delete from r where returning = 5")
+		require.Equal(t, []sql.Row{{int32(1), int32(9)}, {int32(2), int32(6)}, {int32(12), int32(6)}},
+			mustQuery("select * from r order by pk"))
+	}
+	require.Equal(t, []sql.Row{{int32(12)}}, mustQuery("delete from r where returning = 6 and pk > 10 returning pk"))
+	mustQuery("drop table r")
+
+	e.EnableReturning = true
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}},
+		mustQuery("insert into t (s) values ('a'), ('b') returning i, s"))
+	require.Equal(t, []sql.Row{{int64(2)}}, mustQuery("select row_count()"))
+	require.Equal(t, []sql.Row{{int32(3), "c", int32(10)}},
+		mustQuery("insert into t (s) values ('c') returning *"))
+	require.Equal(t, []sql.Row{{int32(4), "CC", int32(11)}},
+		mustQuery("insert into t (s, n) select upper(s), n + 1 from t where i = 3 returning i, concat(s, s) as ss, n"))
+	require.Equal(t, []sql.Row{{"x", int32(12)}},
+		mustQuery("replace into t values (1, 'x', 12) returning s, n"))
+	require.Equal(t, []sql.Row{{int32(2), "b!"}},
+		mustQuery("insert into t values (2, 'b', 0) on duplicate key update s = concat(s, '!') returning i, t.s"))
+	require.Equal(t, []sql.Row{{int32(5), "e"}},
+		mustQuery("insert ignore into t values (1, 'dup', 0), (5, 'e', 0) returning i, s"))
+
+	// the rows a DELETE returns are the deleted ones
+	require.Equal(t, []sql.Row{{int32(3), "cc"}, {int32(4), "CC"}},
+		mustQuery("delete from t where i in (3, 4) returning i, concat(s, s)"))
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}, {int32(5)}}, mustQuery("select i from t order by i"))
+
+	// a quoted column named returning isn't the clause
+	mustQuery("create table r (i int primary key, `returning` int)")
+	mustQuery("insert into r (i, `returning`) values (1, 2)")
+	require.Equal(t, []sql.Row{{int64(2)}}, mustQuery("insert into r values (2, 3) returning `returning` - 1"))
+
+	_, err = query("delete t, r from t join r on t.i = r.i returning t.i")
+	require.Error(t, err)
+	_, err = query("insert into t (s) values ('f') returning")
+	require.Error(t, err)
+	_, err = query("insert into t (s) values ('f') returning nosuchcolumn")
+	require.Error(t, err)
+}
+
+func TestDatabaseComments(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	// the option is a syntax error unless the engine enables it
+	ctx := enginetest.NewContext(harness)
+	_, _, err = e.Query(ctx, "CREATE DATABASE commented COMMENT 'first'")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+
+	e.EnableDatabaseComments = true
+	enginetest.TestScriptWithEngine(t, e, harness, queries.ScriptTest{
+		Name: "database comments",
+		SetUpScript: []string{
+			"CREATE DATABASE commented COMMENT = 'first'",
+			"CREATE DATABASE collated COLLATE utf8mb3_general_ci COMMENT 'schema two'",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE DATABASE commented",
+				Expected: []sql.Row{{"commented", "CREATE DATABASE `commented` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_bin */ COMMENT 'first'"}},
+			},
+			{
+				Query:    "SELECT schema_name, default_collation_name, schema_comment FROM information_schema.schemata WHERE schema_name = 'collated'",
+				Expected: []sql.Row{{"collated", "utf8mb3_general_ci", "schema two"}},
+			},
+			{
+				Query:    "ALTER DATABASE commented COMMENT 'it''s second'",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SELECT schema_comment FROM information_schema.schemata WHERE schema_name = 'commented'",
+				Expected: []sql.Row{{"it's second"}},
+			},
+			{
+				Query:    "SHOW CREATE DATABASE commented",
+				Expected: []sql.Row{{"commented", "CREATE DATABASE `commented` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_bin */ COMMENT 'it''s second'"}},
+			},
+			{
+				Query:    "ALTER DATABASE commented COMMENT ''",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SHOW CREATE DATABASE commented",
+				Expected: []sql.Row{{"commented", "CREATE DATABASE `commented` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_bin */"}},
+			},
+		},
+	})
+}
+
+func TestRestrictDropTableDependents(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	query := func(q string) ([]sql.Row, error) {
+		ctx := ctx.WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+
+	mustQuery("create table a (x int primary key)")
+	mustQuery("create table b (y int primary key)")
+	mustQuery("create table c (z int primary key)")
+	mustQuery("create table log (s varchar(10))")
+	mustQuery("create trigger ta before insert on a for each row set new.x = new.x + 1")
+	mustQuery("create trigger tlog after insert on log for each row insert into c values (1)")
+	mustQuery("create view va as select x from a join b on a.x = b.y")
+	mustQuery("create view vb as select * from (select y from mydb.b) sq")
+
+	e.RestrictDropTableDependents = true
+	// the experimental analyzer resolves the dropped tables when it builds the statement
+	for _, version := range []sql.AnalyzerVersion{sql.VersionStable, sql.VersionExperimental} {
+		ctx.Version = version
+		_, err = query("drop table a")
+		require.True(t, sql.ErrDropTableRestricted.Is(err), "unexpected error %v", err)
+		require.Equal(t, "cannot drop table `a` as it is referenced by view `va`", err.Error())
+		_, err = query("drop table b")
+		require.Equal(t, "cannot drop table `b` as it is referenced by view `va`, view `vb`", err.Error())
+		_, err = query("drop table c")
+		require.Equal(t, "cannot drop table `c` as it is referenced by trigger `tlog`", err.Error())
+	}
+	ctx.Version = sql.VersionStable
+	_, err = query("drop table if exists nosuchtable, b")
+	require.Equal(t, "cannot drop table `b` as it is referenced by view `va`, view `vb`", err.Error())
+	require.Len(t, mustQuery("show tables"), 7)
+
+	// views and triggers of other databases read the tables they name qualified with their database
+	mustQuery("create database other")
+	mustQuery("create table other.b (y int primary key)")
+	mustQuery("create view other.vo as select y from mydb.b")
+	mustQuery("create view other.vlocal as select y from b")
+	mustQuery("create table other.t (i int primary key)")
+	mustQuery("create trigger other.tt after insert on other.t for each row insert into mydb.c values (new.i)")
+	_, err = query("drop table b")
+	require.Equal(t, "cannot drop table `b` as it is referenced by view `other`.`vo`, view `va`, view `vb`", err.Error())
+	_, err = query("drop table c")
+	require.Equal(t, "cannot drop table `c` as it is referenced by trigger `other`.`tt`, trigger `tlog`", err.Error())
+	_, err = query("drop table other.b")
+	require.Equal(t, "cannot drop table `b` as it is referenced by view `vlocal`", err.Error())
+	mustQuery("drop database other")
+
+	// the triggers of the dropped tables are dropped along with them, so they aren't dependents
+	mustQuery("drop view va, vb")
+	mustQuery("drop table a, b")
+	mustQuery("drop table c, log")
+	require.Empty(t, mustQuery("show triggers"))
+
+	// the dependents of the tables are kept otherwise
+	e.RestrictDropTableDependents = false
+	mustQuery("create table c (z int primary key)")
+	mustQuery("create view vc as select z from c")
+	mustQuery("create trigger tc before insert on c for each row set new.z = new.z + 1")
+	mustQuery("drop table c")
+	require.Empty(t, mustQuery("show triggers"))
+	require.Equal(t, []sql.Row{{"myview", "VIEW"}, {"vc", "VIEW"}}, mustQuery("show full tables"))
+	_, err = query("select * from vc")
+	require.True(t, sql.ErrViewInvalid.Is(err), "unexpected error %v", err)
 }
 
-var _ sql.TableFunction = (*SimpleTableFunction)(nil)
-var _ sql.CollationCoercible = (*SimpleTableFunction)(nil)
-var _ sql.ExecSourceRel = (*SimpleTableFunction)(nil)
+// rowAccessPolicyDatabase is a database whose tables restrict the rows of each user to the ones of their tenant.
+type rowAccessPolicyDatabase struct {
+	*memory.Database
+}
 
-// SimpleTableFunction an extremely simple implementation of TableFunction for testing.
-// When evaluated, returns a single row: {"foo", 123}
-type SimpleTableFunction struct {
-	returnedResults bool
+func (d rowAccessPolicyDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	table, ok, err := d.Database.GetTableInsensitive(ctx, tblName)
+	if !ok || err != nil {
+		return table, ok, err
+	}
+	return rowAccessPolicyTable{table.(*memory.Table)}, true, nil
 }
 
-func (s SimpleTableFunction) NewInstance(_ *sql.Context, _ sql.Database, _ []sql.Expression) (sql.Node, error) {
-	return SimpleTableFunction{}, nil
+type rowAccessPolicyTable struct {
+	*memory.Table
 }
 
-func (s SimpleTableFunction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
-	if s.returnedResults == true {
-		return nil, io.EOF
+func (t rowAccessPolicyTable) RowAccessPolicies(ctx *sql.Context) ([]string, error) {
+	if user := ctx.Session.Client().User; user != "root" {
+		return []string{fmt.Sprintf("tenant = '%s'", user)}, nil
 	}
-	s.returnedResults = true
-	return &SimpleTableFunctionRowIter{}, nil
+	return nil, nil
 }
 
-func (s SimpleTableFunction) Resolved() bool {
-	return true
+func TestRowAccessPolicies(t *testing.T) {
+	pro := memory.NewDBProvider(rowAccessPolicyDatabase{memory.NewDatabase("mydb")})
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+
+	query := func(user, q string) ([]sql.Row, error) {
+		session := sql.NewBaseSessionWithClientServer("", sql.Client{User: user, Address: "localhost"}, 1)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(session)).WithQuery(q)
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	mustQuery := func(user, q string) []sql.Row {
+		rows, err := query(user, q)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+
+	mustQuery("root", "create table t (i int primary key, tenant varchar(10), v int)")
+	mustQuery("root", "insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'a', 30)")
+
+	// reads only see the rows of the tenant, whichever way the table is referenced
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, mustQuery("a", "select i from t order by i"))
+	require.Equal(t, []sql.Row{{int32(2)}}, mustQuery("b", "select x.i from t as x"))
+	require.Equal(t, []sql.Row{{int64(2)}}, mustQuery("a", "select count(*) from (select * from t) s"))
+	require.Equal(t, []sql.Row{{int64(1)}}, mustQuery("b", "select count(*) from t x join t y on x.i = y.i"))
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, mustQuery("a", "select i from t where i in (select i from t) order by i"))
+	require.Equal(t, []sql.Row{{int64(3)}}, mustQuery("root", "select count(*) from t"))
+
+	// writes only change the rows of the tenant
+	mustQuery("a", "update t set v = v + 1")
+	mustQuery("b", "delete from t where i = 1")
+	require.Equal(t, []sql.Row{{int32(1), int32(11)}, {int32(2), int32(20)}, {int32(3), int32(31)}},
+		mustQuery("root", "select i, v from t order by i"))
+
+	// and new rows must belong to the tenant
+	mustQuery("a", "insert into t values (4, 'a', 40)")
+	_, err := query("a", "insert into t values (5, 'b', 50)")
+	require.True(t, sql.ErrCheckConstraintViolated.Is(sql.UnwrapError(err)), "unexpected error %v", err)
+	_, err = query("a", "insert into t values (5, null, 50)")
+	require.True(t, sql.ErrCheckConstraintViolated.Is(sql.UnwrapError(err)), "unexpected error %v", err)
+	_, err = query("a", "update t set tenant = 'b' where i = 4")
+	require.True(t, sql.ErrCheckConstraintViolated.Is(sql.UnwrapError(err)), "unexpected error %v", err)
+	require.Equal(t, []sql.Row{{int64(4)}}, mustQuery("root", "select count(*) from t"))
+
+	// the results cached for a user aren't returned to the other users, whose policies filter other rows
+	e.QueryCache = sqle.NewQueryCache(10, 100)
+	cachedQuery := func(user, q string) []sql.Row {
+		session := sql.NewBaseSessionWithClientServer("", sql.Client{User: user, Address: "localhost"}, 1)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(session)).WithQuery(q)
+		ctx.SetCurrentDatabase("mydb")
+		require.NoError(t, session.SetSessionVariable(ctx, "query_cache_type", "ON"))
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}, {int32(4)}}, cachedQuery("a", "select i from t order by i"))
+	require.Equal(t, 1, e.QueryCache.Len())
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}, {int32(4)}}, cachedQuery("a", "select i from t order by i"))
+	require.Equal(t, 1, e.QueryCache.Len())
+	require.Equal(t, []sql.Row{{int32(2)}}, cachedQuery("b", "select i from t order by i"))
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}}, cachedQuery("root", "select i from t order by i"))
+	require.Equal(t, 3, e.QueryCache.Len())
 }
 
-func (s SimpleTableFunction) String() string {
-	return "SimpleTableFunction"
+func TestColumnMasks(t *testing.T) {
+	pro := memory.NewDBProvider(columnMaskingDatabase{memory.NewDatabase("mydb")})
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	query := func(user, q string) ([]sql.Row, error) {
+		session := sql.NewBaseSessionWithClientServer("", sql.Client{User: user, Address: "localhost"}, 1)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(session)).WithQuery(q)
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	mustQuery := func(user, q string) []sql.Row {
+		rows, err := query(user, q)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+
+	mustQuery("root", "create table t (i int primary key, email varchar(50), salary int)")
+	mustQuery("root", "insert into t values (1, 'ann@example.com', 100), (2, 'bob@example.com', 200)")
+	mustQuery("root", "create table u (email varchar(50) primary key)")
+	mustQuery("root", "insert into u values ('ann@example.com')")
+	mustQuery("root", "create user masked@localhost, unmasked@localhost")
+	mustQuery("root", "grant select, update on *.* to masked@localhost, unmasked@localhost")
+	mustQuery("root", "grant unmask on *.* to unmasked@localhost")
+
+	// the masked values are the ones seen by projections, functions, filters and joins
+	require.Equal(t, []sql.Row{{int32(1), "***@example.com", int32(0)}, {int32(2), "***@example.com", int32(0)}},
+		mustQuery("masked", "select * from t order by i"))
+	require.Equal(t, []sql.Row{{"***@EXAMPLE.COM"}}, mustQuery("masked", "select upper(x.email) from t x where i = 1"))
+	require.Equal(t, []sql.Row{{int64(0)}}, mustQuery("masked", "select count(*) from t where email = 'ann@example.com'"))
+	require.Equal(t, []sql.Row{{int64(0)}}, mustQuery("masked", "select count(*) from t join u on t.email = u.email"))
+	require.Equal(t, []sql.Row{{float64(0)}}, mustQuery("masked", "select sum(salary) from (select salary from t) s"))
+
+	// users with the UNMASK privilege see the values
+	require.Equal(t, []sql.Row{{int32(1), "ann@example.com", int32(100)}, {int32(2), "bob@example.com", int32(200)}},
+		mustQuery("unmasked", "select * from t order by i"))
+	require.Equal(t, []sql.Row{{int64(1)}}, mustQuery("unmasked", "select count(*) from t join u on t.email = u.email"))
+
+	// updates change the values, not the masked ones
+	mustQuery("masked", "update t set salary = salary + 1 where i = 1")
+	require.Equal(t, []sql.Row{{int32(101)}}, mustQuery("root", "select salary from t where i = 1"))
+	require.Equal(t, []sql.Row{{int32(0)}}, mustQuery("masked", "select salary from t where i = 1"))
 }
 
-func (s SimpleTableFunction) Schema() sql.Schema {
-	schema := []*sql.Column{
-		&sql.Column{
-			Name: "one",
-			Type: types.TinyText,
-		},
-		&sql.Column{
-			Name: "two",
-			Type: types.Int64,
-		},
+// columnMaskingDatabase is a database whose tables mask the email and salary columns.
+type columnMaskingDatabase struct {
+	*memory.Database
+}
+
+func (d columnMaskingDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	table, ok, err := d.Database.GetTableInsensitive(ctx, tblName)
+	if !ok || err != nil || !strings.EqualFold(tblName, "t") {
+		return table, ok, err
 	}
+	return columnMaskingTable{table.(*memory.Table)}, true, nil
+}
 
-	return schema
+type columnMaskingTable struct {
+	*memory.Table
 }
 
-func (s SimpleTableFunction) Children() []sql.Node {
-	return []sql.Node{}
+func (t columnMaskingTable) ColumnMasks(ctx *sql.Context) (map[string]string, error) {
+	return map[string]string{
+		"email":  "concat('***', substring(email, locate('@', email)))",
+		"salary": "0",
+	}, nil
 }
 
-func (s SimpleTableFunction) WithChildren(_ ...sql.Node) (sql.Node, error) {
-	return s, nil
+func TestInfoSchemaColumnsOfGeneratedAndInvisibleColumns(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "t", Type: types.Int32, PrimaryKey: true},
+		{Name: "b", Source: "t", Type: types.Int32, Nullable: true, Invisible: true,
+			Default: &sql.ColumnDefaultValue{Expression: expression.NewLiteral(int32(3), types.Int32), Literal: true, ReturnNil: true}},
+		{Name: "c", Source: "t", Type: types.Int64, Nullable: true, Virtual: true, Generated: &sql.ColumnDefaultValue{
+			Expression: expression.NewPlus(expression.NewUnresolvedColumn("a"), expression.NewUnresolvedColumn("b")),
+		}},
+		{Name: "d", Source: "t", Type: types.Int64, Nullable: true, Invisible: true, Generated: &sql.ColumnDefaultValue{
+			Expression: expression.NewMult(expression.NewUnresolvedColumn("a"), expression.NewLiteral(int8(2), types.Int8)),
+		}},
+	}), db.GetForeignKeyCollection()))
+	e := sqle.NewDefault(memory.NewDBProvider(db))
+	defer e.Close()
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	sch, iter, err := e.Query(ctx, "select column_name, column_default, extra, generation_expression from information_schema.columns where table_name = 't' order by ordinal_position")
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, sch, iter)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{
+		{"a", nil, "", ""},
+		{"b", "3", "INVISIBLE", ""},
+		{"c", nil, "VIRTUAL GENERATED", "(`a` + `b`)"},
+		{"d", nil, "STORED GENERATED INVISIBLE", "(`a` * 2)"},
+	}, rows)
 }
 
-func (s SimpleTableFunction) CheckPrivileges(_ *sql.Context, _ sql.PrivilegedOperationChecker) bool {
-	return true
+func TestTableMaintenanceHooks(t *testing.T) {
+	db := maintainedDatabase{Database: memory.NewDatabase("mydb"), optimized: new(int)}
+	e := sqle.NewDefault(memory.NewDBProvider(db))
+	defer e.Close()
+
+	query := func(q string) []sql.Row {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithQuery(q)
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+
+	query("create table t (pk int primary key)")
+	query("insert into t values (1), (2)")
+
+	require.Equal(t, []sql.Row{{"mydb.t", "optimize", "status", "OK"}}, query("optimize table t"))
+	require.Equal(t, 1, *db.optimized)
+
+	// the checksum maintained by the table is used unless EXTENDED is given
+	require.Equal(t, []sql.Row{{"mydb.t", int64(42)}}, query("checksum table t"))
+	require.Equal(t, []sql.Row{{"mydb.t", int64(42)}}, query("checksum table t quick"))
+	require.NotEqual(t, []sql.Row{{"mydb.t", int64(42)}}, query("checksum table t extended"))
 }
 
-// CollationCoercibility implements the interface sql.CollationCoercible.
-func (SimpleTableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
-	return sql.Collation_binary, 7
+// maintainedDatabase is a database whose tables maintain their checksums and count how often they are optimized.
+type maintainedDatabase struct {
+	*memory.Database
+	optimized *int
 }
 
-func (s SimpleTableFunction) Expressions() []sql.Expression {
-	return []sql.Expression{}
+func (d maintainedDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	table, ok, err := d.Database.GetTableInsensitive(ctx, tblName)
+	if !ok || err != nil {
+		return table, ok, err
+	}
+	return maintainedTable{Table: table.(*memory.Table), optimized: d.optimized}, true, nil
 }
 
-func (s SimpleTableFunction) WithExpressions(e ...sql.Expression) (sql.Node, error) {
-	return s, nil
+type maintainedTable struct {
+	*memory.Table
+	optimized *int
 }
 
-func (s SimpleTableFunction) Database() sql.Database {
+var _ sql.ChecksumTable = maintainedTable{}
+var _ sql.OptimizableTable = maintainedTable{}
+
+func (t maintainedTable) Checksum(ctx *sql.Context) (uint64, error) {
+	return 42, nil
+}
+
+func (t maintainedTable) Optimize(ctx *sql.Context) error {
+	*t.optimized++
 	return nil
 }
 
-func (s SimpleTableFunction) WithDatabase(_ sql.Database) (sql.Node, error) {
-	return s, nil
+func TestShardedTables(t *testing.T) {
+	db := shardedDatabase{Database: memory.NewDatabase("mydb"), state: &shardState{reads: map[string]int{}}}
+	e := sqle.NewDefault(memory.NewDBProvider(db))
+	defer e.Close()
+
+	query := func(q string) ([]sql.Row, error) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithQuery(q)
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
+	}
+	reads := func(q string) map[string]int {
+		db.state.reads = map[string]int{}
+		mustQuery(q)
+		return db.state.reads
+	}
+
+	// the shards of t are the tables t_0, t_1 and t_2, holding the rows by k mod 3
+	for i := 0; i < 3; i++ {
+		mustQuery(fmt.Sprintf("create table t_%d (k int primary key, v varchar(10))", i))
+	}
+
+	// rows written are fanned out to their shards
+	mustQuery("insert into t values (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd')")
+	require.Equal(t, []sql.Row{{int32(3), "c"}}, mustQuery("select * from t_0"))
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(4), "d"}}, mustQuery("select * from t_1 order by k"))
+	require.Equal(t, []sql.Row{{int32(2), "b"}}, mustQuery("select * from t_2"))
+
+	// filters on the shard key read only the shards of the keys they match
+	require.Equal(t, map[string]int{"t_1": 1}, reads("select * from t where k = 4"))
+	require.Equal(t, map[string]int{"t_0": 1, "t_2": 1}, reads("select * from t x where x.k in (2, 6) and v <> 'z'"))
+	require.Equal(t, map[string]int{"t_0": 1, "t_1": 1, "t_2": 1}, reads("select * from t where k > 2"))
+	require.Equal(t, map[string]int{"t_0": 1, "t_1": 1, "t_2": 1}, reads("select * from t where k = 1 or v = 'c'"))
+	require.Equal(t, []sql.Row{{int32(2), "b"}, {int32(3), "c"}}, mustQuery("select * from t where k in (2, 3) order by k"))
+	require.Equal(t, map[string]int{"t_1": 1}, reads("update t set v = 'a' where k = 1"))
+	require.Equal(t, []sql.Row{
+		{"Filter"},
+		{" ├─ (t.k = 4)"},
+		{" └─ Table"},
+		{"     ├─ name: t"},
+		{"     └─ shards: [t_1]"},
+	}, mustQuery("explain select * from t where k = 4"))
+
+	// a row whose shard key changes moves to its new shard
+	mustQuery("update t set k = 5 where k = 4")
+	mustQuery("delete from t where k = 3")
+	require.Equal(t, []sql.Row{{int64(0)}}, mustQuery("select count(*) from t_0"))
+	require.Equal(t, []sql.Row{{int32(2), "b"}, {int32(5), "d"}}, mustQuery("select * from t_2 order by k"))
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}, {int32(5), "d"}}, mustQuery("select * from t order by k"))
+
+	// the changes of a statement are prepared on all its shards before any is completed, and are discarded on all of
+	// them if one fails to prepare
+	db.state.prepared = nil
+	mustQuery("insert into t values (6, 'e'), (7, 'f')")
+	require.ElementsMatch(t, []string{"t_0", "t_1"}, db.state.prepared)
+	db.state.failPrepare = "t_2"
+	_, err := query("insert into t values (9, 'g'), (8, 'h')")
+	require.Error(t, err)
+	require.Equal(t, []sql.Row{{int64(5)}}, mustQuery("select count(*) from t"))
 }
 
-func (s SimpleTableFunction) Name() string {
-	return "simple_table_function"
+// shardedDatabase is a database whose table t is sharded by its column k across the tables t_0, t_1 and t_2.
+type shardedDatabase struct {
+	*memory.Database
+	state *shardState
 }
 
-func (s SimpleTableFunction) Description() string {
-	return "SimpleTableFunction"
+// shardState records the shards read and prepared by the statements on a sharded table.
+type shardState struct {
+	reads       map[string]int
+	prepared    []string
+	failPrepare string
 }
 
-var _ sql.RowIter = (*SimpleTableFunctionRowIter)(nil)
+func (d shardedDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	if !strings.EqualFold(tblName, "t") {
+		return d.Database.GetTableInsensitive(ctx, tblName)
+	}
+	table := &shardedTable{db: d}
+	for i := 0; i < 3; i++ {
+		shard, ok, err := d.Database.GetTableInsensitive(ctx, fmt.Sprintf("t_%d", i))
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		table.shards = append(table.shards, shard.(*memory.Table))
+		table.selected = append(table.selected, shard.Name())
+	}
+	return table, true, nil
+}
 
-type SimpleTableFunctionRowIter struct {
-	returnedResults bool
+func (d shardedDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
+	names, err := d.Database.GetTableNames(ctx)
+	return append(names, "t"), err
 }
 
-func (itr *SimpleTableFunctionRowIter) Next(_ *sql.Context) (sql.Row, error) {
-	if itr.returnedResults {
-		return nil, io.EOF
-	}
+type shardedTable struct {
+	db       shardedDatabase
+	shards   []*memory.Table
+	selected []string
+}
 
-	itr.returnedResults = true
-	return sql.Row{"foo", 123}, nil
+var _ sql.ShardedTable = (*shardedTable)(nil)
+var _ sql.InsertableTable = (*shardedTable)(nil)
+var _ sql.UpdatableTable = (*shardedTable)(nil)
+var _ sql.DeletableTable = (*shardedTable)(nil)
+
+type shardPartition struct {
+	shard *memory.Table
+	sql.Partition
 }
 
-func (itr *SimpleTableFunctionRowIter) Close(_ *sql.Context) error {
-	return nil
+func (t *shardedTable) Name() string {
+	return "t"
 }
 
-var _ sql.FunctionProvider = (*TestProvider)(nil)
+func (t *shardedTable) String() string {
+	return "t"
+}
 
-type TestProvider struct {
-	sql.MutableDatabaseProvider
-	tableFunctions map[string]sql.TableFunction
+func (t *shardedTable) Schema() sql.Schema {
+	sch := t.shards[0].Schema().Copy()
+	for _, col := range sch {
+		col.Source = "t"
+	}
+	return sch
 }
 
-func NewTestProvider(dbProvider *sql.MutableDatabaseProvider, tf ...sql.TableFunction) *TestProvider {
-	tfs := make(map[string]sql.TableFunction)
-	for _, tf := range tf {
-		tfs[strings.ToLower(tf.Name())] = tf
+func (t *shardedTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+func (t *shardedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	var partitions []sql.Partition
+	for _, shard := range t.shards {
+		if !slices.Contains(t.selected, shard.Name()) {
+			continue
+		}
+		t.db.state.reads[shard.Name()]++
+		iter, err := shard.Partitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			p, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			partitions = append(partitions, shardPartition{shard: shard, Partition: p})
+		}
 	}
-	return &TestProvider{
-		*dbProvider,
-		tfs,
+	return sql.PartitionsToPartitionIter(partitions...), nil
+}
+
+func (t *shardedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	p := partition.(shardPartition)
+	return p.shard.PartitionRows(ctx, p.Partition)
+}
+
+func (t *shardedTable) ShardKey() []string {
+	return []string{"k"}
+}
+
+func (t *shardedTable) Shard(ctx *sql.Context, key sql.Row) (string, error) {
+	k, ok := key[0].(int32)
+	if !ok {
+		return "", fmt.Errorf("unexpected shard key %v", key)
 	}
+	return fmt.Sprintf("t_%d", k%3), nil
 }
 
-func (t TestProvider) Function(_ *sql.Context, name string) (sql.Function, error) {
-	return nil, sql.ErrFunctionNotFound.New(name)
+func (t *shardedTable) Shards() []string {
+	return t.selected
 }
 
-func (t TestProvider) TableFunction(_ *sql.Context, name string) (sql.TableFunction, error) {
-	if tf, ok := t.tableFunctions[strings.ToLower(name)]; ok {
-		return tf, nil
+func (t *shardedTable) WithShards(shards []string) sql.Table {
+	nt := *t
+	nt.selected = shards
+	return &nt
+}
+
+func (t *shardedTable) ShardEditor(ctx *sql.Context, shard string) (sql.TableEditor, error) {
+	for _, table := range t.shards {
+		if table.Name() == shard {
+			return &preparedShardEditor{TableEditor: table.Updater(ctx).(sql.TableEditor), shard: shard, state: t.db.state}, nil
+		}
 	}
+	return nil, fmt.Errorf("unknown shard %s", shard)
+}
 
-	return nil, sql.ErrTableFunctionNotFound.New(name)
+func (t *shardedTable) Inserter(ctx *sql.Context) sql.RowInserter {
+	return t.editor()
 }
 
-func TestTimestampBindingsCanBeConverted(t *testing.T) {
-	db, close := newDatabase()
-	defer close()
+func (t *shardedTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return t.editor()
+}
 
-	_, err := db.Exec("CREATE TABLE mytable (t TIMESTAMP)")
-	require.NoError(t, err)
+func (t *shardedTable) Deleter(ctx *sql.Context) sql.RowDeleter {
+	return t.editor()
+}
 
-	// All we are doing in this test is ensuring that writing a timestamp to the
-	// database does not throw an error.
-	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", time.Now())
-	require.NoError(t, err)
+func (t *shardedTable) editor() *plan.ShardedTableEditor {
+	editor, err := plan.NewShardedTableEditor(t)
+	if err != nil {
+		panic(err)
+	}
+	return editor
 }
 
-func TestTimestampBindingsCanBeCompared(t *testing.T) {
-	db, close := newDatabase()
-	defer close()
+// preparedShardEditor is the editor of a shard that records the statements prepared on it.
+type preparedShardEditor struct {
+	sql.TableEditor
+	shard string
+	state *shardState
+}
 
-	_, err := db.Exec("CREATE TABLE mytable (t TIMESTAMP)")
-	require.NoError(t, err)
+var _ sql.ShardPreparer = (*preparedShardEditor)(nil)
 
-	// We'll insert both of these timestamps and then try and filter them.
-	t0 := time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC)
-	t1 := t0.Add(1 * time.Minute)
+func (e *preparedShardEditor) PrepareStatement(ctx *sql.Context) error {
+	if e.shard == e.state.failPrepare {
+		return fmt.Errorf("shard %s is unavailable", e.shard)
+	}
+	e.state.prepared = append(e.state.prepared, e.shard)
+	return nil
+}
 
-	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", t0)
+func TestFlushHooks(t *testing.T) {
+	db := flushedDatabase{Database: memory.NewDatabase("mydb"), flushed: new([][]string)}
+	e := sqle.NewDefault(memory.NewDBProvider(db))
+	defer e.Close()
+
+	newContext := func(id uint32) func(q string) ([]sql.Row, error) {
+		sess := sql.NewBaseSessionWithClientServer("", sql.Client{}, id)
+		return func(q string) ([]sql.Row, error) {
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+			ctx.SetCurrentDatabase("mydb")
+			sch, iter, err := e.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			return sql.RowIterToRows(ctx, sch, iter)
+		}
+	}
+	session1, session2 := newContext(1), newContext(2)
+	query := func(q string) {
+		_, err := session1(q)
+		require.NoError(t, err, "error running query %s", q)
+	}
+
+	query("create table t1 (pk int primary key)")
+	query("create table t2 (pk int primary key)")
+
+	query("flush tables")
+	query("flush tables t2, mydb.t1")
+	require.Equal(t, [][]string{nil, {"t2", "t1"}}, *db.flushed)
+
+	var rotated []sql.LogType
+	for _, logType := range sql.LogTypes {
+		logType := logType
+		e.Analyzer.Catalog.LogSinks.Register(logType, logSinkFunc(func(ctx *sql.Context) error {
+			rotated = append(rotated, logType)
+			return nil
+		}))
+	}
+	query("flush logs")
+	require.Equal(t, sql.LogTypes, rotated)
+	rotated = nil
+	query("flush slow logs")
+	require.Equal(t, []sql.LogType{sql.LogType_Slow}, rotated)
+
+	// The global read lock prevents writes in the session holding it, and makes the writes of others wait for it
+	query("flush tables with read lock")
+	_, err := session1("insert into t1 values (1)")
+	require.Error(t, err)
+	require.True(t, sql.ErrCantUpdateWithReadLock.Is(err), "unexpected error %s", err)
+	_, err = session2("set lock_wait_timeout = 1")
 	require.NoError(t, err)
-	_, err = db.Exec("INSERT INTO mytable (t) VALUES (?)", t1)
+	_, err = session2("insert into t1 values (1)")
+	require.Error(t, err)
+	require.True(t, sql.ErrLockWaitTimeout.Is(err), "unexpected error %s", err)
+	_, err = session2("select * from t1")
 	require.NoError(t, err)
 
-	var count int
-	err = db.QueryRow("SELECT COUNT(1) FROM mytable WHERE t > ?", t0).Scan(&count)
+	query("unlock tables")
+	_, err = session2("insert into t1 values (1)")
 	require.NoError(t, err)
-	require.Equal(t, 1, count)
+	rows, err := session1("select * from t1")
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{int32(1)}}, rows)
 }
 
-func newDatabase() (*sql2.DB, func()) {
-	// Grab an empty port so that tests do not fail if a specific port is already in use
-	listener, err := net.Listen("tcp", ":0")
-	if err != nil {
-		panic(err)
+// flushedDatabase is a database that records the tables it's asked to flush.
+type flushedDatabase struct {
+	*memory.Database
+	flushed *[][]string
+}
+
+var _ sql.TableCacheDatabase = flushedDatabase{}
+
+func (d flushedDatabase) FlushTables(ctx *sql.Context, tableNames []string) error {
+	*d.flushed = append(*d.flushed, tableNames)
+	return nil
+}
+
+type logSinkFunc func(ctx *sql.Context) error
+
+func (f logSinkFunc) Rotate(ctx *sql.Context) error {
+	return f(ctx)
+}
+
+func TestDumpDatabase(t *testing.T) {
+	mydb, restored := memory.NewDatabase("mydb"), memory.NewDatabase("restored")
+	mydb.EnablePrimaryKeyIndexes()
+	restored.EnablePrimaryKeyIndexes()
+	e := sqle.NewDefault(memory.NewDBProvider(mydb, restored))
+	defer e.Close()
+
+	sess := sql.NewBaseSession()
+	newContext := func(db, q string) *sql.Context {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		ctx.SetCurrentDatabase(db)
+		return ctx
 	}
-	port := listener.Addr().(*net.TCPAddr).Port
-	if err = listener.Close(); err != nil {
-		panic(err)
+	query := func(db, q string) []sql.Row {
+		ctx := newContext(db, q)
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, "error running query %s", q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err, "error running query %s", q)
+		return rows
 	}
 
-	provider := sql.NewDatabaseProvider(
-		memory.NewDatabase("mydb"),
-	)
-	engine := sqle.New(analyzer.NewDefault(provider), &sqle.Config{
-		IncludeRootAccount: true,
-	})
-	cfg := server.Config{
-		Protocol: "tcp",
-		Address:  fmt.Sprintf("localhost:%d", port),
+	for _, q := range []string{
+		"create table parent (pk int primary key auto_increment, s varchar(20), b blob, j json, d datetime(6), f float, dc decimal(10,2), e enum('a','b'))",
+		// child is dumped before the table it references
+		"create table child (pk int primary key, parent_pk int, foreign key (parent_pk) references parent (pk))",
+		"create table audit (n int)",
+		"insert into parent values (1, 'it''s \"quoted\"\\n', 0x00ff27, '{\"a\": [1, \"x\"]}', '2020-01-01 10:00:00.5', 1.5, 3.25, 'b'), (2, null, '', null, null, null, null, null)",
+		"insert into child values (10, 1), (20, 2)",
+		"create view v as select pk, s from parent where pk > 1",
+		"create trigger trg after insert on child for each row begin insert into audit values (new.pk); set @last_child = new.pk; end",
+		"create procedure p() select count(*) from parent",
+	} {
+		query("mydb", q)
 	}
-	srv, err := server.NewDefaultServer(cfg, engine)
-	if err != nil {
-		panic(err)
+
+	var dump strings.Builder
+	ctx := newContext("mydb", "")
+	require.NoError(t, e.DumpDatabase(ctx, "mydb", &dump, sql.DumpOptions{Routines: true}))
+	require.Nil(t, ctx.GetTransaction())
+
+	for remainder := dump.String(); strings.TrimSpace(remainder) != ""; {
+		var stmt string
+		var err error
+		_, stmt, remainder, err = parse.ParseOne(newContext("restored", remainder), remainder)
+		require.NoError(t, err)
+		query("restored", stmt)
 	}
-	go srv.Start()
 
-	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
-	if err != nil {
-		panic(err)
+	for _, table := range []string{"parent", "child", "audit"} {
+		require.Equal(t, query("mydb", "show create table "+table), query("restored", "show create table "+table))
+		require.Equal(t, query("mydb", "select * from "+table+" order by 1"), query("restored", "select * from "+table+" order by 1"))
 	}
-	return db, func() { srv.Close() }
+	require.Equal(t, []sql.Row{{int32(2), nil}}, query("restored", "select * from v"))
+	// The trigger isn't restored before the rows, which don't fire it
+	require.Empty(t, query("restored", "select * from audit"))
+	query("restored", "insert into child values (30, 1)")
+	require.Equal(t, []sql.Row{{int32(30)}}, query("restored", "select * from audit"))
+	require.Equal(t, []sql.Row{{int64(2)}}, query("restored", "call p()"))
+
+	// The mysqldump format can be read by the mysql client
+	dump.Reset()
+	require.NoError(t, e.DumpDatabase(newContext("mydb", ""), "mydb", &dump, sql.DumpOptions{MySQLDump: true, AddDropTable: true, NoData: true}))
+	require.True(t, strings.HasPrefix(dump.String(), "-- MySQL dump\n"))
+	require.Contains(t, dump.String(), "DROP TABLE IF EXISTS `parent`;\nCREATE TABLE `parent`")
+	require.Contains(t, dump.String(), "DELIMITER ;;\ncreate trigger trg")
+	require.NotContains(t, dump.String(), "INSERT INTO")
+
+	// BACKUP DATABASE saves the dump to a file in the secure_file_priv directory
+	dir := t.TempDir()
+	_, prev, _ := sql.SystemVariables.GetGlobal("secure_file_priv")
+	require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"secure_file_priv": dir}))
+	defer sql.SystemVariables.AssignValues(map[string]interface{}{"secure_file_priv": prev})
+
+	dump.Reset()
+	require.NoError(t, e.DumpDatabase(newContext("mydb", ""), "mydb", &dump, sql.DumpOptions{}))
+	query("mydb", "backup database mydb to 'mydb.sql'")
+	contents, err := os.ReadFile(filepath.Join(dir, "mydb.sql"))
+	require.NoError(t, err)
+	require.Equal(t, dump.String(), string(contents))
+
+	q := "backup database mydb to 'mydb.sql' with no_data"
+	_, _, err = e.Query(newContext("mydb", q), q)
+	require.Error(t, err)
+	require.True(t, sql.ErrFileExists.Is(err), "unexpected error %s", err)
+}
+
+func TestExpiredPasswordSandbox(t *testing.T) {
+	db := memory.NewDatabase("db")
+	pro := memory.NewDBProvider(db)
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	root := sql.NewBaseSessionWithClientServer("", sql.Client{User: "root", Address: "localhost"}, 1)
+	sess := sql.NewBaseSessionWithClientServer("", sql.Client{User: "expired", Address: "localhost", PasswordExpired: true}, 2)
+	query := func(sess sql.Session, q string) error {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		return err
+	}
+	require.NoError(t, query(root, "CREATE USER expired@localhost IDENTIFIED BY 'old'"))
+	require.NoError(t, query(root, "ALTER USER expired@localhost PASSWORD EXPIRE"))
+
+	// only the statements changing the password are allowed until it's changed
+	err := query(sess, "SELECT 1")
+	require.True(t, sql.ErrMustChangePassword.Is(err), "unexpected error %v", err)
+	require.NoError(t, query(sess, "SET @x = 1"))
+	require.NoError(t, query(sess, "ALTER USER USER() IDENTIFIED BY 'new'"))
+	require.False(t, sess.Client().PasswordExpired)
+	require.NoError(t, query(sess, "SELECT 1"))
 }
@@ -296,6 +296,32 @@ func TestInfoSchema(t *testing.T, h Harness) {
 		}, nil, nil)
 	})
 
+	t.Run("performance_schema.session_connect_attrs", func(t *testing.T) {
+		e := mustNewEngine(t, h)
+		defer e.Close()
+		p := sqle.NewProcessList()
+		p.AddConnection(1, "localhost")
+		sess := sql.NewBaseSessionWithClientServer("localhost", sql.Client{Address: "localhost", User: "root",
+			Attributes: map[string]string{"_client_name": "libmysql", "program_name": "mysql"}}, 1)
+		p.ConnectionReady(sess)
+		ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p))
+
+		p.AddConnection(2, "otherhost")
+		sess2 := sql.NewBaseSessionWithClientServer("localhost", sql.Client{Address: "otherhost", User: "root",
+			Attributes: map[string]string{"_os": "Linux"}}, 2)
+		p.ConnectionReady(sess2)
+
+		TestQueryWithContext(t, ctx, e, h, "SELECT * FROM performance_schema.session_connect_attrs", []sql.Row{
+			{uint32(1), "_client_name", "libmysql", int32(0)},
+			{uint32(1), "program_name", "mysql", int32(1)},
+			{uint32(2), "_os", "Linux", int32(0)},
+		}, nil, nil)
+		TestQueryWithContext(t, ctx, e, h, "SELECT * FROM performance_schema.session_account_connect_attrs", []sql.Row{
+			{uint32(1), "_client_name", "libmysql", int32(0)},
+			{uint32(1), "program_name", "mysql", int32(1)},
+		}, nil, nil)
+	})
+
 	for _, tt := range queries.SkippedInfoSchemaQueries {
 		t.Run(tt.Query, func(t *testing.T) {
 			t.Skip()
@@ -635,6 +661,30 @@ func TestColumnAliases(t *testing.T, harness Harness) {
 	}
 }
 
+func TestDualQueries(t *testing.T, harness Harness) {
+	for _, tt := range queries.DualQueries {
+		TestScript(t, harness, tt)
+	}
+}
+
+func TestValuesStatements(t *testing.T, harness Harness) {
+	for _, tt := range queries.ValuesStatementScripts {
+		TestScript(t, harness, tt)
+	}
+}
+
+func TestTypeAggregation(t *testing.T, harness Harness) {
+	for _, tt := range queries.TypeAggregationScripts {
+		TestScript(t, harness, tt)
+	}
+}
+
+func TestTableSample(t *testing.T, harness Harness) {
+	for _, tt := range queries.TableSampleScripts {
+		TestScript(t, harness, tt)
+	}
+}
+
 func TestDerivedTableOuterScopeVisibility(t *testing.T, harness Harness) {
 	for _, tt := range queries.DerivedTableOuterScopeVisibilityQueries {
 		TestScript(t, harness, tt)
@@ -1534,6 +1584,27 @@ func TestComplexIndexQueries(t *testing.T, harness Harness) {
 	}
 }
 
+func TestSequences(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.SequenceScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestTableMaintenance(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.TableMaintenanceScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStatusVariables(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.StatusVariableScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 func TestTriggers(t *testing.T, harness Harness) {
 	harness.Setup(setup.MydbData, setup.FooData)
 	for _, script := range queries.TriggerTests {
@@ -1856,20 +1927,20 @@ func TestStoredProcedures(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "CREATE PROCEDURE mydb.p2() SELECT 6", []sql.Row{{types.OkResult{}}}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p1", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p1", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "DROP PROCEDURE mydb.p1", []sql.Row{}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil)
 	})
@@ -2417,7 +2488,7 @@ func TestRenameColumn(t *testing.T, harness Harness) {
 	t.Run("rename column preserves table checks", func(t *testing.T) {
 		RunQuery(t, e, harness, "ALTER TABLE mytable ADD CONSTRAINT test_check CHECK (i2 < 12345)")
 
-		AssertErr(t, e, harness, "ALTER TABLE mytable RENAME COLUMN i2 TO i3", sql.ErrCheckConstraintInvalidatedByColumnAlter)
+		RunQuery(t, e, harness, "ALTER TABLE mytable RENAME COLUMN i2 TO i3")
 
 		RunQuery(t, e, harness, "ALTER TABLE mytable RENAME COLUMN s2 TO s3")
 		tbl, ok, err = db.GetTableInsensitive(NewContext(harness), "mytable")
@@ -2430,7 +2501,7 @@ func TestRenameColumn(t *testing.T, harness Harness) {
 		require.NoError(err)
 		require.Equal(1, len(checks))
 		require.Equal("test_check", checks[0].Name)
-		require.Equal("(i2 < 12345)", checks[0].CheckExpression)
+		require.Equal("(i3 < 12345)", checks[0].CheckExpression)
 	})
 
 	t.Run("no database selected", func(t *testing.T) {
@@ -4822,6 +4893,41 @@ func TestWarnings(t *testing.T, harness Harness) {
 	}
 }
 
+func TestStrictMode(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.StrictModeScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestExternalSort(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.ExternalSortScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestIndexConditionPushdown(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.IndexConditionPushdownScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestExplainDML(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.ExplainDMLScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestWarningScripts(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.WarningScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 func TestClearWarnings(t *testing.T, harness Harness) {
 	require := require.New(t)
 	harness.Setup(setup.Mytable...)
@@ -6502,11 +6608,11 @@ func TestTypesOverWire(t *testing.T, harness ClientHarness, sessionBuilder serve
 			_, err = conn.Exec("USE mydb;")
 			require.NoError(t, err)
 			for queryIdx, query := range script.Queries {
+				expectedRowSet := script.Results[queryIdx]
 				r, err := conn.Query(query)
 				if assert.NoError(t, err) {
 					sch, engineIter, err := engine.Query(ctx, query)
 					require.NoError(t, err)
-					expectedRowSet := script.Results[queryIdx]
 					expectedRowIdx := 0
 					var engineRow sql.Row
 					for engineRow, err = engineIter.Next(ctx); err == nil; engineRow, err = engineIter.Next(ctx) {
@@ -6558,6 +6664,43 @@ func TestTypesOverWire(t *testing.T, harness ClientHarness, sessionBuilder serve
 					assert.False(t, r.Next())
 					require.NoError(t, r.Close())
 				}
+
+				// prepared statements return their results over the binary protocol
+				if script.BinaryResults != nil {
+					expectedRowSet = script.BinaryResults[queryIdx]
+				}
+				stmt, err := conn.Prepare(query)
+				if !assert.NoError(t, err) {
+					continue
+				}
+				r, err = stmt.Query()
+				if assert.NoError(t, err) {
+					var rows []sql.Row
+					for r.Next() {
+						connRow := make([]*string, len(expectedRowSet[0]))
+						interfaceRow := make([]any, len(connRow))
+						for i := range connRow {
+							interfaceRow[i] = &connRow[i]
+						}
+						require.NoError(t, r.Scan(interfaceRow...))
+						row := make(sql.Row, len(connRow))
+						for i, val := range connRow {
+							if val != nil {
+								str := *val
+								if script.Name == "JSON" {
+									str = strings.Replace(str, `, `, `,`, -1)
+									str = strings.Replace(str, `: "`, `:"`, -1)
+								}
+								row[i] = str
+							}
+						}
+						rows = append(rows, row)
+					}
+					require.NoError(t, r.Err())
+					assert.Equal(t, expectedRowSet, rows, "binary protocol results of %s", query)
+					require.NoError(t, r.Close())
+				}
+				require.NoError(t, stmt.Close())
 			}
 			require.NoError(t, conn.Close())
 		})
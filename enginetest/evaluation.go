@@ -356,8 +356,8 @@ func injectBindVarsAndPrepare(
 	_, isInsert := parsed.(*plan.InsertInto)
 	_, isDatabaser := parsed.(sql.Databaser)
 
-	// *ast.MultiAlterDDL parses arbitrary nodes in a *plan.Block
-	if bl, ok := parsed.(*plan.Block); ok {
+	// *ast.MultiAlterDDL parses arbitrary nodes in a *plan.AlterTable
+	if bl, ok := parsed.(*plan.AlterTable); ok {
 		for _, n := range bl.Children() {
 			if _, ok := n.(*plan.InsertInto); ok {
 				isInsert = true
@@ -149,6 +149,44 @@ var joinOpTests = []struct {
 			},
 		},
 	},
+	{
+		name: "null-safe equality",
+		setup: [][]string{
+			setup.MydbData[0],
+			{
+				"create table xy (x int primary key, y int, key(y))",
+				"create table uv (u int primary key, v int, key(v))",
+				"insert into xy values (0,0),(1,1),(2,null),(3,null),(4,4)",
+				"insert into uv values (0,0),(1,null),(2,2),(3,null),(4,1)",
+			},
+		},
+		tests: []JoinOpTests{
+			{
+				Query:    "select x, u from xy join uv on y <=> v order by 1,2",
+				Expected: []sql.Row{{0, 0}, {1, 4}, {2, 1}, {2, 3}, {3, 1}, {3, 3}},
+			},
+			{
+				Query:    "select x, u from xy join uv on y = v order by 1,2",
+				Expected: []sql.Row{{0, 0}, {1, 4}},
+			},
+			{
+				Query:    "select x, u from xy join uv on y <=> v and x <=> u order by 1,2",
+				Expected: []sql.Row{{0, 0}, {3, 3}},
+			},
+			{
+				Query:    "select x, u from xy left join uv on y <=> v order by 1,2",
+				Expected: []sql.Row{{0, 0}, {1, 4}, {2, 1}, {2, 3}, {3, 1}, {3, 3}, {4, nil}},
+			},
+			{
+				Query:    "select x, u from xy left join uv on y = v order by 1,2",
+				Expected: []sql.Row{{0, 0}, {1, 4}, {2, nil}, {3, nil}, {4, nil}},
+			},
+			{
+				Query:    "select x, u from xy right join uv on y <=> v order by 2,1",
+				Expected: []sql.Row{{0, 0}, {2, 1}, {3, 1}, {nil, 2}, {2, 3}, {3, 3}, {1, 4}},
+			},
+		},
+	},
 	{
 		name: "point lookups",
 		setup: [][]string{
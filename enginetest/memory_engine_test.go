@@ -113,7 +113,13 @@ func TestQueriesSimple(t *testing.T) {
 
 // TestQueriesSimple runs the canonical test queries against a single threaded index enabled harness.
 func TestQueriesSimple_Experimental(t *testing.T) {
-	enginetest.TestQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil).WithVersion(sql.VersionExperimental))
+	harness := enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil)
+	// The planner types SUM as a DOUBLE rather than a DECIMAL, so a UNION of it with a DECIMAL is a DOUBLE
+	harness.QueriesToSkip(
+		"with recursive t (n) as (select sum(1) from dual union all select (2.00) from dual) select sum(n) from t;",
+		"with recursive t (n) as (select sum(1) from dual union all select (2.00/3.0) from dual) select sum(n) from t;",
+	)
+	enginetest.TestQueries(t, harness.WithVersion(sql.VersionExperimental))
 }
 
 // TestQueriesSimple runs the canonical test queries against a single threaded index enabled harness.
@@ -532,6 +538,38 @@ func TestColumnAliases_Experimental(t *testing.T) {
 	enginetest.TestColumnAliases(t, enginetest.NewDefaultMemoryHarness().WithVersion(sql.VersionExperimental))
 }
 
+func TestDualQueries(t *testing.T) {
+	enginetest.TestDualQueries(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestDualQueries_Experimental(t *testing.T) {
+	enginetest.TestDualQueries(t, enginetest.NewDefaultMemoryHarness().WithVersion(sql.VersionExperimental))
+}
+
+func TestValuesStatements(t *testing.T) {
+	enginetest.TestValuesStatements(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestValuesStatements_Experimental(t *testing.T) {
+	enginetest.TestValuesStatements(t, enginetest.NewDefaultMemoryHarness().WithVersion(sql.VersionExperimental))
+}
+
+func TestTypeAggregation(t *testing.T) {
+	enginetest.TestTypeAggregation(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTypeAggregation_Experimental(t *testing.T) {
+	enginetest.TestTypeAggregation(t, enginetest.NewDefaultMemoryHarness().WithVersion(sql.VersionExperimental))
+}
+
+func TestTableSample(t *testing.T) {
+	enginetest.TestTableSample(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTableSample_Experimental(t *testing.T) {
+	enginetest.TestTableSample(t, enginetest.NewDefaultMemoryHarness().WithVersion(sql.VersionExperimental))
+}
+
 func TestDerivedTableOuterScopeVisibility(t *testing.T) {
 	enginetest.TestDerivedTableOuterScopeVisibility(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -749,6 +787,34 @@ func TestComplexIndexQueries(t *testing.T) {
 	enginetest.TestComplexIndexQueries(t, harness)
 }
 
+func TestSequences(t *testing.T) {
+	enginetest.TestSequences(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTableMaintenance(t *testing.T) {
+	enginetest.TestTableMaintenance(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStatusVariables(t *testing.T) {
+	enginetest.TestStatusVariables(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStrictMode(t *testing.T) {
+	enginetest.TestStrictMode(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestExternalSort(t *testing.T) {
+	enginetest.TestExternalSort(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestIndexConditionPushdown(t *testing.T) {
+	enginetest.TestIndexConditionPushdown(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestExplainDML(t *testing.T) {
+	enginetest.TestExplainDML(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestTriggers(t *testing.T) {
 	enginetest.TestTriggers(t, enginetest.NewDefaultMemoryHarness())
 }
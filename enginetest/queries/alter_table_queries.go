@@ -44,6 +44,200 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "alter table with ALGORITHM and LOCK clauses",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, key ka (a));",
+			"insert into t values (1, 10), (2, 20);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t add column b int default 5, algorithm=instant, lock=none;",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:       "alter table t add column b int default 5, algorithm=instant, lock=shared;",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:    "alter table t add column b int default 5, algorithm=instant, lock=default;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t algorithm=inplace, rename column b to c;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "alter table t add index kc (c), algorithm=instant;",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:       "alter table t add index kc (c), algorithm=copy, lock=none;",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:       "alter table t drop index ka, add index kc (c), algorithm=instant;",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:    "alter table t add index kc (c), algorithm=inplace, lock=shared;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `a` int,\n  `c` int DEFAULT '5',\n  PRIMARY KEY (`pk`),\n  KEY `ka` (`a`),\n  KEY `kc` (`c`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:       "alter table t add column d int, algorithm=fast;",
+				ExpectedErr: sql.ErrUnknownAlterAlgorithm,
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 10, 5}, {2, 20, 5}},
+			},
+		},
+	},
+	{
+		Name: "alter table with ALGORITHM=COPY copies the table",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, key ka (a) comment 'index a');",
+			"insert into t values (1, 10), (2, 20);",
+			"create table c (pk int primary key, a int, constraint chk_a check (a > 0));",
+			"create table ai (id int primary key auto_increment, v int);",
+			"insert into ai (v) values (1), (2);",
+			"create table p (pk int primary key);",
+			"create table f (pk int primary key, p int, foreign key (p) references p (pk));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column b int default 5, algorithm=copy;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t algorithm=copy, lock=exclusive, modify column a bigint, drop column b;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `a` bigint,\n  PRIMARY KEY (`pk`),\n  KEY `ka` (`a`) COMMENT 'index a'\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 10}, {2, 20}},
+			},
+			{
+				Query:    "select count(*) from information_schema.tables where table_name like '#sql%';",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "alter table c add column b int, algorithm=copy;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table c rename column a to a2, algorithm=copy;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "show create table c;",
+				Expected: []sql.Row{{"c", "CREATE TABLE `c` (\n  `pk` int NOT NULL,\n  `a2` int,\n  `b` int,\n  PRIMARY KEY (`pk`),\n  CONSTRAINT `chk_a` CHECK ((`a2` > 0))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:       "insert into c values (1, 0, 0);",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "alter table ai auto_increment = 50;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "alter table ai add column w int, algorithm=copy;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into ai (v) values (3);",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 50}}},
+			},
+			{
+				// an ALTER TABLE with only the ALGORITHM clause rebuilds the table
+				Query:    "alter table ai algorithm=copy;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from ai order by id;",
+				Expected: []sql.Row{{1, 1, nil}, {2, 2, nil}, {50, 3, nil}},
+			},
+			{
+				Query:    "insert into ai (v) values (4);",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 51}}},
+			},
+			{
+				// the engine can't copy foreign keys, so tables with them must be altered by the tables themselves
+				Query:       "alter table f add column b int, algorithm=copy;",
+				ExpectedErr: sql.ErrCopyAlterNotSupported,
+			},
+			{
+				Query:       "alter table p add column b int, algorithm=copy;",
+				ExpectedErr: sql.ErrCopyAlterNotSupported,
+			},
+			{
+				Query:    "alter table f add column b int;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from information_schema.tables where table_name like '#sql%';",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "multi-clause alter table is rolled back when a clause fails",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, key ka (a));",
+			"insert into t values (1, 1000, 1), (2, 2, 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t drop column b, modify column a tinyint;",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:       "alter table t add column c int default 3, drop index ka, add unique index ub (b);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:       "alter table t add column c int default 3, modify column a tinyint, algorithm=copy;",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:       "alter table t add column c int default 3, drop index ka, add unique index ub (b), algorithm=copy;",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select count(*) from information_schema.tables where table_name like '#sql%';",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `a` int,\n  `b` int,\n  PRIMARY KEY (`pk`),\n  KEY `ka` (`a`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 1000, 1}, {2, 2, 1}},
+			},
+			{
+				Query:    "alter table t add column c int default 3, drop index ka, add unique index ua (a);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `a` int,\n  `b` int,\n  `c` int DEFAULT '3',\n  PRIMARY KEY (`pk`),\n  UNIQUE KEY `ua` (`a`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 1000, 1, 3}, {2, 2, 1, 3}},
+			},
+		},
+	},
 	{
 		// https://github.com/dolthub/dolt/issues/6206
 		Name: "alter table containing column default value expressions",
@@ -92,6 +286,135 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "rename column updates defaults, check constraints and foreign keys",
+		SetUpScript: []string{
+			"create table p1 (id int primary key, b int, c int default (b * 10), constraint b_check check (b > 0), key kb (b));",
+			"create table c1 (id int primary key, pb int, foreign key (pb) references p1 (b));",
+			"insert into p1 (id, b) values (1, 1), (2, 2);",
+			"insert into c1 values (1, 1);",
+			"alter table p1 rename column b to bb;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table p1",
+				Expected: []sql.Row{{"p1", "CREATE TABLE `p1` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `bb` int,\n" +
+					"  `c` int DEFAULT ((bb * 10)),\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  KEY `kb` (`bb`),\n" +
+					"  CONSTRAINT `b_check` CHECK ((`bb` > 0))\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "insert into p1 (id, bb) values (3, 3);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "insert into p1 (id, bb) values (4, -1);",
+				ExpectedErrStr: "Check constraint \"b_check\" violated",
+			},
+			{
+				Query:    "select * from p1 order by id;",
+				Expected: []sql.Row{{1, 1, 10}, {2, 2, 20}, {3, 3, 30}},
+			},
+			{
+				Query:       "insert into c1 values (2, 5);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "alter table p1 change column bb b2 int after c;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from p1 where b2 = 2;",
+				Expected: []sql.Row{{2, 20, 2}},
+			},
+			{
+				Query:    "insert into p1 (id, b2) values (4, 4);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "insert into p1 (id, b2) values (5, 0);",
+				ExpectedErrStr: "Check constraint \"b_check\" violated",
+			},
+			{
+				Query:    "select * from p1 order by id;",
+				Expected: []sql.Row{{1, 10, 1}, {2, 20, 2}, {3, 30, 3}, {4, 40, 4}},
+			},
+		},
+	},
+	{
+		Name: "rename index",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, key ka (a), unique key kb (b));",
+			"insert into t values (1, 10, 100), (2, 20, 200);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t rename index ka to ka2;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t rename key KB to kb2;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select index_name, column_name from information_schema.statistics where table_name = 't' order by index_name;",
+				Expected: []sql.Row{{"ka2", "a"}, {"kb2", "b"}, {"PRIMARY", "pk"}},
+			},
+			{
+				Query:       "alter table t rename index ka to ka3;",
+				ExpectedErr: sql.ErrKeyDoesNotExist,
+			},
+			{
+				Query:       "alter table t rename index ka2 to kb2;",
+				ExpectedErr: sql.ErrDuplicateKeyName,
+			},
+			{
+				Query:       "insert into t values (3, 30, 200);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select pk from t where a = 20;",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "modify column reorders columns with FIRST and AFTER",
+		SetUpScript: []string{
+			"create table t (a int, b int auto_increment, c varchar(10), d int default (b + 100), primary key (b), unique key (c));",
+			"insert into t (a, c) values (1, 'x'), (2, 'y');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t modify column b int auto_increment first;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t change column c cc varchar(10) after b;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t (a, cc) values (3, 'z');",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 3}}},
+			},
+			{
+				Query:       "insert into t (a, cc) values (4, 'z');",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select * from t order by b;",
+				Expected: []sql.Row{{1, "x", 1, 101}, {2, "y", 2, 102}, {3, "z", 3, 103}},
+			},
+			{
+				Query:    "select b, d from t where cc = 'y';",
+				Expected: []sql.Row{{2, 102}},
+			},
+		},
+	},
 	{
 		Name: "drop column drops all relevant check constraints",
 		SetUpScript: []string{
@@ -332,4 +655,56 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "alter table options and comments",
+		SetUpScript: []string{
+			"CREATE TABLE t41 (pk int PRIMARY KEY COMMENT 'the key', val int) ROW_FORMAT=DYNAMIC COMMENT 'first'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER TABLE t41 COMMENT = 'it''s second', ENGINE=innodb, STATS_PERSISTENT=1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t41",
+				Expected: []sql.Row{{"t41", "CREATE TABLE `t41` (\n  `pk` int NOT NULL COMMENT 'the key',\n  `val` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_PERSISTENT=1 ROW_FORMAT=DYNAMIC COMMENT='it''s second'"}},
+			},
+			{
+				Query:    "SELECT table_comment FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name = 't41'",
+				Expected: []sql.Row{{"it's second"}},
+			},
+			{
+				Query:    "ALTER TABLE t41 ADD COLUMN u int COMMENT 'unique column', ADD UNIQUE INDEX u (u) COMMENT 'unique index'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE t41 ALGORITHM=COPY, MODIFY COLUMN val bigint",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT column_name, column_comment FROM information_schema.columns WHERE table_schema = 'mydb' AND table_name = 't41' ORDER BY ordinal_position",
+				Expected: []sql.Row{{"pk", "the key"}, {"val", ""}, {"u", "unique column"}},
+			},
+			{
+				Query:    "SELECT index_name, index_comment FROM information_schema.statistics WHERE table_schema = 'mydb' AND table_name = 't41' ORDER BY index_name",
+				Expected: []sql.Row{{"PRIMARY", ""}, {"u", "unique index"}},
+			},
+			{
+				Query:    "SELECT table_comment FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name = 't41'",
+				Expected: []sql.Row{{"it's second"}},
+			},
+			{
+				Query:    "ALTER TABLE t41 COMMENT '', STATS_PERSISTENT=DEFAULT",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t41",
+				Expected: []sql.Row{{"t41", "CREATE TABLE `t41` (\n  `pk` int NOT NULL COMMENT 'the key',\n  `val` bigint,\n  `u` int COMMENT 'unique column',\n  PRIMARY KEY (`pk`),\n  UNIQUE KEY `u` (`u`) COMMENT 'unique index'\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_PERSISTENT=DEFAULT ROW_FORMAT=DYNAMIC"}},
+			},
+			{
+				Query:       "ALTER TABLE t41 COMMENT 'third', ADD COLUMN v int",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+		},
+	},
 }
@@ -1359,7 +1359,10 @@ var DatabaseCollationWireTests = []CharsetCollationWireTest{
 T.TABLE_SCHEMA AS 'database', T.TABLE_CATALOG AS 'catalog',
 0 AS isView FROM INFORMATION_SCHEMA.TABLES AS T WHERE T.TABLE_CATALOG = 'def' AND
                                                       UPPER(T.TABLE_TYPE) = 'BASE TABLE' ORDER BY T.TABLE_NAME;`,
-				Expected: []sql.Row(nil),
+				Expected: []sql.Row{
+					{"session_account_connect_attrs", "connection.table", "performance_schema", "performance_schema", "def", "0"},
+					{"session_connect_attrs", "connection.table", "performance_schema", "performance_schema", "def", "0"},
+				},
 			},
 		},
 	},
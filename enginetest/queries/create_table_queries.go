@@ -246,6 +246,97 @@ var CreateTableScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "generated invisible primary keys",
+		SetUpScript: []string{
+			"SET sql_generate_invisible_primary_key = 1",
+			"CREATE TABLE t1 (a int, b int DEFAULT (a + 1))",
+			"INSERT INTO t1 VALUES (1, 10), (2, 20)",
+			"INSERT INTO t1 (a) VALUES (3)",
+			"CREATE TABLE t2 (pk int PRIMARY KEY)",
+			"CREATE TABLE t3 AS SELECT a FROM t1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t1 ORDER BY a",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {3, 4}},
+			},
+			{
+				Query:    "SELECT my_row_id, a FROM t1 ORDER BY a",
+				Expected: []sql.Row{{uint64(1), 1}, {uint64(2), 2}, {uint64(3), 3}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t1",
+				Expected: []sql.Row{{"t1", "CREATE TABLE `t1` (\n  `my_row_id` bigint unsigned NOT NULL AUTO_INCREMENT /*!80023 INVISIBLE */,\n  `a` int,\n  `b` int DEFAULT ((a + 1)),\n  PRIMARY KEY (`my_row_id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SELECT my_row_id, a FROM t3 ORDER BY a",
+				Expected: []sql.Row{{uint64(1), 1}, {uint64(2), 2}, {uint64(3), 3}},
+			},
+			{
+				Query:    "SELECT column_name, column_key, extra FROM information_schema.columns WHERE table_name = 't1' ORDER BY ordinal_position",
+				Expected: []sql.Row{{"my_row_id", "PRI", "auto_increment INVISIBLE"}, {"a", "", ""}, {"b", "", "DEFAULT_GENERATED"}},
+			},
+			{
+				Query:       "CREATE TABLE t4 (my_row_id int)",
+				ExpectedErr: sql.ErrGeneratedInvisiblePrimaryKeyColumnExists,
+			},
+			{
+				Query:       "CREATE TABLE t4 (id int AUTO_INCREMENT, KEY (id))",
+				ExpectedErr: sql.ErrGeneratedInvisiblePrimaryKeyAutoIncrementExists,
+			},
+			{
+				Query:    "SET show_gipk_in_create_table_and_information_schema = 0",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t1",
+				Expected: []sql.Row{{"t1", "CREATE TABLE `t1` (\n  `a` int,\n  `b` int DEFAULT ((a + 1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SELECT column_name FROM information_schema.columns WHERE table_name = 't1' ORDER BY ordinal_position",
+				Expected: []sql.Row{{"a"}, {"b"}},
+			},
+		},
+	},
+	{
+		Name: "table options",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk int PRIMARY KEY) ENGINE=innodb, ROW_FORMAT=dynamic COMMENT 'it''s a table' SECONDARY_ENGINE=rapid, STATS_PERSISTENT=0 DEFAULT CHARSET=utf8mb4",
+			"CREATE TABLE t2 LIKE t1",
+			"CREATE TABLE t3 (pk int PRIMARY KEY) ENGINE=MyISAM AUTO_INCREMENT=10 SECONDARY_ENGINE=NULL",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE TABLE t1",
+				Expected: []sql.Row{{"t1", "CREATE TABLE `t1` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_PERSISTENT=0 ROW_FORMAT=DYNAMIC COMMENT='it''s a table' SECONDARY_ENGINE=rapid"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_PERSISTENT=0 ROW_FORMAT=DYNAMIC COMMENT='it''s a table' SECONDARY_ENGINE=rapid"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t3",
+				Expected: []sql.Row{{"t3", "CREATE TABLE `t3` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=MyISAM DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SET show_create_table_skip_secondary_engine = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t1",
+				Expected: []sql.Row{{"t1", "CREATE TABLE `t1` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_PERSISTENT=0 ROW_FORMAT=DYNAMIC COMMENT='it''s a table'"}},
+			},
+			{
+				Query:    "SELECT table_name, engine, row_format FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name LIKE 't%' ORDER BY table_name",
+				Expected: []sql.Row{{"t1", "InnoDB", "Dynamic"}, {"t2", "InnoDB", "Dynamic"}, {"t3", "MyISAM", "Dynamic"}},
+			},
+		},
+	},
 }
 
 var BrokenCreateTableQueries = []WriteQueryTest{
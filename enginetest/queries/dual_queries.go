@@ -0,0 +1,152 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DualQueries tests SELECT statements without a FROM clause, which are evaluated over the single row of DUAL.
+var DualQueries = []ScriptTest{
+	{
+		Name: "scalar subqueries without FROM",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int);",
+			"insert into xy values (1,0),(2,1),(3,2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select (select 1)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select (select max(x) from xy) + 1",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select exists (select * from xy where y > 1), 2 in (select x from xy)",
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				Query:    "select (select count(*) from xy where x > (select min(y) from xy where y > 0))",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select (select 1 where false)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:       "select (select x from xy)",
+				ExpectedErr: sql.ErrExpectedSingleRow,
+			},
+		},
+	},
+	{
+		Name: "aggregates and grouping without FROM",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select count(*), sum(1)",
+				Expected: []sql.Row{{1, float64(1)}},
+			},
+			{
+				Query:    "select count(*), sum(1) where false",
+				Expected: []sql.Row{{0, nil}},
+			},
+			{
+				Query:    "select 1 as x group by x",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select max(1) having max(1) > 5",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "window functions without FROM",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select row_number() over (), rank() over (order by 1), lag(1) over ()",
+				Expected: []sql.Row{{1, uint64(1), nil}},
+			},
+			{
+				Query:    "select row_number() over () where false",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select rank() over (order by 1), lag(1) over () from dual where 1 = 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select percent_rank() over w from dual where false window w as ()",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "variables without FROM",
+		SetUpScript: []string{
+			"set @a = 1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select @a + 1, @b, @@autocommit, @@session.autocommit",
+				Expected: []sql.Row{{2, nil, 1, 1}},
+			},
+			{
+				Query:    "select (select @a) + 1",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "select into variables without FROM",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int);",
+			"insert into xy values (1,0),(2,1),(3,2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select 1, (select max(x) from xy) into @a, @b",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @a, @b",
+				Expected: []sql.Row{{1, 3}},
+			},
+			{
+				Query:    "select row_number() over (), @a + @b into @c, @d",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @c, @d",
+				Expected: []sql.Row{{1, 4}},
+			},
+			{
+				Query:           "select 5 into @a where false",
+				Expected:        []sql.Row{{}},
+				ExpectedWarning: 1329,
+			},
+			{
+				Query:    "select @a",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "select 1, 2 into @a where false",
+				ExpectedErr: sql.ErrColumnNumberDoesNotMatch,
+			},
+		},
+	},
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ExplainDMLScripts test EXPLAIN and EXPLAIN ANALYZE of the statements that modify data, which show the index used to
+// find the rows to modify and, for EXPLAIN ANALYZE, the number of rows that would be modified.
+var ExplainDMLScripts = []ScriptTest{
+	{
+		Name: "EXPLAIN of INSERT, REPLACE, UPDATE and DELETE",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(20), index a (a))",
+			"insert into t values (1, 1, 'one'), (2, 1, 'two'), (3, 2, 'three'), (4, 3, 'four')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "explain update t set b = 'x' where a = 1",
+				Expected: []sql.Row{
+					{"Update"},
+					{" └─ UpdateSource(SET t.b = 'x')"},
+					{"     └─ IndexedTableAccess(t)"},
+					{"         ├─ index: [t.a]"},
+					{"         └─ filters: [{[1, 1]}]"},
+				},
+			},
+			{
+				Query: "explain delete from t where a > 1",
+				Expected: []sql.Row{
+					{"Delete"},
+					{" └─ IndexedTableAccess(t)"},
+					{"     ├─ index: [t.a]"},
+					{"     └─ filters: [{(1, ∞)}]"},
+				},
+			},
+			{
+				// the row whose value is already 'two' isn't counted
+				Query: "explain analyze update t set b = 'two' where a = 1",
+				Expected: []sql.Row{
+					{"Update (rows=1)"},
+					{" └─ UpdateSource(SET t.b = 'two')"},
+					{"     └─ IndexedTableAccess(t)"},
+					{"         ├─ index: [t.a]"},
+					{"         └─ filters: [{[1, 1]}]"},
+				},
+			},
+			{
+				Query: "explain analyze delete from t where a > 1",
+				Expected: []sql.Row{
+					{"Delete (rows=2)"},
+					{" └─ IndexedTableAccess(t)"},
+					{"     ├─ index: [t.a]"},
+					{"     └─ filters: [{(1, ∞)}]"},
+				},
+			},
+			{
+				Query: "describe analyze replace into t select pk, a + 1, b from t where a = 1",
+				Expected: []sql.Row{
+					{"Replace(pk, a, b) (rows=2)"},
+					{" ├─ Table"},
+					{" │   └─ name: t"},
+					{" └─ Project"},
+					{"     ├─ columns: [pk, a, b]"},
+					{"     └─ Project"},
+					{"         ├─ columns: [t.pk, (t.a + 1), t.b]"},
+					{"         └─ IndexedTableAccess(t)"},
+					{"             ├─ index: [t.a]"},
+					{"             ├─ filters: [{[1, 1]}]"},
+					{"             └─ columns: [pk a b]"},
+				},
+			},
+			{
+				Query: "explain analyze insert into t values (5, 4, 'five'), (6, 4, 'six')",
+				Expected: []sql.Row{
+					{"Insert(pk, a, b) (rows=2)"},
+					{" ├─ Table"},
+					{" │   └─ name: t"},
+					{" └─ Project"},
+					{"     ├─ columns: [pk, a, b]"},
+					{"     └─ Values((5),(4),('five'),"},
+					{"        (6),(4),('six'))"},
+				},
+			},
+			{
+				// EXPLAIN ANALYZE doesn't modify any rows
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, "one"}, {2, 1, "two"}, {3, 2, "three"}, {4, 3, "four"}},
+			},
+		},
+	},
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// externalSortRows is the number of rows in the table of ExternalSortScripts, which don't fit in the smallest
+// sort_buffer_size.
+const externalSortRows = 1000
+
+var externalSortSetUp = []string{
+	"create table digits (i int primary key)",
+	"insert into digits values (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+	"create table t (pk int primary key, v int, s varchar(100))",
+	`insert into t
+		select a.i * 100 + b.i * 10 + c.i + 1, (a.i * 100 + b.i * 10 + c.i + 1) % 7, concat('row ', repeat('x', 50))
+		from digits a, digits b, digits c`,
+}
+
+// ExternalSortScripts test sorts and groupings whose rows don't fit in the memory budget of the session.
+var ExternalSortScripts = []ScriptTest{
+	{
+		Name:        "ORDER BY spills to disk over sort_buffer_size",
+		SetUpScript: append([]string{"set sort_buffer_size = 32768"}, externalSortSetUp...),
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk, v from t order by v desc, pk",
+				Expected: externalSortExpectedRows(),
+			},
+			{
+				Query:    "select count(*) from (select pk from t order by s, v) sq",
+				Expected: []sql.Row{{externalSortRows}},
+			},
+		},
+	},
+	{
+		Name:        "GROUP BY sorts the groups over tmp_table_size",
+		SetUpScript: append([]string{"set sort_buffer_size = 32768", "set tmp_table_size = 1024"}, externalSortSetUp...),
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk % 100 as g, count(*), min(pk), max(pk) from t group by g order by g",
+				Expected: externalSortExpectedGroups(),
+			},
+			{
+				Query:    "select count(*), sum(c) from (select v, s, count(*) as c from t group by pk, v, s) sq",
+				Expected: []sql.Row{{externalSortRows, float64(externalSortRows)}},
+			},
+		},
+	},
+}
+
+// externalSortExpectedRows returns the rows of the table of ExternalSortScripts ordered by v descending and pk.
+func externalSortExpectedRows() []sql.Row {
+	var rows []sql.Row
+	for v := 6; v >= 0; v-- {
+		for pk := 1; pk <= externalSortRows; pk++ {
+			if pk%7 == v {
+				rows = append(rows, sql.Row{int32(pk), int32(v)})
+			}
+		}
+	}
+	return rows
+}
+
+// externalSortExpectedGroups returns the rows of the table of ExternalSortScripts grouped by pk % 100.
+func externalSortExpectedGroups() []sql.Row {
+	var rows []sql.Row
+	for g := 0; g < 100; g++ {
+		minPk, maxPk := g, 900+g
+		if g == 0 {
+			minPk, maxPk = 100, externalSortRows
+		}
+		rows = append(rows, sql.Row{strconv.Itoa(g), externalSortRows / 100, int32(minPk), int32(maxPk)})
+	}
+	return rows
+}
@@ -345,6 +345,32 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "DROP TABLE names the foreign key and table referencing it",
+		SetUpScript: []string{
+			"CREATE TABLE customers (id INT PRIMARY KEY);",
+			"CREATE TABLE orders (id INT PRIMARY KEY, customer_id INT, CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id));",
+			"CREATE TABLE tree (id INT PRIMARY KEY, parent_id INT, FOREIGN KEY (parent_id) REFERENCES tree (id));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "DROP TABLE customers;",
+				ExpectedErrStr: "cannot drop table `customers` as it is referenced in foreign key `fk_customer` on table `orders`",
+			},
+			{
+				Query:    "DROP TABLE customers, orders;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "DROP TABLE tree;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW TABLES LIKE '%o%';",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	{
 		Name: "Indexes used by foreign keys can't be dropped",
 		SetUpScript: []string{
@@ -1247,7 +1273,7 @@ var ForeignKeyTests = []ScriptTest{
 			},
 			{
 				Query:       "INSERT INTO delayed_child VALUES (2, 3);",
-				ExpectedErr: sql.ErrForeignKeyNotResolved,
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
 			},
 			{
 				Query:    "INSERT INTO delayed_parent VALUES (1, 2), (2, 3);",
@@ -1387,6 +1413,134 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Delayed foreign key resolution doesn't validate rows written with FOREIGN_KEY_CHECKS=0",
+		SetUpScript: []string{
+			"SET FOREIGN_KEY_CHECKS=0;",
+			"CREATE TABLE orders (pk INT PRIMARY KEY, customer INT, CONSTRAINT fk_customer FOREIGN KEY (customer) REFERENCES customers(pk));",
+			"INSERT INTO orders VALUES (1, 10), (2, 99);",
+			"CREATE TABLE customers (pk INT PRIMARY KEY);",
+			"INSERT INTO customers VALUES (10), (20);",
+			"SET FOREIGN_KEY_CHECKS=1;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT constraint_name, unique_constraint_name, table_name, referenced_table_name FROM information_schema.referential_constraints WHERE table_name = 'orders';",
+				Expected: []sql.Row{{"fk_customer", "PRIMARY", "orders", "customers"}},
+			},
+			{
+				Query:    "INSERT INTO orders VALUES (3, 20);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO orders VALUES (4, 30);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "SELECT * FROM orders ORDER BY pk;",
+				Expected: []sql.Row{{1, 10}, {2, 99}, {3, 20}},
+			},
+		},
+	},
+	{
+		Name: "ALTER TABLE ADD FOREIGN KEY for a missing table with FOREIGN_KEY_CHECKS=0",
+		SetUpScript: []string{
+			"CREATE TABLE orders (pk INT PRIMARY KEY, customer INT);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "ALTER TABLE orders ADD CONSTRAINT fk_customer FOREIGN KEY (customer) REFERENCES customers(pk);",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "SET FOREIGN_KEY_CHECKS=0;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "ALTER TABLE orders ADD CONSTRAINT fk_customer FOREIGN KEY (customer) REFERENCES customers(pk);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT constraint_name, unique_constraint_name, referenced_table_name FROM information_schema.referential_constraints WHERE table_name = 'orders';",
+				Expected: []sql.Row{{"fk_customer", nil, "customers"}},
+			},
+			{
+				Query:    "INSERT INTO orders VALUES (1, 10);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SET FOREIGN_KEY_CHECKS=1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "INSERT INTO orders VALUES (2, 10);",
+				ExpectedErr: sql.ErrForeignKeyNotResolved,
+			},
+			{
+				Query:    "CREATE TABLE customers (pk INT PRIMARY KEY);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "INSERT INTO orders VALUES (2, 10);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "INSERT INTO customers VALUES (10);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO orders VALUES (2, 10);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
+	{
+		Name: "Foreign keys referencing a table dropped with FOREIGN_KEY_CHECKS=0 are resolved against its replacement",
+		SetUpScript: []string{
+			"CREATE TABLE customers (pk INT PRIMARY KEY);",
+			"CREATE TABLE orders (pk INT PRIMARY KEY, customer INT, CONSTRAINT fk_customer FOREIGN KEY (customer) REFERENCES customers(pk));",
+			"INSERT INTO customers VALUES (10);",
+			"INSERT INTO orders VALUES (1, 10);",
+			"SET FOREIGN_KEY_CHECKS=0;",
+			"DROP TABLE customers;",
+			"CREATE TABLE customers (pk VARCHAR(10) PRIMARY KEY);",
+			"SET FOREIGN_KEY_CHECKS=1;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO orders VALUES (2, 10);",
+				ExpectedErr: sql.ErrForeignKeyNotResolved,
+			},
+			{
+				Query:    "SET FOREIGN_KEY_CHECKS=0;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "DROP TABLE customers;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CREATE TABLE customers (pk INT PRIMARY KEY);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SET FOREIGN_KEY_CHECKS=1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "INSERT INTO orders VALUES (2, 10);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "INSERT INTO customers VALUES (10);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO orders VALUES (2, 10);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
 	{
 		Name: "ALTER TABLE ADD CONSTRAINT for different database",
 		SetUpScript: []string{
@@ -1409,6 +1563,52 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "foreign keys across databases",
+		SetUpScript: []string{
+			"CREATE DATABASE db2;",
+			"CREATE TABLE dept (id INT PRIMARY KEY, v VARCHAR(10));",
+			"CREATE TABLE db2.dept (id INT PRIMARY KEY);",
+			"CREATE TABLE db2.emp (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_emp FOREIGN KEY (pid) REFERENCES mydb.dept (id) ON DELETE CASCADE);",
+			"CREATE TABLE db2.proj (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_proj FOREIGN KEY (pid) REFERENCES dept (id));",
+			"INSERT INTO dept VALUES (1, 'one'), (2, 'two');",
+			"INSERT INTO db2.dept VALUES (3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT constraint_name, unique_constraint_schema, referenced_table_name FROM information_schema.referential_constraints WHERE constraint_schema = 'db2' ORDER BY 1;",
+				Expected: []sql.Row{{"fk_emp", "mydb", "dept"}, {"fk_proj", "db2", "dept"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE db2.emp;",
+				Expected: []sql.Row{{"emp", "CREATE TABLE `emp` (\n  `id` int NOT NULL,\n  `pid` int,\n  PRIMARY KEY (`id`),\n  KEY `pid` (`pid`),\n  CONSTRAINT `fk_emp` FOREIGN KEY (`pid`) REFERENCES `mydb`.`dept` (`id`) ON DELETE CASCADE\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "INSERT INTO db2.emp VALUES (10, 1), (20, 2);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:       "INSERT INTO db2.emp VALUES (30, 3);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "INSERT INTO db2.proj VALUES (30, 3);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT c.id, p.v FROM db2.emp c JOIN dept p ON c.pid = p.id ORDER BY c.id;",
+				Expected: []sql.Row{{10, "one"}, {20, "two"}},
+			},
+			{
+				Query:    "DELETE FROM dept WHERE id = 1;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM db2.emp;",
+				Expected: []sql.Row{{20, 2}},
+			},
+		},
+	},
 	{
 		Name: "Creating a foreign key on a table with an unsupported type works",
 		SetUpScript: []string{
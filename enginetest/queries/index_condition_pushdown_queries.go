@@ -0,0 +1,60 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// IndexConditionPushdownScripts test predicates on the columns of an index that are evaluated during its lookups.
+var IndexConditionPushdownScripts = []ScriptTest{
+	{
+		Name: "residual predicates on index columns",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(20), c int, index ab (a, b))",
+			"insert into t values (1, 1, 'apple', 10), (2, 1, 'banana', 20), (3, 1, 'cherry', 30), (4, 2, 'apple', 40), (5, 2, 'banana', 50), (6, null, 'apple', 60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk from t where a = 1 and b like '%an%' order by pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "explain select pk from t where a = 1 and b like '%an%'",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.pk]"},
+					{" └─ IndexedTableAccess(t)"},
+					{"     ├─ index: [t.a,t.b]"},
+					{"     ├─ filters: [{[1, 1], [NULL, ∞)}]"},
+					{"     ├─ columns: [pk a b]"},
+					{"     └─ index condition: [t.b LIKE '%an%']"},
+				},
+			},
+			{
+				Query:    "select pk, c from t where a > 1 and b <> 'apple' and c > 10 order by pk",
+				Expected: []sql.Row{{5, 50}},
+			},
+			{
+				Query:    "select x.pk from t x join t y on x.pk = y.pk where x.a = 1 and x.b in ('apple', 'cherry') order by x.pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select x.pk, y.pk from t x left join t y on x.pk = y.c where x.a = 2 and x.b = concat('ban', 'ana')",
+				Expected: []sql.Row{{5, nil}},
+			},
+		},
+	},
+}
@@ -462,19 +462,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=86) OR (v1<>9)) AND (v1=87 AND v2<=45);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThanOrEqual\n" +
-			" │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   └─ 86 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[87, 87], (NULL, 45]}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[87, 87], (NULL, 45]}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1018,19 +1009,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>39) OR (v1=55)) AND (v1=67);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[67, 67], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[67, 67], [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1051,56 +1033,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((((v1<>99 AND v2 BETWEEN 12 AND 31) OR (v1<56 AND v2<>69)) OR (v1>=37 AND v2<47)) OR (v1<=98 AND v2=50)) AND (v1 BETWEEN 15 AND 47) OR (v1>55 AND v2>85)) OR (v1>86));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   │   │   │   │   │   └─ (comp_index_t0.v2:2 BETWEEN 12 (tinyint) AND 31 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │   │       ├─ LessThan\n" +
-			" │   │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   │       │   └─ 56 (tinyint)\n" +
-			" │   │   │   │   │   │       └─ NOT\n" +
-			" │   │   │   │   │   │           └─ Eq\n" +
-			" │   │   │   │   │   │               ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │   │               └─ 69 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │       │   └─ 37 (tinyint)\n" +
-			" │   │   │   │   │       └─ LessThan\n" +
-			" │   │   │   │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │           └─ 47 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ LessThanOrEqual\n" +
-			" │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       │   └─ 98 (tinyint)\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │           └─ 50 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t0.v1:1 BETWEEN 15 (tinyint) AND 47 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 55 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │           └─ 85 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[15, 47], (NULL, 69)}, {[15, 47], (69, ∞)}, {(55, 86], (85, ∞)}, {(86, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[15, 47], (NULL, 69)}, {[15, 47], (69, ∞)}, {(55, 86], (85, ∞)}, {(86, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1185,30 +1121,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1<40) OR (v1<=59)) OR (v1<99)) AND (v1>=83) OR (v1>9));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       └─ 59 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 83 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(9, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(9, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1251,12 +1167,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t0 WHERE (v1 BETWEEN 11 AND 18) AND (v1>31 AND v2 BETWEEN 38 AND 88);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t0 WHERE (v1 BETWEEN 11 AND 18) AND (v1>31 AND v2 BETWEEN 38 AND 88);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>95 AND v2>5) OR (v1>16 AND v2>=38));`,
@@ -1619,54 +1531,19 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>87 AND v2 BETWEEN 8 AND 33) OR (v1 BETWEEN 39 AND 69 AND v3<4));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 87 (tinyint)\n" +
-			" │   │   └─ (comp_index_t1.v2:2 BETWEEN 8 (tinyint) AND 33 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 39 (tinyint) AND 69 (tinyint))\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 39), [8, 33], [NULL, ∞)}, {[39, 69], [NULL, ∞), [NULL, ∞)}, {(69, 87), [8, 33], [NULL, ∞)}, {(87, ∞), [8, 33], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 39), [8, 33], [NULL, ∞)}, {[39, 69], [NULL, ∞), [NULL, ∞)}, {(69, 87), [8, 33], [NULL, ∞)}, {(87, ∞), [8, 33], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: [(((NOT((comp_index_t1.v1 = 87))) AND (comp_index_t1.v2 BETWEEN 8 AND 33)) OR ((comp_index_t1.v1 BETWEEN 39 AND 69) AND (comp_index_t1.v3 < 4)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=55 AND v2>=72 AND v3=63) AND (v1<>54 AND v2 BETWEEN 3 AND 80) OR (v1=15)) AND (v1<>50);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 55 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       │       └─ 54 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v2:2 BETWEEN 3 (tinyint) AND 80 (tinyint))\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 15 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞)}, {[55, ∞), [72, 80], [63, 63]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞)}, {[55, ∞), [72, 80], [63, 63]}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
@@ -2094,26 +1971,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>27 AND v3=10) OR (v1>=25 AND v2<26)) AND (v1>=62 AND v2<=96 AND v3>28);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 27 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 25 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[62, ∞), (NULL, 96], (28, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[62, ∞), (NULL, 26), (28, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
@@ -2233,12 +2094,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 21 AND 44 AND v2 BETWEEN 18 AND 88 AND v3=42) AND (v1>=52 AND v2>37 AND v3 BETWEEN 26 AND 91);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 21 AND 44 AND v2 BETWEEN 18 AND 88 AND v3=42) AND (v1>=52 AND v2>37 AND v3 BETWEEN 26 AND 91);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>29 AND v2>93 AND v3<64) OR (v1<>54 AND v2>35));`,
@@ -2458,12 +2315,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1>=17 AND v2 BETWEEN 17 AND 78 AND v3=10) AND (v1<=67) AND (v1>=81 AND v2<=88 AND v3>=70);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1>=17 AND v2 BETWEEN 17 AND 78 AND v3=10) AND (v1<=67) AND (v1>=81 AND v2<=88 AND v3>=70);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<77 AND v2<35 AND v3=73) OR (v1=85 AND v2>0 AND v3<65)) AND (v1>=20 AND v3<23 AND v2<=81) OR (v1<34 AND v2<=21 AND v3<=45));`,
@@ -2772,12 +2625,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 36 AND 67 AND v3<74 AND v2=26) AND (v1 BETWEEN 9 AND 10 AND v2=96) AND (v1<=11 AND v2<>63 AND v3>=62);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 36 AND 67 AND v3<74 AND v2=26) AND (v1 BETWEEN 9 AND 10 AND v2=96) AND (v1<=11 AND v2<>63 AND v3>=62);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 28 AND 49 AND v2<47) OR (v1>37 AND v2 BETWEEN 45 AND 61 AND v3<73));`,
@@ -2969,12 +2818,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1<64 AND v2>=90 AND v3>41) AND (v1>=14 AND v2 BETWEEN 30 AND 70 AND v3>=25);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1<64 AND v2>=90 AND v3>41) AND (v1>=14 AND v2 BETWEEN 30 AND 70 AND v3>=25);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<27 AND v2<=43) OR (v1<62 AND v2<=99)) OR (v1<>48 AND v2<29 AND v3<>69));`,
@@ -3017,12 +2862,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 55 AND 59) OR (v1<=10 AND v2>=24)) AND (v1>93 AND v3<70 AND v2 BETWEEN 44 AND 79) AND (v1>=22 AND v2=27);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 55 AND 59) OR (v1<=10 AND v2>=24)) AND (v1>93 AND v3<70 AND v2 BETWEEN 44 AND 79) AND (v1>=22 AND v2=27);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=43 AND v2<28 AND v3<>24) OR (v1<36 AND v2=14 AND v3 BETWEEN 16 AND 55));`,
@@ -3163,28 +3004,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>23 AND v3<=52) OR (v1<>19 AND v2=25));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 23 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 52 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t1.v1:1\n" +
-			" │       │       └─ 19 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 25 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞)}, {[23, 23], [25, 25], [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞)}, {[23, 23], [25, 25], [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: [(((NOT((comp_index_t1.v1 = 23))) AND (comp_index_t1.v3 <= 52)) OR ((NOT((comp_index_t1.v1 = 19))) AND (comp_index_t1.v2 = 25)))]\n" +
 			"",
 	},
 	{
@@ -3766,12 +3590,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1>=19 AND v2<2) AND (v1<4 AND v3>23 AND v2<>53);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1>=19 AND v2<2) AND (v1<4 AND v3>23 AND v2<>53);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 34 AND 40) OR (v1<=80 AND v2<>53)) AND (v1=81 AND v2=17 AND v3<>12);`,
@@ -3829,12 +3649,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=48) OR (v1<38 AND v2>=26)) AND (v1<=45 AND v2>21) AND (v1=83 AND v2=20);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE ((v1<=48) OR (v1<38 AND v2>=26)) AND (v1<=45 AND v2>21) AND (v1=83 AND v2=20);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>25) OR (v1<53));`,
@@ -3861,12 +3677,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1>4) AND (v1=3 AND v2 BETWEEN 4 AND 34 AND v3<=40);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1>4) AND (v1=3 AND v2 BETWEEN 4 AND 34 AND v3<=40);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>36 AND v2>82) OR (v1 BETWEEN 22 AND 59));`,
@@ -3886,25 +3698,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<1 AND v3<=34) OR (v1 BETWEEN 2 AND 57 AND v2<>70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 1 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 34 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 2 (tinyint) AND 57 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[2, 57], (NULL, 70), [NULL, ∞)}, {[2, 57], (70, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[2, 57], (NULL, 70), [NULL, ∞)}, {[2, 57], (70, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: [(((comp_index_t1.v1 < 1) AND (comp_index_t1.v3 <= 34)) OR ((comp_index_t1.v1 BETWEEN 2 AND 57) AND (NOT((comp_index_t1.v2 = 70)))))]\n" +
 			"",
 	},
 	{
@@ -4170,12 +3968,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (v1=99 AND v2<=41 AND v3>=61) AND (v1=34 AND v2>68 AND v3<=42);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t1 WHERE (v1=99 AND v2<=41 AND v3>=61) AND (v1=34 AND v2>68 AND v3<=42);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=74 AND v2<=18) OR (v1>=72)) AND (v1=95 AND v2=31 AND v3 BETWEEN 5 AND 19);`,
@@ -4322,37 +4116,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=16 AND v2 BETWEEN 66 AND 94) OR (v1>70 AND v2<=3)) AND (v1<>91) OR (v1=17 AND v2>=7));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 16 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 66 (tinyint) AND 94 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThan\n" +
-			" │   │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   └─ 70 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 3 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 91 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 17 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 7 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[16, 17), [66, 94], [NULL, ∞)}, {[17, 17], [7, ∞), [NULL, ∞)}, {(17, 91), [66, 94], [NULL, ∞)}, {(70, 91), (NULL, 3], [NULL, ∞)}, {(91, ∞), (NULL, 3], [NULL, ∞)}, {(91, ∞), [66, 94], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[16, 17), [66, 94], [NULL, ∞)}, {[17, 17], [7, ∞), [NULL, ∞)}, {(17, 91), [66, 94], [NULL, ∞)}, {(70, 91), (NULL, 3], [NULL, ∞)}, {(91, ∞), (NULL, 3], [NULL, ∞)}, {(91, ∞), [66, 94], [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
@@ -4526,39 +4293,14 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=24 AND v2=62) OR (v1<=24 AND v3<>22 AND v2 BETWEEN 12 AND 25)) OR (v1 BETWEEN 48 AND 49 AND v3>=90)) AND (v1<15 AND v2<>55 AND v3=51);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 24 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 62 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 24 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v3:3\n" +
-			" │   │       │           └─ 22 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v2:2 BETWEEN 12 (tinyint) AND 25 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 48 (tinyint) AND 49 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 15), [12, 25], [51, 51]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<66 AND v2>=11 AND v3<90) OR (v1<>90)) OR (v1<=7 AND v2=52));`,
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 15), [12, 25], [51, 51]}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<66 AND v2>=11 AND v3<90) OR (v1<>90)) OR (v1<=7 AND v2=52));`,
 		ExpectedPlan: "Filter\n" +
 			" ├─ Or\n" +
 			" │   ├─ Or\n" +
@@ -5202,45 +4944,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=70) OR (v1>=38 AND v3 BETWEEN 25 AND 30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 70 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 38 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[38, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[38, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 = 70) OR ((comp_index_t2.v1 >= 38) AND (comp_index_t2.v3 BETWEEN 25 AND 30)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=33) OR (v1<=31 AND v4<>35 AND v2=38));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThanOrEqual\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 33 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 31 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v4:4\n" +
-			" │       │           └─ 35 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 38 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 <= 33) OR (((comp_index_t2.v1 <= 31) AND (NOT((comp_index_t2.v4 = 35)))) AND (comp_index_t2.v2 = 38)))]\n" +
 			"",
 	},
 	{
@@ -5261,52 +4978,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1>=91 AND v4<=47 AND v2>=43) OR (v1=75)) OR (v1<41 AND v4>=64 AND v2>83)) OR (v1 BETWEEN 72 AND 88 AND v2=48 AND v3<=10)) OR (v1<=44));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 91 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 75 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 41 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 64 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 83 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 72 (tinyint) AND 88 (tinyint))\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 48 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 75), [48, 48], (NULL, 10], [NULL, ∞)}, {[75, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, 88], [48, 48], (NULL, 10], [NULL, ∞)}, {[91, ∞), [43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 75), [48, 48], (NULL, 10], [NULL, ∞)}, {[75, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, 88], [48, 48], (NULL, 10], [NULL, ∞)}, {[91, ∞), [43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((((comp_index_t2.v1 >= 91) AND (comp_index_t2.v4 <= 47)) AND (comp_index_t2.v2 >= 43)) OR (comp_index_t2.v1 = 75)) OR (((comp_index_t2.v1 < 41) AND (comp_index_t2.v4 >= 64)) AND (comp_index_t2.v2 > 83))) OR (((comp_index_t2.v1 BETWEEN 72 AND 88) AND (comp_index_t2.v2 = 48)) AND (comp_index_t2.v3 <= 10))) OR (comp_index_t2.v1 <= 44))]\n" +
 			"",
 	},
 	{
@@ -5380,12 +5056,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=92 AND v4 BETWEEN 8 AND 90) AND (v1 BETWEEN 39 AND 42);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 90 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(comp_index_t2.v4 BETWEEN 8 AND 90)]\n" +
 			"",
 	},
 	{
@@ -5406,87 +5081,24 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>77 AND v4>82 AND v2>=96) OR (v1 BETWEEN 41 AND 80 AND v2<>21 AND v3>60));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 77 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 82 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 96 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 80 (tinyint))\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 21 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 60 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[41, 77], (21, ∞), (60, ∞), [NULL, ∞)}, {[41, 80], (NULL, 21), (60, ∞), [NULL, ∞)}, {(77, 80], (21, 96), (60, ∞), [NULL, ∞)}, {(77, ∞), [96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[41, 77], (21, ∞), (60, ∞), [NULL, ∞)}, {[41, 80], (NULL, 21), (60, ∞), [NULL, ∞)}, {(77, 80], (21, 96), (60, ∞), [NULL, ∞)}, {(77, ∞), [96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 > 77) AND (comp_index_t2.v4 > 82)) AND (comp_index_t2.v2 >= 96)) OR (((comp_index_t2.v1 BETWEEN 41 AND 80) AND (NOT((comp_index_t2.v2 = 21)))) AND (comp_index_t2.v3 > 60)))]\n" +
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1=28 AND v4 BETWEEN 44 AND 50) AND (v1>=49);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (comp_index_t2.v4:4 BETWEEN 44 (tinyint) AND 50 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1=28 AND v4 BETWEEN 44 AND 50) AND (v1>=49);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 81 AND 87 AND v3<>81 AND v4<30) AND (v1=17) OR (v1<27 AND v2<>8 AND v3>35)) OR (v1>28 AND v2<62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 81 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │           └─ 81 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 17 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 27 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 8 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 28 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 27), (NULL, 8), (35, ∞), [NULL, ∞)}, {(NULL, 27), (8, ∞), (35, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 62), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 27), (NULL, 8), (35, ∞), [NULL, ∞)}, {(NULL, 27), (8, ∞), (35, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 62), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((comp_index_t2.v1 BETWEEN 81 AND 87) AND (NOT((comp_index_t2.v3 = 81)))) AND (comp_index_t2.v4 < 30)) AND (comp_index_t2.v1 = 17)) OR (((comp_index_t2.v1 < 27) AND (NOT((comp_index_t2.v2 = 8)))) AND (comp_index_t2.v3 > 35))) OR ((comp_index_t2.v1 > 28) AND (comp_index_t2.v2 < 62)))]\n" +
 			"",
 	},
 	{
@@ -5530,27 +5142,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=27 AND v3>23) OR (v1<70 AND v2<>43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 27 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 23 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 70 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, 70), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(27, 70), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, 70), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(27, 70), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 <= 27) AND (comp_index_t2.v3 > 23)) OR ((comp_index_t2.v1 < 70) AND (NOT((comp_index_t2.v2 = 43)))))]\n" +
 			"",
 	},
 	{
@@ -5612,23 +5208,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<83 AND v4>51) OR (v1<>30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 83 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 51 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 < 83) AND (comp_index_t2.v4 > 51)) OR (NOT((comp_index_t2.v1 = 30))))]\n" +
 			"",
 	},
 	{
@@ -5649,52 +5233,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>29 AND v4=40 AND v2>=63) OR (v1<70 AND v2<70 AND v3<=20)) OR (v1 BETWEEN 7 AND 61 AND v2>=33 AND v3>78)) OR (v1>=4 AND v2<=22));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 29 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 70 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 70 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 20 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 7 (tinyint) AND 61 (tinyint))\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 33 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 78 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 4 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 4), (NULL, 70), (NULL, 20], [NULL, ∞)}, {[4, 29], (22, 70), (NULL, 20], [NULL, ∞)}, {[4, ∞), (NULL, 22], [NULL, ∞), [NULL, ∞)}, {[7, 29], [33, ∞), (78, ∞), [NULL, ∞)}, {(29, 61], [33, 63), (78, ∞), [NULL, ∞)}, {(29, 70), (22, 63), (NULL, 20], [NULL, ∞)}, {(29, ∞), [63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 4), (NULL, 70), (NULL, 20], [NULL, ∞)}, {[4, 29], (22, 70), (NULL, 20], [NULL, ∞)}, {[4, ∞), (NULL, 22], [NULL, ∞), [NULL, ∞)}, {[7, 29], [33, ∞), (78, ∞), [NULL, ∞)}, {(29, 61], [33, 63), (78, ∞), [NULL, ∞)}, {(29, 70), (22, 63), (NULL, 20], [NULL, ∞)}, {(29, ∞), [63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((comp_index_t2.v1 > 29) AND (comp_index_t2.v4 = 40)) AND (comp_index_t2.v2 >= 63)) OR (((comp_index_t2.v1 < 70) AND (comp_index_t2.v2 < 70)) AND (comp_index_t2.v3 <= 20))) OR (((comp_index_t2.v1 BETWEEN 7 AND 61) AND (comp_index_t2.v2 >= 33)) AND (comp_index_t2.v3 > 78))) OR ((comp_index_t2.v1 >= 4) AND (comp_index_t2.v2 <= 22)))]\n" +
 			"",
 	},
 	{
@@ -5755,43 +5298,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 54 AND 87 AND v2<78 AND v3<33) OR (v1<>52)) OR (v1 BETWEEN 3 AND 61 AND v4<=49)) OR (v1>3 AND v2<73 AND v3>59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 54 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 78 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │   │           └─ 52 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 3 (tinyint) AND 61 (tinyint))\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 3 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 73 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((comp_index_t2.v1 BETWEEN 54 AND 87) AND (comp_index_t2.v2 < 78)) AND (comp_index_t2.v3 < 33)) OR (NOT((comp_index_t2.v1 = 52)))) OR ((comp_index_t2.v1 BETWEEN 3 AND 61) AND (comp_index_t2.v4 <= 49))) OR (((comp_index_t2.v1 > 3) AND (comp_index_t2.v2 < 73)) AND (comp_index_t2.v3 > 59)))]\n" +
 			"",
 	},
 	{
@@ -5804,46 +5315,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=31 AND v2>44) OR (v1<44 AND v4<>6 AND v2<>10 AND v3<>14)) AND (v1=96 AND v3>25 AND v4<>32);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 31 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 44 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │           └─ 6 (tinyint)\n" +
-			" │   │   │       │   └─ NOT\n" +
-			" │   │   │       │       └─ Eq\n" +
-			" │   │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │           └─ 10 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │               └─ 14 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 25 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -6019,25 +5494,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 42 AND 54 AND v2>20) OR (v1<>68 AND v3>32));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 42 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 20 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 68 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 68), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 68), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 BETWEEN 42 AND 54) AND (comp_index_t2.v2 > 20)) OR ((NOT((comp_index_t2.v1 = 68))) AND (comp_index_t2.v3 > 32)))]\n" +
 			"",
 	},
 	{
@@ -6049,12 +5510,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>83 AND v2<>16 AND v3=22) AND (v1=34) AND (v1=79 AND v2<=45 AND v3=49);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>83 AND v2<>16 AND v3=22) AND (v1=34) AND (v1=79 AND v2<=45 AND v3=49);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=44 AND v2<=98) AND (v1>15) OR (v1<=45 AND v2=1 AND v3<>54));`,
@@ -6240,7 +5697,7 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ 11 (tinyint)\n" +
 			" └─ IndexedTableAccess(comp_index_t2)\n" +
 			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[59, 59], (NULL, 56], [NULL, ∞), [NULL, ∞)}, {[83, ∞), (NULL, 11], [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ static: [{[59, 59], (NULL, 56], (NULL, 42], (NULL, 94]}, {[59, 59], (NULL, 56], [74, ∞), [54, 54]}, {[83, ∞), (NULL, 11], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -6326,25 +5783,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>13) OR (v1<>3 AND v4<=42 AND v2 BETWEEN 89 AND 94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 13 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 3 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 42 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 89 (tinyint) AND 94 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 3), [89, 94], [NULL, ∞), [NULL, ∞)}, {(3, 13], [89, 94], [NULL, ∞), [NULL, ∞)}, {(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 3), [89, 94], [NULL, ∞), [NULL, ∞)}, {(3, 13], [89, 94], [NULL, ∞), [NULL, ∞)}, {(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 > 13) OR (((NOT((comp_index_t2.v1 = 3))) AND (comp_index_t2.v4 <= 42)) AND (comp_index_t2.v2 BETWEEN 89 AND 94)))]\n" +
 			"",
 	},
 	{
@@ -6423,31 +5866,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>61 AND v2 BETWEEN 46 AND 51) OR (v1 BETWEEN 32 AND 75 AND v4<=32)) AND (v1>97) OR (v1<97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 61 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 46 (tinyint) AND 51 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 75 (tinyint))\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 32 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 97 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 97), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), [46, 51], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 97), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), [46, 51], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((NOT((comp_index_t2.v1 = 61))) AND (comp_index_t2.v2 BETWEEN 46 AND 51)) OR ((comp_index_t2.v1 BETWEEN 32 AND 75) AND (comp_index_t2.v4 <= 32))) AND (comp_index_t2.v1 > 97)) OR (comp_index_t2.v1 < 97))]\n" +
 			"",
 	},
 	{
@@ -6590,42 +6013,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>35 AND v2<>26) OR (v1<=30 AND v2 BETWEEN 6 AND 61 AND v3<=95 AND v4>5)) AND (v1<>97) OR (v1>31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 61 (tinyint))\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 95 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 5 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 97 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 30], [6, 61], (NULL, 95], (5, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 30], [6, 61], (NULL, 95], (5, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -6728,12 +6119,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>30 AND v2 BETWEEN 20 AND 64) AND (v1<=29) AND (v1>=25 AND v2<>0);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>30 AND v2 BETWEEN 20 AND 64) AND (v1<=29) AND (v1>=25 AND v2<>0);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=89 AND v2<=1 AND v3<=7 AND v4>=4) AND (v1<=87) OR (v1 BETWEEN 10 AND 46 AND v2 BETWEEN 18 AND 76));`,
@@ -6858,30 +6245,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=64) OR (v1>21 AND v2 BETWEEN 0 AND 58)) OR (v1<15 AND v4 BETWEEN 63 AND 76 AND v2>84));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 64 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 21 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 0 (tinyint) AND 58 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 15 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v4:4 BETWEEN 63 (tinyint) AND 76 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 64], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(64, ∞), [0, 58], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 64], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(64, ∞), [0, 58], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 <= 64) OR ((comp_index_t2.v1 > 21) AND (comp_index_t2.v2 BETWEEN 0 AND 58))) OR (((comp_index_t2.v1 < 15) AND (comp_index_t2.v4 BETWEEN 63 AND 76)) AND (comp_index_t2.v2 > 84)))]\n" +
 			"",
 	},
 	{
@@ -6894,52 +6262,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>37) OR (v1<=94 AND v2 BETWEEN 53 AND 65 AND v3>=9)) OR (v1<10 AND v3<>26 AND v4<91)) OR (v1<>21 AND v2<>24 AND v3<46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 37 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 94 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 53 (tinyint) AND 65 (tinyint))\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 9 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 10 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │           └─ 26 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 91 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 21 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 24 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 24), (NULL, 46), [NULL, ∞)}, {[37, 37], (24, 53), (NULL, 46), [NULL, ∞)}, {[37, 37], [53, 65], (NULL, ∞), [NULL, ∞)}, {[37, 37], (65, ∞), (NULL, 46), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 24), (NULL, 46), [NULL, ∞)}, {[37, 37], (24, 53), (NULL, 46), [NULL, ∞)}, {[37, 37], [53, 65], (NULL, ∞), [NULL, ∞)}, {[37, 37], (65, ∞), (NULL, 46), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((NOT((comp_index_t2.v1 = 37))) OR (((comp_index_t2.v1 <= 94) AND (comp_index_t2.v2 BETWEEN 53 AND 65)) AND (comp_index_t2.v3 >= 9))) OR (((comp_index_t2.v1 < 10) AND (NOT((comp_index_t2.v3 = 26)))) AND (comp_index_t2.v4 < 91))) OR (((NOT((comp_index_t2.v1 = 21))) AND (NOT((comp_index_t2.v2 = 24)))) AND (comp_index_t2.v3 < 46)))]\n" +
 			"",
 	},
 	{
@@ -6960,34 +6287,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>91 AND v2=91 AND v3>=15) OR (v1 BETWEEN 16 AND 30)) OR (v1<>27 AND v4=62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 15 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 30 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 27 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((NOT((comp_index_t2.v1 = 91))) AND (comp_index_t2.v2 = 91)) AND (comp_index_t2.v3 >= 15)) OR (comp_index_t2.v1 BETWEEN 16 AND 30)) OR ((NOT((comp_index_t2.v1 = 27))) AND (comp_index_t2.v4 = 62)))]\n" +
 			"",
 	},
 	{
@@ -7027,38 +6331,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=38 AND v2<>11 AND v3>=26) OR (v1 BETWEEN 37 AND 90 AND v4<85 AND v2<0)) OR (v1<>23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 38 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 11 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 90 (tinyint))\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 85 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 0 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((comp_index_t2.v1 >= 38) AND (NOT((comp_index_t2.v2 = 11)))) AND (comp_index_t2.v3 >= 26)) OR (((comp_index_t2.v1 BETWEEN 37 AND 90) AND (comp_index_t2.v4 < 85)) AND (comp_index_t2.v2 < 0))) OR (NOT((comp_index_t2.v1 = 23))))]\n" +
 			"",
 	},
 	{
@@ -7313,37 +6590,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<86) OR (v1<=5 AND v2<25 AND v3<>24)) OR (v1<32 AND v3 BETWEEN 51 AND 54 AND v4<=70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 86 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 5 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 25 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 24 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 32 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 51 (tinyint) AND 54 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 86), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 86), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 < 86) OR (((comp_index_t2.v1 <= 5) AND (comp_index_t2.v2 < 25)) AND (NOT((comp_index_t2.v3 = 24))))) OR (((comp_index_t2.v1 < 32) AND (comp_index_t2.v3 BETWEEN 51 AND 54)) AND (comp_index_t2.v4 <= 70)))]\n" +
 			"",
 	},
 	{
@@ -7435,12 +6686,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1<17 AND v2<54) AND (v1>=70 AND v2 BETWEEN 53 AND 53 AND v3>10 AND v4=17);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1<17 AND v2<54) AND (v1>=70 AND v2 BETWEEN 53 AND 53 AND v3>10 AND v4=17);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=21 AND v2>25 AND v3>=7) OR (v1 BETWEEN 23 AND 88 AND v2<=26 AND v3>=87 AND v4 BETWEEN 42 AND 95)) OR (v1<4 AND v2>=66 AND v3<=24 AND v4=10)) OR (v1>69));`,
@@ -7452,57 +6699,19 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 0 AND 39) OR (v1<18 AND v4>=90));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 39 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 18 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 BETWEEN 0 AND 39) OR ((comp_index_t2.v1 < 18) AND (comp_index_t2.v4 >= 90)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<99 AND v2>1 AND v3<=56) OR (v1>36 AND v2=53 AND v3>17)) OR (v1<>71)) AND (v1 BETWEEN 2 AND 86 AND v2<>78 AND v3<>29 AND v4<>63);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 99 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 1 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 56 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 53 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 17 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[2, 71), (NULL, 78), (NULL, 29), (NULL, 63)}, {[2, 71), (NULL, 78), (NULL, 29), (63, ∞)}, {[2, 71), (NULL, 78), (29, ∞), (NULL, 63)}, {[2, 71), (NULL, 78), (29, ∞), (63, ∞)}, {[2, 71), (78, ∞), (29, ∞), (NULL, 63)}, {[2, 71), (78, ∞), (29, ∞), (63, ∞)}, {[2, 86], (78, ∞), (NULL, 29), (NULL, 63)}, {[2, 86], (78, ∞), (NULL, 29), (63, ∞)}, {[71, 71], (1, 53), (29, 56], (NULL, 63)}, {[71, 71], (1, 53), (29, 56], (63, ∞)}, {[71, 71], (1, 78), (NULL, 29), (NULL, 63)}, {[71, 71], (1, 78), (NULL, 29), (63, ∞)}, {[71, 71], [53, 53], (29, ∞), (NULL, 63)}, {[71, 71], [53, 53], (29, ∞), (63, ∞)}, {[71, 71], (53, 78), (29, 56], (NULL, 63)}, {[71, 71], (53, 78), (29, 56], (63, ∞)}, {[71, 71], (78, ∞), (29, 56], (NULL, 63)}, {[71, 71], (78, ∞), (29, 56], (63, ∞)}, {(71, 86], (NULL, 78), (NULL, 29), (NULL, 63)}, {(71, 86], (NULL, 78), (NULL, 29), (63, ∞)}, {(71, 86], (NULL, 78), (29, ∞), (NULL, 63)}, {(71, 86], (NULL, 78), (29, ∞), (63, ∞)}, {(71, 86], (78, ∞), (29, ∞), (NULL, 63)}, {(71, 86], (78, ∞), (29, ∞), (63, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[2, 71), (NULL, 78), (NULL, 29), (NULL, 63)}, {[2, 71), (NULL, 78), (NULL, 29), (63, ∞)}, {[2, 71), (NULL, 78), (29, ∞), (NULL, 63)}, {[2, 71), (NULL, 78), (29, ∞), (63, ∞)}, {[2, 71), (78, ∞), (29, ∞), (NULL, 63)}, {[2, 71), (78, ∞), (29, ∞), (63, ∞)}, {[2, 86], (78, ∞), (NULL, 29), (NULL, 63)}, {[2, 86], (78, ∞), (NULL, 29), (63, ∞)}, {[71, 71], (1, 53), (29, 56], (NULL, 63)}, {[71, 71], (1, 53), (29, 56], (63, ∞)}, {[71, 71], (1, 78), (NULL, 29), (NULL, 63)}, {[71, 71], (1, 78), (NULL, 29), (63, ∞)}, {[71, 71], [53, 53], (29, ∞), (NULL, 63)}, {[71, 71], [53, 53], (29, ∞), (63, ∞)}, {[71, 71], (53, 78), (29, 56], (NULL, 63)}, {[71, 71], (53, 78), (29, 56], (63, ∞)}, {[71, 71], (78, ∞), (29, 56], (NULL, 63)}, {[71, 71], (78, ∞), (29, 56], (63, ∞)}, {(71, 86], (NULL, 78), (NULL, 29), (NULL, 63)}, {(71, 86], (NULL, 78), (NULL, 29), (63, ∞)}, {(71, 86], (NULL, 78), (29, ∞), (NULL, 63)}, {(71, 86], (NULL, 78), (29, ∞), (63, ∞)}, {(71, 86], (78, ∞), (29, ∞), (NULL, 63)}, {(71, 86], (78, ∞), (29, ∞), (63, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -7606,41 +6815,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<63 AND v2<>32 AND v3>=14) OR (v1=18 AND v3 BETWEEN 4 AND 42 AND v4>10)) OR (v1<23 AND v2>=21));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 63 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 32 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 18 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 42 (tinyint))\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 23 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 18), (NULL, 21), [14, ∞), [NULL, ∞)}, {(NULL, 18), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[18, 18], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 23), (NULL, 21), [14, ∞), [NULL, ∞)}, {(18, 23), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 63), (NULL, 32), [14, ∞), [NULL, ∞)}, {[23, 63), (32, ∞), [14, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 18), (NULL, 21), [14, ∞), [NULL, ∞)}, {(NULL, 18), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[18, 18], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 23), (NULL, 21), [14, ∞), [NULL, ∞)}, {(18, 23), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 63), (NULL, 32), [14, ∞), [NULL, ∞)}, {[23, 63), (32, ∞), [14, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((comp_index_t2.v1 < 63) AND (NOT((comp_index_t2.v2 = 32)))) AND (comp_index_t2.v3 >= 14)) OR (((comp_index_t2.v1 = 18) AND (comp_index_t2.v3 BETWEEN 4 AND 42)) AND (comp_index_t2.v4 > 10))) OR ((comp_index_t2.v1 < 23) AND (comp_index_t2.v2 >= 21)))]\n" +
 			"",
 	},
 	{
@@ -7814,30 +6993,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=82) OR (v1<=4 AND v2>=51)) OR (v1=58 AND v4<86));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 4 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 51 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 58 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 4], [51, ∞), [NULL, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 4], [51, ∞), [NULL, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 = 82) OR ((comp_index_t2.v1 <= 4) AND (comp_index_t2.v2 >= 51))) OR ((comp_index_t2.v1 = 58) AND (comp_index_t2.v4 < 86)))]\n" +
 			"",
 	},
 	{
@@ -8058,31 +7218,28 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>5) OR (v1<96 AND v2>=14)) OR (v1<>96)) AND (v1<>51 AND v3>41);`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
+			" ├─ Or\n" +
 			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 96 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 96 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
-			" │       └─ 41 (tinyint)\n" +
+			" │   │   ├─ NOT\n" +
+			" │   │   │   └─ Eq\n" +
+			" │   │   │       ├─ comp_index_t2.v1:1\n" +
+			" │   │   │       └─ 5 (tinyint)\n" +
+			" │   │   └─ AND\n" +
+			" │   │       ├─ LessThan\n" +
+			" │   │       │   ├─ comp_index_t2.v1:1\n" +
+			" │   │       │   └─ 96 (tinyint)\n" +
+			" │   │       └─ GreaterThanOrEqual\n" +
+			" │   │           ├─ comp_index_t2.v2:2\n" +
+			" │   │           └─ 14 (tinyint)\n" +
+			" │   └─ NOT\n" +
+			" │       └─ Eq\n" +
+			" │           ├─ comp_index_t2.v1:1\n" +
+			" │           └─ 96 (tinyint)\n" +
 			" └─ IndexedTableAccess(comp_index_t2)\n" +
 			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 51), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+			"     ├─ columns: [pk v1 v2 v3 v4]\n" +
+			"     └─ index condition: [(comp_index_t2.v3 > 41)]\n" +
 			"",
 	},
 	{
@@ -8129,30 +7286,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 34 AND 37 AND v3>23 AND v4>31) OR (v1 BETWEEN 43 AND 81 AND v3>=54 AND v4>=72));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 34 (tinyint) AND 37 (tinyint))\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 23 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 43 (tinyint) AND 81 (tinyint))\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 54 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 72 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[34, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[43, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[34, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[43, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 BETWEEN 34 AND 37) AND (comp_index_t2.v3 > 23)) AND (comp_index_t2.v4 > 31)) OR (((comp_index_t2.v1 BETWEEN 43 AND 81) AND (comp_index_t2.v3 >= 54)) AND (comp_index_t2.v4 >= 72)))]\n" +
 			"",
 	},
 	{
@@ -8414,35 +7552,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>46 AND v2>93 AND v3>19) AND (v1<51 AND v2=39) OR (v1<61)) AND (v1<>22);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 93 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 19 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 51 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 39 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 61 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, 61), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, 61), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -8522,61 +7635,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=59) OR (v1<>85 AND v4<6 AND v2 BETWEEN 14 AND 82));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 59 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 85 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 6 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 59), [14, 82], [NULL, ∞), [NULL, ∞)}, {[59, 59], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(59, 85), [14, 82], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [14, 82], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 59), [14, 82], [NULL, ∞), [NULL, ∞)}, {[59, 59], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(59, 85), [14, 82], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [14, 82], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 = 59) OR (((NOT((comp_index_t2.v1 = 85))) AND (comp_index_t2.v4 < 6)) AND (comp_index_t2.v2 BETWEEN 14 AND 82)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=94 AND v2>32 AND v3>61) OR (v1>51 AND v4>84 AND v2>=46)) OR (v1=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 94 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 32 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 61 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 51 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 84 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 46 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[39, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [46, ∞), [NULL, ∞), [NULL, ∞)}, {[94, ∞), (32, 46), (61, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[39, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [46, ∞), [NULL, ∞), [NULL, ∞)}, {[94, ∞), (32, 46), (61, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((comp_index_t2.v1 >= 94) AND (comp_index_t2.v2 > 32)) AND (comp_index_t2.v3 > 61)) OR (((comp_index_t2.v1 > 51) AND (comp_index_t2.v4 > 84)) AND (comp_index_t2.v2 >= 46))) OR (comp_index_t2.v1 = 39))]\n" +
 			"",
 	},
 	{
@@ -8734,12 +7806,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=41 AND v2<13 AND v3 BETWEEN 62 AND 87) AND (v1<=67 AND v2>68 AND v3=56 AND v4>28);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>=41 AND v2<13 AND v3 BETWEEN 62 AND 87) AND (v1<=67 AND v2>68 AND v3=56 AND v4>28);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 23 AND 34 AND v2 BETWEEN 4 AND 75 AND v3<91) OR (v1>=31));`,
@@ -8751,33 +7819,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<59) OR (v1 BETWEEN 6 AND 86 AND v4<97)) OR (v1<>90 AND v2=43 AND v3=29));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 59 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 6 (tinyint) AND 86 (tinyint))\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 97 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 90 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 29 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 90), [43, 43], [29, 29], [NULL, ∞)}, {(90, ∞), [43, 43], [29, 29], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 90), [43, 43], [29, 29], [NULL, ∞)}, {(90, ∞), [43, 43], [29, 29], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 < 59) OR ((comp_index_t2.v1 BETWEEN 6 AND 86) AND (comp_index_t2.v4 < 97))) OR (((NOT((comp_index_t2.v1 = 90))) AND (comp_index_t2.v2 = 43)) AND (comp_index_t2.v3 = 29)))]\n" +
 			"",
 	},
 	{
@@ -8851,26 +7897,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>12 AND v2<0) OR (v1=36 AND v3<37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 12 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 0 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 36 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(12, 36), (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[36, 36], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), (NULL, 0), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(12, 36), (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[36, 36], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), (NULL, 0), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 > 12) AND (comp_index_t2.v2 < 0)) OR ((comp_index_t2.v1 = 36) AND (comp_index_t2.v3 < 37)))]\n" +
 			"",
 	},
 	{
@@ -8882,20 +7913,12 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1=78 AND v2>28 AND v3<=47) AND (v1<35 AND v2=69 AND v3>16);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1=78 AND v2>28 AND v3<=47) AND (v1<35 AND v2=69 AND v3>16);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 31 AND 49 AND v2=20 AND v3 BETWEEN 8 AND 46) AND (v1<>57 AND v2<5);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 31 AND 49 AND v2=20 AND v3 BETWEEN 8 AND 46) AND (v1<>57 AND v2<5);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=39 AND v2<>3) OR (v1=97 AND v2<>37));`,
@@ -9235,84 +8258,29 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=9 AND v4=22 AND v2>=95) OR (v1>96));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 9 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 22 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 95 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 96 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 9], [95, ∞), [NULL, ∞), [NULL, ∞)}, {(96, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 9], [95, ∞), [NULL, ∞), [NULL, ∞)}, {(96, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 <= 9) AND (comp_index_t2.v4 = 22)) AND (comp_index_t2.v2 >= 95)) OR (comp_index_t2.v1 > 96))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=56) OR (v1>=31 AND v4<38 AND v2>20)) OR (v1=91 AND v2<48));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 56 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 31 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 38 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 20 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 91 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 56], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(56, 91), (20, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), (20, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 56], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(56, 91), (20, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), (20, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 <= 56) OR (((comp_index_t2.v1 >= 31) AND (comp_index_t2.v4 < 38)) AND (comp_index_t2.v2 > 20))) OR ((comp_index_t2.v1 = 91) AND (comp_index_t2.v2 < 48)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=75 AND v4<=30) OR (v1>=41 AND v2 BETWEEN 16 AND 25 AND v3>=99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 75 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 30 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 41 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 16 (tinyint) AND 25 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [16, 25], [99, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [16, 25], [99, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 <= 75) AND (comp_index_t2.v4 <= 30)) OR (((comp_index_t2.v1 >= 41) AND (comp_index_t2.v2 BETWEEN 16 AND 25)) AND (comp_index_t2.v3 >= 99)))]\n" +
 			"",
 	},
 	{
@@ -9484,93 +8452,28 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>50 AND v2>=46) AND (v1<>17 AND v2=45 AND v3<=79) OR (v1=10 AND v2>=35)) AND (v1=44 AND v2=38);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 17 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 45 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 79 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 10 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 35 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<34) OR (v1<=62 AND v4<>18 AND v2 BETWEEN 1 AND 41)) OR (v1>=65 AND v2>=93 AND v3 BETWEEN 34 AND 41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 34 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 62 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v4:4\n" +
-			" │   │       │           └─ 18 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 1 (tinyint) AND 41 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 65 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 93 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 34), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[34, 62], [1, 41], [NULL, ∞), [NULL, ∞)}, {[65, ∞), [93, ∞), [34, 41], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 34), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[34, 62], [1, 41], [NULL, ∞), [NULL, ∞)}, {[65, ∞), [93, ∞), [34, 41], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 < 34) OR (((comp_index_t2.v1 <= 62) AND (NOT((comp_index_t2.v4 = 18)))) AND (comp_index_t2.v2 BETWEEN 1 AND 41))) OR (((comp_index_t2.v1 >= 65) AND (comp_index_t2.v2 >= 93)) AND (comp_index_t2.v3 BETWEEN 34 AND 41)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>8) OR (v1>20 AND v4>=99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 8 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 20 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 > 8) OR ((comp_index_t2.v1 > 20) AND (comp_index_t2.v4 >= 99)))]\n" +
 			"",
 	},
 	{
@@ -9599,32 +8502,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>5 AND v3<>53 AND v4>=49) OR (v1<18 AND v2<94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 53 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 18 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 5), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[5, 5], (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 5), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[5, 5], (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((NOT((comp_index_t2.v1 = 5))) AND (NOT((comp_index_t2.v3 = 53)))) AND (comp_index_t2.v4 >= 49)) OR ((comp_index_t2.v1 < 18) AND (comp_index_t2.v2 < 94)))]\n" +
 			"",
 	},
 	{
@@ -9827,59 +8709,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>2 AND v4=0 AND v2 BETWEEN 6 AND 23 AND v3 BETWEEN 46 AND 52) OR (v1<=63 AND v2>=71 AND v3=28)) AND (v1<=52);`,
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 52], [71, ∞), [28, 28], [NULL, ∞)}, {(2, 52], [6, 23], [46, 52], [0, 0]}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
+			"",
+	},
+	{
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 10 AND 90) AND (v1=86 AND v4>=4) AND (v1 BETWEEN 6 AND 58 AND v2=85);`,
+		ExpectedPlan: "EmptyTable",
+	},
+	{
+		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=46 AND v4>41 AND v2<>12) OR (v1>17 AND v2>=34 AND v3<>68 AND v4<=13)) OR (v1>=98 AND v4 BETWEEN 3 AND 62 AND v2=39));`,
 		ExpectedPlan: "Filter\n" +
 			" ├─ Or\n" +
-			" │   ├─ AND\n" +
+			" │   ├─ Or\n" +
 			" │   │   ├─ AND\n" +
 			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
+			" │   │   │   │   ├─ Eq\n" +
 			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 2 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 23 (tinyint))\n" +
-			" │   │   └─ (comp_index_t2.v3:3 BETWEEN 46 (tinyint) AND 52 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 63 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 71 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 52], [71, ∞), [28, 28], [NULL, ∞)}, {(2, 52], [6, 23], [46, 52], [0, 0]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 10 AND 90) AND (v1=86 AND v4>=4) AND (v1 BETWEEN 6 AND 58 AND v2=85);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThanOrEqual\n" +
-			" │   ├─ comp_index_t2.v4:4\n" +
-			" │   └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=46 AND v4>41 AND v2<>12) OR (v1>17 AND v2>=34 AND v3<>68 AND v4<=13)) OR (v1>=98 AND v4 BETWEEN 3 AND 62 AND v2=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 46 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
+			" │   │   │   │   │   └─ 46 (tinyint)\n" +
+			" │   │   │   │   └─ GreaterThan\n" +
 			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
 			" │   │   │   │       └─ 41 (tinyint)\n" +
 			" │   │   │   └─ NOT\n" +
@@ -9987,12 +8837,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1<>37 AND v2>67 AND v3>52) AND (v1<48 AND v2<>73 AND v3=25 AND v4=22);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1<>37 AND v2>67 AND v3>52) AND (v1<48 AND v2<>73 AND v3=25 AND v4=22);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 57 AND 62 AND v2>=99) OR (v1>31));`,
@@ -10034,30 +8880,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=82 AND v4<=67 AND v2=40) OR (v1>63)) OR (v1<=16));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 67 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 40 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 63 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 16 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 16], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(63, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 16], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(63, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((comp_index_t2.v1 >= 82) AND (comp_index_t2.v4 <= 67)) AND (comp_index_t2.v2 = 40)) OR (comp_index_t2.v1 > 63)) OR (comp_index_t2.v1 <= 16))]\n" +
 			"",
 	},
 	{
@@ -10184,63 +9011,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>53 AND v4<99 AND v2<>31) OR (v1<>5 AND v2>70 AND v3>=71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 53 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 5 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 70 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 53), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(NULL, 53), (31, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], (70, ∞), [71, ∞), [NULL, ∞)}, {(53, ∞), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(53, ∞), (31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 53), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(NULL, 53), (31, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], (70, ∞), [71, ∞), [NULL, ∞)}, {(53, ∞), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(53, ∞), (31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((NOT((comp_index_t2.v1 = 53))) AND (comp_index_t2.v4 < 99)) AND (NOT((comp_index_t2.v2 = 31)))) OR (((NOT((comp_index_t2.v1 = 5))) AND (comp_index_t2.v2 > 70)) AND (comp_index_t2.v3 >= 71)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>1 AND v4=93) OR (v1<10 AND v2 BETWEEN 40 AND 74 AND v3>=27));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 1 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 93 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 10 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 40 (tinyint) AND 74 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1], [40, 74], [27, ∞), [NULL, ∞)}, {(1, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1], [40, 74], [27, ∞), [NULL, ∞)}, {(1, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 > 1) AND (comp_index_t2.v4 = 93)) OR (((comp_index_t2.v1 < 10) AND (comp_index_t2.v2 BETWEEN 40 AND 74)) AND (comp_index_t2.v3 >= 27)))]\n" +
 			"",
 	},
 	{
@@ -10318,12 +9102,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 33 AND 71 AND v2<=61 AND v3<=32 AND v4 BETWEEN 18 AND 73) AND (v1<3) AND (v1<=59 AND v2=47 AND v3<49 AND v4>36);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 33 AND 71 AND v2<=61 AND v3<=32 AND v4 BETWEEN 18 AND 73) AND (v1<3) AND (v1<=59 AND v2=47 AND v3<49 AND v4>36);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<77 AND v2=43 AND v3<92 AND v4=13) OR (v1=38 AND v2<=46)) OR (v1 BETWEEN 10 AND 79 AND v2>=11 AND v3 BETWEEN 14 AND 14));`,
@@ -10377,22 +9157,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<98 AND v3 BETWEEN 80 AND 82) OR (v1 BETWEEN 31 AND 38 AND v2=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 98 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v3:3 BETWEEN 80 (tinyint) AND 82 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 38 (tinyint))\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 98), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 98), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 < 98) AND (comp_index_t2.v3 BETWEEN 80 AND 82)) OR ((comp_index_t2.v1 BETWEEN 31 AND 38) AND (comp_index_t2.v2 = 39)))]\n" +
 			"",
 	},
 	{
@@ -10405,23 +9174,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=40) OR (v1<>32 AND v4<=37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThanOrEqual\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 40 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(32, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(32, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 >= 40) OR ((NOT((comp_index_t2.v1 = 32))) AND (comp_index_t2.v4 <= 37)))]\n" +
 			"",
 	},
 	{
@@ -10545,34 +9302,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=21 AND v2<50 AND v3>=39) OR (v1<=79 AND v4>62 AND v2=31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 21 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 79 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 62 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 79], [31, 31], [NULL, ∞), [NULL, ∞)}, {[21, 21], (NULL, 31), [39, ∞), [NULL, ∞)}, {[21, 21], (31, 50), [39, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 79], [31, 31], [NULL, ∞), [NULL, ∞)}, {[21, 21], (NULL, 31), [39, ∞), [NULL, ∞)}, {[21, 21], (31, 50), [39, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 = 21) AND (comp_index_t2.v2 < 50)) AND (comp_index_t2.v3 >= 39)) OR (((comp_index_t2.v1 <= 79) AND (comp_index_t2.v4 > 62)) AND (comp_index_t2.v2 = 31)))]\n" +
 			"",
 	},
 	{
@@ -10585,27 +9319,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>16 AND v3>=29) OR (v1>=47 AND v2<>63));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 16 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 29 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 47 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(16, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(16, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 > 16) AND (comp_index_t2.v3 >= 29)) OR ((comp_index_t2.v1 >= 47) AND (NOT((comp_index_t2.v2 = 63)))))]\n" +
 			"",
 	},
 	{
@@ -10791,22 +9509,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>86) OR (v1>=48 AND v4>9));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 86 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 48 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 > 86) OR ((comp_index_t2.v1 >= 48) AND (comp_index_t2.v4 > 9)))]\n" +
 			"",
 	},
 	{
@@ -10842,12 +9549,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=69 AND v2 BETWEEN 38 AND 45) AND (v1<>35 AND v2<28 AND v3>14);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>=69 AND v2 BETWEEN 38 AND 45) AND (v1<>35 AND v2<28 AND v3>14);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=93 AND v2<=10 AND v3 BETWEEN 21 AND 83) AND (v1<>5 AND v2>59 AND v3<>17) OR (v1<69 AND v3<>65 AND v4>=51 AND v2<=48)) OR (v1 BETWEEN 37 AND 57 AND v2 BETWEEN 44 AND 57 AND v3<40 AND v4=98));`,
@@ -11035,26 +9738,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>33) OR (v1<23 AND v4<=23 AND v2>=41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 33 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 23 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 23 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 23), [41, ∞), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 23), [41, ∞), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((comp_index_t2.v1 > 33) OR (((comp_index_t2.v1 < 23) AND (comp_index_t2.v4 <= 23)) AND (comp_index_t2.v2 >= 41)))]\n" +
 			"",
 	},
 	{
@@ -11099,76 +9787,19 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<4 AND v2<>1 AND v3<=34) OR (v1>=63)) OR (v1<58 AND v2=33)) AND (v1<=55) OR (v1 BETWEEN 1 AND 80 AND v2<=51));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 4 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │           └─ 1 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 58 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 33 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 55 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 1 (tinyint) AND 80 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 51 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1), (NULL, 1), (NULL, 34], [NULL, ∞)}, {(NULL, 1), (1, 33), (NULL, 34], [NULL, ∞)}, {(NULL, 1), [33, 33], [NULL, ∞), [NULL, ∞)}, {(NULL, 1), (33, ∞), (NULL, 34], [NULL, ∞)}, {[1, 4), (51, ∞), (NULL, 34], [NULL, ∞)}, {[1, 80], (NULL, 51], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1), (NULL, 1), (NULL, 34], [NULL, ∞)}, {(NULL, 1), (1, 33), (NULL, 34], [NULL, ∞)}, {(NULL, 1), [33, 33], [NULL, ∞), [NULL, ∞)}, {(NULL, 1), (33, ∞), (NULL, 34], [NULL, ∞)}, {[1, 4), (51, ∞), (NULL, 34], [NULL, ∞)}, {[1, 80], (NULL, 51], [NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 33 AND 82 AND v2<26) OR (v1>=98 AND v4>30 AND v2 BETWEEN 47 AND 67 AND v3 BETWEEN 9 AND 54)) OR (v1>=5)) AND (v1<>85 AND v4<>31);`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 82 (tinyint))\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 98 (tinyint)\n" +
-			" │   │   │       │   │   └─ GreaterThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │       └─ 30 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 67 (tinyint))\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 5 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 31 (tinyint)\n" +
+			" ├─ NOT\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ comp_index_t2.v4:4\n" +
+			" │       └─ 31 (tinyint)\n" +
 			" └─ IndexedTableAccess(comp_index_t2)\n" +
 			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 85), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(85, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
@@ -11177,33 +9808,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=82 AND v3<>55 AND v4>26) OR (v1=35)) OR (v1 BETWEEN 18 AND 70 AND v2>=17));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 55 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 70 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 17 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[18, 35), [17, ∞), [NULL, ∞), [NULL, ∞)}, {[35, 35], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, 70], [17, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[18, 35), [17, ∞), [NULL, ∞), [NULL, ∞)}, {[35, 35], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, 70], [17, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((((comp_index_t2.v1 = 82) AND (NOT((comp_index_t2.v3 = 55)))) AND (comp_index_t2.v4 > 26)) OR (comp_index_t2.v1 = 35)) OR ((comp_index_t2.v1 BETWEEN 18 AND 70) AND (comp_index_t2.v2 >= 17)))]\n" +
 			"",
 	},
 	{
@@ -11224,35 +9833,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<90 AND v4=77) OR (v1<>32 AND v2<=17 AND v3=68)) OR (v1<41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 90 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 32 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 17 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 68 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[90, ∞), (NULL, 17], [68, 68], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[90, ∞), (NULL, 17], [68, 68], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 < 90) AND (comp_index_t2.v4 = 77)) OR (((NOT((comp_index_t2.v1 = 32))) AND (comp_index_t2.v2 <= 17)) AND (comp_index_t2.v3 = 68))) OR (comp_index_t2.v1 < 41))]\n" +
 			"",
 	},
 	{
@@ -11283,29 +9868,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 32 AND 72 AND v2<>89 AND v3>=39) OR (v1>50 AND v4>80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 72 (tinyint))\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 89 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 50 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[32, 50], (NULL, 89), [39, ∞), [NULL, ∞)}, {[32, 50], (89, ∞), [39, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[32, 50], (NULL, 89), [39, ∞), [NULL, ∞)}, {[32, 50], (89, ∞), [39, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 BETWEEN 32 AND 72) AND (NOT((comp_index_t2.v2 = 89)))) AND (comp_index_t2.v3 >= 39)) OR ((comp_index_t2.v1 > 50) AND (comp_index_t2.v4 > 80)))]\n" +
 			"",
 	},
 	{
@@ -11360,63 +9927,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>10 AND v2<43 AND v3<>15) OR (v1<=71 AND v4<>22));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 71 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(71, ∞), (NULL, 43), (NULL, 15), [NULL, ∞)}, {(71, ∞), (NULL, 43), (15, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(71, ∞), (NULL, 43), (NULL, 15), [NULL, ∞)}, {(71, ∞), (NULL, 43), (15, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 > 10) AND (comp_index_t2.v2 < 43)) AND (NOT((comp_index_t2.v3 = 15)))) OR ((comp_index_t2.v1 <= 71) AND (NOT((comp_index_t2.v4 = 22)))))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 18 AND 36 AND v4<>87 AND v2>=13) OR (v1>=63 AND v3<=89)) AND (v1<76 AND v4<49 AND v2<=96);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 36 (tinyint))\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 87 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 63 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 89 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v4:4\n" +
-			" │       └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[18, 36], [13, 96], [NULL, ∞), [NULL, ∞)}, {[63, 76), (NULL, 96], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[18, 36], [13, 96], [NULL, ∞), [NULL, ∞)}, {[63, 76), (NULL, 96], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 BETWEEN 18 AND 36) AND (NOT((comp_index_t2.v4 = 87)))) AND (comp_index_t2.v2 >= 13)) OR ((comp_index_t2.v1 >= 63) AND (comp_index_t2.v3 <= 89))) (comp_index_t2.v4 < 49)]\n" +
 			"",
 	},
 	{
@@ -11505,29 +10029,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 5 AND 41 AND v3<78 AND v4<41) OR (v1>84 AND v2<>43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 41 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 78 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 41 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 84 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 41], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 41], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 BETWEEN 5 AND 41) AND (comp_index_t2.v3 < 78)) AND (comp_index_t2.v4 < 41)) OR ((comp_index_t2.v1 > 84) AND (NOT((comp_index_t2.v2 = 43)))))]\n" +
 			"",
 	},
 	{
@@ -11540,56 +10046,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=18 AND v2<=70) OR (v1>55 AND v2>52 AND v3<>70)) OR (v1=58)) AND (v1<>22 AND v4>76) OR (v1>14 AND v2<32 AND v3>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 18 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 70 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   └─ 55 (tinyint)\n" +
-			" │   │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │       └─ 52 (tinyint)\n" +
-			" │   │   │   │       └─ NOT\n" +
-			" │   │   │   │           └─ Eq\n" +
-			" │   │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │               └─ 70 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 58 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 22 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 76 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 14 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 18], (NULL, 70], [NULL, ∞), [NULL, ∞)}, {(18, 58), (NULL, 32), (97, ∞), [NULL, ∞)}, {(55, 58), (52, ∞), (NULL, 70), [NULL, ∞)}, {(55, 58), (52, ∞), (70, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 32), (97, ∞), [NULL, ∞)}, {(58, ∞), (52, ∞), (NULL, 70), [NULL, ∞)}, {(58, ∞), (52, ∞), (70, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 18], (NULL, 70], [NULL, ∞), [NULL, ∞)}, {(18, 58), (NULL, 32), (97, ∞), [NULL, ∞)}, {(55, 58), (52, ∞), (NULL, 70), (76, ∞)}, {(55, 58), (52, ∞), (70, ∞), (76, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 32), (97, ∞), [NULL, ∞)}, {(58, ∞), (52, ∞), (NULL, 70), (76, ∞)}, {(58, ∞), (52, ∞), (70, ∞), (76, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((comp_index_t2.v1 <= 18) AND (comp_index_t2.v2 <= 70)) OR (((comp_index_t2.v1 > 55) AND (comp_index_t2.v2 > 52)) AND (NOT((comp_index_t2.v3 = 70))))) OR (comp_index_t2.v1 = 58)) AND ((NOT((comp_index_t2.v1 = 22))) AND (comp_index_t2.v4 > 76))) OR (((comp_index_t2.v1 > 14) AND (comp_index_t2.v2 < 32)) AND (comp_index_t2.v3 > 97)))]\n" +
 			"",
 	},
 	{
@@ -11700,34 +10161,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>25 AND v2 BETWEEN 23 AND 54) OR (v1<>40 AND v3>90)) OR (v1<>7 AND v4<=78));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 25 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 23 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 40 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 90 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 7 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 78 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 > 25) AND (comp_index_t2.v2 BETWEEN 23 AND 54)) OR ((NOT((comp_index_t2.v1 = 40))) AND (comp_index_t2.v3 > 90))) OR ((NOT((comp_index_t2.v1 = 7))) AND (comp_index_t2.v4 <= 78)))]\n" +
 			"",
 	},
 	{
@@ -11763,31 +10201,16 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=34 AND v2<>61 AND v3<>3) AND (v1 BETWEEN 69 AND 93) AND (v1=36 AND v2>14);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>=34 AND v2<>61 AND v3<>3) AND (v1 BETWEEN 69 AND 93) AND (v1=36 AND v2>14);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>75) OR (v1<>74 AND v3 BETWEEN 29 AND 73));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 75 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 74 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 29 (tinyint) AND 73 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((NOT((comp_index_t2.v1 = 75))) OR ((NOT((comp_index_t2.v1 = 74))) AND (comp_index_t2.v3 BETWEEN 29 AND 73)))]\n" +
 			"",
 	},
 	{
@@ -11932,12 +10355,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 13 AND 77 AND v2>75 AND v3<73 AND v4>=6) AND (v1<=58 AND v2=48 AND v3 BETWEEN 33 AND 73);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 13 AND 77 AND v2>75 AND v3<73 AND v4>=6) AND (v1<=58 AND v2=48 AND v3 BETWEEN 33 AND 73);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>47 AND v3>47 AND v4 BETWEEN 51 AND 86 AND v2=26) OR (v1<82 AND v2<=17 AND v3<17 AND v4>=46));`,
@@ -11979,57 +10398,17 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>87) OR (v1>82 AND v4>=22)) OR (v1>=52 AND v2<>47 AND v3=37)) OR (v1<=14 AND v2<57 AND v3<10));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 87 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 82 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 22 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 52 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 47 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 37 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 14 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 57 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 14], (NULL, 57), (NULL, 10), [NULL, ∞)}, {[52, 82], (NULL, 47), [37, 37], [NULL, ∞)}, {[52, 82], (47, ∞), [37, 37], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=99 AND v3<=41) AND (v1<>38 AND v2<94 AND v3 BETWEEN 83 AND 95 AND v4>=86);`,
 		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
 			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
+			" ├─ static: [{(NULL, 14], (NULL, 57), (NULL, 10), [NULL, ∞)}, {[52, 82], (NULL, 47), [37, 37], [NULL, ∞)}, {[52, 82], (47, ∞), [37, 37], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 > 87) OR ((comp_index_t2.v1 > 82) AND (comp_index_t2.v4 >= 22))) OR (((comp_index_t2.v1 >= 52) AND (NOT((comp_index_t2.v2 = 47)))) AND (comp_index_t2.v3 = 37))) OR (((comp_index_t2.v1 <= 14) AND (comp_index_t2.v2 < 57)) AND (comp_index_t2.v3 < 10)))]\n" +
 			"",
 	},
+	{
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>=99 AND v3<=41) AND (v1<>38 AND v2<94 AND v3 BETWEEN 83 AND 95 AND v4>=86);`,
+		ExpectedPlan: "EmptyTable",
+	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>78) AND (v1>32 AND v2>11 AND v3>=78);`,
 		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
@@ -12039,12 +10418,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1<>3 AND v2=26 AND v3=22 AND v4<=76) AND (v1 BETWEEN 59 AND 92 AND v2 BETWEEN 36 AND 80);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1<>3 AND v2=26 AND v3=22 AND v4<=76) AND (v1 BETWEEN 59 AND 92 AND v2 BETWEEN 36 AND 80);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>10) OR (v1=12));`,
@@ -12056,50 +10431,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>=12 AND v3>=45 AND v4<98) OR (v1<>51 AND v3=79 AND v4<=24)) OR (v1 BETWEEN 4 AND 59 AND v4<82)) OR (v1>=29 AND v2<>21));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 12 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 45 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 98 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │       └─ 51 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 79 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 24 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 59 (tinyint))\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 82 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 29 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((comp_index_t2.v1 >= 12) AND (comp_index_t2.v3 >= 45)) AND (comp_index_t2.v4 < 98)) OR (((NOT((comp_index_t2.v1 = 51))) AND (comp_index_t2.v3 = 79)) AND (comp_index_t2.v4 <= 24))) OR ((comp_index_t2.v1 BETWEEN 4 AND 59) AND (comp_index_t2.v4 < 82))) OR ((comp_index_t2.v1 >= 29) AND (NOT((comp_index_t2.v2 = 21)))))]\n" +
 			"",
 	},
 	{
@@ -12141,12 +10477,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1>48 AND v2 BETWEEN 4 AND 84 AND v3<=3 AND v4<>31) AND (v1 BETWEEN 2 AND 15 AND v3>75);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1>48 AND v2 BETWEEN 4 AND 84 AND v3<=3 AND v4<>31) AND (v1 BETWEEN 2 AND 15 AND v3>75);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<41 AND v4=9 AND v2>77 AND v3=41) OR (v1>62 AND v2>=48 AND v3=13 AND v4>61)) OR (v1 BETWEEN 33 AND 75)) OR (v1 BETWEEN 45 AND 65 AND v4 BETWEEN 4 AND 68));`,
@@ -12196,52 +10528,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>20) OR (v1>=71 AND v4 BETWEEN 12 AND 20 AND v2<=30 AND v3 BETWEEN 14 AND 44)) AND (v1>97 AND v2=91 AND v3>=5) OR (v1>7 AND v2<34 AND v3<55 AND v4 BETWEEN 88 AND 97)) AND (v1 BETWEEN 2 AND 16 AND v2<>23 AND v3=75 AND v4>99);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 20 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 71 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 12 (tinyint) AND 20 (tinyint))\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 30 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 44 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 97 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 91 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 5 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 7 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 34 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 55 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -12490,46 +10780,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<30 AND v4>11 AND v2<=11) OR (v1<>19 AND v2<>47 AND v3 BETWEEN 38 AND 77 AND v4>31)) OR (v1 BETWEEN 0 AND 27 AND v2 BETWEEN 33 AND 34)) OR (v1<32)) AND (v1<9 AND v3=54 AND v4<>31 AND v2<>95);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ NOT\n" +
-			" │   │   │       │   │   │   └─ Eq\n" +
-			" │   │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │       └─ 19 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │           └─ 47 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 38 (tinyint) AND 77 (tinyint))\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 31 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 27 (tinyint))\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 33 (tinyint) AND 34 (tinyint))\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 9), (NULL, 95), [54, 54], (NULL, 31)}, {(NULL, 9), (NULL, 95), [54, 54], (31, ∞)}, {(NULL, 9), (95, ∞), [54, 54], (NULL, 31)}, {(NULL, 9), (95, ∞), [54, 54], (31, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 9), (NULL, 95), [54, 54], (NULL, 31)}, {(NULL, 9), (NULL, 95), [54, 54], (31, ∞)}, {(NULL, 9), (95, ∞), [54, 54], (NULL, 31)}, {(NULL, 9), (95, ∞), [54, 54], (31, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -12566,53 +10820,27 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ AND\n" +
 			" │       ├─ AND\n" +
 			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 50 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 3 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 74 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 3], [1, 74], [NULL, ∞)}, {[36, 36], [23, 39], [NULL, ∞), [NULL, ∞)}, {(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>30) OR (v1>98 AND v4>43 AND v2<>80)) OR (v1 BETWEEN 2 AND 23 AND v2>=34)) OR (v1>=42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 98 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 43 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v2:2\n" +
-			" │   │   │               └─ 80 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 2 (tinyint) AND 23 (tinyint))\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 34 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 42 (tinyint)\n" +
+			" │       │   │   ├─ comp_index_t2.v1:1\n" +
+			" │       │   │   └─ 50 (tinyint)\n" +
+			" │       │   └─ LessThanOrEqual\n" +
+			" │       │       ├─ comp_index_t2.v2:2\n" +
+			" │       │       └─ 3 (tinyint)\n" +
+			" │       └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 74 (tinyint))\n" +
 			" └─ IndexedTableAccess(comp_index_t2)\n" +
 			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[2, 23], [34, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 3], [1, 74], [NULL, ∞)}, {[36, 36], [23, 39], [NULL, ∞), [NULL, ∞)}, {(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
+	{
+		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>30) OR (v1>98 AND v4>43 AND v2<>80)) OR (v1 BETWEEN 2 AND 23 AND v2>=34)) OR (v1>=42));`,
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[2, 23], [34, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 > 30) OR (((comp_index_t2.v1 > 98) AND (comp_index_t2.v4 > 43)) AND (NOT((comp_index_t2.v2 = 80))))) OR ((comp_index_t2.v1 BETWEEN 2 AND 23) AND (comp_index_t2.v2 >= 34))) OR (comp_index_t2.v1 >= 42))]\n" +
+			"",
+	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<68 AND v2<81 AND v3<34 AND v4<>33) OR (v1<=78 AND v4 BETWEEN 34 AND 99 AND v2>=79 AND v3>=9)) OR (v1=27 AND v4 BETWEEN 20 AND 41 AND v2<98 AND v3>=15));`,
 		ExpectedPlan: "Filter\n" +
@@ -12699,12 +10927,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1=46) AND (v1>=93 AND v3<>51 AND v4=93 AND v2=8);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1=46) AND (v1>=93 AND v3<>51 AND v4=93 AND v2=8);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=5 AND v2>=14 AND v3<=2) OR (v1<53 AND v4=99 AND v2=72)) OR (v1<>49 AND v2<>39 AND v3>=70 AND v4<>24)) OR (v1<79));`,
@@ -12818,30 +11042,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=15) OR (v1>=59 AND v2<18)) OR (v1 BETWEEN 23 AND 31 AND v3>50 AND v4 BETWEEN 15 AND 54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 15 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 59 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 18 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 31 (tinyint))\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 50 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 15 (tinyint) AND 54 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [(((comp_index_t2.v1 >= 15) OR ((comp_index_t2.v1 >= 59) AND (comp_index_t2.v2 < 18))) OR (((comp_index_t2.v1 BETWEEN 23 AND 31) AND (comp_index_t2.v3 > 50)) AND (comp_index_t2.v4 BETWEEN 15 AND 54)))]\n" +
 			"",
 	},
 	{
@@ -13052,55 +11257,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=19 AND v3<72 AND v4=23) OR (v1<=36 AND v2>99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 19 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 23 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 36 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 19), (99, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 36], (99, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 19), (99, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 36], (99, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 = 19) AND (comp_index_t2.v3 < 72)) AND (comp_index_t2.v4 = 23)) OR ((comp_index_t2.v1 <= 36) AND (comp_index_t2.v2 > 99)))]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>43) OR (v1>=41 AND v4=32 AND v2<=66)) AND (v1>43 AND v2 BETWEEN 83 AND 97);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 41 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(43, ∞), [83, 97], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(43, ∞), [83, 97], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((NOT((comp_index_t2.v1 = 43))) OR (((comp_index_t2.v1 >= 41) AND (comp_index_t2.v4 = 32)) AND (comp_index_t2.v2 <= 66)))]\n" +
 			"",
 	},
 	{
@@ -13197,12 +11367,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1=23 AND v4>=52 AND v2>=61) AND (v1<>85 AND v3>2 AND v4<15);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			" └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1=23 AND v4>=52 AND v2>=61) AND (v1<>85 AND v3>2 AND v4<15);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 32 AND 51 AND v4 BETWEEN 5 AND 14 AND v2=46 AND v3>=31) OR (v1>=32 AND v2<=26 AND v3>52 AND v4>55));`,
@@ -13422,57 +11588,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1<>89 AND v2>=75) OR (v1<=5)) OR (v1=5 AND v2<19 AND v3>=1)) OR (v1>=18 AND v2>=17 AND v3 BETWEEN 78 AND 83)) OR (v1>=11 AND v3<=9 AND v4>39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 75 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ Eq\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 5 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 19 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 1 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 18 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 17 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 78 (tinyint) AND 83 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 11 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 9 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(5, 11), [75, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(5, 11), [75, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((((NOT((comp_index_t2.v1 = 89))) AND (comp_index_t2.v2 >= 75)) OR (comp_index_t2.v1 <= 5)) OR (((comp_index_t2.v1 = 5) AND (comp_index_t2.v2 < 19)) AND (comp_index_t2.v3 >= 1))) OR (((comp_index_t2.v1 >= 18) AND (comp_index_t2.v2 >= 17)) AND (comp_index_t2.v3 BETWEEN 78 AND 83))) OR (((comp_index_t2.v1 >= 11) AND (comp_index_t2.v3 <= 9)) AND (comp_index_t2.v4 > 39)))]\n" +
 			"",
 	},
 	{
@@ -13528,62 +11648,15 @@ var IndexPlanTests = []QueryPlanTest{
 			"",
 	},
 	{
-		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=50 AND v3>=51 AND v4<>69) AND (v1>1 AND v3<24);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 69 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
-			" │       └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(1, 50], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
-			"",
+		Query:        `SELECT * FROM comp_index_t2 WHERE (v1<=50 AND v3>=51 AND v4<>69) AND (v1>1 AND v3<24);`,
+		ExpectedPlan: "EmptyTable",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>10 AND v2=72 AND v3<31) OR (v1<67 AND v3 BETWEEN 13 AND 70 AND v4>66 AND v2>39)) OR (v1<82)) AND (v1>=66);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 31 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 67 (tinyint)\n" +
-			" │   │       │   │   └─ (comp_index_t2.v3:3 BETWEEN 13 (tinyint) AND 70 (tinyint))\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 66 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 39 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 82 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[66, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [72, 72], (NULL, 31), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[66, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [72, 72], (NULL, 31), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -13851,30 +11924,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=79 AND v3<89 AND v4>=3) OR (v1<63 AND v2<66));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 79 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 3 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 63 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 63), (NULL, 66), [NULL, ∞), [NULL, ∞)}, {[79, 79], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 63), (NULL, 66), [NULL, ∞), [NULL, ∞)}, {[79, 79], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 = 79) AND (comp_index_t2.v3 < 89)) AND (comp_index_t2.v4 >= 3)) OR ((comp_index_t2.v1 < 63) AND (comp_index_t2.v2 < 66)))]\n" +
 			"",
 	},
 	{
@@ -13939,29 +11993,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 23 AND 45 AND v4<30) OR (v1>=36 AND v2<>6 AND v3 BETWEEN 30 AND 53)) OR (v1 BETWEEN 41 AND 95));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 45 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 6 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 53 (tinyint))\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 95 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[23, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(95, ∞), (NULL, 6), [30, 53], [NULL, ∞)}, {(95, ∞), (6, ∞), [30, 53], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[23, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(95, ∞), (NULL, 6), [30, 53], [NULL, ∞)}, {(95, ∞), (6, ∞), [30, 53], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: [((((comp_index_t2.v1 BETWEEN 23 AND 45) AND (comp_index_t2.v4 < 30)) OR (((comp_index_t2.v1 >= 36) AND (NOT((comp_index_t2.v2 = 6)))) AND (comp_index_t2.v3 BETWEEN 30 AND 53))) OR (comp_index_t2.v1 BETWEEN 41 AND 95))]\n" +
 			"",
 	},
 	{
@@ -14005,44 +12041,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 94 AND 99 AND v2>4 AND v3<94 AND v4<=59) OR (v1=19 AND v2 BETWEEN 47 AND 54)) AND (v1>=83) OR (v1 BETWEEN 50 AND 97 AND v2<12 AND v3>23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 94 (tinyint) AND 99 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 94 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 59 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ Eq\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 19 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 83 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 12 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[50, 97], (NULL, 12), (23, ∞), [NULL, ∞)}, {[94, 97], (4, 12), (NULL, 23], (NULL, 59]}, {[94, 97], [12, ∞), (NULL, 94), (NULL, 59]}, {(97, 99], (4, ∞), (NULL, 94), (NULL, 59]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[50, 97], (NULL, 12), (23, ∞), [NULL, ∞)}, {[94, 97], (4, 12), (NULL, 23], (NULL, 59]}, {[94, 97], [12, ∞), (NULL, 94), (NULL, 59]}, {(97, 99], (4, ∞), (NULL, 94), (NULL, 59]}]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -37,6 +37,7 @@ var InfoSchemaQueries = []QueryTest{
 			{"engines"},
 			{"events"},
 			{"files"},
+			{"global_status"},
 			{"innodb_buffer_page"},
 			{"innodb_buffer_page_lru"},
 			{"innodb_buffer_pool_stats"},
@@ -85,6 +86,7 @@ var InfoSchemaQueries = []QueryTest{
 			{"schemata"},
 			{"schemata_extensions"},
 			{"schema_privileges"},
+			{"session_status"},
 			{"statistics"},
 			{"st_geometry_columns"},
 			{"st_spatial_reference_systems"},
@@ -259,8 +261,8 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW INDEXES FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", nil, 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", nil, 3, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
@@ -270,8 +272,8 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW KEYS FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", nil, 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", nil, 3, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
 			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
@@ -624,7 +626,7 @@ FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = 'mydb' AND INDEX_NAME='P
 	},
 	{
 		Query:    "SELECT * FROM information_schema.schemata_extensions",
-		Expected: []sql.Row{{"def", "information_schema", ""}, {"def", "foo", ""}, {"def", "mydb", ""}},
+		Expected: []sql.Row{{"def", "information_schema", ""}, {"def", "foo", ""}, {"def", "mydb", ""}, {"def", "performance_schema", ""}},
 	},
 	{
 		Query:    `SELECT * FROM information_schema.columns_extensions where table_name = 'mytable'`,
@@ -818,11 +820,53 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				Query: "SELECT * FROM information_schema.statistics where table_name='t'",
 				Expected: []sql.Row{
 					{"def", "mydb", "t", 1, "mydb", "myindex", 1, "test_score", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
-					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", 2, nil, nil, "", "BTREE", "", "", "YES", nil},
 				},
 			},
 		},
 	},
+	{
+		Name: "index cardinality and table status from statistics",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int, index ab (a, b))",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 1, 2), (3, 2, 3), (4, 2, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// without histograms, only unique indexes have an estimate
+				Query: "SELECT index_name, seq_in_index, cardinality FROM information_schema.statistics WHERE table_name = 't' ORDER BY 1, 2",
+				Expected: []sql.Row{
+					{"ab", 1, 0},
+					{"ab", 2, 0},
+					{"PRIMARY", 1, 4},
+				},
+			},
+			{
+				Query:    "ANALYZE TABLE t",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query: "SHOW INDEX FROM t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "pk", nil, int64(4), nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "ab", 1, "a", nil, int64(2), nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"t", 1, "ab", 2, "b", nil, int64(4), nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "SELECT index_name, seq_in_index, cardinality FROM information_schema.statistics WHERE table_name = 't' ORDER BY 1, 2",
+				Expected: []sql.Row{
+					{"ab", 1, 2},
+					{"ab", 2, 4},
+					{"PRIMARY", 1, 4},
+				},
+			},
+			{
+				Query:    "SHOW TABLE STATUS LIKE 't'",
+				Expected: []sql.Row{{"t", "InnoDB", "10", "Fixed", uint64(4), uint64(24), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil}},
+			},
+		},
+	},
 	{
 		Name: "information_schema.columns shows default value",
 		SetUpScript: []string{
@@ -872,7 +916,7 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				Expected: []sql.Row{
 					{"test_table", "pk", nil, "NO"},
 					{"test_table", "col2", "length('he`Llo')", "YES"},
-					{"test_table", "col3", "greatest(pk,2)", "YES"},
+					{"test_table", "col3", "greatest(`pk`,2)", "YES"},
 					{"test_table", "col4", "(5 + 5)", "YES"},
 					{"test_table", "col5", "CURRENT_TIMESTAMP", "YES"},
 					{"test_table", "create_time", "CURRENT_TIMESTAMP(6)", "NO"},
@@ -926,16 +970,16 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				Expected: []sql.Row{
 					{"p1", "def", "mydb", "p1", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "YES", "CONTAINS SQL", nil, "DEFINER", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY",
-						"hi", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"hi", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p2", "def", "mydb", "p2", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "NO", "CONTAINS SQL", nil, "INVOKER", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY",
 						"", "user@%", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p12", "def", "foo", "p12", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "YES", "CONTAINS SQL", nil, "DEFINER", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY",
-						"hello", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"hello", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 					{"p21", "def", "mydb", "p21", "PROCEDURE", "", nil, nil, nil, nil, nil, nil, nil, nil, "SQL",
 						nil, "SQL", "SQL", "NO", "CONTAINS SQL", nil, "DEFINER", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY",
-						"", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
+						"", "root@localhost", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 				},
 			},
 		},
@@ -1176,7 +1220,7 @@ FROM information_schema.COLUMNS WHERE TABLE_SCHEMA='mydb' AND TABLE_NAME='all_ty
 					{"bit_2", "b'10'", "YES", "bit", "bit(2)", nil, nil},
 					{"some_blob", "'abc'", "YES", "blob", "blob", 65535, 65535},
 					{"char_1", "A", "YES", "char", "char(1)", 1, 4},
-					{"some_date", "2022-02-22 00:00:00", "YES", "date", "date", nil, nil},
+					{"some_date", "2022-02-22", "YES", "date", "date", nil, nil},
 					{"date_time", "2022-02-22 22:22:21", "YES", "datetime", "datetime(6)", nil, nil},
 					{"decimal_52", "994.45", "YES", "decimal", "decimal(5,2)", nil, nil},
 					{"some_double", "1.1", "YES", "double", "double", nil, nil},
@@ -1420,9 +1464,9 @@ from information_schema.routines where routine_schema = 'mydb' and routine_type
 			{
 				Query: "SELECT * FROM information_schema.schemata where schema_name like 'mydb%' order by schema_name",
 				Expected: []sql.Row{
-					{"def", "mydb", "utf8mb4", "utf8mb4_0900_bin", nil, "NO"},
-					{"def", "mydb1", "latin1", "latin1_general_ci", nil, "NO"},
-					{"def", "mydb2", "utf8mb3", "utf8mb3_general_ci", nil, "NO"},
+					{"def", "mydb", "utf8mb4", "utf8mb4_0900_bin", nil, "NO", ""},
+					{"def", "mydb1", "latin1", "latin1_general_ci", nil, "NO", ""},
+					{"def", "mydb2", "utf8mb3", "utf8mb3_general_ci", nil, "NO", ""},
 				},
 			},
 		},
@@ -1032,6 +1032,64 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "alter auto_increment value below existing values",
+		SetUpScript: []string{
+			"create table auto (pk int auto_increment primary key, c0 int)",
+			"insert into auto values (NULL,10), (NULL,20), (100,30)",
+			"alter table auto auto_increment = 5",
+			"create table fresh (pk int auto_increment primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select auto_increment from information_schema.tables where table_name = 'auto'",
+				Expected: []sql.Row{{uint64(101)}},
+			},
+			{
+				Query:    "show table status like 'auto'",
+				Expected: []sql.Row{{"auto", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(101), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil}},
+			},
+			{
+				Query:    "show table status like 'fresh'",
+				Expected: []sql.Row{{"fresh", "InnoDB", "10", "Fixed", uint64(0), uint64(0), uint64(0), uint64(0), int64(0), int64(0), int64(1), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil}},
+			},
+			{
+				Query:    "insert into auto values (NULL,40)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 101}}},
+			},
+			{
+				Query:            "alter table auto auto_increment = 500",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into auto values (NULL,50)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 500}}},
+			},
+		},
+	},
+	{
+		Name: "auto_increment_increment and auto_increment_offset",
+		SetUpScript: []string{
+			"create table auto (pk int auto_increment primary key, c0 int)",
+			"insert into auto values (NULL,10), (NULL,20)",
+			"set @@auto_increment_increment = 10, @@auto_increment_offset = 5",
+			"insert into auto values (NULL,30), (NULL,40)",
+			"insert into auto values (47,50)",
+			"insert into auto values (NULL,60)",
+			"set @@auto_increment_offset = 20",
+			"insert into auto values (NULL,70)",
+			"set @@auto_increment_increment = 1, @@auto_increment_offset = 1",
+			"insert into auto values (NULL,80)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from auto order by 1",
+				Expected: []sql.Row{
+					{1, 10}, {2, 20}, {5, 30}, {15, 40}, {47, 50}, {55, 60}, {61, 70}, {62, 80},
+				},
+			},
+		},
+	},
 	{
 		Name: "auto increment on tinyint",
 		SetUpScript: []string{
@@ -1888,6 +1946,57 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "insert of a zero auto_increment value with NO_AUTO_VALUE_ON_ZERO",
+		SetUpScript: []string{
+			"create table t (id int primary key auto_increment, c varchar(10))",
+			"set sql_mode = 'NO_AUTO_VALUE_ON_ZERO'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (0, 'z'), (1, 'a')",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t (c) values ('b')",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 2}}},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{0, "z"}, {1, "a"}, {2, "b"}},
+			},
+		},
+	},
+	{
+		Name: "insert with unique_checks disabled",
+		SetUpScript: []string{
+			"create table t (pk int primary key, u int, unique key (u))",
+			"insert into t values (1, 1)",
+			"set unique_checks = 0",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (2, 2), (3, 3), (4, null), (5, null)",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				Query:       "insert into t values (6, 6), (7, 1)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:       "insert into t values (8, 8), (9, 8)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:       "insert into t values (2, 10)",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}, {4, nil}, {5, nil}},
+			},
+		},
+	},
 }
 
 var InsertDuplicateKeyKeyless = []ScriptTest{
@@ -2304,7 +2413,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{types.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERWarnDataTruncated,
 			},
 			{
 				Query: "SELECT * FROM t2",
@@ -2341,7 +2450,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{types.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERWarnDataTruncated,
 			},
 			{
 				Query: "SELECT * FROM t2",
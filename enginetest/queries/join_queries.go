@@ -513,6 +513,18 @@ inner join pq on true order by 1,2,3,4,5,6,7,8 limit 5;`,
 			{1, "first row", 1, nil, nil, nil},
 		},
 	},
+	{
+		Query:    "select i from niltable a where exists (select 1 from niltable b where a.i2 <=> b.f) order by 1",
+		Expected: []sql.Row{{1}, {3}, {4}, {5}, {6}},
+	},
+	{
+		Query:    "select i from niltable a where exists (select 1 from niltable b where a.i2 = b.f) order by 1",
+		Expected: []sql.Row{{4}, {6}},
+	},
+	{
+		Query:    "select i from niltable a where not exists (select 1 from niltable b where a.i2 <=> b.f) order by 1",
+		Expected: []sql.Row{{2}},
+	},
 	{
 		Query: "select * from mytable a join niltable  b on a.i = b.i and s IS NOT NULL",
 		Expected: []sql.Row{
@@ -744,6 +756,100 @@ var JoinScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "left joins reduced to inner joins and eliminated",
+		SetUpScript: []string{
+			"create table parent (id int primary key, name varchar(10), code int, unique key (code))",
+			"create table child (id int primary key, parent_id int, code int)",
+			"insert into parent values (1, 'one', 10), (2, 'two', 20), (3, 'three', null)",
+			"insert into child values (1, 1, 10), (2, 1, 10), (3, 2, 30), (4, null, null), (5, 4, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select c.id from child c left join parent p on p.id = c.parent_id order by c.id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+			},
+			{
+				Query:    "select p.id from parent p left join child c on c.parent_id = p.id order by p.id",
+				Expected: []sql.Row{{1}, {1}, {2}, {3}},
+			},
+			{
+				Query:    "select c.id from child c left join parent p on p.code = c.code order by c.id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+			},
+			{
+				Query:    "select id from (select cp.id, cp.name, q.name as code_name from (select c.id, c.parent_id, p.name from child c left join parent p on p.id = c.parent_id) cp left join parent q on q.code = cp.id * 10) cpc order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+			},
+			{
+				Query:    "select id, name from (select cp.id, cp.name, q.name as code_name from (select c.id, c.parent_id, p.name from child c left join parent p on p.id = c.parent_id) cp left join parent q on q.code = cp.id * 10) cpc order by id",
+				Expected: []sql.Row{{1, "one"}, {2, "one"}, {3, "two"}, {4, nil}, {5, nil}},
+			},
+			{
+				Query:    "select c.id, p.name from child c left join parent p on p.id = c.parent_id where p.name like 't%' order by c.id",
+				Expected: []sql.Row{{3, "two"}},
+			},
+			{
+				Query:    "select c.id, p.name from child c left join parent p on p.id = c.parent_id where p.name is null order by c.id",
+				Expected: []sql.Row{{4, nil}, {5, nil}},
+			},
+			{
+				Query:    "select c.id, p.name from child c left join parent p on p.id = c.parent_id where coalesce(p.name, 'none') = 'none' order by c.id",
+				Expected: []sql.Row{{4, nil}, {5, nil}},
+			},
+			{
+				Query:    "select c.id, p.name from child c left join parent p on p.id = c.parent_id where p.name = 'one' or c.id = 5 order by c.id",
+				Expected: []sql.Row{{1, "one"}, {2, "one"}, {5, nil}},
+			},
+			{
+				Query:    "select c.id, p.name, q.id from child c left join parent p on p.id = c.parent_id join parent q on q.name = p.name order by c.id",
+				Expected: []sql.Row{{1, "one", 1}, {2, "one", 1}, {3, "two", 2}},
+			},
+		},
+	},
+	{
+		Name: "derived tables merged into their queries",
+		SetUpScript: []string{
+			"create table parent (id int primary key, name varchar(10), code int, unique key (code))",
+			"create table child (id int primary key, parent_id int, code int)",
+			"insert into parent values (1, 'one', 10), (2, 'two', 20), (3, 'three', null)",
+			"insert into child values (1, 1, 10), (2, 1, 10), (3, 2, 30), (4, null, null), (5, 4, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select c.id, d.pname from child c join (select id, name as pname from parent where code > 5) d on d.id = c.parent_id order by c.id",
+				Expected: []sql.Row{{1, "one"}, {2, "one"}, {3, "two"}},
+			},
+			{
+				Query:    "select /*+ NO_MERGE(d) */ c.id, d.pname from child c join (select id, name as pname from parent where code > 5) d on d.id = c.parent_id order by c.id",
+				Expected: []sql.Row{{1, "one"}, {2, "one"}, {3, "two"}},
+			},
+			{
+				Query:    "select c.id, d.flag from child c left join (select id, 1 as flag from parent) d on d.id = c.parent_id order by c.id",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 1}, {4, nil}, {5, nil}},
+			},
+			{
+				Query:    "select c.id, d.name from child c left join (select id, name from parent where name <> 'one') d on d.id = c.parent_id order by c.id",
+				Expected: []sql.Row{{1, nil}, {2, nil}, {3, "two"}, {4, nil}, {5, nil}},
+			},
+			{
+				Query:    "select d.id, p.name from (select id, parent_id from child where id < 4) d left join parent p on p.id = d.parent_id order by d.id",
+				Expected: []sql.Row{{1, "one"}, {2, "one"}, {3, "two"}},
+			},
+			{
+				Query:    "select x.id, x.double_code from (select id, double_code from (select id, code * 2 as double_code from parent) y where double_code > 20) x order by x.id",
+				Expected: []sql.Row{{2, 40}},
+			},
+			{
+				Query:    "select parent.id, c.id from (select id from parent) parent join child c on c.parent_id = parent.id order by 1, 2",
+				Expected: []sql.Row{{1, 1}, {1, 2}, {2, 3}},
+			},
+			{
+				Query:    "select d.cid, d.pname, q.id from (select c.id as cid, p.name as pname from child c, parent p where p.id = c.parent_id) d join parent q on q.name = d.pname order by d.cid",
+				Expected: []sql.Row{{1, "one", 1}, {2, "one", 1}, {3, "two", 2}},
+			},
+		},
+	},
 }
 
 var SkippedJoinQueryTests = []QueryTest{
@@ -253,6 +253,26 @@ var OrderByGroupByScriptTests = []ScriptTest{
 					{8, "purple"},
 				},
 			},
+			{
+				Query: "select team, any_value(id) > 2 from members group by team order by team",
+				Expected: []sql.Row{
+					{"orange", true},
+					{"purple", true},
+					{"red", true},
+				},
+			},
+			{
+				Query:    "select any_value(id), count(*) from members",
+				Expected: []sql.Row{{3, 6}},
+			},
+			{
+				Query:    "select any_value(id) from members where id > 100",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select any_value(if(id = 3, null, id)), count(*) from members where team = 'red'",
+				Expected: []sql.Row{{nil, 2}},
+			},
 		},
 	},
 	{
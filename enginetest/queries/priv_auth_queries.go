@@ -105,6 +105,375 @@ type ServerAuthenticationTestAssertion struct {
 // UserPrivTests test the user and privilege systems. These tests always have the root account available, and the root
 // account is used with any queries in the SetUpScript.
 var UserPrivTests = []UserPrivilegeTest{
+	{
+		Name: "privileges are checked in the database of each table",
+		SetUpScript: []string{
+			"CREATE DATABASE db2;",
+			"CREATE TABLE mydb.p (id INT PRIMARY KEY);",
+			"CREATE TABLE db2.c (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_p FOREIGN KEY (pid) REFERENCES mydb.p (id));",
+			"INSERT INTO mydb.p VALUES (1), (2);",
+			"INSERT INTO db2.c VALUES (10, 1);",
+			"CREATE PROCEDURE mydb.pr() SELECT COUNT(*) FROM p;",
+			"CREATE VIEW db2.v AS SELECT c.id, p.id AS pid FROM c JOIN mydb.p ON c.pid = p.id;",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT, INSERT, CREATE ON db2.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT c.id, p.id FROM db2.c JOIN mydb.p ON c.pid = p.id;",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO db2.c SELECT id + 20, id FROM mydb.p;",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM db2.v;",
+				Expected: []sql.Row{{10, 1}},
+			},
+			{
+				// foreign key checks don't need privileges on the parent table
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "INSERT INTO db2.c VALUES (11, 2);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO db2.c VALUES (12, 5);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.pr();",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE TABLE db2.c2 (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_p2 FOREIGN KEY (pid) REFERENCES mydb.p (id));",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.p TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT c.id, p.id FROM db2.c JOIN mydb.p ON c.pid = p.id ORDER BY c.id;",
+				Expected: []sql.Row{{10, 1}, {11, 2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.p VALUES (3);",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE TABLE db2.c2 (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_p2 FOREIGN KEY (pid) REFERENCES mydb.p (id));",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT EXECUTE, REFERENCES ON mydb.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.pr();",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE TABLE db2.c2 (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_p2 FOREIGN KEY (pid) REFERENCES mydb.p (id));",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "SQL SECURITY of views",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.t (pk BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO mydb.t VALUES (1, 10), (2, 20);",
+			"CREATE VIEW mydb.definer_view AS SELECT pk FROM mydb.t;",
+			"CREATE SQL SECURITY INVOKER VIEW mydb.invoker_view AS SELECT pk FROM mydb.t;",
+			"CREATE DEFINER = 'gone'@'localhost' VIEW mydb.orphan_view AS SELECT pk FROM mydb.t;",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON mydb.definer_view TO tester@localhost;",
+			"GRANT SELECT ON mydb.invoker_view TO tester@localhost;",
+			"GRANT SELECT ON mydb.orphan_view TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.definer_view ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.invoker_view ORDER BY pk;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.orphan_view ORDER BY pk;",
+				ExpectedErr: sql.ErrDefinerDoesNotExist,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.t;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "ALTER SQL SECURITY INVOKER VIEW mydb.definer_view AS SELECT pk FROM mydb.t;",
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.definer_view ORDER BY pk;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "GRANT SELECT ON mydb.t TO tester@localhost;",
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.invoker_view ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+		},
+	},
+	{
+		Name: "SQL SECURITY of stored procedures",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.t (pk BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO mydb.t VALUES (1, 10), (2, 20);",
+			"CREATE PROCEDURE mydb.definer_proc() SELECT pk FROM mydb.t ORDER BY pk;",
+			"CREATE PROCEDURE mydb.invoker_proc() SQL SECURITY INVOKER SELECT pk FROM mydb.t ORDER BY pk;",
+			"CREATE PROCEDURE mydb.nested_proc() SQL SECURITY INVOKER CALL mydb.definer_proc();",
+			"CREATE DEFINER = 'gone'@'localhost' PROCEDURE mydb.orphan_proc() SELECT pk FROM mydb.t ORDER BY pk;",
+			"CREATE USER tester@localhost;",
+			"GRANT EXECUTE ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.definer_proc();",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.invoker_proc();",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.nested_proc();",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.orphan_proc();",
+				ExpectedErr: sql.ErrDefinerDoesNotExist,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.t;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT definer, security_type FROM information_schema.routines WHERE routine_schema = 'mydb' ORDER BY routine_name;",
+				Expected: []sql.Row{{"root@localhost", "DEFINER"}, {"root@localhost", "INVOKER"}, {"root@localhost", "INVOKER"}, {"gone@localhost", "DEFINER"}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "GRANT SELECT ON mydb.t TO tester@localhost;",
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.invoker_proc();",
+				Expected: []sql.Row{{1}, {2}},
+			},
+		},
+	},
+	{
+		Name: "triggers are executed with the privileges of their definer",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.t (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE mydb.audit (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE mydb.t2 (pk BIGINT PRIMARY KEY);",
+			"CREATE TRIGGER mydb.t_audit AFTER INSERT ON mydb.t FOR EACH ROW INSERT INTO mydb.audit VALUES (new.pk);",
+			"CREATE DEFINER = 'gone'@'localhost' TRIGGER mydb.t2_audit AFTER INSERT ON mydb.t2 FOR EACH ROW INSERT INTO mydb.audit VALUES (new.pk);",
+			"CREATE USER tester@localhost;",
+			"GRANT INSERT ON mydb.t TO tester@localhost;",
+			"GRANT INSERT ON mydb.t2 TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.t VALUES (1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.t2 VALUES (2);",
+				ExpectedErr: sql.ErrDefinerDoesNotExist,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.audit VALUES (3);",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.audit;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT trigger_name, definer FROM information_schema.triggers WHERE trigger_schema = 'mydb' ORDER BY trigger_name;",
+				Expected: []sql.Row{{"t2_audit", "gone@localhost"}, {"t_audit", "root@localhost"}},
+			},
+		},
+	},
+	{
+		Name: "naming another account as the definer takes SET_USER_ID",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.t (pk BIGINT PRIMARY KEY);",
+			"CREATE USER tester@localhost;",
+			"GRANT CREATE ROUTINE, TRIGGER, CREATE VIEW, EVENT ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` PROCEDURE mydb.p1() SELECT 1;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` TRIGGER mydb.t1 BEFORE INSERT ON mydb.t FOR EACH ROW SET new.pk = new.pk;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` VIEW mydb.v1 AS SELECT 1;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` EVENT mydb.e1 ON SCHEDULE EVERY 1 DAY DISABLE DO SELECT 1;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `tester`@`localhost` PROCEDURE mydb.p1() SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE TRIGGER mydb.t1 BEFORE INSERT ON mydb.t FOR EACH ROW SET new.pk = new.pk;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE VIEW mydb.v1 AS SELECT 1;",
+				Expected: []sql.Row{},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE EVENT mydb.e1 ON SCHEDULE EVERY 1 DAY DISABLE DO SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "ALTER DEFINER = `root`@`localhost` EVENT mydb.e1 DISABLE;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "GRANT SET_USER_ID ON *.* TO tester@localhost;",
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `root`@`localhost` PROCEDURE mydb.p2() SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `root`@`localhost` TRIGGER mydb.t2 BEFORE INSERT ON mydb.t FOR EACH ROW SET new.pk = new.pk;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `root`@`localhost` VIEW mydb.v2 AS SELECT 1;",
+				Expected: []sql.Row{},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "ALTER DEFINER = `root`@`localhost` EVENT mydb.e1 DISABLE;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT routine_name, definer FROM information_schema.routines WHERE routine_schema = 'mydb' ORDER BY routine_name;",
+				Expected: []sql.Row{{"p1", "tester@localhost"}, {"p2", "root@localhost"}},
+			},
+		},
+	},
 	{
 		Name: "Binlog replication privileges",
 		SetUpScript: []string{
@@ -591,6 +960,330 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "User creation with TLS requirements",
+		SetUpScript: []string{
+			"CREATE USER tls_none@localhost REQUIRE NONE;",
+			"CREATE USER tls_ssl@localhost REQUIRE SSL;",
+			"CREATE USER tls_x509@localhost REQUIRE X509;",
+			"CREATE USER tls_specified@localhost REQUIRE ISSUER '/C=SE/CN=CA' AND SUBJECT '/C=SE/CN=client' AND CIPHER 'TLS_AES_128_GCM_SHA256';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query: "SELECT User, ssl_type, ssl_cipher, x509_issuer, x509_subject FROM mysql.user WHERE User LIKE 'tls%' ORDER BY User;",
+				Expected: []sql.Row{
+					{"tls_none", uint16(1), []byte(""), []byte(""), []byte("")},
+					{"tls_specified", uint16(4), []byte("TLS_AES_128_GCM_SHA256"), []byte("/C=SE/CN=CA"), []byte("/C=SE/CN=client")},
+					{"tls_ssl", uint16(2), []byte(""), []byte(""), []byte("")},
+					{"tls_x509", uint16(3), []byte(""), []byte(""), []byte("")},
+				},
+			},
+		},
+	},
+	{
+		Name: "User creation with password options",
+		SetUpScript: []string{
+			"CREATE USER pass_default@localhost;",
+			"CREATE USER pass_options@localhost PASSWORD EXPIRE INTERVAL 90 DAY PASSWORD HISTORY 5 PASSWORD REUSE INTERVAL 365 DAY PASSWORD REQUIRE CURRENT OPTIONAL;",
+			"CREATE USER pass_never@localhost PASSWORD EXPIRE NEVER FAILED_LOGIN_ATTEMPTS 3 PASSWORD_LOCK_TIME 2;",
+			"CREATE USER pass_locked@localhost FAILED_LOGIN_ATTEMPTS 4 PASSWORD_LOCK_TIME UNBOUNDED ACCOUNT LOCK;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query: "SELECT User, password_expired, password_lifetime, Password_reuse_history, Password_reuse_time, Password_require_current, User_attributes, account_locked FROM mysql.user WHERE User LIKE 'pass%' ORDER BY User;",
+				Expected: []sql.Row{
+					{"pass_default", uint16(1), nil, nil, nil, nil, nil, uint16(1)},
+					{"pass_locked", uint16(1), nil, nil, nil, nil, types.MustJSON(`{"Password_locking": {"failed_login_attempts": 4, "password_lock_time_days": -1}}`), uint16(2)},
+					{"pass_never", uint16(1), uint16(0), nil, nil, nil, types.MustJSON(`{"Password_locking": {"failed_login_attempts": 3, "password_lock_time_days": 2}}`), uint16(1)},
+					{"pass_options", uint16(1), uint16(90), uint16(5), uint16(365), uint16(1), nil, uint16(1)},
+				},
+			},
+			{
+				Query:       "CREATE USER pass_invalid@localhost FAILED_LOGIN_ATTEMPTS 40000;",
+				ExpectedErr: sql.ErrUserCreationFailure,
+			},
+		},
+	},
+	{
+		Name: "User alteration with password options",
+		SetUpScript: []string{
+			"CREATE USER alt@localhost IDENTIFIED BY 'first' PASSWORD HISTORY 2;",
+			"CREATE USER other@localhost IDENTIFIED BY 'other';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query:    "ALTER USER alt@localhost PASSWORD EXPIRE INTERVAL 30 DAY FAILED_LOGIN_ATTEMPTS 3 PASSWORD_LOCK_TIME UNBOUNDED ACCOUNT LOCK;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SELECT User, password_expired, password_lifetime, Password_reuse_history, User_attributes, account_locked FROM mysql.user WHERE User = 'alt';",
+				Expected: []sql.Row{
+					{"alt", uint16(1), uint16(30), uint16(2), types.MustJSON(`{"Password_locking": {"failed_login_attempts": 3, "password_lock_time_days": -1}}`), uint16(2)},
+				},
+			},
+			{
+				Query:    "ALTER USER alt@localhost PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER USER alt@localhost PASSWORD EXPIRE;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT User, password_expired, password_lifetime, account_locked FROM mysql.user WHERE User = 'alt';",
+				Expected: []sql.Row{{"alt", uint16(2), nil, uint16(1)}},
+			},
+			{
+				Query:       "ALTER USER alt@localhost IDENTIFIED BY 'first';",
+				ExpectedErr: sql.ErrCredentialsContradictToHistory,
+			},
+			{
+				Query:    "ALTER USER alt@localhost IDENTIFIED BY 'second';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// changing the password ends its expiration
+				Query:    "SELECT User, password_expired FROM mysql.user WHERE User = 'alt';",
+				Expected: []sql.Row{{"alt", uint16(1)}},
+			},
+			{
+				Query:    "SELECT User, Host, Password FROM mysql.password_history ORDER BY Password_timestamp;",
+				Expected: []sql.Row{{"alt", "localhost", "*9BDE81F857CBD7E27CAED548ED36F57CE4307F0B"}, {"alt", "localhost", "*350BEBA0BA8F7BA4E147A3E652BE04A3B53B4479"}},
+			},
+			{
+				Query:       "ALTER USER alt@localhost IDENTIFIED BY 'first';",
+				ExpectedErr: sql.ErrCredentialsContradictToHistory,
+			},
+			{
+				Query:    "ALTER USER alt@localhost IDENTIFIED BY 'third';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER USER alt@localhost IDENTIFIED BY 'first';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "ALTER USER missing@localhost ACCOUNT LOCK;",
+				ExpectedErr: sql.ErrUserAlterationFailure,
+			},
+			{
+				Query:    "ALTER USER IF EXISTS missing@localhost ACCOUNT LOCK;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "other",
+				Host:        "localhost",
+				Query:       "ALTER USER alt@localhost IDENTIFIED BY 'fourth';",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "other",
+				Host:        "localhost",
+				Query:       "ALTER USER USER() ACCOUNT LOCK;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				// users may change their own password without any privilege
+				User:     "other",
+				Host:     "localhost",
+				Query:    "ALTER USER USER() IDENTIFIED BY 'changed';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "DROP USER alt@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM mysql.password_history;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "Changing a password that requires the current one",
+		SetUpScript: []string{
+			"CREATE USER req@localhost IDENTIFIED BY 'first';",
+			"CREATE USER other@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query:    "ALTER USER req@localhost PASSWORD REQUIRE CURRENT;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT User, Password_require_current FROM mysql.user WHERE User = 'req';",
+				Expected: []sql.Row{{"req", uint16(2)}},
+			},
+			{
+				User:        "req",
+				Host:        "localhost",
+				Query:       "ALTER USER USER() IDENTIFIED BY 'second';",
+				ExpectedErr: sql.ErrMissingCurrentPassword,
+			},
+			{
+				User:        "req",
+				Host:        "localhost",
+				Query:       "ALTER USER USER() IDENTIFIED BY 'second' REPLACE 'wrong';",
+				ExpectedErr: sql.ErrIncorrectCurrentPassword,
+			},
+			{
+				User:     "req",
+				Host:     "localhost",
+				Query:    "ALTER USER USER() IDENTIFIED BY 'second' REPLACE 'first';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// accounts that may change any password don't give the current one
+				Query:    "ALTER USER req@localhost IDENTIFIED BY 'third';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "ALTER USER req@localhost IDENTIFIED BY 'fourth' REPLACE 'third';",
+				ExpectedErr: sql.ErrCurrentPasswordNotRequired,
+			},
+			{
+				Query:    "ALTER USER req@localhost PASSWORD REQUIRE CURRENT OPTIONAL;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "req",
+				Host:     "localhost",
+				Query:    "ALTER USER USER() IDENTIFIED BY 'fourth';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER USER req@localhost PASSWORD REQUIRE CURRENT DEFAULT;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SET GLOBAL password_require_current = ON;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:        "req",
+				Host:        "localhost",
+				Query:       "ALTER USER USER() IDENTIFIED BY 'fifth';",
+				ExpectedErr: sql.ErrMissingCurrentPassword,
+			},
+			{
+				User:     "other",
+				Host:     "localhost",
+				Query:    "ALTER USER USER() IDENTIFIED BY 'pw' REPLACE '';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SET GLOBAL password_require_current = OFF;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:     "req",
+				Host:     "localhost",
+				Query:    "ALTER USER USER() IDENTIFIED BY 'fifth';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "Read-only modes",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.ro (pk INT PRIMARY KEY);",
+			"CREATE USER writer@localhost;",
+			"GRANT SELECT, INSERT, CREATE, DROP ON *.* TO writer@localhost;",
+			"SET GLOBAL read_only = ON;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "writer",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.ro VALUES (1);",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "writer",
+				Host:        "localhost",
+				Query:       "CREATE TABLE mydb.ro2 (pk INT PRIMARY KEY);",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:     "writer",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mydb.ro;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.ro VALUES (1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET GLOBAL super_read_only = ON;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.ro VALUES (2);",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "CREATE USER other@localhost;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER USER writer@localhost ACCOUNT LOCK;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER TABLE mydb.ro AUTO_INCREMENT = 10;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER TABLE mydb.ro ALTER COLUMN pk SET DEFAULT 5;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER TABLE mydb.ro ALTER COLUMN pk DROP DEFAULT;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "ALTER TABLE mydb.ro COMMENT 'read-only';",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "CREATE SEQUENCE mydb.seq;",
+				ExpectedErr: sql.ErrOptionPreventsStatement,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET GLOBAL super_read_only = OFF, GLOBAL read_only = OFF;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:     "writer",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.ro VALUES (2);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
 	{
 		Name: "Dynamic privilege support",
 		SetUpScript: []string{
@@ -644,6 +1337,45 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "UNMASK privilege",
+		SetUpScript: []string{
+			"CREATE USER testuser@localhost;",
+			"GRANT SELECT, UNMASK ON *.* TO testuser@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR testuser@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT ON *.* TO `testuser`@`localhost`"},
+					{"GRANT UNMASK ON *.* TO `testuser`@`localhost`"},
+				},
+			},
+			{
+				// Dynamic privileges may only be applied globally
+				User:        "root",
+				Host:        "localhost",
+				Query:       "GRANT UNMASK ON mydb.* TO 'testuser'@'localhost';",
+				ExpectedErr: sql.ErrGrantRevokeIllegalPrivilegeWithMessage,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE UNMASK ON *.* FROM testuser@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR testuser@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT ON *.* TO `testuser`@`localhost`"},
+				},
+			},
+		},
+	},
 	{
 		Name: "user creation no host",
 		SetUpScript: []string{
@@ -1432,6 +2164,7 @@ var UserPrivTests = []UserPrivilegeTest{
 					{"information_schema"},
 					{"mydb"},
 					{"mysql"},
+					{"performance_schema"},
 				},
 			},
 		},
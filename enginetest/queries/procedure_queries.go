@@ -711,15 +711,15 @@ END;`,
 			{
 				Query: "CALL p1(3, 4)",
 				Expected: []sql.Row{
-					{"4", "6"},
-					{"3", "4"},
+					{4, 6},
+					{3, 4},
 				},
 			},
 			{
 				Query: "CALL p2(5, 6)",
 				Expected: []sql.Row{
-					{"6", "8"},
-					{"5", "6"},
+					{6, 8},
+					{5, 6},
 				},
 			},
 		},
@@ -2161,6 +2161,37 @@ var ProcedureCallTests = []ScriptTest{
 		},
 	},
 
+	{
+		Name: "procedures read the tables of their own database",
+		SetUpScript: []string{
+			"CREATE DATABASE otherdb",
+			"CREATE TABLE otherdb.t (i INT PRIMARY KEY)",
+			"CREATE TABLE t (i INT PRIMARY KEY)",
+			"INSERT INTO otherdb.t VALUES (1), (2)",
+			"INSERT INTO t VALUES (3)",
+			"CREATE PROCEDURE otherdb.p1() SELECT COUNT(*) FROM t",
+			"CREATE PROCEDURE otherdb.p2() INSERT INTO t VALUES (4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL otherdb.p1()",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "CALL otherdb.p2()",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM otherdb.t ORDER BY i",
+				Expected: []sql.Row{{1}, {2}, {4}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY i",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+
 	{
 		Name: "String literals with escaped chars",
 		SetUpScript: []string{
@@ -2253,7 +2284,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2279,7 +2310,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2310,7 +2341,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2332,7 +2363,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2358,7 +2389,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2376,7 +2407,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2389,7 +2420,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2425,7 +2456,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2451,7 +2482,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2531,7 +2562,7 @@ var ProcedureShowCreate = []ScriptTest{
 var NoDbProcedureTests = []ScriptTestAssertion{
 	{
 		Query:    "SHOW databases;",
-		Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}},
+		Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"performance_schema"}},
 	},
 	{
 		Query:    "SELECT database();",
@@ -1961,6 +1961,20 @@ Select * from (
 			{"third row", int64(3)},
 		},
 	},
+	{
+		Query: "select * from (select i, s from mytable union all select i + 10, concat(s, '!') from mytable) t where i in (1, 12) order by i",
+		Expected: []sql.Row{
+			{int64(1), "first row"},
+			{int64(12), "second row!"},
+		},
+	},
+	{
+		Query: "select * from (select i from mytable union select i from mytable) t where i > 1 order by i",
+		Expected: []sql.Row{
+			{int64(2)},
+			{int64(3)},
+		},
+	},
 	{
 		Query: "WITH mytable as (select * FROM mytable) SELECT s,i FROM mytable;",
 		Expected: []sql.Row{
@@ -2249,6 +2263,39 @@ Select * from (
 			{"second row"},
 		},
 	},
+	{
+		Query: "SELECT i, (SELECT max(n2.i) FROM niltable n2 WHERE n2.b <=> n1.b) FROM niltable n1 ORDER BY i",
+		Expected: []sql.Row{
+			{1, 4},
+			{2, 5},
+			{3, 6},
+			{4, 4},
+			{5, 5},
+			{6, 6},
+		},
+	},
+	{
+		Query: "SELECT i, (SELECT n2.f FROM niltable n2 WHERE n2.i = n1.b + 4) FROM niltable n1 ORDER BY i",
+		Expected: []sql.Row{
+			{1, nil},
+			{2, 5.0},
+			{3, 4.0},
+			{4, nil},
+			{5, 5.0},
+			{6, 4.0},
+		},
+	},
+	{
+		Query: "SELECT i, EXISTS (SELECT 1 FROM niltable n2 WHERE n2.b = n1.b AND n2.i > 4) FROM niltable n1 ORDER BY i",
+		Expected: []sql.Row{
+			{1, false},
+			{2, true},
+			{3, true},
+			{4, false},
+			{5, true},
+			{6, true},
+		},
+	},
 	{
 		Query: "SELECT mytable.i, selfjoined.s FROM mytable LEFT JOIN (SELECT * FROM mytable) selfjoined ON mytable.i = selfjoined.i",
 		Expected: []sql.Row{
@@ -2808,6 +2855,30 @@ Select * from (
 		Query:    "SELECT 1 FROM DUAL WHERE (null, null) <=> (select null, null from dual)",
 		Expected: []sql.Row{{1}},
 	},
+	{
+		Query:    "SELECT (1, 2) < (1, 3), (1, NULL) < (2, 1), (1, NULL) < (1, 1), (1, 2) >= (1, 2), (NULL, 2) = (1, 3), (1, 2) <> (1, NULL)",
+		Expected: []sql.Row{{true, true, nil, true, false, nil}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE (v1, v2) > (2, 2) ORDER BY pk",
+		Expected: []sql.Row{{3}, {4}, {5}, {6}, {7}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE (v1, v2) <= (3, 2) ORDER BY pk",
+		Expected: []sql.Row{{0}, {1}, {2}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE (2, 2) < (v1, v2) AND (v1, v2) < (5, 0) ORDER BY pk",
+		Expected: []sql.Row{{3}, {4}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE (v1, v2) IN ((1, 1), (3, 3), (4, 5)) ORDER BY pk",
+		Expected: []sql.Row{{1}, {3}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE (v1, v2) = (1, 1) OR (v1, v2) <=> (6, 6) ORDER BY pk",
+		Expected: []sql.Row{{1}, {6}},
+	},
 	{
 		Query:    "SELECT 1 FROM DUAL WHERE (select 1, 2 from dual) in (select 1, 2 from dual)",
 		Expected: []sql.Row{{1}},
@@ -3006,6 +3077,54 @@ Select * from (
 		Query:    "SELECT i FROM mytable ORDER BY i LIMIT 1 OFFSET 1;",
 		Expected: []sql.Row{{int64(2)}},
 	},
+	{
+		Query:    "SELECT * FROM one_pk_two_idx ORDER BY pk LIMIT 2 OFFSET 3;",
+		Expected: []sql.Row{{3, 3, 3}, {4, 4, 4}},
+	},
+	{
+		Query:    "SELECT * FROM one_pk_two_idx ORDER BY pk DESC LIMIT 2 OFFSET 3;",
+		Expected: []sql.Row{{4, 4, 4}, {3, 3, 3}},
+	},
+	{
+		Query:    "SELECT t.pk, t.v2 FROM one_pk_two_idx t ORDER BY t.pk LIMIT 5 OFFSET 6;",
+		Expected: []sql.Row{{6, 6}, {7, 7}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx ORDER BY pk LIMIT 2 OFFSET 8;",
+		Expected: []sql.Row{},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_two_idx WHERE pk > 2 ORDER BY pk LIMIT 2 OFFSET 2;",
+		Expected: []sql.Row{{5}, {6}},
+	},
+	{
+		Query:    "SELECT pk, (SELECT pk FROM one_pk_two_idx ORDER BY pk DESC LIMIT 1 OFFSET 1) FROM one_pk_two_idx ORDER BY pk LIMIT 1 OFFSET 6;",
+		Expected: []sql.Row{{6, 6}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_three_idx WHERE (v1, v2, v3) < (0, 2, 2) ORDER BY v1 DESC, v2 DESC, v3 DESC LIMIT 2;",
+		Expected: []sql.Row{{2}, {1}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_three_idx WHERE v1 = 0 ORDER BY v1, v2 DESC, v3 DESC;",
+		Expected: []sql.Row{{3}, {2}, {1}, {0}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_three_idx ORDER BY v1 DESC, v2 DESC, v3 DESC LIMIT 3;",
+		Expected: []sql.Row{{7}, {6}, {5}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_three_idx WHERE v1 IN (0, 2) ORDER BY v1 DESC, v2 DESC, v3 DESC;",
+		Expected: []sql.Row{{5}, {3}, {2}, {1}, {0}},
+	},
+	{
+		Query:    "SELECT pk FROM one_pk_three_idx WHERE v1 > 0 ORDER BY v1, v2 DESC;",
+		Expected: []sql.Row{{4}, {5}, {6}, {7}},
+	},
+	{
+		Query:    "SELECT pk1, pk2 FROM two_pk WHERE pk1 = 1 ORDER BY pk2 DESC;",
+		Expected: []sql.Row{{1, 1}, {1, 0}},
+	},
 	{
 		Query:    "SELECT i FROM (SELECT i FROM mytable LIMIT 1) sq WHERE i = 3;",
 		Expected: []sql.Row{},
@@ -4748,9 +4867,9 @@ Select * from (
 	{
 		Query: "select from_unixtime(i) from mytable order by 1",
 		Expected: []sql.Row{
-			{time.Unix(1, 0)},
-			{time.Unix(2, 0)},
-			{time.Unix(3, 0)},
+			{time.Unix(1, 0).UTC()},
+			{time.Unix(2, 0).UTC()},
+			{time.Unix(3, 0).UTC()},
 		},
 	},
 	// TODO: add additional tests for other functions. Every function needs an engine test to ensure it works correctly
@@ -4860,7 +4979,7 @@ Select * from (
 	},
 	{
 		Query:    `SHOW DATABASES`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}, {"performance_schema"}},
 	},
 	{
 		Query:    `SHOW DATABASES LIKE 'information_schema'`,
@@ -4872,7 +4991,7 @@ Select * from (
 	},
 	{
 		Query:    `SHOW SCHEMAS`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}, {"performance_schema"}},
 	},
 	{
 		Query: `SELECT SCHEMA_NAME, DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME FROM information_schema.SCHEMATA`,
@@ -4880,6 +4999,7 @@ Select * from (
 			{"information_schema", "utf8mb4", "utf8mb4_0900_bin"},
 			{"mydb", "utf8mb4", "utf8mb4_0900_bin"},
 			{"foo", "utf8mb4", "utf8mb4_0900_bin"},
+			{"performance_schema", "utf8mb4", "utf8mb4_0900_bin"},
 		},
 	},
 	{
@@ -4993,7 +5113,7 @@ Select * from (
 	{
 		Query: `SHOW VARIABLES WHERE Variable_name > 'version' and variable_name like '%_%'`,
 		Expected: []sql.Row{
-			{"version_comment", "Dolt"}, {"version_compile_machine", ""}, {"version_compile_os", ""}, {"version_compile_zlib", ""}, {"wait_timeout", 28800}, {"windowing_use_high_precision", 1},
+			{"version_comment", "Dolt"}, {"version_compile_machine", ""}, {"version_compile_os", ""}, {"version_compile_zlib", ""}, {"wait_timeout", 28800}, {"warning_count", 0}, {"windowing_use_high_precision", 1},
 		},
 	},
 	{
@@ -5219,7 +5339,7 @@ Select * from (
 	{
 		Query: `SELECT if(0, "abc", 456)`,
 		Expected: []sql.Row{
-			{456},
+			{"456"},
 		},
 	},
 	{
@@ -5576,6 +5696,38 @@ Select * from (
 		Query:    "select date_sub(time('12:13:14'), interval 1 minute);",
 		Expected: []sql.Row{{types.Timespan(43934000000)}},
 	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02 10:00:00', INTERVAL 1.5 SECOND), DATE_SUB('2018-05-02 10:00:00', INTERVAL '1.5' SECOND)",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 10, 0, 1, 500000000, time.UTC), time.Date(2018, time.May, 2, 9, 59, 58, 500000000, time.UTC)}},
+	},
+	{
+		Query:    "SELECT TIMESTAMP('2018-05-02 10:00:00.123456') + INTERVAL 1 DAY",
+		Expected: []sql.Row{{time.Date(2018, time.May, 3, 10, 0, 0, 123456000, time.UTC)}},
+	},
+	{
+		Query:    "SELECT TIMESTAMPADD(SECOND, 1, '2018-05-02 10:00:00.25'), TIMESTAMPADD(MONTH, 1, '2018-01-31')",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 10, 0, 1, 250000000, time.UTC), time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT LAST_DAY('2020-02-10'), LAST_DAY('2021-02-10 10:00:00'), LAST_DAY('2020-12-31'), LAST_DAY('2020-13-01'), LAST_DAY(NULL)",
+		Expected: []sql.Row{{time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC), time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC), time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC), nil, nil}},
+	},
+	{
+		Query:    "SELECT PERIOD_ADD(202401, 2), PERIOD_ADD(9912, 1), PERIOD_ADD(6901, -1), PERIOD_DIFF(202403, 202311), PERIOD_DIFF(7001, 6912)",
+		Expected: []sql.Row{{int64(202403), int64(200001), int64(206812), int64(4), int64(-1199)}},
+	},
+	{
+		Query:    "SELECT SEC_TO_TIME(3661), SEC_TO_TIME(3661.5), SEC_TO_TIME(-90), SEC_TO_TIME(99999999), SEC_TO_TIME(NULL)",
+		Expected: []sql.Row{{types.Timespan(3661000000), types.Timespan(3661500000), types.Timespan(-90000000), types.Timespan(3020399000000), nil}},
+	},
+	{
+		Query:    "SELECT WEEK('2024-12-30', 3), WEEK('2021-01-01', 2), WEEK('2021-01-01', 7), YEARWEEK('2021-01-01', 2), WEEK(NULL), YEARWEEK(NULL)",
+		Expected: []sql.Row{{int32(1), int32(52), int32(52), int32(202052), nil, nil}},
+	},
+	{
+		Query:    "SELECT DATE_FORMAT('2005-01-02', '%y')",
+		Expected: []sql.Row{{"05"}},
+	},
 	{
 		Query:    "SELECT '2018-05-02' + INTERVAL 1 DAY",
 		Expected: []sql.Row{{time.Date(2018, time.May, 3, 0, 0, 0, 0, time.UTC)}},
@@ -6960,6 +7112,21 @@ Select * from (
 			{1, 1, 1, 1},
 		},
 	},
+	{
+		Query:    "select * from (select pk1, pk2, row_number() over (partition by pk1 order by c1 desc) rn from two_pk) t where pk1 = 1 and rn = 1",
+		Expected: []sql.Row{{1, 1, 1}},
+	},
+	{
+		Query:    "select * from (select pk1, pk2, row_number() over (partition by pk1 order by c1 desc) rn from two_pk) t where pk2 = 0 order by 1",
+		Expected: []sql.Row{{0, 0, 2}, {1, 0, 2}},
+	},
+	{
+		Query: `select * from (select pk1, pk2,
+			row_number() over (partition by pk1 order by c1 desc) r1,
+			row_number() over (partition by pk2 order by 10 - c1) r2
+			from two_pk) t where pk1 = 0 order by 2`,
+		Expected: []sql.Row{{0, 0, 2, 2}, {0, 1, 1, 2}},
+	},
 	{
 		Query: `select pk1, pk2,
 			row_number() over (partition by pk1 order by c1 desc),
@@ -7224,25 +7391,12 @@ Select * from (
 		Expected: []sql.Row{},
 	},
 	{
-		Query: `SHOW STATUS LIKE 'use_secondary_engine'`,
-		Expected: []sql.Row{
-			{"use_secondary_engine", "ON"},
-		},
-	},
-	{
-		Query: `SHOW GLOBAL STATUS LIKE 'admin_port'`,
-		Expected: []sql.Row{
-			{"admin_port", 33062},
-		},
-	},
-	{
-		Query: `SHOW SESSION STATUS LIKE 'auto_increment_increment'`,
-		Expected: []sql.Row{
-			{"auto_increment_increment", 1},
-		},
+		// System variables aren't status variables
+		Query:    `SHOW STATUS LIKE 'use_secondary_engine'`,
+		Expected: []sql.Row{},
 	},
 	{
-		Query:    `SHOW GLOBAL STATUS LIKE 'use_secondary_engine'`,
+		Query:    `SHOW GLOBAL STATUS LIKE 'admin_port'`,
 		Expected: []sql.Row{},
 	},
 	{
@@ -7254,10 +7408,8 @@ Select * from (
 		Expected: []sql.Row{}, // TODO: should be added at some point
 	},
 	{
-		Query: `SHOW SESSION STATUS WHERE Value < 0`,
-		Expected: []sql.Row{
-			{"optimizer_trace_offset", -1},
-		},
+		Query:    `SHOW SESSION STATUS WHERE Value < 0`,
+		Expected: []sql.Row{},
 	},
 	{
 		Query: `SELECT a.* FROM invert_pk as a, invert_pk as b WHERE a.y = b.z`,
@@ -7383,6 +7535,38 @@ Select * from (
 		Query:    "SELECT CONV(i, 10, 2) FROM mytable",
 		Expected: []sql.Row{{"1"}, {"10"}, {"11"}},
 	},
+	{
+		Query:    "SELECT CONV('', 10, 2), CONV('  1a', 16, 10)",
+		Expected: []sql.Row{{"0", "26"}},
+	},
+	{
+		Query:    "SELECT FORMAT(1e20, 2), FORMAT(12345678901234567890, 0), FORMAT(12332.2, 2, 'de_DE')",
+		Expected: []sql.Row{{"100,000,000,000,000,000,000.00", "12,345,678,901,234,567,890", "12.332,20"}},
+	},
+	{
+		Query:    "SELECT ELT(1, 'a', 'b'), ELT(3, 'a', 'b'), ELT(NULL, 'a'), ELT(i, 'x', 'y', 'z') FROM mytable ORDER BY i",
+		Expected: []sql.Row{{"a", nil, nil, "x"}, {"a", nil, nil, "y"}, {"a", nil, nil, "z"}},
+	},
+	{
+		Query:    "SELECT FIELD('b', 'a', 'b'), FIELD(2, 1, 2), FIELD(NULL, NULL, 'a'), FIELD(s, 'third row', 'first row') FROM mytable ORDER BY i",
+		Expected: []sql.Row{{2, 2, 0, 2}, {2, 2, 0, 0}, {2, 2, 0, 1}},
+	},
+	{
+		Query:    "SELECT MAKE_SET(1|4, 'hello', 'nice', 'world'), MAKE_SET(1|4, 'hello', 'nice', NULL, 'world'), MAKE_SET(0, 'a'), MAKE_SET(NULL, 'a')",
+		Expected: []sql.Row{{"hello,world", "hello", "", nil}},
+	},
+	{
+		Query:    "SELECT EXPORT_SET(5, 'Y', 'N', ',', 4), EXPORT_SET(6, '1', '0', ',', 10), EXPORT_SET(NULL, 'a', 'b'), LENGTH(EXPORT_SET(1, 'a', 'b'))",
+		Expected: []sql.Row{{"Y,N,Y,N", "0,1,1,0,0,0,0,0,0,0", nil, 127}},
+	},
+	{
+		Query:    `SELECT QUOTE('Don\'t!'), QUOTE(NULL), QUOTE(12)`,
+		Expected: []sql.Row{{`'Don\'t!'`, "NULL", "'12'"}},
+	},
+	{
+		Query:    "SELECT FROM_BASE64('Zm9v\nYmFy'), FROM_BASE64('!!!')",
+		Expected: []sql.Row{{[]byte("foobar"), nil}},
+	},
 	{
 		Query:    `SELECT t1.pk from one_pk join (one_pk t1 join one_pk t2 on t1.pk = t2.pk) on t1.pk = one_pk.pk and one_pk.pk = 1 join (one_pk t3 join one_pk t4 on t3.c1 is not null) on t3.pk = one_pk.pk and one_pk.c1 = 10`,
 		Expected: []sql.Row{{1}, {1}, {1}, {1}},
@@ -8220,11 +8404,6 @@ SELECT * FROM ladder;`,
 		Query:    "SELECT X'0a'",
 		Expected: []sql.Row{{"0x0A"}},
 	},
-	// Parsers for u, U, v, V, w, W, x and X are not supported yet.
-	{
-		Query:    "STR_TO_DATE('2013 32 Tuesday', '%X %V %W')", // Tuesday of 32th week
-		Expected: []sql.Row{{"2013-08-13"}},
-	},
 	{
 		// https://github.com/dolthub/dolt/issues/4931
 		// The current output is "0.07200000000000"
@@ -8395,6 +8574,18 @@ var DateParseQueries = []QueryTest{
 		Query:    "SELECT STR_TO_DATE('Jan 3, 2000', '%b %e, %Y')",
 		Expected: []sql.Row{{"2000-01-03"}},
 	},
+	{
+		Query:    "SELECT STR_TO_DATE('2013 32 Tuesday', '%X %V %W')", // Tuesday of 32th week
+		Expected: []sql.Row{{"2013-08-13"}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('2013 32 Tuesday', '%Y %V %W')",
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT STR_TO_DATE('20130801 12:05:06.5 am', '%Y%m%d %h:%i:%s.%f %p')",
+		Expected: []sql.Row{{"2013-08-01 00:05:06.500000"}},
+	},
 	{
 		Query:    "SELECT STR_TO_DATE('01,5,2013', '%d,%m,%Y')",
 		Expected: []sql.Row{{"2013-05-01"}},
@@ -8698,6 +8889,14 @@ var ErrorQueries = []QueryErrorTest{
 		Query:       `SELECT name FROM specialtable t WHERE t.name LIKE '$%' ESCAPE '$$'`,
 		ExpectedErr: sql.ErrInvalidArgument,
 	},
+	{
+		Query:       `SELECT PERIOD_ADD(202413, 1)`,
+		ExpectedErr: sql.ErrInvalidArgument,
+	},
+	{
+		Query:       `SELECT PERIOD_DIFF(202401, 0)`,
+		ExpectedErr: sql.ErrInvalidArgument,
+	},
 	{
 		Query:       `SELECT JSON_OBJECT("a","b","c") FROM dual`,
 		ExpectedErr: sql.ErrInvalidArgumentNumber,
@@ -251,12 +251,16 @@ WHERE
 			"     │   └─ columns: [w_id w_tax]\n" +
 			"     └─ Filter\n" +
 			"         ├─ AND\n" +
-			"         │   ├─ Eq\n" +
-			"         │   │   ├─ customer1.c_d_id:1!null\n" +
-			"         │   │   └─ 2 (tinyint)\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ customer1.c_d_id:1!null\n" +
+			"         │   │   │   └─ 2 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ customer1.c_id:0!null\n" +
+			"         │   │       └─ 2327 (smallint)\n" +
 			"         │   └─ Eq\n" +
-			"         │       ├─ customer1.c_id:0!null\n" +
-			"         │       └─ 2327 (smallint)\n" +
+			"         │       ├─ customer1.c_w_id:2!null\n" +
+			"         │       └─ 1 (tinyint)\n" +
 			"         └─ IndexedTableAccess(customer1)\n" +
 			"             ├─ index: [customer1.c_w_id,customer1.c_d_id,customer1.c_id]\n" +
 			"             └─ columns: [c_id c_d_id c_w_id c_last c_credit c_discount]\n" +
@@ -366,31 +370,19 @@ where
 	{
 		Query: `SELECT col1->'$.key1' from (SELECT JSON_OBJECT('key1', 1, 'key2', 'abc')) as dt(col1);`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [json_extract(dt.col1, '$.key1') as col1->'$.key1']\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: dt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [json_object('key1',1,'key2','abc') as JSON_OBJECT('key1', 1, 'key2', 'abc')]\n" +
-			"         └─ Table\n" +
-			"             ├─ name: \n" +
-			"             └─ columns: []\n" +
+			" ├─ columns: [json_extract(json_object('key1',1,'key2','abc'), '$.key1') as col1->'$.key1']\n" +
+			" └─ Table\n" +
+			"     ├─ name: \n" +
+			"     └─ columns: []\n" +
 			"",
 	},
 	{
 		Query: `SELECT col1->>'$.key1' from (SELECT JSON_OBJECT('key1', 1, 'key2', 'abc')) as dt(col1);`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [json_unquote(json_extract(dt.col1, '$.key1')) as col1->>'$.key1']\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: dt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [json_object('key1',1,'key2','abc') as JSON_OBJECT('key1', 1, 'key2', 'abc')]\n" +
-			"         └─ Table\n" +
-			"             ├─ name: \n" +
-			"             └─ columns: []\n" +
+			" ├─ columns: [json_unquote(json_extract(json_object('key1',1,'key2','abc'), '$.key1')) as col1->>'$.key1']\n" +
+			" └─ Table\n" +
+			"     ├─ name: \n" +
+			"     └─ columns: []\n" +
 			"",
 	},
 	{
@@ -504,32 +496,30 @@ Select * from (
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s:0!null as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: true\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1 (tinyint)]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       ├─ name: \n" +
-			"     │               │       └─ columns: []\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x:1!null]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ Eq\n" +
-			"     │                       │   ├─ xy.x:1!null\n" +
-			"     │                       │   └─ cte.s:0!null\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           └─ columns: [x]\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: true\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1 (tinyint)]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       ├─ name: \n" +
+			"     │           │       └─ columns: []\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x:1!null]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ Eq\n" +
+			"     │                   │   ├─ xy.x:1!null\n" +
+			"     │                   │   └─ cte.s:0!null\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       └─ columns: [x]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [convert\n" +
-			"         │   ├─ type: signed\n" +
+			"         │   ├─ type: bigint\n" +
 			"         │   └─ xy.x:0!null\n" +
 			"         │   as x]\n" +
 			"         └─ Project\n" +
@@ -579,32 +569,30 @@ Select * from (
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s:0!null as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: true\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1 (tinyint)]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       ├─ name: \n" +
-			"     │               │       └─ columns: []\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x:1!null]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ Eq\n" +
-			"     │                       │   ├─ xy.x:1!null\n" +
-			"     │                       │   └─ cte.s:0!null\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           └─ columns: [x]\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: true\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1 (tinyint)]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       ├─ name: \n" +
+			"     │           │       └─ columns: []\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x:1!null]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ Eq\n" +
+			"     │                   │   ├─ xy.x:1!null\n" +
+			"     │                   │   └─ cte.s:0!null\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       └─ columns: [x]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [convert\n" +
-			"         │   ├─ type: signed\n" +
+			"         │   ├─ type: bigint\n" +
 			"         │   └─ xy.x:0!null\n" +
 			"         │   as x]\n" +
 			"         └─ Project\n" +
@@ -1141,16 +1129,12 @@ Select * from (
 	},
 	{
 		Query: `SELECT a FROM (select i,s FROM mytable) mt (a,b) order by 1;`,
-		ExpectedPlan: "Sort(mt.a:0!null ASC nullsFirst)\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: mt\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [mytable.i:0!null]\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i s]\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [mytable.i:0!null as a]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -1253,14 +1237,10 @@ Select * from (
 			"                                                                 ├─ left-key: TUPLE(ab.b:0)\n" +
 			"                                                                 ├─ right-key: TUPLE(uv.u:0!null)\n" +
 			"                                                                 └─ CachedResults\n" +
-			"                                                                     └─ Filter\n" +
-			"                                                                         ├─ HashIn\n" +
-			"                                                                         │   ├─ uv.u:0!null\n" +
-			"                                                                         │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
-			"                                                                         └─ IndexedTableAccess(uv)\n" +
-			"                                                                             ├─ index: [uv.u]\n" +
-			"                                                                             ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
-			"                                                                             └─ columns: [u v]\n" +
+			"                                                                     └─ IndexedTableAccess(uv)\n" +
+			"                                                                         ├─ index: [uv.u]\n" +
+			"                                                                         ├─ static: [{[2, 3]}]\n" +
+			"                                                                         └─ columns: [u v]\n" +
 			"",
 	},
 	{
@@ -1325,47 +1305,35 @@ Select * from (
 	{
 		Query: `select * from (select a,v from ab join uv on a=u) av join (select x,q from xy join pq on x = p) xq on av.v = xq.x`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [av.a:2!null, av.v:3, xq.x:0!null, xq.q:1]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ av.v:3\n" +
-			"     │   └─ xq.x:0!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: xq\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [xy.x:2!null, pq.q:1]\n" +
-			"     │       └─ LookupJoin\n" +
-			"     │           ├─ Eq\n" +
-			"     │           │   ├─ xy.x:2!null\n" +
-			"     │           │   └─ pq.p:0!null\n" +
-			"     │           ├─ Table\n" +
-			"     │           │   ├─ name: pq\n" +
-			"     │           │   └─ columns: [p q]\n" +
-			"     │           └─ IndexedTableAccess(xy)\n" +
-			"     │               ├─ index: [xy.x]\n" +
-			"     │               └─ columns: [x]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(xq.x:0!null)\n" +
-			"         ├─ right-key: TUPLE(av.v:1)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: av\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [ab.a:2!null, uv.v:1]\n" +
-			"                     └─ LookupJoin\n" +
-			"                         ├─ Eq\n" +
-			"                         │   ├─ ab.a:2!null\n" +
-			"                         │   └─ uv.u:0!null\n" +
-			"                         ├─ Table\n" +
-			"                         │   ├─ name: uv\n" +
-			"                         │   └─ columns: [u v]\n" +
-			"                         └─ IndexedTableAccess(ab)\n" +
-			"                             ├─ index: [ab.a]\n" +
-			"                             └─ columns: [a]\n" +
+			" ├─ columns: [ab.a:4!null, uv.v:1, xy.x:5!null, pq.q:3]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ xy.x:5!null\n" +
+			"     │   │   └─ pq.p:2!null\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ uv.v:1\n" +
+			"     │       └─ xy.x:5!null\n" +
+			"     ├─ LookupJoin\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ ab.a:4!null\n" +
+			"     │   │   └─ uv.u:0!null\n" +
+			"     │   ├─ LookupJoin\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ uv.v:1\n" +
+			"     │   │   │   └─ pq.p:2!null\n" +
+			"     │   │   ├─ Table\n" +
+			"     │   │   │   ├─ name: uv\n" +
+			"     │   │   │   └─ columns: [u v]\n" +
+			"     │   │   └─ IndexedTableAccess(pq)\n" +
+			"     │   │       ├─ index: [pq.p]\n" +
+			"     │   │       └─ columns: [p q]\n" +
+			"     │   └─ IndexedTableAccess(ab)\n" +
+			"     │       ├─ index: [ab.a]\n" +
+			"     │       └─ columns: [a]\n" +
+			"     └─ IndexedTableAccess(xy)\n" +
+			"         ├─ index: [xy.x]\n" +
+			"         └─ columns: [x]\n" +
 			"",
 	},
 	{
@@ -1460,13 +1428,9 @@ Select * from (
 	},
 	{
 		Query: `with cte (a,b) as (select * from ab) select * from cte`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: cte\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ Table\n" +
-			"     ├─ name: ab\n" +
-			"     └─ columns: [a b]\n" +
+		ExpectedPlan: "Table\n" +
+			" ├─ name: ab\n" +
+			" └─ columns: [a b]\n" +
 			"",
 	},
 	{
@@ -1862,16 +1826,9 @@ where exists
 			"     ├─ OrderedDistinct\n" +
 			"     │   └─ Project\n" +
 			"     │       ├─ columns: [uv.u:0!null]\n" +
-			"     │       └─ LeftOuterLookupJoin\n" +
-			"     │           ├─ Eq\n" +
-			"     │           │   ├─ uv.u:0!null\n" +
-			"     │           │   └─ pq.p:2!null\n" +
-			"     │           ├─ Table\n" +
-			"     │           │   ├─ name: uv\n" +
-			"     │           │   └─ columns: [u v]\n" +
-			"     │           └─ IndexedTableAccess(pq)\n" +
-			"     │               ├─ index: [pq.p]\n" +
-			"     │               └─ columns: [p q]\n" +
+			"     │       └─ Table\n" +
+			"     │           ├─ name: uv\n" +
+			"     │           └─ columns: [u v]\n" +
 			"     └─ IndexedTableAccess(ab)\n" +
 			"         ├─ index: [ab.a]\n" +
 			"         └─ columns: [a b]\n" +
@@ -1963,39 +1920,35 @@ select * from
 inner join uv on true
 inner join pq on true
 `,
-		ExpectedPlan: "CrossHashJoin\n" +
-			" ├─ CrossHashJoin\n" +
-			" │   ├─ SubqueryAlias\n" +
-			" │   │   ├─ name: alias1\n" +
-			" │   │   ├─ outerVisibility: false\n" +
-			" │   │   ├─ cacheable: true\n" +
-			" │   │   └─ Project\n" +
-			" │   │       ├─ columns: [ab.a:2!null, ab.b:3, xy.x:0!null, xy.y:1]\n" +
-			" │   │       └─ CrossHashJoin\n" +
-			" │   │           ├─ Table\n" +
-			" │   │           │   ├─ name: xy\n" +
-			" │   │           │   └─ columns: [x y]\n" +
-			" │   │           └─ HashLookup\n" +
-			" │   │               ├─ left-key: TUPLE()\n" +
-			" │   │               ├─ right-key: TUPLE()\n" +
-			" │   │               └─ CachedResults\n" +
-			" │   │                   └─ Table\n" +
-			" │   │                       ├─ name: ab\n" +
-			" │   │                       └─ columns: [a b]\n" +
-			" │   └─ HashLookup\n" +
-			" │       ├─ left-key: TUPLE()\n" +
-			" │       ├─ right-key: TUPLE()\n" +
-			" │       └─ CachedResults\n" +
-			" │           └─ Table\n" +
-			" │               ├─ name: uv\n" +
-			" │               └─ columns: [u v]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ left-key: TUPLE()\n" +
-			"     ├─ right-key: TUPLE()\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: pq\n" +
-			"             └─ columns: [p q]\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [ab.a:2!null, ab.b:3, xy.x:0!null, xy.y:1, uv.u:4!null, uv.v:5, pq.p:6!null, pq.q:7]\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ CrossHashJoin\n" +
+			"     │   ├─ CrossHashJoin\n" +
+			"     │   │   ├─ Table\n" +
+			"     │   │   │   ├─ name: xy\n" +
+			"     │   │   │   └─ columns: [x y]\n" +
+			"     │   │   └─ HashLookup\n" +
+			"     │   │       ├─ left-key: TUPLE()\n" +
+			"     │   │       ├─ right-key: TUPLE()\n" +
+			"     │   │       └─ CachedResults\n" +
+			"     │   │           └─ Table\n" +
+			"     │   │               ├─ name: ab\n" +
+			"     │   │               └─ columns: [a b]\n" +
+			"     │   └─ HashLookup\n" +
+			"     │       ├─ left-key: TUPLE()\n" +
+			"     │       ├─ right-key: TUPLE()\n" +
+			"     │       └─ CachedResults\n" +
+			"     │           └─ Table\n" +
+			"     │               ├─ name: uv\n" +
+			"     │               └─ columns: [u v]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
+			"         └─ CachedResults\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: pq\n" +
+			"                 └─ columns: [p q]\n" +
 			"",
 	},
 	{
@@ -2114,6 +2067,208 @@ inner join pq on true
 			"         └─ columns: [i2]\n" +
 			"",
 	},
+	{
+		Query: `SELECT a.i FROM mytable a LEFT JOIN othertable b ON a.i = b.i2`,
+		ExpectedPlan: "TableAlias(a)\n" +
+			" └─ Table\n" +
+			"     ├─ name: mytable\n" +
+			"     └─ columns: [i]\n" +
+			"",
+	},
+	{
+		Query: `SELECT a.i, a.s FROM mytable a LEFT JOIN othertable b ON b.i2 = a.i + 1 LEFT JOIN othertable c ON c.i2 = b.i2 WHERE a.i > 1`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ GreaterThan\n" +
+			" │   ├─ a.i:0!null\n" +
+			" │   └─ 1 (tinyint)\n" +
+			" └─ TableAlias(a)\n" +
+			"     └─ IndexedTableAccess(mytable)\n" +
+			"         ├─ index: [mytable.i]\n" +
+			"         ├─ static: [{(1, ∞)}]\n" +
+			"         └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `SELECT a.i, b.s2 FROM mytable a LEFT JOIN othertable b ON a.i = b.i2 WHERE b.s2 <> 'second'`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.i:2!null, b.s2:0]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ b.i2:1!null\n" +
+			"     │   └─ a.i:2!null\n" +
+			"     ├─ Filter\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ b.s2:0\n" +
+			"     │   │       └─ second (longtext)\n" +
+			"     │   └─ TableAlias(b)\n" +
+			"     │       └─ IndexedTableAccess(othertable)\n" +
+			"     │           ├─ index: [othertable.i2]\n" +
+			"     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           └─ columns: [s2 i2]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i]\n" +
+			"",
+	},
+	{
+		Query: `SELECT d.i, d.s FROM (SELECT i, s FROM mytable) d WHERE d.i = 2`,
+		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
+			" ├─ index: [mytable.i]\n" +
+			" ├─ static: [{[2, 2]}]\n" +
+			" └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `SELECT o.i2, d.j FROM othertable o JOIN (SELECT i, i + 1 AS j FROM mytable WHERE s <> 'first row') d ON d.i = o.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [o.i2:2!null, (mytable.i:0!null + 1 (tinyint)) as j]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ o.i2:2!null\n" +
+			"     ├─ sel: NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ mytable.s:1!null\n" +
+			"     │       └─ first row (longtext)\n" +
+			"     ├─ IndexedTableAccess(mytable)\n" +
+			"     │   ├─ index: [mytable.i]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ TableAlias(o)\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.i2]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT /*+ NO_MERGE(d) */ o.i2, d.j FROM othertable o JOIN (SELECT i, i + 1 AS j FROM mytable WHERE s <> 'first row') d ON d.i = o.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [o.i2:2!null, d.j:1!null]\n" +
+			" └─ HashJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ d.i:0!null\n" +
+			"     │   └─ o.i2:2!null\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: d\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ Project\n" +
+			"     │       ├─ columns: [mytable.i:0!null, (mytable.i:0!null + 1 (tinyint)) as j]\n" +
+			"     │       └─ Filter\n" +
+			"     │           ├─ NOT\n" +
+			"     │           │   └─ Eq\n" +
+			"     │           │       ├─ mytable.s:1!null\n" +
+			"     │           │       └─ first row (longtext)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.s]\n" +
+			"     │               ├─ static: [{(first row, ∞)}, {(NULL, first row)}]\n" +
+			"     │               └─ columns: [i s]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE(d.i:0!null)\n" +
+			"         ├─ right-key: TUPLE(o.i2:0!null)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(o)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: othertable\n" +
+			"                     └─ columns: [i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT o.i2, d.j FROM othertable o LEFT JOIN (SELECT i, i + 1 AS j FROM mytable) d ON d.i = o.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [o.i2:0!null, d.j:2]\n" +
+			" └─ LeftOuterHashJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ d.i:1!null\n" +
+			"     │   └─ o.i2:0!null\n" +
+			"     ├─ TableAlias(o)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: othertable\n" +
+			"     │       └─ columns: [i2]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE(o.i2:0!null)\n" +
+			"         ├─ right-key: TUPLE(d.i:0!null)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: d\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Project\n" +
+			"                     ├─ columns: [mytable.i:0!null, (mytable.i:0!null + 1 (tinyint)) as j]\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: mytable\n" +
+			"                         └─ columns: [i]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM (SELECT i, s, row_number() OVER (PARTITION BY s ORDER BY i) rn FROM mytable) d WHERE d.s = 'first row' AND d.rn = 1`,
+		ExpectedPlan: "SubqueryAlias\n" +
+			" ├─ name: d\n" +
+			" ├─ outerVisibility: false\n" +
+			" ├─ cacheable: true\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [mytable.i:0!null, mytable.s:1!null, row_number() over ( partition by mytable.s order by mytable.i ASC):2!null as rn]\n" +
+			"     └─ Filter\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ row_number() over ( partition by mytable.s order by mytable.i ASC):2!null\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ Window\n" +
+			"             ├─ mytable.i:0!null\n" +
+			"             ├─ mytable.s:1!null\n" +
+			"             ├─ row_number() over ( partition by mytable.s order by mytable.i ASC)\n" +
+			"             └─ Filter\n" +
+			"                 ├─ Eq\n" +
+			"                 │   ├─ mytable.s:1!null\n" +
+			"                 │   └─ first row (longtext)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM (SELECT i, s, row_number() OVER (PARTITION BY s ORDER BY i) rn FROM mytable) d WHERE d.i = 1`,
+		ExpectedPlan: "SubqueryAlias\n" +
+			" ├─ name: d\n" +
+			" ├─ outerVisibility: false\n" +
+			" ├─ cacheable: true\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [mytable.i:0!null, mytable.s:1!null, row_number() over ( partition by mytable.s order by mytable.i ASC):2!null as rn]\n" +
+			"     └─ Filter\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ mytable.i:0!null\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ Window\n" +
+			"             ├─ mytable.i:0!null\n" +
+			"             ├─ mytable.s:1!null\n" +
+			"             ├─ row_number() over ( partition by mytable.s order by mytable.i ASC)\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: mytable\n" +
+			"                 └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM (SELECT i, s FROM mytable UNION ALL SELECT i2, s2 FROM othertable) d WHERE d.i = 1`,
+		ExpectedPlan: "SubqueryAlias\n" +
+			" ├─ name: d\n" +
+			" ├─ outerVisibility: false\n" +
+			" ├─ cacheable: true\n" +
+			" └─ Union all\n" +
+			"     ├─ IndexedTableAccess(mytable)\n" +
+			"     │   ├─ index: [mytable.i]\n" +
+			"     │   ├─ static: [{[1, 1]}]\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [othertable.i2:1!null, othertable.s2:0!null]\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.i2]\n" +
+			"             ├─ static: [{[1, 1]}]\n" +
+			"             └─ columns: [s2 i2]\n" +
+			"",
+	},
 	{
 		Query: `SELECT mytable.i FROM mytable INNER JOIN othertable ON (mytable.i = othertable.i2) LEFT JOIN othertable T4 ON (mytable.i = T4.i2) ORDER BY othertable.i2, T4.s2`,
 		ExpectedPlan: "Project\n" +
@@ -2248,14 +2403,10 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM one_pk_two_idx WHERE v1 IN (1, 2) AND v2 <= 2`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ one_pk_two_idx.v1:1\n" +
-			" │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
-			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
-			"     ├─ static: [{[1, 1], (NULL, 2]}, {[2, 2], (NULL, 2]}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(one_pk_two_idx)\n" +
+			" ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			" ├─ static: [{[1, 2], (NULL, 2]}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -2268,14 +2419,11 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM one_pk_three_idx WHERE v1 > 2 AND v3 = 3`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ one_pk_three_idx.v3:3\n" +
-			" │   └─ 3 (tinyint)\n" +
-			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
-			"     ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(one_pk_three_idx)\n" +
+			" ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: [(one_pk_three_idx.v3 = 3)]\n" +
 			"",
 	},
 	{
@@ -2288,18 +2436,22 @@ inner join pq on true
 			"     ├─ columns: [row_number() over ( order by mytable.i DESC):0!null as row_number() over (order by i desc), i2:1!null]\n" +
 			"     └─ Window\n" +
 			"         ├─ row_number() over ( order by mytable.i DESC)\n" +
-			"         ├─ mytable.i:0!null as i2\n" +
+			"         ├─ mytable.i:1!null as i2\n" +
 			"         └─ LookupJoin\n" +
 			"             ├─ Eq\n" +
-			"             │   ├─ mytable.i:0!null\n" +
-			"             │   └─ othertable.i2:1!null\n" +
-			"             ├─ IndexedTableAccess(mytable)\n" +
-			"             │   ├─ index: [mytable.i]\n" +
+			"             │   ├─ mytable.i:1!null\n" +
+			"             │   └─ othertable.i2:0!null\n" +
+			"             ├─ IndexedTableAccess(othertable)\n" +
+			"             │   ├─ index: [othertable.i2]\n" +
 			"             │   ├─ static: [{[2, 2]}]\n" +
-			"             │   └─ columns: [i]\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.i2]\n" +
-			"                 └─ columns: [i2]\n" +
+			"             │   └─ columns: [i2]\n" +
+			"             └─ Filter\n" +
+			"                 ├─ Eq\n" +
+			"                 │   ├─ mytable.i:0!null\n" +
+			"                 │   └─ 2 (tinyint)\n" +
+			"                 └─ IndexedTableAccess(mytable)\n" +
+			"                     ├─ index: [mytable.i]\n" +
+			"                     └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -2604,83 +2756,78 @@ inner join pq on true
 	{
 		Query: `SELECT sub.i, sub.i2, sub.s2, ot.i2, ot.s2 FROM (SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2) sub INNER JOIN othertable ot ON sub.i = ot.i2`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [sub.i:0!null, sub.i2:1!null, sub.s2:2!null, ot.i2:4!null, ot.s2:3!null]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ sub.i:0!null\n" +
-			"     │   └─ ot.i2:4!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: sub\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [mytable.i:2!null, othertable.i2:1!null, othertable.s2:0!null]\n" +
-			"     │       └─ MergeJoin\n" +
-			"     │           ├─ cmp: Eq\n" +
-			"     │           │   ├─ othertable.i2:1!null\n" +
-			"     │           │   └─ mytable.i:2!null\n" +
-			"     │           ├─ IndexedTableAccess(othertable)\n" +
-			"     │           │   ├─ index: [othertable.i2]\n" +
-			"     │           │   ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │   └─ columns: [s2 i2]\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
-			"     │               ├─ static: [{[NULL, ∞)}]\n" +
-			"     │               └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(sub.i:0!null)\n" +
-			"         ├─ right-key: TUPLE(ot.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ TableAlias(ot)\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			" ├─ columns: [mytable.i:2!null, othertable.i2:4!null, othertable.s2:3!null, ot.i2:1!null, ot.s2:0!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ mytable.i:2!null\n" +
+			"     │   │   └─ othertable.i2:4!null\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ othertable.i2:4!null\n" +
+			"     │       └─ ot.i2:1!null\n" +
+			"     ├─ MergeJoin\n" +
+			"     │   ├─ cmp: Eq\n" +
+			"     │   │   ├─ ot.i2:1!null\n" +
+			"     │   │   └─ mytable.i:2!null\n" +
+			"     │   ├─ TableAlias(ot)\n" +
+			"     │   │   └─ IndexedTableAccess(othertable)\n" +
+			"     │   │       ├─ index: [othertable.i2]\n" +
+			"     │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       └─ columns: [s2 i2]\n" +
+			"     │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       ├─ index: [mytable.i]\n" +
+			"     │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │       └─ columns: [i]\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT sub.i, sub.i2, sub.s2, ot.i2, ot.s2 FROM othertable ot INNER JOIN (SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2) sub ON sub.i = ot.i2`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [sub.i:0!null, sub.i2:1!null, sub.s2:2!null, ot.i2:4!null, ot.s2:3!null]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ sub.i:0!null\n" +
-			"     │   └─ ot.i2:4!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: sub\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [mytable.i:2!null, othertable.i2:1!null, othertable.s2:0!null]\n" +
-			"     │       └─ MergeJoin\n" +
-			"     │           ├─ cmp: Eq\n" +
-			"     │           │   ├─ othertable.i2:1!null\n" +
-			"     │           │   └─ mytable.i:2!null\n" +
-			"     │           ├─ IndexedTableAccess(othertable)\n" +
-			"     │           │   ├─ index: [othertable.i2]\n" +
-			"     │           │   ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │   └─ columns: [s2 i2]\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
-			"     │               ├─ static: [{[NULL, ∞)}]\n" +
-			"     │               └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(sub.i:0!null)\n" +
-			"         ├─ right-key: TUPLE(ot.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ TableAlias(ot)\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			" ├─ columns: [mytable.i:0!null, othertable.i2:4!null, othertable.s2:3!null, ot.i2:2!null, ot.s2:1!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ mytable.i:0!null\n" +
+			"     │   │   └─ othertable.i2:4!null\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ ot.i2:2!null\n" +
+			"     │       └─ othertable.i2:4!null\n" +
+			"     ├─ MergeJoin\n" +
+			"     │   ├─ cmp: Eq\n" +
+			"     │   │   ├─ mytable.i:0!null\n" +
+			"     │   │   └─ ot.i2:2!null\n" +
+			"     │   ├─ IndexedTableAccess(mytable)\n" +
+			"     │   │   ├─ index: [mytable.i]\n" +
+			"     │   │   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │   └─ columns: [i]\n" +
+			"     │   └─ TableAlias(ot)\n" +
+			"     │       └─ IndexedTableAccess(othertable)\n" +
+			"     │           ├─ index: [othertable.i2]\n" +
+			"     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           └─ columns: [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT sub.i, sub.i2, sub.s2, ot.i2, ot.s2 FROM othertable ot LEFT JOIN (SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2 WHERE CONVERT(s2, signed) <> 0) sub ON sub.i = ot.i2 WHERE ot.i2 > 0`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [sub.i:2, sub.i2:3, sub.s2:4, ot.i2:1!null, ot.s2:0!null]\n" +
+			" ├─ columns: [mytable.i:4!null, othertable.i2:3!null, othertable.s2:2!null, ot.i2:1!null, ot.s2:0!null]\n" +
 			" └─ LeftOuterJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ sub.i:2!null\n" +
-			"     │   └─ ot.i2:1!null\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ mytable.i:4!null\n" +
+			"     │   │   └─ ot.i2:1!null\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ convert\n" +
+			"     │           │   ├─ type: signed\n" +
+			"     │           │   └─ othertable.s2:2!null\n" +
+			"     │           └─ 0 (tinyint)\n" +
 			"     ├─ Filter\n" +
 			"     │   ├─ GreaterThan\n" +
 			"     │   │   ├─ ot.i2:1!null\n" +
@@ -2690,31 +2837,18 @@ inner join pq on true
 			"     │           ├─ index: [othertable.i2]\n" +
 			"     │           ├─ static: [{(0, ∞)}]\n" +
 			"     │           └─ columns: [s2 i2]\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sub\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ Project\n" +
-			"             ├─ columns: [mytable.i:2!null, othertable.i2:1!null, othertable.s2:0!null]\n" +
-			"             └─ MergeJoin\n" +
-			"                 ├─ cmp: Eq\n" +
-			"                 │   ├─ othertable.i2:1!null\n" +
-			"                 │   └─ mytable.i:2!null\n" +
-			"                 ├─ Filter\n" +
-			"                 │   ├─ NOT\n" +
-			"                 │   │   └─ Eq\n" +
-			"                 │   │       ├─ convert\n" +
-			"                 │   │       │   ├─ type: signed\n" +
-			"                 │   │       │   └─ othertable.s2:0!null\n" +
-			"                 │   │       └─ 0 (tinyint)\n" +
-			"                 │   └─ IndexedTableAccess(othertable)\n" +
-			"                 │       ├─ index: [othertable.i2]\n" +
-			"                 │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                 │       └─ columns: [s2 i2]\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.i]\n" +
-			"                     ├─ static: [{[NULL, ∞)}]\n" +
-			"                     └─ columns: [i]\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ othertable.i2:3!null\n" +
+			"         │   └─ mytable.i:4!null\n" +
+			"         ├─ IndexedTableAccess(othertable)\n" +
+			"         │   ├─ index: [othertable.i2]\n" +
+			"         │   ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   └─ columns: [s2 i2]\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -2790,37 +2924,31 @@ inner join pq on true
 			"     └─ Project\n" +
 			"         ├─ columns: [i:0!null, s:1!null]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [(sub.i:0!null + 10 (tinyint)), ot.s2:3!null]\n" +
-			"             └─ HashJoin\n" +
-			"                 ├─ Eq\n" +
-			"                 │   ├─ sub.i:0!null\n" +
-			"                 │   └─ ot.i2:4!null\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: sub\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [mytable.i:2!null, othertable.i2:1!null, othertable.s2:0!null]\n" +
-			"                 │       └─ MergeJoin\n" +
-			"                 │           ├─ cmp: Eq\n" +
-			"                 │           │   ├─ othertable.i2:1!null\n" +
-			"                 │           │   └─ mytable.i:2!null\n" +
-			"                 │           ├─ IndexedTableAccess(othertable)\n" +
-			"                 │           │   ├─ index: [othertable.i2]\n" +
-			"                 │           │   ├─ static: [{[NULL, ∞)}]\n" +
-			"                 │           │   └─ columns: [s2 i2]\n" +
-			"                 │           └─ IndexedTableAccess(mytable)\n" +
-			"                 │               ├─ index: [mytable.i]\n" +
-			"                 │               ├─ static: [{[NULL, ∞)}]\n" +
-			"                 │               └─ columns: [i]\n" +
-			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: TUPLE(sub.i:0!null)\n" +
-			"                     ├─ right-key: TUPLE(ot.i2:1!null)\n" +
-			"                     └─ CachedResults\n" +
-			"                         └─ TableAlias(ot)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: othertable\n" +
-			"                                 └─ columns: [s2 i2]\n" +
+			"             ├─ columns: [(mytable.i:0!null + 10 (tinyint)) as (sub.i + 10), ot.s2:1!null]\n" +
+			"             └─ LookupJoin\n" +
+			"                 ├─ AND\n" +
+			"                 │   ├─ Eq\n" +
+			"                 │   │   ├─ mytable.i:0!null\n" +
+			"                 │   │   └─ othertable.i2:4!null\n" +
+			"                 │   └─ Eq\n" +
+			"                 │       ├─ ot.i2:2!null\n" +
+			"                 │       └─ othertable.i2:4!null\n" +
+			"                 ├─ MergeJoin\n" +
+			"                 │   ├─ cmp: Eq\n" +
+			"                 │   │   ├─ mytable.i:0!null\n" +
+			"                 │   │   └─ ot.i2:2!null\n" +
+			"                 │   ├─ IndexedTableAccess(mytable)\n" +
+			"                 │   │   ├─ index: [mytable.i]\n" +
+			"                 │   │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                 │   │   └─ columns: [i]\n" +
+			"                 │   └─ TableAlias(ot)\n" +
+			"                 │       └─ IndexedTableAccess(othertable)\n" +
+			"                 │           ├─ index: [othertable.i2]\n" +
+			"                 │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                 │           └─ columns: [s2 i2]\n" +
+			"                 └─ IndexedTableAccess(othertable)\n" +
+			"                     ├─ index: [othertable.i2]\n" +
+			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
@@ -3094,76 +3222,50 @@ inner join pq on true
 		Query: `SELECT /*+ JOIN_ORDER(mytable, othertable) */ s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [othertable.s2:1!null, othertable.i2:2!null, mytable.i:0!null]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ othertable.i2:2!null\n" +
-			"     │   └─ mytable.i:0!null\n" +
-			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ othertable.i2:2!null\n" +
+			"     ├─ IndexedTableAccess(mytable)\n" +
+			"     │   ├─ index: [mytable.i]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(mytable.i:0!null)\n" +
-			"         ├─ right-key: TUPLE(othertable.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: othertable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable LEFT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [othertable.s2:1, othertable.i2:2, mytable.i:0!null]\n" +
-			" └─ LeftOuterHashJoin\n" +
+			" ├─ columns: [othertable.s2:1!null, othertable.i2:2!null, mytable.i:0!null]\n" +
+			" └─ LeftOuterLookupJoin\n" +
 			"     ├─ Eq\n" +
 			"     │   ├─ othertable.i2:2!null\n" +
 			"     │   └─ mytable.i:0!null\n" +
 			"     ├─ Table\n" +
 			"     │   ├─ name: mytable\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(mytable.i:0!null)\n" +
-			"         ├─ right-key: TUPLE(othertable.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: othertable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM (SELECT * FROM mytable) mytable RIGHT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [othertable.s2:0!null, othertable.i2:1!null, mytable.i:2]\n" +
-			" └─ LeftOuterHashJoin\n" +
+			" ├─ columns: [othertable.s2:0!null, othertable.i2:1!null, mytable.i:2!null]\n" +
+			" └─ LeftOuterLookupJoin\n" +
 			"     ├─ Eq\n" +
 			"     │   ├─ othertable.i2:1!null\n" +
 			"     │   └─ mytable.i:2!null\n" +
-			"     ├─ SubqueryAlias\n" +
+			"     ├─ Table\n" +
 			"     │   ├─ name: othertable\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Table\n" +
-			"     │       ├─ name: othertable\n" +
-			"     │       └─ columns: [s2 i2]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(othertable.i2:1!null)\n" +
-			"         ├─ right-key: TUPLE(mytable.i:0!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: mytable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i s]\n" +
+			"     │   └─ columns: [s2 i2]\n" +
+			"     └─ IndexedTableAccess(mytable)\n" +
+			"         ├─ index: [mytable.i]\n" +
+			"         └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -3258,9 +3360,13 @@ inner join pq on true
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.s:2!null\n" +
 			"     ├─ Filter\n" +
-			"     │   ├─ HashIn\n" +
-			"     │   │   ├─ a.i:0!null\n" +
-			"     │   │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ a.i:0!null\n" +
+			"     │   │   │   └─ 1 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ a.i:0!null\n" +
+			"     │   │       └─ 4 (tinyint)\n" +
 			"     │   └─ TableAlias(a)\n" +
 			"     │       └─ IndexedTableAccess(mytable)\n" +
 			"     │           ├─ index: [mytable.i]\n" +
@@ -3275,26 +3381,18 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM mytable WHERE i in (1, 2, 3, 4)`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ mytable.i:0!null\n" +
-			" │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
-			"     ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
-			"     └─ columns: [i s]\n" +
+		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
+			" ├─ index: [mytable.i]\n" +
+			" ├─ static: [{[1, 4]}]\n" +
+			" └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM mytable WHERE i in (1, 1)`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ mytable.i:0!null\n" +
-			" │   └─ TUPLE(1 (tinyint), 1 (tinyint))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
-			"     ├─ static: [{[1, 1]}]\n" +
-			"     └─ columns: [i s]\n" +
+		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
+			" ├─ index: [mytable.i]\n" +
+			" ├─ static: [{[1, 1]}]\n" +
+			" └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -3451,9 +3549,9 @@ inner join pq on true
 	{
 		Query: `SELECT * from mytable WHERE s IN (cast('first row' AS CHAR))`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
+			" ├─ Eq\n" +
 			" │   ├─ mytable.s:1!null\n" +
-			" │   └─ TUPLE(first row (longtext))\n" +
+			" │   └─ first row (longtext)\n" +
 			" └─ IndexedTableAccess(mytable)\n" +
 			"     ├─ index: [mytable.s]\n" +
 			"     ├─ static: [{[first row, first row]}]\n" +
@@ -3660,24 +3758,35 @@ inner join pq on true
 			"     │   │   └─ Eq\n" +
 			"     │   │       ├─ b.i:0!null\n" +
 			"     │   │       └─ d.i:3!null\n" +
-			"     │   ├─ MergeJoin\n" +
-			"     │   │   ├─ cmp: Eq\n" +
-			"     │   │   │   ├─ b.i:0!null\n" +
-			"     │   │   │   └─ a.i:1!null\n" +
-			"     │   │   ├─ TableAlias(b)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.i]\n" +
-			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │   │       └─ columns: [i]\n" +
-			"     │   │   └─ TableAlias(a)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
-			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │           └─ columns: [i s]\n" +
-			"     │   └─ TableAlias(d)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
-			"     │           └─ columns: [i]\n" +
+			"     │   ├─ LookupJoin\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ a.i:1!null\n" +
+			"     │   │   │   └─ b.i:0!null\n" +
+			"     │   │   ├─ Filter\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ b.i:0!null\n" +
+			"     │   │   │   │   └─ 2 (tinyint)\n" +
+			"     │   │   │   └─ TableAlias(b)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable)\n" +
+			"     │   │   │           ├─ index: [mytable.i]\n" +
+			"     │   │   │           ├─ static: [{[2, 2]}]\n" +
+			"     │   │   │           └─ columns: [i]\n" +
+			"     │   │   └─ Filter\n" +
+			"     │   │       ├─ Eq\n" +
+			"     │   │       │   ├─ a.i:0!null\n" +
+			"     │   │       │   └─ 2 (tinyint)\n" +
+			"     │   │       └─ TableAlias(a)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable)\n" +
+			"     │   │               ├─ index: [mytable.i]\n" +
+			"     │   │               └─ columns: [i s]\n" +
+			"     │   └─ Filter\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ d.i:0!null\n" +
+			"     │       │   └─ 2 (tinyint)\n" +
+			"     │       └─ TableAlias(d)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               └─ columns: [i]\n" +
 			"     └─ Filter\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ c.i:0!null\n" +
@@ -3927,29 +4036,40 @@ inner join pq on true
 			"     │   │   └─ Eq\n" +
 			"     │   │       ├─ b.i:0!null\n" +
 			"     │   │       └─ d.i:3!null\n" +
-			"     │   ├─ MergeJoin\n" +
-			"     │   │   ├─ cmp: Eq\n" +
-			"     │   │   │   ├─ b.i:0!null\n" +
-			"     │   │   │   └─ a.i:1!null\n" +
-			"     │   │   ├─ TableAlias(b)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.i]\n" +
-			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │   │       └─ columns: [i]\n" +
-			"     │   │   └─ TableAlias(a)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
-			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │           └─ columns: [i s]\n" +
-			"     │   └─ TableAlias(d)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
-			"     │           └─ columns: [i]\n" +
-			"     └─ Filter\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ c.i:0!null\n" +
-			"         │   └─ 2 (tinyint)\n" +
-			"         └─ TableAlias(c)\n" +
+			"     │   ├─ LookupJoin\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ a.i:1!null\n" +
+			"     │   │   │   └─ b.i:0!null\n" +
+			"     │   │   ├─ Filter\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ b.i:0!null\n" +
+			"     │   │   │   │   └─ 2 (tinyint)\n" +
+			"     │   │   │   └─ TableAlias(b)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable)\n" +
+			"     │   │   │           ├─ index: [mytable.i]\n" +
+			"     │   │   │           ├─ static: [{[2, 2]}]\n" +
+			"     │   │   │           └─ columns: [i]\n" +
+			"     │   │   └─ Filter\n" +
+			"     │   │       ├─ Eq\n" +
+			"     │   │       │   ├─ a.i:0!null\n" +
+			"     │   │       │   └─ 2 (tinyint)\n" +
+			"     │   │       └─ TableAlias(a)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable)\n" +
+			"     │   │               ├─ index: [mytable.i]\n" +
+			"     │   │               └─ columns: [i s]\n" +
+			"     │   └─ Filter\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ d.i:0!null\n" +
+			"     │       │   └─ 2 (tinyint)\n" +
+			"     │       └─ TableAlias(d)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               └─ columns: [i]\n" +
+			"     └─ Filter\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ c.i:0!null\n" +
+			"         │   └─ 2 (tinyint)\n" +
+			"         └─ TableAlias(c)\n" +
 			"             └─ IndexedTableAccess(mytable)\n" +
 			"                 ├─ index: [mytable.i]\n" +
 			"                 └─ columns: [i]\n" +
@@ -4066,17 +4186,17 @@ inner join pq on true
 			JOIN (SELECT * FROM mytable) righttable
 			ON lefttable.i = righttable.i AND righttable.s = lefttable.s
 			ORDER BY lefttable.i ASC`,
-		ExpectedPlan: "Sort(lefttable.i:0!null ASC nullsFirst)\n" +
+		ExpectedPlan: "Sort(mytable.i:0!null ASC nullsFirst)\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [lefttable.i:2!null, righttable.s:1!null]\n" +
+			"     ├─ columns: [mytable.i:2!null, righttable.s:1!null]\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ AND\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ lefttable.i:2!null\n" +
+			"         │   │   ├─ mytable.i:2!null\n" +
 			"         │   │   └─ righttable.i:0!null\n" +
 			"         │   └─ Eq\n" +
 			"         │       ├─ righttable.s:1!null\n" +
-			"         │       └─ lefttable.s:3!null\n" +
+			"         │       └─ mytable.s:3!null\n" +
 			"         ├─ SubqueryAlias\n" +
 			"         │   ├─ name: righttable\n" +
 			"         │   ├─ outerVisibility: false\n" +
@@ -4086,126 +4206,84 @@ inner join pq on true
 			"         │       └─ columns: [i s]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ left-key: TUPLE(righttable.i:0!null, righttable.s:1!null)\n" +
-			"             ├─ right-key: TUPLE(lefttable.i:0!null, lefttable.s:1!null)\n" +
+			"             ├─ right-key: TUPLE(mytable.i:0!null, mytable.s:1!null)\n" +
 			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: lefttable\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
-			"                         └─ columns: [i s]\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable RIGHT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
-		ExpectedPlan: "LeftOuterHashJoin\n" +
+		ExpectedPlan: "LeftOuterLookupJoin\n" +
 			" ├─ Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
+			" ├─ Table\n" +
 			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
-			" │       └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ left-key: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ right-key: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" │   └─ columns: [s2 i2]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
-		ExpectedPlan: "HashJoin\n" +
-			" ├─ Eq\n" +
+		ExpectedPlan: "MergeJoin\n" +
+			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
-			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
-			" │       └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ left-key: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ right-key: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" ├─ IndexedTableAccess(othertable)\n" +
+			" │   ├─ index: [othertable.i2]\n" +
+			" │   ├─ static: [{[NULL, ∞)}]\n" +
+			" │   └─ columns: [s2 i2]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable) othertable_alias WHERE s2 = 'a'`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ Filter\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ othertable.s2:0!null\n" +
-			"     │   └─ a (longtext)\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.s2]\n" +
-			"         ├─ static: [{[a, a]}]\n" +
-			"         └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ Eq\n" +
+			" │   ├─ othertable.s2:0!null\n" +
+			" │   └─ a (longtext)\n" +
+			" └─ IndexedTableAccess(othertable)\n" +
+			"     ├─ index: [othertable.s2]\n" +
+			"     ├─ static: [{[a, a]}]\n" +
+			"     └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM (SELECT * FROM (SELECT * FROM othertable) othertable_one) othertable_two) othertable_three WHERE s2 = 'a'`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_three\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: othertable_two\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: othertable_one\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ Filter\n" +
-			"             ├─ Eq\n" +
-			"             │   ├─ othertable.s2:0!null\n" +
-			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.s2]\n" +
-			"                 ├─ static: [{[a, a]}]\n" +
-			"                 └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ Eq\n" +
+			" │   ├─ othertable.s2:0!null\n" +
+			" │   └─ a (longtext)\n" +
+			" └─ IndexedTableAccess(othertable)\n" +
+			"     ├─ index: [othertable.s2]\n" +
+			"     ├─ static: [{[a, a]}]\n" +
+			"     └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT othertable.s2, othertable.i2, mytable.i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON othertable.i2 = mytable.i WHERE othertable.s2 > 'a'`,
-		ExpectedPlan: "HashJoin\n" +
-			" ├─ Eq\n" +
+		ExpectedPlan: "MergeJoin\n" +
+			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
-			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Filter\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ othertable.s2:0!null\n" +
-			" │       │   └─ a (longtext)\n" +
-			" │       └─ IndexedTableAccess(othertable)\n" +
-			" │           ├─ index: [othertable.s2]\n" +
-			" │           ├─ static: [{(a, ∞)}]\n" +
-			" │           └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ left-key: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ right-key: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" ├─ sel: GreaterThan\n" +
+			" │   ├─ othertable.s2:0!null\n" +
+			" │   └─ a (longtext)\n" +
+			" ├─ IndexedTableAccess(othertable)\n" +
+			" │   ├─ index: [othertable.i2]\n" +
+			" │   ├─ static: [{[NULL, ∞)}]\n" +
+			" │   └─ columns: [s2 i2]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -4532,26 +4610,18 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable) othertable_alias WHERE othertable_alias.i2 = 1`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
-			"     ├─ static: [{[1, 1]}]\n" +
-			"     └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "IndexedTableAccess(othertable)\n" +
+			" ├─ index: [othertable.i2]\n" +
+			" ├─ static: [{[1, 1]}]\n" +
+			" └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable WHERE i2 = 1) othertable_alias WHERE othertable_alias.i2 = 1`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
-			"     ├─ static: [{[1, 1]}]\n" +
-			"     └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "IndexedTableAccess(othertable)\n" +
+			" ├─ index: [othertable.i2]\n" +
+			" ├─ static: [{[1, 1]}]\n" +
+			" └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
@@ -4565,20 +4635,20 @@ inner join pq on true
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100`,
 		ExpectedPlan: "Limit(100)\n" +
-			" └─ TopN(Limit: [100 (tinyint)]; datetime_table.date_col:1 ASC nullsFirst)\n" +
-			"     └─ Table\n" +
-			"         ├─ name: datetime_table\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ IndexedTableAccess(datetime_table)\n" +
+			"     ├─ index: [datetime_table.date_col]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100 OFFSET 100`,
 		ExpectedPlan: "Limit(100)\n" +
-			" └─ Offset(100)\n" +
-			"     └─ TopN(Limit: [(100 + 100)]; datetime_table.date_col ASC)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: datetime_table\n" +
-			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ IndexedTableAccess(datetime_table)\n" +
+			"     ├─ index: [datetime_table.date_col]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ offset: 100\n" +
 			"",
 	},
 	{
@@ -4884,34 +4954,22 @@ inner join pq on true
 						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [one_pk.pk:2!null]\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ tpk2.pk1:3!null\n" +
-			"     │   │   └─ tpk.pk2:1!null\n" +
-			"     │   └─ Eq\n" +
-			"     │       ├─ tpk2.pk2:4!null\n" +
-			"     │       └─ tpk.pk1:0!null\n" +
-			"     ├─ MergeJoin\n" +
-			"     │   ├─ cmp: Eq\n" +
-			"     │   │   ├─ tpk.pk1:0!null\n" +
-			"     │   │   └─ one_pk.pk:2!null\n" +
-			"     │   ├─ sel: Eq\n" +
-			"     │   │   ├─ one_pk.pk:2!null\n" +
-			"     │   │   └─ tpk.pk2:1!null\n" +
-			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     │   │       └─ columns: [pk1 pk2]\n" +
-			"     │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       ├─ index: [one_pk.pk]\n" +
-			"     │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │       └─ columns: [pk]\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"             └─ columns: [pk1 pk2]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ tpk.pk1:0!null\n" +
+			"     │   └─ one_pk.pk:2!null\n" +
+			"     ├─ sel: Eq\n" +
+			"     │   ├─ one_pk.pk:2!null\n" +
+			"     │   └─ tpk.pk2:1!null\n" +
+			"     ├─ TableAlias(tpk)\n" +
+			"     │   └─ IndexedTableAccess(two_pk)\n" +
+			"     │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       └─ columns: [pk1 pk2]\n" +
+			"     └─ IndexedTableAccess(one_pk)\n" +
+			"         ├─ index: [one_pk.pk]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -4964,27 +5022,15 @@ inner join pq on true
 			" └─ LeftOuterLookupJoin\n" +
 			"     ├─ AND\n" +
 			"     │   ├─ Eq\n" +
-			"     │   │   ├─ tpk2.pk1:3!null\n" +
-			"     │   │   └─ tpk.pk2:2!null\n" +
+			"     │   │   ├─ one_pk.pk:0!null\n" +
+			"     │   │   └─ tpk.pk1:1!null\n" +
 			"     │   └─ Eq\n" +
-			"     │       ├─ tpk2.pk2:4!null\n" +
-			"     │       └─ tpk.pk1:1!null\n" +
-			"     ├─ LeftOuterLookupJoin\n" +
-			"     │   ├─ AND\n" +
-			"     │   │   ├─ Eq\n" +
-			"     │   │   │   ├─ one_pk.pk:0!null\n" +
-			"     │   │   │   └─ tpk.pk1:1!null\n" +
-			"     │   │   └─ Eq\n" +
-			"     │   │       ├─ one_pk.pk:0!null\n" +
-			"     │   │       └─ tpk.pk2:2!null\n" +
-			"     │   ├─ Table\n" +
-			"     │   │   ├─ name: one_pk\n" +
-			"     │   │   └─ columns: [pk]\n" +
-			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk)\n" +
-			"     │           ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     │           └─ columns: [pk1 pk2]\n" +
-			"     └─ TableAlias(tpk2)\n" +
+			"     │       ├─ one_pk.pk:0!null\n" +
+			"     │       └─ tpk.pk2:2!null\n" +
+			"     ├─ Table\n" +
+			"     │   ├─ name: one_pk\n" +
+			"     │   └─ columns: [pk]\n" +
+			"     └─ TableAlias(tpk)\n" +
 			"         └─ IndexedTableAccess(two_pk)\n" +
 			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
@@ -4995,58 +5041,39 @@ inner join pq on true
 						LEFT JOIN two_pk tpk ON one_pk.pk=tpk.pk1 AND one_pk.pk=tpk.pk2
 						JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:0!null]\n" +
+			" ├─ columns: [one_pk.pk:2!null]\n" +
 			" └─ LookupJoin\n" +
 			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ tpk2.pk1:3!null\n" +
-			"     │   │   └─ tpk.pk2:2!null\n" +
-			"     │   └─ Eq\n" +
-			"     │       ├─ tpk2.pk2:4!null\n" +
-			"     │       └─ tpk.pk1:1!null\n" +
-			"     ├─ LeftOuterLookupJoin\n" +
 			"     │   ├─ AND\n" +
-			"     │   │   ├─ Eq\n" +
-			"     │   │   │   ├─ one_pk.pk:0!null\n" +
-			"     │   │   │   └─ tpk.pk1:1!null\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   │   ├─ one_pk.pk:2!null\n" +
+			"     │   │   │   │   │   │   └─ tpk.pk1:3!null\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ one_pk.pk:2!null\n" +
+			"     │   │   │   │   │       └─ tpk.pk2:4!null\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ tpk2.pk1:0!null\n" +
+			"     │   │   │   │       └─ tpk.pk2:4!null\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ tpk2.pk2:1!null\n" +
+			"     │   │   │       └─ tpk.pk1:3!null\n" +
 			"     │   │   └─ Eq\n" +
-			"     │   │       ├─ one_pk.pk:0!null\n" +
-			"     │   │       └─ tpk.pk2:2!null\n" +
-			"     │   ├─ Table\n" +
-			"     │   │   ├─ name: one_pk\n" +
-			"     │   │   └─ columns: [pk]\n" +
-			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk)\n" +
-			"     │           ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"     │           └─ columns: [pk1 pk2]\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
-			"             └─ columns: [pk1 pk2]\n" +
-			"",
-	},
-	{
-		Query: `SELECT pk FROM one_pk
-						JOIN two_pk tpk ON one_pk.pk=tpk.pk1 AND one_pk.pk=tpk.pk2
-						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:2!null]\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ tpk2.pk1:3!null\n" +
-			"     │   │   └─ tpk.pk2:1!null\n" +
+			"     │   │       ├─ tpk.pk1:3!null\n" +
+			"     │   │       └─ tpk2.pk1:0!null\n" +
 			"     │   └─ Eq\n" +
-			"     │       ├─ tpk2.pk2:4!null\n" +
-			"     │       └─ tpk.pk1:0!null\n" +
+			"     │       ├─ tpk.pk2:4!null\n" +
+			"     │       └─ tpk2.pk2:1!null\n" +
 			"     ├─ MergeJoin\n" +
 			"     │   ├─ cmp: Eq\n" +
-			"     │   │   ├─ tpk.pk1:0!null\n" +
+			"     │   │   ├─ tpk2.pk1:0!null\n" +
 			"     │   │   └─ one_pk.pk:2!null\n" +
 			"     │   ├─ sel: Eq\n" +
 			"     │   │   ├─ one_pk.pk:2!null\n" +
-			"     │   │   └─ tpk.pk2:1!null\n" +
-			"     │   ├─ TableAlias(tpk)\n" +
+			"     │   │   └─ tpk2.pk2:1!null\n" +
+			"     │   ├─ TableAlias(tpk2)\n" +
 			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
 			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
@@ -5055,12 +5082,36 @@ inner join pq on true
 			"     │       ├─ index: [one_pk.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk]\n" +
-			"     └─ TableAlias(tpk2)\n" +
+			"     └─ TableAlias(tpk)\n" +
 			"         └─ IndexedTableAccess(two_pk)\n" +
 			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
+	{
+		Query: `SELECT pk FROM one_pk
+						JOIN two_pk tpk ON one_pk.pk=tpk.pk1 AND one_pk.pk=tpk.pk2
+						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk.pk:2!null]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ tpk.pk1:0!null\n" +
+			"     │   └─ one_pk.pk:2!null\n" +
+			"     ├─ sel: Eq\n" +
+			"     │   ├─ one_pk.pk:2!null\n" +
+			"     │   └─ tpk.pk2:1!null\n" +
+			"     ├─ TableAlias(tpk)\n" +
+			"     │   └─ IndexedTableAccess(two_pk)\n" +
+			"     │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       └─ columns: [pk1 pk2]\n" +
+			"     └─ IndexedTableAccess(one_pk)\n" +
+			"         ├─ index: [one_pk.pk]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [pk]\n" +
+			"",
+	},
 	{
 		Query: `SELECT pk FROM one_pk
 						RIGHT JOIN two_pk tpk ON one_pk.pk=tpk.pk1 AND one_pk.pk=tpk.pk2
@@ -5235,57 +5286,67 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE f IS NOT NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ NOT\n" +
-			" │   └─ niltable.f:2 IS NULL\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ Eq\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
-			"         └─ columns: [i f]\n" +
+			"     └─ Filter\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ niltable.f:1 IS NULL\n" +
+			"         └─ IndexedTableAccess(niltable)\n" +
+			"             ├─ index: [niltable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i f]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE i2 > 1`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:3]\n" +
-			" └─ Filter\n" +
-			"     ├─ GreaterThan\n" +
-			"     │   ├─ niltable.i2:2\n" +
-			"     │   └─ 1 (tinyint)\n" +
-			"     └─ LeftOuterLookupJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ one_pk.pk:0!null\n" +
-			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ Table\n" +
-			"         │   ├─ name: one_pk\n" +
-			"         │   └─ columns: [pk]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ one_pk.pk:0!null\n" +
+			"     │   └─ niltable.i:1!null\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   └─ columns: [pk]\n" +
+			"     └─ Filter\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ niltable.i2:1\n" +
+			"         │   └─ 1 (tinyint)\n" +
 			"         └─ IndexedTableAccess(niltable)\n" +
 			"             ├─ index: [niltable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 f]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE i > 1`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ niltable.i:1\n" +
-			" │   └─ 1 (tinyint)\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ Eq\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
-			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     │   └─ niltable.i:1\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
-			"         └─ columns: [i f]\n" +
+			"     └─ Filter\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ niltable.i:0\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ IndexedTableAccess(niltable)\n" +
+			"             ├─ index: [niltable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -5350,38 +5411,35 @@ inner join pq on true
 		ExpectedPlan: "Sort(l.i:0!null ASC nullsFirst)\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [l.i:1!null, r.i2:0]\n" +
-			"     └─ LookupJoin\n" +
-			"         ├─ (l.i2:2 <=> r.i2:0)\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: (r.i2:0 <=> l.i2:2)\n" +
 			"         ├─ TableAlias(r)\n" +
-			"         │   └─ Table\n" +
-			"         │       ├─ name: niltable\n" +
+			"         │   └─ IndexedTableAccess(niltable)\n" +
+			"         │       ├─ index: [niltable.i2]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i2]\n" +
 			"         └─ TableAlias(l)\n" +
 			"             └─ IndexedTableAccess(niltable)\n" +
 			"                 ├─ index: [niltable.i2]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i WHERE pk > 0`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:2, niltable.i:0!null, niltable.f:1]\n" +
-			" └─ Filter\n" +
-			"     ├─ GreaterThan\n" +
-			"     │   ├─ one_pk.pk:2\n" +
-			"     │   └─ 0 (tinyint)\n" +
-			"     └─ LeftOuterMergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ niltable.i:0!null\n" +
-			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
-			"         │   ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [pk]\n" +
+			" ├─ columns: [one_pk.pk:0, niltable.i:1!null, niltable.f:2]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ one_pk.pk:0\n" +
+			"     │   └─ niltable.i:1!null\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{(0, ∞)}]\n" +
+			"     │   └─ columns: [pk]\n" +
+			"     └─ IndexedTableAccess(niltable)\n" +
+			"         ├─ index: [niltable.i]\n" +
+			"         └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -5725,19 +5783,23 @@ inner join pq on true
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE f IS NOT NULL ORDER BY 1`,
 		ExpectedPlan: "Sort(one_pk.pk:0!null ASC nullsFirst)\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ niltable.f:2 IS NULL\n" +
-			"     └─ LeftOuterLookupJoin\n" +
-			"         ├─ Eq\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ Table\n" +
-			"         │   ├─ name: one_pk\n" +
+			"         ├─ IndexedTableAccess(one_pk)\n" +
+			"         │   ├─ index: [one_pk.pk]\n" +
+			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
-			"             └─ columns: [i f]\n" +
+			"         └─ Filter\n" +
+			"             ├─ NOT\n" +
+			"             │   └─ niltable.f:1 IS NULL\n" +
+			"             └─ IndexedTableAccess(niltable)\n" +
+			"                 ├─ index: [niltable.i]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -5802,23 +5864,18 @@ inner join pq on true
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i WHERE pk > 0 ORDER BY 2,3`,
 		ExpectedPlan: "Sort(niltable.i:1!null ASC nullsFirst, niltable.f:2 ASC nullsFirst)\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [one_pk.pk:2, niltable.i:0!null, niltable.f:1]\n" +
-			"     └─ Filter\n" +
-			"         ├─ GreaterThan\n" +
-			"         │   ├─ one_pk.pk:2\n" +
-			"         │   └─ 0 (tinyint)\n" +
-			"         └─ LeftOuterMergeJoin\n" +
-			"             ├─ cmp: Eq\n" +
-			"             │   ├─ niltable.i:0!null\n" +
-			"             │   └─ one_pk.pk:2!null\n" +
-			"             ├─ IndexedTableAccess(niltable)\n" +
-			"             │   ├─ index: [niltable.i]\n" +
-			"             │   ├─ static: [{[NULL, ∞)}]\n" +
-			"             │   └─ columns: [i f]\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [pk]\n" +
+			"     ├─ columns: [one_pk.pk:0, niltable.i:1!null, niltable.f:2]\n" +
+			"     └─ LookupJoin\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ one_pk.pk:0\n" +
+			"         │   └─ niltable.i:1!null\n" +
+			"         ├─ IndexedTableAccess(one_pk)\n" +
+			"         │   ├─ index: [one_pk.pk]\n" +
+			"         │   ├─ static: [{(0, ∞)}]\n" +
+			"         │   └─ columns: [pk]\n" +
+			"         └─ IndexedTableAccess(niltable)\n" +
+			"             ├─ index: [niltable.i]\n" +
+			"             └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -6527,46 +6584,34 @@ inner join pq on true
 		ExpectedPlan: "IndexedTableAccess(invert_pk)\n" +
 			" ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			" ├─ static: [{[0, ∞), (NULL, 1), [NULL, ∞)}]\n" +
-			" └─ columns: [x y z]\n" +
-			"",
-	},
-	{
-		Query: `SELECT * FROM one_pk WHERE pk IN (1)`,
-		ExpectedPlan: "IndexedTableAccess(one_pk)\n" +
-			" ├─ index: [one_pk.pk]\n" +
-			" ├─ static: [{[1, 1]}]\n" +
-			" └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"",
-	},
-	{
-		Query: `SELECT a.* FROM one_pk a CROSS JOIN one_pk c LEFT JOIN one_pk b ON b.pk = c.pk and b.pk = a.pk`,
-		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.pk:1!null, a.c1:2, a.c2:3, a.c3:4, a.c4:5, a.c5:6]\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ b.pk:7!null\n" +
-			"     │   │   └─ c.pk:0!null\n" +
-			"     │   └─ Eq\n" +
-			"     │       ├─ b.pk:7!null\n" +
-			"     │       └─ a.pk:1!null\n" +
-			"     ├─ CrossHashJoin\n" +
-			"     │   ├─ TableAlias(c)\n" +
-			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk\n" +
-			"     │   │       └─ columns: [pk]\n" +
-			"     │   └─ HashLookup\n" +
-			"     │       ├─ left-key: TUPLE()\n" +
-			"     │       ├─ right-key: TUPLE()\n" +
-			"     │       └─ CachedResults\n" +
-			"     │           └─ TableAlias(a)\n" +
-			"     │               └─ Table\n" +
-			"     │                   ├─ name: one_pk\n" +
-			"     │                   └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
-			"             └─ columns: [pk]\n" +
+			" └─ columns: [x y z]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk WHERE pk IN (1)`,
+		ExpectedPlan: "IndexedTableAccess(one_pk)\n" +
+			" ├─ index: [one_pk.pk]\n" +
+			" ├─ static: [{[1, 1]}]\n" +
+			" └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"",
+	},
+	{
+		Query: `SELECT a.* FROM one_pk a CROSS JOIN one_pk c LEFT JOIN one_pk b ON b.pk = c.pk and b.pk = a.pk`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.pk:1!null, a.c1:2, a.c2:3, a.c3:4, a.c4:5, a.c5:6]\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ TableAlias(c)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: one_pk\n" +
+			"     │       └─ columns: [pk]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(a)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: one_pk\n" +
+			"                     └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"",
 	},
 	{
@@ -6638,71 +6683,57 @@ inner join pq on true
 		Query: `SELECT a.* FROM one_pk a CROSS JOIN one_pk b INNER JOIN one_pk c ON b.pk = c.pk LEFT JOIN one_pk d ON c.pk = d.pk`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.pk:0!null, a.c1:1, a.c2:2, a.c3:3, a.c4:4, a.c5:5]\n" +
-			" └─ LeftOuterLookupJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ c.pk:6!null\n" +
-			"     │   └─ d.pk:8!null\n" +
-			"     ├─ CrossHashJoin\n" +
-			"     │   ├─ TableAlias(a)\n" +
-			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk\n" +
-			"     │   │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"     │   └─ HashLookup\n" +
-			"     │       ├─ left-key: TUPLE()\n" +
-			"     │       ├─ right-key: TUPLE()\n" +
-			"     │       └─ CachedResults\n" +
-			"     │           └─ MergeJoin\n" +
-			"     │               ├─ cmp: Eq\n" +
-			"     │               │   ├─ c.pk:6!null\n" +
-			"     │               │   └─ b.pk:7!null\n" +
-			"     │               ├─ TableAlias(c)\n" +
-			"     │               │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │               │       ├─ index: [one_pk.pk]\n" +
-			"     │               │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │               │       └─ columns: [pk]\n" +
-			"     │               └─ TableAlias(b)\n" +
-			"     │                   └─ IndexedTableAccess(one_pk)\n" +
-			"     │                       ├─ index: [one_pk.pk]\n" +
-			"     │                       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                       └─ columns: [pk]\n" +
-			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
-			"             └─ columns: [pk]\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ TableAlias(a)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: one_pk\n" +
+			"     │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
+			"         └─ CachedResults\n" +
+			"             └─ MergeJoin\n" +
+			"                 ├─ cmp: Eq\n" +
+			"                 │   ├─ c.pk:6!null\n" +
+			"                 │   └─ b.pk:7!null\n" +
+			"                 ├─ TableAlias(c)\n" +
+			"                 │   └─ IndexedTableAccess(one_pk)\n" +
+			"                 │       ├─ index: [one_pk.pk]\n" +
+			"                 │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                 │       └─ columns: [pk]\n" +
+			"                 └─ TableAlias(b)\n" +
+			"                     └─ IndexedTableAccess(one_pk)\n" +
+			"                         ├─ index: [one_pk.pk]\n" +
+			"                         ├─ static: [{[NULL, ∞)}]\n" +
+			"                         └─ columns: [pk]\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM one_pk a CROSS JOIN one_pk c INNER JOIN (select * from one_pk) b ON b.pk = c.pk`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.pk:7!null, a.c1:8, a.c2:9, a.c3:10, a.c4:11, a.c5:12]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ b.pk:0!null\n" +
-			"     │   └─ c.pk:6!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: b\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
+			" ├─ columns: [a.pk:0!null, a.c1:1, a.c2:2, a.c3:3, a.c4:4, a.c5:5]\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: one_pk\n" +
 			"     │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE(b.pk:0!null)\n" +
-			"         ├─ right-key: TUPLE(c.pk:0!null)\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
 			"         └─ CachedResults\n" +
-			"             └─ CrossHashJoin\n" +
-			"                 ├─ TableAlias(c)\n" +
-			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
-			"                 │       └─ columns: [pk]\n" +
-			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: TUPLE()\n" +
-			"                     ├─ right-key: TUPLE()\n" +
-			"                     └─ CachedResults\n" +
-			"                         └─ TableAlias(a)\n" +
-			"                             └─ Table\n" +
-			"                                 ├─ name: one_pk\n" +
-			"                                 └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"             └─ MergeJoin\n" +
+			"                 ├─ cmp: Eq\n" +
+			"                 │   ├─ one_pk.pk:6!null\n" +
+			"                 │   └─ c.pk:12!null\n" +
+			"                 ├─ IndexedTableAccess(one_pk)\n" +
+			"                 │   ├─ index: [one_pk.pk]\n" +
+			"                 │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                 │   └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"                 └─ TableAlias(c)\n" +
+			"                     └─ IndexedTableAccess(one_pk)\n" +
+			"                         ├─ index: [one_pk.pk]\n" +
+			"                         ├─ static: [{[NULL, ∞)}]\n" +
+			"                         └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -6735,44 +6766,53 @@ inner join pq on true
 	{
 		Query: `select a.pk, c.v2 from one_pk_three_idx a cross join one_pk_three_idx b right join one_pk_three_idx c on b.pk = c.v1 where b.pk = 0 and c.v2 = 0;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.pk:3, c.v2:1]\n" +
+			" ├─ columns: [a.pk:0, c.v2:2]\n" +
 			" └─ Filter\n" +
 			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ b.pk:2\n" +
-			"     │   │   └─ 0 (tinyint)\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ b.pk:3\n" +
+			"     │   │   │   └─ 0 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ c.v2:2\n" +
+			"     │   │       └─ 0 (tinyint)\n" +
 			"     │   └─ Eq\n" +
-			"     │       ├─ c.v2:1\n" +
+			"     │       ├─ c.v1:1\n" +
 			"     │       └─ 0 (tinyint)\n" +
-			"     └─ LeftOuterHashJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ b.pk:2!null\n" +
-			"         │   └─ c.v1:0\n" +
-			"         ├─ Filter\n" +
-			"         │   ├─ Eq\n" +
-			"         │   │   ├─ c.v2:1\n" +
-			"         │   │   └─ 0 (tinyint)\n" +
-			"         │   └─ TableAlias(c)\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: one_pk_three_idx\n" +
-			"         │           └─ columns: [v1 v2]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ TableAlias(a)\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: one_pk_three_idx\n" +
+			"         │       └─ columns: [pk]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(c.v1:0)\n" +
-			"             ├─ right-key: TUPLE(b.pk:0!null)\n" +
+			"             ├─ left-key: TUPLE()\n" +
+			"             ├─ right-key: TUPLE()\n" +
 			"             └─ CachedResults\n" +
-			"                 └─ CrossHashJoin\n" +
-			"                     ├─ TableAlias(b)\n" +
-			"                     │   └─ Table\n" +
-			"                     │       ├─ name: one_pk_three_idx\n" +
-			"                     │       └─ columns: [pk]\n" +
-			"                     └─ HashLookup\n" +
-			"                         ├─ left-key: TUPLE()\n" +
-			"                         ├─ right-key: TUPLE()\n" +
-			"                         └─ CachedResults\n" +
-			"                             └─ TableAlias(a)\n" +
-			"                                 └─ Table\n" +
-			"                                     ├─ name: one_pk_three_idx\n" +
-			"                                     └─ columns: [pk]\n" +
+			"                 └─ LookupJoin\n" +
+			"                     ├─ Eq\n" +
+			"                     │   ├─ b.pk:3\n" +
+			"                     │   └─ c.v1:1\n" +
+			"                     ├─ Filter\n" +
+			"                     │   ├─ AND\n" +
+			"                     │   │   ├─ Eq\n" +
+			"                     │   │   │   ├─ c.v2:1\n" +
+			"                     │   │   │   └─ 0 (tinyint)\n" +
+			"                     │   │   └─ Eq\n" +
+			"                     │   │       ├─ c.v1:0\n" +
+			"                     │   │       └─ 0 (tinyint)\n" +
+			"                     │   └─ TableAlias(c)\n" +
+			"                     │       └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"                     │           ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"                     │           ├─ static: [{[0, 0], [0, 0], [NULL, ∞)}]\n" +
+			"                     │           └─ columns: [v1 v2]\n" +
+			"                     └─ Filter\n" +
+			"                         ├─ Eq\n" +
+			"                         │   ├─ b.pk:0\n" +
+			"                         │   └─ 0 (tinyint)\n" +
+			"                         └─ TableAlias(b)\n" +
+			"                             └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"                                 ├─ index: [one_pk_three_idx.pk]\n" +
+			"                                 └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -6814,8 +6854,8 @@ inner join pq on true
 	{
 		Query: `with a as (select a.i, a.s from mytable a CROSS JOIN mytable b) select * from a RIGHT JOIN mytable c on a.i+1 = c.i-1;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:2, a.s:3, c.i:0!null, c.s:1!null]\n" +
-			" └─ LeftOuterHashJoin\n" +
+			" ├─ columns: [a.i:2!null, a.s:3!null, c.i:0!null, c.s:1!null]\n" +
+			" └─ LeftOuterJoin\n" +
 			"     ├─ Eq\n" +
 			"     │   ├─ (a.i:2!null + 1 (tinyint))\n" +
 			"     │   └─ (c.i:0!null - 1 (tinyint))\n" +
@@ -6823,27 +6863,19 @@ inner join pq on true
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i s]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ left-key: TUPLE((c.i:0!null - 1 (tinyint)))\n" +
-			"         ├─ right-key: TUPLE((a.i:0!null + 1 (tinyint)))\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: a\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ CrossHashJoin\n" +
-			"                     ├─ TableAlias(b)\n" +
-			"                     │   └─ Table\n" +
-			"                     │       ├─ name: mytable\n" +
-			"                     │       └─ columns: []\n" +
-			"                     └─ HashLookup\n" +
-			"                         ├─ left-key: TUPLE()\n" +
-			"                         ├─ right-key: TUPLE()\n" +
-			"                         └─ CachedResults\n" +
-			"                             └─ TableAlias(a)\n" +
-			"                                 └─ Table\n" +
-			"                                     ├─ name: mytable\n" +
-			"                                     └─ columns: [i s]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ TableAlias(b)\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: mytable\n" +
+			"         │       └─ columns: []\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: TUPLE()\n" +
+			"             ├─ right-key: TUPLE()\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ TableAlias(a)\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: mytable\n" +
+			"                         └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -6884,68 +6916,52 @@ inner join pq on true
 		Query: `select a.*,b.* from mytable a RIGHT JOIN othertable b on a.i = b.i2+1 LEFT JOIN mytable c on a.i = c.i-1 LEFT JOIN othertable d on b.i2 = d.i2;`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:2, a.s:3, b.s2:0!null, b.i2:1!null]\n" +
-			" └─ LeftOuterLookupJoin\n" +
+			" └─ LeftOuterJoin\n" +
 			"     ├─ Eq\n" +
-			"     │   ├─ b.i2:1!null\n" +
-			"     │   └─ d.i2:5!null\n" +
-			"     ├─ LeftOuterJoin\n" +
+			"     │   ├─ a.i:2!null\n" +
+			"     │   └─ (c.i:4!null - 1 (tinyint))\n" +
+			"     ├─ LeftOuterLookupJoin\n" +
 			"     │   ├─ Eq\n" +
 			"     │   │   ├─ a.i:2!null\n" +
-			"     │   │   └─ (c.i:4!null - 1 (tinyint))\n" +
-			"     │   ├─ LeftOuterLookupJoin\n" +
-			"     │   │   ├─ Eq\n" +
-			"     │   │   │   ├─ a.i:2!null\n" +
-			"     │   │   │   └─ (b.i2:1!null + 1 (tinyint))\n" +
-			"     │   │   ├─ TableAlias(b)\n" +
-			"     │   │   │   └─ Table\n" +
-			"     │   │   │       ├─ name: othertable\n" +
-			"     │   │   │       └─ columns: [s2 i2]\n" +
-			"     │   │   └─ TableAlias(a)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
-			"     │   │           └─ columns: [i s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Table\n" +
-			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i]\n" +
-			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
-			"             └─ columns: [i2]\n" +
+			"     │   │   └─ (b.i2:1!null + 1 (tinyint))\n" +
+			"     │   ├─ TableAlias(b)\n" +
+			"     │   │   └─ Table\n" +
+			"     │   │       ├─ name: othertable\n" +
+			"     │   │       └─ columns: [s2 i2]\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mytable)\n" +
+			"     │           ├─ index: [mytable.i]\n" +
+			"     │           └─ columns: [i s]\n" +
+			"     └─ TableAlias(c)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `select a.*,b.* from mytable a RIGHT JOIN othertable b on a.i = b.i2+1 RIGHT JOIN mytable c on a.i = c.i-1 LEFT JOIN othertable d on b.i2 = d.i2;`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:3, a.s:4, b.s2:1, b.i2:2]\n" +
-			" └─ LeftOuterLookupJoin\n" +
+			" └─ LeftOuterJoin\n" +
 			"     ├─ Eq\n" +
-			"     │   ├─ b.i2:2!null\n" +
-			"     │   └─ d.i2:5!null\n" +
-			"     ├─ LeftOuterJoin\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ a.i:3!null\n" +
-			"     │   │   └─ (c.i:0!null - 1 (tinyint))\n" +
-			"     │   ├─ TableAlias(c)\n" +
-			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: mytable\n" +
-			"     │   │       └─ columns: [i]\n" +
-			"     │   └─ LeftOuterLookupJoin\n" +
-			"     │       ├─ Eq\n" +
-			"     │       │   ├─ a.i:3!null\n" +
-			"     │       │   └─ (b.i2:2!null + 1 (tinyint))\n" +
-			"     │       ├─ TableAlias(b)\n" +
-			"     │       │   └─ Table\n" +
-			"     │       │       ├─ name: othertable\n" +
-			"     │       │       └─ columns: [s2 i2]\n" +
-			"     │       └─ TableAlias(a)\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
-			"     │               └─ columns: [i s]\n" +
-			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
-			"             └─ columns: [i2]\n" +
+			"     │   ├─ a.i:3!null\n" +
+			"     │   └─ (c.i:0!null - 1 (tinyint))\n" +
+			"     ├─ TableAlias(c)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [i]\n" +
+			"     └─ LeftOuterLookupJoin\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ a.i:3!null\n" +
+			"         │   └─ (b.i2:2!null + 1 (tinyint))\n" +
+			"         ├─ TableAlias(b)\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: othertable\n" +
+			"         │       └─ columns: [s2 i2]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -7200,20 +7216,24 @@ inner join pq on true
 		Query: `select a.* from mytable a join mytable b on a.i = b.i and now() >= coalesce(NULL, NULL, now())`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ MergeJoin\n" +
-			"     ├─ cmp: Eq\n" +
-			"     │   ├─ b.i:0!null\n" +
-			"     │   └─ a.i:1!null\n" +
-			"     ├─ TableAlias(b)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
-			"     │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │       └─ columns: [i]\n" +
-			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [i s]\n" +
+			" └─ Filter\n" +
+			"     ├─ GreaterThanOrEqual\n" +
+			"     │   ├─ NOW()\n" +
+			"     │   └─ coalesce(NULL,NULL,NOW())\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ b.i:0!null\n" +
+			"         │   └─ a.i:1!null\n" +
+			"         ├─ TableAlias(b)\n" +
+			"         │   └─ IndexedTableAccess(mytable)\n" +
+			"         │       ├─ index: [mytable.i]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [i]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -8183,66 +8203,50 @@ With c as (
     On b.I = e.i
   ) d
 ) select * from c;`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: c\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: d\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [a.s:5!null]\n" +
-			"         └─ HashJoin\n" +
-			"             ├─ AND\n" +
-			"             │   ├─ Eq\n" +
-			"             │   │   ├─ b.i:2!null\n" +
-			"             │   │   └─ e.i:0!null\n" +
-			"             │   └─ Eq\n" +
-			"             │       ├─ a.i:4!null\n" +
-			"             │       └─ e.i:0!null\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: e\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Filter\n" +
-			"             │       ├─ HashIn\n" +
-			"             │       │   ├─ t1.i:0!null\n" +
-			"             │       │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
-			"             │       └─ TableAlias(t1)\n" +
-			"             │           └─ IndexedTableAccess(mytable)\n" +
-			"             │               ├─ index: [mytable.i]\n" +
-			"             │               ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
-			"             │               └─ columns: [i s]\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: TUPLE(e.i:0!null, e.i:0!null)\n" +
-			"                 ├─ right-key: TUPLE(b.i:0!null, a.i:2!null)\n" +
-			"                 └─ CachedResults\n" +
-			"                     └─ HashJoin\n" +
-			"                         ├─ Eq\n" +
-			"                         │   ├─ a.i:4!null\n" +
-			"                         │   └─ b.i:2!null\n" +
-			"                         ├─ SubqueryAlias\n" +
-			"                         │   ├─ name: b\n" +
-			"                         │   ├─ outerVisibility: false\n" +
-			"                         │   ├─ cacheable: true\n" +
-			"                         │   └─ Filter\n" +
-			"                         │       ├─ HashIn\n" +
-			"                         │       │   ├─ t2.i:0!null\n" +
-			"                         │       │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
-			"                         │       └─ TableAlias(t2)\n" +
-			"                         │           └─ IndexedTableAccess(mytable)\n" +
-			"                         │               ├─ index: [mytable.i]\n" +
-			"                         │               ├─ static: [{[1, 1]}, {[2, 2]}]\n" +
-			"                         │               └─ columns: [i s]\n" +
-			"                         └─ HashLookup\n" +
-			"                             ├─ left-key: TUPLE(b.i:2!null)\n" +
-			"                             ├─ right-key: TUPLE(a.i:0!null)\n" +
-			"                             └─ CachedResults\n" +
-			"                                 └─ TableAlias(a)\n" +
-			"                                     └─ Table\n" +
-			"                                         ├─ name: mytable\n" +
-			"                                         └─ columns: [i s]\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.s:3!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   │   ├─ a.i:2!null\n" +
+			"     │   │   │   │   │   │   └─ t2.i:4!null\n" +
+			"     │   │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │       ├─ t2.i:4!null\n" +
+			"     │   │   │   │   │       └─ 1 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ t2.i:4!null\n" +
+			"     │   │   │   │       └─ 2 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ t2.i:4!null\n" +
+			"     │   │   │       └─ t1.i:0!null\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ t1.i:0!null\n" +
+			"     │   │       └─ 2 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ t1.i:0!null\n" +
+			"     │       └─ 3 (tinyint)\n" +
+			"     ├─ MergeJoin\n" +
+			"     │   ├─ cmp: Eq\n" +
+			"     │   │   ├─ t1.i:0!null\n" +
+			"     │   │   └─ a.i:2!null\n" +
+			"     │   ├─ TableAlias(t1)\n" +
+			"     │   │   └─ IndexedTableAccess(mytable)\n" +
+			"     │   │       ├─ index: [mytable.i]\n" +
+			"     │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       └─ columns: [i s]\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mytable)\n" +
+			"     │           ├─ index: [mytable.i]\n" +
+			"     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           └─ columns: [i s]\n" +
+			"     └─ TableAlias(t2)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -8264,21 +8268,17 @@ With c as (
 	{
 		Query: `SELECT i FROM (SELECT i FROM (SELECT i FROM mytable LIMIT 1) sq1) sq2 WHERE i = 3;`,
 		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: sq2\n" +
+			" ├─ name: sq1\n" +
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq1\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Filter\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ mytable.i:0!null\n" +
-			"         │   └─ 3 (tinyint)\n" +
-			"         └─ Limit(1)\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [i]\n" +
+			" └─ Filter\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ 3 (tinyint)\n" +
+			"     └─ Limit(1)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -8302,91 +8302,63 @@ With c as (
 	{
 		Query: `SELECT i FROM (SELECT i FROM (SELECT i FROM mytable ORDER BY i DESC  LIMIT 1) sq1) sq2 WHERE i = 3;`,
 		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: sq2\n" +
+			" ├─ name: sq1\n" +
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq1\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Filter\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ mytable.i:0!null\n" +
-			"         │   └─ 3 (tinyint)\n" +
-			"         └─ Limit(1)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 ├─ columns: [i]\n" +
-			"                 └─ reverse: true\n" +
+			" └─ Filter\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ 3 (tinyint)\n" +
+			"     └─ Limit(1)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             ├─ columns: [i]\n" +
+			"             └─ reverse: true\n" +
 			"",
 	},
 	{
 		Query: `SELECT i FROM (SELECT i FROM mytable WHERE i > 1) sq LIMIT 1;`,
 		ExpectedPlan: "Limit(1)\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
-			"         ├─ static: [{(1, ∞)}]\n" +
-			"         └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{(1, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT i FROM (SELECT i FROM (SELECT i FROM mytable WHERE i > 1) sq1) sq2 LIMIT 1;`,
 		ExpectedPlan: "Limit(1)\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq2\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
-			"             ├─ static: [{(1, ∞)}]\n" +
-			"             └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{(1, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT i FROM (SELECT i FROM (SELECT i FROM mytable) sq1 WHERE i > 1) sq2 LIMIT 1;`,
 		ExpectedPlan: "Limit(1)\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq2\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
-			"             ├─ static: [{(1, ∞)}]\n" +
-			"             └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{(1, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT i FROM (SELECT i FROM (SELECT i FROM mytable LIMIT 1) sq1 WHERE i > 1) sq2 LIMIT 10;`,
 		ExpectedPlan: "Limit(10)\n" +
 			" └─ SubqueryAlias\n" +
-			"     ├─ name: sq2\n" +
+			"     ├─ name: sq1\n" +
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: sq1\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ Filter\n" +
-			"             ├─ GreaterThan\n" +
-			"             │   ├─ mytable.i:0!null\n" +
-			"             │   └─ 1 (tinyint)\n" +
-			"             └─ Limit(1)\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i]\n" +
+			"     └─ Filter\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ mytable.i:0!null\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ Limit(1)\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: mytable\n" +
+			"                 └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -8740,11 +8712,135 @@ WHERE keyless.c0 IN (
 	{
 		Query: `SELECT * FROM one_pk ORDER BY pk LIMIT 5, 10;`,
 		ExpectedPlan: "Limit(10)\n" +
-			" └─ Offset(5)\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
-			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			" └─ IndexedTableAccess(one_pk)\n" +
+			"     ├─ index: [one_pk.pk]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ offset: 5\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_two_idx WHERE (v1, v2) > (2, 2)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_two_idx.pk:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2)\n" +
+			"     │   └─ TUPLE(2 (tinyint), 2 (tinyint))\n" +
+			"     └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"         ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"         ├─ static: [{[2, 2], (2, ∞)}, {(2, ∞), [NULL, ∞)}]\n" +
+			"         └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_two_idx WHERE (v1, v2) <= (3, 3)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_two_idx.pk:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ LessThanOrEqual\n" +
+			"     │   ├─ TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2)\n" +
+			"     │   └─ TUPLE(3 (tinyint), 3 (tinyint))\n" +
+			"     └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"         ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"         ├─ static: [{(NULL, 3), [NULL, ∞)}, {[3, 3], (NULL, 3]}]\n" +
+			"         └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_two_idx WHERE (v1, v2) = (1, 1)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_two_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"     ├─ static: [{[1, 1], [1, 1]}]\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_two_idx WHERE (v1, v2) IN ((1, 1), (3, 3))`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_two_idx.pk:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ HashIn\n" +
+			"     │   ├─ TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2)\n" +
+			"     │   └─ TUPLE(TUPLE(1 (tinyint), 1 (tinyint)), TUPLE(3 (tinyint), 3 (tinyint)))\n" +
+			"     └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"         ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"         ├─ static: [{[1, 1], [1, 1]}, {[3, 3], [3, 3]}]\n" +
+			"         └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_two_idx ORDER BY pk DESC LIMIT 2 OFFSET 3`,
+		ExpectedPlan: "Limit(2)\n" +
+			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"     ├─ index: [one_pk_two_idx.pk]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2]\n" +
+			"     ├─ offset: 3\n" +
+			"     └─ reverse: true\n" +
+			"",
+	},
+	{
+		Query: `SELECT t.pk, t.v2 FROM one_pk_two_idx t ORDER BY t.pk LIMIT 5 OFFSET 6`,
+		ExpectedPlan: "Limit(5)\n" +
+			" └─ TableAlias(t)\n" +
+			"     └─ IndexedTableAccess(one_pk_two_idx)\n" +
+			"         ├─ index: [one_pk_two_idx.pk]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         ├─ columns: [pk v2]\n" +
+			"         └─ offset: 6\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE (v1, v2, v3) < (0, 2, 2) ORDER BY v1 DESC, v2 DESC, v3 DESC LIMIT 2`,
+		ExpectedPlan: "Limit(2)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			"     └─ Filter\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ TUPLE(one_pk_three_idx.v1:1, one_pk_three_idx.v2:2, one_pk_three_idx.v3:3)\n" +
+			"         │   └─ TUPLE(0 (tinyint), 2 (tinyint), 2 (tinyint))\n" +
+			"         └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"             ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"             ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], (NULL, 2), [NULL, ∞)}, {[0, 0], [2, 2], (NULL, 2)}]\n" +
+			"             ├─ columns: [pk v1 v2 v3]\n" +
+			"             └─ reverse: true\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 ORDER BY v1, v2 DESC, v3 DESC`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2 v3]\n" +
+			"     └─ reverse: true\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx ORDER BY v1 DESC, v2 DESC, v3 DESC LIMIT 3`,
+		ExpectedPlan: "Limit(3)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			"     └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"         ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"         ├─ static: [{[NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"         ├─ columns: [pk v1 v2 v3]\n" +
+			"         └─ reverse: true\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 > 0 ORDER BY v1, v2 DESC`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ Sort(one_pk_three_idx.v1:1 ASC nullsFirst, one_pk_three_idx.v2:2 DESC nullsFirst)\n" +
+			"     └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"         ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"         ├─ static: [{(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"         └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 }
@@ -8877,13 +8973,13 @@ WHERE
 			"                                 ├─ select: ZH72S:0, COUNT(CCEFL.ZH72S:2), MIN(CCEFL.WGBRL:1), SUM(CCEFL.WGBRL:1)\n" +
 			"                                 ├─ group: ZH72S:0\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [CCEFL.ZH72S:1 as ZH72S, CCEFL.WGBRL:2, CCEFL.ZH72S:1]\n" +
+			"                                     ├─ columns: [CCEFL.ZH72S:0 as ZH72S, CCEFL.WGBRL:1, CCEFL.ZH72S:0]\n" +
 			"                                     └─ SubqueryAlias\n" +
 			"                                         ├─ name: CCEFL\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
-			"                                             ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"                                             ├─ columns: [nd.ZH72S:7 as ZH72S, Subquery\n" +
 			"                                             │   ├─ cacheable: false\n" +
 			"                                             │   └─ Project\n" +
 			"                                             │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
@@ -9421,13 +9517,13 @@ WHERE
 			"                                 ├─ select: ZH72S:0, COUNT(WOOJ5.ZH72S:2), MIN(WOOJ5.LEA4J:1), SUM(WOOJ5.LEA4J:1)\n" +
 			"                                 ├─ group: ZH72S:0\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [WOOJ5.ZH72S:1 as ZH72S, WOOJ5.LEA4J:2, WOOJ5.ZH72S:1]\n" +
+			"                                     ├─ columns: [WOOJ5.ZH72S:0 as ZH72S, WOOJ5.LEA4J:1, WOOJ5.ZH72S:0]\n" +
 			"                                     └─ SubqueryAlias\n" +
 			"                                         ├─ name: WOOJ5\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
-			"                                             ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"                                             ├─ columns: [nd.ZH72S:7 as ZH72S, Subquery\n" +
 			"                                             │   ├─ cacheable: false\n" +
 			"                                             │   └─ Project\n" +
 			"                                             │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
@@ -10316,13 +10412,13 @@ WHERE
 			"                                 ├─ select: ZH72S:0, COUNT(TQ57W.ZH72S:2), MIN(TQ57W.TJ66D:1), SUM(TQ57W.TJ66D:1)\n" +
 			"                                 ├─ group: ZH72S:0\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [TQ57W.ZH72S:1 as ZH72S, TQ57W.TJ66D:2, TQ57W.ZH72S:1]\n" +
+			"                                     ├─ columns: [TQ57W.ZH72S:0 as ZH72S, TQ57W.TJ66D:1, TQ57W.ZH72S:0]\n" +
 			"                                     └─ SubqueryAlias\n" +
 			"                                         ├─ name: TQ57W\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
-			"                                             ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"                                             ├─ columns: [nd.ZH72S:7 as ZH72S, Subquery\n" +
 			"                                             │   ├─ cacheable: false\n" +
 			"                                             │   └─ Project\n" +
 			"                                             │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
@@ -10933,7 +11029,7 @@ WHERE
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
+			" │   │   ├─ type: longtext\n" +
 			" │   │   └─ T4IBQ:0!null\n" +
 			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
@@ -10997,9 +11093,9 @@ WHERE
 			" │           │               │           │   │   │   ├─ ci.id:0!null\n" +
 			" │           │               │           │   │   │   └─ ct.FZ2R5:2!null\n" +
 			" │           │               │           │   │   ├─ Filter\n" +
-			" │           │               │           │   │   │   ├─ HashIn\n" +
+			" │           │               │           │   │   │   ├─ Eq\n" +
 			" │           │               │           │   │   │   │   ├─ ci.FTQLQ:1!null\n" +
-			" │           │               │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │           │               │           │   │   │   │   └─ SQ1 (longtext)\n" +
 			" │           │               │           │   │   │   └─ TableAlias(ci)\n" +
 			" │           │               │           │   │   │       └─ IndexedTableAccess(JDLNA)\n" +
 			" │           │               │           │   │   │           ├─ index: [JDLNA.id]\n" +
@@ -11060,9 +11156,9 @@ WHERE
 			" │                       │   ├─ cla.id:33!null\n" +
 			" │                       │   └─ bs.IXUXU:65\n" +
 			" │                       ├─ Filter\n" +
-			" │                       │   ├─ HashIn\n" +
+			" │                       │   ├─ Eq\n" +
 			" │                       │   │   ├─ cla.FTQLQ:1!null\n" +
-			" │                       │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │                       │   │   └─ SQ1 (longtext)\n" +
 			" │                       │   └─ TableAlias(cla)\n" +
 			" │                       │       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                       │           ├─ index: [YK2GW.id]\n" +
@@ -11073,118 +11169,116 @@ WHERE
 			" │                               ├─ index: [THNTS.IXUXU]\n" +
 			" │                               ├─ static: [{[NULL, ∞)}]\n" +
 			" │                               └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ CrossHashJoin\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: AOEV5\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Values() as temp_AOEV5\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  1 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  2 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  3 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  4 (longtext))\n" +
-			"         │       └─ Row(\n" +
-			"         │          5 (longtext))\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE()\n" +
-			"             ├─ right-key: TUPLE()\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: VUMUY\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"                         │   ├─ cacheable: false\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: AOEV5\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ Values() as temp_AOEV5\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  1 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  2 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  3 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  4 (longtext))\n" +
+			"     │       └─ Row(\n" +
+			"     │          5 (longtext))\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: VUMUY\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Project\n" +
+			"                     ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"                     │   ├─ cacheable: false\n" +
+			"                     │   └─ Project\n" +
+			"                     │       ├─ columns: [aac.BTXC5:17]\n" +
+			"                     │       └─ Filter\n" +
+			"                     │           ├─ Eq\n" +
+			"                     │           │   ├─ aac.id:16!null\n" +
+			"                     │           │   └─ SL3S5.M22QN:2!null\n" +
+			"                     │           └─ TableAlias(aac)\n" +
+			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
+			"                     │                   ├─ index: [TPXBU.id]\n" +
+			"                     │                   └─ columns: [id btxc5]\n" +
+			"                     │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"                     └─ LookupJoin\n" +
+			"                         ├─ Eq\n" +
+			"                         │   ├─ SL3S5.BDNYB:0!null\n" +
+			"                         │   └─ sn.id:6!null\n" +
+			"                         ├─ SubqueryAlias\n" +
+			"                         │   ├─ name: SL3S5\n" +
+			"                         │   ├─ outerVisibility: false\n" +
+			"                         │   ├─ cacheable: true\n" +
 			"                         │   └─ Project\n" +
-			"                         │       ├─ columns: [aac.BTXC5:17]\n" +
-			"                         │       └─ Filter\n" +
+			"                         │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
+			"                         │       └─ HashJoin\n" +
 			"                         │           ├─ Eq\n" +
-			"                         │           │   ├─ aac.id:16!null\n" +
-			"                         │           │   └─ SL3S5.M22QN:2!null\n" +
-			"                         │           └─ TableAlias(aac)\n" +
-			"                         │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                         │                   ├─ index: [TPXBU.id]\n" +
-			"                         │                   └─ columns: [id btxc5]\n" +
-			"                         │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"                         └─ LookupJoin\n" +
-			"                             ├─ Eq\n" +
-			"                             │   ├─ SL3S5.BDNYB:0!null\n" +
-			"                             │   └─ sn.id:6!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: SL3S5\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ ct.LUEVY:12!null\n" +
-			"                             │           │   └─ sn.BRQP2:1!null\n" +
-			"                             │           ├─ TableAlias(sn)\n" +
-			"                             │           │   └─ Table\n" +
-			"                             │           │       ├─ name: NOXN3\n" +
-			"                             │           │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ left-key: TUPLE(sn.BRQP2:1!null)\n" +
-			"                             │               ├─ right-key: TUPLE(ct.LUEVY:2!null)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ LookupJoin\n" +
-			"                             │                       ├─ Eq\n" +
-			"                             │                       │   ├─ cec.id:24!null\n" +
-			"                             │                       │   └─ ct.OVE3E:14!null\n" +
-			"                             │                       ├─ LookupJoin\n" +
-			"                             │                       │   ├─ Eq\n" +
-			"                             │                       │   │   ├─ ci.id:22!null\n" +
-			"                             │                       │   │   └─ ct.FZ2R5:11!null\n" +
-			"                             │                       │   ├─ Project\n" +
-			"                             │                       │   │   ├─ columns: [ct.id:1!null, ct.FZ2R5:2!null, ct.LUEVY:3!null, ct.M22QN:4!null, ct.OVE3E:5!null, ct.NRURT:6, ct.OCA7E:7, ct.XMM6Q:8, ct.V5DPX:9!null, ct.S3Q3Y:10!null, ct.ZRV3B:11!null, ct.FHCYT:12]\n" +
-			"                             │                       │   │   └─ LookupJoin\n" +
-			"                             │                       │   │       ├─ Eq\n" +
-			"                             │                       │   │       │   ├─ ct.M22QN:14!null\n" +
-			"                             │                       │   │       │   └─ scalarSubq0.id:10!null\n" +
-			"                             │                       │   │       ├─ OrderedDistinct\n" +
-			"                             │                       │   │       │   └─ Project\n" +
-			"                             │                       │   │       │       ├─ columns: [scalarSubq0.id:0!null]\n" +
-			"                             │                       │   │       │       └─ Max1Row\n" +
-			"                             │                       │   │       │           └─ Filter\n" +
-			"                             │                       │   │       │               ├─ Eq\n" +
-			"                             │                       │   │       │               │   ├─ scalarSubq0.BTXC5:1\n" +
-			"                             │                       │   │       │               │   └─ WT (longtext)\n" +
-			"                             │                       │   │       │               └─ TableAlias(scalarSubq0)\n" +
-			"                             │                       │   │       │                   └─ Table\n" +
-			"                             │                       │   │       │                       ├─ name: TPXBU\n" +
-			"                             │                       │   │       │                       └─ columns: [id btxc5]\n" +
-			"                             │                       │   │       └─ Filter\n" +
-			"                             │                       │   │           ├─ Eq\n" +
-			"                             │                       │   │           │   ├─ ct.ZRV3B:10!null\n" +
-			"                             │                       │   │           │   └─ = (longtext)\n" +
-			"                             │                       │   │           └─ TableAlias(ct)\n" +
-			"                             │                       │   │               └─ IndexedTableAccess(FLQLP)\n" +
-			"                             │                       │   │                   ├─ index: [FLQLP.M22QN]\n" +
-			"                             │                       │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"                             │                       │   └─ Filter\n" +
-			"                             │                       │       ├─ HashIn\n" +
-			"                             │                       │       │   ├─ ci.FTQLQ:1!null\n" +
-			"                             │                       │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │                       │       └─ TableAlias(ci)\n" +
-			"                             │                       │           └─ IndexedTableAccess(JDLNA)\n" +
-			"                             │                       │               ├─ index: [JDLNA.id]\n" +
-			"                             │                       │               └─ columns: [id ftqlq]\n" +
-			"                             │                       └─ TableAlias(cec)\n" +
-			"                             │                           └─ IndexedTableAccess(SFEGG)\n" +
-			"                             │                               ├─ index: [SFEGG.id]\n" +
-			"                             │                               └─ columns: [id no52d adurz]\n" +
-			"                             └─ TableAlias(sn)\n" +
-			"                                 └─ IndexedTableAccess(NOXN3)\n" +
-			"                                     ├─ index: [NOXN3.id]\n" +
-			"                                     └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                         │           │   ├─ ct.LUEVY:12!null\n" +
+			"                         │           │   └─ sn.BRQP2:1!null\n" +
+			"                         │           ├─ TableAlias(sn)\n" +
+			"                         │           │   └─ Table\n" +
+			"                         │           │       ├─ name: NOXN3\n" +
+			"                         │           │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                         │           └─ HashLookup\n" +
+			"                         │               ├─ left-key: TUPLE(sn.BRQP2:1!null)\n" +
+			"                         │               ├─ right-key: TUPLE(ct.LUEVY:2!null)\n" +
+			"                         │               └─ CachedResults\n" +
+			"                         │                   └─ LookupJoin\n" +
+			"                         │                       ├─ Eq\n" +
+			"                         │                       │   ├─ cec.id:24!null\n" +
+			"                         │                       │   └─ ct.OVE3E:14!null\n" +
+			"                         │                       ├─ LookupJoin\n" +
+			"                         │                       │   ├─ Eq\n" +
+			"                         │                       │   │   ├─ ci.id:22!null\n" +
+			"                         │                       │   │   └─ ct.FZ2R5:11!null\n" +
+			"                         │                       │   ├─ Project\n" +
+			"                         │                       │   │   ├─ columns: [ct.id:1!null, ct.FZ2R5:2!null, ct.LUEVY:3!null, ct.M22QN:4!null, ct.OVE3E:5!null, ct.NRURT:6, ct.OCA7E:7, ct.XMM6Q:8, ct.V5DPX:9!null, ct.S3Q3Y:10!null, ct.ZRV3B:11!null, ct.FHCYT:12]\n" +
+			"                         │                       │   │   └─ LookupJoin\n" +
+			"                         │                       │   │       ├─ Eq\n" +
+			"                         │                       │   │       │   ├─ ct.M22QN:14!null\n" +
+			"                         │                       │   │       │   └─ scalarSubq0.id:10!null\n" +
+			"                         │                       │   │       ├─ OrderedDistinct\n" +
+			"                         │                       │   │       │   └─ Project\n" +
+			"                         │                       │   │       │       ├─ columns: [scalarSubq0.id:0!null]\n" +
+			"                         │                       │   │       │       └─ Max1Row\n" +
+			"                         │                       │   │       │           └─ Filter\n" +
+			"                         │                       │   │       │               ├─ Eq\n" +
+			"                         │                       │   │       │               │   ├─ scalarSubq0.BTXC5:1\n" +
+			"                         │                       │   │       │               │   └─ WT (longtext)\n" +
+			"                         │                       │   │       │               └─ TableAlias(scalarSubq0)\n" +
+			"                         │                       │   │       │                   └─ Table\n" +
+			"                         │                       │   │       │                       ├─ name: TPXBU\n" +
+			"                         │                       │   │       │                       └─ columns: [id btxc5]\n" +
+			"                         │                       │   │       └─ Filter\n" +
+			"                         │                       │   │           ├─ Eq\n" +
+			"                         │                       │   │           │   ├─ ct.ZRV3B:10!null\n" +
+			"                         │                       │   │           │   └─ = (longtext)\n" +
+			"                         │                       │   │           └─ TableAlias(ct)\n" +
+			"                         │                       │   │               └─ IndexedTableAccess(FLQLP)\n" +
+			"                         │                       │   │                   ├─ index: [FLQLP.M22QN]\n" +
+			"                         │                       │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"                         │                       │   └─ Filter\n" +
+			"                         │                       │       ├─ Eq\n" +
+			"                         │                       │       │   ├─ ci.FTQLQ:1!null\n" +
+			"                         │                       │       │   └─ SQ1 (longtext)\n" +
+			"                         │                       │       └─ TableAlias(ci)\n" +
+			"                         │                       │           └─ IndexedTableAccess(JDLNA)\n" +
+			"                         │                       │               ├─ index: [JDLNA.id]\n" +
+			"                         │                       │               └─ columns: [id ftqlq]\n" +
+			"                         │                       └─ TableAlias(cec)\n" +
+			"                         │                           └─ IndexedTableAccess(SFEGG)\n" +
+			"                         │                               ├─ index: [SFEGG.id]\n" +
+			"                         │                               └─ columns: [id no52d adurz]\n" +
+			"                         └─ TableAlias(sn)\n" +
+			"                             └─ IndexedTableAccess(NOXN3)\n" +
+			"                                 ├─ index: [NOXN3.id]\n" +
+			"                                 └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 	},
 	{
@@ -11290,7 +11384,7 @@ WHERE
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
+			" │   │   ├─ type: longtext\n" +
 			" │   │   └─ T4IBQ:0!null\n" +
 			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
@@ -11354,9 +11448,9 @@ WHERE
 			" │           │               │           │   │   │   ├─ ci.id:0!null\n" +
 			" │           │               │           │   │   │   └─ ct.FZ2R5:2!null\n" +
 			" │           │               │           │   │   ├─ Filter\n" +
-			" │           │               │           │   │   │   ├─ HashIn\n" +
+			" │           │               │           │   │   │   ├─ Eq\n" +
 			" │           │               │           │   │   │   │   ├─ ci.FTQLQ:1!null\n" +
-			" │           │               │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │           │               │           │   │   │   │   └─ SQ1 (longtext)\n" +
 			" │           │               │           │   │   │   └─ TableAlias(ci)\n" +
 			" │           │               │           │   │   │       └─ IndexedTableAccess(JDLNA)\n" +
 			" │           │               │           │   │   │           ├─ index: [JDLNA.id]\n" +
@@ -11417,9 +11511,9 @@ WHERE
 			" │                       │   ├─ cla.id:33!null\n" +
 			" │                       │   └─ bs.IXUXU:65\n" +
 			" │                       ├─ Filter\n" +
-			" │                       │   ├─ HashIn\n" +
+			" │                       │   ├─ Eq\n" +
 			" │                       │   │   ├─ cla.FTQLQ:1!null\n" +
-			" │                       │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │                       │   │   └─ SQ1 (longtext)\n" +
 			" │                       │   └─ TableAlias(cla)\n" +
 			" │                       │       └─ IndexedTableAccess(YK2GW)\n" +
 			" │                       │           ├─ index: [YK2GW.id]\n" +
@@ -11430,118 +11524,116 @@ WHERE
 			" │                               ├─ index: [THNTS.IXUXU]\n" +
 			" │                               ├─ static: [{[NULL, ∞)}]\n" +
 			" │                               └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ CrossHashJoin\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: AOEV5\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Values() as temp_AOEV5\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  1 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  2 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  3 (longtext))\n" +
-			"         │       ├─ Row(\n" +
-			"         │       │  4 (longtext))\n" +
-			"         │       └─ Row(\n" +
-			"         │          5 (longtext))\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE()\n" +
-			"             ├─ right-key: TUPLE()\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: VUMUY\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"                         │   ├─ cacheable: false\n" +
+			" └─ CrossHashJoin\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: AOEV5\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ Values() as temp_AOEV5\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  1 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  2 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  3 (longtext))\n" +
+			"     │       ├─ Row(\n" +
+			"     │       │  4 (longtext))\n" +
+			"     │       └─ Row(\n" +
+			"     │          5 (longtext))\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ left-key: TUPLE()\n" +
+			"         ├─ right-key: TUPLE()\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: VUMUY\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Project\n" +
+			"                     ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"                     │   ├─ cacheable: false\n" +
+			"                     │   └─ Project\n" +
+			"                     │       ├─ columns: [aac.BTXC5:17]\n" +
+			"                     │       └─ Filter\n" +
+			"                     │           ├─ Eq\n" +
+			"                     │           │   ├─ aac.id:16!null\n" +
+			"                     │           │   └─ SL3S5.M22QN:2!null\n" +
+			"                     │           └─ TableAlias(aac)\n" +
+			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
+			"                     │                   ├─ index: [TPXBU.id]\n" +
+			"                     │                   └─ columns: [id btxc5]\n" +
+			"                     │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"                     └─ LookupJoin\n" +
+			"                         ├─ Eq\n" +
+			"                         │   ├─ SL3S5.BDNYB:0!null\n" +
+			"                         │   └─ sn.id:6!null\n" +
+			"                         ├─ SubqueryAlias\n" +
+			"                         │   ├─ name: SL3S5\n" +
+			"                         │   ├─ outerVisibility: false\n" +
+			"                         │   ├─ cacheable: true\n" +
 			"                         │   └─ Project\n" +
-			"                         │       ├─ columns: [aac.BTXC5:17]\n" +
-			"                         │       └─ Filter\n" +
+			"                         │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
+			"                         │       └─ HashJoin\n" +
 			"                         │           ├─ Eq\n" +
-			"                         │           │   ├─ aac.id:16!null\n" +
-			"                         │           │   └─ SL3S5.M22QN:2!null\n" +
-			"                         │           └─ TableAlias(aac)\n" +
-			"                         │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                         │                   ├─ index: [TPXBU.id]\n" +
-			"                         │                   └─ columns: [id btxc5]\n" +
-			"                         │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"                         └─ LookupJoin\n" +
-			"                             ├─ Eq\n" +
-			"                             │   ├─ SL3S5.BDNYB:0!null\n" +
-			"                             │   └─ sn.id:6!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: SL3S5\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ ct.LUEVY:12!null\n" +
-			"                             │           │   └─ sn.BRQP2:1!null\n" +
-			"                             │           ├─ TableAlias(sn)\n" +
-			"                             │           │   └─ Table\n" +
-			"                             │           │       ├─ name: NOXN3\n" +
-			"                             │           │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ left-key: TUPLE(sn.BRQP2:1!null)\n" +
-			"                             │               ├─ right-key: TUPLE(ct.LUEVY:2!null)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ LookupJoin\n" +
-			"                             │                       ├─ Eq\n" +
-			"                             │                       │   ├─ cec.id:24!null\n" +
-			"                             │                       │   └─ ct.OVE3E:14!null\n" +
-			"                             │                       ├─ LookupJoin\n" +
-			"                             │                       │   ├─ Eq\n" +
-			"                             │                       │   │   ├─ ci.id:22!null\n" +
-			"                             │                       │   │   └─ ct.FZ2R5:11!null\n" +
-			"                             │                       │   ├─ Project\n" +
-			"                             │                       │   │   ├─ columns: [ct.id:1!null, ct.FZ2R5:2!null, ct.LUEVY:3!null, ct.M22QN:4!null, ct.OVE3E:5!null, ct.NRURT:6, ct.OCA7E:7, ct.XMM6Q:8, ct.V5DPX:9!null, ct.S3Q3Y:10!null, ct.ZRV3B:11!null, ct.FHCYT:12]\n" +
-			"                             │                       │   │   └─ LookupJoin\n" +
-			"                             │                       │   │       ├─ Eq\n" +
-			"                             │                       │   │       │   ├─ ct.M22QN:14!null\n" +
-			"                             │                       │   │       │   └─ scalarSubq0.id:10!null\n" +
-			"                             │                       │   │       ├─ OrderedDistinct\n" +
-			"                             │                       │   │       │   └─ Project\n" +
-			"                             │                       │   │       │       ├─ columns: [scalarSubq0.id:0!null]\n" +
-			"                             │                       │   │       │       └─ Max1Row\n" +
-			"                             │                       │   │       │           └─ Filter\n" +
-			"                             │                       │   │       │               ├─ Eq\n" +
-			"                             │                       │   │       │               │   ├─ scalarSubq0.BTXC5:1\n" +
-			"                             │                       │   │       │               │   └─ WT (longtext)\n" +
-			"                             │                       │   │       │               └─ TableAlias(scalarSubq0)\n" +
-			"                             │                       │   │       │                   └─ Table\n" +
-			"                             │                       │   │       │                       ├─ name: TPXBU\n" +
-			"                             │                       │   │       │                       └─ columns: [id btxc5]\n" +
-			"                             │                       │   │       └─ Filter\n" +
-			"                             │                       │   │           ├─ Eq\n" +
-			"                             │                       │   │           │   ├─ ct.ZRV3B:10!null\n" +
-			"                             │                       │   │           │   └─ = (longtext)\n" +
-			"                             │                       │   │           └─ TableAlias(ct)\n" +
-			"                             │                       │   │               └─ IndexedTableAccess(FLQLP)\n" +
-			"                             │                       │   │                   ├─ index: [FLQLP.M22QN]\n" +
-			"                             │                       │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"                             │                       │   └─ Filter\n" +
-			"                             │                       │       ├─ HashIn\n" +
-			"                             │                       │       │   ├─ ci.FTQLQ:1!null\n" +
-			"                             │                       │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │                       │       └─ TableAlias(ci)\n" +
-			"                             │                       │           └─ IndexedTableAccess(JDLNA)\n" +
-			"                             │                       │               ├─ index: [JDLNA.id]\n" +
-			"                             │                       │               └─ columns: [id ftqlq]\n" +
-			"                             │                       └─ TableAlias(cec)\n" +
-			"                             │                           └─ IndexedTableAccess(SFEGG)\n" +
-			"                             │                               ├─ index: [SFEGG.id]\n" +
-			"                             │                               └─ columns: [id no52d adurz]\n" +
-			"                             └─ TableAlias(sn)\n" +
-			"                                 └─ IndexedTableAccess(NOXN3)\n" +
-			"                                     ├─ index: [NOXN3.id]\n" +
-			"                                     └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                         │           │   ├─ ct.LUEVY:12!null\n" +
+			"                         │           │   └─ sn.BRQP2:1!null\n" +
+			"                         │           ├─ TableAlias(sn)\n" +
+			"                         │           │   └─ Table\n" +
+			"                         │           │       ├─ name: NOXN3\n" +
+			"                         │           │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                         │           └─ HashLookup\n" +
+			"                         │               ├─ left-key: TUPLE(sn.BRQP2:1!null)\n" +
+			"                         │               ├─ right-key: TUPLE(ct.LUEVY:2!null)\n" +
+			"                         │               └─ CachedResults\n" +
+			"                         │                   └─ LookupJoin\n" +
+			"                         │                       ├─ Eq\n" +
+			"                         │                       │   ├─ cec.id:24!null\n" +
+			"                         │                       │   └─ ct.OVE3E:14!null\n" +
+			"                         │                       ├─ LookupJoin\n" +
+			"                         │                       │   ├─ Eq\n" +
+			"                         │                       │   │   ├─ ci.id:22!null\n" +
+			"                         │                       │   │   └─ ct.FZ2R5:11!null\n" +
+			"                         │                       │   ├─ Project\n" +
+			"                         │                       │   │   ├─ columns: [ct.id:1!null, ct.FZ2R5:2!null, ct.LUEVY:3!null, ct.M22QN:4!null, ct.OVE3E:5!null, ct.NRURT:6, ct.OCA7E:7, ct.XMM6Q:8, ct.V5DPX:9!null, ct.S3Q3Y:10!null, ct.ZRV3B:11!null, ct.FHCYT:12]\n" +
+			"                         │                       │   │   └─ LookupJoin\n" +
+			"                         │                       │   │       ├─ Eq\n" +
+			"                         │                       │   │       │   ├─ ct.M22QN:14!null\n" +
+			"                         │                       │   │       │   └─ scalarSubq0.id:10!null\n" +
+			"                         │                       │   │       ├─ OrderedDistinct\n" +
+			"                         │                       │   │       │   └─ Project\n" +
+			"                         │                       │   │       │       ├─ columns: [scalarSubq0.id:0!null]\n" +
+			"                         │                       │   │       │       └─ Max1Row\n" +
+			"                         │                       │   │       │           └─ Filter\n" +
+			"                         │                       │   │       │               ├─ Eq\n" +
+			"                         │                       │   │       │               │   ├─ scalarSubq0.BTXC5:1\n" +
+			"                         │                       │   │       │               │   └─ WT (longtext)\n" +
+			"                         │                       │   │       │               └─ TableAlias(scalarSubq0)\n" +
+			"                         │                       │   │       │                   └─ Table\n" +
+			"                         │                       │   │       │                       ├─ name: TPXBU\n" +
+			"                         │                       │   │       │                       └─ columns: [id btxc5]\n" +
+			"                         │                       │   │       └─ Filter\n" +
+			"                         │                       │   │           ├─ Eq\n" +
+			"                         │                       │   │           │   ├─ ct.ZRV3B:10!null\n" +
+			"                         │                       │   │           │   └─ = (longtext)\n" +
+			"                         │                       │   │           └─ TableAlias(ct)\n" +
+			"                         │                       │   │               └─ IndexedTableAccess(FLQLP)\n" +
+			"                         │                       │   │                   ├─ index: [FLQLP.M22QN]\n" +
+			"                         │                       │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"                         │                       │   └─ Filter\n" +
+			"                         │                       │       ├─ Eq\n" +
+			"                         │                       │       │   ├─ ci.FTQLQ:1!null\n" +
+			"                         │                       │       │   └─ SQ1 (longtext)\n" +
+			"                         │                       │       └─ TableAlias(ci)\n" +
+			"                         │                       │           └─ IndexedTableAccess(JDLNA)\n" +
+			"                         │                       │               ├─ index: [JDLNA.id]\n" +
+			"                         │                       │               └─ columns: [id ftqlq]\n" +
+			"                         │                       └─ TableAlias(cec)\n" +
+			"                         │                           └─ IndexedTableAccess(SFEGG)\n" +
+			"                         │                               ├─ index: [SFEGG.id]\n" +
+			"                         │                               └─ columns: [id no52d adurz]\n" +
+			"                         └─ TableAlias(sn)\n" +
+			"                             └─ IndexedTableAccess(NOXN3)\n" +
+			"                                 ├─ index: [NOXN3.id]\n" +
+			"                                 └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 	},
 	{
@@ -11795,9 +11887,9 @@ WHERE
 	FROM YK2GW
 	WHERE FTQLQ IN ('SQ1')`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
+			" ├─ Eq\n" +
 			" │   ├─ YK2GW.FTQLQ:0!null\n" +
-			" │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │   └─ SQ1 (longtext)\n" +
 			" └─ IndexedTableAccess(YK2GW)\n" +
 			"     ├─ index: [YK2GW.FTQLQ]\n" +
 			"     ├─ static: [{[SQ1, SQ1]}]\n" +
@@ -11912,9 +12004,9 @@ WHERE
 			"         │                               │   ├─ cla.id:0!null\n" +
 			"         │                               │   └─ bs.IXUXU:3\n" +
 			"         │                               ├─ Filter\n" +
-			"         │                               │   ├─ HashIn\n" +
+			"         │                               │   ├─ Eq\n" +
 			"         │                               │   │   ├─ cla.FTQLQ:1!null\n" +
-			"         │                               │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │                               │   │   └─ SQ1 (longtext)\n" +
 			"         │                               │   └─ TableAlias(cla)\n" +
 			"         │                               │       └─ IndexedTableAccess(YK2GW)\n" +
 			"         │                               │           ├─ index: [YK2GW.id]\n" +
@@ -12054,13 +12146,13 @@ WHERE
 			"     ├─ select: T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF:2), SUM(XPRW6.SP4SI:3!null)\n" +
 			"     ├─ group: T4IBQ:0!null, ECUWU:1\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
+			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:2, XPRW6.SP4SI:3!null]\n" +
 			"         └─ SubqueryAlias\n" +
 			"             ├─ name: XPRW6\n" +
 			"             ├─ outerVisibility: false\n" +
 			"             ├─ cacheable: true\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
+			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
 			"                 └─ GroupBy\n" +
 			"                     ├─ select: T4IBQ:0!null, ECUWU:1, GSTQA:2, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
 			"                     │   ├─ LessThan\n" +
@@ -12079,320 +12171,308 @@ WHERE
 			"                             ├─ cacheable: true\n" +
 			"                             └─ Distinct\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:8 as ECUWU, pga.DZLIM:17 as GSTQA, pog.B5OUF:15, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:3 as TW55N]\n" +
-			"                                             └─ Filter\n" +
-			"                                                 ├─ Eq\n" +
-			"                                                 │   ├─ ms.D237E:11\n" +
-			"                                                 │   └─ true (tinyint)\n" +
-			"                                                 └─ LeftOuterHashJoin\n" +
-			"                                                     ├─ Eq\n" +
-			"                                                     │   ├─ nd.HPCMS:4\n" +
-			"                                                     │   └─ nma.id:25!null\n" +
-			"                                                     ├─ LeftOuterHashJoin\n" +
-			"                                                     │   ├─ AND\n" +
-			"                                                     │   │   ├─ Eq\n" +
-			"                                                     │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
-			"                                                     │   │   │   └─ bs.T4IBQ:1!null\n" +
-			"                                                     │   │   └─ Eq\n" +
-			"                                                     │   │       ├─ F26ZW.BRQP2:22!null\n" +
-			"                                                     │   │       └─ nd.id:2!null\n" +
-			"                                                     │   ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   ├─ AND\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ bs.id:0!null\n" +
-			"                                                     │   │   │   │   └─ fc.GXLUB:18!null\n" +
-			"                                                     │   │   │   └─ Eq\n" +
-			"                                                     │   │   │       ├─ nd.id:2!null\n" +
-			"                                                     │   │   │       └─ fc.LUEVY:19!null\n" +
-			"                                                     │   │   ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ ms.GXLUB:9!null\n" +
-			"                                                     │   │   │   │   └─ bs.id:0!null\n" +
-			"                                                     │   │   │   ├─ SubqueryAlias\n" +
-			"                                                     │   │   │   │   ├─ name: bs\n" +
-			"                                                     │   │   │   │   ├─ outerVisibility: false\n" +
-			"                                                     │   │   │   │   ├─ cacheable: true\n" +
-			"                                                     │   │   │   │   └─ Filter\n" +
-			"                                                     │   │   │   │       ├─ HashIn\n" +
-			"                                                     │   │   │   │       │   ├─ T4IBQ:1!null\n" +
-			"                                                     │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │   │   │   │       └─ Project\n" +
-			"                                                     │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
-			"                                                     │   │   │   │           └─ MergeJoin\n" +
-			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
-			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
-			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
-			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
-			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
-			"                                                     │   │   │   └─ HashLookup\n" +
-			"                                                     │   │   │       ├─ left-key: TUPLE(bs.id:0!null)\n" +
-			"                                                     │   │   │       ├─ right-key: TUPLE(ms.GXLUB:7!null)\n" +
-			"                                                     │   │   │       └─ CachedResults\n" +
-			"                                                     │   │   │           └─ HashJoin\n" +
-			"                                                     │   │   │               ├─ Eq\n" +
-			"                                                     │   │   │               │   ├─ pog.id:12\n" +
-			"                                                     │   │   │               │   └─ GZ7Z4.GMSGA:6!null\n" +
-			"                                                     │   │   │               ├─ MergeJoin\n" +
-			"                                                     │   │   │               │   ├─ cmp: Eq\n" +
-			"                                                     │   │   │               │   │   ├─ nd.id:2!null\n" +
-			"                                                     │   │   │               │   │   └─ GZ7Z4.LUEVY:5!null\n" +
-			"                                                     │   │   │               │   ├─ TableAlias(nd)\n" +
-			"                                                     │   │   │               │   │   └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               │   │       ├─ index: [E2I7U.id]\n" +
-			"                                                     │   │   │               │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │               │   │       └─ columns: [id tw55n hpcms]\n" +
-			"                                                     │   │   │               │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │               │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │               │           ├─ index: [FEIOE.LUEVY,FEIOE.GMSGA]\n" +
-			"                                                     │   │   │               │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                                                     │   │   │               │           └─ columns: [luevy gmsga]\n" +
-			"                                                     │   │   │               └─ HashLookup\n" +
-			"                                                     │   │   │                   ├─ left-key: TUPLE(GZ7Z4.GMSGA:6!null)\n" +
-			"                                                     │   │   │                   ├─ right-key: TUPLE(pog.id:5)\n" +
-			"                                                     │   │   │                   └─ CachedResults\n" +
-			"                                                     │   │   │                       └─ HashJoin\n" +
-			"                                                     │   │   │                           ├─ Eq\n" +
-			"                                                     │   │   │                           │   ├─ pog.XVSBH:14\n" +
-			"                                                     │   │   │                           │   └─ pga.id:16!null\n" +
-			"                                                     │   │   │                           ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   │                           │   ├─ Eq\n" +
-			"                                                     │   │   │                           │   │   ├─ pa.id:7!null\n" +
-			"                                                     │   │   │                           │   │   └─ pog.CH3FR:13!null\n" +
-			"                                                     │   │   │                           │   ├─ MergeJoin\n" +
-			"                                                     │   │   │                           │   │   ├─ cmp: Eq\n" +
-			"                                                     │   │   │                           │   │   │   ├─ pa.id:7!null\n" +
-			"                                                     │   │   │                           │   │   │   └─ ms.CH3FR:10!null\n" +
-			"                                                     │   │   │                           │   │   ├─ TableAlias(pa)\n" +
-			"                                                     │   │   │                           │   │   │   └─ IndexedTableAccess(XOAOP)\n" +
-			"                                                     │   │   │                           │   │   │       ├─ index: [XOAOP.id]\n" +
-			"                                                     │   │   │                           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │                           │   │   │       └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │                           │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │                           │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │                           │   │           ├─ index: [SZQWJ.CH3FR]\n" +
-			"                                                     │   │   │                           │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │                           │   │           └─ columns: [gxlub ch3fr d237e]\n" +
-			"                                                     │   │   │                           │   └─ HashLookup\n" +
-			"                                                     │   │   │                           │       ├─ left-key: TUPLE(pa.id:7!null)\n" +
-			"                                                     │   │   │                           │       ├─ right-key: TUPLE(pog.CH3FR:1!null)\n" +
-			"                                                     │   │   │                           │       └─ CachedResults\n" +
-			"                                                     │   │   │                           │           └─ TableAlias(pog)\n" +
-			"                                                     │   │   │                           │               └─ Table\n" +
-			"                                                     │   │   │                           │                   ├─ name: NPCYY\n" +
-			"                                                     │   │   │                           │                   └─ columns: [id ch3fr xvsbh b5ouf]\n" +
-			"                                                     │   │   │                           └─ HashLookup\n" +
-			"                                                     │   │   │                               ├─ left-key: TUPLE(pog.XVSBH:14)\n" +
-			"                                                     │   │   │                               ├─ right-key: TUPLE(pga.id:0!null)\n" +
-			"                                                     │   │   │                               └─ CachedResults\n" +
-			"                                                     │   │   │                                   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │                                       └─ Table\n" +
-			"                                                     │   │   │                                           ├─ name: PG27A\n" +
-			"                                                     │   │   │                                           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   └─ HashLookup\n" +
-			"                                                     │   │       ├─ left-key: TUPLE(bs.id:0!null, nd.id:2!null)\n" +
-			"                                                     │   │       ├─ right-key: TUPLE(fc.GXLUB:0!null, fc.LUEVY:1!null)\n" +
-			"                                                     │   │       └─ CachedResults\n" +
-			"                                                     │   │           └─ TableAlias(fc)\n" +
-			"                                                     │   │               └─ Table\n" +
-			"                                                     │   │                   ├─ name: AMYXQ\n" +
-			"                                                     │   │                   └─ columns: [gxlub luevy oztqf]\n" +
-			"                                                     │   └─ HashLookup\n" +
-			"                                                     │       ├─ left-key: TUPLE(bs.T4IBQ:1!null, nd.id:2!null)\n" +
-			"                                                     │       ├─ right-key: TUPLE(F26ZW.T4IBQ:0!null, F26ZW.BRQP2:1!null)\n" +
-			"                                                     │       └─ CachedResults\n" +
-			"                                                     │           └─ SubqueryAlias\n" +
-			"                                                     │               ├─ name: F26ZW\n" +
-			"                                                     │               ├─ outerVisibility: false\n" +
-			"                                                     │               ├─ cacheable: true\n" +
-			"                                                     │               └─ Project\n" +
-			"                                                     │                   ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ KAOAS (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ OG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ TSG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ W6W24 (longtext)\n" +
-			"                                                     │                   │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ OG (longtext)\n" +
-			"                                                     │                   │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ TSG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
-			"                                                     │                   └─ LeftOuterHashJoin\n" +
-			"                                                     │                       ├─ Eq\n" +
-			"                                                     │                       │   ├─ W2MAO.YH4XB:6\n" +
-			"                                                     │                       │   └─ vc.id:7!null\n" +
-			"                                                     │                       ├─ LeftOuterHashJoin\n" +
-			"                                                     │                       │   ├─ Eq\n" +
-			"                                                     │                       │   │   ├─ iq.Z7CP5:2!null\n" +
-			"                                                     │                       │   │   └─ W2MAO.Z7CP5:5!null\n" +
-			"                                                     │                       │   ├─ SubqueryAlias\n" +
-			"                                                     │                       │   │   ├─ name: iq\n" +
-			"                                                     │                       │   │   ├─ outerVisibility: false\n" +
-			"                                                     │                       │   │   ├─ cacheable: true\n" +
-			"                                                     │                       │   │   └─ Project\n" +
-			"                                                     │                       │   │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:11!null as IDWIO]\n" +
-			"                                                     │                       │   │       └─ HashJoin\n" +
-			"                                                     │                       │   │           ├─ AND\n" +
-			"                                                     │                       │   │           │   ├─ Eq\n" +
-			"                                                     │                       │   │           │   │   ├─ mf.LUEVY:6!null\n" +
-			"                                                     │                       │   │           │   │   └─ nd.id:8!null\n" +
-			"                                                     │                       │   │           │   └─ Eq\n" +
-			"                                                     │                       │   │           │       ├─ mf.LUEVY:6!null\n" +
-			"                                                     │                       │   │           │       └─ sn.BRQP2:12!null\n" +
-			"                                                     │                       │   │           ├─ HashJoin\n" +
-			"                                                     │                       │   │           │   ├─ Eq\n" +
-			"                                                     │                       │   │           │   │   ├─ mf.GXLUB:5!null\n" +
-			"                                                     │                       │   │           │   │   └─ bs.id:2!null\n" +
-			"                                                     │                       │   │           │   ├─ MergeJoin\n" +
-			"                                                     │                       │   │           │   │   ├─ cmp: Eq\n" +
-			"                                                     │                       │   │           │   │   │   ├─ cla.id:0!null\n" +
-			"                                                     │                       │   │           │   │   │   └─ bs.IXUXU:3\n" +
-			"                                                     │                       │   │           │   │   ├─ Filter\n" +
-			"                                                     │                       │   │           │   │   │   ├─ HashIn\n" +
-			"                                                     │                       │   │           │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                                                     │                       │   │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │                       │   │           │   │   │   └─ TableAlias(cla)\n" +
-			"                                                     │                       │   │           │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │                       │   │           │   │   │           ├─ index: [YK2GW.id]\n" +
-			"                                                     │                       │   │           │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │           │   │   │           └─ columns: [id ftqlq]\n" +
-			"                                                     │                       │   │           │   │   └─ TableAlias(bs)\n" +
-			"                                                     │                       │   │           │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │                       │   │           │   │           ├─ index: [THNTS.IXUXU]\n" +
-			"                                                     │                       │   │           │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │           │   │           └─ columns: [id ixuxu]\n" +
-			"                                                     │                       │   │           │   └─ HashLookup\n" +
-			"                                                     │                       │   │           │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
-			"                                                     │                       │   │           │       ├─ right-key: TUPLE(mf.GXLUB:1!null)\n" +
-			"                                                     │                       │   │           │       └─ CachedResults\n" +
-			"                                                     │                       │   │           │           └─ TableAlias(mf)\n" +
-			"                                                     │                       │   │           │               └─ Table\n" +
-			"                                                     │                       │   │           │                   ├─ name: HGMQ6\n" +
-			"                                                     │                       │   │           │                   └─ columns: [id gxlub luevy fsdy2]\n" +
-			"                                                     │                       │   │           └─ HashLookup\n" +
-			"                                                     │                       │   │               ├─ left-key: TUPLE(mf.LUEVY:6!null, mf.LUEVY:6!null)\n" +
-			"                                                     │                       │   │               ├─ right-key: TUPLE(nd.id:0!null, sn.BRQP2:4!null)\n" +
-			"                                                     │                       │   │               └─ CachedResults\n" +
-			"                                                     │                       │   │                   └─ HashJoin\n" +
-			"                                                     │                       │   │                       ├─ Eq\n" +
-			"                                                     │                       │   │                       │   ├─ sn.BRQP2:12!null\n" +
-			"                                                     │                       │   │                       │   └─ nd.id:8!null\n" +
-			"                                                     │                       │   │                       ├─ MergeJoin\n" +
-			"                                                     │                       │   │                       │   ├─ cmp: Eq\n" +
-			"                                                     │                       │   │                       │   │   ├─ nd.HPCMS:9!null\n" +
-			"                                                     │                       │   │                       │   │   └─ nma.id:10!null\n" +
-			"                                                     │                       │   │                       │   ├─ TableAlias(nd)\n" +
-			"                                                     │                       │   │                       │   │   └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │                       │   │                       │   │       ├─ index: [E2I7U.HPCMS]\n" +
-			"                                                     │                       │   │                       │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │                       │   │       └─ columns: [id hpcms]\n" +
-			"                                                     │                       │   │                       │   └─ TableAlias(nma)\n" +
-			"                                                     │                       │   │                       │       └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                     │                       │   │                       │           ├─ index: [TNMXI.id]\n" +
-			"                                                     │                       │   │                       │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │                       │           └─ columns: [id dzlim]\n" +
-			"                                                     │                       │   │                       └─ HashLookup\n" +
-			"                                                     │                       │   │                           ├─ left-key: TUPLE(nd.id:8!null)\n" +
-			"                                                     │                       │   │                           ├─ right-key: TUPLE(sn.BRQP2:0!null)\n" +
-			"                                                     │                       │   │                           └─ CachedResults\n" +
-			"                                                     │                       │   │                               └─ TableAlias(sn)\n" +
-			"                                                     │                       │   │                                   └─ Table\n" +
-			"                                                     │                       │   │                                       ├─ name: NOXN3\n" +
-			"                                                     │                       │   │                                       └─ columns: [brqp2]\n" +
-			"                                                     │                       │   └─ HashLookup\n" +
-			"                                                     │                       │       ├─ left-key: TUPLE(iq.Z7CP5:2!null)\n" +
-			"                                                     │                       │       ├─ right-key: TUPLE(W2MAO.Z7CP5:0!null)\n" +
-			"                                                     │                       │       └─ CachedResults\n" +
-			"                                                     │                       │           └─ TableAlias(W2MAO)\n" +
-			"                                                     │                       │               └─ Table\n" +
-			"                                                     │                       │                   ├─ name: SEQS3\n" +
-			"                                                     │                       │                   └─ columns: [z7cp5 yh4xb]\n" +
-			"                                                     │                       └─ HashLookup\n" +
-			"                                                     │                           ├─ left-key: TUPLE(W2MAO.YH4XB:6)\n" +
-			"                                                     │                           ├─ right-key: TUPLE(vc.id:0!null)\n" +
-			"                                                     │                           └─ CachedResults\n" +
-			"                                                     │                               └─ TableAlias(vc)\n" +
-			"                                                     │                                   └─ Table\n" +
-			"                                                     │                                       ├─ name: D34QP\n" +
-			"                                                     │                                       └─ columns: [id znp4p]\n" +
-			"                                                     └─ HashLookup\n" +
-			"                                                         ├─ left-key: TUPLE(nd.HPCMS:4)\n" +
-			"                                                         ├─ right-key: TUPLE(nma.id:0!null)\n" +
-			"                                                         └─ CachedResults\n" +
-			"                                                             └─ TableAlias(nma)\n" +
-			"                                                                 └─ Table\n" +
-			"                                                                     ├─ name: TNMXI\n" +
-			"                                                                     └─ columns: [id]\n" +
+			"                                     ├─ columns: [bs.T4IBQ:17!null, pa.DZLIM:9 as ECUWU, pga.DZLIM:3 as GSTQA, pog.B5OUF:7, nd.TW55N:11, fc.OZTQF:20, F26ZW.YHYLK:23]\n" +
+			"                                     └─ LeftOuterHashJoin\n" +
+			"                                         ├─ AND\n" +
+			"                                         │   ├─ Eq\n" +
+			"                                         │   │   ├─ F26ZW.T4IBQ:21!null\n" +
+			"                                         │   │   └─ bs.T4IBQ:17!null\n" +
+			"                                         │   └─ Eq\n" +
+			"                                         │       ├─ F26ZW.BRQP2:22!null\n" +
+			"                                         │       └─ nd.id:10!null\n" +
+			"                                         ├─ LeftOuterHashJoin\n" +
+			"                                         │   ├─ AND\n" +
+			"                                         │   │   ├─ Eq\n" +
+			"                                         │   │   │   ├─ bs.id:16!null\n" +
+			"                                         │   │   │   └─ fc.GXLUB:18!null\n" +
+			"                                         │   │   └─ Eq\n" +
+			"                                         │   │       ├─ nd.id:10!null\n" +
+			"                                         │   │       └─ fc.LUEVY:19!null\n" +
+			"                                         │   ├─ HashJoin\n" +
+			"                                         │   │   ├─ AND\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ ms.CH3FR:14!null\n" +
+			"                                         │   │   │   │   └─ pa.id:8!null\n" +
+			"                                         │   │   │   └─ Eq\n" +
+			"                                         │   │   │       ├─ ms.CH3FR:14!null\n" +
+			"                                         │   │   │       └─ pog.CH3FR:5!null\n" +
+			"                                         │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ GZ7Z4.LUEVY:0!null\n" +
+			"                                         │   │   │   │   └─ nd.id:10!null\n" +
+			"                                         │   │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   │   ├─ pa.id:8!null\n" +
+			"                                         │   │   │   │   │   └─ pog.CH3FR:5!null\n" +
+			"                                         │   │   │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   │   │   ├─ pog.id:4\n" +
+			"                                         │   │   │   │   │   │   └─ GZ7Z4.GMSGA:1!null\n" +
+			"                                         │   │   │   │   │   ├─ TableAlias(GZ7Z4)\n" +
+			"                                         │   │   │   │   │   │   └─ Table\n" +
+			"                                         │   │   │   │   │   │       ├─ name: FEIOE\n" +
+			"                                         │   │   │   │   │   │       └─ columns: [luevy gmsga]\n" +
+			"                                         │   │   │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │   │   │       ├─ left-key: TUPLE(GZ7Z4.GMSGA:1!null)\n" +
+			"                                         │   │   │   │   │       ├─ right-key: TUPLE(pog.id:2)\n" +
+			"                                         │   │   │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │   │   │           └─ MergeJoin\n" +
+			"                                         │   │   │   │   │               ├─ cmp: Eq\n" +
+			"                                         │   │   │   │   │               │   ├─ pga.id:2!null\n" +
+			"                                         │   │   │   │   │               │   └─ pog.XVSBH:6\n" +
+			"                                         │   │   │   │   │               ├─ TableAlias(pga)\n" +
+			"                                         │   │   │   │   │               │   └─ IndexedTableAccess(PG27A)\n" +
+			"                                         │   │   │   │   │               │       ├─ index: [PG27A.id]\n" +
+			"                                         │   │   │   │   │               │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │   │               │       └─ columns: [id dzlim]\n" +
+			"                                         │   │   │   │   │               └─ TableAlias(pog)\n" +
+			"                                         │   │   │   │   │                   └─ IndexedTableAccess(NPCYY)\n" +
+			"                                         │   │   │   │   │                       ├─ index: [NPCYY.XVSBH]\n" +
+			"                                         │   │   │   │   │                       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │   │                       └─ columns: [id ch3fr xvsbh b5ouf]\n" +
+			"                                         │   │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │   │       ├─ left-key: TUPLE(pog.CH3FR:5!null)\n" +
+			"                                         │   │   │   │       ├─ right-key: TUPLE(pa.id:0!null)\n" +
+			"                                         │   │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │   │           └─ TableAlias(pa)\n" +
+			"                                         │   │   │   │               └─ Table\n" +
+			"                                         │   │   │   │                   ├─ name: XOAOP\n" +
+			"                                         │   │   │   │                   └─ columns: [id dzlim]\n" +
+			"                                         │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │       ├─ left-key: TUPLE(GZ7Z4.LUEVY:0!null)\n" +
+			"                                         │   │   │       ├─ right-key: TUPLE(nd.id:0!null)\n" +
+			"                                         │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │           └─ TableAlias(nd)\n" +
+			"                                         │   │   │               └─ Table\n" +
+			"                                         │   │   │                   ├─ name: E2I7U\n" +
+			"                                         │   │   │                   └─ columns: [id tw55n hpcms]\n" +
+			"                                         │   │   └─ HashLookup\n" +
+			"                                         │   │       ├─ left-key: TUPLE(pa.id:8!null, pog.CH3FR:5!null)\n" +
+			"                                         │   │       ├─ right-key: TUPLE(ms.CH3FR:1!null, ms.CH3FR:1!null)\n" +
+			"                                         │   │       └─ CachedResults\n" +
+			"                                         │   │           └─ HashJoin\n" +
+			"                                         │   │               ├─ Eq\n" +
+			"                                         │   │               │   ├─ ms.GXLUB:13!null\n" +
+			"                                         │   │               │   └─ bs.id:16!null\n" +
+			"                                         │   │               ├─ Filter\n" +
+			"                                         │   │               │   ├─ Eq\n" +
+			"                                         │   │               │   │   ├─ ms.D237E:2\n" +
+			"                                         │   │               │   │   └─ true (tinyint)\n" +
+			"                                         │   │               │   └─ TableAlias(ms)\n" +
+			"                                         │   │               │       └─ Table\n" +
+			"                                         │   │               │           ├─ name: SZQWJ\n" +
+			"                                         │   │               │           └─ columns: [gxlub ch3fr d237e]\n" +
+			"                                         │   │               └─ HashLookup\n" +
+			"                                         │   │                   ├─ left-key: TUPLE(ms.GXLUB:13!null)\n" +
+			"                                         │   │                   ├─ right-key: TUPLE(bs.id:0!null)\n" +
+			"                                         │   │                   └─ CachedResults\n" +
+			"                                         │   │                       └─ SubqueryAlias\n" +
+			"                                         │   │                           ├─ name: bs\n" +
+			"                                         │   │                           ├─ outerVisibility: false\n" +
+			"                                         │   │                           ├─ cacheable: true\n" +
+			"                                         │   │                           └─ Filter\n" +
+			"                                         │   │                               ├─ Eq\n" +
+			"                                         │   │                               │   ├─ T4IBQ:1!null\n" +
+			"                                         │   │                               │   └─ SQ1 (longtext)\n" +
+			"                                         │   │                               └─ Project\n" +
+			"                                         │   │                                   ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
+			"                                         │   │                                   └─ MergeJoin\n" +
+			"                                         │   │                                       ├─ cmp: Eq\n" +
+			"                                         │   │                                       │   ├─ YK2GW.id:0!null\n" +
+			"                                         │   │                                       │   └─ THNTS.IXUXU:3\n" +
+			"                                         │   │                                       ├─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │   │                                       │   ├─ index: [YK2GW.id]\n" +
+			"                                         │   │                                       │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │                                       │   └─ columns: [id ftqlq]\n" +
+			"                                         │   │                                       └─ IndexedTableAccess(THNTS)\n" +
+			"                                         │   │                                           ├─ index: [THNTS.IXUXU]\n" +
+			"                                         │   │                                           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │                                           └─ columns: [id ixuxu]\n" +
+			"                                         │   └─ HashLookup\n" +
+			"                                         │       ├─ left-key: TUPLE(bs.id:16!null, nd.id:10!null)\n" +
+			"                                         │       ├─ right-key: TUPLE(fc.GXLUB:0!null, fc.LUEVY:1!null)\n" +
+			"                                         │       └─ CachedResults\n" +
+			"                                         │           └─ TableAlias(fc)\n" +
+			"                                         │               └─ Table\n" +
+			"                                         │                   ├─ name: AMYXQ\n" +
+			"                                         │                   └─ columns: [gxlub luevy oztqf]\n" +
+			"                                         └─ HashLookup\n" +
+			"                                             ├─ left-key: TUPLE(bs.T4IBQ:17!null, nd.id:10!null)\n" +
+			"                                             ├─ right-key: TUPLE(F26ZW.T4IBQ:0!null, F26ZW.BRQP2:1!null)\n" +
+			"                                             └─ CachedResults\n" +
+			"                                                 └─ SubqueryAlias\n" +
+			"                                                     ├─ name: F26ZW\n" +
+			"                                                     ├─ outerVisibility: false\n" +
+			"                                                     ├─ cacheable: true\n" +
+			"                                                     └─ Project\n" +
+			"                                                         ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, CASE  WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ KAOAS (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ OG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ TSG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ W6W24 (longtext)\n" +
+			"                                                         │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ OG (longtext)\n" +
+			"                                                         │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ TSG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
+			"                                                         └─ LeftOuterHashJoin\n" +
+			"                                                             ├─ Eq\n" +
+			"                                                             │   ├─ W2MAO.YH4XB:6\n" +
+			"                                                             │   └─ vc.id:7!null\n" +
+			"                                                             ├─ LeftOuterHashJoin\n" +
+			"                                                             │   ├─ Eq\n" +
+			"                                                             │   │   ├─ iq.Z7CP5:2!null\n" +
+			"                                                             │   │   └─ W2MAO.Z7CP5:5!null\n" +
+			"                                                             │   ├─ SubqueryAlias\n" +
+			"                                                             │   │   ├─ name: iq\n" +
+			"                                                             │   │   ├─ outerVisibility: false\n" +
+			"                                                             │   │   ├─ cacheable: true\n" +
+			"                                                             │   │   └─ Project\n" +
+			"                                                             │   │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:11!null as IDWIO]\n" +
+			"                                                             │   │       └─ HashJoin\n" +
+			"                                                             │   │           ├─ AND\n" +
+			"                                                             │   │           │   ├─ Eq\n" +
+			"                                                             │   │           │   │   ├─ mf.LUEVY:6!null\n" +
+			"                                                             │   │           │   │   └─ nd.id:8!null\n" +
+			"                                                             │   │           │   └─ Eq\n" +
+			"                                                             │   │           │       ├─ mf.LUEVY:6!null\n" +
+			"                                                             │   │           │       └─ sn.BRQP2:12!null\n" +
+			"                                                             │   │           ├─ HashJoin\n" +
+			"                                                             │   │           │   ├─ Eq\n" +
+			"                                                             │   │           │   │   ├─ mf.GXLUB:5!null\n" +
+			"                                                             │   │           │   │   └─ bs.id:2!null\n" +
+			"                                                             │   │           │   ├─ MergeJoin\n" +
+			"                                                             │   │           │   │   ├─ cmp: Eq\n" +
+			"                                                             │   │           │   │   │   ├─ cla.id:0!null\n" +
+			"                                                             │   │           │   │   │   └─ bs.IXUXU:3\n" +
+			"                                                             │   │           │   │   ├─ Filter\n" +
+			"                                                             │   │           │   │   │   ├─ Eq\n" +
+			"                                                             │   │           │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
+			"                                                             │   │           │   │   │   │   └─ SQ1 (longtext)\n" +
+			"                                                             │   │           │   │   │   └─ TableAlias(cla)\n" +
+			"                                                             │   │           │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                             │   │           │   │   │           ├─ index: [YK2GW.id]\n" +
+			"                                                             │   │           │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │           │   │   │           └─ columns: [id ftqlq]\n" +
+			"                                                             │   │           │   │   └─ TableAlias(bs)\n" +
+			"                                                             │   │           │   │       └─ IndexedTableAccess(THNTS)\n" +
+			"                                                             │   │           │   │           ├─ index: [THNTS.IXUXU]\n" +
+			"                                                             │   │           │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │           │   │           └─ columns: [id ixuxu]\n" +
+			"                                                             │   │           │   └─ HashLookup\n" +
+			"                                                             │   │           │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
+			"                                                             │   │           │       ├─ right-key: TUPLE(mf.GXLUB:1!null)\n" +
+			"                                                             │   │           │       └─ CachedResults\n" +
+			"                                                             │   │           │           └─ TableAlias(mf)\n" +
+			"                                                             │   │           │               └─ Table\n" +
+			"                                                             │   │           │                   ├─ name: HGMQ6\n" +
+			"                                                             │   │           │                   └─ columns: [id gxlub luevy fsdy2]\n" +
+			"                                                             │   │           └─ HashLookup\n" +
+			"                                                             │   │               ├─ left-key: TUPLE(mf.LUEVY:6!null, mf.LUEVY:6!null)\n" +
+			"                                                             │   │               ├─ right-key: TUPLE(nd.id:0!null, sn.BRQP2:4!null)\n" +
+			"                                                             │   │               └─ CachedResults\n" +
+			"                                                             │   │                   └─ HashJoin\n" +
+			"                                                             │   │                       ├─ Eq\n" +
+			"                                                             │   │                       │   ├─ sn.BRQP2:12!null\n" +
+			"                                                             │   │                       │   └─ nd.id:8!null\n" +
+			"                                                             │   │                       ├─ MergeJoin\n" +
+			"                                                             │   │                       │   ├─ cmp: Eq\n" +
+			"                                                             │   │                       │   │   ├─ nd.HPCMS:9!null\n" +
+			"                                                             │   │                       │   │   └─ nma.id:10!null\n" +
+			"                                                             │   │                       │   ├─ TableAlias(nd)\n" +
+			"                                                             │   │                       │   │   └─ IndexedTableAccess(E2I7U)\n" +
+			"                                                             │   │                       │   │       ├─ index: [E2I7U.HPCMS]\n" +
+			"                                                             │   │                       │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │                       │   │       └─ columns: [id hpcms]\n" +
+			"                                                             │   │                       │   └─ TableAlias(nma)\n" +
+			"                                                             │   │                       │       └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                             │   │                       │           ├─ index: [TNMXI.id]\n" +
+			"                                                             │   │                       │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │                       │           └─ columns: [id dzlim]\n" +
+			"                                                             │   │                       └─ HashLookup\n" +
+			"                                                             │   │                           ├─ left-key: TUPLE(nd.id:8!null)\n" +
+			"                                                             │   │                           ├─ right-key: TUPLE(sn.BRQP2:0!null)\n" +
+			"                                                             │   │                           └─ CachedResults\n" +
+			"                                                             │   │                               └─ TableAlias(sn)\n" +
+			"                                                             │   │                                   └─ Table\n" +
+			"                                                             │   │                                       ├─ name: NOXN3\n" +
+			"                                                             │   │                                       └─ columns: [brqp2]\n" +
+			"                                                             │   └─ HashLookup\n" +
+			"                                                             │       ├─ left-key: TUPLE(iq.Z7CP5:2!null)\n" +
+			"                                                             │       ├─ right-key: TUPLE(W2MAO.Z7CP5:0!null)\n" +
+			"                                                             │       └─ CachedResults\n" +
+			"                                                             │           └─ TableAlias(W2MAO)\n" +
+			"                                                             │               └─ Table\n" +
+			"                                                             │                   ├─ name: SEQS3\n" +
+			"                                                             │                   └─ columns: [z7cp5 yh4xb]\n" +
+			"                                                             └─ HashLookup\n" +
+			"                                                                 ├─ left-key: TUPLE(W2MAO.YH4XB:6)\n" +
+			"                                                                 ├─ right-key: TUPLE(vc.id:0!null)\n" +
+			"                                                                 └─ CachedResults\n" +
+			"                                                                     └─ TableAlias(vc)\n" +
+			"                                                                         └─ Table\n" +
+			"                                                                             ├─ name: D34QP\n" +
+			"                                                                             └─ columns: [id znp4p]\n" +
 			"",
 	},
 	{
@@ -12514,13 +12594,13 @@ WHERE
 			"     ├─ select: T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF:2), SUM(XPRW6.SP4SI:3!null)\n" +
 			"     ├─ group: T4IBQ:0!null, ECUWU:1\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
+			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:2, XPRW6.SP4SI:3!null]\n" +
 			"         └─ SubqueryAlias\n" +
 			"             ├─ name: XPRW6\n" +
 			"             ├─ outerVisibility: false\n" +
 			"             ├─ cacheable: true\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
+			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
 			"                 └─ GroupBy\n" +
 			"                     ├─ select: T4IBQ:0!null, ECUWU:1, GSTQA:2, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
 			"                     │   ├─ LessThan\n" +
@@ -12539,321 +12619,309 @@ WHERE
 			"                             ├─ cacheable: true\n" +
 			"                             └─ Distinct\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:8 as ECUWU, pga.DZLIM:17 as GSTQA, pog.B5OUF:15, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:3 as TW55N]\n" +
-			"                                             └─ Filter\n" +
-			"                                                 ├─ Eq\n" +
-			"                                                 │   ├─ ms.D237E:11\n" +
-			"                                                 │   └─ true (tinyint)\n" +
-			"                                                 └─ LeftOuterHashJoin\n" +
-			"                                                     ├─ Eq\n" +
-			"                                                     │   ├─ nd.HPCMS:4\n" +
-			"                                                     │   └─ nma.id:25!null\n" +
-			"                                                     ├─ LeftOuterHashJoin\n" +
-			"                                                     │   ├─ AND\n" +
-			"                                                     │   │   ├─ Eq\n" +
-			"                                                     │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
-			"                                                     │   │   │   └─ bs.T4IBQ:1!null\n" +
-			"                                                     │   │   └─ Eq\n" +
-			"                                                     │   │       ├─ F26ZW.BRQP2:22!null\n" +
-			"                                                     │   │       └─ nd.id:2!null\n" +
-			"                                                     │   ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   ├─ AND\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ bs.id:0!null\n" +
-			"                                                     │   │   │   │   └─ fc.GXLUB:18!null\n" +
-			"                                                     │   │   │   └─ Eq\n" +
-			"                                                     │   │   │       ├─ nd.id:2!null\n" +
-			"                                                     │   │   │       └─ fc.LUEVY:19!null\n" +
-			"                                                     │   │   ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ ms.GXLUB:9!null\n" +
-			"                                                     │   │   │   │   └─ bs.id:0!null\n" +
-			"                                                     │   │   │   ├─ SubqueryAlias\n" +
-			"                                                     │   │   │   │   ├─ name: bs\n" +
-			"                                                     │   │   │   │   ├─ outerVisibility: false\n" +
-			"                                                     │   │   │   │   ├─ cacheable: true\n" +
-			"                                                     │   │   │   │   └─ Filter\n" +
-			"                                                     │   │   │   │       ├─ HashIn\n" +
-			"                                                     │   │   │   │       │   ├─ T4IBQ:1!null\n" +
-			"                                                     │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │   │   │   │       └─ Project\n" +
-			"                                                     │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
-			"                                                     │   │   │   │           └─ MergeJoin\n" +
-			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
-			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
-			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
-			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
-			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
-			"                                                     │   │   │   └─ HashLookup\n" +
-			"                                                     │   │   │       ├─ left-key: TUPLE(bs.id:0!null)\n" +
-			"                                                     │   │   │       ├─ right-key: TUPLE(ms.GXLUB:7!null)\n" +
-			"                                                     │   │   │       └─ CachedResults\n" +
-			"                                                     │   │   │           └─ HashJoin\n" +
-			"                                                     │   │   │               ├─ Eq\n" +
-			"                                                     │   │   │               │   ├─ pog.id:12\n" +
-			"                                                     │   │   │               │   └─ GZ7Z4.GMSGA:6!null\n" +
-			"                                                     │   │   │               ├─ MergeJoin\n" +
-			"                                                     │   │   │               │   ├─ cmp: Eq\n" +
-			"                                                     │   │   │               │   │   ├─ nd.id:2!null\n" +
-			"                                                     │   │   │               │   │   └─ GZ7Z4.LUEVY:5!null\n" +
-			"                                                     │   │   │               │   ├─ TableAlias(nd)\n" +
-			"                                                     │   │   │               │   │   └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               │   │       ├─ index: [E2I7U.id]\n" +
-			"                                                     │   │   │               │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │               │   │       └─ columns: [id tw55n hpcms]\n" +
-			"                                                     │   │   │               │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │               │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │               │           ├─ index: [FEIOE.LUEVY,FEIOE.GMSGA]\n" +
-			"                                                     │   │   │               │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                                                     │   │   │               │           └─ columns: [luevy gmsga]\n" +
-			"                                                     │   │   │               └─ HashLookup\n" +
-			"                                                     │   │   │                   ├─ left-key: TUPLE(GZ7Z4.GMSGA:6!null)\n" +
-			"                                                     │   │   │                   ├─ right-key: TUPLE(pog.id:5)\n" +
-			"                                                     │   │   │                   └─ CachedResults\n" +
-			"                                                     │   │   │                       └─ HashJoin\n" +
-			"                                                     │   │   │                           ├─ Eq\n" +
-			"                                                     │   │   │                           │   ├─ pog.XVSBH:14\n" +
-			"                                                     │   │   │                           │   └─ pga.id:16!null\n" +
-			"                                                     │   │   │                           ├─ LeftOuterHashJoin\n" +
-			"                                                     │   │   │                           │   ├─ Eq\n" +
-			"                                                     │   │   │                           │   │   ├─ pa.id:7!null\n" +
-			"                                                     │   │   │                           │   │   └─ pog.CH3FR:13!null\n" +
-			"                                                     │   │   │                           │   ├─ MergeJoin\n" +
-			"                                                     │   │   │                           │   │   ├─ cmp: Eq\n" +
-			"                                                     │   │   │                           │   │   │   ├─ pa.id:7!null\n" +
-			"                                                     │   │   │                           │   │   │   └─ ms.CH3FR:10!null\n" +
-			"                                                     │   │   │                           │   │   ├─ TableAlias(pa)\n" +
-			"                                                     │   │   │                           │   │   │   └─ IndexedTableAccess(XOAOP)\n" +
-			"                                                     │   │   │                           │   │   │       ├─ index: [XOAOP.id]\n" +
-			"                                                     │   │   │                           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │                           │   │   │       └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │                           │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │                           │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │                           │   │           ├─ index: [SZQWJ.CH3FR]\n" +
-			"                                                     │   │   │                           │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │                           │   │           └─ columns: [gxlub ch3fr d237e]\n" +
-			"                                                     │   │   │                           │   └─ HashLookup\n" +
-			"                                                     │   │   │                           │       ├─ left-key: TUPLE(pa.id:7!null)\n" +
-			"                                                     │   │   │                           │       ├─ right-key: TUPLE(pog.CH3FR:1!null)\n" +
-			"                                                     │   │   │                           │       └─ CachedResults\n" +
-			"                                                     │   │   │                           │           └─ TableAlias(pog)\n" +
-			"                                                     │   │   │                           │               └─ Table\n" +
-			"                                                     │   │   │                           │                   ├─ name: NPCYY\n" +
-			"                                                     │   │   │                           │                   └─ columns: [id ch3fr xvsbh b5ouf]\n" +
-			"                                                     │   │   │                           └─ HashLookup\n" +
-			"                                                     │   │   │                               ├─ left-key: TUPLE(pog.XVSBH:14)\n" +
-			"                                                     │   │   │                               ├─ right-key: TUPLE(pga.id:0!null)\n" +
-			"                                                     │   │   │                               └─ CachedResults\n" +
-			"                                                     │   │   │                                   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │                                       └─ Table\n" +
-			"                                                     │   │   │                                           ├─ name: PG27A\n" +
-			"                                                     │   │   │                                           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   └─ HashLookup\n" +
-			"                                                     │   │       ├─ left-key: TUPLE(bs.id:0!null, nd.id:2!null)\n" +
-			"                                                     │   │       ├─ right-key: TUPLE(fc.GXLUB:0!null, fc.LUEVY:1!null)\n" +
-			"                                                     │   │       └─ CachedResults\n" +
-			"                                                     │   │           └─ TableAlias(fc)\n" +
-			"                                                     │   │               └─ Table\n" +
-			"                                                     │   │                   ├─ name: AMYXQ\n" +
-			"                                                     │   │                   └─ columns: [gxlub luevy oztqf]\n" +
-			"                                                     │   └─ HashLookup\n" +
-			"                                                     │       ├─ left-key: TUPLE(bs.T4IBQ:1!null, nd.id:2!null)\n" +
-			"                                                     │       ├─ right-key: TUPLE(F26ZW.T4IBQ:0!null, F26ZW.BRQP2:1!null)\n" +
-			"                                                     │       └─ CachedResults\n" +
-			"                                                     │           └─ SubqueryAlias\n" +
-			"                                                     │               ├─ name: F26ZW\n" +
-			"                                                     │               ├─ outerVisibility: false\n" +
-			"                                                     │               ├─ cacheable: true\n" +
-			"                                                     │               └─ Project\n" +
-			"                                                     │                   ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ KAOAS (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ OG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ Eq\n" +
-			"                                                     │                   │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ TSG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ W6W24 (longtext)\n" +
-			"                                                     │                   │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ OG (longtext)\n" +
-			"                                                     │                   │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │                   │   ├─ AND\n" +
-			"                                                     │                   │   │   ├─ IN\n" +
-			"                                                     │                   │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │                   │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │                   │   │   └─ NOT\n" +
-			"                                                     │                   │   │       └─ Eq\n" +
-			"                                                     │                   │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │                   │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │                   │   └─ Eq\n" +
-			"                                                     │                   │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │                   │       └─ TSG (longtext)\n" +
-			"                                                     │                   │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
-			"                                                     │                   └─ LeftOuterHashJoin\n" +
-			"                                                     │                       ├─ Eq\n" +
-			"                                                     │                       │   ├─ W2MAO.YH4XB:6\n" +
-			"                                                     │                       │   └─ vc.id:7!null\n" +
-			"                                                     │                       ├─ LeftOuterHashJoin\n" +
-			"                                                     │                       │   ├─ Eq\n" +
-			"                                                     │                       │   │   ├─ iq.Z7CP5:2!null\n" +
-			"                                                     │                       │   │   └─ W2MAO.Z7CP5:5!null\n" +
-			"                                                     │                       │   ├─ SubqueryAlias\n" +
-			"                                                     │                       │   │   ├─ name: iq\n" +
-			"                                                     │                       │   │   ├─ outerVisibility: false\n" +
-			"                                                     │                       │   │   ├─ cacheable: true\n" +
-			"                                                     │                       │   │   └─ Project\n" +
-			"                                                     │                       │   │       ├─ columns: [cla.FTQLQ:7!null as T4IBQ, sn.BRQP2:8!null, mf.id:2!null as Z7CP5, mf.FSDY2:5!null, nma.DZLIM:10!null as IDWIO]\n" +
-			"                                                     │                       │   │       └─ HashJoin\n" +
-			"                                                     │                       │   │           ├─ AND\n" +
-			"                                                     │                       │   │           │   ├─ Eq\n" +
-			"                                                     │                       │   │           │   │   ├─ mf.LUEVY:4!null\n" +
-			"                                                     │                       │   │           │   │   └─ nd.id:11!null\n" +
-			"                                                     │                       │   │           │   └─ Eq\n" +
-			"                                                     │                       │   │           │       ├─ mf.LUEVY:4!null\n" +
-			"                                                     │                       │   │           │       └─ sn.BRQP2:8!null\n" +
-			"                                                     │                       │   │           ├─ HashJoin\n" +
-			"                                                     │                       │   │           │   ├─ Eq\n" +
-			"                                                     │                       │   │           │   │   ├─ bs.IXUXU:1\n" +
-			"                                                     │                       │   │           │   │   └─ cla.id:6!null\n" +
-			"                                                     │                       │   │           │   ├─ MergeJoin\n" +
-			"                                                     │                       │   │           │   │   ├─ cmp: Eq\n" +
-			"                                                     │                       │   │           │   │   │   ├─ bs.id:0!null\n" +
-			"                                                     │                       │   │           │   │   │   └─ mf.GXLUB:3!null\n" +
-			"                                                     │                       │   │           │   │   ├─ TableAlias(bs)\n" +
-			"                                                     │                       │   │           │   │   │   └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │                       │   │           │   │   │       ├─ index: [THNTS.id]\n" +
-			"                                                     │                       │   │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │           │   │   │       └─ columns: [id ixuxu]\n" +
-			"                                                     │                       │   │           │   │   └─ TableAlias(mf)\n" +
-			"                                                     │                       │   │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                                                     │                       │   │           │   │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                                                     │                       │   │           │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │           │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
-			"                                                     │                       │   │           │   └─ HashLookup\n" +
-			"                                                     │                       │   │           │       ├─ left-key: TUPLE(bs.IXUXU:1)\n" +
-			"                                                     │                       │   │           │       ├─ right-key: TUPLE(cla.id:0!null)\n" +
-			"                                                     │                       │   │           │       └─ CachedResults\n" +
-			"                                                     │                       │   │           │           └─ Filter\n" +
-			"                                                     │                       │   │           │               ├─ HashIn\n" +
-			"                                                     │                       │   │           │               │   ├─ cla.FTQLQ:1!null\n" +
-			"                                                     │                       │   │           │               │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │                       │   │           │               └─ TableAlias(cla)\n" +
-			"                                                     │                       │   │           │                   └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │                       │   │           │                       ├─ index: [YK2GW.FTQLQ]\n" +
-			"                                                     │                       │   │           │                       ├─ static: [{[SQ1, SQ1]}]\n" +
-			"                                                     │                       │   │           │                       └─ columns: [id ftqlq]\n" +
-			"                                                     │                       │   │           └─ HashLookup\n" +
-			"                                                     │                       │   │               ├─ left-key: TUPLE(mf.LUEVY:4!null, mf.LUEVY:4!null)\n" +
-			"                                                     │                       │   │               ├─ right-key: TUPLE(nd.id:3!null, sn.BRQP2:0!null)\n" +
-			"                                                     │                       │   │               └─ CachedResults\n" +
-			"                                                     │                       │   │                   └─ HashJoin\n" +
-			"                                                     │                       │   │                       ├─ Eq\n" +
-			"                                                     │                       │   │                       │   ├─ sn.BRQP2:8!null\n" +
-			"                                                     │                       │   │                       │   └─ nd.id:11!null\n" +
-			"                                                     │                       │   │                       ├─ TableAlias(sn)\n" +
-			"                                                     │                       │   │                       │   └─ Table\n" +
-			"                                                     │                       │   │                       │       ├─ name: NOXN3\n" +
-			"                                                     │                       │   │                       │       └─ columns: [brqp2]\n" +
-			"                                                     │                       │   │                       └─ HashLookup\n" +
-			"                                                     │                       │   │                           ├─ left-key: TUPLE(sn.BRQP2:8!null)\n" +
-			"                                                     │                       │   │                           ├─ right-key: TUPLE(nd.id:2!null)\n" +
-			"                                                     │                       │   │                           └─ CachedResults\n" +
-			"                                                     │                       │   │                               └─ MergeJoin\n" +
-			"                                                     │                       │   │                                   ├─ cmp: Eq\n" +
-			"                                                     │                       │   │                                   │   ├─ nma.id:9!null\n" +
-			"                                                     │                       │   │                                   │   └─ nd.HPCMS:12!null\n" +
-			"                                                     │                       │   │                                   ├─ TableAlias(nma)\n" +
-			"                                                     │                       │   │                                   │   └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                     │                       │   │                                   │       ├─ index: [TNMXI.id]\n" +
-			"                                                     │                       │   │                                   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │                                   │       └─ columns: [id dzlim]\n" +
-			"                                                     │                       │   │                                   └─ TableAlias(nd)\n" +
-			"                                                     │                       │   │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │                       │   │                                           ├─ index: [E2I7U.HPCMS]\n" +
-			"                                                     │                       │   │                                           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │                       │   │                                           └─ columns: [id hpcms]\n" +
-			"                                                     │                       │   └─ HashLookup\n" +
-			"                                                     │                       │       ├─ left-key: TUPLE(iq.Z7CP5:2!null)\n" +
-			"                                                     │                       │       ├─ right-key: TUPLE(W2MAO.Z7CP5:0!null)\n" +
-			"                                                     │                       │       └─ CachedResults\n" +
-			"                                                     │                       │           └─ TableAlias(W2MAO)\n" +
-			"                                                     │                       │               └─ Table\n" +
-			"                                                     │                       │                   ├─ name: SEQS3\n" +
-			"                                                     │                       │                   └─ columns: [z7cp5 yh4xb]\n" +
-			"                                                     │                       └─ HashLookup\n" +
-			"                                                     │                           ├─ left-key: TUPLE(W2MAO.YH4XB:6)\n" +
-			"                                                     │                           ├─ right-key: TUPLE(vc.id:0!null)\n" +
-			"                                                     │                           └─ CachedResults\n" +
-			"                                                     │                               └─ TableAlias(vc)\n" +
-			"                                                     │                                   └─ Table\n" +
-			"                                                     │                                       ├─ name: D34QP\n" +
-			"                                                     │                                       └─ columns: [id znp4p]\n" +
-			"                                                     └─ HashLookup\n" +
-			"                                                         ├─ left-key: TUPLE(nd.HPCMS:4)\n" +
-			"                                                         ├─ right-key: TUPLE(nma.id:0!null)\n" +
-			"                                                         └─ CachedResults\n" +
-			"                                                             └─ TableAlias(nma)\n" +
-			"                                                                 └─ Table\n" +
-			"                                                                     ├─ name: TNMXI\n" +
-			"                                                                     └─ columns: [id]\n" +
+			"                                     ├─ columns: [bs.T4IBQ:17!null, pa.DZLIM:9 as ECUWU, pga.DZLIM:3 as GSTQA, pog.B5OUF:7, nd.TW55N:11, fc.OZTQF:20, F26ZW.YHYLK:23]\n" +
+			"                                     └─ LeftOuterHashJoin\n" +
+			"                                         ├─ AND\n" +
+			"                                         │   ├─ Eq\n" +
+			"                                         │   │   ├─ F26ZW.T4IBQ:21!null\n" +
+			"                                         │   │   └─ bs.T4IBQ:17!null\n" +
+			"                                         │   └─ Eq\n" +
+			"                                         │       ├─ F26ZW.BRQP2:22!null\n" +
+			"                                         │       └─ nd.id:10!null\n" +
+			"                                         ├─ LeftOuterHashJoin\n" +
+			"                                         │   ├─ AND\n" +
+			"                                         │   │   ├─ Eq\n" +
+			"                                         │   │   │   ├─ bs.id:16!null\n" +
+			"                                         │   │   │   └─ fc.GXLUB:18!null\n" +
+			"                                         │   │   └─ Eq\n" +
+			"                                         │   │       ├─ nd.id:10!null\n" +
+			"                                         │   │       └─ fc.LUEVY:19!null\n" +
+			"                                         │   ├─ HashJoin\n" +
+			"                                         │   │   ├─ AND\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ ms.CH3FR:14!null\n" +
+			"                                         │   │   │   │   └─ pa.id:8!null\n" +
+			"                                         │   │   │   └─ Eq\n" +
+			"                                         │   │   │       ├─ ms.CH3FR:14!null\n" +
+			"                                         │   │   │       └─ pog.CH3FR:5!null\n" +
+			"                                         │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ GZ7Z4.LUEVY:0!null\n" +
+			"                                         │   │   │   │   └─ nd.id:10!null\n" +
+			"                                         │   │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   │   ├─ pa.id:8!null\n" +
+			"                                         │   │   │   │   │   └─ pog.CH3FR:5!null\n" +
+			"                                         │   │   │   │   ├─ HashJoin\n" +
+			"                                         │   │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   │   │   ├─ pog.id:4\n" +
+			"                                         │   │   │   │   │   │   └─ GZ7Z4.GMSGA:1!null\n" +
+			"                                         │   │   │   │   │   ├─ TableAlias(GZ7Z4)\n" +
+			"                                         │   │   │   │   │   │   └─ Table\n" +
+			"                                         │   │   │   │   │   │       ├─ name: FEIOE\n" +
+			"                                         │   │   │   │   │   │       └─ columns: [luevy gmsga]\n" +
+			"                                         │   │   │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │   │   │       ├─ left-key: TUPLE(GZ7Z4.GMSGA:1!null)\n" +
+			"                                         │   │   │   │   │       ├─ right-key: TUPLE(pog.id:2)\n" +
+			"                                         │   │   │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │   │   │           └─ MergeJoin\n" +
+			"                                         │   │   │   │   │               ├─ cmp: Eq\n" +
+			"                                         │   │   │   │   │               │   ├─ pga.id:2!null\n" +
+			"                                         │   │   │   │   │               │   └─ pog.XVSBH:6\n" +
+			"                                         │   │   │   │   │               ├─ TableAlias(pga)\n" +
+			"                                         │   │   │   │   │               │   └─ IndexedTableAccess(PG27A)\n" +
+			"                                         │   │   │   │   │               │       ├─ index: [PG27A.id]\n" +
+			"                                         │   │   │   │   │               │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │   │               │       └─ columns: [id dzlim]\n" +
+			"                                         │   │   │   │   │               └─ TableAlias(pog)\n" +
+			"                                         │   │   │   │   │                   └─ IndexedTableAccess(NPCYY)\n" +
+			"                                         │   │   │   │   │                       ├─ index: [NPCYY.XVSBH]\n" +
+			"                                         │   │   │   │   │                       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │   │                       └─ columns: [id ch3fr xvsbh b5ouf]\n" +
+			"                                         │   │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │   │       ├─ left-key: TUPLE(pog.CH3FR:5!null)\n" +
+			"                                         │   │   │   │       ├─ right-key: TUPLE(pa.id:0!null)\n" +
+			"                                         │   │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │   │           └─ TableAlias(pa)\n" +
+			"                                         │   │   │   │               └─ Table\n" +
+			"                                         │   │   │   │                   ├─ name: XOAOP\n" +
+			"                                         │   │   │   │                   └─ columns: [id dzlim]\n" +
+			"                                         │   │   │   └─ HashLookup\n" +
+			"                                         │   │   │       ├─ left-key: TUPLE(GZ7Z4.LUEVY:0!null)\n" +
+			"                                         │   │   │       ├─ right-key: TUPLE(nd.id:0!null)\n" +
+			"                                         │   │   │       └─ CachedResults\n" +
+			"                                         │   │   │           └─ TableAlias(nd)\n" +
+			"                                         │   │   │               └─ Table\n" +
+			"                                         │   │   │                   ├─ name: E2I7U\n" +
+			"                                         │   │   │                   └─ columns: [id tw55n hpcms]\n" +
+			"                                         │   │   └─ HashLookup\n" +
+			"                                         │   │       ├─ left-key: TUPLE(pa.id:8!null, pog.CH3FR:5!null)\n" +
+			"                                         │   │       ├─ right-key: TUPLE(ms.CH3FR:1!null, ms.CH3FR:1!null)\n" +
+			"                                         │   │       └─ CachedResults\n" +
+			"                                         │   │           └─ HashJoin\n" +
+			"                                         │   │               ├─ Eq\n" +
+			"                                         │   │               │   ├─ ms.GXLUB:13!null\n" +
+			"                                         │   │               │   └─ bs.id:16!null\n" +
+			"                                         │   │               ├─ Filter\n" +
+			"                                         │   │               │   ├─ Eq\n" +
+			"                                         │   │               │   │   ├─ ms.D237E:2\n" +
+			"                                         │   │               │   │   └─ true (tinyint)\n" +
+			"                                         │   │               │   └─ TableAlias(ms)\n" +
+			"                                         │   │               │       └─ Table\n" +
+			"                                         │   │               │           ├─ name: SZQWJ\n" +
+			"                                         │   │               │           └─ columns: [gxlub ch3fr d237e]\n" +
+			"                                         │   │               └─ HashLookup\n" +
+			"                                         │   │                   ├─ left-key: TUPLE(ms.GXLUB:13!null)\n" +
+			"                                         │   │                   ├─ right-key: TUPLE(bs.id:0!null)\n" +
+			"                                         │   │                   └─ CachedResults\n" +
+			"                                         │   │                       └─ SubqueryAlias\n" +
+			"                                         │   │                           ├─ name: bs\n" +
+			"                                         │   │                           ├─ outerVisibility: false\n" +
+			"                                         │   │                           ├─ cacheable: true\n" +
+			"                                         │   │                           └─ Filter\n" +
+			"                                         │   │                               ├─ Eq\n" +
+			"                                         │   │                               │   ├─ T4IBQ:1!null\n" +
+			"                                         │   │                               │   └─ SQ1 (longtext)\n" +
+			"                                         │   │                               └─ Project\n" +
+			"                                         │   │                                   ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
+			"                                         │   │                                   └─ MergeJoin\n" +
+			"                                         │   │                                       ├─ cmp: Eq\n" +
+			"                                         │   │                                       │   ├─ YK2GW.id:0!null\n" +
+			"                                         │   │                                       │   └─ THNTS.IXUXU:3\n" +
+			"                                         │   │                                       ├─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │   │                                       │   ├─ index: [YK2GW.id]\n" +
+			"                                         │   │                                       │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │                                       │   └─ columns: [id ftqlq]\n" +
+			"                                         │   │                                       └─ IndexedTableAccess(THNTS)\n" +
+			"                                         │   │                                           ├─ index: [THNTS.IXUXU]\n" +
+			"                                         │   │                                           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │                                           └─ columns: [id ixuxu]\n" +
+			"                                         │   └─ HashLookup\n" +
+			"                                         │       ├─ left-key: TUPLE(bs.id:16!null, nd.id:10!null)\n" +
+			"                                         │       ├─ right-key: TUPLE(fc.GXLUB:0!null, fc.LUEVY:1!null)\n" +
+			"                                         │       └─ CachedResults\n" +
+			"                                         │           └─ TableAlias(fc)\n" +
+			"                                         │               └─ Table\n" +
+			"                                         │                   ├─ name: AMYXQ\n" +
+			"                                         │                   └─ columns: [gxlub luevy oztqf]\n" +
+			"                                         └─ HashLookup\n" +
+			"                                             ├─ left-key: TUPLE(bs.T4IBQ:17!null, nd.id:10!null)\n" +
+			"                                             ├─ right-key: TUPLE(F26ZW.T4IBQ:0!null, F26ZW.BRQP2:1!null)\n" +
+			"                                             └─ CachedResults\n" +
+			"                                                 └─ SubqueryAlias\n" +
+			"                                                     ├─ name: F26ZW\n" +
+			"                                                     ├─ outerVisibility: false\n" +
+			"                                                     ├─ cacheable: true\n" +
+			"                                                     └─ Project\n" +
+			"                                                         ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, CASE  WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ KAOAS (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ OG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ Eq\n" +
+			"                                                         │   │       ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │       └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ TSG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ W6W24 (longtext)\n" +
+			"                                                         │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ OG (longtext)\n" +
+			"                                                         │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                                         │   ├─ AND\n" +
+			"                                                         │   │   ├─ IN\n" +
+			"                                                         │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                                         │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                                         │   │   └─ NOT\n" +
+			"                                                         │   │       └─ Eq\n" +
+			"                                                         │   │           ├─ vc.ZNP4P:8\n" +
+			"                                                         │   │           └─ L5Q44 (longtext)\n" +
+			"                                                         │   └─ Eq\n" +
+			"                                                         │       ├─ iq.IDWIO:4!null\n" +
+			"                                                         │       └─ TSG (longtext)\n" +
+			"                                                         │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
+			"                                                         └─ LeftOuterHashJoin\n" +
+			"                                                             ├─ Eq\n" +
+			"                                                             │   ├─ W2MAO.YH4XB:6\n" +
+			"                                                             │   └─ vc.id:7!null\n" +
+			"                                                             ├─ LeftOuterHashJoin\n" +
+			"                                                             │   ├─ Eq\n" +
+			"                                                             │   │   ├─ iq.Z7CP5:2!null\n" +
+			"                                                             │   │   └─ W2MAO.Z7CP5:5!null\n" +
+			"                                                             │   ├─ SubqueryAlias\n" +
+			"                                                             │   │   ├─ name: iq\n" +
+			"                                                             │   │   ├─ outerVisibility: false\n" +
+			"                                                             │   │   ├─ cacheable: true\n" +
+			"                                                             │   │   └─ Project\n" +
+			"                                                             │   │       ├─ columns: [cla.FTQLQ:7!null as T4IBQ, sn.BRQP2:8!null, mf.id:2!null as Z7CP5, mf.FSDY2:5!null, nma.DZLIM:10!null as IDWIO]\n" +
+			"                                                             │   │       └─ HashJoin\n" +
+			"                                                             │   │           ├─ AND\n" +
+			"                                                             │   │           │   ├─ Eq\n" +
+			"                                                             │   │           │   │   ├─ mf.LUEVY:4!null\n" +
+			"                                                             │   │           │   │   └─ nd.id:11!null\n" +
+			"                                                             │   │           │   └─ Eq\n" +
+			"                                                             │   │           │       ├─ mf.LUEVY:4!null\n" +
+			"                                                             │   │           │       └─ sn.BRQP2:8!null\n" +
+			"                                                             │   │           ├─ HashJoin\n" +
+			"                                                             │   │           │   ├─ Eq\n" +
+			"                                                             │   │           │   │   ├─ bs.IXUXU:1\n" +
+			"                                                             │   │           │   │   └─ cla.id:6!null\n" +
+			"                                                             │   │           │   ├─ MergeJoin\n" +
+			"                                                             │   │           │   │   ├─ cmp: Eq\n" +
+			"                                                             │   │           │   │   │   ├─ bs.id:0!null\n" +
+			"                                                             │   │           │   │   │   └─ mf.GXLUB:3!null\n" +
+			"                                                             │   │           │   │   ├─ TableAlias(bs)\n" +
+			"                                                             │   │           │   │   │   └─ IndexedTableAccess(THNTS)\n" +
+			"                                                             │   │           │   │   │       ├─ index: [THNTS.id]\n" +
+			"                                                             │   │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │           │   │   │       └─ columns: [id ixuxu]\n" +
+			"                                                             │   │           │   │   └─ TableAlias(mf)\n" +
+			"                                                             │   │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                                             │   │           │   │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                                                             │   │           │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │           │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
+			"                                                             │   │           │   └─ HashLookup\n" +
+			"                                                             │   │           │       ├─ left-key: TUPLE(bs.IXUXU:1)\n" +
+			"                                                             │   │           │       ├─ right-key: TUPLE(cla.id:0!null)\n" +
+			"                                                             │   │           │       └─ CachedResults\n" +
+			"                                                             │   │           │           └─ Filter\n" +
+			"                                                             │   │           │               ├─ Eq\n" +
+			"                                                             │   │           │               │   ├─ cla.FTQLQ:1!null\n" +
+			"                                                             │   │           │               │   └─ SQ1 (longtext)\n" +
+			"                                                             │   │           │               └─ TableAlias(cla)\n" +
+			"                                                             │   │           │                   └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                             │   │           │                       ├─ index: [YK2GW.FTQLQ]\n" +
+			"                                                             │   │           │                       ├─ static: [{[SQ1, SQ1]}]\n" +
+			"                                                             │   │           │                       └─ columns: [id ftqlq]\n" +
+			"                                                             │   │           └─ HashLookup\n" +
+			"                                                             │   │               ├─ left-key: TUPLE(mf.LUEVY:4!null, mf.LUEVY:4!null)\n" +
+			"                                                             │   │               ├─ right-key: TUPLE(nd.id:3!null, sn.BRQP2:0!null)\n" +
+			"                                                             │   │               └─ CachedResults\n" +
+			"                                                             │   │                   └─ HashJoin\n" +
+			"                                                             │   │                       ├─ Eq\n" +
+			"                                                             │   │                       │   ├─ sn.BRQP2:8!null\n" +
+			"                                                             │   │                       │   └─ nd.id:11!null\n" +
+			"                                                             │   │                       ├─ TableAlias(sn)\n" +
+			"                                                             │   │                       │   └─ Table\n" +
+			"                                                             │   │                       │       ├─ name: NOXN3\n" +
+			"                                                             │   │                       │       └─ columns: [brqp2]\n" +
+			"                                                             │   │                       └─ HashLookup\n" +
+			"                                                             │   │                           ├─ left-key: TUPLE(sn.BRQP2:8!null)\n" +
+			"                                                             │   │                           ├─ right-key: TUPLE(nd.id:2!null)\n" +
+			"                                                             │   │                           └─ CachedResults\n" +
+			"                                                             │   │                               └─ MergeJoin\n" +
+			"                                                             │   │                                   ├─ cmp: Eq\n" +
+			"                                                             │   │                                   │   ├─ nma.id:9!null\n" +
+			"                                                             │   │                                   │   └─ nd.HPCMS:12!null\n" +
+			"                                                             │   │                                   ├─ TableAlias(nma)\n" +
+			"                                                             │   │                                   │   └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                             │   │                                   │       ├─ index: [TNMXI.id]\n" +
+			"                                                             │   │                                   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │                                   │       └─ columns: [id dzlim]\n" +
+			"                                                             │   │                                   └─ TableAlias(nd)\n" +
+			"                                                             │   │                                       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                                             │   │                                           ├─ index: [E2I7U.HPCMS]\n" +
+			"                                                             │   │                                           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │   │                                           └─ columns: [id hpcms]\n" +
+			"                                                             │   └─ HashLookup\n" +
+			"                                                             │       ├─ left-key: TUPLE(iq.Z7CP5:2!null)\n" +
+			"                                                             │       ├─ right-key: TUPLE(W2MAO.Z7CP5:0!null)\n" +
+			"                                                             │       └─ CachedResults\n" +
+			"                                                             │           └─ TableAlias(W2MAO)\n" +
+			"                                                             │               └─ Table\n" +
+			"                                                             │                   ├─ name: SEQS3\n" +
+			"                                                             │                   └─ columns: [z7cp5 yh4xb]\n" +
+			"                                                             └─ HashLookup\n" +
+			"                                                                 ├─ left-key: TUPLE(W2MAO.YH4XB:6)\n" +
+			"                                                                 ├─ right-key: TUPLE(vc.id:0!null)\n" +
+			"                                                                 └─ CachedResults\n" +
+			"                                                                     └─ TableAlias(vc)\n" +
+			"                                                                         └─ Table\n" +
+			"                                                                             ├─ name: D34QP\n" +
+			"                                                                             └─ columns: [id znp4p]\n" +
 			"",
 	},
 	{
@@ -12877,37 +12945,31 @@ WHERE
 	   ON XJ2RD.WNUNU = TUSAY.XLFIA
 	ORDER BY Y46B2 ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [TUSAY.Y3IOU:0!null as RWGEU]\n" +
-			" └─ Sort(XJ2RD.Y46B2:2!null ASC nullsFirst)\n" +
+			" ├─ columns: [TUSAY.Y3IOU:3!null as RWGEU]\n" +
+			" └─ Sort(QYWQD.id:0!null ASC nullsFirst)\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ XJ2RD.WNUNU:3!null\n" +
-			"         │   └─ TUSAY.XLFIA:1!null\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: TUSAY\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, XLFIA:1!null]\n" +
-			"         │       └─ Window\n" +
-			"         │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"         │           ├─ NOXN3.id:0!null as XLFIA\n" +
-			"         │           └─ Table\n" +
-			"         │               ├─ name: NOXN3\n" +
-			"         │               └─ columns: [id]\n" +
+			"         │   ├─ QYWQD.WNUNU:1!null\n" +
+			"         │   └─ TUSAY.XLFIA:4!null\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: QYWQD\n" +
+			"         │   └─ columns: [id wnunu hvhrz]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(TUSAY.XLFIA:1!null)\n" +
-			"             ├─ right-key: TUPLE(XJ2RD.WNUNU:1!null)\n" +
+			"             ├─ left-key: TUPLE(QYWQD.WNUNU:1!null)\n" +
+			"             ├─ right-key: TUPLE(TUSAY.XLFIA:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: XJ2RD\n" +
+			"                     ├─ name: TUSAY\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [QYWQD.id:0!null as Y46B2, QYWQD.WNUNU:1!null as WNUNU, QYWQD.HVHRZ:2!null as HVHRZ]\n" +
-			"                         └─ Table\n" +
-			"                             ├─ name: QYWQD\n" +
-			"                             └─ columns: [id wnunu hvhrz]\n" +
+			"                         ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, XLFIA:1!null]\n" +
+			"                         └─ Window\n" +
+			"                             ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             ├─ NOXN3.id:0!null as XLFIA\n" +
+			"                             └─ Table\n" +
+			"                                 ├─ name: NOXN3\n" +
+			"                                 └─ columns: [id]\n" +
 			"",
 	},
 	{
@@ -12957,24 +13019,18 @@ WHERE
 			"     ├─ name: YZXYP\n" +
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ cacheable: true\n" +
-			"     └─ Sort(nd.T722E:0!null ASC nullsFirst)\n" +
+			"     └─ Sort(T722E:0!null ASC nullsFirst)\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [nd.T722E:0!null, fc.Z35GY:2]\n" +
+			"             ├─ columns: [E2I7U.id:0!null as T722E, fc.Z35GY:2]\n" +
 			"             └─ LeftOuterHashJoin\n" +
 			"                 ├─ Eq\n" +
-			"                 │   ├─ nd.T722E:0!null\n" +
+			"                 │   ├─ E2I7U.id:0!null\n" +
 			"                 │   └─ fc.ZPAIK:1!null\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: nd\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [E2I7U.id:0!null as T722E]\n" +
-			"                 │       └─ Table\n" +
-			"                 │           ├─ name: E2I7U\n" +
-			"                 │           └─ columns: [id]\n" +
+			"                 ├─ Table\n" +
+			"                 │   ├─ name: E2I7U\n" +
+			"                 │   └─ columns: [id]\n" +
 			"                 └─ HashLookup\n" +
-			"                     ├─ left-key: TUPLE(nd.T722E:0!null)\n" +
+			"                     ├─ left-key: TUPLE(E2I7U.id:0!null)\n" +
 			"                     ├─ right-key: TUPLE(fc.ZPAIK:0!null)\n" +
 			"                     └─ CachedResults\n" +
 			"                         └─ SubqueryAlias\n" +
@@ -13180,9 +13236,9 @@ WHERE
 			"             │                       │   │   │   │   │   ├─ cla.id:0!null\n" +
 			"             │                       │   │   │   │   │   └─ bs.IXUXU:3\n" +
 			"             │                       │   │   │   │   ├─ Filter\n" +
-			"             │                       │   │   │   │   │   ├─ HashIn\n" +
+			"             │                       │   │   │   │   │   ├─ Eq\n" +
 			"             │                       │   │   │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"             │                       │   │   │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			"             │                       │   │   │   │   │   │   └─ SQ1 (longtext)\n" +
 			"             │                       │   │   │   │   │   └─ TableAlias(cla)\n" +
 			"             │                       │   │   │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
 			"             │                       │   │   │   │   │           ├─ index: [YK2GW.id]\n" +
@@ -13253,36 +13309,37 @@ WHERE
 			"                                 │   ├─ nd.TCE7A:4\n" +
 			"                                 │   └─ 0.900000 (double)\n" +
 			"                                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ Eq\n" +
-			"                                     │       ├─ nma.DZLIM:7\n" +
-			"                                     │       └─ Q5I4E (longtext)\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ nd.HPCMS:5\n" +
-			"                                         │   └─ nma.id:6!null\n" +
-			"                                         ├─ LeftOuterMergeJoin\n" +
-			"                                         │   ├─ cmp: Eq\n" +
-			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
-			"                                         │   │   └─ nd.id:2!null\n" +
-			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │       └─ columns: [id brqp2]\n" +
-			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
-			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ left-key: TUPLE(nd.HPCMS:5)\n" +
-			"                                             ├─ right-key: TUPLE(nma.id:0!null)\n" +
-			"                                             └─ CachedResults\n" +
+			"                                 └─ HashJoin\n" +
+			"                                     ├─ Eq\n" +
+			"                                     │   ├─ nd.HPCMS:5\n" +
+			"                                     │   └─ nma.id:6!null\n" +
+			"                                     ├─ LeftOuterMergeJoin\n" +
+			"                                     │   ├─ cmp: Eq\n" +
+			"                                     │   │   ├─ sn.BRQP2:1!null\n" +
+			"                                     │   │   └─ nd.id:2!null\n" +
+			"                                     │   ├─ TableAlias(sn)\n" +
+			"                                     │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │   │       └─ columns: [id brqp2]\n" +
+			"                                     │   └─ TableAlias(nd)\n" +
+			"                                     │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                     │           ├─ index: [E2I7U.id]\n" +
+			"                                     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │           └─ columns: [id tw55n tce7a hpcms]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ left-key: TUPLE(nd.HPCMS:5)\n" +
+			"                                         ├─ right-key: TUPLE(nma.id:0!null)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ Filter\n" +
+			"                                                 ├─ NOT\n" +
+			"                                                 │   └─ Eq\n" +
+			"                                                 │       ├─ nma.DZLIM:1\n" +
+			"                                                 │       └─ Q5I4E (longtext)\n" +
 			"                                                 └─ TableAlias(nma)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
+			"                                                     └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                         ├─ index: [TNMXI.DZLIM]\n" +
+			"                                                         ├─ static: [{(Q5I4E, ∞)}, {(NULL, Q5I4E)}]\n" +
 			"                                                         └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -13355,171 +13412,156 @@ WHERE
 		ExpectedPlan: "Sort(CKELE.M6T2N:4!null ASC nullsFirst)\n" +
 			" └─ Distinct\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [OXXEI.T4IBQ:2!null, OXXEI.Z7CP5:5!null, E52AP.KUXQY:9, OXXEI.BDNYB:3!null, CKELE.M6T2N:1!null, OXXEI.BTXC5:4 as BTXC5, OXXEI.vaf:6 as vaf, OXXEI.QCGTS:7 as QCGTS, OXXEI.SNY4H:8!null as SNY4H, E52AP.YHVEZ:11 as YHVEZ, E52AP.YAZ4X:12!null as YAZ4X]\n" +
+			"         ├─ columns: [cla.FTQLQ:21!null as T4IBQ, mf.id:6!null as Z7CP5, E52AP.KUXQY:0, sn.id:4!null as BDNYB, CKELE.M6T2N:15!null, aac.BTXC5:17 as BTXC5, CASE  WHEN NOT\n" +
+			"         │   └─ mf.LT7K6:11 IS NULL\n" +
+			"         │   THEN mf.LT7K6:11 ELSE mf.SPPYD:12 END as vaf, CASE  WHEN NOT\n" +
+			"         │   └─ mf.QCGTS:13 IS NULL\n" +
+			"         │   THEN mf.QCGTS:13 ELSE 0.500000 (double) END as QCGTS, CASE  WHEN Eq\n" +
+			"         │   ├─ vc.ZNP4P:23!null\n" +
+			"         │   └─ L5Q44 (longtext)\n" +
+			"         │   THEN 1 (tinyint) ELSE 0 (tinyint) END as SNY4H, E52AP.YHVEZ:2 as YHVEZ, E52AP.YAZ4X:3!null as YAZ4X]\n" +
 			"         └─ HashJoin\n" +
-			"             ├─ AND\n" +
-			"             │   ├─ Eq\n" +
-			"             │   │   ├─ E52AP.BDNYB:10!null\n" +
-			"             │   │   └─ OXXEI.BDNYB:3!null\n" +
-			"             │   └─ Eq\n" +
-			"             │       ├─ E52AP.BDNYB:10!null\n" +
-			"             │       └─ CKELE.LWQ6O:0!null\n" +
+			"             ├─ Eq\n" +
+			"             │   ├─ W2MAO.Z7CP5:24!null\n" +
+			"             │   └─ mf.id:6!null\n" +
 			"             ├─ HashJoin\n" +
-			"             │   ├─ Eq\n" +
-			"             │   │   ├─ CKELE.LWQ6O:0!null\n" +
-			"             │   │   └─ OXXEI.BDNYB:3!null\n" +
-			"             │   ├─ SubqueryAlias\n" +
-			"             │   │   ├─ name: CKELE\n" +
-			"             │   │   ├─ outerVisibility: false\n" +
-			"             │   │   ├─ cacheable: true\n" +
-			"             │   │   └─ Project\n" +
-			"             │   │       ├─ columns: [LWQ6O:0!null, row_number() over ( order by NOXN3.id ASC):1!null as M6T2N]\n" +
-			"             │   │       └─ Window\n" +
-			"             │   │           ├─ NOXN3.id:0!null as LWQ6O\n" +
-			"             │   │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"             │   │           └─ Table\n" +
-			"             │   │               ├─ name: NOXN3\n" +
-			"             │   │               └─ columns: [id]\n" +
+			"             │   ├─ AND\n" +
+			"             │   │   ├─ Eq\n" +
+			"             │   │   │   ├─ mf.GXLUB:7!null\n" +
+			"             │   │   │   └─ bs.id:18!null\n" +
+			"             │   │   └─ Eq\n" +
+			"             │   │       ├─ cla.FTQLQ:21!null\n" +
+			"             │   │       └─ SQ1 (longtext)\n" +
+			"             │   ├─ HashJoin\n" +
+			"             │   │   ├─ Eq\n" +
+			"             │   │   │   ├─ aac.id:16!null\n" +
+			"             │   │   │   └─ mf.M22QN:9!null\n" +
+			"             │   │   ├─ HashJoin\n" +
+			"             │   │   │   ├─ Eq\n" +
+			"             │   │   │   │   ├─ E52AP.BDNYB:1!null\n" +
+			"             │   │   │   │   └─ CKELE.LWQ6O:14!null\n" +
+			"             │   │   │   ├─ LookupJoin\n" +
+			"             │   │   │   │   ├─ AND\n" +
+			"             │   │   │   │   │   ├─ Eq\n" +
+			"             │   │   │   │   │   │   ├─ sn.BRQP2:5!null\n" +
+			"             │   │   │   │   │   │   └─ mf.LUEVY:8!null\n" +
+			"             │   │   │   │   │   └─ IN\n" +
+			"             │   │   │   │   │       ├─ left: mf.FSDY2:10!null\n" +
+			"             │   │   │   │   │       └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"             │   │   │   │   ├─ LookupJoin\n" +
+			"             │   │   │   │   │   ├─ Eq\n" +
+			"             │   │   │   │   │   │   ├─ E52AP.BDNYB:1!null\n" +
+			"             │   │   │   │   │   │   └─ sn.id:4!null\n" +
+			"             │   │   │   │   │   ├─ SubqueryAlias\n" +
+			"             │   │   │   │   │   │   ├─ name: E52AP\n" +
+			"             │   │   │   │   │   │   ├─ outerVisibility: false\n" +
+			"             │   │   │   │   │   │   ├─ cacheable: true\n" +
+			"             │   │   │   │   │   │   └─ Sort(BDNYB:1!null ASC nullsFirst)\n" +
+			"             │   │   │   │   │   │       └─ Project\n" +
+			"             │   │   │   │   │   │           ├─ columns: [nd.TW55N:3 as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:7 as YHVEZ, CASE  WHEN LessThan\n" +
+			"             │   │   │   │   │   │           │   ├─ nd.TCE7A:4\n" +
+			"             │   │   │   │   │   │           │   └─ 0.900000 (double)\n" +
+			"             │   │   │   │   │   │           │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
+			"             │   │   │   │   │   │           └─ HashJoin\n" +
+			"             │   │   │   │   │   │               ├─ Eq\n" +
+			"             │   │   │   │   │   │               │   ├─ nd.HPCMS:5\n" +
+			"             │   │   │   │   │   │               │   └─ nma.id:6!null\n" +
+			"             │   │   │   │   │   │               ├─ LeftOuterMergeJoin\n" +
+			"             │   │   │   │   │   │               │   ├─ cmp: Eq\n" +
+			"             │   │   │   │   │   │               │   │   ├─ sn.BRQP2:1!null\n" +
+			"             │   │   │   │   │   │               │   │   └─ nd.id:2!null\n" +
+			"             │   │   │   │   │   │               │   ├─ TableAlias(sn)\n" +
+			"             │   │   │   │   │   │               │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"             │   │   │   │   │   │               │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"             │   │   │   │   │   │               │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │   │   │               │   │       └─ columns: [id brqp2]\n" +
+			"             │   │   │   │   │   │               │   └─ TableAlias(nd)\n" +
+			"             │   │   │   │   │   │               │       └─ IndexedTableAccess(E2I7U)\n" +
+			"             │   │   │   │   │   │               │           ├─ index: [E2I7U.id]\n" +
+			"             │   │   │   │   │   │               │           ├─ static: [{[NULL, ∞)}]\n" +
+			"             │   │   │   │   │   │               │           └─ columns: [id tw55n tce7a hpcms]\n" +
+			"             │   │   │   │   │   │               └─ HashLookup\n" +
+			"             │   │   │   │   │   │                   ├─ left-key: TUPLE(nd.HPCMS:5)\n" +
+			"             │   │   │   │   │   │                   ├─ right-key: TUPLE(nma.id:0!null)\n" +
+			"             │   │   │   │   │   │                   └─ CachedResults\n" +
+			"             │   │   │   │   │   │                       └─ Filter\n" +
+			"             │   │   │   │   │   │                           ├─ NOT\n" +
+			"             │   │   │   │   │   │                           │   └─ Eq\n" +
+			"             │   │   │   │   │   │                           │       ├─ nma.DZLIM:1\n" +
+			"             │   │   │   │   │   │                           │       └─ Q5I4E (longtext)\n" +
+			"             │   │   │   │   │   │                           └─ TableAlias(nma)\n" +
+			"             │   │   │   │   │   │                               └─ IndexedTableAccess(TNMXI)\n" +
+			"             │   │   │   │   │   │                                   ├─ index: [TNMXI.DZLIM]\n" +
+			"             │   │   │   │   │   │                                   ├─ static: [{(Q5I4E, ∞)}, {(NULL, Q5I4E)}]\n" +
+			"             │   │   │   │   │   │                                   └─ columns: [id dzlim]\n" +
+			"             │   │   │   │   │   └─ TableAlias(sn)\n" +
+			"             │   │   │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"             │   │   │   │   │           ├─ index: [NOXN3.id]\n" +
+			"             │   │   │   │   │           └─ columns: [id brqp2]\n" +
+			"             │   │   │   │   └─ TableAlias(mf)\n" +
+			"             │   │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"             │   │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"             │   │   │   │           └─ columns: [id gxlub luevy m22qn fsdy2 lt7k6 sppyd qcgts]\n" +
+			"             │   │   │   └─ HashLookup\n" +
+			"             │   │   │       ├─ left-key: TUPLE(E52AP.BDNYB:1!null)\n" +
+			"             │   │   │       ├─ right-key: TUPLE(CKELE.LWQ6O:0!null)\n" +
+			"             │   │   │       └─ CachedResults\n" +
+			"             │   │   │           └─ SubqueryAlias\n" +
+			"             │   │   │               ├─ name: CKELE\n" +
+			"             │   │   │               ├─ outerVisibility: false\n" +
+			"             │   │   │               ├─ cacheable: true\n" +
+			"             │   │   │               └─ Project\n" +
+			"             │   │   │                   ├─ columns: [LWQ6O:0!null, row_number() over ( order by NOXN3.id ASC):1!null as M6T2N]\n" +
+			"             │   │   │                   └─ Window\n" +
+			"             │   │   │                       ├─ NOXN3.id:0!null as LWQ6O\n" +
+			"             │   │   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"             │   │   │                       └─ Table\n" +
+			"             │   │   │                           ├─ name: NOXN3\n" +
+			"             │   │   │                           └─ columns: [id]\n" +
+			"             │   │   └─ HashLookup\n" +
+			"             │   │       ├─ left-key: TUPLE(mf.M22QN:9!null)\n" +
+			"             │   │       ├─ right-key: TUPLE(aac.id:0!null)\n" +
+			"             │   │       └─ CachedResults\n" +
+			"             │   │           └─ TableAlias(aac)\n" +
+			"             │   │               └─ Table\n" +
+			"             │   │                   ├─ name: TPXBU\n" +
+			"             │   │                   └─ columns: [id btxc5]\n" +
 			"             │   └─ HashLookup\n" +
-			"             │       ├─ left-key: TUPLE(CKELE.LWQ6O:0!null)\n" +
-			"             │       ├─ right-key: TUPLE(OXXEI.BDNYB:1!null)\n" +
+			"             │       ├─ left-key: TUPLE(mf.GXLUB:7!null, SQ1 (longtext))\n" +
+			"             │       ├─ right-key: TUPLE(bs.id:0!null, cla.FTQLQ:3!null)\n" +
 			"             │       └─ CachedResults\n" +
-			"             │           └─ SubqueryAlias\n" +
-			"             │               ├─ name: OXXEI\n" +
-			"             │               ├─ outerVisibility: false\n" +
-			"             │               ├─ cacheable: true\n" +
-			"             │               └─ Project\n" +
-			"             │                   ├─ columns: [cla.FTQLQ:13!null as T4IBQ, sn.id:0!null as BDNYB, aac.BTXC5:11 as BTXC5, mf.id:2!null as Z7CP5, CASE  WHEN NOT\n" +
-			"             │                   │   └─ mf.LT7K6:7 IS NULL\n" +
-			"             │                   │   THEN mf.LT7K6:7 ELSE mf.SPPYD:8 END as vaf, CASE  WHEN NOT\n" +
-			"             │                   │   └─ mf.QCGTS:9 IS NULL\n" +
-			"             │                   │   THEN mf.QCGTS:9 ELSE 0.500000 (double) END as QCGTS, CASE  WHEN Eq\n" +
-			"             │                   │   ├─ vc.ZNP4P:17!null\n" +
-			"             │                   │   └─ L5Q44 (longtext)\n" +
-			"             │                   │   THEN 1 (tinyint) ELSE 0 (tinyint) END as SNY4H]\n" +
-			"             │                   └─ HashJoin\n" +
-			"             │                       ├─ Eq\n" +
-			"             │                       │   ├─ W2MAO.Z7CP5:18!null\n" +
-			"             │                       │   └─ mf.id:2!null\n" +
-			"             │                       ├─ HashJoin\n" +
-			"             │                       │   ├─ Eq\n" +
-			"             │                       │   │   ├─ mf.GXLUB:3!null\n" +
-			"             │                       │   │   └─ bs.id:14!null\n" +
-			"             │                       │   ├─ HashJoin\n" +
-			"             │                       │   │   ├─ Eq\n" +
-			"             │                       │   │   │   ├─ aac.id:10!null\n" +
-			"             │                       │   │   │   └─ mf.M22QN:5!null\n" +
-			"             │                       │   │   ├─ MergeJoin\n" +
-			"             │                       │   │   │   ├─ cmp: Eq\n" +
-			"             │                       │   │   │   │   ├─ sn.BRQP2:1!null\n" +
-			"             │                       │   │   │   │   └─ mf.LUEVY:4!null\n" +
-			"             │                       │   │   │   ├─ TableAlias(sn)\n" +
-			"             │                       │   │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       │   │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │                       │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │   │   │   │       └─ columns: [id brqp2]\n" +
-			"             │                       │   │   │   └─ Filter\n" +
-			"             │                       │   │   │       ├─ HashIn\n" +
-			"             │                       │   │   │       │   ├─ mf.FSDY2:4!null\n" +
-			"             │                       │   │   │       │   └─ TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"             │                       │   │   │       └─ TableAlias(mf)\n" +
-			"             │                       │   │   │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                       │   │   │               ├─ index: [HGMQ6.LUEVY]\n" +
-			"             │                       │   │   │               ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │   │   │               └─ columns: [id gxlub luevy m22qn fsdy2 lt7k6 sppyd qcgts]\n" +
-			"             │                       │   │   └─ HashLookup\n" +
-			"             │                       │   │       ├─ left-key: TUPLE(mf.M22QN:5!null)\n" +
-			"             │                       │   │       ├─ right-key: TUPLE(aac.id:0!null)\n" +
-			"             │                       │   │       └─ CachedResults\n" +
-			"             │                       │   │           └─ TableAlias(aac)\n" +
-			"             │                       │   │               └─ Table\n" +
-			"             │                       │   │                   ├─ name: TPXBU\n" +
-			"             │                       │   │                   └─ columns: [id btxc5]\n" +
-			"             │                       │   └─ HashLookup\n" +
-			"             │                       │       ├─ left-key: TUPLE(mf.GXLUB:3!null)\n" +
-			"             │                       │       ├─ right-key: TUPLE(bs.id:2!null)\n" +
-			"             │                       │       └─ CachedResults\n" +
-			"             │                       │           └─ MergeJoin\n" +
-			"             │                       │               ├─ cmp: Eq\n" +
-			"             │                       │               │   ├─ cla.id:12!null\n" +
-			"             │                       │               │   └─ bs.IXUXU:15\n" +
-			"             │                       │               ├─ Filter\n" +
-			"             │                       │               │   ├─ HashIn\n" +
-			"             │                       │               │   │   ├─ cla.FTQLQ:1!null\n" +
-			"             │                       │               │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │                       │               │   └─ TableAlias(cla)\n" +
-			"             │                       │               │       └─ IndexedTableAccess(YK2GW)\n" +
-			"             │                       │               │           ├─ index: [YK2GW.id]\n" +
-			"             │                       │               │           ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │               │           └─ columns: [id ftqlq]\n" +
-			"             │                       │               └─ TableAlias(bs)\n" +
-			"             │                       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │                       │                       ├─ index: [THNTS.IXUXU]\n" +
-			"             │                       │                       ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │                       └─ columns: [id ixuxu]\n" +
-			"             │                       └─ HashLookup\n" +
-			"             │                           ├─ left-key: TUPLE(mf.id:2!null)\n" +
-			"             │                           ├─ right-key: TUPLE(W2MAO.Z7CP5:2!null)\n" +
-			"             │                           └─ CachedResults\n" +
-			"             │                               └─ MergeJoin\n" +
-			"             │                                   ├─ cmp: Eq\n" +
-			"             │                                   │   ├─ vc.id:16!null\n" +
-			"             │                                   │   └─ W2MAO.YH4XB:19!null\n" +
-			"             │                                   ├─ TableAlias(vc)\n" +
-			"             │                                   │   └─ IndexedTableAccess(D34QP)\n" +
-			"             │                                   │       ├─ index: [D34QP.id]\n" +
-			"             │                                   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                                   │       └─ columns: [id znp4p]\n" +
-			"             │                                   └─ TableAlias(W2MAO)\n" +
-			"             │                                       └─ IndexedTableAccess(SEQS3)\n" +
-			"             │                                           ├─ index: [SEQS3.YH4XB]\n" +
-			"             │                                           ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                                           └─ columns: [z7cp5 yh4xb]\n" +
+			"             │           └─ MergeJoin\n" +
+			"             │               ├─ cmp: Eq\n" +
+			"             │               │   ├─ bs.IXUXU:19\n" +
+			"             │               │   └─ cla.id:20!null\n" +
+			"             │               ├─ TableAlias(bs)\n" +
+			"             │               │   └─ IndexedTableAccess(THNTS)\n" +
+			"             │               │       ├─ index: [THNTS.IXUXU]\n" +
+			"             │               │       ├─ static: [{[NULL, ∞)}]\n" +
+			"             │               │       └─ columns: [id ixuxu]\n" +
+			"             │               └─ TableAlias(cla)\n" +
+			"             │                   └─ IndexedTableAccess(YK2GW)\n" +
+			"             │                       ├─ index: [YK2GW.id]\n" +
+			"             │                       ├─ static: [{[NULL, ∞)}]\n" +
+			"             │                       └─ columns: [id ftqlq]\n" +
 			"             └─ HashLookup\n" +
-			"                 ├─ left-key: TUPLE(OXXEI.BDNYB:3!null, CKELE.LWQ6O:0!null)\n" +
-			"                 ├─ right-key: TUPLE(E52AP.BDNYB:1!null, E52AP.BDNYB:1!null)\n" +
+			"                 ├─ left-key: TUPLE(mf.id:6!null)\n" +
+			"                 ├─ right-key: TUPLE(W2MAO.Z7CP5:2!null)\n" +
 			"                 └─ CachedResults\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: E52AP\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ Sort(BDNYB:1!null ASC nullsFirst)\n" +
-			"                             └─ Project\n" +
-			"                                 ├─ columns: [nd.TW55N:3 as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:7 as YHVEZ, CASE  WHEN LessThan\n" +
-			"                                 │   ├─ nd.TCE7A:4\n" +
-			"                                 │   └─ 0.900000 (double)\n" +
-			"                                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ Eq\n" +
-			"                                     │       ├─ nma.DZLIM:7\n" +
-			"                                     │       └─ Q5I4E (longtext)\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ nd.HPCMS:5\n" +
-			"                                         │   └─ nma.id:6!null\n" +
-			"                                         ├─ LeftOuterMergeJoin\n" +
-			"                                         │   ├─ cmp: Eq\n" +
-			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
-			"                                         │   │   └─ nd.id:2!null\n" +
-			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │       └─ columns: [id brqp2]\n" +
-			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
-			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ left-key: TUPLE(nd.HPCMS:5)\n" +
-			"                                             ├─ right-key: TUPLE(nma.id:0!null)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(nma)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
-			"                                                         └─ columns: [id dzlim]\n" +
+			"                     └─ MergeJoin\n" +
+			"                         ├─ cmp: Eq\n" +
+			"                         │   ├─ vc.id:22!null\n" +
+			"                         │   └─ W2MAO.YH4XB:25!null\n" +
+			"                         ├─ TableAlias(vc)\n" +
+			"                         │   └─ IndexedTableAccess(D34QP)\n" +
+			"                         │       ├─ index: [D34QP.id]\n" +
+			"                         │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                         │       └─ columns: [id znp4p]\n" +
+			"                         └─ TableAlias(W2MAO)\n" +
+			"                             └─ IndexedTableAccess(SEQS3)\n" +
+			"                                 ├─ index: [SEQS3.YH4XB]\n" +
+			"                                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                                 └─ columns: [z7cp5 yh4xb]\n" +
 			"",
 	},
 	{
@@ -13750,7 +13792,7 @@ WHERE
 			"     │   └─ Eq\n" +
 			"     │       ├─ aac.id:21!null\n" +
 			"     │       └─ MJR3D.M22QN:3!null\n" +
-			"     ├─ LeftOuterJoin\n" +
+			"     ├─ InnerJoin\n" +
 			"     │   ├─ Or\n" +
 			"     │   │   ├─ Or\n" +
 			"     │   │   │   ├─ Or\n" +
@@ -13826,341 +13868,318 @@ WHERE
 			"     │   │   ├─ outerVisibility: false\n" +
 			"     │   │   ├─ cacheable: true\n" +
 			"     │   │   └─ Union distinct\n" +
-			"     │   │       ├─ Project\n" +
-			"     │   │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
-			"     │   │       │   │   ├─ type: char\n" +
-			"     │   │       │   │   └─ JCHIR.QNI57:9\n" +
-			"     │   │       │   │   as QNI57, TDEIU:10 as TDEIU]\n" +
-			"     │   │       │   └─ Union distinct\n" +
-			"     │   │       │       ├─ Project\n" +
-			"     │   │       │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
-			"     │   │       │       │   │   ├─ type: char\n" +
-			"     │   │       │       │   │   └─ JCHIR.TDEIU:10\n" +
-			"     │   │       │       │   │   as TDEIU]\n" +
-			"     │   │       │       │   └─ SubqueryAlias\n" +
-			"     │   │       │       │       ├─ name: JCHIR\n" +
-			"     │   │       │       │       ├─ outerVisibility: false\n" +
-			"     │   │       │       │       ├─ cacheable: true\n" +
-			"     │   │       │       │       └─ Filter\n" +
-			"     │   │       │       │           ├─ Or\n" +
-			"     │   │       │       │           │   ├─ AND\n" +
-			"     │   │       │       │           │   │   ├─ NOT\n" +
-			"     │   │       │       │           │   │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │       │       │           │   │   └─ TDEIU:10 IS NULL\n" +
-			"     │   │       │       │           │   └─ AND\n" +
-			"     │   │       │       │           │       ├─ QNI57:9 IS NULL\n" +
-			"     │   │       │       │           │       └─ NOT\n" +
-			"     │   │       │       │           │           └─ TDEIU:10 IS NULL\n" +
-			"     │   │       │       │           └─ Project\n" +
-			"     │   │       │       │               ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
-			"     │   │       │       │               │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │       │               │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │       │       │               │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │       │       │               │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │       │               │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │       │       │               │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │       │       │               │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │       │               │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │       │       │               │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │       │       │               │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │       │               │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │       │       │               │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │       │       │               └─ Filter\n" +
-			"     │   │       │       │                   ├─ Or\n" +
-			"     │   │       │       │                   │   ├─ NOT\n" +
-			"     │   │       │       │                   │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │       │       │                   │   └─ NOT\n" +
-			"     │   │       │       │                   │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │       │       │                   └─ LeftOuterHashJoin\n" +
-			"     │   │       │       │                       ├─ AND\n" +
-			"     │   │       │       │                       │   ├─ Eq\n" +
-			"     │   │       │       │                       │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │       │       │                       │   │   └─ ism.UJ6XY:6!null\n" +
-			"     │   │       │       │                       │   └─ Eq\n" +
-			"     │   │       │       │                       │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │       │       │                       │       └─ ism.FV24E:5!null\n" +
-			"     │   │       │       │                       ├─ LeftOuterHashJoin\n" +
-			"     │   │       │       │                       │   ├─ AND\n" +
-			"     │   │       │       │                       │   │   ├─ Eq\n" +
-			"     │   │       │       │                       │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │       │       │                       │   │   │   └─ ism.FV24E:5!null\n" +
-			"     │   │       │       │                       │   │   └─ Eq\n" +
-			"     │   │       │       │                       │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │       │       │                       │   │       └─ ism.UJ6XY:6!null\n" +
-			"     │   │       │       │                       │   ├─ LeftOuterLookupJoin\n" +
-			"     │   │       │       │                       │   │   ├─ AND\n" +
-			"     │   │       │       │                       │   │   │   ├─ Eq\n" +
-			"     │   │       │       │                       │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │       │       │                       │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │       │       │                       │   │   │   └─ NOT\n" +
-			"     │   │       │       │                       │   │   │       └─ Eq\n" +
-			"     │   │       │       │                       │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │       │       │                       │   │   │           └─ ism.FV24E:5!null\n" +
-			"     │   │       │       │                       │   │   ├─ LeftOuterHashJoin\n" +
-			"     │   │       │       │                       │   │   │   ├─ Eq\n" +
-			"     │   │       │       │                       │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │       │       │                       │   │   │   │   └─ ism.PRUV2:9\n" +
-			"     │   │       │       │                       │   │   │   ├─ MergeJoin\n" +
-			"     │   │       │       │                       │   │   │   │   ├─ cmp: Eq\n" +
-			"     │   │       │       │                       │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │   │       │       │                       │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
-			"     │   │       │       │                       │   │   │   │   ├─ TableAlias(G3YXS)\n" +
-			"     │   │       │       │                       │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
-			"     │   │       │       │                       │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
-			"     │   │       │       │                       │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │       │       │                       │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │       │       │                       │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │   │       │       │                       │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │   │       │       │                       │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │   │       │       │                       │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │       │       │                       │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │       │       │                       │   │   │   └─ HashLookup\n" +
-			"     │   │       │       │                       │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
-			"     │   │       │       │                       │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"     │   │       │       │                       │   │   │       └─ CachedResults\n" +
-			"     │   │       │       │                       │   │   │           └─ TableAlias(NHMXW)\n" +
-			"     │   │       │       │                       │   │   │               └─ Table\n" +
-			"     │   │       │       │                       │   │   │                   ├─ name: WGSDC\n" +
-			"     │   │       │       │                       │   │   │                   └─ columns: [id nohhr]\n" +
-			"     │   │       │       │                       │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │       │       │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │   │       │       │                       │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │   │       │       │                       │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │       │       │                       │   └─ HashLookup\n" +
-			"     │   │       │       │                       │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
-			"     │   │       │       │                       │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"     │   │       │       │                       │       └─ CachedResults\n" +
-			"     │   │       │       │                       │           └─ TableAlias(YQIF4)\n" +
-			"     │   │       │       │                       │               └─ Table\n" +
-			"     │   │       │       │                       │                   ├─ name: NOXN3\n" +
-			"     │   │       │       │                       │                   └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │       │       │                       └─ HashLookup\n" +
-			"     │   │       │       │                           ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
-			"     │   │       │       │                           ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"     │   │       │       │                           └─ CachedResults\n" +
-			"     │   │       │       │                               └─ TableAlias(YVHJZ)\n" +
-			"     │   │       │       │                                   └─ Table\n" +
-			"     │   │       │       │                                       ├─ name: NOXN3\n" +
-			"     │   │       │       │                                       └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │       │       └─ Project\n" +
-			"     │   │       │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
-			"     │   │       │           │   ├─ type: char\n" +
-			"     │   │       │           │   └─ TDEIU:10\n" +
-			"     │   │       │           │   as TDEIU]\n" +
-			"     │   │       │           └─ Project\n" +
-			"     │   │       │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (null) as TDEIU]\n" +
-			"     │   │       │               └─ SubqueryAlias\n" +
-			"     │   │       │                   ├─ name: JCHIR\n" +
-			"     │   │       │                   ├─ outerVisibility: false\n" +
-			"     │   │       │                   ├─ cacheable: true\n" +
+			"     │   │       ├─ Union distinct\n" +
+			"     │   │       │   ├─ SubqueryAlias\n" +
+			"     │   │       │   │   ├─ name: JCHIR\n" +
+			"     │   │       │   │   ├─ outerVisibility: false\n" +
+			"     │   │       │   │   ├─ cacheable: true\n" +
+			"     │   │       │   │   └─ Filter\n" +
+			"     │   │       │   │       ├─ Or\n" +
+			"     │   │       │   │       │   ├─ AND\n" +
+			"     │   │       │   │       │   │   ├─ NOT\n" +
+			"     │   │       │   │       │   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │       │   │       │   │   └─ TDEIU:10 IS NULL\n" +
+			"     │   │       │   │       │   └─ AND\n" +
+			"     │   │       │   │       │       ├─ QNI57:9 IS NULL\n" +
+			"     │   │       │   │       │       └─ NOT\n" +
+			"     │   │       │   │       │           └─ TDEIU:10 IS NULL\n" +
+			"     │   │       │   │       └─ Project\n" +
+			"     │   │       │   │           ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
+			"     │   │       │   │           │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │   │           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │       │   │           │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │       │   │           │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │   │           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │       │   │           │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │       │   │           │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │   │           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │       │   │           │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │       │   │           │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │   │           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │       │   │           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │       │   │           └─ Filter\n" +
+			"     │   │       │   │               ├─ Or\n" +
+			"     │   │       │   │               │   ├─ NOT\n" +
+			"     │   │       │   │               │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │       │   │               │   └─ NOT\n" +
+			"     │   │       │   │               │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │       │   │               └─ LeftOuterHashJoin\n" +
+			"     │   │       │   │                   ├─ AND\n" +
+			"     │   │       │   │                   │   ├─ Eq\n" +
+			"     │   │       │   │                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │       │   │                   │   │   └─ ism.UJ6XY:6!null\n" +
+			"     │   │       │   │                   │   └─ Eq\n" +
+			"     │   │       │   │                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │       │   │                   │       └─ ism.FV24E:5!null\n" +
+			"     │   │       │   │                   ├─ LeftOuterHashJoin\n" +
+			"     │   │       │   │                   │   ├─ AND\n" +
+			"     │   │       │   │                   │   │   ├─ Eq\n" +
+			"     │   │       │   │                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │       │   │                   │   │   │   └─ ism.FV24E:5!null\n" +
+			"     │   │       │   │                   │   │   └─ Eq\n" +
+			"     │   │       │   │                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │       │   │                   │   │       └─ ism.UJ6XY:6!null\n" +
+			"     │   │       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"     │   │       │   │                   │   │   ├─ AND\n" +
+			"     │   │       │   │                   │   │   │   ├─ Eq\n" +
+			"     │   │       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │       │   │                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │       │   │                   │   │   │   └─ NOT\n" +
+			"     │   │       │   │                   │   │   │       └─ Eq\n" +
+			"     │   │       │   │                   │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │       │   │                   │   │   │           └─ ism.FV24E:5!null\n" +
+			"     │   │       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"     │   │       │   │                   │   │   │   ├─ Eq\n" +
+			"     │   │       │   │                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │       │   │                   │   │   │   │   └─ ism.PRUV2:9\n" +
+			"     │   │       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"     │   │       │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"     │   │       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │   │       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
+			"     │   │       │   │                   │   │   │   │   ├─ TableAlias(G3YXS)\n" +
+			"     │   │       │   │                   │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
+			"     │   │       │   │                   │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
+			"     │   │       │   │                   │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       │   │                   │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │   │       │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"     │   │       │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"     │   │       │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       │   │                   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │       │   │                   │   │   │   └─ HashLookup\n" +
+			"     │   │       │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
+			"     │   │       │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"     │   │       │   │                   │   │   │       └─ CachedResults\n" +
+			"     │   │       │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
+			"     │   │       │   │                   │   │   │               └─ Table\n" +
+			"     │   │       │   │                   │   │   │                   ├─ name: WGSDC\n" +
+			"     │   │       │   │                   │   │   │                   └─ columns: [id nohhr]\n" +
+			"     │   │       │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"     │   │       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"     │   │       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │   │       │   │                   │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │       │   │                   │   └─ HashLookup\n" +
+			"     │   │       │   │                   │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
+			"     │   │       │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"     │   │       │   │                   │       └─ CachedResults\n" +
+			"     │   │       │   │                   │           └─ TableAlias(YQIF4)\n" +
+			"     │   │       │   │                   │               └─ Table\n" +
+			"     │   │       │   │                   │                   ├─ name: NOXN3\n" +
+			"     │   │       │   │                   │                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │       │   │                   └─ HashLookup\n" +
+			"     │   │       │   │                       ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
+			"     │   │       │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"     │   │       │   │                       └─ CachedResults\n" +
+			"     │   │       │   │                           └─ TableAlias(YVHJZ)\n" +
+			"     │   │       │   │                               └─ Table\n" +
+			"     │   │       │   │                                   ├─ name: NOXN3\n" +
+			"     │   │       │   │                                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │       │   └─ Project\n" +
+			"     │   │       │       ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (null) as TDEIU]\n" +
+			"     │   │       │       └─ SubqueryAlias\n" +
+			"     │   │       │           ├─ name: JCHIR\n" +
+			"     │   │       │           ├─ outerVisibility: false\n" +
+			"     │   │       │           ├─ cacheable: true\n" +
+			"     │   │       │           └─ Filter\n" +
+			"     │   │       │               ├─ AND\n" +
+			"     │   │       │               │   ├─ NOT\n" +
+			"     │   │       │               │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │       │               │   └─ NOT\n" +
+			"     │   │       │               │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │       │               └─ Project\n" +
+			"     │   │       │                   ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
+			"     │   │       │                   │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │                   │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │       │                   │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │       │                   │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │                   │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │       │                   │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │       │                   │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │                   │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │       │                   │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │       │                   │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │       │                   │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │       │                   │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
 			"     │   │       │                   └─ Filter\n" +
-			"     │   │       │                       ├─ AND\n" +
+			"     │   │       │                       ├─ Or\n" +
 			"     │   │       │                       │   ├─ NOT\n" +
-			"     │   │       │                       │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │       │                       │   │   └─ YQIF4.id:15 IS NULL\n" +
 			"     │   │       │                       │   └─ NOT\n" +
-			"     │   │       │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │       │                       └─ Project\n" +
-			"     │   │       │                           ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
-			"     │   │       │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │       │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │       │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │       │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │       │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │       │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │       │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │       │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │       │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │       │                           └─ Filter\n" +
-			"     │   │       │                               ├─ Or\n" +
-			"     │   │       │                               │   ├─ NOT\n" +
-			"     │   │       │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │       │                               │   └─ NOT\n" +
-			"     │   │       │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │       │                               └─ LeftOuterHashJoin\n" +
-			"     │   │       │                                   ├─ AND\n" +
-			"     │   │       │                                   │   ├─ Eq\n" +
-			"     │   │       │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │       │                                   │   │   └─ ism.UJ6XY:6!null\n" +
-			"     │   │       │                                   │   └─ Eq\n" +
-			"     │   │       │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │       │                                   │       └─ ism.FV24E:5!null\n" +
-			"     │   │       │                                   ├─ LeftOuterHashJoin\n" +
-			"     │   │       │                                   │   ├─ AND\n" +
-			"     │   │       │                                   │   │   ├─ Eq\n" +
-			"     │   │       │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │       │                                   │   │   │   └─ ism.FV24E:5!null\n" +
-			"     │   │       │                                   │   │   └─ Eq\n" +
-			"     │   │       │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │       │                                   │   │       └─ ism.UJ6XY:6!null\n" +
-			"     │   │       │                                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │   │       │                                   │   │   ├─ AND\n" +
-			"     │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │       │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │       │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │       │                                   │   │   │   └─ NOT\n" +
-			"     │   │       │                                   │   │   │       └─ Eq\n" +
-			"     │   │       │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │       │                                   │   │   │           └─ ism.FV24E:5!null\n" +
-			"     │   │       │                                   │   │   ├─ LeftOuterHashJoin\n" +
-			"     │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │       │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │       │                                   │   │   │   │   └─ ism.PRUV2:9\n" +
-			"     │   │       │                                   │   │   │   ├─ MergeJoin\n" +
-			"     │   │       │                                   │   │   │   │   ├─ cmp: Eq\n" +
-			"     │   │       │                                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │   │       │                                   │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
-			"     │   │       │                                   │   │   │   │   ├─ TableAlias(G3YXS)\n" +
-			"     │   │       │                                   │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
-			"     │   │       │                                   │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
-			"     │   │       │                                   │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │       │                                   │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │       │                                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │   │       │                                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │   │       │                                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │   │       │                                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │       │                                   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │       │                                   │   │   │   └─ HashLookup\n" +
-			"     │   │       │                                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
-			"     │   │       │                                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"     │   │       │                                   │   │   │       └─ CachedResults\n" +
-			"     │   │       │                                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"     │   │       │                                   │   │   │               └─ Table\n" +
-			"     │   │       │                                   │   │   │                   ├─ name: WGSDC\n" +
-			"     │   │       │                                   │   │   │                   └─ columns: [id nohhr]\n" +
-			"     │   │       │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │       │                                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │   │       │                                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │   │       │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │       │                                   │   └─ HashLookup\n" +
-			"     │   │       │                                   │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
-			"     │   │       │                                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"     │   │       │                                   │       └─ CachedResults\n" +
-			"     │   │       │                                   │           └─ TableAlias(YQIF4)\n" +
-			"     │   │       │                                   │               └─ Table\n" +
-			"     │   │       │                                   │                   ├─ name: NOXN3\n" +
-			"     │   │       │                                   │                   └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │       │                                   └─ HashLookup\n" +
-			"     │   │       │                                       ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
-			"     │   │       │                                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"     │   │       │                                       └─ CachedResults\n" +
-			"     │   │       │                                           └─ TableAlias(YVHJZ)\n" +
-			"     │   │       │                                               └─ Table\n" +
-			"     │   │       │                                                   ├─ name: NOXN3\n" +
-			"     │   │       │                                                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │       │                       │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │       │                       └─ LeftOuterHashJoin\n" +
+			"     │   │       │                           ├─ AND\n" +
+			"     │   │       │                           │   ├─ Eq\n" +
+			"     │   │       │                           │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │       │                           │   │   └─ ism.UJ6XY:6!null\n" +
+			"     │   │       │                           │   └─ Eq\n" +
+			"     │   │       │                           │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │       │                           │       └─ ism.FV24E:5!null\n" +
+			"     │   │       │                           ├─ LeftOuterHashJoin\n" +
+			"     │   │       │                           │   ├─ AND\n" +
+			"     │   │       │                           │   │   ├─ Eq\n" +
+			"     │   │       │                           │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │       │                           │   │   │   └─ ism.FV24E:5!null\n" +
+			"     │   │       │                           │   │   └─ Eq\n" +
+			"     │   │       │                           │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │       │                           │   │       └─ ism.UJ6XY:6!null\n" +
+			"     │   │       │                           │   ├─ LeftOuterLookupJoin\n" +
+			"     │   │       │                           │   │   ├─ AND\n" +
+			"     │   │       │                           │   │   │   ├─ Eq\n" +
+			"     │   │       │                           │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │       │                           │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │       │                           │   │   │   └─ NOT\n" +
+			"     │   │       │                           │   │   │       └─ Eq\n" +
+			"     │   │       │                           │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │       │                           │   │   │           └─ ism.FV24E:5!null\n" +
+			"     │   │       │                           │   │   ├─ LeftOuterHashJoin\n" +
+			"     │   │       │                           │   │   │   ├─ Eq\n" +
+			"     │   │       │                           │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │       │                           │   │   │   │   └─ ism.PRUV2:9\n" +
+			"     │   │       │                           │   │   │   ├─ MergeJoin\n" +
+			"     │   │       │                           │   │   │   │   ├─ cmp: Eq\n" +
+			"     │   │       │                           │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │   │       │                           │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
+			"     │   │       │                           │   │   │   │   ├─ TableAlias(G3YXS)\n" +
+			"     │   │       │                           │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
+			"     │   │       │                           │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
+			"     │   │       │                           │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       │                           │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │       │                           │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │   │       │                           │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"     │   │       │                           │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"     │   │       │                           │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │       │                           │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │       │                           │   │   │   └─ HashLookup\n" +
+			"     │   │       │                           │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
+			"     │   │       │                           │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"     │   │       │                           │   │   │       └─ CachedResults\n" +
+			"     │   │       │                           │   │   │           └─ TableAlias(NHMXW)\n" +
+			"     │   │       │                           │   │   │               └─ Table\n" +
+			"     │   │       │                           │   │   │                   ├─ name: WGSDC\n" +
+			"     │   │       │                           │   │   │                   └─ columns: [id nohhr]\n" +
+			"     │   │       │                           │   │   └─ TableAlias(CPMFE)\n" +
+			"     │   │       │                           │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"     │   │       │                           │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │   │       │                           │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │       │                           │   └─ HashLookup\n" +
+			"     │   │       │                           │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
+			"     │   │       │                           │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"     │   │       │                           │       └─ CachedResults\n" +
+			"     │   │       │                           │           └─ TableAlias(YQIF4)\n" +
+			"     │   │       │                           │               └─ Table\n" +
+			"     │   │       │                           │                   ├─ name: NOXN3\n" +
+			"     │   │       │                           │                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │       │                           └─ HashLookup\n" +
+			"     │   │       │                               ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
+			"     │   │       │                               ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"     │   │       │                               └─ CachedResults\n" +
+			"     │   │       │                                   └─ TableAlias(YVHJZ)\n" +
+			"     │   │       │                                       └─ Table\n" +
+			"     │   │       │                                           ├─ name: NOXN3\n" +
+			"     │   │       │                                           └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │       └─ Project\n" +
-			"     │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
-			"     │   │           │   ├─ type: char\n" +
-			"     │   │           │   └─ QNI57:9\n" +
-			"     │   │           │   as QNI57, convert\n" +
-			"     │   │           │   ├─ type: char\n" +
-			"     │   │           │   └─ JCHIR.TDEIU:10\n" +
-			"     │   │           │   as TDEIU]\n" +
-			"     │   │           └─ Project\n" +
-			"     │   │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (null) as QNI57, JCHIR.TDEIU:10]\n" +
-			"     │   │               └─ SubqueryAlias\n" +
-			"     │   │                   ├─ name: JCHIR\n" +
-			"     │   │                   ├─ outerVisibility: false\n" +
-			"     │   │                   ├─ cacheable: true\n" +
-			"     │   │                   └─ Filter\n" +
-			"     │   │                       ├─ AND\n" +
-			"     │   │                       │   ├─ NOT\n" +
-			"     │   │                       │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │                       │   └─ NOT\n" +
-			"     │   │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │                       └─ Project\n" +
-			"     │   │                           ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
-			"     │   │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │                           │   ├─ left: G3YXS.SL76B:2!null\n" +
-			"     │   │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │                           └─ Filter\n" +
-			"     │   │                               ├─ Or\n" +
-			"     │   │                               │   ├─ NOT\n" +
-			"     │   │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │                               │   └─ NOT\n" +
-			"     │   │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │                               └─ LeftOuterHashJoin\n" +
-			"     │   │                                   ├─ AND\n" +
-			"     │   │                                   │   ├─ Eq\n" +
-			"     │   │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │                                   │   │   └─ ism.UJ6XY:6!null\n" +
-			"     │   │                                   │   └─ Eq\n" +
-			"     │   │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │                                   │       └─ ism.FV24E:5!null\n" +
-			"     │   │                                   ├─ LeftOuterHashJoin\n" +
-			"     │   │                                   │   ├─ AND\n" +
-			"     │   │                                   │   │   ├─ Eq\n" +
-			"     │   │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │                                   │   │   │   └─ ism.FV24E:5!null\n" +
-			"     │   │                                   │   │   └─ Eq\n" +
-			"     │   │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │                                   │   │       └─ ism.UJ6XY:6!null\n" +
-			"     │   │                                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │   │                                   │   │   ├─ AND\n" +
-			"     │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │                                   │   │   │   └─ NOT\n" +
-			"     │   │                                   │   │   │       └─ Eq\n" +
-			"     │   │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │                                   │   │   │           └─ ism.FV24E:5!null\n" +
-			"     │   │                                   │   │   ├─ LeftOuterHashJoin\n" +
-			"     │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │                                   │   │   │   │   └─ ism.PRUV2:9\n" +
-			"     │   │                                   │   │   │   ├─ MergeJoin\n" +
-			"     │   │                                   │   │   │   │   ├─ cmp: Eq\n" +
-			"     │   │                                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │   │                                   │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
-			"     │   │                                   │   │   │   │   ├─ TableAlias(G3YXS)\n" +
-			"     │   │                                   │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
-			"     │   │                                   │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
-			"     │   │                                   │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │                                   │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │                                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │   │                                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │   │                                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │   │                                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │   │                                   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │                                   │   │   │   └─ HashLookup\n" +
-			"     │   │                                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
-			"     │   │                                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"     │   │                                   │   │   │       └─ CachedResults\n" +
-			"     │   │                                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"     │   │                                   │   │   │               └─ Table\n" +
-			"     │   │                                   │   │   │                   ├─ name: WGSDC\n" +
-			"     │   │                                   │   │   │                   └─ columns: [id nohhr]\n" +
-			"     │   │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │                                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │   │                                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │   │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │                                   │   └─ HashLookup\n" +
-			"     │   │                                   │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
-			"     │   │                                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"     │   │                                   │       └─ CachedResults\n" +
-			"     │   │                                   │           └─ TableAlias(YQIF4)\n" +
-			"     │   │                                   │               └─ Table\n" +
-			"     │   │                                   │                   ├─ name: NOXN3\n" +
-			"     │   │                                   │                   └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │                                   └─ HashLookup\n" +
-			"     │   │                                       ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
-			"     │   │                                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"     │   │                                       └─ CachedResults\n" +
-			"     │   │                                           └─ TableAlias(YVHJZ)\n" +
-			"     │   │                                               └─ Table\n" +
-			"     │   │                                                   ├─ name: NOXN3\n" +
-			"     │   │                                                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (null) as QNI57, JCHIR.TDEIU:10]\n" +
+			"     │   │           └─ SubqueryAlias\n" +
+			"     │   │               ├─ name: JCHIR\n" +
+			"     │   │               ├─ outerVisibility: false\n" +
+			"     │   │               ├─ cacheable: true\n" +
+			"     │   │               └─ Filter\n" +
+			"     │   │                   ├─ AND\n" +
+			"     │   │                   │   ├─ NOT\n" +
+			"     │   │                   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │                   │   └─ NOT\n" +
+			"     │   │                   │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │                   └─ Project\n" +
+			"     │   │                       ├─ columns: [ism.FV24E:5!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:7!null as M22QN, G3YXS.GE5EL:3, G3YXS.F7A4Q:4, G3YXS.ESFVY:1!null, CASE  WHEN IN\n" +
+			"     │   │                       │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │                       │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │                       │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │                       │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │                       │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │                       │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │                       │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │                       │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │                       │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │                       │   ├─ left: G3YXS.SL76B:2!null\n" +
+			"     │   │                       │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │                       │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:2!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │                       └─ Filter\n" +
+			"     │   │                           ├─ Or\n" +
+			"     │   │                           │   ├─ NOT\n" +
+			"     │   │                           │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │                           │   └─ NOT\n" +
+			"     │   │                           │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │                           └─ LeftOuterHashJoin\n" +
+			"     │   │                               ├─ AND\n" +
+			"     │   │                               │   ├─ Eq\n" +
+			"     │   │                               │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │                               │   │   └─ ism.UJ6XY:6!null\n" +
+			"     │   │                               │   └─ Eq\n" +
+			"     │   │                               │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │                               │       └─ ism.FV24E:5!null\n" +
+			"     │   │                               ├─ LeftOuterHashJoin\n" +
+			"     │   │                               │   ├─ AND\n" +
+			"     │   │                               │   │   ├─ Eq\n" +
+			"     │   │                               │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │                               │   │   │   └─ ism.FV24E:5!null\n" +
+			"     │   │                               │   │   └─ Eq\n" +
+			"     │   │                               │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │                               │   │       └─ ism.UJ6XY:6!null\n" +
+			"     │   │                               │   ├─ LeftOuterLookupJoin\n" +
+			"     │   │                               │   │   ├─ AND\n" +
+			"     │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │                               │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │                               │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │                               │   │   │   └─ NOT\n" +
+			"     │   │                               │   │   │       └─ Eq\n" +
+			"     │   │                               │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │                               │   │   │           └─ ism.FV24E:5!null\n" +
+			"     │   │                               │   │   ├─ LeftOuterHashJoin\n" +
+			"     │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │                               │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │                               │   │   │   │   └─ ism.PRUV2:9\n" +
+			"     │   │                               │   │   │   ├─ MergeJoin\n" +
+			"     │   │                               │   │   │   │   ├─ cmp: Eq\n" +
+			"     │   │                               │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │   │                               │   │   │   │   │   └─ ism.NZ4MQ:8!null\n" +
+			"     │   │                               │   │   │   │   ├─ TableAlias(G3YXS)\n" +
+			"     │   │                               │   │   │   │   │   └─ IndexedTableAccess(YYBCX)\n" +
+			"     │   │                               │   │   │   │   │       ├─ index: [YYBCX.id]\n" +
+			"     │   │                               │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │                               │   │   │   │   │       └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │                               │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │   │                               │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"     │   │                               │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"     │   │                               │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   │                               │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │                               │   │   │   └─ HashLookup\n" +
+			"     │   │                               │   │   │       ├─ left-key: TUPLE(ism.PRUV2:9)\n" +
+			"     │   │                               │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"     │   │                               │   │   │       └─ CachedResults\n" +
+			"     │   │                               │   │   │           └─ TableAlias(NHMXW)\n" +
+			"     │   │                               │   │   │               └─ Table\n" +
+			"     │   │                               │   │   │                   ├─ name: WGSDC\n" +
+			"     │   │                               │   │   │                   └─ columns: [id nohhr]\n" +
+			"     │   │                               │   │   └─ TableAlias(CPMFE)\n" +
+			"     │   │                               │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"     │   │                               │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │   │                               │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │                               │   └─ HashLookup\n" +
+			"     │   │                               │       ├─ left-key: TUPLE(ism.FV24E:5!null, ism.UJ6XY:6!null)\n" +
+			"     │   │                               │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"     │   │                               │       └─ CachedResults\n" +
+			"     │   │                               │           └─ TableAlias(YQIF4)\n" +
+			"     │   │                               │               └─ Table\n" +
+			"     │   │                               │                   ├─ name: NOXN3\n" +
+			"     │   │                               │                   └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │                               └─ HashLookup\n" +
+			"     │   │                                   ├─ left-key: TUPLE(ism.UJ6XY:6!null, ism.FV24E:5!null)\n" +
+			"     │   │                                   ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"     │   │                                   └─ CachedResults\n" +
+			"     │   │                                       └─ TableAlias(YVHJZ)\n" +
+			"     │   │                                           └─ Table\n" +
+			"     │   │                                               ├─ name: NOXN3\n" +
+			"     │   │                                               └─ columns: [id brqp2 fftbj]\n" +
 			"     │   └─ TableAlias(sn)\n" +
 			"     │       └─ Table\n" +
 			"     │           ├─ name: NOXN3\n" +
@@ -14213,9 +14232,9 @@ WHERE
 			"                                         ├─ right-key: TUPLE(cla.id:0!null)\n" +
 			"                                         └─ CachedResults\n" +
 			"                                             └─ Filter\n" +
-			"                                                 ├─ HashIn\n" +
+			"                                                 ├─ Eq\n" +
 			"                                                 │   ├─ cla.FTQLQ:1!null\n" +
-			"                                                 │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                                 │   └─ SQ1 (longtext)\n" +
 			"                                                 └─ TableAlias(cla)\n" +
 			"                                                     └─ IndexedTableAccess(YK2GW)\n" +
 			"                                                         ├─ index: [YK2GW.FTQLQ]\n" +
@@ -14394,56 +14413,54 @@ WHERE
 			"     │           │   ├─ outerVisibility: false\n" +
 			"     │           │   ├─ cacheable: true\n" +
 			"     │           │   └─ Distinct\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
-			"     │           │           └─ SubqueryAlias\n" +
-			"     │           │               ├─ name: HTKBS\n" +
-			"     │           │               ├─ outerVisibility: false\n" +
-			"     │           │               ├─ cacheable: true\n" +
-			"     │           │               └─ Project\n" +
-			"     │           │                   ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
-			"     │           │                   └─ HashJoin\n" +
-			"     │           │                       ├─ Eq\n" +
-			"     │           │                       │   ├─ sn.BRQP2:8!null\n" +
-			"     │           │                       │   └─ mf.LUEVY:5!null\n" +
-			"     │           │                       ├─ HashJoin\n" +
-			"     │           │                       │   ├─ Eq\n" +
-			"     │           │                       │   │   ├─ bs.id:2!null\n" +
-			"     │           │                       │   │   └─ mf.GXLUB:4!null\n" +
-			"     │           │                       │   ├─ MergeJoin\n" +
-			"     │           │                       │   │   ├─ cmp: Eq\n" +
-			"     │           │                       │   │   │   ├─ cla.id:0!null\n" +
-			"     │           │                       │   │   │   └─ bs.IXUXU:3\n" +
-			"     │           │                       │   │   ├─ Filter\n" +
-			"     │           │                       │   │   │   ├─ HashIn\n" +
-			"     │           │                       │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"     │           │                       │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"     │           │                       │   │   │   └─ TableAlias(cla)\n" +
-			"     │           │                       │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"     │           │                       │   │   │           ├─ index: [YK2GW.id]\n" +
-			"     │           │                       │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                       │   │   │           └─ columns: [id ftqlq]\n" +
-			"     │           │                       │   │   └─ TableAlias(bs)\n" +
-			"     │           │                       │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"     │           │                       │   │           ├─ index: [THNTS.IXUXU]\n" +
-			"     │           │                       │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                       │   │           └─ columns: [id ixuxu]\n" +
-			"     │           │                       │   └─ HashLookup\n" +
-			"     │           │                       │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
-			"     │           │                       │       ├─ right-key: TUPLE(mf.GXLUB:0!null)\n" +
-			"     │           │                       │       └─ CachedResults\n" +
-			"     │           │                       │           └─ TableAlias(mf)\n" +
-			"     │           │                       │               └─ Table\n" +
-			"     │           │                       │                   ├─ name: HGMQ6\n" +
-			"     │           │                       │                   └─ columns: [gxlub luevy m22qn]\n" +
-			"     │           │                       └─ HashLookup\n" +
-			"     │           │                           ├─ left-key: TUPLE(mf.LUEVY:5!null)\n" +
-			"     │           │                           ├─ right-key: TUPLE(sn.BRQP2:1!null)\n" +
-			"     │           │                           └─ CachedResults\n" +
-			"     │           │                               └─ TableAlias(sn)\n" +
-			"     │           │                                   └─ Table\n" +
-			"     │           │                                       ├─ name: NOXN3\n" +
-			"     │           │                                       └─ columns: [id brqp2]\n" +
+			"     │           │       └─ SubqueryAlias\n" +
+			"     │           │           ├─ name: HTKBS\n" +
+			"     │           │           ├─ outerVisibility: false\n" +
+			"     │           │           ├─ cacheable: true\n" +
+			"     │           │           └─ Project\n" +
+			"     │           │               ├─ columns: [cla.FTQLQ:1!null as T4IBQ]\n" +
+			"     │           │               └─ HashJoin\n" +
+			"     │           │                   ├─ Eq\n" +
+			"     │           │                   │   ├─ sn.BRQP2:8!null\n" +
+			"     │           │                   │   └─ mf.LUEVY:5!null\n" +
+			"     │           │                   ├─ HashJoin\n" +
+			"     │           │                   │   ├─ Eq\n" +
+			"     │           │                   │   │   ├─ bs.id:2!null\n" +
+			"     │           │                   │   │   └─ mf.GXLUB:4!null\n" +
+			"     │           │                   │   ├─ MergeJoin\n" +
+			"     │           │                   │   │   ├─ cmp: Eq\n" +
+			"     │           │                   │   │   │   ├─ cla.id:0!null\n" +
+			"     │           │                   │   │   │   └─ bs.IXUXU:3\n" +
+			"     │           │                   │   │   ├─ Filter\n" +
+			"     │           │                   │   │   │   ├─ Eq\n" +
+			"     │           │                   │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
+			"     │           │                   │   │   │   │   └─ SQ1 (longtext)\n" +
+			"     │           │                   │   │   │   └─ TableAlias(cla)\n" +
+			"     │           │                   │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
+			"     │           │                   │   │   │           ├─ index: [YK2GW.id]\n" +
+			"     │           │                   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │                   │   │   │           └─ columns: [id ftqlq]\n" +
+			"     │           │                   │   │   └─ TableAlias(bs)\n" +
+			"     │           │                   │   │       └─ IndexedTableAccess(THNTS)\n" +
+			"     │           │                   │   │           ├─ index: [THNTS.IXUXU]\n" +
+			"     │           │                   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │                   │   │           └─ columns: [id ixuxu]\n" +
+			"     │           │                   │   └─ HashLookup\n" +
+			"     │           │                   │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
+			"     │           │                   │       ├─ right-key: TUPLE(mf.GXLUB:0!null)\n" +
+			"     │           │                   │       └─ CachedResults\n" +
+			"     │           │                   │           └─ TableAlias(mf)\n" +
+			"     │           │                   │               └─ Table\n" +
+			"     │           │                   │                   ├─ name: HGMQ6\n" +
+			"     │           │                   │                   └─ columns: [gxlub luevy m22qn]\n" +
+			"     │           │                   └─ HashLookup\n" +
+			"     │           │                       ├─ left-key: TUPLE(mf.LUEVY:5!null)\n" +
+			"     │           │                       ├─ right-key: TUPLE(sn.BRQP2:1!null)\n" +
+			"     │           │                       └─ CachedResults\n" +
+			"     │           │                           └─ TableAlias(sn)\n" +
+			"     │           │                               └─ Table\n" +
+			"     │           │                                   ├─ name: NOXN3\n" +
+			"     │           │                                   └─ columns: [id brqp2]\n" +
 			"     │           └─ HashLookup\n" +
 			"     │               ├─ left-key: TUPLE()\n" +
 			"     │               ├─ right-key: TUPLE()\n" +
@@ -14453,228 +14470,226 @@ WHERE
 			"     │                       ├─ outerVisibility: false\n" +
 			"     │                       ├─ cacheable: true\n" +
 			"     │                       └─ Distinct\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
-			"     │                               └─ SubqueryAlias\n" +
-			"     │                                   ├─ name: JQHRG\n" +
-			"     │                                   ├─ outerVisibility: false\n" +
-			"     │                                   ├─ cacheable: true\n" +
-			"     │                                   └─ Project\n" +
-			"     │                                       ├─ columns: [CASE  WHEN NOT\n" +
-			"     │                                       │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                       │   THEN Subquery\n" +
-			"     │                                       │   ├─ cacheable: false\n" +
-			"     │                                       │   └─ Project\n" +
-			"     │                                       │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │                                       │       └─ Filter\n" +
-			"     │                                       │           ├─ Eq\n" +
-			"     │                                       │           │   ├─ ei.id:20!null\n" +
-			"     │                                       │           │   └─ MJR3D.QNI57:5\n" +
-			"     │                                       │           └─ SubqueryAlias\n" +
-			"     │                                       │               ├─ name: ei\n" +
-			"     │                                       │               ├─ outerVisibility: true\n" +
-			"     │                                       │               ├─ cacheable: true\n" +
-			"     │                                       │               └─ Project\n" +
-			"     │                                       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │                                       │                   └─ Window\n" +
-			"     │                                       │                       ├─ NOXN3.id:20!null\n" +
-			"     │                                       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │                                       │                       └─ Table\n" +
-			"     │                                       │                           ├─ name: NOXN3\n" +
-			"     │                                       │                           └─ columns: [id]\n" +
-			"     │                                       │   WHEN NOT\n" +
-			"     │                                       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                       │   THEN Subquery\n" +
-			"     │                                       │   ├─ cacheable: false\n" +
-			"     │                                       │   └─ Project\n" +
-			"     │                                       │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │                                       │       └─ Filter\n" +
-			"     │                                       │           ├─ Eq\n" +
-			"     │                                       │           │   ├─ ei.id:20!null\n" +
-			"     │                                       │           │   └─ MJR3D.TDEIU:6\n" +
-			"     │                                       │           └─ SubqueryAlias\n" +
-			"     │                                       │               ├─ name: ei\n" +
-			"     │                                       │               ├─ outerVisibility: true\n" +
-			"     │                                       │               ├─ cacheable: true\n" +
-			"     │                                       │               └─ Project\n" +
-			"     │                                       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │                                       │                   └─ Window\n" +
-			"     │                                       │                       ├─ NOXN3.id:20!null\n" +
-			"     │                                       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │                                       │                       └─ Table\n" +
-			"     │                                       │                           ├─ name: NOXN3\n" +
-			"     │                                       │                           └─ columns: [id]\n" +
-			"     │                                       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"     │                                       └─ LeftOuterJoin\n" +
-			"     │                                           ├─ Or\n" +
-			"     │                                           │   ├─ Or\n" +
-			"     │                                           │   │   ├─ Or\n" +
-			"     │                                           │   │   │   ├─ AND\n" +
-			"     │                                           │   │   │   │   ├─ AND\n" +
-			"     │                                           │   │   │   │   │   ├─ NOT\n" +
-			"     │                                           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                           │   │   │   │   │   └─ Eq\n" +
-			"     │                                           │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"     │                                           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"     │                                           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   │   │   └─ AND\n" +
-			"     │                                           │   │   │       ├─ AND\n" +
-			"     │                                           │   │   │       │   ├─ NOT\n" +
-			"     │                                           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                           │   │   │       │   └─ InSubquery\n" +
-			"     │                                           │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │   │   │       │       └─ right: Subquery\n" +
-			"     │                                           │   │   │       │           ├─ cacheable: false\n" +
-			"     │                                           │   │   │       │           └─ Project\n" +
-			"     │                                           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"     │                                           │   │   │       │               └─ Filter\n" +
-			"     │                                           │   │   │       │                   ├─ Eq\n" +
-			"     │                                           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"     │                                           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │                                           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"     │                                           │   │   │       │                       └─ Table\n" +
-			"     │                                           │   │   │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │   │   │       └─ NOT\n" +
-			"     │                                           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   │   └─ AND\n" +
-			"     │                                           │   │       ├─ AND\n" +
-			"     │                                           │   │       │   ├─ NOT\n" +
-			"     │                                           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                           │   │       │   └─ InSubquery\n" +
-			"     │                                           │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │   │       │       └─ right: Subquery\n" +
-			"     │                                           │   │       │           ├─ cacheable: false\n" +
-			"     │                                           │   │       │           └─ Project\n" +
-			"     │                                           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │                                           │   │       │               └─ Filter\n" +
-			"     │                                           │   │       │                   ├─ Eq\n" +
-			"     │                                           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │                                           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"     │                                           │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │                                           │   │       │                       └─ Table\n" +
-			"     │                                           │   │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   └─ AND\n" +
-			"     │                                           │       ├─ AND\n" +
-			"     │                                           │       │   ├─ NOT\n" +
-			"     │                                           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                           │       │   └─ InSubquery\n" +
-			"     │                                           │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │       │       └─ right: Subquery\n" +
-			"     │                                           │       │           ├─ cacheable: false\n" +
-			"     │                                           │       │           └─ Project\n" +
-			"     │                                           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │                                           │       │               └─ Filter\n" +
-			"     │                                           │       │                   ├─ Eq\n" +
-			"     │                                           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │                                           │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │                                           │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │                                           │       │                       └─ Table\n" +
-			"     │                                           │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │       └─ NOT\n" +
-			"     │                                           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           ├─ LookupJoin\n" +
-			"     │                                           │   ├─ Eq\n" +
-			"     │                                           │   │   ├─ aac.id:7!null\n" +
-			"     │                                           │   │   └─ MJR3D.M22QN:2!null\n" +
-			"     │                                           │   ├─ SubqueryAlias\n" +
-			"     │                                           │   │   ├─ name: MJR3D\n" +
-			"     │                                           │   │   ├─ outerVisibility: false\n" +
-			"     │                                           │   │   ├─ cacheable: true\n" +
-			"     │                                           │   │   └─ Distinct\n" +
-			"     │                                           │   │       └─ Project\n" +
-			"     │                                           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"     │                                           │   │           └─ Filter\n" +
-			"     │                                           │   │               ├─ Or\n" +
-			"     │                                           │   │               │   ├─ NOT\n" +
-			"     │                                           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"     │                                           │   │               │   └─ NOT\n" +
-			"     │                                           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"     │                                           │   │               └─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   ├─ AND\n" +
-			"     │                                           │   │                   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"     │                                           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"     │                                           │   │                   │   └─ Eq\n" +
-			"     │                                           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"     │                                           │   │                   │       └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   ├─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   │   ├─ AND\n" +
-			"     │                                           │   │                   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"     │                                           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   │   │   └─ Eq\n" +
-			"     │                                           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"     │                                           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"     │                                           │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │                                           │   │                   │   │   ├─ AND\n" +
-			"     │                                           │   │                   │   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"     │                                           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"     │                                           │   │                   │   │   │   └─ NOT\n" +
-			"     │                                           │   │                   │   │   │       └─ Eq\n" +
-			"     │                                           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"     │                                           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   │   │   ├─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   │   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"     │                                           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"     │                                           │   │                   │   │   │   ├─ MergeJoin\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ cmp: Eq\n" +
-			"     │                                           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │                                           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ Filter\n" +
-			"     │                                           │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"     │                                           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"     │                                           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │                                           │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"     │                                           │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"     │                                           │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                                           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │                                           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │                                           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │                                           │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                                           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   │   └─ HashLookup\n" +
-			"     │                                           │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
-			"     │                                           │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"     │                                           │   │                   │   │   │       └─ CachedResults\n" +
-			"     │                                           │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"     │                                           │   │                   │   │   │               └─ Table\n" +
-			"     │                                           │   │                   │   │   │                   ├─ name: WGSDC\n" +
-			"     │                                           │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │                                           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │                                           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │                                           │   │                   │   └─ HashLookup\n" +
-			"     │                                           │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
-			"     │                                           │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"     │                                           │   │                   │       └─ CachedResults\n" +
-			"     │                                           │   │                   │           └─ TableAlias(YQIF4)\n" +
-			"     │                                           │   │                   │               └─ Table\n" +
-			"     │                                           │   │                   │                   ├─ name: NOXN3\n" +
-			"     │                                           │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │                                           │   │                   └─ HashLookup\n" +
-			"     │                                           │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
-			"     │                                           │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"     │                                           │   │                       └─ CachedResults\n" +
-			"     │                                           │   │                           └─ TableAlias(YVHJZ)\n" +
-			"     │                                           │   │                               └─ Table\n" +
-			"     │                                           │   │                                   ├─ name: NOXN3\n" +
-			"     │                                           │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │                                           │   └─ TableAlias(aac)\n" +
-			"     │                                           │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │                                           │           ├─ index: [TPXBU.id]\n" +
-			"     │                                           │           └─ columns: [id btxc5 fhcyt]\n" +
-			"     │                                           └─ TableAlias(sn)\n" +
-			"     │                                               └─ Table\n" +
-			"     │                                                   ├─ name: NOXN3\n" +
-			"     │                                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                           └─ SubqueryAlias\n" +
+			"     │                               ├─ name: JQHRG\n" +
+			"     │                               ├─ outerVisibility: false\n" +
+			"     │                               ├─ cacheable: true\n" +
+			"     │                               └─ Project\n" +
+			"     │                                   ├─ columns: [CASE  WHEN NOT\n" +
+			"     │                                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                   │   THEN Subquery\n" +
+			"     │                                   │   ├─ cacheable: false\n" +
+			"     │                                   │   └─ Project\n" +
+			"     │                                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                   │       └─ Filter\n" +
+			"     │                                   │           ├─ Eq\n" +
+			"     │                                   │           │   ├─ ei.id:20!null\n" +
+			"     │                                   │           │   └─ MJR3D.QNI57:5\n" +
+			"     │                                   │           └─ SubqueryAlias\n" +
+			"     │                                   │               ├─ name: ei\n" +
+			"     │                                   │               ├─ outerVisibility: true\n" +
+			"     │                                   │               ├─ cacheable: true\n" +
+			"     │                                   │               └─ Project\n" +
+			"     │                                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                   │                   └─ Window\n" +
+			"     │                                   │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                   │                       └─ Table\n" +
+			"     │                                   │                           ├─ name: NOXN3\n" +
+			"     │                                   │                           └─ columns: [id]\n" +
+			"     │                                   │   WHEN NOT\n" +
+			"     │                                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                   │   THEN Subquery\n" +
+			"     │                                   │   ├─ cacheable: false\n" +
+			"     │                                   │   └─ Project\n" +
+			"     │                                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                   │       └─ Filter\n" +
+			"     │                                   │           ├─ Eq\n" +
+			"     │                                   │           │   ├─ ei.id:20!null\n" +
+			"     │                                   │           │   └─ MJR3D.TDEIU:6\n" +
+			"     │                                   │           └─ SubqueryAlias\n" +
+			"     │                                   │               ├─ name: ei\n" +
+			"     │                                   │               ├─ outerVisibility: true\n" +
+			"     │                                   │               ├─ cacheable: true\n" +
+			"     │                                   │               └─ Project\n" +
+			"     │                                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                   │                   └─ Window\n" +
+			"     │                                   │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                   │                       └─ Table\n" +
+			"     │                                   │                           ├─ name: NOXN3\n" +
+			"     │                                   │                           └─ columns: [id]\n" +
+			"     │                                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, MJR3D.TUV25:3 as TUV25]\n" +
+			"     │                                   └─ LeftOuterJoin\n" +
+			"     │                                       ├─ Or\n" +
+			"     │                                       │   ├─ Or\n" +
+			"     │                                       │   │   ├─ Or\n" +
+			"     │                                       │   │   │   ├─ AND\n" +
+			"     │                                       │   │   │   │   ├─ AND\n" +
+			"     │                                       │   │   │   │   │   ├─ NOT\n" +
+			"     │                                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                       │   │   │   │   │   └─ Eq\n" +
+			"     │                                       │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"     │                                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"     │                                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   │   │   └─ AND\n" +
+			"     │                                       │   │   │       ├─ AND\n" +
+			"     │                                       │   │   │       │   ├─ NOT\n" +
+			"     │                                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                       │   │   │       │   └─ InSubquery\n" +
+			"     │                                       │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │   │   │       │       └─ right: Subquery\n" +
+			"     │                                       │   │   │       │           ├─ cacheable: false\n" +
+			"     │                                       │   │   │       │           └─ Project\n" +
+			"     │                                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"     │                                       │   │   │       │               └─ Filter\n" +
+			"     │                                       │   │   │       │                   ├─ Eq\n" +
+			"     │                                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"     │                                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                       │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"     │                                       │   │   │       │                       └─ Table\n" +
+			"     │                                       │   │   │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │   │   │       └─ NOT\n" +
+			"     │                                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   │   └─ AND\n" +
+			"     │                                       │   │       ├─ AND\n" +
+			"     │                                       │   │       │   ├─ NOT\n" +
+			"     │                                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                       │   │       │   └─ InSubquery\n" +
+			"     │                                       │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │   │       │       └─ right: Subquery\n" +
+			"     │                                       │   │       │           ├─ cacheable: false\n" +
+			"     │                                       │   │       │           └─ Project\n" +
+			"     │                                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                       │   │       │               └─ Filter\n" +
+			"     │                                       │   │       │                   ├─ Eq\n" +
+			"     │                                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"     │                                       │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"     │                                       │   │       │                       └─ Table\n" +
+			"     │                                       │   │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   └─ AND\n" +
+			"     │                                       │       ├─ AND\n" +
+			"     │                                       │       │   ├─ NOT\n" +
+			"     │                                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                       │       │   └─ InSubquery\n" +
+			"     │                                       │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │       │       └─ right: Subquery\n" +
+			"     │                                       │       │           ├─ cacheable: false\n" +
+			"     │                                       │       │           └─ Project\n" +
+			"     │                                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                       │       │               └─ Filter\n" +
+			"     │                                       │       │                   ├─ Eq\n" +
+			"     │                                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                       │       │                   └─ TableAlias(XMAFZ)\n" +
+			"     │                                       │       │                       └─ Table\n" +
+			"     │                                       │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │       └─ NOT\n" +
+			"     │                                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       ├─ LookupJoin\n" +
+			"     │                                       │   ├─ Eq\n" +
+			"     │                                       │   │   ├─ aac.id:7!null\n" +
+			"     │                                       │   │   └─ MJR3D.M22QN:2!null\n" +
+			"     │                                       │   ├─ SubqueryAlias\n" +
+			"     │                                       │   │   ├─ name: MJR3D\n" +
+			"     │                                       │   │   ├─ outerVisibility: false\n" +
+			"     │                                       │   │   ├─ cacheable: true\n" +
+			"     │                                       │   │   └─ Distinct\n" +
+			"     │                                       │   │       └─ Project\n" +
+			"     │                                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"     │                                       │   │           └─ Filter\n" +
+			"     │                                       │   │               ├─ Or\n" +
+			"     │                                       │   │               │   ├─ NOT\n" +
+			"     │                                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"     │                                       │   │               │   └─ NOT\n" +
+			"     │                                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"     │                                       │   │               └─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   ├─ AND\n" +
+			"     │                                       │   │                   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"     │                                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"     │                                       │   │                   │   └─ Eq\n" +
+			"     │                                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"     │                                       │   │                   │       └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   ├─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   │   ├─ AND\n" +
+			"     │                                       │   │                   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"     │                                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   │   │   └─ Eq\n" +
+			"     │                                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"     │                                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"     │                                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                       │   │                   │   │   ├─ AND\n" +
+			"     │                                       │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"     │                                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"     │                                       │   │                   │   │   │   └─ NOT\n" +
+			"     │                                       │   │                   │   │   │       └─ Eq\n" +
+			"     │                                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"     │                                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"     │                                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"     │                                       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"     │                                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │                                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ Filter\n" +
+			"     │                                       │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"     │                                       │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"     │                                       │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"     │                                       │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"     │                                       │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │                                       │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"     │                                       │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"     │                                       │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   │   └─ HashLookup\n" +
+			"     │                                       │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
+			"     │                                       │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"     │                                       │   │                   │   │   │       └─ CachedResults\n" +
+			"     │                                       │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
+			"     │                                       │   │                   │   │   │               └─ Table\n" +
+			"     │                                       │   │                   │   │   │                   ├─ name: WGSDC\n" +
+			"     │                                       │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"     │                                       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"     │                                       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                       │   │                   │   └─ HashLookup\n" +
+			"     │                                       │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
+			"     │                                       │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"     │                                       │   │                   │       └─ CachedResults\n" +
+			"     │                                       │   │                   │           └─ TableAlias(YQIF4)\n" +
+			"     │                                       │   │                   │               └─ Table\n" +
+			"     │                                       │   │                   │                   ├─ name: NOXN3\n" +
+			"     │                                       │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                       │   │                   └─ HashLookup\n" +
+			"     │                                       │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
+			"     │                                       │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"     │                                       │   │                       └─ CachedResults\n" +
+			"     │                                       │   │                           └─ TableAlias(YVHJZ)\n" +
+			"     │                                       │   │                               └─ Table\n" +
+			"     │                                       │   │                                   ├─ name: NOXN3\n" +
+			"     │                                       │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                       │   └─ TableAlias(aac)\n" +
+			"     │                                       │       └─ IndexedTableAccess(TPXBU)\n" +
+			"     │                                       │           ├─ index: [TPXBU.id]\n" +
+			"     │                                       │           └─ columns: [id btxc5 fhcyt]\n" +
+			"     │                                       └─ TableAlias(sn)\n" +
+			"     │                                           └─ Table\n" +
+			"     │                                               ├─ name: NOXN3\n" +
+			"     │                                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"     └─ SubqueryAlias\n" +
 			"         ├─ name: scalarSubq0\n" +
 			"         ├─ outerVisibility: false\n" +
@@ -14685,290 +14700,290 @@ WHERE
 			"             ├─ cacheable: true\n" +
 			"             └─ Distinct\n" +
 			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
+			"                     ├─ columns: [cld.T4IBQ:5!null as T4IBQ, P4PJZ.M6T2N:0 as M6T2N, P4PJZ.BTXC5:1 as BTXC5, P4PJZ.TUV25:4 as TUV25]\n" +
 			"                     └─ Filter\n" +
 			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
+			"                         │   └─ P4PJZ.M6T2N:0 IS NULL\n" +
+			"                         └─ HashJoin\n" +
 			"                             ├─ AND\n" +
 			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
+			"                             │   │   ├─ P4PJZ.LWQ6O:3\n" +
+			"                             │   │   └─ cld.BDNYB:6!null\n" +
 			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
+			"                             │       ├─ P4PJZ.NTOFG:2!null\n" +
+			"                             │       └─ cld.M22QN:7!null\n" +
 			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
+			"                             │   ├─ name: P4PJZ\n" +
 			"                             │   ├─ outerVisibility: false\n" +
 			"                             │   ├─ cacheable: true\n" +
 			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ sn.BRQP2:8!null\n" +
-			"                             │           │   └─ mf.LUEVY:5!null\n" +
-			"                             │           ├─ HashJoin\n" +
+			"                             │       ├─ columns: [CASE  WHEN NOT\n" +
+			"                             │       │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │       │   THEN Subquery\n" +
+			"                             │       │   ├─ cacheable: false\n" +
+			"                             │       │   └─ Project\n" +
+			"                             │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                             │       │       └─ Filter\n" +
+			"                             │       │           ├─ Eq\n" +
+			"                             │       │           │   ├─ ei.id:20!null\n" +
+			"                             │       │           │   └─ MJR3D.QNI57:5\n" +
+			"                             │       │           └─ SubqueryAlias\n" +
+			"                             │       │               ├─ name: ei\n" +
+			"                             │       │               ├─ outerVisibility: true\n" +
+			"                             │       │               ├─ cacheable: true\n" +
+			"                             │       │               └─ Project\n" +
+			"                             │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                             │       │                   └─ Window\n" +
+			"                             │       │                       ├─ NOXN3.id:20!null\n" +
+			"                             │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             │       │                       └─ Table\n" +
+			"                             │       │                           ├─ name: NOXN3\n" +
+			"                             │       │                           └─ columns: [id]\n" +
+			"                             │       │   WHEN NOT\n" +
+			"                             │       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │       │   THEN Subquery\n" +
+			"                             │       │   ├─ cacheable: false\n" +
+			"                             │       │   └─ Project\n" +
+			"                             │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                             │       │       └─ Filter\n" +
+			"                             │       │           ├─ Eq\n" +
+			"                             │       │           │   ├─ ei.id:20!null\n" +
+			"                             │       │           │   └─ MJR3D.TDEIU:6\n" +
+			"                             │       │           └─ SubqueryAlias\n" +
+			"                             │       │               ├─ name: ei\n" +
+			"                             │       │               ├─ outerVisibility: true\n" +
+			"                             │       │               ├─ cacheable: true\n" +
+			"                             │       │               └─ Project\n" +
+			"                             │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                             │       │                   └─ Window\n" +
+			"                             │       │                       ├─ NOXN3.id:20!null\n" +
+			"                             │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             │       │                       └─ Table\n" +
+			"                             │       │                           ├─ name: NOXN3\n" +
+			"                             │       │                           └─ columns: [id]\n" +
+			"                             │       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                             │       └─ LeftOuterJoin\n" +
+			"                             │           ├─ Or\n" +
+			"                             │           │   ├─ Or\n" +
+			"                             │           │   │   ├─ Or\n" +
+			"                             │           │   │   │   ├─ AND\n" +
+			"                             │           │   │   │   │   ├─ AND\n" +
+			"                             │           │   │   │   │   │   ├─ NOT\n" +
+			"                             │           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │           │   │   │   │   │   └─ Eq\n" +
+			"                             │           │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                             │           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                             │           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   │   │   └─ AND\n" +
+			"                             │           │   │   │       ├─ AND\n" +
+			"                             │           │   │   │       │   ├─ NOT\n" +
+			"                             │           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │           │   │   │       │   └─ InSubquery\n" +
+			"                             │           │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │   │   │       │       └─ right: Subquery\n" +
+			"                             │           │   │   │       │           ├─ cacheable: false\n" +
+			"                             │           │   │   │       │           └─ Project\n" +
+			"                             │           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                             │           │   │   │       │               └─ Filter\n" +
+			"                             │           │   │   │       │                   ├─ Eq\n" +
+			"                             │           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                             │           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                             │           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"                             │           │   │   │       │                       └─ Table\n" +
+			"                             │           │   │   │       │                           ├─ name: NOXN3\n" +
+			"                             │           │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │   │   │       └─ NOT\n" +
+			"                             │           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   │   └─ AND\n" +
+			"                             │           │   │       ├─ AND\n" +
+			"                             │           │   │       │   ├─ NOT\n" +
+			"                             │           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │           │   │       │   └─ InSubquery\n" +
+			"                             │           │   │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │   │       │       └─ right: Subquery\n" +
+			"                             │           │   │       │           ├─ cacheable: false\n" +
+			"                             │           │   │       │           └─ Project\n" +
+			"                             │           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                             │           │   │       │               └─ Filter\n" +
+			"                             │           │   │       │                   ├─ Eq\n" +
+			"                             │           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                             │           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                             │           │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                             │           │   │       │                       └─ Table\n" +
+			"                             │           │   │       │                           ├─ name: NOXN3\n" +
+			"                             │           │   │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   └─ AND\n" +
+			"                             │           │       ├─ AND\n" +
+			"                             │           │       │   ├─ NOT\n" +
+			"                             │           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │           │       │   └─ InSubquery\n" +
+			"                             │           │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │       │       └─ right: Subquery\n" +
+			"                             │           │       │           ├─ cacheable: false\n" +
+			"                             │           │       │           └─ Project\n" +
+			"                             │           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                             │           │       │               └─ Filter\n" +
+			"                             │           │       │                   ├─ Eq\n" +
+			"                             │           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                             │           │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                             │           │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                             │           │       │                       └─ Table\n" +
+			"                             │           │       │                           ├─ name: NOXN3\n" +
+			"                             │           │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │       └─ NOT\n" +
+			"                             │           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           ├─ LookupJoin\n" +
 			"                             │           │   ├─ Eq\n" +
-			"                             │           │   │   ├─ bs.id:2!null\n" +
-			"                             │           │   │   └─ mf.GXLUB:4!null\n" +
-			"                             │           │   ├─ MergeJoin\n" +
-			"                             │           │   │   ├─ cmp: Eq\n" +
-			"                             │           │   │   │   ├─ cla.id:0!null\n" +
-			"                             │           │   │   │   └─ bs.IXUXU:3\n" +
-			"                             │           │   │   ├─ Filter\n" +
-			"                             │           │   │   │   ├─ HashIn\n" +
-			"                             │           │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │           │   │   │   └─ TableAlias(cla)\n" +
-			"                             │           │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │           │   │   │           ├─ index: [YK2GW.id]\n" +
-			"                             │           │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │           │   │   │           └─ columns: [id ftqlq]\n" +
-			"                             │           │   │   └─ TableAlias(bs)\n" +
-			"                             │           │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"                             │           │   │           ├─ index: [THNTS.IXUXU]\n" +
-			"                             │           │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │           │   │           └─ columns: [id ixuxu]\n" +
-			"                             │           │   └─ HashLookup\n" +
-			"                             │           │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
-			"                             │           │       ├─ right-key: TUPLE(mf.GXLUB:0!null)\n" +
-			"                             │           │       └─ CachedResults\n" +
-			"                             │           │           └─ TableAlias(mf)\n" +
-			"                             │           │               └─ Table\n" +
-			"                             │           │                   ├─ name: HGMQ6\n" +
-			"                             │           │                   └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ left-key: TUPLE(mf.LUEVY:5!null)\n" +
-			"                             │               ├─ right-key: TUPLE(sn.BRQP2:1!null)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ TableAlias(sn)\n" +
-			"                             │                       └─ Table\n" +
-			"                             │                           ├─ name: NOXN3\n" +
-			"                             │                           └─ columns: [id brqp2]\n" +
+			"                             │           │   │   ├─ aac.id:7!null\n" +
+			"                             │           │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                             │           │   ├─ SubqueryAlias\n" +
+			"                             │           │   │   ├─ name: MJR3D\n" +
+			"                             │           │   │   ├─ outerVisibility: false\n" +
+			"                             │           │   │   ├─ cacheable: true\n" +
+			"                             │           │   │   └─ Distinct\n" +
+			"                             │           │   │       └─ Project\n" +
+			"                             │           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                             │           │   │           └─ Filter\n" +
+			"                             │           │   │               ├─ Or\n" +
+			"                             │           │   │               │   ├─ NOT\n" +
+			"                             │           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                             │           │   │               │   └─ NOT\n" +
+			"                             │           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                             │           │   │               └─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   ├─ AND\n" +
+			"                             │           │   │                   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                             │           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                             │           │   │                   │   └─ Eq\n" +
+			"                             │           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                             │           │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   ├─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   │   ├─ AND\n" +
+			"                             │           │   │                   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                             │           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   │   │   └─ Eq\n" +
+			"                             │           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                             │           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                             │           │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                             │           │   │                   │   │   ├─ AND\n" +
+			"                             │           │   │                   │   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                             │           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                             │           │   │                   │   │   │   └─ NOT\n" +
+			"                             │           │   │                   │   │   │       └─ Eq\n" +
+			"                             │           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                             │           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   │   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                             │           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                             │           │   │                   │   │   │   ├─ MergeJoin\n" +
+			"                             │           │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"                             │           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                             │           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                             │           │   │                   │   │   │   │   ├─ Filter\n" +
+			"                             │           │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                             │           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                             │           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"                             │           │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"                             │           │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"                             │           │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                             │           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"                             │           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"                             │           │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   │   └─ HashLookup\n" +
+			"                             │           │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
+			"                             │           │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"                             │           │   │                   │   │   │       └─ CachedResults\n" +
+			"                             │           │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
+			"                             │           │   │                   │   │   │               └─ Table\n" +
+			"                             │           │   │                   │   │   │                   ├─ name: WGSDC\n" +
+			"                             │           │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"                             │           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                             │           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                             │           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                             │           │   │                   │   └─ HashLookup\n" +
+			"                             │           │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
+			"                             │           │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"                             │           │   │                   │       └─ CachedResults\n" +
+			"                             │           │   │                   │           └─ TableAlias(YQIF4)\n" +
+			"                             │           │   │                   │               └─ Table\n" +
+			"                             │           │   │                   │                   ├─ name: NOXN3\n" +
+			"                             │           │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                             │           │   │                   └─ HashLookup\n" +
+			"                             │           │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
+			"                             │           │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"                             │           │   │                       └─ CachedResults\n" +
+			"                             │           │   │                           └─ TableAlias(YVHJZ)\n" +
+			"                             │           │   │                               └─ Table\n" +
+			"                             │           │   │                                   ├─ name: NOXN3\n" +
+			"                             │           │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                             │           │   └─ TableAlias(aac)\n" +
+			"                             │           │       └─ IndexedTableAccess(TPXBU)\n" +
+			"                             │           │           ├─ index: [TPXBU.id]\n" +
+			"                             │           │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                             │           └─ TableAlias(sn)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: NOXN3\n" +
+			"                             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ right-key: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                 ├─ left-key: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                 ├─ right-key: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
 			"                                 └─ CachedResults\n" +
 			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
+			"                                         ├─ name: cld\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
+			"                                             ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
+			"                                             └─ HashJoin\n" +
+			"                                                 ├─ Eq\n" +
+			"                                                 │   ├─ sn.BRQP2:8!null\n" +
+			"                                                 │   └─ mf.LUEVY:5!null\n" +
+			"                                                 ├─ HashJoin\n" +
 			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ MergeJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ cmp: Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ HashLookup\n" +
-			"                                                 │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
-			"                                                 │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"                                                 │   │                   │   │   │       └─ CachedResults\n" +
-			"                                                 │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │               └─ Table\n" +
-			"                                                 │   │                   │   │   │                   ├─ name: WGSDC\n" +
-			"                                                 │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ HashLookup\n" +
-			"                                                 │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
-			"                                                 │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"                                                 │   │                   │       └─ CachedResults\n" +
-			"                                                 │   │                   │           └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │               └─ Table\n" +
-			"                                                 │   │                   │                   ├─ name: NOXN3\n" +
-			"                                                 │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ HashLookup\n" +
-			"                                                 │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
-			"                                                 │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"                                                 │   │                       └─ CachedResults\n" +
-			"                                                 │   │                           └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                               └─ Table\n" +
-			"                                                 │   │                                   ├─ name: NOXN3\n" +
-			"                                                 │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                                 │   │   ├─ bs.id:2!null\n" +
+			"                                                 │   │   └─ mf.GXLUB:4!null\n" +
+			"                                                 │   ├─ MergeJoin\n" +
+			"                                                 │   │   ├─ cmp: Eq\n" +
+			"                                                 │   │   │   ├─ cla.id:0!null\n" +
+			"                                                 │   │   │   └─ bs.IXUXU:3\n" +
+			"                                                 │   │   ├─ Filter\n" +
+			"                                                 │   │   │   ├─ Eq\n" +
+			"                                                 │   │   │   │   ├─ cla.FTQLQ:1!null\n" +
+			"                                                 │   │   │   │   └─ SQ1 (longtext)\n" +
+			"                                                 │   │   │   └─ TableAlias(cla)\n" +
+			"                                                 │   │   │       └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                 │   │   │           ├─ index: [YK2GW.id]\n" +
+			"                                                 │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                 │   │   │           └─ columns: [id ftqlq]\n" +
+			"                                                 │   │   └─ TableAlias(bs)\n" +
+			"                                                 │   │       └─ IndexedTableAccess(THNTS)\n" +
+			"                                                 │   │           ├─ index: [THNTS.IXUXU]\n" +
+			"                                                 │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                 │   │           └─ columns: [id ixuxu]\n" +
+			"                                                 │   └─ HashLookup\n" +
+			"                                                 │       ├─ left-key: TUPLE(bs.id:2!null)\n" +
+			"                                                 │       ├─ right-key: TUPLE(mf.GXLUB:0!null)\n" +
+			"                                                 │       └─ CachedResults\n" +
+			"                                                 │           └─ TableAlias(mf)\n" +
+			"                                                 │               └─ Table\n" +
+			"                                                 │                   ├─ name: HGMQ6\n" +
+			"                                                 │                   └─ columns: [gxlub luevy m22qn]\n" +
+			"                                                 └─ HashLookup\n" +
+			"                                                     ├─ left-key: TUPLE(mf.LUEVY:5!null)\n" +
+			"                                                     ├─ right-key: TUPLE(sn.BRQP2:1!null)\n" +
+			"                                                     └─ CachedResults\n" +
+			"                                                         └─ TableAlias(sn)\n" +
+			"                                                             └─ Table\n" +
+			"                                                                 ├─ name: NOXN3\n" +
+			"                                                                 └─ columns: [id brqp2]\n" +
 			"",
 	},
 	{
@@ -15144,53 +15159,47 @@ WHERE
 			"     │           │   ├─ cacheable: true\n" +
 			"     │           │   └─ Distinct\n" +
 			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
-			"     │           │           └─ SubqueryAlias\n" +
-			"     │           │               ├─ name: HTKBS\n" +
-			"     │           │               ├─ outerVisibility: false\n" +
-			"     │           │               ├─ cacheable: true\n" +
-			"     │           │               └─ Project\n" +
-			"     │           │                   ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:0!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
-			"     │           │                   └─ HashJoin\n" +
-			"     │           │                       ├─ Eq\n" +
-			"     │           │                       │   ├─ bs.id:7!null\n" +
-			"     │           │                       │   └─ mf.GXLUB:2!null\n" +
-			"     │           │                       ├─ MergeJoin\n" +
-			"     │           │                       │   ├─ cmp: Eq\n" +
-			"     │           │                       │   │   ├─ sn.BRQP2:1!null\n" +
-			"     │           │                       │   │   └─ mf.LUEVY:3!null\n" +
-			"     │           │                       │   ├─ TableAlias(sn)\n" +
-			"     │           │                       │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"     │           │                       │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"     │           │                       │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                       │   │       └─ columns: [id brqp2]\n" +
-			"     │           │                       │   └─ TableAlias(mf)\n" +
-			"     │           │                       │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"     │           │                       │           ├─ index: [HGMQ6.LUEVY]\n" +
-			"     │           │                       │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                       │           └─ columns: [gxlub luevy m22qn]\n" +
-			"     │           │                       └─ HashLookup\n" +
-			"     │           │                           ├─ left-key: TUPLE(mf.GXLUB:2!null)\n" +
-			"     │           │                           ├─ right-key: TUPLE(bs.id:2!null)\n" +
-			"     │           │                           └─ CachedResults\n" +
-			"     │           │                               └─ MergeJoin\n" +
-			"     │           │                                   ├─ cmp: Eq\n" +
-			"     │           │                                   │   ├─ cla.id:5!null\n" +
-			"     │           │                                   │   └─ bs.IXUXU:8\n" +
-			"     │           │                                   ├─ Filter\n" +
-			"     │           │                                   │   ├─ HashIn\n" +
-			"     │           │                                   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"     │           │                                   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"     │           │                                   │   └─ TableAlias(cla)\n" +
-			"     │           │                                   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"     │           │                                   │           ├─ index: [YK2GW.id]\n" +
-			"     │           │                                   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                                   │           └─ columns: [id ftqlq]\n" +
-			"     │           │                                   └─ TableAlias(bs)\n" +
-			"     │           │                                       └─ IndexedTableAccess(THNTS)\n" +
-			"     │           │                                           ├─ index: [THNTS.IXUXU]\n" +
-			"     │           │                                           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │           │                                           └─ columns: [id ixuxu]\n" +
+			"     │           │           ├─ columns: [cla.FTQLQ:6!null as T4IBQ]\n" +
+			"     │           │           └─ HashJoin\n" +
+			"     │           │               ├─ Eq\n" +
+			"     │           │               │   ├─ bs.id:7!null\n" +
+			"     │           │               │   └─ mf.GXLUB:2!null\n" +
+			"     │           │               ├─ MergeJoin\n" +
+			"     │           │               │   ├─ cmp: Eq\n" +
+			"     │           │               │   │   ├─ sn.BRQP2:1!null\n" +
+			"     │           │               │   │   └─ mf.LUEVY:3!null\n" +
+			"     │           │               │   ├─ TableAlias(sn)\n" +
+			"     │           │               │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"     │           │               │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"     │           │               │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │               │   │       └─ columns: [id brqp2]\n" +
+			"     │           │               │   └─ TableAlias(mf)\n" +
+			"     │           │               │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"     │           │               │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"     │           │               │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │               │           └─ columns: [gxlub luevy m22qn]\n" +
+			"     │           │               └─ HashLookup\n" +
+			"     │           │                   ├─ left-key: TUPLE(mf.GXLUB:2!null)\n" +
+			"     │           │                   ├─ right-key: TUPLE(bs.id:2!null)\n" +
+			"     │           │                   └─ CachedResults\n" +
+			"     │           │                       └─ MergeJoin\n" +
+			"     │           │                           ├─ cmp: Eq\n" +
+			"     │           │                           │   ├─ cla.id:5!null\n" +
+			"     │           │                           │   └─ bs.IXUXU:8\n" +
+			"     │           │                           ├─ Filter\n" +
+			"     │           │                           │   ├─ Eq\n" +
+			"     │           │                           │   │   ├─ cla.FTQLQ:1!null\n" +
+			"     │           │                           │   │   └─ SQ1 (longtext)\n" +
+			"     │           │                           │   └─ TableAlias(cla)\n" +
+			"     │           │                           │       └─ IndexedTableAccess(YK2GW)\n" +
+			"     │           │                           │           ├─ index: [YK2GW.id]\n" +
+			"     │           │                           │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │                           │           └─ columns: [id ftqlq]\n" +
+			"     │           │                           └─ TableAlias(bs)\n" +
+			"     │           │                               └─ IndexedTableAccess(THNTS)\n" +
+			"     │           │                                   ├─ index: [THNTS.IXUXU]\n" +
+			"     │           │                                   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │                                   └─ columns: [id ixuxu]\n" +
 			"     │           └─ HashLookup\n" +
 			"     │               ├─ left-key: TUPLE()\n" +
 			"     │               ├─ right-key: TUPLE()\n" +
@@ -15200,228 +15209,226 @@ WHERE
 			"     │                       ├─ outerVisibility: false\n" +
 			"     │                       ├─ cacheable: true\n" +
 			"     │                       └─ Distinct\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
-			"     │                               └─ SubqueryAlias\n" +
-			"     │                                   ├─ name: JQHRG\n" +
-			"     │                                   ├─ outerVisibility: false\n" +
-			"     │                                   ├─ cacheable: true\n" +
-			"     │                                   └─ Project\n" +
-			"     │                                       ├─ columns: [CASE  WHEN NOT\n" +
-			"     │                                       │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                       │   THEN Subquery\n" +
-			"     │                                       │   ├─ cacheable: false\n" +
-			"     │                                       │   └─ Project\n" +
-			"     │                                       │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │                                       │       └─ Filter\n" +
-			"     │                                       │           ├─ Eq\n" +
-			"     │                                       │           │   ├─ ei.id:20!null\n" +
-			"     │                                       │           │   └─ MJR3D.QNI57:5\n" +
-			"     │                                       │           └─ SubqueryAlias\n" +
-			"     │                                       │               ├─ name: ei\n" +
-			"     │                                       │               ├─ outerVisibility: true\n" +
-			"     │                                       │               ├─ cacheable: true\n" +
-			"     │                                       │               └─ Project\n" +
-			"     │                                       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │                                       │                   └─ Window\n" +
-			"     │                                       │                       ├─ NOXN3.id:20!null\n" +
-			"     │                                       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │                                       │                       └─ Table\n" +
-			"     │                                       │                           ├─ name: NOXN3\n" +
-			"     │                                       │                           └─ columns: [id]\n" +
-			"     │                                       │   WHEN NOT\n" +
-			"     │                                       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                       │   THEN Subquery\n" +
-			"     │                                       │   ├─ cacheable: false\n" +
-			"     │                                       │   └─ Project\n" +
-			"     │                                       │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │                                       │       └─ Filter\n" +
-			"     │                                       │           ├─ Eq\n" +
-			"     │                                       │           │   ├─ ei.id:20!null\n" +
-			"     │                                       │           │   └─ MJR3D.TDEIU:6\n" +
-			"     │                                       │           └─ SubqueryAlias\n" +
-			"     │                                       │               ├─ name: ei\n" +
-			"     │                                       │               ├─ outerVisibility: true\n" +
-			"     │                                       │               ├─ cacheable: true\n" +
-			"     │                                       │               └─ Project\n" +
-			"     │                                       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │                                       │                   └─ Window\n" +
-			"     │                                       │                       ├─ NOXN3.id:20!null\n" +
-			"     │                                       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │                                       │                       └─ Table\n" +
-			"     │                                       │                           ├─ name: NOXN3\n" +
-			"     │                                       │                           └─ columns: [id]\n" +
-			"     │                                       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"     │                                       └─ LeftOuterJoin\n" +
-			"     │                                           ├─ Or\n" +
-			"     │                                           │   ├─ Or\n" +
-			"     │                                           │   │   ├─ Or\n" +
-			"     │                                           │   │   │   ├─ AND\n" +
-			"     │                                           │   │   │   │   ├─ AND\n" +
-			"     │                                           │   │   │   │   │   ├─ NOT\n" +
-			"     │                                           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                           │   │   │   │   │   └─ Eq\n" +
-			"     │                                           │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"     │                                           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"     │                                           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   │   │   └─ AND\n" +
-			"     │                                           │   │   │       ├─ AND\n" +
-			"     │                                           │   │   │       │   ├─ NOT\n" +
-			"     │                                           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │                                           │   │   │       │   └─ InSubquery\n" +
-			"     │                                           │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │   │   │       │       └─ right: Subquery\n" +
-			"     │                                           │   │   │       │           ├─ cacheable: false\n" +
-			"     │                                           │   │   │       │           └─ Project\n" +
-			"     │                                           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"     │                                           │   │   │       │               └─ Filter\n" +
-			"     │                                           │   │   │       │                   ├─ Eq\n" +
-			"     │                                           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"     │                                           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │                                           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"     │                                           │   │   │       │                       └─ Table\n" +
-			"     │                                           │   │   │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │   │   │       └─ NOT\n" +
-			"     │                                           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   │   └─ AND\n" +
-			"     │                                           │   │       ├─ AND\n" +
-			"     │                                           │   │       │   ├─ NOT\n" +
-			"     │                                           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                           │   │       │   └─ InSubquery\n" +
-			"     │                                           │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │   │       │       └─ right: Subquery\n" +
-			"     │                                           │   │       │           ├─ cacheable: false\n" +
-			"     │                                           │   │       │           └─ Project\n" +
-			"     │                                           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │                                           │   │       │               └─ Filter\n" +
-			"     │                                           │   │       │                   ├─ Eq\n" +
-			"     │                                           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │                                           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"     │                                           │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │                                           │   │       │                       └─ Table\n" +
-			"     │                                           │   │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           │   └─ AND\n" +
-			"     │                                           │       ├─ AND\n" +
-			"     │                                           │       │   ├─ NOT\n" +
-			"     │                                           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │                                           │       │   └─ InSubquery\n" +
-			"     │                                           │       │       ├─ left: sn.id:10!null\n" +
-			"     │                                           │       │       └─ right: Subquery\n" +
-			"     │                                           │       │           ├─ cacheable: false\n" +
-			"     │                                           │       │           └─ Project\n" +
-			"     │                                           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │                                           │       │               └─ Filter\n" +
-			"     │                                           │       │                   ├─ Eq\n" +
-			"     │                                           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │                                           │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │                                           │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │                                           │       │                       └─ Table\n" +
-			"     │                                           │       │                           ├─ name: NOXN3\n" +
-			"     │                                           │       │                           └─ columns: [id brqp2]\n" +
-			"     │                                           │       └─ NOT\n" +
-			"     │                                           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │                                           ├─ LookupJoin\n" +
-			"     │                                           │   ├─ Eq\n" +
-			"     │                                           │   │   ├─ aac.id:7!null\n" +
-			"     │                                           │   │   └─ MJR3D.M22QN:2!null\n" +
-			"     │                                           │   ├─ SubqueryAlias\n" +
-			"     │                                           │   │   ├─ name: MJR3D\n" +
-			"     │                                           │   │   ├─ outerVisibility: false\n" +
-			"     │                                           │   │   ├─ cacheable: true\n" +
-			"     │                                           │   │   └─ Distinct\n" +
-			"     │                                           │   │       └─ Project\n" +
-			"     │                                           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"     │                                           │   │           └─ Filter\n" +
-			"     │                                           │   │               ├─ Or\n" +
-			"     │                                           │   │               │   ├─ NOT\n" +
-			"     │                                           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"     │                                           │   │               │   └─ NOT\n" +
-			"     │                                           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"     │                                           │   │               └─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   ├─ AND\n" +
-			"     │                                           │   │                   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"     │                                           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"     │                                           │   │                   │   └─ Eq\n" +
-			"     │                                           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"     │                                           │   │                   │       └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   ├─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   │   ├─ AND\n" +
-			"     │                                           │   │                   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"     │                                           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   │   │   └─ Eq\n" +
-			"     │                                           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"     │                                           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"     │                                           │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │                                           │   │                   │   │   ├─ AND\n" +
-			"     │                                           │   │                   │   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"     │                                           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"     │                                           │   │                   │   │   │   └─ NOT\n" +
-			"     │                                           │   │                   │   │   │       └─ Eq\n" +
-			"     │                                           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"     │                                           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"     │                                           │   │                   │   │   ├─ LeftOuterHashJoin\n" +
-			"     │                                           │   │                   │   │   │   ├─ Eq\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"     │                                           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"     │                                           │   │                   │   │   │   ├─ MergeJoin\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ cmp: Eq\n" +
-			"     │                                           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │                                           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"     │                                           │   │                   │   │   │   │   ├─ Filter\n" +
-			"     │                                           │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"     │                                           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"     │                                           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │                                           │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"     │                                           │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"     │                                           │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                                           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │                                           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │                                           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │                                           │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"     │                                           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   │   └─ HashLookup\n" +
-			"     │                                           │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
-			"     │                                           │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"     │                                           │   │                   │   │   │       └─ CachedResults\n" +
-			"     │                                           │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"     │                                           │   │                   │   │   │               └─ Table\n" +
-			"     │                                           │   │                   │   │   │                   ├─ name: WGSDC\n" +
-			"     │                                           │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"     │                                           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │                                           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │                                           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │                                           │   │                   │   └─ HashLookup\n" +
-			"     │                                           │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
-			"     │                                           │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"     │                                           │   │                   │       └─ CachedResults\n" +
-			"     │                                           │   │                   │           └─ TableAlias(YQIF4)\n" +
-			"     │                                           │   │                   │               └─ Table\n" +
-			"     │                                           │   │                   │                   ├─ name: NOXN3\n" +
-			"     │                                           │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │                                           │   │                   └─ HashLookup\n" +
-			"     │                                           │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
-			"     │                                           │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"     │                                           │   │                       └─ CachedResults\n" +
-			"     │                                           │   │                           └─ TableAlias(YVHJZ)\n" +
-			"     │                                           │   │                               └─ Table\n" +
-			"     │                                           │   │                                   ├─ name: NOXN3\n" +
-			"     │                                           │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │                                           │   └─ TableAlias(aac)\n" +
-			"     │                                           │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │                                           │           ├─ index: [TPXBU.id]\n" +
-			"     │                                           │           └─ columns: [id btxc5 fhcyt]\n" +
-			"     │                                           └─ TableAlias(sn)\n" +
-			"     │                                               └─ Table\n" +
-			"     │                                                   ├─ name: NOXN3\n" +
-			"     │                                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                           └─ SubqueryAlias\n" +
+			"     │                               ├─ name: JQHRG\n" +
+			"     │                               ├─ outerVisibility: false\n" +
+			"     │                               ├─ cacheable: true\n" +
+			"     │                               └─ Project\n" +
+			"     │                                   ├─ columns: [CASE  WHEN NOT\n" +
+			"     │                                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                   │   THEN Subquery\n" +
+			"     │                                   │   ├─ cacheable: false\n" +
+			"     │                                   │   └─ Project\n" +
+			"     │                                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                   │       └─ Filter\n" +
+			"     │                                   │           ├─ Eq\n" +
+			"     │                                   │           │   ├─ ei.id:20!null\n" +
+			"     │                                   │           │   └─ MJR3D.QNI57:5\n" +
+			"     │                                   │           └─ SubqueryAlias\n" +
+			"     │                                   │               ├─ name: ei\n" +
+			"     │                                   │               ├─ outerVisibility: true\n" +
+			"     │                                   │               ├─ cacheable: true\n" +
+			"     │                                   │               └─ Project\n" +
+			"     │                                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                   │                   └─ Window\n" +
+			"     │                                   │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                   │                       └─ Table\n" +
+			"     │                                   │                           ├─ name: NOXN3\n" +
+			"     │                                   │                           └─ columns: [id]\n" +
+			"     │                                   │   WHEN NOT\n" +
+			"     │                                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                   │   THEN Subquery\n" +
+			"     │                                   │   ├─ cacheable: false\n" +
+			"     │                                   │   └─ Project\n" +
+			"     │                                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                   │       └─ Filter\n" +
+			"     │                                   │           ├─ Eq\n" +
+			"     │                                   │           │   ├─ ei.id:20!null\n" +
+			"     │                                   │           │   └─ MJR3D.TDEIU:6\n" +
+			"     │                                   │           └─ SubqueryAlias\n" +
+			"     │                                   │               ├─ name: ei\n" +
+			"     │                                   │               ├─ outerVisibility: true\n" +
+			"     │                                   │               ├─ cacheable: true\n" +
+			"     │                                   │               └─ Project\n" +
+			"     │                                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                   │                   └─ Window\n" +
+			"     │                                   │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                   │                       └─ Table\n" +
+			"     │                                   │                           ├─ name: NOXN3\n" +
+			"     │                                   │                           └─ columns: [id]\n" +
+			"     │                                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, MJR3D.TUV25:3 as TUV25]\n" +
+			"     │                                   └─ LeftOuterJoin\n" +
+			"     │                                       ├─ Or\n" +
+			"     │                                       │   ├─ Or\n" +
+			"     │                                       │   │   ├─ Or\n" +
+			"     │                                       │   │   │   ├─ AND\n" +
+			"     │                                       │   │   │   │   ├─ AND\n" +
+			"     │                                       │   │   │   │   │   ├─ NOT\n" +
+			"     │                                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                       │   │   │   │   │   └─ Eq\n" +
+			"     │                                       │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"     │                                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"     │                                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   │   │   └─ AND\n" +
+			"     │                                       │   │   │       ├─ AND\n" +
+			"     │                                       │   │   │       │   ├─ NOT\n" +
+			"     │                                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                       │   │   │       │   └─ InSubquery\n" +
+			"     │                                       │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │   │   │       │       └─ right: Subquery\n" +
+			"     │                                       │   │   │       │           ├─ cacheable: false\n" +
+			"     │                                       │   │   │       │           └─ Project\n" +
+			"     │                                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"     │                                       │   │   │       │               └─ Filter\n" +
+			"     │                                       │   │   │       │                   ├─ Eq\n" +
+			"     │                                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"     │                                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                       │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"     │                                       │   │   │       │                       └─ Table\n" +
+			"     │                                       │   │   │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │   │   │       └─ NOT\n" +
+			"     │                                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   │   └─ AND\n" +
+			"     │                                       │   │       ├─ AND\n" +
+			"     │                                       │   │       │   ├─ NOT\n" +
+			"     │                                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                       │   │       │   └─ InSubquery\n" +
+			"     │                                       │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │   │       │       └─ right: Subquery\n" +
+			"     │                                       │   │       │           ├─ cacheable: false\n" +
+			"     │                                       │   │       │           └─ Project\n" +
+			"     │                                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                       │   │       │               └─ Filter\n" +
+			"     │                                       │   │       │                   ├─ Eq\n" +
+			"     │                                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"     │                                       │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"     │                                       │   │       │                       └─ Table\n" +
+			"     │                                       │   │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       │   └─ AND\n" +
+			"     │                                       │       ├─ AND\n" +
+			"     │                                       │       │   ├─ NOT\n" +
+			"     │                                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                       │       │   └─ InSubquery\n" +
+			"     │                                       │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                       │       │       └─ right: Subquery\n" +
+			"     │                                       │       │           ├─ cacheable: false\n" +
+			"     │                                       │       │           └─ Project\n" +
+			"     │                                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                       │       │               └─ Filter\n" +
+			"     │                                       │       │                   ├─ Eq\n" +
+			"     │                                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                       │       │                   └─ TableAlias(XMAFZ)\n" +
+			"     │                                       │       │                       └─ Table\n" +
+			"     │                                       │       │                           ├─ name: NOXN3\n" +
+			"     │                                       │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                       │       └─ NOT\n" +
+			"     │                                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                       ├─ LookupJoin\n" +
+			"     │                                       │   ├─ Eq\n" +
+			"     │                                       │   │   ├─ aac.id:7!null\n" +
+			"     │                                       │   │   └─ MJR3D.M22QN:2!null\n" +
+			"     │                                       │   ├─ SubqueryAlias\n" +
+			"     │                                       │   │   ├─ name: MJR3D\n" +
+			"     │                                       │   │   ├─ outerVisibility: false\n" +
+			"     │                                       │   │   ├─ cacheable: true\n" +
+			"     │                                       │   │   └─ Distinct\n" +
+			"     │                                       │   │       └─ Project\n" +
+			"     │                                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"     │                                       │   │           └─ Filter\n" +
+			"     │                                       │   │               ├─ Or\n" +
+			"     │                                       │   │               │   ├─ NOT\n" +
+			"     │                                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"     │                                       │   │               │   └─ NOT\n" +
+			"     │                                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"     │                                       │   │               └─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   ├─ AND\n" +
+			"     │                                       │   │                   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"     │                                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"     │                                       │   │                   │   └─ Eq\n" +
+			"     │                                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"     │                                       │   │                   │       └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   ├─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   │   ├─ AND\n" +
+			"     │                                       │   │                   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"     │                                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   │   │   └─ Eq\n" +
+			"     │                                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"     │                                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"     │                                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                       │   │                   │   │   ├─ AND\n" +
+			"     │                                       │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"     │                                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"     │                                       │   │                   │   │   │   └─ NOT\n" +
+			"     │                                       │   │                   │   │   │       └─ Eq\n" +
+			"     │                                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"     │                                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"     │                                       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"     │                                       │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"     │                                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"     │                                       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"     │                                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │                                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"     │                                       │   │                   │   │   │   │   ├─ Filter\n" +
+			"     │                                       │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"     │                                       │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"     │                                       │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"     │                                       │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"     │                                       │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │                                       │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"     │                                       │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"     │                                       │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"     │                                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   │   └─ HashLookup\n" +
+			"     │                                       │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
+			"     │                                       │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"     │                                       │   │                   │   │   │       └─ CachedResults\n" +
+			"     │                                       │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
+			"     │                                       │   │                   │   │   │               └─ Table\n" +
+			"     │                                       │   │                   │   │   │                   ├─ name: WGSDC\n" +
+			"     │                                       │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"     │                                       │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"     │                                       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"     │                                       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                       │   │                   │   └─ HashLookup\n" +
+			"     │                                       │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
+			"     │                                       │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"     │                                       │   │                   │       └─ CachedResults\n" +
+			"     │                                       │   │                   │           └─ TableAlias(YQIF4)\n" +
+			"     │                                       │   │                   │               └─ Table\n" +
+			"     │                                       │   │                   │                   ├─ name: NOXN3\n" +
+			"     │                                       │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                       │   │                   └─ HashLookup\n" +
+			"     │                                       │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
+			"     │                                       │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"     │                                       │   │                       └─ CachedResults\n" +
+			"     │                                       │   │                           └─ TableAlias(YVHJZ)\n" +
+			"     │                                       │   │                               └─ Table\n" +
+			"     │                                       │   │                                   ├─ name: NOXN3\n" +
+			"     │                                       │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                       │   └─ TableAlias(aac)\n" +
+			"     │                                       │       └─ IndexedTableAccess(TPXBU)\n" +
+			"     │                                       │           ├─ index: [TPXBU.id]\n" +
+			"     │                                       │           └─ columns: [id btxc5 fhcyt]\n" +
+			"     │                                       └─ TableAlias(sn)\n" +
+			"     │                                           └─ Table\n" +
+			"     │                                               ├─ name: NOXN3\n" +
+			"     │                                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"     └─ SubqueryAlias\n" +
 			"         ├─ name: scalarSubq0\n" +
 			"         ├─ outerVisibility: false\n" +
@@ -15432,288 +15439,288 @@ WHERE
 			"             ├─ cacheable: true\n" +
 			"             └─ Distinct\n" +
 			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
+			"                     ├─ columns: [cld.T4IBQ:5!null as T4IBQ, P4PJZ.M6T2N:0 as M6T2N, P4PJZ.BTXC5:1 as BTXC5, P4PJZ.TUV25:4 as TUV25]\n" +
 			"                     └─ Filter\n" +
 			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
+			"                         │   └─ P4PJZ.M6T2N:0 IS NULL\n" +
+			"                         └─ HashJoin\n" +
 			"                             ├─ AND\n" +
 			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
+			"                             │   │   ├─ P4PJZ.LWQ6O:3\n" +
+			"                             │   │   └─ cld.BDNYB:6!null\n" +
 			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
+			"                             │       ├─ P4PJZ.NTOFG:2!null\n" +
+			"                             │       └─ cld.M22QN:7!null\n" +
 			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
+			"                             │   ├─ name: P4PJZ\n" +
 			"                             │   ├─ outerVisibility: false\n" +
 			"                             │   ├─ cacheable: true\n" +
 			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:0!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ bs.id:7!null\n" +
-			"                             │           │   └─ mf.GXLUB:2!null\n" +
-			"                             │           ├─ MergeJoin\n" +
-			"                             │           │   ├─ cmp: Eq\n" +
-			"                             │           │   │   ├─ sn.BRQP2:1!null\n" +
-			"                             │           │   │   └─ mf.LUEVY:3!null\n" +
-			"                             │           │   ├─ TableAlias(sn)\n" +
-			"                             │           │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                             │           │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"                             │           │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │           │   │       └─ columns: [id brqp2]\n" +
-			"                             │           │   └─ TableAlias(mf)\n" +
-			"                             │           │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                             │           │           ├─ index: [HGMQ6.LUEVY]\n" +
-			"                             │           │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │           │           └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ left-key: TUPLE(mf.GXLUB:2!null)\n" +
-			"                             │               ├─ right-key: TUPLE(bs.id:2!null)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ MergeJoin\n" +
-			"                             │                       ├─ cmp: Eq\n" +
-			"                             │                       │   ├─ cla.id:5!null\n" +
-			"                             │                       │   └─ bs.IXUXU:8\n" +
-			"                             │                       ├─ Filter\n" +
-			"                             │                       │   ├─ HashIn\n" +
-			"                             │                       │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │                       │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │                       │   └─ TableAlias(cla)\n" +
-			"                             │                       │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │                       │           ├─ index: [YK2GW.id]\n" +
-			"                             │                       │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │                       │           └─ columns: [id ftqlq]\n" +
-			"                             │                       └─ TableAlias(bs)\n" +
-			"                             │                           └─ IndexedTableAccess(THNTS)\n" +
-			"                             │                               ├─ index: [THNTS.IXUXU]\n" +
-			"                             │                               ├─ static: [{[NULL, ∞)}]\n" +
-			"                             │                               └─ columns: [id ixuxu]\n" +
+			"                             │       ├─ columns: [CASE  WHEN NOT\n" +
+			"                             │       │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │       │   THEN Subquery\n" +
+			"                             │       │   ├─ cacheable: false\n" +
+			"                             │       │   └─ Project\n" +
+			"                             │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                             │       │       └─ Filter\n" +
+			"                             │       │           ├─ Eq\n" +
+			"                             │       │           │   ├─ ei.id:20!null\n" +
+			"                             │       │           │   └─ MJR3D.QNI57:5\n" +
+			"                             │       │           └─ SubqueryAlias\n" +
+			"                             │       │               ├─ name: ei\n" +
+			"                             │       │               ├─ outerVisibility: true\n" +
+			"                             │       │               ├─ cacheable: true\n" +
+			"                             │       │               └─ Project\n" +
+			"                             │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                             │       │                   └─ Window\n" +
+			"                             │       │                       ├─ NOXN3.id:20!null\n" +
+			"                             │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             │       │                       └─ Table\n" +
+			"                             │       │                           ├─ name: NOXN3\n" +
+			"                             │       │                           └─ columns: [id]\n" +
+			"                             │       │   WHEN NOT\n" +
+			"                             │       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │       │   THEN Subquery\n" +
+			"                             │       │   ├─ cacheable: false\n" +
+			"                             │       │   └─ Project\n" +
+			"                             │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                             │       │       └─ Filter\n" +
+			"                             │       │           ├─ Eq\n" +
+			"                             │       │           │   ├─ ei.id:20!null\n" +
+			"                             │       │           │   └─ MJR3D.TDEIU:6\n" +
+			"                             │       │           └─ SubqueryAlias\n" +
+			"                             │       │               ├─ name: ei\n" +
+			"                             │       │               ├─ outerVisibility: true\n" +
+			"                             │       │               ├─ cacheable: true\n" +
+			"                             │       │               └─ Project\n" +
+			"                             │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                             │       │                   └─ Window\n" +
+			"                             │       │                       ├─ NOXN3.id:20!null\n" +
+			"                             │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             │       │                       └─ Table\n" +
+			"                             │       │                           ├─ name: NOXN3\n" +
+			"                             │       │                           └─ columns: [id]\n" +
+			"                             │       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                             │       └─ LeftOuterJoin\n" +
+			"                             │           ├─ Or\n" +
+			"                             │           │   ├─ Or\n" +
+			"                             │           │   │   ├─ Or\n" +
+			"                             │           │   │   │   ├─ AND\n" +
+			"                             │           │   │   │   │   ├─ AND\n" +
+			"                             │           │   │   │   │   │   ├─ NOT\n" +
+			"                             │           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │           │   │   │   │   │   └─ Eq\n" +
+			"                             │           │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                             │           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                             │           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   │   │   └─ AND\n" +
+			"                             │           │   │   │       ├─ AND\n" +
+			"                             │           │   │   │       │   ├─ NOT\n" +
+			"                             │           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                             │           │   │   │       │   └─ InSubquery\n" +
+			"                             │           │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │   │   │       │       └─ right: Subquery\n" +
+			"                             │           │   │   │       │           ├─ cacheable: false\n" +
+			"                             │           │   │   │       │           └─ Project\n" +
+			"                             │           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                             │           │   │   │       │               └─ Filter\n" +
+			"                             │           │   │   │       │                   ├─ Eq\n" +
+			"                             │           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                             │           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                             │           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"                             │           │   │   │       │                       └─ Table\n" +
+			"                             │           │   │   │       │                           ├─ name: NOXN3\n" +
+			"                             │           │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │   │   │       └─ NOT\n" +
+			"                             │           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   │   └─ AND\n" +
+			"                             │           │   │       ├─ AND\n" +
+			"                             │           │   │       │   ├─ NOT\n" +
+			"                             │           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │           │   │       │   └─ InSubquery\n" +
+			"                             │           │   │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │   │       │       └─ right: Subquery\n" +
+			"                             │           │   │       │           ├─ cacheable: false\n" +
+			"                             │           │   │       │           └─ Project\n" +
+			"                             │           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                             │           │   │       │               └─ Filter\n" +
+			"                             │           │   │       │                   ├─ Eq\n" +
+			"                             │           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                             │           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                             │           │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                             │           │   │       │                       └─ Table\n" +
+			"                             │           │   │       │                           ├─ name: NOXN3\n" +
+			"                             │           │   │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           │   └─ AND\n" +
+			"                             │           │       ├─ AND\n" +
+			"                             │           │       │   ├─ NOT\n" +
+			"                             │           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                             │           │       │   └─ InSubquery\n" +
+			"                             │           │       │       ├─ left: sn.id:10!null\n" +
+			"                             │           │       │       └─ right: Subquery\n" +
+			"                             │           │       │           ├─ cacheable: false\n" +
+			"                             │           │       │           └─ Project\n" +
+			"                             │           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                             │           │       │               └─ Filter\n" +
+			"                             │           │       │                   ├─ Eq\n" +
+			"                             │           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                             │           │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                             │           │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                             │           │       │                       └─ Table\n" +
+			"                             │           │       │                           ├─ name: NOXN3\n" +
+			"                             │           │       │                           └─ columns: [id brqp2]\n" +
+			"                             │           │       └─ NOT\n" +
+			"                             │           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                             │           ├─ LookupJoin\n" +
+			"                             │           │   ├─ Eq\n" +
+			"                             │           │   │   ├─ aac.id:7!null\n" +
+			"                             │           │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                             │           │   ├─ SubqueryAlias\n" +
+			"                             │           │   │   ├─ name: MJR3D\n" +
+			"                             │           │   │   ├─ outerVisibility: false\n" +
+			"                             │           │   │   ├─ cacheable: true\n" +
+			"                             │           │   │   └─ Distinct\n" +
+			"                             │           │   │       └─ Project\n" +
+			"                             │           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                             │           │   │           └─ Filter\n" +
+			"                             │           │   │               ├─ Or\n" +
+			"                             │           │   │               │   ├─ NOT\n" +
+			"                             │           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                             │           │   │               │   └─ NOT\n" +
+			"                             │           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                             │           │   │               └─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   ├─ AND\n" +
+			"                             │           │   │                   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                             │           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                             │           │   │                   │   └─ Eq\n" +
+			"                             │           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                             │           │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   ├─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   │   ├─ AND\n" +
+			"                             │           │   │                   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                             │           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   │   │   └─ Eq\n" +
+			"                             │           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                             │           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                             │           │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                             │           │   │                   │   │   ├─ AND\n" +
+			"                             │           │   │                   │   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                             │           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                             │           │   │                   │   │   │   └─ NOT\n" +
+			"                             │           │   │                   │   │   │       └─ Eq\n" +
+			"                             │           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                             │           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                             │           │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"                             │           │   │                   │   │   │   ├─ Eq\n" +
+			"                             │           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                             │           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                             │           │   │                   │   │   │   ├─ MergeJoin\n" +
+			"                             │           │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"                             │           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                             │           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                             │           │   │                   │   │   │   │   ├─ Filter\n" +
+			"                             │           │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                             │           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                             │           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"                             │           │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"                             │           │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"                             │           │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                             │           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"                             │           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"                             │           │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   │   └─ HashLookup\n" +
+			"                             │           │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
+			"                             │           │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
+			"                             │           │   │                   │   │   │       └─ CachedResults\n" +
+			"                             │           │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
+			"                             │           │   │                   │   │   │               └─ Table\n" +
+			"                             │           │   │                   │   │   │                   ├─ name: WGSDC\n" +
+			"                             │           │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                             │           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"                             │           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                             │           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                             │           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                             │           │   │                   │   └─ HashLookup\n" +
+			"                             │           │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
+			"                             │           │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
+			"                             │           │   │                   │       └─ CachedResults\n" +
+			"                             │           │   │                   │           └─ TableAlias(YQIF4)\n" +
+			"                             │           │   │                   │               └─ Table\n" +
+			"                             │           │   │                   │                   ├─ name: NOXN3\n" +
+			"                             │           │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                             │           │   │                   └─ HashLookup\n" +
+			"                             │           │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
+			"                             │           │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
+			"                             │           │   │                       └─ CachedResults\n" +
+			"                             │           │   │                           └─ TableAlias(YVHJZ)\n" +
+			"                             │           │   │                               └─ Table\n" +
+			"                             │           │   │                                   ├─ name: NOXN3\n" +
+			"                             │           │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                             │           │   └─ TableAlias(aac)\n" +
+			"                             │           │       └─ IndexedTableAccess(TPXBU)\n" +
+			"                             │           │           ├─ index: [TPXBU.id]\n" +
+			"                             │           │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                             │           └─ TableAlias(sn)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: NOXN3\n" +
+			"                             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ right-key: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                 ├─ left-key: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                 ├─ right-key: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
 			"                                 └─ CachedResults\n" +
 			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
+			"                                         ├─ name: cld\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
-			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterHashJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ MergeJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ cmp: Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ HashLookup\n" +
-			"                                                 │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:14)\n" +
-			"                                                 │   │                   │   │   │       ├─ right-key: TUPLE(NHMXW.id:0!null)\n" +
-			"                                                 │   │                   │   │   │       └─ CachedResults\n" +
-			"                                                 │   │                   │   │   │           └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │               └─ Table\n" +
-			"                                                 │   │                   │   │   │                   ├─ name: WGSDC\n" +
-			"                                                 │   │                   │   │   │                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ HashLookup\n" +
-			"                                                 │   │                   │       ├─ left-key: TUPLE(ism.FV24E:9!null, ism.UJ6XY:10!null)\n" +
-			"                                                 │   │                   │       ├─ right-key: TUPLE(YQIF4.BRQP2:1!null, YQIF4.FFTBJ:2!null)\n" +
-			"                                                 │   │                   │       └─ CachedResults\n" +
-			"                                                 │   │                   │           └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │               └─ Table\n" +
-			"                                                 │   │                   │                   ├─ name: NOXN3\n" +
-			"                                                 │   │                   │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ HashLookup\n" +
-			"                                                 │   │                       ├─ left-key: TUPLE(ism.UJ6XY:10!null, ism.FV24E:9!null)\n" +
-			"                                                 │   │                       ├─ right-key: TUPLE(YVHJZ.BRQP2:1!null, YVHJZ.FFTBJ:2!null)\n" +
-			"                                                 │   │                       └─ CachedResults\n" +
-			"                                                 │   │                           └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                               └─ Table\n" +
-			"                                                 │   │                                   ├─ name: NOXN3\n" +
-			"                                                 │   │                                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                             ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:0!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
+			"                                             └─ HashJoin\n" +
+			"                                                 ├─ Eq\n" +
+			"                                                 │   ├─ bs.id:7!null\n" +
+			"                                                 │   └─ mf.GXLUB:2!null\n" +
+			"                                                 ├─ MergeJoin\n" +
+			"                                                 │   ├─ cmp: Eq\n" +
+			"                                                 │   │   ├─ sn.BRQP2:1!null\n" +
+			"                                                 │   │   └─ mf.LUEVY:3!null\n" +
+			"                                                 │   ├─ TableAlias(sn)\n" +
+			"                                                 │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"                                                 │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                                 │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                 │   │       └─ columns: [id brqp2]\n" +
+			"                                                 │   └─ TableAlias(mf)\n" +
+			"                                                 │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                                 │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"                                                 │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                 │           └─ columns: [gxlub luevy m22qn]\n" +
+			"                                                 └─ HashLookup\n" +
+			"                                                     ├─ left-key: TUPLE(mf.GXLUB:2!null)\n" +
+			"                                                     ├─ right-key: TUPLE(bs.id:2!null)\n" +
+			"                                                     └─ CachedResults\n" +
+			"                                                         └─ MergeJoin\n" +
+			"                                                             ├─ cmp: Eq\n" +
+			"                                                             │   ├─ cla.id:5!null\n" +
+			"                                                             │   └─ bs.IXUXU:8\n" +
+			"                                                             ├─ Filter\n" +
+			"                                                             │   ├─ Eq\n" +
+			"                                                             │   │   ├─ cla.FTQLQ:1!null\n" +
+			"                                                             │   │   └─ SQ1 (longtext)\n" +
+			"                                                             │   └─ TableAlias(cla)\n" +
+			"                                                             │       └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                             │           ├─ index: [YK2GW.id]\n" +
+			"                                                             │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                             │           └─ columns: [id ftqlq]\n" +
+			"                                                             └─ TableAlias(bs)\n" +
+			"                                                                 └─ IndexedTableAccess(THNTS)\n" +
+			"                                                                     ├─ index: [THNTS.IXUXU]\n" +
+			"                                                                     ├─ static: [{[NULL, ∞)}]\n" +
+			"                                                                     └─ columns: [id ixuxu]\n" +
 			"",
 	},
 	{
@@ -15792,37 +15799,31 @@ INNER JOIN
    ON XJ2RD.HHVLX = TUSAY.XLFIA
 ORDER BY Y46B2 ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [TUSAY.Y3IOU:0!null as Q7H3X]\n" +
-			" └─ Sort(XJ2RD.Y46B2:2!null ASC nullsFirst)\n" +
+			" ├─ columns: [TUSAY.Y3IOU:3!null as Q7H3X]\n" +
+			" └─ Sort(QYWQD.id:0!null ASC nullsFirst)\n" +
 			"     └─ HashJoin\n" +
 			"         ├─ Eq\n" +
-			"         │   ├─ XJ2RD.HHVLX:3!null\n" +
-			"         │   └─ TUSAY.XLFIA:1!null\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: TUSAY\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, XLFIA:1!null]\n" +
-			"         │       └─ Window\n" +
-			"         │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"         │           ├─ NOXN3.id:0!null as XLFIA\n" +
-			"         │           └─ Table\n" +
-			"         │               ├─ name: NOXN3\n" +
-			"         │               └─ columns: [id]\n" +
+			"         │   ├─ QYWQD.HHVLX:1!null\n" +
+			"         │   └─ TUSAY.XLFIA:4!null\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: QYWQD\n" +
+			"         │   └─ columns: [id hhvlx hvhrz]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(TUSAY.XLFIA:1!null)\n" +
-			"             ├─ right-key: TUPLE(XJ2RD.HHVLX:1!null)\n" +
+			"             ├─ left-key: TUPLE(QYWQD.HHVLX:1!null)\n" +
+			"             ├─ right-key: TUPLE(TUSAY.XLFIA:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: XJ2RD\n" +
+			"                     ├─ name: TUSAY\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [QYWQD.id:0!null as Y46B2, QYWQD.HHVLX:1!null as HHVLX, QYWQD.HVHRZ:2!null as HVHRZ]\n" +
-			"                         └─ Table\n" +
-			"                             ├─ name: QYWQD\n" +
-			"                             └─ columns: [id hhvlx hvhrz]\n" +
+			"                         ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, XLFIA:1!null]\n" +
+			"                         └─ Window\n" +
+			"                             ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                             ├─ NOXN3.id:0!null as XLFIA\n" +
+			"                             └─ Table\n" +
+			"                                 ├─ name: NOXN3\n" +
+			"                                 └─ columns: [id]\n" +
 			"",
 	},
 	{
@@ -15884,36 +15885,22 @@ ORDER BY sn.XLFIA ASC`,
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
-			"                         ├─ columns: [nd.LUEVY:0!null, CASE  WHEN Eq\n" +
-			"                         │   ├─ nma.DZLIM:3\n" +
+			"                         ├─ columns: [E2I7U.id:0!null as LUEVY, CASE  WHEN Eq\n" +
+			"                         │   ├─ TNMXI.DZLIM:3!null\n" +
 			"                         │   └─ Q5I4E (longtext)\n" +
 			"                         │   THEN 1 (tinyint) ELSE 0 (tinyint) END as R2SR7]\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ Eq\n" +
-			"                             │   ├─ nd.HPCMS:1!null\n" +
-			"                             │   └─ nma.MLECF:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: nd\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [E2I7U.id:0!null as LUEVY, E2I7U.HPCMS:1!null as HPCMS]\n" +
-			"                             │       └─ Table\n" +
-			"                             │           ├─ name: E2I7U\n" +
-			"                             │           └─ columns: [id hpcms]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ left-key: TUPLE(nd.HPCMS:1!null)\n" +
-			"                                 ├─ right-key: TUPLE(nma.MLECF:0!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: nma\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [TNMXI.id:0!null as MLECF, TNMXI.DZLIM:1!null]\n" +
-			"                                             └─ Table\n" +
-			"                                                 ├─ name: TNMXI\n" +
-			"                                                 └─ columns: [id dzlim]\n" +
+			"                         └─ LeftOuterMergeJoin\n" +
+			"                             ├─ cmp: Eq\n" +
+			"                             │   ├─ E2I7U.HPCMS:1!null\n" +
+			"                             │   └─ TNMXI.id:2!null\n" +
+			"                             ├─ IndexedTableAccess(E2I7U)\n" +
+			"                             │   ├─ index: [E2I7U.HPCMS]\n" +
+			"                             │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                             │   └─ columns: [id hpcms]\n" +
+			"                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                 ├─ index: [TNMXI.id]\n" +
+			"                                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                                 └─ columns: [id dzlim]\n" +
 			"",
 	},
 	{
@@ -15936,22 +15923,16 @@ LEFT JOIN
 ORDER BY GRRB6.XLFIA ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [QI2IE.DICQO:2 as DICQO]\n" +
-			" └─ Sort(GRRB6.XLFIA:0!null ASC nullsFirst)\n" +
+			" └─ Sort(NOXN3.id:0!null ASC nullsFirst)\n" +
 			"     └─ LeftOuterHashJoin\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ QI2IE.VIBZI:3!null\n" +
-			"         │   └─ GRRB6.AHMDT:1!null\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: GRRB6\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Project\n" +
-			"         │       ├─ columns: [NOXN3.id:0!null as XLFIA, NOXN3.BRQP2:1!null as AHMDT]\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: NOXN3\n" +
-			"         │           └─ columns: [id brqp2]\n" +
+			"         │   └─ NOXN3.BRQP2:1!null\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: NOXN3\n" +
+			"         │   └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
-			"             ├─ left-key: TUPLE(GRRB6.AHMDT:1!null)\n" +
+			"             ├─ left-key: TUPLE(NOXN3.BRQP2:1!null)\n" +
 			"             ├─ right-key: TUPLE(QI2IE.VIBZI:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
@@ -16535,18 +16516,18 @@ FROM
 WHERE NUMK2 = 4
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [sn.Y3IOU:0!null, sn.ECDKM:3]\n" +
+			" ├─ columns: [sn.Y3IOU:0!null, sn.ECDKM:2]\n" +
 			" └─ Sort(sn.id:1!null ASC nullsFirst)\n" +
 			"     └─ SubqueryAlias\n" +
 			"         ├─ name: sn\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
-			"         └─ Filter\n" +
-			"             ├─ Eq\n" +
-			"             │   ├─ NOXN3.NUMK2:2!null\n" +
-			"             │   └─ 4 (tinyint)\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, NOXN3.id:1!null, NOXN3.NUMK2:2!null, NOXN3.ECDKM:3]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [row_number() over ( order by NOXN3.id ASC):0!null as Y3IOU, NOXN3.id:1!null, NOXN3.ECDKM:3]\n" +
+			"             └─ Filter\n" +
+			"                 ├─ Eq\n" +
+			"                 │   ├─ NOXN3.NUMK2:2!null\n" +
+			"                 │   └─ 4 (tinyint)\n" +
 			"                 └─ Window\n" +
 			"                     ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			"                     ├─ NOXN3.id:0!null\n" +
@@ -16978,51 +16959,45 @@ FROM
 			"             ├─ Project\n" +
 			"             │   ├─ columns: [id:0!null, WNUNU:1!null, HHVLX:2!null, HVHRZ:3!null, YKSSU:4, FHCYT:5]\n" +
 			"             │   └─ Project\n" +
-			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, ITWML.DRIWM:0!null as WNUNU, ITWML.JIEVY:1!null as HHVLX, 1 (decimal(2,1)) as HVHRZ, NULL (null) as YKSSU, NULL (null) as FHCYT]\n" +
-			"             │       └─ SubqueryAlias\n" +
-			"             │           ├─ name: ITWML\n" +
-			"             │           ├─ outerVisibility: false\n" +
-			"             │           ├─ cacheable: true\n" +
-			"             │           └─ Project\n" +
-			"             │               ├─ columns: [sn.id:0!null as DRIWM, SKPM6.id:4!null as JIEVY, sn.ECDKM:2 as HVHRZ]\n" +
-			"             │               └─ Filter\n" +
-			"             │                   ├─ AND\n" +
-			"             │                   │   ├─ rn.WNUNU:6 IS NULL\n" +
-			"             │                   │   └─ rn.HHVLX:7 IS NULL\n" +
-			"             │                   └─ LeftOuterHashJoin\n" +
-			"             │                       ├─ AND\n" +
-			"             │                       │   ├─ Eq\n" +
-			"             │                       │   │   ├─ rn.WNUNU:6!null\n" +
-			"             │                       │   │   └─ sn.id:0!null\n" +
-			"             │                       │   └─ Eq\n" +
-			"             │                       │       ├─ rn.HHVLX:7!null\n" +
-			"             │                       │       └─ SKPM6.id:4!null\n" +
-			"             │                       ├─ MergeJoin\n" +
-			"             │                       │   ├─ cmp: Eq\n" +
-			"             │                       │   │   ├─ sn.FFTBJ:1!null\n" +
-			"             │                       │   │   └─ SKPM6.BRQP2:5!null\n" +
-			"             │                       │   ├─ Filter\n" +
-			"             │                       │   │   ├─ Eq\n" +
-			"             │                       │   │   │   ├─ sn.NUMK2:3!null\n" +
-			"             │                       │   │   │   └─ 1 (tinyint)\n" +
-			"             │                       │   │   └─ TableAlias(sn)\n" +
-			"             │                       │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       │   │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"             │                       │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │   │           └─ columns: [id fftbj ecdkm numk2]\n" +
-			"             │                       │   └─ TableAlias(SKPM6)\n" +
-			"             │                       │       └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       │           ├─ index: [NOXN3.BRQP2]\n" +
-			"             │                       │           ├─ static: [{[NULL, ∞)}]\n" +
-			"             │                       │           └─ columns: [id brqp2]\n" +
-			"             │                       └─ HashLookup\n" +
-			"             │                           ├─ left-key: TUPLE(sn.id:0!null, SKPM6.id:4!null)\n" +
-			"             │                           ├─ right-key: TUPLE(rn.WNUNU:0!null, rn.HHVLX:1!null)\n" +
-			"             │                           └─ CachedResults\n" +
-			"             │                               └─ TableAlias(rn)\n" +
-			"             │                                   └─ Table\n" +
-			"             │                                       ├─ name: QYWQD\n" +
-			"             │                                       └─ columns: [wnunu hhvlx]\n" +
+			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, sn.id:0!null as WNUNU, SKPM6.id:4!null as HHVLX, 1 (decimal(2,1)) as HVHRZ, NULL (null) as YKSSU, NULL (null) as FHCYT]\n" +
+			"             │       └─ Filter\n" +
+			"             │           ├─ AND\n" +
+			"             │           │   ├─ rn.WNUNU:6 IS NULL\n" +
+			"             │           │   └─ rn.HHVLX:7 IS NULL\n" +
+			"             │           └─ LeftOuterHashJoin\n" +
+			"             │               ├─ AND\n" +
+			"             │               │   ├─ Eq\n" +
+			"             │               │   │   ├─ rn.WNUNU:6!null\n" +
+			"             │               │   │   └─ sn.id:0!null\n" +
+			"             │               │   └─ Eq\n" +
+			"             │               │       ├─ rn.HHVLX:7!null\n" +
+			"             │               │       └─ SKPM6.id:4!null\n" +
+			"             │               ├─ MergeJoin\n" +
+			"             │               │   ├─ cmp: Eq\n" +
+			"             │               │   │   ├─ sn.FFTBJ:1!null\n" +
+			"             │               │   │   └─ SKPM6.BRQP2:5!null\n" +
+			"             │               │   ├─ Filter\n" +
+			"             │               │   │   ├─ Eq\n" +
+			"             │               │   │   │   ├─ sn.NUMK2:3!null\n" +
+			"             │               │   │   │   └─ 1 (tinyint)\n" +
+			"             │               │   │   └─ TableAlias(sn)\n" +
+			"             │               │   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"             │               │   │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"             │               │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"             │               │   │           └─ columns: [id fftbj ecdkm numk2]\n" +
+			"             │               │   └─ TableAlias(SKPM6)\n" +
+			"             │               │       └─ IndexedTableAccess(NOXN3)\n" +
+			"             │               │           ├─ index: [NOXN3.BRQP2]\n" +
+			"             │               │           ├─ static: [{[NULL, ∞)}]\n" +
+			"             │               │           └─ columns: [id brqp2]\n" +
+			"             │               └─ HashLookup\n" +
+			"             │                   ├─ left-key: TUPLE(sn.id:0!null, SKPM6.id:4!null)\n" +
+			"             │                   ├─ right-key: TUPLE(rn.WNUNU:0!null, rn.HHVLX:1!null)\n" +
+			"             │                   └─ CachedResults\n" +
+			"             │                       └─ TableAlias(rn)\n" +
+			"             │                           └─ Table\n" +
+			"             │                               ├─ name: QYWQD\n" +
+			"             │                               └─ columns: [wnunu hhvlx]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 └─ IF BLOCK\n" +
 			"                     └─ IF(NOT\n" +
@@ -18262,31 +18237,25 @@ INNER JOIN D34QP vc ON C6PUD.AZ6SP LIKE CONCAT(CONCAT('%', vc.TWMSR), '%')`,
 			"     └─ Project\n" +
 			"         ├─ columns: [id:0!null, Z7CP5:1!null, YH4XB:2!null]\n" +
 			"         └─ Project\n" +
-			"             ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, C6PUD.id:0!null as Z7CP5, vc.id:2!null as YH4XB]\n" +
+			"             ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, mf.id:0!null as Z7CP5, vc.id:4!null as YH4XB]\n" +
 			"             └─ InnerJoin\n" +
-			"                 ├─ C6PUD.AZ6SP LIKE concat(concat('%',vc.TWMSR),'%')\n" +
-			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: C6PUD\n" +
-			"                 │   ├─ outerVisibility: false\n" +
-			"                 │   ├─ cacheable: true\n" +
-			"                 │   └─ Project\n" +
-			"                 │       ├─ columns: [mf.id:0!null as id, umf.AZ6SP:3 as AZ6SP]\n" +
-			"                 │       └─ LookupJoin\n" +
-			"                 │           ├─ Eq\n" +
-			"                 │           │   ├─ umf.id:2!null\n" +
-			"                 │           │   └─ mf.TEUJA:1\n" +
-			"                 │           ├─ TableAlias(mf)\n" +
-			"                 │           │   └─ Table\n" +
-			"                 │           │       ├─ name: HGMQ6\n" +
-			"                 │           │       └─ columns: [id teuja]\n" +
-			"                 │           └─ Filter\n" +
-			"                 │               ├─ HashIn\n" +
-			"                 │               │   ├─ umf.id:0!null\n" +
-			"                 │               │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"                 │               └─ TableAlias(umf)\n" +
-			"                 │                   └─ IndexedTableAccess(NZKPM)\n" +
-			"                 │                       ├─ index: [NZKPM.id]\n" +
-			"                 │                       └─ columns: [id az6sp]\n" +
+			"                 ├─ AND\n" +
+			"                 │   ├─ umf.AZ6SP LIKE concat(concat('%',vc.TWMSR),'%')\n" +
+			"                 │   └─ IN\n" +
+			"                 │       ├─ left: umf.id:2!null\n" +
+			"                 │       └─ right: TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"                 ├─ LookupJoin\n" +
+			"                 │   ├─ Eq\n" +
+			"                 │   │   ├─ umf.id:2!null\n" +
+			"                 │   │   └─ mf.TEUJA:1\n" +
+			"                 │   ├─ TableAlias(mf)\n" +
+			"                 │   │   └─ Table\n" +
+			"                 │   │       ├─ name: HGMQ6\n" +
+			"                 │   │       └─ columns: [id teuja]\n" +
+			"                 │   └─ TableAlias(umf)\n" +
+			"                 │       └─ IndexedTableAccess(NZKPM)\n" +
+			"                 │           ├─ index: [NZKPM.id]\n" +
+			"                 │           └─ columns: [id az6sp]\n" +
 			"                 └─ TableAlias(vc)\n" +
 			"                     └─ Table\n" +
 			"                         ├─ name: D34QP\n" +
@@ -18410,14 +18379,8 @@ FROM
 			"         ├─ columns: [id:0!null, FV24E:1!null, UJ6XY:2!null, M22QN:3!null, NZ4MQ:4!null, ETPQV:5, PRUV2:6, YKSSU:7, FHCYT:8]\n" +
 			"         └─ Union distinct\n" +
 			"             ├─ Project\n" +
-			"             │   ├─ columns: [id:0!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ FV24E:1!null\n" +
-			"             │   │   as FV24E, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ UJ6XY:2!null\n" +
-			"             │   │   as UJ6XY, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
+			"             │   ├─ columns: [id:0!null, FV24E:1!null, UJ6XY:2!null, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, convert\n" +
+			"             │   │   ├─ type: varchar(24)\n" +
 			"             │   │   └─ PRUV2:6\n" +
 			"             │   │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
 			"             │   └─ Project\n" +
@@ -18527,131 +18490,126 @@ FROM
 			"             │                                               ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                                               └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [id:0!null, FV24E:1 as FV24E, UJ6XY:2 as UJ6XY, M22QN:3, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"                 │   ├─ type: char\n" +
-			"                 │   └─ PRUV2:6\n" +
-			"                 │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
-			"                     │   ├─ cacheable: false\n" +
-			"                     │   └─ Project\n" +
-			"                     │       ├─ columns: [aac.id:8!null]\n" +
-			"                     │       └─ Filter\n" +
-			"                     │           ├─ Eq\n" +
-			"                     │           │   ├─ aac.BTXC5:9\n" +
-			"                     │           │   └─ BPNW2.SYPKF:3\n" +
-			"                     │           └─ TableAlias(aac)\n" +
-			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                     │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"                     │                   └─ columns: [id btxc5]\n" +
-			"                     │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, BPNW2.I4NDZ:7 as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: BPNW2\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ Distinct\n" +
-			"                             └─ Project\n" +
-			"                                 ├─ columns: [TIZHK.id:0!null as MU3KG, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.FZXV5:15 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.FZXV5:15\n" +
-			"                                 │           └─ TableAlias(overridden_nd_mutant)\n" +
-			"                                 │               └─ IndexedTableAccess(E2I7U)\n" +
-			"                                 │                   ├─ index: [E2I7U.TW55N]\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.DQYGV:16 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.DQYGV:16\n" +
-			"                                 │           └─ TableAlias(overridden_QI2IEner)\n" +
+			"                 ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
+			"                 │   ├─ cacheable: false\n" +
+			"                 │   └─ Project\n" +
+			"                 │       ├─ columns: [aac.id:8!null]\n" +
+			"                 │       └─ Filter\n" +
+			"                 │           ├─ Eq\n" +
+			"                 │           │   ├─ aac.BTXC5:9\n" +
+			"                 │           │   └─ BPNW2.SYPKF:3\n" +
+			"                 │           └─ TableAlias(aac)\n" +
+			"                 │               └─ IndexedTableAccess(TPXBU)\n" +
+			"                 │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"                 │                   └─ columns: [id btxc5]\n" +
+			"                 │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, BPNW2.I4NDZ:7 as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: BPNW2\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [TIZHK.id:10!null as MU3KG, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.FZXV5:5 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.FZXV5:5\n" +
+			"                             │           └─ TableAlias(overridden_nd_mutant)\n" +
+			"                             │               └─ IndexedTableAccess(E2I7U)\n" +
+			"                             │                   ├─ index: [E2I7U.TW55N]\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.DQYGV:6 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.DQYGV:6\n" +
+			"                             │           └─ TableAlias(overridden_QI2IEner)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: E2I7U\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:13 as SYPKF, Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [G3YXS.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
+			"                             │           │   └─ TIZHK.IDUT2:14\n" +
+			"                             │           └─ TableAlias(G3YXS)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: YYBCX\n" +
+			"                             │                   └─ columns: [id esfvy sl76b]\n" +
+			"                             │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:0 as I4NDZ]\n" +
+			"                             └─ LeftOuterHashJoin\n" +
+			"                                 ├─ Eq\n" +
+			"                                 │   ├─ RHUZN.ZH72S:44\n" +
+			"                                 │   └─ TIZHK.ZHITY:12\n" +
+			"                                 ├─ LeftOuterHashJoin\n" +
+			"                                 │   ├─ Eq\n" +
+			"                                 │   │   ├─ J4JYP.ZH72S:27\n" +
+			"                                 │   │   └─ TIZHK.TVNW2:11\n" +
+			"                                 │   ├─ MergeJoin\n" +
+			"                                 │   │   ├─ cmp: Eq\n" +
+			"                                 │   │   │   ├─ NHMXW.NOHHR:1!null\n" +
+			"                                 │   │   │   └─ TIZHK.TVNW2:11\n" +
+			"                                 │   │   ├─ sel: AND\n" +
+			"                                 │   │   │   ├─ AND\n" +
+			"                                 │   │   │   │   ├─ AND\n" +
+			"                                 │   │   │   │   │   ├─ Eq\n" +
+			"                                 │   │   │   │   │   │   ├─ NHMXW.SWCQV:7!null\n" +
+			"                                 │   │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"                                 │   │   │   │   │   └─ Eq\n" +
+			"                                 │   │   │   │   │       ├─ NHMXW.AVPYF:2!null\n" +
+			"                                 │   │   │   │   │       └─ TIZHK.ZHITY:12\n" +
+			"                                 │   │   │   │   └─ Eq\n" +
+			"                                 │   │   │   │       ├─ NHMXW.SYPKF:3!null\n" +
+			"                                 │   │   │   │       └─ TIZHK.SYPKF:13\n" +
+			"                                 │   │   │   └─ Eq\n" +
+			"                                 │   │   │       ├─ NHMXW.IDUT2:4!null\n" +
+			"                                 │   │   │       └─ TIZHK.IDUT2:14\n" +
+			"                                 │   │   ├─ Filter\n" +
+			"                                 │   │   │   ├─ NOT\n" +
+			"                                 │   │   │   │   └─ NHMXW.id:0 IS NULL\n" +
+			"                                 │   │   │   └─ TableAlias(NHMXW)\n" +
+			"                                 │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
+			"                                 │   │   │           ├─ index: [WGSDC.NOHHR]\n" +
+			"                                 │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                 │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                 │   │   └─ Filter\n" +
+			"                                 │   │       ├─ HashIn\n" +
+			"                                 │   │       │   ├─ TIZHK.id:0!null\n" +
+			"                                 │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"                                 │   │       └─ TableAlias(TIZHK)\n" +
+			"                                 │   │           └─ IndexedTableAccess(WRZVO)\n" +
+			"                                 │   │               ├─ index: [WRZVO.TVNW2]\n" +
+			"                                 │   │               ├─ static: [{[NULL, ∞)}]\n" +
+			"                                 │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"                                 │   └─ HashLookup\n" +
+			"                                 │       ├─ left-key: TUPLE(TIZHK.TVNW2:11)\n" +
+			"                                 │       ├─ right-key: TUPLE(J4JYP.ZH72S:7)\n" +
+			"                                 │       └─ CachedResults\n" +
+			"                                 │           └─ TableAlias(J4JYP)\n" +
 			"                                 │               └─ Table\n" +
 			"                                 │                   ├─ name: E2I7U\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:3 as SYPKF, Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [G3YXS.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
-			"                                 │           │   └─ TIZHK.IDUT2:4\n" +
-			"                                 │           └─ TableAlias(G3YXS)\n" +
-			"                                 │               └─ Table\n" +
-			"                                 │                   ├─ name: YYBCX\n" +
-			"                                 │                   └─ columns: [id esfvy sl76b]\n" +
-			"                                 │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:10 as I4NDZ]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ NHMXW.id:10 IS NULL\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ RHUZN.ZH72S:44\n" +
-			"                                         │   └─ TIZHK.ZHITY:2\n" +
-			"                                         ├─ LeftOuterHashJoin\n" +
-			"                                         │   ├─ Eq\n" +
-			"                                         │   │   ├─ J4JYP.ZH72S:27\n" +
-			"                                         │   │   └─ TIZHK.TVNW2:1\n" +
-			"                                         │   ├─ LeftOuterMergeJoin\n" +
-			"                                         │   │   ├─ cmp: Eq\n" +
-			"                                         │   │   │   ├─ TIZHK.TVNW2:1\n" +
-			"                                         │   │   │   └─ NHMXW.NOHHR:11!null\n" +
-			"                                         │   │   ├─ sel: AND\n" +
-			"                                         │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   │   ├─ Eq\n" +
-			"                                         │   │   │   │   │   │   ├─ NHMXW.SWCQV:17!null\n" +
-			"                                         │   │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"                                         │   │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │   │       ├─ NHMXW.AVPYF:12!null\n" +
-			"                                         │   │   │   │   │       └─ TIZHK.ZHITY:2\n" +
-			"                                         │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │       ├─ NHMXW.SYPKF:13!null\n" +
-			"                                         │   │   │   │       └─ TIZHK.SYPKF:3\n" +
-			"                                         │   │   │   └─ Eq\n" +
-			"                                         │   │   │       ├─ NHMXW.IDUT2:14!null\n" +
-			"                                         │   │   │       └─ TIZHK.IDUT2:4\n" +
-			"                                         │   │   ├─ Filter\n" +
-			"                                         │   │   │   ├─ HashIn\n" +
-			"                                         │   │   │   │   ├─ TIZHK.id:0!null\n" +
-			"                                         │   │   │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"                                         │   │   │   └─ TableAlias(TIZHK)\n" +
-			"                                         │   │   │       └─ IndexedTableAccess(WRZVO)\n" +
-			"                                         │   │   │           ├─ index: [WRZVO.TVNW2]\n" +
-			"                                         │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │   │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"                                         │   │   └─ TableAlias(NHMXW)\n" +
-			"                                         │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                         │   │           ├─ index: [WGSDC.NOHHR]\n" +
-			"                                         │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                         │   └─ HashLookup\n" +
-			"                                         │       ├─ left-key: TUPLE(TIZHK.TVNW2:1)\n" +
-			"                                         │       ├─ right-key: TUPLE(J4JYP.ZH72S:7)\n" +
-			"                                         │       └─ CachedResults\n" +
-			"                                         │           └─ TableAlias(J4JYP)\n" +
-			"                                         │               └─ Table\n" +
-			"                                         │                   ├─ name: E2I7U\n" +
-			"                                         │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ left-key: TUPLE(TIZHK.ZHITY:2)\n" +
-			"                                             ├─ right-key: TUPLE(RHUZN.ZH72S:7)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(RHUZN)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: E2I7U\n" +
-			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                 │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                 └─ HashLookup\n" +
+			"                                     ├─ left-key: TUPLE(TIZHK.ZHITY:12)\n" +
+			"                                     ├─ right-key: TUPLE(RHUZN.ZH72S:7)\n" +
+			"                                     └─ CachedResults\n" +
+			"                                         └─ TableAlias(RHUZN)\n" +
+			"                                             └─ Table\n" +
+			"                                                 ├─ name: E2I7U\n" +
+			"                                                 └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
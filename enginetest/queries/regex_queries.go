@@ -103,6 +103,50 @@ var RegexTests = []RegexTest{
 		Query:    `SELECT REGEXP_REPLACE(CONCAT("abc123"), "[0-4]", "X")`,
 		Expected: []sql.Row{{"abcXXX"}},
 	},
+	{
+		Query:    `SELECT REGEXP_REPLACE("ñaña😀aña", "a", "X", 5)`,
+		Expected: []sql.Row{{"ñaña😀XñX"}},
+	},
+	{
+		Query:    `SELECT REGEXP_REPLACE("TEST test" COLLATE utf8mb4_0900_ai_ci, "[a-z]", "X", 1, 0, "m")`,
+		Expected: []sql.Row{{"XXXX XXXX"}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("abc def ghi", "[a-z]+"), REGEXP_SUBSTR("abc def ghi", "[a-z]+", 1, 3), REGEXP_SUBSTR("abc def ghi", "[a-z]+", 6)`,
+		Expected: []sql.Row{{"abc", "ghi", "ef"}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("abc def", "[a-z]+", 1, 3), REGEXP_SUBSTR(NULL, "a"), REGEXP_SUBSTR("abc", NULL), REGEXP_SUBSTR("abc", "b", NULL)`,
+		Expected: []sql.Row{{nil, nil, nil, nil}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("ABC def", "[a-z]+", 1, 1, "i"), REGEXP_SUBSTR("ABC def" COLLATE utf8mb4_0900_ai_ci, "[a-z]+"), REGEXP_SUBSTR("ABC def" COLLATE utf8mb4_0900_ai_ci, "[a-z]+", 1, 1, "c")`,
+		Expected: []sql.Row{{"ABC", "ABC", "def"}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("xfoo foo", "\\bfoo\\b"), REGEXP_SUBSTR("12ab3", "[[:alpha:]]+")`,
+		Expected: []sql.Row{{"foo", "ab"}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("dog cat dog", "dog"), REGEXP_INSTR("dog cat dog", "dog", 2), REGEXP_INSTR("dog cat dog", "dog", 1, 2), REGEXP_INSTR("dog cat dog", "dog", 1, 3)`,
+		Expected: []sql.Row{{1, 9, 9, 0}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("aa aaa aaaa", "a{4}", 1, 1, 0), REGEXP_INSTR("aa aaa aaaa", "a{4}", 1, 1, 1), REGEXP_INSTR("😀ñ dog", "dog")`,
+		Expected: []sql.Row{{8, 12, 4}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("Dog", "dog", 1, 1, 0, "i"), REGEXP_INSTR(NULL, "dog"), REGEXP_INSTR("dog", "dog", 1, 1, NULL)`,
+		Expected: []sql.Row{{1, nil, nil}},
+	},
+	{
+		Query:       `SELECT REGEXP_INSTR("dog", "dog", 1, 1, 2)`,
+		ExpectedErr: sql.ErrInvalidArgumentDetails,
+	},
+	{
+		Query:       `SELECT REGEXP_SUBSTR("dog", "dog", 4)`,
+		ExpectedErr: sql.ErrInvalidArgumentDetails,
+	},
 	{
 		Query: `SELECT * FROM mytable WHERE s LIKE REGEXP_REPLACE("123456%r1o2w", "[0-9]", "")`,
 		Expected: []sql.Row{
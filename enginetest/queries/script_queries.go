@@ -17,6 +17,7 @@ package queries
 import (
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	gmstime "github.com/dolthub/go-mysql-server/internal/time"
@@ -657,6 +658,47 @@ var ScriptTests = []ScriptTest{
 				Query:    `SELECT BIN_TO_UUID(@binuuid)`,
 				Expected: []sql.Row{{"30303131-3232-3333-3434-353536363737"}},
 			},
+			{
+				Query:    `SELECT HEX(UUID_TO_BIN(@uuid, 2)), HEX(UUID_TO_BIN(@uuid, NULL)), BIN_TO_UUID(UUID_TO_BIN(@uuid, TRUE), 2), BIN_TO_UUID(UUID_TO_BIN(@uuid), NULL)`,
+				Expected: []sql.Row{{"1026BABA6CCD780C95645B8C656024DB", "6CCD780CBABA102695645B8C656024DB", "6ccd780c-baba-1026-9564-5b8c656024db", "6ccd780c-baba-1026-9564-5b8c656024db"}},
+			},
+			{
+				Query:    `SELECT IS_UUID(CONCAT('{', @uuid, '}')), IS_UUID(REPLACE(@uuid, '-', '')), IS_UUID(CONCAT('urn:uuid:', @uuid))`,
+				Expected: []sql.Row{{int8(1), int8(1), int8(0)}},
+			},
+			{
+				Query:       `SELECT UUID_TO_BIN(CONCAT('urn:uuid:', @uuid))`,
+				ExpectedErr: sql.ErrUuidUnableToParse,
+			},
+			{
+				Query:       `SELECT BIN_TO_UUID(X'00112233445566778899aabbccddeeff00')`,
+				ExpectedErr: sql.ErrUuidUnableToParse,
+			},
+		},
+	},
+	{
+		Name: "UUID keys in BINARY(16) columns",
+		SetUpScript: []string{
+			"CREATE TABLE uuids (id BINARY(16) PRIMARY KEY DEFAULT (UUID_TO_BIN(UUID(), 1)), v int);",
+			"INSERT INTO uuids (v) VALUES (1);",
+			"INSERT INTO uuids (v) VALUES (2);",
+			"INSERT INTO uuids (v) VALUES (3);",
+			"INSERT INTO uuids VALUES (UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1), 0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// swapped version 1 UUIDs are ordered by the time they were generated
+				Query:    "SELECT v FROM uuids ORDER BY id;",
+				Expected: []sql.Row{{0}, {1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT v, BIN_TO_UUID(id, 1) FROM uuids WHERE id = UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1);",
+				Expected: []sql.Row{{0, "6ccd780c-baba-1026-9564-5b8c656024db"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM uuids WHERE IS_UUID(BIN_TO_UUID(id, 1)) AND BIN_TO_UUID(id) <> BIN_TO_UUID(id, 1);",
+				Expected: []sql.Row{{4}},
+			},
 		},
 	},
 	{
@@ -2629,6 +2671,297 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "signed and unsigned integer arithmetic",
+		SetUpScript: []string{
+			"CREATE TABLE ints (pk int primary key, u bigint unsigned, i bigint, t tinyint unsigned);",
+			"INSERT INTO ints VALUES (1, 1, -3, 0), (2, 18446744073709551615, 9223372036854775807, 255);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, u - 1, u - i, i - 1, t + t FROM ints WHERE pk = 2;",
+				Expected: []sql.Row{{2, uint64(18446744073709551614), uint64(9223372036854775808), int64(9223372036854775806), uint64(510)}},
+			},
+			{
+				Query:       "SELECT u - 2 FROM ints WHERE pk = 1;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:       "SELECT t - 1 FROM ints WHERE pk = 1;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:       "SELECT u + i FROM ints WHERE pk = 1;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:    "SELECT CAST(u AS SIGNED) - 2, u + 2 - 2 FROM ints WHERE pk = 1;",
+				Expected: []sql.Row{{int64(-1), uint64(1)}},
+			},
+			{
+				Query:       "SELECT i * 2 FROM ints WHERE pk = 2;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:       "SELECT 18446744073709551615 + 1;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:       "SELECT -9223372036854775807 - 2;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:    "SELECT u DIV 1, u DIV 2, u / 1, 18446744073709551615 DIV 1 FROM ints WHERE pk = 2;",
+				Expected: []sql.Row{{uint64(18446744073709551615), uint64(9223372036854775807), "18446744073709551615.0000", uint64(18446744073709551615)}},
+			},
+			{
+				Query:       "SELECT u DIV -1 FROM ints WHERE pk = 2;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+			{
+				Query:    "SELECT pk, u > i, u = i, i < u, -1 < u FROM ints ORDER BY pk;",
+				Expected: []sql.Row{{1, true, false, true, true}, {2, true, false, true, true}},
+			},
+			{
+				Query:    "SELECT pk FROM ints WHERE u > 9223372036854775807 ORDER BY pk;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT -t, -u FROM ints WHERE pk = 1;",
+				Expected: []sql.Row{{int64(0), int64(-1)}},
+			},
+			{
+				Query:       "SELECT -u FROM ints WHERE pk = 2;",
+				ExpectedErr: sql.ErrDataOutOfRange,
+			},
+		},
+	},
+	{
+		Name: "YEAR columns and zero dates",
+		SetUpScript: []string{
+			"CREATE TABLE dates (pk int primary key, y year, d date, dt datetime, index (y), index (d), index (dt));",
+			"INSERT INTO dates VALUES (1, '0000', '0000-00-00', '0000-00-00 00:00:00'), (2, '00', '2020-01-02', '2020-01-02 03:04:05'), (3, 0, '1999-12-31', '1999-12-31 23:59:59'), (4, ' 69 ', '2000-01-01', '2000-01-01 00:00:00'), (5, 1999.5, NULL, NULL);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, y FROM dates ORDER BY pk;",
+				Expected: []sql.Row{{1, int16(0)}, {2, int16(2000)}, {3, int16(0)}, {4, int16(2069)}, {5, int16(2000)}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE y = 0 ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE d = '0000-00-00';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE d > '0000-00-00' ORDER BY pk;",
+				Expected: []sql.Row{{2}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE dt < '2000-01-01' ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE d BETWEEN '0000-00-00' AND '2000-01-01' ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:          "INSERT INTO dates VALUES (6, 1900, NULL, NULL);",
+				ExpectedErrStr: "value 1900 is not a valid Year",
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ZERO_DATE';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:          "INSERT INTO dates VALUES (6, NULL, '0000-00-00', NULL);",
+				ExpectedErrStr: "Incorrect datetime value: '0000-00-00'",
+			},
+			{
+				Query:          "UPDATE dates SET dt = '0000-00-00' WHERE pk = 2;",
+				ExpectedErrStr: "Incorrect datetime value: '0000-00-00'",
+			},
+			{
+				Query:    "SET sql_mode = 'NO_ZERO_DATE';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:           "INSERT INTO dates VALUES (6, 1900, '0000-00-00', NULL);",
+				Expected:        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning: mysql.ERWarnDataOutOfRange,
+			},
+			{
+				Query:    "SELECT y, d FROM dates WHERE pk = 6;",
+				Expected: []sql.Row{{int16(0), types.Date.Zero()}},
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:                 "INSERT INTO dates VALUES (7, NULL, '0000-00-00', '0000-00-00');",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarningsCount: 0,
+			},
+			{
+				Query:    "SET sql_mode = DEFAULT;",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "user variable assignments and types",
+		SetUpScript: []string{
+			"CREATE TABLE uv (i int primary key, s varchar(20) COLLATE utf8mb4_bin, v int);",
+			"INSERT INTO uv VALUES (1, 'one', 0), (2, 'two', 0), (3, 'three', 0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SET @a := 1, @b := 'x';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @a, @b;",
+				Expected: []sql.Row{{int8(1), "x"}},
+			},
+			{
+				Query:    "SET @r = 0;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @r := @r + 1 AS n, i FROM uv ORDER BY i;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+			{
+				Query:    "SELECT i, @p := i * 2, @p + 1 FROM uv ORDER BY i;",
+				Expected: []sql.Row{{1, 2, float64(3)}, {2, 4, float64(5)}, {3, 6, float64(7)}},
+			},
+			{
+				Query:    "SELECT @x := @y := 3, @x, @y;",
+				Expected: []sql.Row{{int8(3), int8(3), int8(3)}},
+			},
+			{
+				Query:    "SELECT @k := CASE WHEN i > 1 THEN 'big' ELSE 'small' END k, @k FROM uv ORDER BY i;",
+				Expected: []sql.Row{{"small", "small"}, {"big", "big"}, {"big", "big"}},
+			},
+			{
+				Query:    "SELECT i FROM uv WHERE (@w := i) > 1 ORDER BY i;",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT sum(@q := i), @q FROM uv;",
+				Expected: []sql.Row{{float64(6), 3}},
+			},
+			{
+				Query:    "UPDATE uv SET v = @v := i * 10 WHERE i < 3;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, Info: plan.UpdateInfo{Matched: 2, Updated: 2}}}},
+			},
+			{
+				Query:    "SELECT i, v, @v FROM uv ORDER BY i;",
+				Expected: []sql.Row{{1, 10, 20}, {2, 20, 20}, {3, 0, 20}},
+			},
+			{
+				Query:    "SELECT ':=', '@a := 1';",
+				Expected: []sql.Row{{":=", "@a := 1"}},
+			},
+			{
+				Query:    "SET @c = 'abc' COLLATE utf8mb4_bin;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT COLLATION(@c), COERCIBILITY(@c);",
+				Expected: []sql.Row{{"utf8mb4_bin", uint64(2)}},
+			},
+			{
+				Query:    "SELECT COLLATION(@s := s) FROM uv WHERE i = 1;",
+				Expected: []sql.Row{{"utf8mb4_bin"}},
+			},
+			{
+				Query:    "SELECT @s, COLLATION(@s);",
+				Expected: []sql.Row{{"one", "utf8mb4_bin"}},
+			},
+			{
+				Query:    "SELECT i, s INTO @si, @ss FROM uv ORDER BY i LIMIT 1 OFFSET 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @si, @ss, COLLATION(@ss);",
+				Expected: []sql.Row{{2, "two", "utf8mb4_bin"}},
+			},
+			{
+				Query:    "SELECT i, s FROM uv ORDER BY i DESC LIMIT 1 INTO @si, @ss;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @si, @ss;",
+				Expected: []sql.Row{{3, "three"}},
+			},
+			{
+				Query:       "SELECT i, s INTO @si FROM uv LIMIT 1;",
+				ExpectedErr: sql.ErrColumnNumberDoesNotMatch,
+			},
+		},
+	},
+	{
+		Name: "BIT columns",
+		SetUpScript: []string{
+			"CREATE TABLE bits (pk int primary key, b bit(4), b64 bit(64), index (b));",
+			"INSERT INTO bits VALUES (1, b'1010', 0b1), (2, 0b0011, 18446744073709551615), (3, 15, x'0102'), (4, x'05', 0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT b'1010', 0b1010, B'11', 0b1 + 1, 0b11111111 = 255;",
+				Expected: []sql.Row{{uint64(10), uint64(10), uint64(3), uint64(2), true}},
+			},
+			{
+				Query:    "SELECT pk, b, cast(b64 as unsigned) FROM bits ORDER BY b;",
+				Expected: []sql.Row{{2, uint64(3), uint64(18446744073709551615)}, {4, uint64(5), uint64(0)}, {1, uint64(10), uint64(1)}, {3, uint64(15), uint64(258)}},
+			},
+			{
+				Query:    "SELECT pk FROM bits WHERE b > 0b0100 ORDER BY pk;",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM bits WHERE b BETWEEN b'0011' AND 5 ORDER BY pk;",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM bits WHERE b IN (0b1010, 3) ORDER BY pk;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT bin(b), hex(b) FROM bits WHERE pk = 1;",
+				Expected: []sql.Row{{"1010", "A"}},
+			},
+			{
+				Query:          "INSERT INTO bits VALUES (5, b'10000', 0);",
+				ExpectedErrStr: "16 is beyond the maximum value that can be held by 4 bits",
+			},
+			{
+				Query:          "UPDATE bits SET b = -1 WHERE pk = 1;",
+				ExpectedErrStr: "18446744073709551615 is beyond the maximum value that can be held by 4 bits",
+			},
+			{
+				Query:    "SET sql_mode = '';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:           "INSERT INTO bits VALUES (5, 16, 0);",
+				Expected:        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning: mysql.ERWarnDataOutOfRange,
+			},
+			{
+				Query:    "SELECT b FROM bits WHERE pk = 5;",
+				Expected: []sql.Row{{uint64(15)}},
+			},
+			{
+				Query:    "SET sql_mode = DEFAULT;",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
 	{
 		Name: "arithmetic bit operations on int, float and decimal types",
 		SetUpScript: []string{
@@ -3308,8 +3641,6 @@ var ScriptTests = []ScriptTest{
 				Expected: []sql.Row{{}},
 			},
 			{
-				// TODO: Unskip after adding support for converting timestamp values to/from session time_zone
-				Skip: true,
 				// After changing the session's time zone, we should get back a different result for the timestamp
 				// column, but the same result for the datetime column.
 				Query: `select * from timezonetest;`,
@@ -3327,7 +3658,7 @@ var ScriptTests = []ScriptTest{
 				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				// TODO: Unskip after adding support for converting timestamp values to/from session time_zone
+				// TODO: Unskip after converting datetime literals with a time zone offset to the session time_zone
 				Skip:  true,
 				Query: `select * from timezonetest;`,
 				Expected: []sql.Row{
@@ -3341,7 +3672,7 @@ var ScriptTests = []ScriptTest{
 				Expected: []sql.Row{{}},
 			},
 			{
-				// TODO: Unskip after adding support for converting timestamp values to/from session time_zone
+				// TODO: Unskip after converting datetime literals with a time zone offset to the session time_zone
 				Skip:  true,
 				Query: `select * from timezonetest;`,
 				Expected: []sql.Row{
@@ -3352,6 +3683,90 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "timestamp timezone conversion with indexes, updates and deletes",
+		SetUpScript: []string{
+			"set time_zone='+00:00';",
+			"create table tztest(pk int primary key, dt datetime, ts timestamp, index (ts));",
+			"insert into tztest values (1, '2020-02-14 12:00:00', '2020-02-14 12:00:00'), (2, '2020-02-14 13:00:00', '2020-02-14 13:00:00');",
+			"set time_zone='+02:00';",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `select pk from tztest where ts = '2020-02-14 14:00:00';`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    `select pk from tztest where ts > '2020-02-14 14:30:00';`,
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    `select pk from tztest where dt = '2020-02-14 12:00:00';`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    `select pk from tztest where ts = dt;`,
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    `select unix_timestamp(ts), unix_timestamp(dt) from tztest where pk = 1;`,
+				Expected: []sql.Row{{float64(1581681600), float64(1581674400)}},
+			},
+			{
+				Query:    `select from_unixtime(1581681600);`,
+				Expected: []sql.Row{{time.Date(2020, time.February, 14, 14, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    `update tztest set ts = '2020-02-14 20:00:00' where pk = 2;`,
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    `update tztest set dt = '2020-02-15 00:00:00' where pk = 1;`,
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    `delete from tztest where ts = '2020-02-14 14:00:00';`,
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    `insert into tztest values (3, '2020-02-16 12:00:00', '2020-02-16 12:00:00');`,
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    `set time_zone='+00:00';`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query: `select * from tztest order by pk;`,
+				Expected: []sql.Row{
+					{2, time.Date(2020, time.February, 14, 13, 0, 0, 0, time.UTC),
+						time.Date(2020, time.February, 14, 18, 0, 0, 0, time.UTC)},
+					{3, time.Date(2020, time.February, 16, 12, 0, 0, 0, time.UTC),
+						time.Date(2020, time.February, 16, 10, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    `set time_zone='+02:00';`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				// changing a column to DATETIME keeps the wall clock of the session
+				Query:    `alter table tztest modify column ts datetime;`,
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    `set time_zone='+00:00';`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query: `select pk, ts from tztest order by pk;`,
+				Expected: []sql.Row{
+					{2, time.Date(2020, time.February, 14, 20, 0, 0, 0, time.UTC)},
+					{3, time.Date(2020, time.February, 16, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+	},
 	{
 		Name: "test index scan over floats",
 		SetUpScript: []string{
@@ -3391,6 +3806,126 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "full-text search with MATCH ... AGAINST",
+		SetUpScript: []string{
+			"create table articles (id int primary key, title varchar(200) collate utf8mb4_0900_ai_ci, body text collate utf8mb4_0900_ai_ci)",
+			`insert into articles values
+				(1, 'MySQL Tutorial', 'DBMS stands for DataBase ...'),
+				(2, 'How To Use MySQL Well', 'After you went through a ...'),
+				(3, 'Optimizing MySQL', 'In this tutorial, we show ...'),
+				(4, '1001 MySQL Tricks', '1. Never run mysqld as root. 2. ...'),
+				(5, 'MySQL vs. YourSQL', 'In the following database comparison ...'),
+				(6, 'MySQL Security', 'When configured properly, MySQL ...')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select id, match(title, body) against ('database') > 0 from articles order by id",
+				Expected: []sql.Row{{1, true}, {2, false}, {3, false}, {4, false}, {5, true}, {6, false}},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('database' in natural language mode) order by id",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('MySQL security') order by match(title, body) against ('MySQL security') desc, id limit 2",
+				Expected: []sql.Row{{6}, {1}},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('the' in natural language mode)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('+MySQL -YourSQL' in boolean mode) order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {6}},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('tut*' in boolean mode) order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    `select id from articles where match(title, body) against ('"database comparison"' in boolean mode) order by id`,
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    `select id from articles where match(title, body) against ('"comparison database"' in boolean mode) order by id`,
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('-mysql' in boolean mode)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from articles where match(title, body) against ('security' with query expansion) order by id",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:       "select id from articles where match(title, concat(body, 'x')) against ('database')",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+		},
+	},
+	{
+		Name: "GTID functions and variables",
+		SetUpScript: []string{
+			"SET @@GLOBAL.gtid_purged = '8a6e7c1d-51b2-11ee-9d4a-0242ac120002:1-5';",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT GTID_SUBTRACT('3E11FA47-71CA-11E1-9E33-C80AA9429562:1-10', '3e11fa47-71ca-11e1-9e33-c80aa9429562:3-5');",
+				Expected: []sql.Row{{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-2:6-10"}},
+			},
+			{
+				Query:    "SELECT GTID_SUBSET('3e11fa47-71ca-11e1-9e33-c80aa9429562:3-5', '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10'), GTID_SUBSET('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10', ''), GTID_SUBSET(NULL, '');",
+				Expected: []sql.Row{{1, 0, nil}},
+			},
+			{
+				Query:       "SELECT GTID_SUBSET('3e11fa47-71ca-11e1-9e33-c80aa9429562:0', '');",
+				ExpectedErr: sql.ErrMalformedGTIDSet,
+			},
+			{
+				Query:    "SELECT @@GLOBAL.gtid_purged, GTID_SUBSET(@@GLOBAL.gtid_purged, @@gtid_executed);",
+				Expected: []sql.Row{{"8a6e7c1d-51b2-11ee-9d4a-0242ac120002:1-5", 1}},
+			},
+			{
+				Query:    "SELECT WAIT_FOR_EXECUTED_GTID_SET('8a6e7c1d-51b2-11ee-9d4a-0242ac120002:1-5', 1), WAIT_FOR_EXECUTED_GTID_SET('8a6e7c1d-51b2-11ee-9d4a-0242ac120002:6', 0.01);",
+				Expected: []sql.Row{{0, 1}},
+			},
+			{
+				Query:       "SET @@GLOBAL.gtid_purged = 'abc';",
+				ExpectedErr: sql.ErrMalformedGTIDSet,
+			},
+			{
+				Query:       "SET @@GLOBAL.gtid_purged = '8a6e7c1d-51b2-11ee-9d4a-0242ac120002:2-5';",
+				ExpectedErr: sql.ErrCantSetGTIDPurged,
+			},
+			{
+				Query:       "SET @@GLOBAL.gtid_executed = '';",
+				ExpectedErr: sql.ErrSystemVariableReadOnly,
+			},
+		},
+	},
+	{
+		Name: "binlog_row_image system variable",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@SESSION.binlog_row_image;",
+				Expected: []sql.Row{{"FULL"}},
+			},
+			{
+				Query:    "SET @@SESSION.binlog_row_image = 'minimal';",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@SESSION.binlog_row_image;",
+				Expected: []sql.Row{{"MINIMAL"}},
+			},
+			{
+				Query:       "SET @@SESSION.binlog_row_image = 'partial';",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+		},
+	},
 }
 
 var SpatialScriptTests = []ScriptTest{
@@ -4175,6 +4710,42 @@ var PreparedScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "prepared statements keep the database they were prepared in",
+		SetUpScript: []string{
+			"create database db2;",
+			"create table t (i int primary key);",
+			"create table db2.t (i int primary key);",
+			"insert into t values (1);",
+			"insert into db2.t values (2);",
+			"prepare s from 'select i, database() from t';",
+			"prepare ins from 'insert into t values (?)';",
+			"use db2;",
+			"set @v = 3;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "execute s",
+				Expected: []sql.Row{{1, "db2"}},
+			},
+			{
+				Query:    "execute ins using @v",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select * from mydb.t order by i",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select database()",
+				Expected: []sql.Row{{"db2"}},
+			},
+		},
+	},
 	{
 		Name:        "bad prepare",
 		SetUpScript: []string{},
@@ -4487,6 +5058,43 @@ var PreparedScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "user variable assignments in prepared statements",
+		SetUpScript: []string{
+			"CREATE TABLE uv (i int primary key);",
+			"INSERT INTO uv VALUES (1), (2), (3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "PREPARE lim FROM 'SELECT @l := i FROM uv ORDER BY i LIMIT ? OFFSET ?';",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
+			},
+			{
+				Query:    "SET @n = 1, @o = 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "EXECUTE lim USING @n, @o;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT @l;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "PREPARE assign FROM 'SELECT @p := ?';",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
+			},
+			{
+				Query:    "EXECUTE assign USING @n;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT @p;",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
 }
 
 var BrokenScriptTests = []ScriptTest{
@@ -0,0 +1,251 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var SequenceScripts = []ScriptTest{
+	{
+		Name: "sequence with default options",
+		SetUpScript: []string{
+			"create sequence s",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select lastval(s)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "select nextval(s), nextval(s), lastval(s)",
+				Expected: []sql.Row{{int64(1), int64(2), int64(2)}},
+			},
+			{
+				Query:    "select next value for s",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "select nextval('s'), nextval(mydb.s)",
+				Expected: []sql.Row{{int64(4), int64(5)}},
+			},
+			{
+				Query:    "select setval(s, 100)",
+				Expected: []sql.Row{{int64(100)}},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(101)}},
+			},
+		},
+	},
+	{
+		Name: "sequence values in DML",
+		SetUpScript: []string{
+			"create sequence ids start with 10 increment by 10",
+			"create table t (id int primary key, v varchar(10))",
+			"insert into t values (nextval(ids), 'a'), (nextval(ids), 'b')",
+			"insert into t select nextval(ids), 'c'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{10, "a"}, {20, "b"}, {30, "c"}},
+			},
+		},
+	},
+	{
+		Name: "descending and cycling sequences",
+		SetUpScript: []string{
+			"create sequence down increment by -2 minvalue 0 maxvalue 4",
+			"create sequence cyc minvalue 1 maxvalue 3 cycle cache 2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select nextval(down), nextval(down), nextval(down)",
+				Expected: []sql.Row{{int64(4), int64(2), int64(0)}},
+			},
+			{
+				Query:       "select nextval(down)",
+				ExpectedErr: sql.ErrSequenceRunOut,
+			},
+			{
+				Query:    "select nextval(cyc), nextval(cyc), nextval(cyc), nextval(cyc)",
+				Expected: []sql.Row{{int64(1), int64(2), int64(3), int64(1)}},
+			},
+		},
+	},
+	{
+		Name: "alter sequence",
+		SetUpScript: []string{
+			"create sequence s maxvalue 3 nocache",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select nextval(s), nextval(s), nextval(s)",
+				Expected: []sql.Row{{int64(1), int64(2), int64(3)}},
+			},
+			{
+				Query:       "select nextval(s)",
+				ExpectedErr: sql.ErrSequenceRunOut,
+			},
+			{
+				Query:    "alter sequence s no maxvalue increment by 5",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(4)}},
+			},
+			{
+				Query:    "alter sequence s restart with 50",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select nextval(s), nextval(s)",
+				Expected: []sql.Row{{int64(50), int64(55)}},
+			},
+			{
+				Query:       "alter sequence s minvalue 100",
+				ExpectedErr: sql.ErrSequenceInvalidData,
+			},
+			{
+				Query:       "alter sequence missing restart",
+				ExpectedErr: sql.ErrSequenceNotFound,
+			},
+			{
+				Query:    "alter sequence if exists missing restart",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "lastval of uncached sequences",
+		SetUpScript: []string{
+			"create sequence s nocache",
+			"create sequence z cache 0",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select nextval(s), lastval(s)",
+				Expected: []sql.Row{{int64(1), int64(1)}},
+			},
+			{
+				Query:    "select nextval(z), nextval(z), lastval(z)",
+				Expected: []sql.Row{{int64(1), int64(2), int64(2)}},
+			},
+			{
+				Query:    "select lastval(s)",
+				Expected: []sql.Row{{int64(1)}},
+			},
+		},
+	},
+	{
+		Name: "reserved sequence values are kept across transactions",
+		SetUpScript: []string{
+			"create sequence s cache 10",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "commit",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select nextval(s), lastval(s)",
+				Expected: []sql.Row{{int64(2), int64(2)}},
+			},
+			{
+				Query:    "start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select nextval(s)",
+				Expected: []sql.Row{{int64(4)}},
+			},
+		},
+	},
+	{
+		Name: "create and drop sequences",
+		SetUpScript: []string{
+			"create table t (a int primary key)",
+			"create sequence s1",
+			"create sequence s2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create sequence s1",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:       "create sequence t",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:       "create table s1 (a int primary key)",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:    "create sequence if not exists s1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create sequence bad start with 0",
+				ExpectedErr: sql.ErrSequenceInvalidData,
+			},
+			{
+				Query:       "drop sequence s1, s3",
+				ExpectedErr: sql.ErrSequenceNotFound,
+			},
+			{
+				// The failed drop didn't drop s1
+				Query:    "select nextval(s1)",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "drop sequence if exists s1, s3",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select nextval(s1)",
+				ExpectedErr: sql.ErrSequenceNotFound,
+			},
+			{
+				Query:    "drop sequence s2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select lastval(s2)",
+				ExpectedErr: sql.ErrSequenceNotFound,
+			},
+		},
+	},
+}
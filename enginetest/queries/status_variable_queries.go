@@ -0,0 +1,164 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// StatusVariableScripts test the status variables. Status variables count across the whole session and server, so
+// these tests check how much a variable changes rather than its value.
+var StatusVariableScripts = []ScriptTest{
+	{
+		Name: "statement counters",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set @insert = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Com_insert')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set @select = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Com_select')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set @questions = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Questions')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "insert into t values (1, 1), (2, 2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t values (3, 3)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @insert from information_schema.session_status where variable_name = 'Com_insert'",
+				Expected: []sql.Row{{"Com_insert", int64(2)}},
+			},
+			{
+				// Statements are counted before they run, so this SELECT counts itself
+				Query:    "select variable_name, cast(variable_value as signed) - @select from information_schema.session_status where variable_name = 'Com_select'",
+				Expected: []sql.Row{{"Com_select", int64(2)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @questions from information_schema.session_status where variable_name = 'Questions'",
+				Expected: []sql.Row{{"Questions", int64(5)}},
+			},
+		},
+	},
+	{
+		Name: "row counters",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+			"insert into t values (1, 1), (2, 2), (3, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set @write = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Handler_write')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set @update = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Handler_update')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set @delete = (select cast(variable_value as signed) from information_schema.session_status where variable_name = 'Handler_delete')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set @inserted = (select cast(variable_value as signed) from information_schema.global_status where variable_name = 'Innodb_rows_inserted')",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "insert into t values (4, 4), (5, 5)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "update t set b = b + 1 where a > 3",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "delete from t where a = 1",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @write from information_schema.session_status where variable_name = 'Handler_write'",
+				Expected: []sql.Row{{"Handler_write", int64(2)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @update from information_schema.session_status where variable_name = 'Handler_update'",
+				Expected: []sql.Row{{"Handler_update", int64(2)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @delete from information_schema.session_status where variable_name = 'Handler_delete'",
+				Expected: []sql.Row{{"Handler_delete", int64(1)}},
+			},
+			{
+				Query:    "select variable_name, cast(variable_value as signed) - @inserted from information_schema.global_status where variable_name = 'Innodb_rows_inserted'",
+				Expected: []sql.Row{{"Innodb_rows_inserted", int64(2)}},
+			},
+		},
+	},
+	{
+		Name: "show status",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select count(*) from information_schema.global_status where variable_name = 'Uptime' and variable_value >= 0",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// Threads_connected is only counted globally, but is also shown for the session
+				Query:    "select count(*) from information_schema.session_status where variable_name = 'Threads_connected'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// Handler_read_key is counted per session, but is also counted globally
+				Query:    "select count(*) from information_schema.global_status where variable_name = 'Handler_read_key'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// Variable names are matched case-insensitively
+				Query:    "select variable_name from information_schema.session_status where variable_name = 'com_select'",
+				Expected: []sql.Row{{"Com_select"}},
+			},
+			{
+				Query:            "show status like 'com_select'",
+				SkipResultsCheck: true, // ensure that there's no error
+			},
+		},
+	},
+	{
+		Name: "show engine status",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "show engine innodb status",
+				SkipResultsCheck: true, // ensure that there's no error
+			},
+			{
+				Query:    "show engine innodb mutex",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "show engine myisam status",
+				ExpectedErr: sql.ErrUnknownStorageEngine,
+			},
+		},
+	},
+}
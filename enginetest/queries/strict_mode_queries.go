@@ -0,0 +1,135 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// StrictModeScripts test how writes of values that are out of range, too long or invalid for their column behave in
+// and out of strict mode.
+var StrictModeScripts = []ScriptTest{
+	{
+		Name: "strict mode rejects values that don't fit their column",
+		SetUpScript: []string{
+			"set sql_mode = 'STRICT_TRANS_TABLES'",
+			"create table t (pk int primary key, i tinyint, d decimal(4,2), s varchar(3), dt datetime)",
+			"insert into t values (1, 1, 1, 'a', '2020-01-01')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t (pk, i) values (2, 1000)",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:       "insert into t (pk, s) values (2, 'abcdef')",
+				ExpectedErr: types.ErrLengthBeyondLimit,
+			},
+			{
+				Query:       "insert into t (pk, d) values (2, 123.45)",
+				ExpectedErr: types.ErrConvertToDecimalLimit,
+			},
+			{
+				Query:       "insert into t (pk, i) values (2, 'abc')",
+				ExpectedErr: sql.ErrInvalidValue,
+			},
+			{
+				Query:       "update t set i = 1000 where pk = 1",
+				ExpectedErr: sql.ErrValueOutOfRange,
+			},
+			{
+				Query:    "select pk, i, d, s from t",
+				Expected: []sql.Row{{1, int8(1), "1", "a"}},
+			},
+		},
+	},
+	{
+		Name: "non-strict mode adjusts values that don't fit their column",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, i tinyint, u int unsigned, d decimal(4,2), s varchar(3), dt datetime)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                           "insert into t (pk, i) values (1, 1000)",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'i' at row 1",
+			},
+			{
+				Query:                           "insert into t (pk, u) values (2, 1), (3, -1)",
+				Expected:                        []sql.Row{{types.NewOkResult(2)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'u' at row 2",
+			},
+			{
+				Query:                           "insert into t (pk, d) values (4, -123.45)",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'd' at row 1",
+			},
+			{
+				Query:                           "insert into t (pk, s) values (5, 'abcdef')",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataTruncated,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Data truncated for column 's' at row 1",
+			},
+			{
+				Query:                           "insert into t (pk, i) values (6, 'abc')",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERTruncatedWrongValueForField,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect integer value: 'abc' for column 'i' at row 1",
+			},
+			{
+				Query:                 "update t set i = -1000 where pk = 1",
+				Expected:              []sql.Row{{newUpdateResult(1, 1)}},
+				ExpectedWarning:       mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query: "select pk, i, u, d, s from t order by pk",
+				Expected: []sql.Row{
+					{1, int8(-128), nil, nil, nil},
+					{2, nil, uint32(1), nil, nil},
+					{3, nil, uint32(0), nil, nil},
+					{4, nil, nil, "-99.99", nil},
+					{5, nil, nil, nil, "abc"},
+					{6, int8(0), nil, nil, nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "JSON values are an error in non-strict mode",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, j json)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t values (1, 'not json')",
+				ExpectedErr: sql.ErrInvalidJson,
+			},
+		},
+	},
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var TableMaintenanceScripts = []ScriptTest{
+	{
+		Name: "CHECKSUM TABLE",
+		SetUpScript: []string{
+			"create table t1 (pk int primary key, c varchar(20), d datetime)",
+			"create table t2 (pk int primary key, c varchar(20), d datetime)",
+			"create table t3 (pk int primary key, c varchar(20), d datetime)",
+			"insert into t1 values (1, 'one', '2020-01-01 10:00:00'), (2, null, null), (3, 'three', '2021-03-04 05:06:07')",
+			"insert into t2 values (3, 'three', '2021-03-04 05:06:07'), (2, null, null), (1, 'one', '2020-01-01 10:00:00')",
+			"insert into t3 values (1, 'one', '2020-01-01 10:00:00'), (2, '', null), (3, 'three', '2021-03-04 05:06:07')",
+			"create table t4 (pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "checksum table t1, t2",
+				Expected: []sql.Row{{"mydb.t1", int64(931936959)}, {"mydb.t2", int64(931936959)}},
+			},
+			{
+				Query:    "checksum table mydb.t3 extended",
+				Expected: []sql.Row{{"mydb.t3", int64(1349724672)}},
+			},
+			{
+				Query:    "checksum table t4",
+				Expected: []sql.Row{{"mydb.t4", int64(0)}},
+			},
+			{
+				Query:    "checksum table t1 quick",
+				Expected: []sql.Row{{"mydb.t1", nil}},
+			},
+			{
+				Query:           "checksum table missing",
+				Expected:        []sql.Row{{"mydb.missing", nil}},
+				ExpectedWarning: 1146,
+			},
+			{
+				Query:            "update t1 set c = 'uno' where pk = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "checksum table t1",
+				Expected: []sql.Row{{"mydb.t1", int64(1419845703)}},
+			},
+		},
+	},
+	{
+		Name: "CHECK TABLE",
+		SetUpScript: []string{
+			"create table t (pk int primary key auto_increment, u varchar(20), v int not null, unique key (u))",
+			"insert into t (u, v) values ('a', 1), ('b', 2), (null, 3), (null, 4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "check table t",
+				Expected: []sql.Row{{"mydb.t", "check", "status", "OK"}},
+			},
+			{
+				Query: "check table t, missing for upgrade extended",
+				Expected: []sql.Row{
+					{"mydb.t", "check", "status", "OK"},
+					{"mydb.missing", "check", "Error", "Table 'mydb.missing' doesn't exist"},
+					{"mydb.missing", "check", "status", "Operation failed"},
+				},
+			},
+			{
+				Query:    "check table information_schema.tables",
+				Expected: []sql.Row{{"information_schema.tables", "check", "note", "The storage engine for the table doesn't support check"}},
+			},
+		},
+	},
+	{
+		Name: "OPTIMIZE TABLE",
+		SetUpScript: []string{
+			"create table t (pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "optimize local table t, missing",
+				Expected: []sql.Row{
+					{"mydb.t", "optimize", "note", "The storage engine for the table doesn't support optimize"},
+					{"mydb.missing", "optimize", "Error", "Table 'mydb.missing' doesn't exist"},
+					{"mydb.missing", "optimize", "status", "Operation failed"},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableSampleScripts tests TABLESAMPLE clauses and the tablesample table function.
+var TableSampleScripts = []ScriptTest{
+	{
+		Name: "TABLESAMPLE clauses",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int);",
+			"insert into xy values (1,0),(2,1),(3,2),(4,3),(5,4),(6,5),(7,6),(8,7);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select count(*) from xy tablesample bernoulli(100)",
+				Expected: []sql.Row{{8}},
+			},
+			{
+				Query:    "select count(*) from xy tablesample bernoulli(0)",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select count(*) from xy TABLESAMPLE SYSTEM (100)",
+				Expected: []sql.Row{{8}},
+			},
+			{
+				Query:    "select count(*) from xy tablesample system(0)",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select s.x from mydb.xy as s tablesample bernoulli(100) where s.y > 5 order by s.x",
+				Expected: []sql.Row{{7}, {8}},
+			},
+			{
+				Query:    "select xy.x, t.y from xy join xy t tablesample bernoulli(100) on xy.x = t.y + 1 where xy.x < 3 order by 1",
+				Expected: []sql.Row{{1, 0}, {2, 1}},
+			},
+			{
+				Query:    "select count(*) from xy tablesample bernoulli(50 + 50) repeatable(3)",
+				Expected: []sql.Row{{8}},
+			},
+			{
+				Query: `select (select group_concat(x order by x) from xy tablesample bernoulli(50) repeatable(7)) =
+       (select group_concat(x order by x) from xy tablesample bernoulli(50) repeatable(7))`,
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select count(*) from tablesample('xy', 'bernoulli', 100)",
+				Expected: []sql.Row{{8}},
+			},
+			{
+				Query:    "select count(*) from tablesample('mydb.xy', 'system', null) t",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "select * from xy tablesample random(10)",
+				ExpectedErr: sql.ErrInvalidTableSampleMethod,
+			},
+			{
+				Query:       "select * from xy tablesample bernoulli(101)",
+				ExpectedErr: sql.ErrInvalidTableSamplePercent,
+			},
+			{
+				Query:       "select * from nope tablesample bernoulli(10)",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+}
@@ -343,10 +343,19 @@ var TriggerTests = []ScriptTest{
 				"set new.a = new.a + 1, new.b = new.c, new.c = 0, @@auto_increment_increment = @@auto_increment_increment + 1",
 			"insert into x values (1, 10, 100), (2, 20, 200)",
 		},
-		Query: "select *, @@auto_increment_increment from x order by 1",
-		Expected: []sql.Row{
-			{2, 100, 0, 3},
-			{3, 200, 0, 3},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select *, @@auto_increment_increment from x order by 1",
+				Expected: []sql.Row{
+					{2, 100, 0, 3},
+					{3, 200, 0, 3},
+				},
+			},
+			{
+				// auto_increment_increment affects generated values, so restore it for the scripts that follow
+				Query:    "set @@auto_increment_increment = 1",
+				Expected: []sql.Row{{}},
+			},
 		},
 	},
 	{
@@ -1188,6 +1197,61 @@ END;`,
 			},
 		},
 	},
+	{
+		Name: "multiple triggers following the same trigger, and action order per table",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create trigger b1 before insert on b for each row set new.y = new.y + 1",
+			"create trigger a1 before insert on a for each row set new.x = new.x + 1",
+			"create trigger a2 before insert on a for each row follows a1 set new.x = new.x * 2",
+			"create trigger a3 before insert on a for each row follows A1 set new.x = new.x - 5",
+			// order of execution should be: a1, a3, a2
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "insert into a values (1), (3)",
+				Expected: []sql.Row{
+					{types.NewOkResult(2)},
+				},
+			},
+			{
+				Query: "select x from a order by 1",
+				Expected: []sql.Row{
+					{-6}, {-2},
+				},
+			},
+			{
+				Query: "select trigger_name, event_object_table, action_order from information_schema.triggers where trigger_schema = 'mydb' order by 2, 3",
+				Expected: []sql.Row{
+					{"a1", "a", int64(1)},
+					{"a3", "a", int64(2)},
+					{"a2", "a", int64(3)},
+					{"b1", "b", int64(1)},
+				},
+			},
+			{
+				Query:       "create trigger a4 before insert on a for each row follows a9 set new.x = 1",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a4 after insert on a for each row follows a1 set @x = 1",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a4 before update on a for each row precedes a1 set new.x = 1",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger a4 before insert on a for each row precedes b1 set new.x = 1",
+				ExpectedErr: sql.ErrReferencedTriggerDoesNotExist,
+			},
+			{
+				Query:       "create trigger A1 before insert on a for each row set new.x = 1",
+				ExpectedErr: sql.ErrTriggerAlreadyExists,
+			},
+		},
+	},
 	{
 		Name: "triggers before and after update",
 		SetUpScript: []string{
@@ -1537,6 +1601,209 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "trigger with local variables, IF, CASE and SIGNAL",
+		SetUpScript: []string{
+			"create table a (x int primary key, y varchar(20))",
+			"create table log (msg varchar(100))",
+			`create trigger a1 before insert on a for each row
+begin
+	declare v int default 10;
+	declare s varchar(20);
+	set v = v + new.x;
+	if v > 15 then
+		set s = 'big';
+	elseif v > 12 then
+		set s = 'medium';
+	else
+		set s = 'small';
+	end if;
+	case
+		when new.x = 4 then set s = concat(s, '!');
+		else begin end;
+	end case;
+	set new.y = s;
+	set new.x = v;
+end`,
+			`create trigger a2 after insert on a for each row
+begin
+	declare m varchar(100);
+	set m = concat('inserted ', new.x, ' ', new.y);
+	insert into log values (m);
+end`,
+			`create trigger a3 before update on a for each row
+begin
+	declare msg varchar(100);
+	if new.x < old.x then
+		set msg = concat('cannot decrease ', old.x);
+		signal sqlstate '45000' set message_text = msg;
+	end if;
+end`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "insert into a (x) values (1), (4), (8)",
+				Expected: []sql.Row{
+					{types.NewOkResult(3)},
+				},
+			},
+			{
+				Query: "select * from a order by x",
+				Expected: []sql.Row{
+					{11, "small"}, {14, "medium!"}, {18, "big"},
+				},
+			},
+			{
+				Query: "select * from log order by msg",
+				Expected: []sql.Row{
+					{"inserted 11 small"}, {"inserted 14 medium!"}, {"inserted 18 big"},
+				},
+			},
+			{
+				Query:          "update a set x = x - 1 where x = 11",
+				ExpectedErrStr: "cannot decrease 11 (errno 1644) (sqlstate 45000)",
+			},
+			{
+				Query: "update a set x = x + 1 where x = 11",
+				Expected: []sql.Row{
+					{newUpdateResult(1, 1)},
+				},
+			},
+		},
+	},
+	{
+		Name: "trigger with nested blocks, loops and LEAVE",
+		SetUpScript: []string{
+			"create table a (x int primary key, y int)",
+			`create trigger a1 before insert on a for each row
+begin
+	declare i int default 0;
+	declare total int default 0;
+	while i < new.x do
+		set i = i + 1;
+		set total = total + i;
+	end while;
+	begin
+		declare total int default 100;
+		set new.y = total;
+	end;
+	lbl: begin
+		case new.x
+			when 3 then
+				set new.y = total;
+				set new.y = new.y * 10;
+			when 4 then leave lbl;
+			else set new.y = new.y + total;
+		end case;
+		set new.y = -new.y;
+	end;
+end`,
+			`create trigger a2 before delete on a for each row
+begin
+	declare msg varchar(20) default 'nope';
+	if old.x = 3 then
+		signal sqlstate '45000' set message_text = msg;
+	end if;
+end`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "insert into a (x) values (2), (3), (4)",
+				Expected: []sql.Row{
+					{types.NewOkResult(3)},
+				},
+			},
+			{
+				Query: "select * from a order by x",
+				Expected: []sql.Row{
+					{2, -103}, {3, -60}, {4, 100},
+				},
+			},
+			{
+				Query:          "delete from a where x = 3",
+				ExpectedErrStr: "nope (errno 1644) (sqlstate 45000)",
+			},
+			{
+				Query: "delete from a where x = 2",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				Query: "select x from a order by x",
+				Expected: []sql.Row{
+					{3}, {4},
+				},
+			},
+		},
+	},
+	{
+		Name: "trigger updates a table of the same name in another database",
+		SetUpScript: []string{
+			"create database db2",
+			"create table db2.a (x int primary key)",
+			"create table a (x int primary key)",
+			"create trigger a1 before insert on a for each row insert into db2.a values (new.x * 10)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "insert into a values (1), (2)",
+				Expected: []sql.Row{
+					{types.NewOkResult(2)},
+				},
+			},
+			{
+				Query: "select x from db2.a order by 1",
+				Expected: []sql.Row{
+					{10}, {20},
+				},
+			},
+		},
+	},
+	{
+		Name: "triggers are created and run in the database of their table",
+		SetUpScript: []string{
+			"create database db2",
+			"create table db2.a (x int primary key)",
+			"create table db2.log (msg varchar(20))",
+			"create table log (msg varchar(20))",
+			"create trigger trg after insert on db2.a for each row insert into log values (concat('a', new.x))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select trigger_schema, trigger_name from information_schema.triggers where trigger_name = 'trg'",
+				Expected: []sql.Row{{"db2", "trg"}},
+			},
+			{
+				Query:    "insert into db2.a values (1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from db2.log",
+				Expected: []sql.Row{{"a1"}},
+			},
+			{
+				Query:    "select * from log",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "create trigger db2.trg2 before insert on mydb.log for each row set new.msg = 'x'",
+				ExpectedErr: sql.ErrTriggerInWrongSchema,
+			},
+			{
+				Query:    "create trigger db2.trg2 before insert on db2.log for each row set new.msg = upper(new.msg)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into db2.a values (2)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from db2.log order by 1",
+				Expected: []sql.Row{{"A2"}, {"a1"}},
+			},
+		},
+	},
 	// SHOW CREATE TRIGGER scripts
 	{
 		Name: "show create triggers",
@@ -3066,6 +3333,63 @@ var TriggerErrorTests = []ScriptTest{
 		Query:       "insert into a values (1), (2), (3)",
 		ExpectedErr: sql.ErrTriggerTableInUse,
 	},
+	{
+		Name: "circular dependency, table names in different case",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create trigger a1 before insert on a for each row insert into B values (new.x * 2)",
+			"create trigger b1 before insert on b for each row insert into A values (new.y * 7)",
+		},
+		Query:       "insert into a values (1), (2), (3)",
+		ExpectedErr: sql.ErrTriggerTableInUse,
+	},
+	{
+		Name: "circular dependency, after triggers",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create trigger a1 after insert on a for each row insert into b values (new.x * 2)",
+			"create trigger b1 after insert on b for each row insert into a values (new.y * 7)",
+		},
+		Query:       "insert into a values (1), (2), (3)",
+		ExpectedErr: sql.ErrTriggerTableInUse,
+	},
+	{
+		Name: "circular dependency through a stored procedure",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create procedure insert_a(v int) insert into a values (v)",
+			"create trigger a1 before insert on a for each row insert into b values (new.x + 10)",
+			"create trigger b1 before insert on b for each row call insert_a(new.y)",
+		},
+		Query:       "insert into a values (1)",
+		ExpectedErr: sql.ErrTriggerTableInUse,
+	},
+	{
+		Name: "trigger updates a table read by the invoking statement",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"insert into b values (1), (2)",
+			"create trigger a1 before insert on a for each row insert into b values (new.x * 10)",
+		},
+		Query:       "insert into a select y from b",
+		ExpectedErr: sql.ErrTriggerTableInUse,
+	},
+	{
+		Name: "trigger updates a table read by a subquery of the invoking statement",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"insert into a values (1), (2)",
+			"insert into b values (1)",
+			"create trigger a1 before delete on a for each row delete from b where y = old.x",
+		},
+		Query:       "delete from a where x in (select y from b)",
+		ExpectedErr: sql.ErrTriggerTableInUse,
+	},
 	{
 		Name: "reference to old on insert",
 		SetUpScript: []string{
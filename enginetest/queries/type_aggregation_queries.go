@@ -0,0 +1,116 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// TypeAggregationScripts tests the result types of UNION columns and of the CASE, IF and COALESCE expressions, which
+// are aggregated from the types of their branches.
+var TypeAggregationScripts = []ScriptTest{
+	{
+		Name: "UNION of columns with different types",
+		SetUpScript: []string{
+			"create table t (i int primary key, ti tinyint, tu tinyint unsigned, bu bigint unsigned, d decimal(5,2), d2 decimal(10,4), vc varchar(5), e enum('first','second'), dt date);",
+			"insert into t values (1, -1, 255, 18446744073709551615, 123.45, 1.2345, 'abc', 'second', '2023-01-02');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i from t union all select vc from t order by i",
+				Expected: []sql.Row{{"1"}, {"abc"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "i", Type: types.MustCreateString(sqltypes.VarChar, 11, sql.Collation_Default)},
+				},
+			},
+			{
+				Query:    "select tu from t union all select ti from t order by tu",
+				Expected: []sql.Row{{-1}, {255}},
+				ExpectedColumns: sql.Schema{
+					{Name: "tu", Type: types.Int16},
+				},
+			},
+			{
+				Query:    "select bu from t union all select ti from t order by bu",
+				Expected: []sql.Row{{"-1"}, {"18446744073709551615"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "bu", Type: types.MustCreateDecimalType(20, 0)},
+				},
+			},
+			{
+				Query:    "select d from t union all select d2 from t order by d",
+				Expected: []sql.Row{{"1.2345"}, {"123.45"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "d", Type: types.MustCreateDecimalType(10, 4)},
+				},
+			},
+			{
+				Query:    "select e from t union all select vc from t order by e",
+				Expected: []sql.Row{{"abc"}, {"second"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "e", Type: types.MustCreateString(sqltypes.VarChar, 6, sql.Collation_Default)},
+				},
+			},
+			{
+				Query:    "select dt from t union all select i from t order by dt",
+				Expected: []sql.Row{{"1"}, {"2023-01-02"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "dt", Type: types.MustCreateString(sqltypes.VarChar, 11, sql.Collation_Default)},
+				},
+			},
+		},
+	},
+	{
+		Name: "CASE, IF and COALESCE of different types",
+		SetUpScript: []string{
+			"create table t (i int primary key, ti tinyint, tu tinyint unsigned, vc varchar(5), e enum('first','second'));",
+			"insert into t values (1, -1, 255, 'abc', 'second');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select case when i > 0 then tu else ti end from t",
+				Expected: []sql.Row{{255}},
+				ExpectedColumns: sql.Schema{
+					{Name: "case when i > 0 then tu else ti end", Type: types.Int16},
+				},
+			},
+			{
+				Query:    "select if(i > 0, i, vc) from t",
+				Expected: []sql.Row{{"1"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "if(i > 0, i, vc)", Type: types.MustCreateString(sqltypes.VarChar, 11, sql.Collation_Default)},
+				},
+			},
+			{
+				Query:    "select if(i > 0, e, vc) from t",
+				Expected: []sql.Row{{"second"}},
+			},
+			{
+				Query:    "select coalesce(null, ti, tu) from t",
+				Expected: []sql.Row{{-1}},
+				ExpectedColumns: sql.Schema{
+					{Name: "coalesce(null, ti, tu)", Type: types.Int16},
+				},
+			},
+			{
+				Query:    "select coalesce(i, vc) from t",
+				Expected: []sql.Row{{"1"}},
+			},
+		},
+	},
+}
@@ -23,6 +23,10 @@ type TypeWireTest struct {
 	SetUpScript []string
 	Queries     []string
 	Results     [][]sql.Row
+	// BinaryResults are the results of the queries over the binary protocol, as prepared statements return them, when
+	// they differ from Results. Clients show the fractional seconds of temporal values decoded from the binary protocol
+	// with as many decimals as their column reports.
+	BinaryResults [][]sql.Row
 }
 
 // TypeWireTests are used to ensure that types are properly represented over the wire (vs being directly returned from
@@ -341,6 +345,16 @@ var TypeWireTests = []TypeWireTest{
 			{{"2022-10-26 00:01:00"}},
 			{{"2022-10-26 01:00:00"}},
 		},
+		BinaryResults: [][]sql.Row{
+			{{"1980-04-12 12:02:11.000000", "2000-01-01 00:00:00.000000"}, {"1999-11-28 13:06:33.000000", "2022-01-14 15:08:44.000000"}},
+			{{"1980-04-12 12:02:11.000000", "2000-01-01 00:00:00.000000"}, {"1999-11-28 13:06:33.000000", "2022-01-14 15:08:44.000000"}},
+			{{"2000-01-01 00:00:00.000000", "1980-04-12 12:02:11.000000"}, {"2022-01-14 15:08:44.000000", "1999-11-28 13:06:33.000000"}},
+			{{"2022-10-27 13:14:15.000000"}},
+			{{"2022-10-27 13:14:15"}},
+			{{"2022-10-26 00:00:01"}},
+			{{"2022-10-26 00:01:00"}},
+			{{"2022-10-26 01:00:00"}},
+		},
 	},
 	{
 		Name: "DATETIME",
@@ -368,6 +382,15 @@ var TypeWireTests = []TypeWireTest{
 			{{"2022-10-26 00:01:00"}},
 			{{"2022-10-26 01:00:00"}},
 		},
+		BinaryResults: [][]sql.Row{
+			{{"1000-04-12 12:02:11.000000", "2000-01-01 00:00:00.000000"}, {"1999-11-28 13:06:33.000000", "2022-01-14 15:08:44.000000"}},
+			{{"1000-04-12 12:02:11.000000", "2000-01-01 00:00:00.000000"}, {"1999-11-28 13:06:33.000000", "2022-01-14 15:08:44.000000"}},
+			{{"2000-01-01 00:00:00.000000", "1000-04-12 12:02:11.000000"}, {"2022-01-14 15:08:44.000000", "1999-11-28 13:06:33.000000"}},
+			{{"2022-10-27 13:14:15"}},
+			{{"2022-10-26 00:00:01"}},
+			{{"2022-10-26 00:01:00"}},
+			{{"2022-10-26 01:00:00"}},
+		},
 	},
 	{
 		Name: "DATE",
@@ -420,6 +443,30 @@ var TypeWireTests = []TypeWireTest{
 			{{"-800:00:00", "-120:12:20"}, {"00:00:00", "00:00:00"}, {"10:26:57", "30:53:14"}},
 			{{"-120:12:20", "-800:00:00"}, {"00:00:00", "00:00:00"}, {"30:53:14", "10:26:57"}},
 		},
+		BinaryResults: [][]sql.Row{
+			{{"-800:00:00.000000", "-120:12:20.000000"}, {"00:00:00.000000", "00:00:00.000000"}, {"10:26:57.000000", "30:53:14.000000"}},
+			{{"-800:00:00.000000", "-120:12:20.000000"}, {"00:00:00.000000", "00:00:00.000000"}, {"10:26:57.000000", "30:53:14.000000"}},
+			{{"-120:12:20.000000", "-800:00:00.000000"}, {"00:00:00.000000", "00:00:00.000000"}, {"30:53:14.000000", "10:26:57.000000"}},
+		},
+	},
+	{
+		Name: "fractional seconds",
+		SetUpScript: []string{
+			`CREATE TABLE test (pk INT PRIMARY KEY, v1 DATETIME(6), v2 TIMESTAMP(6), v3 TIME(6));`,
+			`INSERT INTO test VALUES (1, "2023-01-02 03:04:05.123456", "2023-01-02 03:04:05.5", "-12:34:56.000001");`,
+		},
+		Queries: []string{
+			`SELECT v1, v2, v3 FROM test;`,
+			`SELECT TIMEDIFF("12:00:00", "11:00:00.5");`,
+		},
+		Results: [][]sql.Row{
+			{{"2023-01-02 03:04:05.123456", "2023-01-02 03:04:05.5", "-12:34:56.000001"}},
+			{{"00:59:59.500000"}},
+		},
+		BinaryResults: [][]sql.Row{
+			{{"2023-01-02 03:04:05.123456", "2023-01-02 03:04:05.500000", "-12:34:56.000001"}},
+			{{"00:59:59.500000"}},
+		},
 	},
 	{
 		Name: "CHAR",
@@ -0,0 +1,78 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ValuesStatementScripts tests VALUES statements used as queries rather than as derived tables.
+var ValuesStatementScripts = []ScriptTest{
+	{
+		Name: "top-level VALUES statements",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "VALUES ROW(1, 'a'), ROW(3, 'b')",
+				Expected: []sql.Row{{1, "a"}, {3, "b"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "column_0", Type: types.Int8},
+					{Name: "column_1", Type: types.LongText},
+				},
+			},
+			{
+				Query:    "values row(1, 'a'), row(3, 'b'), row(2, 'c') order by column_0 desc",
+				Expected: []sql.Row{{3, "b"}, {2, "c"}, {1, "a"}},
+			},
+			{
+				Query:    "values row(1, 'a'), row(3, 'b'), row(2, 'c') order by column_1 desc limit 1",
+				Expected: []sql.Row{{2, "c"}},
+			},
+			{
+				Query:    "values row(1 + 1, concat('a', 'b'))",
+				Expected: []sql.Row{{2, "ab"}},
+			},
+			{
+				Query:    "select * from (values row(1, 2), row(3, 4)) t where column_1 > 2",
+				Expected: []sql.Row{{3, 4}},
+			},
+		},
+	},
+	{
+		Name: "VALUES statements in UNION",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int);",
+			"insert into xy values (1,0),(2,1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select x, y from xy union values row(3, 2), row(1, 0)",
+				Expected: []sql.Row{{1, 0}, {2, 1}, {3, 2}},
+			},
+			{
+				Query:    "values row(5, 6) union all select x, y from xy order by column_0",
+				Expected: []sql.Row{{1, 0}, {2, 1}, {5, 6}},
+			},
+			{
+				Query:    "values row(1, 2) union all values row(3, 4), row(1, 2) order by column_1 desc",
+				Expected: []sql.Row{{3, 4}, {1, 2}, {1, 2}},
+			},
+			{
+				Query:    "(values row(1, 2)) union distinct (values row(1, 2), row(5, 6)) limit 1",
+				Expected: []sql.Row{{1, 2}},
+			},
+		},
+	},
+}
@@ -129,7 +129,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@session.sql_mode",
 		Expected: []sql.Row{
-			{"ANSI"},
+			{"ANSI_QUOTES,IGNORE_SPACE,ONLY_FULL_GROUP_BY,PIPES_AS_CONCAT,REAL_AS_FLOAT,ANSI"},
 		},
 	},
 	{
@@ -156,7 +156,7 @@ var VariableQueries = []ScriptTest{
 		Name: "set system variable with expressions",
 		SetUpScript: []string{
 			`set lc_messages = "123", @@auto_increment_increment = 1`,
-			`set lc_messages = concat(@@lc_messages, "456"), @@auto_increment_increment = @@auto_increment_increment + 3`,
+			`set lc_messages = concat(@@lc_messages, '456'), @@auto_increment_increment = @@auto_increment_increment + 3`,
 		},
 		Query: "SELECT @@lc_messages, @@auto_increment_increment",
 		Expected: []sql.Row{
@@ -233,7 +233,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@sql_mode, @@time_zone, @@character_set_client, @@character_set_connection, @@character_set_results",
 		Expected: []sql.Row{
-			{"NO_ENGINE_SUBSTITUTION,PIPES_AS_CONCAT,ANSI", "+00:00", "utf8mb3", "utf8mb3", "utf8mb3"},
+			{"ANSI_QUOTES,IGNORE_SPACE,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,PIPES_AS_CONCAT,REAL_AS_FLOAT,ANSI", "+00:00", "utf8mb3", "utf8mb3", "utf8mb3"},
 		},
 	},
 	{
@@ -452,6 +452,85 @@ var VariableQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "setting a character set or collation variable updates the related variable",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set character_set_connection = 'latin1'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@character_set_connection, @@collation_connection",
+				Expected: []sql.Row{{"latin1", "latin1_swedish_ci"}},
+			},
+			{
+				Query:    "set collation_connection = 'utf8mb4_bin'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@character_set_connection, @@collation_connection",
+				Expected: []sql.Row{{"utf8mb4", "utf8mb4_bin"}},
+			},
+			{
+				Query:    "set character_set_connection = default",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@character_set_connection, @@collation_connection",
+				Expected: []sql.Row{{"utf8mb4", "utf8mb4_0900_ai_ci"}},
+			},
+		},
+	},
+	{
+		Name: "setting sql_mode takes effect immediately",
+		SetUpScript: []string{
+			"create table sql_mode_t (c varchar(10))",
+			"insert into sql_mode_t values ('col')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `select "c" from sql_mode_t`,
+				Expected: []sql.Row{{"c"}},
+			},
+			{
+				Query:    "set sql_mode = 'ANSI'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `select "c" from sql_mode_t`,
+				Expected: []sql.Row{{"col"}},
+			},
+			{
+				Query:    "set sql_mode = default",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "time_zone values",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set time_zone = '-13:59'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set time_zone = 'America/New_York'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@time_zone",
+				Expected: []sql.Row{{"America/New_York"}},
+			},
+			{
+				Query:    "set time_zone = default",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@time_zone",
+				Expected: []sql.Row{{"SYSTEM"}},
+			},
+		},
+	},
 	//TODO: do not override tables with user-var-like names...but why would you do this??
 	//{
 	//	Name: "user var table name no conflict",
@@ -484,6 +563,26 @@ var VariableErrorTests = []QueryErrorTest{
 		Query:       `set @@sql_mode = "NOT_AN_OPTION"`,
 		ExpectedErr: sql.ErrInvalidSetValue,
 	},
+	{
+		Query:       `set @@time_zone = 'not_a_time_zone'`,
+		ExpectedErr: sql.ErrUnknownTimeZone,
+	},
+	{
+		Query:       `set @@time_zone = '+14:01'`,
+		ExpectedErr: sql.ErrUnknownTimeZone,
+	},
+	{
+		Query:       `set @@sql_select_limit = -1`,
+		ExpectedErr: sql.ErrInvalidSystemVariableValue,
+	},
+	{
+		Query:       `set @@character_set_client = 'not_a_charset'`,
+		ExpectedErr: sql.ErrCharSetUnknown,
+	},
+	{
+		Query:       `set @@collation_connection = 'not_a_collation'`,
+		ExpectedErr: sql.ErrCollationUnknown,
+	},
 	{
 		Query:       `set global core_file = true`,
 		ExpectedErr: sql.ErrSystemVariableReadOnly,
@@ -14,9 +14,103 @@
 
 package queries
 
-import "github.com/dolthub/go-mysql-server/sql"
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
 
 var ViewScripts = []ScriptTest{
+	{
+		Name: "views are created and dropped in the database they're qualified with",
+		SetUpScript: []string{
+			"create database db2;",
+			"create table db2.t (i int primary key);",
+			"create table t (i int primary key);",
+			"insert into db2.t values (1), (2);",
+			"insert into t values (3);",
+			"create view db2.v as select t.i, mt.i as j from t join mydb.t mt;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select table_schema, table_name from information_schema.views where table_name = 'v';",
+				Expected: []sql.Row{{"db2", "v"}},
+			},
+			{
+				Query:    "select * from db2.v order by i;",
+				Expected: []sql.Row{{1, 3}, {2, 3}},
+			},
+			{
+				Query:       "select * from v;",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "drop view db2.v;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select count(*) from information_schema.views where table_name = 'v';",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "views reading dropped tables are kept but invalid",
+		SetUpScript: []string{
+			"create table t1 (i int primary key, j int);",
+			"create table t2 (i int primary key);",
+			"insert into t1 values (1, 10), (2, 20);",
+			"create view v1 as select t1.j from t1 join t2 on t1.i = t2.i;",
+			"create view v2 as select i from t1 where j > 10;",
+			"create view v3 as select * from v1;",
+			"drop table t2;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select table_name from information_schema.views where table_schema = 'mydb' order by 1;",
+				Expected: []sql.Row{{"myview"}, {"v1"}, {"v2"}, {"v3"}},
+			},
+			{
+				Query:          "select * from v1;",
+				ExpectedErrStr: "View 'mydb.v1' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them",
+			},
+			{
+				Query:       "select * from v3;",
+				ExpectedErr: sql.ErrViewInvalid,
+			},
+			{
+				Query:    "select * from v2;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "alter table t1 rename column j to k;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:          "select * from v2;",
+				ExpectedErrStr: "View 'mydb.v2' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them",
+			},
+			{
+				Query:    "create table t2 (i int primary key);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t2 values (2);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "select * from v1;",
+				ExpectedErr: sql.ErrViewInvalid,
+			},
+			{
+				Query:    "alter table t1 rename column k to j;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from v3;",
+				Expected: []sql.Row{{20}},
+			},
+		},
+	},
 	{
 		Name: "check view with escaped strings",
 		SetUpScript: []string{
@@ -47,4 +141,62 @@ var ViewScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "view column lists, ALTER VIEW and CREATE OR REPLACE VIEW",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v varchar(10));",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b');",
+			"CREATE VIEW v1 (x, y) AS SELECT pk, v FROM t;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT x, y FROM v1 ORDER BY x",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}},
+			},
+			{
+				Query:    "SHOW CREATE VIEW v1",
+				Expected: []sql.Row{{"v1", "CREATE VIEW `v1` (`x`,`y`) AS SELECT pk, v FROM t", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:       "CREATE VIEW v2 (x) AS SELECT pk, v FROM t",
+				ExpectedErr: sql.ErrColumnCountMismatch,
+			},
+			{
+				Query:       "CREATE VIEW v2 (x, X) AS SELECT pk, v FROM t",
+				ExpectedErr: sql.ErrDuplicateViewColumn,
+			},
+			{
+				Query:       "ALTER VIEW v2 AS SELECT 1",
+				ExpectedErr: sql.ErrViewDoesNotExist,
+			},
+			{
+				Query:    "ALTER ALGORITHM = MERGE SQL SECURITY INVOKER VIEW v1 (z) AS SELECT v FROM t WHERE pk = 2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM v1",
+				Expected: []sql.Row{{"b"}},
+			},
+			{
+				Query:    "SELECT table_name, view_definition, security_type FROM information_schema.views WHERE table_name = 'v1'",
+				Expected: []sql.Row{{"v1", "SELECT v FROM t WHERE pk = 2", "INVOKER"}},
+			},
+			{
+				Query:       "ALTER OR REPLACE VIEW v1 AS SELECT 1",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    "CREATE OR REPLACE VIEW v1 (a, b) AS SELECT v, pk FROM t WHERE pk = 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT a, b FROM v1",
+				Expected: []sql.Row{{"a", 1}},
+			},
+			{
+				Query:    "SELECT table_name, security_type FROM information_schema.views WHERE table_name = 'v1'",
+				Expected: []sql.Row{{"v1", "DEFINER"}},
+			},
+		},
+	},
 }
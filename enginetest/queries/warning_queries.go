@@ -0,0 +1,126 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// WarningScripts test the warnings raised by statements, and SHOW WARNINGS, SHOW ERRORS and the diagnostics system
+// variables that read them.
+var WarningScripts = []ScriptTest{
+	{
+		Name: "division by zero and truncation warnings",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                           "select 1 / 0",
+				Expected:                        []sql.Row{{nil}},
+				ExpectedWarning:                 1365,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Division by 0",
+			},
+			{
+				Query:                           "select cast('abc' as signed)",
+				Expected:                        []sql.Row{{int64(0)}},
+				ExpectedWarning:                 mysql.ERTruncatedWrongValue,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Truncated incorrect INTEGER value: 'abc'",
+			},
+		},
+	},
+	{
+		Name: "diagnostics statements keep the warnings of the previous statement",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select 1 / 0, 2 / 0, 3 / 0",
+				Expected: []sql.Row{{nil, nil, nil}},
+			},
+			{
+				Query:    "select @@warning_count, @@error_count",
+				Expected: []sql.Row{{int64(3), int64(0)}},
+			},
+			{
+				Query:    "show count(*) warnings",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query: "show warnings limit 1, 1",
+				Expected: []sql.Row{
+					{"Warning", 1365, "Division by 0"},
+				},
+			},
+			{
+				Query:    "show errors",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select @@warning_count",
+				Expected: []sql.Row{{int64(0)}},
+			},
+		},
+	},
+	{
+		Name: "errors are shown by SHOW ERRORS",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select * from nonexistent_table",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "select @@error_count",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "show count(*) errors",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query: "show errors",
+				Expected: []sql.Row{
+					{"Error", 1146, "table not found: nonexistent_table"},
+				},
+			},
+		},
+	},
+	{
+		Name: "max_error_count limits the warnings kept but not the count",
+		SetUpScript: []string{
+			"set max_error_count = 2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select 1 / 0, 2 / 0, 3 / 0",
+				Expected: []sql.Row{{nil, nil, nil}},
+			},
+			{
+				Query:    "select @@warning_count",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query: "show warnings",
+				Expected: []sql.Row{
+					{"Warning", 1365, "Division by 0"},
+					{"Warning", 1365, "Division by 0"},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// csvNull is the value of the fields of CSV files that are NULL.
+const csvNull = `\N`
+
+// inferCSVSchema returns the schema of the CSV file |path|, with the columns named in its header.
+func inferCSVSchema(path string) (sql.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := newCSVReader(f)
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, ErrUnsupportedSchema.New(path, "no header")
+	} else if err != nil {
+		return nil, err
+	}
+	header = append([]string(nil), header...)
+
+	inferred := make([]inferredType, len(header))
+	for n := 0; n < inferenceSampleSize; n++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		for i := range inferred {
+			if i >= len(record) || record[i] == "" || record[i] == csvNull {
+				continue
+			}
+			inferred[i] = inferred[i].merge(inferTextType(record[i]), inferredText)
+		}
+	}
+	return inferredSchema(header, inferred), nil
+}
+
+func newCSVReader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	return cr
+}
+
+// csvIter returns the rows of a CSV file.
+type csvIter struct {
+	path    string
+	file    *os.File
+	reader  *csv.Reader
+	schema  sql.Schema
+	indexes []int
+}
+
+var _ sql.RowIter = (*csvIter)(nil)
+
+func newCSVIter(path string, schema sql.Schema) (*csvIter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newCSVReader(f)
+	// The header is only used before the next record is read, which reuses its slice
+	header, err := r.Read()
+	if err != nil && err != io.EOF {
+		f.Close()
+		return nil, err
+	}
+	return &csvIter{
+		path:    path,
+		file:    f,
+		reader:  r,
+		schema:  schema,
+		indexes: columnIndexes(schema, header),
+	}, nil
+}
+
+// Next implements the interface sql.RowIter.
+func (i *csvIter) Next(ctx *sql.Context) (sql.Row, error) {
+	record, err := i.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(sql.Row, len(i.schema))
+	for j, col := range i.schema {
+		idx := i.indexes[j]
+		if idx < 0 || idx >= len(record) {
+			continue
+		}
+		field := record[idx]
+		if field == csvNull || (field == "" && !types.IsText(col.Type)) {
+			continue
+		}
+		if row[j], err = convert(i.path, col, field); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (i *csvIter) Close(ctx *sql.Context) error {
+	return i.file.Close()
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Database is a read-only database of external tables.
+type Database struct {
+	name   string
+	tables map[string]sql.Table
+}
+
+var _ sql.Database = (*Database)(nil)
+
+// NewDatabase returns a database named |name| with the tables given.
+func NewDatabase(name string, tables ...*Table) *Database {
+	db := &Database{
+		name:   name,
+		tables: make(map[string]sql.Table),
+	}
+	for _, t := range tables {
+		db.AddTable(t)
+	}
+	return db
+}
+
+// AddTable adds the table |t| to the database, replacing any table with the same name.
+func (d *Database) AddTable(t *Table) {
+	d.tables[t.Name()] = t
+}
+
+// Name implements the interface sql.Database.
+func (d *Database) Name() string {
+	return d.name
+}
+
+// GetTableInsensitive implements the interface sql.Database.
+func (d *Database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	tbl, ok := sql.GetTableInsensitive(tblName, d.tables)
+	return tbl, ok, nil
+}
+
+// GetTableNames implements the interface sql.Database.
+func (d *Database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	names := make([]string, 0, len(d.tables))
+	for name := range d.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func writeFile(t *testing.T, dir, name, contents string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+// query runs |q| on an engine with a database named files with the tables given.
+func query(t *testing.T, q string, tables ...*Table) []sql.Row {
+	engine := sqle.NewDefault(memory.NewDBProvider(NewDatabase("files", tables...)))
+	ctx := sql.NewEmptyContext()
+	sch, iter, err := engine.Query(ctx, q)
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, sch, iter)
+	require.NoError(t, err)
+	return rows
+}
+
+func explain(t *testing.T, q string, tables ...*Table) string {
+	var lines []string
+	for _, row := range query(t, "EXPLAIN "+q, tables...) {
+		lines = append(lines, row[0].(string))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func schemaTypes(schema sql.Schema) map[string]sql.Type {
+	m := make(map[string]sql.Type)
+	for _, col := range schema {
+		m[col.Name] = col.Type
+	}
+	return m
+}
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestCSV(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.csv", "id,name,score,born\n1,alice,9.5,1990-01-02\n2,\"bob, jr\",7,\n")
+	writeFile(t, dir, "b.csv", "id,name,score,born\n3,,\\N,2001-12-31\n")
+
+	table, err := InferTable("people", CSV, filepath.Join(dir, "*.csv"))
+	require.NoError(t, err)
+	require.Len(t, table.Paths(), 2)
+	require.Equal(t, map[string]sql.Type{
+		"id":    types.Int64,
+		"name":  types.LongText,
+		"score": types.Float64,
+		"born":  types.Date,
+	}, schemaTypes(table.Schema()))
+
+	require.Equal(t, []sql.Row{
+		{int64(1), "alice", 9.5, date("1990-01-02")},
+		{int64(2), "bob, jr", float64(7), nil},
+		{int64(3), "", nil, date("2001-12-31")},
+	}, query(t, "SELECT * FROM files.people ORDER BY id", table))
+
+	require.Equal(t, []sql.Row{{int64(2), 16.5}}, query(t, "SELECT count(*), sum(score) FROM files.people WHERE id < 3", table))
+}
+
+func TestJSONL(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "events.jsonl", strings.Join([]string{
+		`{"id": 1, "kind": "click", "value": 1, "ok": true, "tags": ["a"]}`,
+		``,
+		`{"id": 2, "kind": "view", "value": 2.5, "ok": false, "tags": {"b": 1}, "extra": "x"}`,
+		`{"id": 3, "kind": null, "value": 12345678901234567, "tags": null}`,
+	}, "\n"))
+
+	table, err := InferTable("events", JSONL, path)
+	require.NoError(t, err)
+	var names []string
+	for _, col := range table.Schema() {
+		names = append(names, col.Name)
+	}
+	require.Equal(t, []string{"id", "kind", "value", "ok", "tags", "extra"}, names)
+	require.Equal(t, map[string]sql.Type{
+		"id":    types.Int64,
+		"kind":  types.LongText,
+		"value": types.Float64,
+		"ok":    types.Boolean,
+		"tags":  types.JSON,
+		"extra": types.LongText,
+	}, schemaTypes(table.Schema()))
+
+	require.Equal(t, []sql.Row{
+		{int64(1), "click", int8(1), "[\"a\"]", nil},
+		{int64(2), "view", int8(0), "{\"b\": 1}", "x"},
+		{int64(3), nil, nil, nil, nil},
+	}, query(t, "SELECT id, kind, ok, cast(tags as char), extra FROM files.events ORDER BY id", table))
+
+	_, err = InferTable("bad", JSONL, writeFile(t, dir, "bad.jsonl", "[1, 2]\n"))
+	require.Error(t, err)
+}
+
+func TestExplicitSchema(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "1.csv", "b,a\nx,1\n")
+	writeFile(t, dir, "2.csv", "a\n2\n")
+	pattern := filepath.Join(dir, "*.csv")
+
+	ctx := sql.NewEmptyContext()
+	table, err := NewTableFromDDL(ctx, CSV, []string{pattern}, "CREATE TABLE t (a int PRIMARY KEY, b varchar(10), c decimal(5,2))")
+	require.NoError(t, err)
+	require.Equal(t, "t", table.Name())
+	require.Equal(t, []int{0}, table.PrimaryKeySchema().PkOrdinals)
+	require.Equal(t, []sql.Row{
+		{int32(1), "x", nil},
+		{int32(2), nil, nil},
+	}, query(t, "SELECT * FROM files.t ORDER BY a", table))
+
+	// columns the table doesn't have aren't projected
+	projected := table.WithProjections([]string{"c", "nosuchcolumn", "a"})
+	require.Equal(t, []string{"c", "a"}, projected.(*Table).Projections())
+	require.Equal(t, []string{"c", "a"}, []string{projected.Schema()[0].Name, projected.Schema()[1].Name})
+
+	table, err = NewTable("u", JSONL, []string{writeFile(t, dir, "u.jsonl", `{"a": "12", "b": 3}`)}, sql.Schema{
+		{Name: "a", Type: types.Int64, Nullable: true},
+		{Name: "b", Type: types.Text, Nullable: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{int64(12), "3"}}, query(t, "SELECT * FROM files.u", table))
+
+	_, err = NewTable("none", CSV, []string{filepath.Join(dir, "*.parquet")}, nil)
+	require.True(t, ErrNoFiles.Is(err))
+}
+
+type parquetRow struct {
+	ID    int64    `parquet:"name=id, type=INT64"`
+	Name  *string  `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Score *float64 `parquet:"name=score, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Born  int32    `parquet:"name=born, type=INT32, convertedtype=DATE"`
+	Price int64    `parquet:"name=price, type=INT64, convertedtype=DECIMAL, scale=2, precision=10"`
+	Count int32    `parquet:"name=count, type=INT32, convertedtype=UINT_32"`
+}
+
+// writeParquet writes the rows with the ids from |start| to |end| to the Parquet file |path|.
+func writeParquet(t *testing.T, path string, start, end int) {
+	fw, err := local.NewLocalFileWriter(path)
+	require.NoError(t, err)
+	pw, err := writer.NewParquetWriter(fw, new(parquetRow), 1)
+	require.NoError(t, err)
+	pw.RowGroupSize = 16 * 1024
+
+	for i := start; i < end; i++ {
+		row := parquetRow{
+			ID:    int64(i),
+			Born:  int32(i % 365),
+			Price: int64(i*100 + 5),
+			Count: -1,
+		}
+		if i%2 == 0 {
+			name := fmt.Sprintf("name%d", i)
+			score := float64(i) / 2
+			row.Name, row.Score = &name, &score
+		}
+		require.NoError(t, pw.Write(row))
+	}
+	require.NoError(t, pw.WriteStop())
+	require.NoError(t, fw.Close())
+}
+
+func TestParquet(t *testing.T) {
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "1.parquet"), 0, 2500)
+	writeParquet(t, filepath.Join(dir, "2.parquet"), 2500, 2503)
+
+	table, err := InferTable("data", Parquet, filepath.Join(dir, "*.parquet"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]sql.Type{
+		"id":    types.Int64,
+		"name":  types.LongText,
+		"score": types.Float64,
+		"born":  types.Date,
+		"price": types.MustCreateDecimalType(10, 2),
+		"count": types.Uint32,
+	}, schemaTypes(table.Schema()))
+
+	require.Equal(t, []sql.Row{
+		{int64(0), "name0", float64(0), date("1970-01-01"), decimal.New(5, -2), uint32(4294967295)},
+		{int64(1), nil, nil, date("1970-01-02"), decimal.New(105, -2), uint32(4294967295)},
+	}, query(t, "SELECT * FROM files.data WHERE id < 2 ORDER BY id", table))
+
+	require.Equal(t, []sql.Row{{int64(2503), int64(1252)}}, query(t, "SELECT count(*), count(name) FROM files.data", table))
+	require.Equal(t, []sql.Row{{"name2502"}}, query(t, "SELECT max(name) FROM files.data WHERE id > 2490", table))
+
+	plan := explain(t, "SELECT name FROM files.data WHERE id = 10", table)
+	require.Contains(t, plan, "columns: [id name]")
+}
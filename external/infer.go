@@ -0,0 +1,118 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// inferenceSampleSize is the number of rows of a file whose values are used to infer the types of its columns.
+const inferenceSampleSize = 1000
+
+// inferredType is the type of a column inferred from its values. Types that appear later can hold the values of the
+// types before them they are merged with.
+type inferredType byte
+
+const (
+	inferredNull inferredType = iota
+	inferredBool
+	inferredInt
+	inferredFloat
+	inferredDate
+	inferredDatetime
+	inferredText
+	inferredJSON
+)
+
+// merge returns the type of a column with values of the types |t| and |o|. Values of types that can't be merged are
+// held by |fallback|.
+func (t inferredType) merge(o inferredType, fallback inferredType) inferredType {
+	switch {
+	case t == o || o == inferredNull:
+		return t
+	case t == inferredNull:
+		return o
+	case t.isNumber() && o.isNumber():
+		return inferredFloat
+	case t.isTime() && o.isTime():
+		return inferredDatetime
+	default:
+		return fallback
+	}
+}
+
+func (t inferredType) isNumber() bool {
+	return t == inferredInt || t == inferredFloat
+}
+
+func (t inferredType) isTime() bool {
+	return t == inferredDate || t == inferredDatetime
+}
+
+// sqlType returns the type of a column with the inferred type |t|.
+func (t inferredType) sqlType() sql.Type {
+	switch t {
+	case inferredBool:
+		return types.Boolean
+	case inferredInt:
+		return types.Int64
+	case inferredFloat:
+		return types.Float64
+	case inferredDate:
+		return types.Date
+	case inferredDatetime:
+		return types.Datetime
+	case inferredJSON:
+		return types.JSON
+	default:
+		return types.LongText
+	}
+}
+
+// inferTextType returns the type of the text value |s| of a field.
+func inferTextType(s string) inferredType {
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return inferredInt
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return inferredFloat
+	}
+	if _, err := time.Parse("2006-01-02", s); err == nil {
+		return inferredDate
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05.999999", "2006-01-02T15:04:05"} {
+		if _, err := time.Parse(layout, s); err == nil {
+			return inferredDatetime
+		}
+	}
+	return inferredText
+}
+
+// inferredSchema returns the schema of the columns named |names| with the inferred types |inferred|.
+func inferredSchema(names []string, inferred []inferredType) sql.Schema {
+	schema := make(sql.Schema, len(names))
+	for i, name := range names {
+		schema[i] = &sql.Column{
+			Name:     name,
+			Type:     inferred[i].sqlType(),
+			Nullable: true,
+		}
+	}
+	return schema
+}
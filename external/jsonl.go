@@ -0,0 +1,224 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// inferJSONLSchema returns the schema of the JSON Lines file |path|, with a column for each field of its objects in
+// the order they first appear.
+func inferJSONLSchema(path string) (sql.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var names []string
+	var inferred []inferredType
+	positions := make(map[string]int)
+
+	r := newJSONLReader(f)
+	for n := 0; n < inferenceSampleSize; n++ {
+		keys, values, err := r.next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for i, key := range keys {
+			pos, ok := positions[key]
+			if !ok {
+				pos = len(names)
+				positions[key] = pos
+				names = append(names, key)
+				inferred = append(inferred, inferredNull)
+			}
+			inferred[pos] = inferred[pos].merge(inferJSONType(values[i]), inferredJSON)
+		}
+	}
+	if len(names) == 0 {
+		return nil, ErrUnsupportedSchema.New(path, "no fields")
+	}
+	return inferredSchema(names, inferred), nil
+}
+
+// inferJSONType returns the type of the JSON value |raw|.
+func inferJSONType(raw json.RawMessage) inferredType {
+	switch raw[0] {
+	case 'n':
+		return inferredNull
+	case 't', 'f':
+		return inferredBool
+	case '"':
+		return inferredText
+	case '{', '[':
+		return inferredJSON
+	default:
+		if _, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+			return inferredInt
+		}
+		return inferredFloat
+	}
+}
+
+// jsonlReader reads the objects of a JSON Lines file.
+type jsonlReader struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	return &jsonlReader{reader: bufio.NewReader(r)}
+}
+
+// next returns the keys and the values of the fields of the next object, skipping blank lines.
+func (r *jsonlReader) next() ([]string, []json.RawMessage, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, nil, err
+		}
+		r.line++
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		keys, values, err := decodeObject(line)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		return keys, values, nil
+	}
+}
+
+// decodeObject returns the keys and the values of the fields of the JSON object |data|, in order.
+func decodeObject(data []byte) ([]string, []json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, nil, err
+	} else if tok != json.Delim('{') {
+		return nil, nil, fmt.Errorf("expected a JSON object")
+	}
+
+	var keys []string
+	var values []json.RawMessage
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, tok.(string))
+		values = append(values, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// jsonlIter returns the rows of a JSON Lines file.
+type jsonlIter struct {
+	path   string
+	file   *os.File
+	reader *jsonlReader
+	schema sql.Schema
+}
+
+var _ sql.RowIter = (*jsonlIter)(nil)
+
+func newJSONLIter(path string, schema sql.Schema) (*jsonlIter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonlIter{
+		path:   path,
+		file:   f,
+		reader: newJSONLReader(f),
+		schema: schema,
+	}, nil
+}
+
+// Next implements the interface sql.RowIter.
+func (i *jsonlIter) Next(ctx *sql.Context) (sql.Row, error) {
+	keys, values, err := i.reader.next()
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, fmt.Errorf("%s: %w", i.path, err)
+	}
+
+	row := make(sql.Row, len(i.schema))
+	for j, idx := range columnIndexes(i.schema, keys) {
+		if idx < 0 {
+			continue
+		}
+		v, err := jsonValue(i.schema[j].Type, values[idx])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", i.path, err)
+		}
+		if v == nil {
+			continue
+		}
+		if row[j], err = convert(i.path, i.schema[j], v); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// jsonValue returns the JSON value |raw| as a value to convert to the type |typ|.
+func jsonValue(typ sql.Type, raw json.RawMessage) (interface{}, error) {
+	if raw[0] == 'n' {
+		return nil, nil
+	}
+	if types.IsJSON(typ) {
+		return []byte(raw), nil
+	}
+
+	switch raw[0] {
+	case '"':
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, err
+	case 't':
+		return true, nil
+	case 'f':
+		return false, nil
+	default:
+		// Numbers are converted from their text to keep their precision, objects and arrays are text
+		return string(raw), nil
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (i *jsonlIter) Close(ctx *sql.Context) error {
+	return i.file.Close()
+}
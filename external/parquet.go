@@ -0,0 +1,416 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// parquetBatchSize is the number of rows read from each column of a Parquet file at a time.
+const parquetBatchSize = 1024
+
+// parquetKind is the kind of the values of a Parquet column, which determines how they are read.
+type parquetKind byte
+
+const (
+	parquetPlain parquetKind = iota
+	parquetUnsigned
+	parquetString
+	parquetBytes
+	parquetDecimal
+	parquetDate
+	parquetTimestampMillis
+	parquetTimestampMicros
+	parquetTimestampNanos
+	parquetInt96
+)
+
+// parquetColumn is a column of a Parquet file.
+type parquetColumn struct {
+	name  string
+	path  string
+	kind  parquetKind
+	scale int32
+	typ   sql.Type
+}
+
+// parquetSchema returns the schema of the Parquet file |path|, read from its metadata.
+func parquetSchema(path string) (sql.Schema, error) {
+	pr, err := openParquet(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closeParquet(pr)
+
+	columns, err := parquetColumns(path, pr)
+	if err != nil {
+		return nil, err
+	}
+	schema := make(sql.Schema, len(columns))
+	for i, c := range columns {
+		schema[i] = &sql.Column{
+			Name:     c.name,
+			Type:     c.typ,
+			Nullable: true,
+		}
+	}
+	return schema, nil
+}
+
+func openParquet(path string) (*reader.ParquetReader, error) {
+	f, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := reader.NewParquetColumnReader(f, 1)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return pr, nil
+}
+
+func closeParquet(pr *reader.ParquetReader) error {
+	pr.ReadStop()
+	return pr.PFile.Close()
+}
+
+// parquetColumns returns the columns of the Parquet file |path| read by |pr|.
+func parquetColumns(path string, pr *reader.ParquetReader) ([]parquetColumn, error) {
+	elements := pr.Footer.GetSchema()
+	if len(elements) == 0 {
+		return nil, ErrUnsupportedSchema.New(path, "no schema")
+	}
+
+	root := elements[0]
+	if int(root.GetNumChildren()) != len(elements)-1 {
+		return nil, ErrUnsupportedSchema.New(path, "nested columns")
+	}
+
+	columns := make([]parquetColumn, len(elements)-1)
+	for i, e := range elements[1:] {
+		if e.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			return nil, ErrUnsupportedSchema.New(path, fmt.Sprintf("repeated column %s", pr.SchemaHandler.GetExName(i+1)))
+		}
+		// The elements of the schema have been renamed by the reader, which keeps their original names apart
+		name := pr.SchemaHandler.GetExName(i + 1)
+		c, err := newParquetColumn(name, e)
+		if err != nil {
+			return nil, ErrUnsupportedSchema.New(path, err.Error())
+		}
+		c.path = common.PathToStr([]string{root.GetName(), e.GetName()})
+		columns[i] = c
+	}
+	return columns, nil
+}
+
+// newParquetColumn returns the column named |name| for the element |e| of the schema of a Parquet file.
+func newParquetColumn(name string, e *parquet.SchemaElement) (parquetColumn, error) {
+	c := parquetColumn{name: name}
+	unsupported := func() (parquetColumn, error) {
+		return parquetColumn{}, fmt.Errorf("column %s has unsupported type %s", name, e.GetType())
+	}
+
+	if lt := e.GetLogicalType(); lt != nil {
+		switch {
+		case lt.IsSetSTRING(), lt.IsSetENUM():
+			c.kind, c.typ = parquetString, types.LongText
+			return c, nil
+		case lt.IsSetJSON():
+			c.kind, c.typ = parquetString, types.JSON
+			return c, nil
+		case lt.IsSetDATE():
+			c.kind, c.typ = parquetDate, types.Date
+			return c, nil
+		case lt.IsSetTIMESTAMP():
+			c.typ = types.Datetime
+			unit := lt.GetTIMESTAMP().GetUnit()
+			switch {
+			case unit.IsSetMILLIS():
+				c.kind = parquetTimestampMillis
+			case unit.IsSetMICROS():
+				c.kind = parquetTimestampMicros
+			default:
+				c.kind = parquetTimestampNanos
+			}
+			return c, nil
+		case lt.IsSetDECIMAL():
+			return decimalColumn(c, lt.GetDECIMAL().GetPrecision(), lt.GetDECIMAL().GetScale())
+		case lt.IsSetINTEGER():
+			it := lt.GetINTEGER()
+			if !it.GetIsSigned() {
+				c.kind = parquetUnsigned
+			}
+			c.typ = integerType(it.GetBitWidth(), it.GetIsSigned())
+			return c, nil
+		case lt.IsSetTIME(), lt.IsSetMAP(), lt.IsSetLIST(), lt.IsSetBSON():
+			return unsupported()
+		}
+	}
+
+	if e.IsSetConvertedType() {
+		switch e.GetConvertedType() {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_ENUM:
+			c.kind, c.typ = parquetString, types.LongText
+		case parquet.ConvertedType_JSON:
+			c.kind, c.typ = parquetString, types.JSON
+		case parquet.ConvertedType_DATE:
+			c.kind, c.typ = parquetDate, types.Date
+		case parquet.ConvertedType_TIMESTAMP_MILLIS:
+			c.kind, c.typ = parquetTimestampMillis, types.Datetime
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			c.kind, c.typ = parquetTimestampMicros, types.Datetime
+		case parquet.ConvertedType_DECIMAL:
+			return decimalColumn(c, e.GetPrecision(), e.GetScale())
+		case parquet.ConvertedType_INT_8:
+			c.typ = types.Int8
+		case parquet.ConvertedType_INT_16:
+			c.typ = types.Int16
+		case parquet.ConvertedType_INT_32:
+			c.typ = types.Int32
+		case parquet.ConvertedType_INT_64:
+			c.typ = types.Int64
+		case parquet.ConvertedType_UINT_8:
+			c.kind, c.typ = parquetUnsigned, types.Uint8
+		case parquet.ConvertedType_UINT_16:
+			c.kind, c.typ = parquetUnsigned, types.Uint16
+		case parquet.ConvertedType_UINT_32:
+			c.kind, c.typ = parquetUnsigned, types.Uint32
+		case parquet.ConvertedType_UINT_64:
+			c.kind, c.typ = parquetUnsigned, types.Uint64
+		default:
+			return unsupported()
+		}
+		return c, nil
+	}
+
+	switch e.GetType() {
+	case parquet.Type_BOOLEAN:
+		c.typ = types.Boolean
+	case parquet.Type_INT32:
+		c.typ = types.Int32
+	case parquet.Type_INT64:
+		c.typ = types.Int64
+	case parquet.Type_INT96:
+		c.kind, c.typ = parquetInt96, types.Datetime
+	case parquet.Type_FLOAT:
+		c.typ = types.Float32
+	case parquet.Type_DOUBLE:
+		c.typ = types.Float64
+	case parquet.Type_BYTE_ARRAY:
+		c.kind, c.typ = parquetBytes, types.LongBlob
+	case parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		c.kind, c.typ = parquetBytes, types.MustCreateBinary(sqltypes.Binary, int64(e.GetTypeLength()))
+	default:
+		return unsupported()
+	}
+	return c, nil
+}
+
+func decimalColumn(c parquetColumn, precision, scale int32) (parquetColumn, error) {
+	typ, err := types.CreateDecimalType(uint8(precision), uint8(scale))
+	if err != nil || precision > types.DecimalTypeMaxPrecision {
+		return parquetColumn{}, fmt.Errorf("column %s has unsupported decimal(%d,%d) type", c.name, precision, scale)
+	}
+	c.kind, c.scale, c.typ = parquetDecimal, scale, typ
+	return c, nil
+}
+
+func integerType(bitWidth int8, signed bool) sql.Type {
+	switch {
+	case bitWidth <= 8 && signed:
+		return types.Int8
+	case bitWidth <= 8:
+		return types.Uint8
+	case bitWidth <= 16 && signed:
+		return types.Int16
+	case bitWidth <= 16:
+		return types.Uint16
+	case bitWidth <= 32 && signed:
+		return types.Int32
+	case bitWidth <= 32:
+		return types.Uint32
+	case signed:
+		return types.Int64
+	default:
+		return types.Uint64
+	}
+}
+
+// value returns the value |v| read from the column as a value to convert to the type of a table column.
+func (c parquetColumn) value(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch c.kind {
+	case parquetUnsigned:
+		switch v := v.(type) {
+		case int32:
+			return uint64(uint32(v))
+		case int64:
+			return uint64(v)
+		}
+	case parquetBytes:
+		return []byte(v.(string))
+	case parquetDecimal:
+		switch v := v.(type) {
+		case int32:
+			return decimal.New(int64(v), -c.scale)
+		case int64:
+			return decimal.New(v, -c.scale)
+		case string:
+			// Big-endian two's complement unscaled value
+			unscaled := new(big.Int).SetBytes([]byte(v))
+			if len(v) > 0 && v[0]&0x80 != 0 {
+				unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(v)*8)))
+			}
+			return decimal.NewFromBigInt(unscaled, -c.scale)
+		}
+	case parquetDate:
+		return time.Unix(int64(v.(int32))*24*60*60, 0).UTC()
+	case parquetTimestampMillis:
+		return time.UnixMilli(v.(int64)).UTC()
+	case parquetTimestampMicros:
+		return time.UnixMicro(v.(int64)).UTC()
+	case parquetTimestampNanos:
+		return time.Unix(0, v.(int64)).UTC()
+	case parquetInt96:
+		// Nanoseconds of the day followed by the Julian day, both little-endian
+		b := []byte(v.(string))
+		nanos := int64(binary.LittleEndian.Uint64(b[:8]))
+		days := int64(binary.LittleEndian.Uint32(b[8:])) - 2440588
+		return time.Unix(days*24*60*60, nanos).UTC()
+	}
+	return v
+}
+
+// parquetIter returns the rows of a Parquet file, reading only the columns of the file in its schema.
+type parquetIter struct {
+	path      string
+	reader    *reader.ParquetReader
+	schema    sql.Schema
+	columns   []*parquetColumn
+	remaining int64
+	batch     [][]interface{}
+	batchLen  int
+	pos       int
+}
+
+var _ sql.RowIter = (*parquetIter)(nil)
+
+func newParquetIter(path string, schema sql.Schema) (*parquetIter, error) {
+	pr, err := openParquet(path)
+	if err != nil {
+		return nil, err
+	}
+	fileColumns, err := parquetColumns(path, pr)
+	if err != nil {
+		closeParquet(pr)
+		return nil, err
+	}
+
+	columns := make([]*parquetColumn, len(schema))
+	for i, col := range schema {
+		for j := range fileColumns {
+			if strings.EqualFold(col.Name, fileColumns[j].name) {
+				columns[i] = &fileColumns[j]
+				break
+			}
+		}
+	}
+
+	return &parquetIter{
+		path:      path,
+		reader:    pr,
+		schema:    schema,
+		columns:   columns,
+		remaining: pr.GetNumRows(),
+		batch:     make([][]interface{}, len(schema)),
+	}, nil
+}
+
+// Next implements the interface sql.RowIter.
+func (i *parquetIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.remaining == 0 {
+		return nil, io.EOF
+	}
+	if i.pos == i.batchLen {
+		if err := i.readBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := make(sql.Row, len(i.schema))
+	for j, c := range i.columns {
+		if c == nil {
+			continue
+		}
+		v := c.value(i.batch[j][i.pos])
+		if v == nil {
+			continue
+		}
+		var err error
+		if row[j], err = convert(i.path, i.schema[j], v); err != nil {
+			return nil, err
+		}
+	}
+	i.pos++
+	i.remaining--
+	return row, nil
+}
+
+// readBatch reads the values of the next rows of each column.
+func (i *parquetIter) readBatch() error {
+	n := i.remaining
+	if n > parquetBatchSize {
+		n = parquetBatchSize
+	}
+	for j, c := range i.columns {
+		if c == nil {
+			i.batch[j] = make([]interface{}, n)
+			continue
+		}
+		values, _, _, err := i.reader.ReadColumnByPath(c.path, n)
+		if err != nil {
+			return fmt.Errorf("%s: column %s: %w", i.path, c.name, err)
+		}
+		if int64(len(values)) != n {
+			return fmt.Errorf("%s: column %s: expected %d values, read %d", i.path, c.name, n, len(values))
+		}
+		i.batch[j] = values
+	}
+	i.batchLen, i.pos = int(n), 0
+	return nil
+}
+
+// Close implements the interface sql.RowIter.
+func (i *parquetIter) Close(ctx *sql.Context) error {
+	return closeParquet(i.reader)
+}
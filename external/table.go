@@ -0,0 +1,297 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package external provides read-only tables whose rows are read from files in CSV, JSON Lines or Parquet format
+// whenever they are queried, so that data files can be queried directly without loading them into a database first.
+// Each file of a table is one of its partitions.
+package external
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// Format is the format of the files of a table.
+type Format byte
+
+const (
+	// CSV is the format of comma-separated values files, whose first record is a header with the names of the columns.
+	// Fields that are \N, and empty fields of columns that aren't strings, are NULL.
+	CSV Format = iota
+	// JSONL is the format of JSON Lines files, with a JSON object per line whose fields are the columns of a row.
+	JSONL
+	// Parquet is the format of Apache Parquet files, whose columns must not be nested.
+	Parquet
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+	switch f {
+	case CSV:
+		return "csv"
+	case JSONL:
+		return "jsonl"
+	case Parquet:
+		return "parquet"
+	default:
+		return fmt.Sprintf("unknown format %d", byte(f))
+	}
+}
+
+// ErrNoFiles is returned when the patterns of the files of a table match no file.
+var ErrNoFiles = errors.NewKind("no files match %v")
+
+// ErrUnsupportedSchema is returned when the schema of a file can't be read as a table schema.
+var ErrUnsupportedSchema = errors.NewKind("unsupported schema in file %s: %s")
+
+// Table is a read-only table whose rows are read from a set of files with the same format, one partition per file.
+type Table struct {
+	name   string
+	format Format
+	paths  []string
+	schema sql.PrimaryKeySchema
+
+	projection      []string
+	projectedSchema sql.Schema
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.PrimaryKeyTable = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+
+// NewTable returns a table named |name| with the schema given, whose rows are read from the files in the format
+// |format| that match the glob patterns |patterns|. The columns of the schema are read from the fields of the files
+// with the same names, and are NULL in the files without them.
+func NewTable(name string, format Format, patterns []string, schema sql.Schema) (*Table, error) {
+	paths, err := glob(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return newTable(name, format, paths, sql.NewPrimaryKeySchema(schema)), nil
+}
+
+// NewTableFromDDL returns a table with the name and the schema of the CREATE TABLE statement |createTable|, whose rows
+// are read from the files in the format |format| that match the glob patterns |patterns|.
+func NewTableFromDDL(ctx *sql.Context, format Format, patterns []string, createTable string) (*Table, error) {
+	node, err := parse.Parse(ctx, createTable)
+	if err != nil {
+		return nil, err
+	}
+	ct, ok := node.(*plan.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("expected a CREATE TABLE statement: %s", createTable)
+	}
+
+	paths, err := glob(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return newTable(ct.Name(), format, paths, ct.PkSchema()), nil
+}
+
+// InferTable returns a table named |name| whose rows are read from the files in the format |format| that match the
+// glob patterns |patterns|, and whose schema is inferred from the first of them. The schema of Parquet files is read
+// from their metadata. The types of the columns of CSV and JSON Lines files are inferred from the values of their
+// first rows.
+func InferTable(name string, format Format, patterns ...string) (*Table, error) {
+	paths, err := glob(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema sql.Schema
+	switch format {
+	case CSV:
+		schema, err = inferCSVSchema(paths[0])
+	case JSONL:
+		schema, err = inferJSONLSchema(paths[0])
+	case Parquet:
+		schema, err = parquetSchema(paths[0])
+	default:
+		err = fmt.Errorf("unknown format %d", byte(format))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newTable(name, format, paths, sql.NewPrimaryKeySchema(schema)), nil
+}
+
+func newTable(name string, format Format, paths []string, schema sql.PrimaryKeySchema) *Table {
+	columns := make(sql.Schema, len(schema.Schema))
+	for i, col := range schema.Schema {
+		c := *col
+		c.Source = name
+		columns[i] = &c
+	}
+	return &Table{
+		name:   name,
+		format: format,
+		paths:  paths,
+		schema: sql.NewPrimaryKeySchema(columns, schema.PkOrdinals...),
+	}
+}
+
+// glob returns the sorted paths of the files matching |patterns|.
+func glob(patterns []string) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range matches {
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, ErrNoFiles.New(patterns)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Name implements the interface sql.Table.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// String implements the interface sql.Table.
+func (t *Table) String() string {
+	return t.name
+}
+
+// Format returns the format of the files of the table.
+func (t *Table) Format() Format {
+	return t.format
+}
+
+// Paths returns the paths of the files of the table.
+func (t *Table) Paths() []string {
+	return t.paths
+}
+
+// Schema implements the interface sql.Table.
+func (t *Table) Schema() sql.Schema {
+	if t.projectedSchema != nil {
+		return t.projectedSchema
+	}
+	return t.schema.Schema
+}
+
+// PrimaryKeySchema implements the interface sql.PrimaryKeyTable.
+func (t *Table) PrimaryKeySchema() sql.PrimaryKeySchema {
+	return t.schema
+}
+
+// Collation implements the interface sql.Table.
+func (t *Table) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Projections implements the interface sql.ProjectedTable.
+func (t *Table) Projections() []string {
+	return t.projection
+}
+
+// WithProjections implements the interface sql.ProjectedTable. Only the projected columns of Parquet files are read,
+// while the other formats have to be read in full.
+func (t *Table) WithProjections(colNames []string) sql.Table {
+	// Columns the table doesn't have, such as the ones of other tables in a join, are skipped rather than projected
+	projection := make([]string, 0, len(colNames))
+	projectedSchema := make(sql.Schema, 0, len(colNames))
+	for _, name := range colNames {
+		idx := t.schema.Schema.IndexOfColName(name)
+		if idx < 0 {
+			continue
+		}
+		projection = append(projection, name)
+		projectedSchema = append(projectedSchema, t.schema.Schema[idx])
+	}
+
+	nt := *t
+	nt.projection = projection
+	nt.projectedSchema = projectedSchema
+	return &nt
+}
+
+// Partitions implements the interface sql.Table.
+func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	partitions := make([]sql.Partition, len(t.paths))
+	for i, path := range t.paths {
+		partitions[i] = filePartition(path)
+	}
+	return sql.PartitionsToPartitionIter(partitions...), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	path := string(partition.(filePartition))
+	switch t.format {
+	case CSV:
+		return newCSVIter(path, t.Schema())
+	case JSONL:
+		return newJSONLIter(path, t.Schema())
+	case Parquet:
+		return newParquetIter(path, t.Schema())
+	default:
+		return nil, fmt.Errorf("unknown format %d", byte(t.format))
+	}
+}
+
+// filePartition is a partition of a table, which is the path of one of its files.
+type filePartition string
+
+var _ sql.Partition = filePartition("")
+
+// Key implements the interface sql.Partition.
+func (p filePartition) Key() []byte {
+	return []byte(p)
+}
+
+// columnIndexes returns, for each column of |schema|, the position of the field of a file with the same name in
+// |fields|, or -1 if there's none.
+func columnIndexes(schema sql.Schema, fields []string) []int {
+	indexes := make([]int, len(schema))
+	for i, col := range schema {
+		indexes[i] = -1
+		for j, field := range fields {
+			if strings.EqualFold(col.Name, field) {
+				indexes[i] = j
+				break
+			}
+		}
+	}
+	return indexes
+}
+
+// convert converts the value |v| of the field of a file to the type of the column |col|.
+func convert(path string, col *sql.Column, v interface{}) (interface{}, error) {
+	ret, _, err := col.Type.Convert(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: column %s: %w", path, col.Name, err)
+	}
+	return ret, nil
+}
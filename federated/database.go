@@ -0,0 +1,157 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package federated provides databases whose tables are stored in a remote MySQL server, which is queried over the
+// wire protocol whenever their rows are read. Filters, projections, sorts and limits on the tables are sent to the
+// remote server when possible, so that only the rows needed are transferred.
+package federated
+
+import (
+	dsql "database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// Database is a read-only database whose tables are the tables of a database of a remote MySQL server.
+type Database struct {
+	name   string
+	remote string
+	conn   *dsql.DB
+}
+
+var _ sql.Database = (*Database)(nil)
+
+// NewDatabase returns a database named |name| whose tables are the tables of the database |remote| of the MySQL server
+// that |conn| connects to.
+func NewDatabase(name string, conn *dsql.DB, remote string) *Database {
+	return &Database{
+		name:   name,
+		remote: remote,
+		conn:   conn,
+	}
+}
+
+// Open returns a database named |name| whose tables are the tables of the database of the data source name |dsn|,
+// as accepted by github.com/go-sql-driver/mysql, e.g. "user:password@tcp(host:3306)/database".
+func Open(name, dsn string) (*Database, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DBName == "" {
+		return nil, fmt.Errorf("federated database %s: no remote database in data source name", name)
+	}
+
+	conn, err := dsql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	return NewDatabase(name, conn, cfg.DBName), nil
+}
+
+// Name implements the interface sql.Database.
+func (d *Database) Name() string {
+	return d.name
+}
+
+// Close closes the connections to the remote server.
+func (d *Database) Close() error {
+	return d.conn.Close()
+}
+
+// GetTableInsensitive implements the interface sql.Database.
+func (d *Database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	names, err := d.GetTableNames(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, name := range names {
+		if strings.EqualFold(name, tblName) {
+			table, err := d.remoteTable(ctx, name)
+			if err != nil {
+				return nil, false, err
+			}
+			return table, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// GetTableNames implements the interface sql.Database.
+func (d *Database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	rows, err := d.conn.QueryContext(ctx, fmt.Sprintf("SHOW TABLES FROM %s", quoteIdentifier(d.remote)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// remoteTable returns the table for the remote table |name|, whose schema is read from its CREATE TABLE statement.
+func (d *Database) remoteTable(ctx *sql.Context, name string) (*Table, error) {
+	var tableName, createStmt string
+	row := d.conn.QueryRowContext(ctx, fmt.Sprintf("SHOW CREATE TABLE %s", d.qualify(name)))
+	if err := row.Scan(&tableName, &createStmt); err != nil {
+		return nil, err
+	}
+
+	node, err := parse.Parse(sql.NewEmptyContext(), createStmt)
+	if err != nil {
+		return nil, err
+	}
+	ct, ok := node.(*plan.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("federated table %s: unexpected CREATE TABLE statement: %s", name, createStmt)
+	}
+
+	pkSchema := ct.PkSchema()
+	schema := make(sql.Schema, len(pkSchema.Schema))
+	for i, col := range pkSchema.Schema {
+		c := *col
+		// Values are generated by the remote server, whose tables can't be written to
+		c.Source = name
+		c.DatabaseSource = d.name
+		c.Default = nil
+		c.AutoIncrement = false
+		c.Extra = ""
+		schema[i] = &c
+	}
+	return newTable(d, name, sql.NewPrimaryKeySchema(schema, pkSchema.PkOrdinals...), ct.Collation), nil
+}
+
+// qualify returns the quoted name of the remote table |name|.
+func (d *Database) qualify(name string) string {
+	return quoteIdentifier(d.remote) + "." + quoteIdentifier(name)
+}
+
+// quoteIdentifier quotes |name| to be used as an identifier in a query.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
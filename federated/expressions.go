@@ -0,0 +1,174 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// exprToSQL returns the SQL text of |e|, to be sent to the remote server in a query on a table with the schema
+// |schema|, or false if the expression can't be sent to the remote server. Only boolean operators, comparisons and
+// literals of basic types are supported.
+func exprToSQL(e sql.Expression, schema sql.Schema) (string, bool) {
+	switch e := e.(type) {
+	case *expression.GetField:
+		if schema.IndexOfColName(e.Name()) < 0 {
+			return "", false
+		}
+		return quoteIdentifier(e.Name()), true
+	case *expression.Literal:
+		return literalToSQL(e.Value())
+	case *expression.And:
+		return binaryToSQL(e.Left, "AND", e.Right, schema)
+	case *expression.Or:
+		return binaryToSQL(e.Left, "OR", e.Right, schema)
+	case *expression.Not:
+		child, ok := exprToSQL(e.Child, schema)
+		if !ok {
+			return "", false
+		}
+		return "(NOT " + child + ")", true
+	case *expression.IsNull:
+		child, ok := exprToSQL(e.Child, schema)
+		if !ok {
+			return "", false
+		}
+		return "(" + child + " IS NULL)", true
+	case *expression.Equals:
+		return binaryToSQL(e.Left(), "=", e.Right(), schema)
+	case *expression.NullSafeEquals:
+		return binaryToSQL(e.Left(), "<=>", e.Right(), schema)
+	case *expression.GreaterThan:
+		return binaryToSQL(e.Left(), ">", e.Right(), schema)
+	case *expression.GreaterThanOrEqual:
+		return binaryToSQL(e.Left(), ">=", e.Right(), schema)
+	case *expression.LessThan:
+		return binaryToSQL(e.Left(), "<", e.Right(), schema)
+	case *expression.LessThanOrEqual:
+		return binaryToSQL(e.Left(), "<=", e.Right(), schema)
+	case *expression.InTuple:
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return "", false
+		}
+		return binaryToSQL(e.Left(), "IN", tuple, schema)
+	case expression.Tuple:
+		values := make([]string, len(e))
+		for i, child := range e {
+			value, ok := exprToSQL(child, schema)
+			if !ok {
+				return "", false
+			}
+			values[i] = value
+		}
+		return "(" + strings.Join(values, ", ") + ")", true
+	case *expression.Like:
+		like, ok := binaryToSQL(e.Left, "LIKE", e.Right, schema)
+		if !ok || e.Escape == nil {
+			return like, ok
+		}
+		escape, ok := e.Escape.(*expression.Literal)
+		if !ok {
+			return "", false
+		}
+		escapeSQL, ok := literalToSQL(escape.Value())
+		if !ok {
+			return "", false
+		}
+		return "(" + like + " ESCAPE " + escapeSQL + ")", true
+	default:
+		return "", false
+	}
+}
+
+// binaryToSQL returns the SQL text of the binary operator |op| applied to |left| and |right|.
+func binaryToSQL(left sql.Expression, op string, right sql.Expression, schema sql.Schema) (string, bool) {
+	l, ok := exprToSQL(left, schema)
+	if !ok {
+		return "", false
+	}
+	r, ok := exprToSQL(right, schema)
+	if !ok {
+		return "", false
+	}
+	return "(" + l + " " + op + " " + r + ")", true
+}
+
+// literalToSQL returns the SQL text of the literal value |v|.
+func literalToSQL(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "NULL", true
+	case bool:
+		if v {
+			return "TRUE", true
+		}
+		return "FALSE", true
+	case int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint:
+		return fmt.Sprintf("%d", v), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case decimal.Decimal:
+		return v.String(), true
+	case string:
+		return quoteString(v), true
+	case []byte:
+		if len(v) == 0 {
+			return "''", true
+		}
+		return "X'" + hex.EncodeToString(v) + "'", true
+	case time.Time:
+		return quoteString(v.Format("2006-01-02 15:04:05.999999")), true
+	default:
+		return "", false
+	}
+}
+
+// quoteString quotes |s| to be used as a string literal in a query.
+func quoteString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case 0:
+			sb.WriteString(`\0`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\x1a':
+			sb.WriteString(`\Z`)
+		case '\'':
+			sb.WriteString(`\'`)
+		case '\\':
+			sb.WriteString(`\\`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('\'')
+	return sb.String()
+}
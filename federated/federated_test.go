@@ -0,0 +1,234 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// startRemote starts a server for a memory database named mydb, and returns the data source name to connect to it.
+func startRemote(t *testing.T) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	engine := sqle.New(analyzer.NewDefault(memory.NewDBProvider(memory.NewDatabase("mydb"))), &sqle.Config{
+		IncludeRootAccount: true,
+	})
+	srv, err := server.NewDefaultServer(server.Config{
+		Protocol: "tcp",
+		Address:  fmt.Sprintf("localhost:%d", port),
+	}, engine)
+	require.NoError(t, err)
+	go srv.Start()
+	t.Cleanup(func() {
+		srv.Close()
+	})
+
+	return fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port)
+}
+
+func TestFederatedTables(t *testing.T) {
+	dsn := startRemote(t)
+	db, err := Open("fed", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, stmt := range []string{
+		"CREATE TABLE people (id int PRIMARY KEY, name varchar(20), score double, born date, photo blob)",
+		`INSERT INTO people VALUES
+			(1, 'alice', 9.5, '1990-01-02', 0x0102),
+			(2, 'bob', 7.25, '1985-06-30', NULL),
+			(3, 'carol''s', NULL, NULL, 0xff),
+			(4, 'dave', 8, '2001-12-31', NULL)`,
+	} {
+		_, err := db.conn.Exec(stmt)
+		require.NoError(t, err)
+	}
+
+	engine := sqle.NewDefault(memory.NewDBProvider(db))
+	ctx := sql.NewEmptyContext()
+	query := func(q string) []sql.Row {
+		sch, iter, err := engine.Query(ctx, q)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+	explain := func(q string) string {
+		var lines []string
+		for _, row := range query("EXPLAIN " + q) {
+			lines = append(lines, row[0].(string))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	t.Run("tables", func(t *testing.T) {
+		require.Equal(t, []sql.Row{{"people"}}, query("SHOW TABLES FROM fed"))
+	})
+
+	t.Run("all rows", func(t *testing.T) {
+		require.ElementsMatch(t, []sql.Row{
+			{int32(1), "alice", 9.5, mustDate(t, "1990-01-02"), []byte{1, 2}},
+			{int32(2), "bob", 7.25, mustDate(t, "1985-06-30"), nil},
+			{int32(3), "carol's", nil, nil, []byte{0xff}},
+			{int32(4), "dave", float64(8), mustDate(t, "2001-12-31"), nil},
+		}, query("SELECT * FROM fed.people"))
+	})
+
+	t.Run("filters and projections", func(t *testing.T) {
+		q := "SELECT name FROM fed.people WHERE score > 7.5 OR name = 'carol''s'"
+		require.ElementsMatch(t, []sql.Row{{"alice"}, {"carol's"}, {"dave"}}, query(q))
+		plan := explain(q)
+		require.Contains(t, plan, "filters: [")
+		require.Contains(t, plan, "columns: [name score]")
+		require.NotContains(t, plan, "Filter")
+	})
+
+	t.Run("unsupported filters stay in the engine", func(t *testing.T) {
+		q := "SELECT id FROM fed.people WHERE id > 1 AND upper(name) = 'BOB'"
+		require.Equal(t, []sql.Row{{int32(2)}}, query(q))
+		plan := explain(q)
+		require.Contains(t, plan, "filters: [(people.id > 1)]")
+		require.Contains(t, plan, "Filter")
+		// the filter left above the table is evaluated by the engine, and the ones under its remote source remotely
+		require.Equal(t, strings.Join([]string{
+			"Project",
+			" ├─ columns: [people.id]",
+			" └─ Filter",
+			"     ├─ (upper(people.name) = 'BOB')",
+			"     └─ Table",
+			"         ├─ name: people",
+			"         └─ remote: `mydb`.`people`",
+			"             ├─ columns: [id name]",
+			"             ├─ filters: [(people.id > 1)]",
+			"             └─ query: SELECT `id`, `name` FROM `mydb`.`people` WHERE (`id` > 1)",
+		}, "\n"), plan)
+	})
+
+	t.Run("sort and limit", func(t *testing.T) {
+		q := "SELECT id, name FROM fed.people ORDER BY score DESC LIMIT 2 OFFSET 1"
+		require.Equal(t, []sql.Row{{int32(4), "dave"}, {int32(2), "bob"}}, query(q))
+		plan := explain(q)
+		require.Contains(t, plan, "sort: [people.score DESC]")
+		require.Contains(t, plan, "limit: 2, offset: 1")
+		require.NotContains(t, plan, "Limit")
+	})
+
+	t.Run("join with a local table", func(t *testing.T) {
+		local := memory.NewDatabase("mydb")
+		engine := sqle.NewDefault(memory.NewDBProvider(db, local))
+		ctx := sql.NewEmptyContext()
+		for _, q := range []string{
+			"CREATE TABLE mydb.scores (id int PRIMARY KEY, bonus int)",
+			"INSERT INTO mydb.scores VALUES (1, 10), (4, 20)",
+		} {
+			_, iter, err := engine.Query(ctx, q)
+			require.NoError(t, err)
+			_, err = sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+		}
+
+		sch, iter, err := engine.Query(ctx, "SELECT p.name, s.bonus FROM fed.people p JOIN mydb.scores s ON p.id = s.id ORDER BY p.id")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{{"alice", int32(10)}, {"dave", int32(20)}}, rows)
+	})
+}
+
+func TestRemoteQuery(t *testing.T) {
+	db := NewDatabase("fed", nil, "mydb")
+	table := newTable(db, "t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "t"},
+		{Name: "b", Type: types.Text, Source: "t"},
+	}), sql.Collation_Default)
+	a := expression.NewGetFieldWithTable(0, types.Int64, "t", "a", false)
+	b := expression.NewGetFieldWithTable(1, types.Text, "t", "b", false)
+
+	tests := []struct {
+		name     string
+		table    sql.Table
+		expected string
+	}{
+		{
+			name:     "all columns",
+			table:    table,
+			expected: "SELECT `a`, `b` FROM `mydb`.`t`",
+		},
+		{
+			name:     "no columns",
+			table:    table.WithProjections([]string{}),
+			expected: "SELECT 1 FROM `mydb`.`t`",
+		},
+		{
+			name:     "unknown columns",
+			table:    table.WithProjections([]string{"c", "b"}),
+			expected: "SELECT `b` FROM `mydb`.`t`",
+		},
+		{
+			name: "filters",
+			table: table.WithFilters(nil, []sql.Expression{
+				expression.NewGreaterThan(a, expression.NewLiteral(int64(1), types.Int64)),
+				expression.NewInTuple(b, expression.NewTuple(
+					expression.NewLiteral("it's", types.Text),
+					expression.NewLiteral(`back\slash`, types.Text),
+				)),
+			}),
+			expected: "SELECT `a`, `b` FROM `mydb`.`t` WHERE (`a` > 1) AND (`b` IN ('it\\'s', 'back\\\\slash'))",
+		},
+		{
+			name: "sort and limit",
+			table: table.WithProjections([]string{"b"}).(*Table).WithSortFields(sql.SortFields{
+				{Column: a, Order: sql.Descending},
+				{Column: b, Order: sql.Ascending},
+			}).(*Table).WithLimit(10, 5),
+			expected: "SELECT `b` FROM `mydb`.`t` ORDER BY `a` DESC, `b` LIMIT 5, 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := tt.table.(*Table).query()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, query)
+		})
+	}
+
+	require.Empty(t, table.HandledFilters([]sql.Expression{
+		expression.NewEquals(expression.NewGetFieldWithTable(0, types.Int64, "u", "c", false), expression.NewLiteral(int64(1), types.Int64)),
+	}))
+	require.False(t, table.CanSort(sql.SortFields{{Column: a, NullOrdering: sql.NullsLast}}))
+}
+
+func mustDate(t *testing.T, s string) interface{} {
+	d, _, err := types.Date.Convert(s)
+	require.NoError(t, err)
+	return d
+}
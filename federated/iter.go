@@ -0,0 +1,96 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	dsql "database/sql"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// rowIter returns the rows of a query to the remote server, converted to the types of the columns of a table.
+type rowIter struct {
+	rows   *dsql.Rows
+	schema sql.Schema
+}
+
+var _ sql.RowIter = (*rowIter)(nil)
+
+func newRowIter(rows *dsql.Rows, schema sql.Schema) *rowIter {
+	return &rowIter{
+		rows:   rows,
+		schema: schema,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *rowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !i.rows.Next() {
+		if err := i.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	// Queries are sent with the text protocol, so every value is scanned as bytes or nil
+	values := make([]interface{}, len(i.schema))
+	dest := make([]interface{}, len(i.schema))
+	for j := range values {
+		dest[j] = &values[j]
+	}
+	if len(dest) == 0 {
+		dest = []interface{}{new(interface{})}
+	}
+	if err := i.rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	row := make(sql.Row, len(i.schema))
+	for j, col := range i.schema {
+		val, err := convertValue(col.Type, values[j])
+		if err != nil {
+			return nil, err
+		}
+		row[j] = val
+	}
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (i *rowIter) Close(ctx *sql.Context) error {
+	return i.rows.Close()
+}
+
+// convertValue converts the value |v| read from the remote server to the type |typ|.
+func convertValue(typ sql.Type, v interface{}) (interface{}, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		ret, _, err := typ.Convert(v)
+		return ret, err
+	}
+
+	// The bytes of binary values are the values themselves, the others are their text representation
+	if types.IsBinaryType(typ) || types.IsBit(typ) || types.IsGeometry(typ) {
+		ret, _, err := typ.Convert(append([]byte(nil), b...))
+		return ret, err
+	}
+	ret, _, err := typ.Convert(string(b))
+	return ret, err
+}
@@ -0,0 +1,263 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Table is a table of a remote MySQL server. Its rows are read with a query to the remote server, which applies the
+// filters, projections, sort and limit pushed down to the table.
+type Table struct {
+	db        *Database
+	name      string
+	schema    sql.PrimaryKeySchema
+	collation sql.CollationID
+
+	projection      []string
+	projectedSchema sql.Schema
+	filters         []sql.Expression
+	sortFields      sql.SortFields
+	limit           uint64
+	offset          uint64
+	hasLimit        bool
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.PrimaryKeyTable = (*Table)(nil)
+var _ sql.FilteredTable = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.SortableTable = (*Table)(nil)
+var _ sql.LimitedTable = (*Table)(nil)
+var _ sql.RemoteTable = (*Table)(nil)
+
+func newTable(db *Database, name string, schema sql.PrimaryKeySchema, collation sql.CollationID) *Table {
+	if collation == sql.Collation_Unspecified {
+		collation = sql.Collation_Default
+	}
+	return &Table{
+		db:        db,
+		name:      name,
+		schema:    schema,
+		collation: collation,
+	}
+}
+
+// Name implements the interface sql.Table.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// String implements the interface sql.Table.
+func (t *Table) String() string {
+	return t.name
+}
+
+// Schema implements the interface sql.Table.
+func (t *Table) Schema() sql.Schema {
+	if t.projectedSchema != nil {
+		return t.projectedSchema
+	}
+	return t.schema.Schema
+}
+
+// PrimaryKeySchema implements the interface sql.PrimaryKeyTable.
+func (t *Table) PrimaryKeySchema() sql.PrimaryKeySchema {
+	return t.schema
+}
+
+// Collation implements the interface sql.Table.
+func (t *Table) Collation() sql.CollationID {
+	return t.collation
+}
+
+// Filters implements the interface sql.FilteredTable.
+func (t *Table) Filters() []sql.Expression {
+	return t.filters
+}
+
+// HandledFilters implements the interface sql.FilteredTable. The filters handled are those that can be sent to the
+// remote server.
+func (t *Table) HandledFilters(filters []sql.Expression) []sql.Expression {
+	var handled []sql.Expression
+	for _, f := range filters {
+		if _, ok := exprToSQL(f, t.schema.Schema); ok {
+			handled = append(handled, f)
+		}
+	}
+	return handled
+}
+
+// WithFilters implements the interface sql.FilteredTable.
+func (t *Table) WithFilters(ctx *sql.Context, filters []sql.Expression) sql.Table {
+	nt := *t
+	nt.filters = filters
+	return &nt
+}
+
+// Projections implements the interface sql.ProjectedTable.
+func (t *Table) Projections() []string {
+	return t.projection
+}
+
+// WithProjections implements the interface sql.ProjectedTable.
+func (t *Table) WithProjections(colNames []string) sql.Table {
+	// Columns the table doesn't have, such as the ones of other tables in a join, are skipped rather than projected
+	projection := make([]string, 0, len(colNames))
+	projectedSchema := make(sql.Schema, 0, len(colNames))
+	for _, name := range colNames {
+		idx := t.schema.Schema.IndexOfColName(name)
+		if idx < 0 {
+			continue
+		}
+		projection = append(projection, name)
+		projectedSchema = append(projectedSchema, t.schema.Schema[idx])
+	}
+
+	nt := *t
+	nt.projection = projection
+	nt.projectedSchema = projectedSchema
+	return &nt
+}
+
+// SortFields implements the interface sql.SortableTable.
+func (t *Table) SortFields() sql.SortFields {
+	return t.sortFields
+}
+
+// CanSort implements the interface sql.SortableTable. The remote server can sort by columns of the table, putting null
+// values first in ascending order and last in descending order.
+func (t *Table) CanSort(sortFields sql.SortFields) bool {
+	for _, sf := range sortFields {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok || sf.NullOrdering != sql.NullsFirst || t.schema.Schema.IndexOfColName(gf.Name()) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// WithSortFields implements the interface sql.SortableTable.
+func (t *Table) WithSortFields(sortFields sql.SortFields) sql.Table {
+	nt := *t
+	nt.sortFields = sortFields
+	return &nt
+}
+
+// Limit implements the interface sql.LimitedTable.
+func (t *Table) Limit() (uint64, uint64, bool) {
+	return t.limit, t.offset, t.hasLimit
+}
+
+// WithLimit implements the interface sql.LimitedTable.
+func (t *Table) WithLimit(limit, offset uint64) sql.Table {
+	nt := *t
+	nt.limit, nt.offset, nt.hasLimit = limit, offset, true
+	return &nt
+}
+
+// RemoteSource implements the interface sql.RemoteTable.
+func (t *Table) RemoteSource() string {
+	return t.db.qualify(t.name)
+}
+
+// RemoteQuery implements the interface sql.RemoteTable.
+func (t *Table) RemoteQuery() string {
+	query, err := t.query()
+	if err != nil {
+		return ""
+	}
+	return query
+}
+
+// Partitions implements the interface sql.Table. A table has a single partition, read with a single query.
+func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(partition{}), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *Table) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	query, err := t.query()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := t.db.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return newRowIter(rows, t.Schema()), nil
+}
+
+// query returns the query sent to the remote server to read the rows of the table.
+func (t *Table) query() (string, error) {
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	schema := t.Schema()
+	if len(schema) == 0 {
+		sb.WriteString("1")
+	}
+	for i, col := range schema {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(quoteIdentifier(col.Name))
+	}
+	sb.WriteString(" FROM ")
+	sb.WriteString(t.db.qualify(t.name))
+
+	for i, f := range t.filters {
+		filter, ok := exprToSQL(f, t.schema.Schema)
+		if !ok {
+			return "", fmt.Errorf("federated table %s: unsupported filter %s", t.name, f)
+		}
+		if i == 0 {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		sb.WriteString(filter)
+	}
+
+	for i, sf := range t.sortFields {
+		if i == 0 {
+			sb.WriteString(" ORDER BY ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(quoteIdentifier(sf.Column.(*expression.GetField).Name()))
+		if sf.Order == sql.Descending {
+			sb.WriteString(" DESC")
+		}
+	}
+
+	if t.hasLimit {
+		fmt.Fprintf(&sb, " LIMIT %d, %d", t.offset, t.limit)
+	}
+	return sb.String(), nil
+}
+
+// partition is the single partition of a Table.
+type partition struct{}
+
+var _ sql.Partition = partition{}
+
+// Key implements the interface sql.Partition.
+func (partition) Key() []byte {
+	return nil
+}
@@ -15,6 +15,7 @@
 package memory
 
 import (
+	"math"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -43,7 +44,10 @@ var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
 var _ sql.EventDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
+var _ sql.ViewDefinitionDatabase = (*Database)(nil)
 var _ sql.CollatedDatabase = (*Database)(nil)
+var _ sql.CommentedDatabase = (*Database)(nil)
+var _ sql.SequenceDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
@@ -53,8 +57,10 @@ type BaseDatabase struct {
 	triggers          []sql.TriggerDefinition
 	storedProcedures  []sql.StoredProcedureDetails
 	events            []sql.EventDefinition
+	sequences         map[string]*sequence
 	primaryKeyIndexes bool
 	collation         sql.CollationID
+	comment           string
 }
 
 var _ MemoryDatabase = (*Database)(nil)
@@ -71,9 +77,10 @@ func NewDatabase(name string) *Database {
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:   name,
-		tables: map[string]sql.Table{},
-		fkColl: newForeignKeyCollection(),
+		name:      name,
+		tables:    map[string]sql.Table{},
+		fkColl:    newForeignKeyCollection(name),
+		sequences: map[string]*sequence{},
 	}
 }
 
@@ -172,6 +179,9 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Pri
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
+	if _, ok = d.sequences[strings.ToLower(name)]; ok {
+		return sql.ErrTableAlreadyExists.New(name)
+	}
 
 	table := NewTableWithCollation(name, schema, d.fkColl, collation)
 	if d.primaryKeyIndexes {
@@ -240,7 +250,7 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 		memIndex := index.(*Index)
 		for i, expr := range memIndex.Exprs {
 			getField := expr.(*expression.GetField)
-			memIndex.Exprs[i] = expression.NewGetFieldWithTable(i, getField.Type(), newName, getField.Name(), getField.IsNullable())
+			memIndex.Exprs[i] = expression.NewGetFieldWithTable(getField.Index(), getField.Type(), newName, getField.Name(), getField.IsNullable())
 		}
 	}
 	d.tables[newName] = tbl
@@ -389,6 +399,97 @@ func (d *BaseDatabase) UpdateEvent(ctx *sql.Context, originalName string, ed sql
 	return nil
 }
 
+// sequence is a sequence stored in a BaseDatabase, along with the next value it will hand out. A next value outside
+// the bounds of the sequence means the sequence has run out of values.
+type sequence struct {
+	def  sql.Sequence
+	next int64
+}
+
+// GetSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) GetSequence(ctx *sql.Context, name string) (sql.Sequence, bool, error) {
+	seq, ok := d.sequences[strings.ToLower(name)]
+	if !ok {
+		return sql.Sequence{}, false, nil
+	}
+	return seq.def, true, nil
+}
+
+// CreateSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) CreateSequence(ctx *sql.Context, def sql.Sequence) error {
+	if _, ok := d.sequences[strings.ToLower(def.Name)]; ok {
+		return sql.ErrTableAlreadyExists.New(def.Name)
+	}
+	if _, ok := sql.GetTableInsensitive(def.Name, d.tables); ok {
+		return sql.ErrTableAlreadyExists.New(def.Name)
+	}
+	d.sequences[strings.ToLower(def.Name)] = &sequence{def: def, next: def.Start}
+	return nil
+}
+
+// AlterSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) AlterSequence(ctx *sql.Context, def sql.Sequence) error {
+	seq, ok := d.sequences[strings.ToLower(def.Name)]
+	if !ok {
+		return sql.ErrSequenceNotFound.New(d.name + "." + def.Name)
+	}
+	seq.def = def
+	return nil
+}
+
+// DropSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) DropSequence(ctx *sql.Context, name string) error {
+	if _, ok := d.sequences[strings.ToLower(name)]; !ok {
+		return sql.ErrSequenceNotFound.New(d.name + "." + name)
+	}
+	delete(d.sequences, strings.ToLower(name))
+	return nil
+}
+
+// ReserveSequenceValues implements sql.SequenceDatabase
+func (d *BaseDatabase) ReserveSequenceValues(ctx *sql.Context, name string, count int64) ([]int64, error) {
+	seq, ok := d.sequences[strings.ToLower(name)]
+	if !ok {
+		return nil, sql.ErrSequenceNotFound.New(d.name + "." + name)
+	}
+
+	def := seq.def
+	var vals []int64
+	for int64(len(vals)) < count {
+		if seq.next < def.MinValue || seq.next > def.MaxValue {
+			if !def.Cycle {
+				break
+			}
+			seq.next = def.FirstValue()
+		}
+		vals = append(vals, seq.next)
+
+		// Sequence bounds never reach the limits of int64, so saturating at those limits runs the sequence out
+		if def.Increment > 0 && seq.next > math.MaxInt64-def.Increment {
+			seq.next = math.MaxInt64
+		} else if def.Increment < 0 && seq.next < math.MinInt64-def.Increment {
+			seq.next = math.MinInt64
+		} else {
+			seq.next += def.Increment
+		}
+	}
+
+	if len(vals) == 0 {
+		return nil, sql.ErrSequenceRunOut.New(d.name, def.Name)
+	}
+	return vals, nil
+}
+
+// RestartSequence implements sql.SequenceDatabase
+func (d *BaseDatabase) RestartSequence(ctx *sql.Context, name string, next int64) error {
+	seq, ok := d.sequences[strings.ToLower(name)]
+	if !ok {
+		return sql.ErrSequenceNotFound.New(d.name + "." + name)
+	}
+	seq.next = next
+	return nil
+}
+
 // GetCollation implements sql.CollatedDatabase.
 func (d *BaseDatabase) GetCollation(ctx *sql.Context) sql.CollationID {
 	return d.collation
@@ -400,14 +501,30 @@ func (d *BaseDatabase) SetCollation(ctx *sql.Context, collation sql.CollationID)
 	return nil
 }
 
+// GetComment implements sql.CommentedDatabase.
+func (d *BaseDatabase) GetComment(ctx *sql.Context) string {
+	return d.comment
+}
+
+// SetComment implements sql.CommentedDatabase.
+func (d *BaseDatabase) SetComment(ctx *sql.Context, comment string) error {
+	d.comment = comment
+	return nil
+}
+
 // CreateView implements the interface sql.ViewDatabase.
 func (d *Database) CreateView(ctx *sql.Context, name string, selectStatement, createViewStmt string) error {
-	_, ok := d.views[name]
+	return d.CreateViewDefinition(ctx, sql.ViewDefinition{Name: name, TextDefinition: selectStatement, CreateViewStatement: createViewStmt})
+}
+
+// CreateViewDefinition implements the interface sql.ViewDefinitionDatabase.
+func (d *Database) CreateViewDefinition(ctx *sql.Context, view sql.ViewDefinition) error {
+	_, ok := d.views[view.Name]
 	if ok {
-		return sql.ErrExistingView.New(name)
+		return sql.ErrExistingView.New(view.Name)
 	}
 
-	d.views[name] = sql.ViewDefinition{Name: name, TextDefinition: selectStatement, CreateViewStatement: createViewStmt}
+	d.views[view.Name] = view
 	return nil
 }
 
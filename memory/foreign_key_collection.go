@@ -16,6 +16,7 @@ package memory
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -23,11 +24,50 @@ import (
 // ForeignKeyCollection is a shareable container for a collection of foreign keys.
 type ForeignKeyCollection struct {
 	fks []sql.ForeignKeyConstraint
+	// dbName is the name of the database whose tables declare the foreign keys
+	dbName string
+	// linked holds the collections of all the databases of a provider, which may declare foreign keys referencing the
+	// tables of this database. Nil when the database isn't in a provider.
+	linked *linkedForeignKeyCollections
 }
 
-// newForeignKeyCollection returns a new ForeignKeyCollection.
-func newForeignKeyCollection() *ForeignKeyCollection {
-	return &ForeignKeyCollection{}
+// linkedForeignKeyCollections are the foreign key collections of the databases of a provider.
+type linkedForeignKeyCollections struct {
+	mu    sync.RWMutex
+	colls map[*ForeignKeyCollection]struct{}
+}
+
+// newForeignKeyCollection returns a new ForeignKeyCollection for the database given.
+func newForeignKeyCollection(dbName string) *ForeignKeyCollection {
+	return &ForeignKeyCollection{dbName: dbName}
+}
+
+// newLinkedForeignKeyCollections returns a new, empty set of linked collections.
+func newLinkedForeignKeyCollections() *linkedForeignKeyCollections {
+	return &linkedForeignKeyCollections{colls: make(map[*ForeignKeyCollection]struct{})}
+}
+
+// link adds the collection given to the set, so that its foreign keys referencing the tables of other databases are
+// found from them.
+func (l *linkedForeignKeyCollections) link(fkc *ForeignKeyCollection) {
+	if fkc == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fkc.linked = l
+	l.colls[fkc] = struct{}{}
+}
+
+// unlink removes the collection given from the set.
+func (l *linkedForeignKeyCollections) unlink(fkc *ForeignKeyCollection) {
+	if fkc == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.colls, fkc)
+	fkc.linked = nil
 }
 
 // AddFK adds the given foreign key to the internal slice.
@@ -75,3 +115,38 @@ func (fkc *ForeignKeyCollection) Keys() []sql.ForeignKeyConstraint {
 	}
 	return fkc.fks
 }
+
+// ReferencedKeys returns the foreign keys referencing the table given of this collection's database, including the
+// ones declared in the other databases it's linked with.
+func (fkc *ForeignKeyCollection) ReferencedKeys(tblName string) []sql.ForeignKeyConstraint {
+	if fkc == nil {
+		return nil
+	}
+	colls := []*ForeignKeyCollection{fkc}
+	if fkc.linked != nil {
+		fkc.linked.mu.RLock()
+		for coll := range fkc.linked.colls {
+			if coll != fkc {
+				colls = append(colls, coll)
+			}
+		}
+		fkc.linked.mu.RUnlock()
+	}
+
+	var fks []sql.ForeignKeyConstraint
+	for _, coll := range colls {
+		for _, fk := range coll.fks {
+			if !strings.EqualFold(fk.ParentTable, tblName) {
+				continue
+			}
+			parentDb := fk.ParentDatabase
+			if parentDb == "" {
+				parentDb = coll.dbName
+			}
+			if fkc.dbName == "" || strings.EqualFold(parentDb, fkc.dbName) {
+				fks = append(fks, fk)
+			}
+		}
+	}
+	return fks
+}
@@ -21,8 +21,9 @@ type DbProvider struct {
 	readOnly                  bool
 	nativeIndexes             bool
 	mu                        *sync.RWMutex
-	tableFunctions            map[string]sql.TableFunction
+	tableFunctions            *sql.TableFunctionRegistry
 	externalProcedureRegistry sql.ExternalStoredProcedureRegistry
+	fkColls                   *linkedForeignKeyCollections
 }
 
 type ProviderOption func(*DbProvider)
@@ -39,12 +40,27 @@ func NewDBProvider(dbs ...sql.Database) sql.MutableDatabaseProvider {
 		externalProcedureRegistry.Register(esp)
 	}
 
-	return &DbProvider{
+	pro := &DbProvider{
 		dbs:                       dbMap,
 		mu:                        &sync.RWMutex{},
-		tableFunctions:            make(map[string]sql.TableFunction),
+		tableFunctions:            sql.NewTableFunctionRegistry(),
 		externalProcedureRegistry: externalProcedureRegistry,
+		fkColls:                   newLinkedForeignKeyCollections(),
 	}
+	for _, db := range dbs {
+		pro.fkColls.link(foreignKeyCollection(db))
+	}
+	return pro
+}
+
+// foreignKeyCollection returns the foreign key collection of the database given, or nil if it doesn't have one.
+func foreignKeyCollection(db sql.Database) *ForeignKeyCollection {
+	if fkdb, ok := db.(interface {
+		GetForeignKeyCollection() *ForeignKeyCollection
+	}); ok {
+		return fkdb.GetForeignKeyCollection()
+	}
+	return nil
 }
 
 // NewDBProviderWithOpts creates a new DbProvider with the given options and no databases
@@ -82,12 +98,23 @@ func HistoryProvider(enableHistory bool) ProviderOption {
 	}
 }
 
+// WithTableFunctionsOption returns a ProviderOption to construct a DbProvider with the given table functions
+func WithTableFunctionsOption(fns ...sql.TableFunction) ProviderOption {
+	return func(pro *DbProvider) {
+		pro.tableFunctions.Register(fns...)
+	}
+}
+
 // WithDbsOption returns a ProviderOption to construct a DbProvider with the given databases
 func WithDbsOption(dbs []sql.Database) ProviderOption {
 	return func(pro *DbProvider) {
+		for _, db := range pro.dbs {
+			pro.fkColls.unlink(foreignKeyCollection(db))
+		}
 		pro.dbs = make(map[string]sql.Database, len(dbs))
 		for _, db := range dbs {
 			pro.dbs[strings.ToLower(db.Name())] = db
+			pro.fkColls.link(foreignKeyCollection(db))
 		}
 	}
 }
@@ -161,6 +188,7 @@ func (pro *DbProvider) CreateDatabase(_ *sql.Context, name string) (err error) {
 	}
 
 	pro.dbs[strings.ToLower(db.Name())] = db
+	pro.fkColls.link(foreignKeyCollection(db))
 	return
 }
 
@@ -169,6 +197,9 @@ func (pro *DbProvider) DropDatabase(_ *sql.Context, name string) (err error) {
 	pro.mu.Lock()
 	defer pro.mu.Unlock()
 
+	if db, ok := pro.dbs[strings.ToLower(name)]; ok {
+		pro.fkColls.unlink(foreignKeyCollection(db))
+	}
 	delete(pro.dbs, strings.ToLower(name))
 	return
 }
@@ -184,10 +215,6 @@ func (pro *DbProvider) ExternalStoredProcedures(_ *sql.Context, name string) ([]
 }
 
 // TableFunction implements sql.TableFunctionProvider
-func (pro *DbProvider) TableFunction(_ *sql.Context, name string) (sql.TableFunction, error) {
-	if tableFunction, ok := pro.tableFunctions[name]; ok {
-		return tableFunction, nil
-	}
-
-	return nil, sql.ErrTableFunctionNotFound.New(name)
+func (pro *DbProvider) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, error) {
+	return pro.tableFunctions.TableFunction(ctx, name)
 }
@@ -10,13 +10,15 @@ import (
 )
 
 var _ sql.TableFunction = (*IntSequenceTable)(nil)
+var _ sql.LateralTableFunction = (*IntSequenceTable)(nil)
 var _ sql.CollationCoercible = (*IntSequenceTable)(nil)
 
 // IntSequenceTable a simple table function that returns a sequence
-// of integers.
+// of integers. Its length can reference the columns of the tables
+// before it, in which case it's evaluated for each of their rows.
 type IntSequenceTable struct {
 	name string
-	Len  int
+	Len  sql.Expression
 }
 
 func (s IntSequenceTable) NewInstance(_ *sql.Context, _ sql.Database, args []sql.Expression) (sql.Node, error) {
@@ -25,21 +27,23 @@ func (s IntSequenceTable) NewInstance(_ *sql.Context, _ sql.Database, args []sql
 	}
 	nameExp, ok := args[0].(*expression.Literal)
 	if !ok {
-		return nil, fmt.Errorf("sequence table expects arguments to be literal expressions")
+		return nil, fmt.Errorf("sequence table expects 1st argument to be a literal expression")
 	}
 	name, ok := nameExp.Value().(string)
 	if !ok {
 		return nil, fmt.Errorf("sequence table expects 1st argument to be column name")
 	}
-	lenExp, ok := args[1].(*expression.Literal)
-	if !ok {
-		return nil, fmt.Errorf("sequence table expects arguments to be literal expressions")
-	}
-	length, _, err := types.Int64.Convert(lenExp.Value())
-	if !ok {
-		return nil, fmt.Errorf("%w; sequence table expects 2nd argument to be a sequence length integer", err)
+	if lenExp, ok := args[1].(*expression.Literal); ok {
+		if _, _, err := types.Int64.Convert(lenExp.Value()); err != nil {
+			return nil, fmt.Errorf("%w; sequence table expects 2nd argument to be a sequence length integer", err)
+		}
 	}
-	return IntSequenceTable{name: name, Len: int(length.(int64))}, nil
+	return IntSequenceTable{name: name, Len: args[1]}, nil
+}
+
+// AcceptsLateralArguments implements the interface sql.LateralTableFunction.
+func (s IntSequenceTable) AcceptsLateralArguments() bool {
+	return true
 }
 
 func (s IntSequenceTable) Resolved() bool {
@@ -47,7 +51,7 @@ func (s IntSequenceTable) Resolved() bool {
 }
 
 func (s IntSequenceTable) String() string {
-	return fmt.Sprintf("sequence(%s, %d)", s.name, s.Len)
+	return fmt.Sprintf("sequence(%s, %s)", s.name, s.Len)
 }
 
 func (s IntSequenceTable) DebugString() string {
@@ -55,7 +59,7 @@ func (s IntSequenceTable) DebugString() string {
 	_ = pr.WriteNode("sequence")
 	children := []string{
 		fmt.Sprintf("name: %s", s.name),
-		fmt.Sprintf("len: %d", s.Len),
+		fmt.Sprintf("len: %s", sql.DebugString(s.Len)),
 	}
 	_ = pr.WriteChildren(children...)
 	return pr.String()
@@ -76,9 +80,19 @@ func (s IntSequenceTable) Children() []sql.Node {
 	return []sql.Node{}
 }
 
-func (s IntSequenceTable) RowIter(_ *sql.Context, _ sql.Row) (sql.RowIter, error) {
-	rowIter := &SequenceTableFnRowIter{i: 0, n: s.Len}
-	return rowIter, nil
+func (s IntSequenceTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	v, err := s.Len.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	length, _, err := types.Int64.Convert(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w; sequence table expects 2nd argument to be a sequence length integer", err)
+	}
+	if length == nil {
+		return NewSequenceTableFnRowIter(0), nil
+	}
+	return NewSequenceTableFnRowIter(int(length.(int64))), nil
 }
 
 func (s IntSequenceTable) WithChildren(_ ...sql.Node) (sql.Node, error) {
@@ -95,10 +109,14 @@ func (IntSequenceTable) CollationCoercibility(ctx *sql.Context) (collation sql.C
 }
 
 func (s IntSequenceTable) Expressions() []sql.Expression {
-	return []sql.Expression{}
+	return []sql.Expression{s.Len}
 }
 
 func (s IntSequenceTable) WithExpressions(e ...sql.Expression) (sql.Node, error) {
+	if len(e) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(e), 1)
+	}
+	s.Len = e[0]
 	return s, nil
 }
 
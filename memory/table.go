@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/rand"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -44,6 +46,7 @@ type Table struct {
 	fkColl           *ForeignKeyCollection
 	checks           []sql.CheckDefinition
 	collation        sql.CollationID
+	options          sql.TableOptions
 	pkIndexesEnabled bool
 	ed               tableEditAccumulator
 
@@ -52,6 +55,9 @@ type Table struct {
 	projection      []string
 	projectedSchema sql.Schema
 	columns         []int
+	sample          *sql.TableSample
+	// runtimeFilters are evaluated on the projected rows, after filters
+	runtimeFilters []sql.Expression
 
 	// Data storage
 	partitions    map[string][]sql.Row
@@ -68,6 +74,12 @@ type Table struct {
 	autoColIdx int
 
 	tableStats *sql.TableStatistics
+
+	// dataVersion is shared by all the copies of this table, and changes whenever its rows or schema change
+	dataVersion *uint64
+
+	// ALTER TABLE bookkeeping
+	alterSnapshot *Table
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -78,15 +90,22 @@ var _ sql.ReplaceableTable = (*Table)(nil)
 var _ sql.TruncateableTable = (*Table)(nil)
 var _ sql.DriverIndexableTable = (*Table)(nil)
 var _ sql.AlterableTable = (*Table)(nil)
+var _ sql.AlterAlgorithmTable = (*Table)(nil)
+var _ sql.AtomicAlterableTable = (*Table)(nil)
 var _ sql.IndexAlterableTable = (*Table)(nil)
 var _ sql.CollationAlterableTable = (*Table)(nil)
+var _ sql.TableOptionsAlterableTable = (*Table)(nil)
 
 var _ sql.ForeignKeyTable = (*Table)(nil)
 var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.ConsistencyCheckTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.SampledTable = (*Table)(nil)
+var _ sql.RuntimeFilterTable = (*Table)(nil)
+var _ sql.VersionedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
 
@@ -141,6 +160,7 @@ func NewPartitionedTableWithCollation(name string, schema sql.PrimaryKeySchema,
 		partitionKeys: keys,
 		autoIncVal:    autoIncVal,
 		autoColIdx:    autoIncIdx,
+		dataVersion:   new(uint64),
 	}
 }
 
@@ -179,13 +199,43 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 			keys = append(keys, k)
 		}
 	}
+	if t.sample != nil {
+		var err error
+		if keys, err = samplePartitions(*t.sample, keys); err != nil {
+			return nil, err
+		}
+	}
 	return &partitionIter{keys: keys}, nil
 }
 
+// samplePartitions returns the partitions of |keys| in |sample|. Partitions of a repeatable sample are sampled by the
+// hash of their key with its seed.
+func samplePartitions(sample sql.TableSample, keys [][]byte) ([][]byte, error) {
+	var sampled [][]byte
+	for _, k := range keys {
+		var p float64
+		if sample.Repeatable {
+			h, err := sql.HashOf(sql.Row{sample.Seed, string(k)})
+			if err != nil {
+				return nil, err
+			}
+			p = float64(h) / math.MaxUint64
+		} else {
+			p = rand.Float64()
+		}
+		if p*100 < sample.Percent {
+			sampled = append(sampled, k)
+		}
+	}
+	return sampled, nil
+}
+
 // rangePartitionIter returns a partition that has range and table data access
 type rangePartitionIter struct {
 	child  *partitionIter
 	ranges sql.Expression
+	// skip is the number of rows of the lookup left to skip, shared by all its partitions
+	skip *uint64
 }
 
 var _ sql.PartitionIter = (*rangePartitionIter)(nil)
@@ -199,15 +249,32 @@ func (i rangePartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The rows of a lookup are sorted by its index, so they're all read as a single partition to be in order across
+	// the partitions of the table.
+	keys := [][]byte{part.Key()}
+	for {
+		part, err := i.child.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		keys = append(keys, part.Key())
+	}
 	return &rangePartition{
 		Partition: part.(*Partition),
 		rang:      i.ranges,
+		skip:      i.skip,
+		keys:      keys,
 	}, nil
 }
 
 type rangePartition struct {
 	*Partition
 	rang sql.Expression
+	skip *uint64
+	// keys are the keys of the partitions of the table whose rows are read as this partition
+	keys [][]byte
 }
 
 // spatialRangePartitionIter returns a partition that has range and table data access
@@ -252,19 +319,27 @@ func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
 	filters := t.filters
+	var skip *uint64
 	if r, ok := partition.(*rangePartition); ok {
 		// index lookup is currently a single filter applied to a full table scan
 		filters = append(t.filters, r.rang)
+		skip = r.skip
 	}
 
-	rows, ok := t.partitions[string(partition.Key())]
-	if !ok {
-		return nil, sql.ErrPartitionNotFound.New(partition.Key())
+	keys := [][]byte{partition.Key()}
+	if r, ok := partition.(*rangePartition); ok {
+		keys = r.keys
 	}
 	// The slice could be altered by other operations taking place during iteration (such as deletion or insertion), so
 	// make a copy of the values as they exist when execution begins.
-	rowsCopy := make([]sql.Row, len(rows))
-	copy(rowsCopy, rows)
+	var rowsCopy []sql.Row
+	for _, key := range keys {
+		rows, ok := t.partitions[string(key)]
+		if !ok {
+			return nil, sql.ErrPartitionNotFound.New(key)
+		}
+		rowsCopy = append(rowsCopy, rows...)
+	}
 
 	if r, ok := partition.(*spatialRangePartition); ok {
 		return &spatialTableIter{
@@ -279,9 +354,11 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	}
 
 	return &tableIter{
-		rows:    rowsCopy,
-		columns: t.columns,
-		filters: filters,
+		rows:       rowsCopy,
+		columns:    t.columns,
+		filters:    filters,
+		conditions: t.runtimeFilters,
+		skip:       skip,
 	}, nil
 }
 
@@ -356,6 +433,116 @@ func (t *Table) RowCount(ctx *sql.Context) (uint64, error) {
 	return t.numRows(ctx)
 }
 
+// CheckConsistency implements the sql.ConsistencyCheckTable interface. It verifies that every row matches the schema,
+// that the primary key and unique indexes have no duplicate entries, and that the next AUTO_INCREMENT value is greater
+// than the values already in the table.
+func (t *Table) CheckConsistency(ctx *sql.Context) ([]string, error) {
+	var problems []string
+
+	type uniqueKey struct {
+		name          string
+		columns       []int
+		prefixLengths []uint16
+		seen          map[string]struct{}
+	}
+	var keys []*uniqueKey
+	if len(t.schema.PkOrdinals) > 0 {
+		keys = append(keys, &uniqueKey{name: "PRIMARY", columns: t.schema.PkOrdinals, seen: make(map[string]struct{})})
+	}
+	indexNames := make([]string, 0, len(t.indexes))
+	for name := range t.indexes {
+		indexNames = append(indexNames, name)
+	}
+	sort.Strings(indexNames)
+	for _, name := range indexNames {
+		idx, ok := t.indexes[name].(*Index)
+		if !ok || !idx.Unique {
+			continue
+		}
+		colNames := make([]string, len(idx.Exprs))
+		for i, expr := range idx.Exprs {
+			colNames[i] = expr.(*expression.GetField).Name()
+		}
+		columns, err := t.columnIndexes(colNames)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("Index '%s' is on a column that doesn't exist: %s", name, err.Error()))
+			continue
+		}
+		keys = append(keys, &uniqueKey{name: name, columns: columns, prefixLengths: idx.PrefixLens, seen: make(map[string]struct{})})
+	}
+
+	var maxAutoIncVal uint64
+	hasAutoIncVal := false
+	for _, partKey := range t.partitionKeys {
+		for _, row := range t.partitions[string(partKey)] {
+			if len(row) != len(t.schema.Schema) {
+				problems = append(problems, fmt.Sprintf("Row %v has %d values, but the table has %d columns",
+					row, len(row), len(t.schema.Schema)))
+				continue
+			}
+			for i, col := range t.schema.Schema {
+				if row[i] == nil {
+					if !col.Nullable {
+						problems = append(problems, fmt.Sprintf("Column '%s' is NULL in a row, but it's NOT NULL", col.Name))
+					}
+					continue
+				}
+				valType := reflect.TypeOf(row[i])
+				if expectedType := col.Type.ValueType(); valType != expectedType && !valType.AssignableTo(expectedType) {
+					problems = append(problems, fmt.Sprintf("Column '%s' has a value of type %s, expected %s",
+						col.Name, valType.String(), expectedType.String()))
+				}
+			}
+			for _, key := range keys {
+				if hasNullForAnyCols(row, key.columns) {
+					continue
+				}
+				k := columnsKey(row, key.columns, key.prefixLengths)
+				if _, ok := key.seen[k]; ok {
+					problems = append(problems, fmt.Sprintf("Duplicate entry '%s' for key '%s'", formatRow(row, key.columns), key.name))
+					continue
+				}
+				key.seen[k] = struct{}{}
+			}
+			if t.autoColIdx >= 0 && row[t.autoColIdx] != nil {
+				val, _, err := types.Uint64.Convert(row[t.autoColIdx])
+				if err != nil {
+					return nil, err
+				}
+				if v := val.(uint64); !hasAutoIncVal || v > maxAutoIncVal {
+					maxAutoIncVal, hasAutoIncVal = v, true
+				}
+			}
+		}
+	}
+
+	if hasAutoIncVal && t.autoIncVal <= maxAutoIncVal {
+		problems = append(problems, fmt.Sprintf("AUTO_INCREMENT value %d isn't greater than the largest value %d of column '%s'",
+			t.autoIncVal, maxAutoIncVal, t.schema.Schema[t.autoColIdx].Name))
+	}
+	return problems, nil
+}
+
+// lastDataVersion is the last data version given to any table, so that tables never share versions, even when one
+// replaces another with the same name.
+var lastDataVersion uint64
+
+// DataVersion implements the sql.VersionedTable interface. Tables are only given a version the first time it's asked
+// for, so that new tables with the same data are still equal.
+func (t *Table) DataVersion(ctx *sql.Context) (string, error) {
+	v := atomic.LoadUint64(t.dataVersion)
+	if v == 0 {
+		atomic.CompareAndSwapUint64(t.dataVersion, 0, atomic.AddUint64(&lastDataVersion, 1))
+		v = atomic.LoadUint64(t.dataVersion)
+	}
+	return strconv.FormatUint(v, 10), nil
+}
+
+// dataChanged gives this table, and all of its copies, a new data version.
+func (t *Table) dataChanged() {
+	atomic.StoreUint64(t.dataVersion, atomic.AddUint64(&lastDataVersion, 1))
+}
+
 func NewPartition(key []byte) *Partition {
 	return &Partition{key: key}
 }
@@ -386,40 +573,129 @@ func (p *partitionIter) Close(*sql.Context) error { return nil }
 type tableIter struct {
 	columns []int
 	filters []sql.Expression
+	// conditions are evaluated on the projected rows, after filters
+	conditions []sql.Expression
 
 	rows        []sql.Row
 	indexValues sql.IndexValueIter
 	pos         int
+	// skip is the number of matching rows left to skip before any is returned, shared with the other partitions of
+	// an index lookup
+	skip *uint64
 }
 
-var _ sql.RowIter = (*tableIter)(nil)
+var _ sql.RowBatchIter = (*tableIter)(nil)
 
 func (i *tableIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if err := i.skipRows(ctx); err != nil {
+		return nil, err
+	}
+
 	row, err := i.getRow(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, f := range i.filters {
-		result, err := f.Eval(ctx, row)
+	row, ok, err := i.filterAndProject(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return i.Next(ctx)
+	}
+	return row, nil
+}
+
+// NextBatch implements sql.RowBatchIter.
+func (i *tableIter) NextBatch(ctx *sql.Context, batch []sql.Row) (int, error) {
+	if i.indexValues != nil {
+		return sql.NextRowBatch(ctx, rowIterOnly{i}, batch)
+	}
+
+	if err := i.skipRows(ctx); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for n < len(batch) && i.pos < len(i.rows) {
+		row, ok, err := i.filterAndProject(ctx, i.rows[i.pos])
+		i.pos++
 		if err != nil {
-			return nil, err
+			return n, err
 		}
-		result, _ = types.ConvertToBool(result)
-		if result != true {
-			return i.Next(ctx)
+		if ok {
+			batch[n] = row
+			n++
 		}
 	}
+	if n == 0 && len(batch) > 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// filterAndProject returns the projected columns of |row|, or false when |row| doesn't match the filters.
+func (i *tableIter) filterAndProject(ctx *sql.Context, row sql.Row) (sql.Row, bool, error) {
+	if ok, err := evalFilters(ctx, i.filters, row); !ok || err != nil {
+		return nil, false, err
+	}
 
 	if i.columns != nil {
 		resultRow := make(sql.Row, len(i.columns))
 		for i, j := range i.columns {
 			resultRow[i] = row[j]
 		}
-		return resultRow, nil
+		row = resultRow
 	}
 
-	return row, nil
+	if ok, err := evalFilters(ctx, i.conditions, row); !ok || err != nil {
+		return nil, false, err
+	}
+	return row, true, nil
+}
+
+// skipRows skips the rows of the offset pushed down to an index lookup. Only the filters on the whole row, which
+// include the ranges of the lookup, are evaluated on the rows skipped, and they are only projected when conditions
+// have to be evaluated on them too.
+func (i *tableIter) skipRows(ctx *sql.Context) error {
+	for i.skip != nil && *i.skip > 0 && i.pos < len(i.rows) {
+		row := i.rows[i.pos]
+		i.pos++
+		var ok bool
+		var err error
+		if len(i.conditions) > 0 {
+			_, ok, err = i.filterAndProject(ctx, row)
+		} else {
+			ok, err = evalFilters(ctx, i.filters, row)
+		}
+		if err != nil {
+			return err
+		}
+		if ok {
+			*i.skip--
+		}
+	}
+	return nil
+}
+
+// evalFilters returns whether |row| matches all the |filters| given.
+func evalFilters(ctx *sql.Context, filters []sql.Expression, row sql.Row) (bool, error) {
+	for _, f := range filters {
+		result, err := f.Eval(ctx, row)
+		if err != nil {
+			return false, err
+		}
+		result, _ = types.ConvertToBool(result)
+		if result != true {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// rowIterOnly hides the sql.RowBatchIter implementation of an iterator, so that it's read one row at a time.
+type rowIterOnly struct {
+	sql.RowIter
 }
 
 func (i *tableIter) colIsProjected(idx int) bool {
@@ -628,6 +904,7 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 		count += len(t.partitions[key])
 		t.partitions[key] = nil
 	}
+	t.dataChanged()
 	return count, nil
 }
 
@@ -720,6 +997,7 @@ func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{
 }
 
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
+	defer t.dataChanged()
 	newColIdx := t.addColumnToSchema(ctx, column, order)
 	return t.insertValueInRows(ctx, newColIdx, column.Default)
 }
@@ -836,6 +1114,7 @@ func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.Col
 }
 
 func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
+	defer t.dataChanged()
 	droppedCol := t.dropColumnFromSchema(ctx, columnName)
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
@@ -878,6 +1157,7 @@ func (t *Table) dropColumnFromSchema(ctx *sql.Context, columnName string) int {
 }
 
 func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
+	defer t.dataChanged()
 	oldIdx := -1
 	newIdx := 0
 	for i, col := range t.schema.Schema {
@@ -911,13 +1191,21 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 	}
 
+	// TIMESTAMP values are stored in UTC, and converting them to or from DATETIME keeps the wall clock of the session
+	wasTimestamp, isTimestamp := sql.IsTimestampType(t.schema.Schema[oldIdx].Type), sql.IsTimestampType(column.Type)
+	tsLoc := sql.TimestampLocation(ctx)
+
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
 			var oldRowWithoutVal sql.Row
 			oldRowWithoutVal = append(oldRowWithoutVal, row[:oldIdx]...)
 			oldRowWithoutVal = append(oldRowWithoutVal, row[oldIdx+1:]...)
-			newVal, inRange, err := column.Type.Convert(row[oldIdx])
+			oldVal := row[oldIdx]
+			if ts, ok := oldVal.(time.Time); ok && wasTimestamp && !isTimestamp {
+				oldVal = sql.TimestampFromUTC(ts, tsLoc)
+			}
+			newVal, inRange, err := column.Type.Convert(oldVal)
 			if err != nil {
 				if sql.ErrNotMatchingSRID.Is(err) {
 					err = sql.ErrNotMatchingSRIDWithColName.New(columnName, err)
@@ -927,6 +1215,9 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 			if !inRange {
 				return sql.ErrValueOutOfRange.New(row[oldIdx], column.Type)
 			}
+			if ts, ok := newVal.(time.Time); ok && isTimestamp && !wasTimestamp {
+				newVal = sql.TimestampToUTC(ts, tsLoc)
+			}
 			var newRow sql.Row
 			newRow = append(newRow, oldRowWithoutVal[:newIdx]...)
 			newRow = append(newRow, newVal)
@@ -973,6 +1264,80 @@ func (t *Table) PrimaryKeySchema() sql.PrimaryKeySchema {
 	return t.schema
 }
 
+// AlterAlgorithm implements sql.AlterAlgorithmTable. Like InnoDB, adding and dropping columns and changes that only
+// touch table metadata are instant, while changes that must validate or rebuild rows and indexes happen in place.
+func (t *Table) AlterAlgorithm(ctx *sql.Context, op sql.AlterOperation) sql.AlterAlgorithm {
+	switch op {
+	case sql.AlterOperation_AddColumn,
+		sql.AlterOperation_DropColumn,
+		sql.AlterOperation_RenameColumn,
+		sql.AlterOperation_SetDefault,
+		sql.AlterOperation_DropDefault,
+		sql.AlterOperation_DropIndex,
+		sql.AlterOperation_RenameIndex,
+		sql.AlterOperation_DropCheck,
+		sql.AlterOperation_AutoIncrement,
+		sql.AlterOperation_Collation,
+		sql.AlterOperation_TableOptions:
+		return sql.AlterAlgorithm_Instant
+	default:
+		return sql.AlterAlgorithm_Inplace
+	}
+}
+
+// BeginAlter implements sql.AtomicAlterableTable
+func (t *Table) BeginAlter(ctx *sql.Context) error {
+	t.alterSnapshot = t.snapshot()
+	return nil
+}
+
+// CommitAlter implements sql.AtomicAlterableTable
+func (t *Table) CommitAlter(ctx *sql.Context) error {
+	t.alterSnapshot = nil
+	return nil
+}
+
+// RollbackAlter implements sql.AtomicAlterableTable
+func (t *Table) RollbackAlter(ctx *sql.Context) error {
+	defer t.dataChanged()
+	if t.alterSnapshot == nil {
+		return nil
+	}
+	*t = *t.alterSnapshot
+	return nil
+}
+
+// snapshot returns a copy of this table that shares none of the state modified by ALTER TABLE operations, namely the
+// schema, indexes, checks and rows.
+func (t *Table) snapshot() *Table {
+	nt := *t
+	nt.alterSnapshot = nil
+	nt.schema = sql.PrimaryKeySchema{
+		Schema:     t.schema.Schema.Copy(),
+		PkOrdinals: append([]int(nil), t.schema.PkOrdinals...),
+	}
+
+	nt.indexes = make(map[string]sql.Index, len(t.indexes))
+	for name, index := range t.indexes {
+		if memIndex, ok := index.(*Index); ok {
+			idx := *memIndex
+			idx.Exprs = append([]sql.Expression(nil), memIndex.Exprs...)
+			idx.PrefixLens = append([]uint16(nil), memIndex.PrefixLens...)
+			index = &idx
+		}
+		nt.indexes[name] = index
+	}
+
+	nt.checks = append([]sql.CheckDefinition(nil), t.checks...)
+
+	nt.partitions = make(map[string][]sql.Row, len(t.partitions))
+	for key, rows := range t.partitions {
+		nt.partitions[key] = append([]sql.Row(nil), rows...)
+	}
+	nt.partitionKeys = append([][]byte(nil), t.partitionKeys...)
+	return &nt
+}
+
 func checkRow(schema sql.Schema, row sql.Row) error {
 	if len(row) != len(schema) {
 		return sql.ErrUnexpectedRowLength.New(len(schema), len(row))
@@ -988,6 +1353,29 @@ func checkRow(schema sql.Schema, row sql.Row) error {
 	return nil
 }
 
+// readBlobValues returns the row given with any sql.BlobValue read into the value of its column's type, since memory
+// tables hold the contents of their rows.
+func readBlobValues(schema sql.Schema, row sql.Row) (sql.Row, error) {
+	var read sql.Row
+	for i, value := range row {
+		if _, ok := value.(sql.BlobValue); !ok || i >= len(schema) {
+			continue
+		}
+		if read == nil {
+			read = row.Copy()
+		}
+		converted, _, err := schema[i].Type.Convert(value)
+		if err != nil {
+			return nil, err
+		}
+		read[i] = converted
+	}
+	if read == nil {
+		return row, nil
+	}
+	return read, nil
+}
+
 // String implements the sql.Table interface.
 func (t *Table) String() string {
 	return t.name
@@ -1080,6 +1468,13 @@ func (t *FilteredTable) WithProjections(schema []string) sql.Table {
 	return &nt
 }
 
+// WithRuntimeFilter implements sql.RuntimeFilterTable
+func (t *FilteredTable) WithRuntimeFilter(filter sql.Expression) sql.Table {
+	nt := *t
+	nt.Table = t.Table.WithRuntimeFilter(filter).(*Table)
+	return &nt
+}
+
 // Projections implements sql.ProjectedTable
 func (t *FilteredTable) Projections() []string {
 	return t.projection
@@ -1089,7 +1484,52 @@ func (t *FilteredTable) Projections() []string {
 // for range lookups.
 type IndexedTable struct {
 	*Table
-	Lookup sql.IndexLookup
+	Lookup     sql.IndexLookup
+	conditions []sql.Expression
+	offset     uint64
+}
+
+var _ sql.IndexConditionPushdownTable = (*IndexedTable)(nil)
+var _ sql.IndexOffsetTable = (*IndexedTable)(nil)
+
+// HandledIndexConditions implements the sql.IndexConditionPushdownTable interface.
+func (t *IndexedTable) HandledIndexConditions(index sql.Index, filters []sql.Expression) []sql.Expression {
+	// Spatial lookups don't evaluate filters on the rows they return
+	if index.IsSpatial() {
+		return nil
+	}
+	return t.Table.HandledFilters(filters)
+}
+
+// WithIndexConditions implements the sql.IndexConditionPushdownTable interface.
+func (t *IndexedTable) WithIndexConditions(filters []sql.Expression) sql.IndexedTable {
+	nt := *t
+	nt.conditions = filters
+	return &nt
+}
+
+// WithRuntimeFilter implements the sql.RuntimeFilterTable interface.
+func (t *IndexedTable) WithRuntimeFilter(filter sql.Expression) sql.Table {
+	nt := *t
+	nt.Table = t.Table.WithRuntimeFilter(filter).(*Table)
+	return &nt
+}
+
+// IndexConditions implements the sql.IndexConditionPushdownTable interface.
+func (t *IndexedTable) IndexConditions() []sql.Expression {
+	return t.conditions
+}
+
+// WithIndexOffset implements the sql.IndexOffsetTable interface.
+func (t *IndexedTable) WithIndexOffset(offset uint64) sql.IndexedTable {
+	nt := *t
+	nt.offset = offset
+	return &nt
+}
+
+// IndexOffset implements the sql.IndexOffsetTable interface.
+func (t *IndexedTable) IndexOffset() uint64 {
+	return t.offset
 }
 
 func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
@@ -1125,7 +1565,123 @@ func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup
 		}, nil
 	}
 
-	return rangePartitionIter{child: child.(*partitionIter), ranges: filter}, nil
+	iter := rangePartitionIter{child: child.(*partitionIter), ranges: filter}
+	if t.offset > 0 {
+		skip := t.offset
+		iter.skip = &skip
+	}
+	return iter, nil
+}
+
+var _ sql.BatchLookupTable = (*IndexedTable)(nil)
+
+// BatchLookup implements the sql.BatchLookupTable interface. The rows of all the lookups are read with a single scan
+// of the table, and sorted by the index, as PartitionRows does for a single lookup.
+func (t *IndexedTable) BatchLookup(ctx *sql.Context, lookups []sql.IndexLookup) (sql.BatchLookupIter, error) {
+	if len(lookups) == 0 {
+		return &batchLookupIter{}, nil
+	}
+
+	ranges := make([]sql.Expression, len(lookups))
+	for i, lookup := range lookups {
+		var err error
+		ranges[i], err = lookup.Index.(*Index).rangeFilterExpr(ctx, lookup.Ranges...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []sql.Row
+	var positions [][]int
+	for _, key := range t.partitionKeys {
+		for _, row := range t.partitions[string(key)] {
+			ok, err := evalFilters(ctx, t.filters, row)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+			var matched []int
+			for i, rang := range ranges {
+				ok, err = evalFilters(ctx, []sql.Expression{rang}, row)
+				if err != nil {
+					return nil, err
+				} else if ok {
+					matched = append(matched, i)
+				}
+			}
+			if len(matched) > 0 {
+				rows = append(rows, row)
+				positions = append(positions, matched)
+			}
+		}
+	}
+
+	idx := lookups[0].Index.(*Index)
+	sorter := &expression.Sorter{SortFields: make(sql.SortFields, len(idx.Exprs)), Ctx: ctx}
+	for i, e := range idx.Exprs {
+		sorter.SortFields[i] = sql.SortField{Column: e}
+		if lookups[0].IsReverse {
+			sorter.SortFields[i].Order = sql.Descending
+		}
+	}
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sorter.LessRows(rows[order[i]], rows[order[j]])
+	})
+	if sorter.LastError != nil {
+		return nil, sorter.LastError
+	}
+
+	iter := &batchLookupIter{
+		rows:      make([]sql.Row, 0, len(rows)),
+		positions: make([][]int, 0, len(rows)),
+	}
+	for _, i := range order {
+		row := rows[i]
+		if t.columns != nil {
+			projected := make(sql.Row, len(t.columns))
+			for j, c := range t.columns {
+				projected[j] = row[c]
+			}
+			row = projected
+		}
+		ok, err := evalFilters(ctx, t.conditions, row)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		iter.rows = append(iter.rows, row)
+		iter.positions = append(iter.positions, positions[i])
+	}
+	return iter, nil
+}
+
+// batchLookupIter returns the rows of an IndexedTable.BatchLookup.
+type batchLookupIter struct {
+	rows      []sql.Row
+	positions [][]int
+	pos       int
+}
+
+var _ sql.BatchLookupIter = (*batchLookupIter)(nil)
+
+// Next implements the sql.BatchLookupIter interface.
+func (i *batchLookupIter) Next(ctx *sql.Context) (sql.Row, []int, error) {
+	if i.pos >= len(i.rows) {
+		return nil, nil, io.EOF
+	}
+	i.pos++
+	return i.rows[i.pos-1], i.positions[i.pos-1], nil
+}
+
+// Close implements the sql.BatchLookupIter interface.
+func (i *batchLookupIter) Close(ctx *sql.Context) error {
+	return nil
 }
 
 // PartitionRows implements the sql.PartitionRows interface.
@@ -1164,6 +1720,10 @@ func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition)
 		sort.Stable(sorter)
 	}
 
+	if i, ok := iter.(*tableIter); ok {
+		i.conditions = append(i.conditions, t.conditions...)
+	}
+
 	return iter, nil
 }
 
@@ -1191,11 +1751,38 @@ func (t *Table) WithProjections(cols []string) sql.Table {
 	return &nt
 }
 
+// WithRuntimeFilter implements sql.RuntimeFilterTable
+func (t *Table) WithRuntimeFilter(filter sql.Expression) sql.Table {
+	nt := *t
+	nt.runtimeFilters = append(t.runtimeFilters[:len(t.runtimeFilters):len(t.runtimeFilters)], filter)
+	return &nt
+}
+
 // Projections implements sql.ProjectedTable
 func (t *Table) Projections() []string {
 	return t.projection
 }
 
+// Sample implements sql.SampledTable
+func (t *Table) Sample() (sql.TableSample, bool) {
+	if t.sample == nil {
+		return sql.TableSample{}, false
+	}
+	return *t.sample, true
+}
+
+// CanSample implements sql.SampledTable. Only whole partitions are sampled, rows are sampled by the TableSample node.
+func (t *Table) CanSample(method sql.TableSampleMethod) bool {
+	return method == sql.TableSampleSystem
+}
+
+// WithSample implements sql.SampledTable
+func (t *Table) WithSample(sample sql.TableSample) sql.Table {
+	nt := *t
+	nt.sample = &sample
+	return &nt
+}
+
 func (t *Table) columnIndexes(colNames []string) ([]int, error) {
 	columns := make([]int, 0, len(colNames))
 
@@ -1268,15 +1855,7 @@ func (t *Table) GetDeclaredForeignKeys(ctx *sql.Context) ([]sql.ForeignKeyConstr
 
 // GetReferencedForeignKeys implements the interface sql.ForeignKeyTable.
 func (t *Table) GetReferencedForeignKeys(ctx *sql.Context) ([]sql.ForeignKeyConstraint, error) {
-	//TODO: may not be the best location, need to handle db as well
-	var fks []sql.ForeignKeyConstraint
-	lowerName := strings.ToLower(t.name)
-	for _, fk := range t.fkColl.Keys() {
-		if strings.ToLower(fk.ParentTable) == lowerName {
-			fks = append(fks, fk)
-		}
-	}
-	return fks, nil
+	return t.fkColl.ReferencedKeys(t.name), nil
 }
 
 // AddForeignKey implements sql.ForeignKeyTable. Foreign partitionKeys are not enforced on update / delete.
@@ -1499,8 +2078,20 @@ func (t *Table) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName
 	if fromIndexName == toIndexName {
 		return nil
 	}
+	for name := range t.indexes {
+		if strings.EqualFold(name, fromIndexName) {
+			fromIndexName = name
+			break
+		}
+	}
 	if idx, ok := t.indexes[fromIndexName]; ok {
+		defer t.dataChanged()
 		delete(t.indexes, fromIndexName)
+		if memIdx, ok := idx.(*Index); ok {
+			renamed := *memIdx
+			renamed.Name = toIndexName
+			idx = &renamed
+		}
 		t.indexes[toIndexName] = idx
 	}
 	return nil
@@ -1513,10 +2104,25 @@ func (t *Table) ModifyStoredCollation(ctx *sql.Context, collation sql.CollationI
 
 // ModifyDefaultCollation implements sql.CollationAlterableTable
 func (t *Table) ModifyDefaultCollation(ctx *sql.Context, collation sql.CollationID) error {
+	defer t.dataChanged()
 	t.collation = collation
 	return nil
 }
 
+// TableOptions implements sql.TableOptionsTable
+func (t *Table) TableOptions() sql.TableOptions {
+	return t.options
+}
+
+// SetTableOptions implements sql.TableOptionsAlterableTable
+func (t *Table) SetTableOptions(ctx *sql.Context, options sql.TableOptions) error {
+	t.options = make(sql.TableOptions, len(options))
+	for name, value := range options {
+		t.options[name] = value
+	}
+	return nil
+}
+
 // WithDriverIndexLookup implements the sql.IndexAddressableTable interface.
 func (t *Table) WithDriverIndexLookup(lookup sql.DriverIndexLookup) sql.Table {
 	if t.lookup != nil {
@@ -1573,6 +2179,7 @@ Top:
 
 // CreatePrimaryKey implements the PrimaryKeyAlterableTable
 func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) error {
+	defer t.dataChanged()
 	// First check that a primary key already exists
 	for _, col := range t.schema.Schema {
 		if col.PrimaryKey {
@@ -1705,20 +2312,24 @@ func copyschema(sch sql.Schema) sql.Schema {
 
 func newTable(t *Table, newSch sql.PrimaryKeySchema) (*Table, error) {
 	newTable := NewPartitionedTableWithCollation(t.name, newSch, t.fkColl, len(t.partitions), t.collation)
+	// the rows are inserted in a single statement, so that the new table is sorted once
+	ctx := sql.NewEmptyContext()
+	inserter := newTable.Inserter(ctx)
 	for _, partition := range t.partitions {
 		for _, partitionRow := range partition {
-			err := newTable.Insert(sql.NewEmptyContext(), partitionRow)
+			err := inserter.Insert(ctx, partitionRow)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
-	return newTable, nil
+	return newTable, inserter.Close(ctx)
 }
 
 // DropPrimaryKey implements the PrimaryKeyAlterableTable
 func (t *Table) DropPrimaryKey(ctx *sql.Context) error {
+	defer t.dataChanged()
 	// Must drop auto increment property before dropping primary key
 	if t.schema.HasAutoIncrement() {
 		return sql.ErrWrongAutoKey.New()
@@ -16,7 +16,9 @@ package memory
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -33,6 +35,9 @@ type tableEditor struct {
 	uniqueIdxCols [][]int
 	prefixLengths [][]uint16
 	fkTable       *Table
+	// deferUniqueChecks is set when unique_checks is disabled, as it is while a dump is restored. Inserts then skip the
+	// checks of the unique indexes, which are made once for the whole statement when it completes instead.
+	deferUniqueChecks bool
 }
 
 var _ sql.Table = (*tableEditor)(nil)
@@ -79,6 +84,10 @@ func (t *tableEditor) Close(ctx *sql.Context) error {
 }
 
 func (t *tableEditor) StatementBegin(ctx *sql.Context) {
+	t.deferUniqueChecks = false
+	if uniqueChecks, err := ctx.GetSessionVariable(ctx, "unique_checks"); err == nil {
+		t.deferUniqueChecks = uniqueChecks == int8(0)
+	}
 	t.initialInsert = t.table.insertPartIdx
 	t.initialAutoIncVal = t.table.autoIncVal
 	t.initialPartitions = make(map[string][]sql.Row)
@@ -96,6 +105,7 @@ func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) e
 	t.table.autoIncVal = t.initialAutoIncVal
 	t.table.partitions = t.initialPartitions
 	t.ea.Clear()
+	t.table.dataChanged()
 	return nil
 }
 
@@ -105,6 +115,13 @@ func (t *tableEditor) StatementComplete(ctx *sql.Context) error {
 		return nil
 	}
 	t.ea.Clear()
+	t.table.dataChanged()
+	if t.deferUniqueChecks {
+		if err := t.checkUniqueIndexes(); err != nil {
+			_ = t.DiscardChanges(ctx, err)
+			return err
+		}
+	}
 	t.initialInsert = t.table.insertPartIdx
 	t.initialAutoIncVal = t.table.autoIncVal
 	t.initialPartitions = make(map[string][]sql.Row)
@@ -120,6 +137,10 @@ func (t *tableEditor) StatementComplete(ctx *sql.Context) error {
 
 // Insert a new row into the table.
 func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	row, err := readBlobValues(t.table.schema.Schema, row)
+	if err != nil {
+		return err
+	}
 	if err := checkRow(t.table.schema.Schema, row); err != nil {
 		return err
 	}
@@ -136,7 +157,7 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	}
 
 	for i, cols := range t.uniqueIdxCols {
-		if hasNullForAnyCols(row, cols) {
+		if t.deferUniqueChecks || hasNullForAnyCols(row, cols) {
 			continue
 		}
 		prefixLengths := t.prefixLengths[i]
@@ -179,6 +200,28 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	return nil
 }
 
+// checkUniqueIndexes returns a unique key error if the rows of the table have a duplicate value in any unique index.
+// It makes the checks that inserts skip while unique checks are deferred.
+func (t *tableEditor) checkUniqueIndexes() error {
+	for i, cols := range t.uniqueIdxCols {
+		prefixLengths := t.prefixLengths[i]
+		seen := make(map[string]sql.Row)
+		for _, partition := range t.table.partitions {
+			for _, row := range partition {
+				if hasNullForAnyCols(row, cols) {
+					continue
+				}
+				key := columnsKey(row, cols, prefixLengths)
+				if existing, ok := seen[key]; ok {
+					return sql.NewUniqueKeyErr(formatRow(row, cols), false, existing)
+				}
+				seen[key] = row
+			}
+		}
+	}
+	return nil
+}
+
 // Delete the given row from the table.
 func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 	if err := checkRow(t.table.Schema(), row); err != nil {
@@ -199,13 +242,17 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	if err := checkRow(t.table.Schema(), oldRow); err != nil {
 		return err
 	}
+	newRow, err := readBlobValues(t.table.Schema(), newRow)
+	if err != nil {
+		return err
+	}
 	if err := checkRow(t.table.Schema(), newRow); err != nil {
 		return err
 	}
 	t.table.verifyRowTypes(oldRow)
 	t.table.verifyRowTypes(newRow)
 
-	err := t.ea.Delete(oldRow)
+	err = t.ea.Delete(oldRow)
 	if err != nil {
 		return err
 	}
@@ -250,56 +297,42 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	return nil
 }
 
-// SetAutoIncrementValue sets a new AUTO_INCREMENT value
+// SetAutoIncrementValue sets a new AUTO_INCREMENT value. Like InnoDB, a value that isn't greater than the largest
+// value in the AUTO_INCREMENT column, including the rows still pending in the editor, is raised to the value after the
+// largest one.
 func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val uint64) error {
+	idx := t.table.autoColIdx
+	if idx >= 0 {
+		table, err := t.ea.EditedTable()
+		if err != nil {
+			return err
+		}
+		for _, p := range table.partitions {
+			for _, row := range p {
+				if row[idx] == nil {
+					continue
+				}
+				v, _, err := types.Uint64.Convert(row[idx])
+				if err != nil {
+					return err
+				}
+				if v.(uint64) >= val {
+					val = v.(uint64) + 1
+				}
+			}
+		}
+	}
 	t.table.autoIncVal = val
 	return nil
 }
 
 func (t *tableEditor) IndexedAccess(i sql.IndexLookup) sql.IndexedTable {
 	//TODO: optimize this, should create some a struct that encloses the tableEditor and filters based on the lookup
-	if pkTea, ok := t.ea.(*pkTableEditAccumulator); ok {
-		newTable, err := newTable(pkTea.table, pkTea.table.schema)
-		if err != nil {
-			panic(err)
-		}
-		adds := make(map[string]sql.Row)
-		deletes := make(map[string]sql.Row)
-		for key, val := range pkTea.adds {
-			adds[key] = val
-		}
-		for key, val := range pkTea.deletes {
-			deletes[key] = val
-		}
-		err = (&pkTableEditAccumulator{
-			table:   newTable,
-			adds:    adds,
-			deletes: deletes,
-		}).ApplyEdits(sql.NewEmptyContext())
-		if err != nil {
-			panic(err)
-		}
-		return &IndexedTable{Table: newTable, Lookup: i}
-	} else {
-		nonPkTea := t.ea.(*keylessTableEditAccumulator)
-		newTable, err := newTable(nonPkTea.table, nonPkTea.table.schema)
-		if err != nil {
-			panic(err)
-		}
-		adds := make([]sql.Row, len(nonPkTea.adds))
-		deletes := make([]sql.Row, len(nonPkTea.deletes))
-		copy(adds, nonPkTea.adds)
-		copy(deletes, nonPkTea.deletes)
-		err = (&keylessTableEditAccumulator{
-			table:   newTable,
-			adds:    adds,
-			deletes: deletes,
-		}).ApplyEdits(sql.NewEmptyContext())
-		if err != nil {
-			panic(err)
-		}
-		return &IndexedTable{Table: newTable, Lookup: i}
+	table, err := t.ea.EditedTable()
+	if err != nil {
+		panic(err)
 	}
+	return &IndexedTable{Table: table, Lookup: i}
 }
 
 func (t *tableEditor) pkColumnIndexes() []int {
@@ -321,49 +354,58 @@ func (t *tableEditor) pkColsDiffer(row, row2 sql.Row) bool {
 // Returns whether the values for the columns given match in the two rows provided
 func columnsMatch(colIndexes []int, prefixLengths []uint16, row sql.Row, row2 sql.Row) bool {
 	for i, idx := range colIndexes {
-		v1 := row[idx]
-		v2 := row2[idx]
-		if len(prefixLengths) > i && prefixLengths[i] > 0 {
-			prefixLength := prefixLengths[i]
-			switch v := v1.(type) {
-			case string:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v1 = v[:prefixLength]
-			case []byte:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v1 = v[:prefixLength]
-			}
+		var prefixLength uint16
+		if len(prefixLengths) > i {
 			prefixLength = prefixLengths[i]
-			switch v := v2.(type) {
-			case string:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v2 = v[:prefixLength]
-			case []byte:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v2 = v[:prefixLength]
-			}
-		}
-		if v, ok := v1.([]byte); ok {
-			v1 = string(v)
 		}
-		if v, ok := v2.([]byte); ok {
-			v2 = string(v)
-		}
-		if v1 != v2 {
+		if prefixValue(row[idx], prefixLength) != prefixValue(row2[idx], prefixLength) {
 			return false
 		}
 	}
 	return true
 }
 
+// prefixValue returns the prefix of the length given of a string or []byte value, or the whole value when the length
+// is 0, as a string. Other values are returned unchanged.
+func prefixValue(v interface{}, prefixLength uint16) interface{} {
+	switch val := v.(type) {
+	case string:
+		if prefixLength > 0 && int(prefixLength) < len(val) {
+			return val[:prefixLength]
+		}
+		return val
+	case []byte:
+		if prefixLength > 0 && int(prefixLength) < len(val) {
+			return string(val[:prefixLength])
+		}
+		return string(val)
+	default:
+		return v
+	}
+}
+
+// columnsKey returns a key of the values of the columns given of a row, which is the same for the rows that
+// columnsMatch finds match.
+func columnsKey(row sql.Row, colIndexes []int, prefixLengths []uint16) string {
+	var key strings.Builder
+	for i, idx := range colIndexes {
+		var prefixLength uint16
+		if len(prefixLengths) > i {
+			prefixLength = prefixLengths[i]
+		}
+		v := prefixValue(row[idx], prefixLength)
+		writeKeyPart(&key, fmt.Sprintf("%T:%#v", v, v))
+	}
+	return key.String()
+}
+
+// writeKeyPart writes a part of a key prefixed with its length, so that keys of different parts never collide.
+func writeKeyPart(key *strings.Builder, part string) {
+	key.WriteString(strconv.Itoa(len(part)))
+	key.WriteByte(':')
+	key.WriteString(part)
+}
+
 // tableEditAccumulator tracks the set of inserts and deletes and applies those edits to a initialTable.
 type tableEditAccumulator interface {
 	// Insert adds a row to the accumulator to be inserted in the future. Updates are modeled as a Delete then an insertPartIdx.
@@ -377,6 +419,10 @@ type tableEditAccumulator interface {
 	// accumulator.
 	ApplyEdits(ctx *sql.Context) error
 	GetByCols(value sql.Row, cols []int, prefixLengths []uint16) (sql.Row, bool, error)
+	// EditedTable returns a copy of the table with the edits in the accumulator applied. The copy is kept until the
+	// edits or the rows of the table change, so that lookups into it, like the ones of foreign key checks, don't copy
+	// the table every time.
+	EditedTable() (*Table, error)
 	// Clear wipes all of the stored inserts and deletes that may or may not have been applied.
 	Clear()
 }
@@ -398,33 +444,94 @@ func NewTableEditAccumulator(t *Table) tableEditAccumulator {
 	}
 }
 
+// editIndexThreshold is the number of rows looked up or edited in a statement past which the rows of a keyed table are
+// indexed by key, rather than scanned for each of them. Indexing the rows is only worth it for larger statements, like
+// bulk inserts and loads.
+const editIndexThreshold = 16
+
 // pkTableEditAccumulator manages the updates of keyed tables. It uses a map to efficiently toggle edits.
 type pkTableEditAccumulator struct {
 	table   *Table
 	adds    map[string]sql.Row
 	deletes map[string]sql.Row
+	// lookups index the rows of the table, so that the rows edits conflict with are found without scanning it, once
+	// the table has been scanned editIndexThreshold times
+	lookups *pkLookups
+	scans   int
+	// edited is the table with the edits applied, as of the data version editedVersion of the table
+	edited        *Table
+	editedVersion uint64
 }
 
 var _ tableEditAccumulator = (*pkTableEditAccumulator)(nil)
 
+// pkLookups index the rows of a keyed table by primary key, and by the columns of its unique indexes along with the
+// rows of the pending edits. They're built when they're first needed, and are kept until the edits are applied or
+// cleared, or the rows of the table change.
+type pkLookups struct {
+	version uint64
+	rows    map[string]sql.Row
+	unique  map[string]*uniqueLookup
+}
+
+// uniqueLookup indexes rows by the values of the columns of a unique index. The rows of the pending edits are indexed
+// by their primary key as well.
+type uniqueLookup struct {
+	cols          []int
+	prefixLengths []uint16
+	rows          map[string]sql.Row
+	adds          map[string]map[string]sql.Row
+	deletes       map[string]map[string]sql.Row
+}
+
 // Insert implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Insert(value sql.Row) error {
 	rowKey := pke.getRowKey(value)
-	delete(pke.deletes, rowKey)
+	pke.removeEdit(pke.deletes, rowKey, false)
+	pke.removeEdit(pke.adds, rowKey, true)
 	pke.adds[rowKey] = value
+	pke.indexEdit(rowKey, value, true)
 	return nil
 }
 
 // Delete implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Delete(value sql.Row) error {
 	rowKey := pke.getRowKey(value)
-
-	delete(pke.adds, rowKey)
+	pke.removeEdit(pke.adds, rowKey, true)
+	pke.removeEdit(pke.deletes, rowKey, false)
 	pke.deletes[rowKey] = value
-
+	pke.indexEdit(rowKey, value, false)
 	return nil
 }
 
+// removeEdit removes the row with the key given from the added or deleted rows |edits| of the pending edits.
+func (pke *pkTableEditAccumulator) removeEdit(edits map[string]sql.Row, rowKey string, added bool) {
+	row, ok := edits[rowKey]
+	if !ok {
+		return
+	}
+	delete(edits, rowKey)
+	pke.edited = nil
+	if pke.lookups == nil {
+		return
+	}
+	for _, lookup := range pke.lookups.unique {
+		lookup.removeEdit(rowKey, row, added)
+	}
+}
+
+// indexEdit adds the row with the key given, added or deleted by the pending edits, to the lookups of the unique
+// indexes.
+func (pke *pkTableEditAccumulator) indexEdit(rowKey string, row sql.Row, added bool) {
+	pke.edited = nil
+	if pke.lookups == nil {
+		return
+	}
+	for _, lookup := range pke.lookups.unique {
+		lookup.addEdit(rowKey, row, added)
+	}
+}
+
 // Get implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Get(value sql.Row) (sql.Row, bool, error) {
 	rowKey := pke.getRowKey(value)
@@ -439,6 +546,11 @@ func (pke *pkTableEditAccumulator) Get(value sql.Row) (sql.Row, bool, error) {
 		return r, false, nil
 	}
 
+	if lookups := pke.getLookups(); lookups != nil {
+		r, exists = lookups.rows[rowKey]
+		return r, exists, nil
+	}
+
 	pkColIdxes := pke.pkColumnIndexes()
 	for _, partition := range pke.table.partitions {
 		for _, partitionRow := range partition {
@@ -453,6 +565,21 @@ func (pke *pkTableEditAccumulator) Get(value sql.Row) (sql.Row, bool, error) {
 
 // GetByCols finds a row that has the same |cols| values as |value|.
 func (pke *pkTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefixLengths []uint16) (sql.Row, bool, error) {
+	if lookups := pke.getLookups(); lookups != nil {
+		lookup := pke.getUniqueLookup(lookups, cols, prefixLengths)
+		key := columnsKey(value, cols, prefixLengths)
+
+		// If we have this row in any delete, bail.
+		if len(lookup.deletes[key]) > 0 {
+			return nil, false, nil
+		}
+		for _, r := range lookup.adds[key] {
+			return r, true, nil
+		}
+		r, ok := lookup.rows[key]
+		return r, ok, nil
+	}
+
 	// If we have this row in any delete, bail.
 	for _, r := range pke.deletes {
 		if columnsMatch(cols, prefixLengths, r, value) {
@@ -477,119 +604,230 @@ func (pke *pkTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefixLe
 	return nil, false, nil
 }
 
-// ApplyEdits implements the tableEditAccumulator interface.
-func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
-	for _, val := range pke.deletes {
-		err := pke.deleteHelper(ctx, pke.table, val)
-		if err != nil {
-			return err
-		}
+// getLookups returns the lookups of the rows of the table, building them if they're out of date, or nil if the table
+// hasn't been scanned enough times in the statement to build them.
+func (pke *pkTableEditAccumulator) getLookups() *pkLookups {
+	version := atomic.LoadUint64(pke.table.dataVersion)
+	if pke.lookups != nil && pke.lookups.version == version {
+		return pke.lookups
+	}
+	if pke.scans < editIndexThreshold {
+		pke.scans++
+		return nil
 	}
 
-	for _, val := range pke.adds {
-		err := pke.insertHelper(ctx, pke.table, val)
-		if err != nil {
-			return err
+	rows := make(map[string]sql.Row)
+	for _, partition := range pke.table.partitions {
+		for _, row := range partition {
+			rows[pke.getRowKey(row)] = row
 		}
 	}
-
-	pke.table.sortRows()
-
-	return nil
+	pke.lookups = &pkLookups{
+		version: version,
+		rows:    rows,
+		unique:  make(map[string]*uniqueLookup),
+	}
+	return pke.lookups
 }
 
-// Clear implements the tableEditAccumulator interface.
-func (pke *pkTableEditAccumulator) Clear() {
-	pke.adds = make(map[string]sql.Row)
-	pke.deletes = make(map[string]sql.Row)
-}
+// getUniqueLookup returns the lookup of the rows of the table and the pending edits by the columns given, building it
+// if it's not in |lookups| yet.
+func (pke *pkTableEditAccumulator) getUniqueLookup(lookups *pkLookups, cols []int, prefixLengths []uint16) *uniqueLookup {
+	name := fmt.Sprint(cols, prefixLengths)
+	if lookup, ok := lookups.unique[name]; ok {
+		return lookup
+	}
 
-// pkColumnIndexes returns the indexes of the primary partitionKeys in the initialized table.
-func (pke *pkTableEditAccumulator) pkColumnIndexes() []int {
-	return pke.table.schema.PkOrdinals
+	lookup := &uniqueLookup{
+		cols:          cols,
+		prefixLengths: prefixLengths,
+		rows:          make(map[string]sql.Row),
+		adds:          make(map[string]map[string]sql.Row),
+		deletes:       make(map[string]map[string]sql.Row),
+	}
+	for _, partition := range pke.table.partitions {
+		for _, row := range partition {
+			key := columnsKey(row, cols, prefixLengths)
+			if _, ok := lookup.rows[key]; !ok {
+				lookup.rows[key] = row
+			}
+		}
+	}
+	for rowKey, row := range pke.adds {
+		lookup.addEdit(rowKey, row, true)
+	}
+	for rowKey, row := range pke.deletes {
+		lookup.addEdit(rowKey, row, false)
+	}
+	lookups.unique[name] = lookup
+	return lookup
 }
 
-// getRowKey returns a sql.Row of the primary partitionKeys a row in relation with the initialized table.
-func (pke *pkTableEditAccumulator) getRowKey(r sql.Row) string {
-	var rowKey strings.Builder
-	for _, i := range pke.table.schema.PkOrdinals {
-		rowKey.WriteString(fmt.Sprintf("%v", r[i]))
+// addEdit indexes the row with the primary key given, added or deleted by the pending edits.
+func (l *uniqueLookup) addEdit(rowKey string, row sql.Row, added bool) {
+	edits := l.deletes
+	if added {
+		edits = l.adds
 	}
-	return rowKey.String()
+	key := columnsKey(row, l.cols, l.prefixLengths)
+	if edits[key] == nil {
+		edits[key] = make(map[string]sql.Row)
+	}
+	edits[key][rowKey] = row
 }
 
-// deleteHelper deletes the given row from the table.
-func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	if err := checkRow(table.Schema(), row); err != nil {
-		return err
+// removeEdit removes the row with the primary key given, added or deleted by the pending edits, from the index.
+func (l *uniqueLookup) removeEdit(rowKey string, row sql.Row, added bool) {
+	edits := l.deletes
+	if added {
+		edits = l.adds
+	}
+	key := columnsKey(row, l.cols, l.prefixLengths)
+	delete(edits[key], rowKey)
+	if len(edits[key]) == 0 {
+		delete(edits, key)
 	}
+}
 
-	matches := false
-	for partitionIndex, partition := range table.partitions {
-		for partitionRowIndex, partitionRow := range partition {
-			matches = true
+// ApplyEdits implements the tableEditAccumulator interface. The rows are deleted and inserted in a single pass over
+// the table, which is sorted once all of them are inserted.
+func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
+	table := pke.table
+	pkColIdxes := pke.pkColumnIndexes()
 
-			// For DELETE queries, we will have previously selected the row in order to delete it. For REPLACE, we will just
-			// have the row to be replaced, so we need to consider primary key information.
-			pkColIdxes := pke.pkColumnIndexes()
-			if len(pkColIdxes) > 0 {
-				if columnsMatch(pkColIdxes, nil, partitionRow, row) {
-					table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
-					break
+	if len(pke.deletes) > 0 {
+		// For DELETE queries, we will have previously selected the row in order to delete it. For REPLACE, we will
+		// just have the row to be replaced, so we only consider primary key information.
+		isDeleted := func(row sql.Row) bool {
+			_, ok := pke.deletes[pke.getRowKey(row)]
+			return ok
+		}
+		if len(pke.deletes) <= editIndexThreshold {
+			isDeleted = func(row sql.Row) bool {
+				for _, deleted := range pke.deletes {
+					if columnsMatch(pkColIdxes, nil, row, deleted) {
+						return true
+					}
 				}
+				return false
 			}
+		}
 
-			var err error
-			matches, err = rowsAreEqual(ctx, table.Schema(), row, partitionRow)
-			if err != nil {
-				return err
+		for partitionIndex, partition := range table.partitions {
+			kept := partition[:0]
+			for _, partitionRow := range partition {
+				if !isDeleted(partitionRow) {
+					kept = append(kept, partitionRow)
+				}
 			}
+			table.partitions[partitionIndex] = kept
+		}
+	}
 
-			if matches {
-				table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
-				break
+	if len(pke.adds) > 0 {
+		type rowPosition struct {
+			partition string
+			index     int
+		}
+		var positions map[string]rowPosition
+		if len(pke.adds) > editIndexThreshold {
+			positions = make(map[string]rowPosition)
+			for partitionIndex, partition := range table.partitions {
+				for partitionRowIndex, partitionRow := range partition {
+					positions[pke.getRowKey(partitionRow)] = rowPosition{partitionIndex, partitionRowIndex}
+				}
 			}
 		}
-		if matches {
-			break
+		findPosition := func(rowKey string, row sql.Row) (rowPosition, bool) {
+			if positions != nil {
+				pos, ok := positions[rowKey]
+				return pos, ok
+			}
+			for partitionIndex, partition := range table.partitions {
+				for partitionRowIndex, partitionRow := range partition {
+					if columnsMatch(pkColIdxes, nil, partitionRow, row) {
+						return rowPosition{partitionIndex, partitionRowIndex}, true
+					}
+				}
+			}
+			return rowPosition{}, false
 		}
+
+		for rowKey, row := range pke.adds {
+			// Instead of throwing a unique key error, we perform an update operation to essentially represent map
+			// semantics for the keyed table.
+			if pos, ok := findPosition(rowKey, row); ok {
+				table.partitions[pos.partition][pos.index] = row
+				continue
+			}
+
+			key := string(table.partitionKeys[table.insertPartIdx])
+			table.insertPartIdx++
+			if table.insertPartIdx == len(table.partitionKeys) {
+				table.insertPartIdx = 0
+			}
+			table.partitions[key] = append(table.partitions[key], row)
+		}
+
+		table.sortRows()
 	}
 
+	pke.lookups, pke.scans = nil, 0
 	return nil
 }
 
-// insertHelper inserts the given row into the given table.
-func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+// EditedTable implements the tableEditAccumulator interface.
+func (pke *pkTableEditAccumulator) EditedTable() (*Table, error) {
+	version := atomic.LoadUint64(pke.table.dataVersion)
+	if pke.edited != nil && pke.editedVersion == version {
+		return pke.edited, nil
 	}
 
-	pkColIdxes := pke.pkColumnIndexes()
-	savedPartitionIndex := ""
-	savedPartitionRowIndex := -1
-	if len(pkColIdxes) > 0 {
-		for partitionIndex, partition := range table.partitions {
-			for partitionRowIndex, partitionRow := range partition {
-				if columnsMatch(pkColIdxes, nil, partitionRow, row) {
-					// Instead of throwing a unique key error, we perform an update operation to essentially represent
-					// map semantics for the keyed table.
-					savedPartitionIndex = partitionIndex
-					savedPartitionRowIndex = partitionRowIndex
-					break
-				}
-			}
-		}
+	newTable, err := newTable(pke.table, pke.table.schema)
+	if err != nil {
+		return nil, err
 	}
-
-	if savedPartitionRowIndex > -1 {
-		table.partitions[savedPartitionIndex][savedPartitionRowIndex] = row
-	} else {
-		table.partitions[key] = append(table.partitions[key], row)
+	adds := make(map[string]sql.Row, len(pke.adds))
+	deletes := make(map[string]sql.Row, len(pke.deletes))
+	for key, val := range pke.adds {
+		adds[key] = val
+	}
+	for key, val := range pke.deletes {
+		deletes[key] = val
+	}
+	err = (&pkTableEditAccumulator{
+		table:   newTable,
+		adds:    adds,
+		deletes: deletes,
+	}).ApplyEdits(sql.NewEmptyContext())
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	pke.edited, pke.editedVersion = newTable, version
+	return newTable, nil
+}
+
+// Clear implements the tableEditAccumulator interface.
+func (pke *pkTableEditAccumulator) Clear() {
+	pke.adds = make(map[string]sql.Row)
+	pke.deletes = make(map[string]sql.Row)
+	pke.lookups, pke.scans = nil, 0
+	pke.edited = nil
+}
+
+// pkColumnIndexes returns the indexes of the primary partitionKeys in the initialized table.
+func (pke *pkTableEditAccumulator) pkColumnIndexes() []int {
+	return pke.table.schema.PkOrdinals
+}
+
+// getRowKey returns a sql.Row of the primary partitionKeys a row in relation with the initialized table.
+func (pke *pkTableEditAccumulator) getRowKey(r sql.Row) string {
+	var rowKey strings.Builder
+	for _, i := range pke.table.schema.PkOrdinals {
+		writeKeyPart(&rowKey, fmt.Sprintf("%v", r[i]))
+	}
+	return rowKey.String()
 }
 
 // keylessTableEditAccumulator manages updates for a keyless table.
@@ -597,12 +835,16 @@ type keylessTableEditAccumulator struct {
 	table   *Table
 	adds    []sql.Row
 	deletes []sql.Row
+	// edited is the table with the edits applied, as of the data version editedVersion of the table
+	edited        *Table
+	editedVersion uint64
 }
 
 var _ tableEditAccumulator = (*keylessTableEditAccumulator)(nil)
 
 // Insert implements the tableEditAccumulator interface.
 func (k *keylessTableEditAccumulator) Insert(value sql.Row) error {
+	k.edited = nil
 	for i, row := range k.deletes {
 		eq, err := value.Equals(row, k.table.schema.Schema)
 		if err != nil {
@@ -621,6 +863,7 @@ func (k *keylessTableEditAccumulator) Insert(value sql.Row) error {
 
 // Delete implements the tableEditAccumulator interface.
 func (k *keylessTableEditAccumulator) Delete(value sql.Row) error {
+	k.edited = nil
 	for i, row := range k.adds {
 		eq, err := value.Equals(row, k.table.schema.Schema)
 		if err != nil {
@@ -695,10 +938,39 @@ func (k *keylessTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
 	return nil
 }
 
+// EditedTable implements the tableEditAccumulator interface.
+func (k *keylessTableEditAccumulator) EditedTable() (*Table, error) {
+	version := atomic.LoadUint64(k.table.dataVersion)
+	if k.edited != nil && k.editedVersion == version {
+		return k.edited, nil
+	}
+
+	newTable, err := newTable(k.table, k.table.schema)
+	if err != nil {
+		return nil, err
+	}
+	adds := make([]sql.Row, len(k.adds))
+	deletes := make([]sql.Row, len(k.deletes))
+	copy(adds, k.adds)
+	copy(deletes, k.deletes)
+	err = (&keylessTableEditAccumulator{
+		table:   newTable,
+		adds:    adds,
+		deletes: deletes,
+	}).ApplyEdits(sql.NewEmptyContext())
+	if err != nil {
+		return nil, err
+	}
+
+	k.edited, k.editedVersion = newTable, version
+	return newTable, nil
+}
+
 // Clear implements the tableEditAccumulator interface.
 func (k *keylessTableEditAccumulator) Clear() {
 	k.adds = make([]sql.Row, 0)
 	k.deletes = make([]sql.Row, 0)
+	k.edited = nil
 }
 
 // deleteHelper deletes a row from a keyless table, if it exists.
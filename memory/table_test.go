@@ -307,6 +307,25 @@ func TestFilterAndProject(t *testing.T) {
 	}
 }
 
+func TestSampled(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var require = require.New(t)
+
+			table := memory.NewPartitionedTable(test.name, test.schema, nil, test.numPartitions)
+			for _, row := range test.rows {
+				require.NoError(table.Insert(sql.NewEmptyContext(), row))
+			}
+
+			require.Len(getAllRows(t, table.WithSample(sql.TableSample{Method: sql.TableSampleSystem, Percent: 100})), len(test.rows))
+			require.Empty(getAllRows(t, table.WithSample(sql.TableSample{Method: sql.TableSampleSystem, Percent: 0})))
+
+			sample := sql.TableSample{Method: sql.TableSampleSystem, Percent: 50, Repeatable: true, Seed: 42}
+			require.Equal(getAllRows(t, table.WithSample(sample)), getAllRows(t, table.WithSample(sample)))
+		})
+	}
+}
+
 func TestIndexed(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -332,6 +351,45 @@ func TestIndexed(t *testing.T) {
 	}
 }
 
+func TestIndexLookupOrder(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t"},
+		{Name: "v", Type: types.Int64, Source: "t"},
+	})
+	table := memory.NewPartitionedTable("t", schema, nil, 3)
+	for i, v := range []int64{5, 1, 4, 2, 3, 6} {
+		require.NoError(table.Insert(ctx, sql.NewRow(int64(i), v)))
+	}
+	idx := &memory.Index{
+		Name:      "v",
+		TableName: "t",
+		Tbl:       table,
+		Exprs:     []sql.Expression{expression.NewGetFieldWithTable(1, types.Int64, "t", "v", true)},
+	}
+
+	lookupRows := func(reverse bool, offset uint64) []sql.Row {
+		lookup, err := sql.NewIndexBuilder(idx).GreaterThan(ctx, "t.v", 1).Build(ctx)
+		require.NoError(err)
+		lookup.IsReverse = reverse
+		indexed := table.IndexedAccess(lookup).(sql.IndexOffsetTable).WithIndexOffset(offset)
+		partitions, err := indexed.LookupPartitions(ctx, lookup)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, indexed, partitions))
+		require.NoError(err)
+		return rows
+	}
+
+	// the rows of a lookup are in the order of the index across the partitions of the table
+	require.Equal([]sql.Row{{int64(3), int64(2)}, {int64(4), int64(3)}, {int64(2), int64(4)}, {int64(0), int64(5)}, {int64(5), int64(6)}}, lookupRows(false, 0))
+	require.Equal([]sql.Row{{int64(5), int64(6)}, {int64(0), int64(5)}, {int64(2), int64(4)}, {int64(4), int64(3)}, {int64(3), int64(2)}}, lookupRows(true, 0))
+	require.Equal([]sql.Row{{int64(2), int64(4)}, {int64(0), int64(5)}, {int64(5), int64(6)}}, lookupRows(false, 2))
+	require.Equal([]sql.Row{{int64(3), int64(2)}}, lookupRows(true, 4))
+	require.Empty(lookupRows(false, 5))
+}
+
 func getAllRows(t *testing.T, table sql.Table) []sql.Row {
 	var require = require.New(t)
 
@@ -417,3 +475,109 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func TestTableBulkInsert(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("bulk", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Text, Source: "bulk", PrimaryKey: true},
+		{Name: "b", Type: types.Text, Source: "bulk", PrimaryKey: true},
+		{Name: "c", Type: types.Int64, Source: "bulk"},
+	}), nil, 3)
+
+	// keys whose columns concatenate to the same string are distinct rows
+	inserter := table.Inserter(ctx)
+	var expected []sql.Row
+	for i := 0; i < 100; i++ {
+		row := sql.NewRow(fmt.Sprintf("a%d", i), fmt.Sprintf("b%d", i), int64(i))
+		require.NoError(inserter.Insert(ctx, row))
+		expected = append(expected, row)
+		row = sql.NewRow(fmt.Sprintf("a%db", i), fmt.Sprint(i), int64(-i))
+		require.NoError(inserter.Insert(ctx, row))
+		expected = append(expected, row)
+	}
+	require.NoError(inserter.Close(ctx))
+
+	inserter = table.Inserter(ctx)
+	err := inserter.Insert(ctx, sql.NewRow("a50", "b50", int64(0)))
+	require.Error(err)
+	require.True(sql.ErrPrimaryKeyViolation.Is(err) || sql.ErrUniqueKeyViolation.Is(err))
+	require.NoError(inserter.Close(ctx))
+
+	partitions, err := table.Partitions(ctx)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, table, partitions))
+	require.NoError(err)
+	require.ElementsMatch(expected, rows)
+}
+
+func TestSetAutoIncrementValueWithPendingRows(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "t", Type: types.Int64, PrimaryKey: true, AutoIncrement: true},
+		{Name: "v", Source: "t", Type: types.Int64},
+	}), nil, 2)
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(5), int64(1))))
+
+	inserter := table.Inserter(ctx)
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(30), int64(2))))
+	require.NoError(inserter.(sql.AutoIncrementSetter).SetAutoIncrementValue(ctx, 10))
+	next, err := table.PeekNextAutoIncrementValue(ctx)
+	require.NoError(err)
+	require.Equal(uint64(31), next)
+
+	require.NoError(inserter.(sql.AutoIncrementSetter).SetAutoIncrementValue(ctx, 40))
+	next, err = table.PeekNextAutoIncrementValue(ctx)
+	require.NoError(err)
+	require.Equal(uint64(40), next)
+	require.NoError(inserter.Close(ctx))
+}
+
+func TestTableCheckConsistency(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "t", Type: types.Int64, PrimaryKey: true, AutoIncrement: true},
+		{Name: "u", Source: "t", Type: types.Text, Nullable: true},
+		{Name: "v", Source: "t", Type: types.Int64},
+	}), nil, 2)
+	require.NoError(table.CreateIndex(ctx, sql.IndexDef{
+		Name:       "u",
+		Columns:    []sql.IndexColumn{{Name: "u", Length: 2}},
+		Constraint: sql.IndexConstraint_Unique,
+	}))
+	for _, row := range []sql.Row{
+		{int64(1), "aaa", int64(1)},
+		{int64(2), "bbb", int64(2)},
+		{int64(3), nil, int64(3)},
+		{int64(4), nil, int64(4)},
+	} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	problems, err := table.CheckConsistency(ctx)
+	require.NoError(err)
+	require.Empty(problems)
+
+	// corrupt the rows in place to break the primary key, the unique prefix index and a NOT NULL column
+	var rows []sql.Row
+	for _, key := range []string{"0", "1"} {
+		rows = append(rows, table.GetPartition(key)...)
+	}
+	require.Len(rows, 4)
+	rows[1][0] = rows[0][0]
+	rows[1][1] = rows[0][1].(string)[:2] + "z"
+	rows[2][2] = nil
+
+	problems, err = table.CheckConsistency(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]string{
+		"Column 'v' is NULL in a row, but it's NOT NULL",
+		fmt.Sprintf("Duplicate entry '[%v]' for key 'PRIMARY'", rows[0][0]),
+		"Duplicate entry '[aaz]' for key 'u'",
+	}, problems)
+}
@@ -90,6 +90,8 @@ func (pl *ProcessList) ConnectionReady(sess sql.Session) {
 		User:       sess.Client().User,
 		StartedAt:  time.Now(),
 		Database:   sess.GetCurrentDatabase(),
+
+		ConnectAttributes: sess.Client().Attributes,
 	}
 }
 
@@ -0,0 +1,427 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// defaultQueryCacheRowLimit is the maximum number of rows of the result sets cached when the engine's config doesn't
+// give one.
+const defaultQueryCacheRowLimit = 1000
+
+// queryCacheSessionVariables are the session variables that change the results of the same query, whose values are
+// part of the key of its cached results.
+var queryCacheSessionVariables = []string{
+	"sql_mode",
+	"time_zone",
+	"collation_connection",
+	"character_set_results",
+	"div_precision_increment",
+	"sql_select_limit",
+}
+
+// QueryCache caches the result sets of deterministic SELECT statements that only read tables implementing
+// sql.VersionedTable. Results are keyed by the text of the query, the current database and the session variables that
+// change them, and are stored along with the data versions of the tables read. Cached results are only returned while
+// all those tables still have the same versions, and are dropped otherwise. Queries are still analyzed before their
+// results are looked up, so that privileges are checked and the tables they read are resolved as usual.
+//
+// Whether a session uses the cache is given by its query_cache_type system variable: OFF never uses it, ON caches all
+// the queries that can be cached except those with the SQL_NO_CACHE modifier, and DEMAND only caches the queries with
+// the SQL_CACHE modifier.
+type QueryCache struct {
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	size     int
+	rowLimit int
+}
+
+// queryCacheEntry is the result set of a query stored in a QueryCache.
+type queryCacheEntry struct {
+	key      string
+	versions []string
+	schema   sql.Schema
+	rows     []sql.Row
+}
+
+// NewQueryCache returns a new QueryCache holding the result sets of at most |size| queries, evicting the least
+// recently used ones first. Result sets of more than |rowLimit| rows aren't cached.
+func NewQueryCache(size, rowLimit int) *QueryCache {
+	if rowLimit <= 0 {
+		rowLimit = defaultQueryCacheRowLimit
+	}
+	return &QueryCache{
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		size:     size,
+		rowLimit: rowLimit,
+	}
+}
+
+// Len returns the number of result sets in this cache.
+func (c *QueryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Clear removes all the result sets from this cache.
+func (c *QueryCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sql.IncrementStatusVariable(nil, "Qcache_queries_in_cache", -int64(c.lru.Len()))
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// get returns the cached rows for |key| if they were read from tables with the |versions| given. Rows read from tables
+// with other versions are dropped.
+func (c *QueryCache) get(key string, versions []string) ([]sql.Row, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*queryCacheEntry)
+	if !versionsEqual(entry.versions, versions) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.rows, true
+}
+
+// put stores the |rows| of the query with the |key| given, which were read from tables with the |versions| given.
+func (c *QueryCache) put(key string, versions []string, schema sql.Schema, rows []sql.Row) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	for c.lru.Len() >= c.size && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+		sql.IncrementStatusVariable(nil, "Qcache_lowmem_prunes", 1)
+	}
+	c.entries[key] = c.lru.PushFront(&queryCacheEntry{key: key, versions: versions, schema: schema, rows: rows})
+	sql.IncrementStatusVariable(nil, "Qcache_inserts", 1)
+	sql.IncrementStatusVariable(nil, "Qcache_queries_in_cache", 1)
+}
+
+// remove removes the entry |elem| from this cache. The cache must be locked.
+func (c *QueryCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*queryCacheEntry).key)
+	sql.IncrementStatusVariable(nil, "Qcache_queries_in_cache", -1)
+}
+
+func versionsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// query returns the results of the |query| given, whose plan is |analyzed|, from this cache, if the session uses the
+// cache for it and they're in it. Otherwise returns the key and versions to store its results with, if they can be.
+func (c *QueryCache) query(ctx *sql.Context, query string, analyzed sql.Node) (rows []sql.Row, key string, versions []string, ok bool, err error) {
+	useCache, err := sessionUsesQueryCache(ctx, query)
+	if err != nil || !useCache {
+		return nil, "", nil, false, err
+	}
+
+	versions, cacheable, err := queryTableVersions(ctx, analyzed)
+	if err != nil {
+		return nil, "", nil, false, err
+	}
+	if !cacheable {
+		sql.IncrementStatusVariable(ctx, "Qcache_not_cached", 1)
+		return nil, "", nil, false, nil
+	}
+
+	key, err = queryCacheKey(ctx, query)
+	if err != nil {
+		return nil, "", nil, false, err
+	}
+	if rows, ok := c.get(key, versions); ok {
+		sql.IncrementStatusVariable(ctx, "Qcache_hits", 1)
+		return rows, key, versions, true, nil
+	}
+	return nil, key, versions, false, nil
+}
+
+// sessionUsesQueryCache returns whether the session of the context given uses the query cache for the |query| given,
+// according to its query_cache_type and the SQL_CACHE and SQL_NO_CACHE modifiers of the query.
+func sessionUsesQueryCache(ctx *sql.Context, query string) (bool, error) {
+	val, err := ctx.GetSessionVariable(ctx, "query_cache_type")
+	if err != nil {
+		return false, err
+	}
+	cacheType, _ := val.(string)
+	if strings.EqualFold(cacheType, "OFF") {
+		return false, nil
+	}
+
+	isSelect, hint := selectCacheModifier(query)
+	if !isSelect {
+		return false, nil
+	}
+	switch hint {
+	case sqlparser.SQL_CACHE:
+		return true, nil
+	case sqlparser.SQL_NO_CACHE:
+		return false, nil
+	default:
+		return !strings.EqualFold(cacheType, "DEMAND"), nil
+	}
+}
+
+// selectCacheModifier returns whether the |query| given is a SELECT statement that can be cached, which excludes
+// locking reads, and the SQL_CACHE or SQL_NO_CACHE token of its modifiers, if it has one.
+func selectCacheModifier(query string) (isSelect bool, modifier int) {
+	tkn := sqlparser.NewStringTokenizer(query)
+	typ, _ := tkn.Scan()
+	for typ == '(' || typ == sqlparser.COMMENT {
+		typ, _ = tkn.Scan()
+	}
+	if typ != sqlparser.SELECT {
+		return false, 0
+	}
+
+	depth, prev := 0, 0
+	for typ != 0 {
+		switch {
+		case typ == '(':
+			depth++
+		case typ == ')':
+			depth--
+		case typ == sqlparser.LEX_ERROR || typ == ';':
+			return false, 0
+		case depth > 0:
+		case typ == sqlparser.SQL_CACHE || typ == sqlparser.SQL_NO_CACHE:
+			modifier = typ
+		case isLockingReadClause(prev, typ):
+			return false, 0
+		}
+		prev = typ
+		typ, _ = tkn.Scan()
+	}
+	return true, modifier
+}
+
+// isLockingReadClause returns whether the tokens |prev| and |typ| start a FOR UPDATE, FOR SHARE or LOCK IN SHARE MODE
+// clause.
+func isLockingReadClause(prev, typ int) bool {
+	return prev == sqlparser.FOR && (typ == sqlparser.UPDATE || typ == sqlparser.SHARE) ||
+		prev == sqlparser.LOCK && typ == sqlparser.IN
+}
+
+// hasLockingRead returns whether the |query| given has a locking read clause anywhere in it.
+func hasLockingRead(query string) bool {
+	tkn := sqlparser.NewStringTokenizer(query)
+	prev := 0
+	for {
+		typ, _ := tkn.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR || typ == ';' {
+			return false
+		}
+		if isLockingReadClause(prev, typ) {
+			return true
+		}
+		prev = typ
+	}
+}
+
+// queryCacheKey returns the key of the results of the |query| given for the session of the context given. The results
+// of each user are kept apart, since the row access policies and column masks of the tables they read depend on the
+// user.
+func queryCacheKey(ctx *sql.Context, query string) (string, error) {
+	var sb strings.Builder
+	client := ctx.Session.Client()
+	sb.WriteString(client.User)
+	sb.WriteByte('@')
+	sb.WriteString(client.Address)
+	sb.WriteByte(0)
+	sb.WriteString(ctx.GetCurrentDatabase())
+	for _, name := range queryCacheSessionVariables {
+		val, err := ctx.GetSessionVariable(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(0)
+		fmt.Fprintf(&sb, "%v", val)
+	}
+	sb.WriteByte(0)
+	sb.WriteString(query)
+	return sb.String(), nil
+}
+
+// queryTableVersions returns the data versions of the tables read by the plan |n| given, and whether its results can
+// be cached, which they can't if it reads a table that isn't versioned or has non-deterministic expressions.
+func queryTableVersions(ctx *sql.Context, n sql.Node) ([]string, bool, error) {
+	var versions []string
+	cacheable := true
+	var err error
+
+	var inspectNode func(n sql.Node) bool
+	inspectExpr := func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery:
+			transform.Inspect(e.Query, inspectNode)
+		case *expression.UserVar, *expression.SystemVar, *expression.BindVar, *expression.ProcedureParam,
+			*function.Now, *function.UTCTimestamp, function.CurrTime, *function.CurrTimestamp, function.CurrDate,
+			*function.Sleep, *function.GetLock, *function.IsUsedLock, *function.IsFreeLock, function.ReleaseAllLocks,
+			*function.ReleaseLock:
+			cacheable = false
+		case *function.UnixTimestamp:
+			cacheable = cacheable && e.Date != nil
+		case sql.NonDeterministicExpression:
+			cacheable = cacheable && !e.IsNonDeterministic()
+		}
+		return cacheable
+	}
+	addVersion := func(db sql.Database, table sql.Table) {
+		for table != nil {
+			if st, ok := table.(sql.SampledTable); ok {
+				if sample, ok := st.Sample(); ok && !sample.Repeatable {
+					cacheable = false
+					return
+				}
+			}
+			if vt, ok := table.(sql.VersionedTable); ok {
+				var version string
+				if version, err = vt.DataVersion(ctx); err != nil {
+					cacheable = false
+					return
+				}
+				dbName := ""
+				if db != nil {
+					dbName = db.Name()
+				}
+				versions = append(versions, fmt.Sprintf("%s.%s:%s", dbName, table.Name(), version))
+				return
+			}
+			tw, ok := table.(sql.TableWrapper)
+			if !ok {
+				break
+			}
+			table = tw.Underlying()
+		}
+		cacheable = false
+	}
+	inspectNode = func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			addVersion(n.Database, n.Table)
+		case *plan.IndexedTableAccess:
+			addVersion(n.ResolvedTable.Database, n.ResolvedTable.Table)
+		case *plan.TableCountLookup:
+			addVersion(n.Db(), n.Table())
+		case *plan.TableSample:
+			cacheable = cacheable && n.Sample.Repeatable
+		case *plan.Into:
+			cacheable = false
+		case *plan.Limit:
+			cacheable = cacheable && !n.CalcFoundRows
+		case sql.TableFunction, sql.Table:
+			cacheable = false
+		}
+		if ex, ok := n.(sql.Expressioner); ok && cacheable {
+			for _, e := range ex.Expressions() {
+				transform.InspectExpr(e, func(e sql.Expression) bool {
+					return !inspectExpr(e)
+				})
+			}
+		}
+		return cacheable
+	}
+	transform.Inspect(n, inspectNode)
+	return versions, cacheable, err
+}
+
+// withQueryCacheResults returns the |analyzed| plan of a query with the node that computes its results, below the nodes
+// that track and commit it, replaced by its cached |rows|.
+func withQueryCacheResults(analyzed sql.Node, rows []sql.Row) (sql.Node, error) {
+	switch n := analyzed.(type) {
+	case *plan.TransactionCommittingNode:
+		child, err := withQueryCacheResults(n.Child(), rows)
+		if err != nil {
+			return nil, err
+		}
+		return n.WithChildren(child)
+	case *plan.QueryProcess:
+		child, err := withQueryCacheResults(n.Child(), rows)
+		if err != nil {
+			return nil, err
+		}
+		return n.WithChildren(child)
+	default:
+		return plan.NewCachedQueryResults(analyzed.Schema(), rows), nil
+	}
+}
+
+// queryCacheIter stores the rows of the query it iterates in a QueryCache once they have all been read, unless there
+// are more than the cache stores.
+type queryCacheIter struct {
+	iter     sql.RowIter
+	cache    *QueryCache
+	key      string
+	versions []string
+	schema   sql.Schema
+	rows     []sql.Row
+	skip     bool
+}
+
+var _ sql.RowIter = (*queryCacheIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (i *queryCacheIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err == io.EOF && !i.skip {
+		i.cache.put(i.key, i.versions, i.schema, i.rows)
+		i.skip = true
+	} else if err == nil && !i.skip {
+		if len(i.rows) >= i.cache.rowLimit {
+			i.rows, i.skip = nil, true
+		} else {
+			i.rows = append(i.rows, row.Copy())
+		}
+	}
+	return row, err
+}
+
+// Close implements the interface sql.RowIter.
+func (i *queryCacheIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectCacheModifier(t *testing.T) {
+	tests := []struct {
+		query    string
+		isSelect bool
+		modifier int
+	}{
+		{"select * from t", true, 0},
+		{"SELECT SQL_CACHE * FROM t", true, sqlparser.SQL_CACHE},
+		{"select distinct sql_no_cache a from t", true, sqlparser.SQL_NO_CACHE},
+		{"(select sql_cache a from t) union (select b from u)", true, sqlparser.SQL_CACHE},
+		{"select a from t where b in (select sql_cache b from u)", true, 0},
+		{"/* comment */ select sql_cache 1", true, sqlparser.SQL_CACHE},
+		{"select * from t for update", false, 0},
+		{"select * from t lock in share mode", false, 0},
+		{"select 1; select 2", false, 0},
+		{"insert into t select sql_cache * from u", false, 0},
+		{"show tables", false, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			isSelect, modifier := selectCacheModifier(test.query)
+			require.Equal(t, test.isSelect, isSelect)
+			require.Equal(t, test.modifier, modifier)
+		})
+	}
+}
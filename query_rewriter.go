@@ -0,0 +1,56 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// QueryRewriter inspects the statements run by the engine before they're analyzed, and may rewrite or reject them,
+// like the query rewrite plugins of MySQL. Integrators use it to route statements, block the ones they consider
+// dangerous or translate the syntax of other databases, without changing the parser or the analyzer.
+type QueryRewriter interface {
+	// RewriteQuery is called with the text of each statement before it's parsed. It returns the text to parse
+	// instead, which is |query| itself when it isn't rewritten, or an error to reject the statement.
+	RewriteQuery(ctx *sql.Context, query string) (string, error)
+	// RewriteNode is called with the parsed, unresolved statement before it's analyzed. |query| is the text it was
+	// parsed from. It returns the node to analyze instead, which is |parsed| itself when it isn't rewritten, or an
+	// error to reject the statement.
+	RewriteNode(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error)
+}
+
+// RewriteQuery returns the text of the statement given as rewritten by the engine's query rewriters, in the order
+// they were configured. It's the text to parse, and must be called by the clients that parse statements themselves
+// before running them with QueryNodeWithBindings.
+func (e *Engine) RewriteQuery(ctx *sql.Context, query string) (string, error) {
+	for _, r := range e.QueryRewriters {
+		var err error
+		if query, err = r.RewriteQuery(ctx, query); err != nil {
+			return "", err
+		}
+	}
+	return query, nil
+}
+
+// rewriteNode returns the parsed statement given as rewritten by the engine's query rewriters.
+func (e *Engine) rewriteNode(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error) {
+	for _, r := range e.QueryRewriters {
+		var err error
+		if parsed, err = r.RewriteNode(ctx, query, parsed); err != nil {
+			return nil, err
+		}
+	}
+	return parsed, nil
+}
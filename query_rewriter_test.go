@@ -0,0 +1,66 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var errDropDatabaseBlocked = errors.New("DROP DATABASE is blocked")
+
+// testRewriter translates a function of another database and blocks DROP DATABASE statements.
+type testRewriter struct{}
+
+func (testRewriter) RewriteQuery(ctx *sql.Context, query string) (string, error) {
+	return strings.ReplaceAll(query, "GETDATE()", "NOW()"), nil
+}
+
+func (testRewriter) RewriteNode(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error) {
+	if _, ok := parsed.(*plan.DropDB); ok {
+		return nil, errDropDatabaseBlocked
+	}
+	return parsed, nil
+}
+
+func TestQueryRewriter(t *testing.T) {
+	pro := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	e := New(analyzer.NewDefault(pro), &Config{QueryRewriters: []QueryRewriter{testRewriter{}}})
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+	_, iter, err := e.Query(ctx, "SELECT GETDATE() IS NOT NULL")
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{true}}, rows)
+
+	_, err = e.AnalyzeQuery(ctx, "SELECT GETDATE()")
+	require.NoError(t, err)
+
+	_, _, err = e.Query(ctx, "DROP DATABASE mydb")
+	require.ErrorIs(t, err, errDropDatabaseBlocked)
+	_, err = e.PrepareQuery(ctx, "DROP DATABASE mydb")
+	require.ErrorIs(t, err, errDropDatabaseBlocked)
+	require.True(t, pro.HasDatabase(ctx, "mydb"))
+}
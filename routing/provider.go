@@ -0,0 +1,312 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routing provides a database provider that sends the statements that only read tables to one of several
+// replicas of a primary provider, and every other statement to the primary. A session that writes to the primary is
+// pinned to it until a replica has caught up with its writes, so that it reads them back. Replicating the primary's
+// databases to the replicas is up to the integrator.
+package routing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// DefaultPinDuration is how long a session that wrote to the primary reads from it when the replicas can't report
+// how far they've caught up.
+const DefaultPinDuration = time.Second
+
+// Token is a position in the history of the writes to the primary, such as a commit or log sequence number. Later
+// writes have greater tokens.
+type Token uint64
+
+// TokenProvider is a primary database provider that reports the token of its latest write.
+type TokenProvider interface {
+	sql.DatabaseProvider
+
+	// CurrentToken returns the token of the latest write to the provider.
+	CurrentToken(ctx *sql.Context) (Token, error)
+}
+
+// TokenReplica is a replica database provider that reports the token of the latest write of the primary that it
+// applied.
+type TokenReplica interface {
+	sql.DatabaseProvider
+
+	// AppliedToken returns the token of the latest write of the primary that the replica applied.
+	AppliedToken(ctx *sql.Context) (Token, error)
+}
+
+// Provider is a database provider that routes each statement to its primary or one of its replicas. The statements
+// that only read tables outside of explicit transactions are spread over the replicas in turn, and the others go to
+// the primary. Once a session writes, its reads go to the primary until a replica has applied the write, as reported
+// by the tokens of the providers when they implement TokenProvider and TokenReplica, or until the pin duration has
+// passed otherwise.
+type Provider struct {
+	primary     sql.DatabaseProvider
+	replicas    []sql.DatabaseProvider
+	pinDuration time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	next     int
+	sessions map[uint32]*session
+}
+
+var _ sql.MutableDatabaseProvider = (*Provider)(nil)
+var _ sql.CollatedDatabaseProvider = (*Provider)(nil)
+var _ sql.SessionDatabaseProvider = (*Provider)(nil)
+var _ sql.FunctionProvider = (*Provider)(nil)
+var _ sql.TableFunctionProvider = (*Provider)(nil)
+var _ sql.ExternalStoredProcedureProvider = (*Provider)(nil)
+
+// session is the routing state of a session.
+type session struct {
+	// statement is the ID of the last statement routed, and target the provider it was routed to
+	statement uint64
+	target    sql.DatabaseProvider
+	// written is set when the session wrote to the primary and hasn't been pinned since
+	written bool
+	// the session is pinned to the primary until a replica applied |token|, if |hasToken|, or until |until|
+	pinned   bool
+	token    Token
+	hasToken bool
+	until    time.Time
+}
+
+// NewProvider returns a provider that routes the statements to |primary| and |replicas|.
+func NewProvider(primary sql.DatabaseProvider, replicas ...sql.DatabaseProvider) *Provider {
+	return &Provider{
+		primary:     primary,
+		replicas:    replicas,
+		pinDuration: DefaultPinDuration,
+		now:         time.Now,
+		sessions:    make(map[uint32]*session),
+	}
+}
+
+// WithPinDuration sets how long a session that wrote reads from the primary, unless a replica reports that it applied
+// the write before.
+func (p *Provider) WithPinDuration(d time.Duration) *Provider {
+	p.pinDuration = d
+	return p
+}
+
+// Primary returns the primary provider.
+func (p *Provider) Primary() sql.DatabaseProvider {
+	return p.primary
+}
+
+// Replicas returns the replica providers.
+func (p *Provider) Replicas() []sql.DatabaseProvider {
+	return p.replicas
+}
+
+// Database implements the interface sql.DatabaseProvider.
+func (p *Provider) Database(ctx *sql.Context, name string) (sql.Database, error) {
+	return p.target(ctx).Database(ctx, name)
+}
+
+// HasDatabase implements the interface sql.DatabaseProvider.
+func (p *Provider) HasDatabase(ctx *sql.Context, name string) bool {
+	return p.target(ctx).HasDatabase(ctx, name)
+}
+
+// AllDatabases implements the interface sql.DatabaseProvider.
+func (p *Provider) AllDatabases(ctx *sql.Context) []sql.Database {
+	return p.target(ctx).AllDatabases(ctx)
+}
+
+// CreateDatabase implements the interface sql.MutableDatabaseProvider.
+func (p *Provider) CreateDatabase(ctx *sql.Context, name string) error {
+	mut, ok := p.primary.(sql.MutableDatabaseProvider)
+	if !ok {
+		return sql.ErrImmutableDatabaseProvider.New()
+	}
+	p.markWritten(ctx)
+	return mut.CreateDatabase(ctx, name)
+}
+
+// CreateCollatedDatabase implements the interface sql.CollatedDatabaseProvider.
+func (p *Provider) CreateCollatedDatabase(ctx *sql.Context, name string, collation sql.CollationID) error {
+	collated, ok := p.primary.(sql.CollatedDatabaseProvider)
+	if !ok {
+		if err := p.CreateDatabase(ctx, name); err != nil {
+			return err
+		}
+		// the collation is set on the database created if it supports collations, as the catalog does
+		if db, err := p.primary.Database(ctx, name); err == nil {
+			if collatedDb, ok := db.(sql.CollatedDatabase); ok {
+				return collatedDb.SetCollation(ctx, collation)
+			}
+		}
+		return nil
+	}
+	p.markWritten(ctx)
+	return collated.CreateCollatedDatabase(ctx, name, collation)
+}
+
+// DropDatabase implements the interface sql.MutableDatabaseProvider.
+func (p *Provider) DropDatabase(ctx *sql.Context, name string) error {
+	mut, ok := p.primary.(sql.MutableDatabaseProvider)
+	if !ok {
+		return sql.ErrImmutableDatabaseProvider.New()
+	}
+	p.markWritten(ctx)
+	return mut.DropDatabase(ctx, name)
+}
+
+// Function implements the interface sql.FunctionProvider.
+func (p *Provider) Function(ctx *sql.Context, name string) (sql.Function, error) {
+	if fp, ok := p.primary.(sql.FunctionProvider); ok {
+		return fp.Function(ctx, name)
+	}
+	return nil, sql.ErrFunctionNotFound.New(name)
+}
+
+// TableFunction implements the interface sql.TableFunctionProvider.
+func (p *Provider) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, error) {
+	if fp, ok := p.primary.(sql.TableFunctionProvider); ok {
+		return fp.TableFunction(ctx, name)
+	}
+	return nil, sql.ErrTableFunctionNotFound.New(name)
+}
+
+// ExternalStoredProcedure implements the interface sql.ExternalStoredProcedureProvider.
+func (p *Provider) ExternalStoredProcedure(ctx *sql.Context, name string, numOfParams int) (*sql.ExternalStoredProcedureDetails, error) {
+	if espp, ok := p.primary.(sql.ExternalStoredProcedureProvider); ok {
+		return espp.ExternalStoredProcedure(ctx, name, numOfParams)
+	}
+	return nil, nil
+}
+
+// ExternalStoredProcedures implements the interface sql.ExternalStoredProcedureProvider.
+func (p *Provider) ExternalStoredProcedures(ctx *sql.Context, name string) ([]sql.ExternalStoredProcedureDetails, error) {
+	if espp, ok := p.primary.(sql.ExternalStoredProcedureProvider); ok {
+		return espp.ExternalStoredProcedures(ctx, name)
+	}
+	return nil, nil
+}
+
+// CloseSession implements the interface sql.SessionDatabaseProvider.
+func (p *Provider) CloseSession(id uint32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.sessions, id)
+}
+
+// target returns the provider that the statement of the context given is routed to. All the calls made for a
+// statement are routed to the same provider, chosen the first time.
+func (p *Provider) target(ctx *sql.Context) sql.DatabaseProvider {
+	// only the statements run by the engine are routed to the replicas
+	if ctx == nil || ctx.Session == nil || ctx.StatementID() == 0 || len(p.replicas) == 0 {
+		return p.primary
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.session(ctx)
+	if s.statement == ctx.StatementID() {
+		return s.target
+	}
+	s.statement = ctx.StatementID()
+	s.target = p.route(ctx, s)
+	return s.target
+}
+
+// route returns the provider for a new statement of the session |s|.
+func (p *Provider) route(ctx *sql.Context, s *session) sql.DatabaseProvider {
+	if !ctx.IsReadOnlyStatement() {
+		s.written = true
+		return p.primary
+	}
+	if inTransaction(ctx) {
+		return p.primary
+	}
+
+	// the writes are pinned once they're committed, which is by the end of the transaction that made them
+	if s.written {
+		s.written = false
+		s.pinned = true
+		s.until = p.now().Add(p.pinDuration)
+		s.hasToken = false
+		if tp, ok := p.primary.(TokenProvider); ok {
+			if token, err := tp.CurrentToken(ctx); err == nil {
+				s.token, s.hasToken = token, true
+			} else {
+				ctx.GetLogger().Warnf("routing: unable to get the token of the primary: %s", err)
+			}
+		}
+	}
+	if s.pinned && !p.now().Before(s.until) {
+		s.pinned = false
+	}
+
+	for i := range p.replicas {
+		replica := p.replicas[(p.next+i)%len(p.replicas)]
+		if s.pinned && !p.caughtUp(ctx, replica, s) {
+			continue
+		}
+		p.next = (p.next + i + 1) % len(p.replicas)
+		return replica
+	}
+	return p.primary
+}
+
+// caughtUp returns whether |replica| applied the writes that the session |s| is pinned to the primary for.
+func (p *Provider) caughtUp(ctx *sql.Context, replica sql.DatabaseProvider, s *session) bool {
+	tr, ok := replica.(TokenReplica)
+	if !ok || !s.hasToken {
+		return false
+	}
+	applied, err := tr.AppliedToken(ctx)
+	if err != nil {
+		ctx.GetLogger().Warnf("routing: unable to get the token of a replica: %s", err)
+		return false
+	}
+	return applied >= s.token
+}
+
+// markWritten records that the statement of the context given writes to the primary.
+func (p *Provider) markWritten(ctx *sql.Context) {
+	if ctx == nil || ctx.Session == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.session(ctx).written = true
+}
+
+// session returns the routing state of the session of the context given, which must be called with the lock held.
+func (p *Provider) session(ctx *sql.Context) *session {
+	s, ok := p.sessions[ctx.Session.ID()]
+	if !ok {
+		s = &session{}
+		p.sessions[ctx.Session.ID()] = s
+	}
+	return s
+}
+
+// inTransaction returns whether the session of the context given is in an explicit transaction, whose statements all
+// go to the primary.
+func inTransaction(ctx *sql.Context) bool {
+	if ctx.GetIgnoreAutoCommit() {
+		return true
+	}
+	autocommit, err := plan.IsSessionAutocommit(ctx)
+	return err != nil || !autocommit
+}
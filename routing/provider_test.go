@@ -0,0 +1,196 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// tokenProvider is a memory provider with a settable token, used as a primary or as a replica.
+type tokenProvider struct {
+	*memory.DbProvider
+	token Token
+}
+
+var _ TokenProvider = (*tokenProvider)(nil)
+var _ TokenReplica = (*tokenProvider)(nil)
+
+func (p *tokenProvider) CurrentToken(*sql.Context) (Token, error) {
+	return p.token, nil
+}
+
+func (p *tokenProvider) AppliedToken(*sql.Context) (Token, error) {
+	return p.token, nil
+}
+
+// newTestProvider returns a memory provider with a database mydb whose table t has a row naming the provider.
+func newTestProvider(t *testing.T, name string) *tokenProvider {
+	pro := &tokenProvider{DbProvider: memory.NewDBProvider(memory.NewDatabase("mydb")).(*memory.DbProvider)}
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+	for _, q := range []string{
+		"CREATE TABLE t (i int PRIMARY KEY, src varchar(20))",
+		"INSERT INTO t VALUES (1, '" + name + "')",
+	} {
+		query(t, e, newSession(1), q)
+	}
+	return pro
+}
+
+func newSession(id uint32) sql.Session {
+	return sql.NewBaseSessionWithClientServer("", sql.Client{}, id)
+}
+
+func query(t *testing.T, e *sqle.Engine, sess sql.Session, q string) []sql.Row {
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess)).WithQuery(q)
+	ctx.SetCurrentDatabase("mydb")
+	sch, iter, err := e.Query(ctx, q)
+	require.NoError(t, err, "error running query %s", q)
+	rows, err := sql.RowIterToRows(ctx, sch, iter)
+	require.NoError(t, err, "error running query %s", q)
+	return rows
+}
+
+func TestRouting(t *testing.T) {
+	primary := newTestProvider(t, "primary")
+	replica1 := newTestProvider(t, "replica1")
+	replica2 := newTestProvider(t, "replica2")
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	pro := NewProvider(primary, replica1, replica2).WithPinDuration(10 * time.Second)
+	pro.now = func() time.Time {
+		return now
+	}
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+
+	s1, s2 := newSession(1), newSession(2)
+	src := func(session sql.Session) string {
+		rows := query(t, e, session, "SELECT src FROM t WHERE i = 1")
+		return rows[0][0].(string)
+	}
+
+	// reads are spread over the replicas
+	require.Equal(t, "replica1", src(s1))
+	require.Equal(t, "replica2", src(s1))
+	require.Equal(t, "replica1", src(s2))
+	require.Equal(t, "replica2", src(s1))
+	// a statement reading several tables reads them all from the same provider
+	require.Equal(t, []sql.Row{{"replica1", "replica1"}},
+		query(t, e, s1, "SELECT a.src, b.src FROM t a, t b WHERE a.i = 1 AND b.i = 1"))
+
+	// writes go to the primary, and pin the session to it until the replicas applied them, or until the pin duration
+	// has passed
+	primary.token = 1
+	query(t, e, s1, "INSERT INTO t VALUES (2, 'write')")
+	require.Equal(t, []sql.Row{{int64(2)}}, query(t, e, s1, "SELECT count(*) FROM t"))
+	require.Equal(t, "primary", src(s1))
+	require.Equal(t, "replica2", src(s2))
+	now = now.Add(10 * time.Second)
+	require.Equal(t, "replica1", src(s1))
+
+	// replicas reporting that they applied the writes of the session are read from right away
+	primary.token = 5
+	replica1.token = 4
+	replica2.token = 5
+	query(t, e, s1, "UPDATE t SET src = 'update' WHERE i = 2")
+	require.Equal(t, "replica2", src(s1))
+	require.Equal(t, "replica2", src(s1))
+	replica1.token = 5
+	require.Equal(t, "replica1", src(s1))
+
+	// the statements of explicit transactions go to the primary
+	query(t, e, s1, "SET autocommit = 0")
+	require.Equal(t, "primary", src(s1))
+	query(t, e, s1, "SET autocommit = 1")
+	require.Equal(t, "replica2", src(s1))
+
+	// databases are created on the primary
+	query(t, e, s1, "CREATE DATABASE otherdb")
+	require.True(t, primary.HasDatabase(sql.NewEmptyContext(), "otherdb"))
+	require.False(t, replica1.HasDatabase(sql.NewEmptyContext(), "otherdb"))
+
+	e.CloseSession(1)
+	require.NotContains(t, pro.sessions, uint32(1))
+	require.Contains(t, pro.sessions, uint32(2))
+}
+
+// TestRoutingWrites tests that the statements writing anything are routed to the primary, whether they write rows,
+// schemas or sequences, or lock the rows they read.
+func TestRoutingWrites(t *testing.T) {
+	tests := []struct {
+		setup []string
+		query string
+	}{
+		{query: "ALTER TABLE t AUTO_INCREMENT = 10"},
+		{query: "ALTER TABLE t ALTER COLUMN src SET DEFAULT 'default'"},
+		{setup: []string{"ALTER TABLE t ALTER COLUMN src SET DEFAULT 'default'"}, query: "ALTER TABLE t ALTER COLUMN src DROP DEFAULT"},
+		{query: "ALTER TABLE t COMMENT 'commented'"},
+		{query: "CREATE SEQUENCE seq"},
+		{setup: []string{"CREATE SEQUENCE seq"}, query: "ALTER SEQUENCE seq RESTART WITH 5"},
+		{setup: []string{"CREATE SEQUENCE seq"}, query: "DROP SEQUENCE seq"},
+		{setup: []string{"CREATE SEQUENCE seq"}, query: "SELECT NEXTVAL(seq)"},
+		{setup: []string{"CREATE SEQUENCE seq"}, query: "SELECT NEXT VALUE FOR seq"},
+		{query: "SELECT src FROM t WHERE i = 1 FOR UPDATE"},
+		{query: "SELECT src FROM t WHERE i = 1 LOCK IN SHARE MODE"},
+		{query: "SELECT src FROM t WHERE i IN (SELECT i FROM t FOR UPDATE)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			primary := newTestProvider(t, "primary")
+			replica := newTestProvider(t, "replica")
+
+			now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+			pro := NewProvider(primary, replica).WithPinDuration(10 * time.Second)
+			pro.now = func() time.Time {
+				return now
+			}
+			e := sqle.NewDefault(pro)
+			defer e.Close()
+			e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+			e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+			s := sql.NewBaseSessionWithClientServer("", sql.Client{User: "root", Address: "localhost"}, 1)
+			src := func() string {
+				return query(t, e, s, "SELECT src FROM t WHERE i = 1")[0][0].(string)
+			}
+			for _, q := range tt.setup {
+				query(t, e, s, q)
+			}
+			now = now.Add(time.Minute)
+			require.Equal(t, "replica", src())
+
+			// the replica doesn't have the sequences created on the primary, so statements using them only succeed on it
+			primary.token = 1
+			rows := query(t, e, s, tt.query)
+			if len(rows) > 0 {
+				if src, ok := rows[0][0].(string); ok {
+					require.Equal(t, "primary", src)
+				}
+			}
+			require.Equal(t, "primary", src(), "the statement didn't pin the session to the primary")
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"net"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/netutil"
+)
+
+// maxHandshakeResponseLength is the length of the longest handshake response whose connection attributes are read.
+const maxHandshakeResponseLength = 1 << 16
+
+// ConnectionAttributes returns the connection attributes the client of the connection given sent in its handshake
+// response, or nil if it sent none. The attributes are only known for connections accepted by a Listener, and not
+// for the ones using TLS, whose handshake response is encrypted.
+func ConnectionAttributes(c *mysql.Conn) map[string]string {
+	if hc := getHandshakeConn(c); hc != nil {
+		return hc.attributes()
+	}
+	return nil
+}
+
+// isInteractive returns whether the client of the connection given is interactive, which vitess doesn't keep track
+// of. Only known for connections accepted by a Listener.
+func isInteractive(c *mysql.Conn) bool {
+	if hc := getHandshakeConn(c); hc != nil {
+		return hc.capabilities()&capabilityClientInteractive != 0
+	}
+	return false
+}
+
+// handlesExpiredPasswords returns whether the client of the connection given can handle expired passwords, which
+// vitess doesn't keep track of. Only known for connections accepted by a Listener.
+func handlesExpiredPasswords(c *mysql.Conn) bool {
+	if hc := getHandshakeConn(c); hc != nil {
+		return hc.capabilities()&capabilityClientCanHandleExpiredPasswords != 0
+	}
+	return false
+}
+
+const (
+	// capabilityClientInteractive is CLIENT_INTERACTIVE, which is missing from vitess.
+	capabilityClientInteractive = 1 << 10
+	// capabilityClientCanHandleExpiredPasswords is CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS, which is missing from vitess.
+	capabilityClientCanHandleExpiredPasswords = 1 << 22
+)
+
+// getHandshakeConn returns the *handshakeConn the connection given reads from, or nil if it has none.
+func getHandshakeConn(c *mysql.Conn) *handshakeConn {
+	conn := c.Conn
+	for {
+		switch wrapped := conn.(type) {
+		case *handshakeConn:
+			return wrapped
+		case netutil.ConnWithTimeouts:
+			conn = wrapped.Conn
+		case *tls.Conn:
+			conn = wrapped.NetConn()
+		default:
+			return nil
+		}
+	}
+}
+
+// handshakeConn is a connection accepted by a Listener, which reads the capability flags and the connection
+// attributes from the handshake response of the client as it's read by the server.
+type handshakeConn struct {
+	net.Conn
+	mu    sync.Mutex
+	done  bool
+	buf   []byte
+	flags uint32
+	attrs map[string]string
+}
+
+// Read implements the net.Conn interface.
+func (c *handshakeConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.record(b[:n])
+	}
+	return n, err
+}
+
+// record adds the data read from the client to the handshake response, and reads the capability flags and the
+// connection attributes once the whole packet is read. A request to switch to TLS has the same capability flags as
+// the encrypted handshake response that follows it.
+func (c *handshakeConn) record(data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done {
+		return
+	}
+	c.buf = append(c.buf, data...)
+	if len(c.buf) < 4 {
+		return
+	}
+	length := int(uint32(c.buf[0]) | uint32(c.buf[1])<<8 | uint32(c.buf[2])<<16)
+	if length > maxHandshakeResponseLength {
+		c.done, c.buf = true, nil
+		return
+	}
+	if len(c.buf) < 4+length {
+		return
+	}
+	payload := c.buf[4 : 4+length]
+	if len(payload) >= 4 {
+		c.flags = binary.LittleEndian.Uint32(payload)
+	}
+	c.attrs = parseConnectionAttributes(payload)
+	c.done, c.buf = true, nil
+}
+
+func (c *handshakeConn) attributes() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.attrs
+}
+
+func (c *handshakeConn) capabilities() uint32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.flags
+}
+
+// parseConnectionAttributes returns the connection attributes in the handshake response given, or nil if it has
+// none or can't be parsed.
+func parseConnectionAttributes(data []byte) map[string]string {
+	r := &packetReader{data: data}
+	flags, ok := r.uint32()
+	if !ok || flags&mysql.CapabilityClientProtocol41 == 0 || flags&mysql.CapabilityClientConnAttr == 0 {
+		return nil
+	}
+	// the max packet size, character set and filler
+	if !r.skip(4 + 1 + 23) {
+		return nil
+	}
+	// a request to switch to TLS ends here, and is followed by the encrypted handshake response
+	if _, ok = r.nulString(); !ok {
+		return nil
+	}
+
+	switch {
+	case flags&mysql.CapabilityClientPluginAuthLenencClientData != 0:
+		_, ok = r.lenEncString()
+	case flags&mysql.CapabilityClientSecureConnection != 0:
+		var n byte
+		if n, ok = r.byte(); ok {
+			ok = r.skip(int(n))
+		}
+	default:
+		_, ok = r.nulString()
+	}
+	if !ok {
+		return nil
+	}
+	if flags&mysql.CapabilityClientConnectWithDB != 0 {
+		if _, ok = r.nulString(); !ok {
+			return nil
+		}
+	}
+	if flags&mysql.CapabilityClientPluginAuth != 0 {
+		if _, ok = r.nulString(); !ok {
+			return nil
+		}
+	}
+
+	length, ok := r.lenEncInt()
+	if !ok || length > uint64(len(r.data)-r.pos) {
+		return nil
+	}
+	attrsReader := &packetReader{data: r.data[r.pos : r.pos+int(length)]}
+	attrs := make(map[string]string)
+	for attrsReader.pos < len(attrsReader.data) {
+		name, ok := attrsReader.lenEncString()
+		if !ok {
+			return nil
+		}
+		value, ok := attrsReader.lenEncString()
+		if !ok {
+			return nil
+		}
+		attrs[name] = value
+	}
+	return attrs
+}
+
+// packetReader reads the fields of a packet of the MySQL protocol.
+type packetReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *packetReader) skip(n int) bool {
+	if r.pos+n > len(r.data) {
+		return false
+	}
+	r.pos += n
+	return true
+}
+
+func (r *packetReader) byte() (byte, bool) {
+	if r.pos >= len(r.data) {
+		return 0, false
+	}
+	r.pos++
+	return r.data[r.pos-1], true
+}
+
+func (r *packetReader) uint32() (uint32, bool) {
+	if r.pos+4 > len(r.data) {
+		return 0, false
+	}
+	r.pos += 4
+	return binary.LittleEndian.Uint32(r.data[r.pos-4:]), true
+}
+
+func (r *packetReader) nulString() (string, bool) {
+	end := bytes.IndexByte(r.data[r.pos:], 0)
+	if end < 0 {
+		return "", false
+	}
+	s := string(r.data[r.pos : r.pos+end])
+	r.pos += end + 1
+	return s, true
+}
+
+func (r *packetReader) lenEncInt() (uint64, bool) {
+	first, ok := r.byte()
+	if !ok {
+		return 0, false
+	}
+	var size int
+	switch first {
+	case 0xfc:
+		size = 2
+	case 0xfd:
+		size = 3
+	case 0xfe:
+		size = 8
+	case 0xfb, 0xff:
+		return 0, false
+	default:
+		return uint64(first), true
+	}
+	if r.pos+size > len(r.data) {
+		return 0, false
+	}
+	var v uint64
+	for i := 0; i < size; i++ {
+		v |= uint64(r.data[r.pos+i]) << (8 * i)
+	}
+	r.pos += size
+	return v, true
+}
+
+func (r *packetReader) lenEncString() (string, bool) {
+	n, ok := r.lenEncInt()
+	if !ok || n > uint64(len(r.data)-r.pos) {
+		return "", false
+	}
+	s := string(r.data[r.pos : r.pos+int(n)])
+	r.pos += int(n)
+	return s, true
+}
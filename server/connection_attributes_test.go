@@ -0,0 +1,105 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// handshakeResponse returns a handshake response packet of the client capabilities given, with the attributes given.
+func handshakeResponse(flags uint32, attrs [][2]string) []byte {
+	var payload []byte
+	payload = binary.LittleEndian.AppendUint32(payload, flags)
+	payload = append(payload, make([]byte, 4+1+23)...)
+	payload = append(payload, "root\x00"...)
+	payload = append(payload, 3, 'a', 'b', 'c')
+	payload = append(payload, "mydb\x00"...)
+	payload = append(payload, "mysql_native_password\x00"...)
+	var block []byte
+	for _, attr := range attrs {
+		block = append(block, byte(len(attr[0])))
+		block = append(block, attr[0]...)
+		block = append(block, byte(len(attr[1])))
+		block = append(block, attr[1]...)
+	}
+	payload = append(payload, byte(len(block)))
+	payload = append(payload, block...)
+
+	packet := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), 1}
+	return append(packet, payload...)
+}
+
+func TestConnectionAttributes(t *testing.T) {
+	var flags uint32 = mysql.CapabilityClientProtocol41 | mysql.CapabilityClientSecureConnection |
+		mysql.CapabilityClientConnectWithDB | mysql.CapabilityClientPluginAuth | mysql.CapabilityClientConnAttr
+
+	t.Run("attributes", func(t *testing.T) {
+		packet := handshakeResponse(flags, [][2]string{{"_client_name", "libmysql"}, {"program_name", "mysql"}})
+		attrs := readHandshake(t, packet, 7)
+		require.Equal(t, map[string]string{"_client_name": "libmysql", "program_name": "mysql"}, attrs)
+	})
+
+	t.Run("no attributes capability", func(t *testing.T) {
+		packet := handshakeResponse(flags&^mysql.CapabilityClientConnAttr, nil)
+		require.Nil(t, readHandshake(t, packet, len(packet)))
+	})
+
+	t.Run("ssl request", func(t *testing.T) {
+		var payload []byte
+		payload = binary.LittleEndian.AppendUint32(payload, flags|mysql.CapabilityClientSSL)
+		payload = append(payload, make([]byte, 4+1+23)...)
+		packet := append([]byte{byte(len(payload)), 0, 0, 1}, payload...)
+		require.Nil(t, readHandshake(t, packet, len(packet)))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		packet := handshakeResponse(flags, [][2]string{{"_client_name", "libmysql"}})
+		packet[0] -= 4
+		require.Nil(t, readHandshake(t, packet[:len(packet)-4], len(packet)))
+	})
+}
+
+// readHandshake writes the packet given to a handshakeConn in chunks of |chunk| bytes, reads it back and returns
+// the connection attributes read.
+func readHandshake(t *testing.T, packet []byte, chunk int) map[string]string {
+	server, client := net.Pipe()
+	defer client.Close()
+	conn := &handshakeConn{Conn: server}
+	defer conn.Close()
+
+	go func() {
+		for data := packet; len(data) > 0; {
+			n := chunk
+			if n > len(data) {
+				n = len(data)
+			}
+			if _, err := client.Write(data[:n]); err != nil {
+				return
+			}
+			data = data[n:]
+		}
+	}()
+
+	buf := make([]byte, len(packet))
+	_, err := io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	return conn.attributes()
+}
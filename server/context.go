@@ -43,7 +43,7 @@ func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql
 		host = mysqlConnectionUser.Host
 		user = mysqlConnectionUser.User
 	}
-	client := sql.Client{Address: host, User: user, Capabilities: c.Capabilities}
+	client := sql.Client{Address: host, User: user, Capabilities: c.Capabilities, Attributes: ConnectionAttributes(c)}
 	return sql.NewBaseSessionWithClientServer(addr, client, c.ConnectionID), nil
 }
 
@@ -60,7 +60,17 @@ type SessionManager struct {
 	builder     SessionBuilder
 	sessions    map[uint32]sql.Session
 	connections map[uint32]*mysql.Conn
+	idleTimers  map[uint32]*idleTimer
 	lastPid     uint64
+	// maxConnections is the maximum number of sessions, or 0 if there's no limit.
+	maxConnections uint64
+}
+
+// idleTimer closes a connection once it has been idle for longer than the wait_timeout of its session.
+type idleTimer struct {
+	timer *time.Timer
+	// deadline is when the connection is closed, or zero while it runs a command.
+	deadline time.Time
 }
 
 // NewSessionManager creates a SessionManager with the given SessionBuilder.
@@ -82,6 +92,7 @@ func NewSessionManager(
 		builder:     builder,
 		sessions:    make(map[uint32]sql.Session),
 		connections: make(map[uint32]*mysql.Conn),
+		idleTimers:  make(map[uint32]*idleTimer),
 	}
 }
 
@@ -113,10 +124,55 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 	if err != nil {
 		return err
 	}
+	if err = s.checkConnectionLimits(session); err != nil {
+		return err
+	}
+	if err = s.initSession(ctx, conn, session); err != nil {
+		return err
+	}
+	s.sessions[conn.ConnectionID] = session
+	return nil
+}
 
-	session.SetConnectionId(conn.ConnectionID)
+// ResetSession replaces the session of the given connection with a new one, in the state of the session of a new
+// connection of the same user to its current database, as COM_RESET_CONNECTION does. Whatever the old session held,
+// such as its session and user variables and the temporary tables of integrators, is discarded with it.
+func (s *SessionManager) ResetSession(ctx context.Context, conn *mysql.Conn) error {
+	old := s.session(conn)
+	if old == nil {
+		return nil
+	}
 
+	s.mu.Lock()
+	session, err := s.builder(ctx, conn, s.addr)
+	if err == nil {
+		err = s.initSession(ctx, conn, session)
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
 	s.sessions[conn.ConnectionID] = session
+	s.mu.Unlock()
+
+	return s.SetDB(conn, old.GetCurrentDatabase())
+}
+
+// initSession sets up a session created by the session builder for the given connection.
+func (s *SessionManager) initSession(ctx context.Context, conn *mysql.Conn, session sql.Session) error {
+	session.SetConnectionId(conn.ConnectionID)
+
+	// like MySQL, the wait_timeout of interactive clients is their interactive_timeout
+	if isInteractive(conn) {
+		sqlCtx := sql.NewContext(ctx, sql.WithSession(session))
+		timeout, err := session.GetSessionVariable(sqlCtx, "interactive_timeout")
+		if err != nil {
+			return err
+		}
+		if err = session.SetSessionVariable(sqlCtx, "wait_timeout", timeout); err != nil {
+			return err
+		}
+	}
 
 	logger := session.GetLogger()
 	if logger == nil {
@@ -128,8 +184,34 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 		logger.WithField(sql.ConnectionIdLogField, conn.ConnectionID).
 			WithField(sql.ConnectTimeLogKey, time.Now()),
 	)
+	return nil
+}
 
-	return err
+// checkConnectionLimits returns an error if adding the session given would exceed the maximum number of connections
+// of the server, or the max_user_connections of the account of the session. The caller must hold the lock.
+func (s *SessionManager) checkConnectionLimits(session sql.Session) error {
+	if s.maxConnections > 0 && uint64(len(s.sessions)) >= s.maxConnections {
+		sql.StatusVariables.IncrementGlobal("Connection_errors_max_connections", 1)
+		return mysql.NewSQLError(mysql.ERConCount, "08004", "Too many connections")
+	}
+
+	_, val, ok := sql.SystemVariables.GetGlobal("max_user_connections")
+	maxUserConnections, _ := val.(int64)
+	if !ok || maxUserConnections <= 0 {
+		return nil
+	}
+	client := session.Client()
+	var userConnections int64
+	for _, other := range s.sessions {
+		if otherClient := other.Client(); otherClient.User == client.User && otherClient.Address == client.Address {
+			userConnections++
+		}
+	}
+	if userConnections >= maxUserConnections {
+		return mysql.NewSQLError(mysql.ERTooManyUserConnections, mysql.SSClientError,
+			"User %s already has more than 'max_user_connections' active connections", client.User)
+	}
+	return nil
 }
 
 func (s *SessionManager) SetDB(conn *mysql.Conn, dbName string) error {
@@ -263,7 +345,71 @@ func (s *SessionManager) KillConnection(connID uint32) error {
 func (s *SessionManager) RemoveConn(conn *mysql.Conn) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if t, ok := s.idleTimers[conn.ConnectionID]; ok {
+		t.timer.Stop()
+		delete(s.idleTimers, conn.ConnectionID)
+	}
 	delete(s.sessions, conn.ConnectionID)
 	delete(s.connections, conn.ConnectionID)
 	s.processlist.RemoveConnection(conn.ConnectionID)
 }
+
+// stopIdleTimer stops the idle timer of |conn| while it runs a command.
+func (s *SessionManager) stopIdleTimer(conn *mysql.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.idleTimers[conn.ConnectionID]; ok {
+		t.timer.Stop()
+		t.deadline = time.Time{}
+	}
+}
+
+// startIdleTimer starts the idle timer of |conn| once it's done running a command, which closes the connection if
+// it doesn't run another one within the wait_timeout of its session.
+func (s *SessionManager) startIdleTimer(conn *mysql.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[conn.ConnectionID]
+	if !ok {
+		return
+	}
+	if _, ok = s.connections[conn.ConnectionID]; !ok {
+		return
+	}
+
+	val, err := sess.GetSessionVariable(sql.NewContext(context.Background(), sql.WithSession(sess)), "wait_timeout")
+	if err != nil {
+		return
+	}
+	seconds, ok := val.(int64)
+	if !ok || seconds <= 0 {
+		return
+	}
+	timeout := time.Duration(seconds) * time.Second
+
+	t, ok := s.idleTimers[conn.ConnectionID]
+	if !ok {
+		t = &idleTimer{}
+		t.timer = time.AfterFunc(timeout, func() {
+			s.closeIdleConnection(conn)
+		})
+		s.idleTimers[conn.ConnectionID] = t
+	} else {
+		t.timer.Reset(timeout)
+	}
+	t.deadline = time.Now().Add(timeout)
+}
+
+// closeIdleConnection closes |conn| if it's still idle past its deadline when its idle timer fires. The rest of the
+// teardown happens as for a connection closed by the client.
+func (s *SessionManager) closeIdleConnection(conn *mysql.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.idleTimers[conn.ConnectionID]
+	if !ok || t.deadline.IsZero() || time.Now().Before(t.deadline) {
+		return
+	}
+	logrus.WithField(sql.ConnectionIdLogField, conn.ConnectionID).Infof("Closing connection idle for longer than wait_timeout")
+	sql.StatusVariables.IncrementGlobal("Aborted_clients", 1)
+	conn.Close()
+}
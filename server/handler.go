@@ -38,6 +38,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -84,22 +85,82 @@ func (h *Handler) NewConnection(c *mysql.Conn) {
 	}
 
 	h.sm.AddConn(c)
+	sql.StatusVariables.IncrementGlobal("Connections", 1)
+	sql.StatusVariables.IncrementGlobal("Threads_connected", 1)
 
 	c.DisableClientMultiStatements = h.disableMultiStmts
 	logrus.WithField(sql.ConnectionIdLogField, c.ConnectionID).WithField("DisableClientMultiStatements", c.DisableClientMultiStatements).Infof("NewConnection")
 }
 
 func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
+	h.sm.stopIdleTimer(c)
+	defer h.sm.startIdleTimer(c)
+	// the database is first set once the client is authenticated, before the session is created
+	if h.sm.session(c) == nil {
+		if err := h.verifyTLSRequirements(c); err != nil {
+			return err
+		}
+		expired, err := h.checkExpiredPassword(c)
+		if err != nil {
+			return err
+		}
+		if err = h.sm.SetDB(c, schemaName); err != nil {
+			return err
+		}
+		if expired {
+			h.restrictExpiredPassword(c)
+		}
+		return nil
+	}
 	return h.sm.SetDB(c, schemaName)
 }
 
+// checkExpiredPassword returns whether the password of the account the client of the connection given authenticated
+// as has expired, or an error if the client must be disconnected because it can't handle expired passwords.
+func (h *Handler) checkExpiredPassword(c *mysql.Conn) (bool, error) {
+	user, ok := c.UserData.(mysql_db.MysqlConnectionUser)
+	if !ok {
+		return false, nil
+	}
+	return h.e.Analyzer.Catalog.MySQLDb.CheckExpiredPassword(user.User, user.Host, handlesExpiredPasswords(c))
+}
+
+// restrictExpiredPassword puts the session of the connection given in the sandbox mode of the clients whose password
+// has expired, which only lets them change their password.
+func (h *Handler) restrictExpiredPassword(c *mysql.Conn) {
+	sess := h.sm.session(c)
+	client := sess.Client()
+	client.PasswordExpired = true
+	sess.SetClient(client)
+}
+
+// verifyTLSRequirements returns an error if the connection given doesn't meet the TLS requirements of the account
+// its client authenticated as, which are set with the REQUIRE clause of CREATE USER.
+func (h *Handler) verifyTLSRequirements(c *mysql.Conn) error {
+	user, ok := c.UserData.(mysql_db.MysqlConnectionUser)
+	if !ok {
+		return nil
+	}
+	return h.e.Analyzer.Catalog.MySQLDb.VerifyTLSRequirements(user.User, user.Host, tlsConnectionState(c))
+}
+
 // ComPrepare parses, partially analyzes, and caches a prepared statement's plan
 // with the given [c.ConnectionID].
 func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error) {
+	h.sm.stopIdleTimer(c)
+	defer h.sm.startIdleTimer(c)
 	ctx, err := h.sm.NewContextWithQuery(c, query)
 	if err != nil {
 		return nil, err
 	}
+	sql.IncrementStatusVariable(ctx, "Com_stmt_prepare", 1)
+	maxPacket, err := maxAllowedPacket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(query)) > maxPacket {
+		return nil, sql.CastSQLError(sql.ErrNetPacketTooLarge.New())
+	}
 
 	var analyzed sql.Node
 	if analyzer.PreparedStmtDisabled {
@@ -118,6 +179,9 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	return schemaToFields(ctx, analyzed.Schema()), nil
 }
 
+// ComStmtExecute executes a prepared statement. When the client opens a cursor for its results, as with
+// CURSOR_TYPE_READ_ONLY, the statement runs until its rows are fetched with COM_STMT_FETCH or the cursor is closed:
+// |callback| then blocks until the client fetches the rows given to it.
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
 	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
 		return callback(res)
@@ -125,8 +189,43 @@ func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, call
 	return err
 }
 
+// ComResetConnection resets the session of a connection to the state of a new connection of the same user to the same
+// database, as connection pools do before reusing a connection: its transaction is rolled back, its locks are
+// released, and its prepared statements, session and user variables and temporary tables are discarded.
+//
+// COM_CHANGE_USER, which also re-authenticates the connection, is not supported: the vitess listener answers it with
+// an unknown command error without passing it to the handler, so connection pools must be configured to reset their
+// connections with COM_RESET_CONNECTION instead.
 func (h *Handler) ComResetConnection(c *mysql.Conn) {
-	// TODO: handle reset logic
+	logrus.WithField(sql.ConnectionIdLogField, c.ConnectionID).Debugf("ComResetConnection")
+	if h.sm.session(c) == nil {
+		return
+	}
+
+	ctx, err := h.sm.NewContextWithQuery(c, "")
+	if err != nil {
+		logrus.Errorf("unable to reset connection: %s", err)
+		return
+	}
+	if tx := ctx.GetTransaction(); tx != nil {
+		if ts, ok := ctx.Session.(sql.TransactionSession); ok {
+			if err = ts.Rollback(ctx, tx); err != nil {
+				logrus.Errorf("unable to roll back transaction on connection reset: %s", err)
+			}
+		}
+		ctx.SetTransaction(nil)
+	}
+	h.releaseLocks(ctx, c, "on connection reset")
+	h.e.CloseSession(c.ConnectionID)
+
+	if err = h.sm.ResetSession(ctx, c); err != nil {
+		logrus.Errorf("unable to reset connection: %s", err)
+		return
+	}
+	// the new session is still restricted if the password hasn't been changed
+	if expired, _ := h.checkExpiredPassword(c); expired {
+		h.restrictExpiredPassword(c)
+	}
 }
 
 // ConnectionClosed reports that a connection has been closed.
@@ -138,24 +237,34 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 	}()
 
 	defer h.sm.RemoveConn(c)
+	defer sql.StatusVariables.IncrementGlobal("Threads_connected", -1)
 	defer h.e.CloseSession(c.ConnectionID)
 
-	if ctx, err := h.sm.NewContextWithQuery(c, ""); err != nil {
-		logrus.Errorf("unable to release all locks on session close: %s", err)
-		logrus.Errorf("unable to unlock tables on session close: %s", err)
-	} else {
-		_, err = h.e.LS.ReleaseAll(ctx)
-		if err != nil {
+	// a connection closed before it was ready, such as one refused for exceeding the connection limits, has no
+	// session, and so no locks
+	if h.sm.session(c) != nil {
+		if ctx, err := h.sm.NewContextWithQuery(c, ""); err != nil {
 			logrus.Errorf("unable to release all locks on session close: %s", err)
-		}
-		if err = h.e.Analyzer.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
 			logrus.Errorf("unable to unlock tables on session close: %s", err)
+		} else {
+			h.releaseLocks(ctx, c, "on session close")
 		}
 	}
 
 	logrus.WithField(sql.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
 }
 
+// releaseLocks releases the named locks and table locks held by the session of a connection that is closed or reset.
+// The reason given, such as "on session close", completes the messages logged for the locks that can't be released.
+func (h *Handler) releaseLocks(ctx *sql.Context, c *mysql.Conn, reason string) {
+	if _, err := h.e.LS.ReleaseAll(ctx); err != nil {
+		logrus.Errorf("unable to release all locks %s: %s", reason, err)
+	}
+	if err := h.e.Analyzer.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
+		logrus.Errorf("unable to unlock tables %s: %s", reason, err)
+	}
+}
+
 func (h *Handler) ComMultiQuery(
 	c *mysql.Conn,
 	query string,
@@ -294,6 +403,14 @@ func (h *Handler) doQuery(
 		return "", err
 	}
 
+	maxPacket, err := maxAllowedPacket(ctx)
+	if err != nil {
+		return "", err
+	}
+	if incomingPacketLength(query, bindings) > maxPacket {
+		return "", sql.ErrNetPacketTooLarge.New()
+	}
+
 	var remainder string
 	var parsed sql.Node
 	ctx.Version = h.e.Version
@@ -303,11 +420,11 @@ func (h *Handler) doQuery(
 		case sql.VersionExperimental:
 			parsed, prequery, remainder, err = planbuilder.ParseOne(ctx, h.e.Analyzer.Catalog, query)
 			if err != nil {
-				parsed, prequery, remainder, _ = parse.ParseOne(ctx, query)
+				parsed, prequery, remainder, _ = parse.ParseOneWithOptions(ctx, query, h.e.ParserOptions())
 				ctx.Version = sql.VersionStable
 			}
 		default:
-			parsed, prequery, remainder, _ = parse.ParseOne(ctx, query)
+			parsed, prequery, remainder, _ = parse.ParseOneWithOptions(ctx, query, h.e.ParserOptions())
 		}
 		if prequery != "" {
 			query = prequery
@@ -337,17 +454,26 @@ func (h *Handler) doQuery(
 
 	start := time.Now()
 
+	// the engine runs the statement with the text it was parsed from, so the rewritten text is parsed here
+	rewritten, err := h.e.RewriteQuery(ctx, query)
+	if err != nil {
+		return remainder, err
+	}
+	if rewritten != query {
+		query, parsed = rewritten, nil
+	}
+
 	if parsed == nil {
 		switch ctx.Version {
 		case sql.VersionExperimental:
 			parsed, err = planbuilder.Parse(ctx, h.e.Analyzer.Catalog, query)
 			if err != nil {
 				ctx.GetLogger().Tracef("experimental planbuilder failed: %s", err)
-				parsed, err = parse.Parse(ctx, query)
+				parsed, err = parse.ParseWithOptions(ctx, query, h.e.ParserOptions())
 				ctx.Version = sql.VersionStable
 			}
 		default:
-			parsed, err = parse.Parse(ctx, query)
+			parsed, err = parse.ParseWithOptions(ctx, query, h.e.ParserOptions())
 		}
 	}
 	if err != nil {
@@ -393,23 +519,26 @@ func (h *Handler) doQuery(
 	eg.Go(func() error {
 		defer wg.Done()
 		defer close(rowChan)
+		batch := make([]sql.Row, sql.RowBatchSize)
 		for {
 			select {
 			case <-ctx.Done():
 				return nil
 			default:
-				row, err := rowIter.Next(ctx)
+				n, err := sql.NextRowBatch(ctx, rowIter, batch)
+				for _, row := range batch[:n] {
+					select {
+					case rowChan <- row:
+					case <-ctx.Done():
+						return nil
+					}
+				}
 				if err == io.EOF {
 					return nil
 				}
 				if err != nil {
 					return err
 				}
-				select {
-				case rowChan <- row:
-				case <-ctx.Done():
-					return nil
-				}
 			}
 		}
 
@@ -450,6 +579,9 @@ func (h *Handler) doQuery(
 				}
 				r = nil
 				processedAtLeastOneBatch = true
+				// the callback blocks until the client fetches the rows of a cursor, which isn't time spent waiting
+				// for rows
+				resetTimer(timer, waitTime)
 				continue
 			}
 
@@ -472,6 +604,9 @@ func (h *Handler) doQuery(
 				if err != nil {
 					return err
 				}
+				if rowPacketLength(outputRow) > maxPacket {
+					return sql.ErrNetPacketTooLarge.New()
+				}
 
 				ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 				r.Rows = append(r.Rows, outputRow)
@@ -483,10 +618,7 @@ func (h *Handler) doQuery(
 					return ErrRowTimeout.New()
 				}
 			}
-			if !timer.Stop() {
-				<-timer.C
-			}
-			timer.Reset(waitTime)
+			resetTimer(timer, waitTime)
 		}
 	})
 
@@ -521,7 +653,14 @@ func (h *Handler) doQuery(
 		ctx.GetLogger().Tracef("returning result %v", r)
 	}
 
-	ctx.GetLogger().Debugf("Query finished in %d ms", time.Since(start).Milliseconds())
+	elapsed := time.Since(start)
+	ctx.GetLogger().Debugf("Query finished in %d ms", elapsed.Milliseconds())
+	if isSlowQuery(ctx, elapsed) {
+		sql.IncrementStatusVariable(ctx, "Slow_queries", 1)
+		digest := h.e.QueryDigest(query)
+		ctx.GetLogger().WithField("digest", digest.Hash).WithField("digest_text", digest.Text).
+			Warnf("Slow query finished in %d ms", elapsed.Milliseconds())
+	}
 
 	// processedAtLeastOneBatch means we already called callback() at least
 	// once, so no need to call it if RowsAffected == 0.
@@ -532,6 +671,17 @@ func (h *Handler) doQuery(
 	return remainder, callback(r, more)
 }
 
+// resetTimer restarts |timer| to fire after |d|, whether it has fired or not.
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(d)
+}
+
 // See https://dev.mysql.com/doc/internals/en/status-flags.html
 func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
 	ok, err := isSessionAutocommit(ctx)
@@ -569,6 +719,9 @@ func (h *Handler) errorWrappedDoQuery(
 	bindings map[string]*query.BindVariable,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
+	h.sm.stopIdleTimer(c)
+	defer h.sm.startIdleTimer(c)
+
 	start := time.Now()
 	if h.sel != nil {
 		h.sel.QueryStarted()
@@ -641,6 +794,12 @@ func maybeGetTCPConn(conn net.Conn) (*net.TCPConn, bool) {
 	if ok {
 		conn = wrap.Conn
 	}
+	if hc, ok := conn.(*handshakeConn); ok {
+		conn = hc.Conn
+	}
+	if pc, ok := conn.(*proxiedConn); ok {
+		conn = pc.Conn
+	}
 
 	tcp, ok := conn.(*net.TCPConn)
 	if ok {
@@ -675,35 +834,63 @@ func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
-func rowToSQL(ctx *sql.Context, s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
-	o := make([]sqltypes.Value, len(row))
-	var err error
-	for i, v := range row {
-		if v == nil {
-			o[i] = sqltypes.NULL
+// maxAllowedPacket returns the max_allowed_packet of the session, the largest statement and result row it may send or
+// receive.
+func maxAllowedPacket(ctx *sql.Context) (int64, error) {
+	val, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return 0, err
+	}
+	return val.(int64), nil
+}
+
+// incomingPacketLength returns the length of a statement and its bound parameters, which is the length of the
+// COM_QUERY or COM_STMT_EXECUTE packet sending them, less the parameters' metadata. Parameters sent with
+// COM_STMT_SEND_LONG_DATA are counted too, since they're only bound to the statement when it's executed.
+func incomingPacketLength(query string, bindings map[string]*query.BindVariable) int64 {
+	length := int64(len(query))
+	for _, binding := range bindings {
+		length += int64(len(binding.Value))
+	}
+	return length
+}
+
+// rowPacketLength returns the length of the packet of the row given in the text protocol. Rows longer than
+// mysql.MaxPacketSize are split into several packets when they're written, but rows longer than max_allowed_packet
+// are an error.
+func rowPacketLength(row []sqltypes.Value) int64 {
+	var length int64
+	for _, val := range row {
+		if val.IsNull() {
+			length++
 			continue
 		}
-
-		o[i], err = s[i].Type.SQL(ctx, nil, v)
-		if err != nil {
-			return nil, err
+		l := uint64(len(val.Raw()))
+		switch {
+		case l < 251:
+			length += 1
+		case l < 1<<16:
+			length += 3
+		case l < 1<<24:
+			length += 4
+		default:
+			length += 9
 		}
+		length += int64(l)
 	}
-
-	return o, nil
+	return length
 }
 
-func row2ToSQL(s sql.Schema, row sql.Row2) ([]sqltypes.Value, error) {
+func rowToSQL(ctx *sql.Context, s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
 	o := make([]sqltypes.Value, len(row))
 	var err error
-	for i := 0; i < row.Len(); i++ {
-		v := row.GetField(i)
-		if v.IsNull() {
+	for i, v := range row {
+		if v == nil {
 			o[i] = sqltypes.NULL
 			continue
 		}
 
-		o[i], err = s[i].Type.(sql.Type2).SQL2(v)
+		o[i], err = s[i].Type.SQL(ctx, nil, v)
 		if err != nil {
 			return nil, err
 		}
@@ -727,9 +914,14 @@ func schemaToFields(ctx *sql.Context, s sql.Schema) []*query.Field {
 			ColumnLength: c.Type.MaxTextResponseByteLength(ctx),
 		}
 
-		if types.IsDecimal(c.Type) {
-			decimalType := c.Type.(sql.DecimalType)
-			fields[i].Decimals = uint32(decimalType.Scale())
+		// clients decoding the binary protocol only show as many fractional digits as the column reports
+		switch t := c.Type.(type) {
+		case sql.DecimalType:
+			fields[i].Decimals = uint32(t.Scale())
+		case sql.DatetimeType:
+			fields[i].Decimals = uint32(t.Precision())
+		case types.TimeType:
+			fields[i].Decimals = uint32(t.Precision())
 		}
 	}
 
@@ -747,6 +939,20 @@ var (
 	QueryHistogram = discard.NewHistogram()
 )
 
+// isSlowQuery returns whether a query that took the time given to run is reported as slow: the slow_query_log system
+// variable is enabled, and the time is over long_query_time seconds.
+func isSlowQuery(ctx *sql.Context, elapsed time.Duration) bool {
+	if _, val, ok := sql.SystemVariables.GetGlobal("slow_query_log"); !ok || val != int8(1) {
+		return false
+	}
+	longQueryTime, err := ctx.GetSessionVariable(ctx, "long_query_time")
+	if err != nil {
+		return false
+	}
+	threshold, ok := longQueryTime.(float64)
+	return ok && elapsed.Seconds() > threshold
+}
+
 func observeQuery(ctx *sql.Context, query string) func(err error) {
 	span, ctx := ctx.Span("query", trace.WithAttributes(attribute.String("query", query)))
 
@@ -20,6 +20,7 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/go-mysql-server/sql/variables"
 )
@@ -207,7 +209,7 @@ func TestHandlerOutput(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.Equal(t, 1, len(result.Rows))
-		require.Equal(t, sqltypes.Int64, result.Rows[0][0].Type())
+		require.Equal(t, sqltypes.Int16, result.Rows[0][0].Type())
 		require.Equal(t, []byte("456"), result.Rows[0][0].ToBytes())
 	})
 }
@@ -368,6 +370,47 @@ func TestHandlerComPrepareExecute(t *testing.T) {
 	}
 }
 
+// TestHandlerComStmtExecuteCursor tests that the rows of a prepared statement are fetched from a cursor in batches,
+// however long the client waits between fetches.
+func TestHandlerComStmtExecuteCursor(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		readTimeout: 100 * time.Millisecond,
+	}
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "select c1 from test where c1 < ?",
+		BindVars: map[string]*query.BindVariable{
+			"v1": {Type: query.Type_INT32, Value: []byte("300")},
+		},
+	}
+	_, err := handler.ComPrepare(conn, prepare.PrepareStmt)
+	require.NoError(err)
+
+	// vitess blocks the callback until the client fetches the rows of the cursor
+	var batches []int
+	err = handler.ComStmtExecute(conn, prepare, func(res *sqltypes.Result) error {
+		batches = append(batches, len(res.Rows))
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]int{rowsBatch, rowsBatch, 300 - 2*rowsBatch}, batches)
+}
+
 func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 	e, db := setupMemDB(require.New(t))
 	dummyConn := newConn(1)
@@ -625,6 +668,266 @@ func TestHandlerKill(t *testing.T) {
 	require.Len(handler.sm.sessions, 1)
 }
 
+func TestHandlerMaxAllowedPacket(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+	run := func(q string) error {
+		return handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			return nil
+		})
+	}
+	requireTooLarge := func(err error) {
+		require.Error(err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(ok, "expected a SQLError, got %T", err)
+		require.Equal(mysql.ERNetPacketTooLarge, sqlErr.Number())
+	}
+
+	require.NoError(run("set @@max_allowed_packet = 1024"))
+	require.NoError(run("select repeat('a', 1000)"))
+
+	// statements longer than max_allowed_packet
+	requireTooLarge(run("select '" + strings.Repeat("a", 1024) + "'"))
+	_, err := handler.ComPrepare(conn, "select '"+strings.Repeat("a", 1024)+"'")
+	requireTooLarge(err)
+
+	// parameters longer than max_allowed_packet
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "select length(?)",
+		BindVars: map[string]*query.BindVariable{
+			"v1": {Type: query.Type_BLOB, Value: make([]byte, 1024)},
+		},
+	}
+	_, err = handler.ComPrepare(conn, prepare.PrepareStmt)
+	require.NoError(err)
+	requireTooLarge(handler.ComStmtExecute(conn, prepare, func(res *sqltypes.Result) error {
+		return nil
+	}))
+
+	// result rows longer than max_allowed_packet
+	requireTooLarge(run("select repeat('a', 1024)"))
+	requireTooLarge(run("select repeat('a', 600), repeat('b', 600)"))
+}
+
+func TestHandlerComResetConnection(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+
+	var rows [][]sqltypes.Value
+	query := func(conn *mysql.Conn, q string) {
+		rows = nil
+		err := handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			rows = append(rows, res.Rows...)
+			return nil
+		})
+		require.NoError(err, "error running query %s", q)
+	}
+
+	conn := newConn(1)
+	conn.User = "root"
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+	query(conn, "set @@sql_select_limit = 1, @v = 'x'")
+	query(conn, "prepare s from 'select ?'")
+	_, err := handler.ComPrepare(conn, "select c1 from test where c1 = ?")
+	require.NoError(err)
+	query(conn, "select get_lock('l', 0)")
+	query(conn, "lock tables test read")
+	require.NotEmpty(e.PreparedDataCache.GetSessionData(conn.ConnectionID))
+	sess := handler.sm.session(conn)
+
+	handler.ComResetConnection(conn)
+	require.NotSame(sess, handler.sm.session(conn))
+	require.Empty(e.PreparedDataCache.GetSessionData(conn.ConnectionID))
+
+	query(conn, "select database(), current_user(), @@sql_select_limit, @v is null, is_free_lock('l')")
+	require.Len(rows, 1)
+	require.Equal("test", rows[0][0].ToString())
+	require.Equal("root@127.0.0.1:34567", rows[0][1].ToString())
+	require.Equal("2147483647", rows[0][2].ToString())
+	require.Equal("1", rows[0][3].ToString())
+	require.Equal("1", rows[0][4].ToString())
+
+	err = handler.ComQuery(conn, "execute s using @v", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.Error(err)
+}
+
+func TestHandlerExpiredPassword(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+	rootCtx := sql.NewContext(context.Background(), sql.WithSession(
+		sql.NewBaseSessionWithClientServer("foo", sql.Client{User: "root", Address: "localhost"}, 0)))
+	for _, q := range []string{"CREATE USER expired@localhost", "ALTER USER expired@localhost PASSWORD EXPIRE"} {
+		sch, iter, err := e.Query(rootCtx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(rootCtx, sch, iter)
+		require.NoError(err)
+	}
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			DefaultSessionBuilder,
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+	query := func(conn *mysql.Conn, q string) error {
+		return handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			return nil
+		})
+	}
+	requireErrorCode := func(err error, code int) {
+		require.Error(err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(ok, err.Error())
+		require.Equal(code, sqlErr.Number(), err.Error())
+	}
+
+	// clients that can't handle expired passwords are disconnected
+	conn := newConn(1)
+	conn.UserData = mysql_db.MysqlConnectionUser{User: "expired", Host: "localhost"}
+	handler.NewConnection(conn)
+	requireErrorCode(handler.ComInitDB(conn, ""), 1862)
+
+	// the other clients can only change their password
+	conn = newConn(2)
+	conn.Conn = &handshakeConn{Conn: new(mockConn), done: true, flags: capabilityClientCanHandleExpiredPasswords}
+	conn.UserData = mysql_db.MysqlConnectionUser{User: "expired", Host: "localhost"}
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, ""))
+	requireErrorCode(query(conn, "select 1"), 1820)
+	handler.ComResetConnection(conn)
+	requireErrorCode(query(conn, "select 1"), 1820)
+	require.NoError(query(conn, "alter user user() identified by 'new'"))
+	require.NoError(query(conn, "select 1"))
+}
+
+func TestHandlerConnectionLimits(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Address: "localhost", User: conn.User}, conn.ConnectionID), nil
+			},
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+	handler.sm.maxConnections = 2
+
+	newUserConn := func(id uint32, user string) *mysql.Conn {
+		conn := newConn(id)
+		conn.User = user
+		handler.NewConnection(conn)
+		return conn
+	}
+
+	conn1 := newUserConn(1, "root")
+	require.NoError(handler.ComInitDB(conn1, ""))
+	conn2 := newUserConn(2, "root")
+	require.NoError(handler.ComInitDB(conn2, ""))
+
+	conn3 := newUserConn(3, "other")
+	err := handler.ComInitDB(conn3, "")
+	require.Error(err)
+	require.Equal(mysql.ERConCount, err.(*mysql.SQLError).Number())
+	handler.ConnectionClosed(conn3)
+	require.Len(handler.sm.sessions, 2)
+	require.Len(handler.sm.connections, 2)
+
+	handler.sm.maxConnections = 0
+	require.NoError(sql.SystemVariables.SetGlobal("max_user_connections", 2))
+	defer sql.SystemVariables.SetGlobal("max_user_connections", 0)
+
+	conn4 := newUserConn(4, "root")
+	err = handler.ComInitDB(conn4, "")
+	require.Error(err)
+	require.Equal(mysql.ERTooManyUserConnections, err.(*mysql.SQLError).Number())
+	handler.ConnectionClosed(conn4)
+
+	conn5 := newUserConn(5, "other")
+	require.NoError(handler.ComInitDB(conn5, ""))
+
+	handler.ConnectionClosed(conn1)
+	conn6 := newUserConn(6, "root")
+	require.NoError(handler.ComInitDB(conn6, ""))
+	require.Len(handler.sm.sessions, 3)
+}
+
+func TestHandlerIdleTimeout(t *testing.T) {
+	require := require.New(t)
+	e, _ := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			e.Analyzer.Catalog.Database,
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, ""))
+	err := handler.ComQuery(conn, "SET SESSION wait_timeout = 1", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.NoError(err)
+	require.False(conn.IsClosed())
+
+	require.Eventually(conn.IsClosed, 5*time.Second, 50*time.Millisecond)
+	handler.ConnectionClosed(conn)
+	require.Len(handler.sm.sessions, 0)
+	require.Len(handler.sm.idleTimers, 0)
+	require.Empty(e.ProcessList.Processes())
+}
+
 func TestSchemaToFields(t *testing.T) {
 	require := require.New(t)
 
@@ -727,10 +1030,10 @@ func TestSchemaToFields(t *testing.T) {
 		{Name: "bit12", Type: query.Type_BIT, Charset: mysql.CharacterSetUtf8, ColumnLength: 12},
 
 		// Dates
-		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
-		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
+		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 26, Decimals: 6},
+		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetUtf8, ColumnLength: 26, Decimals: 6},
 		{Name: "date", Type: query.Type_DATE, Charset: mysql.CharacterSetUtf8, ColumnLength: 10},
-		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 17},
+		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 17, Decimals: 6},
 		{Name: "year", Type: query.Type_YEAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 4},
 
 		// Set and Enum Types
@@ -1231,3 +1534,20 @@ func newConn(id uint32) *mysql.Conn {
 		Conn:         new(mockConn),
 	}
 }
+
+func TestIsSlowQuery(t *testing.T) {
+	variables.InitSystemVariables()
+	session := sql.NewBaseSession()
+	ctx := sql.NewContext(
+		context.Background(),
+		sql.WithSession(session),
+	)
+	require.NoError(t, session.SetSessionVariable(ctx, "long_query_time", 0.5))
+
+	require.False(t, isSlowQuery(ctx, time.Second))
+
+	require.NoError(t, sql.SystemVariables.SetGlobal("slow_query_log", 1))
+	defer sql.SystemVariables.SetGlobal("slow_query_log", 0)
+	require.True(t, isSlowQuery(ctx, time.Second))
+	require.False(t, isSlowQuery(ctx, 100*time.Millisecond))
+}
@@ -16,12 +16,10 @@ package server
 
 import (
 	"errors"
-	"fmt"
 	"net"
-	"runtime"
 	"sync"
-	"syscall"
 
+	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -46,28 +44,52 @@ type Listener struct {
 	// channel to close both listener
 	shutdown chan struct{}
 	once     *sync.Once
+	// trustedProxies are the proxies whose TCP connections start with a PROXY protocol header
+	trustedProxies []*net.IPNet
+}
+
+// ListenerConfig is the configuration of a Listener.
+type ListenerConfig struct {
+	// Protocol takes "tcp" for the TCP socket.
+	Protocol string
+	// Address takes "host:port" information for the TCP socket.
+	Address string
+	// UnixSocketPath takes a path for the unix socket file, or the name of a named pipe on Windows like the socket
+	// option of MySQL. If empty, no need to create the second listener.
+	UnixSocketPath string
+	// TrustedProxies are the proxies, such as L4 load balancers, whose TCP connections start with a PROXY protocol
+	// header of either version. The address in the header is used as the address of the client. The header is
+	// required on the connections of the trusted proxies, and ignored on the others.
+	TrustedProxies []*net.IPNet
 }
 
 // NewListener creates a new Listener.
 // 'protocol' takes "tcp" and 'address' takes "host:port" information for TCP socket connection.
 // For unix socket connection, 'unixSocketPath' takes a path for the unix socket file.
+// On Windows, 'unixSocketPath' takes the name of a named pipe instead, like the socket option of MySQL.
 // If 'unixSocketPath' is empty, no need to create the second listener.
 func NewListener(protocol, address string, unixSocketPath string) (*Listener, error) {
-	netl, err := newNetListener(protocol, address)
+	return NewListenerWithConfig(ListenerConfig{
+		Protocol:       protocol,
+		Address:        address,
+		UnixSocketPath: unixSocketPath,
+	})
+}
+
+// NewListenerWithConfig creates a new Listener with the configuration given.
+func NewListenerWithConfig(cfg ListenerConfig) (*Listener, error) {
+	netl, err := newNetListener(cfg.Protocol, cfg.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	var unixl net.Listener
 	var unixSocketInUse error
-	if unixSocketPath != "" {
-		if runtime.GOOS == "windows" {
-			return nil, fmt.Errorf("unable to create unix socket listener on Windows")
-		}
-		unixListener, err := net.ListenUnix("unix", &net.UnixAddr{Name: unixSocketPath, Net: "unix"})
+	if cfg.UnixSocketPath != "" {
+		unixListener, err := newSocketListener(cfg.UnixSocketPath)
 		if err == nil {
 			unixl = unixListener
-		} else if errors.Is(err, syscall.EADDRINUSE) {
+		} else if errors.Is(err, UnixSocketInUseError) {
 			// we continue if error is unix socket bind address is already in use
 			// we return UnixSocketInUseError error to track the error back to where server gets started and add warning
 			unixSocketInUse = UnixSocketInUseError
@@ -77,12 +99,13 @@ func NewListener(protocol, address string, unixSocketPath string) (*Listener, er
 	}
 
 	l := &Listener{
-		netListener:  netl,
-		unixListener: unixl,
-		conns:        make(chan connRes),
-		eg:           new(errgroup.Group),
-		shutdown:     make(chan struct{}),
-		once:         &sync.Once{},
+		netListener:    netl,
+		unixListener:   unixl,
+		conns:          make(chan connRes),
+		eg:             new(errgroup.Group),
+		shutdown:       make(chan struct{}),
+		once:           &sync.Once{},
+		trustedProxies: cfg.TrustedProxies,
 	}
 	l.eg.Go(func() error {
 		for {
@@ -91,6 +114,14 @@ func NewListener(protocol, address string, unixSocketPath string) (*Listener, er
 			if errors.Is(err, net.ErrClosed) {
 				return nil
 			}
+			// the header is read in its own goroutine, so that a slow proxy doesn't hold up the other connections
+			if err == nil && isTrustedProxy(conn.RemoteAddr(), l.trustedProxies) {
+				l.eg.Go(func() error {
+					l.acceptProxied(conn)
+					return nil
+				})
+				continue
+			}
 
 			select {
 			case <-l.shutdown:
@@ -109,6 +140,9 @@ func NewListener(protocol, address string, unixSocketPath string) (*Listener, er
 				if errors.Is(err, net.ErrClosed) {
 					return nil
 				}
+				if err == nil {
+					conn = withPeerCredentials(conn)
+				}
 
 				select {
 				case <-l.shutdown:
@@ -123,12 +157,31 @@ func NewListener(protocol, address string, unixSocketPath string) (*Listener, er
 	return l, unixSocketInUse
 }
 
+// acceptProxied reads the PROXY protocol header of a connection from a trusted proxy, and passes the connection on
+// to Accept. The connection is closed if it doesn't start with a valid header.
+func (l *Listener) acceptProxied(conn net.Conn) {
+	proxied, err := readProxyHeader(conn)
+	if err != nil {
+		logrus.Warnf("closing connection from proxy %s: %s", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	select {
+	case <-l.shutdown:
+		conn.Close()
+	case l.conns <- connRes{proxied, nil}:
+	}
+}
+
 func (l *Listener) Accept() (net.Conn, error) {
 	cr, ok := <-l.conns
 	if !ok {
 		return nil, net.ErrClosed
 	}
-	return cr.conn, cr.err
+	if cr.err != nil {
+		return cr.conn, cr.err
+	}
+	return &handshakeConn{Conn: cr.conn}, nil
 }
 
 func (l *Listener) Close() error {
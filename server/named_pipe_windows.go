@@ -0,0 +1,339 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	// pipePrefix is the prefix of the paths of local named pipes.
+	pipePrefix = `\\.\pipe\`
+	// pipeBufferSize is the size of the input and output buffers of each instance of a named pipe.
+	pipeBufferSize = 64 * 1024
+)
+
+// newSocketListener creates a listener of the named pipe given, which takes the place of the unix socket on Windows
+// like the socket option of MySQL. The name is either the full path of the pipe, or its name under \\.\pipe\.
+func newSocketListener(name string) (net.Listener, error) {
+	path := name
+	if !strings.HasPrefix(path, pipePrefix) {
+		path = pipePrefix + path
+	}
+
+	closeEvent, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	l := &pipeListener{path: path, closeEvent: closeEvent}
+	// creating the first instance of the pipe fails if another process already has the pipe
+	l.next, err = l.createPipe(true)
+	if err != nil {
+		windows.CloseHandle(closeEvent)
+		if errors.Is(err, windows.ERROR_ACCESS_DENIED) || errors.Is(err, windows.ERROR_PIPE_BUSY) {
+			return nil, UnixSocketInUseError
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+// pipeListener is a net.Listener of a named pipe, which creates an instance of the pipe for each connection.
+type pipeListener struct {
+	path string
+	// closeEvent is signaled when the listener is closed, to interrupt a pending Accept
+	closeEvent windows.Handle
+
+	mu sync.Mutex
+	// next is the instance of the pipe the next client connects to, or 0 if it's yet to be created
+	next      windows.Handle
+	accepting bool
+	closed    bool
+}
+
+var _ net.Listener = (*pipeListener)(nil)
+
+func (l *pipeListener) createPipe(first bool) (windows.Handle, error) {
+	name, err := windows.UTF16PtrFromString(l.path)
+	if err != nil {
+		return 0, err
+	}
+	flags := uint32(windows.PIPE_ACCESS_DUPLEX | windows.FILE_FLAG_OVERLAPPED)
+	if first {
+		flags |= windows.FILE_FLAG_FIRST_PIPE_INSTANCE
+	}
+	return windows.CreateNamedPipe(
+		name,
+		flags,
+		windows.PIPE_TYPE_BYTE|windows.PIPE_READMODE_BYTE|windows.PIPE_WAIT,
+		windows.PIPE_UNLIMITED_INSTANCES,
+		pipeBufferSize,
+		pipeBufferSize,
+		0,
+		nil,
+	)
+}
+
+// Accept implements the net.Listener interface.
+func (l *pipeListener) Accept() (net.Conn, error) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil, net.ErrClosed
+	}
+	if l.next == 0 {
+		h, err := l.createPipe(false)
+		if err != nil {
+			l.mu.Unlock()
+			return nil, err
+		}
+		l.next = h
+	}
+	h := l.next
+	l.accepting = true
+	l.mu.Unlock()
+
+	_, err := waitIO(h, l.closeEvent, time.Time{}, func(o *windows.Overlapped) error {
+		return windows.ConnectNamedPipe(h, o)
+	})
+	// a client that connects before the server waits for it is already connected
+	if errors.Is(err, windows.ERROR_PIPE_CONNECTED) {
+		err = nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.next, l.accepting = 0, false
+	if l.closed {
+		windows.CloseHandle(h)
+		return nil, net.ErrClosed
+	}
+	if err != nil {
+		windows.CloseHandle(h)
+		return nil, err
+	}
+	return newPipeConn(h, pipeAddr(l.path))
+}
+
+// Close implements the net.Listener interface. Connections already accepted aren't closed.
+func (l *pipeListener) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return net.ErrClosed
+	}
+	l.closed = true
+	// a pending Accept closes the instance of the pipe it waits on
+	windows.SetEvent(l.closeEvent)
+	if !l.accepting && l.next != 0 {
+		windows.CloseHandle(l.next)
+		l.next = 0
+	}
+	return nil
+}
+
+// Addr implements the net.Listener interface.
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr(l.path)
+}
+
+// pipeAddr is the address of a named pipe, which is its path.
+type pipeAddr string
+
+// Network implements the net.Addr interface.
+func (a pipeAddr) Network() string {
+	return "pipe"
+}
+
+// String implements the net.Addr interface.
+func (a pipeAddr) String() string {
+	return string(a)
+}
+
+// pipeConn is a connection to the client of an instance of a named pipe.
+type pipeConn struct {
+	handle windows.Handle
+	addr   pipeAddr
+	// closeEvent is signaled when the connection is closed, to interrupt pending reads and writes
+	closeEvent windows.Handle
+	closed     atomic.Bool
+	// ioMu is held for reading by reads and writes, and for writing when the handles are closed
+	ioMu sync.RWMutex
+
+	mu            sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+var _ net.Conn = (*pipeConn)(nil)
+
+func newPipeConn(h windows.Handle, addr pipeAddr) (*pipeConn, error) {
+	closeEvent, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		windows.CloseHandle(h)
+		return nil, err
+	}
+	return &pipeConn{handle: h, addr: addr, closeEvent: closeEvent}, nil
+}
+
+// Read implements the net.Conn interface.
+func (c *pipeConn) Read(b []byte) (int, error) {
+	c.ioMu.RLock()
+	defer c.ioMu.RUnlock()
+	if c.closed.Load() {
+		return 0, net.ErrClosed
+	}
+
+	c.mu.Lock()
+	deadline := c.readDeadline
+	c.mu.Unlock()
+	n, err := waitIO(c.handle, c.closeEvent, deadline, func(o *windows.Overlapped) error {
+		return windows.ReadFile(c.handle, b, nil, o)
+	})
+	if errors.Is(err, windows.ERROR_BROKEN_PIPE) || errors.Is(err, windows.ERROR_PIPE_NOT_CONNECTED) {
+		return int(n), io.EOF
+	}
+	return int(n), err
+}
+
+// Write implements the net.Conn interface.
+func (c *pipeConn) Write(b []byte) (int, error) {
+	c.ioMu.RLock()
+	defer c.ioMu.RUnlock()
+	if c.closed.Load() {
+		return 0, net.ErrClosed
+	}
+
+	c.mu.Lock()
+	deadline := c.writeDeadline
+	c.mu.Unlock()
+	written := 0
+	for written < len(b) {
+		n, err := waitIO(c.handle, c.closeEvent, deadline, func(o *windows.Overlapped) error {
+			return windows.WriteFile(c.handle, b[written:], nil, o)
+		})
+		written += int(n)
+		if errors.Is(err, windows.ERROR_BROKEN_PIPE) || errors.Is(err, windows.ERROR_NO_DATA) {
+			return written, io.ErrClosedPipe
+		} else if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Close implements the net.Conn interface.
+func (c *pipeConn) Close() error {
+	if !c.closed.CompareAndSwap(false, true) {
+		return net.ErrClosed
+	}
+	windows.SetEvent(c.closeEvent)
+	c.ioMu.Lock()
+	defer c.ioMu.Unlock()
+	err := windows.CloseHandle(c.handle)
+	windows.CloseHandle(c.closeEvent)
+	return err
+}
+
+// LocalAddr implements the net.Conn interface.
+func (c *pipeConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+// RemoteAddr implements the net.Conn interface. Like the ones of unix sockets, the address of the client is empty.
+func (c *pipeConn) RemoteAddr() net.Addr {
+	return pipeAddr("")
+}
+
+// SetDeadline implements the net.Conn interface.
+func (c *pipeConn) SetDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline, c.writeDeadline = t, t
+	return nil
+}
+
+// SetReadDeadline implements the net.Conn interface. The deadline applies to the reads started after it's set.
+func (c *pipeConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return nil
+}
+
+// SetWriteDeadline implements the net.Conn interface. The deadline applies to the writes started after it's set.
+func (c *pipeConn) SetWriteDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writeDeadline = t
+	return nil
+}
+
+// waitIO starts an overlapped operation on |h| with |start|, and waits for it to complete. The operation is canceled
+// when |cancel| is signaled, returning net.ErrClosed, or once |deadline| passes if it's not zero, returning
+// os.ErrDeadlineExceeded. Returns the number of bytes transferred.
+func waitIO(h, cancel windows.Handle, deadline time.Time, start func(*windows.Overlapped) error) (uint32, error) {
+	event, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer windows.CloseHandle(event)
+	o := &windows.Overlapped{HEvent: event}
+
+	var n uint32
+	err = start(o)
+	if err != nil && !errors.Is(err, windows.ERROR_IO_PENDING) {
+		return 0, err
+	}
+	if err != nil {
+		timeout := uint32(windows.INFINITE)
+		if !deadline.IsZero() {
+			timeout = 0
+			if d := time.Until(deadline); d > 0 {
+				timeout = uint32(windows.INFINITE - 1)
+				if ms := d / time.Millisecond; ms < time.Duration(timeout) {
+					timeout = uint32(ms)
+				}
+			}
+		}
+		ev, err := windows.WaitForMultipleObjects([]windows.Handle{event, cancel}, false, timeout)
+		if err != nil || ev != windows.WAIT_OBJECT_0 {
+			windows.CancelIoEx(h, o)
+			// the operation may have completed before it was canceled
+			if err := windows.GetOverlappedResult(h, o, &n, true); err == nil {
+				return n, nil
+			}
+			switch {
+			case err != nil:
+				return 0, err
+			case ev == windows.WAIT_OBJECT_0+1:
+				return 0, net.ErrClosed
+			default:
+				return 0, os.ErrDeadlineExceeded
+			}
+		}
+	}
+	err = windows.GetOverlappedResult(h, o, &n, true)
+	return n, err
+}
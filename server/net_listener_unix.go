@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"net"
 	"syscall"
 
@@ -37,3 +38,14 @@ func newNetListener(protocol, address string) (net.Listener, error) {
 	}
 	return lc.Listen(context.Background(), protocol, address)
 }
+
+// newSocketListener creates a listener of the unix socket at the path given.
+func newSocketListener(path string) (net.Listener, error) {
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if errors.Is(err, syscall.EADDRINUSE) {
+		return nil, UnixSocketInUseError
+	} else if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
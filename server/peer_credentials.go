@@ -0,0 +1,81 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"os/user"
+	"strconv"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// withPeerCredentials returns the connection given, accepted on a unix socket, with a remote address that has the
+// operating system user of the client, which the auth_socket authentication plugin authenticates. Returns the
+// connection as is if the credentials of the client can't be read.
+func withPeerCredentials(conn net.Conn) net.Conn {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return conn
+	}
+	uid, err := peerUID(uc)
+	if err != nil {
+		logrus.Debugf("unable to read the peer credentials of a unix socket connection: %s", err)
+		return conn
+	}
+	return &peerCredentialsConn{
+		Conn: conn,
+		addr: &peerAddr{Addr: conn.RemoteAddr(), uid: uid},
+	}
+}
+
+// peerCredentialsConn is a connection accepted on a unix socket whose client has known peer credentials.
+type peerCredentialsConn struct {
+	net.Conn
+	addr *peerAddr
+}
+
+// RemoteAddr implements the net.Conn interface.
+func (c *peerCredentialsConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// peerAddr is the address of a client of a unix socket, with the user id of the client process.
+type peerAddr struct {
+	net.Addr
+	uid uint32
+
+	once sync.Once
+	user string
+	err  error
+}
+
+var _ mysql_db.PeerCredentialsAddr = (*peerAddr)(nil)
+
+// PeerUser implements the mysql_db.PeerCredentialsAddr interface. The name of the user is only looked up when it's
+// first needed.
+func (a *peerAddr) PeerUser() (string, error) {
+	a.once.Do(func() {
+		var u *user.User
+		u, a.err = user.LookupId(strconv.FormatUint(uint64(a.uid), 10))
+		if a.err == nil {
+			a.user = u.Username
+		}
+	})
+	return a.user, a.err
+}
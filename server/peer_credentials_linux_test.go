@@ -0,0 +1,49 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"os/user"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+func TestPeerCredentials(t *testing.T) {
+	current, err := user.Current()
+	require.NoError(t, err)
+
+	l, err := newSocketListener(filepath.Join(t.TempDir(), "mysql.sock"))
+	require.NoError(t, err)
+	defer l.Close()
+
+	client, err := net.Dial("unix", l.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	addr, ok := withPeerCredentials(conn).RemoteAddr().(mysql_db.PeerCredentialsAddr)
+	require.True(t, ok)
+	require.Equal(t, "unix", addr.Network())
+	peerUser, err := addr.PeerUser()
+	require.NoError(t, err)
+	require.Equal(t, current.Username, peerUser)
+}
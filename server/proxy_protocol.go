@@ -0,0 +1,202 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// proxyHeaderTimeout is how long a trusted proxy has to send the PROXY protocol header of a connection.
+	proxyHeaderTimeout = 5 * time.Second
+	// maxProxyHeaderV1Length is the maximum length of a version 1 header, including the trailing CRLF.
+	maxProxyHeaderV1Length = 107
+)
+
+// proxyHeaderV2Signature starts the version 2 headers of the PROXY protocol.
+var proxyHeaderV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// ParseTrustedProxies parses the IP addresses and CIDR blocks given, which identify the trusted proxies.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address: %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR block: %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// isTrustedProxy returns whether the address given is one of the trusted proxies given.
+func isTrustedProxy(addr net.Addr, trustedProxies []*net.IPNet) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// proxiedConn is a connection from a proxy, whose remote address is the one of the client of the proxy.
+type proxiedConn struct {
+	net.Conn
+	remoteAddr net.Addr
+}
+
+// RemoteAddr implements the net.Conn interface.
+func (c *proxiedConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+// readProxyHeader reads the PROXY protocol header, of either version, that a connection from a trusted proxy starts
+// with. Returns the connection with the address of the client of the proxy as its remote address, or as is if the
+// header has no address, like the ones of the health checks of the proxy.
+func readProxyHeader(conn net.Conn) (net.Conn, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(proxyHeaderTimeout)); err != nil {
+		return nil, err
+	}
+
+	start := make([]byte, len(proxyHeaderV2Signature))
+	if _, err := io.ReadFull(conn, start[:5]); err != nil {
+		return nil, err
+	}
+	var addr net.Addr
+	var err error
+	if string(start[:5]) == "PROXY" {
+		addr, err = readProxyHeaderV1(conn)
+	} else {
+		if _, err = io.ReadFull(conn, start[5:]); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(start, proxyHeaderV2Signature) {
+			return nil, fmt.Errorf("connection from %s doesn't start with a PROXY protocol header", conn.RemoteAddr())
+		}
+		addr, err = readProxyHeaderV2(conn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	if addr == nil {
+		return conn, nil
+	}
+	return &proxiedConn{Conn: conn, remoteAddr: addr}, nil
+}
+
+// readProxyHeaderV1 reads the rest of a version 1 header, after its "PROXY" prefix. The header is read one byte at a
+// time so that none of the data that follows it is read.
+func readProxyHeaderV1(conn net.Conn) (net.Addr, error) {
+	line := []byte("PROXY")
+	b := make([]byte, 1)
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= maxProxyHeaderV1Length {
+			return nil, fmt.Errorf("PROXY protocol header is too long")
+		}
+		if _, err := io.ReadFull(conn, b); err != nil {
+			return nil, err
+		}
+		line = append(line, b[0])
+	}
+
+	fields := strings.Fields(string(line))
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid PROXY protocol header: %q", line)
+	}
+	switch fields[1] {
+	case "UNKNOWN":
+		return nil, nil
+	case "TCP4", "TCP6":
+	default:
+		return nil, fmt.Errorf("invalid PROXY protocol header: %q", line)
+	}
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("invalid PROXY protocol header: %q", line)
+	}
+	ip := net.ParseIP(fields[2])
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if ip == nil || err != nil {
+		return nil, fmt.Errorf("invalid PROXY protocol header: %q", line)
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readProxyHeaderV2 reads the rest of a version 2 header, after its signature.
+func readProxyHeaderV2(conn net.Conn) (net.Addr, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	if header[0]>>4 != 2 {
+		return nil, fmt.Errorf("unsupported PROXY protocol version: %d", header[0]>>4)
+	}
+	payload := make([]byte, binary.BigEndian.Uint16(header[2:]))
+	if _, err := io.ReadFull(conn, payload); err != nil {
+		return nil, err
+	}
+
+	switch command := header[0] & 0xf; command {
+	case 0x0:
+		// a LOCAL connection is made by the proxy itself
+		return nil, nil
+	case 0x1:
+	default:
+		return nil, fmt.Errorf("unsupported PROXY protocol command: %d", command)
+	}
+
+	var ipLen int
+	switch family := header[1] >> 4; family {
+	case 0x1:
+		ipLen = net.IPv4len
+	case 0x2:
+		ipLen = net.IPv6len
+	default:
+		// the clients of other address families have no address to use
+		return nil, nil
+	}
+	if len(payload) < 2*ipLen+4 {
+		return nil, fmt.Errorf("PROXY protocol header is too short for its addresses")
+	}
+	ip := net.IP(payload[:ipLen])
+	port := binary.BigEndian.Uint16(payload[2*ipLen:])
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
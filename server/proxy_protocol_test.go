@@ -0,0 +1,133 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProxyHeader(t *testing.T) {
+	v2 := func(command, family byte, addrs ...byte) []byte {
+		header := append([]byte{}, proxyHeaderV2Signature...)
+		header = append(header, 0x20|command, family, 0, byte(len(addrs)))
+		return append(header, addrs...)
+	}
+
+	tests := []struct {
+		name   string
+		header []byte
+		addr   string
+		err    bool
+	}{
+		{
+			name:   "v1 tcp4",
+			header: []byte("PROXY TCP4 192.168.0.1 192.168.0.11 56324 3306\r\n"),
+			addr:   "192.168.0.1:56324",
+		},
+		{
+			name:   "v1 tcp6",
+			header: []byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 3306\r\n"),
+			addr:   "[2001:db8::1]:56324",
+		},
+		{
+			name:   "v1 unknown",
+			header: []byte("PROXY UNKNOWN\r\n"),
+		},
+		{
+			name:   "v1 invalid",
+			header: []byte("PROXY TCP4 192.168.0.1\r\n"),
+			err:    true,
+		},
+		{
+			name:   "v2 tcp4",
+			header: v2(0x1, 0x11, 10, 0, 0, 1, 10, 0, 0, 2, 0xdc, 0x04, 0x0c, 0xea),
+			addr:   "10.0.0.1:56324",
+		},
+		{
+			name:   "v2 local",
+			header: v2(0x0, 0x00),
+		},
+		{
+			name:   "v2 short",
+			header: v2(0x1, 0x11, 10, 0, 0, 1),
+			err:    true,
+		},
+		{
+			name:   "no header",
+			header: []byte("\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			defer client.Close()
+			go func() {
+				client.Write(test.header)
+				client.Write([]byte("data"))
+			}()
+
+			conn, err := readProxyHeader(server)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if test.addr == "" {
+				require.Equal(t, server.RemoteAddr(), conn.RemoteAddr())
+			} else {
+				require.Equal(t, test.addr, conn.RemoteAddr().String())
+			}
+
+			// the data after the header is left to read
+			data := make([]byte, 4)
+			_, err = io.ReadFull(conn, data)
+			require.NoError(t, err)
+			require.Equal(t, "data", string(data))
+		})
+	}
+}
+
+func TestListenerTrustedProxies(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = ParseTrustedProxies([]string{"localhost"})
+	require.Error(t, err)
+
+	l, err := NewListenerWithConfig(ListenerConfig{
+		Protocol:       "tcp",
+		Address:        "127.0.0.1:0",
+		TrustedProxies: trustedProxies,
+	})
+	require.NoError(t, err)
+	defer l.Close()
+
+	client, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.Write([]byte("PROXY TCP4 203.0.113.7 127.0.0.1 40000 3306\r\n"))
+	require.NoError(t, err)
+
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, "203.0.113.7:40000", conn.RemoteAddr().String())
+}
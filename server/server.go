@@ -119,6 +119,9 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 	if cfg.MaxConnections < 0 {
 		cfg.MaxConnections = 0
 	}
+	// connections over the limit are refused by the session manager once they're authenticated, rather than left
+	// waiting to be accepted by the listener
+	sm.maxConnections = cfg.MaxConnections
 
 	var unixSocketInUse error
 
@@ -126,7 +129,16 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 		unixSocketInUse = fmt.Errorf("Port %s already in use.", cfg.Address)
 	}
 
-	l, err := NewListener(cfg.Protocol, cfg.Address, cfg.Socket)
+	trustedProxies, err := ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	l, err := NewListenerWithConfig(ListenerConfig{
+		Protocol:       cfg.Protocol,
+		Address:        cfg.Address,
+		UnixSocketPath: cfg.Socket,
+		TrustedProxies: trustedProxies,
+	})
 	if err != nil {
 		if errors.Is(err, UnixSocketInUseError) {
 			unixSocketInUse = err
@@ -141,7 +153,6 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 		Handler:                  handler,
 		ConnReadTimeout:          cfg.ConnReadTimeout,
 		ConnWriteTimeout:         cfg.ConnWriteTimeout,
-		MaxConns:                 cfg.MaxConnections,
 		ConnReadBufferSize:       mysql.DefaultConnBufferSize,
 		AllowClearTextWithoutTLS: cfg.AllowClearTextWithoutTLS,
 	}
@@ -153,7 +164,13 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 	if cfg.Version != "" {
 		vtListnr.ServerVersion = cfg.Version
 	}
-	vtListnr.TLSConfig = cfg.TLSConfig
+	if cfg.TLSConfig != nil {
+		tlsConfig := countTLSHandshakes(cfg.TLSConfig)
+		if cfg.TLSCertificate != nil {
+			tlsConfig = cfg.TLSCertificate.configure(tlsConfig)
+		}
+		vtListnr.TLSConfig = tlsConfig
+	}
 	vtListnr.RequireSecureTransport = cfg.RequireSecureTransport
 
 	return &Server{
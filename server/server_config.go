@@ -48,10 +48,14 @@ type Config struct {
 	ConnReadTimeout time.Duration
 	// ConnWriteTimeout is the server's write timeout
 	ConnWriteTimeout time.Duration
-	// MaxConnections is the maximum number of simultaneous connections that the server will allow.
+	// MaxConnections is the maximum number of simultaneous connections that the server will allow. Connections over
+	// the limit are refused with ER_CON_COUNT_ERROR. A value of 0 means no limit.
 	MaxConnections uint64
 	// TLSConfig is the configuration for TLS on this server. If |nil|, TLS is not supported.
 	TLSConfig *tls.Config
+	// TLSCertificate, if set, is the certificate of the server for TLS in place of the certificates of TLSConfig. It
+	// can be reloaded while the server runs, such as when it's renewed. Requires non-|nil| TLSConfig.
+	TLSCertificate *CertificateReloader
 	// RequestSecureTransport will require incoming connections to be TLS. Requires non-|nil| TLSConfig.
 	RequireSecureTransport bool
 	// DisableClientMultiStatements will prevent processing of incoming
@@ -64,7 +68,13 @@ type Config struct {
 	DisableClientMultiStatements bool
 	// NoDefaults prevents using persisted configuration for new server sessions
 	NoDefaults bool
-	// Socket is a path to unix socket file
+	// TrustedProxies are the IP addresses and CIDR blocks of the proxies, such as L4 load balancers, whose
+	// connections start with a PROXY protocol header. The address of the client in the header is used for privilege
+	// host matching, the process list and the logs. Connections from other addresses are taken as is.
+	TrustedProxies []string
+	// Socket is a path to unix socket file. On Windows, it's the name of a named pipe instead, like the socket option
+	// of MySQL. Accounts using the auth_socket authentication plugin are authenticated by the operating system user of
+	// the clients connected through the unix socket.
 	Socket                   string
 	AllowClearTextWithoutTLS bool
 	// MaxLoggedQueryLen sets the length at which queries written to the logs are truncated.  A value of 0 will
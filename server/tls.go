@@ -0,0 +1,146 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// certificateTimeFormat is the format of the validity times of the certificate in the Ssl_server_not_before and
+// Ssl_server_not_after status variables, which is the one of MySQL.
+const certificateTimeFormat = "Jan _2 15:04:05 2006 GMT"
+
+// CertificateReloader holds the certificate of the server for TLS, loaded from a certificate file and a key file in
+// PEM format, and reloads it from the same files on demand so that a renewed certificate is used without restarting
+// the server. Connections already established keep the certificate they were established with.
+type CertificateReloader struct {
+	certFile string
+	keyFile  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	leaf *x509.Certificate
+}
+
+// NewCertificateReloader creates a CertificateReloader of the certificate in the files given, which is loaded
+// immediately.
+func NewCertificateReloader(certFile, keyFile string) (*CertificateReloader, error) {
+	r := &CertificateReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificate from its files again, which is used by the TLS handshakes that follow. If the files
+// can't be loaded, an error is returned and the certificate loaded before is kept.
+func (r *CertificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.leaf = &cert, leaf
+	return nil
+}
+
+// GetCertificate returns the certificate last loaded. It's meant to be the GetCertificate function of a tls.Config.
+func (r *CertificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// validity returns the times the certificate last loaded is valid from and until.
+func (r *CertificateReloader) validity() (time.Time, time.Time) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.leaf.NotBefore, r.leaf.NotAfter
+}
+
+// configure returns a copy of the TLS configuration given that uses the certificate of the reloader, and adds the
+// status variables with the validity of the certificate.
+func (r *CertificateReloader) configure(tlsConfig *tls.Config) *tls.Config {
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.Certificates = nil
+	tlsConfig.GetCertificate = r.GetCertificate
+
+	sql.StatusVariables.AddStatusVariables([]sql.StatusVariable{
+		{
+			Name:  "Ssl_server_not_after",
+			Scope: sql.SystemVariableScope_Global,
+			ValueFunction: func() (interface{}, error) {
+				_, notAfter := r.validity()
+				return notAfter.UTC().Format(certificateTimeFormat), nil
+			},
+		},
+		{
+			Name:  "Ssl_server_not_before",
+			Scope: sql.SystemVariableScope_Global,
+			ValueFunction: func() (interface{}, error) {
+				notBefore, _ := r.validity()
+				return notBefore.UTC().Format(certificateTimeFormat), nil
+			},
+		},
+	})
+	return tlsConfig
+}
+
+// countTLSHandshakes returns a copy of the TLS configuration given that counts the TLS handshakes started and the ones
+// completed in the Ssl_accepts and Ssl_finished_accepts status variables.
+func countTLSHandshakes(tlsConfig *tls.Config) *tls.Config {
+	tlsConfig = tlsConfig.Clone()
+	getConfigForClient := tlsConfig.GetConfigForClient
+	tlsConfig.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		sql.StatusVariables.IncrementGlobal("Ssl_accepts", 1)
+		if getConfigForClient != nil {
+			return getConfigForClient(hello)
+		}
+		return nil, nil
+	}
+	verifyConnection := tlsConfig.VerifyConnection
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if verifyConnection != nil {
+			if err := verifyConnection(state); err != nil {
+				return err
+			}
+		}
+		sql.StatusVariables.IncrementGlobal("Ssl_finished_accepts", 1)
+		return nil
+	}
+	return tlsConfig
+}
+
+// tlsConnectionState returns the state of the TLS connection given, or nil if the client didn't connect with TLS.
+func tlsConnectionState(c *mysql.Conn) *tls.ConnectionState {
+	if tlsConn, ok := c.Conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		return &state
+	}
+	return nil
+}
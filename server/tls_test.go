@@ -0,0 +1,105 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// writeCertificate writes a new self-signed certificate valid from |notBefore|, and its key, to the files given.
+func writeCertificate(t *testing.T, certFile, keyFile string, notBefore time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notBefore.Unix()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+// handshake runs a TLS handshake between a client and a server with the configuration given, and returns the
+// certificate of the server.
+func handshake(t *testing.T, tlsConfig *tls.Config) *x509.Certificate {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- tls.Server(serverConn, tlsConfig).Handshake()
+	}()
+	client := tls.Client(clientConn, &tls.Config{InsecureSkipVerify: true})
+	require.NoError(t, client.Handshake())
+	require.NoError(t, <-errs)
+	return client.ConnectionState().PeerCertificates[0]
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	first := time.Date(2023, 4, 20, 10, 13, 3, 0, time.UTC)
+	writeCertificate(t, certFile, keyFile, first)
+
+	reloader, err := NewCertificateReloader(certFile, keyFile)
+	require.NoError(t, err)
+	tlsConfig := reloader.configure(countTLSHandshakes(&tls.Config{}))
+	_, accepts, _ := sql.StatusVariables.GetGlobal("Ssl_accepts")
+	_, finished, _ := sql.StatusVariables.GetGlobal("Ssl_finished_accepts")
+
+	require.Equal(t, first, handshake(t, tlsConfig).NotBefore)
+	_, notBefore, ok := sql.StatusVariables.GetGlobal("Ssl_server_not_before")
+	require.True(t, ok)
+	require.Equal(t, "Apr 20 10:13:03 2023 GMT", notBefore)
+
+	// a certificate that can't be loaded keeps the one loaded before
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0600))
+	require.Error(t, reloader.Reload())
+	require.Equal(t, first, handshake(t, tlsConfig).NotBefore)
+
+	second := first.Add(time.Hour)
+	writeCertificate(t, certFile, keyFile, second)
+	require.NoError(t, reloader.Reload())
+	require.Equal(t, second, handshake(t, tlsConfig).NotBefore)
+	_, notAfter, _ := sql.StatusVariables.GetGlobal("Ssl_server_not_after")
+	require.Equal(t, "Apr 21 11:13:03 2023 GMT", notAfter)
+
+	_, val, _ := sql.StatusVariables.GetGlobal("Ssl_accepts")
+	require.Equal(t, accepts.(int64)+3, val)
+	_, val, _ = sql.StatusVariables.GetGlobal("Ssl_finished_accepts")
+	require.Equal(t, finished.(int64)+3, val)
+}
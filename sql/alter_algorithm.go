@@ -0,0 +1,151 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "strings"
+
+// AlterAlgorithm is the algorithm used to perform an ALTER TABLE operation, either as requested by the ALGORITHM clause
+// or as supported by a table. Algorithms are ordered from cheapest to most expensive.
+type AlterAlgorithm byte
+
+const (
+	AlterAlgorithm_Default AlterAlgorithm = iota
+	AlterAlgorithm_Instant
+	AlterAlgorithm_Inplace
+	AlterAlgorithm_Copy
+)
+
+// String returns the keyword for this algorithm as used in the ALGORITHM clause.
+func (a AlterAlgorithm) String() string {
+	switch a {
+	case AlterAlgorithm_Instant:
+		return "INSTANT"
+	case AlterAlgorithm_Inplace:
+		return "INPLACE"
+	case AlterAlgorithm_Copy:
+		return "COPY"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// ParseAlterAlgorithm returns the AlterAlgorithm for the keyword given, which is matched case-insensitively.
+func ParseAlterAlgorithm(s string) (AlterAlgorithm, error) {
+	switch strings.ToUpper(s) {
+	case "DEFAULT":
+		return AlterAlgorithm_Default, nil
+	case "INSTANT":
+		return AlterAlgorithm_Instant, nil
+	case "INPLACE":
+		return AlterAlgorithm_Inplace, nil
+	case "COPY":
+		return AlterAlgorithm_Copy, nil
+	default:
+		return AlterAlgorithm_Default, ErrUnknownAlterAlgorithm.New(s)
+	}
+}
+
+// AlterLock is the level of concurrent access permitted during an ALTER TABLE operation, as requested by the LOCK
+// clause. Locks are ordered from least to most restrictive.
+type AlterLock byte
+
+const (
+	AlterLock_Default AlterLock = iota
+	AlterLock_None
+	AlterLock_Shared
+	AlterLock_Exclusive
+)
+
+// String returns the keyword for this lock as used in the LOCK clause.
+func (l AlterLock) String() string {
+	switch l {
+	case AlterLock_None:
+		return "NONE"
+	case AlterLock_Shared:
+		return "SHARED"
+	case AlterLock_Exclusive:
+		return "EXCLUSIVE"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// ParseAlterLock returns the AlterLock for the keyword given, which is matched case-insensitively.
+func ParseAlterLock(s string) (AlterLock, error) {
+	switch strings.ToUpper(s) {
+	case "DEFAULT":
+		return AlterLock_Default, nil
+	case "NONE":
+		return AlterLock_None, nil
+	case "SHARED":
+		return AlterLock_Shared, nil
+	case "EXCLUSIVE":
+		return AlterLock_Exclusive, nil
+	default:
+		return AlterLock_Default, ErrUnknownAlterLock.New(s)
+	}
+}
+
+// AlterOperation identifies the kind of change made by a single clause of an ALTER TABLE statement.
+type AlterOperation byte
+
+const (
+	AlterOperation_AddColumn AlterOperation = iota
+	AlterOperation_DropColumn
+	AlterOperation_ModifyColumn
+	AlterOperation_RenameColumn
+	AlterOperation_SetDefault
+	AlterOperation_DropDefault
+	AlterOperation_AddIndex
+	AlterOperation_DropIndex
+	AlterOperation_RenameIndex
+	AlterOperation_AddPrimaryKey
+	AlterOperation_DropPrimaryKey
+	AlterOperation_AddCheck
+	AlterOperation_DropCheck
+	AlterOperation_AutoIncrement
+	AlterOperation_Collation
+	AlterOperation_TableOptions
+)
+
+// NegotiateAlterAlgorithm returns the algorithm that |table| will use to perform |op|, given the ALGORITHM and LOCK
+// clauses of the statement: COPY when it's requested explicitly, and the table's own algorithm otherwise. An error is
+// returned if the table cannot satisfy the requested algorithm or lock. Tables that don't implement AlterAlgorithmTable
+// are assumed to support every algorithm.
+func NegotiateAlterAlgorithm(ctx *Context, table Table, op AlterOperation, algorithm AlterAlgorithm, lock AlterLock) (AlterAlgorithm, error) {
+	supported := AlterAlgorithm_Instant
+	if aat, ok := table.(AlterAlgorithmTable); ok {
+		supported = aat.AlterAlgorithm(ctx, op)
+	}
+
+	if algorithm != AlterAlgorithm_Default && algorithm < supported {
+		return AlterAlgorithm_Default, ErrAlterAlgorithmNotSupported.New("ALGORITHM="+algorithm.String(), "ALGORITHM="+supported.String())
+	}
+
+	// The INSTANT algorithm only changes metadata, so it doesn't take the locks requested by LOCK
+	if algorithm == AlterAlgorithm_Instant && lock != AlterLock_Default {
+		return AlterAlgorithm_Default, ErrAlterAlgorithmNotSupported.New("LOCK="+lock.String(), "LOCK=DEFAULT")
+	}
+
+	// The COPY algorithm blocks writes while rows are copied, so it can't run with LOCK=NONE
+	if lock == AlterLock_None && (algorithm == AlterAlgorithm_Copy || supported == AlterAlgorithm_Copy) {
+		return AlterAlgorithm_Default, ErrAlterAlgorithmNotSupported.New("LOCK=NONE", "LOCK=SHARED")
+	}
+
+	if algorithm == AlterAlgorithm_Copy {
+		return AlterAlgorithm_Copy, nil
+	}
+	return supported, nil
+}
@@ -99,7 +99,7 @@ func getTableAliases(n sql.Node, scope *plan.Scope) (TableAliases, error) {
 			return false
 		case *plan.Procedure:
 			return false
-		case *plan.Block:
+		case *plan.Block, *plan.AlterTable:
 			// blocks should not be parsed as a whole, just their statements individually
 			for _, child := range node.Children() {
 				_, analysisErr = getTableAliases(child, recScope)
@@ -116,6 +116,10 @@ func getTableAliases(n sql.Node, scope *plan.Scope) (TableAliases, error) {
 			rt := getResolvedTable(node.ResolvedTable)
 			analysisErr = passAliases.add(rt, node)
 			return false
+		case *plan.AdaptiveJoin:
+			// both alternatives join the same tables, only one of them is walked
+			transform.Inspect(node.Lookup, aliasFn)
+			return false
 		case sql.Nameable:
 			analysisErr = passAliases.add(node, node)
 			return false
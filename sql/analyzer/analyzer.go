@@ -449,8 +449,7 @@ func NewProcRuleSelector(sel RuleSelector) RuleSelector {
 			// once after default rules should only be run once
 			AutocommitId,
 			TrackProcessId,
-			parallelizeId,
-			clearWarningsId:
+			parallelizeId:
 			return false
 		}
 		return sel(id)
@@ -499,7 +498,9 @@ func NewFinalizeUnionSel(sel RuleSelector) RuleSelector {
 			resolveSubqueryExprsId,
 			resolveSubqueriesId,
 			resolveUnionsId,
-			parallelizeId:
+			parallelizeId,
+			// the columns of unions are the columns of their left nodes
+			mergeDerivedTablesId:
 			return false
 		case finalizeSubqueriesId,
 			hoistOutOfScopeFiltersId:
@@ -522,7 +523,17 @@ func newInsertSourceSelector(sel RuleSelector) RuleSelector {
 // Analyze applies the transformation rules to the node given. In the case of an error, the last successfully
 // transformed node is returned along with the error.
 func (a *Analyzer) Analyze(ctx *sql.Context, n sql.Node, scope *plan.Scope) (sql.Node, error) {
-	n, _, err := a.analyzeWithSelector(ctx, n, scope, SelectAllBatches, DefaultRuleSelector)
+	// the statements defining views, triggers, procedures and events are resolved in the database they're created in
+	var dbName string
+	switch n := n.(type) {
+	case *plan.CreateView, *plan.CreateTrigger, *plan.CreateProcedure, *plan.CreateEvent:
+		dbName = n.(sql.Databaser).Database().Name()
+	}
+	err := withCurrentDatabase(ctx, dbName, func() error {
+		var err error
+		n, _, err = a.analyzeWithSelector(ctx, n, scope, SelectAllBatches, DefaultRuleSelector)
+		return err
+	})
 	return n, err
 }
 
@@ -634,8 +645,7 @@ func postPrepareInsertSourceRuleSelector(id RuleId) bool {
 
 		AutocommitId,
 		TrackProcessId,
-		parallelizeId,
-		clearWarningsId:
+		parallelizeId:
 		return true
 	}
 	return false
@@ -60,7 +60,7 @@ func applyForeignKeysToNodes(ctx *sql.Context, a *Analyzer, n sql.Node, cache *f
 				fkParentTbls[i] = nil
 				continue
 			}
-			parentTbl, _, err := a.Catalog.Table(ctx, fkDef.ParentDatabase, fkDef.ParentTable)
+			parentTbl, err := foreignKeyTable(ctx, a, fkDef.ParentDatabase, fkDef.ParentTable)
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
@@ -486,7 +486,7 @@ func (cache *foreignKeyCache) GetUpdater(ctx *sql.Context, a *Analyzer, dbName s
 	if fkTblEditor, ok := cache.updaterCache[fkTableName]; ok {
 		return fkTblEditor.tbl, fkTblEditor.updater, nil
 	}
-	tbl, _, err := a.Catalog.Table(ctx, dbName, tblName)
+	tbl, err := foreignKeyTable(ctx, a, dbName, tblName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -618,3 +618,14 @@ func (chain foreignKeyChain) GetUpdaters() []sql.ForeignKeyEditor {
 	}
 	return updaters
 }
+
+// foreignKeyTable returns the table given, which is the parent or child of a foreign key. As in MySQL, the user doesn't
+// need any privileges on the tables that foreign key checks and referential actions access, so they're not checked.
+func foreignKeyTable(ctx *sql.Context, a *Analyzer, dbName string, tblName string) (sql.Table, error) {
+	db, err := a.Catalog.Provider.Database(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	tbl, _, err := a.Catalog.DatabaseTable(ctx, db, tblName)
+	return tbl, err
+}
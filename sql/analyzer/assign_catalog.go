@@ -54,6 +54,31 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, transform.NewTree, nil
+		case *plan.ChecksumTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.CheckTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.OptimizeTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.FlushTables:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.GlobalReadLock = a.Catalog.GlobalReadLock
+			return &nc, transform.NewTree, nil
+		case *plan.FlushLogs:
+			nc := *node
+			nc.LogSinks = a.Catalog.LogSinks
+			return &nc, transform.NewTree, nil
+		case *plan.BackupDatabase:
+			nc := *node
+			nc.Dumper = a.Catalog.DatabaseDumper
+			return &nc, transform.NewTree, nil
 		case *plan.Use:
 			nc := *node
 			nc.Catalog = a.Catalog
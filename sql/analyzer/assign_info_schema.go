@@ -33,6 +33,10 @@ func loadInfoSchema(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope
 			}
 
 			x.IndexesToShow = filterGeneratedIndexes(tableIndexes)
+			x.Stats, err = a.Catalog.Statistics(ctx)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
 			return x, transform.NewTree, nil
 		case *plan.ShowCreateTable:
 			if !x.IsView {
@@ -24,16 +24,26 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 type Catalog struct {
 	MySQLDb    *mysql_db.MySQLDb
 	InfoSchema sql.Database
+	PerfSchema sql.Database
 
 	Provider         sql.DatabaseProvider
 	builtInFunctions function.Registry
+	tableFunctions   *sql.TableFunctionRegistry
 	mu               sync.RWMutex
 	locks            sessionLocks
+
+	// GlobalReadLock is the lock acquired by FLUSH TABLES WITH READ LOCK, which blocks writes until it's released
+	GlobalReadLock *sql.GlobalReadLock
+	// LogSinks are the server logs rotated by FLUSH LOGS
+	LogSinks *sql.LogSinkRegistry
+	// DatabaseDumper writes the database dumps saved by BACKUP DATABASE, and is set by the engine
+	DatabaseDumper sql.DatabaseDumper
 }
 
 var _ sql.Catalog = (*Catalog)(nil)
@@ -49,13 +59,19 @@ type sessionLocks map[uint32]dbLocks
 
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) *Catalog {
-	return &Catalog{
+	c := &Catalog{
 		MySQLDb:          mysql_db.CreateEmptyMySQLDb(),
 		InfoSchema:       information_schema.NewInformationSchemaDatabase(),
+		PerfSchema:       information_schema.NewPerformanceSchemaDatabase(),
 		Provider:         provider,
 		builtInFunctions: function.NewRegistry(),
+		tableFunctions:   sql.NewTableFunctionRegistry(),
 		locks:            make(sessionLocks),
+		GlobalReadLock:   sql.NewGlobalReadLock(),
+		LogSinks:         sql.NewLogSinkRegistry(),
 	}
+	c.tableFunctions.Register(plan.NewTableSampleFunction(c))
+	return c
 }
 
 // TODO: kill this
@@ -65,7 +81,7 @@ func NewDatabaseProvider(dbs ...sql.Database) sql.DatabaseProvider {
 
 func (c *Catalog) AllDatabases(ctx *sql.Context) []sql.Database {
 	var dbs []sql.Database
-	dbs = append(dbs, c.InfoSchema)
+	dbs = append(dbs, c.InfoSchema, c.PerfSchema)
 
 	if c.MySQLDb.Enabled {
 		dbs = append(dbs, mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).AllDatabases(ctx)...)
@@ -118,7 +134,7 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 
 func (c *Catalog) HasDatabase(ctx *sql.Context, db string) bool {
 	db = strings.ToLower(db)
-	if db == "information_schema" {
+	if db == sql.InformationSchemaDatabaseName || db == sql.PerformanceSchemaDatabaseName {
 		return true
 	} else if c.MySQLDb.Enabled {
 		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).HasDatabase(ctx, db)
@@ -129,8 +145,10 @@ func (c *Catalog) HasDatabase(ctx *sql.Context, db string) bool {
 
 // Database returns the database with the given name.
 func (c *Catalog) Database(ctx *sql.Context, db string) (sql.Database, error) {
-	if strings.ToLower(db) == "information_schema" {
+	if strings.ToLower(db) == sql.InformationSchemaDatabaseName {
 		return c.InfoSchema, nil
+	} else if strings.ToLower(db) == sql.PerformanceSchemaDatabaseName {
+		return c.PerfSchema, nil
 	} else if c.MySQLDb.Enabled {
 		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).Database(ctx, db)
 	} else {
@@ -158,9 +176,18 @@ func (c *Catalog) LockTable(ctx *sql.Context, table string) {
 	c.locks[id][db][table] = struct{}{}
 }
 
+// HasLockedTables returns whether the given session client holds any table locks.
+func (c *Catalog) HasLockedTables(id uint32) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.locks[id]) > 0
+}
+
 // UnlockTables unlocks all tables for which the given session client has a
-// lock.
+// lock, and releases the global read lock if the client holds it.
 func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
+	c.GlobalReadLock.Release(id)
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -311,18 +338,24 @@ func (c *Catalog) ExternalStoredProcedures(ctx *sql.Context, name string) ([]sql
 	return nil, nil
 }
 
+// RegisterTableFunction registers the table functions given, which are used when the provider doesn't have a table
+// function with the same name.
+func (c *Catalog) RegisterTableFunction(ctx *sql.Context, fns ...sql.TableFunction) {
+	c.tableFunctions.Register(fns...)
+}
+
 // TableFunction implements the TableFunctionProvider interface
 func (c *Catalog) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, error) {
 	if fp, ok := c.Provider.(sql.TableFunctionProvider); ok {
 		tf, err := fp.TableFunction(ctx, name)
-		if err != nil {
+		if err != nil && !sql.ErrTableFunctionNotFound.Is(err) {
 			return nil, err
 		} else if tf != nil {
 			return tf, nil
 		}
 	}
 
-	return nil, sql.ErrTableFunctionNotFound.New(name)
+	return c.tableFunctions.TableFunction(ctx, name)
 }
 
 func (c *Catalog) Statistics(ctx *sql.Context) (sql.StatsReadWriter, error) {
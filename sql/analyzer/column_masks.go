@@ -0,0 +1,130 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyColumnMasks replaces the sql.ColumnMaskingTable tables read by the node with plan.MaskedTable tables, unless the
+// user has the UNMASK privilege. Masking the rows of the tables, rather than the columns projected from them, makes
+// the masked values the ones that every filter, join and function of the query sees. The tables written by updates
+// and deletes aren't masked, since they change the unmasked values.
+func applyColumnMasks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("applyColumnMasks")
+	defer span.End()
+
+	// DDL and SHOW statements refer to tables without reading their rows
+	if plan.IsNoRowNode(n) {
+		return n, transform.SameTree, nil
+	}
+	if a.Catalog.MySQLDb.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(plan.DynamicPrivilege_Unmask)) {
+		return n, transform.SameTree, nil
+	}
+
+	var written []*plan.ResolvedTable
+	transform.Inspect(n, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.Update:
+			written = append(written, getResolvedTable(node.Child))
+		case *plan.DeleteFrom:
+			if node.HasExplicitTargets() {
+				for _, target := range node.GetDeleteTargets() {
+					written = append(written, getResolvedTable(target))
+				}
+			} else {
+				written = append(written, getResolvedTable(node.Child))
+			}
+		}
+		return true
+	})
+	isWritten := func(rt *plan.ResolvedTable) bool {
+		for _, w := range written {
+			if w != nil && strings.EqualFold(w.Name(), rt.Name()) && strings.EqualFold(w.Database.Name(), rt.Database.Name()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return transform.NodeWithCtx(n, nil, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := c.Node.(*plan.ResolvedTable)
+		if !ok || isWritten(rt) {
+			return c.Node, transform.SameTree, nil
+		}
+		// the destination of an insert isn't read
+		if _, ok := c.Parent.(*plan.InsertInto); ok {
+			return c.Node, transform.SameTree, nil
+		}
+		mt, ok := rt.Table.(sql.ColumnMaskingTable)
+		if !ok {
+			return c.Node, transform.SameTree, nil
+		}
+		masks, err := columnMasks(ctx, a, mt)
+		if err != nil || masks == nil {
+			return c.Node, transform.SameTree, err
+		}
+		nrt := *rt
+		nrt.Table = plan.NewMaskedTable(rt.Table, masks)
+		return &nrt, transform.NewTree, nil
+	})
+}
+
+// columnMasks returns the resolved masks of the columns of the table given by column index, or nil if the table
+// masks none of its columns.
+func columnMasks(ctx *sql.Context, a *Analyzer, table sql.ColumnMaskingTable) ([]sql.Expression, error) {
+	definitions, err := table.ColumnMasks(ctx)
+	if err != nil || len(definitions) == 0 {
+		return nil, err
+	}
+
+	sch := table.Schema()
+	masks := make([]sql.Expression, len(sch))
+	for column, definition := range definitions {
+		idx := sch.IndexOfColName(column)
+		if idx < 0 {
+			return nil, sql.ErrTableColumnNotFound.New(table.Name(), column)
+		}
+		mask, err := ConvertCheckDefToConstraint(ctx, &sql.CheckDefinition{Name: column, CheckExpression: definition})
+		if err != nil {
+			return nil, err
+		}
+		// masks are evaluated on the rows of the table, like column defaults
+		masks[idx], _, err = transform.Expr(mask.Expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			switch e := e.(type) {
+			case *expression.UnresolvedColumn:
+				colIdx := sch.IndexOfColName(e.Name())
+				if colIdx < 0 {
+					return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(table.Name(), e.Name())
+				}
+				col := sch[colIdx]
+				return expression.NewGetFieldWithTable(colIdx, col.Type, table.Name(), col.Name, col.Nullable), transform.NewTree, nil
+			case *expression.UnresolvedFunction:
+				return resolveFunctionsInExpr(ctx, a)(e)
+			default:
+				return e, transform.SameTree, nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return masks, nil
+}
@@ -76,6 +76,18 @@ func expandStars(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, s
 				return n, transform.SameTree, nil
 			}
 			return plan.NewWindow(expanded, n.Child), transform.NewTree, nil
+		case *plan.Returning:
+			if !n.Target().Resolved() {
+				return n, transform.SameTree, nil
+			}
+			expanded, same, err := expandStarsForExpressions(a, n.Projections, n.Target(), scopeLen)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if same {
+				return n, transform.SameTree, nil
+			}
+			return plan.NewReturning(expanded, n.Child), transform.NewTree, nil
 		default:
 			return n, transform.SameTree, nil
 		}
@@ -94,6 +106,10 @@ func expandStarsForExpressions(a *Analyzer, exprs []sql.Expression, n sql.Node,
 			same = transform.NewTree
 			var exprs []sql.Expression
 			for i, col := range schema {
+				// Invisible columns are only returned when they are named explicitly
+				if col.Invisible {
+					continue
+				}
 				lowerSource := strings.ToLower(col.Source)
 				lowerTable := strings.ToLower(star.Table)
 				if star.Table == "" || lowerTable == lowerSource {
@@ -13,11 +13,48 @@ import (
 // indexes.
 func fixupAuxiliaryExprs(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
-		switch n.(type) {
+		switch n := n.(type) {
 		case *plan.Sort, *plan.Project:
 			return fixidx.FixFieldIndexesForExpressions(a.LogFn(), n, scope)
+		case sql.TableFunction:
+			return fixupTableFunctionExprs(a, n, nil, scope)
+		case *plan.JoinNode:
+			if !n.JoinType().IsLateral() {
+				return n, transform.SameTree, nil
+			}
+			right, same, err := fixupTableFunctionExprs(a, n.Right(), n.Left().Schema(), scope)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			ret, err := n.WithChildren(n.Left(), right)
+			return ret, transform.NewTree, err
 		default:
 			return n, transform.SameTree, nil
 		}
 	})
 }
+
+// fixupTableFunctionExprs fixes the field indexes of the arguments of the table function |n|, which can reference the
+// columns of the |schema| of the left side of a lateral join or of the outer scopes. Arguments that reference columns
+// not found are left for a later pass with the schema of its lateral join.
+func fixupTableFunctionExprs(a *Analyzer, n sql.Node, schema sql.Schema, scope *plan.Scope) (sql.Node, transform.TreeIdentity, error) {
+	switch n := n.(type) {
+	case *plan.TableAlias:
+		child, same, err := fixupTableFunctionExprs(a, n.Child, schema, scope)
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		ret, err := n.WithChildren(child)
+		return ret, transform.NewTree, err
+	case sql.TableFunction:
+		return transform.OneNodeExprsWithNode(n, func(_ sql.Node, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			fixed, same, err := fixidx.FixFieldIndexes(scope, a.LogFn(), schema, e)
+			if fixidx.ErrFieldMissing.Is(err) {
+				return e, transform.SameTree, nil
+			}
+			return fixed, same, err
+		})
+	default:
+		return n, transform.SameTree, nil
+	}
+}
@@ -1,11 +1,14 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/fixidx"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // generateIndexScans generates indexscan alternatives for sql.IndexAddressableTable
@@ -116,6 +119,13 @@ func convertFiltersToIndexedAccess(
 			filtersByTable := getFiltersByTable(n)
 			filters := newFilterSet(n.Expression, filtersByTable, tableAliases)
 			filters.markFiltersHandled(handled...)
+			child, same, err := pushdownIndexConditions(a, n, filters, indexes, tableAliases)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if !same {
+				n = plan.NewFilter(n.Expression, child)
+			}
 			newF := removePushedDownPredicates(ctx, a, n, filters)
 			if newF == nil {
 				return fixidx.FixFieldIndexesForExpressions(a.LogFn(), n, scope)
@@ -194,6 +204,152 @@ func pushdownIndexesToTable(scope *plan.Scope, a *Analyzer, tableNode sql.Nameab
 	return ret, same, err, lookup
 }
 
+// pushdownIndexConditions pushes the predicates of |filter| that reference only the columns of the index of a static
+// index lookup on a table below it, and not only the columns the ranges of the lookup were built from, to that table
+// when it implements sql.IndexConditionPushdownTable, so that rows that don't match them are skipped during the lookup.
+// Pushed predicates are marked handled in |filters|.
+func pushdownIndexConditions(a *Analyzer, filter *plan.Filter, filters *filterSet, indexes indexLookupsByTable, tableAliases TableAliases) (sql.Node, transform.TreeIdentity, error) {
+	predicatesByTable := exprToTableFilters(filter.Expression)
+	if len(predicatesByTable) == 0 {
+		return filter.Child, transform.SameTree, nil
+	}
+
+	// Only tables whose rows are all filtered by the predicates can evaluate them during their lookups
+	selector := func(c transform.Context) bool {
+		switch n := c.Node.(type) {
+		case *plan.JoinNode:
+			if n.Op.IsPartial() || n.Op.IsFullOuter() {
+				return false
+			}
+		case *plan.TableAlias, *plan.IndexedTableAccess:
+		default:
+			return false
+		}
+
+		if j, ok := c.Parent.(*plan.JoinNode); ok && j.Op.IsLeftOuter() {
+			return c.ChildNum == 0
+		}
+		return true
+	}
+
+	return transform.NodeWithCtx(filter.Child, selector, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch n := c.Node.(type) {
+		case *plan.IndexedTableAccess:
+			if _, ok := c.Parent.(*plan.TableAlias); ok {
+				return n, transform.SameTree, nil
+			}
+			return pushdownIndexConditionsToTable(a, n, predicatesByTable[n.Name()], indexes[n.Name()], filters, tableAliases)
+		case *plan.TableAlias:
+			ita, ok := n.Child.(*plan.IndexedTableAccess)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+			newIta, same, err := pushdownIndexConditionsToTable(a, ita, predicatesByTable[n.Name()], indexes[n.Name()], filters, tableAliases)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			ret, err := n.WithChildren(newIta)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return ret, transform.NewTree, nil
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// pushdownIndexConditionsToTable pushes the |predicates| on the table of |ita| that reference only columns of its
+// index, and not only the columns of its |lookup|, to the table if it supports it.
+func pushdownIndexConditionsToTable(a *Analyzer, ita *plan.IndexedTableAccess, predicates []sql.Expression, lookup *indexLookup, filters *filterSet, tableAliases TableAliases) (sql.Node, transform.TreeIdentity, error) {
+	ict, ok := ita.Table.(sql.IndexConditionPushdownTable)
+	if !ok || !ita.IsStatic() {
+		return ita, transform.SameTree, nil
+	}
+
+	index := ita.Index()
+	indexColumns := make(map[string]bool)
+	for _, e := range index.Expressions() {
+		indexColumns[strings.ToLower(e)] = true
+	}
+
+	// Predicates on only the columns the ranges of the lookup were built from are mostly enforced by the ranges already
+	rangeColumns := make(map[string]bool)
+	if lookup != nil {
+		rangeExprs := lookup.fields
+		if lookup.expr != nil {
+			rangeExprs = append(rangeExprs[:len(rangeExprs):len(rangeExprs)], lookup.expr)
+		}
+		for _, f := range normalizeExpressions(tableAliases, rangeExprs...) {
+			sql.Inspect(f, func(e sql.Expression) bool {
+				if gf, ok := e.(*expression.GetField); ok {
+					rangeColumns[strings.ToLower(gf.Table()+"."+gf.Name())] = true
+				}
+				return true
+			})
+		}
+	}
+
+	predicates = subtractExprSet(predicates, filters.handledFilters)
+	var candidates, originals []sql.Expression
+	for i, e := range normalizeExpressions(tableAliases, predicates...) {
+		if isIndexCondition(e, indexColumns) && !isIndexCondition(e, rangeColumns) {
+			candidates = append(candidates, e)
+			originals = append(originals, predicates[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return ita, transform.SameTree, nil
+	}
+
+	handled := ict.HandledIndexConditions(index, candidates)
+	var conditions, handledOriginals []sql.Expression
+	for i, e := range candidates {
+		if len(subtractExprSet([]sql.Expression{e}, handled)) == 0 {
+			conditions = append(conditions, e)
+			handledOriginals = append(handledOriginals, originals[i])
+		}
+	}
+	if len(conditions) == 0 {
+		return ita, transform.SameTree, nil
+	}
+
+	conditions, _, err := fixidx.FixFieldIndexesOnExpressions(nil, a.LogFn(), ita.ResolvedTable.Schema(), conditions...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	ret, err := ita.WithIndexConditions(append(ict.IndexConditions(), conditions...))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	a.Log("table %q transformed with pushdown of %d index conditions to index %s", ita.Name(), len(conditions), index.ID())
+	filters.markFiltersHandled(handledOriginals...)
+	return ret, transform.NewTree, nil
+}
+
+// isIndexCondition returns whether |e| can be evaluated by a table on the rows of an index lookup, which requires all
+// the columns it references to be in |columns|, which are lower case qualified column names. Expressions that need
+// the scope of the query, like subqueries and bind variables, or that must be validated with the rest of the plan,
+// like tuples, aren't.
+func isIndexCondition(e sql.Expression, columns map[string]bool) bool {
+	ok := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			ok = columns[strings.ToLower(e.Table()+"."+e.Name())]
+		case *plan.Subquery, *expression.BindVar:
+			ok = false
+		default:
+			if e != nil && types.NumColumns(e.Type()) != 1 {
+				ok = false
+			}
+		}
+		return ok
+	})
+	return ok
+}
+
 // pushdownFiltersToTable attempts to push down filters to indexes that can accept them.
 func getPredicateExprsHandledByLookup(ctx *sql.Context, a *Analyzer, name string, lookup *indexLookup, tableAliases TableAliases) ([]sql.Expression, error) {
 	filteredIdx, ok := lookup.lookup.Index.(sql.FilteredIndex)
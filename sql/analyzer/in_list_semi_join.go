@@ -0,0 +1,145 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// convertInListsToSemiJoins replaces the IN predicates of Filter nodes over tables whose lists of constants are longer
+// than the session's in_list_semi_join_threshold with hash semi joins of the tables and the values of the lists.
+// Shorter lists are left to be converted into index lookups of one range per value by generateIndexScans, which runs
+// after this rule. A threshold of 0 disables the conversion.
+func convertInListsToSemiJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	// the rows of the semi joins would be prefixed with the rows of outer scopes
+	if !scope.IsEmpty() {
+		return n, transform.SameTree, nil
+	}
+	val, err := ctx.GetSessionVariable(ctx, "in_list_semi_join_threshold")
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	threshold, ok := val.(uint64)
+	if !ok || threshold == 0 {
+		return n, transform.SameTree, nil
+	}
+
+	selector := func(c transform.Context) bool {
+		switch n := c.Node.(type) {
+		case *plan.JoinNode, *plan.AdaptiveJoin, *plan.RecursiveCte, *plan.Update, *plan.DeleteFrom:
+			// joins pass the rows of their left children to their right children, and DML with joins changes meaning
+			return false
+		case *plan.SubqueryAlias:
+			return !n.OuterScopeVisibility
+		}
+		return true
+	}
+	return transform.NodeWithCtx(n, selector, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := c.Node.(*plan.Filter)
+		if !ok {
+			return c.Node, transform.SameTree, nil
+		}
+		switch filter.Child.(type) {
+		case *plan.ResolvedTable, *plan.TableAlias:
+		default:
+			return filter, transform.SameTree, nil
+		}
+
+		var rest []sql.Expression
+		var lists []*expression.InTuple
+		for _, e := range expression.SplitConjunction(filter.Expression) {
+			if in, ok := e.(*expression.InTuple); ok && isSemiJoinableInList(in, threshold) {
+				lists = append(lists, in)
+			} else {
+				rest = append(rest, e)
+			}
+		}
+		if len(lists) == 0 {
+			return filter, transform.SameTree, nil
+		}
+
+		// generateIndexScans doesn't look into semi joins, so the remaining predicates are converted into index lookups
+		// here
+		var ret sql.Node = filter.Child
+		if len(rest) > 0 {
+			ret, _, err = generateIndexScans(ctx, a, plan.NewFilter(expression.JoinAnd(rest...), filter.Child), scope, sel)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		for _, in := range lists {
+			ret = newInListSemiJoin(ret, in.Left().(*expression.GetField), in.Right().(expression.Tuple))
+		}
+		a.Log("converted %d IN lists to semi joins", len(lists))
+		return ret, transform.NewTree, nil
+	})
+}
+
+// isSemiJoinableInList returns whether the IN given is of an integer column and a list of more than |threshold| numeric
+// constants. Integers are exactly represented as decimals, and their values are comparable as the keys of hash lookups.
+func isSemiJoinableInList(in *expression.InTuple, threshold uint64) bool {
+	col, ok := in.Left().(*expression.GetField)
+	if !ok || !types.IsInteger(col.Type()) {
+		return false
+	}
+	tuple, ok := in.Right().(expression.Tuple)
+	if !ok || uint64(len(tuple)) <= threshold {
+		return false
+	}
+	for _, e := range tuple {
+		lit, ok := e.(*expression.Literal)
+		if !ok || (lit.Value() != nil && !types.IsNumber(lit.Type())) {
+			return false
+		}
+	}
+	return true
+}
+
+// newInListSemiJoin returns a hash semi join of |left| and a Values node of |values| on their equality with |col|.
+// NULL values, and values out of the range of the column, are left out, since they match no row. The values are
+// converted to decimals, so that they're compared with the column exactly. The keys of the hash lookup are converted to
+// the type of the column, which may round them, but rows matching rounded values fail the join condition.
+func newInListSemiJoin(left sql.Node, col *expression.GetField, values expression.Tuple) sql.Node {
+	var rows [][]sql.Expression
+	for _, v := range values {
+		val := v.(*expression.Literal).Value()
+		if val == nil {
+			continue
+		}
+		if _, inRange, err := col.Type().Convert(val); err != nil || inRange != sql.InRange {
+			continue
+		}
+		dec, _, err := types.InternalDecimalType.Convert(val)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, []sql.Expression{expression.NewLiteral(dec, types.InternalDecimalType)})
+	}
+	if len(rows) == 0 {
+		return plan.NewEmptyTableWithSchema(left.Schema())
+	}
+
+	right := plan.NewValues(rows)
+	valueCol := right.Schema()[0]
+	leftProbeKey := expression.Tuple{col}
+	rightEntryKey := expression.Tuple{expression.NewGetField(0, valueCol.Type, "value", false)}
+	cond := expression.NewEquals(col, expression.NewGetField(len(left.Schema()), valueCol.Type, "value", false))
+	lookup := plan.NewHashLookup(plan.NewCachedResults(right), rightEntryKey, leftProbeKey)
+	return plan.NewJoin(left, lookup, plan.JoinTypeSemiHash, cond)
+}
@@ -71,6 +71,10 @@ func optimizeJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 			if sqa, ok := n.Right().(*plan.SubqueryAlias); ok && sqa.IsLateral {
 				reorder = false
 			}
+			if n.JoinType().IsLateral() {
+				// the right side of a lateral join can reference the left side, like table function arguments
+				reorder = false
+			}
 		default:
 		}
 		return n, transform.SameTree, nil
@@ -623,20 +627,22 @@ func addHashJoins(m *memo.Memo) error {
 
 		var fromExpr, toExpr []*memo.ExprGroup
 		for _, f := range join.Filter {
-			switch f := f.(type) {
-			case *memo.Equal:
-				if satisfiesScalarRefs(f.Left.Scalar, join.Left) &&
-					satisfiesScalarRefs(f.Right.Scalar, join.Right) {
-					fromExpr = append(fromExpr, f.Right)
-					toExpr = append(toExpr, f.Left)
-				} else if satisfiesScalarRefs(f.Right.Scalar, join.Left) &&
-					satisfiesScalarRefs(f.Left.Scalar, join.Right) {
-					fromExpr = append(fromExpr, f.Left)
-					toExpr = append(toExpr, f.Right)
-				} else {
-					return nil
-				}
-			default:
+			// NULL keys are hashed like any other value, so they match for
+			// null-safe equalities, and are rejected by the join filter
+			// otherwise
+			l, r, ok := equalityOperands(f)
+			if !ok {
+				return nil
+			}
+			if satisfiesScalarRefs(l.Scalar, join.Left) &&
+				satisfiesScalarRefs(r.Scalar, join.Right) {
+				fromExpr = append(fromExpr, r)
+				toExpr = append(toExpr, l)
+			} else if satisfiesScalarRefs(r.Scalar, join.Left) &&
+				satisfiesScalarRefs(l.Scalar, join.Right) {
+				fromExpr = append(fromExpr, l)
+				toExpr = append(toExpr, r)
+			} else {
 				return nil
 			}
 		}
@@ -651,6 +657,19 @@ func addHashJoins(m *memo.Memo) error {
 	})
 }
 
+// equalityOperands returns the operands of |e| if it's an equality or a
+// null-safe equality.
+func equalityOperands(e memo.ScalarExpr) (*memo.ExprGroup, *memo.ExprGroup, bool) {
+	switch e := e.(type) {
+	case *memo.Equal:
+		return e.Left, e.Right, true
+	case *memo.NullSafeEq:
+		return e.Left, e.Right, true
+	default:
+		return nil, nil, false
+	}
+}
+
 // satisfiesScalarRefs returns true if all GetFields in the expression
 // are columns provided by |grp|
 func satisfiesScalarRefs(e memo.ScalarExpr, grp *memo.ExprGroup) bool {
@@ -688,12 +707,10 @@ func addMergeJoins(m *memo.Memo) error {
 		}
 
 		for i, f := range join.Filter {
-			var l, r *memo.ExprGroup
-			switch f := f.(type) {
-			case *memo.Equal:
-				l = f.Left
-				r = f.Right
-			default:
+			// NULLs are sorted first by indexes, and compared as the
+			// smallest values by null-safe equalities
+			l, r, ok := equalityOperands(f)
+			if !ok {
 				continue
 			}
 
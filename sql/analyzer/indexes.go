@@ -16,6 +16,8 @@ package analyzer
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -28,11 +30,9 @@ import (
 // in it.
 type indexLookup struct {
 	fields  []sql.Expression
-	fields2 []sql.Expression2
 	lookup  sql.IndexLookup
 	indexes []sql.Index
 	expr    sql.Expression
-	expr2   sql.Expression2
 }
 
 type indexLookupsByTable map[string]*indexLookup
@@ -56,6 +56,15 @@ func getIndexes(
 	tableAliases TableAliases,
 ) (indexLookupsByTable, error) {
 	var result = make(indexLookupsByTable)
+	if cols, conds, ok := tupleComparisonConditions(e); ok {
+		lookup, err := getTupleComparisonIndexLookup(ctx, ia, e, cols, conds, tableAliases)
+		if err != nil || lookup == nil {
+			return result, err
+		}
+		result[cols[0].(*expression.GetField).Table()] = lookup
+		return result, nil
+	}
+
 	switch e := e.(type) {
 	case *expression.Or:
 		// If more than one table is involved in a disjunction, we can't use indexed lookups. This is because we will
@@ -282,6 +291,25 @@ func getIndexes(
 			}
 		}
 
+		// ORs are only matched on their own above, so for multi-column indexes only the columns they're on are used. The
+		// disjunctive normal form of the conjunction matches each combination of their alternatives with the other
+		// predicates, e.g. (a = 1 OR a = 2) AND b = 3 as (a = 1 AND b = 3) OR (a = 2 AND b = 3), which use all the
+		// columns of an index on (a, b). Its lookup replaces the one above of the same index if it handles as many
+		// predicates.
+		if disjuncts := disjunctiveNormalForm(exprs); disjuncts != nil && len(findTables(e)) == 1 {
+			table, idx, err := getDisjunctiveNormalFormIndex(ctx, ia, exprs, disjuncts, tableAliases)
+			if err != nil {
+				return nil, err
+			}
+			if idx != nil {
+				cur, ok := result[table]
+				if !ok || (cur.lookup.Index.ID() == idx.lookup.Index.ID() &&
+					len(expression.SplitConjunction(idx.expr)) >= len(expression.SplitConjunction(cur.expr))) {
+					result[table] = idx
+				}
+			}
+		}
+
 		for name, idx := range result {
 			newRanges, err := sql.RemoveOverlappingRanges(idx.lookup.Ranges...)
 			if err != nil {
@@ -362,6 +390,199 @@ func getIndexes(
 	return result, nil
 }
 
+// tupleComparisonConditions returns the columns of a comparison of a tuple of columns with a tuple of constants, or of
+// a tuple of columns IN a list of such tuples, along with the equivalent conditions on the individual columns, as a
+// disjunction of conjunctions. (a, b) > (1, 2) is equivalent to a > 1 OR (a = 1 AND b > 2), and
+// (a, b) IN ((1, 2), (3, 4)) to (a = 1 AND b = 2) OR (a = 3 AND b = 4). Returns false if |e| isn't such a comparison.
+func tupleComparisonConditions(e sql.Expression) (expression.Tuple, [][]expression.Comparer, bool) {
+	cmp, ok := e.(expression.Comparer)
+	if !ok {
+		return nil, nil, false
+	}
+
+	switch cmp.(type) {
+	case *expression.InTuple, *expression.HashInTuple:
+		cols, ok := columnTuple(cmp.Left())
+		if !ok || !isEvaluable(cmp.Right()) {
+			return nil, nil, false
+		}
+		list, ok := cmp.Right().(expression.Tuple)
+		if !ok {
+			return nil, nil, false
+		}
+		disjuncts := make([][]expression.Comparer, len(list))
+		for i, el := range list {
+			vals, ok := valueTuple(el, len(cols))
+			if !ok {
+				return nil, nil, false
+			}
+			for j := range cols {
+				disjuncts[i] = append(disjuncts[i], expression.NewEquals(cols[j], vals[j]))
+			}
+		}
+		return cols, disjuncts, true
+	case *expression.Equals, *expression.NullSafeEquals, *expression.GreaterThan, *expression.GreaterThanOrEqual,
+		*expression.LessThan, *expression.LessThanOrEqual:
+		if !isEvaluable(cmp.Right()) {
+			_, _, cmp = swapTermsOfExpression(cmp)
+		}
+		cols, ok := columnTuple(cmp.Left())
+		if !ok || !isEvaluable(cmp.Right()) {
+			return nil, nil, false
+		}
+		vals, ok := valueTuple(cmp.Right(), len(cols))
+		if !ok {
+			return nil, nil, false
+		}
+
+		switch cmp.(type) {
+		case *expression.Equals, *expression.NullSafeEquals:
+			conjuncts := make([]expression.Comparer, len(cols))
+			for i := range cols {
+				elem, _ := cmp.WithChildren(cols[i], vals[i])
+				conjuncts[i] = elem.(expression.Comparer)
+			}
+			return cols, [][]expression.Comparer{conjuncts}, true
+		default:
+			// the first pair of elements that differ decides the order of the tuples, and the last one does if the
+			// others are equal
+			disjuncts := make([][]expression.Comparer, len(cols))
+			for i := range cols {
+				for j := 0; j < i; j++ {
+					disjuncts[i] = append(disjuncts[i], expression.NewEquals(cols[j], vals[j]))
+				}
+				last := cmp
+				if i < len(cols)-1 {
+					last = strictComparison(cmp)
+				}
+				elem, _ := last.WithChildren(cols[i], vals[i])
+				disjuncts[i] = append(disjuncts[i], elem.(expression.Comparer))
+			}
+			return cols, disjuncts, true
+		}
+	default:
+		return nil, nil, false
+	}
+}
+
+// getTupleComparisonIndexLookup returns the lookup of the tuple comparison |e| on an index with all of its columns
+// |cols|, whose ranges are built from the conditions on the columns returned by tupleComparisonConditions.
+func getTupleComparisonIndexLookup(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	e sql.Expression,
+	cols expression.Tuple,
+	disjuncts [][]expression.Comparer,
+	tableAliases TableAliases,
+) (*indexLookup, error) {
+	colExprs := normalizeExpressions(tableAliases, cols...)
+	idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), cols[0].(*expression.GetField).Table(), colExprs...)
+	if idx == nil {
+		return nil, nil
+	}
+	// the ranges are only exact when the index has all the columns
+	indexExprs := make(map[string]bool)
+	for _, ie := range idx.Expressions() {
+		indexExprs[strings.ToLower(ie)] = true
+	}
+	colNames := make(map[sql.Expression]string)
+	for i, col := range colExprs {
+		if !indexExprs[strings.ToLower(col.String())] {
+			return nil, nil
+		}
+		colNames[cols[i]] = col.String()
+	}
+
+	var ranges sql.RangeCollection
+	for _, conjuncts := range disjuncts {
+		b := sql.NewIndexBuilder(idx)
+		for _, c := range conjuncts {
+			col := colNames[c.Left()]
+			val, err := c.Right().Eval(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			switch c.(type) {
+			case *expression.NullSafeEquals:
+				if val == nil {
+					b = b.IsNull(ctx, col)
+				} else {
+					b = b.Equals(ctx, col, val)
+				}
+			case *expression.Equals:
+				b = b.Equals(ctx, col, val)
+			case *expression.GreaterThan:
+				b = b.GreaterThan(ctx, col, val)
+			case *expression.GreaterThanOrEqual:
+				b = b.GreaterOrEqual(ctx, col, val)
+			case *expression.LessThan:
+				b = b.LessThan(ctx, col, val)
+			case *expression.LessThanOrEqual:
+				b = b.LessOrEqual(ctx, col, val)
+			}
+		}
+		lookup, err := b.Build(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, lookup.Ranges...)
+	}
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	ranges, err := sql.RemoveOverlappingRanges(ranges...)
+	if err != nil {
+		return nil, nil
+	}
+
+	return &indexLookup{
+		fields:  cols,
+		lookup:  sql.IndexLookup{Index: idx, Ranges: ranges},
+		indexes: []sql.Index{idx},
+		expr:    e,
+	}, nil
+}
+
+// columnTuple returns the elements of |e| if it's a tuple of more than one column.
+func columnTuple(e sql.Expression) (expression.Tuple, bool) {
+	t, ok := e.(expression.Tuple)
+	if !ok || len(t) < 2 {
+		return nil, false
+	}
+	for _, el := range t {
+		if _, ok := el.(*expression.GetField); !ok {
+			return nil, false
+		}
+	}
+	return t, true
+}
+
+// valueTuple returns the elements of |e| if it's a tuple of |n| values that aren't tuples.
+func valueTuple(e sql.Expression, n int) (expression.Tuple, bool) {
+	t, ok := e.(expression.Tuple)
+	if !ok || len(t) != n {
+		return nil, false
+	}
+	for _, el := range t {
+		if types.IsTuple(el.Type()) {
+			return nil, false
+		}
+	}
+	return t, true
+}
+
+// strictComparison returns the comparison that excludes equal values for an inclusive one, like > for >=.
+func strictComparison(cmp expression.Comparer) expression.Comparer {
+	switch cmp.(type) {
+	case *expression.GreaterThanOrEqual:
+		return expression.NewGreaterThan(cmp.Left(), cmp.Right())
+	case *expression.LessThanOrEqual:
+		return expression.NewLessThan(cmp.Left(), cmp.Right())
+	default:
+		return cmp
+	}
+}
+
 // getComparisonIndexLookup returns the index and index lookup for the given
 // comparison if any index can be found.
 // It works for the following comparisons: eq, lt, gt, gte and lte.
@@ -426,20 +647,11 @@ func getComparisonIndexLookup(
 		return nil, err
 	}
 
-	var fields2 []sql.Expression2
-	if field2, ok := left.(sql.Expression2); ok {
-		fields2 = []sql.Expression2{field2}
-	}
-
-	expr2, _ := e.(sql.Expression2)
-
 	return &indexLookup{
 		fields:  []sql.Expression{left},
-		fields2: fields2,
 		lookup:  lookup,
 		indexes: []sql.Index{idx},
 		expr:    e,
-		expr2:   expr2,
 	}, nil
 }
 
@@ -1182,3 +1394,114 @@ func canMergeIndexes(a, b sql.IndexLookup) bool {
 	}
 	return true
 }
+
+// maxDisjuncts is the maximum number of disjuncts of the disjunctive normal forms of conjunctions built for index
+// lookups.
+const maxDisjuncts = 32
+
+// disjunctiveNormalForm returns the disjunctive normal form of the conjunction of the expressions given, as the
+// alternatives of each of the expressions chosen by each disjunct, or nil if none of the expressions is an OR or the
+// form has more than maxDisjuncts disjuncts.
+func disjunctiveNormalForm(conjuncts []sql.Expression) [][]sql.Expression {
+	hasOr := false
+	disjuncts := [][]sql.Expression{nil}
+	for _, c := range conjuncts {
+		alternatives := expression.SplitDisjunction(c)
+		if len(alternatives) > 1 {
+			hasOr = true
+		}
+		for _, alt := range alternatives {
+			// tuples are invalid as predicates, and can't be the keys of the sets of expressions matched to indexes
+			if _, ok := alt.(expression.Tuple); ok {
+				return nil
+			}
+		}
+		if len(disjuncts)*len(alternatives) > maxDisjuncts {
+			return nil
+		}
+		var next [][]sql.Expression
+		for _, d := range disjuncts {
+			for _, alt := range alternatives {
+				next = append(next, append(d[:len(d):len(d)], alt))
+			}
+		}
+		disjuncts = next
+	}
+	if !hasOr {
+		return nil
+	}
+	return disjuncts
+}
+
+// getDisjunctiveNormalFormIndex returns the index lookup of the union of the ranges of the lookups of the |disjuncts|
+// of the disjunctive normal form of |conjuncts|, or nil if any of them has no lookup, or they can't be merged. The
+// expression of the lookup is the conjunction of the |conjuncts| whose alternatives are handled by the lookups of all
+// the disjuncts, which are enforced by the union of the ranges.
+func getDisjunctiveNormalFormIndex(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	conjuncts []sql.Expression,
+	disjuncts [][]sql.Expression,
+	tableAliases TableAliases,
+) (string, *indexLookup, error) {
+	var table string
+	var result *indexLookup
+	handled := make([]bool, len(conjuncts))
+	for i := range handled {
+		handled[i] = true
+	}
+
+	for _, d := range disjuncts {
+		indexes, err := getIndexes(ctx, ia, expression.JoinAnd(d...), tableAliases)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(indexes) != 1 {
+			return "", nil, nil
+		}
+		for t, idx := range indexes {
+			if result == nil {
+				table = t
+				result = &indexLookup{
+					fields:  idx.fields,
+					indexes: idx.indexes,
+					lookup:  sql.IndexLookup{Index: idx.lookup.Index, Ranges: idx.lookup.Ranges},
+				}
+			} else if t != table || !canMergeIndexes(result.lookup, idx.lookup) {
+				return "", nil, nil
+			} else {
+				result.lookup.Ranges = append(result.lookup.Ranges[:len(result.lookup.Ranges):len(result.lookup.Ranges)], idx.lookup.Ranges...)
+				result.indexes = append(result.indexes, idx.indexes...)
+			}
+
+			idxExprs := expression.SplitConjunction(idx.expr)
+			for i, alt := range d {
+				for _, part := range expression.SplitConjunction(alt) {
+					if !containsExpr(idxExprs, part) {
+						handled[i] = false
+						break
+					}
+				}
+			}
+		}
+	}
+
+	var exprs []sql.Expression
+	for i, c := range conjuncts {
+		if handled[i] {
+			exprs = append(exprs, c)
+		}
+	}
+	result.expr = expression.JoinAnd(exprs...)
+	return table, result, nil
+}
+
+// containsExpr returns whether |exprs| contains an expression equal to |e|.
+func containsExpr(exprs []sql.Expression, e sql.Expression) bool {
+	for _, x := range exprs {
+		if reflect.DeepEqual(x, e) {
+			return true
+		}
+	}
+	return false
+}
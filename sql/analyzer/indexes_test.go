@@ -0,0 +1,80 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDisjunctiveNormalForm(t *testing.T) {
+	a := col(0, "t", "a")
+	b := col(1, "t", "b")
+	c := col(2, "t", "c")
+
+	testCases := []struct {
+		name      string
+		conjuncts []sql.Expression
+		expected  [][]sql.Expression
+	}{
+		{
+			name:      "no ors",
+			conjuncts: []sql.Expression{eq(a, lit(1)), eq(b, lit(2))},
+		},
+		{
+			name:      "one or",
+			conjuncts: []sql.Expression{or(eq(a, lit(1)), eq(a, lit(5))), eq(b, lit(2))},
+			expected: [][]sql.Expression{
+				{eq(a, lit(1)), eq(b, lit(2))},
+				{eq(a, lit(5)), eq(b, lit(2))},
+			},
+		},
+		{
+			name:      "two ors",
+			conjuncts: []sql.Expression{or(eq(a, lit(1)), eq(a, lit(5))), or(eq(b, lit(2)), eq(b, lit(3)))},
+			expected: [][]sql.Expression{
+				{eq(a, lit(1)), eq(b, lit(2))},
+				{eq(a, lit(1)), eq(b, lit(3))},
+				{eq(a, lit(5)), eq(b, lit(2))},
+				{eq(a, lit(5)), eq(b, lit(3))},
+			},
+		},
+		{
+			name:      "nested and",
+			conjuncts: []sql.Expression{or(and(eq(a, lit(1)), eq(c, lit(3))), eq(a, lit(5))), eq(b, lit(2))},
+			expected: [][]sql.Expression{
+				{and(eq(a, lit(1)), eq(c, lit(3))), eq(b, lit(2))},
+				{eq(a, lit(5)), eq(b, lit(2))},
+			},
+		},
+		{
+			name: "too many disjuncts",
+			conjuncts: []sql.Expression{
+				or(or(eq(a, lit(1)), eq(a, lit(2))), or(eq(a, lit(3)), eq(a, lit(4)))),
+				or(or(eq(b, lit(1)), eq(b, lit(2))), or(eq(b, lit(3)), eq(b, lit(4)))),
+				or(or(eq(c, lit(1)), eq(c, lit(2))), or(eq(c, lit(3)), eq(c, lit(4)))),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, disjunctiveNormalForm(tt.conjuncts))
+		})
+	}
+}
@@ -43,11 +43,7 @@ func setInsertColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sco
 		// them all in now that the destination is resolved.
 		// TODO: setting the plan field directly is not great
 		if len(ii.ColumnNames) == 0 {
-			colNames := make([]string, len(schema))
-			for i, col := range schema {
-				colNames[i] = col.Name
-			}
-			ii.ColumnNames = colNames
+			ii.ColumnNames = visibleColumnNames(schema)
 		}
 
 		return ii, transform.NewTree, nil
@@ -112,10 +108,7 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 
 		// If no columns are given and value tuples are not all empty, use the full schema
 		if len(columnNames) == 0 && existsNonZeroValueCount(source) {
-			columnNames = make([]string, len(dstSchema))
-			for i, f := range dstSchema {
-				columnNames[i] = f.Name
-			}
+			columnNames = visibleColumnNames(dstSchema)
 		} else {
 			err = validateColumns(columnNames, dstSchema)
 			if err != nil {
@@ -306,3 +299,15 @@ func validateRowSource(values sql.Node, projExprs []sql.Expression) error {
 		return assertCompatibleSchemas(projExprs, n.Schema())
 	}
 }
+
+// visibleColumnNames returns the names of the columns of the schema given that aren't invisible, which are the columns
+// an INSERT without a column list provides values for.
+func visibleColumnNames(schema sql.Schema) []string {
+	colNames := make([]string, 0, len(schema))
+	for _, col := range schema {
+		if !col.Invisible {
+			colNames = append(colNames, col.Name)
+		}
+	}
+	return colNames
+}
@@ -113,6 +113,10 @@ func loadTriggersFromDb(ctx *sql.Context, db sql.Database) ([]*plan.CreateTrigge
 				return nil, sql.ErrTriggerCreateStatementInvalid.New(trigger.CreateStatement)
 			}
 			triggerPlan.CreatedAt = trigger.CreatedAt // use the stored created time
+			// a statement without a DEFINER clause is parsed with the user of the session as the definer
+			if trigger.Definer != "" {
+				triggerPlan.Definer = trigger.Definer
+			}
 			loadedTriggers = append(loadedTriggers, triggerPlan)
 		}
 	}
@@ -0,0 +1,399 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/fixidx"
+	"github.com/dolthub/go-mysql-server/sql/memo"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// mergeDerivedTables replaces the derived tables and views of a query with the tables they read, when their queries
+// only project, filter and join tables, so that these tables are joined with the other tables of the query and looked
+// up with their indexes, rather than materialized. The columns of the derived tables are replaced with the expressions
+// they project. Derived tables named by a NO_MERGE hint of the query, or all of them for a NO_MERGE hint without
+// arguments, aren't merged, and neither are views with the TEMPTABLE algorithm.
+func mergeDerivedTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	// the columns of outer scopes are prefixed to the rows of subqueries
+	if !scope.IsEmpty() {
+		return n, transform.SameTree, nil
+	}
+
+	same := transform.SameTree
+	for {
+		sqa := findMergeableDerivedTable(a, n)
+		if sqa == nil {
+			return n, same, nil
+		}
+		var err error
+		n, err = mergeDerivedTable(a, n, sqa, scope)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		a.Log("merged derived table %s", sqa.Name())
+		same = transform.NewTree
+	}
+}
+
+// queryBlock is the tree of nodes of a query up to its derived tables.
+type queryBlock struct {
+	// tables are the lower case names of the tables and derived tables of the block
+	tables map[string]int
+	// derived are the derived tables of the block, and whether they're on the NULL-extended side of outer joins
+	derived  []*plan.SubqueryAlias
+	nullable map[*plan.SubqueryAlias]bool
+	// preserved are the derived tables that are the left nodes of left joins, whose filters can't be join conditions
+	preserved map[*plan.SubqueryAlias]bool
+	noMerge   map[string]bool
+	// noMergeAll is whether a NO_MERGE hint without arguments was given
+	noMergeAll bool
+}
+
+// newQueryBlock returns the query block of the node given, and false if it has nodes whose derived tables can't be
+// merged, like aggregations, whose expressions are referenced by name above them, or subquery expressions that can read
+// the columns of derived tables.
+func newQueryBlock(n sql.Node) (*queryBlock, bool) {
+	b := &queryBlock{
+		tables:    make(map[string]int),
+		nullable:  make(map[*plan.SubqueryAlias]bool),
+		preserved: make(map[*plan.SubqueryAlias]bool),
+		noMerge:   make(map[string]bool),
+	}
+	if !b.add(n, false) {
+		return nil, false
+	}
+	return b, true
+}
+
+func (b *queryBlock) add(n sql.Node, nullable bool) bool {
+	if cn, ok := n.(sql.CommentedNode); ok && cn.Comment() != "" {
+		for _, h := range memo.ExtractQueryHints(cn) {
+			if h.Typ != memo.HintTypeNoMerge {
+				continue
+			}
+			b.noMergeAll = b.noMergeAll || len(h.Args) == 0
+			for _, arg := range h.Args {
+				b.noMerge[arg] = true
+			}
+		}
+	}
+	if e, ok := n.(sql.Expressioner); ok {
+		for _, e := range e.Expressions() {
+			if containsSubquery(e) {
+				return false
+			}
+		}
+	}
+
+	switch n := n.(type) {
+	case *plan.SubqueryAlias:
+		b.tables[strings.ToLower(n.Name())]++
+		b.derived = append(b.derived, n)
+		b.nullable[n] = nullable
+		return true
+	case *plan.TableAlias:
+		b.tables[strings.ToLower(n.Name())]++
+		return true
+	case *plan.ResolvedTable:
+		b.tables[strings.ToLower(n.Name())]++
+		return true
+	case *plan.JoinNode:
+		switch {
+		case n.Op.IsInner():
+		case n.Op == plan.JoinTypeLeftOuter:
+			if sqa, ok := n.Left().(*plan.SubqueryAlias); ok {
+				b.preserved[sqa] = true
+			}
+			return b.add(n.Left(), nullable) && b.add(n.Right(), true)
+		default:
+			return false
+		}
+	case *plan.Project, *plan.Filter, *plan.Sort, *plan.Limit, *plan.Offset, *plan.Distinct, *plan.TopN:
+	default:
+		return false
+	}
+	for _, c := range n.Children() {
+		if !b.add(c, nullable) {
+			return false
+		}
+	}
+	return true
+}
+
+// findMergeableDerivedTable returns a derived table of the query block of the node given that can be merged into it, or
+// nil if there isn't one.
+func findMergeableDerivedTable(a *Analyzer, n sql.Node) *plan.SubqueryAlias {
+	b, ok := newQueryBlock(n)
+	if !ok {
+		return nil
+	}
+	for _, sqa := range b.derived {
+		if b.noMergeAll || b.noMerge[strings.ToLower(sqa.Name())] {
+			continue
+		}
+		if isMergeableDerivedTable(sqa, b) {
+			return sqa
+		}
+	}
+	return nil
+}
+
+// isMergeableDerivedTable returns whether the derived table given projects columns of a join tree of tables that can be
+// merged into the query block given. The NULL-extended rows of outer joins only have NULL columns, so that the derived
+// tables on their NULL-extended sides can only project columns.
+func isMergeableDerivedTable(sqa *plan.SubqueryAlias, b *queryBlock) bool {
+	if sqa.IsLateral || sqa.OuterScopeVisibility || sqa.CacheableCTESource || sqa.Algorithm == "TEMPTABLE" {
+		return false
+	}
+	projections, child, ok := derivedTableQuery(sqa.Child)
+	if !ok {
+		return false
+	}
+	if _, ok := child.(*plan.Filter); ok && b.preserved[sqa] {
+		return false
+	}
+	for _, e := range projections {
+		if containsSubquery(e) || !isDeterministic(e) || transform.InspectExpr(e, func(e sql.Expression) bool {
+			switch e.(type) {
+			case sql.Aggregation, sql.WindowAggregation:
+				return true
+			}
+			return false
+		}) {
+			return false
+		}
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+		if _, ok := e.(*expression.GetField); !ok && b.nullable[sqa] {
+			return false
+		}
+	}
+
+	// the tables of the derived table can't have the names of the other tables of the block
+	inner, ok := newQueryBlock(child)
+	if !ok {
+		return false
+	}
+	if len(inner.tables) == 0 {
+		return false
+	}
+	for name := range inner.tables {
+		if b.tables[name] > 0 && name != strings.ToLower(sqa.Name()) || b.tables[name] > 1 {
+			return false
+		}
+	}
+	merged := true
+	transform.Inspect(child, func(n sql.Node) bool {
+		// the hints of the query of a derived table only apply to its joins
+		if cn, ok := n.(sql.CommentedNode); ok && cn.Comment() != "" {
+			merged = false
+			return false
+		}
+		switch n := n.(type) {
+		case *plan.JoinNode, *plan.Filter, nil:
+			return true
+		case *plan.TableAlias, *plan.ResolvedTable:
+			return false
+		case *plan.SubqueryAlias:
+			merged = merged && !n.IsLateral
+			return false
+		default:
+			merged = false
+			return false
+		}
+	})
+	return merged
+}
+
+// derivedTableQuery returns the expressions the query of a derived table projects, and the node they're projected from.
+// The filters pushed above the projection of the query are moved below it, and the query is the projection of all the
+// columns of its node when it has no projection. It returns false if the filters can't be moved.
+func derivedTableQuery(n sql.Node) ([]sql.Expression, sql.Node, bool) {
+	switch n := n.(type) {
+	case *plan.Project:
+		return n.Projections, n.Child, true
+	case *plan.Filter:
+		projections, child, ok := derivedTableQuery(n.Child)
+		if !ok {
+			return nil, nil, false
+		}
+		cond, _, err := transform.Expr(n.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return e, transform.SameTree, nil
+			}
+			if gf.Index() >= len(projections) {
+				return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(gf.Table(), gf.Name())
+			}
+			e = projections[gf.Index()]
+			if alias, ok := e.(*expression.Alias); ok {
+				e = alias.Child
+			}
+			return e, transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, nil, false
+		}
+		return projections, plan.NewFilter(cond, child), true
+	default:
+		projections := make([]sql.Expression, len(n.Schema()))
+		for i, c := range n.Schema() {
+			projections[i] = expression.NewGetFieldWithTable(i, c.Type, c.Source, c.Name, c.Nullable)
+		}
+		return projections, n, true
+	}
+}
+
+// mergeDerivedTable replaces the derived table given with the tables of its query, and its columns with the expressions
+// its query projects, in the nodes above it. The columns the query block selects keep the names of the columns of the
+// derived table.
+func mergeDerivedTable(a *Analyzer, n sql.Node, sqa *plan.SubqueryAlias, scope *plan.Scope) (sql.Node, error) {
+	projections, child, ok := derivedTableQuery(sqa.Child)
+	if !ok {
+		return n, nil
+	}
+	cols := make(map[string]sql.Expression)
+	for i, c := range sqa.Schema() {
+		e := projections[i]
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+		cols[strings.ToLower(c.Name)] = e
+	}
+	replace := func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok || !strings.EqualFold(gf.Table(), sqa.Name()) {
+			return e, transform.SameTree, nil
+		}
+		col, ok := cols[strings.ToLower(gf.Name())]
+		if !ok {
+			return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(gf.Table(), gf.Name())
+		}
+		return col, transform.NewTree, nil
+	}
+
+	// merge returns the node given with the derived table merged, and whether a node selecting columns was rebuilt, above
+	// which the columns of the derived table are the columns this node selects
+	var merge func(n sql.Node) (sql.Node, transform.TreeIdentity, bool, error)
+	merge = func(n sql.Node) (sql.Node, transform.TreeIdentity, bool, error) {
+		if n == sqa {
+			// the hints of the query are in the comment of its first table
+			if cn, ok := child.(sql.CommentedNode); ok && sqa.Comment() != "" {
+				return cn.WithComment(sqa.Comment()), transform.NewTree, false, nil
+			}
+			return child, transform.NewTree, false, nil
+		}
+		if _, ok := n.(*plan.SubqueryAlias); ok {
+			return n, transform.SameTree, false, nil
+		}
+		children := n.Children()
+		newChildren := make([]sql.Node, len(children))
+		same := transform.SameTree
+		selected := false
+		// the filters of a derived table joined with other tables are conditions of its join, since the joins are
+		// reordered without the filters between them
+		var filters []sql.Expression
+		for i, c := range children {
+			var cSame transform.TreeIdentity
+			var cSelected bool
+			var err error
+			newChildren[i], cSame, cSelected, err = merge(c)
+			if err != nil {
+				return nil, transform.SameTree, false, err
+			}
+			same = same && cSame
+			selected = selected || cSelected
+			if _, ok := n.(*plan.JoinNode); ok && c == sqa {
+				for f, ok := newChildren[i].(*plan.Filter); ok; f, ok = newChildren[i].(*plan.Filter) {
+					filters = append(filters, f.Expression)
+					newChildren[i] = f.Child
+				}
+			}
+		}
+		if same {
+			return n, transform.SameTree, false, nil
+		}
+		n, err := n.WithChildren(newChildren...)
+		if err != nil {
+			return nil, transform.SameTree, false, err
+		}
+
+		if selected {
+			// the columns of the derived table are now the columns of another table or aliases
+			schema := n.Children()[0].Schema()
+			n, _, err = transform.OneNodeExpressions(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				gf, ok := e.(*expression.GetField)
+				if !ok || !strings.EqualFold(gf.Table(), sqa.Name()) || gf.Index() >= len(schema) ||
+					!strings.EqualFold(gf.Name(), schema[gf.Index()].Name) {
+					return e, transform.SameTree, nil
+				}
+				return gf.WithTable(schema[gf.Index()].Source), transform.NewTree, nil
+			})
+			return n, transform.NewTree, true, err
+		}
+
+		if e, ok := n.(sql.Expressioner); ok {
+			_, selects := n.(*plan.Project)
+			exprs := e.Expressions()
+			newExprs := make([]sql.Expression, len(exprs))
+			for i, e := range exprs {
+				newExprs[i], _, err = transform.Expr(e, replace)
+				if err != nil {
+					return nil, transform.SameTree, false, err
+				}
+				// the selected columns keep their names
+				if selects && newExprs[i] != e {
+					if name := transform.ExpressionToColumn(e).Name; transform.ExpressionToColumn(newExprs[i]).Name != name {
+						newExprs[i] = expression.NewAlias(name, newExprs[i])
+					}
+				}
+			}
+			n, err = e.WithExpressions(newExprs...)
+			if err != nil {
+				return nil, transform.SameTree, false, err
+			}
+			selected = selects
+		}
+
+		if j, ok := n.(*plan.JoinNode); ok {
+			ret := *j
+			if len(filters) > 0 {
+				ret.Filter = expression.JoinAnd(append([]sql.Expression{j.Filter}, filters...)...)
+				if ret.Op.IsCross() {
+					ret.Op = plan.JoinTypeInner
+				}
+			}
+			if ret.Filter == nil {
+				return &ret, transform.NewTree, false, nil
+			}
+			ret.Filter, _, err = fixidx.FixFieldIndexes(scope, a.LogFn(), append(j.Left().Schema(), j.Right().Schema()...), ret.Filter)
+			if err != nil {
+				return nil, transform.SameTree, false, err
+			}
+			return &ret, transform.NewTree, false, nil
+		}
+		n, _, err = fixidx.FixFieldIndexesForExpressions(a.LogFn(), n, scope)
+		return n, transform.NewTree, selected, err
+	}
+
+	ret, _, _, err := merge(n)
+	return ret, err
+}
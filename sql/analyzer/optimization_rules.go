@@ -15,6 +15,7 @@
 package analyzer
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -275,7 +276,8 @@ func expressionSources(expr sql.Expression) []string {
 }
 
 // simplifyFilters simplifies the expressions in Filter nodes where possible. This involves removing redundant parts of AND
-// and OR expressions, as well as replacing evaluable expressions with their literal result. Filters that can
+// and OR expressions, as well as replacing evaluable expressions with their literal result. IN lists of constants are
+// reduced, and the ranges of the comparisons of columns with constants are checked for contradictions. Filters that can
 // statically be determined to be true or false are replaced with the child node or an empty result, respectively.
 func simplifyFilters(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	if !node.Resolved() {
@@ -313,6 +315,10 @@ func simplifyFilters(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.S
 					return e.Left, transform.NewTree, nil
 				}
 
+				if isSameExpression(e.Left, e.Right) {
+					return e.Left, transform.NewTree, nil
+				}
+
 				return e, transform.SameTree, nil
 			case *expression.And:
 				if isFalse(e.Left) {
@@ -331,7 +337,16 @@ func simplifyFilters(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.S
 					return e.Left, transform.NewTree, nil
 				}
 
+				if isSameExpression(e.Left, e.Right) {
+					return e.Left, transform.NewTree, nil
+				}
+
 				return e, transform.SameTree, nil
+			case *expression.InTuple:
+				if isEvaluable(e) {
+					return foldExpression(ctx, e)
+				}
+				return simplifyInTuple(e)
 			case *expression.Like:
 				// if the charset is not utf8mb4, the last character used in optimization rule does not work
 				coll, _ := sql.GetCoercibility(ctx, e.Left)
@@ -394,17 +409,16 @@ func simplifyFilters(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.S
 				}
 
 				// All other expressions types can be evaluated once and turned into literals for the rest of query execution
-				val, err := e.Eval(ctx, nil)
-				if err != nil {
-					return e, transform.SameTree, nil
-				}
-				return expression.NewLiteral(val, e.Type()), transform.NewTree, nil
+				return foldExpression(ctx, e)
 			}
 		})
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
 
+		e, sameConjuncts := simplifyConjunction(e)
+		same = same && sameConjuncts
+
 		if isFalse(e) {
 			emptyTable := plan.NewEmptyTableWithSchema(filter.Schema())
 			return emptyTable, transform.NewTree, nil
@@ -446,3 +460,259 @@ func isTrue(e sql.Expression) bool {
 	}
 	return false
 }
+
+// foldExpression replaces the evaluable expression given with a literal of its result. Non-deterministic expressions,
+// and those that fail to evaluate, are left as they are, to be evaluated for each row.
+func foldExpression(ctx *sql.Context, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+	if !isDeterministic(e) {
+		return e, transform.SameTree, nil
+	}
+	val, err := e.Eval(ctx, nil)
+	if err != nil {
+		return e, transform.SameTree, nil
+	}
+	return expression.NewLiteral(val, e.Type()), transform.NewTree, nil
+}
+
+// isDeterministic returns whether the expression given returns the same result every time it's evaluated with the
+// same row.
+func isDeterministic(e sql.Expression) bool {
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		nd, ok := e.(sql.NonDeterministicExpression)
+		return ok && nd.IsNonDeterministic()
+	})
+}
+
+// isSameExpression returns whether the expressions given always have the same result, which is the case for equal
+// deterministic expressions.
+func isSameExpression(left, right sql.Expression) bool {
+	return isDeterministic(left) && !containsSubquery(left) && sql.DebugString(left) == sql.DebugString(right)
+}
+
+// simplifyInTuple simplifies an IN of a column and a list of constants. Duplicate constants are removed, an IN of a
+// single constant is replaced with an equality, and an IN of an integer column and consecutive integers is replaced
+// with the range they cover.
+func simplifyInTuple(e *expression.InTuple) (sql.Expression, transform.TreeIdentity, error) {
+	col, ok := e.Left().(*expression.GetField)
+	if !ok {
+		return e, transform.SameTree, nil
+	}
+	tuple, ok := e.Right().(expression.Tuple)
+	if !ok {
+		return e, transform.SameTree, nil
+	}
+
+	var distinct []sql.Expression
+	seen := make(map[string]bool)
+	for _, el := range tuple {
+		lit, ok := el.(*expression.Literal)
+		// NULL makes IN evaluate to NULL rather than false for values not in the list
+		if !ok || lit.Value() == nil {
+			return e, transform.SameTree, nil
+		}
+		key := fmt.Sprintf("%s:%v", lit.Type(), lit.Value())
+		if !seen[key] {
+			seen[key] = true
+			distinct = append(distinct, lit)
+		}
+	}
+
+	if len(distinct) == 1 {
+		return expression.NewEquals(col, distinct[0]), transform.NewTree, nil
+	}
+	if lower, upper, ok := consecutiveIntegers(col, distinct); ok {
+		return expression.NewAnd(
+			expression.NewGreaterThanOrEqual(col, lower),
+			expression.NewLessThanOrEqual(col, upper),
+		), transform.NewTree, nil
+	}
+	if len(distinct) < len(tuple) {
+		return expression.NewInTuple(col, expression.NewTuple(distinct...)), transform.NewTree, nil
+	}
+	return e, transform.SameTree, nil
+}
+
+// consecutiveIntegers returns the lowest and highest of the literals given if the column given is an integer, and the
+// literals are distinct integers with no gaps between them.
+func consecutiveIntegers(col *expression.GetField, literals []sql.Expression) (sql.Expression, sql.Expression, bool) {
+	if !types.IsInteger(col.Type()) {
+		return nil, nil, false
+	}
+	vals := make([]int64, len(literals))
+	for i, l := range literals {
+		lit := l.(*expression.Literal)
+		if !types.IsInteger(lit.Type()) {
+			return nil, nil, false
+		}
+		v, inRange, err := types.Int64.Convert(lit.Value())
+		if err != nil || inRange != sql.InRange {
+			return nil, nil, false
+		}
+		vals[i] = v.(int64)
+	}
+	lower, upper := 0, 0
+	for i := range vals {
+		if vals[i] < vals[lower] {
+			lower = i
+		}
+		if vals[i] > vals[upper] {
+			upper = i
+		}
+	}
+	// distinct values are consecutive if they span as many values as there are
+	if vals[upper]-vals[lower] != int64(len(vals)-1) {
+		return nil, nil, false
+	}
+	return literals[lower], literals[upper], true
+}
+
+// simplifyConjunction simplifies the conjunction of the filter expression given as a whole. The filter is false if the
+// ranges its comparisons of columns with constants allow for any column are empty.
+func simplifyConjunction(e sql.Expression) (sql.Expression, transform.TreeIdentity) {
+	if hasEmptyRange(expression.SplitConjunction(e)) {
+		return expression.NewLiteral(false, types.Boolean), transform.NewTree
+	}
+	return e, transform.SameTree
+}
+
+// columnComparison returns the column, comparison operator and non-NULL constant of a comparison of a column with a
+// constant, with the column on the left.
+func columnComparison(e sql.Expression) (*expression.GetField, string, *expression.Literal, bool) {
+	var op string
+	switch e.(type) {
+	case *expression.Equals:
+		op = "="
+	case *expression.GreaterThan:
+		op = ">"
+	case *expression.GreaterThanOrEqual:
+		op = ">="
+	case *expression.LessThan:
+		op = "<"
+	case *expression.LessThanOrEqual:
+		op = "<="
+	default:
+		return nil, "", nil, false
+	}
+	cmp := e.(expression.Comparer)
+	if col, ok := cmp.Left().(*expression.GetField); ok {
+		if lit, ok := cmp.Right().(*expression.Literal); ok && lit.Value() != nil {
+			return col, op, lit, true
+		}
+	}
+	if col, ok := cmp.Right().(*expression.GetField); ok {
+		if lit, ok := cmp.Left().(*expression.Literal); ok && lit.Value() != nil {
+			flipped := map[string]string{"=": "=", ">": "<", ">=": "<=", "<": ">", "<=": ">="}
+			return col, flipped[op], lit, true
+		}
+	}
+	return nil, "", nil, false
+}
+
+// columnRange is the range of values allowed for a column by comparisons with constants.
+type columnRange struct {
+	lower, upper                   interface{}
+	hasLower, hasUpper             bool
+	lowerInclusive, upperInclusive bool
+}
+
+// hasEmptyRange returns whether the comparisons of columns with constants in the conjuncts given allow no value for
+// some column, e.g. `a > 5 AND a < 3`. Only comparisons whose constants can be compared with each other in the same
+// way they're compared with the column are considered: numbers with numeric columns, and strings with string columns
+// in the collation of the column.
+func hasEmptyRange(conjuncts []sql.Expression) bool {
+	var comparisons []sql.Expression
+	for _, c := range conjuncts {
+		// the bounds of BETWEEN are converted to the type of the column, which only compare the same way unconverted
+		// for integers with integer columns and strings with string columns
+		if b, ok := c.(*expression.Between); ok && sameWhenConverted(b.Val, b.Lower) && sameWhenConverted(b.Val, b.Upper) {
+			comparisons = append(comparisons,
+				expression.NewGreaterThanOrEqual(b.Val, b.Lower),
+				expression.NewLessThanOrEqual(b.Val, b.Upper))
+		} else {
+			comparisons = append(comparisons, c)
+		}
+	}
+
+	ranges := make(map[string]*columnRange)
+	for _, c := range comparisons {
+		col, op, lit, ok := columnComparison(c)
+		if !ok {
+			continue
+		}
+		cmpType, val, ok := rangeValue(col, lit)
+		if !ok {
+			continue
+		}
+
+		key := strings.ToLower(col.Table() + "." + col.Name())
+		r, ok := ranges[key]
+		if !ok {
+			r = &columnRange{}
+			ranges[key] = r
+		}
+		if op == "=" || op == ">" || op == ">=" {
+			cmp := 1
+			if r.hasLower {
+				var err error
+				if cmp, err = cmpType.Compare(val, r.lower); err != nil {
+					return false
+				}
+			}
+			if cmp > 0 || (cmp == 0 && op == ">") {
+				r.lower, r.hasLower, r.lowerInclusive = val, true, op != ">"
+			}
+		}
+		if op == "=" || op == "<" || op == "<=" {
+			cmp := -1
+			if r.hasUpper {
+				var err error
+				if cmp, err = cmpType.Compare(val, r.upper); err != nil {
+					return false
+				}
+			}
+			if cmp < 0 || (cmp == 0 && op == "<") {
+				r.upper, r.hasUpper, r.upperInclusive = val, true, op != "<"
+			}
+		}
+		if r.hasLower && r.hasUpper {
+			cmp, err := cmpType.Compare(r.lower, r.upper)
+			if err != nil {
+				return false
+			}
+			if cmp > 0 || (cmp == 0 && !(r.lowerInclusive && r.upperInclusive)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameWhenConverted returns whether the constant given compares with the column given the same way when converted to
+// the type of the column.
+func sameWhenConverted(col, e sql.Expression) bool {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return false
+	}
+	return (types.IsInteger(col.Type()) && types.IsInteger(lit.Type())) ||
+		(types.IsTextOnly(col.Type()) && types.IsTextOnly(lit.Type()))
+}
+
+// rangeValue returns the type to compare the constant given in, when compared with the column given, and the constant
+// converted to that type.
+func rangeValue(col *expression.GetField, lit *expression.Literal) (sql.Type, interface{}, bool) {
+	var cmpType sql.Type
+	switch {
+	case types.IsNumber(col.Type()) && types.IsNumber(lit.Type()):
+		cmpType = types.InternalDecimalType
+	case types.IsTextOnly(col.Type()) && types.IsTextOnly(lit.Type()):
+		cmpType = col.Type()
+	default:
+		return nil, nil, false
+	}
+	val, _, err := cmpType.Convert(lit.Value())
+	if err != nil {
+		return nil, nil, false
+	}
+	return cmpType, val, true
+}
@@ -385,6 +385,71 @@ func TestEvalFilter(t *testing.T) {
 			),
 			plan.NewEmptyTableWithSchema(inner.Schema()),
 		},
+		{
+			or(
+				eq(col(0, "foo", "bar"), lit(5)),
+				eq(col(0, "foo", "bar"), lit(5)),
+			),
+			plan.NewFilter(
+				eq(col(0, "foo", "bar"), lit(5)),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			and(
+				gt(col(0, "foo", "bar"), lit(5)),
+				expression.NewLessThan(col(0, "foo", "bar"), lit(3)),
+			),
+			plan.NewEmptyTableWithSchema(inner.Schema()),
+		},
+		{
+			and(
+				eq(col(0, "foo", "bar"), lit(5)),
+				expression.NewBetween(col(0, "foo", "bar"), lit(1), lit(4)),
+			),
+			plan.NewEmptyTableWithSchema(inner.Schema()),
+		},
+		{
+			and(
+				expression.NewGreaterThanOrEqual(col(0, "foo", "bar"), lit(5)),
+				expression.NewLessThanOrEqual(col(0, "foo", "bar"), lit(5)),
+			),
+			plan.NewFilter(
+				and(
+					expression.NewGreaterThanOrEqual(col(0, "foo", "bar"), lit(5)),
+					expression.NewLessThanOrEqual(col(0, "foo", "bar"), lit(5)),
+				),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			expression.NewInTuple(col(0, "foo", "bar"), expression.NewTuple(lit(4), lit(4))),
+			plan.NewFilter(
+				eq(col(0, "foo", "bar"), lit(4)),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			expression.NewInTuple(col(0, "foo", "bar"), expression.NewTuple(lit(3), lit(1), lit(2), lit(1))),
+			plan.NewFilter(
+				and(
+					expression.NewGreaterThanOrEqual(col(0, "foo", "bar"), lit(1)),
+					expression.NewLessThanOrEqual(col(0, "foo", "bar"), lit(3)),
+				),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			expression.NewInTuple(col(0, "foo", "bar"), expression.NewTuple(lit(1), lit(5), lit(1))),
+			plan.NewFilter(
+				expression.NewInTuple(col(0, "foo", "bar"), expression.NewTuple(lit(1), lit(5))),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			expression.NewInTuple(lit(1), expression.NewTuple(lit(3), lit(4))),
+			plan.NewEmptyTableWithSchema(inner.Schema()),
+		},
 	}
 
 	for _, tt := range testCases {
@@ -183,6 +183,11 @@ func isParallelizable(node sql.Node) bool {
 			parallelizable = false
 			return false
 		case sql.Table:
+			// The rows of tables that sort or limit them are only correct when their partitions are read in order
+			if rt, ok := node.(*plan.ResolvedTable); ok && isSortedOrLimited(rt.Table) {
+				parallelizable = false
+				return false
+			}
 			lastWasTable = true
 			tableSeen = true
 		case *plan.JoinNode:
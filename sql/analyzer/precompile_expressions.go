@@ -0,0 +1,112 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// precompileExpressions compiles the projections of Project nodes and the conditions of Filter nodes into programs
+// that evaluate their common subexpressions once per row, e.g. the sum in `select a + b, (a + b) * 2 from t`. The
+// expressions compiled are described as they were, so the rule doesn't change how plans are shown.
+func precompileExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch node := node.(type) {
+		case *plan.Project:
+			return precompileProjections(node)
+		case *plan.Filter:
+			if !canPrecompile(node.Expression) {
+				return node, transform.SameTree, nil
+			}
+			p, ok := expression.CompileProgram([]sql.Expression{node.Expression})
+			if !ok {
+				return node, transform.SameTree, nil
+			}
+			a.Log("precompiled filter with %d common subexpressions", p.Len())
+			filter, err := node.WithExpressions(p.Outputs()...)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return filter, transform.NewTree, nil
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// precompileProjections compiles the projections of the node given into one program, so that the subexpressions
+// common to several projections are evaluated once per row. Projections that name columns directly, rather than
+// through an alias, are left as they are, since the schema of the node is taken from them.
+func precompileProjections(project *plan.Project) (sql.Node, transform.TreeIdentity, error) {
+	var exprs []sql.Expression
+	var indexes []int
+	for i, e := range project.Projections {
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		} else if _, ok := e.(sql.Nameable); ok {
+			continue
+		}
+		if !canPrecompile(e) {
+			continue
+		}
+		exprs = append(exprs, e)
+		indexes = append(indexes, i)
+	}
+	if len(exprs) == 0 {
+		return project, transform.SameTree, nil
+	}
+
+	p, ok := expression.CompileProgram(exprs)
+	if !ok {
+		return project, transform.SameTree, nil
+	}
+
+	projections := make([]sql.Expression, len(project.Projections))
+	copy(projections, project.Projections)
+	for i, output := range p.Outputs() {
+		j := indexes[i]
+		if alias, ok := projections[j].(*expression.Alias); ok {
+			na := *alias
+			na.Child = output
+			projections[j] = &na
+		} else {
+			projections[j] = output
+		}
+	}
+	node, err := project.WithExpressions(projections...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, transform.NewTree, nil
+}
+
+// canPrecompile returns whether the expression given can be compiled into a program. Expressions that aren't
+// deterministic, evaluate nested plans or are bound when run can't be, and neither can those already compiled.
+func canPrecompile(e sql.Expression) bool {
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery, *expression.BindVar, *expression.ProcedureParam, *expression.ProgramOutput,
+			*sql.ColumnDefaultValue, sql.Aggregation, sql.WindowAggregation:
+			return true
+		case sql.NonDeterministicExpression:
+			return e.IsNonDeterministic()
+		default:
+			return false
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestPrecompileExpressions(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "foo"},
+		{Name: "b", Type: types.Int64, Source: "foo"},
+	}), nil)
+	a := expression.NewGetFieldWithTable(0, types.Int64, "foo", "a", false)
+	b := expression.NewGetFieldWithTable(1, types.Int64, "foo", "b", false)
+	sum := func() sql.Expression {
+		return expression.NewPlus(a, b)
+	}
+
+	rand, err := function.NewRand()
+	require.NoError(err)
+
+	node := plan.NewProject(
+		[]sql.Expression{
+			a,
+			expression.NewAlias("s", sum()),
+			expression.NewAlias("d", expression.NewMult(sum(), expression.NewLiteral(int64(2), types.Int64))),
+			expression.NewAlias("r", expression.NewPlus(rand, sum())),
+		},
+		plan.NewFilter(
+			expression.NewAnd(
+				expression.NewGreaterThan(sum(), expression.NewLiteral(int64(1), types.Int64)),
+				expression.NewLessThan(sum(), expression.NewLiteral(int64(10), types.Int64)),
+			),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	result, same, err := precompileExpressions(ctx, NewDefault(nil), node, nil, DefaultRuleSelector)
+	require.NoError(err)
+	require.Equal(transform.NewTree, same)
+	require.Equal(node.String(), result.String())
+	require.Equal(node.Schema(), result.Schema())
+
+	project := result.(*plan.Project)
+	require.IsType(&expression.GetField{}, project.Projections[0])
+	s := project.Projections[1].(*expression.Alias).Child.(*expression.ProgramOutput)
+	d := project.Projections[2].(*expression.Alias).Child.(*expression.ProgramOutput)
+	require.Same(s.Program, d.Program)
+	require.Equal(1, s.Program.Len())
+	// non-deterministic expressions aren't compiled
+	require.IsType(&expression.Arithmetic{}, project.Projections[3].(*expression.Alias).Child)
+
+	filter := project.Child.(*plan.Filter)
+	require.IsType(&expression.ProgramOutput{}, filter.Expression)
+
+	// compiled expressions aren't compiled again
+	_, same, err = precompileExpressions(ctx, NewDefault(nil), result, nil, DefaultRuleSelector)
+	require.NoError(err)
+	require.Equal(transform.SameTree, same)
+}
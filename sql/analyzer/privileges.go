@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -29,29 +31,44 @@ import (
 func validatePrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	mysqlDb := a.Catalog.MySQLDb
 	switch n.(type) {
-	case *plan.CreateUser, *plan.DropUser, *plan.RenameUser, *plan.CreateRole, *plan.DropRole,
+	case *plan.CreateUser, *plan.AlterUser, *plan.DropUser, *plan.RenameUser, *plan.CreateRole, *plan.DropRole,
 		*plan.Grant, *plan.GrantRole, *plan.GrantProxy, *plan.Revoke, *plan.RevokeRole, *plan.RevokeAll, *plan.RevokeProxy:
 		mysqlDb.Enabled = true
 	}
 	if !mysqlDb.Enabled {
 		return n, transform.SameTree, nil
 	}
+	// the privileges to read the query of a view with SQL SECURITY DEFINER are checked by the view
+	if scope.InDefinerView() {
+		return n, transform.SameTree, nil
+	}
 
 	client := ctx.Session.Client()
 	user := mysqlDb.GetUser(client.User, client.Address, false)
 	if user == nil {
 		return nil, transform.SameTree, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", ctx.Session.Client().User)
 	}
+	// the statements of a stored program executed with the privileges of its definer are checked with those privileges
+	var opChecker sql.PrivilegedOperationChecker = mysqlDb
+	account := user.UserHostToString("'")
+	if definer := scope.Definer(); definer != "" {
+		if !mysqlDb.DefinerExists(definer) {
+			return nil, transform.SameTree, sql.ErrDefinerDoesNotExist.New(definer)
+		}
+		opChecker = mysqlDb.DefinerChecker(definer)
+		account = strings.ReplaceAll(definer, "`", "'")
+	}
 
 	// TODO: this is incorrect, getTable returns only the first table, there could be others in the tree
-	if plan.IsDualTable(getTable(n)) {
+	// Statements defining stored objects are always checked, as their bodies may read no table
+	if plan.IsDualTable(getTable(n)) && plan.ImplicitCommitOf(n) == plan.NoImplicitCommit {
 		return n, transform.SameTree, nil
 	}
 	if rt := getResolvedTable(n); rt != nil && rt.Database.Name() == sql.InformationSchemaDatabaseName {
 		return n, transform.SameTree, nil
 	}
-	if !n.CheckPrivileges(ctx, a.Catalog.MySQLDb) {
-		return nil, transform.SameTree, sql.ErrPrivilegeCheckFailed.New(user.UserHostToString("'"))
+	if !n.CheckPrivileges(ctx, opChecker) {
+		return nil, transform.SameTree, sql.ErrPrivilegeCheckFailed.New(account)
 	}
 	return n, transform.SameTree, nil
 }
@@ -71,6 +71,11 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 	}
 	tblName := strings.ToLower(tbl.Name())
 
+	// the consumers and hooks of row changes are given every deleted row, which a TRUNCATE doesn't report
+	if ctx.RowEvents().Observed() {
+		return deletePlan, transform.SameTree, nil
+	}
+
 	// auto_increment behaves differently for TRUNCATE and DELETE
 	for _, col := range tbl.Schema() {
 		if col.AutoIncrement {
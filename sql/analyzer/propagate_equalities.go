@@ -0,0 +1,126 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// propagateFilterEqualities adds to Filter nodes the equalities of columns to constants implied by their conditions and
+// the conditions of the inner joins below them, e.g. `a.x = 5` for `a join b on a.x = b.y where b.y = 5`, so that the
+// tables of these columns can be looked up with them. It runs once, before filters are pushed down, since the
+// equalities added are indistinguishable from the ones written.
+func propagateFilterEqualities(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := node.(*plan.Filter)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+
+		sources := make(map[string]bool)
+		for _, s := range nodeSources(filter.Child) {
+			sources[strings.ToLower(s)] = true
+		}
+		conjuncts := expression.SplitConjunction(filter.Expression)
+		derived := propagateEqualities(conjuncts, innerJoinConditions(filter.Child), sources)
+		if len(derived) == 0 {
+			return node, transform.SameTree, nil
+		}
+		a.Log("propagated %d equalities to filter", len(derived))
+		return plan.NewFilter(expression.JoinAnd(append(conjuncts, derived...)...), filter.Child), transform.NewTree, nil
+	})
+}
+
+// innerJoinConditions returns the conjuncts of the conditions of the inner joins at the root of the node given.
+func innerJoinConditions(n sql.Node) []sql.Expression {
+	j, ok := n.(*plan.JoinNode)
+	if !ok || !j.Op.IsInner() {
+		return nil
+	}
+	var conds []sql.Expression
+	if j.Filter != nil {
+		conds = append(conds, expression.SplitConjunction(j.Filter)...)
+	}
+	conds = append(conds, innerJoinConditions(j.Left())...)
+	return append(conds, innerJoinConditions(j.Right())...)
+}
+
+// propagateEqualities returns the equalities of columns to constants implied by the conjuncts given and the join
+// conditions they're evaluated with, and missing from the conjuncts. E.g. for `a.x = b.y AND b.y = 5`, returns
+// `a.x = 5`. Equalities are only propagated between columns of the same type, which compare equally with constants,
+// and to the columns of the tables in |sources|, where they can be used.
+func propagateEqualities(conjuncts, joinConds []sql.Expression, sources map[string]bool) []sql.Expression {
+	colKey := func(col *expression.GetField) string {
+		return strings.ToLower(col.Table() + "." + col.Name())
+	}
+
+	// the columns equal to each column, and the constants columns are equal to
+	equalCols := make(map[string][]*expression.GetField)
+	constants := make(map[string]*expression.Literal)
+	for _, c := range conjuncts {
+		if col, op, lit, ok := columnComparison(c); ok && op == "=" {
+			constants[colKey(col)] = lit
+		}
+	}
+	if len(constants) == 0 {
+		return nil
+	}
+	for _, c := range append(conjuncts, joinConds...) {
+		eq, ok := c.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		left, ok := eq.Left().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		right, ok := eq.Right().(*expression.GetField)
+		if !ok || !left.Type().Equals(right.Type()) {
+			continue
+		}
+		equalCols[colKey(left)] = append(equalCols[colKey(left)], right)
+		equalCols[colKey(right)] = append(equalCols[colKey(right)], left)
+	}
+
+	var derived []sql.Expression
+	var keys []string
+	for key := range constants {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		lit := constants[key]
+		queue := equalCols[key]
+		for len(queue) > 0 {
+			col := queue[0]
+			queue = queue[1:]
+			if _, ok := constants[colKey(col)]; ok {
+				continue
+			}
+			constants[colKey(col)] = lit
+			if sources[strings.ToLower(col.Table())] {
+				derived = append(derived, expression.NewEquals(col, lit))
+			}
+			queue = append(queue, equalCols[colKey(col)]...)
+		}
+	}
+	return derived
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPropagateEqualities(t *testing.T) {
+	sources := map[string]bool{"a": true, "b": true}
+
+	testCases := []struct {
+		name      string
+		conjuncts []sql.Expression
+		joinConds []sql.Expression
+		expected  []sql.Expression
+	}{
+		{
+			name:      "no constants",
+			conjuncts: []sql.Expression{eq(col(0, "a", "x"), col(1, "b", "y"))},
+		},
+		{
+			name:      "through filter",
+			conjuncts: []sql.Expression{eq(col(0, "a", "x"), col(1, "b", "y")), eq(col(1, "b", "y"), lit(5))},
+			expected:  []sql.Expression{eq(col(0, "a", "x"), lit(5))},
+		},
+		{
+			name:      "through join condition",
+			conjuncts: []sql.Expression{eq(lit(5), col(1, "b", "y"))},
+			joinConds: []sql.Expression{eq(col(0, "a", "x"), col(1, "b", "y"))},
+			expected:  []sql.Expression{eq(col(0, "a", "x"), lit(5))},
+		},
+		{
+			name:      "transitive",
+			conjuncts: []sql.Expression{eq(col(0, "a", "x"), lit(5))},
+			joinConds: []sql.Expression{eq(col(0, "a", "x"), col(1, "b", "y")), eq(col(1, "b", "y"), col(2, "b", "z"))},
+			expected:  []sql.Expression{eq(col(1, "b", "y"), lit(5)), eq(col(2, "b", "z"), lit(5))},
+		},
+		{
+			name:      "already constant",
+			conjuncts: []sql.Expression{eq(col(0, "a", "x"), lit(5)), eq(col(1, "b", "y"), lit(6))},
+			joinConds: []sql.Expression{eq(col(0, "a", "x"), col(1, "b", "y"))},
+		},
+		{
+			name:      "column out of sources",
+			conjuncts: []sql.Expression{eq(col(0, "a", "x"), lit(5))},
+			joinConds: []sql.Expression{eq(col(0, "a", "x"), col(1, "c", "y"))},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, propagateEqualities(tt.conjuncts, tt.joinConds, sources))
+		})
+	}
+}
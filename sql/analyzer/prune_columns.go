@@ -57,7 +57,7 @@ func pruneColumns(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.Scop
 	// happens at execution time. Otherwise the logic below will convert a Project to a ResolvedTable for the selected
 	// table, which can alter the column order of the select.
 	switch n := node.(type) {
-	case *plan.InsertInto, *plan.CreateTrigger:
+	case *plan.InsertInto, *plan.CreateTrigger, *plan.Returning:
 		return n, transform.SameTree, nil
 	}
 
@@ -114,6 +114,10 @@ func pruneColumnsIsSafe(n sql.Node) bool {
 func columnsUsedByNode(n sql.Node) usedColumns {
 	columns := make(usedColumns)
 
+	// SELECT ... INTO returns no rows, but stores all the columns of its child into variables
+	if into, ok := n.(*plan.Into); ok {
+		n = into.Child
+	}
 	for _, col := range n.Schema() {
 		columns.add(col.Source, col.Name)
 	}
@@ -173,6 +177,10 @@ func pruneSubqueryColumns(
 	same := sameCols && sameSq
 	if len(n.Columns) > 0 {
 		schemaLen := schemaLength(node)
+		if schemaLen > len(n.Columns) {
+			// the columns of the subquery can't be renamed after pruning, so it's left as it is
+			return n, transform.SameTree, nil
+		}
 		if schemaLen != len(n.Columns) {
 			n = n.WithColumns(n.Columns[:schemaLen])
 			same = transform.NewTree
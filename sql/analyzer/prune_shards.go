@@ -0,0 +1,178 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// maxShardKeys is the most shard keys that the filters on a sharded table may match for its shards to be pruned.
+const maxShardKeys = 1024
+
+// pruneShards restricts the sql.ShardedTable tables read under a filter to the shards of the rows the filter may
+// match, when the filter restricts every column of the shard key to some literal values with equalities or IN lists.
+// It runs once the filters are pushed down to the tables they apply to. The filters are kept, since a shard also holds
+// rows with other keys.
+func pruneShards(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("prune_shards")
+	defer span.End()
+
+	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := node.(*plan.Filter)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		var rt *plan.ResolvedTable
+		tableName := ""
+		switch child := filter.Child.(type) {
+		case *plan.ResolvedTable:
+			rt, tableName = child, child.Name()
+		case *plan.TableAlias:
+			if rt, ok = child.Child.(*plan.ResolvedTable); !ok {
+				return node, transform.SameTree, nil
+			}
+			tableName = child.Name()
+		default:
+			return node, transform.SameTree, nil
+		}
+		sharded, ok := rt.Table.(sql.ShardedTable)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+
+		shards, ok, err := filteredShards(ctx, sharded, tableName, filter.Expression)
+		if err != nil || !ok {
+			return node, transform.SameTree, err
+		}
+		nt, err := rt.WithTable(sharded.WithShards(shards))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		var child sql.Node = nt
+		if alias, ok := filter.Child.(*plan.TableAlias); ok {
+			if child, err = alias.WithChildren(nt); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		nf, err := filter.WithChildren(child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return nf, transform.NewTree, nil
+	})
+}
+
+// filteredShards returns the shards of |table|, read as |tableName|, holding the rows that |filter| may match, if it
+// restricts all the columns of the shard key.
+func filteredShards(ctx *sql.Context, table sql.ShardedTable, tableName string, filter sql.Expression) ([]string, bool, error) {
+	sch := table.Schema()
+	keys := []sql.Row{{}}
+	for _, col := range table.ShardKey() {
+		idx := sch.IndexOfColName(col)
+		if idx < 0 {
+			return nil, false, nil
+		}
+		values, ok := shardKeyValues(sch[idx], tableName, filter)
+		if !ok || len(keys)*len(values) > maxShardKeys {
+			return nil, false, nil
+		}
+		product := make([]sql.Row, 0, len(keys)*len(values))
+		for _, key := range keys {
+			for _, value := range values {
+				product = append(product, append(key[:len(key):len(key)], value))
+			}
+		}
+		keys = product
+	}
+
+	var shards []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		shard, err := table.Shard(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if !seen[shard] {
+			seen[shard] = true
+			shards = append(shards, shard)
+		}
+	}
+	return shards, true, nil
+}
+
+// shardKeyValues returns the values of the column |col| of the table read as |tableName| that the conjunction
+// |filter| may match, as restricted by an equality or IN list of literals, converted to the type of the column.
+func shardKeyValues(col *sql.Column, tableName string, filter sql.Expression) ([]interface{}, bool) {
+	isKey := func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		return ok && strings.EqualFold(gf.Table(), tableName) && strings.EqualFold(gf.Name(), col.Name)
+	}
+	var literals []sql.Expression
+	for _, e := range expression.SplitConjunction(filter) {
+		switch e := e.(type) {
+		case *expression.Equals:
+			if isKey(e.Left()) {
+				literals = []sql.Expression{e.Right()}
+			} else if isKey(e.Right()) {
+				literals = []sql.Expression{e.Left()}
+			} else {
+				continue
+			}
+		case *expression.InTuple:
+			tuple, ok := e.Right().(expression.Tuple)
+			if !ok || !isKey(e.Left()) {
+				continue
+			}
+			literals = tuple
+		default:
+			continue
+		}
+
+		if values, ok := shardKeyLiterals(col, literals); ok {
+			return values, true
+		}
+	}
+	return nil, false
+}
+
+// shardKeyLiterals returns the values of |literals| converted to the type of the column |col|, if they're all
+// literals that the column compares equal to only when it has their value.
+func shardKeyLiterals(col *sql.Column, literals []sql.Expression) ([]interface{}, bool) {
+	values := make([]interface{}, 0, len(literals))
+	for _, l := range literals {
+		lit, ok := l.(*expression.Literal)
+		if !ok {
+			return nil, false
+		}
+		// strings are compared with numbers as numbers, so that '1.0' = 1
+		if types.IsText(col.Type) && !types.IsText(lit.Type()) && lit.Value() != nil {
+			return nil, false
+		}
+		v, inRange, err := col.Type.Convert(lit.Value())
+		if err != nil || inRange != sql.InRange {
+			return nil, false
+		}
+		if v != nil {
+			values = append(values, v)
+		}
+	}
+	return values, true
+}
@@ -184,9 +184,10 @@ func filterPushdownAboveTablesChildSelector(c transform.Context) bool {
 		switch c.Node.(type) {
 		// Don't bother pushing filters down above tables if the direct child node is a table. At best this
 		// just splits the predicates into multiple filter nodes, and at worst it breaks other parts of the
-		// analyzer that don't expect this structure in the tree.
+		// analyzer that don't expect this structure in the tree. Tables that can apply filters themselves are the
+		// exception.
 		case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
-			return false
+			return acceptsFilters(c.Node)
 		}
 	}
 
@@ -250,7 +251,10 @@ func pushdownFiltersToAboveTable(
 			return nil, transform.SameTree, err
 		}
 
-		pushedDownFilterExpression = expression.JoinAnd(handled...)
+		table, handled = pushdownFiltersIntoTable(ctx, a, tableNode, table, handled)
+		if len(handled) > 0 {
+			pushedDownFilterExpression = expression.JoinAnd(handled...)
+		}
 
 		a.Log(
 			"pushed down filters %s above table %q, %d filters handled of %d",
@@ -280,6 +284,56 @@ func pushdownFiltersToAboveTable(
 	}
 }
 
+// pushdownFiltersIntoTable pushes the |filters| that the table of |tableNode| can apply down to it, if it is a
+// sql.FilteredTable that has no filters yet. It returns the table with the filters applied and the filters left to
+// evaluate above the table. Filters with bind variables are left above the table, since they can't be applied until
+// the variables are bound. Filters on TIMESTAMP columns are left above the table too when the session isn't in UTC,
+// since tables apply filters to the stored UTC values.
+func pushdownFiltersIntoTable(ctx *sql.Context, a *Analyzer, tableNode sql.NameableNode, table sql.Table, filters []sql.Expression) (sql.Table, []sql.Expression) {
+	if !acceptsFilters(tableNode) {
+		return table, filters
+	}
+	ft := table.(sql.FilteredTable)
+
+	convertsTimestamps := sql.TimestampLocation(ctx) != nil
+	var candidates []sql.Expression
+	for _, f := range filters {
+		if exprHasBindVar(f) || convertsTimestamps && exprHasTimestampField(f) {
+			continue
+		}
+		candidates = append(candidates, f)
+	}
+	handled := ft.HandledFilters(candidates)
+	if len(handled) == 0 {
+		return table, filters
+	}
+
+	a.Log("table %q transformed with pushdown of filters, %d filters handled of %d", tableNode.Name(), len(handled), len(filters))
+	return ft.WithFilters(ctx, handled), subtractExprSet(filters, handled)
+}
+
+// exprHasTimestampField returns whether |e| references a TIMESTAMP column.
+func exprHasTimestampField(e sql.Expression) bool {
+	return transform.InspectExpr(e, func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		return ok && sql.IsTimestampType(gf.Type())
+	})
+}
+
+// acceptsFilters returns whether |n| is a table, possibly aliased, that implements sql.FilteredTable and has no filters
+// applied yet.
+func acceptsFilters(n sql.Node) bool {
+	if ta, ok := n.(*plan.TableAlias); ok {
+		n = ta.Child
+	}
+	rt, ok := n.(*plan.ResolvedTable)
+	if !ok {
+		return false
+	}
+	ft, ok := rt.Table.(sql.FilteredTable)
+	return ok && len(ft.Filters()) == 0
+}
+
 // pushdownFiltersUnderSubqueryAlias takes |filters| applying to the subquery
 // alias a moves them under the subquery alias. Because the subquery alias is
 // Opaque, it behaves a little bit like a FilteredTable, and pushing the
@@ -312,13 +366,157 @@ func pushdownFiltersUnderSubqueryAlias(ctx *sql.Context, a *Analyzer, sa *plan.S
 		})
 	}
 
-	n, err := sa.WithChildren(plan.NewFilter(expression.JoinAnd(expressionsForChild...), sa.Child))
+	child, err := pushdownFiltersBelowWindowsAndUnions(sa.Child, expressionsForChild)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	n, err := sa.WithChildren(child)
 	if err != nil {
 		return nil, transform.SameTree, err
 	}
 	return n, transform.NewTree, nil
 }
 
+// pushdownFiltersBelowWindowsAndUnions returns the node given filtered by |filters|, which are in terms of its schema.
+// The filters are pushed into both branches of UNION ALL nodes, and below windows when they only reference columns
+// all the window functions are partitioned by, since a partition is windowed regardless of the other ones. This
+// reduces the rows windows and unions materialize.
+func pushdownFiltersBelowWindowsAndUnions(n sql.Node, filters []sql.Expression) (sql.Node, error) {
+	var pushed []sql.Expression
+	switch n := n.(type) {
+	case *plan.Project:
+		switch n.Child.(type) {
+		case *plan.Window, *plan.Union:
+			pushed, filters = filtersOfColumns(filters, n.Projections, nil)
+		}
+	case *plan.Window:
+		pushed, filters = filtersOfColumns(filters, n.SelectExprs, windowPartitions(n))
+	case *plan.Union:
+		if n.Distinct || n.Limit != nil || n.Offset != nil || len(n.SortFields) > 0 {
+			break
+		}
+		children := n.Children()
+		for i, c := range children {
+			var err error
+			children[i], err = pushdownFiltersBelowWindowsAndUnions(c, filtersOfSchema(filters, c.Schema()))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n.WithChildren(children...)
+	}
+
+	if len(pushed) > 0 {
+		child, err := pushdownFiltersBelowWindowsAndUnions(n.Children()[0], pushed)
+		if err != nil {
+			return nil, err
+		}
+		n, err = n.WithChildren(child)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(filters) == 0 {
+		return n, nil
+	}
+	return plan.NewFilter(expression.JoinAnd(filters...), n), nil
+}
+
+// filtersOfColumns returns the |filters| that only reference columns of |exprs|, which select columns of the child of
+// their node, rewritten in terms of the child, and the other filters. If |columns| isn't nil, the filters can only
+// reference the columns it contains.
+func filtersOfColumns(filters, exprs []sql.Expression, columns map[tableCol]struct{}) (pushed, kept []sql.Expression) {
+	for _, f := range filters {
+		if containsSubquery(f) {
+			kept = append(kept, f)
+			continue
+		}
+		ok := true
+		rewritten, _, _ := transform.Expr(f, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			gf, isGf := e.(*expression.GetField)
+			if !isGf {
+				return e, transform.SameTree, nil
+			}
+			if gf.Index() >= len(exprs) {
+				ok = false
+				return e, transform.SameTree, nil
+			}
+			col := exprs[gf.Index()]
+			if alias, isAlias := col.(*expression.Alias); isAlias {
+				col = alias.Child
+			}
+			colGf, isGf := col.(*expression.GetField)
+			if !isGf {
+				ok = false
+				return e, transform.SameTree, nil
+			}
+			if columns != nil {
+				if _, isPartition := columns[newTableCol(colGf.Table(), colGf.Name())]; !isPartition {
+					ok = false
+				}
+			}
+			return colGf, transform.NewTree, nil
+		})
+		if ok {
+			pushed = append(pushed, rewritten)
+		} else {
+			kept = append(kept, f)
+		}
+	}
+	return pushed, kept
+}
+
+// windowPartitions returns the columns every window function of the window given is partitioned by.
+func windowPartitions(w *plan.Window) map[tableCol]struct{} {
+	var partitions map[tableCol]struct{}
+	for _, e := range w.SelectExprs {
+		transform.InspectExpr(e, func(e sql.Expression) bool {
+			wa, ok := e.(sql.WindowAdaptableExpression)
+			if !ok {
+				return false
+			}
+			cols := make(map[tableCol]struct{})
+			if wa.Window() != nil {
+				for _, p := range wa.Window().PartitionBy {
+					if gf, ok := p.(*expression.GetField); ok {
+						cols[newTableCol(gf.Table(), gf.Name())] = struct{}{}
+					}
+				}
+			}
+			if partitions == nil {
+				partitions = cols
+				return false
+			}
+			for col := range partitions {
+				if _, ok := cols[col]; !ok {
+					delete(partitions, col)
+				}
+			}
+			return false
+		})
+	}
+	if partitions == nil {
+		partitions = make(map[tableCol]struct{})
+	}
+	return partitions
+}
+
+// filtersOfSchema returns the |filters| in terms of the schema given, whose columns are in the same positions as the
+// ones they reference.
+func filtersOfSchema(filters []sql.Expression, schema sql.Schema) []sql.Expression {
+	ret := make([]sql.Expression, len(filters))
+	for i, f := range filters {
+		ret[i], _, _ = transform.Expr(f, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			if gf, ok := e.(*expression.GetField); ok && gf.Index() < len(schema) {
+				col := schema[gf.Index()]
+				return gf.WithTable(col.Source).WithName(col.Name), transform.NewTree, nil
+			}
+			return e, transform.SameTree, nil
+		})
+	}
+	return ret
+}
+
 // removePushedDownPredicates removes all handled filter predicates from the filter given and returns. If all
 // predicates have been handled, it replaces the filter with its child.
 func removePushedDownPredicates(ctx *sql.Context, a *Analyzer, node *plan.Filter, filters *filterSet) sql.Node {
@@ -0,0 +1,255 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// pushdownLimitAndSort pushes Sort nodes down to the tables below them that implement sql.SortableTable and can sort
+// their rows by the same columns, and then Limit and Offset nodes down to the tables below them that implement
+// sql.LimitedTable, removing the nodes pushed down. Offset nodes that can't be pushed down that way are pushed down to
+// the index scans below them whose tables implement sql.IndexOffsetTable.
+func pushdownLimitAndSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_limit_and_sort")
+	defer span.End()
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.Sort:
+			return pushdownSortToTable(a, n)
+		case *plan.Limit:
+			return pushdownLimitToTable(a, n)
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// pushdownSortToTable pushes |sort| down to the table below it, if it can sort its rows by the same columns. Filters
+// and projections below the sort don't change the order of the rows of the table.
+func pushdownSortToTable(a *Analyzer, sort *plan.Sort) (sql.Node, transform.TreeIdentity, error) {
+	child, same, err := pushdownToTable(sort.Child, "", true, func(rt *plan.ResolvedTable, name string) (sql.Node, transform.TreeIdentity, error) {
+		st, ok := rt.Table.(sql.SortableTable)
+		if !ok || len(st.SortFields()) > 0 {
+			return rt, transform.SameTree, nil
+		}
+
+		schema := rt.Schema()
+		sortFields := make(sql.SortFields, len(sort.SortFields))
+		for i, sf := range sort.SortFields {
+			gf, ok := sf.Column.(*expression.GetField)
+			if !ok || !strings.EqualFold(gf.Table(), name) {
+				return rt, transform.SameTree, nil
+			}
+			idx := schema.IndexOfColName(gf.Name())
+			if idx < 0 {
+				return rt, transform.SameTree, nil
+			}
+			sf.Column = gf.WithIndex(idx)
+			sortFields[i] = sf
+		}
+		if !st.CanSort(sortFields) {
+			return rt, transform.SameTree, nil
+		}
+
+		a.Log("table %q transformed with pushdown of sort", rt.Name())
+		nt, err := rt.WithTable(st.WithSortFields(sortFields))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return nt, transform.NewTree, nil
+	})
+	if err != nil || same {
+		return sort, transform.SameTree, err
+	}
+	return child, transform.NewTree, nil
+}
+
+// pushdownLimitToTable pushes |limit|, and the offset right below it, down to the table below them. Only projections
+// can be between them and the table, since any other node could change which rows are limited.
+func pushdownLimitToTable(a *Analyzer, limit *plan.Limit) (sql.Node, transform.TreeIdentity, error) {
+	if limit.CalcFoundRows {
+		return limit, transform.SameTree, nil
+	}
+	limitVal, ok := limitValue(limit.Limit)
+	if !ok {
+		return limit, transform.SameTree, nil
+	}
+
+	var offsetVal uint64
+	child := limit.Child
+	offset, hasOffset := child.(*plan.Offset)
+	if hasOffset {
+		if offsetVal, ok = limitValue(offset.Offset); !ok {
+			return limit, transform.SameTree, nil
+		}
+		child = offset.Child
+	}
+
+	newChild, same, err := pushdownToTable(child, "", false, func(rt *plan.ResolvedTable, name string) (sql.Node, transform.TreeIdentity, error) {
+		lt, ok := rt.Table.(sql.LimitedTable)
+		if !ok {
+			return rt, transform.SameTree, nil
+		}
+		if _, _, ok := lt.Limit(); ok {
+			return rt, transform.SameTree, nil
+		}
+
+		a.Log("table %q transformed with pushdown of limit", rt.Name())
+		nt, err := rt.WithTable(lt.WithLimit(limitVal, offsetVal))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return nt, transform.NewTree, nil
+	})
+	if err != nil {
+		return limit, transform.SameTree, err
+	}
+	if !same {
+		return newChild, transform.NewTree, nil
+	}
+	if hasOffset && offsetVal > 0 {
+		return pushdownOffsetToIndexScan(a, limit, offset, offsetVal)
+	}
+	return limit, transform.SameTree, nil
+}
+
+// pushdownOffsetToIndexScan pushes |offset|, whose value is |offsetVal|, down to the index scan below it, removing it
+// from below |limit|. The scan returns its rows in the order of its index, which is the order of the query when its
+// sort was replaced by the scan, and skips the rows of the offset while reading the index. Only projections and table
+// aliases can be between the offset and the scan.
+func pushdownOffsetToIndexScan(a *Analyzer, limit *plan.Limit, offset *plan.Offset, offsetVal uint64) (sql.Node, transform.TreeIdentity, error) {
+	child, same, err := pushdownToIndexScan(offset.Child, func(ita *plan.IndexedTableAccess) (sql.Node, transform.TreeIdentity, error) {
+		// the rows of spatial lookups aren't ordered by the index
+		if ita.Index().IsSpatial() {
+			return ita, transform.SameTree, nil
+		}
+		ot, ok := ita.Table.(sql.IndexOffsetTable)
+		if !ok || ot.IndexOffset() > 0 {
+			return ita, transform.SameTree, nil
+		}
+
+		a.Log("table %q transformed with pushdown of offset", ita.Name())
+		nt, err := ita.WithIndexOffset(offsetVal)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return nt, transform.NewTree, nil
+	})
+	if err != nil || same {
+		return limit, transform.SameTree, err
+	}
+	ret, err := limit.WithChildren(child)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return ret, transform.NewTree, nil
+}
+
+// pushdownToIndexScan applies |pushdown| to the index scan below |n|, which can only be separated from it by
+// projections and table aliases.
+func pushdownToIndexScan(
+	n sql.Node,
+	pushdown func(ita *plan.IndexedTableAccess) (sql.Node, transform.TreeIdentity, error),
+) (sql.Node, transform.TreeIdentity, error) {
+	switch n := n.(type) {
+	case *plan.IndexedTableAccess:
+		return pushdown(n)
+	case *plan.TableAlias, *plan.Project:
+	default:
+		return n, transform.SameTree, nil
+	}
+
+	child, same, err := pushdownToIndexScan(n.Children()[0], pushdown)
+	if err != nil || same {
+		return n, transform.SameTree, err
+	}
+	ret, err := n.WithChildren(child)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return ret, transform.NewTree, nil
+}
+
+// pushdownToTable applies |pushdown| to the table below |n|, which can only be separated from it by projections,
+// table aliases and, if |throughFilters| is set, filters. |pushdown| is given the name the table is referenced by.
+func pushdownToTable(
+	n sql.Node,
+	name string,
+	throughFilters bool,
+	pushdown func(rt *plan.ResolvedTable, name string) (sql.Node, transform.TreeIdentity, error),
+) (sql.Node, transform.TreeIdentity, error) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		if name == "" {
+			name = n.Name()
+		}
+		return pushdown(n, name)
+	case *plan.TableAlias:
+		if name == "" {
+			name = n.Name()
+		}
+	case *plan.Project:
+	case *plan.Filter:
+		if !throughFilters {
+			return n, transform.SameTree, nil
+		}
+	default:
+		return n, transform.SameTree, nil
+	}
+
+	child, same, err := pushdownToTable(n.Children()[0], name, throughFilters, pushdown)
+	if err != nil || same {
+		return n, transform.SameTree, err
+	}
+	ret, err := n.WithChildren(child)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return ret, transform.NewTree, nil
+}
+
+// limitValue returns the value of a LIMIT or OFFSET expression, if it's a literal.
+func limitValue(e sql.Expression) (uint64, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	v, _, err := types.Uint64.Convert(lit.Value())
+	if err != nil {
+		return 0, false
+	}
+	return v.(uint64), true
+}
+
+// isSortedOrLimited returns whether a sort or a limit has been pushed down to |table|, in which case its partitions
+// must be read in order.
+func isSortedOrLimited(table sql.Table) bool {
+	if st, ok := table.(sql.SortableTable); ok && len(st.SortFields()) > 0 {
+		return true
+	}
+	if lt, ok := table.(sql.LimitedTable); ok {
+		_, _, ok := lt.Limit()
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// sortLimitTable is a table that can be sorted by its first column and limited.
+type sortLimitTable struct {
+	*memory.Table
+	sortFields sql.SortFields
+	limit      uint64
+	offset     uint64
+	hasLimit   bool
+}
+
+var _ sql.SortableTable = (*sortLimitTable)(nil)
+var _ sql.LimitedTable = (*sortLimitTable)(nil)
+
+func (t *sortLimitTable) SortFields() sql.SortFields {
+	return t.sortFields
+}
+
+func (t *sortLimitTable) CanSort(sortFields sql.SortFields) bool {
+	for _, sf := range sortFields {
+		if gf, ok := sf.Column.(*expression.GetField); !ok || gf.Index() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *sortLimitTable) WithSortFields(sortFields sql.SortFields) sql.Table {
+	nt := *t
+	nt.sortFields = sortFields
+	return &nt
+}
+
+func (t *sortLimitTable) Limit() (uint64, uint64, bool) {
+	return t.limit, t.offset, t.hasLimit
+}
+
+func (t *sortLimitTable) WithLimit(limit, offset uint64) sql.Table {
+	nt := *t
+	nt.limit, nt.offset, nt.hasLimit = limit, offset, true
+	return &nt
+}
+
+func TestPushdownLimitAndSort(t *testing.T) {
+	table := &sortLimitTable{Table: memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "foo"},
+		{Name: "b", Type: types.Int64, Source: "foo"},
+	}), nil)}
+
+	rt := plan.NewResolvedTable(table, nil, nil)
+	a := expression.NewGetFieldWithTable(0, types.Int64, "foo", "a", false)
+	b := expression.NewGetFieldWithTable(1, types.Int64, "foo", "b", false)
+	project := func(child sql.Node) sql.Node {
+		return plan.NewProject([]sql.Expression{a, b}, child)
+	}
+	lit := func(i int64) sql.Expression {
+		return expression.NewLiteral(i, types.Int64)
+	}
+	sortByA := sql.SortFields{{Column: a, Order: sql.Descending}}
+	sortedByA := func(table *sortLimitTable) *sortLimitTable {
+		return table.WithSortFields(sortByA).(*sortLimitTable)
+	}
+	withTable := func(table sql.Table) *plan.ResolvedTable {
+		nt, err := rt.WithTable(table)
+		require.NoError(t, err)
+		return nt
+	}
+	filter := plan.NewFilter(expression.NewGreaterThan(b, lit(1)), rt)
+
+	tests := []analyzerFnTestCase{
+		{
+			name:     "sort",
+			node:     project(plan.NewSort(sortByA, rt)),
+			expected: project(withTable(sortedByA(table))),
+		},
+		{
+			name: "sort below filter",
+			node: plan.NewSort(sortByA, filter),
+			expected: plan.NewFilter(
+				expression.NewGreaterThan(b, lit(1)),
+				withTable(sortedByA(table)),
+			),
+		},
+		{
+			name: "sort of table alias",
+			node: plan.NewSort(
+				sql.SortFields{{Column: expression.NewGetFieldWithTable(0, types.Int64, "f", "a", false)}},
+				plan.NewTableAlias("f", rt),
+			),
+			expected: plan.NewTableAlias("f", withTable(table.WithSortFields(
+				sql.SortFields{{Column: expression.NewGetFieldWithTable(0, types.Int64, "f", "a", false)}},
+			))),
+		},
+		{
+			name: "sort by column the table can't sort by",
+			node: plan.NewSort(sql.SortFields{{Column: b}}, rt),
+		},
+		{
+			name: "sort by expression",
+			node: plan.NewSort(sql.SortFields{{Column: expression.NewArithmetic(a, lit(1), "+")}}, rt),
+		},
+		{
+			name:     "limit and offset",
+			node:     plan.NewLimit(lit(5), plan.NewOffset(lit(2), project(rt))),
+			expected: project(withTable(table.WithLimit(5, 2))),
+		},
+		{
+			name:     "limit of sort",
+			node:     plan.NewLimit(lit(5), project(plan.NewSort(sortByA, rt))),
+			expected: project(withTable(sortedByA(table).WithLimit(5, 0))),
+		},
+		{
+			name: "limit of sort the table can't do",
+			node: plan.NewLimit(lit(5), plan.NewSort(sql.SortFields{{Column: b}}, rt)),
+		},
+		{
+			name: "limit of filter",
+			node: plan.NewLimit(lit(5), filter),
+		},
+		{
+			name: "limit with bind variable",
+			node: plan.NewLimit(expression.NewBindVar("v1"), rt),
+		},
+		{
+			name: "limit with found rows",
+			node: plan.NewLimit(lit(5), rt).WithCalcFoundRows(true),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, NewDefault(sql.NewDatabaseProvider()), getRule(pushdownLimitAndSortId))
+}
+
+func TestIsParallelizableSortedOrLimitedTable(t *testing.T) {
+	table := &sortLimitTable{Table: memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "foo"},
+	}), nil)}
+
+	require.True(t, isParallelizable(plan.NewResolvedTable(table, nil, nil)))
+	require.False(t, isParallelizable(plan.NewResolvedTable(table.WithLimit(1, 0), nil, nil)))
+	require.False(t, isParallelizable(plan.NewResolvedTable(table.WithSortFields(sql.SortFields{
+		{Column: expression.NewGetFieldWithTable(0, types.Int64, "foo", "a", false)},
+	}), nil, nil)))
+}
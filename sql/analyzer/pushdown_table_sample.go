@@ -0,0 +1,54 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// pushdownTableSample pushes TableSample nodes down to the tables below them that implement sql.SampledTable and can
+// sample their rows with the same method, removing the nodes pushed down. It runs after index scans are generated, so
+// that a sampled table is never replaced by an index lookup that would read all of its rows.
+func pushdownTableSample(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_table_sample")
+	defer span.End()
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		ts, ok := n.(*plan.TableSample)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		rt, ok := ts.Child.(*plan.ResolvedTable)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		st, ok := rt.Table.(sql.SampledTable)
+		if !ok || !st.CanSample(ts.Sample.Method) {
+			return n, transform.SameTree, nil
+		}
+		if _, ok := st.Sample(); ok {
+			return n, transform.SameTree, nil
+		}
+
+		a.Log("table %q transformed with pushdown of sample", rt.Name())
+		nt, err := rt.WithTable(st.WithSample(ts.Sample))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return nt, transform.NewTree, nil
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestPushdownTableSample(t *testing.T) {
+	table := memory.NewPartitionedTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "foo"},
+	}), nil, 4)
+
+	rt := plan.NewResolvedTable(table, nil, nil)
+	system := sql.TableSample{Method: sql.TableSampleSystem, Percent: 10, Repeatable: true, Seed: 1}
+	bernoulli := sql.TableSample{Method: sql.TableSampleBernoulli, Percent: 10}
+
+	tests := []analyzerFnTestCase{
+		{
+			name:     "system sample",
+			node:     plan.NewTableAlias("f", plan.NewTableSample(rt, system)),
+			expected: plan.NewTableAlias("f", plan.NewResolvedTable(table.WithSample(system), nil, nil)),
+		},
+		{
+			name: "bernoulli sample",
+			node: plan.NewTableAlias("f", plan.NewTableSample(rt, bernoulli)),
+		},
+		{
+			name: "sample of sampled table",
+			node: plan.NewTableSample(plan.NewResolvedTable(table.WithSample(system), nil, nil), system),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, NewDefault(sql.NewDatabaseProvider()), getRule(pushdownTableSampleId))
+}
@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -8,27 +9,31 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
-// replacePkSort applies an IndexAccess when there is an `OrderBy` over a prefix of any `PrimaryKey`s
+// replacePkSort replaces Sort nodes with reads of an index in order, forwards for ascending sorts and in reverse for
+// descending ones. A sort over a table scan is replaced by a scan of its primary key, or, when only the first rows of
+// the sort are returned, of any other index, if the sort is over a prefix of its columns. A sort over a static index
+// lookup is removed when the rows of the lookup are in the order of the sort, skipping the columns of the index the
+// lookup fixes to a single value, whose direction doesn't matter.
 func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
-	return replacePkSortHelper(ctx, scope, n, nil)
+	return replacePkSortHelper(ctx, scope, n, nil, false)
 }
 
-func replacePkSortHelper(ctx *sql.Context, scope *plan.Scope, node sql.Node, sortNode *plan.Sort) (sql.Node, transform.TreeIdentity, error) {
+func replacePkSortHelper(ctx *sql.Context, scope *plan.Scope, node sql.Node, sortNode *plan.Sort, limited bool) (sql.Node, transform.TreeIdentity, error) {
 	switch n := node.(type) {
 	case *plan.Sort:
 		sortNode = n // TODO: this only preserves the most recent Sort node
-	// TODO: make this work with IndexedTableAccess, if we are statically sorting by the same col
+	case *plan.Limit:
+		// a limit below the sort doesn't limit the rows it sorts
+		limited = sortNode == nil
 	case *plan.ResolvedTable:
 		// No sort node above this, so do nothing
 		if sortNode == nil {
 			return n, transform.SameTree, nil
 		}
-		tableAliases, err := getTableAliases(sortNode, scope)
+		sortCols, err := sortColumns(sortNode, scope)
 		if err != nil {
 			return n, transform.SameTree, nil
 		}
-		sfExprs := normalizeExpressions(tableAliases, sortNode.SortFields.ToExpressions()...)
-		sfAliases := aliasedExpressionsInNode(sortNode)
 		table := n.Table
 		if w, ok := table.(sql.TableWrapper); ok {
 			table = w.Underlying()
@@ -42,56 +47,67 @@ func replacePkSortHelper(ctx *sql.Context, scope *plan.Scope, node sql.Node, sor
 			return nil, transform.SameTree, err
 		}
 
-		var pkIndex sql.Index // TODO: support secondary indexes
+		// The primary key is preferred, since the rows it reads don't need to be looked up in the table. Other indexes
+		// are only used when a limit stops reading them early.
+		sort.SliceStable(idxs, func(i, j int) bool {
+			return idxs[i].ID() == "PRIMARY" && idxs[j].ID() != "PRIMARY"
+		})
 		for _, idx := range idxs {
-			if idx.ID() == "PRIMARY" {
-				pkIndex = idx
+			if idx.ID() != "PRIMARY" && !limited {
 				break
 			}
-		}
-		if pkIndex == nil {
-			return n, transform.SameTree, nil
-		}
+			reverse, ok := indexSortOrder(idx, sortNode, sortCols, nil)
+			if !ok {
+				continue
+			}
 
-		pkColNames := pkIndex.Expressions()
-		if len(sfExprs) > len(pkColNames) {
-			return n, transform.SameTree, nil
-		}
-		for i, fieldExpr := range sfExprs {
-			// TODO: could generalize this to more monotonic expressions.
-			// For example, order by x+1 is ok, but order by mod(x) is not
-			if sortNode.SortFields[0].Order != sortNode.SortFields[i].Order {
-				return n, transform.SameTree, nil
+			// Create a lookup of the whole index
+			lookup, err := sql.NewIndexBuilder(idx).Build(ctx)
+			if err != nil {
+				return nil, transform.SameTree, err
 			}
-			fieldName := fieldExpr.String()
-			if alias, ok := sfAliases[strings.ToLower(pkColNames[i])]; ok && alias == fieldName {
+			lookup.IsReverse = reverse
+			if !idx.CanSupport(lookup.Ranges...) {
 				continue
 			}
-			if pkColNames[i] != fieldExpr.String() {
-				return n, transform.SameTree, nil
+			nn, err := plan.NewStaticIndexedAccessForResolvedTable(n, lookup)
+			if err != nil {
+				return nil, transform.SameTree, err
 			}
+			return nn, transform.NewTree, nil
 		}
-
-		// Create lookup based off of PrimaryKey
-		indexBuilder := sql.NewIndexBuilder(pkIndex)
-		lookup, err := indexBuilder.Build(ctx)
+		return n, transform.SameTree, nil
+	case *plan.IndexedTableAccess:
+		if sortNode == nil || !n.IsStatic() {
+			return n, transform.SameTree, nil
+		}
+		lookup, err := n.GetLookup(ctx, nil)
+		if err != nil || lookup.IsReverse {
+			return n, transform.SameTree, nil
+		}
+		if ok, err := rangesInIndexOrder(lookup.Ranges); err != nil || !ok {
+			return n, transform.SameTree, nil
+		}
+		sortCols, err := sortColumns(sortNode, scope)
 		if err != nil {
-			return nil, transform.SameTree, err
+			return n, transform.SameTree, nil
 		}
-		lookup.IsReverse = sortNode.SortFields[0].Order == sql.Descending
-		// Some Primary Keys (like doltHistoryTable) are not in order
-		if oi, ok := pkIndex.(sql.OrderedIndex); ok && ((lookup.IsReverse && !oi.Reversible()) || oi.Order() == sql.IndexOrderNone) {
+		fixed, err := fixedIndexColumns(lookup)
+		if err != nil {
 			return n, transform.SameTree, nil
 		}
-		if !pkIndex.CanSupport(lookup.Ranges...) {
+		reverse, ok := indexSortOrder(lookup.Index, sortNode, sortCols, fixed)
+		if !ok {
 			return n, transform.SameTree, nil
 		}
-		nn, err := plan.NewStaticIndexedAccessForResolvedTable(n, lookup)
+		if !reverse {
+			return n, transform.NewTree, nil
+		}
+		nn, err := n.WithReverseLookup()
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
-
-		return nn, transform.NewTree, err
+		return nn, transform.NewTree, nil
 	}
 
 	allSame := transform.SameTree
@@ -100,8 +116,8 @@ func replacePkSortHelper(ctx *sql.Context, scope *plan.Scope, node sql.Node, sor
 		var err error
 		same := transform.SameTree
 		switch c := child.(type) {
-		case *plan.Project, *plan.TableAlias, *plan.ResolvedTable, *plan.Filter, *plan.Limit, *plan.Offset, *plan.Sort:
-			newChildren[i], same, err = replacePkSortHelper(ctx, scope, child, sortNode)
+		case *plan.Project, *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.Filter, *plan.Limit, *plan.Offset, *plan.Sort:
+			newChildren[i], same, err = replacePkSortHelper(ctx, scope, child, sortNode, limited)
 		default:
 			newChildren[i] = c
 		}
@@ -126,3 +142,146 @@ func replacePkSortHelper(ctx *sql.Context, scope *plan.Scope, node sql.Node, sor
 	}
 	return newNode, transform.NewTree, nil
 }
+
+// sortColumns returns the names of the columns |sortNode| sorts by, with the table aliases they're referenced by
+// replaced by the names of their tables, as they're named by Index.Expressions. Columns referenced by an alias of a
+// projection are named by it.
+func sortColumns(sortNode *plan.Sort, scope *plan.Scope) ([]string, error) {
+	tableAliases, err := getTableAliases(sortNode, scope)
+	if err != nil {
+		return nil, err
+	}
+	sfExprs := normalizeExpressions(tableAliases, sortNode.SortFields.ToExpressions()...)
+	cols := make([]string, len(sfExprs))
+	for i, e := range sfExprs {
+		cols[i] = e.String()
+	}
+	return cols, nil
+}
+
+// indexSortOrder returns whether the rows of |idx| are in the order of |sortNode|, whose columns are named
+// |sortCols|, when read forwards or in reverse, and whether they have to be read in reverse. The columns of the index
+// in |fixed| have a single value in the rows read, so they can be skipped, or sorted in either direction.
+func indexSortOrder(idx sql.Index, sortNode *plan.Sort, sortCols []string, fixed []bool) (reverse bool, ok bool) {
+	if idx.IsSpatial() {
+		return false, false
+	}
+	// the rows of prefix indexes are only in the order of the prefixes of their values
+	for _, l := range idx.PrefixLengths() {
+		if l > 0 {
+			return false, false
+		}
+	}
+	if vi, ok := idx.(sql.VisibleIndex); ok && !vi.IsVisible() {
+		return false, false
+	}
+	oi, ordered := idx.(sql.OrderedIndex)
+	if ordered && oi.Order() == sql.IndexOrderNone {
+		return false, false
+	} else if !ordered && idx.ID() != "PRIMARY" {
+		return false, false
+	}
+
+	sfAliases := aliasedExpressionsInNode(sortNode)
+	isFixed := func(i int) bool {
+		return i < len(fixed) && fixed[i]
+	}
+	idxCols := idx.Expressions()
+	var order sql.SortOrder
+	pos := 0
+	for i, col := range sortCols {
+		matches := func(idxCol string) bool {
+			if alias, ok := sfAliases[strings.ToLower(idxCol)]; ok && alias == col {
+				return true
+			}
+			return strings.EqualFold(idxCol, col)
+		}
+		for pos < len(idxCols) && !matches(idxCols[pos]) && isFixed(pos) {
+			pos++
+		}
+		if pos == len(idxCols) || !matches(idxCols[pos]) {
+			// a column already skipped has a single value, so the rows are sorted by it anyway
+			skipped := false
+			for j := 0; j < pos; j++ {
+				if isFixed(j) && matches(idxCols[j]) {
+					skipped = true
+				}
+			}
+			if !skipped {
+				return false, false
+			}
+			continue
+		}
+		if !isFixed(pos) {
+			if order == 0 {
+				order = sortNode.SortFields[i].Order
+			} else if order != sortNode.SortFields[i].Order {
+				return false, false
+			}
+		}
+		pos++
+	}
+
+	reverse = order == sql.Descending
+	if reverse && ordered && !oi.Reversible() {
+		return false, false
+	}
+	return reverse, true
+}
+
+// fixedIndexColumns returns, for each column of the index of |lookup|, whether the lookup fixes it to a single value:
+// every range of the lookup is an equality on the same value for it.
+func fixedIndexColumns(lookup sql.IndexLookup) ([]bool, error) {
+	if len(lookup.Ranges) == 0 {
+		return nil, nil
+	}
+	fixed := make([]bool, len(lookup.Ranges[0]))
+	for i, rce := range lookup.Ranges[0] {
+		eq, err := rce.RepresentsEquals()
+		if err != nil {
+			return nil, err
+		}
+		fixed[i] = eq
+		for _, rang := range lookup.Ranges[1:] {
+			if !fixed[i] {
+				break
+			}
+			if fixed[i], err = rce.Equals(rang[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fixed, nil
+}
+
+// rangesInIndexOrder returns whether the rows of |ranges|, read one range after another, are in the order of their
+// index: all the rows of each range come before the rows of the next one. This is the case when the columns the
+// ranges differ by first don't overlap, and are in ascending order.
+func rangesInIndexOrder(ranges sql.RangeCollection) (bool, error) {
+	for i := 1; i < len(ranges); i++ {
+		prev, next := ranges[i-1], ranges[i]
+		separated := false
+		for j := range prev {
+			if eq, err := prev[j].RepresentsEquals(); err != nil {
+				return false, err
+			} else if eq {
+				same, err := prev[j].Equals(next[j])
+				if err != nil {
+					return false, err
+				} else if same {
+					continue
+				}
+			}
+			cmp, err := prev[j].UpperBound.Compare(next[j].LowerBound, prev[j].Typ)
+			if err != nil {
+				return false, err
+			}
+			separated = cmp <= 0
+			break
+		}
+		if !separated {
+			return false, nil
+		}
+	}
+	return true, nil
+}
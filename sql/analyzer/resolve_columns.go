@@ -757,7 +757,12 @@ func resolveColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope
 		// We need to use the schema, so all children must be resolved.
 		// TODO: also enforce the equivalent constraint for outer scopes. More complicated, because the outer scope can't
 		//  be Resolved() owing to a child expression (the one being evaluated) not being resolved yet.
-		for _, c := range n.Children() {
+		children := n.Children()
+		if r, ok := n.(*plan.Returning); ok {
+			// the source of an insert is resolved later, and the expressions of a RETURNING clause only use its target
+			children = []sql.Node{r.Target()}
+		}
+		for _, c := range children {
 			if !c.Resolved() {
 				return n, transform.SameTree, nil
 			}
@@ -803,8 +808,13 @@ func indexColumns(_ *sql.Context, _ *Analyzer, n sql.Node, scope *plan.Scope) (m
 	}
 
 	indexSchema := func(n sql.Schema) {
-		for _, col := range n {
-			indexColumn(col)
+		schemaIdx := n.Index()
+		for i, col := range n {
+			columns[tableCol{
+				table: schemaIdx.Source(i),
+				col:   schemaIdx.Name(i),
+			}] = indexedCol{col, idx}
+			idx++
 		}
 	}
 
@@ -848,11 +858,15 @@ func indexColumns(_ *sql.Context, _ *Analyzer, n sql.Node, scope *plan.Scope) (m
 	// For the innermost scope (the node being evaluated), look at the schemas of the children instead of this node
 	// itself. Skip this for DDL nodes that handle indexing separately.
 	shouldIndexChildNode := true
-	switch n.(type) {
+	switch n := n.(type) {
 	case *plan.AddColumn, *plan.ModifyColumn:
 		shouldIndexChildNode = false
 	case *plan.RecursiveCte, *plan.Union:
 		shouldIndexChildNode = false
+	case *plan.Returning:
+		// the expressions of a RETURNING clause are evaluated on the rows of the target table
+		indexSchema(n.Target().Schema())
+		shouldIndexChildNode = false
 	}
 
 	if shouldIndexChildNode {
@@ -107,11 +107,17 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 		}
 	}
 
+	var options sql.TableOptions
+	if optionsTable, ok := likeTable.(sql.TableOptionsTable); ok {
+		options = optionsTable.TableOptions()
+	}
+
 	tableSpec := &plan.TableSpec{
 		Schema:    sql.NewPrimaryKeySchema(newSch, pkOrdinals...),
 		IdxDefs:   idxDefs,
 		ChDefs:    checkDefs,
 		Collation: likeTable.Collation(),
+		Options:   options,
 	}
 
 	return plan.NewCreateTable(ct.Database(), ct.Name(), ct.IfNotExists(), ct.Temporary(), tableSpec), transform.NewTree, nil
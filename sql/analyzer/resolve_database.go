@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -46,7 +48,7 @@ func resolveDatabases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sco
 
 			// Only search the catalog if we have a database to resolve.
 			if dbName != "" {
-				db, err := a.Catalog.Database(ctx, dbName)
+				db, err := scopeDatabase(ctx, a, scope, dbName)
 				if err != nil {
 					return nil, transform.SameTree, err
 				}
@@ -82,6 +84,17 @@ func resolveDatabases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sco
 	})
 }
 
+// scopeDatabase returns the database named from the catalog. The statements of a stored program executed with the
+// privileges of its definer may use the databases the user can't access, and the privileges of the definer to use them
+// are checked by validatePrivileges.
+func scopeDatabase(ctx *sql.Context, a *Analyzer, scope *plan.Scope, dbName string) (sql.Database, error) {
+	if scope.Definer() == "" || !a.Catalog.MySQLDb.Enabled ||
+		strings.EqualFold(dbName, sql.InformationSchemaDatabaseName) || strings.EqualFold(dbName, sql.PerformanceSchemaDatabaseName) {
+		return a.Catalog.Database(ctx, dbName)
+	}
+	return a.Catalog.Provider.Database(ctx, dbName)
+}
+
 // validateDatabaseSet returns an error if any database node that requires a database doesn't have one
 func validateDatabaseSet(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	var err error
@@ -51,15 +51,26 @@ func resolveTableFunctions(ctx *sql.Context, a *Analyzer, n sql.Node, _ *plan.Sc
 			database = privilegedDatabase.Unwrap()
 		}
 
-		var hasBindVarArgs bool
+		var hasBindVarArgs, hasColumnArgs bool
 		for _, arg := range utf.Arguments {
-			if _, ok := arg.(*expression.BindVar); ok {
-				hasBindVarArgs = true
-				break
-			}
+			transform.InspectExpr(arg, func(e sql.Expression) bool {
+				switch e.(type) {
+				case *expression.BindVar:
+					hasBindVarArgs = true
+				case *expression.UnresolvedColumn, *expression.GetField:
+					hasColumnArgs = true
+				}
+				return false
+			})
 		}
 
-		if hasBindVarArgs {
+		// Lateral table functions evaluate their arguments when their rows are iterated, and the others are resolved
+		// once the bindvars in their arguments are replaced
+		ltf, ok := tableFunction.(sql.LateralTableFunction)
+		lateral := ok && ltf.AcceptsLateralArguments()
+		if hasColumnArgs && !lateral {
+			return nil, transform.SameTree, sql.ErrTableFunctionNotLateral.New(utf.Name())
+		} else if hasBindVarArgs && !lateral {
 			return n, transform.SameTree, nil
 		}
 
@@ -152,6 +163,14 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) transform.ExprFunc {
 			}
 		}
 
+		// Sequence functions find their sequence in the database provider when they are evaluated
+		if se, ok := rf.(sql.SequenceExpression); ok {
+			rf, err = se.WithDatabaseProvider(a.Catalog.Provider)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+
 		a.Log("resolved function %q", n)
 		return rf, transform.NewTree, nil
 	}
@@ -361,7 +361,6 @@ func resolveSortField(a *Analyzer, f sql.SortField, schema sql.Schema) (sql.Sort
 		uc := expression.NewUnresolvedQualifiedColumn(schema[idx].Source, schema[idx].Name)
 		return sql.SortField{
 			Column:       uc,
-			Column2:      uc,
 			Order:        f.Order,
 			NullOrdering: f.NullOrdering,
 		}, transform.NewTree, nil
@@ -374,7 +373,6 @@ func resolveSortField(a *Analyzer, f sql.SortField, schema sql.Schema) (sql.Sort
 		uc := expression.NewUnresolvedColumn(name)
 		return sql.SortField{
 			Column:       uc,
-			Column2:      uc,
 			Order:        f.Order,
 			NullOrdering: f.NullOrdering,
 		}, transform.NewTree, nil
@@ -247,6 +247,10 @@ func analyzeSubqueryAlias(ctx *sql.Context, a *Analyzer, sqa *plan.SubqueryAlias
 		child, same, err = a.analyzeThroughBatch(ctx, sqa.Child, subScope, "default-rules", sel)
 	}
 	if err != nil {
+		// the tables or columns a view reads may have been dropped after the view was created
+		if sqa.ViewDatabase != "" && (sql.ErrTableNotFound.Is(err) || sql.ErrColumnNotFound.Is(err) || sql.ErrTableColumnNotFound.Is(err)) {
+			return nil, same, sql.ErrViewInvalid.New(sqa.ViewDatabase, sqa.Name())
+		}
 		return nil, same, err
 	}
 
@@ -337,9 +341,53 @@ func nodeIsCacheable(n sql.Node, lowestAllowedIdx int) bool {
 	return cacheable
 }
 
+// correlatedColumns returns the indexes of the outer scope columns, those below |scopeLen|, referenced by the node
+// given. It returns false if the results of the node depend on anything other than the values of those columns, such
+// as non-deterministic expressions.
+func correlatedColumns(n sql.Node, scopeLen int) (sql.FastIntSet, bool) {
+	var cols sql.FastIntSet
+	ok := true
+	inspectExpr := func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if e.Index() < scopeLen {
+				cols.Add(e.Index())
+			}
+		case *plan.Subquery:
+			subCols, subOk := correlatedColumns(e.Query, scopeLen)
+			cols.UnionWith(subCols)
+			ok = ok && subOk
+		case *deferredColumn, sql.NonDeterministicExpression:
+			ok = false
+		}
+		return ok
+	}
+	transform.Inspect(n, func(node sql.Node) bool {
+		if !ok {
+			return false
+		}
+		if er, isExpressioner := node.(sql.Expressioner); isExpressioner {
+			for _, expr := range er.Expressions() {
+				sql.Inspect(expr, inspectExpr)
+			}
+		} else if sqa, isSqa := node.(*plan.SubqueryAlias); isSqa {
+			if sqa.OuterScopeVisibility && !sqa.CacheableCTESource {
+				subCols, subOk := correlatedColumns(sqa.Child, scopeLen)
+				cols.UnionWith(subCols)
+				ok = ok && subOk
+			}
+			return false
+		}
+		return ok
+	})
+	return cols, ok
+}
+
 // cacheSubqueryResults determines whether it's safe to cache the results for subqueries (expressions and aliases), and marks the
 // subquery as cacheable if so. Caching subquery results is safe in the case that no outer scope columns are referenced,
-// if all expressions in the subquery are deterministic, and if the subquery isn't inside a trigger block.
+// if all expressions in the subquery are deterministic, and if the subquery isn't inside a trigger block. Correlated
+// subquery expressions that are otherwise deterministic have their results memoized on the values of the outer scope
+// columns they reference instead.
 func cacheSubqueryResults(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	if !scope.IsEmpty() {
 		// triggers cannot be cached
@@ -387,8 +435,11 @@ func cacheSubqueryResultsHelper(ctx *sql.Context, a *Analyzer, node sql.Node, sc
 				if !same {
 					sq = sq.WithQuery(newQ)
 				}
-				if nodeIsCacheable(sq.Query, len(subScope.Schema())) {
+				scopeLen := len(subScope.Schema())
+				if nodeIsCacheable(sq.Query, scopeLen) {
 					return sq.WithCachedResults(), transform.NewTree, nil
+				} else if cols, ok := correlatedColumns(sq.Query, scopeLen); ok && !cols.Empty() {
+					return sq.WithCorrelatedCache(cols, scopeLen), transform.NewTree, nil
 				} else if !same {
 					return sq, transform.NewTree, nil
 				}
@@ -79,7 +79,7 @@ func TestResolveSubqueries(t *testing.T) {
 									plan.NewResolvedTable(bar.WithProjections(make([]string, 0)), db, nil)),
 							),
 						), "select MAX(a) from (select a from bar) sqa1",
-					).WithExecBuilder(rowexec.DefaultBuilder),
+					).WithCorrelatedCache(sql.NewFastIntSet(0), 1).WithExecBuilder(rowexec.DefaultBuilder),
 				},
 				plan.NewResolvedTable(foo.WithProjections([]string{"a"}), db, nil),
 			),
@@ -123,10 +123,9 @@ func TestResolveSubqueries(t *testing.T) {
 					plan.NewCrossJoin(
 						newSubqueryAlias("t1", "", false, true, plan.NewResolvedTable(foo.WithProjections([]string{"a"}), db, nil)),
 
+						// the derived table of t2 is merged into its query
 						newSubqueryAlias("t2", "", false, true,
-							newSubqueryAlias("t2alias", "", false, true,
-								plan.NewResolvedTable(bar.WithProjections([]string{"b"}), db, nil),
-							),
+							plan.NewResolvedTable(bar.WithProjections([]string{"b"}), db, nil),
 						),
 					),
 					plan.NewUnresolvedTable("baz", ""),
@@ -517,6 +516,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					uc("i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytable2", "y"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(1, "mytable", "x"),
+									gf(2, "mytable2", "i"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithCorrelatedCache(sql.NewFastIntSet(1), 2).WithExecBuilder(rowexec.DefaultBuilder),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "cacheable",
@@ -562,7 +581,7 @@ func TestCacheSubqueryResults(t *testing.T) {
 			),
 		},
 		{
-			name: "not cacheable, outer scope referenced",
+			name: "correlated, outer scope referenced",
 			node: plan.NewProject(
 				[]sql.Expression{
 					gf(0, "mytable", "i"),
@@ -583,6 +602,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					gf(0, "mytable", "i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytables", "x"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(0, "mytable", "i"),
+									gf(3, "mytable2", "x"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithCorrelatedCache(sql.NewFastIntSet(0), 2).WithExecBuilder(rowexec.DefaultBuilder),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "not cacheable, non-deterministic expression",
@@ -39,6 +39,10 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 
 		switch p := c.Node.(type) {
 		case *plan.UnresolvedTable:
+			p, err := withScopeDatabase(ctx, a, scope, p)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
 			r, err := resolveTable(ctx, p, a)
 			if sql.ErrTableNotFound.Is(err) && ignore {
 				return p, transform.SameTree, nil
@@ -107,6 +111,26 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 	})
 }
 
+// withScopeDatabase returns the table given with its database resolved by scopeDatabase, when the scope is within a
+// stored program executed with the privileges of its definer.
+func withScopeDatabase(ctx *sql.Context, a *Analyzer, scope *plan.Scope, t *plan.UnresolvedTable) (*plan.UnresolvedTable, error) {
+	if _, ok := t.Database().(sql.UnresolvedDatabase); !ok || scope.Definer() == "" {
+		return t, nil
+	}
+	dbName := t.Database().Name()
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	if dbName == "" {
+		return t, nil
+	}
+	db, err := scopeDatabase(ctx, a, scope, dbName)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewUnresolvedTableAsOfWithDatabase(t.Name(), db, t.AsOf()).WithComment(t.Comment()).(*plan.UnresolvedTable), nil
+}
+
 func resolveTable(ctx *sql.Context, t sql.UnresolvedTable, a *Analyzer) (sql.Node, error) {
 	name := t.Name()
 	db := t.Database()
@@ -252,6 +276,10 @@ func reresolveTables(ctx *sql.Context, a *Analyzer, node sql.Node, scope *plan.S
 					return nil, transform.SameTree, err
 				}
 			}
+			// the tables masked for the user stay masked
+			if mt, ok := n.Table.(*plan.MaskedTable); ok {
+				to.(*plan.ResolvedTable).Table = mt.WithTable(to.(*plan.ResolvedTable).Table)
+			}
 			new := transferProjections(ctx, from, to.(*plan.ResolvedTable))
 			return new, transform.NewTree, nil
 		case *plan.IndexedTableAccess:
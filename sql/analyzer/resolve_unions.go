@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"reflect"
+	"strings"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -140,18 +141,19 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 				if types.IsDeferredType(ls[i].Type) || types.IsDeferredType(rs[i].Type) {
 					continue
 				}
-				hasdiff = true
 
-				// try to get optimal type to convert both into
-				convertTo := getConvertToType(ls[i].Type, rs[i].Type)
-
-				// TODO: Principled type coercion...
-				les[i] = expression.NewConvert(les[i], convertTo)
-				res[i] = expression.NewConvert(res[i], convertTo)
-
-				// Preserve schema names across the conversion.
-				les[i] = expression.NewAlias(ls[i].Name, les[i])
-				res[i] = expression.NewAlias(rs[i].Name, res[i])
+				// Preserve schema names across the conversion. The schema of the union is the schema of its left
+				// child, so only the left child of NULL columns is converted. NULL is also the type of procedure
+				// parameters before they are bound, which must not be converted to the type of the other child.
+				convertTo := types.AggregateTypes(ls[i].Type, rs[i].Type)
+				if !reflect.DeepEqual(ls[i].Type, convertTo) {
+					les[i] = expression.NewAlias(ls[i].Name, expression.NewTypeConversion(les[i], convertTo))
+					hasdiff = true
+				}
+				if rs[i].Type != types.Null && !reflect.DeepEqual(rs[i].Type, convertTo) {
+					res[i] = expression.NewAlias(rs[i].Name, expression.NewTypeConversion(res[i], convertTo))
+					hasdiff = true
+				}
 			}
 			if hasdiff {
 				n, err := u.WithChildren(
@@ -161,6 +163,10 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 				if err != nil {
 					return nil, transform.SameTree, err
 				}
+				n, _, err = requalifyUnionSortFields(n.(*plan.Union), ls)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
 				return n, transform.NewTree, nil
 			}
 		}
@@ -168,26 +174,25 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 	})
 }
 
-// getConvertToType returns which type the both left and right values should be converted to.
-// If neither sql.Type represent number, then converted to string. Otherwise, we try to get
-// the appropriate type to avoid any precision loss.
-func getConvertToType(l, r sql.Type) string {
-	if !types.IsNumber(l) || !types.IsNumber(r) {
-		return expression.ConvertToChar
-	}
-
-	if types.IsDecimal(l) || types.IsDecimal(r) {
-		return expression.ConvertToDecimal
+// requalifyUnionSortFields replaces the references to the columns of |oldSchema|, the schema of the union given
+// before its children were projected to a common type, in the sort fields of the union with references to the
+// columns of its current schema. The projections don't preserve the source of the columns they convert.
+func requalifyUnionSortFields(u *plan.Union, oldSchema sql.Schema) (sql.Node, transform.TreeIdentity, error) {
+	if len(u.SortFields) == 0 {
+		return u, transform.SameTree, nil
 	}
-	if types.IsUnsigned(l) && types.IsUnsigned(r) {
-		return expression.ConvertToUnsigned
-	}
-	if types.IsSigned(l) && types.IsSigned(r) {
-		return expression.ConvertToSigned
-	}
-	if types.IsInteger(l) && types.IsInteger(r) {
-		return expression.ConvertToSigned
-	}
-
-	return expression.ConvertToChar
+	newSchema := u.Schema()
+	return transform.OneNodeExprsWithNode(u, func(_ sql.Node, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		for i, c := range oldSchema {
+			if strings.EqualFold(c.Name, gf.Name()) && strings.EqualFold(c.Source, gf.Table()) {
+				col := newSchema[i]
+				return expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable), transform.NewTree, nil
+			}
+		}
+		return e, transform.SameTree, nil
+	})
 }
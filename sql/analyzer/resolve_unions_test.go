@@ -73,7 +73,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 			errors.New("this is an error"),
 		},
 		{
-			"Mismatched Types Coerced to Strings",
+			"Mismatched Types Aggregated",
 			plan.NewUnion(plan.NewProject(
 				[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
@@ -83,8 +83,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 			), false, nil, nil, nil),
 			plan.NewUnion(plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("1", expression.NewConvert(
-						expression.NewGetField(0, types.Int64, "1", false), "signed")),
+					expression.NewGetField(0, types.Int64, "1", false),
 				},
 				plan.NewProject(
 					[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
@@ -92,8 +91,8 @@ func TestMergeUnionSchemas(t *testing.T) {
 				),
 			), plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("3", expression.NewConvert(
-						expression.NewGetField(0, types.Int32, "3", false), "signed")),
+					expression.NewAlias("3", expression.NewTypeConversion(
+						expression.NewGetField(0, types.Int32, "3", false), types.Int64)),
 				},
 				plan.NewProject(
 					[]sql.Expression{expression.NewLiteral(int32(3), types.Int32)},
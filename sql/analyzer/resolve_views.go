@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/parse"
@@ -56,11 +57,30 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 					return nil, transform.SameTree, verr
 				}
 				if vdok {
+					var err error
 					query, qerr := parse.Parse(ctx, viewDef.TextDefinition)
 					if qerr != nil {
 						return nil, transform.SameTree, qerr
 					}
-					view = plan.NewSubqueryAlias(viewName, viewDef.TextDefinition, query).AsView(viewDef.CreateViewStatement)
+					viewDef = loadViewAttributes(ctx, viewDef)
+					securityType := viewDef.SecurityType
+					if securityType == "" {
+						securityType = "DEFINER"
+					}
+					if mysqlDb := a.Catalog.MySQLDb; mysqlDb.Enabled && strings.EqualFold(securityType, "DEFINER") &&
+						viewDef.Definer != "" && !mysqlDb.DefinerExists(viewDef.Definer) {
+						return nil, transform.SameTree, sql.ErrDefinerDoesNotExist.New(viewDef.Definer)
+					}
+					// tables read as of a revision are resolved later with the revision
+					if a.Catalog.MySQLDb.Enabled && strings.EqualFold(securityType, "DEFINER") && viewDef.Definer != "" && urt.AsOf() == nil {
+						if query, err = resolveDefinerViewTables(ctx, a, query, dbName); err != nil {
+							return nil, transform.SameTree, err
+						}
+					}
+					sqa := plan.NewSubqueryAlias(viewName, viewDef.TextDefinition, query)
+					sqa.Columns = viewDef.Columns
+					sqa.Algorithm = strings.ToUpper(viewDef.Algorithm)
+					view = sqa.WithViewSecurity(dbName, viewDef.Definer, securityType).AsView(viewDef.CreateViewStatement)
 				}
 			}
 		}
@@ -98,10 +118,65 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
+		// the hints of the query are in the comment of its first table
+		if urt.Comment() != "" {
+			n = n.(*plan.SubqueryAlias).WithComment(urt.Comment())
+		}
 		return n, transform.NewTree, nil
 	})
 }
 
+// loadViewAttributes returns the view definition given with the column list and attributes of the view read from its
+// CREATE VIEW statement, if the database it's from doesn't store them.
+func loadViewAttributes(ctx *sql.Context, viewDef sql.ViewDefinition) sql.ViewDefinition {
+	if viewDef.Definer != "" || viewDef.SecurityType != "" || len(viewDef.Columns) > 0 || viewDef.CreateViewStatement == "" {
+		return viewDef
+	}
+	parsed, err := parse.Parse(ctx, viewDef.CreateViewStatement)
+	if err != nil {
+		return viewDef
+	}
+	if cv, ok := parsed.(*plan.CreateView); ok {
+		viewDef.Columns = cv.Columns
+		viewDef.Algorithm = cv.Algorithm
+		// a statement without a DEFINER clause is parsed with the user of the session as its definer
+		if strings.Contains(strings.ToLower(viewDef.CreateViewStatement), "definer") {
+			viewDef.Definer = cv.Definer
+		}
+		viewDef.SecurityType = cv.Security
+	}
+	return viewDef
+}
+
+// resolveDefinerViewTables resolves the tables read by the query of a view with SQL SECURITY DEFINER, which the user
+// may not have the privileges to access. The privileges of the definer to read them are checked by the view. Views
+// the query reads are resolved later as usual. Unqualified tables are in |viewDb|, the database of the view.
+func resolveDefinerViewTables(ctx *sql.Context, a *Analyzer, query sql.Node, viewDb string) (sql.Node, error) {
+	n, _, err := transform.Node(query, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		urt, ok := n.(*plan.UnresolvedTable)
+		if !ok || urt.AsOf() != nil {
+			return n, transform.SameTree, nil
+		}
+		dbName := urt.Database().Name()
+		if dbName == "" {
+			dbName = viewDb
+		}
+		if dbName == "" || strings.EqualFold(dbName, sql.InformationSchemaDatabaseName) {
+			return n, transform.SameTree, nil
+		}
+		db, err := a.Catalog.Provider.Database(ctx, dbName)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		table, ok, err := db.GetTableInsensitive(ctx, urt.Name())
+		if err != nil || !ok {
+			return n, transform.SameTree, err
+		}
+		return plan.NewResolvedTable(table, db, nil), transform.NewTree, nil
+	})
+	return n, err
+}
+
 // applyAsOfToView transforms the nodes in the view's execution plan to apply the asOf expression to every
 // individual table involved in the view.
 func applyAsOfToView(n sql.Node, a *Analyzer, asOf sql.Expression) (sql.Node, transform.TreeIdentity, error) {
@@ -0,0 +1,147 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// rowAccessPolicyName is the name of the check constraints that enforce the row access policies of a table on the
+// rows written to it, which is reported when a row doesn't satisfy them.
+const rowAccessPolicyName = "row access policy"
+
+// applyRowAccessPolicies folds the row access policies of the sql.RowAccessPolicyTable tables of the node into it. The
+// rows read from those tables are filtered by the policies, and the rows inserted or updated must satisfy them, as if
+// they were check constraints. Subqueries and insert sources are analyzed on their own, so this rule applies to them
+// then.
+func applyRowAccessPolicies(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("applyRowAccessPolicies")
+	defer span.End()
+
+	// DDL and SHOW statements refer to tables without reading their rows
+	if plan.IsNoRowNode(n) {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.NodeWithCtx(n, nil, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch node := c.Node.(type) {
+		case *plan.ResolvedTable:
+			switch c.Parent.(type) {
+			case *plan.TableAlias:
+				// the rows are filtered above the alias, which the columns of the policies are qualified with
+				return node, transform.SameTree, nil
+			case *plan.InsertInto:
+				// the destination of an insert isn't read
+				return node, transform.SameTree, nil
+			}
+			return filterRowsByPolicies(ctx, node, node.Name(), node)
+		case *plan.TableAlias:
+			rt, ok := node.Child.(*plan.ResolvedTable)
+			if !ok {
+				return node, transform.SameTree, nil
+			}
+			return filterRowsByPolicies(ctx, rt, node.Name(), node)
+		case *plan.InsertInto:
+			rt := getResolvedTable(node.Destination)
+			if rt == nil {
+				return node, transform.SameTree, nil
+			}
+			checks, err := rowAccessPolicyChecks(ctx, rt.Table)
+			if err != nil || len(checks) == 0 {
+				return node, transform.SameTree, err
+			}
+			nn := *node
+			nn.Checks = append(append(sql.CheckConstraints{}, node.Checks...), checks...)
+			return &nn, transform.NewTree, nil
+		case *plan.Update:
+			rt := getResolvedTable(node.Child)
+			if rt == nil {
+				return node, transform.SameTree, nil
+			}
+			checks, err := rowAccessPolicyChecks(ctx, rt.Table)
+			if err != nil || len(checks) == 0 {
+				return node, transform.SameTree, err
+			}
+			nn := *node
+			nn.Checks = append(append(sql.CheckConstraints{}, node.Checks...), checks...)
+			return &nn, transform.NewTree, nil
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// filterRowsByPolicies returns |n|, which reads the rows of |rt| as |tableName|, filtered by the row access policies
+// of its table. The columns of the policies are qualified with |tableName|, since the table may be joined with others
+// that have the same columns, like itself.
+func filterRowsByPolicies(ctx *sql.Context, rt *plan.ResolvedTable, tableName string, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	policies, err := rowAccessPolicies(ctx, rt.Table)
+	if err != nil || len(policies) == 0 {
+		return n, transform.SameTree, err
+	}
+	filter, _, err := transform.Expr(expression.JoinAnd(policies...), func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if col, ok := e.(*expression.UnresolvedColumn); ok && col.Table() == "" {
+			return expression.NewUnresolvedQualifiedColumn(tableName, col.Name()), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return plan.NewFilter(filter, n), transform.NewTree, nil
+}
+
+// rowAccessPolicyChecks returns the check constraints that the rows written to the table given must satisfy, which
+// are its row access policies. Unlike regular check constraints, a policy that evaluates to NULL rejects the row,
+// since the row couldn't be read back.
+func rowAccessPolicyChecks(ctx *sql.Context, table sql.Table) (sql.CheckConstraints, error) {
+	policies, err := rowAccessPolicies(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	var checks sql.CheckConstraints
+	for _, policy := range policies {
+		checks = append(checks, &sql.CheckConstraint{
+			Name:     rowAccessPolicyName,
+			Expr:     expression.NewIsTrue(policy),
+			Enforced: true,
+		})
+	}
+	return checks, nil
+}
+
+// rowAccessPolicies returns the parsed row access policies of the table given for the current user, if any.
+func rowAccessPolicies(ctx *sql.Context, table sql.Table) ([]sql.Expression, error) {
+	rat, ok := table.(sql.RowAccessPolicyTable)
+	if !ok {
+		return nil, nil
+	}
+	predicates, err := rat.RowAccessPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var policies []sql.Expression
+	for _, predicate := range predicates {
+		check, err := ConvertCheckDefToConstraint(ctx, &sql.CheckDefinition{Name: rowAccessPolicyName, CheckExpression: predicate})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, check.Expr)
+	}
+	return policies, nil
+}
@@ -29,6 +29,8 @@ const (
 	resolveDropConstraintId                      // resolveDropConstraint
 	validateDropConstraintId                     // validateDropConstraint
 	loadCheckConstraintsId                       // loadCheckConstraints
+	applyRowAccessPoliciesId                     // applyRowAccessPolicies
+	applyColumnMasksId                           // applyColumnMasks
 	assignCatalogId                              // assignCatalog
 	resolveAnalyzeTablesId                       // resolveAnalyzeTables
 	resolveCreateSelectId                        // resolveCreateSelect
@@ -47,6 +49,7 @@ const (
 	loadInfoSchemaId                             // loadInfoSchema
 	validateReadOnlyDatabaseId                   // validateReadOnlyDatabase
 	validateReadOnlyTransactionId                // validateReadOnlyTransaction
+	validateReadOnlyModeId                       // validateReadOnlyMode
 	validateDatabaseSetId                        // validateDatabaseSet
 	validatePrivilegesId                         // validatePrivileges
 	reresolveTablesId                            // reresolveTables
@@ -93,16 +96,24 @@ const (
 	removeUnnecessaryConvertsId  // removeUnnecessaryConverts
 	pruneColumnsId               // pruneColumns
 	stripTableNameInDefaultsId   // stripTableNamesFromColumnDefaults
+	mergeDerivedTablesId         // mergeDerivedTables
 	foldEmptyJoinsId             // foldEmptyJoins
+	reduceOuterJoinsId           // reduceOuterJoins
+	eliminateUnusedJoinsId       // eliminateUnusedJoins
+	propagateFilterEqualitiesId  // propagateFilterEqualities
 	optimizeJoinsId              // optimizeJoins
+	convertInListsToSemiJoinsId  // convertInListsToSemiJoins
 	generateIndexScansId         // generateIndexScans
 	pushFiltersId                // pushFilters
+	pruneShardsId                // pruneShards
 	subqueryIndexesId            // subqueryIndexes
 	pruneTablesId                // pruneTables
 	fixupAuxiliaryExprsId        // fixupAuxiliaryExprs
 	setJoinScopeLenId            // setJoinScopeLen
 	eraseProjectionId            // eraseProjection
 	replaceSortPkId              // replaceSortPk
+	pushdownLimitAndSortId       // pushdownLimitAndSort
+	pushdownTableSampleId        // pushdownTableSample
 	insertTopNId                 // insertTopN
 	applyHashInId                // applyHashIn
 	resolveInsertRowsId          // resolveInsertRows
@@ -133,8 +144,8 @@ const (
 	// after all
 	cacheSubqueryResultsId        // cacheSubqueryResults
 	cacheSubqueryAliasesInJoinsId // cacheSubqueryAliasesInJoins
+	precompileExpressionsId       // precompileExpressions
 	AutocommitId                  // addAutocommitNode
 	TrackProcessId                // trackProcess
 	parallelizeId                 // parallelize
-	clearWarningsId               // clearWarnings
 )
@@ -31,111 +31,122 @@ func _() {
 	_ = x[resolveDropConstraintId-20]
 	_ = x[validateDropConstraintId-21]
 	_ = x[loadCheckConstraintsId-22]
-	_ = x[assignCatalogId-23]
-	_ = x[resolveAnalyzeTablesId-24]
-	_ = x[resolveCreateSelectId-25]
-	_ = x[resolveSubqueriesId-26]
-	_ = x[setViewTargetSchemaId-27]
-	_ = x[resolveUnionsId-28]
-	_ = x[resolveDescribeQueryId-29]
-	_ = x[checkUniqueTableNamesId-30]
-	_ = x[resolveTableFunctionsId-31]
-	_ = x[resolveDeclarationsId-32]
-	_ = x[resolveColumnDefaultsId-33]
-	_ = x[validateColumnDefaultsId-34]
-	_ = x[validateCreateTriggerId-35]
-	_ = x[validateCreateProcedureId-36]
-	_ = x[resolveCreateProcedureId-37]
-	_ = x[loadInfoSchemaId-38]
-	_ = x[validateReadOnlyDatabaseId-39]
-	_ = x[validateReadOnlyTransactionId-40]
-	_ = x[validateDatabaseSetId-41]
-	_ = x[validatePrivilegesId-42]
-	_ = x[reresolveTablesId-43]
-	_ = x[setInsertColumnsId-44]
-	_ = x[validateJoinComplexityId-45]
-	_ = x[applyBinlogReplicaControllerId-46]
-	_ = x[resolveNaturalJoinsId-47]
-	_ = x[resolveOrderbyLiteralsId-48]
-	_ = x[resolveFunctionsId-49]
-	_ = x[flattenTableAliasesId-50]
-	_ = x[pushdownSortId-51]
-	_ = x[pushdownGroupbyAliasesId-52]
-	_ = x[pushdownSubqueryAliasFiltersId-53]
-	_ = x[qualifyColumnsId-54]
-	_ = x[resolveColumnsId-55]
-	_ = x[validateCheckConstraintId-56]
-	_ = x[resolveBarewordSetVariablesId-57]
-	_ = x[replaceCountStarId-58]
-	_ = x[expandStarsId-59]
-	_ = x[transposeRightJoinsId-60]
-	_ = x[resolveHavingId-61]
-	_ = x[mergeUnionSchemasId-62]
-	_ = x[flattenAggregationExprsId-63]
-	_ = x[reorderProjectionId-64]
-	_ = x[resolveSubqueryExprsId-65]
-	_ = x[replaceCrossJoinsId-66]
-	_ = x[moveJoinCondsToFilterId-67]
-	_ = x[evalFilterId-68]
-	_ = x[optimizeDistinctId-69]
-	_ = x[hoistOutOfScopeFiltersId-70]
-	_ = x[transformJoinApplyId-71]
-	_ = x[hoistSelectExistsId-72]
-	_ = x[finalizeSubqueriesId-73]
-	_ = x[finalizeUnionsId-74]
-	_ = x[loadTriggersId-75]
-	_ = x[loadEventsId-76]
-	_ = x[processTruncateId-77]
-	_ = x[resolveAlterColumnId-78]
-	_ = x[resolveGeneratorsId-79]
-	_ = x[removeUnnecessaryConvertsId-80]
-	_ = x[pruneColumnsId-81]
-	_ = x[stripTableNameInDefaultsId-82]
-	_ = x[foldEmptyJoinsId-83]
-	_ = x[optimizeJoinsId-84]
-	_ = x[generateIndexScansId-85]
-	_ = x[pushFiltersId-86]
-	_ = x[subqueryIndexesId-87]
-	_ = x[pruneTablesId-88]
-	_ = x[fixupAuxiliaryExprsId-89]
-	_ = x[setJoinScopeLenId-90]
-	_ = x[eraseProjectionId-91]
-	_ = x[replaceSortPkId-92]
-	_ = x[insertTopNId-93]
-	_ = x[applyHashInId-94]
-	_ = x[resolveInsertRowsId-95]
-	_ = x[resolvePreparedInsertId-96]
-	_ = x[applyTriggersId-97]
-	_ = x[applyProceduresId-98]
-	_ = x[assignRoutinesId-99]
-	_ = x[modifyUpdateExprsForJoinId-100]
-	_ = x[applyRowUpdateAccumulatorsId-101]
-	_ = x[wrapWithRollbackId-102]
-	_ = x[applyFKsId-103]
-	_ = x[validateResolvedId-104]
-	_ = x[validateOrderById-105]
-	_ = x[validateGroupById-106]
-	_ = x[validateSchemaSourceId-107]
-	_ = x[validateIndexCreationId-108]
-	_ = x[validateOperandsId-109]
-	_ = x[validateCaseResultTypesId-110]
-	_ = x[validateIntervalUsageId-111]
-	_ = x[validateExplodeUsageId-112]
-	_ = x[validateSubqueryColumnsId-113]
-	_ = x[validateUnionSchemasMatchId-114]
-	_ = x[validateAggregationsId-115]
-	_ = x[validateDeleteFromId-116]
-	_ = x[cacheSubqueryResultsId-117]
-	_ = x[cacheSubqueryAliasesInJoinsId-118]
-	_ = x[AutocommitId-119]
-	_ = x[TrackProcessId-120]
-	_ = x[parallelizeId-121]
-	_ = x[clearWarningsId-122]
+	_ = x[applyRowAccessPoliciesId-23]
+	_ = x[applyColumnMasksId-24]
+	_ = x[assignCatalogId-25]
+	_ = x[resolveAnalyzeTablesId-26]
+	_ = x[resolveCreateSelectId-27]
+	_ = x[resolveSubqueriesId-28]
+	_ = x[setViewTargetSchemaId-29]
+	_ = x[resolveUnionsId-30]
+	_ = x[resolveDescribeQueryId-31]
+	_ = x[checkUniqueTableNamesId-32]
+	_ = x[resolveTableFunctionsId-33]
+	_ = x[resolveDeclarationsId-34]
+	_ = x[resolveColumnDefaultsId-35]
+	_ = x[validateColumnDefaultsId-36]
+	_ = x[validateCreateTriggerId-37]
+	_ = x[validateCreateProcedureId-38]
+	_ = x[resolveCreateProcedureId-39]
+	_ = x[loadInfoSchemaId-40]
+	_ = x[validateReadOnlyDatabaseId-41]
+	_ = x[validateReadOnlyTransactionId-42]
+	_ = x[validateReadOnlyModeId-43]
+	_ = x[validateDatabaseSetId-44]
+	_ = x[validatePrivilegesId-45]
+	_ = x[reresolveTablesId-46]
+	_ = x[setInsertColumnsId-47]
+	_ = x[validateJoinComplexityId-48]
+	_ = x[applyBinlogReplicaControllerId-49]
+	_ = x[resolveNaturalJoinsId-50]
+	_ = x[resolveOrderbyLiteralsId-51]
+	_ = x[resolveFunctionsId-52]
+	_ = x[flattenTableAliasesId-53]
+	_ = x[pushdownSortId-54]
+	_ = x[pushdownGroupbyAliasesId-55]
+	_ = x[pushdownSubqueryAliasFiltersId-56]
+	_ = x[qualifyColumnsId-57]
+	_ = x[resolveColumnsId-58]
+	_ = x[validateCheckConstraintId-59]
+	_ = x[resolveBarewordSetVariablesId-60]
+	_ = x[replaceCountStarId-61]
+	_ = x[expandStarsId-62]
+	_ = x[transposeRightJoinsId-63]
+	_ = x[resolveHavingId-64]
+	_ = x[mergeUnionSchemasId-65]
+	_ = x[flattenAggregationExprsId-66]
+	_ = x[reorderProjectionId-67]
+	_ = x[resolveSubqueryExprsId-68]
+	_ = x[replaceCrossJoinsId-69]
+	_ = x[moveJoinCondsToFilterId-70]
+	_ = x[evalFilterId-71]
+	_ = x[optimizeDistinctId-72]
+	_ = x[hoistOutOfScopeFiltersId-73]
+	_ = x[transformJoinApplyId-74]
+	_ = x[hoistSelectExistsId-75]
+	_ = x[finalizeSubqueriesId-76]
+	_ = x[finalizeUnionsId-77]
+	_ = x[loadTriggersId-78]
+	_ = x[loadEventsId-79]
+	_ = x[processTruncateId-80]
+	_ = x[resolveAlterColumnId-81]
+	_ = x[resolveGeneratorsId-82]
+	_ = x[removeUnnecessaryConvertsId-83]
+	_ = x[pruneColumnsId-84]
+	_ = x[stripTableNameInDefaultsId-85]
+	_ = x[mergeDerivedTablesId-86]
+	_ = x[foldEmptyJoinsId-87]
+	_ = x[reduceOuterJoinsId-88]
+	_ = x[eliminateUnusedJoinsId-89]
+	_ = x[propagateFilterEqualitiesId-90]
+	_ = x[optimizeJoinsId-91]
+	_ = x[convertInListsToSemiJoinsId-92]
+	_ = x[generateIndexScansId-93]
+	_ = x[pushFiltersId-94]
+	_ = x[pruneShardsId-95]
+	_ = x[subqueryIndexesId-96]
+	_ = x[pruneTablesId-97]
+	_ = x[fixupAuxiliaryExprsId-98]
+	_ = x[setJoinScopeLenId-99]
+	_ = x[eraseProjectionId-100]
+	_ = x[replaceSortPkId-101]
+	_ = x[pushdownLimitAndSortId-102]
+	_ = x[pushdownTableSampleId-103]
+	_ = x[insertTopNId-104]
+	_ = x[applyHashInId-105]
+	_ = x[resolveInsertRowsId-106]
+	_ = x[resolvePreparedInsertId-107]
+	_ = x[applyTriggersId-108]
+	_ = x[applyProceduresId-109]
+	_ = x[assignRoutinesId-110]
+	_ = x[modifyUpdateExprsForJoinId-111]
+	_ = x[applyRowUpdateAccumulatorsId-112]
+	_ = x[wrapWithRollbackId-113]
+	_ = x[applyFKsId-114]
+	_ = x[validateResolvedId-115]
+	_ = x[validateOrderById-116]
+	_ = x[validateGroupById-117]
+	_ = x[validateSchemaSourceId-118]
+	_ = x[validateIndexCreationId-119]
+	_ = x[validateOperandsId-120]
+	_ = x[validateCaseResultTypesId-121]
+	_ = x[validateIntervalUsageId-122]
+	_ = x[validateExplodeUsageId-123]
+	_ = x[validateSubqueryColumnsId-124]
+	_ = x[validateUnionSchemasMatchId-125]
+	_ = x[validateAggregationsId-126]
+	_ = x[validateDeleteFromId-127]
+	_ = x[cacheSubqueryResultsId-128]
+	_ = x[cacheSubqueryAliasesInJoinsId-129]
+	_ = x[precompileExpressionsId-130]
+	_ = x[AutocommitId-131]
+	_ = x[TrackProcessId-132]
+	_ = x[parallelizeId-133]
 }
 
-const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablespruneDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsassignCatalogresolveAnalyzeTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureresolveCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerresolveNaturalJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarstransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFilterevalFilteroptimizeDistincthoistOutOfScopeFilterstransformJoinApplyhoistSelectExistsfinalizeSubqueriesfinalizeUnionsloadTriggersloadEventsprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertspruneColumnsstripTableNamesFromColumnDefaultsfoldEmptyJoinsoptimizeJoinsgenerateIndexScanspushFilterssubqueryIndexespruneTablesfixupAuxiliaryExprssetJoinScopeLeneraseProjectionreplaceSortPkinsertTopNapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsaddAutocommitNodetrackProcessparallelizeclearWarnings"
+const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablespruneDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsapplyRowAccessPoliciesapplyColumnMasksassignCatalogresolveAnalyzeTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureresolveCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateReadOnlyModevalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerresolveNaturalJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarstransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFilterevalFilteroptimizeDistincthoistOutOfScopeFilterstransformJoinApplyhoistSelectExistsfinalizeSubqueriesfinalizeUnionsloadTriggersloadEventsprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertspruneColumnsstripTableNamesFromColumnDefaultsmergeDerivedTablesfoldEmptyJoinsreduceOuterJoinseliminateUnusedJoinspropagateFilterEqualitiesoptimizeJoinsconvertInListsToSemiJoinsgenerateIndexScanspushFilterspruneShardssubqueryIndexespruneTablesfixupAuxiliaryExprssetJoinScopeLeneraseProjectionreplaceSortPkpushdownLimitAndSortpushdownTableSampleinsertTopNapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsprecompileExpressionsaddAutocommitNodetrackProcessparallelize"
 
-var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 102, 118, 137, 156, 168, 176, 187, 204, 220, 233, 253, 271, 286, 302, 319, 338, 359, 381, 401, 414, 434, 453, 470, 489, 502, 522, 543, 564, 583, 604, 626, 647, 670, 692, 706, 730, 757, 776, 794, 809, 825, 847, 875, 894, 916, 932, 951, 963, 985, 1013, 1027, 1041, 1064, 1091, 1107, 1118, 1137, 1150, 1167, 1190, 1207, 1227, 1244, 1265, 1275, 1291, 1313, 1331, 1348, 1366, 1380, 1392, 1402, 1417, 1435, 1452, 1477, 1489, 1522, 1536, 1549, 1567, 1578, 1593, 1604, 1623, 1638, 1653, 1666, 1676, 1687, 1704, 1725, 1738, 1753, 1767, 1791, 1817, 1834, 1842, 1858, 1873, 1888, 1908, 1929, 1945, 1968, 1989, 2009, 2032, 2057, 2077, 2095, 2115, 2142, 2159, 2171, 2182, 2195}
+var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 102, 118, 137, 156, 168, 176, 187, 204, 220, 233, 253, 271, 286, 302, 319, 338, 359, 381, 401, 423, 439, 452, 472, 491, 508, 527, 540, 560, 581, 602, 621, 642, 664, 685, 708, 730, 744, 768, 795, 815, 834, 852, 867, 883, 905, 933, 952, 974, 990, 1009, 1021, 1043, 1071, 1085, 1099, 1122, 1149, 1165, 1176, 1195, 1208, 1225, 1248, 1265, 1285, 1302, 1323, 1333, 1349, 1371, 1389, 1406, 1424, 1438, 1450, 1460, 1475, 1493, 1510, 1535, 1547, 1580, 1598, 1612, 1628, 1648, 1673, 1686, 1711, 1729, 1740, 1751, 1766, 1777, 1796, 1811, 1826, 1839, 1859, 1878, 1888, 1899, 1916, 1937, 1950, 1965, 1979, 2003, 2029, 2046, 2054, 2070, 2085, 2100, 2120, 2141, 2157, 2180, 2201, 2221, 2244, 2269, 2289, 2307, 2327, 2354, 2375, 2392, 2404, 2415}
 
 func (i RuleId) String() string {
 	if i < 0 || i >= RuleId(len(_RuleId_index)-1) {
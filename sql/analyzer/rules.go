@@ -41,6 +41,8 @@ var OnceBeforeDefault = []Rule{
 	{setInsertColumnsId, setInsertColumns},
 	{setTargetSchemasId, setTargetSchemas},
 	{loadCheckConstraintsId, loadChecks},
+	{applyRowAccessPoliciesId, applyRowAccessPolicies},
+	{applyColumnMasksId, applyColumnMasks},
 	{resolveAlterColumnId, resolveAlterColumn},
 	{validateDropTablesId, validateDropTables},
 	{pruneDropTablesId, pruneDropTables},
@@ -64,6 +66,7 @@ var OnceBeforeDefault = []Rule{
 	{validateColumnDefaultsId, validateColumnDefaults},
 	{validateReadOnlyDatabaseId, validateReadOnlyDatabase},
 	{validateReadOnlyTransactionId, validateReadOnlyTransaction},
+	{validateReadOnlyModeId, validateReadOnlyMode},
 	{validateDatabaseSetId, validateDatabaseSet},
 	{validateDeleteFromId, validateDeleteFrom},
 	{validatePrivilegesId, validatePrivileges}, // Ensure that checking privileges happens after db, table  & table function resolution
@@ -110,14 +113,22 @@ var OnceAfterDefault = []Rule{
 	{processTruncateId, processTruncate},
 	{removeUnnecessaryConvertsId, removeUnnecessaryConverts},
 	{stripTableNameInDefaultsId, stripTableNamesFromColumnDefaults},
+	{mergeDerivedTablesId, mergeDerivedTables},
 	{foldEmptyJoinsId, foldEmptyJoins},
+	{reduceOuterJoinsId, reduceOuterJoins},
+	{eliminateUnusedJoinsId, eliminateUnusedJoins},
+	{propagateFilterEqualitiesId, propagateFilterEqualities},
 	{pushFiltersId, pushFilters},
+	{pruneShardsId, pruneShards},
 	{optimizeJoinsId, optimizeJoins},
+	{convertInListsToSemiJoinsId, convertInListsToSemiJoins},
 	{generateIndexScansId, generateIndexScans},
 	{pruneColumnsId, pruneColumns},
 	{finalizeSubqueriesId, finalizeSubqueries},
 	{subqueryIndexesId, applyIndexesFromOuterScope},
 	{replaceSortPkId, replacePkSort},
+	{pushdownLimitAndSortId, pushdownLimitAndSort},
+	{pushdownTableSampleId, pushdownTableSample},
 	{setJoinScopeLenId, setJoinScopeLen},
 	{eraseProjectionId, eraseProjection},
 	{insertTopNId, insertTopNNodes},
@@ -152,10 +163,10 @@ var DefaultValidationRules = []Rule{
 var OnceAfterAll = []Rule{
 	{cacheSubqueryResultsId, cacheSubqueryResults},
 	{cacheSubqueryAliasesInJoinsId, cacheSubqueryAliasesInJoins},
+	{precompileExpressionsId, precompileExpressions},
 	{AutocommitId, addAutocommitNode},
 	{TrackProcessId, trackProcess},
 	{parallelizeId, parallelize},
-	{clearWarningsId, clearWarnings},
 }
 
 var (
@@ -42,6 +42,7 @@ var OnceBeforeDefault_Exp = []Rule{
 	{validateColumnDefaultsId, validateColumnDefaults},
 	{validateReadOnlyDatabaseId, validateReadOnlyDatabase},
 	{validateReadOnlyTransactionId, validateReadOnlyTransaction},
+	{validateReadOnlyModeId, validateReadOnlyMode},
 	{validateDatabaseSetId, validateDatabaseSet},
 	{validateDeleteFromId, validateDeleteFrom},
 	{validatePrivilegesId, validatePrivileges}, // Ensure that checking privileges happens after db, table  & table function resolution
@@ -74,9 +75,15 @@ var OnceAfterDefault_Experimental = []Rule{
 	{processTruncateId, processTruncate},
 	{removeUnnecessaryConvertsId, removeUnnecessaryConverts},
 	{stripTableNameInDefaultsId, stripTableNamesFromColumnDefaults},
+	{mergeDerivedTablesId, mergeDerivedTables},
 	{foldEmptyJoinsId, foldEmptyJoins},
+	{reduceOuterJoinsId, reduceOuterJoins},
+	{eliminateUnusedJoinsId, eliminateUnusedJoins},
+	{propagateFilterEqualitiesId, propagateFilterEqualities},
 	{pushFiltersId, pushFilters},
+	{pruneShardsId, pruneShards},
 	{optimizeJoinsId, optimizeJoins},
+	{convertInListsToSemiJoinsId, convertInListsToSemiJoins},
 	{generateIndexScansId, generateIndexScans},
 	//{pruneColumnsId, pruneColumns},
 	{finalizeSubqueriesId, finalizeSubqueries},
@@ -102,5 +109,4 @@ var OnceAfterAll_Experimental = []Rule{
 	{AutocommitId, addAutocommitNode},
 	{TrackProcessId, trackProcess},
 	{parallelizeId, parallelize},
-	{clearWarningsId, clearWarnings},
 }
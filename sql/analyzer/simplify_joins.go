@@ -0,0 +1,465 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/fixidx"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// reduceOuterJoins converts left joins into inner joins when the conditions of the Filter nodes or inner joins above
+// them reject the rows of their left children extended with NULLs, e.g. `a left join b on a.x = b.y where b.z = 1`.
+// Inner joins can be reordered, and looked up from either side.
+func reduceOuterJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch node := node.(type) {
+		case *plan.Filter:
+			child, same, err := rejectNullExtendedRows(a, node.Child, nullRejectedTables(node.Expression))
+			if err != nil || same {
+				return node, transform.SameTree, err
+			}
+			return plan.NewFilter(node.Expression, child), transform.NewTree, nil
+		case *plan.JoinNode:
+			if !node.Op.IsInner() || node.Filter == nil {
+				return node, transform.SameTree, nil
+			}
+			return rejectNullExtendedRows(a, node, nil)
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// rejectNullExtendedRows converts the left joins at the root of the node given whose right children have columns of
+// the tables in |rejected| into inner joins. The conditions of inner joins reject the NULLs of both their children.
+func rejectNullExtendedRows(a *Analyzer, n sql.Node, rejected map[string]bool) (sql.Node, transform.TreeIdentity, error) {
+	j, ok := n.(*plan.JoinNode)
+	if !ok {
+		return n, transform.SameTree, nil
+	}
+
+	op := j.Op
+	switch {
+	case op.IsInner():
+		if j.Filter != nil {
+			rejected = unionTables(rejected, nullRejectedTables(j.Filter))
+		}
+	case op == plan.JoinTypeLeftOuter:
+		for _, s := range nodeSources(j.Right()) {
+			if rejected[strings.ToLower(s)] {
+				op = plan.JoinTypeInner
+				a.Log("converted left join of %s into an inner join", s)
+				break
+			}
+		}
+	default:
+		return n, transform.SameTree, nil
+	}
+
+	l, lSame, err := rejectNullExtendedRows(a, j.Left(), rejected)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	r := j.Right()
+	rSame := transform.SameTree
+	if op.IsInner() {
+		r, rSame, err = rejectNullExtendedRows(a, r, rejected)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	if lSame && rSame && op == j.Op {
+		return n, transform.SameTree, nil
+	}
+	ret, err := j.WithChildren(l, r)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	ret.(*plan.JoinNode).Op = op
+	return ret, transform.NewTree, nil
+}
+
+// nullRejectedTables returns the tables for which the expression given is never true when all of their columns are
+// NULL. The operands of comparisons are only considered when they're columns, since functions like COALESCE and
+// IFNULL can turn NULLs into values.
+func nullRejectedTables(e sql.Expression) map[string]bool {
+	var cols []sql.Expression
+	switch e := e.(type) {
+	case *expression.And:
+		return unionTables(nullRejectedTables(e.Left), nullRejectedTables(e.Right))
+	case *expression.Or:
+		left, right := nullRejectedTables(e.Left), nullRejectedTables(e.Right)
+		tables := make(map[string]bool)
+		for t := range left {
+			if right[t] {
+				tables[t] = true
+			}
+		}
+		return tables
+	case *expression.Not:
+		// the negations of comparisons with NULL are NULL, but the negations of other false expressions are true
+		switch c := e.Child.(type) {
+		case *expression.IsNull:
+			cols = []sql.Expression{c.Child}
+		case *expression.Equals, *expression.GreaterThan, *expression.LessThan, *expression.GreaterThanOrEqual,
+			*expression.LessThanOrEqual, *expression.Like:
+			cols = c.Children()
+		}
+	case *expression.Equals, *expression.GreaterThan, *expression.LessThan, *expression.GreaterThanOrEqual,
+		*expression.LessThanOrEqual, *expression.Like, *expression.Between:
+		cols = e.Children()
+	case *expression.InTuple:
+		cols = []sql.Expression{e.Left()}
+	}
+
+	tables := make(map[string]bool)
+	for _, c := range cols {
+		if gf, ok := c.(*expression.GetField); ok {
+			tables[strings.ToLower(gf.Table())] = true
+		}
+	}
+	return tables
+}
+
+func unionTables(left, right map[string]bool) map[string]bool {
+	tables := make(map[string]bool, len(left)+len(right))
+	for t := range left {
+		tables[t] = true
+	}
+	for t := range right {
+		tables[t] = true
+	}
+	return tables
+}
+
+// eliminateUnusedJoins removes the left joins of tables whose columns aren't used outside of their join conditions, and
+// whose unique keys are equal to the columns of the left children of the joins or to constants in the conditions. Each
+// row of the left children matches at most one row of these tables, so that the joins return the rows of their left
+// children unchanged. Only the joins below Project nodes are considered, since the rows of the other nodes reach their
+// parents. The columns of subquery aliases unused by these Project nodes are removed from their projections, so that
+// the joins of views over other views can be eliminated when the subquery aliases are analyzed.
+func eliminateUnusedJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	selector := func(c transform.Context) bool {
+		switch c.Node.(type) {
+		case *plan.SubqueryAlias, *plan.Update, *plan.DeleteFrom:
+			// subquery aliases are analyzed separately, and DML with joins changes meaning
+			return false
+		}
+		return true
+	}
+	return transform.NodeWithCtx(n, selector, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		if _, ok := c.Node.(*plan.Project); !ok {
+			return c.Node, transform.SameTree, nil
+		}
+		ret := c.Node
+		same := transform.SameTree
+		for {
+			path, ok := newJoinPath(ret)
+			if !ok {
+				return ret, same, nil
+			}
+			old, replacement := path.prunableSubqueryAlias()
+			if old == nil {
+				j, err := path.unusedJoin(ctx)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				if j == nil {
+					return ret, same, nil
+				}
+				a.Log("eliminated unused left join of %s", tableSource(j.Right()))
+				old, replacement = j, j.Left()
+			} else {
+				a.Log("pruned unused columns of subquery alias %s", old.(*plan.SubqueryAlias).Name())
+			}
+			var err error
+			ret, _, err = replaceJoinPathNode(a, ret, old, replacement, scope)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			same = transform.NewTree
+		}
+	})
+}
+
+// isJoinPathNode returns whether the node given passes the rows of its children to its parent, so that a join below
+// it can be eliminated when it's unused.
+func isJoinPathNode(n sql.Node) bool {
+	switch n.(type) {
+	case *plan.Project, *plan.JoinNode, *plan.Filter, *plan.Sort, *plan.Limit, *plan.Offset, *plan.Distinct, *plan.TopN:
+		return true
+	default:
+		return false
+	}
+}
+
+// joinPath is the tree of nodes passing the rows of joins to a Project node.
+type joinPath struct {
+	joins   []*plan.JoinNode
+	aliases []*plan.SubqueryAlias
+	// exprs are the expressions of the nodes of the path, which are the only ones using the columns of the joins
+	exprs []sql.Expression
+}
+
+// newJoinPath returns the join path of the Project node given, and false if the columns of its joins can be used
+// elsewhere.
+func newJoinPath(p sql.Node) (*joinPath, bool) {
+	path := &joinPath{}
+	supported := true
+	transform.Inspect(p, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case nil:
+			return false
+		case *plan.JoinNode:
+			path.joins = append(path.joins, n)
+			path.exprs = append(path.exprs, n.Expressions()...)
+			return true
+		case *plan.ResolvedTable, *plan.TableAlias:
+			return false
+		case *plan.SubqueryAlias:
+			// lateral subqueries and subqueries with outer scope visibility can use the columns of the joins
+			supported = supported && !n.IsLateral && !n.OuterScopeVisibility
+			path.aliases = append(path.aliases, n)
+			return false
+		}
+		if !isJoinPathNode(n) {
+			supported = false
+			return false
+		}
+		if e, ok := n.(sql.Expressioner); ok {
+			path.exprs = append(path.exprs, e.Expressions()...)
+		}
+		return true
+	})
+	if !supported {
+		return nil, false
+	}
+	for _, e := range path.exprs {
+		// subqueries can use the columns of the joins
+		if transform.InspectExpr(e, func(e sql.Expression) bool {
+			_, ok := e.(*plan.Subquery)
+			return ok
+		}) {
+			return nil, false
+		}
+	}
+	return path, true
+}
+
+// prunableSubqueryAlias returns a subquery alias of the path whose projection has columns unused by the path, and the
+// subquery alias without them, or nil if there isn't one. The results of CTEs are shared by their references, and
+// the columns of distinct projections make their rows distinct.
+func (p *joinPath) prunableSubqueryAlias() (sql.Node, sql.Node) {
+	for _, sqa := range p.aliases {
+		proj, ok := sqa.Child.(*plan.Project)
+		if !ok || len(sqa.Columns) > 0 || sqa.CacheableCTESource {
+			continue
+		}
+		used := make(map[string]bool)
+		for _, e := range p.exprs {
+			transform.InspectExpr(e, func(e sql.Expression) bool {
+				if gf, ok := e.(*expression.GetField); ok && strings.EqualFold(gf.Table(), sqa.Name()) {
+					used[strings.ToLower(gf.Name())] = true
+				}
+				return false
+			})
+		}
+		var projections []sql.Expression
+		for i, c := range proj.Schema() {
+			if used[strings.ToLower(c.Name)] {
+				projections = append(projections, proj.Projections[i])
+			}
+		}
+		if len(projections) == 0 {
+			// a subquery alias needs a column for its rows
+			projections = proj.Projections[:1]
+		}
+		if len(projections) == len(proj.Projections) {
+			continue
+		}
+		ret, err := sqa.WithChildren(plan.NewProject(projections, proj.Child))
+		if err != nil {
+			continue
+		}
+		return sqa, ret
+	}
+	return nil, nil
+}
+
+// unusedJoin returns a left join of the path that can be eliminated, or nil if there isn't one.
+func (p *joinPath) unusedJoin(ctx *sql.Context) (*plan.JoinNode, error) {
+	for _, j := range p.joins {
+		if j.Op != plan.JoinTypeLeftOuter || j.Filter == nil {
+			continue
+		}
+		table := tableSource(j.Right())
+		if table == "" || isTableUsed(table, j, p.exprs) {
+			continue
+		}
+		unique, err := isUniqueJoin(ctx, j.Right(), table, j.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if unique {
+			return j, nil
+		}
+	}
+	return nil, nil
+}
+
+// tableSource returns the lower case name of the table of the node given, or the empty string if it isn't a table.
+func tableSource(n sql.Node) string {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return strings.ToLower(n.Name())
+	case *plan.TableAlias:
+		if _, ok := n.Child.(*plan.ResolvedTable); ok {
+			return strings.ToLower(n.Name())
+		}
+	}
+	return ""
+}
+
+// isTableUsed returns whether the expressions given, other than the condition of the join |j|, use the columns of
+// |table|.
+func isTableUsed(table string, j *plan.JoinNode, exprs []sql.Expression) bool {
+	var skip sql.Expression = j.Filter
+	for _, e := range exprs {
+		if e == skip {
+			// the condition of the join only appears once
+			skip = nil
+			continue
+		}
+		if transform.InspectExpr(e, func(e sql.Expression) bool {
+			gf, ok := e.(*expression.GetField)
+			return ok && strings.EqualFold(gf.Table(), table)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUniqueJoin returns whether the join condition given matches at most one row of |n| for each row of the other side
+// of the join, because it's a conjunction with the equalities of the columns of a unique key of |n| to deterministic
+// expressions of the same types over the other side.
+func isUniqueJoin(ctx *sql.Context, n sql.Node, table string, cond sql.Expression) (bool, error) {
+	equal := make(map[string]bool)
+	for _, c := range expression.SplitConjunction(cond) {
+		eq, ok := c.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		for _, cols := range [][2]sql.Expression{{eq.Left(), eq.Right()}, {eq.Right(), eq.Left()}} {
+			col, ok := cols[0].(*expression.GetField)
+			if !ok || !strings.EqualFold(col.Table(), table) || !col.Type().Equals(cols[1].Type()) {
+				continue
+			}
+			other := cols[1]
+			if !isDeterministic(other) || containsSubquery(other) || transform.InspectExpr(other, func(e sql.Expression) bool {
+				gf, ok := e.(*expression.GetField)
+				return ok && strings.EqualFold(gf.Table(), table)
+			}) {
+				continue
+			}
+			equal[strings.ToLower(col.Name())] = true
+		}
+	}
+	if len(equal) == 0 {
+		return false, nil
+	}
+
+	// the primary keys of tables aren't always returned with their indexes
+	var keys [][]string
+	var t sql.Table = getResolvedTable(n).Table
+	if w, ok := t.(sql.TableWrapper); ok {
+		t = w.Underlying()
+	}
+	if pkTable, ok := t.(sql.PrimaryKeyTable); ok {
+		pkSch := pkTable.PrimaryKeySchema()
+		var key []string
+		for _, i := range pkSch.PkOrdinals {
+			key = append(key, pkSch.Schema[i].Name)
+		}
+		keys = append(keys, key)
+	}
+	if idxTbl, ok := t.(sql.IndexAddressableTable); ok {
+		idxs, err := idxTbl.GetIndexes(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, idx := range idxs {
+			if !idx.IsUnique() {
+				continue
+			}
+			var key []string
+			for _, e := range idx.Expressions() {
+				// index expressions are qualified with the names of their tables
+				key = append(key, e[strings.LastIndex(e, ".")+1:])
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		covered := len(key) > 0
+		for _, c := range key {
+			if !equal[strings.ToLower(c)] {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// replaceJoinPathNode replaces the node |old| of the join path of the Project node given with |replacement|, and fixes
+// the field indexes of the nodes above it.
+func replaceJoinPathNode(a *Analyzer, p sql.Node, old, replacement sql.Node, scope *plan.Scope) (sql.Node, transform.TreeIdentity, error) {
+	selector := func(c transform.Context) bool {
+		return isJoinPathNode(c.Parent) && c.Parent != old
+	}
+	return transform.NodeWithCtx(p, selector, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		if c.Node == old {
+			return replacement, transform.NewTree, nil
+		}
+		switch n := c.Node.(type) {
+		case *plan.JoinNode:
+			if n.Filter == nil {
+				return n, transform.SameTree, nil
+			}
+			schema := append(n.Left().Schema(), n.Right().Schema()...)
+			cond, same, err := fixidx.FixFieldIndexes(scope, a.LogFn(), schema, n.Filter)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			ret, err := n.WithExpressions(cond)
+			return ret, transform.NewTree, err
+		default:
+			if !isJoinPathNode(n) {
+				return n, transform.SameTree, nil
+			}
+			return fixidx.FixFieldIndexesForExpressions(a.LogFn(), n, scope)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestNullRejectedTables(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expr     sql.Expression
+		expected []string
+	}{
+		{
+			name:     "comparison",
+			expr:     eq(col(0, "a", "x"), col(1, "b", "y")),
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "null safe comparison",
+			expr:     expression.NewNullSafeEquals(col(0, "a", "x"), lit(1)),
+			expected: nil,
+		},
+		{
+			name:     "is not null",
+			expr:     expression.NewNot(expression.NewIsNull(col(1, "b", "y"))),
+			expected: []string{"b"},
+		},
+		{
+			name:     "is null",
+			expr:     expression.NewIsNull(col(1, "b", "y")),
+			expected: nil,
+		},
+		{
+			name:     "negated comparison",
+			expr:     expression.NewNot(eq(col(1, "b", "y"), lit(1))),
+			expected: []string{"b"},
+		},
+		{
+			name:     "negated conjunction",
+			expr:     expression.NewNot(and(gt(col(0, "a", "x"), lit(1)), eq(col(1, "b", "y"), lit(2)))),
+			expected: nil,
+		},
+		{
+			name:     "function of column",
+			expr:     eq(expression.NewArithmetic(col(1, "b", "y"), lit(1), "+"), lit(2)),
+			expected: nil,
+		},
+		{
+			name:     "conjunction",
+			expr:     and(gt(col(0, "a", "x"), lit(1)), eq(col(1, "b", "y"), lit(2))),
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "disjunction",
+			expr:     or(and(gt(col(0, "a", "x"), lit(1)), eq(col(1, "b", "y"), lit(2))), eq(col(1, "b", "z"), lit(3))),
+			expected: []string{"b"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var tables []string
+			for table := range nullRejectedTables(tt.expr) {
+				tables = append(tables, table)
+			}
+			require.ElementsMatch(t, tt.expected, tables)
+		})
+	}
+}
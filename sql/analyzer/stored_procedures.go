@@ -51,22 +51,31 @@ func loadStoredProcedures(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan
 			}
 
 			for _, procedure := range procedures {
-				parsedProcedure, err := parse.Parse(ctx, procedure.CreateStatement)
-				if err != nil {
-					return nil, err
-				}
-				cp, ok := parsedProcedure.(*plan.CreateProcedure)
-				if !ok {
-					return nil, sql.ErrProcedureCreateStatementInvalid.New(procedure.CreateStatement)
-				}
-
 				var procToRegister *plan.Procedure
-				analyzedProc, err := analyzeCreateProcedure(ctx, a, cp, scope, sel)
+				err = withCurrentDatabase(ctx, database.Name(), func() error {
+					parsedProcedure, err := parse.Parse(ctx, procedure.CreateStatement)
+					if err != nil {
+						return err
+					}
+					cp, ok := parsedProcedure.(*plan.CreateProcedure)
+					if !ok {
+						return sql.ErrProcedureCreateStatementInvalid.New(procedure.CreateStatement)
+					}
+					// a statement without a DEFINER clause is parsed with the user of the session as the definer
+					if procedure.Definer != "" {
+						cp.Definer = procedure.Definer
+					}
+					analyzedProc, err := analyzeCreateProcedure(ctx, a, cp, scope, sel)
+					if err != nil {
+						procToRegister = cp.Procedure
+						procToRegister.ValidationError = err
+					} else {
+						procToRegister = analyzedProc
+					}
+					return nil
+				})
 				if err != nil {
-					procToRegister = cp.Procedure
-					procToRegister.ValidationError = err
-				} else {
-					procToRegister = analyzedProc
+					return nil, err
 				}
 
 				procToRegister.CreatedAt = procedure.CreatedAt
@@ -82,12 +91,16 @@ func loadStoredProcedures(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan
 	return scope, nil
 }
 
-// analyzeCreateProcedure checks the plan.CreateProcedure and returns a valid plan.Procedure or an error
+// analyzeCreateProcedure checks the plan.CreateProcedure and returns a valid plan.Procedure or an error. The statements
+// of a procedure with SQL SECURITY DEFINER are analyzed with the privileges of its definer.
 func analyzeCreateProcedure(ctx *sql.Context, a *Analyzer, cp *plan.CreateProcedure, scope *plan.Scope, sel RuleSelector) (*plan.Procedure, error) {
 	err := validateStoredProcedure(ctx, cp.Procedure)
 	if err != nil {
 		return nil, err
 	}
+	if cp.SecurityContext == plan.ProcedureSecurityContext_Definer && cp.Definer != "" {
+		scope = scope.WithDefiner(cp.Definer)
+	}
 	var analyzedNode sql.Node
 	analyzedNode, _, err = resolveDeclarations(ctx, a, cp.Procedure, scope, sel)
 	if err != nil {
@@ -312,47 +325,56 @@ func applyProcedures(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scop
 				}
 				return nil, transform.SameTree, err
 			}
-			parsedProcedure, err := parse.Parse(ctx, procedure.CreateStatement)
-			if err != nil {
-				return nil, transform.SameTree, err
-			}
-			parsedProcedure, _, err = transform.Node(parsedProcedure, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
-				versionable, ok := n.(plan.Versionable)
-				if !ok {
-					return n, transform.SameTree, nil
+			// the statements of a procedure are resolved in its database
+			var analyzedProc *plan.Procedure
+			err = withCurrentDatabase(ctx, spdb.Name(), func() error {
+				parsedProcedure, err := parse.Parse(ctx, procedure.CreateStatement)
+				if err != nil {
+					return err
 				}
-				tree := transform.SameTree
-				if newCall, ok := versionable.(*plan.Call); ok {
-					if newCall.Database() == nil || newCall.Database().Name() == "" {
-						newNode, err := newCall.WithDatabase(spdb)
+				parsedProcedure, _, err = transform.Node(parsedProcedure, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+					versionable, ok := n.(plan.Versionable)
+					if !ok {
+						return n, transform.SameTree, nil
+					}
+					tree := transform.SameTree
+					if newCall, ok := versionable.(*plan.Call); ok {
+						if newCall.Database() == nil || newCall.Database().Name() == "" {
+							newNode, err := newCall.WithDatabase(spdb)
+							if err != nil {
+								return nil, transform.SameTree, err
+							}
+							versionable = newNode.(plan.Versionable)
+							tree = transform.NewTree
+						}
+					}
+					if versionable.AsOf() == nil {
+						newNode, err := versionable.WithAsOf(call.AsOf())
 						if err != nil {
 							return nil, transform.SameTree, err
 						}
 						versionable = newNode.(plan.Versionable)
 						tree = transform.NewTree
 					}
+					return versionable, tree, nil
+				})
+				if err != nil {
+					return err
 				}
-				if versionable.AsOf() == nil {
-					newNode, err := versionable.WithAsOf(call.AsOf())
-					if err != nil {
-						return nil, transform.SameTree, err
-					}
-					versionable = newNode.(plan.Versionable)
-					tree = transform.NewTree
+				cp, ok := parsedProcedure.(*plan.CreateProcedure)
+				if !ok {
+					return sql.ErrProcedureCreateStatementInvalid.New(procedure.CreateStatement)
 				}
-				return versionable, tree, nil
+				// a statement without a DEFINER clause is parsed with the user of the session as the definer
+				if procedure.Definer != "" {
+					cp.Definer = procedure.Definer
+				}
+				analyzedProc, err = analyzeCreateProcedure(ctx, a, cp, scope, sel)
+				return err
 			})
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
-			cp, ok := parsedProcedure.(*plan.CreateProcedure)
-			if !ok {
-				return nil, transform.SameTree, sql.ErrProcedureCreateStatementInvalid.New(procedure.CreateStatement)
-			}
-			analyzedProc, err := analyzeCreateProcedure(ctx, a, cp, scope, sel)
-			if err != nil {
-				return nil, transform.SameTree, err
-			}
 			return call.WithProcedure(analyzedProc), transform.NewTree, nil
 		} else {
 			return nil, transform.SameTree, sql.ErrStoredProceduresNotSupported.New(call.Database().Name())
@@ -429,6 +451,42 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 	pRef := expression.NewProcedureReference()
 	call = call.WithParamReference(pRef)
 
+	transformedProcedure, err := assignProcedureReference(procedure, pRef)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	transformedProcedure, _, err = transform.Node(transformedProcedure, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := node.(*plan.ResolvedTable)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		return plan.NewProcedureResolvedTable(rt), transform.NewTree, nil
+	})
+
+	transformedProcedure, _, err = applyProcedures(ctx, a, transformedProcedure, scope, sel)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	var ok bool
+	procedure, ok = transformedProcedure.(*plan.Procedure)
+	if !ok {
+		return nil, transform.SameTree, fmt.Errorf("expected `*plan.Procedure` but got `%T`", transformedProcedure)
+	}
+
+	if len(procedure.Params) != len(call.Params) {
+		return nil, transform.SameTree, sql.ErrCallIncorrectParameterCount.New(procedure.Name, len(procedure.Params), len(call.Params))
+	}
+
+	call = call.WithProcedure(procedure)
+	return call, transform.NewTree, nil
+}
+
+// assignProcedureReference returns the node given with the *expression.ProcedureReference given assigned to all of the
+// procedure parameters and procedure-referencable nodes it contains, which hold the state of a single execution of a
+// stored procedure, or of a trigger body, in their local variables, cursors and handlers.
+func assignProcedureReference(n sql.Node, pRef *expression.ProcedureReference) (sql.Node, error) {
 	var procParamTransformFunc transform.ExprFunc
 	procParamTransformFunc = func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		switch expr := e.(type) {
@@ -462,12 +520,12 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 			return e, transform.SameTree, nil
 		}
 	}
-	transformedProcedure, _, err := transform.NodeExprsWithOpaque(procedure, procParamTransformFunc)
+	n, _, err := transform.NodeExprsWithOpaque(n, procParamTransformFunc)
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, err
 	}
 	// Some nodes do not expose all of their children, so we need to handle them here.
-	transformedProcedure, _, err = transform.NodeWithOpaque(transformedProcedure, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	n, _, err = transform.NodeWithOpaque(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
 		switch n := node.(type) {
 		case plan.DisjointedChildrenNode:
 			same := transform.SameTree
@@ -498,33 +556,18 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 			return transform.NodeExprsWithOpaque(n, procParamTransformFunc)
 		}
 	})
-	if err != nil {
-		return nil, transform.SameTree, err
-	}
-
-	transformedProcedure, _, err = transform.Node(transformedProcedure, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
-		rt, ok := node.(*plan.ResolvedTable)
-		if !ok {
-			return node, transform.SameTree, nil
-		}
-		return plan.NewProcedureResolvedTable(rt), transform.NewTree, nil
-	})
-
-	transformedProcedure, _, err = applyProcedures(ctx, a, transformedProcedure, scope, sel)
-	if err != nil {
-		return nil, transform.SameTree, err
-	}
-
-	var ok bool
-	procedure, ok = transformedProcedure.(*plan.Procedure)
-	if !ok {
-		return nil, transform.SameTree, fmt.Errorf("expected `*plan.Procedure` but got `%T`", transformedProcedure)
-	}
+	return n, err
+}
 
-	if len(procedure.Params) != len(call.Params) {
-		return nil, transform.SameTree, sql.ErrCallIncorrectParameterCount.New(procedure.Name, len(procedure.Params), len(call.Params))
+// withCurrentDatabase calls |f| with the current database of the session set to the one given, and restores it
+// afterwards. The statements of stored procedures and triggers are resolved in the database they're defined in, rather
+// than in the current database of the statement that runs them.
+func withCurrentDatabase(ctx *sql.Context, dbName string, f func() error) error {
+	prevDb := ctx.GetCurrentDatabase()
+	if dbName == "" || strings.EqualFold(dbName, prevDb) {
+		return f()
 	}
-
-	call = call.WithProcedure(procedure)
-	return call, transform.NewTree, nil
+	ctx.SetCurrentDatabase(dbName)
+	defer ctx.SetCurrentDatabase(prevDb)
+	return f()
 }
@@ -27,6 +27,9 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
+// maxTriggerDepth is the maximum depth of triggers firing other triggers with their statements.
+const maxTriggerDepth = 255
+
 // validateCreateTrigger handles CreateTrigger nodes, resolving references to "old" and "new" table references in
 // the trigger body. Also validates that these old and new references are being used appropriately -- they are only
 // valid for certain kinds of triggers and certain statements.
@@ -99,6 +102,10 @@ func validateCreateTrigger(ctx *sql.Context, a *Analyzer, node sql.Node, scope *
 	}
 
 	trigTable := getResolvedTable(ct.Table)
+	if err = validateTriggerOrder(ctx, ct, trigTable.Name()); err != nil {
+		return nil, transform.SameTree, err
+	}
+
 	sch := trigTable.Schema()
 	colsList := make(map[string]struct{})
 	for _, c := range sch {
@@ -130,6 +137,32 @@ func validateCreateTrigger(ctx *sql.Context, a *Analyzer, node sql.Node, scope *
 	return node, transform.NewTree, nil
 }
 
+// validateTriggerOrder checks that no trigger in the database of the trigger given has its name, and that the trigger
+// it PRECEDES or FOLLOWS, if any, exists and is for the same table, action time and event.
+func validateTriggerOrder(ctx *sql.Context, ct *plan.CreateTrigger, tableName string) error {
+	triggers, err := loadTriggersFromDb(ctx, ct.Database())
+	if err != nil {
+		return err
+	}
+	for _, trigger := range triggers {
+		if strings.EqualFold(trigger.TriggerName, ct.TriggerName) {
+			return sql.ErrTriggerAlreadyExists.New(ct.TriggerName)
+		}
+	}
+	if ct.TriggerOrder == nil {
+		return nil
+	}
+	for _, trigger := range triggers {
+		if strings.EqualFold(trigger.TriggerName, ct.TriggerOrder.OtherTriggerName) &&
+			strings.EqualFold(getTableName(trigger.Table), tableName) &&
+			strings.EqualFold(trigger.TriggerTime, ct.TriggerTime) &&
+			strings.EqualFold(trigger.TriggerEvent, ct.TriggerEvent) {
+			return nil
+		}
+	}
+	return sql.ErrReferencedTriggerDoesNotExist.New(ct.TriggerOrder.OtherTriggerName)
+}
+
 func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	// Skip this step for CreateTrigger statements
 	if _, ok := n.(*plan.CreateTrigger); ok {
@@ -193,6 +226,10 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 			if !ok {
 				return nil, transform.SameTree, sql.ErrTriggerCreateStatementInvalid.New(trigger.CreateStatement)
 			}
+			// a statement without a DEFINER clause is parsed with the user of the session as the definer
+			if trigger.Definer != "" {
+				ct.Definer = trigger.Definer
+			}
 
 			triggerTable := getTableName(ct.Table)
 			if stringContains(affectedTables, triggerTable) && triggerEventsMatch(triggerEvent, ct.TriggerEvent) {
@@ -213,12 +250,19 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 	same := transform.SameTree
 	allSame := transform.SameTree
 	for _, trigger := range triggers {
-		err = validateNoCircularUpdates(trigger, originalNode, scope)
-		if err != nil {
-			return nil, transform.SameTree, err
+		// Each level of triggers fired by the statements of other triggers is a memo of the scope
+		if len(scope.MemoNodes()) >= maxTriggerDepth {
+			return nil, transform.SameTree, sql.ErrTriggerRecursionLimit.New(maxTriggerDepth, trigger.TriggerName)
 		}
-
-		n, same, err = applyTrigger(ctx, a, originalNode, n, scope, trigger)
+		// the tables in the body of a trigger are in its database
+		err = withCurrentDatabase(ctx, database.Name(), func() error {
+			if err := validateNoCircularUpdates(ctx, trigger, originalNode, scope); err != nil {
+				return err
+			}
+			var err error
+			n, same, err = applyTrigger(ctx, a, originalNode, n, scope, trigger)
+			return err
+		})
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
@@ -310,7 +354,8 @@ func applyTrigger(ctx *sql.Context, a *Analyzer, originalNode, n sql.Node, scope
 }
 
 // getTriggerLogic analyzes and returns the Node representing the trigger body for the trigger given, applied to the
-// plan node given, which must be an insert, update, or delete.
+// plan node given, which must be an insert, update, or delete. The body of a trigger is executed with the privileges of
+// its definer.
 func getTriggerLogic(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, trigger *plan.CreateTrigger) (sql.Node, error) {
 	// For trigger body analysis, we don't want any row update accumulators applied to insert / update / delete
 	// statements, we need the raw output from them.
@@ -330,7 +375,7 @@ func getTriggerLogic(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scop
 			[]sql.Expression{expression.NewStar()},
 			plan.NewTableAlias("new", getResolvedTable(n)),
 		)
-		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache())
+		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache()).WithDefiner(trigger.Definer)
 		triggerLogic, _, err = a.analyzeWithSelector(ctx, trigger.Body, s, SelectAllBatches, noRowUpdateAccumulators)
 	case sqlparser.UpdateStr:
 		scopeNode := plan.NewProject(
@@ -340,33 +385,51 @@ func getTriggerLogic(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scop
 				plan.NewTableAlias("new", getResolvedTable(n)),
 			),
 		)
-		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache())
+		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache()).WithDefiner(trigger.Definer)
 		triggerLogic, _, err = a.analyzeWithSelector(ctx, trigger.Body, s, SelectAllBatches, noRowUpdateAccumulators)
 	case sqlparser.DeleteStr:
 		scopeNode := plan.NewProject(
 			[]sql.Expression{expression.NewStar()},
 			plan.NewTableAlias("old", getResolvedTable(n)),
 		)
-		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache())
+		s := (*plan.Scope)(nil).NewScope(scopeNode).WithMemos(scope.Memo(n).MemoNodes()).WithProcedureCache(scope.ProcedureCache()).WithDefiner(trigger.Definer)
 		triggerLogic, _, err = a.analyzeWithSelector(ctx, trigger.Body, s, SelectAllBatches, noRowUpdateAccumulators)
 	}
 
-	return StripPassthroughNodes(triggerLogic), err
+	if err != nil {
+		return nil, err
+	}
+
+	// The local variables, cursors and handlers declared in the trigger body are held by a procedure reference, as they
+	// are for stored procedures
+	return assignProcedureReference(StripPassthroughNodes(triggerLogic), expression.NewProcedureReference())
 }
 
-// validateNoCircularUpdates returns an error if the trigger logic attempts to update the table that invoked it (or any
-// table being updated in an outer scope of this analysis)
-func validateNoCircularUpdates(trigger *plan.CreateTrigger, n sql.Node, scope *plan.Scope) error {
+// validateNoCircularUpdates returns an error if the trigger logic attempts to update a table that the statement that
+// invoked it reads or updates, or that any statement invoking a trigger in an outer scope of this analysis does. Like
+// MySQL, this prevents triggers from firing themselves, directly or through other triggers.
+func validateNoCircularUpdates(ctx *sql.Context, trigger *plan.CreateTrigger, n sql.Node, scope *plan.Scope) error {
+	usedTables := make(map[string]struct{})
+	for _, node := range append([]sql.Node{n}, scope.MemoNodes()...) {
+		collectUsedTables(ctx, node, usedTables)
+	}
+
 	var circularRef error
 	transform.Inspect(trigger.Body, func(node sql.Node) bool {
 		switch node := node.(type) {
 		case *plan.Update, *plan.InsertInto, *plan.DeleteFrom:
-			for _, n := range append([]sql.Node{n}, scope.MemoNodes()...) {
-				invokingTableName := getUnaliasedTableName(n)
-				updatedTable := getUnaliasedTableName(node)
-				// TODO: need to compare DB as well
-				if updatedTable == invokingTableName {
-					circularRef = sql.ErrTriggerTableInUse.New(updatedTable)
+			var updatedTables []sql.Node
+			if deleteFrom, ok := node.(*plan.DeleteFrom); ok && deleteFrom.HasExplicitTargets() {
+				updatedTables = deleteFrom.GetDeleteTargets()
+			} else if insertInto, ok := node.(*plan.InsertInto); ok {
+				updatedTables = []sql.Node{insertInto.Destination}
+			} else {
+				updatedTables = []sql.Node{node}
+			}
+			for _, updatedTable := range updatedTables {
+				tableName := getUnaliasedTableName(updatedTable)
+				if _, ok := usedTables[usedTableKey(ctx, getTableDatabaseName(updatedTable), tableName)]; ok {
+					circularRef = sql.ErrTriggerTableInUse.New(tableName)
 					return false
 				}
 			}
@@ -377,6 +440,69 @@ func validateNoCircularUpdates(trigger *plan.CreateTrigger, n sql.Node, scope *p
 	return circularRef
 }
 
+// collectUsedTables adds the tables read or updated by the node given, including by its subqueries, to |tables|.
+func collectUsedTables(ctx *sql.Context, node sql.Node, tables map[string]struct{}) {
+	transform.Inspect(node, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.ResolvedTable, *plan.UnresolvedTable, *plan.IndexedTableAccess:
+			tables[usedTableKey(ctx, getTableDatabaseName(node), getUnaliasedTableName(node))] = struct{}{}
+			return false
+		case *plan.InsertInto:
+			// the source of an insert isn't one of its children
+			collectUsedTables(ctx, node.Source, tables)
+		case *plan.TriggerExecutor:
+			// the logic of the triggers already applied to the node isn't used by the statement
+			collectUsedTables(ctx, node.Left(), tables)
+			return false
+		}
+		if expressioner, ok := node.(sql.Expressioner); ok {
+			for _, e := range expressioner.Expressions() {
+				sql.Inspect(e, func(e sql.Expression) bool {
+					if sq, ok := e.(*plan.Subquery); ok {
+						collectUsedTables(ctx, sq.Query, tables)
+					}
+					return true
+				})
+			}
+		}
+		return true
+	})
+}
+
+// getTableDatabaseName returns the name of the database of the first table in the node given, which is empty if the
+// table name isn't qualified.
+func getTableDatabaseName(node sql.Node) string {
+	var dbName string
+	transform.Inspect(node, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.ResolvedTable:
+			if node.Database != nil {
+				dbName = node.Database.Name()
+			}
+			return false
+		case *plan.UnresolvedTable:
+			dbName = node.Database().Name()
+			return false
+		case *plan.IndexedTableAccess:
+			if node.Database() != nil {
+				dbName = node.Database().Name()
+			}
+			return false
+		}
+		return true
+	})
+	return dbName
+}
+
+// usedTableKey returns the key of the table given in the sets of tables used by statements. Unqualified tables are in
+// the current database.
+func usedTableKey(ctx *sql.Context, dbName, tableName string) string {
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	return strings.ToLower(dbName) + "." + strings.ToLower(tableName)
+}
+
 func orderTriggersAndReverseAfter(triggers []*plan.CreateTrigger) []*plan.CreateTrigger {
 	beforeTriggers, afterTriggers := plan.OrderTriggers(triggers)
 
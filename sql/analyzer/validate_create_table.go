@@ -190,7 +190,7 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 }
 
 // validateRenameColumn checks that a DDL RenameColumn node can be safely executed (e.g. no collision with other
-// column names, doesn't invalidate any table check constraints).
+// column names). Check constraints that reference the column are updated when it's renamed.
 //
 // Note that schema is passed in twice, because one version is the initial version before the alter column expressions
 // are applied, and the second version is the current schema that is being modified as multiple nodes are processed.
@@ -210,11 +210,6 @@ func validateRenameColumn(initialSch, sch sql.Schema, rc *plan.RenameColumn) (sq
 		return nil, sql.ErrTableColumnNotFound.New(nameable.Name(), rc.ColumnName)
 	}
 
-	err := validateColumnNotUsedInCheckConstraint(rc.ColumnName, rc.Checks)
-	if err != nil {
-		return nil, err
-	}
-
 	return renameInSchema(sch, rc.ColumnName, rc.NewColumnName, nameable.Name()), nil
 }
 
@@ -329,28 +324,6 @@ func validateDropColumn(initialSch, sch sql.Schema, dc *plan.DropColumn) (sql.Sc
 	return newSch, nil
 }
 
-// validateColumnNotUsedInCheckConstraint validates that the specified column name is not referenced in any of
-// the specified table check constraints.
-func validateColumnNotUsedInCheckConstraint(columnName string, checks sql.CheckConstraints) error {
-	var err error
-	for _, check := range checks {
-		_ = transform.InspectExpr(check.Expr, func(e sql.Expression) bool {
-			if unresolvedColumn, ok := e.(*expression.UnresolvedColumn); ok {
-				if columnName == unresolvedColumn.Name() {
-					err = sql.ErrCheckConstraintInvalidatedByColumnAlter.New(columnName, check.Name)
-					return true
-				}
-			}
-			return false
-		})
-
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 // validateColumnSafeToDropWithCheckConstraint validates that the specified column name is safe to drop, even if
 // referenced in a check constraint. Columns referenced in check constraints can be dropped if they are the only
 // column referenced in the check constraint.
@@ -448,7 +421,12 @@ func validateAlterIndex(ctx *sql.Context, initialSch, sch sql.Schema, ai *plan.A
 		}
 
 		if savedIdx == -1 {
-			return nil, sql.ErrCantDropFieldOrKey.New(ai.IndexName)
+			return nil, sql.ErrKeyDoesNotExist.New(ai.PreviousIndexName, tableName)
+		}
+		for i, idx := range indexes {
+			if i != savedIdx && strings.EqualFold(idx, ai.IndexName) {
+				return nil, sql.ErrDuplicateKeyName.New(ai.IndexName)
+			}
 		}
 
 		// Simulate the rename by deleting the old name and adding the new one.
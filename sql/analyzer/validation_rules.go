@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql/fixidx"
 
@@ -244,28 +245,12 @@ func validateDeleteFrom(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 	}
 }
 
-// checkSqlMode checks if the option is set for the Session in ctx
-func checkSqlMode(ctx *sql.Context, option string) (bool, error) {
-	// session variable overrides global
-	sysVal, err := ctx.Session.GetSessionVariable(ctx, "sql_mode")
-	if err != nil {
-		return false, err
-	}
-	val, ok := sysVal.(string)
-	if !ok {
-		return false, sql.ErrSystemVariableCodeFail.New("sql_mode", val)
-	}
-	return strings.Contains(val, option), nil
-}
-
 func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	span, ctx := ctx.Span("validate_group_by")
 	defer span.End()
 
 	// only enforce strict group by when this variable is set
-	if isStrict, err := checkSqlMode(ctx, "ONLY_FULL_GROUP_BY"); err != nil {
-		return n, transform.SameTree, err
-	} else if !isStrict {
+	if !sql.LoadSqlMode(ctx).ModeEnabled(sql.SqlModeOnlyFullGroupBy) {
 		return n, transform.SameTree, nil
 	}
 
@@ -418,7 +403,8 @@ func validateUnionSchemasMatch(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 				return false
 			}
 			for i := range ls {
-				if !reflect.DeepEqual(ls[i].Type, rs[i].Type) {
+				// NULL columns of the right child are not converted to the type of the left
+				if !reflect.DeepEqual(ls[i].Type, rs[i].Type) && rs[i].Type != types.Null {
 					firstmismatch = []string{
 						ls[i].Type.String(),
 						rs[i].Type.String(),
@@ -799,9 +785,10 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 
 			return false
 		default:
-			// DDL statements have an implicit commits which makes them valid to be executed in READ ONLY transactions.
-			if plan.IsDDLNode(n) {
-				valid = true
+			// DDL statements have an implicit commits which makes them valid to be executed in READ ONLY transactions,
+			// unless they're run in the transaction itself.
+			if plan.ImplicitCommitOf(n) == plan.ImplicitCommitDDL {
+				valid = plan.ImplicitCommitFor(ctx, n) != plan.NoImplicitCommit
 				return false
 			}
 
@@ -816,6 +803,101 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 	return n, transform.SameTree, nil
 }
 
+// validateReadOnlyMode invalidates queries that write to tables, change schemas or manage accounts while the server
+// runs with the read_only or super_read_only option. Temporary tables can still be written. Users with the SUPER
+// privilege are exempt from read_only but not from super_read_only, which implies read_only. Sessions that bypass the
+// read-only mode, like the ones applying replicated changes, are exempt from both. While a session holds the global
+// read lock of FLUSH TABLES WITH READ LOCK, the same statements wait for it to be released, and fail in the holder.
+func validateReadOnlyMode(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	option := ""
+	if !ctx.Session.GetBypassReadOnly() {
+		option = readOnlyOption(ctx, a)
+	}
+	globalReadLock := a.Catalog != nil && a.Catalog.GlobalReadLock != nil && a.Catalog.GlobalReadLock.IsHeld()
+	if option == "" && !globalReadLock {
+		return n, transform.SameTree, nil
+	}
+	if !writesPersistentData(n) {
+		return n, transform.SameTree, nil
+	}
+
+	if option != "" {
+		return nil, transform.SameTree, sql.ErrOptionPreventsStatement.New(option)
+	}
+	// Writes wait for the global read lock of FLUSH TABLES WITH READ LOCK to be released, for at most lock_wait_timeout
+	timeout, err := ctx.GetSessionVariable(ctx, "lock_wait_timeout")
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	seconds, _, err := types.Int64.Convert(timeout)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if err := a.Catalog.GlobalReadLock.WaitForRelease(ctx, time.Duration(seconds.(int64))*time.Second); err != nil {
+		return nil, transform.SameTree, err
+	}
+	return n, transform.SameTree, nil
+}
+
+// writesPersistentData returns whether the node given writes anything other than temporary tables, which neither
+// read-only mode nor the global read lock prevents.
+func writesPersistentData(n sql.Node) bool {
+	writes := false
+	temporaryTableSearch := func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok {
+			if tt, ok := rt.Table.(sql.TemporaryTable); !ok || !tt.IsTemporary() {
+				writes = true
+			}
+		}
+		return !writes
+	}
+
+	transform.Inspect(n, func(node sql.Node) bool {
+		switch n := node.(type) {
+		case *plan.DeleteFrom, *plan.Update, *plan.Truncate, *plan.DropTable:
+			transform.Inspect(node, temporaryTableSearch)
+			return false
+		case *plan.InsertInto:
+			transform.Inspect(n.Destination, temporaryTableSearch)
+			return false
+		case *plan.CreateTable:
+			if n.Temporary() != plan.IsTempTable {
+				writes = true
+			}
+			return false
+		case *plan.CreateUser, *plan.AlterUser, *plan.DropUser, *plan.RenameUser, *plan.CreateRole, *plan.DropRole,
+			*plan.Grant, *plan.GrantRole, *plan.GrantProxy, *plan.Revoke, *plan.RevokeAll, *plan.RevokeRole,
+			*plan.RevokeProxy:
+			writes = true
+			return false
+		default:
+			// Every data definition statement writes, as listed by the statements committing implicitly
+			if plan.ImplicitCommitOf(n) == plan.ImplicitCommitDDL {
+				writes = true
+				return false
+			}
+			return !writes
+		}
+	})
+	return writes
+}
+
+// readOnlyOption returns the name of the option that makes the server read-only for the current user, or an empty
+// string if the user may write.
+func readOnlyOption(ctx *sql.Context, a *Analyzer) string {
+	if _, val, ok := sql.SystemVariables.GetGlobal("super_read_only"); ok && val == int8(1) {
+		return "--super-read-only"
+	}
+	if _, val, ok := sql.SystemVariables.GetGlobal("read_only"); !ok || val != int8(1) {
+		return ""
+	}
+	if a.Catalog.MySQLDb != nil && a.Catalog.MySQLDb.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Super)) {
+		return ""
+	}
+	return "--read-only"
+}
+
 // validateAggregations returns an error if an Aggregation expression has been used in
 // an invalid way, such as appearing outside of a GroupBy or Window node, or if an aggregate
 // function is used with the implicit all-rows grouping and contains projected expressions with
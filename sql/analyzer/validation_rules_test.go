@@ -523,6 +523,32 @@ func TestValidateIndexCreation(t *testing.T) {
 	}
 }
 
+func TestValidateReadOnlyMode(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: types.Int64},
+	}), nil)
+	a := NewDefault(sql.NewDatabaseProvider())
+	insert := plan.NewInsertInto(nil, plan.NewResolvedTable(table, nil, nil), plan.NewValues(nil), false, nil, nil, false)
+	read := plan.NewResolvedTable(table, nil, nil)
+
+	ctx := sql.NewEmptyContext()
+	_, _, err := validateReadOnlyMode(ctx, a, insert, nil, DefaultRuleSelector)
+	require.NoError(err)
+
+	require.NoError(sql.SystemVariables.SetGlobal("super_read_only", 1))
+	defer sql.SystemVariables.SetGlobal("super_read_only", 0)
+	_, _, err = validateReadOnlyMode(ctx, a, insert, nil, DefaultRuleSelector)
+	require.True(sql.ErrOptionPreventsStatement.Is(err))
+	_, _, err = validateReadOnlyMode(ctx, a, read, nil, DefaultRuleSelector)
+	require.NoError(err)
+
+	// sessions applying replicated changes bypass the read-only mode
+	ctx.Session.SetBypassReadOnly(true)
+	_, _, err = validateReadOnlyMode(ctx, a, insert, nil, DefaultRuleSelector)
+	require.NoError(err)
+}
+
 func mustFunc(e sql.Expression, err error) sql.Expression {
 	if err != nil {
 		panic(err)
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"math"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -39,16 +40,25 @@ type BaseSession struct {
 	currentDB        string
 	transactionDb    string
 	systemVars       map[string]SystemVarValue
+	statusVars       map[string]*StatusVarValue
 	userVars         SessionUserVariables
 	idxReg           *IndexRegistry
 	viewReg          *ViewRegistry
 	warnings         []*Warning
 	warncnt          uint16
+	errcnt           uint16
 	locks            map[string]bool
 	queriedDb        string
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	bypassReadOnly   bool
+	sequences        *SequenceCache
+
+	// the session state changes tracked since SessionStateChanges was last called, and the current database then
+	trackedVars  []SessionStateChange
+	trackedDB    string
+	stateChanged bool
 
 	// When the MySQL database updates any tables related to privileges, it increments its counter. We then update our
 	// privilege set if our counter doesn't equal the database's counter.
@@ -89,7 +99,30 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 	return s.ignoreAutocommit
 }
 
+func (s *BaseSession) SetBypassReadOnly(bypass bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bypassReadOnly = bypass
+}
+
+func (s *BaseSession) GetBypassReadOnly() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.bypassReadOnly
+}
+
+// SequenceCache implements the SequenceCacheSession interface.
+func (s *BaseSession) SequenceCache() *SequenceCache {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sequences == nil {
+		s.sequences = NewSequenceCache()
+	}
+	return s.sequences
+}
+
 var _ Session = (*BaseSession)(nil)
+var _ SequenceCacheSession = (*BaseSession)(nil)
 
 func (s *BaseSession) SetTransactionDatabase(dbName string) {
 	s.mu.Lock()
@@ -127,6 +160,32 @@ func (s *BaseSession) GetAllSessionVariables() map[string]interface{} {
 	return m
 }
 
+// GetStatusVariable implements the Session interface.
+func (s *BaseSession) GetStatusVariable(ctx *Context, statusVarName string) (interface{}, bool) {
+	statusVar, ok := s.statusVars[strings.ToLower(statusVarName)]
+	if !ok {
+		return nil, false
+	}
+	return statusVar.Value(), true
+}
+
+// IncrementStatusVariable implements the Session interface. The counters are created with the session and updated
+// atomically, so they don't need the session lock.
+func (s *BaseSession) IncrementStatusVariable(ctx *Context, statusVarName string, val int64) {
+	if statusVar, ok := s.statusVars[strings.ToLower(statusVarName)]; ok {
+		statusVar.Increment(val)
+	}
+}
+
+// GetAllStatusVariables implements the Session interface.
+func (s *BaseSession) GetAllStatusVariables(ctx *Context) map[string]interface{} {
+	m := make(map[string]interface{}, len(s.statusVars))
+	for k, v := range s.statusVars {
+		m[k] = v.Value()
+	}
+	return m
+}
+
 // SetSessionVariable implements the Session interface.
 func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value interface{}) error {
 	sysVarName = strings.ToLower(sysVarName)
@@ -141,7 +200,7 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 			if !ok {
 				return ErrUnknownSystemVariable.New(sysVarName)
 			}
-			return s.setSessVar(ctx, sv, value)
+			return s.setSessVar(ctx, sv, value, true)
 		} else {
 			return ErrUnknownSystemVariable.New(sysVarName)
 		}
@@ -150,7 +209,7 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 	if !sysVar.Var.Dynamic || sysVar.Var.ValueFunction != nil {
 		return ErrSystemVariableReadOnly.New(sysVarName)
 	}
-	return s.setSessVar(ctx, sysVar.Var, value)
+	return s.setSessVar(ctx, sysVar.Var, value, true)
 }
 
 // InitSessionVariable implements the Session interface and is used to initialize variables (Including read-only variables)
@@ -165,14 +224,15 @@ func (s *BaseSession) InitSessionVariable(ctx *Context, sysVarName string, value
 		return ErrSystemVariableReinitialized.New(sysVarName)
 	}
 
-	return s.setSessVar(ctx, sysVar, value)
+	return s.setSessVar(ctx, sysVar, value, false)
 }
 
-func (s *BaseSession) setSessVar(ctx *Context, sysVar SystemVariable, value interface{}) error {
+// setSessVar sets the session value of the system variable given, tracking the change when |track| is true.
+func (s *BaseSession) setSessVar(ctx *Context, sysVar SystemVariable, value interface{}, track bool) error {
 	if sysVar.Scope == SystemVariableScope_Global {
 		return ErrSystemVariableGlobalOnly.New(sysVar.Name)
 	}
-	convertedVal, _, err := sysVar.Type.Convert(value)
+	convertedVal, err := sysVar.ConvertValue(value)
 	if err != nil {
 		return err
 	}
@@ -183,6 +243,9 @@ func (s *BaseSession) setSessVar(ctx *Context, sysVar SystemVariable, value inte
 		Val: convertedVal,
 	}
 	s.systemVars[sysVar.Name] = svv
+	if track {
+		s.trackSystemVariable(ctx, sysVar, convertedVal)
+	}
 	if sysVar.NotifyChanged != nil {
 		sysVar.NotifyChanged(SystemVariableScope_Session, svv)
 	}
@@ -191,6 +254,9 @@ func (s *BaseSession) setSessVar(ctx *Context, sysVar SystemVariable, value inte
 
 // SetUserVariable implements the Session interface.
 func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interface{}, typ Type) error {
+	s.mu.Lock()
+	s.stateChanged = true
+	s.mu.Unlock()
 	return s.userVars.SetUserVariable(ctx, varName, value, typ)
 }
 
@@ -204,6 +270,17 @@ func (s *BaseSession) GetSessionVariable(ctx *Context, sysVarName string) (inter
 	if !ok {
 		return nil, ErrUnknownSystemVariable.New(sysVarName)
 	}
+	// warning_count and error_count describe the warnings of the last statement rather than holding a value
+	switch sysVarName {
+	case "warning_count":
+		return int64(s.warncnt), nil
+	case "error_count":
+		return int64(s.errcnt), nil
+	}
+	// Variables with a ValueFunction don't hold a value, so the session's copy of them is never current
+	if sysVar.Var.ValueFunction != nil {
+		return sysVar.Var.ValueFunction()
+	}
 	// TODO: this is duplicated from within variables.globalSystemVariables, suggesting the need for an interface
 	if sysType, ok := sysVar.Var.Type.(SetType); ok {
 		if sv, ok := sysVar.Val.(uint64); ok {
@@ -308,10 +385,30 @@ func (s *BaseSession) SetConnectionId(id uint32) {
 	return
 }
 
-// Warn stores the warning in the session.
+// Warn stores the warning in the session. Notes are dropped when the sql_notes system variable is disabled, and
+// only the first max_error_count warnings of a statement are kept, although all of them are counted.
 func (s *BaseSession) Warn(warn *Warning) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+
+	if warn.Level == "Note" {
+		if sqlNotes, ok := s.systemVars["sql_notes"]; ok && sqlNotes.Val == int8(0) {
+			return
+		}
+	}
+
+	if s.warncnt < math.MaxUint16 {
+		s.warncnt++
+	}
+	if warn.Level == "Error" && s.errcnt < math.MaxUint16 {
+		s.errcnt++
+	}
+
+	if maxErrorCount, ok := s.systemVars["max_error_count"]; ok {
+		if maxCount, ok := maxErrorCount.Val.(int64); ok && int64(len(s.warnings)) >= maxCount {
+			return
+		}
+	}
 	s.warnings = append(s.warnings, warn)
 }
 
@@ -335,22 +432,19 @@ func (s *BaseSession) ClearWarnings() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	cnt := uint16(len(s.warnings))
-	if s.warncnt == cnt {
-		if s.warnings != nil {
-			s.warnings = s.warnings[:0]
-		}
-		s.warncnt = 0
-	} else {
-		s.warncnt = cnt
+	if s.warnings != nil {
+		s.warnings = s.warnings[:0]
 	}
+	s.warncnt = 0
+	s.errcnt = 0
 }
 
-// WarningCount returns a number of session warnings
+// WarningCount returns the number of warnings, notes and errors raised by the last statement, including those that
+// were not kept because of max_error_count.
 func (s *BaseSession) WarningCount() uint16 {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return uint16(len(s.warnings))
+	return s.warncnt
 }
 
 // AddLock adds a lock to the set of locks owned by this user which will need to be released if this session terminates
@@ -467,11 +561,18 @@ func NewBaseSessionWithClientServer(server string, client Client, id uint32) *Ba
 	} else {
 		sessionVars = make(map[string]SystemVarValue)
 	}
+	var statusVars map[string]*StatusVarValue
+	if StatusVariables != nil {
+		statusVars = StatusVariables.NewSessionMap()
+	} else {
+		statusVars = make(map[string]*StatusVarValue)
+	}
 	return &BaseSession{
 		addr:           server,
 		client:         client,
 		id:             id,
 		systemVars:     sessionVars,
+		statusVars:     statusVars,
 		userVars:       NewUserVars(),
 		idxReg:         NewIndexRegistry(),
 		viewReg:        NewViewRegistry(),
@@ -491,9 +592,16 @@ func NewBaseSession() *BaseSession {
 	} else {
 		sessionVars = make(map[string]SystemVarValue)
 	}
+	var statusVars map[string]*StatusVarValue
+	if StatusVariables != nil {
+		statusVars = StatusVariables.NewSessionMap()
+	} else {
+		statusVars = make(map[string]*StatusVarValue)
+	}
 	return &BaseSession{
 		id:             atomic.AddUint32(&autoSessionIDs, 1),
 		systemVars:     sessionVars,
+		statusVars:     statusVars,
 		userVars:       NewUserVars(),
 		idxReg:         NewIndexRegistry(),
 		viewReg:        NewViewRegistry(),
@@ -83,6 +83,8 @@ type ReplicaStatus struct {
 	RetrievedGtidSet      string
 	ExecutedGtidSet       string
 	AutoPosition          bool
+	ReplicateDoDBs        []string
+	ReplicateIgnoreDBs    []string
 	ReplicateDoTables     []string
 	ReplicateIgnoreTables []string
 }
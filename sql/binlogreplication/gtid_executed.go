@@ -0,0 +1,177 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// gtidState holds the GTIDs that this server has executed and purged, which back the @@gtid_executed and
+// @@gtid_purged system variables. A replica adds the GTIDs of the transactions it applies with AddExecutedGTIDs.
+var gtidState = struct {
+	mu       sync.Mutex
+	executed GTIDSet
+	purged   GTIDSet
+	// changed is closed and replaced whenever |executed| grows, to wake up anything waiting for GTIDs
+	changed chan struct{}
+}{changed: make(chan struct{})}
+
+// ExecutedGTIDs returns the set of GTIDs that this server has executed, which is the value of @@gtid_executed.
+func ExecutedGTIDs() GTIDSet {
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	return gtidState.executed
+}
+
+// PurgedGTIDs returns the set of GTIDs that this server has executed but no longer has in its binary log, which is the
+// value of @@gtid_purged.
+func PurgedGTIDs() GTIDSet {
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	return gtidState.purged
+}
+
+// AddExecutedGTIDs adds |set| to the GTIDs that this server has executed.
+func AddExecutedGTIDs(set GTIDSet) {
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	addExecutedGTIDs(set)
+}
+
+// addExecutedGTIDs adds |set| to the executed GTIDs. The caller must hold the lock.
+func addExecutedGTIDs(set GTIDSet) {
+	if gtidState.executed.Contains(set) {
+		return
+	}
+	gtidState.executed = gtidState.executed.Union(set)
+	close(gtidState.changed)
+	gtidState.changed = make(chan struct{})
+}
+
+// SetPurgedGTIDs replaces the purged GTIDs with |set|, and adds them to the executed GTIDs, as setting @@gtid_purged
+// does. The set must have been validated with ResolvePurgedGTIDs.
+func SetPurgedGTIDs(set GTIDSet) {
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	gtidState.purged = set
+	addExecutedGTIDs(set)
+}
+
+// ResolvePurgedGTIDs returns the set of purged GTIDs that results from setting @@gtid_purged to |value|. Like MySQL, a
+// value starting with "+" adds GTIDs that haven't been executed to the purged GTIDs. Any other value replaces the
+// purged GTIDs, and must contain all of them, while not containing GTIDs that were executed without being purged.
+func ResolvePurgedGTIDs(value string) (GTIDSet, error) {
+	trimmed := strings.TrimSpace(value)
+	appending := strings.HasPrefix(trimmed, "+")
+	set, err := ParseGTIDSet(strings.TrimPrefix(trimmed, "+"))
+	if err != nil {
+		return GTIDSet{}, sql.ErrMalformedGTIDSet.New(value)
+	}
+
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	if appending {
+		if set.Intersects(gtidState.executed) {
+			return GTIDSet{}, sql.ErrCantSetGTIDPurged.New("the added gtid set must not overlap with @@GLOBAL.GTID_EXECUTED")
+		}
+		return gtidState.purged.Union(set), nil
+	}
+	if !set.Contains(gtidState.purged) {
+		return GTIDSet{}, sql.ErrCantSetGTIDPurged.New("the new value must be a superset of the old value")
+	}
+	if set.Intersects(gtidState.executed.Subtract(gtidState.purged)) {
+		return GTIDSet{}, sql.ErrCantSetGTIDPurged.New("the new value must not overlap with the GTIDs that were executed but not purged")
+	}
+	return set, nil
+}
+
+// WaitForExecutedGTIDs waits until this server has executed every GTID in |set|, and returns whether it did before
+// |timeout| elapsed. A timeout of zero waits indefinitely.
+func WaitForExecutedGTIDs(ctx context.Context, set GTIDSet, timeout time.Duration) (bool, error) {
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	for {
+		gtidState.mu.Lock()
+		executed, changed := gtidState.executed, gtidState.changed
+		gtidState.mu.Unlock()
+		if executed.Contains(set) {
+			return true, nil
+		}
+
+		select {
+		case <-changed:
+		case <-expired:
+			return false, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// gtidPurgedType is the type of @@gtid_purged. Values assigned to the variable are resolved with ResolvePurgedGTIDs,
+// so that the variable always holds the complete set of purged GTIDs.
+type gtidPurgedType struct {
+	sql.SystemVariableType
+}
+
+var _ sql.SystemVariableType = gtidPurgedType{}
+
+// NewGTIDPurgedType returns the type of the @@gtid_purged system variable.
+func NewGTIDPurgedType() sql.SystemVariableType {
+	return gtidPurgedType{types.NewSystemStringType("gtid_purged")}
+}
+
+// Convert implements the sql.Type interface.
+func (t gtidPurgedType) Convert(v interface{}) (interface{}, sql.ConvertInRange, error) {
+	value, inRange, err := t.SystemVariableType.Convert(v)
+	if err != nil {
+		return nil, inRange, err
+	}
+	set, err := ResolvePurgedGTIDs(value.(string))
+	if err != nil {
+		return nil, sql.OutOfRange, err
+	}
+	return set.String(), sql.InRange, nil
+}
+
+// MustConvert implements the sql.Type interface.
+func (t gtidPurgedType) MustConvert(v interface{}) interface{} {
+	value, _, err := t.Convert(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// NotifyGTIDPurgedChanged is the NotifyChanged function of the @@gtid_purged system variable, which records the value
+// as the purged GTIDs.
+func NotifyGTIDPurgedChanged(_ sql.SystemVariableScope, value sql.SystemVarValue) {
+	set, err := ParseGTIDSet(value.Val.(string))
+	if err != nil {
+		// The value was already resolved by gtidPurgedType, so this never happens
+		return
+	}
+	SetPurgedGTIDs(set)
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// maxGTIDSequenceNumber is the largest sequence number that a GTID may have.
+const maxGTIDSequenceNumber = math.MaxInt64 - 1
+
+// GTIDInterval is a range of transaction sequence numbers, from Start to End inclusive.
+type GTIDInterval struct {
+	Start int64
+	End   int64
+}
+
+// GTIDSet is a set of GTIDs, which identify transactions by the UUID of the server they were originally committed on
+// and by their sequence number on that server. The zero value is an empty set. GTIDSets are never modified in place,
+// so they may be shared freely.
+// https://dev.mysql.com/doc/refman/8.0/en/replication-gtids-concepts.html
+type GTIDSet struct {
+	// intervals maps a lowercase server UUID to its sorted intervals, which neither overlap nor touch each other.
+	intervals map[string][]GTIDInterval
+}
+
+// ParseGTIDSet parses a GTID set in MySQL's format, such as "3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5:11,
+// 2174B383-5441-11E8-B90A-C80AA9429562:1". Whitespace around the elements is ignored, and the empty string is the empty
+// set.
+func ParseGTIDSet(s string) (GTIDSet, error) {
+	set := GTIDSet{intervals: make(map[string][]GTIDInterval)}
+	if strings.TrimSpace(s) == "" {
+		return set, nil
+	}
+	for _, uuidSet := range strings.Split(s, ",") {
+		parts := strings.Split(uuidSet, ":")
+		if len(parts) < 2 {
+			return GTIDSet{}, sql.ErrMalformedGTIDSet.New(s)
+		}
+		uuid, ok := normalizeGTIDUUID(strings.TrimSpace(parts[0]))
+		if !ok {
+			return GTIDSet{}, sql.ErrMalformedGTIDSet.New(s)
+		}
+		intervals := set.intervals[uuid]
+		for _, part := range parts[1:] {
+			interval, ok := parseGTIDInterval(strings.TrimSpace(part))
+			if !ok {
+				return GTIDSet{}, sql.ErrMalformedGTIDSet.New(s)
+			}
+			intervals = append(intervals, interval)
+		}
+		set.intervals[uuid] = intervals
+	}
+	for uuid, intervals := range set.intervals {
+		set.intervals[uuid] = mergeGTIDIntervals(intervals)
+	}
+	return set, nil
+}
+
+// normalizeGTIDUUID returns the lowercase, hyphenated form of |uuid|, which may be written with or without hyphens.
+func normalizeGTIDUUID(uuid string) (string, bool) {
+	hex := strings.ToLower(uuid)
+	if len(hex) == 36 {
+		for _, i := range []int{8, 13, 18, 23} {
+			if hex[i] != '-' {
+				return "", false
+			}
+		}
+		hex = strings.ReplaceAll(hex, "-", "")
+	}
+	if len(hex) != 32 {
+		return "", false
+	}
+	for _, c := range hex {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return "", false
+		}
+	}
+	return hex[:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:], true
+}
+
+// parseGTIDInterval parses an interval written either as a single sequence number or as "start-end".
+func parseGTIDInterval(s string) (GTIDInterval, bool) {
+	startStr, endStr, isRange := strings.Cut(s, "-")
+	start, ok := parseGTIDSequenceNumber(startStr)
+	if !ok {
+		return GTIDInterval{}, false
+	}
+	end := start
+	if isRange {
+		if end, ok = parseGTIDSequenceNumber(endStr); !ok || end < start {
+			return GTIDInterval{}, false
+		}
+	}
+	return GTIDInterval{Start: start, End: end}, true
+}
+
+// parseGTIDSequenceNumber parses a single transaction sequence number, which must be a positive integer.
+func parseGTIDSequenceNumber(s string) (int64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || s[0] == '+' || s[0] == '-' {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 1 || n > maxGTIDSequenceNumber {
+		return 0, false
+	}
+	return n, true
+}
+
+// mergeGTIDIntervals sorts |intervals| and merges the ones that overlap or touch.
+func mergeGTIDIntervals(intervals []GTIDInterval) []GTIDInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start < intervals[j].Start
+	})
+	var merged []GTIDInterval
+	for _, interval := range intervals {
+		if n := len(merged); n > 0 && interval.Start <= merged[n-1].End+1 {
+			if interval.End > merged[n-1].End {
+				merged[n-1].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+// IsEmpty returns whether the set doesn't contain any GTIDs.
+func (s GTIDSet) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Intervals returns the intervals of the server with the given UUID, in ascending order.
+func (s GTIDSet) Intervals(uuid string) []GTIDInterval {
+	uuid, ok := normalizeGTIDUUID(uuid)
+	if !ok {
+		return nil
+	}
+	return s.intervals[uuid]
+}
+
+// Contains returns whether every GTID in |other| is also in this set.
+func (s GTIDSet) Contains(other GTIDSet) bool {
+	return other.Subtract(s).IsEmpty()
+}
+
+// Intersects returns whether any GTID is in both this set and |other|.
+func (s GTIDSet) Intersects(other GTIDSet) bool {
+	for uuid, intervals := range s.intervals {
+		for _, a := range intervals {
+			for _, b := range other.intervals[uuid] {
+				if a.Start <= b.End && b.Start <= a.End {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Union returns the set of GTIDs that are in either this set or |other|.
+func (s GTIDSet) Union(other GTIDSet) GTIDSet {
+	union := GTIDSet{intervals: make(map[string][]GTIDInterval, len(s.intervals))}
+	for uuid, intervals := range s.intervals {
+		union.intervals[uuid] = append([]GTIDInterval(nil), intervals...)
+	}
+	for uuid, intervals := range other.intervals {
+		union.intervals[uuid] = mergeGTIDIntervals(append(union.intervals[uuid], intervals...))
+	}
+	return union
+}
+
+// Subtract returns the set of GTIDs that are in this set but not in |other|.
+func (s GTIDSet) Subtract(other GTIDSet) GTIDSet {
+	diff := GTIDSet{intervals: make(map[string][]GTIDInterval, len(s.intervals))}
+	for uuid, intervals := range s.intervals {
+		remaining := append([]GTIDInterval(nil), intervals...)
+		for _, b := range other.intervals[uuid] {
+			var next []GTIDInterval
+			for _, a := range remaining {
+				if b.End < a.Start || b.Start > a.End {
+					next = append(next, a)
+					continue
+				}
+				if a.Start < b.Start {
+					next = append(next, GTIDInterval{Start: a.Start, End: b.Start - 1})
+				}
+				if a.End > b.End {
+					next = append(next, GTIDInterval{Start: b.End + 1, End: a.End})
+				}
+			}
+			remaining = next
+		}
+		if len(remaining) > 0 {
+			diff.intervals[uuid] = remaining
+		}
+	}
+	return diff
+}
+
+// String returns the set in the format that MySQL displays GTID sets in, with the servers sorted by UUID and separated
+// by a comma and a newline.
+func (s GTIDSet) String() string {
+	uuids := make([]string, 0, len(s.intervals))
+	for uuid := range s.intervals {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	sb := strings.Builder{}
+	for i, uuid := range uuids {
+		if i > 0 {
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(uuid)
+		for _, interval := range s.intervals[uuid] {
+			sb.WriteByte(':')
+			sb.WriteString(strconv.FormatInt(interval.Start, 10))
+			if interval.End != interval.Start {
+				sb.WriteByte('-')
+				sb.WriteString(strconv.FormatInt(interval.End, 10))
+			}
+		}
+	}
+	return sb.String()
+}
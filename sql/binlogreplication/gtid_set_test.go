@@ -0,0 +1,183 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	testUUID1 = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+	testUUID2 = "2174b383-5441-11e8-b90a-c80aa9429562"
+)
+
+func TestParseGTIDSet(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"  \n ", ""},
+		{testUUID1 + ":1-5", testUUID1 + ":1-5"},
+		{"3E11FA47-71CA-11E1-9E33-C80AA9429562:3", testUUID1 + ":3"},
+		{"3e11fa4771ca11e19e33c80aa9429562:3", testUUID1 + ":3"},
+		{testUUID1 + ":7-9:1-3:4", testUUID1 + ":1-4:7-9"},
+		{testUUID1 + ":1-5:2-3", testUUID1 + ":1-5"},
+		{testUUID1 + ":1-2, " + testUUID1 + ":3-4", testUUID1 + ":1-4"},
+		{" " + testUUID1 + " : 1 - 2 ,\n" + testUUID2 + ":5", testUUID2 + ":5,\n" + testUUID1 + ":1-2"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			set, err := ParseGTIDSet(test.input)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, set.String())
+		})
+	}
+
+	for _, input := range []string{
+		"abc",
+		testUUID1,
+		testUUID1 + ":",
+		testUUID1 + ":0",
+		testUUID1 + ":5-3",
+		testUUID1 + ":1-",
+		testUUID1 + ":-1",
+		testUUID1 + ":x",
+		testUUID1 + ":9223372036854775807",
+		testUUID1 + ":1,",
+		"3e11fa47-71ca-11e1-9e33-c80aa942956:1",
+		"3e11fa47+71ca-11e1-9e33-c80aa9429562:1",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseGTIDSet(input)
+			require.True(t, sql.ErrMalformedGTIDSet.Is(err), "%v", err)
+		})
+	}
+}
+
+func TestGTIDSetOperations(t *testing.T) {
+	mustParse := func(s string) GTIDSet {
+		set, err := ParseGTIDSet(s)
+		require.NoError(t, err)
+		return set
+	}
+
+	tests := []struct {
+		set1       string
+		set2       string
+		contains   bool
+		intersects bool
+		union      string
+		subtract   string
+	}{
+		{"", "", true, false, "", ""},
+		{testUUID1 + ":1-5", "", true, false, testUUID1 + ":1-5", testUUID1 + ":1-5"},
+		{"", testUUID1 + ":1-5", false, false, testUUID1 + ":1-5", ""},
+		{testUUID1 + ":1-10", testUUID1 + ":3-5", true, true, testUUID1 + ":1-10", testUUID1 + ":1-2:6-10"},
+		{testUUID1 + ":3-5", testUUID1 + ":1-10", false, true, testUUID1 + ":1-10", ""},
+		{testUUID1 + ":1-5", testUUID1 + ":6-8", false, false, testUUID1 + ":1-8", testUUID1 + ":1-5"},
+		{testUUID1 + ":1-5", testUUID1 + ":4-8", false, true, testUUID1 + ":1-8", testUUID1 + ":1-3"},
+		{testUUID1 + ":1-5:10-20", testUUID1 + ":5-10:15", false, true, testUUID1 + ":1-20", testUUID1 + ":1-4:11-14:16-20"},
+		{testUUID1 + ":1-5", testUUID2 + ":1-5", false, false, testUUID2 + ":1-5,\n" + testUUID1 + ":1-5", testUUID1 + ":1-5"},
+		{testUUID1 + ":1-5," + testUUID2 + ":1-5", testUUID2 + ":1-5", true, true, testUUID2 + ":1-5,\n" + testUUID1 + ":1-5", testUUID1 + ":1-5"},
+	}
+	for _, test := range tests {
+		t.Run(test.set1+" "+test.set2, func(t *testing.T) {
+			set1, set2 := mustParse(test.set1), mustParse(test.set2)
+			require.Equal(t, test.contains, set1.Contains(set2))
+			require.Equal(t, test.intersects, set1.Intersects(set2))
+			require.Equal(t, test.intersects, set2.Intersects(set1))
+			require.Equal(t, test.union, set1.Union(set2).String())
+			require.Equal(t, test.subtract, set1.Subtract(set2).String())
+			// The operations don't modify their operands
+			require.Equal(t, mustParse(test.set1).String(), set1.String())
+			require.Equal(t, mustParse(test.set2).String(), set2.String())
+		})
+	}
+}
+
+func TestPurgedAndExecutedGTIDs(t *testing.T) {
+	resetGTIDs()
+	defer resetGTIDs()
+
+	set, err := ParseGTIDSet(testUUID1 + ":1-5")
+	require.NoError(t, err)
+	AddExecutedGTIDs(set)
+
+	// Appended GTIDs must not have been executed
+	_, err = ResolvePurgedGTIDs("+" + testUUID1 + ":5-6")
+	require.True(t, sql.ErrCantSetGTIDPurged.Is(err), "%v", err)
+	purged, err := ResolvePurgedGTIDs("+" + testUUID2 + ":1-3")
+	require.NoError(t, err)
+	require.Equal(t, testUUID2+":1-3", purged.String())
+	SetPurgedGTIDs(purged)
+	require.Equal(t, testUUID2+":1-3", PurgedGTIDs().String())
+	require.Equal(t, testUUID2+":1-3,\n"+testUUID1+":1-5", ExecutedGTIDs().String())
+
+	// Replacing GTIDs must keep the purged GTIDs, and must not purge the GTIDs that were executed
+	_, err = ResolvePurgedGTIDs(testUUID2 + ":2-10")
+	require.True(t, sql.ErrCantSetGTIDPurged.Is(err), "%v", err)
+	_, err = ResolvePurgedGTIDs(testUUID2 + ":1-3," + testUUID1 + ":1")
+	require.True(t, sql.ErrCantSetGTIDPurged.Is(err), "%v", err)
+	purged, err = ResolvePurgedGTIDs(testUUID2 + ":1-10")
+	require.NoError(t, err)
+	require.Equal(t, testUUID2+":1-10", purged.String())
+
+	_, err = ResolvePurgedGTIDs("+abc")
+	require.True(t, sql.ErrMalformedGTIDSet.Is(err), "%v", err)
+}
+
+func TestWaitForExecutedGTIDs(t *testing.T) {
+	resetGTIDs()
+	defer resetGTIDs()
+
+	set, err := ParseGTIDSet(testUUID1 + ":1-5")
+	require.NoError(t, err)
+
+	executed, err := WaitForExecutedGTIDs(context.Background(), GTIDSet{}, 0)
+	require.NoError(t, err)
+	require.True(t, executed)
+
+	executed, err = WaitForExecutedGTIDs(context.Background(), set, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, executed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = WaitForExecutedGTIDs(ctx, set, 0)
+	require.ErrorIs(t, err, context.Canceled)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		AddExecutedGTIDs(set)
+	}()
+	executed, err = WaitForExecutedGTIDs(context.Background(), set, 0)
+	require.NoError(t, err)
+	require.True(t, executed)
+}
+
+// resetGTIDs forgets all executed and purged GTIDs.
+func resetGTIDs() {
+	gtidState.mu.Lock()
+	defer gtidState.mu.Unlock()
+	gtidState.executed = GTIDSet{}
+	gtidState.purged = GTIDSet{}
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The names of the options of the CHANGE REPLICATION FILTER statement.
+// https://dev.mysql.com/doc/refman/8.0/en/change-replication-filter.html
+const (
+	ReplicateDoDBOption        = "REPLICATE_DO_DB"
+	ReplicateIgnoreDBOption    = "REPLICATE_IGNORE_DB"
+	ReplicateDoTableOption     = "REPLICATE_DO_TABLE"
+	ReplicateIgnoreTableOption = "REPLICATE_IGNORE_TABLE"
+)
+
+// ReplicationFilter decides which changes a replica applies, from the options of CHANGE REPLICATION FILTER. It follows
+// the rules that MySQL uses for row-based replication: the database filters are checked first, and the table filters
+// are only checked for the changes that pass them. Names are matched case-insensitively, and a table name that isn't
+// qualified with a database matches that table in every database.
+// https://dev.mysql.com/doc/refman/8.0/en/replication-rules.html
+type ReplicationFilter struct {
+	DoDBs        []string
+	IgnoreDBs    []string
+	DoTables     []string
+	IgnoreTables []string
+}
+
+// NewReplicationFilter creates a ReplicationFilter from the given filter options. An option that's given more than
+// once replaces the previous value, and an option with an empty list removes the filter, as with CHANGE REPLICATION
+// FILTER. The database options take either a list of names, which are unqualified table names when they come from the
+// parser, or a comma-separated string of names.
+func NewReplicationFilter(options []ReplicationOption) (*ReplicationFilter, error) {
+	filter := &ReplicationFilter{}
+	for _, option := range options {
+		names, err := replicationFilterNames(option)
+		if err != nil {
+			return nil, err
+		}
+		switch strings.ToUpper(option.Name) {
+		case ReplicateDoDBOption:
+			filter.DoDBs = names
+		case ReplicateIgnoreDBOption:
+			filter.IgnoreDBs = names
+		case ReplicateDoTableOption:
+			filter.DoTables = names
+		case ReplicateIgnoreTableOption:
+			filter.IgnoreTables = names
+		default:
+			return nil, fmt.Errorf("unsupported replication filter option: %s", option.Name)
+		}
+	}
+	return filter, nil
+}
+
+// replicationFilterNames returns the names that |option| holds.
+func replicationFilterNames(option ReplicationOption) ([]string, error) {
+	var names []string
+	switch value := option.Value.(type) {
+	case TableNamesReplicationOptionValue:
+		for _, urt := range value.Value {
+			name := urt.Name()
+			if db := urt.Database().Name(); db != "" {
+				name = db + "." + name
+			}
+			names = append(names, name)
+		}
+	case StringReplicationOptionValue:
+		for _, name := range strings.Split(value.Value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value type '%T' specified for replication filter option %q", option.Value, option.Name)
+	}
+	return names, nil
+}
+
+// IsEmpty returns whether the filter doesn't have any rules, and so lets every change through.
+func (f *ReplicationFilter) IsEmpty() bool {
+	return len(f.DoDBs) == 0 && len(f.IgnoreDBs) == 0 && len(f.DoTables) == 0 && len(f.IgnoreTables) == 0
+}
+
+// ShouldReplicateDatabase returns whether the changes to the database |db| pass the database filters. Statements that
+// don't change a table, such as CREATE DATABASE, are only checked against the database filters.
+func (f *ReplicationFilter) ShouldReplicateDatabase(db string) bool {
+	if len(f.DoDBs) > 0 {
+		return containsFoldedName(f.DoDBs, db)
+	}
+	return !containsFoldedName(f.IgnoreDBs, db)
+}
+
+// ShouldReplicateTable returns whether the changes to the table |table| in the database |db| pass the filters.
+func (f *ReplicationFilter) ShouldReplicateTable(db, table string) bool {
+	if !f.ShouldReplicateDatabase(db) {
+		return false
+	}
+	if matchesFilterTable(f.DoTables, db, table) {
+		return true
+	}
+	if matchesFilterTable(f.IgnoreTables, db, table) {
+		return false
+	}
+	// When there are tables to replicate, the tables that aren't one of them aren't replicated
+	return len(f.DoTables) == 0
+}
+
+// matchesFilterTable returns whether |tables| names the table |table| in the database |db|.
+func matchesFilterTable(tables []string, db, table string) bool {
+	for _, name := range tables {
+		filterDB, filterTable, qualified := strings.Cut(name, ".")
+		if !qualified {
+			filterDB, filterTable = "", name
+		}
+		if strings.EqualFold(filterTable, table) && (!qualified || strings.EqualFold(filterDB, db)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFoldedName returns whether |names| contains |name|, ignoring case.
+func containsFoldedName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
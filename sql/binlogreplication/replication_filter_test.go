@@ -0,0 +1,82 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []ReplicationOption
+		db       string
+		table    string
+		expected bool
+	}{
+		{"no filters", nil, "db1", "t1", true},
+		{"do db", []ReplicationOption{stringOption(ReplicateDoDBOption, "db1, db2")}, "DB2", "t1", true},
+		{"not a do db", []ReplicationOption{stringOption(ReplicateDoDBOption, "db1")}, "db3", "t1", false},
+		{"ignore db", []ReplicationOption{stringOption(ReplicateIgnoreDBOption, "db1")}, "db1", "t1", false},
+		{"not an ignore db", []ReplicationOption{stringOption(ReplicateIgnoreDBOption, "db1")}, "db2", "t1", true},
+		{"do db wins over ignore db", []ReplicationOption{
+			stringOption(ReplicateDoDBOption, "db1"),
+			stringOption(ReplicateIgnoreDBOption, "db1"),
+		}, "db1", "t1", true},
+		{"do table", []ReplicationOption{stringOption(ReplicateDoTableOption, "db1.t1")}, "db1", "T1", true},
+		{"not a do table", []ReplicationOption{stringOption(ReplicateDoTableOption, "db1.t1")}, "db1", "t2", false},
+		{"do table in other db", []ReplicationOption{stringOption(ReplicateDoTableOption, "db1.t1")}, "db2", "t1", false},
+		{"unqualified do table", []ReplicationOption{stringOption(ReplicateDoTableOption, "t1")}, "db2", "t1", true},
+		{"ignore table", []ReplicationOption{stringOption(ReplicateIgnoreTableOption, "db1.t1")}, "db1", "t1", false},
+		{"not an ignore table", []ReplicationOption{stringOption(ReplicateIgnoreTableOption, "db1.t1")}, "db1", "t2", true},
+		{"do table wins over ignore table", []ReplicationOption{
+			stringOption(ReplicateDoTableOption, "db1.t1"),
+			stringOption(ReplicateIgnoreTableOption, "db1.t1"),
+		}, "db1", "t1", true},
+		{"db filter before table filter", []ReplicationOption{
+			stringOption(ReplicateIgnoreDBOption, "db1"),
+			stringOption(ReplicateDoTableOption, "db1.t1"),
+		}, "db1", "t1", false},
+		{"later option replaces earlier one", []ReplicationOption{
+			stringOption(ReplicateDoDBOption, "db1"),
+			stringOption(ReplicateDoDBOption, ""),
+		}, "db2", "t1", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewReplicationFilter(test.options)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, filter.ShouldReplicateTable(test.db, test.table))
+		})
+	}
+
+	filter, err := NewReplicationFilter([]ReplicationOption{stringOption(ReplicateIgnoreDBOption, "db1")})
+	require.NoError(t, err)
+	require.False(t, filter.IsEmpty())
+	require.False(t, filter.ShouldReplicateDatabase("db1"))
+	require.True(t, filter.ShouldReplicateDatabase("db2"))
+
+	_, err = NewReplicationFilter([]ReplicationOption{stringOption("REPLICATE_WILD_DO_TABLE", "db1.%")})
+	require.Error(t, err)
+	_, err = NewReplicationFilter([]ReplicationOption{{Name: ReplicateDoDBOption, Value: IntegerReplicationOptionValue{Value: 1}}})
+	require.Error(t, err)
+}
+
+func stringOption(name, value string) ReplicationOption {
+	return *NewReplicationOption(name, StringReplicationOptionValue{Value: value})
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// The values of the binlog_row_image system variable, which controls which columns are written to the before and after
+// images of the row events in the binary log.
+// https://dev.mysql.com/doc/refman/8.0/en/replication-options-binary-log.html#sysvar_binlog_row_image
+const (
+	BinlogRowImageFull    = "FULL"
+	BinlogRowImageMinimal = "MINIMAL"
+	BinlogRowImageNoBlob  = "NOBLOB"
+)
+
+// RowImageColumns returns the columns of a row change that the row image |image| writes to the binary log, as the
+// bitmaps of a row event. |before| is nil for inserts, and |after| is nil for deletes, in which case the matching
+// bitmap is empty. With FULL, every column is written. With MINIMAL, the before image only has the columns needed to
+// identify the row, which are the primary key or else every column, and the after image only has the columns that
+// changed. With NOBLOB, both images have every column except the BLOB and TEXT columns that are neither needed to
+// identify the row nor changed.
+func RowImageColumns(image string, sch sql.Schema, before, after sql.Row) (identify mysql.Bitmap, data mysql.Bitmap, err error) {
+	image = strings.ToUpper(image)
+	if image != BinlogRowImageFull && image != BinlogRowImageMinimal && image != BinlogRowImageNoBlob {
+		return mysql.Bitmap{}, mysql.Bitmap{}, fmt.Errorf("unknown binlog row image: %s", image)
+	}
+
+	hasPrimaryKey := false
+	for _, col := range sch {
+		if col.PrimaryKey {
+			hasPrimaryKey = true
+			break
+		}
+	}
+
+	identify = mysql.NewServerBitmap(len(sch))
+	if before != nil {
+		for i, col := range sch {
+			switch image {
+			case BinlogRowImageFull:
+				identify.Set(i, true)
+			case BinlogRowImageMinimal:
+				identify.Set(i, col.PrimaryKey || !hasPrimaryKey)
+			case BinlogRowImageNoBlob:
+				identify.Set(i, col.PrimaryKey || !hasPrimaryKey || !isBlobColumn(col))
+			}
+		}
+	}
+
+	data = mysql.NewServerBitmap(len(sch))
+	if after != nil {
+		for i, col := range sch {
+			switch image {
+			case BinlogRowImageFull:
+				data.Set(i, true)
+			case BinlogRowImageMinimal, BinlogRowImageNoBlob:
+				if before == nil || (image == BinlogRowImageNoBlob && !isBlobColumn(col)) {
+					data.Set(i, true)
+					continue
+				}
+				changed, err := columnChanged(col, before[i], after[i])
+				if err != nil {
+					return mysql.Bitmap{}, mysql.Bitmap{}, err
+				}
+				data.Set(i, changed)
+			}
+		}
+	}
+	return identify, data, nil
+}
+
+// isBlobColumn returns whether |col| is stored as a BLOB, which NOBLOB leaves out of the row images when it can.
+func isBlobColumn(col *sql.Column) bool {
+	return types.IsTextBlob(col.Type) || types.IsJSON(col.Type) || types.IsGeometry(col.Type)
+}
+
+// columnChanged returns whether the value of |col| differs between |before| and |after|.
+func columnChanged(col *sql.Column, before, after interface{}) (bool, error) {
+	if before == nil || after == nil {
+		return before != after, nil
+	}
+	// Text is compared byte by byte, since a change that the collation considers equal is still a change
+	if types.IsText(col.Type) {
+		beforeStr, _, err := types.LongBlob.Convert(before)
+		if err != nil {
+			return false, err
+		}
+		afterStr, _, err := types.LongBlob.Convert(after)
+		if err != nil {
+			return false, err
+		}
+		return !bytes.Equal(beforeStr.([]byte), afterStr.([]byte)), nil
+	}
+	cmp, err := col.Type.Compare(before, after)
+	if err != nil {
+		return false, err
+	}
+	return cmp != 0, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRowImageColumns(t *testing.T) {
+	withPK := sql.Schema{
+		{Name: "id", Type: types.Int64, PrimaryKey: true},
+		{Name: "name", Type: types.Text},
+		{Name: "n", Type: types.Int64},
+		{Name: "s", Type: types.LongText},
+	}
+	withoutPK := sql.Schema{
+		{Name: "id", Type: types.Int64},
+		{Name: "name", Type: types.Text},
+		{Name: "n", Type: types.Int64},
+		{Name: "s", Type: types.LongText},
+	}
+
+	tests := []struct {
+		name     string
+		image    string
+		sch      sql.Schema
+		before   sql.Row
+		after    sql.Row
+		identify []bool
+		data     []bool
+	}{
+		{"full insert", BinlogRowImageFull, withPK, nil, sql.Row{1, "a", 1, "x"}, []bool{false, false, false, false}, []bool{true, true, true, true}},
+		{"full update", BinlogRowImageFull, withPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", 2, "x"}, []bool{true, true, true, true}, []bool{true, true, true, true}},
+		{"full delete", BinlogRowImageFull, withPK, sql.Row{1, "a", 1, "x"}, nil, []bool{true, true, true, true}, []bool{false, false, false, false}},
+		{"minimal insert", "minimal", withPK, nil, sql.Row{1, "a", 1, "x"}, []bool{false, false, false, false}, []bool{true, true, true, true}},
+		{"minimal update", BinlogRowImageMinimal, withPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "A", 2, "x"}, []bool{true, false, false, false}, []bool{false, true, true, false}},
+		{"minimal update to null", BinlogRowImageMinimal, withPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", nil, "x"}, []bool{true, false, false, false}, []bool{false, false, true, false}},
+		{"minimal update without primary key", BinlogRowImageMinimal, withoutPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", 2, "x"}, []bool{true, true, true, true}, []bool{false, false, true, false}},
+		{"minimal delete", BinlogRowImageMinimal, withPK, sql.Row{1, "a", 1, "x"}, nil, []bool{true, false, false, false}, []bool{false, false, false, false}},
+		{"noblob insert", BinlogRowImageNoBlob, withPK, nil, sql.Row{1, "a", 1, "x"}, []bool{false, false, false, false}, []bool{true, true, true, true}},
+		{"noblob update", BinlogRowImageNoBlob, withPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", 2, "x"}, []bool{true, false, true, false}, []bool{true, false, true, false}},
+		{"noblob update of blob", BinlogRowImageNoBlob, withPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", 1, "y"}, []bool{true, false, true, false}, []bool{true, false, true, true}},
+		{"noblob update without primary key", BinlogRowImageNoBlob, withoutPK, sql.Row{1, "a", 1, "x"}, sql.Row{1, "a", 2, "x"}, []bool{true, true, true, true}, []bool{true, false, true, false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identify, data, err := RowImageColumns(test.image, test.sch, test.before, test.after)
+			require.NoError(t, err)
+			require.Equal(t, test.identify, bitmapBits(identify))
+			require.Equal(t, test.data, bitmapBits(data))
+		})
+	}
+
+	_, _, err := RowImageColumns("partial", withPK, nil, sql.Row{1, "a", 1, "x"})
+	require.Error(t, err)
+}
+
+func bitmapBits(b mysql.Bitmap) []bool {
+	bits := make([]bool, b.Count())
+	for i := range bits {
+		bits[i] = b.Bit(i)
+	}
+	return bits
+}
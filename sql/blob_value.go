@@ -0,0 +1,88 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// BlobValue is a value of a BLOB or TEXT column that integrators can return in rows in place of a []byte or string, so
+// that large values are only read from storage when their contents are needed, rather than being held in memory while
+// rows move through the plan tree. Values are read when an expression converts them and when they are written to the
+// client, and values inserted into BLOB and TEXT columns are passed to the table as they are. Tables that accept them
+// must read the contents before the statement finishes. The contents of values for TEXT columns are encoded in the
+// character set of their column.
+type BlobValue interface {
+	// Size returns the length of the value in bytes.
+	Size() int64
+	// NewReader returns a new reader of the value's contents, which the caller must close. Values may be read any
+	// number of times.
+	NewReader() (io.ReadCloser, error)
+}
+
+// ReadBlobValue reads the whole of the blob value given.
+func ReadBlobValue(v BlobValue) ([]byte, error) {
+	return AppendBlobValue(make([]byte, 0, v.Size()), v)
+}
+
+// AppendBlobValue appends the contents of the blob value given to the buffer given, reading them directly into the
+// buffer.
+func AppendBlobValue(buf []byte, v BlobValue) ([]byte, error) {
+	r, err := v.NewReader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	size := v.Size()
+	start := len(buf)
+	if int64(cap(buf)-start) < size {
+		grown := make([]byte, start, int64(start)+size)
+		copy(grown, buf)
+		buf = grown
+	}
+	buf = buf[:int64(start)+size]
+	if _, err = io.ReadFull(r, buf[start:]); err != nil {
+		return nil, fmt.Errorf("error reading blob value of %d bytes: %w", size, err)
+	}
+	return buf, nil
+}
+
+// bytesBlobValue is a BlobValue holding its contents in memory.
+type bytesBlobValue []byte
+
+var _ BlobValue = bytesBlobValue(nil)
+
+// NewBlobValue returns a BlobValue of the contents given.
+func NewBlobValue(b []byte) BlobValue {
+	return bytesBlobValue(b)
+}
+
+// Size implements the BlobValue interface.
+func (b bytesBlobValue) Size() int64 {
+	return int64(len(b))
+}
+
+// NewReader implements the BlobValue interface.
+func (b bytesBlobValue) NewReader() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+// String returns the contents of the value.
+func (b bytesBlobValue) String() string {
+	return string(b)
+}
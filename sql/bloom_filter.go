@@ -0,0 +1,59 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// bloomFilterBitsPerKey and bloomFilterHashes give bloom filters a false positive rate of about 1%.
+const (
+	bloomFilterBitsPerKey = 10
+	bloomFilterHashes     = 7
+)
+
+// BloomFilter is a set of hashes that can tell that a hash was never added to it, but not that it was: MayContain
+// returns false positives, and no false negatives.
+type BloomFilter struct {
+	bits []uint64
+}
+
+// NewBloomFilter returns an empty BloomFilter sized for |n| hashes.
+func NewBloomFilter(n int) *BloomFilter {
+	words := (n*bloomFilterBitsPerKey + 63) / 64
+	if words == 0 {
+		words = 1
+	}
+	return &BloomFilter{bits: make([]uint64, words)}
+}
+
+// Add adds the |hash| given to this filter.
+func (f *BloomFilter) Add(hash uint64) {
+	size := uint64(len(f.bits)) * 64
+	h1, h2 := hash&0xffffffff, hash>>32
+	for i := uint64(0); i < bloomFilterHashes; i++ {
+		bit := (h1 + i*h2) % size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// MayContain returns whether the |hash| given may have been added to this filter. It's false only if it never was.
+func (f *BloomFilter) MayContain(hash uint64) bool {
+	size := uint64(len(f.bits)) * 64
+	h1, h2 := hash&0xffffffff, hash>>32
+	for i := uint64(0); i < bloomFilterHashes; i++ {
+		bit := (h1 + i*h2) % size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	hash := func(i int) uint64 {
+		h, err := HashOf(Row{i})
+		require.NoError(t, err)
+		return h
+	}
+
+	f := NewBloomFilter(1000)
+	for i := 0; i < 1000; i++ {
+		f.Add(hash(i))
+	}
+	for i := 0; i < 1000; i++ {
+		require.True(t, f.MayContain(hash(i)))
+	}
+
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.MayContain(hash(i)) {
+			falsePositives++
+		}
+	}
+	require.Less(t, falsePositives, 300)
+
+	require.False(t, NewBloomFilter(0).MayContain(hash(1)))
+}
@@ -83,7 +83,6 @@ type rowsCache struct {
 	memory   Freeable
 	reporter Reporter
 	rows     []Row
-	rows2    []Row2
 }
 
 func newRowsCache(memory Freeable, r Reporter) *rowsCache {
@@ -101,19 +100,6 @@ func (c *rowsCache) Add(row Row) error {
 
 func (c *rowsCache) Get() []Row { return c.rows }
 
-func (c *rowsCache) Add2(row2 Row2) error {
-	if !releaseMemoryIfNeeded(c.reporter, c.memory.Free) {
-		return ErrNoMemoryAvailable.New()
-	}
-
-	c.rows2 = append(c.rows2, row2)
-	return nil
-}
-
-func (c *rowsCache) Get2() []Row2 {
-	return c.rows2
-}
-
 func (c *rowsCache) Dispose() {
 	c.memory = nil
 	c.rows = nil
@@ -56,6 +56,9 @@ type Catalog interface {
 	// UnlockTables unlocks all tables locked by the session id given
 	UnlockTables(ctx *Context, id uint32) error
 
+	// HasLockedTables returns whether the session id given holds any table locks
+	HasLockedTables(id uint32) bool
+
 	// Statistics returns a StatsReadWriter for saving and updating table statistics
 	Statistics(ctx *Context) (StatsReadWriter, error)
 }
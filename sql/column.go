@@ -43,10 +43,29 @@ type Column struct {
 	DatabaseSource string
 	// PrimaryKey is true if the column is part of the primary key for its table.
 	PrimaryKey bool
-	// Comment contains the string comment for this column.
+	// Comment contains the string comment for this column. Integrators receive it with the column in CREATE and ALTER
+	// TABLE statements, and should keep it so that it can be shown by SHOW CREATE TABLE and information_schema.
 	Comment string
 	// Extra contains any additional information to put in the `extra` column under `information_schema.columns`.
 	Extra string
+	// Invisible is true if the column is hidden from SELECT * and from INSERT statements without a column list. An
+	// invisible column can still be referenced by name.
+	Invisible bool
+	// Generated contains the expression the values of this column are computed from, or nil if it isn't a generated
+	// column.
+	Generated *ColumnDefaultValue
+	// Virtual is true if this column is a generated column whose values are computed when read rather than stored.
+	Virtual bool
+}
+
+// GeneratedInvisiblePrimaryKeyName is the name of the primary key column generated for tables created without a
+// primary key while sql_generate_invisible_primary_key is enabled.
+const GeneratedInvisiblePrimaryKeyName = "my_row_id"
+
+// IsGeneratedInvisiblePrimaryKey returns whether this column is a primary key generated by the engine because its
+// table was created without one while sql_generate_invisible_primary_key was enabled.
+func (c *Column) IsGeneratedInvisiblePrimaryKey() bool {
+	return c.Invisible && c.PrimaryKey && strings.EqualFold(c.Name, GeneratedInvisiblePrimaryKeyName)
 }
 
 // Check ensures the value is correct for this column.
@@ -111,5 +130,8 @@ func (c *Column) Copy() *Column {
 		PrimaryKey:    c.PrimaryKey,
 		Comment:       c.Comment,
 		Extra:         c.Extra,
+		Invisible:     c.Invisible,
+		Generated:     c.Generated,
+		Virtual:       c.Virtual,
 	}
 }
@@ -340,6 +340,22 @@ type SystemVariableRegistry interface {
 	SetGlobal(name string, val interface{}) error
 	// GetAllGlobalVariables returns a copy of all global variable values.
 	GetAllGlobalVariables() map[string]interface{}
+	// SetPersister sets the persister of the global values set with SET PERSIST and SET PERSIST_ONLY by the sessions
+	// that don't implement PersistableSession.
+	SetPersister(persister SystemVariablePersister)
+	// Persister returns the persister set with SetPersister, or nil if there is none.
+	Persister() SystemVariablePersister
+}
+
+// SystemVariablePersister persists the global values of system variables, so that they're set again when the server
+// restarts, by loading them with SystemVariableRegistry.AssignValues.
+type SystemVariablePersister interface {
+	// PersistGlobal persists the global value of the system variable named
+	PersistGlobal(sysVarName string, value interface{}) error
+	// RemovePersistedGlobal removes the persisted value of the system variable named
+	RemovePersistedGlobal(sysVarName string) error
+	// RemoveAllPersistedGlobals removes the persisted values of all the system variables
+	RemoveAllPersistedGlobals() error
 }
 
 // SystemVariable represents a system variable.
@@ -375,6 +391,28 @@ type SystemVariable struct {
 	// that provide a ValueFunction should also set Dynamic to false, since they
 	// cannot be assigned a value and will return a read-only error if tried.
 	ValueFunction func() (interface{}, error)
+	// Validate is an optional function that checks a value of this system variable, already converted to its type,
+	// before it's set, and returns the value to set, which it may normalize. It's called whenever the variable is set,
+	// including by SET PERSIST_ONLY and when persisted values are loaded with AssignValues.
+	Validate func(value interface{}) (interface{}, error)
+	// OnUpdate is an optional function that is called by a SET statement once it changed the value of this system
+	// variable in the scope given, so that the change takes effect right away in the parts of the engine that depend on
+	// the variable. Unlike NotifyChanged, it's given the context of the statement, so that it may set related variables
+	// in the same scope, and an error it returns fails the statement.
+	OnUpdate func(ctx *Context, scope SystemVariableScope, value SystemVarValue) error
+}
+
+// ConvertValue converts the value given to the type of the system variable and validates it, returning the value to
+// set.
+func (v SystemVariable) ConvertValue(value interface{}) (interface{}, error) {
+	converted, _, err := v.Type.Convert(value)
+	if err != nil {
+		return nil, err
+	}
+	if v.Validate != nil {
+		return v.Validate(converted)
+	}
+	return converted, nil
 }
 
 // SystemVariableScope represents the scope of a system variable.
@@ -22,6 +22,8 @@ import (
 const (
 	// InformationSchemaDatabaseName is the name of the information schema database.
 	InformationSchemaDatabaseName = "information_schema"
+	// PerformanceSchemaDatabaseName is the name of the performance schema database.
+	PerformanceSchemaDatabaseName = "performance_schema"
 )
 
 // DatabaseProvider is the fundamental interface to integrate with the engine. It provides access to all databases in
@@ -54,6 +56,15 @@ type CollatedDatabaseProvider interface {
 	CreateCollatedDatabase(ctx *Context, name string, collation CollationID) error
 }
 
+// SessionDatabaseProvider is a DatabaseProvider that keeps state for each session, which it releases when the engine
+// closes the session.
+type SessionDatabaseProvider interface {
+	DatabaseProvider
+
+	// CloseSession releases the state kept for the session with the ID given.
+	CloseSession(id uint32)
+}
+
 // TableFunctionProvider is an interface that allows custom table functions to be provided. It's usually (but not
 // always) implemented by a DatabaseProvider.
 type TableFunctionProvider interface {
@@ -105,6 +116,14 @@ type ReadOnlyDatabase interface {
 	IsReadOnly() bool
 }
 
+// TableCacheDatabase is a Database that caches open table handles, which FLUSH TABLES closes.
+type TableCacheDatabase interface {
+	Database
+	// FlushTables closes the cached handles of the tables named, or of all tables when no names are given, so that
+	// they are reopened the next time they're used.
+	FlushTables(ctx *Context, tableNames []string) error
+}
+
 // TableCreator is a Database that can create new tables.
 type TableCreator interface {
 	Database
@@ -165,6 +184,16 @@ type CollatedDatabase interface {
 	SetCollation(ctx *Context, collation CollationID) error
 }
 
+// CommentedDatabase is a Database that can store and update its comment, given by the COMMENT option of CREATE
+// DATABASE and ALTER DATABASE statements.
+type CommentedDatabase interface {
+	Database
+	// GetComment returns this database's comment.
+	GetComment(ctx *Context) string
+	// SetComment updates this database's comment.
+	SetComment(ctx *Context, comment string) error
+}
+
 // TriggerDatabase is a Database that supports creating and storing triggers. The engine handles all parsing and
 // execution logic for triggers. Integrators are not expected to parse or understand the trigger definitions, but must
 // store and return them when asked.
@@ -189,6 +218,9 @@ type TriggerDefinition struct {
 	CreateStatement string
 	// The time that the trigger was created.
 	CreatedAt time.Time
+	// The DEFINER of this trigger, whose privileges the trigger is executed with. The statement to create this trigger
+	// may not declare it.
+	Definer string
 }
 
 // TemporaryTableDatabase is a database that can query the session (which manages the temporary table state) to
@@ -258,11 +290,28 @@ type ViewDatabase interface {
 	AllViews(ctx *Context) ([]ViewDefinition, error)
 }
 
+// ViewDefinitionDatabase is a ViewDatabase that persists the column list and attributes of its views along with their
+// definitions. The attributes of the views of other databases are read from their CREATE VIEW statements.
+type ViewDefinitionDatabase interface {
+	ViewDatabase
+
+	// CreateViewDefinition persists the view definition given. If a view with that name already exists, should return
+	// ErrExistingView
+	CreateViewDefinition(ctx *Context, view ViewDefinition) error
+}
+
 // ViewDefinition is the named textual definition of a view
 type ViewDefinition struct {
 	Name                string
 	TextDefinition      string
 	CreateViewStatement string
+	// Columns is the column list of the view, which names its columns in place of the names in its definition
+	Columns []string
+	// Algorithm, Definer and SecurityType are the ALGORITHM, DEFINER and SQL SECURITY attributes of the view. The
+	// privileges of the definer are used to read the tables of the view unless its security type is INVOKER.
+	Algorithm    string
+	Definer      string
+	SecurityType string
 }
 
 // GetTableInsensitive implements a case-insensitive map lookup for tables keyed off of the table name.
@@ -0,0 +1,41 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+)
+
+// DumpOptions are the options of a logical dump of a database.
+type DumpOptions struct {
+	// NoCreateInfo leaves the statements creating the tables, views, triggers and routines out of the dump.
+	NoCreateInfo bool
+	// NoData leaves the rows of the tables out of the dump.
+	NoData bool
+	// AddDropTable precedes the statement creating each table and view with one dropping it if it exists.
+	AddDropTable bool
+	// Routines includes the stored procedures of the database in the dump.
+	Routines bool
+	// MySQLDump writes the dump in the format of mysqldump, with its comments and the statements that set the
+	// session variables for restoring it and restore them afterwards.
+	MySQLDump bool
+}
+
+// DatabaseDumper writes logical dumps of databases, made of the SQL statements that recreate their schemas and data.
+// BACKUP DATABASE statements save the dumps of the engine's dumper to files.
+type DatabaseDumper interface {
+	// DumpDatabase writes a dump of the database named to |w|, reading it from a single snapshot of the database.
+	DumpDatabase(ctx *Context, dbName string, w io.Writer, opts DumpOptions) error
+}
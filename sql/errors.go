@@ -35,6 +35,9 @@ var (
 	// ErrInvalidSystemVariableValue is returned when a system variable is assigned a value that it does not accept.
 	ErrInvalidSystemVariableValue = errors.NewKind("Variable '%s' can't be set to the value of '%v'")
 
+	// ErrUnknownTimeZone is returned when the time_zone system variable is set to a time zone that isn't known.
+	ErrUnknownTimeZone = errors.NewKind("Unknown or incorrect time zone: '%v'")
+
 	// ErrSystemVariableCodeFail is returned when failing to encode/decode a system variable.
 	ErrSystemVariableCodeFail = errors.NewKind("unable to encode/decode value '%v' for '%s'")
 
@@ -85,6 +88,9 @@ var (
 	// ErrDatabaseCollationsNotSupported is thrown when a database does not allow updating its collation
 	ErrDatabaseCollationsNotSupported = errors.NewKind("database %s does not support collation operations")
 
+	// ErrDatabaseCommentsNotSupported is thrown when a database does not allow updating its comment
+	ErrDatabaseCommentsNotSupported = errors.NewKind("database %s does not support comments")
+
 	// ErrTableCreatedNotFound is thrown when a table is created from CREATE TABLE but cannot be found immediately afterward
 	ErrTableCreatedNotFound = errors.NewKind("table was created but could not be found")
 
@@ -160,9 +166,23 @@ var (
 	// ErrTriggerDoesNotExist is returned when a trigger does not exist.
 	ErrTriggerDoesNotExist = errors.NewKind(`trigger "%s" does not exist`)
 
+	// ErrTriggerInWrongSchema is returned when a CREATE TRIGGER statement creates a trigger in another database than
+	// the one of its table
+	ErrTriggerInWrongSchema = errors.NewKind("Trigger in wrong schema")
+
 	// ErrTriggerTableInUse is returned when trigger execution calls for a table that invoked a trigger being updated by it
 	ErrTriggerTableInUse = errors.NewKind("Can't update table %s in stored function/trigger because it is already used by statement which invoked this stored function/trigger")
 
+	// ErrTriggerAlreadyExists is returned when creating a trigger with the name of an existing trigger.
+	ErrTriggerAlreadyExists = errors.NewKind(`Trigger '%s' already exists`)
+
+	// ErrReferencedTriggerDoesNotExist is returned when a trigger PRECEDES or FOLLOWS a trigger that doesn't exist, or
+	// that isn't for the same table, action time and event.
+	ErrReferencedTriggerDoesNotExist = errors.NewKind(`Referenced trigger '%s' for the given action time and event type does not exist.`)
+
+	// ErrTriggerRecursionLimit is returned when triggers firing other triggers are nested too deeply.
+	ErrTriggerRecursionLimit = errors.NewKind("Recursive limit %d was exceeded for trigger %s")
+
 	// ErrTriggerCannotBeDropped is returned when dropping a trigger would cause another trigger to reference a non-existent trigger.
 	ErrTriggerCannotBeDropped = errors.NewKind(`trigger "%s" cannot be dropped as it is referenced by trigger "%s"`)
 
@@ -375,9 +395,54 @@ var (
 	// ErrAlterTableNotSupported is thrown when the table doesn't support ALTER TABLE statements
 	ErrAlterTableNotSupported = errors.NewKind("table %s cannot be altered")
 
+	// ErrAlterAlgorithmNotSupported is returned when a table can't honor the ALGORITHM or LOCK clause of an ALTER TABLE
+	ErrAlterAlgorithmNotSupported = errors.NewKind("%s is not supported for this operation. Try %s.")
+
+	// ErrUnknownAlterAlgorithm is returned when the ALGORITHM clause of an ALTER TABLE has an unknown value
+	ErrUnknownAlterAlgorithm = errors.NewKind("Unknown ALGORITHM '%s'")
+
+	// ErrUnknownAlterLock is returned when the LOCK clause of an ALTER TABLE has an unknown value
+	ErrUnknownAlterLock = errors.NewKind("Unknown LOCK type '%s'")
+
+	// ErrCopyAlterNotSupported is returned when the engine cannot perform an ALTER TABLE with the COPY algorithm
+	ErrCopyAlterNotSupported = errors.NewKind("table %s cannot be altered with ALGORITHM=COPY: %s")
+
+	// ErrGeneratedInvisiblePrimaryKeyColumnExists is returned when a table can't be given a generated invisible primary
+	// key because it already has a column with the name of the generated column
+	ErrGeneratedInvisiblePrimaryKeyColumnExists = errors.NewKind("Failed to generate invisible primary key. Column '%s' already exists.")
+
+	// ErrGeneratedInvisiblePrimaryKeyAutoIncrementExists is returned when a table can't be given a generated invisible
+	// primary key because it already has an auto-increment column
+	ErrGeneratedInvisiblePrimaryKeyAutoIncrementExists = errors.NewKind("Failed to generate invisible primary key. Auto-increment column already exists.")
+
+	// ErrSequenceNotFound is returned when a sequence can't be found
+	ErrSequenceNotFound = errors.NewKind("Unknown SEQUENCE: '%s'")
+
+	// ErrSequenceRunOut is returned when a sequence that doesn't cycle has handed out all of its values
+	ErrSequenceRunOut = errors.NewKind("Sequence '%s.%s' has run out")
+
+	// ErrSequenceInvalidData is returned when the options of a sequence are inconsistent with each other
+	ErrSequenceInvalidData = errors.NewKind("Sequence '%s.%s' has out of range value for options")
+
+	// ErrSequencesNotSupported is returned when a sequence is created in a database that can't store sequences
+	ErrSequencesNotSupported = errors.NewKind("database %s does not support sequences")
+
+	// ErrUnknownStorageEngine is returned when a statement names a storage engine that doesn't exist
+	ErrUnknownStorageEngine = errors.NewKind("Unknown storage engine '%s'")
+
+	// ErrMalformedGTIDSet is returned when a string isn't a valid GTID set
+	ErrMalformedGTIDSet = errors.NewKind("Malformed GTID set specification '%s'.")
+
+	// ErrCantSetGTIDPurged is returned when @@GLOBAL.GTID_PURGED is set to a value that's inconsistent with the GTIDs
+	// that were already purged or executed
+	ErrCantSetGTIDPurged = errors.NewKind("@@GLOBAL.GTID_PURGED cannot be changed: %s")
+
 	// ErrAlterTableCollationNotSupported is thrown when the table doesn't support ALTER TABLE COLLATE statements
 	ErrAlterTableCollationNotSupported = errors.NewKind("table %s cannot have its collation altered")
 
+	// ErrAlterTableOptionsNotSupported is thrown when the table doesn't support altering its table options
+	ErrAlterTableOptionsNotSupported = errors.NewKind("table %s cannot have its table options altered")
+
 	// ErrPartitionNotFound is thrown when a partition key on a table is not found
 	ErrPartitionNotFound = errors.NewKind("partition not found %q")
 
@@ -409,7 +474,11 @@ var (
 	ErrForeignKeyDropColumn = errors.NewKind("cannot drop column `%s` as it is used in foreign key `%s`")
 
 	// ErrForeignKeyDropTable is returned when attempting to drop a table used in a foreign key
-	ErrForeignKeyDropTable = errors.NewKind("cannot drop table `%s` as it is referenced in foreign key `%s`")
+	ErrForeignKeyDropTable = errors.NewKind("cannot drop table `%s` as it is referenced in foreign key `%s` on table `%s`")
+
+	// ErrDropTableRestricted is returned when attempting to drop a table that triggers or views depend on, when the
+	// engine doesn't allow dropping them with their dependents.
+	ErrDropTableRestricted = errors.NewKind("cannot drop table `%s` as it is referenced by %s")
 
 	// ErrForeignKeyDropIndex is returned when attempting to drop an index used in a foreign key when there are no other
 	// indexes which may be used in its place.
@@ -492,6 +561,12 @@ var (
 	// ErrCantDropFieldOrKey is returned when a table invokes DropPrimaryKey on a keyless table.
 	ErrCantDropFieldOrKey = errors.NewKind("error: can't drop '%s'; check that column/key exists")
 
+	// ErrKeyDoesNotExist is returned when an index to rename doesn't exist in the table.
+	ErrKeyDoesNotExist = errors.NewKind("Key '%s' doesn't exist in table '%s'")
+
+	// ErrDuplicateKeyName is returned when an index would be given the name of another index of the table.
+	ErrDuplicateKeyName = errors.NewKind("Duplicate key name '%s'")
+
 	// ErrCantDropIndex is return when a table can't drop an index due to a foreign key relationship.
 	ErrCantDropIndex = errors.NewKind("error: can't drop index '%s': needed in foreign key constraint %s")
 
@@ -513,6 +588,20 @@ var (
 	// ErrTableFunctionNotFound is thrown when a table function is not found
 	ErrTableFunctionNotFound = errors.NewKind("table function: '%s' not found")
 
+	// ErrTableFunctionNotLateral is thrown when a table function that doesn't accept lateral arguments is called with
+	// arguments that reference columns or bind variables
+	ErrTableFunctionNotLateral = errors.NewKind("table function: '%s' does not accept arguments that reference columns or bind variables")
+
+	// ErrTableFunctionSchemaChanged is thrown when a table function prepared with bind variables in its arguments
+	// returns rows with a different schema for their values
+	ErrTableFunctionSchemaChanged = errors.NewKind("table function: '%s' returns a different schema for the values of its bind variables")
+
+	// ErrInvalidTableSampleMethod is thrown when a TABLESAMPLE clause has an unknown sampling method
+	ErrInvalidTableSampleMethod = errors.NewKind("invalid TABLESAMPLE method: '%v', expected BERNOULLI or SYSTEM")
+
+	// ErrInvalidTableSamplePercent is thrown when the percentage of a TABLESAMPLE clause isn't from 0 to 100
+	ErrInvalidTableSamplePercent = errors.NewKind("invalid TABLESAMPLE percentage: %v, expected a number from 0 to 100")
+
 	// ErrNonAggregatedColumnWithoutGroupBy is thrown when an aggregate function is used with the implicit, all-rows
 	// grouping and another projected expression contains a non-aggregated column.
 	// MySQL error code: 1140, SQL state: 42000
@@ -548,10 +637,37 @@ var (
 	// ErrReadOnlyTransaction is returned when a write query is executed in a READ ONLY transaction.
 	ErrReadOnlyTransaction = errors.NewKind("cannot execute statement in a READ ONLY transaction")
 
+	// ErrOptionPreventsStatement is returned when a write query is executed while the server runs with the
+	// read_only or super_read_only option.
+	ErrOptionPreventsStatement = errors.NewKind("The MySQL server is running with the %s option so it cannot execute this statement")
+
+	// ErrCantUpdateWithReadLock is returned when a session that holds the global read lock tries to write.
+	ErrCantUpdateWithReadLock = errors.NewKind("Can't execute the query because you have a conflicting read lock")
+
+	// ErrLockWaitTimeout is returned when a write waits for the global read lock to be released for longer than
+	// lock_wait_timeout.
+	ErrLockWaitTimeout = errors.NewKind("Lock wait timeout exceeded; try restarting transaction")
+
+	// ErrFileExists is returned when a statement would write a file that already exists.
+	ErrFileExists = errors.NewKind("File '%s' already exists")
+
+	// ErrNetPacketTooLarge is returned when a statement or a result row is larger than max_allowed_packet.
+	ErrNetPacketTooLarge = errors.NewKind("Got a packet bigger than 'max_allowed_packet' bytes")
+
+	// ErrDataOutOfRange is returned when the result of an integer arithmetic expression is out of the range of its
+	// type, like ER_DATA_OUT_OF_RANGE.
+	ErrDataOutOfRange = errors.NewKind("%s value is out of range in '%s'")
+
 	// ErrLockDeadlock is the go-mysql-server equivalent of ER_LOCK_DEADLOCK. Transactions throwing this error
 	// are automatically rolled back. Clients receiving this error must retry the transaction.
 	ErrLockDeadlock = errors.NewKind("serialization failure: %s, try restarting transaction.")
 
+	// ErrSerializationFailure is returned by integrators whose transactions fail to commit because of the changes of a
+	// concurrent transaction, as optimistic concurrency control does. Like ErrLockDeadlock, the transaction is rolled
+	// back and clients must retry it. The engine retries the statements of autocommit transactions failing with it
+	// when configured to.
+	ErrSerializationFailure = errors.NewKind("transaction conflict: %s, try restarting transaction.")
+
 	// ErrViewsNotSupported is returned when attempting to access a view on a database that doesn't support them.
 	ErrViewsNotSupported = errors.NewKind("database '%s' doesn't support views")
 
@@ -564,6 +680,16 @@ var (
 	// ErrViewDoesNotExist is returned when a DROP VIEW statement drops a view that does not exist
 	ErrViewDoesNotExist = errors.NewKind("the view %s.%s does not exist")
 
+	// ErrDuplicateViewColumn is returned when the column list of a view has the same column more than once
+	ErrDuplicateViewColumn = errors.NewKind("Duplicate column name '%s'")
+
+	// ErrDefinerDoesNotExist is returned when a view or stored program executed with the privileges of its definer is
+	// used and its definer doesn't exist
+	ErrDefinerDoesNotExist = errors.NewKind("The user specified as a definer (%s) does not exist")
+
+	// ErrViewInvalid is returned when a view is used whose definition references tables or columns that don't exist
+	ErrViewInvalid = errors.NewKind("View '%s.%s' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them")
+
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
 
@@ -637,6 +763,28 @@ var (
 	// ErrRoleDeletionFailure is returned when attempting to create a role and it fails for any reason.
 	ErrRoleDeletionFailure = errors.NewKind("Operation DROP ROLE failed for %s")
 
+	// ErrUserAlterationFailure is returned when attempting to alter a user and it fails for any reason.
+	ErrUserAlterationFailure = errors.NewKind("Operation ALTER USER failed for %s")
+
+	// ErrMustChangePassword is returned when a connection whose password has expired runs a statement other than one
+	// changing its password.
+	ErrMustChangePassword = errors.NewKind("You must reset your password using ALTER USER statement before executing this statement.")
+
+	// ErrCredentialsContradictToHistory is returned when changing the password of an account to one of its previous
+	// passwords that its password reuse policy doesn't allow.
+	ErrCredentialsContradictToHistory = errors.NewKind("Cannot use these credentials for '%s@%s' because they contradict the password history policy")
+
+	// ErrIncorrectCurrentPassword is returned when the current password given to change a password is incorrect.
+	ErrIncorrectCurrentPassword = errors.NewKind("Incorrect current password. Specify the correct password which has to be replaced.")
+
+	// ErrMissingCurrentPassword is returned when changing the password of an account that requires the current one
+	// without giving it.
+	ErrMissingCurrentPassword = errors.NewKind("Current password needs to be specified in the REPLACE clause in order to change it.")
+
+	// ErrCurrentPasswordNotRequired is returned when giving the current password to change the password of another
+	// account.
+	ErrCurrentPasswordNotRequired = errors.NewKind("Do not specify the current password while changing it for other users.")
+
 	// ErrDatabaseAccessDeniedForUser is returned when attempting to access a database that the user does not have
 	// permission for, regardless of whether that database actually exists.
 	ErrDatabaseAccessDeniedForUser = errors.NewKind("Access denied for user %s to database '%s'")
@@ -855,13 +1003,69 @@ func CastSQLError(err error) *mysql.SQLError {
 		code = mysql.ERKeyColumnDoesNotExist
 	case ErrCantDropFieldOrKey.Is(err):
 		code = mysql.ERCantDropFieldOrKey
+	case ErrKeyDoesNotExist.Is(err):
+		code = mysql.ERKeyDoesNotExist
+	case ErrDuplicateKeyName.Is(err):
+		code = mysql.ERDupKeyName
 	case ErrReadOnlyTransaction.Is(err):
 		code = 1792 // TODO: Needs to be added to vitess
+	case ErrMustChangePassword.Is(err):
+		code = 1820 // TODO: Needs to be added to vitess
+	case ErrCredentialsContradictToHistory.Is(err):
+		code = 3638 // TODO: Needs to be added to vitess
+	case ErrIncorrectCurrentPassword.Is(err):
+		code = 3891 // TODO: Needs to be added to vitess
+	case ErrMissingCurrentPassword.Is(err):
+		code = 3892 // TODO: Needs to be added to vitess
+	case ErrCurrentPasswordNotRequired.Is(err):
+		code = 3893 // TODO: Needs to be added to vitess
+	case ErrOptionPreventsStatement.Is(err):
+		code = mysql.EROptionPreventsStatement
+	case ErrCantUpdateWithReadLock.Is(err):
+		code = mysql.ERCantUpdateWithReadLock
+	case ErrLockWaitTimeout.Is(err):
+		code = mysql.ERLockWaitTimeout
+	case ErrFileExists.Is(err):
+		code = mysql.ERFileExists
+	case ErrNetPacketTooLarge.Is(err):
+		code = mysql.ERNetPacketTooLarge
+		sqlState = mysql.SSNetError
+	case ErrDataOutOfRange.Is(err):
+		code = mysql.ERDataOutOfRange
+		sqlState = mysql.SSDataOutOfRange
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
+	case ErrTriggerInWrongSchema.Is(err):
+		code = 1435 // TODO: Needs to be added to vitess
+	case ErrForeignKeyDropTable.Is(err):
+		code = 3730 // TODO: Needs to be added to vitess
+	case ErrViewInvalid.Is(err):
+		code = 1356 // TODO: Needs to be added to vitess
 	case ErrInvalidValue.Is(err):
 		code = mysql.ERTruncatedWrongValueForField
-	case ErrLockDeadlock.Is(err):
+	case ErrAlterAlgorithmNotSupported.Is(err):
+		code = 1845 // TODO: Needs to be added to vitess
+	case ErrUnknownAlterAlgorithm.Is(err):
+		code = 1800 // TODO: Needs to be added to vitess
+	case ErrUnknownAlterLock.Is(err):
+		code = 1801 // TODO: Needs to be added to vitess
+	case ErrGeneratedInvisiblePrimaryKeyColumnExists.Is(err):
+		code = 4108 // TODO: Needs to be added to vitess
+	case ErrGeneratedInvisiblePrimaryKeyAutoIncrementExists.Is(err):
+		code = 4109 // TODO: Needs to be added to vitess
+	case ErrSequenceNotFound.Is(err):
+		code = 4091 // TODO: Needs to be added to vitess
+	case ErrSequenceRunOut.Is(err):
+		code = 4084 // TODO: Needs to be added to vitess
+	case ErrSequenceInvalidData.Is(err):
+		code = 4085 // TODO: Needs to be added to vitess
+	case ErrUnknownStorageEngine.Is(err):
+		code = 1286 // TODO: Needs to be added to vitess
+	case ErrMalformedGTIDSet.Is(err):
+		code = 1772 // TODO: Needs to be added to vitess
+	case ErrCantSetGTIDPurged.Is(err):
+		code = 3546 // TODO: Needs to be added to vitess
+	case ErrLockDeadlock.Is(err), ErrSerializationFailure.Is(err):
 		// ER_LOCK_DEADLOCK signals that the transaction was rolled back
 		// due to a deadlock between concurrent transactions.
 		// MySQL maps this error to the ANSI SQLSTATE code of 40001 which
@@ -18,6 +18,11 @@ func TestSQLErrorCast(t *testing.T) {
 	}{
 		{ErrTableNotFound.New("table not found err"), mysql.ERNoSuchTable},
 		{ErrInvalidType.New("unhandled mysql error"), mysql.ERUnknownError},
+		{ErrUnknownAlterAlgorithm.New("FAST"), 1800},
+		{ErrUnknownAlterLock.New("NOTHING"), 1801},
+		{ErrIncorrectCurrentPassword.New(), 3891},
+		{ErrMissingCurrentPassword.New(), 3892},
+		{ErrCurrentPasswordNotRequired.New(), 3893},
 		{fmt.Errorf("generic error"), mysql.ERUnknownError},
 		{nil, mysql.ERUnknownError},
 	}
@@ -16,6 +16,9 @@ package expression
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
 	"reflect"
 	"regexp"
 	"strings"
@@ -151,15 +154,8 @@ func (a *Arithmetic) Type() sql.Type {
 		return types.Float64
 	}
 
-	if types.IsUnsigned(lTyp) && types.IsUnsigned(rTyp) {
-		return types.Uint64
-	} else if types.IsSigned(lTyp) && types.IsSigned(rTyp) {
-		return types.Int64
-	}
-
-	// if one is uint and the other is int of any size, then use int64
 	if types.IsInteger(lTyp) && types.IsInteger(rTyp) {
-		return types.Int64
+		return integerArithmeticType(lTyp, rTyp)
 	}
 
 	return floatOrDecimalType(a, false)
@@ -201,6 +197,10 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
+	if typ := a.Type(); types.IsInteger(typ) {
+		return integerArithmetic(ctx, a, a.Op, lval, rval, types.IsUnsigned(typ))
+	}
+
 	switch strings.ToLower(a.Op) {
 	case sqlparser.PlusStr:
 		return plus(lval, rval)
@@ -249,6 +249,17 @@ func (a *Arithmetic) convertLeftRight(ctx *sql.Context, left interface{}, right
 
 	lIsTimeType := types.IsTime(a.Left.Type())
 	rIsTimeType := types.IsTime(a.Right.Type())
+	// the date operand of interval arithmetic is converted directly, keeping its fractional seconds
+	if isInterval(a.Left) || isInterval(a.Right) {
+		lIsTimeType, rIsTimeType = false, false
+	}
+
+	// integer operands keep their own signedness, so that the operation is exact
+	if types.IsInteger(typ) {
+		left = convertValueToType(ctx, integerOperandType(a.Left.Type()), left, lIsTimeType)
+		right = convertValueToType(ctx, integerOperandType(a.Right.Type()), right, rIsTimeType)
+		return left, right, nil
+	}
 
 	if i, ok := left.(*TimeDelta); ok {
 		left = i
@@ -366,6 +377,144 @@ func convertTimeTypeToString(val interface{}) interface{} {
 	return val
 }
 
+// integerArithmeticType returns the type of the result of +, -, * and DIV between integers of the types given. Like
+// MySQL, the result is a BIGINT UNSIGNED when either operand is unsigned, and a BIGINT otherwise.
+func integerArithmeticType(lTyp, rTyp sql.Type) sql.Type {
+	if types.IsUnsigned(lTyp) || types.IsUnsigned(rTyp) {
+		return types.Uint64
+	}
+	return types.Int64
+}
+
+// integerOperandType returns the type that an integer operand of the type given is converted to for integer
+// arithmetic, which keeps the signedness of the operand.
+func integerOperandType(typ sql.Type) sql.Type {
+	if types.IsUnsigned(typ) {
+		return types.Uint64
+	}
+	return types.Int64
+}
+
+// mixedIntegerComparisonType is the type that a signed integer and an unsigned integer are compared as. It holds every
+// value of both exactly, so that neither is wrapped by the comparison.
+var mixedIntegerComparisonType = types.MustCreateDecimalType(20, 0)
+
+// integerArithmetic evaluates the integer operation |op| of |e| between |lval| and |rval|, which are int64 or uint64
+// values of either signedness. The operation is exact rather than wrapping, and its result is returned as a uint64
+// when |unsigned| is true and an int64 otherwise. Results out of the range of that type are an error, as in MySQL, so
+// a subtraction between unsigned integers that would be negative is an error. Division by zero is NULL with a warning.
+// Operands of the type of the result are operated on natively, and the others, or operations that overflow, exactly.
+func integerArithmetic(ctx *sql.Context, e sql.Expression, op string, lval, rval interface{}, unsigned bool) (interface{}, error) {
+	op = strings.ToLower(op)
+	if res, ok := nativeIntegerArithmetic(op, lval, rval, unsigned); ok {
+		return res, nil
+	}
+
+	l, r := bigIntValue(lval), bigIntValue(rval)
+	res := new(big.Int)
+	switch op {
+	case sqlparser.PlusStr:
+		res.Add(l, r)
+	case sqlparser.MinusStr:
+		res.Sub(l, r)
+	case sqlparser.MultStr:
+		res.Mul(l, r)
+	case sqlparser.IntDivStr:
+		if r.Sign() == 0 {
+			arithmeticWarning(ctx, ERDivisionByZero, "Division by 0")
+			return nil, nil
+		}
+		// DIV truncates toward zero, like Quo
+		res.Quo(l, r)
+	default:
+		return nil, errUnableToEval.New(lval, op, rval)
+	}
+
+	if unsigned {
+		if !res.IsUint64() {
+			return nil, sql.ErrDataOutOfRange.New("BIGINT UNSIGNED", e)
+		}
+		return res.Uint64(), nil
+	}
+	if !res.IsInt64() {
+		return nil, sql.ErrDataOutOfRange.New("BIGINT", e)
+	}
+	return res.Int64(), nil
+}
+
+// nativeIntegerArithmetic evaluates the integer operation |op| between |lval| and |rval| without allocating, when they
+// are both uint64 values and |unsigned| is true, or both int64 values and |unsigned| is false. Returns the result and
+// whether it could be evaluated, which it can't when the operands are of other types, the result overflows, or the
+// operation is a division by zero.
+func nativeIntegerArithmetic(op string, lval, rval interface{}, unsigned bool) (interface{}, bool) {
+	if unsigned {
+		l, lok := lval.(uint64)
+		r, rok := rval.(uint64)
+		if !lok || !rok {
+			return nil, false
+		}
+		switch op {
+		case sqlparser.PlusStr:
+			sum, carry := bits.Add64(l, r, 0)
+			return sum, carry == 0
+		case sqlparser.MinusStr:
+			diff, borrow := bits.Sub64(l, r, 0)
+			return diff, borrow == 0
+		case sqlparser.MultStr:
+			hi, lo := bits.Mul64(l, r)
+			return lo, hi == 0
+		case sqlparser.IntDivStr:
+			if r == 0 {
+				return nil, false
+			}
+			return l / r, true
+		}
+		return nil, false
+	}
+
+	l, lok := lval.(int64)
+	r, rok := rval.(int64)
+	if !lok || !rok {
+		return nil, false
+	}
+	switch op {
+	case sqlparser.PlusStr:
+		// the sum overflows when it doesn't have the sign of either operand
+		sum := l + r
+		return sum, (l^sum)&(r^sum) >= 0
+	case sqlparser.MinusStr:
+		// the difference overflows when the operands differ in sign and it doesn't have the sign of the left one
+		diff := l - r
+		return diff, (l^r)&(l^diff) >= 0
+	case sqlparser.MultStr:
+		if l == 0 || r == 0 {
+			return int64(0), true
+		}
+		prod := l * r
+		return prod, prod/r == l && !(l == -1 && r == math.MinInt64) && !(r == -1 && l == math.MinInt64)
+	case sqlparser.IntDivStr:
+		// DIV truncates toward zero, like Go's division
+		if r == 0 || (l == math.MinInt64 && r == -1) {
+			return nil, false
+		}
+		return l / r, true
+	}
+	return nil, false
+}
+
+// bigIntValue returns the int64 or uint64 value given as a big.Int. Values that could not be converted to an integer
+// are interpreted as 0.
+func bigIntValue(val interface{}) *big.Int {
+	switch v := val.(type) {
+	case int64:
+		return big.NewInt(v)
+	case uint64:
+		return new(big.Int).SetUint64(v)
+	default:
+		return new(big.Int)
+	}
+}
+
 func plus(lval, rval interface{}) (interface{}, error) {
 	switch l := lval.(type) {
 	case uint8:
@@ -616,16 +765,22 @@ func (e *UnaryMinus) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	case int32:
 		return -n, nil
 	case int64:
+		if n == math.MinInt64 {
+			return nil, sql.ErrDataOutOfRange.New("BIGINT", e)
+		}
 		return -n, nil
 	case uint:
-		return -int(n), nil
+		return -int64(n), nil
 	case uint8:
-		return -int8(n), nil
+		return -int64(n), nil
 	case uint16:
-		return -int16(n), nil
+		return -int64(n), nil
 	case uint32:
-		return -int32(n), nil
+		return -int64(n), nil
 	case uint64:
+		if n > -math.MinInt64 {
+			return nil, sql.ErrDataOutOfRange.New("BIGINT", e)
+		}
 		return -int64(n), nil
 	case decimal.Decimal:
 		return n.Neg(), err
@@ -641,11 +796,8 @@ func (e *UnaryMinus) Type() sql.Type {
 		return types.Float64
 	}
 
-	if typ == types.Uint32 {
-		return types.Int32
-	}
-
-	if typ == types.Uint64 {
+	// the negation of an unsigned integer is signed, and may be beyond the range of a signed integer of the same size
+	if types.IsUnsigned(typ) {
 		return types.Int64
 	}
 
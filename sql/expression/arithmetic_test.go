@@ -15,9 +15,11 @@
 package expression
 
 import (
+	"math"
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -256,9 +258,11 @@ func TestUnaryMinus(t *testing.T) {
 		expected interface{}
 	}{
 		{"int32", int32(1), types.Int32, int32(-1)},
-		{"uint32", uint32(1), types.Uint32, int32(-1)},
+		{"uint8", uint8(200), types.Uint8, int64(-200)},
+		{"uint32", uint32(4294967295), types.Uint32, int64(-4294967295)},
 		{"int64", int64(1), types.Int64, int64(-1)},
 		{"uint64", uint64(1), types.Uint64, int64(-1)},
+		{"uint64 min int64", uint64(9223372036854775808), types.Uint64, int64(math.MinInt64)},
 		{"float32", float32(1), types.Float32, float32(-1)},
 		{"float64", float64(1), types.Float64, float64(-1)},
 		{"int text", "1", types.LongText, "-1"},
@@ -279,3 +283,111 @@ func TestUnaryMinus(t *testing.T) {
 		})
 	}
 }
+
+func TestUnaryMinusOutOfRange(t *testing.T) {
+	_, err := NewUnaryMinus(NewLiteral(uint64(math.MaxUint64), types.Uint64)).Eval(sql.NewEmptyContext(), nil)
+	require.True(t, sql.ErrDataOutOfRange.Is(err), "%v", err)
+	_, err = NewUnaryMinus(NewLiteral(int64(math.MinInt64), types.Int64)).Eval(sql.NewEmptyContext(), nil)
+	require.True(t, sql.ErrDataOutOfRange.Is(err), "%v", err)
+}
+
+func TestIntegerArithmetic(t *testing.T) {
+	newIntDiv := func(left, right sql.Expression) sql.Expression {
+		return NewIntDiv(left, right)
+	}
+	newPlus := func(left, right sql.Expression) sql.Expression {
+		return NewPlus(left, right)
+	}
+	newMinus := func(left, right sql.Expression) sql.Expression {
+		return NewMinus(left, right)
+	}
+	newMult := func(left, right sql.Expression) sql.Expression {
+		return NewMult(left, right)
+	}
+
+	var testCases = []struct {
+		name                string
+		op                  func(left, right sql.Expression) sql.Expression
+		left, right         interface{}
+		leftType, rightType sql.Type
+		expectedType        sql.Type
+		expected            interface{}
+		outOfRange          bool
+	}{
+		{"signed + signed", newPlus, int64(1), int64(-3), types.Int64, types.Int64, types.Int64, int64(-2), false},
+		{"signed + signed overflow", newPlus, int64(math.MaxInt64), int64(1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed + signed underflow", newPlus, int64(math.MinInt64), int64(-1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed + signed opposite limits", newPlus, int64(math.MaxInt64), int64(math.MinInt64), types.Int64, types.Int64, types.Int64, int64(-1), false},
+		{"small signed + small signed", newPlus, int8(127), int8(127), types.Int8, types.Int8, types.Int64, int64(254), false},
+		{"unsigned + unsigned", newPlus, uint64(1), uint64(2), types.Uint64, types.Uint64, types.Uint64, uint64(3), false},
+		{"unsigned + unsigned overflow", newPlus, uint64(math.MaxUint64), uint64(1), types.Uint64, types.Uint64, types.Uint64, nil, true},
+		{"small unsigned + small unsigned", newPlus, uint8(255), uint8(255), types.Uint8, types.Uint8, types.Uint64, uint64(510), false},
+		{"unsigned + signed", newPlus, uint64(math.MaxUint64), int64(-1), types.Uint64, types.Int64, types.Uint64, uint64(math.MaxUint64 - 1), false},
+		{"unsigned + negative signed", newPlus, uint64(1), int64(-3), types.Uint64, types.Int64, types.Uint64, nil, true},
+		{"signed + unsigned", newPlus, int64(math.MaxInt64), uint64(math.MaxInt64), types.Int64, types.Uint64, types.Uint64, uint64(math.MaxUint64 - 1), false},
+
+		{"signed - signed", newMinus, int64(1), int64(3), types.Int64, types.Int64, types.Int64, int64(-2), false},
+		{"signed - signed underflow", newMinus, int64(math.MinInt64), int64(1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed - signed overflow", newMinus, int64(math.MaxInt64), int64(-1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"zero - signed min", newMinus, int64(0), int64(math.MinInt64), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed - signed min", newMinus, int64(-1), int64(math.MinInt64), types.Int64, types.Int64, types.Int64, int64(math.MaxInt64), false},
+		{"unsigned - unsigned", newMinus, uint64(3), uint64(1), types.Uint64, types.Uint64, types.Uint64, uint64(2), false},
+		{"unsigned - unsigned negative", newMinus, uint64(1), uint64(2), types.Uint64, types.Uint64, types.Uint64, nil, true},
+		{"small unsigned - small unsigned negative", newMinus, uint8(0), uint8(1), types.Uint8, types.Uint8, types.Uint64, nil, true},
+		{"unsigned - signed", newMinus, uint64(math.MaxUint64), int64(math.MaxInt64), types.Uint64, types.Int64, types.Uint64, uint64(math.MaxInt64 + 1), false},
+		{"unsigned - signed negative", newMinus, uint64(1), int64(2), types.Uint64, types.Int64, types.Uint64, nil, true},
+		{"unsigned - negative signed", newMinus, uint64(1), int64(-2), types.Uint64, types.Int64, types.Uint64, uint64(3), false},
+		{"signed - unsigned", newMinus, int64(5), uint64(3), types.Int64, types.Uint64, types.Uint64, uint64(2), false},
+		{"signed - unsigned negative", newMinus, int64(math.MaxInt64), uint64(math.MaxUint64), types.Int64, types.Uint64, types.Uint64, nil, true},
+
+		{"signed * signed", newMult, int64(-2), int64(3), types.Int64, types.Int64, types.Int64, int64(-6), false},
+		{"signed * signed overflow", newMult, int64(math.MaxInt64), int64(2), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed * signed min", newMult, int64(math.MinInt64), int64(-1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed * signed min reversed", newMult, int64(-1), int64(math.MinInt64), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed * signed min by one", newMult, int64(math.MinInt64), int64(1), types.Int64, types.Int64, types.Int64, int64(math.MinInt64), false},
+		{"signed * signed square overflow", newMult, int64(3037000500), int64(3037000500), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed * signed negative overflow", newMult, int64(math.MinInt64 / 2), int64(3), types.Int64, types.Int64, types.Int64, nil, true},
+		{"unsigned * unsigned", newMult, uint64(math.MaxUint64), uint64(1), types.Uint64, types.Uint64, types.Uint64, uint64(math.MaxUint64), false},
+		{"unsigned * unsigned overflow", newMult, uint64(1 << 32), uint64(1 << 32), types.Uint64, types.Uint64, types.Uint64, nil, true},
+		{"unsigned * signed", newMult, uint64(1 << 62), int64(2), types.Uint64, types.Int64, types.Uint64, uint64(1 << 63), false},
+		{"unsigned * negative signed", newMult, uint64(1), int64(-1), types.Uint64, types.Int64, types.Uint64, nil, true},
+		{"unsigned * negative signed zero", newMult, uint64(0), int64(-1), types.Uint64, types.Int64, types.Uint64, uint64(0), false},
+
+		{"signed div signed", newIntDiv, int64(-5), int64(2), types.Int64, types.Int64, types.Int64, int64(-2), false},
+		{"signed div signed overflow", newIntDiv, int64(math.MinInt64), int64(-1), types.Int64, types.Int64, types.Int64, nil, true},
+		{"signed div zero", newIntDiv, int64(1), int64(0), types.Int64, types.Int64, types.Int64, nil, false},
+		{"unsigned div unsigned", newIntDiv, uint64(math.MaxUint64), uint64(2), types.Uint64, types.Uint64, types.Uint64, uint64(math.MaxUint64 / 2), false},
+		{"unsigned div signed", newIntDiv, uint64(math.MaxUint64), int64(1), types.Uint64, types.Int64, types.Uint64, uint64(math.MaxUint64), false},
+		{"unsigned div negative signed", newIntDiv, uint64(5), int64(-1), types.Uint64, types.Int64, types.Uint64, nil, true},
+		{"unsigned div larger negative signed", newIntDiv, uint64(5), int64(-10), types.Uint64, types.Int64, types.Uint64, uint64(0), false},
+		{"signed div unsigned", newIntDiv, int64(-10), uint64(20), types.Int64, types.Uint64, types.Uint64, uint64(0), false},
+		{"unsigned div zero", newIntDiv, uint64(1), int64(0), types.Uint64, types.Int64, types.Uint64, nil, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			e := tt.op(NewLiteral(tt.left, tt.leftType), NewLiteral(tt.right, tt.rightType))
+			require.Equal(tt.expectedType, e.Type())
+			result, err := e.Eval(sql.NewEmptyContext(), sql.NewRow())
+			if tt.outOfRange {
+				require.True(sql.ErrDataOutOfRange.Is(err), "%v", err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func TestIntegerArithmeticAllocations(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	e := NewPlus(NewLiteral(int64(1), types.Int64), NewLiteral(int64(2), types.Int64))
+	for _, op := range []string{sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.IntDivStr} {
+		// results small enough to be boxed without allocating show that the operation itself doesn't allocate
+		require.Zero(t, testing.AllocsPerRun(100, func() {
+			_, _ = integerArithmetic(ctx, e, op, int64(6), int64(2), false)
+			_, _ = integerArithmetic(ctx, e, op, uint64(6), uint64(2), true)
+		}), op)
+	}
+}
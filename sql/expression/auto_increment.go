@@ -101,12 +101,13 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, err
 	}
 
-	// When a row passes in 0 as the auto_increment value it is equivalent to NULL.
+	// When a row passes in 0 as the auto_increment value it is equivalent to NULL, unless the NO_AUTO_VALUE_ON_ZERO
+	// mode is set, as it is when restoring a dump of a table with a row whose id is 0.
 	cmp, err := i.Type().Compare(given, i.Type().Zero())
 	if err != nil {
 		return nil, err
 	}
-	if cmp == 0 {
+	if cmp == 0 && !sql.LoadSqlMode(ctx).ModeEnabled(sql.SqlModeNoAutoValueOnZero) {
 		given = nil
 	} else if cmp < 0 {
 		// if given is negative, don't do any auto_increment logic
@@ -122,13 +123,49 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 
 	// Use sequence value if NULL or 0 were provided
 	if given == nil {
-		given = seq
+		next, err := stepAutoIncrementValue(ctx, seq)
+		if err != nil {
+			return nil, err
+		}
+		if next != seq {
+			// The integrator must know that the sequence skipped ahead to the stepped value
+			if _, err = i.autoTbl.GetNextAutoIncrementValue(ctx, next); err != nil {
+				return nil, err
+			}
+		}
+		given = next
 	}
 
 	ret, _, err := i.Type().Convert(given)
 	return ret, err
 }
 
+// stepAutoIncrementValue returns the first value no less than |seq| in the series of values defined by the
+// auto_increment_increment and auto_increment_offset session variables.
+func stepAutoIncrementValue(ctx *sql.Context, seq uint64) (uint64, error) {
+	increment, err := ctx.GetSessionVariable(ctx, "auto_increment_increment")
+	if err != nil {
+		return 0, err
+	}
+	offset, err := ctx.GetSessionVariable(ctx, "auto_increment_offset")
+	if err != nil {
+		return 0, err
+	}
+
+	inc, off := uint64(increment.(int64)), uint64(offset.(int64))
+	if inc <= 1 {
+		return seq, nil
+	}
+	// Like MySQL, an offset larger than the increment is ignored
+	if off > inc {
+		off = 1
+	}
+	if seq <= off {
+		return off, nil
+	}
+	return off + (seq-off+inc-1)/inc*inc, nil
+}
+
 func (i *AutoIncrement) String() string {
 	return fmt.Sprintf("AutoIncrement(%s)", i.Child.String())
 }
@@ -174,14 +174,14 @@ func TestCaseType(t *testing.T) {
 			types.Int64,
 		},
 		{
-			"unsigned promoted and unsigned",
+			"unsigned and unsigned",
 			caseExpr(NewLiteral(uint32(0), types.Uint32), NewLiteral(uint32(1), types.Uint32)),
-			types.Uint64,
+			types.Uint32,
 		},
 		{
-			"signed promoted and signed",
+			"signed widened to signed",
 			caseExpr(NewLiteral(int8(0), types.Int8), NewLiteral(int32(1), types.Int32)),
-			types.Int64,
+			types.Int32,
 		},
 		{
 			"int and float to float",
@@ -216,7 +216,7 @@ func TestCaseType(t *testing.T) {
 		{
 			"uint64 and int8 to decimal",
 			caseExpr(NewLiteral(uint64(10), types.Uint64), NewLiteral(int8(0), types.Int8)),
-			decimalType,
+			types.MustCreateDecimalType(20, 0),
 		},
 		{
 			"int and text to text",
@@ -82,6 +82,13 @@ func (c *comparison) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 // Since both types should be equal, it does not matter which type is used, but for
 // reference, the left type is always used.
 func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
+	if types.IsTuple(c.Left().Type()) && types.IsTuple(c.Right().Type()) {
+		return c.compareTuples(ctx, row, func(l, r sql.Expression) (int, error) {
+			cmp := newComparison(l, r)
+			return cmp.Compare(ctx, row)
+		}, false)
+	}
+
 	left, right, err := c.evalLeftAndRight(ctx, row)
 	if err != nil {
 		return 0, err
@@ -144,6 +151,95 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	return compareType.Compare(left, right)
 }
 
+// compareTuples compares the tuples on both sides of the comparison element by element with |compare|, returning the
+// result of the first pair of elements that differ. The result is NULL, signaled with ErrNilOperand, when a pair with
+// a NULL element comes before that, as in MySQL. With |equality|, only whether the tuples are equal matters, so a pair
+// of different elements decides the result even after a NULL one.
+func (c *comparison) compareTuples(ctx *sql.Context, row sql.Row, compare func(l, r sql.Expression) (int, error), equality bool) (int, error) {
+	left, err := tupleElements(ctx, row, c.Left())
+	if err != nil {
+		return 0, err
+	}
+	right, err := tupleElements(ctx, row, c.Right())
+	if err != nil {
+		return 0, err
+	}
+	if len(left) != len(right) {
+		return 0, sql.ErrInvalidOperandColumns.New(len(left), len(right))
+	}
+
+	foundNil := false
+	for i := range left {
+		cmp, err := compare(left[i], right[i])
+		if ErrNilOperand.Is(err) && equality {
+			foundNil = true
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	if foundNil {
+		return 0, ErrNilOperand.New()
+	}
+	return 0, nil
+}
+
+// SplitTupleEquality rewrites the equality of two tuples of expressions, like (a, b) = (1, 2), as the conjunction of
+// the equalities of their elements, a = 1 AND b = 2, which is equivalent, NULLs included, and lets the columns be
+// matched to indexes. The elements are compared with <=> when |nullSafe| is set. Nested tuples are split as well. The
+// expressions may be unresolved. Returns false if the operands aren't tuples of the same size, which is left for
+// validation to report.
+func SplitTupleEquality(left, right sql.Expression, nullSafe bool) (sql.Expression, bool) {
+	l, ok := left.(Tuple)
+	if !ok || len(l) < 2 {
+		return nil, false
+	}
+	r, ok := right.(Tuple)
+	if !ok || len(l) != len(r) {
+		return nil, false
+	}
+	conds := make([]sql.Expression, len(l))
+	for i := range l {
+		if cond, ok := SplitTupleEquality(l[i], r[i], nullSafe); ok {
+			conds[i] = cond
+		} else if nullSafe {
+			conds[i] = NewNullSafeEquals(l[i], r[i])
+		} else {
+			conds[i] = NewEquals(l[i], r[i])
+		}
+	}
+	return JoinAnd(conds...), true
+}
+
+// tupleElements returns the expressions of the elements of the tuple |e|. Tuples that aren't built from a list of
+// expressions, like those returned by subqueries, are evaluated, and their elements returned as literals. Returns
+// ErrNilOperand for a NULL tuple, like the result of a subquery without rows.
+func tupleElements(ctx *sql.Context, row sql.Row, e sql.Expression) ([]sql.Expression, error) {
+	if t, ok := e.(Tuple); ok {
+		return t, nil
+	}
+	val, err := e.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, ErrNilOperand.New()
+	}
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, sql.ErrNotTuple.New(val)
+	}
+	typ := e.Type().(types.TupleType)
+	elems := make([]sql.Expression, len(vals))
+	for i, v := range vals {
+		elems[i] = NewLiteral(v, typ[i])
+	}
+	return elems, nil
+}
+
 func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	left, err := c.Left().Eval(ctx, row)
 	if err != nil {
@@ -206,6 +302,18 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 			return l, r, types.Float64, nil
 		}
 
+		if types.IsInteger(leftType) && types.IsInteger(rightType) && types.IsUnsigned(leftType) != types.IsUnsigned(rightType) {
+			l, _, err := mixedIntegerComparisonType.Convert(left)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			r, _, err := mixedIntegerComparisonType.Convert(right)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			return l, r, mixedIntegerComparisonType, nil
+		}
+
 		if types.IsSigned(leftType) || types.IsSigned(rightType) {
 			l, r, err := convertLeftAndRight(left, right, ConvertToSigned)
 			if err != nil {
@@ -232,12 +340,12 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 }
 
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
-	l, err := convertValue(left, convertTo, nil, 0, 0)
+	l, err := convertValue(nil, left, convertTo, nil, 0, 0)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	r, err := convertValue(right, convertTo, nil, 0, 0)
+	r, err := convertValue(nil, right, convertTo, nil, 0, 0)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -274,6 +382,16 @@ func (e *Equals) CollationCoercibility(ctx *sql.Context) (collation sql.Collatio
 	return sql.Collation_binary, 5
 }
 
+// Compare implements the Comparer interface.
+func (e *Equals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
+	if types.IsTuple(e.Left().Type()) && types.IsTuple(e.Right().Type()) {
+		return e.compareTuples(ctx, row, func(l, r sql.Expression) (int, error) {
+			return NewEquals(l, r).Compare(ctx, row)
+		}, true)
+	}
+	return e.comparison.Compare(ctx, row)
+}
+
 // Eval implements the Expression interface.
 func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	result, err := e.Compare(ctx, row)
@@ -340,17 +458,29 @@ func (e *NullSafeEquals) CollationCoercibility(ctx *sql.Context) (collation sql.
 }
 
 func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
+	if types.IsTuple(e.Left().Type()) && types.IsTuple(e.Right().Type()) {
+		cmp, err := e.compareTuples(ctx, row, func(l, r sql.Expression) (int, error) {
+			return NewNullSafeEquals(l, r).Compare(ctx, row)
+		}, false)
+		if ErrNilOperand.Is(err) {
+			// a NULL tuple, like the result of a subquery without rows, isn't equal to any tuple
+			return 1, nil
+		}
+		return cmp, err
+	}
+
 	left, right, err := e.evalLeftAndRight(ctx, row)
 	if err != nil {
 		return 0, err
 	}
 
+	// NULLs are the smallest values, as they're sorted by indexes
 	if left == nil && right == nil {
 		return 0, nil
 	} else if left == nil {
-		return 1, nil
-	} else if right == nil {
 		return -1, nil
+	} else if right == nil {
+		return 1, nil
 	}
 
 	if types.TypesEqual(e.Left().Type(), e.Right().Type()) {
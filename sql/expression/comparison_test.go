@@ -15,6 +15,8 @@
 package expression_test
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -165,6 +167,30 @@ func TestNullSafeEquals(t *testing.T) {
 	}
 }
 
+func TestNullSafeEqualsCompare(t *testing.T) {
+	require := require.New(t)
+	seq := expression.NewNullSafeEquals(
+		expression.NewGetField(0, types.Int64, "col1", true),
+		expression.NewGetField(1, types.Int64, "col2", true),
+	)
+
+	// NULLs are compared as the smallest values, in the order of indexes
+	for _, tt := range []struct {
+		row      sql.Row
+		expected int
+	}{
+		{sql.NewRow(nil, nil), 0},
+		{sql.NewRow(nil, int64(1)), -1},
+		{sql.NewRow(int64(1), nil), 1},
+		{sql.NewRow(int64(1), int64(2)), -1},
+		{sql.NewRow(int64(2), int64(2)), 0},
+	} {
+		cmp, err := seq.Compare(sql.NewEmptyContext(), tt.row)
+		require.NoError(err)
+		require.Equal(tt.expected, cmp, "%v", tt.row)
+	}
+}
+
 func TestLessThan(t *testing.T) {
 	require := require.New(t)
 	for resultType, cmpCase := range comparisonCases {
@@ -219,6 +245,104 @@ func TestGreaterThan(t *testing.T) {
 	}
 }
 
+func TestSignedUnsignedComparison(t *testing.T) {
+	var testCases = []struct {
+		signed   int64
+		unsigned uint64
+		expected int
+	}{
+		{math.MaxInt64, math.MaxUint64, -1},
+		{math.MaxInt64, math.MaxInt64, 0},
+		{math.MaxInt64, math.MaxInt64 + 1, -1},
+		{-1, math.MaxUint64, -1},
+		{-1, 0, -1},
+		{0, 0, 0},
+		{1, 0, 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%d and %d", tt.signed, tt.unsigned), func(t *testing.T) {
+			require := require.New(t)
+			row := sql.NewRow(tt.signed, tt.unsigned)
+			signed := expression.NewGetField(0, types.Int64, "col1", true)
+			unsigned := expression.NewGetField(1, types.Uint64, "col2", true)
+
+			cmp, err := expression.NewLessThan(signed, unsigned).Compare(sql.NewEmptyContext(), row)
+			require.NoError(err)
+			require.Equal(tt.expected, cmp)
+
+			cmp, err = expression.NewLessThan(unsigned, signed).Compare(sql.NewEmptyContext(), row)
+			require.NoError(err)
+			require.Equal(-tt.expected, cmp)
+
+			require.Equal(tt.expected == 0, eval(t, expression.NewEquals(signed, unsigned), row))
+			require.Equal(tt.expected == 0, eval(t, expression.NewEquals(unsigned, signed), row))
+		})
+	}
+}
+
+func TestTupleComparison(t *testing.T) {
+	tuple := func(vals ...interface{}) sql.Expression {
+		elems := make([]sql.Expression, len(vals))
+		for i, v := range vals {
+			if v == nil {
+				elems[i] = expression.NewLiteral(nil, types.Null)
+			} else {
+				elems[i] = expression.NewLiteral(v, types.Int64)
+			}
+		}
+		return expression.NewTuple(elems...)
+	}
+
+	var testCases = []struct {
+		name     string
+		expr     sql.Expression
+		expected interface{}
+	}{
+		{"(1, 2) < (1, 3)", expression.NewLessThan(tuple(1, 2), tuple(1, 3)), true},
+		{"(1, 2) < (1, 2)", expression.NewLessThan(tuple(1, 2), tuple(1, 2)), false},
+		{"(1, 2) <= (1, 2)", expression.NewLessThanOrEqual(tuple(1, 2), tuple(1, 2)), true},
+		{"(2, 1) > (1, 3)", expression.NewGreaterThan(tuple(2, 1), tuple(1, 3)), true},
+		{"(1, NULL) < (2, 1)", expression.NewLessThan(tuple(1, nil), tuple(2, 1)), true},
+		{"(1, NULL) < (1, 1)", expression.NewLessThan(tuple(1, nil), tuple(1, 1)), nil},
+		{"(NULL, 1) > (1, 1)", expression.NewGreaterThan(tuple(nil, 1), tuple(1, 1)), nil},
+		{"(1, 2) = (1, 2)", expression.NewEquals(tuple(1, 2), tuple(1, 2)), true},
+		{"(1, 2) = (1, NULL)", expression.NewEquals(tuple(1, 2), tuple(1, nil)), nil},
+		{"(NULL, 2) = (1, 3)", expression.NewEquals(tuple(nil, 2), tuple(1, 3)), false},
+		{"(1, NULL) <=> (1, NULL)", expression.NewNullSafeEquals(tuple(1, nil), tuple(1, nil)), true},
+		{"(1, NULL) <=> (1, 2)", expression.NewNullSafeEquals(tuple(1, nil), tuple(1, 2)), false},
+		{"((1, 2), 3) < ((1, 3), 1)", expression.NewLessThan(
+			expression.NewTuple(tuple(1, 2), expression.NewLiteral(3, types.Int64)),
+			expression.NewTuple(tuple(1, 3), expression.NewLiteral(1, types.Int64))), true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, eval(t, tt.expr, nil))
+		})
+	}
+}
+
+func TestSplitTupleEquality(t *testing.T) {
+	a := expression.NewGetField(0, types.Int64, "a", true)
+	b := expression.NewGetField(1, types.Int64, "b", true)
+	one := expression.NewLiteral(1, types.Int64)
+	two := expression.NewLiteral(2, types.Int64)
+
+	split, ok := expression.SplitTupleEquality(expression.NewTuple(a, b), expression.NewTuple(one, two), false)
+	require.True(t, ok)
+	require.Equal(t, expression.NewAnd(expression.NewEquals(a, one), expression.NewEquals(b, two)), split)
+
+	split, ok = expression.SplitTupleEquality(expression.NewTuple(a, b), expression.NewTuple(one, two), true)
+	require.True(t, ok)
+	require.Equal(t, expression.NewAnd(expression.NewNullSafeEquals(a, one), expression.NewNullSafeEquals(b, two)), split)
+
+	_, ok = expression.SplitTupleEquality(expression.NewTuple(a, b), expression.NewTuple(one), false)
+	require.False(t, ok)
+	_, ok = expression.SplitTupleEquality(a, one, false)
+	require.False(t, ok)
+}
+
 func TestRegexp(t *testing.T) {
 	for _, engine := range regex.Engines() {
 		regex.SetDefault(engine)
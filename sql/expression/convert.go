@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -173,15 +174,18 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 
 // String implements the Stringer interface.
 func (c *Convert) String() string {
-	extraTypeInfo := ""
+	return fmt.Sprintf("convert(%v, %v)", c.Child, c.TypeString())
+}
+
+// TypeString returns the type this expression converts to, with its length and scale, as written in a CONVERT call.
+func (c *Convert) TypeString() string {
 	if c.typeLength > 0 {
 		if c.typeScale > 0 {
-			extraTypeInfo = fmt.Sprintf("(%d,%d)", c.typeLength, c.typeScale)
-		} else {
-			extraTypeInfo = fmt.Sprintf("(%d)", c.typeLength)
+			return fmt.Sprintf("%s(%d,%d)", c.castToType, c.typeLength, c.typeScale)
 		}
+		return fmt.Sprintf("%s(%d)", c.castToType, c.typeLength)
 	}
-	return fmt.Sprintf("convert(%v, %v%s)", c.Child, c.castToType, extraTypeInfo)
+	return c.castToType
 }
 
 // DebugString implements the Expression interface.
@@ -226,7 +230,7 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Should always return nil, and a warning instead
-	casted, err := convertValue(val, c.castToType, c.Child.Type(), c.typeLength, c.typeScale)
+	casted, err := convertValue(ctx, val, c.castToType, c.Child.Type(), c.typeLength, c.typeScale)
 	if err != nil {
 		if c.castToType == ConvertToJSON {
 			return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
@@ -242,8 +246,8 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 // the zero value is returned for float types. Nil is returned in all other cases.
 // If |typeLength| and |typeScale| are 0, they are ignored, otherwise they are used as constraints on the
 // converted type where applicable (e.g. Char conversion supports only |typeLength|, Decimal conversion supports
-// |typeLength| and |typeScale|).
-func convertValue(val interface{}, castTo string, originType sql.Type, typeLength, typeScale int) (interface{}, error) {
+// |typeLength| and |typeScale|). If |ctx| is not nil, a warning is added for values that are truncated to fit the type.
+func convertValue(ctx *sql.Context, val interface{}, castTo string, originType sql.Type, typeLength, typeScale int) (interface{}, error) {
 	switch strings.ToLower(castTo) {
 	case ConvertToBinary:
 		b, _, err := types.LongBlob.Convert(val)
@@ -259,13 +263,13 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 			}
 			b = encodedBytes
 		}
-		return truncateConvertedValue(b, typeLength)
+		return truncateConvertedValue(ctx, b, castTo, typeLength)
 	case ConvertToChar, ConvertToNChar:
 		s, _, err := types.LongText.Convert(val)
 		if err != nil {
 			return nil, nil
 		}
-		return truncateConvertedValue(s, typeLength)
+		return truncateConvertedValue(ctx, s, castTo, typeLength)
 	case ConvertToDate:
 		_, isTime := val.(time.Time)
 		_, isString := val.(string)
@@ -298,6 +302,7 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		dt := createConvertedDecimalType(typeLength, typeScale, false)
 		d, _, err := dt.Convert(value)
 		if err != nil {
+			warnTruncatedValue(ctx, "DECIMAL", val)
 			return "0", nil
 		}
 		return d, nil
@@ -308,6 +313,7 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		}
 		d, _, err := types.Float32.Convert(value)
 		if err != nil {
+			warnTruncatedValue(ctx, "DOUBLE", val)
 			return types.Float32.Zero(), nil
 		}
 		return d, nil
@@ -318,6 +324,7 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		}
 		d, _, err := types.Float64.Convert(value)
 		if err != nil {
+			warnTruncatedValue(ctx, "DOUBLE", val)
 			return types.Float64.Zero(), nil
 		}
 		return d, nil
@@ -334,6 +341,7 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		}
 		num, _, err := types.Int64.Convert(value)
 		if err != nil {
+			warnTruncatedValue(ctx, "INTEGER", val)
 			return types.Int64.Zero(), nil
 		}
 
@@ -353,6 +361,7 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		if err != nil {
 			num, _, err = types.Int64.Convert(value)
 			if err != nil {
+				warnTruncatedValue(ctx, "INTEGER", val)
 				return types.Uint64.Zero(), nil
 			}
 			return uint64(num.(int64)), nil
@@ -366,8 +375,9 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 // truncateConvertedValue truncates |val| to the specified |typeLength| if |val|
 // is a string or byte slice. If the typeLength is 0, or if it is greater than
 // the length of |val|, then |val| is simply returned as is. If |val| is not a
-// string or []byte, then an error is returned.
-func truncateConvertedValue(val interface{}, typeLength int) (interface{}, error) {
+// string or []byte, then an error is returned. A warning is added to |ctx| when
+// |val| is truncated.
+func truncateConvertedValue(ctx *sql.Context, val interface{}, castTo string, typeLength int) (interface{}, error) {
 	if typeLength <= 0 {
 		return val, nil
 	}
@@ -376,11 +386,15 @@ func truncateConvertedValue(val interface{}, typeLength int) (interface{}, error
 	case []byte:
 		if len(v) <= typeLength {
 			typeLength = len(v)
+		} else {
+			warnTruncatedValue(ctx, fmt.Sprintf("%s(%d)", strings.ToUpper(castTo), typeLength), v)
 		}
 		return v[:typeLength], nil
 	case string:
 		if len(v) <= typeLength {
 			typeLength = len(v)
+		} else {
+			warnTruncatedValue(ctx, fmt.Sprintf("%s(%d)", strings.ToUpper(castTo), typeLength), v)
 		}
 		return v[:typeLength], nil
 	default:
@@ -388,6 +402,18 @@ func truncateConvertedValue(val interface{}, typeLength int) (interface{}, error
 	}
 }
 
+// warnTruncatedValue adds the warning given when |val| is truncated to fit the type named by |typeName|, if |ctx| is
+// not nil.
+func warnTruncatedValue(ctx *sql.Context, typeName string, val interface{}) {
+	if ctx == nil {
+		return
+	}
+	if b, ok := val.([]byte); ok {
+		val = string(b)
+	}
+	ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect %s value: '%v'", typeName, val)
+}
+
 // createConvertedDecimalType creates a new Decimal type with the specified |precision| and |scale|. If a Decimal
 // type cannot be created from the values specified, the internal Decimal type is returned. If |logErrors| is true,
 // an error will also logged to the standard logger. (Setting |logErrors| to false, allows the caller to prevent
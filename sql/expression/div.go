@@ -98,7 +98,21 @@ func (d *Div) IsNullable() bool {
 // However, if this is the outermost division expression in an expression tree, we must return the result as a
 // Decimal type in order to match MySQL's results exactly.
 func (d *Div) Type() sql.Type {
-	return d.determineResultType(isOutermostDiv(d, 0, d.divScale))
+	outermost := isOutermostDiv(d, 0, d.divScale)
+	typ := d.determineResultType(outermost)
+	if dt, ok := typ.(sql.DecimalType); ok && outermost {
+		// The result is rounded to the scale of the leftmost value plus the precision increment of every division
+		scale := int(dt.Scale()) + int(d.divScale)*divPrecisionIncrement
+		if scale > types.DecimalTypeMaxScale {
+			scale = types.DecimalTypeMaxScale
+		}
+		precision := int(dt.Precision()-dt.Scale()) + scale
+		if precision > types.DecimalTypeMaxPrecision {
+			precision = types.DecimalTypeMaxPrecision
+		}
+		return types.MustCreateDecimalType(uint8(precision), uint8(scale))
+	}
+	return typ
 }
 
 // internalType returns the internal result type for this division expression. For performance reasons, we prefer
@@ -331,12 +345,18 @@ func floatOrDecimalType(e sql.Expression, treatIntsAsFloats bool) sql.Type {
 	var resType sql.Type
 	var decType sql.Type
 	var maxWhole, maxFrac uint8
+	var hasInts, hasBigInts bool
 	sql.Inspect(e, func(expr sql.Expression) bool {
 		switch c := expr.(type) {
 		case *GetField:
 			if treatIntsAsFloats && types.IsInteger(c.Type()) {
-				resType = types.Float64
-				return false
+				// BIGINT UNSIGNED values can be beyond the integers that floats hold exactly, so they are only divided as
+				// decimals
+				if c.Type() == types.Uint64 {
+					hasBigInts = true
+				} else {
+					hasInts = true
+				}
 			}
 			if types.IsFloat(c.Type()) {
 				resType = types.Float64
@@ -362,8 +382,8 @@ func floatOrDecimalType(e sql.Expression, treatIntsAsFloats bool) sql.Type {
 		return true
 	})
 
-	if resType == types.Float64 {
-		return resType
+	if resType == types.Float64 || (hasInts && !hasBigInts) {
+		return types.Float64
 	}
 
 	if decType != nil {
@@ -646,14 +666,7 @@ func (i *IntDiv) IsNullable() bool {
 
 // Type returns the greatest type for given operation.
 func (i *IntDiv) Type() sql.Type {
-	lTyp := i.Left.Type()
-	rTyp := i.Right.Type()
-
-	if types.IsUnsigned(lTyp) || types.IsUnsigned(rTyp) {
-		return types.Uint64
-	}
-
-	return types.Int64
+	return integerArithmeticType(i.Left.Type(), i.Right.Type())
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -682,9 +695,20 @@ func (i *IntDiv) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	lval, rval = i.convertLeftRight(ctx, lval, rval)
 
+	if i.isIntegerOperation() {
+		return integerArithmetic(ctx, i, sqlparser.IntDivStr, lval, rval, types.IsUnsigned(i.Type()))
+	}
+
 	return intDiv(ctx, lval, rval)
 }
 
+// isIntegerOperation returns whether both operands are integers, or both are temporal values, which are divided as
+// integers.
+func (i *IntDiv) isIntegerOperation() bool {
+	lTyp, rTyp := i.Left.Type(), i.Right.Type()
+	return (types.IsInteger(lTyp) && types.IsInteger(rTyp)) || (types.IsTime(lTyp) && types.IsTime(rTyp))
+}
+
 func (i *IntDiv) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	var lval, rval interface{}
 	var err error
@@ -717,9 +741,12 @@ func (i *IntDiv) convertLeftRight(ctx *sql.Context, left interface{}, right inte
 
 	if types.IsText(lTyp) || types.IsText(rTyp) {
 		typ = types.Float64
-	} else if types.IsUnsigned(lTyp) && types.IsUnsigned(rTyp) {
-		typ = types.Uint64
-	} else if (lIsTimeType && rIsTimeType) || (types.IsSigned(lTyp) && types.IsSigned(rTyp)) {
+	} else if types.IsInteger(lTyp) && types.IsInteger(rTyp) {
+		// integer operands keep their own signedness, so that the operation is exact
+		left = convertValueToType(ctx, integerOperandType(lTyp), left, false)
+		right = convertValueToType(ctx, integerOperandType(rTyp), right, false)
+		return left, right
+	} else if lIsTimeType && rIsTimeType {
 		typ = types.Int64
 	} else {
 		// using max precision which is 65.
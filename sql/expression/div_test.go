@@ -140,6 +140,18 @@ func TestDivUsesFloatsInternally(t *testing.T) {
 	require.Equal(t, types.Float64, bottomDiv.Type())
 	require.Equal(t, types.Float64, middleDiv.Type())
 	require.True(t, types.IsDecimal(topDiv.Type()))
+
+	// BIGINT UNSIGNED values beyond the integers that floats hold exactly are divided as decimals
+	bottomDiv = NewDiv(
+		NewGetField(0, types.Uint64, "", false),
+		NewGetField(1, types.Int32, "", false))
+	topDiv = NewDiv(bottomDiv,
+		NewGetField(2, types.Int32, "", false))
+	require.True(t, types.IsDecimal(bottomDiv.Type()))
+
+	result, err = topDiv.Eval(sql.NewEmptyContext(), sql.NewRow(uint64(18446744073709551615), 1, 1))
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551615", result.(decimal.Decimal).String())
 }
 
 func TestIntDiv(t *testing.T) {
@@ -14,16 +14,17 @@ import (
 
 type anyValueBuffer struct {
 	res  interface{}
+	seen bool
 	expr sql.Expression
 }
 
 func NewAnyValueBuffer(child sql.Expression) *anyValueBuffer {
-	return &anyValueBuffer{nil, child}
+	return &anyValueBuffer{nil, false, child}
 }
 
-// Update implements the AggregationBuffer interface.
+// Update implements the AggregationBuffer interface. Like MySQL, the value of the first row is kept, even if it's NULL.
 func (a *anyValueBuffer) Update(ctx *sql.Context, row sql.Row) error {
-	if a.res != nil {
+	if a.seen {
 		return nil
 	}
 
@@ -31,11 +32,9 @@ func (a *anyValueBuffer) Update(ctx *sql.Context, row sql.Row) error {
 	if err != nil {
 		return err
 	}
-	if v == nil {
-		return nil
-	}
 
 	a.res = v
+	a.seen = true
 
 	return nil
 }
@@ -34,6 +34,9 @@ type WindowIter struct {
 	outputOrdinals [][]int
 	iter           sql.RowIter
 	initialized    bool
+	// empty is true if [iter] has no rows, in which case there are no rows
+	// to evaluate the window functions over.
+	empty bool
 }
 
 func NewWindowIter(partitionIters []*WindowPartitionIter, outputOrdinals [][]int, iter sql.RowIter) *WindowIter {
@@ -77,6 +80,10 @@ func (i *WindowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 	}
 
+	if i.empty {
+		return nil, io.EOF
+	}
+
 	row := make(sql.Row, i.size())
 	for j, pIter := range i.partitionIters {
 		res, err := pIter.Next(ctx)
@@ -121,6 +128,7 @@ func (i *WindowIter) initializeIters(ctx *sql.Context) error {
 		buf = append(buf, row)
 	}
 
+	i.empty = len(buf) == 0
 	for _, i := range i.partitionIters {
 		// each iter has its own copy of input buffer
 		i.child = &windowBufferIter{buf: buf}
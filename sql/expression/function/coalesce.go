@@ -53,17 +53,16 @@ func (c *Coalesce) Description() string {
 // Type implements the sql.Expression interface.
 // The return type of Type() is the aggregated type of the argument types.
 func (c *Coalesce) Type() sql.Type {
+	typs := make([]sql.Type, 0, len(c.args))
 	for _, arg := range c.args {
 		if arg == nil {
 			continue
 		}
-		t := arg.Type()
-		if t == nil || t == types.Null {
-			continue
-		}
-		return t
+		typs = append(typs, arg.Type())
+	}
+	if typ := types.AggregateTypes(typs...); typ != types.Null {
+		return typ
 	}
-
 	return nil
 }
 
@@ -137,7 +136,7 @@ func (c *Coalesce) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			continue
 		}
 
-		return val, nil
+		return types.ConvertToAggregatedType(val, arg.Type(), c.Type())
 	}
 
 	return nil, nil
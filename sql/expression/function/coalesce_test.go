@@ -37,8 +37,8 @@ func TestCoalesce(t *testing.T) {
 		typ      sql.Type
 		nullable bool
 	}{
-		{"coalesce(1, 2, 3)", []sql.Expression{expression.NewLiteral(1, types.Int32), expression.NewLiteral(2, types.Int32), expression.NewLiteral(3, types.Int32)}, 1, types.Int32, false},
-		{"coalesce(NULL, NULL, 3)", []sql.Expression{nil, nil, expression.NewLiteral(3, types.Int32)}, 3, types.Int32, false},
+		{"coalesce(1, 2, 3)", []sql.Expression{expression.NewLiteral(1, types.Int32), expression.NewLiteral(2, types.Int32), expression.NewLiteral(3, types.Int32)}, int32(1), types.Int32, false},
+		{"coalesce(NULL, NULL, 3)", []sql.Expression{nil, nil, expression.NewLiteral(3, types.Int32)}, int32(3), types.Int32, false},
 		{"coalesce(NULL, NULL, '3')", []sql.Expression{nil, nil, expression.NewLiteral("3", types.LongText)}, "3", types.LongText, false},
 		{"coalesce(NULL, '2', 3)", []sql.Expression{nil, expression.NewLiteral("2", types.LongText), expression.NewLiteral(3, types.Int32)}, "2", types.LongText, false},
 		{"coalesce(NULL, NULL, NULL)", []sql.Expression{nil, nil, nil}, nil, nil, true},
@@ -70,19 +70,19 @@ func TestComposeCoalasce(t *testing.T) {
 	require.Equal(t, types.Int32, c2.Type())
 	v, err = c2.Eval(ctx, nil)
 	require.NoError(t, err)
-	require.Equal(t, 1, v)
+	require.Equal(t, int32(1), v)
 
 	c3, err := NewCoalesce(nil, c1, c2)
 	require.NoError(t, err)
 	require.Equal(t, types.Int32, c3.Type())
 	v, err = c3.Eval(ctx, nil)
 	require.NoError(t, err)
-	require.Equal(t, 1, v)
+	require.Equal(t, int32(1), v)
 
 	c4, err := NewCoalesce(expression.NewLiteral(nil, types.Null), c1, c2)
 	require.NoError(t, err)
 	require.Equal(t, types.Int32, c4.Type())
 	v, err = c4.Eval(ctx, nil)
 	require.NoError(t, err)
-	require.Equal(t, 1, v)
+	require.Equal(t, int32(1), v)
 }
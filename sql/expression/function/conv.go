@@ -146,6 +146,12 @@ func convertFromBase(nVal string, fromBase interface{}) interface{} {
 		return nil
 	}
 
+	// like MySQL, leading spaces are skipped and an empty number is 0
+	nVal = strings.TrimLeft(nVal, " ")
+	if len(nVal) == 0 {
+		return uint64(0)
+	}
+
 	negative := false
 	var upper string
 	var lower string
@@ -78,6 +78,8 @@ func TestConv(t *testing.T) {
 		{"max N for base 10 to base -16", types.LongText, sql.NewRow("18446744073709551615", 10, -16), "-1"},
 		{"big N for base 10 to base -16", types.LongText, sql.NewRow("18446744073709551614", 10, -16), "-2"},
 		{"n as hex", types.LongText, sql.NewRow(0x0a, 10, 10), "10"},
+		{"leading spaces are skipped", types.LongText, sql.NewRow("  1a", 16, 10), "26"},
+		{"empty N", types.LongText, sql.NewRow("", 10, 2), "0"},
 	}
 
 	for _, tt := range testCases {
@@ -19,6 +19,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -448,7 +450,8 @@ func (ut *UnixTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error
 		return 0, nil
 	}
 
-	return toUnixTimestamp(date.(time.Time))
+	// the date is on the wall clock of the session's time zone
+	return toUnixTimestamp(sql.TimestampToUTC(date.(time.Time), sql.TimestampLocation(ctx)))
 }
 
 func toUnixTimestamp(t time.Time) (interface{}, error) {
@@ -496,12 +499,14 @@ func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
-	n, _, err := types.Int64.Convert(val)
+	n, _, err := types.InternalDecimalType.Convert(val)
 	if err != nil {
 		return nil, err
 	}
 
-	return time.Unix(n.(int64), 0), nil
+	// the fractional part of the timestamp is kept, with a microsecond precision
+	micros := n.(decimal.Decimal).Shift(6).Round(0).IntPart()
+	return sql.TimestampFromUTC(time.UnixMicro(micros).UTC(), sql.TimestampLocation(ctx)), nil
 }
 
 func (r *FromUnixtime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
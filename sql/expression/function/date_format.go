@@ -117,26 +117,12 @@ func yearWeek(mode int32, t time.Time) (int32, int32) {
 }
 
 func weekMode0(t time.Time) string {
-	yr, wk := yearWeek(0, t)
-
-	if yr < int32(t.Year()) {
-		wk = 0
-	} else if yr > int32(t.Year()) {
-		wk = 53
-	}
-
+	_, wk := calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), weekMode(0))
 	return fmt.Sprintf("%02d", wk)
 }
 
 func weekMode1(t time.Time) string {
-	yr, wk := yearWeek(1, t)
-
-	if yr < int32(t.Year()) {
-		wk = 0
-	} else if yr > int32(t.Year()) {
-		wk = 53
-	}
-
+	_, wk := calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), weekMode(1))
 	return fmt.Sprintf("%02d", wk)
 }
 
@@ -165,7 +151,7 @@ func dayName(t time.Time) string {
 }
 
 func yearTwoDigit(t time.Time) string {
-	return strconv.FormatInt(int64(t.Year())%100, 10)
+	return fmt.Sprintf("%02d", t.Year()%100)
 }
 
 type AppendFuncWrapper struct {
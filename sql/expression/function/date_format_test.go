@@ -81,6 +81,12 @@ func TestDateFormatting(t *testing.T) {
 	}
 }
 
+func TestTwoDigitYearFormatting(t *testing.T) {
+	result, err := formatDate("%y", time.Date(2005, 1, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "05", result)
+}
+
 func TestUnsupportedSpecifiers(t *testing.T) {
 	testFunc := func(t *testing.T, b byte) {
 		if _, ok := dateFormatSpecifierToFunc[b]; !ok {
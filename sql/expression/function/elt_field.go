@@ -0,0 +1,266 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Elt returns the Nth of its string arguments, where N is its first argument. If N is less than 1, greater than the
+// number of strings or NULL, the result is NULL.
+type Elt struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Elt)(nil)
+var _ sql.CollationCoercible = (*Elt)(nil)
+
+// NewElt creates a new Elt expression.
+func NewElt(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ELT", "2 or more", len(args))
+	}
+
+	return &Elt{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Elt) FunctionName() string {
+	return "elt"
+}
+
+// Description implements sql.FunctionExpression
+func (e *Elt) Description() string {
+	return "returns the string at index number."
+}
+
+// Type implements the Expression interface.
+func (e *Elt) Type() sql.Type { return types.LongText }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (e *Elt) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return resolveArgsCoercibility(ctx, e.args[1:])
+}
+
+// IsNullable implements the Expression interface.
+func (e *Elt) IsNullable() bool {
+	return true
+}
+
+func (e *Elt) String() string {
+	return fmt.Sprintf("%s(%s)", e.FunctionName(), joinArgs(e.args))
+}
+
+// WithChildren implements the Expression interface.
+func (*Elt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewElt(children...)
+}
+
+// Resolved implements the Expression interface.
+func (e *Elt) Resolved() bool {
+	return expression.ExpressionsResolved(e.args...)
+}
+
+// Children implements the Expression interface.
+func (e *Elt) Children() []sql.Expression { return e.args }
+
+// Eval implements the Expression interface.
+func (e *Elt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, err := e.args[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, nil
+	}
+
+	n, _, err = types.Int64.Convert(n)
+	if err != nil {
+		return nil, nil
+	}
+	idx := n.(int64)
+	if idx < 1 || idx >= int64(len(e.args)) {
+		return nil, nil
+	}
+
+	val, err := e.args[idx].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	val, _, err = types.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// Field returns the index of its first argument in the rest of its arguments, starting at 1, or 0 if it is not found
+// or is NULL. Like MySQL, the arguments are compared as strings in their collation when they are all strings, as
+// integers when they are all integers, and as doubles otherwise.
+type Field struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Field)(nil)
+var _ sql.CollationCoercible = (*Field)(nil)
+
+// NewField creates a new Field expression.
+func NewField(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("FIELD", "2 or more", len(args))
+	}
+
+	return &Field{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Field) FunctionName() string {
+	return "field"
+}
+
+// Description implements sql.FunctionExpression
+func (f *Field) Description() string {
+	return "returns the index (position) of the first argument in the subsequent arguments."
+}
+
+// Type implements the Expression interface.
+func (f *Field) Type() sql.Type { return types.Int64 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Field) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the Expression interface.
+func (f *Field) IsNullable() bool {
+	return false
+}
+
+func (f *Field) String() string {
+	return fmt.Sprintf("%s(%s)", f.FunctionName(), joinArgs(f.args))
+}
+
+// WithChildren implements the Expression interface.
+func (*Field) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewField(children...)
+}
+
+// Resolved implements the Expression interface.
+func (f *Field) Resolved() bool {
+	return expression.ExpressionsResolved(f.args...)
+}
+
+// Children implements the Expression interface.
+func (f *Field) Children() []sql.Expression { return f.args }
+
+// compareType returns the type that the arguments are compared as.
+func (f *Field) compareType(ctx *sql.Context) sql.Type {
+	allText, allInts := true, true
+	for _, arg := range f.args {
+		// NULL arguments never match, so they don't decide the comparison type
+		if types.IsNull(arg) {
+			continue
+		}
+		typ := arg.Type()
+		if !types.IsText(typ) {
+			allText = false
+		}
+		if !types.IsInteger(typ) {
+			allInts = false
+		}
+	}
+
+	switch {
+	case allText:
+		collation, _ := resolveArgsCoercibility(ctx, f.args)
+		return types.CreateLongText(collation)
+	case allInts:
+		return types.MustCreateDecimalType(20, 0)
+	default:
+		return types.Float64
+	}
+}
+
+// Eval implements the Expression interface.
+func (f *Field) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.args[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return int64(0), nil
+	}
+
+	typ := f.compareType(ctx)
+	val, _, err = typ.Convert(val)
+	if err != nil {
+		return int64(0), nil
+	}
+
+	for i, arg := range f.args[1:] {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+
+		v, _, err = typ.Convert(v)
+		if err != nil {
+			continue
+		}
+		cmp, err := typ.Compare(val, v)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return int64(i + 1), nil
+		}
+	}
+
+	return int64(0), nil
+}
+
+// resolveArgsCoercibility returns the collation and coercibility that the arguments given resolve to together.
+func resolveArgsCoercibility(ctx *sql.Context, args []sql.Expression) (collation sql.CollationID, coercibility byte) {
+	if len(args) == 0 {
+		return sql.Collation_binary, 6
+	}
+	collation, coercibility = sql.GetCoercibility(ctx, args[0])
+	for i := 1; i < len(args); i++ {
+		nextCollation, nextCoercibility := sql.GetCoercibility(ctx, args[i])
+		collation, coercibility = sql.ResolveCoercibility(collation, coercibility, nextCollation, nextCoercibility)
+	}
+	return collation, coercibility
+}
+
+// joinArgs returns the strings of the arguments given separated by commas.
+func joinArgs(args []sql.Expression) string {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = arg.String()
+	}
+	return strings.Join(strs, ",")
+}
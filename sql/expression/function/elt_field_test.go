@@ -0,0 +1,98 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestElt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected interface{}
+	}{
+		{"first string", []interface{}{1, "a", "b"}, "a"},
+		{"last string", []interface{}{2, "a", "b"}, "b"},
+		{"decimal index is rounded", []interface{}{decimal.RequireFromString("1.6"), "a", "b"}, "b"},
+		{"zero index", []interface{}{0, "a", "b"}, nil},
+		{"index past the end", []interface{}{3, "a", "b"}, nil},
+		{"negative index", []interface{}{-1, "a", "b"}, nil},
+		{"null index", []interface{}{nil, "a", "b"}, nil},
+		{"null string", []interface{}{2, "a", nil}, nil},
+		{"number is returned as a string", []interface{}{1, 12}, "12"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			args := make([]sql.Expression, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = expression.NewLiteral(arg, types.ApproximateTypeFromValue(arg))
+			}
+			f, err := NewElt(args...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewElt(expression.NewLiteral(1, types.Int64))
+	require.Error(t, err)
+}
+
+func TestField(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected int64
+	}{
+		{"string found", []interface{}{"b", "a", "b", "c"}, 2},
+		{"string not found", []interface{}{"d", "a", "b", "c"}, 0},
+		{"first match is returned", []interface{}{"a", "a", "a"}, 1},
+		{"integers", []interface{}{2, 1, 2, 3}, 2},
+		{"mixed arguments are compared as doubles", []interface{}{"2.0", 1, 2}, 2},
+		{"null is never found", []interface{}{nil, nil, "a"}, 0},
+		{"null arguments are skipped", []interface{}{"a", nil, "a"}, 2},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			args := make([]sql.Expression, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = expression.NewLiteral(arg, types.ApproximateTypeFromValue(arg))
+			}
+			f, err := NewField(args...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewField(expression.NewLiteral("a", types.LongText))
+	require.Error(t, err)
+}
@@ -0,0 +1,269 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// evalBits evaluates the bits argument of EXPORT_SET or MAKE_SET as an unsigned integer, so that negative numbers
+// have all of their high bits set. It returns false when the argument is NULL.
+func evalBits(ctx *sql.Context, row sql.Row, e sql.Expression) (uint64, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil {
+		return 0, false, err
+	}
+	if val == nil {
+		return 0, false, nil
+	}
+
+	if types.IsSigned(e.Type()) {
+		val, _, err = types.Int64.Convert(val)
+		if err != nil {
+			return 0, false, err
+		}
+		return uint64(val.(int64)), true, nil
+	}
+
+	val, _, err = types.Uint64.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return val.(uint64), true, nil
+}
+
+// MakeSet returns the comma-separated set of its string arguments whose bits are set in its first argument. The first
+// string corresponds to the lowest bit. NULL strings are not added to the result.
+type MakeSet struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*MakeSet)(nil)
+var _ sql.CollationCoercible = (*MakeSet)(nil)
+
+// NewMakeSet creates a new MakeSet expression.
+func NewMakeSet(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MAKE_SET", "2 or more", len(args))
+	}
+
+	return &MakeSet{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MakeSet) FunctionName() string {
+	return "make_set"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MakeSet) Description() string {
+	return "returns a set of comma-separated strings that have the corresponding bit in bits set."
+}
+
+// Type implements the Expression interface.
+func (m *MakeSet) Type() sql.Type { return types.LongText }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (m *MakeSet) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return resolveArgsCoercibility(ctx, m.args[1:])
+}
+
+// IsNullable implements the Expression interface.
+func (m *MakeSet) IsNullable() bool {
+	return m.args[0].IsNullable()
+}
+
+func (m *MakeSet) String() string {
+	return fmt.Sprintf("%s(%s)", m.FunctionName(), joinArgs(m.args))
+}
+
+// WithChildren implements the Expression interface.
+func (*MakeSet) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewMakeSet(children...)
+}
+
+// Resolved implements the Expression interface.
+func (m *MakeSet) Resolved() bool {
+	return expression.ExpressionsResolved(m.args...)
+}
+
+// Children implements the Expression interface.
+func (m *MakeSet) Children() []sql.Expression { return m.args }
+
+// Eval implements the Expression interface.
+func (m *MakeSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bits, ok, err := evalBits(ctx, row, m.args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var parts []string
+	for i, arg := range m.args[1:] {
+		if i >= 64 {
+			break
+		}
+		if bits&(1<<uint(i)) == 0 {
+			continue
+		}
+
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			continue
+		}
+
+		val, _, err = types.LongText.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, val.(string))
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// ExportSet returns a string with an "on" string for every bit set in its first argument and an "off" string for
+// every bit that is not set, separated by a separator, which is a comma by default. The bits are examined from right to
+// left, and the number of them is 64 by default.
+type ExportSet struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*ExportSet)(nil)
+var _ sql.CollationCoercible = (*ExportSet)(nil)
+
+// NewExportSet creates a new ExportSet expression.
+func NewExportSet(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("EXPORT_SET", "3, 4 or 5", len(args))
+	}
+
+	return &ExportSet{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *ExportSet) FunctionName() string {
+	return "export_set"
+}
+
+// Description implements sql.FunctionExpression
+func (e *ExportSet) Description() string {
+	return "returns a string such that for every bit set in the value bits, you get an on string and for every unset bit, you get an off string."
+}
+
+// Type implements the Expression interface.
+func (e *ExportSet) Type() sql.Type { return types.LongText }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (e *ExportSet) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if len(e.args) > 3 {
+		return resolveArgsCoercibility(ctx, e.args[1:4])
+	}
+	return resolveArgsCoercibility(ctx, e.args[1:3])
+}
+
+// IsNullable implements the Expression interface.
+func (e *ExportSet) IsNullable() bool {
+	for _, arg := range e.args {
+		if arg.IsNullable() {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ExportSet) String() string {
+	return fmt.Sprintf("%s(%s)", e.FunctionName(), joinArgs(e.args))
+}
+
+// WithChildren implements the Expression interface.
+func (*ExportSet) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewExportSet(children...)
+}
+
+// Resolved implements the Expression interface.
+func (e *ExportSet) Resolved() bool {
+	return expression.ExpressionsResolved(e.args...)
+}
+
+// Children implements the Expression interface.
+func (e *ExportSet) Children() []sql.Expression { return e.args }
+
+// Eval implements the Expression interface.
+func (e *ExportSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bits, ok, err := evalBits(ctx, row, e.args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	strs := []string{"", "", ","}
+	for i, arg := range e.args[1:] {
+		if i > 2 {
+			break
+		}
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		val, _, err = types.LongText.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		strs[i] = val.(string)
+	}
+	on, off, separator := strs[0], strs[1], strs[2]
+
+	// Like MySQL, a number of bits that is negative or greater than 64 is 64
+	numBits := int64(64)
+	if len(e.args) == 5 {
+		val, err := e.args[4].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		val, _, err = types.Int64.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		if n := val.(int64); n >= 0 && n < 64 {
+			numBits = n
+		}
+	}
+
+	var sb strings.Builder
+	for i := int64(0); i < numBits; i++ {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		if bits&(1<<uint64(i)) != 0 {
+			sb.WriteString(on)
+		} else {
+			sb.WriteString(off)
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestMakeSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected interface{}
+	}{
+		{"some bits", []interface{}{1 | 4, "hello", "nice", "world"}, "hello,world"},
+		{"null strings are skipped", []interface{}{1 | 4, "hello", "nice", nil, "world"}, "hello"},
+		{"no bits", []interface{}{0, "a", "b"}, ""},
+		{"negative bits", []interface{}{-1, "a", "b"}, "a,b"},
+		{"null bits", []interface{}{nil, "a", "b"}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			args := make([]sql.Expression, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = expression.NewLiteral(arg, types.ApproximateTypeFromValue(arg))
+			}
+			f, err := NewMakeSet(args...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
+
+func TestExportSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected interface{}
+	}{
+		{"with bits", []interface{}{5, "Y", "N", ",", 4}, "Y,N,Y,N"},
+		{"more bits than set", []interface{}{6, "1", "0", ",", 10}, "0,1,1,0,0,0,0,0,0,0"},
+		{"custom separator", []interface{}{5, "Y", "N", "", 3}, "YNY"},
+		{"negative bits", []interface{}{-1, "a", "b", "", 3}, "aaa"},
+		{"too many bits is 64", []interface{}{0, "1", "0", "", 70}, "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"default separator and bits", []interface{}{1, "1", "0"}, "1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0"},
+		{"null bits", []interface{}{nil, "a", "b"}, nil},
+		{"null on", []interface{}{1, nil, "b"}, nil},
+		{"null separator", []interface{}{1, "a", "b", nil}, nil},
+		{"null number of bits", []interface{}{1, "a", "b", ",", nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			args := make([]sql.Expression, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = expression.NewLiteral(arg, types.ApproximateTypeFromValue(arg))
+			}
+			f, err := NewExportSet(args...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewExportSet(expression.NewLiteral(1, types.Int64), expression.NewLiteral("a", types.LongText))
+	require.Error(t, err)
+}
@@ -17,8 +17,11 @@ package function
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/shopspring/decimal"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -115,12 +118,6 @@ func (f *Format) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
-	numVal, _, err = types.Float64.Convert(numVal)
-	if err != nil {
-		return nil, nil
-	}
-	numValue := numVal.(float64)
-
 	numDP, _, err = types.Float64.Convert(numDP)
 	if err != nil {
 		return nil, nil
@@ -134,43 +131,66 @@ func (f *Format) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		numDecimalPlaces = 30
 	}
 
-	// One way to round to a decimal place is to shift the number up by the desired decimal position, round to the
-	// nearest integer, and then shift back down.
-	// For example, we have 5.855 and want to round to 2 decimal places.
-	// In this case, numValue = 5.855 and numDecimalPlaces = 2
-	// round(numValue * 10^numDecimalPlaces) / 10^numDecimalPlaces
-	// round(5.855 * 10^2) / 10^2
-	// round(5.855 * 100) / 100
-	// round(585.5) / 100
-	// 586 / 100
-	// 5.86
-	//TODO: this can introduce rounding errors that don't show up in MySQL when the decimal places are larger than the input due to precision errors
-	roundedValue := math.Round(numValue*math.Pow(10.0, numDecimalPlaces)) / math.Pow(10.0, numDecimalPlaces)
-
-	// FORMAT(-5.932887e-08, 2);     		==> -0.00
-	// FORMAT(-0.00000005932887, 2); 		==> 0.00
-	// will return 0.00 for both cases
-	var whole int64
-	var fractionStr string
-	var negative string
-	if roundedValue != 0 {
-		res := decimal.NewFromFloat(roundedValue)
-		whole = res.IntPart()
-		if whole == 0 && res.IsNegative() {
-			negative = "-"
+	var res decimal.Decimal
+	switch n := numVal.(type) {
+	case decimal.Decimal:
+		// Exact values are rounded half away from zero without going through a float, like MySQL does
+		res = n.Round(int32(numDecimalPlaces))
+	case int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint:
+		n, _, err = types.InternalDecimalType.Convert(n)
+		if err != nil {
+			return nil, err
+		}
+		res = n.(decimal.Decimal)
+	default:
+		numVal, _, err = types.Float64.Convert(numVal)
+		if err != nil {
+			if !sql.ErrInvalidValue.Is(err) || numVal == nil {
+				return nil, nil
+			}
+			// Like MySQL, a string that starts with a number is formatted as that number
+			ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect DOUBLE value: '%v'", n)
+		}
+		numValue := numVal.(float64)
+
+		// One way to round to a decimal place is to shift the number up by the desired decimal position, round to the
+		// nearest integer, and then shift back down.
+		// For example, we have 5.855 and want to round to 2 decimal places.
+		// In this case, numValue = 5.855 and numDecimalPlaces = 2
+		// round(numValue * 10^numDecimalPlaces) / 10^numDecimalPlaces
+		// round(5.855 * 10^2) / 10^2
+		// round(5.855 * 100) / 100
+		// round(585.5) / 100
+		// 586 / 100
+		// 5.86
+		//TODO: this can introduce rounding errors that don't show up in MySQL when the decimal places are larger than the input due to precision errors
+		roundedValue := math.Round(numValue*math.Pow(10.0, numDecimalPlaces)) / math.Pow(10.0, numDecimalPlaces)
+		if math.IsInf(roundedValue, 0) || math.IsNaN(roundedValue) {
+			roundedValue = numValue
 		}
 
-		str := res.String()
-		dotIdx := strings.Index(str, ".")
-		if dotIdx == -1 {
-			fractionStr = ""
-		} else {
-			fractionStr = str[dotIdx+1:]
+		// FORMAT(-5.932887e-08, 2);     		==> -0.00
+		// FORMAT(-0.00000005932887, 2); 		==> 0.00
+		// will return 0.00 for both cases
+		if roundedValue != 0 {
+			res = decimal.NewFromFloat(roundedValue)
 		}
 	}
 
+	var negative string
+	if res.IsNegative() {
+		negative = "-"
+		res = res.Neg()
+	}
+
+	var fractionStr string
+	str := res.String()
+	if dotIdx := strings.Index(str, "."); dotIdx != -1 {
+		fractionStr = str[dotIdx+1:]
+	}
+
 	p := message.NewPrinter(locale)
-	formattedWhole := p.Sprintf("%v", number.Decimal(whole))
+	formattedWhole := formatWholeNumber(p, res.Truncate(0).String())
 	if numDecimalPlaces == 0 {
 		return fmt.Sprintf("%s%s", negative, formattedWhole), nil
 	}
@@ -208,3 +228,44 @@ func (f *Format) WithChildren(children ...sql.Expression) (sql.Expression, error
 	}
 	return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
 }
+
+// formatWholeNumber returns the digits of a non-negative whole number grouped as the printer's locale groups them.
+// Numbers that don't fit in an int64 are grouped by hand, in the same way that the locale groups a smaller number.
+func formatWholeNumber(p *message.Printer, digits string) string {
+	if whole, err := strconv.ParseInt(digits, 10, 64); err == nil {
+		return p.Sprintf("%v", number.Decimal(whole))
+	}
+
+	// The locale's separator and group sizes are taken from a sample number, such as 1,234,567 or 12,34,567
+	sample := p.Sprintf("%v", number.Decimal(1234567))
+	var groups []string
+	separator := ""
+	start := 0
+	for i, r := range sample {
+		if r >= '0' && r <= '9' {
+			continue
+		}
+		if i > start {
+			groups = append(groups, sample[start:i])
+		}
+		if separator == "" {
+			separator = string(r)
+		}
+		start = i + utf8.RuneLen(r)
+	}
+	groups = append(groups, sample[start:])
+	if len(groups) < 3 {
+		return digits
+	}
+	primary, secondary := len(groups[len(groups)-1]), len(groups[len(groups)-2])
+
+	var parts []string
+	size := primary
+	for len(digits) > size {
+		parts = append([]string{digits[len(digits)-size:]}, parts...)
+		digits = digits[:len(digits)-size]
+		size = secondary
+	}
+	parts = append([]string{digits}, parts...)
+	return strings.Join(parts, separator)
+}
@@ -17,6 +17,7 @@ package function
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -70,6 +71,15 @@ func TestFormat(t *testing.T) {
 		{"sci-notn neg exp small num with big dp", types.Float64, types.Int32, sql.NewRow(-5.932887e-08, 8, nil), "-0.00000006", nil},
 		{"sci-notn text neg exp small num", types.Float64, types.Int32, sql.NewRow("-5.932887e-08", 2, nil), "0.00", nil},
 		{"sci-notn text neg exp small num with big dp", types.Float64, types.Int32, sql.NewRow("-5.932887e-08", 8, nil), "-0.00000006", nil},
+		{"text with trailing garbage", types.Text, types.Int32, sql.NewRow("1234.5abc", 1, nil), "1,234.5", nil},
+		{"float64 bigger than int64", types.Float64, types.Int32, sql.NewRow(1e20, 2, nil), "100,000,000,000,000,000,000.00", nil},
+		{"uint64 bigger than int64", types.Uint64, types.Int32, sql.NewRow(uint64(12345678901234567890), 0, nil), "12,345,678,901,234,567,890", nil},
+		{"int64 with d", types.Int64, types.Int32, sql.NewRow(int64(-1234567), 2, nil), "-1,234,567.00", nil},
+		{"decimal rounds half away from zero", types.InternalDecimalType, types.Int32, sql.NewRow(decimal.RequireFromString("-2.445"), 2, nil), "-2.45", nil},
+		{"decimal with big dp", types.InternalDecimalType, types.Int32, sql.NewRow(decimal.RequireFromString("1.5"), 40, nil), "1.500000000000000000000000000000", nil},
+		{"decimal rounds to negative zero", types.InternalDecimalType, types.Int32, sql.NewRow(decimal.RequireFromString("-0.5"), 0, nil), "-1", nil},
+		{"decimal bigger than int64 with loc=en_IN", types.InternalDecimalType, types.Int32, sql.NewRow(decimal.RequireFromString("123456789012345678901.5"), 1, "en_IN"), "12,34,56,78,90,12,34,56,78,901.5", nil},
+		{"decimal bigger than int64 with loc=de_DE", types.InternalDecimalType, types.Int32, sql.NewRow(decimal.RequireFromString("123456789012345678901.5"), 1, "de_DE"), "123.456.789.012.345.678.901,5", nil},
 		{"float64 with loc=ar_AE", types.Float64, types.Int32, sql.NewRow(2409384.855, 4, "ar_AE"), "2,409,384.8550", nil},
 		{"float64 with loc=ar_BH", types.Float64, types.Int32, sql.NewRow(2409384.855, 4, "ar_BH"), "2,409,384.8550", nil},
 		{"float64 with loc=ar_EG", types.Float64, types.Int32, sql.NewRow(2409384.855, 4, "ar_EG"), "2,409,384.8550", nil},
@@ -0,0 +1,277 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// evalGTIDSet evaluates |e| and parses it as a GTID set. It returns false when the value is NULL.
+func evalGTIDSet(ctx *sql.Context, e sql.Expression, row sql.Row) (binlogreplication.GTIDSet, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return binlogreplication.GTIDSet{}, false, err
+	}
+	val, _, err = types.LongText.Convert(val)
+	if err != nil {
+		return binlogreplication.GTIDSet{}, false, err
+	}
+	set, err := binlogreplication.ParseGTIDSet(val.(string))
+	if err != nil {
+		return binlogreplication.GTIDSet{}, false, err
+	}
+	return set, true, nil
+}
+
+// GTIDSubset implements the GTID_SUBSET function, which returns whether every GTID of the first set is in the second.
+// https://dev.mysql.com/doc/refman/8.0/en/gtid-functions.html#function_gtid-subset
+type GTIDSubset struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*GTIDSubset)(nil)
+var _ sql.CollationCoercible = (*GTIDSubset)(nil)
+
+// NewGTIDSubset creates a new GTIDSubset expression.
+func NewGTIDSubset(set1, set2 sql.Expression) sql.Expression {
+	return &GTIDSubset{expression.BinaryExpression{Left: set1, Right: set2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *GTIDSubset) FunctionName() string {
+	return "gtid_subset"
+}
+
+// Description implements sql.FunctionExpression
+func (g *GTIDSubset) Description() string {
+	return "returns whether all GTIDs in the first set are also in the second set."
+}
+
+// Type implements the sql.Expression interface.
+func (g *GTIDSubset) Type() sql.Type {
+	return types.Int8
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*GTIDSubset) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (g *GTIDSubset) String() string {
+	return fmt.Sprintf("%s(%s,%s)", g.FunctionName(), g.Left, g.Right)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (g *GTIDSubset) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 2)
+	}
+	return NewGTIDSubset(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (g *GTIDSubset) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	set1, ok, err := evalGTIDSet(ctx, g.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	set2, ok, err := evalGTIDSet(ctx, g.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if set2.Contains(set1) {
+		return int8(1), nil
+	}
+	return int8(0), nil
+}
+
+// GTIDSubtract implements the GTID_SUBTRACT function, which returns the GTIDs of the first set that aren't in the second.
+// https://dev.mysql.com/doc/refman/8.0/en/gtid-functions.html#function_gtid-subtract
+type GTIDSubtract struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*GTIDSubtract)(nil)
+var _ sql.CollationCoercible = (*GTIDSubtract)(nil)
+
+// NewGTIDSubtract creates a new GTIDSubtract expression.
+func NewGTIDSubtract(set1, set2 sql.Expression) sql.Expression {
+	return &GTIDSubtract{expression.BinaryExpression{Left: set1, Right: set2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *GTIDSubtract) FunctionName() string {
+	return "gtid_subtract"
+}
+
+// Description implements sql.FunctionExpression
+func (g *GTIDSubtract) Description() string {
+	return "returns the GTIDs in the first set that are not in the second set."
+}
+
+// Type implements the sql.Expression interface.
+func (g *GTIDSubtract) Type() sql.Type {
+	return types.LongText
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*GTIDSubtract) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+func (g *GTIDSubtract) String() string {
+	return fmt.Sprintf("%s(%s,%s)", g.FunctionName(), g.Left, g.Right)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (g *GTIDSubtract) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 2)
+	}
+	return NewGTIDSubtract(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (g *GTIDSubtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	set1, ok, err := evalGTIDSet(ctx, g.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	set2, ok, err := evalGTIDSet(ctx, g.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return set1.Subtract(set2).String(), nil
+}
+
+// WaitForExecutedGTIDSet implements the WAIT_FOR_EXECUTED_GTID_SET function, which waits until the server has executed
+// every GTID of a set. It returns 0 once it has, or 1 if the timeout, in seconds, elapsed first.
+// https://dev.mysql.com/doc/refman/8.0/en/gtid-functions.html#function_wait-for-executed-gtid-set
+type WaitForExecutedGTIDSet struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*WaitForExecutedGTIDSet)(nil)
+var _ sql.NonDeterministicExpression = (*WaitForExecutedGTIDSet)(nil)
+var _ sql.CollationCoercible = (*WaitForExecutedGTIDSet)(nil)
+
+// NewWaitForExecutedGTIDSet creates a new WaitForExecutedGTIDSet expression.
+func NewWaitForExecutedGTIDSet(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("WAIT_FOR_EXECUTED_GTID_SET", "1 or 2", len(args))
+	}
+	return &WaitForExecutedGTIDSet{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (w *WaitForExecutedGTIDSet) FunctionName() string {
+	return "wait_for_executed_gtid_set"
+}
+
+// Description implements sql.FunctionExpression
+func (w *WaitForExecutedGTIDSet) Description() string {
+	return "waits until the given GTIDs have been executed on the server."
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (w *WaitForExecutedGTIDSet) IsNonDeterministic() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) Type() sql.Type {
+	return types.Int8
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*WaitForExecutedGTIDSet) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) IsNullable() bool {
+	return true
+}
+
+// Children implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) Children() []sql.Expression {
+	return w.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) Resolved() bool {
+	return expression.ExpressionsResolved(w.args...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(w.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(w, len(children), len(w.args))
+	}
+	return NewWaitForExecutedGTIDSet(children...)
+}
+
+func (w *WaitForExecutedGTIDSet) String() string {
+	args := make([]string, len(w.args))
+	for i, arg := range w.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", w.FunctionName(), strings.Join(args, ","))
+}
+
+// Eval implements the sql.Expression interface.
+func (w *WaitForExecutedGTIDSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	set, ok, err := evalGTIDSet(ctx, w.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	// A timeout of zero, which is the default, waits indefinitely
+	var timeout time.Duration
+	if len(w.args) == 2 {
+		val, err := w.args[1].Eval(ctx, row)
+		if err != nil || val == nil {
+			return nil, err
+		}
+		val, _, err = types.Float64.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		seconds := val.(float64)
+		if seconds < 0 {
+			return nil, sql.ErrInvalidArgumentDetails.New(w.FunctionName(), "timeout must not be negative")
+		}
+		timeout = time.Duration(seconds * float64(time.Second))
+		if seconds > 0 && timeout == 0 {
+			timeout = time.Nanosecond
+		}
+	}
+
+	executed, err := binlogreplication.WaitForExecutedGTIDs(ctx, set, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if executed {
+		return int8(0), nil
+	}
+	return int8(1), nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	gtidTestUUID1 = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+	gtidTestUUID2 = "2174b383-5441-11e8-b90a-c80aa9429562"
+)
+
+func TestGTIDSubsetAndSubtract(t *testing.T) {
+	tests := []struct {
+		set1     interface{}
+		set2     interface{}
+		subset   interface{}
+		subtract interface{}
+	}{
+		{"", "", int8(1), ""},
+		{gtidTestUUID1 + ":1-5", gtidTestUUID1 + ":1-10", int8(1), ""},
+		{gtidTestUUID1 + ":1-10", gtidTestUUID1 + ":1-5", int8(0), gtidTestUUID1 + ":6-10"},
+		{gtidTestUUID1 + ":1-10", gtidTestUUID1 + ":3", int8(0), gtidTestUUID1 + ":1-2:4-10"},
+		{"3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5", gtidTestUUID1 + ":1-5", int8(1), ""},
+		{gtidTestUUID1 + ":1-5," + gtidTestUUID2 + ":1-5", gtidTestUUID1 + ":1-5", int8(0), gtidTestUUID2 + ":1-5"},
+		{gtidTestUUID1 + ":1-5," + gtidTestUUID2 + ":1-5", "", int8(0), gtidTestUUID2 + ":1-5,\n" + gtidTestUUID1 + ":1-5"},
+		{nil, gtidTestUUID1 + ":1-5", nil, nil},
+		{gtidTestUUID1 + ":1-5", nil, nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.set1, test.set2), func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			set1 := expression.NewLiteral(test.set1, types.LongText)
+			set2 := expression.NewLiteral(test.set2, types.LongText)
+
+			val, err := NewGTIDSubset(set1, set2).Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.subset, val)
+
+			val, err = NewGTIDSubtract(set1, set2).Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.subtract, val)
+		})
+	}
+
+	ctx := sql.NewEmptyContext()
+	malformed := expression.NewLiteral(gtidTestUUID1+":0", types.LongText)
+	empty := expression.NewLiteral("", types.LongText)
+	_, err := NewGTIDSubset(malformed, empty).Eval(ctx, nil)
+	require.True(t, sql.ErrMalformedGTIDSet.Is(err), "%v", err)
+	_, err = NewGTIDSubtract(empty, malformed).Eval(ctx, nil)
+	require.True(t, sql.ErrMalformedGTIDSet.Is(err), "%v", err)
+}
+
+func TestWaitForExecutedGTIDSet(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	_, err := NewWaitForExecutedGTIDSet()
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewWaitForExecutedGTIDSet(expression.NewLiteral("", types.LongText))
+	require.NoError(t, err)
+	val, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), val)
+
+	f, err = NewWaitForExecutedGTIDSet(expression.NewLiteral(gtidTestUUID1+":1-5", types.LongText), expression.NewLiteral(0.01, types.Float64))
+	require.NoError(t, err)
+	val, err = f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int8(1), val)
+
+	f, err = NewWaitForExecutedGTIDSet(expression.NewLiteral(gtidTestUUID1+":1-5", types.LongText), expression.NewLiteral(-1, types.Int64))
+	require.NoError(t, err)
+	_, err = f.Eval(ctx, nil)
+	require.True(t, sql.ErrInvalidArgumentDetails.Is(err), "%v", err)
+
+	f, err = NewWaitForExecutedGTIDSet(expression.NewLiteral(nil, types.Null))
+	require.NoError(t, err)
+	val, err = f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
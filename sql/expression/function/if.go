@@ -77,22 +77,20 @@ func (f *If) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
+	branch := f.ifFalse
 	if asBool {
-		return f.ifTrue.Eval(ctx, row)
-	} else {
-		return f.ifFalse.Eval(ctx, row)
+		branch = f.ifTrue
 	}
+	val, err := branch.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return types.ConvertToAggregatedType(val, branch.Type(), f.Type())
 }
 
 // Type implements the Expression interface.
 func (f *If) Type() sql.Type {
-	// if either type is string type, this should be a string type, regardless need to promote
-	typ1 := f.ifTrue.Type()
-	typ2 := f.ifFalse.Type()
-	if types.IsText(typ1) || types.IsText(typ2) {
-		return types.Text
-	}
-	return typ1.Promote()
+	return types.AggregateTypes(f.ifTrue.Type(), f.ifFalse.Type())
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -104,7 +102,7 @@ func (f *If) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID,
 
 // IsNullable implements the Expression interface.
 func (f *If) IsNullable() bool {
-	return f.ifTrue.IsNullable()
+	return f.ifTrue.IsNullable() || f.ifFalse.IsNullable()
 }
 
 func (f *If) String() string {
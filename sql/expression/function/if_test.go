@@ -32,8 +32,8 @@ func TestIf(t *testing.T) {
 	}{
 		{eq(lit(1, types.Int64), lit(1, types.Int64)), sql.Row{"a", "b"}, "a"},
 		{eq(lit(1, types.Int64), lit(0, types.Int64)), sql.Row{"a", "b"}, "b"},
-		{eq(lit(1, types.Int64), lit(1, types.Int64)), sql.Row{1, 2}, 1},
-		{eq(lit(1, types.Int64), lit(0, types.Int64)), sql.Row{1, 2}, 2},
+		{eq(lit(1, types.Int64), lit(1, types.Int64)), sql.Row{1, 2}, "1"},
+		{eq(lit(1, types.Int64), lit(0, types.Int64)), sql.Row{1, 2}, "2"},
 		{eq(lit(nil, types.Int64), lit(1, types.Int64)), sql.Row{"a", "b"}, "b"},
 		{eq(lit(1, types.Int64), lit(1, types.Int64)), sql.Row{nil, "b"}, nil},
 	}
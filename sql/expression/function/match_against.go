@@ -0,0 +1,356 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// MatchMode is the search mode of a MATCH ... AGAINST expression.
+type MatchMode byte
+
+const (
+	// MatchMode_NaturalLanguage searches for any of the words of the search string.
+	MatchMode_NaturalLanguage MatchMode = iota
+	// MatchMode_Boolean searches using the operators of the search string.
+	MatchMode_Boolean
+	// MatchMode_QueryExpansion searches for any of the words of the search string, and then for the words that are
+	// relevant to the rows found.
+	MatchMode_QueryExpansion
+)
+
+// String returns the mode as it's written in a query.
+func (m MatchMode) String() string {
+	switch m {
+	case MatchMode_Boolean:
+		return " IN BOOLEAN MODE"
+	case MatchMode_QueryExpansion:
+		return " WITH QUERY EXPANSION"
+	default:
+		return ""
+	}
+}
+
+const (
+	// matchMinTokenSize and matchMaxTokenSize are the defaults of innodb_ft_min_token_size and innodb_ft_max_token_size.
+	// Words outside of these lengths are ignored by searches.
+	matchMinTokenSize = 3
+	matchMaxTokenSize = 84
+)
+
+// matchStopwords is the default InnoDB stopword list, from INFORMATION_SCHEMA.INNODB_FT_DEFAULT_STOPWORD. Stopwords are
+// ignored by searches.
+var matchStopwords = map[string]struct{}{
+	"a": {}, "about": {}, "an": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "com": {}, "de": {}, "en": {},
+	"for": {}, "from": {}, "how": {}, "i": {}, "in": {}, "is": {}, "it": {}, "la": {}, "of": {}, "on": {}, "or": {},
+	"that": {}, "the": {}, "this": {}, "to": {}, "was": {}, "what": {}, "when": {}, "where": {}, "who": {}, "will": {},
+	"with": {}, "und": {}, "www": {},
+}
+
+// MatchAgainst is the MATCH (col1, col2, ...) AGAINST (expr [mode]) expression, which returns the relevance of a row
+// for a full-text search. A relevance of zero means that the row doesn't match. There isn't a full-text index to take
+// the frequency of words across the table from, so the relevance is computed from the words of the row alone. For the
+// same reason, query expansion searches the same words as natural language mode.
+type MatchAgainst struct {
+	Columns []sql.Expression
+	Expr    sql.Expression
+	Mode    MatchMode
+}
+
+var _ sql.FunctionExpression = (*MatchAgainst)(nil)
+var _ sql.CollationCoercible = (*MatchAgainst)(nil)
+
+// NewMatchAgainst creates a new MatchAgainst expression. Like MySQL, the parser only allows columns to be matched.
+func NewMatchAgainst(columns []sql.Expression, expr sql.Expression, mode MatchMode) (*MatchAgainst, error) {
+	if len(columns) == 0 {
+		return nil, sql.ErrInvalidArgument.New("MATCH")
+	}
+	return &MatchAgainst{Columns: columns, Expr: expr, Mode: mode}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MatchAgainst) FunctionName() string {
+	return "match"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MatchAgainst) Description() string {
+	return "returns the relevance of the columns to a full-text search."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MatchAgainst) Type() sql.Type { return types.Float64 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*MatchAgainst) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (m *MatchAgainst) IsNullable() bool { return false }
+
+// Children implements the sql.Expression interface.
+func (m *MatchAgainst) Children() []sql.Expression {
+	children := make([]sql.Expression, len(m.Columns), len(m.Columns)+1)
+	copy(children, m.Columns)
+	return append(children, m.Expr)
+}
+
+// Resolved implements the sql.Expression interface.
+func (m *MatchAgainst) Resolved() bool {
+	return expression.ExpressionsResolved(m.Children()...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (m *MatchAgainst) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(m.Columns)+1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), len(m.Columns)+1)
+	}
+	nm := *m
+	nm.Columns = children[:len(children)-1]
+	nm.Expr = children[len(children)-1]
+	return &nm, nil
+}
+
+func (m *MatchAgainst) String() string {
+	cols := make([]string, len(m.Columns))
+	for i, col := range m.Columns {
+		cols[i] = col.String()
+	}
+	return fmt.Sprintf("MATCH (%s) AGAINST (%s%s)", strings.Join(cols, ","), m.Expr, m.Mode)
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MatchAgainst) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	search, err := m.Expr.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil {
+		return float64(0), nil
+	}
+	search, _, err = types.LongText.Convert(search)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like regular expressions, only the collation decides whether the search is case-sensitive
+	collation, _ := sql.GetCoercibility(ctx, m.Columns[0])
+	foldCase := strings.HasSuffix(collation.String(), "_ci")
+
+	// The words of each column are kept apart, so that a phrase doesn't match across columns
+	var docs [][]string
+	for _, col := range m.Columns {
+		val, err := col.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			continue
+		}
+		val, _, err = types.LongText.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, matchWords(val.(string), foldCase))
+	}
+
+	if m.Mode == MatchMode_Boolean {
+		return matchBooleanRelevance(docs, parseMatchBooleanTerms(search.(string), foldCase)), nil
+	}
+
+	var relevance float64
+	for _, word := range matchWords(search.(string), foldCase) {
+		if isMatchSearchWord(word) {
+			relevance += float64(matchCount(docs, word, false))
+		}
+	}
+	return relevance, nil
+}
+
+// matchWords splits |str| into its words, which are made of letters, digits and underscores.
+func matchWords(str string, foldCase bool) []string {
+	if foldCase {
+		str = strings.ToLower(str)
+	}
+	return strings.FieldsFunc(str, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// isMatchSearchWord returns whether |word| is searched for, which it isn't when it's a stopword or is too short or too
+// long.
+func isMatchSearchWord(word string) bool {
+	n := len([]rune(word))
+	if n < matchMinTokenSize || n > matchMaxTokenSize {
+		return false
+	}
+	_, ok := matchStopwords[strings.ToLower(word)]
+	return !ok
+}
+
+// matchCount returns the number of times that |word| appears in |docs|, or that a word starting with it appears when
+// |prefix| is true.
+func matchCount(docs [][]string, word string, prefix bool) int {
+	count := 0
+	for _, doc := range docs {
+		for _, w := range doc {
+			if w == word || (prefix && strings.HasPrefix(w, word)) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// matchPhraseCount returns the number of times that the words of |phrase| appear next to each other in |docs|.
+func matchPhraseCount(docs [][]string, phrase []string) int {
+	if len(phrase) == 0 {
+		return 0
+	}
+	count := 0
+	for _, doc := range docs {
+	Outer:
+		for i := 0; i+len(phrase) <= len(doc); i++ {
+			for j, word := range phrase {
+				if doc[i+j] != word {
+					continue Outer
+				}
+			}
+			count++
+		}
+	}
+	return count
+}
+
+// matchBooleanOperator is the operator in front of a term of a boolean mode search.
+type matchBooleanOperator byte
+
+const (
+	matchBooleanOptional matchBooleanOperator = iota
+	matchBooleanRequired
+	matchBooleanExcluded
+)
+
+// matchBooleanTerm is a word, a word prefix or a phrase of a boolean mode search.
+type matchBooleanTerm struct {
+	op     matchBooleanOperator
+	words  []string
+	prefix bool
+	phrase bool
+}
+
+// parseMatchBooleanTerms parses the terms of a boolean mode search. The operators + and - make a term required or
+// excluded, a trailing * makes a word a prefix, and double quotes make a phrase. Other operators are ignored, as are
+// words that aren't searched for.
+func parseMatchBooleanTerms(search string, foldCase bool) []matchBooleanTerm {
+	var terms []matchBooleanTerm
+	runes := []rune(search)
+	for i := 0; i < len(runes); {
+		op := matchBooleanOptional
+	Operators:
+		for ; i < len(runes); i++ {
+			switch r := runes[i]; {
+			case r == '+':
+				op = matchBooleanRequired
+			case r == '-':
+				op = matchBooleanExcluded
+			case unicode.IsSpace(r):
+				op = matchBooleanOptional
+			case !strings.ContainsRune("<>~()@", r):
+				break Operators
+			}
+		}
+		if i >= len(runes) {
+			break
+		}
+
+		if runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			words := matchWords(string(runes[i+1:end]), foldCase)
+			if len(words) > 0 {
+				terms = append(terms, matchBooleanTerm{op: op, words: words, phrase: true})
+			}
+			i = end + 1
+			continue
+		}
+
+		start := i
+		for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+			i++
+		}
+		if start == i {
+			// Anything that isn't an operator or a word separates terms
+			i++
+			continue
+		}
+		word := string(runes[start:i])
+		if foldCase {
+			word = strings.ToLower(word)
+		}
+		prefix := i < len(runes) && runes[i] == '*'
+		if prefix {
+			i++
+		}
+		// Prefixes are searched for even if they're short, but not if they're stopwords
+		if prefix {
+			if _, ok := matchStopwords[strings.ToLower(word)]; ok {
+				continue
+			}
+		} else if !isMatchSearchWord(word) {
+			continue
+		}
+		terms = append(terms, matchBooleanTerm{op: op, words: []string{word}, prefix: prefix})
+	}
+	return terms
+}
+
+// matchBooleanRelevance returns the relevance of |docs| to the |terms| of a boolean mode search. A row matches when it
+// has every required term and none of the excluded terms, and, when there are no required terms, at least one of the
+// optional terms.
+func matchBooleanRelevance(docs [][]string, terms []matchBooleanTerm) float64 {
+	var relevance float64
+	for _, term := range terms {
+		var count int
+		if term.phrase {
+			count = matchPhraseCount(docs, term.words)
+		} else {
+			count = matchCount(docs, term.words[0], term.prefix)
+		}
+
+		switch term.op {
+		case matchBooleanRequired:
+			if count == 0 {
+				return 0
+			}
+		case matchBooleanExcluded:
+			if count > 0 {
+				return 0
+			}
+			continue
+		}
+		relevance += float64(count)
+	}
+	return relevance
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestMatchAgainst(t *testing.T) {
+	ciText := types.MustCreateString(sqltypes.Text, types.TextBlobMax, sql.Collation_utf8mb4_0900_ai_ci)
+	cols := []sql.Expression{
+		expression.NewGetField(0, ciText, "title", true),
+		expression.NewGetField(1, ciText, "body", true),
+	}
+
+	testCases := []struct {
+		name     string
+		mode     MatchMode
+		search   interface{}
+		row      sql.Row
+		expected float64
+	}{
+		{"word", MatchMode_NaturalLanguage, "database", sql.NewRow("MySQL", "a DataBase and a database"), 2},
+		{"words in every column", MatchMode_NaturalLanguage, "mysql database", sql.NewRow("MySQL", "database"), 2},
+		{"no words", MatchMode_NaturalLanguage, "oracle", sql.NewRow("MySQL", "database"), 0},
+		{"stopwords and short words are ignored", MatchMode_NaturalLanguage, "the db", sql.NewRow("the db", "the db"), 0},
+		{"null column", MatchMode_NaturalLanguage, "mysql", sql.NewRow(nil, "MySQL"), 1},
+		{"null search", MatchMode_NaturalLanguage, nil, sql.NewRow("MySQL", nil), 0},
+		{"query expansion", MatchMode_QueryExpansion, "mysql", sql.NewRow("MySQL", nil), 1},
+		{"boolean required", MatchMode_Boolean, "+mysql +database", sql.NewRow("MySQL", "database"), 2},
+		{"boolean missing required", MatchMode_Boolean, "+mysql +oracle", sql.NewRow("MySQL", "database"), 0},
+		{"boolean excluded", MatchMode_Boolean, "mysql -database", sql.NewRow("MySQL", "database"), 0},
+		{"boolean only excluded", MatchMode_Boolean, "-oracle", sql.NewRow("MySQL", "database"), 0},
+		{"boolean optional", MatchMode_Boolean, "oracle mysql", sql.NewRow("MySQL", "database"), 1},
+		{"boolean prefix", MatchMode_Boolean, "data*", sql.NewRow("MySQL", "database datasets"), 2},
+		{"boolean phrase", MatchMode_Boolean, `"a fast database"`, sql.NewRow("MySQL", "it's a fast database"), 1},
+		{"boolean phrase out of order", MatchMode_Boolean, `"database fast"`, sql.NewRow("MySQL", "a fast database"), 0},
+		{"boolean phrase across columns", MatchMode_Boolean, `"mysql database"`, sql.NewRow("MySQL", "database"), 0},
+		{"boolean excluded phrase", MatchMode_Boolean, `mysql -"fast database"`, sql.NewRow("MySQL", "a fast database"), 0},
+		{"boolean ignored operators", MatchMode_Boolean, `>mysql <(database)`, sql.NewRow("MySQL", "database"), 2},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewMatchAgainst(cols, expression.NewLiteral(tt.search, types.LongText), tt.mode)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// PeriodAdd implements the PERIOD_ADD function, which adds a number of months to a period in the format YYMM or
+// YYYYMM.
+type PeriodAdd struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*PeriodAdd)(nil)
+var _ sql.CollationCoercible = (*PeriodAdd)(nil)
+
+// NewPeriodAdd creates a new PERIOD_ADD() function.
+func NewPeriodAdd(period, months sql.Expression) sql.Expression {
+	return &PeriodAdd{expression.BinaryExpression{Left: period, Right: months}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PeriodAdd) FunctionName() string {
+	return "period_add"
+}
+
+// Description implements sql.FunctionExpression
+func (p *PeriodAdd) Description() string {
+	return "adds a number of months to a period of the form YYMM or YYYYMM."
+}
+
+// Type implements the sql.Expression interface.
+func (p *PeriodAdd) Type() sql.Type { return types.Int64 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*PeriodAdd) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (p *PeriodAdd) String() string {
+	return fmt.Sprintf("PERIOD_ADD(%s, %s)", p.Left, p.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (p *PeriodAdd) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPeriodAdd(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (p *PeriodAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	vals, err := evalPeriodArgs(ctx, row, p.FunctionName(), p.Left, p.Right)
+	if err != nil || vals == nil {
+		return nil, err
+	}
+
+	period, months := vals[0], vals[1]
+	if !validPeriod(period) {
+		return nil, sql.ErrInvalidArgument.New(p.FunctionName())
+	}
+
+	return monthToPeriod(periodToMonth(period) + months), nil
+}
+
+// PeriodDiff implements the PERIOD_DIFF function, which returns the number of months between two periods in the
+// format YYMM or YYYYMM.
+type PeriodDiff struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*PeriodDiff)(nil)
+var _ sql.CollationCoercible = (*PeriodDiff)(nil)
+
+// NewPeriodDiff creates a new PERIOD_DIFF() function.
+func NewPeriodDiff(period1, period2 sql.Expression) sql.Expression {
+	return &PeriodDiff{expression.BinaryExpression{Left: period1, Right: period2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PeriodDiff) FunctionName() string {
+	return "period_diff"
+}
+
+// Description implements sql.FunctionExpression
+func (p *PeriodDiff) Description() string {
+	return "returns the number of months between periods of the form YYMM or YYYYMM."
+}
+
+// Type implements the sql.Expression interface.
+func (p *PeriodDiff) Type() sql.Type { return types.Int64 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*PeriodDiff) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (p *PeriodDiff) String() string {
+	return fmt.Sprintf("PERIOD_DIFF(%s, %s)", p.Left, p.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (p *PeriodDiff) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPeriodDiff(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (p *PeriodDiff) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	vals, err := evalPeriodArgs(ctx, row, p.FunctionName(), p.Left, p.Right)
+	if err != nil || vals == nil {
+		return nil, err
+	}
+
+	if !validPeriod(vals[0]) || !validPeriod(vals[1]) {
+		return nil, sql.ErrInvalidArgument.New(p.FunctionName())
+	}
+
+	return periodToMonth(vals[0]) - periodToMonth(vals[1]), nil
+}
+
+// evalPeriodArgs evaluates the given arguments as integers. Returns nil if any of them is NULL.
+func evalPeriodArgs(ctx *sql.Context, row sql.Row, name string, args ...sql.Expression) ([]int64, error) {
+	vals := make([]int64, len(args))
+	for i, arg := range args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+
+		n, _, err := types.Int64.Convert(val)
+		if err != nil {
+			return nil, sql.ErrInvalidArgumentDetails.New(name, err.Error())
+		}
+		vals[i] = n.(int64)
+	}
+	return vals, nil
+}
+
+// validPeriod returns whether the given period is a positive YYMM or YYYYMM value with a valid month.
+func validPeriod(period int64) bool {
+	month := period % 100
+	return period > 0 && month >= 1 && month <= 12
+}
+
+// periodToMonth converts a period to a number of months. Two digit years from 70 to 99 are in the 1900s, and those
+// below 70 are in the 2000s.
+func periodToMonth(period int64) int64 {
+	year := period / 100
+	if year < 70 {
+		year += 2000
+	} else if year < 100 {
+		year += 1900
+	}
+	return year*12 + period%100 - 1
+}
+
+// monthToPeriod converts a number of months to a period in the format YYYYMM.
+func monthToPeriod(months int64) int64 {
+	year := months / 12
+	if year < 100 {
+		if year < 70 {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	}
+	return year*100 + months%12 + 1
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Quote function returns its argument as a string literal that can be used in a SQL statement: enclosed in single
+// quotes, with backslashes, single quotes, ASCII NUL and Control+Z escaped by a backslash. If the argument is NULL, the
+// result is the word NULL without quotes.
+// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_quote
+type Quote struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Quote)(nil)
+var _ sql.CollationCoercible = (*Quote)(nil)
+
+// NewQuote returns a new QUOTE function expression
+func NewQuote(arg sql.Expression) sql.Expression {
+	return &Quote{NewUnaryFunc(arg, "QUOTE", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (q *Quote) Description() string {
+	return "escapes the argument for use in an SQL statement."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (q *Quote) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, q.Child)
+}
+
+// IsNullable implements the Expression interface.
+func (q *Quote) IsNullable() bool {
+	return false
+}
+
+// WithChildren implements sql.Expression
+func (q *Quote) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(children), 1)
+	}
+	return NewQuote(children[0]), nil
+}
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\x00", `\0`, "\x1a", `\Z`)
+
+// Eval implements sql.Expression
+func (q *Quote) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := q.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return "NULL", nil
+	}
+
+	val, _, err = types.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return "'" + quoteReplacer.Replace(val.(string)) + "'", nil
+}
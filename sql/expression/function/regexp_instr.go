@@ -0,0 +1,187 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RegexpInstr implements the REGEXP_INSTR function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-instr
+type RegexpInstr struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*RegexpInstr)(nil)
+var _ sql.CollationCoercible = (*RegexpInstr)(nil)
+
+// NewRegexpInstr creates a new RegexpInstr expression.
+func NewRegexpInstr(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 6 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_instr", "2,3,4,5 or 6", len(args))
+	}
+
+	return &RegexpInstr{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpInstr) FunctionName() string {
+	return "regexp_instr"
+}
+
+// Description implements sql.FunctionExpression
+func (r *RegexpInstr) Description() string {
+	return "returns the starting index of substring matching regular expression."
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpInstr) Type() sql.Type { return types.Int32 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*RegexpInstr) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpInstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpInstr) Children() []sql.Expression {
+	return r.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpInstr) Resolved() bool {
+	for _, arg := range r.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpInstr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.args))
+	}
+	return NewRegexpInstr(children...)
+}
+
+func (r *RegexpInstr) String() string {
+	var args []string
+	for _, e := range r.args {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("%s(%s)", r.FunctionName(), strings.Join(args, ","))
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpInstr) Eval(ctx *sql.Context, row sql.Row) (val interface{}, err error) {
+	str, err := r.args[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if str == nil {
+		return nil, nil
+	}
+	str, _, err = types.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+	_str := str.(string)
+
+	var flags sql.Expression = nil
+	if len(r.args) == 6 {
+		flags = r.args[5]
+	}
+
+	// Create regex, should handle null pattern and null flags
+	re, err := compileRegex(ctx, r.args[1], r.args[0], flags, r.FunctionName(), row)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return nil, nil
+	}
+	defer func() {
+		if nErr := re.Close(); err == nil {
+			err = nErr
+		}
+	}()
+
+	// Default position is 1
+	pos := 1
+	if len(r.args) >= 3 {
+		var ok bool
+		pos, ok, err = evalRegexpInt(ctx, row, r.args[2])
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	// Default occurrence is 1, and so is any occurrence less than that
+	occ := 1
+	if len(r.args) >= 4 {
+		var ok bool
+		occ, ok, err = evalRegexpInt(ctx, row, r.args[3])
+		if err != nil || !ok {
+			return nil, err
+		}
+		if occ < 1 {
+			occ = 1
+		}
+	}
+
+	// A return option of 0 returns the position of the match, and 1 returns the position following the match
+	returnOpt := 0
+	if len(r.args) >= 5 {
+		var ok bool
+		returnOpt, ok, err = evalRegexpInt(ctx, row, r.args[4])
+		if err != nil || !ok {
+			return nil, err
+		}
+		if returnOpt != 0 && returnOpt != 1 {
+			return nil, sql.ErrInvalidArgumentDetails.New(r.FunctionName(), "return_option must be 1 or 0.")
+		}
+	}
+
+	// There's nothing to match in an empty string
+	if len(_str) == 0 {
+		return int32(0), nil
+	}
+
+	idx, err := regexpStartIndex(_str, pos, r.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+
+	matchPos, match, ok, err := regexpFind(ctx, re, _str, idx, occ)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return int32(0), nil
+	}
+	if returnOpt == 1 {
+		matchPos += utf8.RuneCountInString(match)
+	}
+	return int32(matchPos), nil
+}
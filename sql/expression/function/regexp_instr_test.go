@@ -0,0 +1,91 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRegexpInstrInvalidArgNumber(t *testing.T) {
+	_, err := NewRegexpInstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+	)
+	require.Error(t, err)
+
+	_, err = NewRegexpInstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.Int32, "return_option", true),
+		expression.NewGetField(5, types.LongText, "flags", true),
+		expression.NewGetField(6, types.LongText, "???", true),
+	)
+	require.Error(t, err)
+}
+
+func TestRegexpInstr(t *testing.T) {
+	f, err := NewRegexpInstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.Int32, "return_option", true),
+		expression.NewGetField(5, types.LongText, "flags", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"nil str", sql.NewRow(nil, "[a-z]+", 1, 1, 0, "c"), nil, false},
+		{"nil pattern", sql.NewRow("abc def", nil, 1, 1, 0, "c"), nil, false},
+		{"nil return option", sql.NewRow("abc def", "[a-z]+", 1, 1, nil, "c"), nil, false},
+		{"empty str", sql.NewRow("", "[a-z]+", 1, 1, 0, "c"), int32(0), false},
+		{"first occurrence", sql.NewRow("dog cat dog", "dog", 1, 1, 0, "c"), int32(1), false},
+		{"second occurrence", sql.NewRow("dog cat dog", "dog", 1, 2, 0, "c"), int32(9), false},
+		{"missing occurrence", sql.NewRow("dog cat dog", "dog", 1, 3, 0, "c"), int32(0), false},
+		{"position", sql.NewRow("dog cat dog", "dog", 2, 1, 0, "c"), int32(9), false},
+		{"end of match", sql.NewRow("dog cat dog", "dog", 1, 1, 1, "c"), int32(4), false},
+		{"multibyte", sql.NewRow("😀ñ dog", "dog", 1, 1, 0, "c"), int32(4), false},
+		{"case insensitive", sql.NewRow("DOG cat dog", "dog", 1, 1, 0, "i"), int32(1), false},
+		{"invalid return option", sql.NewRow("dog", "dog", 1, 1, 2, "c"), nil, true},
+		{"too large position", sql.NewRow("dog", "dog", 4, 1, 0, "c"), nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			val, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
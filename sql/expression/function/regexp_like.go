@@ -19,6 +19,8 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"unicode/utf16"
+	"unicode/utf8"
 
 	regex "github.com/dolthub/go-icu-regex"
 	"gopkg.in/src-d/go-errors.v1"
@@ -230,8 +232,8 @@ func compileRegex(ctx *sql.Context, pattern, text, flags sql.Expression, funcNam
 			return nil, err
 		}
 
-		flagsStr = f.(string)
-		flagsStr, err = consolidateRegexpFlags(flagsStr, funcName)
+		// The flags given are applied after the case-sensitivity of the collation, so that 'c' can override it
+		flagsStr, err = consolidateRegexpFlags(flagsStr+f.(string), funcName)
 		if err != nil {
 			return nil, err
 		}
@@ -293,6 +295,78 @@ func consolidateRegexpFlags(flags, funcName string) (string, error) {
 	return flags, nil
 }
 
+// evalRegexpInt evaluates |e| as an integer argument of a regular expression function. It returns false when the
+// argument is NULL.
+func evalRegexpInt(ctx *sql.Context, row sql.Row, e sql.Expression) (int, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil {
+		return 0, false, err
+	}
+	if val == nil {
+		return 0, false, nil
+	}
+	val, _, err = types.Int32.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(val.(int32)), true, nil
+}
+
+// regexpStartIndex returns the index that ICU starts searching |str| at, given a |pos| counted in characters and
+// starting at 1. ICU indexes strings by their UTF-16 code units, so characters outside the BMP count twice. Like MySQL,
+// a position that isn't positive or is past the end of the string is an error.
+func regexpStartIndex(str string, pos int, funcName string) (int, error) {
+	if pos <= 0 {
+		return 0, sql.ErrInvalidArgumentDetails.New(funcName, fmt.Sprintf("%d", pos))
+	}
+	if pos > utf8.RuneCountInString(str) {
+		return 0, errors.NewKind("Index out of bounds for regular expression search.").New()
+	}
+
+	idx := 0
+	for _, r := range str {
+		if pos == 1 {
+			break
+		}
+		idx += utf16.RuneLen(r)
+		pos--
+	}
+	return idx, nil
+}
+
+// regexpFind returns the |occurrence|th match of |re| in |str|, starting the search at the ICU index |idx|, as returned
+// by regexpStartIndex. The match's position is counted in characters and starts at 1. The go-icu-regex library doesn't expose the bounds of
+// a match, so the match is found by replacing it with itself surrounded by a marker that isn't in |str|.
+func regexpFind(ctx *sql.Context, re regex.Regex, str string, idx int, occurrence int) (matchPos int, match string, ok bool, err error) {
+	if err = re.SetMatchString(ctx, str); err != nil {
+		return 0, "", false, err
+	}
+	ok, err = re.Matches(ctx, idx, occurrence)
+	if err != nil || !ok {
+		return 0, "", false, err
+	}
+
+	marker := '\uE000'
+	for strings.ContainsRune(str, marker) {
+		marker++
+	}
+	replaced, err := re.Replace(ctx, string(marker)+"$0"+string(marker), idx+1, occurrence)
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	start := strings.IndexRune(replaced, marker)
+	if start < 0 {
+		return 0, "", false, nil
+	}
+	rest := replaced[start+utf8.RuneLen(marker):]
+	end := strings.IndexRune(rest, marker)
+	if end < 0 {
+		return 0, "", false, nil
+	}
+	return utf8.RuneCountInString(replaced[:start]) + 1, rest[:end], true, nil
+}
+
 func canBeCached(e sql.Expression) bool {
 	hasCols := false
 	sql.Inspect(e, func(e sql.Expression) bool {
@@ -18,8 +18,6 @@ import (
 	"fmt"
 	"strings"
 
-	"gopkg.in/src-d/go-errors.v1"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -187,14 +185,10 @@ func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (val interface{}, er
 		_pos = int(pos.(int32))
 	}
 
-	// Non-positive position throws incorrect parameter
-	if _pos <= 0 {
-		return nil, sql.ErrInvalidArgumentDetails.New(r.FunctionName(), fmt.Sprintf("%d", _pos))
-	}
-
-	// Handle out of bounds
-	if _pos > len(_str) {
-		return nil, errors.NewKind("Index out of bounds for regular expression search.").New()
+	// Positions are counted in characters, which doesn't match how ICU indexes strings
+	idx, err := regexpStartIndex(_str, _pos, r.FunctionName())
+	if err != nil {
+		return nil, err
 	}
 
 	// Default occurrence is 0 (replace all occurrences)
@@ -220,5 +214,5 @@ func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (val interface{}, er
 		_occ = int(occ.(int32))
 	}
 
-	return re.Replace(ctx, _replaceStr, _pos, _occ)
+	return re.Replace(ctx, _replaceStr, idx+1, _occ)
 }
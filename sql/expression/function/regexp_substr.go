@@ -0,0 +1,169 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RegexpSubstr implements the REGEXP_SUBSTR function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+type RegexpSubstr struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*RegexpSubstr)(nil)
+var _ sql.CollationCoercible = (*RegexpSubstr)(nil)
+
+// NewRegexpSubstr creates a new RegexpSubstr expression.
+func NewRegexpSubstr(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_substr", "2,3,4 or 5", len(args))
+	}
+
+	return &RegexpSubstr{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpSubstr) FunctionName() string {
+	return "regexp_substr"
+}
+
+// Description implements sql.FunctionExpression
+func (r *RegexpSubstr) Description() string {
+	return "returns substring matching regular expression."
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpSubstr) Type() sql.Type { return types.LongText }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (r *RegexpSubstr) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	leftCollation, leftCoercibility := sql.GetCoercibility(ctx, r.args[0])
+	rightCollation, rightCoercibility := sql.GetCoercibility(ctx, r.args[1])
+	return sql.ResolveCoercibility(leftCollation, leftCoercibility, rightCollation, rightCoercibility)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpSubstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpSubstr) Children() []sql.Expression {
+	return r.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpSubstr) Resolved() bool {
+	for _, arg := range r.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpSubstr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.args))
+	}
+	return NewRegexpSubstr(children...)
+}
+
+func (r *RegexpSubstr) String() string {
+	var args []string
+	for _, e := range r.args {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("%s(%s)", r.FunctionName(), strings.Join(args, ","))
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (val interface{}, err error) {
+	str, err := r.args[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if str == nil {
+		return nil, nil
+	}
+	str, _, err = types.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+	_str := str.(string)
+
+	var flags sql.Expression = nil
+	if len(r.args) == 5 {
+		flags = r.args[4]
+	}
+
+	// Create regex, should handle null pattern and null flags
+	re, err := compileRegex(ctx, r.args[1], r.args[0], flags, r.FunctionName(), row)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return nil, nil
+	}
+	defer func() {
+		if nErr := re.Close(); err == nil {
+			err = nErr
+		}
+	}()
+
+	// Default position is 1
+	pos := 1
+	if len(r.args) >= 3 {
+		var ok bool
+		pos, ok, err = evalRegexpInt(ctx, row, r.args[2])
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	// Default occurrence is 1, and so is any occurrence less than that
+	occ := 1
+	if len(r.args) >= 4 {
+		var ok bool
+		occ, ok, err = evalRegexpInt(ctx, row, r.args[3])
+		if err != nil || !ok {
+			return nil, err
+		}
+		if occ < 1 {
+			occ = 1
+		}
+	}
+
+	// There's nothing to match in an empty string
+	if len(_str) == 0 {
+		return nil, nil
+	}
+
+	idx, err := regexpStartIndex(_str, pos, r.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+
+	_, match, ok, err := regexpFind(ctx, re, _str, idx, occ)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return match, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRegexpSubstrInvalidArgNumber(t *testing.T) {
+	_, err := NewRegexpSubstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+	)
+	require.Error(t, err)
+
+	_, err = NewRegexpSubstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.LongText, "flags", true),
+		expression.NewGetField(5, types.LongText, "???", true),
+	)
+	require.Error(t, err)
+}
+
+func TestRegexpSubstr(t *testing.T) {
+	f, err := NewRegexpSubstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.LongText, "flags", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"nil str", sql.NewRow(nil, "[a-z]+", 1, 1, "c"), nil, false},
+		{"nil pattern", sql.NewRow("abc def", nil, 1, 1, "c"), nil, false},
+		{"nil position", sql.NewRow("abc def", "[a-z]+", nil, 1, "c"), nil, false},
+		{"nil occurrence", sql.NewRow("abc def", "[a-z]+", 1, nil, "c"), nil, false},
+		{"nil flags", sql.NewRow("abc def", "[a-z]+", 1, 1, nil), nil, false},
+		{"empty str", sql.NewRow("", "[a-z]+", 1, 1, "c"), nil, false},
+		{"first occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, 1, "c"), "abc", false},
+		{"second occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, 2, "c"), "def", false},
+		{"zero occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, 0, "c"), "abc", false},
+		{"missing occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, 4, "c"), nil, false},
+		{"position", sql.NewRow("abc def ghi", "[a-z]+", 6, 1, "c"), "ef", false},
+		{"multibyte position", sql.NewRow("ñaña😀aña", "a.", 5, 1, "c"), "añ", false},
+		{"case sensitive", sql.NewRow("ABC def", "[a-z]+", 1, 1, "c"), "def", false},
+		{"case insensitive", sql.NewRow("ABC def", "[a-z]+", 1, 1, "i"), "ABC", false},
+		{"word boundary", sql.NewRow("xfoo foo", `\bfoo\b`, 1, 1, "c"), "foo", false},
+		{"posix class", sql.NewRow("12ab3", "[[:alpha:]]+", 1, 1, "c"), "ab", false},
+		{"zero position", sql.NewRow("abc", "[a-z]+", 0, 1, "c"), nil, true},
+		{"too large position", sql.NewRow("abc", "[a-z]+", 4, 1, "c"), nil, true},
+		{"invalid flags", sql.NewRow("abc", "[a-z]+", 1, 1, "x"), nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			val, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
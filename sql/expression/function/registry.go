@@ -31,7 +31,7 @@ var ErrFunctionAlreadyRegistered = errors.NewKind("function '%s' is already regi
 
 // BuiltIns is the set of built-in functions any integrator can use
 var BuiltIns = []sql.Function{
-	// elt, find_in_set, insert, load_file, locate
+	// find_in_set, insert, load_file, locate
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
 	sql.Function1{Name: "any_value", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAnyValue(e) }},
@@ -80,7 +80,10 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "dayofweek", Fn: NewDayOfWeek},
 	sql.Function1{Name: "dayofyear", Fn: NewDayOfYear},
 	sql.Function1{Name: "degrees", Fn: NewDegrees},
+	sql.FunctionN{Name: "elt", Fn: NewElt},
+	sql.FunctionN{Name: "export_set", Fn: NewExportSet},
 	sql.Function2{Name: "extract", Fn: NewExtract},
+	sql.FunctionN{Name: "field", Fn: NewField},
 	sql.Function2{Name: "find_in_set", Fn: NewFindInSet},
 	sql.Function1{Name: "first", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewFirst(e) }},
 	sql.Function1{Name: "floor", Fn: NewFloor},
@@ -90,6 +93,8 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
+	sql.Function2{Name: "gtid_subset", Fn: NewGTIDSubset},
+	sql.Function2{Name: "gtid_subtract", Fn: NewGTIDSubtract},
 	sql.Function1{Name: "hex", Fn: NewHex},
 	sql.Function1{Name: "hour", Fn: NewHour},
 	sql.Function3{Name: "if", Fn: NewIf},
@@ -139,7 +144,9 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "json_value", Fn: NewJSONValue},
 	sql.FunctionN{Name: "lag", Fn: func(e ...sql.Expression) (sql.Expression, error) { return window.NewLag(e...) }},
 	sql.Function1{Name: "last", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewLast(e) }},
+	sql.Function1{Name: "last_day", Fn: NewLastDay},
 	sql.FunctionN{Name: "last_insert_id", Fn: NewLastInsertId},
+	sql.Function1{Name: "lastval", Fn: NewLastVal},
 	sql.Function1{Name: "lcase", Fn: NewLower},
 	sql.FunctionN{Name: "lead", Fn: func(e ...sql.Expression) (sql.Expression, error) { return window.NewLead(e...) }},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
@@ -155,6 +162,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "lpad", Fn: NewLeftPad},
 	sql.Function1{Name: "ltrim", Fn: NewLeftTrim},
 	sql.Function1{Name: "max", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMax(e) }},
+	sql.FunctionN{Name: "make_set", Fn: NewMakeSet},
 	sql.Function1{Name: "md5", Fn: NewMD5},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
@@ -163,14 +171,20 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "mod", Fn: NewMod},
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
+	sql.Function1{Name: "nextval", Fn: NewNextVal},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
+	sql.Function2{Name: "period_add", Fn: NewPeriodAdd},
+	sql.Function2{Name: "period_diff", Fn: NewPeriodDiff},
 	sql.Function2{Name: "pow", Fn: NewPower},
 	sql.Function2{Name: "power", Fn: NewPower},
+	sql.Function1{Name: "quote", Fn: NewQuote},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.FunctionN{Name: "regexp_instr", Fn: NewRegexpInstr},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
 	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
+	sql.FunctionN{Name: "regexp_substr", Fn: NewRegexpSubstr},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
 	sql.Function3{Name: "replace", Fn: NewReplace},
 	sql.Function1{Name: "reverse", Fn: NewReverse},
@@ -186,7 +200,9 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "rpad", Fn: NewRightPad},
 	sql.Function1{Name: "rtrim", Fn: NewRightTrim},
 	sql.Function0{Name: "schema", Fn: NewDatabase},
+	sql.Function1{Name: "sec_to_time", Fn: NewSecToTime},
 	sql.Function1{Name: "second", Fn: NewSecond},
+	sql.Function2{Name: "setval", Fn: NewSetVal},
 	sql.Function1{Name: "sha", Fn: NewSHA1},
 	sql.Function1{Name: "sha1", Fn: NewSHA1},
 	sql.Function2{Name: "sha2", Fn: NewSHA2},
@@ -280,6 +296,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "uuid_to_bin", Fn: NewUUIDToBin},
 	sql.FunctionN{Name: "week", Fn: NewWeek},
 	sql.Function1{Name: "values", Fn: NewValues},
+	sql.FunctionN{Name: "wait_for_executed_gtid_set", Fn: NewWaitForExecutedGTIDSet},
 	sql.Function1{Name: "weekday", Fn: NewWeekday},
 	sql.Function1{Name: "weekofyear", Fn: NewWeekOfYear},
 	sql.Function1{Name: "year", Fn: NewYear},
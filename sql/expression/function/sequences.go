@@ -0,0 +1,320 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// sequenceFunc is the common base of the functions that read or modify a sequence. The first argument of every
+// sequence function is the name of the sequence, optionally qualified with its database.
+type sequenceFunc struct {
+	args     []sql.Expression
+	provider sql.DatabaseProvider
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *sequenceFunc) Resolved() bool {
+	for _, arg := range s.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the sql.Expression interface.
+func (s *sequenceFunc) Children() []sql.Expression {
+	return s.args
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *sequenceFunc) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (s *sequenceFunc) Type() sql.Type {
+	return types.Int64
+}
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface.
+func (s *sequenceFunc) IsNonDeterministic() bool {
+	return true
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*sequenceFunc) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (s *sequenceFunc) string(name string) string {
+	args := make([]string, len(s.args))
+	for i, arg := range s.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ","))
+}
+
+// sequence returns the database holding the sequence named by the first argument, along with the unqualified name of
+// the sequence. Returns a nil database if the name is NULL.
+func (s *sequenceFunc) sequence(ctx *sql.Context, row sql.Row) (sql.SequenceDatabase, string, error) {
+	val, err := s.args[0].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, "", err
+	}
+	name, ok := val.(string)
+	if !ok {
+		return nil, "", sql.ErrInvalidArgumentType.New(s.args[0].String())
+	}
+
+	dbName := ctx.GetCurrentDatabase()
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		dbName, name = name[:i], name[i+1:]
+	}
+	if dbName == "" {
+		return nil, "", sql.ErrNoDatabaseSelected.New()
+	}
+	if s.provider == nil {
+		return nil, "", sql.ErrSequenceNotFound.New(dbName + "." + name)
+	}
+
+	db, err := s.provider.Database(ctx, dbName)
+	if err != nil {
+		return nil, "", err
+	}
+	seqDb, ok := db.(sql.SequenceDatabase)
+	if !ok {
+		return nil, "", sql.ErrSequenceNotFound.New(dbName + "." + name)
+	}
+	return seqDb, name, nil
+}
+
+// NextVal implements the NEXTVAL function, which returns the next value of a sequence.
+type NextVal struct {
+	sequenceFunc
+}
+
+var _ sql.FunctionExpression = (*NextVal)(nil)
+var _ sql.SequenceExpression = (*NextVal)(nil)
+var _ sql.CollationCoercible = (*NextVal)(nil)
+
+// NewNextVal returns a new NEXTVAL function.
+func NewNextVal(name sql.Expression) sql.Expression {
+	return &NextVal{sequenceFunc{args: []sql.Expression{name}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (n *NextVal) FunctionName() string {
+	return "nextval"
+}
+
+// Description implements sql.FunctionExpression
+func (n *NextVal) Description() string {
+	return "returns the next value of a sequence."
+}
+
+// String implements the fmt.Stringer interface.
+func (n *NextVal) String() string {
+	return n.string("nextval")
+}
+
+// WithChildren implements the sql.Expression interface.
+func (n *NextVal) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	nn := *n
+	nn.args = children
+	return &nn, nil
+}
+
+// WithDatabaseProvider implements the sql.SequenceExpression interface.
+func (n *NextVal) WithDatabaseProvider(provider sql.DatabaseProvider) (sql.Expression, error) {
+	nn := *n
+	nn.provider = provider
+	return &nn, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (n *NextVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	db, name, err := n.sequence(ctx, row)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	return sql.NextSequenceValue(ctx, db, name)
+}
+
+// LastVal implements the LASTVAL function, which returns the last value of a sequence read by the current session.
+type LastVal struct {
+	sequenceFunc
+}
+
+var _ sql.FunctionExpression = (*LastVal)(nil)
+var _ sql.SequenceExpression = (*LastVal)(nil)
+var _ sql.CollationCoercible = (*LastVal)(nil)
+
+// NewLastVal returns a new LASTVAL function.
+func NewLastVal(name sql.Expression) sql.Expression {
+	return &LastVal{sequenceFunc{args: []sql.Expression{name}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l *LastVal) FunctionName() string {
+	return "lastval"
+}
+
+// Description implements sql.FunctionExpression
+func (l *LastVal) Description() string {
+	return "returns the last value of a sequence read by the current session."
+}
+
+// String implements the fmt.Stringer interface.
+func (l *LastVal) String() string {
+	return l.string("lastval")
+}
+
+// WithChildren implements the sql.Expression interface.
+func (l *LastVal) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	nl := *l
+	nl.args = children
+	return &nl, nil
+}
+
+// WithDatabaseProvider implements the sql.SequenceExpression interface.
+func (l *LastVal) WithDatabaseProvider(provider sql.DatabaseProvider) (sql.Expression, error) {
+	nl := *l
+	nl.provider = provider
+	return &nl, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (l *LastVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	db, name, err := l.sequence(ctx, row)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	_, ok, err := db.GetSequence(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrSequenceNotFound.New(db.Name() + "." + name)
+	}
+
+	cs, ok := ctx.Session.(sql.SequenceCacheSession)
+	if !ok {
+		return nil, nil
+	}
+	val, ok := cs.SequenceCache().LastValue(db.Name(), name)
+	if !ok {
+		return nil, nil
+	}
+	return val, nil
+}
+
+// SetVal implements the SETVAL function, which sets the next value of a sequence to follow the value given.
+type SetVal struct {
+	sequenceFunc
+}
+
+var _ sql.FunctionExpression = (*SetVal)(nil)
+var _ sql.SequenceExpression = (*SetVal)(nil)
+var _ sql.CollationCoercible = (*SetVal)(nil)
+
+// NewSetVal returns a new SETVAL function.
+func NewSetVal(name, val sql.Expression) sql.Expression {
+	return &SetVal{sequenceFunc{args: []sql.Expression{name, val}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *SetVal) FunctionName() string {
+	return "setval"
+}
+
+// Description implements sql.FunctionExpression
+func (s *SetVal) Description() string {
+	return "sets the value of a sequence, so that the next value follows it."
+}
+
+// String implements the fmt.Stringer interface.
+func (s *SetVal) String() string {
+	return s.string("setval")
+}
+
+// WithChildren implements the sql.Expression interface.
+func (s *SetVal) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	ns := *s
+	ns.args = children
+	return &ns, nil
+}
+
+// WithDatabaseProvider implements the sql.SequenceExpression interface.
+func (s *SetVal) WithDatabaseProvider(provider sql.DatabaseProvider) (sql.Expression, error) {
+	ns := *s
+	ns.provider = provider
+	return &ns, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *SetVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	db, name, err := s.sequence(ctx, row)
+	if err != nil || db == nil {
+		return nil, err
+	}
+	val, err := s.args[1].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	val, _, err = types.Int64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, ok, err := db.GetSequence(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrSequenceNotFound.New(db.Name() + "." + name)
+	}
+
+	// The value given counts as used, so the sequence continues from the value after it. Sequence bounds never reach
+	// the limits of int64, so saturating at those limits leaves the next value out of bounds.
+	given := val.(int64)
+	next := given + seq.Increment
+	if seq.Increment > 0 && given > math.MaxInt64-seq.Increment {
+		next = math.MaxInt64
+	} else if seq.Increment < 0 && given < math.MinInt64-seq.Increment {
+		next = math.MinInt64
+	}
+
+	err = db.RestartSequence(ctx, name, next)
+	if cs, ok := ctx.Session.(sql.SequenceCacheSession); ok {
+		cs.SequenceCache().Invalidate(db.Name(), name)
+	}
+	return given, err
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	gmstime "github.com/dolthub/go-mysql-server/internal/time"
@@ -547,7 +548,7 @@ func (*YearWeek) CollationCoercibility(ctx *sql.Context) (collation sql.Collatio
 // Eval implements the Expression interface.
 func (d *YearWeek) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	date, err := getDate(ctx, expression.UnaryExpression{Child: d.date}, row)
-	if err != nil {
+	if err != nil || date == nil {
 		return nil, err
 	}
 	yyyy, ok := year(date).(int32)
@@ -615,11 +616,10 @@ func NewWeek(args ...sql.Expression) (sql.Expression, error) {
 		return nil, sql.ErrInvalidArgumentNumber.New("YEARWEEK", "1 or more", 0)
 	}
 
+	// without a mode, the default_week_format system variable is used
 	w := &Week{date: args[0]}
-	if len(args) > 1 && args[1].Resolved() && types.IsInteger(args[1].Type()) {
+	if len(args) > 1 {
 		w.mode = args[1]
-	} else {
-		w.mode = expression.NewLiteral(0, types.Int64)
 	}
 
 	return w, nil
@@ -635,7 +635,12 @@ func (d *Week) Description() string {
 	return "returns the week number."
 }
 
-func (d *Week) String() string { return fmt.Sprintf("WEEK(%s, %d)", d.date, d.mode) }
+func (d *Week) String() string {
+	if d.mode == nil {
+		return fmt.Sprintf("WEEK(%s)", d.date)
+	}
+	return fmt.Sprintf("WEEK(%s, %s)", d.date, d.mode)
+}
 
 // Type implements the Expression interface.
 func (d *Week) Type() sql.Type { return types.Int32 }
@@ -648,7 +653,7 @@ func (*Week) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID,
 // Eval implements the Expression interface.
 func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	date, err := getDate(ctx, expression.UnaryExpression{Child: d.date}, row)
-	if err != nil {
+	if err != nil || date == nil {
 		return nil, err
 	}
 
@@ -665,11 +670,17 @@ func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid day")
 	}
 
-	mode := int64(0)
-	val, err := d.mode.Eval(ctx, row)
+	var val interface{}
+	if d.mode == nil {
+		val, err = ctx.GetSessionVariable(ctx, "default_week_format")
+	} else {
+		val, err = d.mode.Eval(ctx, row)
+	}
 	if err != nil {
 		return nil, err
 	}
+
+	mode := int64(0)
 	if val != nil {
 		if i64, _, err := types.Int64.Convert(val); err == nil {
 			if mode, ok = i64.(int64); ok {
@@ -678,24 +689,24 @@ func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
-	yearForWeek, week := calcWeek(yyyy, mm, dd, weekMode(mode)|weekBehaviourYear)
-
-	if yearForWeek < yyyy {
-		week = 0
-	} else if yearForWeek > yyyy {
-		week = 53
-	}
-
+	// unlike YEARWEEK, the week range depends on the mode: modes with a 1-53 range report the week of the
+	// neighbouring year for dates at the start or end of the year
+	_, week := calcWeek(yyyy, mm, dd, weekMode(mode))
 	return week, nil
 }
 
 // Resolved implements the Expression interface.
 func (d *Week) Resolved() bool {
-	return d.date.Resolved() && d.mode.Resolved()
+	return d.date.Resolved() && (d.mode == nil || d.mode.Resolved())
 }
 
 // Children implements the Expression interface.
-func (d *Week) Children() []sql.Expression { return []sql.Expression{d.date, d.mode} }
+func (d *Week) Children() []sql.Expression {
+	if d.mode == nil {
+		return []sql.Expression{d.date}
+	}
+	return []sql.Expression{d.date, d.mode}
+}
 
 // IsNullable implements the Expression interface.
 func (d *Week) IsNullable() bool {
@@ -1323,6 +1334,125 @@ func (m *TimeToSec) WithChildren(children ...sql.Expression) (sql.Expression, er
 	return NewTimeToSec(children[0]), nil
 }
 
+// LastDay implements the LAST_DAY function
+type LastDay struct {
+	*UnaryDatetimeFunc
+}
+
+var _ sql.FunctionExpression = (*LastDay)(nil)
+var _ sql.CollationCoercible = (*LastDay)(nil)
+
+func NewLastDay(arg sql.Expression) sql.Expression {
+	return &LastDay{NewUnaryDatetimeFunc(arg, "LAST_DAY", types.Date)}
+}
+
+// Description implements sql.FunctionExpression
+func (d *LastDay) Description() string {
+	return "returns the last day of the month for the argument."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*LastDay) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (d *LastDay) IsNullable() bool {
+	return true
+}
+
+func (d *LastDay) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := d.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	// invalid dates result in NULL rather than an error
+	date, _, err := types.Datetime.Convert(val)
+	if err != nil {
+		ctx.Warn(1292, err.Error())
+		return nil, nil
+	}
+
+	t := date.(time.Time)
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC), nil
+}
+
+func (d *LastDay) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+	return NewLastDay(children[0]), nil
+}
+
+// SecToTime implements the SEC_TO_TIME function
+type SecToTime struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*SecToTime)(nil)
+var _ sql.CollationCoercible = (*SecToTime)(nil)
+
+func NewSecToTime(arg sql.Expression) sql.Expression {
+	return &SecToTime{expression.UnaryExpression{Child: arg}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *SecToTime) FunctionName() string {
+	return "sec_to_time"
+}
+
+// Description implements sql.FunctionExpression
+func (s *SecToTime) Description() string {
+	return "converts seconds to 'hh:mm:ss' format."
+}
+
+// Type implements the sql.Expression interface.
+func (s *SecToTime) Type() sql.Type {
+	return types.Time
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SecToTime) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// String implements the fmt.Stringer interface.
+func (s *SecToTime) String() string {
+	return fmt.Sprintf("SEC_TO_TIME(%s)", s.Child)
+}
+
+func (s *SecToTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := s.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	secs, _, err := types.InternalDecimalType.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	// fractional seconds are kept, and the result is clamped to the TIME range
+	micros := secs.(decimal.Decimal).Shift(6).Round(0).IntPart()
+	return types.Time.MicrosecondsToTimespan(micros), nil
+}
+
+func (s *SecToTime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewSecToTime(children[0]), nil
+}
+
 // WeekOfYear implements the weekofyear function
 type WeekOfYear struct {
 	*UnaryDatetimeFunc
@@ -313,7 +313,7 @@ func TestYearWeek(t *testing.T) {
 		expected interface{}
 		err      bool
 	}{
-		{"null date", sql.NewRow(nil), nil, true},
+		{"null date", sql.NewRow(nil), nil, false},
 		{"invalid type", sql.NewRow([]byte{0, 1, 2}), int32(1), false},
 		{"date as string", sql.NewRow(stringDate), int32(200653), false},
 	}
@@ -332,6 +332,44 @@ func TestYearWeek(t *testing.T) {
 	}
 }
 
+func TestWeek(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		date     string
+		mode     int64
+		expected int32
+	}{
+		{"2008-02-20", 0, 7},
+		{"2008-02-20", 1, 8},
+		{"2021-01-01", 0, 0},
+		{"2021-01-01", 1, 0},
+		{"2021-01-01", 2, 52},
+		{"2021-01-01", 3, 53},
+		{"2021-01-01", 7, 52},
+		{"2024-12-30", 1, 53},
+		{"2024-12-30", 3, 1},
+		{"2024-12-30", 5, 53},
+		{"2024-12-30", 7, 53},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%s mode %d", tt.date, tt.mode), func(t *testing.T) {
+			f, err := NewWeek(expression.NewLiteral(tt.date, types.LongText), expression.NewLiteral(tt.mode, types.Int64))
+			require.NoError(t, err)
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+
+	f, err := NewWeek(expression.NewLiteral(nil, types.Null))
+	require.NoError(t, err)
+	val, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
 func TestCalcDaynr(t *testing.T) {
 	require.EqualValues(t, calcDaynr(0, 0, 0), 0)
 	require.EqualValues(t, calcDaynr(9999, 12, 31), 3652424)
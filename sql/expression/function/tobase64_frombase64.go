@@ -160,6 +160,8 @@ func (t *FromBase64) Description() string {
 	return "decodes the base64-encoded string str."
 }
 
+var base64WhitespaceRemover = strings.NewReplacer(" ", "", "\t", "", "\r", "", "\n", "")
+
 // Eval implements the Expression interface.
 func (t *FromBase64) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	str, err := t.Child.Eval(ctx, row)
@@ -177,9 +179,10 @@ func (t *FromBase64) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrInvalidType.New(reflect.TypeOf(str))
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(str.(string))
+	// Like MySQL, spaces, tabs and line breaks are ignored, and strings that aren't valid base64 are NULL
+	decoded, err := base64.StdEncoding.DecodeString(base64WhitespaceRemover.Replace(str.(string)))
 	if err != nil {
-		return nil, err
+		return nil, nil
 	}
 
 	return decoded, nil
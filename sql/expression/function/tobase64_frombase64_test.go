@@ -70,3 +70,27 @@ func TestBase64(t *testing.T) {
 		})
 	}
 }
+
+func TestFromBase64(t *testing.T) {
+	f := NewFromBase64(expression.NewGetField(0, types.LongText, "", false))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"null input", sql.NewRow(nil), nil},
+		{"whitespace is ignored", sql.NewRow("Zm 9v\nYm\tFy\r\n"), []byte("foobar")},
+		{"invalid input", sql.NewRow("!!!"), nil},
+		{"bad padding", sql.NewRow("Zm9v="), nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
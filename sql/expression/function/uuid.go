@@ -84,7 +84,13 @@ func (UUIDFunc) CollationCoercibility(ctx *sql.Context) (collation sql.Collation
 }
 
 func (u UUIDFunc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	return uuid.New().String(), nil
+	// Version 1 UUIDs begin with the time they were generated, so that UUID_TO_BIN with the swap flag orders them by
+	// time and they are inserted near each other in indexes
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
 }
 
 func (u UUIDFunc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -167,14 +173,14 @@ func (u IsUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	switch str := str.(type) {
 	case string:
-		_, err := uuid.Parse(str)
+		_, err := parseUUID(str)
 		if err != nil {
 			return int8(0), nil
 		}
 
 		return int8(1), nil
 	case []byte:
-		_, err := uuid.ParseBytes(str)
+		_, err := parseUUID(string(str))
 		if err != nil {
 			return int8(0), nil
 		}
@@ -185,6 +191,39 @@ func (u IsUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// parseUUID parses a string UUID in one of the formats that MySQL permits: 32 hexadecimal digits, optionally with
+// dashes between the five groups of digits, and optionally in curly braces when there are dashes.
+func parseUUID(str string) (uuid.UUID, error) {
+	switch len(str) {
+	case 32, 36, 38:
+		return uuid.Parse(str)
+	default:
+		return uuid.UUID{}, fmt.Errorf("invalid UUID length: %d", len(str))
+	}
+}
+
+// evalUUIDSwapFlag evaluates the swap flag of UUID_TO_BIN or BIN_TO_UUID, which is false when it's not given or is
+// NULL, and true when it's any other value than 0.
+func evalUUIDSwapFlag(ctx *sql.Context, row sql.Row, swapFlag sql.Expression) (bool, error) {
+	if swapFlag == nil {
+		return false, nil
+	}
+
+	sf, err := swapFlag.Eval(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	if sf == nil {
+		return false, nil
+	}
+
+	sf, _, err = types.Int64.Convert(sf)
+	if err != nil {
+		return false, err
+	}
+	return sf.(int64) != 0, nil
+}
+
 func (u IsUUID) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
@@ -293,44 +332,21 @@ func (ub UUIDToBin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, fmt.Errorf("invalid data format passed to UUID_TO_BIN")
 	}
 
-	parsed, err := uuid.Parse(uuidAsStr)
+	parsed, err := parseUUID(uuidAsStr)
 	if err != nil {
 		return nil, sql.ErrUuidUnableToParse.New(uuidAsStr, err.Error())
 	}
 
-	// If no swap flag is passed we can return uuid's byte format as is.
-	if ub.swapFlag == nil {
-		bt, err := parsed.MarshalBinary()
-		if err != nil {
-			return nil, err
-		}
-		return string(bt), nil
-	}
-
-	sf, err := ub.swapFlag.Eval(ctx, row)
+	swap, err := evalUUIDSwapFlag(ctx, row, ub.swapFlag)
 	if err != nil {
 		return nil, err
 	}
 
-	sf, _, err = types.Int8.Convert(sf)
-	if err != nil {
-		return nil, err
-	}
-
-	// If the swap flag is 0 we can return uuid's byte format as is.
-	if sf == nil || sf.(int8) == 0 {
-		bt, err := parsed.MarshalBinary()
-		if err != nil {
-			return nil, err
-		}
-
-		return string(bt), nil
-	} else if sf.(int8) == 1 {
-		encoding := swapUUIDBytes(parsed)
-		return string(encoding), nil
-	} else {
-		return nil, fmt.Errorf("UUID_TO_BIN received invalid swap flag")
+	// If the swap flag is false we can return uuid's byte format as is.
+	if !swap {
+		return string(parsed[:]), nil
 	}
+	return string(swapUUIDBytes(parsed)), nil
 }
 
 // swapUUIDBytes swaps the time-low and time-high parts (the first and third groups of hexadecimal digits, respectively)
@@ -447,8 +463,8 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// Get the inputted uuid as a string.
-	converted, _, err := types.MustCreateBinary(query.Type_VARBINARY, int64(16)).Convert(str)
+	// Get the inputted uuid as bytes, which must be exactly 16 of them.
+	converted, _, err := types.LongBlob.Convert(str)
 	if err != nil {
 		return nil, err
 	}
@@ -463,36 +479,21 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrUuidUnableToParse.New(asBytes, err.Error())
 	}
 
-	// If no swap flag is passed we can return uuid's string format as is.
-	if bu.swapFlag == nil {
-		return parsed.String(), nil
-	}
-
-	sf, err := bu.swapFlag.Eval(ctx, row)
+	swap, err := evalUUIDSwapFlag(ctx, row, bu.swapFlag)
 	if err != nil {
 		return nil, err
 	}
 
-	sf, _, err = types.Int8.Convert(sf)
-	if err != nil {
-		return nil, err
-	}
-
-	// If the swap flag is 0 we can return uuid's string format as is.
-	if sf.(int8) == 0 {
+	// If the swap flag is false we can return uuid's string format as is.
+	if !swap {
 		return parsed.String(), nil
-	} else if sf.(int8) == 1 {
-		encoding := unswapUUIDBytes(parsed)
-		parsed, err = uuid.FromBytes(encoding)
-
-		if err != nil {
-			return nil, err
-		}
+	}
 
-		return parsed.String(), nil
-	} else {
-		return nil, fmt.Errorf("UUID_TO_BIN received invalid swap flag")
+	parsed, err = uuid.FromBytes(unswapUUIDBytes(parsed))
+	if err != nil {
+		return nil, err
 	}
+	return parsed.String(), nil
 }
 
 // unswapUUIDBytes unswaps the time-low and time-high parts (the third and first groups of hexadecimal digits, respectively)
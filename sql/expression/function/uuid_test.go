@@ -46,6 +46,17 @@ func TestUUID(t *testing.T) {
 	// Use a UUID regex as a sanity check
 	re2 := regexp.MustCompile(`\b[0-9a-f]{8}\b-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-\b[0-9a-f]{12}\b`)
 	require.True(t, re2.MatchString(myUUID))
+
+	// UUIDs are version 1, so that they are ordered by time when their time parts are swapped
+	require.Equal(t, uuid.Version(1), uuid.MustParse(myUUID).Version())
+	swapped, err := NewUUIDToBin(uuidE, expression.NewLiteral(int8(1), types.Int8))
+	require.NoError(t, err)
+	var prev string
+	for i := 0; i < 100; i++ {
+		bin := eval(t, swapped, sql.Row{nil}).(string)
+		require.Less(t, prev, bin)
+		prev = bin
+	}
 }
 
 func TestIsUUID(t *testing.T) {
@@ -63,6 +74,9 @@ func TestIsUUID(t *testing.T) {
 		{"random bool", types.Boolean, false, int8(0)},
 		{"random string", types.LongText, "12345678-dasd-fasdf8", int8(0)},
 		{"swapped uuid", types.LongText, "5678-1234-12345678-1234-567812345678", int8(0)},
+		{"braced uuid without dashes", types.LongText, "{12345678123456781234567812345678}", int8(0)},
+		{"urn uuid", types.LongText, "urn:uuid:12345678-1234-5678-1234-567812345678", int8(0)},
+		{"uuid bytes", types.LongBlob, []byte("12345678-1234-5678-1234-567812345678"), int8(1)},
 	}
 
 	for _, tt := range testCases {
@@ -90,6 +104,9 @@ func TestUUIDToBinValid(t *testing.T) {
 		{"valid uuid; swap=0", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", true, types.Int8, int8(0), "6CCD780CBABA102695645B8C656024DB"},
 		{"valid uuid; swap=nil", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", true, types.Null, nil, "6CCD780CBABA102695645B8C656024DB"},
 		{"valid uuid; swap=1", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", true, types.Int8, int8(1), "1026BABA6CCD780C95645B8C656024DB"},
+		{"valid uuid; swap=2", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", true, types.Int8, int8(2), "1026BABA6CCD780C95645B8C656024DB"},
+		{"valid uuid; swap=true", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", true, types.Boolean, true, "1026BABA6CCD780C95645B8C656024DB"},
+		{"braced uuid; swap=1", types.LongText, "{6ccd780c-baba-1026-9564-5b8c656024db}", true, types.Int8, int8(1), "1026BABA6CCD780C95645B8C656024DB"},
 		{"valid uuid; no swap", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", false, nil, nil, "6CCD780CBABA102695645B8C656024DB"},
 		{"null uuid; no swap", types.Null, nil, false, nil, nil, nil},
 	}
@@ -126,7 +143,8 @@ func TestUUIDToBinFailing(t *testing.T) {
 		swapType  sql.Type
 		swapValue interface{}
 	}{
-		{"bad swap value", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", types.Int8, int8(2)},
+		{"bad swap value", types.LongText, "6ccd780c-baba-1026-9564-5b8c656024db", types.LongText, "swap"},
+		{"urn uuid value", types.LongText, "urn:uuid:6ccd780c-baba-1026-9564-5b8c656024db", types.Int8, int8(0)},
 		{"bad uuid value", types.LongText, "sdasdsad", types.Int8, int8(0)},
 		{"bad uuid value2", types.Int8, int8(0), types.Int8, int8(0)},
 	}
@@ -167,6 +185,8 @@ func TestBinToUUID(t *testing.T) {
 	}{
 		{"valid uuid; swap=0", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, types.Int8, int8(0), "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; swap=1", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("&ººlÍxd[e`$Û"), true, types.Int8, int8(1), "ba6cc38d-bac2-26c2-7864-5b656024c39b"},
+		{"valid uuid; swap=2", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("&ººlÍxd[e`$Û"), true, types.Int8, int8(2), "ba6cc38d-bac2-26c2-7864-5b656024c39b"},
+		{"valid uuid; swap=nil", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, types.Null, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; no swap", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), false, nil, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"null input", types.Null, nil, false, nil, nil, nil},
 	}
@@ -201,6 +221,7 @@ func TestBinToUUIDFailing(t *testing.T) {
 	}{
 		{"bad swap value", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), "helo", types.Int8, int8(2)},
 		{"bad binary value", types.MustCreateBinary(query.Type_VARBINARY, int64(16)), "sdasdsad", types.Int8, int8(0)},
+		{"too long binary value", types.LongBlob, []byte("0123456789abcdefg"), types.Int8, int8(0)},
 		{"bad input value", types.Int8, int8(0), types.Int8, int8(0)},
 	}
 
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -88,7 +89,7 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 			return nil, errInvalidIntervalFormat.New(i.Unit, text)
 		}
 
-		parts := textFormatParts(text, r)
+		parts := textFormatParts(text, r, strings.HasSuffix(i.Unit, "_MICROSECOND"))
 
 		switch i.Unit {
 		case "DAY_HOUR":
@@ -137,6 +138,16 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 		default:
 			return nil, errInvalidIntervalUnit.New(i.Unit)
 		}
+	} else if i.Unit == "SECOND" {
+		// seconds may be fractional, with a microsecond precision
+		val, _, err = types.InternalDecimalType.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+
+		micros := val.(decimal.Decimal).Shift(6).Round(0).IntPart()
+		td.Seconds = micros / 1000000
+		td.Microseconds = micros % 1000000
 	} else {
 		val, _, err = types.Int64.Convert(val)
 		if err != nil {
@@ -152,8 +163,6 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 			td.Hours = num
 		case "MINUTE":
 			td.Minutes = num
-		case "SECOND":
-			td.Seconds = num
 		case "MICROSECOND":
 			td.Microseconds = num
 		case "QUARTER":
@@ -198,10 +207,18 @@ var unitTextFormats = map[string]*regexp.Regexp{
 	"YEAR_MONTH":         regexp.MustCompile(`^(\d+)-(\d+)$`),
 }
 
-func textFormatParts(text string, r *regexp.Regexp) []int64 {
+// textFormatParts returns the numeric parts of the interval text given. If |micros| is true, the last part is the
+// fractional part of the seconds, and is scaled to microseconds.
+func textFormatParts(text string, r *regexp.Regexp, micros bool) []int64 {
 	parts := r.FindStringSubmatch(text)
 	var result []int64
-	for _, p := range parts[1:] {
+	for i, p := range parts[1:] {
+		if micros && i == len(parts)-2 {
+			if len(p) > 6 {
+				p = p[:6]
+			}
+			p += strings.Repeat("0", 6-len(p))
+		}
 		// It is safe to ignore the error here, because at this point we know
 		// the string matches the regexp, and that means it can't be an
 		// invalid number.
@@ -166,6 +166,22 @@ func JoinOr(exprs ...sql.Expression) sql.Expression {
 	}
 }
 
+// SplitDisjunction breaks OR expressions into their left and right parts, recursively
+func SplitDisjunction(expr sql.Expression) []sql.Expression {
+	if expr == nil {
+		return nil
+	}
+	or, ok := expr.(*Or)
+	if !ok {
+		return []sql.Expression{expr}
+	}
+
+	return append(
+		SplitDisjunction(or.Left),
+		SplitDisjunction(or.Right)...,
+	)
+}
+
 func (o *Or) String() string {
 	return fmt.Sprintf("(%s OR %s)", o.Left, o.Right)
 }
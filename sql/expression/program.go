@@ -0,0 +1,354 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Program is a list of expressions compiled so that the subexpressions they have in common are evaluated once per
+// row. The common subexpressions are flattened into the steps of the program, ordered so that each step comes after
+// the steps it references, and their occurrences are replaced by references to the steps. A step is evaluated the
+// first time it's referenced when evaluating a row, and its result is reused afterwards, so conditional expressions
+// like CASE and AND still only evaluate the operands they need.
+type Program struct {
+	// exprs are the expressions the program was compiled from
+	exprs []sql.Expression
+	// outputs are the expressions compiled, with their common subexpressions replaced by step references
+	outputs []sql.Expression
+	steps   []sql.Expression
+}
+
+// programFrame holds the results of the steps of a Program for the row being evaluated. It's appended to the row
+// given to the compiled expressions, where step references find it.
+type programFrame struct {
+	program *Program
+	values  []interface{}
+	done    []bool
+}
+
+// CompileProgram compiles the expressions given into a Program. Returns false if the expressions have no common
+// subexpressions, in which case they're better evaluated as they are. Expressions must be deterministic and must not
+// evaluate nested plans, which is the caller's responsibility to check.
+func CompileProgram(exprs []sql.Expression) (*Program, bool) {
+	counts := make(map[string]int)
+	for _, e := range exprs {
+		countSubexpressions(e, counts)
+	}
+	// subexpressions are only common if they're evaluated more than once, which those only found within the
+	// occurrences of a larger common subexpression aren't
+	evals := make(map[string]int)
+	for _, e := range exprs {
+		countEvaluations(e, counts, evals)
+	}
+	counts = evals
+
+	common := false
+	for _, n := range counts {
+		if n > 1 {
+			common = true
+			break
+		}
+	}
+	if !common {
+		return nil, false
+	}
+
+	p := &Program{exprs: exprs}
+	stepIdx := make(map[string]int)
+	// compile returns the compiled form of |e|, and whether it's different from |e|
+	var compile func(e sql.Expression) (sql.Expression, bool, error)
+	compile = func(e sql.Expression) (sql.Expression, bool, error) {
+		children := e.Children()
+		if len(children) == 0 {
+			return e, false, nil
+		}
+		if canBeStep(e) {
+			if idx, ok := stepIdx[subexpressionKey(e)]; ok {
+				return &programStep{idx: idx, expr: p.steps[idx]}, true, nil
+			}
+		}
+		newChildren := make([]sql.Expression, len(children))
+		changed := false
+		for i, c := range children {
+			nc, childChanged, err := compile(c)
+			if err != nil {
+				return nil, false, err
+			}
+			newChildren[i] = nc
+			changed = changed || childChanged
+		}
+		compiled := e
+		if changed {
+			var err error
+			compiled, err = e.WithChildren(newChildren...)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		if !canBeStep(e) {
+			return compiled, changed, nil
+		}
+		key := subexpressionKey(e)
+		if counts[key] < 2 {
+			return compiled, changed, nil
+		}
+		idx, ok := stepIdx[key]
+		if !ok {
+			idx = len(p.steps)
+			stepIdx[key] = idx
+			p.steps = append(p.steps, compiled)
+		}
+		return &programStep{idx: idx, expr: p.steps[idx]}, true, nil
+	}
+
+	p.outputs = make([]sql.Expression, len(exprs))
+	for i, e := range exprs {
+		compiled, _, err := compile(e)
+		if err != nil {
+			return nil, false
+		}
+		p.outputs[i] = compiled
+	}
+	return p, true
+}
+
+// countSubexpressions counts the occurrences of the subexpressions of |e| that could be steps of a Program.
+func countSubexpressions(e sql.Expression, counts map[string]int) {
+	if canBeStep(e) {
+		counts[subexpressionKey(e)]++
+	}
+	for _, c := range e.Children() {
+		countSubexpressions(c, counts)
+	}
+}
+
+// countEvaluations counts how many times the subexpressions of |e| would be evaluated if those occurring more than
+// once according to |counts| were evaluated once.
+func countEvaluations(e sql.Expression, counts, evals map[string]int) {
+	if canBeStep(e) {
+		key := subexpressionKey(e)
+		evals[key]++
+		if counts[key] > 1 && evals[key] > 1 {
+			return
+		}
+	}
+	for _, c := range e.Children() {
+		countEvaluations(c, counts, evals)
+	}
+}
+
+// canBeStep returns whether the expression given can be replaced by a step reference. Only scalar expressions with
+// children can be, since some expressions depend on the type of their children, like IN on its tuple.
+func canBeStep(e sql.Expression) bool {
+	if len(e.Children()) == 0 {
+		return false
+	}
+	switch e.(type) {
+	case ArithmeticOp, Comparer, sql.FunctionExpression, *Convert, *IsNull, *IsTrue:
+		return true
+	default:
+		return false
+	}
+}
+
+// subexpressionKey returns a key identifying the expression given, equal for equal expressions. Types are included
+// because the description of some expressions, like literals, doesn't tell them apart.
+func subexpressionKey(e sql.Expression) string {
+	var sb strings.Builder
+	writeSubexpressionKey(&sb, e)
+	return sb.String()
+}
+
+func writeSubexpressionKey(sb *strings.Builder, e sql.Expression) {
+	fmt.Fprintf(sb, "%T(%s:%s", e, sql.DebugString(e), e.Type())
+	for _, c := range e.Children() {
+		sb.WriteString(",")
+		writeSubexpressionKey(sb, c)
+	}
+	sb.WriteString(")")
+}
+
+// Len returns the number of steps of this program.
+func (p *Program) Len() int {
+	return len(p.steps)
+}
+
+// Outputs returns expressions evaluating the expressions this program was compiled from, in the same order.
+func (p *Program) Outputs() []sql.Expression {
+	outputs := make([]sql.Expression, len(p.outputs))
+	for i := range p.outputs {
+		outputs[i] = &ProgramOutput{Program: p, Index: i}
+	}
+	return outputs
+}
+
+// WithFrame returns the row given with the space to hold the results of the steps of this program. Outputs of this
+// program evaluated with the row returned share the results of the steps, rather than each evaluating them. The
+// values of the row are unchanged.
+func (p *Program) WithFrame(row sql.Row) sql.Row {
+	r := make(sql.Row, len(row)+1)
+	copy(r, row)
+	r[len(row)] = &programFrame{
+		program: p,
+		values:  make([]interface{}, len(p.steps)),
+		done:    make([]bool, len(p.steps)),
+	}
+	return r
+}
+
+// frameOf returns the frame of this program in the row given, or nil if the row doesn't have one.
+func (p *Program) frameOf(row sql.Row) *programFrame {
+	if len(row) == 0 {
+		return nil
+	}
+	if f, ok := row[len(row)-1].(*programFrame); ok && f.program == p {
+		return f
+	}
+	return nil
+}
+
+// ProgramOutput is one of the expressions of a Program. It evaluates its compiled form, and otherwise acts as the
+// expression it was compiled from, which is its only child.
+type ProgramOutput struct {
+	Program *Program
+	Index   int
+}
+
+var _ sql.Expression = (*ProgramOutput)(nil)
+var _ sql.CollationCoercible = (*ProgramOutput)(nil)
+
+// Unwrap returns the expression this output was compiled from.
+func (o *ProgramOutput) Unwrap() sql.Expression {
+	return o.Program.exprs[o.Index]
+}
+
+// Eval implements sql.Expression
+func (o *ProgramOutput) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if o.Program.frameOf(row) == nil {
+		row = o.Program.WithFrame(row)
+	}
+	return o.Program.outputs[o.Index].Eval(ctx, row)
+}
+
+// Children implements sql.Expression
+func (o *ProgramOutput) Children() []sql.Expression {
+	return []sql.Expression{o.Unwrap()}
+}
+
+// WithChildren implements sql.Expression
+func (o *ProgramOutput) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 1)
+	}
+	p, ok := CompileProgram(children)
+	if !ok {
+		return children[0], nil
+	}
+	return p.Outputs()[0], nil
+}
+
+// Resolved implements sql.Expression
+func (o *ProgramOutput) Resolved() bool {
+	return o.Unwrap().Resolved()
+}
+
+// IsNullable implements sql.Expression
+func (o *ProgramOutput) IsNullable() bool {
+	return o.Unwrap().IsNullable()
+}
+
+// Type implements sql.Expression
+func (o *ProgramOutput) Type() sql.Type {
+	return o.Unwrap().Type()
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (o *ProgramOutput) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, o.Unwrap())
+}
+
+func (o *ProgramOutput) String() string {
+	return o.Unwrap().String()
+}
+
+func (o *ProgramOutput) DebugString() string {
+	return sql.DebugString(o.Unwrap())
+}
+
+// programStep is a reference to a step of a Program, which it evaluates once per row.
+type programStep struct {
+	idx  int
+	expr sql.Expression
+}
+
+var _ sql.Expression = (*programStep)(nil)
+var _ sql.CollationCoercible = (*programStep)(nil)
+
+// Eval implements sql.Expression
+func (s *programStep) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	f := row[len(row)-1].(*programFrame)
+	if f.done[s.idx] {
+		return f.values[s.idx], nil
+	}
+	v, err := s.expr.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	f.values[s.idx] = v
+	f.done[s.idx] = true
+	return v, nil
+}
+
+// Children implements sql.Expression
+func (s *programStep) Children() []sql.Expression {
+	return nil
+}
+
+// WithChildren implements sql.Expression
+func (s *programStep) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// Resolved implements sql.Expression
+func (s *programStep) Resolved() bool {
+	return true
+}
+
+// IsNullable implements sql.Expression
+func (s *programStep) IsNullable() bool {
+	return s.expr.IsNullable()
+}
+
+// Type implements sql.Expression
+func (s *programStep) Type() sql.Type {
+	return s.expr.Type()
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (s *programStep) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, s.expr)
+}
+
+func (s *programStep) String() string {
+	return fmt.Sprintf("step(%d)", s.idx)
+}
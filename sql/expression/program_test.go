@@ -0,0 +1,108 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// countingFunc is a function returning its argument, which counts how many times it's evaluated.
+type countingFunc struct {
+	UnaryExpression
+	evals *int
+}
+
+func (f *countingFunc) FunctionName() string { return "counting" }
+func (f *countingFunc) Description() string  { return "" }
+func (f *countingFunc) Type() sql.Type       { return f.Child.Type() }
+func (f *countingFunc) String() string       { return "counting(" + f.Child.String() + ")" }
+
+func (f *countingFunc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	*f.evals++
+	return f.Child.Eval(ctx, row)
+}
+
+func (f *countingFunc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return &countingFunc{UnaryExpression{children[0]}, f.evals}, nil
+}
+
+func TestProgram(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var evals int
+	a := NewGetField(0, types.Int64, "a", true)
+	counting := func() sql.Expression {
+		return &countingFunc{UnaryExpression{NewPlus(a, NewLiteral(int64(1), types.Int64))}, &evals}
+	}
+
+	// expressions without common subexpressions aren't compiled
+	_, ok := CompileProgram([]sql.Expression{counting(), NewMult(a, a)})
+	require.False(ok)
+
+	exprs := []sql.Expression{
+		counting(),
+		NewMult(counting(), NewLiteral(int64(2), types.Int64)),
+		NewGreaterThan(counting(), NewLiteral(int64(3), types.Int64)),
+	}
+	p, ok := CompileProgram(exprs)
+	require.True(ok)
+	require.Equal(1, p.Len())
+
+	outputs := p.Outputs()
+	for i, o := range outputs {
+		require.Equal(exprs[i].String(), o.String())
+		require.Equal(exprs[i].Type(), o.Type())
+	}
+
+	// the common subexpression is evaluated once for the outputs evaluated with the same frame
+	row := p.WithFrame(sql.NewRow(int64(2)))
+	var results []interface{}
+	for _, o := range outputs {
+		v, err := o.Eval(ctx, row)
+		require.NoError(err)
+		results = append(results, v)
+	}
+	require.Equal([]interface{}{int64(3), int64(6), false}, results)
+	require.Equal(1, evals)
+
+	// and once per output otherwise
+	evals = 0
+	v, err := outputs[1].Eval(ctx, sql.NewRow(int64(4)))
+	require.NoError(err)
+	require.Equal(int64(10), v)
+	require.Equal(1, evals)
+
+	// conditional expressions only evaluate the steps they need
+	evals = 0
+	p, ok = CompileProgram([]sql.Expression{
+		NewAnd(NewGreaterThan(a, NewLiteral(int64(5), types.Int64)), NewGreaterThan(counting(), counting())),
+	})
+	require.True(ok)
+	v, err = p.Outputs()[0].Eval(ctx, sql.NewRow(int64(1)))
+	require.NoError(err)
+	require.Equal(false, v)
+	require.Equal(0, evals)
+
+	// replacing the child of an output compiles it again
+	o, err := outputs[1].WithChildren(NewMult(a, NewLiteral(int64(2), types.Int64)))
+	require.NoError(err)
+	require.IsType(&Arithmetic{}, o)
+}
@@ -72,7 +72,13 @@ func (s *SetField) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 	if val != nil {
-		convertedVal, _, err := getField.fieldType.Convert(val)
+		convertedVal, inRange, err := getField.fieldType.Convert(val)
+		if err == nil && !inRange {
+			err = sql.ErrValueOutOfRange.New(val, getField.fieldType)
+		}
+		if err == nil && types.IsDisallowedZeroDate(ctx, convertedVal) {
+			err = types.ErrConvertingToTime.New(val)
+		}
 		if err != nil {
 			// Fill in error with information
 			if types.ErrLengthBeyondLimit.Is(err) {
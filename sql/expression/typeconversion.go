@@ -0,0 +1,84 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// TypeConversion converts the values of its child to a type aggregated from the child's type and others, such as the
+// type of a UNION column. Unlike Convert, which casts to one of the types of the CAST function, the type converted to
+// is exactly the one given.
+type TypeConversion struct {
+	UnaryExpression
+	typ sql.Type
+}
+
+var _ sql.Expression = (*TypeConversion)(nil)
+var _ sql.CollationCoercible = (*TypeConversion)(nil)
+
+// NewTypeConversion creates a new TypeConversion expression converting the values of |expr| to |typ|.
+func NewTypeConversion(expr sql.Expression, typ sql.Type) *TypeConversion {
+	return &TypeConversion{
+		UnaryExpression: UnaryExpression{Child: expr},
+		typ:             typ,
+	}
+}
+
+// Type implements the Expression interface.
+func (c *TypeConversion) Type() sql.Type {
+	return c.typ
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (c *TypeConversion) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return c.typ.CollationCoercibility(ctx)
+}
+
+// String implements the Stringer interface.
+func (c *TypeConversion) String() string {
+	return fmt.Sprintf("convert(%v, %v)", c.Child, c.typ)
+}
+
+// DebugString implements the Expression interface.
+func (c *TypeConversion) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("convert")
+	_ = pr.WriteChildren(
+		fmt.Sprintf("type: %v", c.typ),
+		sql.DebugString(c.Child),
+	)
+	return pr.String()
+}
+
+// WithChildren implements the Expression interface.
+func (c *TypeConversion) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewTypeConversion(children[0], c.typ), nil
+}
+
+// Eval implements the Expression interface.
+func (c *TypeConversion) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return types.ConvertToAggregatedType(val, c.Child.Type(), c.typ)
+}
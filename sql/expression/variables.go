@@ -166,3 +166,73 @@ func (v *UserVar) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	}
 	return v, nil
 }
+
+// UserVarAssignment is an expression that assigns the value of its child to a user variable and returns it, written
+// @var := expr. The variable takes the type of the value, keeping the collation of strings. Like in MySQL, the
+// assignments of a row are made as its expressions are evaluated, so that the expressions evaluated after an
+// assignment read its value.
+type UserVarAssignment struct {
+	UnaryExpression
+	Name string
+}
+
+var _ sql.Expression = (*UserVarAssignment)(nil)
+var _ sql.CollationCoercible = (*UserVarAssignment)(nil)
+var _ sql.NonDeterministicExpression = (*UserVarAssignment)(nil)
+
+// NewUserVarAssignment creates a UserVarAssignment of the user variable named to the expression given.
+func NewUserVarAssignment(name string, expr sql.Expression) *UserVarAssignment {
+	return &UserVarAssignment{UnaryExpression: UnaryExpression{Child: expr}, Name: name}
+}
+
+// Eval implements the sql.Expression interface.
+func (a *UserVarAssignment) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.SetUserVariable(ctx, a.Name, val, types.UserVariableType(a.Child.Type(), val)); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// Type implements the sql.Expression interface.
+func (a *UserVarAssignment) Type() sql.Type {
+	return a.Child.Type()
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (a *UserVarAssignment) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	collation, _ = sql.GetCoercibility(ctx, a.Child)
+	return collation, 2
+}
+
+// IsNullable implements the sql.Expression interface.
+func (a *UserVarAssignment) IsNullable() bool {
+	return a.Child.IsNullable()
+}
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface. An assignment must be evaluated for
+// every row, even when its child is constant.
+func (a *UserVarAssignment) IsNonDeterministic() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (a *UserVarAssignment) String() string {
+	return fmt.Sprintf("@%s := %s", a.Name, a.Child)
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (a *UserVarAssignment) DebugString() string {
+	return fmt.Sprintf("@%s := %s", a.Name, sql.DebugString(a.Child))
+}
+
+// WithChildren implements the Expression interface.
+func (a *UserVarAssignment) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewUserVarAssignment(a.Name, children[0]), nil
+}
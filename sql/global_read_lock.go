@@ -0,0 +1,93 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+	"time"
+)
+
+// GlobalReadLock is the global read lock acquired by FLUSH TABLES WITH READ LOCK, which blocks the writes of all
+// sessions so that a consistent backup of the databases can be taken. Several sessions may hold it at once, and it's
+// released once every holder runs UNLOCK TABLES or closes.
+type GlobalReadLock struct {
+	mu      sync.Mutex
+	holders map[uint32]struct{}
+	// released is closed when the last holder releases the lock
+	released chan struct{}
+}
+
+// NewGlobalReadLock returns a new GlobalReadLock that isn't held by any session.
+func NewGlobalReadLock() *GlobalReadLock {
+	return &GlobalReadLock{holders: make(map[uint32]struct{})}
+}
+
+// Acquire acquires the lock for the session with the id given.
+func (l *GlobalReadLock) Acquire(id uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.holders) == 0 {
+		l.released = make(chan struct{})
+	}
+	l.holders[id] = struct{}{}
+}
+
+// Release releases the lock held by the session with the id given, if any.
+func (l *GlobalReadLock) Release(id uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.holders[id]; !ok {
+		return
+	}
+	delete(l.holders, id)
+	if len(l.holders) == 0 {
+		close(l.released)
+	}
+}
+
+// IsHeld returns whether any session holds the lock.
+func (l *GlobalReadLock) IsHeld() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.holders) > 0
+}
+
+// WaitForRelease waits until no session holds the lock, so that the session of the context given may write. Returns
+// ErrCantUpdateWithReadLock if that session holds the lock itself, and ErrLockWaitTimeout if the lock isn't released
+// within the timeout given.
+func (l *GlobalReadLock) WaitForRelease(ctx *Context, timeout time.Duration) error {
+	l.mu.Lock()
+	if _, ok := l.holders[ctx.ID()]; ok {
+		l.mu.Unlock()
+		return ErrCantUpdateWithReadLock.New()
+	}
+	if len(l.holders) == 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	released := l.released
+	l.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-released:
+		return nil
+	case <-timer.C:
+		return ErrLockWaitTimeout.New()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
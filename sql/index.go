@@ -16,12 +16,15 @@ package sql
 
 import "fmt"
 
+// IndexDef is the definition of an index given to integrators by CREATE TABLE, ALTER TABLE and CREATE INDEX
+// statements.
 type IndexDef struct {
 	Name       string
 	Columns    []IndexColumn
 	Constraint IndexConstraint
 	Storage    IndexUsing
-	Comment    string
+	// Comment is the comment of the index, which should be returned by the Comment method of the index created.
+	Comment string
 }
 
 // IndexColumn is the column by which to add to an index.
@@ -100,6 +103,24 @@ type ExtendedIndex interface {
 	ExtendedColumnExpressionTypes() []ColumnExpressionType
 }
 
+// VisibleIndex is an extension of Index for indexes that can be made invisible with the INVISIBLE index option. An
+// invisible index is still maintained, but isn't used by the optimizer. Indexes that don't implement this interface
+// are always visible.
+type VisibleIndex interface {
+	Index
+	// IsVisible returns whether this index is visible.
+	IsVisible() bool
+}
+
+// FunctionalIndex is an extension of Index for indexes with functional key parts, which index the values of
+// expressions rather than columns.
+type FunctionalIndex interface {
+	Index
+	// ExpressionKeyParts returns, for each of the expressions returned by Expressions, the SQL string of the
+	// expression its key part indexes, or an empty string if the key part indexes a column.
+	ExpressionKeyParts() []string
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup. The IndexLookup must contain all necessary
 // information to retrieve exactly the rows in the table as specified by the ranges given to their parent index.
 // Implementors are responsible for all semantics of correctly returning rows that match an index lookup.
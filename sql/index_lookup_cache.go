@@ -0,0 +1,209 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"container/list"
+	"io"
+	"sync"
+)
+
+// defaultIndexLookupCacheRowLimit is the maximum number of rows of the index lookups cached when no limit is given.
+const defaultIndexLookupCacheRowLimit = 100
+
+// IndexLookupCache caches the rows returned by index lookups of read-only queries, so that repeated lookups of the
+// same ranges, such as the point lookups of the inner side of a lookup join, don't read the table again. It has two
+// tiers, each evicting its least recently used lookups first: a shared tier, kept across queries, for the lookups
+// whose key includes the data version of the table read, and a tier for the lookups of a single query, created with
+// ForQuery.
+type IndexLookupCache struct {
+	shared *indexLookupLRU
+	query  *indexLookupLRU
+}
+
+// indexLookupLRU is one tier of an IndexLookupCache.
+type indexLookupLRU struct {
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	size     int
+	rowLimit int
+}
+
+// indexLookupCacheEntry is the rows of an index lookup stored in an indexLookupLRU.
+type indexLookupCacheEntry struct {
+	key  string
+	rows []Row
+}
+
+// NewIndexLookupCache returns a new IndexLookupCache whose tiers hold the rows of at most |size| lookups each.
+// Lookups returning more than |rowLimit| rows aren't cached.
+func NewIndexLookupCache(size, rowLimit int) *IndexLookupCache {
+	if rowLimit <= 0 {
+		rowLimit = defaultIndexLookupCacheRowLimit
+	}
+	return &IndexLookupCache{shared: newIndexLookupLRU(size, rowLimit)}
+}
+
+func newIndexLookupLRU(size, rowLimit int) *indexLookupLRU {
+	return &indexLookupLRU{
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		size:     size,
+		rowLimit: rowLimit,
+	}
+}
+
+// ForQuery returns a cache for a single query, which shares the shared tier of this cache and has its own empty
+// per-query tier. Returns nil on a nil cache.
+func (c *IndexLookupCache) ForQuery() *IndexLookupCache {
+	if c == nil {
+		return nil
+	}
+	return &IndexLookupCache{
+		shared: c.shared,
+		query:  newIndexLookupLRU(c.shared.size, c.shared.rowLimit),
+	}
+}
+
+// Len returns the number of lookups in the shared tier of this cache.
+func (c *IndexLookupCache) Len() int {
+	return c.shared.len()
+}
+
+// Clear removes all the lookups from this cache.
+func (c *IndexLookupCache) Clear() {
+	c.shared.clear()
+	if c.query != nil {
+		c.query.clear()
+	}
+}
+
+// Iter returns an iterator of the rows of the lookup with the |key| given. When the lookup is in the cache, its rows
+// are returned from there. Otherwise the iterator returned by |build| is used, and the rows it returns are cached
+// once it's exhausted. The lookup is looked up in the shared tier if |shared| is true, and in the per-query tier
+// otherwise, in which case the cache must have been returned by ForQuery.
+func (c *IndexLookupCache) Iter(key string, shared bool, build func() (RowIter, error)) (RowIter, error) {
+	tier := c.query
+	if shared {
+		tier = c.shared
+	}
+	if tier == nil {
+		return build()
+	}
+
+	if rows, ok := tier.get(key); ok {
+		return RowsToRowIter(copyRows(rows)...), nil
+	}
+
+	iter, err := build()
+	if err != nil {
+		return nil, err
+	}
+	return &indexLookupCacheIter{iter: iter, tier: tier, key: key}, nil
+}
+
+func (t *indexLookupLRU) len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lru.Len()
+}
+
+func (t *indexLookupLRU) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = make(map[string]*list.Element)
+	t.lru.Init()
+}
+
+func (t *indexLookupLRU) get(key string) ([]Row, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	elem, ok := t.entries[key]
+	if !ok {
+		return nil, false
+	}
+	t.lru.MoveToFront(elem)
+	return elem.Value.(*indexLookupCacheEntry).rows, true
+}
+
+func (t *indexLookupLRU) put(key string, rows []Row) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if elem, ok := t.entries[key]; ok {
+		t.lru.Remove(elem)
+		delete(t.entries, key)
+	}
+	for t.lru.Len() >= t.size && t.lru.Len() > 0 {
+		elem := t.lru.Back()
+		t.lru.Remove(elem)
+		delete(t.entries, elem.Value.(*indexLookupCacheEntry).key)
+	}
+	if t.size > 0 {
+		t.entries[key] = t.lru.PushFront(&indexLookupCacheEntry{key: key, rows: rows})
+	}
+}
+
+// indexLookupCacheIter returns the rows of an index lookup, and caches them once they've all been returned.
+type indexLookupCacheIter struct {
+	iter     RowIter
+	tier     *indexLookupLRU
+	key      string
+	rows     []Row
+	tooLarge bool
+}
+
+var _ RowIter = (*indexLookupCacheIter)(nil)
+
+// Next implements the RowIter interface.
+func (i *indexLookupCacheIter) Next(ctx *Context) (Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err == io.EOF {
+		if !i.tooLarge {
+			i.tier.put(i.key, i.rows)
+		}
+		i.rows = nil
+		i.tooLarge = true
+		return nil, err
+	}
+	if err != nil {
+		i.rows = nil
+		i.tooLarge = true
+		return nil, err
+	}
+
+	if !i.tooLarge {
+		if len(i.rows) >= i.tier.rowLimit {
+			i.rows = nil
+			i.tooLarge = true
+		} else {
+			i.rows = append(i.rows, row.Copy())
+		}
+	}
+	return row, nil
+}
+
+// Close implements the RowIter interface.
+func (i *indexLookupCacheIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
+
+func copyRows(rows []Row) []Row {
+	copied := make([]Row, len(rows))
+	for i, row := range rows {
+		copied[i] = row.Copy()
+	}
+	return copied
+}
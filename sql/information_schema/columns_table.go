@@ -25,8 +25,10 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -212,14 +214,16 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, dbName,
 		datetimePrecision = 6
 	}
 
-	columnDefault := getColumnDefault(ctx, col.Default)
-
-	extra := col.Extra
-	// If extra is not defined, fill it here.
-	if extra == "" && !col.Default.IsLiteral() {
-		extra = "DEFAULT_GENERATED"
+	var columnDefault interface{}
+	var generationExpression string
+	if col.Generated != nil {
+		generationExpression = formatColumnExpression(col.Generated.Expression)
+	} else {
+		columnDefault = getColumnDefault(ctx, col.Default)
 	}
 
+	extra := getColumnExtra(col)
+
 	var curColPrivStr []string
 	for p := range privSetMap {
 		curColPrivStr = append(curColPrivStr, p)
@@ -237,28 +241,28 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, dbName,
 	privileges := strings.Join(curColPrivStr, ",")
 
 	return sql.Row{
-		"def",             // table_catalog
-		dbName,            // table_schema
-		tblName,           // table_name
-		col.Name,          // column_name
-		ordinalPos,        // ordinal_position
-		columnDefault,     // column_default
-		nullable,          // is_nullable
-		dataType,          // data_type
-		charMaxLen,        // character_maximum_length
-		charOctetLen,      // character_octet_length
-		numericPrecision,  // numeric_precision
-		numericScale,      // numeric_scale
-		datetimePrecision, // datetime_precision
-		charName,          // character_set_name
-		collName,          // collation_name
-		colType,           // column_type
-		columnKey,         // column_key
-		extra,             // extra
-		privileges,        // privileges
-		col.Comment,       // column_comment
-		"",                // generation_expression
-		srsId,             // srs_id
+		"def",                // table_catalog
+		dbName,               // table_schema
+		tblName,              // table_name
+		col.Name,             // column_name
+		ordinalPos,           // ordinal_position
+		columnDefault,        // column_default
+		nullable,             // is_nullable
+		dataType,             // data_type
+		charMaxLen,           // character_maximum_length
+		charOctetLen,         // character_octet_length
+		numericPrecision,     // numeric_precision
+		numericScale,         // numeric_scale
+		datetimePrecision,    // datetime_precision
+		charName,             // character_set_name
+		collName,             // collation_name
+		colType,              // column_type
+		columnKey,            // column_key
+		extra,                // extra
+		privileges,           // privileges
+		col.Comment,          // column_comment
+		generationExpression, // generation_expression
+		srsId,                // srs_id
 	}
 }
 
@@ -274,8 +278,17 @@ func getRowsFromTable(ctx *sql.Context, db sql.Database, t sql.Table, privSetDb
 		return nil, err
 	}
 
+	showGIPK, err := ctx.GetSessionVariable(ctx, "show_gipk_in_create_table_and_information_schema")
+	if err != nil {
+		return nil, err
+	}
+
 	tblName := t.Name()
 	for i, col := range schemaForTable(t, db, allColsWithDefaultValue) {
+		if col.IsGeneratedInvisiblePrimaryKey() && showGIPK.(int8) == 0 {
+			continue
+		}
+
 		var columnKey string
 		// Check column PK here first because there are PKs from table implementations that don't implement sql.IndexedTable
 		if col.PrimaryKey {
@@ -440,9 +453,9 @@ func getColumnDefault(ctx *sql.Context, cd *sql.ColumnDefaultValue) interface{}
 		}
 		if types.IsTime(cd.Type()) && (strings.HasPrefix(defStr, "NOW") || strings.HasPrefix(defStr, "CURRENT_TIMESTAMP")) {
 			defStr = strings.Replace(defStr, "NOW", "CURRENT_TIMESTAMP", -1)
-			defStr = strings.TrimSuffix(defStr, "()")
+			return strings.TrimSuffix(defStr, "()")
 		}
-		return fmt.Sprint(defStr)
+		return formatColumnExpression(cd.Expression)
 	}
 
 	if types.IsEnum(cd.Type()) || types.IsSet(cd.Type()) {
@@ -456,7 +469,17 @@ func getColumnDefault(ctx *sql.Context, cd *sql.ColumnDefaultValue) interface{}
 
 	switch l := v.(type) {
 	case time.Time:
-		v = l.Format("2006-01-02 15:04:05")
+		// dates are printed without a time, and datetimes with their fractional seconds
+		if sv, err := cd.Type().SQL(ctx, nil, l); err == nil {
+			v = sv.ToString()
+		} else {
+			v = l.Format("2006-01-02 15:04:05")
+		}
+	case decimal.Decimal:
+		// decimals are printed with the scale of the column
+		if dt, ok := cd.Type().(sql.DecimalType); ok {
+			v = l.StringFixed(int32(dt.Scale()))
+		}
 	case []uint8:
 		hexStr := hex.EncodeToString(l)
 		v = fmt.Sprintf("0x%s", hexStr)
@@ -472,6 +495,47 @@ func getColumnDefault(ctx *sql.Context, cd *sql.ColumnDefaultValue) interface{}
 	return fmt.Sprint(v)
 }
 
+// getColumnExtra returns the EXTRA value of the column given, made of the clauses of its definition that aren't
+// reported by other columns of the table, in the order MySQL prints them.
+func getColumnExtra(col *sql.Column) string {
+	var extra []string
+	if col.Extra != "" {
+		extra = append(extra, col.Extra)
+	}
+	if col.Generated != nil {
+		if col.Virtual {
+			extra = append(extra, "VIRTUAL GENERATED")
+		} else {
+			extra = append(extra, "STORED GENERATED")
+		}
+	} else if col.Extra == "" && !col.Default.IsLiteral() {
+		extra = append(extra, "DEFAULT_GENERATED")
+	}
+	if col.Invisible && !strings.Contains(col.Extra, "INVISIBLE") {
+		extra = append(extra, "INVISIBLE")
+	}
+	return strings.Join(extra, " ")
+}
+
+// formatColumnExpression returns the SQL text of the default value or generation expression given, as MySQL prints
+// it: with quoted identifiers and without the name of the table they belong to.
+func formatColumnExpression(e sql.Expression) string {
+	quoted, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			return expression.NewUnresolvedColumn(sql.QuoteIdentifier(e.Name())), transform.NewTree, nil
+		case *expression.UnresolvedColumn:
+			return expression.NewUnresolvedColumn(sql.QuoteIdentifier(e.Name())), transform.NewTree, nil
+		default:
+			return e, transform.SameTree, nil
+		}
+	})
+	if err != nil {
+		return e.String()
+	}
+	return quoted.String()
+}
+
 func schemaForTable(t sql.Table, db sql.Database, allColsWithDefaultValue sql.Schema) sql.Schema {
 	start, end := -1, -1
 	tableName := strings.ToLower(t.Name())
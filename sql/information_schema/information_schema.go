@@ -62,6 +62,8 @@ const (
 	EventsTableName = "events"
 	// FilesTableName is the name of the FILES table.
 	FilesTableName = "files"
+	// GlobalStatusTableName is the name of the GLOBAL_STATUS table.
+	GlobalStatusTableName = "global_status"
 	// KeyColumnUsageTableName is the name of the KEY_COLUMN_USAGE table.
 	KeyColumnUsageTableName = "key_column_usage"
 	// KeywordsTableName is the name of the KEYWORDS table.
@@ -96,6 +98,8 @@ const (
 	SchemataTableName = "schemata"
 	// SchemataExtensionsTableName is the name of the SCHEMATA_EXTENSIONS table.
 	SchemataExtensionsTableName = "schemata_extensions"
+	// SessionStatusTableName is the name of the SESSION_STATUS table.
+	SessionStatusTableName = "session_status"
 	// StGeometryColumnsTableName is the name of the ST_GEOMETRY_COLUMNS table.
 	StGeometryColumnsTableName = "st_geometry_columns"
 	// StSpatialReferenceSystemsTableName is the name of the ST_SPATIAL_REFERENCE_SYSTEMS table.
@@ -376,6 +380,11 @@ var keyColumnUsageSchema = Schema{
 	{Name: "REFERENCED_COLUMN_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: KeyColumnUsageTableName},
 }
 
+var globalStatusSchema = Schema{
+	{Name: "VARIABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: GlobalStatusTableName},
+	{Name: "VARIABLE_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: GlobalStatusTableName},
+}
+
 var keywordsSchema = Schema{
 	{Name: "WORD", Type: types.MustCreateString(sqltypes.VarChar, 128, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: KeywordsTableName},
 	{Name: "RESERVED", Type: types.Int32, Default: nil, Nullable: true, Source: KeywordsTableName},
@@ -592,6 +601,7 @@ var schemataSchema = Schema{
 	{Name: "DEFAULT_COLLATION_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SchemataTableName},
 	{Name: "SQL_PATH", Type: types.MustCreateBinary(sqltypes.Binary, 0), Default: nil, Nullable: true, Source: SchemataTableName},
 	{Name: "DEFAULT_ENCRYPTION", Type: types.MustCreateEnumType([]string{"NO", "YES"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SchemataTableName},
+	{Name: "SCHEMA_COMMENT", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SchemataTableName},
 }
 
 var schemataExtensionsSchema = Schema{
@@ -600,6 +610,11 @@ var schemataExtensionsSchema = Schema{
 	{Name: "OPTIONS", Type: types.MustCreateString(sqltypes.VarChar, 256, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SchemataExtensionsTableName},
 }
 
+var sessionStatusSchema = Schema{
+	{Name: "VARIABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SessionStatusTableName},
+	{Name: "VARIABLE_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SessionStatusTableName},
+}
+
 var stGeometryColumnsSchema = Schema{
 	{Name: "TABLE_CATALOG", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: StGeometryColumnsTableName},
 	{Name: "TABLE_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: StGeometryColumnsTableName},
@@ -1045,6 +1060,11 @@ func keyColumnUsageRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// globalStatusRowIter implements the sql.RowIter for the information_schema.GLOBAL_STATUS table.
+func globalStatusRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return statusRowIter(ctx, true), nil
+}
+
 // keywordsRowIter implements the sql.RowIter for the information_schema.KEYWORDS table.
 func keywordsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
@@ -1093,6 +1113,23 @@ func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// sessionStatusRowIter implements the sql.RowIter for the information_schema.SESSION_STATUS table.
+func sessionStatusRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return statusRowIter(ctx, false), nil
+}
+
+// statusRowIter returns the rows of the GLOBAL_STATUS table if |global| is true, or the SESSION_STATUS table
+// otherwise. Values are shown as strings, as they are in MySQL.
+func statusRowIter(ctx *Context, global bool) RowIter {
+	rows := StatusVariableRows(ctx, global)
+	for _, row := range rows {
+		if row[1] != nil {
+			row[1] = fmt.Sprint(row[1])
+		}
+	}
+	return RowsToRowIter(rows...)
+}
+
 // referentialConstraintsRowIter implements the sql.RowIter for the information_schema.REFERENTIAL_CONSTRAINTS table.
 func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
@@ -1134,8 +1171,9 @@ func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 						onDelete = "NO ACTION"
 					}
 
+					// The referenced table of a foreign key added with foreign_key_checks disabled may not exist
 					refTbl, _, rerr := c.Table(ctx, referencedSchema, referencedTableName)
-					if rerr != nil {
+					if rerr != nil && !ErrTableNotFound.Is(rerr) && !ErrDatabaseNotFound.Is(rerr) {
 						return nil, rerr
 					}
 
@@ -1247,6 +1285,10 @@ func schemataRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range dbs {
 		collation := plan.GetDatabaseCollation(ctx, db)
+		var comment string
+		if cdb, ok := db.(CommentedDatabase); ok {
+			comment = cdb.GetComment(ctx)
+		}
 		rows = append(rows, Row{
 			"def",                             // catalog_name
 			db.Name(),                         // schema_name
@@ -1254,6 +1296,7 @@ func schemataRowIter(ctx *Context, c Catalog) (RowIter, error) {
 			collation.String(),                // default_collation_name
 			nil,                               // sql_path
 			"NO",                              // default_encryption
+			comment,                           // schema_comment
 		})
 	}
 
@@ -1363,6 +1406,11 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	dbs := c.AllDatabases(ctx)
 
+	stats, err := c.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, db := range dbs {
 		tableNames, tErr := db.GetTableNames(ctx)
 		if tErr != nil {
@@ -1382,6 +1430,19 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					return nil, iErr
 				}
 
+				var rowCount uint64
+				var hist HistogramMap
+				if stats != nil {
+					rowCount, _, err = stats.RowCount(ctx, db.Name(), tbl.Name())
+					if err != nil {
+						return nil, err
+					}
+					// tables that weren't analyzed have no histograms
+					if hist, err = stats.Hist(ctx, db.Name(), tbl.Name()); err != nil {
+						hist = nil
+					}
+				}
+
 				for _, index := range indexes {
 					var (
 						nonUnique    int
@@ -1401,9 +1462,17 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					// setting `VISIBLE` is not supported, so defaulting it to "YES"
 					isVisible = "YES"
 
+					exprs := index.Expressions()
+					columns := make([]string, len(exprs))
+					for j, expr := range exprs {
+						if col := plan.GetColumnFromIndexExpr(expr, tbl); col != nil {
+							columns[j] = col.Name
+						}
+					}
+
 					// Create a Row for each column this index refers too.
 					i := 0
-					for j, expr := range index.Expressions() {
+					for j, expr := range exprs {
 						col := plan.GetColumnFromIndexExpr(expr, tbl)
 						if col != nil {
 							i += 1
@@ -1420,7 +1489,8 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
 							collation = "A"
 
-							// TODO : cardinality is an estimate of the number of unique values in the index.
+							// cardinality is an estimate of the number of unique values in the index
+							cardinality = IndexCardinality(hist, rowCount, columns, j+1, index.IsUnique())
 
 							if j < len(index.PrefixLengths()) {
 								subPart = int64(index.PrefixLengths()[j])
@@ -1660,9 +1730,14 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	)
 
 	for _, db := range cat.AllDatabases(ctx) {
-		if db.Name() == InformationSchemaDatabaseName {
+		switch db.Name() {
+		case InformationSchemaDatabaseName:
 			tableType = "SYSTEM VIEW"
-		} else {
+		case PerformanceSchemaDatabaseName:
+			tableType = "BASE TABLE"
+			engine = "PERFORMANCE_SCHEMA"
+			rowFormat = "Dynamic"
+		default:
 			tableType = "BASE TABLE"
 			engine = "InnoDB"
 			rowFormat = "Dynamic"
@@ -1671,6 +1746,17 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		y2k, _, _ := types.Timestamp.Convert("2000-01-01 00:00:00")
 		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
 			tableCollation = t.Collation().String()
+			tableEngine, tableRowFormat, tableComment := engine, rowFormat, ""
+			if ot, ok := t.(TableOptionsTable); ok {
+				options := ot.TableOptions()
+				tableComment = options[TableOptionComment]
+				if e := options[TableOptionEngine]; e != "" {
+					tableEngine = e
+				}
+				if f := options[TableOptionRowFormat]; f != "" && f != "DEFAULT" {
+					tableRowFormat = f[:1] + strings.ToLower(f[1:])
+				}
+			}
 			if db.Name() != InformationSchemaDatabaseName {
 				if st, ok := t.(StatisticsTable); ok {
 					tableRows, err = st.RowCount(ctx)
@@ -1712,9 +1798,9 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				db.Name(),      // table_schema
 				t.Name(),       // table_name
 				tableType,      // table_type
-				engine,         // engine
+				tableEngine,    // engine
 				10,             // version (protocol, always 10)
-				rowFormat,      // row_format
+				tableRowFormat, // row_format
 				tableRows,      // table_rows
 				avgRowLength,   // avg_row_length
 				dataLength,     // data_length
@@ -1728,7 +1814,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				tableCollation, // table_collation
 				nil,            // checksum
 				"",             // create_options
-				"",             // table_comment
+				tableComment,   // table_comment
 			})
 
 			return true, nil
@@ -1845,6 +1931,10 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					return nil, ErrTriggerCreateStatementInvalid.New(trigger.CreateStatement)
 				}
 				triggerPlan.CreatedAt = trigger.CreatedAt // Keep stored created time
+				// a statement without a DEFINER clause is parsed with the user of the session as the definer
+				if trigger.Definer != "" {
+					triggerPlan.Definer = trigger.Definer
+				}
 				triggerPlans = append(triggerPlans, triggerPlan)
 			}
 
@@ -1876,13 +1966,16 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 				}
 			}
 
-			// These are grouped as such just to use the index as the action order. No special importance on the arrangement,
-			// or the fact that these are slices in a larger slice rather than separate counts.
+			// These are grouped as such to count the action order of the triggers of each table. No special importance on
+			// the arrangement, or the fact that these are slices in a larger slice rather than separate counts.
 			for _, planGroup := range [][]*plan.CreateTrigger{beforeDelete, beforeInsert, beforeUpdate, afterDelete, afterInsert, afterUpdate} {
-				for order, triggerPlan := range planGroup {
+				tableOrders := make(map[string]int)
+				for _, triggerPlan := range planGroup {
 					triggerEvent := strings.ToUpper(triggerPlan.TriggerEvent)
 					triggerTime := strings.ToUpper(triggerPlan.TriggerTime)
 					tableName := triggerPlan.Table.(*plan.UnresolvedTable).Name()
+					tableOrders[strings.ToLower(tableName)]++
+					order := tableOrders[strings.ToLower(tableName)]
 					definer := removeBackticks(triggerPlan.Definer)
 
 					// triggers cannot be created on table that is not in current schema, so the trigger_name = event_object_schema
@@ -1898,7 +1991,7 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							"def",                   // event_object_catalog
 							triggerDb.Name(),        // event_object_schema
 							tableName,               // event_object_table
-							int64(order + 1),        // action_order
+							int64(order),            // action_order
 							nil,                     // action_condition
 							triggerPlan.BodyString,  // action_statement
 							"ROW",                   // action_orientation
@@ -2204,6 +2297,16 @@ func NewInformationSchemaDatabase() Database {
 				schema: pluginsSchema,
 				reader: emptyRowIter,
 			},
+			GlobalStatusTableName: &informationSchemaTable{
+				name:   GlobalStatusTableName,
+				schema: globalStatusSchema,
+				reader: globalStatusRowIter,
+			},
+			SessionStatusTableName: &informationSchemaTable{
+				name:   SessionStatusTableName,
+				schema: sessionStatusSchema,
+				reader: sessionStatusRowIter,
+			},
 			ProcessListTableName: &informationSchemaTable{
 				name:   ProcessListTableName,
 				schema: processListSchema,
@@ -2507,7 +2610,7 @@ func (db *informationSchemaDatabase) Name() string { return db.name }
 
 func (db *informationSchemaDatabase) GetTableInsensitive(ctx *Context, tblName string) (Table, bool, error) {
 	// The columns table has dynamic information that can't be cached across queries
-	if strings.ToLower(tblName) == ColumnsTableName {
+	if db.name == InformationSchemaDatabaseName && strings.ToLower(tblName) == ColumnsTableName {
 		return &ColumnsTable{}, true, nil
 	}
 
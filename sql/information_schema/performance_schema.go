@@ -0,0 +1,105 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"sort"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// SessionConnectAttrsTableName is the name of the SESSION_CONNECT_ATTRS table.
+	SessionConnectAttrsTableName = "session_connect_attrs"
+	// SessionAccountConnectAttrsTableName is the name of the SESSION_ACCOUNT_CONNECT_ATTRS table.
+	SessionAccountConnectAttrsTableName = "session_account_connect_attrs"
+)
+
+var sessionConnectAttrsSchema = connectAttrsSchema(SessionConnectAttrsTableName)
+
+var sessionAccountConnectAttrsSchema = connectAttrsSchema(SessionAccountConnectAttrsTableName)
+
+func connectAttrsSchema(tableName string) Schema {
+	return Schema{
+		{Name: "PROCESSLIST_ID", Type: types.Uint32, Default: nil, Nullable: false, Source: tableName},
+		{Name: "ATTR_NAME", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: tableName},
+		{Name: "ATTR_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: tableName},
+		{Name: "ORDINAL_POSITION", Type: types.Int32, Default: nil, Nullable: true, Source: tableName},
+	}
+}
+
+// NewPerformanceSchemaDatabase creates a new performance_schema database, with the tables of the performance schema
+// of MySQL that the engine keeps track of.
+func NewPerformanceSchemaDatabase() Database {
+	return &informationSchemaDatabase{
+		name: PerformanceSchemaDatabaseName,
+		tables: map[string]Table{
+			SessionConnectAttrsTableName: &informationSchemaTable{
+				name:   SessionConnectAttrsTableName,
+				schema: sessionConnectAttrsSchema,
+				reader: sessionConnectAttrsRowIter,
+			},
+			SessionAccountConnectAttrsTableName: &informationSchemaTable{
+				name:   SessionAccountConnectAttrsTableName,
+				schema: sessionAccountConnectAttrsSchema,
+				reader: sessionAccountConnectAttrsRowIter,
+			},
+		},
+	}
+}
+
+// sessionConnectAttrsRowIter implements the sql.RowIter for the performance_schema.SESSION_CONNECT_ATTRS table.
+func sessionConnectAttrsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return connectAttrsRowIter(ctx, false), nil
+}
+
+// sessionAccountConnectAttrsRowIter implements the sql.RowIter for the performance_schema.SESSION_ACCOUNT_CONNECT_ATTRS
+// table.
+func sessionAccountConnectAttrsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return connectAttrsRowIter(ctx, true), nil
+}
+
+// connectAttrsRowIter returns the connection attributes of the sessions in the process list, or only of the ones of
+// the account of the current session if |sameAccount| is true. The attributes of a session are ordered by name.
+func connectAttrsRowIter(ctx *Context, sameAccount bool) RowIter {
+	client := ctx.Session.Client()
+	var rows []Row
+	for _, proc := range ctx.ProcessList.Processes() {
+		if sameAccount && (proc.User != client.User || proc.Host != client.Address) {
+			continue
+		}
+		names := make([]string, 0, len(proc.ConnectAttributes))
+		for name := range proc.ConnectAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for i, name := range names {
+			rows = append(rows, Row{
+				proc.Connection,              // processlist_id
+				name,                         // attr_name
+				proc.ConnectAttributes[name], // attr_value
+				int32(i),                     // ordinal_position
+			})
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][0].(uint32) < rows[j][0].(uint32)
+	})
+	return RowsToRowIter(rows...)
+}
@@ -14,8 +14,70 @@
 
 package sql
 
+import (
+	"sync"
+)
+
 const (
 	ConnectionIdLogField = "connectionID"
 	ConnectionDbLogField = "connectionDb"
 	ConnectTimeLogKey    = "connectTime"
 )
+
+// LogType is a kind of server log, which FLUSH LOGS statements rotate.
+type LogType string
+
+const (
+	LogType_Binary  LogType = "BINARY"
+	LogType_Engine  LogType = "ENGINE"
+	LogType_Error   LogType = "ERROR"
+	LogType_General LogType = "GENERAL"
+	LogType_Relay   LogType = "RELAY"
+	LogType_Slow    LogType = "SLOW"
+)
+
+// LogTypes are all the kinds of server logs, in the order FLUSH LOGS rotates them.
+var LogTypes = []LogType{LogType_Binary, LogType_Engine, LogType_Error, LogType_General, LogType_Relay, LogType_Slow}
+
+// LogSink is a destination of a server log, such as a log file, provided by an integrator.
+type LogSink interface {
+	// Rotate closes the current log and starts a new one, e.g. by reopening a log file that was renamed.
+	Rotate(ctx *Context) error
+}
+
+// LogSinkRegistry holds the log sinks of the server by log type.
+type LogSinkRegistry struct {
+	mu    sync.Mutex
+	sinks map[LogType][]LogSink
+}
+
+// NewLogSinkRegistry returns a new LogSinkRegistry without sinks.
+func NewLogSinkRegistry() *LogSinkRegistry {
+	return &LogSinkRegistry{sinks: make(map[LogType][]LogSink)}
+}
+
+// Register adds a sink of the log type given, which is rotated by FLUSH LOGS and by FLUSH statements of that log type.
+func (r *LogSinkRegistry) Register(logType LogType, sink LogSink) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sinks[logType] = append(r.sinks[logType], sink)
+}
+
+// Rotate rotates the sinks of the log types given, in the order they were registered. Every sink is rotated even if
+// another one fails, and the first error is returned.
+func (r *LogSinkRegistry) Rotate(ctx *Context, logTypes ...LogType) error {
+	r.mu.Lock()
+	var sinks []LogSink
+	for _, logType := range logTypes {
+		sinks = append(sinks, r.sinks[logType]...)
+	}
+	r.mu.Unlock()
+
+	var firstErr error
+	for _, sink := range sinks {
+		if err := sink.Rotate(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
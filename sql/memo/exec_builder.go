@@ -22,6 +22,11 @@ func (b *ExecBuilder) buildRel(r RelExpr, input sql.Schema, children ...sql.Node
 		return nil, err
 	}
 
+	n, err = b.buildAdaptiveJoin(r, n, input, children...)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.buildDistinct(n, r.Distinct())
 }
 
@@ -171,6 +176,80 @@ func (b *ExecBuilder) buildConcatJoin(j *ConcatJoin, input sql.Schema, children
 	return plan.NewJoin(children[0], right, j.Op, filters).WithScopeLen(j.g.m.scopeLen), nil
 }
 
+// buildAdaptiveJoin returns an AdaptiveJoin that chooses between the lookup join and the hash join of the same
+// children in the group of |r|, which is one of them and was built as |n|, when the session's adaptive_join_threshold
+// isn't 0 and the query has no join operator hints. Otherwise returns |n|.
+func (b *ExecBuilder) buildAdaptiveJoin(r RelExpr, n sql.Node, input sql.Schema, children ...sql.Node) (sql.Node, error) {
+	j, ok := r.(JoinRel)
+	if !ok {
+		return n, nil
+	}
+	var lookupOp, hashOp plan.JoinType
+	switch j.JoinPrivate().Op {
+	case plan.JoinTypeLookup, plan.JoinTypeHash:
+		lookupOp, hashOp = plan.JoinTypeLookup, plan.JoinTypeHash
+	case plan.JoinTypeLeftOuterLookup, plan.JoinTypeLeftOuterHash:
+		lookupOp, hashOp = plan.JoinTypeLeftOuterLookup, plan.JoinTypeLeftOuterHash
+	default:
+		return n, nil
+	}
+
+	m := j.Group().m
+	if m.Ctx == nil || (m.hints != nil && len(m.hints.ops) > 0) {
+		// join operator hints choose the join
+		return n, nil
+	}
+	val, err := m.Ctx.GetSessionVariable(m.Ctx, "adaptive_join_threshold")
+	if err != nil {
+		return nil, err
+	}
+	threshold, ok := val.(uint64)
+	if !ok || threshold == 0 {
+		return n, nil
+	}
+
+	base := j.JoinPrivate()
+	var lookup *LookupJoin
+	var hash *HashJoin
+	for rel := j.Group().First; rel != nil; rel = rel.Next() {
+		alt, ok := rel.(JoinRel)
+		if !ok || alt.JoinPrivate().Left != base.Left || alt.JoinPrivate().Right != base.Right {
+			continue
+		}
+		switch alt := alt.(type) {
+		case *LookupJoin:
+			if lookup == nil && alt.Op == lookupOp {
+				lookup = alt
+			}
+		case *HashJoin:
+			if hash == nil && alt.Op == hashOp {
+				hash = alt
+			}
+		}
+	}
+	if lookup == nil || hash == nil {
+		return n, nil
+	}
+
+	lookupNode, ok := n.(*plan.JoinNode)
+	if !ok || r != lookup {
+		built, err := b.buildLookupJoin(lookup, input, children...)
+		if err != nil {
+			return nil, err
+		}
+		lookupNode = built.(*plan.JoinNode)
+	}
+	hashNode, ok := n.(*plan.JoinNode)
+	if !ok || r != hash {
+		built, err := b.buildHashJoin(hash, input, children...)
+		if err != nil {
+			return nil, err
+		}
+		hashNode = built.(*plan.JoinNode)
+	}
+	return plan.NewAdaptiveJoin(lookupNode, hashNode, threshold), nil
+}
+
 func (b *ExecBuilder) buildHashJoin(j *HashJoin, input sql.Schema, children ...sql.Node) (sql.Node, error) {
 	leftProbeFilters := make([]sql.Expression, len(j.LeftAttrs))
 	var err error
@@ -266,11 +345,14 @@ func (b *ExecBuilder) buildMergeJoin(j *MergeJoin, input sql.Schema, children ..
 		return nil, fmt.Errorf("index scan type mismatch")
 	}
 	if j.SwapCmp {
-		cmp, ok := j.Filter[0].(*Equal)
-		if !ok {
+		switch cmp := j.Filter[0].(type) {
+		case *Equal:
+			j.Filter[0] = &Equal{Left: cmp.Right, Right: cmp.Left}
+		case *NullSafeEq:
+			j.Filter[0] = &NullSafeEq{Left: cmp.Right, Right: cmp.Left}
+		default:
 			return nil, fmt.Errorf("unexpected non-equals comparison in merge join")
 		}
-		j.Filter[0] = &Equal{Left: cmp.Right, Right: cmp.Left}
 	}
 	filters, err := b.buildFilterConjunction(j.g.m.scope, input, j.Filter...)
 	if err != nil {
@@ -40,6 +40,7 @@ const (
 	HintTypeInnerJoin                                // INNER_JOIN
 	HintTypeLeftOuterLookupJoin                      // LEFT_OUTER_LOOKUP_JOIN
 	HintTypeNoIndexConditionPushDown                 // NO_ICP
+	HintTypeNoMerge                                  // NO_MERGE
 )
 
 type Hint struct {
@@ -78,6 +79,8 @@ func newHint(joinTyp string, args []string) Hint {
 		typ = HintTypeLeftOuterLookupJoin
 	case "no_icp":
 		typ = HintTypeNoIndexConditionPushDown
+	case "no_merge":
+		typ = HintTypeNoMerge
 	default:
 		typ = HintTypeUnknown
 	}
@@ -106,6 +109,9 @@ func (h Hint) valid() bool {
 		return len(h.Args) == 2
 	case HintTypeNoIndexConditionPushDown:
 		return len(h.Args) == 0
+	case HintTypeNoMerge:
+		// without arguments, the hint applies to every derived table of the query
+		return true
 	case HintTypeUnknown:
 		return false
 	default:
@@ -123,6 +129,12 @@ func ExtractJoinHint(n *plan.JoinNode) []Hint {
 	return nil
 }
 
+// ExtractQueryHints returns the hints of the comment of the node given, which is the first node of the FROM clause of
+// its query.
+func ExtractQueryHints(n sql.CommentedNode) []Hint {
+	return parseJoinHints(n.Comment())
+}
+
 // TODO: this is pretty nasty. Should be done in the parser instead.
 func parseJoinHints(comment string) []Hint {
 	if !strings.HasPrefix(comment, "/*+") {
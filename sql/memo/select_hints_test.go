@@ -58,6 +58,14 @@ func TestHintParsing(t *testing.T) {
 			comment: "/*+ NO_ICP */",
 			hints:   []Hint{{Typ: HintTypeNoIndexConditionPushDown}},
 		},
+		{
+			comment: "/*+ NO_MERGE */",
+			hints:   []Hint{{Typ: HintTypeNoMerge}},
+		},
+		{
+			comment: "/*+ no_merge(DT1, dt2) */",
+			hints:   []Hint{{Typ: HintTypeNoMerge, Args: []string{"dt1", "dt2"}}},
+		},
 		{
 			comment: "/*+ JOIN_FIXED_ORDER */",
 			hints:   []Hint{{Typ: HintTypeJoinFixedOrder}},
@@ -0,0 +1,55 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import "net"
+
+// AuthSocketPlugin is the name of the built-in authentication plugin that authenticates the clients connected through
+// a unix socket by the operating system user of the client process, like the auth_socket plugin of MySQL. The client
+// must be the operating system user named in the authentication string of the account, or the user with the same
+// name as the account if the authentication string is empty.
+const AuthSocketPlugin = "auth_socket"
+
+// PeerCredentialsAddr is the address of a client connected through a local socket, whose operating system user is
+// known from the credentials of the socket peer.
+type PeerCredentialsAddr interface {
+	net.Addr
+	// PeerUser returns the name of the operating system user of the client.
+	PeerUser() (string, error)
+}
+
+// authenticateSocketPeer returns whether the client at the address given is the operating system user that the
+// account given, which uses the AuthSocketPlugin, maps to.
+func authenticateSocketPeer(userEntry *User, addr net.Addr) bool {
+	peerAddr, ok := addr.(PeerCredentialsAddr)
+	if !ok {
+		return false
+	}
+	peerUser, err := peerAddr.PeerUser()
+	if err != nil {
+		return false
+	}
+	osUser := userEntry.Password
+	if osUser == "" {
+		osUser = userEntry.User
+	}
+	return peerUser == osUser
+}
+
+// isLocalNetwork returns whether the network of a client address is a local socket, whose clients connect from
+// localhost: a unix socket, or a named pipe on Windows.
+func isLocalNetwork(network string) bool {
+	return network == "unix" || network == "pipe"
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+type testPeerAddr struct {
+	net.UnixAddr
+	user string
+}
+
+func (a *testPeerAddr) PeerUser() (string, error) {
+	return a.user, nil
+}
+
+func TestAuthSocket(t *testing.T) {
+	db := CreateEmptyMySQLDb()
+	db.AddRootAccount()
+	for _, u := range []*User{
+		{User: "alice", Host: "localhost", Plugin: AuthSocketPlugin},
+		{User: "admin", Host: "localhost", Plugin: AuthSocketPlugin, Password: "bob"},
+	} {
+		u.PrivilegeSet = NewPrivilegeSet()
+		u.PasswordLastChanged = time.Unix(1, 0).UTC()
+		require.NoError(t, db.user.data.Put(sql.NewEmptyContext(), u))
+	}
+	require.NoError(t, db.VerifyPlugin(AuthSocketPlugin))
+
+	method, err := db.AuthMethod("alice", "")
+	require.NoError(t, err)
+	require.Equal(t, "mysql_native_password", method)
+
+	tests := []struct {
+		user   string
+		addr   net.Addr
+		authed bool
+	}{
+		{"alice", &testPeerAddr{UnixAddr: net.UnixAddr{Net: "unix"}, user: "alice"}, true},
+		{"alice", &testPeerAddr{UnixAddr: net.UnixAddr{Net: "unix"}, user: "bob"}, false},
+		{"alice", &net.UnixAddr{Net: "unix"}, false},
+		{"admin", &testPeerAddr{UnixAddr: net.UnixAddr{Net: "unix"}, user: "bob"}, true},
+		{"admin", &testPeerAddr{UnixAddr: net.UnixAddr{Net: "unix"}, user: "admin"}, false},
+	}
+	for _, test := range tests {
+		_, err := db.ValidateHash(nil, test.user, []byte("ignored"), test.addr)
+		if test.authed {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
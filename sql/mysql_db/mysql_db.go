@@ -23,11 +23,13 @@ import (
 	"net"
 	"sort"
 	"strings"
+	"sync"
 
 	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db/serial"
 )
 
@@ -36,6 +38,15 @@ type MySQLDbPersistence interface {
 	Persist(ctx *sql.Context, data []byte) error
 }
 
+// MySQLDbPersistenceLoader is a MySQLDbPersistence that can also read back the data it persisted. FLUSH PRIVILEGES
+// reloads the privileges from it, which picks up changes persisted by others, such as other servers sharing the same
+// storage.
+type MySQLDbPersistenceLoader interface {
+	MySQLDbPersistence
+	// Load returns the data last persisted, in the format given to Persist, or nil if nothing was persisted.
+	Load(ctx *sql.Context) ([]byte, error)
+}
+
 // NoopPersister is used when nothing in mysql db should be persisted
 type NoopPersister struct{}
 
@@ -57,6 +68,7 @@ type MySQLDb struct {
 	user                *mysqlTable
 	role_edges          *mysqlTable
 	replica_source_info *mysqlTable
+	password_history    *mysqlTable
 
 	help_topic    *mysqlTable
 	help_keyword  *mysqlTable
@@ -72,15 +84,19 @@ type MySQLDb struct {
 	//procs_priv       *mysqlTable
 	//proxies_priv     *mysqlTable
 	//default_roles    *mysqlTable
-	//password_history *mysqlTable
 
 	persister MySQLDbPersistence
 	plugins   map[string]PlaintextAuthPlugin
 
+	// loginFailures are the consecutive failed logins of the accounts that lock after too many of them
+	loginMu       sync.Mutex
+	loginFailures map[UserPrimaryKey]*loginFailures
+
 	updateCounter uint64
 }
 
 var _ sql.Database = (*MySQLDb)(nil)
+var _ sql.DefinerPrivilegedOperationChecker = (*MySQLDb)(nil)
 var _ mysql.AuthServer = (*MySQLDb)(nil)
 
 // CreateEmptyMySQLDb returns a collection of MySQL Tables that do not contain any data.
@@ -111,6 +127,14 @@ func CreateEmptyMySQLDb() *MySQLDb {
 		&ReplicaSourceInfo{},
 		ReplicaSourceInfoPrimaryKey{},
 	)
+	mysqlDb.password_history = newMySQLTable(
+		passwordHistoryTblName,
+		passwordHistoryTblSchema,
+		mysqlDb,
+		&PasswordHistory{},
+		PasswordHistoryPrimaryKey{},
+		PasswordHistorySecondaryKey{},
+	)
 
 	// Help tables
 	mysqlDb.help_topic = newEmptyMySQLTable(
@@ -235,12 +259,59 @@ func (db *MySQLDb) LoadData(ctx *sql.Context, buf []byte) (err error) {
 		}
 	}
 
+	// Fill in the PasswordHistory table
+	for i := 0; i < serialMySQLDb.PasswordHistoryLength(); i++ {
+		serialPasswordHistory := new(serial.PasswordHistory)
+		if !serialMySQLDb.PasswordHistory(serialPasswordHistory, i) {
+			continue
+		}
+		passwordHistory := LoadPasswordHistory(serialPasswordHistory)
+		if err := db.password_history.data.Put(ctx, passwordHistory); err != nil {
+			return err
+		}
+	}
+
 	db.updateCounter++
 
 	// TODO: fill in other tables when they exist
 	return
 }
 
+// Reload replaces the users, roles and replica source information with the ones persisted, if the persister can load
+// them. The super users, which are never persisted, are kept. Returns whether the data was reloaded.
+func (db *MySQLDb) Reload(ctx *sql.Context) (bool, error) {
+	loader, ok := db.persister.(MySQLDbPersistenceLoader)
+	if !ok {
+		return false, nil
+	}
+	buf, err := loader.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var superUsers []in_mem_table.Entry
+	for _, entry := range db.user.data.ToSlice(ctx) {
+		if entry.(*User).IsSuperUser {
+			superUsers = append(superUsers, entry)
+		}
+	}
+	db.user.data.Clear()
+	db.role_edges.data.Clear()
+	db.replica_source_info.data.Clear()
+	db.password_history.data.Clear()
+	for _, entry := range superUsers {
+		if err = db.user.data.Put(ctx, entry); err != nil {
+			return false, err
+		}
+	}
+
+	if err = db.LoadData(ctx, buf); err != nil {
+		return false, err
+	}
+	db.updateCounter++
+	return true, nil
+}
+
 // SetPersister sets the custom persister to be used when the MySQL Db tables have been updated and need to be persisted.
 func (db *MySQLDb) SetPersister(persister MySQLDbPersistence) {
 	db.persister = persister
@@ -252,7 +323,7 @@ func (db *MySQLDb) SetPlugins(plugins map[string]PlaintextAuthPlugin) {
 
 func (db *MySQLDb) VerifyPlugin(plugin string) error {
 	_, ok := db.plugins[plugin]
-	if ok {
+	if ok || plugin == AuthSocketPlugin {
 		return nil
 	}
 	return fmt.Errorf(`must provide authentication plugin for unsupported authentication format`)
@@ -345,6 +416,13 @@ func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 		return NewPrivilegeSet()
 	}
 
+	privSet := db.userPrivilegeSet(user)
+	ctx.Session.SetPrivilegeSet(privSet, db.updateCounter)
+	return privSet
+}
+
+// userPrivilegeSet returns the privileges of the user given, including the privileges of the roles granted to it.
+func (db *MySQLDb) userPrivilegeSet(user *User) PrivilegeSet {
 	privSet := user.PrivilegeSet.Copy()
 	roleEdgeEntries := db.role_edges.data.Get(RoleEdgesToKey{
 		ToHost: user.Host,
@@ -360,7 +438,6 @@ func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 		}
 	}
 
-	ctx.Session.SetPrivilegeSet(privSet, db.updateCounter)
 	return privSet
 }
 
@@ -371,7 +448,37 @@ func (db *MySQLDb) UserHasPrivileges(ctx *sql.Context, operations ...sql.Privile
 	if !db.Enabled {
 		return true
 	}
-	privSet := db.UserActivePrivilegeSet(ctx)
+	return privilegeSetHasPrivileges(ctx, db.UserActivePrivilegeSet(ctx), operations...)
+}
+
+// DefinerChecker implements the interface sql.DefinerPrivilegedOperationChecker.
+func (db *MySQLDb) DefinerChecker(definer string) sql.PrivilegedOperationChecker {
+	return definerPrivilegeChecker{db: db, definer: definer}
+}
+
+// IsCurrentUser implements the interface sql.DefinerPrivilegedOperationChecker.
+func (db *MySQLDb) IsCurrentUser(ctx *sql.Context, definer string) bool {
+	user, host := splitDefiner(definer)
+	client := ctx.Session.Client()
+	if user != client.User {
+		return false
+	}
+	if strings.EqualFold(host, client.Address) {
+		return true
+	}
+	account := db.GetUser(client.User, client.Address, false)
+	return account != nil && strings.EqualFold(account.Host, host)
+}
+
+// DefinerExists returns whether the account |definer|, written like `user`@`host`, exists.
+func (db *MySQLDb) DefinerExists(definer string) bool {
+	user, host := splitDefiner(definer)
+	return db.GetUser(user, host, false) != nil
+}
+
+// privilegeSetHasPrivileges returns whether the privilege set given has the privileges necessary to perform the
+// privileged operations given.
+func privilegeSetHasPrivileges(ctx *sql.Context, privSet PrivilegeSet, operations ...sql.PrivilegedOperation) bool {
 	for _, operation := range operations {
 		for _, operationPriv := range operation.StaticPrivileges {
 			if privSet.Has(operationPriv) {
@@ -415,6 +522,57 @@ func (db *MySQLDb) UserHasPrivileges(ctx *sql.Context, operations ...sql.Privile
 	return true
 }
 
+// definerPrivilegeChecker checks the privileges of the definer of a stored object, rather than those of the user of
+// the session.
+type definerPrivilegeChecker struct {
+	db      *MySQLDb
+	definer string
+}
+
+var _ sql.DefinerPrivilegedOperationChecker = definerPrivilegeChecker{}
+
+// UserHasPrivileges implements the interface sql.PrivilegedOperationChecker.
+func (c definerPrivilegeChecker) UserHasPrivileges(ctx *sql.Context, operations ...sql.PrivilegedOperation) bool {
+	if !c.db.Enabled {
+		return true
+	}
+	user, host := splitDefiner(c.definer)
+	definer := c.db.GetUser(user, host, false)
+	if definer == nil {
+		return false
+	}
+	return privilegeSetHasPrivileges(ctx, c.db.userPrivilegeSet(definer), operations...)
+}
+
+// DefinerChecker implements the interface sql.DefinerPrivilegedOperationChecker.
+func (c definerPrivilegeChecker) DefinerChecker(definer string) sql.PrivilegedOperationChecker {
+	return c.db.DefinerChecker(definer)
+}
+
+// IsCurrentUser implements the interface sql.DefinerPrivilegedOperationChecker.
+func (c definerPrivilegeChecker) IsCurrentUser(ctx *sql.Context, definer string) bool {
+	user, host := splitDefiner(definer)
+	definerUser, definerHost := splitDefiner(c.definer)
+	return user == definerUser && strings.EqualFold(host, definerHost)
+}
+
+// splitDefiner returns the user and host of the account |definer|, written like `user`@`host`.
+func splitDefiner(definer string) (string, string) {
+	i := strings.LastIndex(definer, "@")
+	if i < 0 {
+		return unquoteDefinerPart(definer), "%"
+	}
+	return unquoteDefinerPart(definer[:i]), unquoteDefinerPart(definer[i+1:])
+}
+
+func unquoteDefinerPart(s string) string {
+	if len(s) >= 2 && (s[0] == '`' || s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		q := string(s[0])
+		return strings.ReplaceAll(s[1:len(s)-1], q+q, q)
+	}
+	return s
+}
+
 // Name implements the interface sql.Database.
 func (db *MySQLDb) Name() string {
 	return "mysql"
@@ -433,6 +591,8 @@ func (db *MySQLDb) GetTableInsensitive(_ *sql.Context, tblName string) (sql.Tabl
 		return db.tables_priv, true, nil
 	case replicaSourceInfoTblName:
 		return db.replica_source_info, true, nil
+	case passwordHistoryTblName:
+		return db.password_history, true, nil
 	case helpTopicTableName:
 		return db.help_topic, true, nil
 	case helpKeywordTableName:
@@ -454,6 +614,7 @@ func (db *MySQLDb) GetTableNames(ctx *sql.Context) ([]string, error) {
 		tablesPrivTblName,
 		roleEdgesTblName,
 		replicaSourceInfoTblName,
+		passwordHistoryTblName,
 		helpTopicTableName,
 		helpKeywordTableName,
 		helpCategoryTableName,
@@ -486,6 +647,10 @@ func (db *MySQLDb) AuthMethod(user, addr string) (string, error) {
 	if _, ok := db.plugins[u.Plugin]; ok {
 		return "mysql_clear_password", nil
 	}
+	// clients of accounts using auth_socket send a native password response, which ValidateHash ignores
+	if u.Plugin == AuthSocketPlugin {
+		return "mysql_native_password", nil
+	}
 	return u.Plugin, nil
 }
 
@@ -498,7 +663,7 @@ func (db *MySQLDb) Salt() ([]byte, error) {
 func (db *MySQLDb) ValidateHash(salt []byte, user string, authResponse []byte, addr net.Addr) (mysql.Getter, error) {
 	var host string
 	var err error
-	if addr.Network() == "unix" {
+	if isLocalNetwork(addr.Network()) {
 		host = "localhost"
 	} else {
 		host, _, err = net.SplitHostPort(addr.String())
@@ -515,14 +680,29 @@ func (db *MySQLDb) ValidateHash(salt []byte, user string, authResponse []byte, a
 	if userEntry == nil || userEntry.Locked {
 		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 	}
+	if err = db.checkPasswordLock(userEntry); err != nil {
+		return nil, err
+	}
+	if _, ok := db.plugins[userEntry.Plugin]; !ok && userEntry.Plugin == AuthSocketPlugin {
+		if !authenticateSocketPeer(userEntry, addr) {
+			db.recordLogin(userEntry, false)
+			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+		}
+		db.recordLogin(userEntry, true)
+		return MysqlConnectionUser{User: userEntry.User, Host: userEntry.Host}, nil
+	}
 	if len(userEntry.Password) > 0 {
 		if !validateMysqlNativePassword(authResponse, salt, userEntry.Password) {
+			db.recordLogin(userEntry, false)
 			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 		}
 	} else if len(authResponse) > 0 { // password is nil or empty, therefore no password is set
 		// a password was given and the account has no password set, therefore access is denied
+		db.recordLogin(userEntry, false)
 		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 	}
+	// expired passwords don't prevent logging in, the server restricts the connection instead, see CheckExpiredPassword
+	db.recordLogin(userEntry, true)
 
 	return MysqlConnectionUser{User: userEntry.User, Host: userEntry.Host}, nil
 }
@@ -531,7 +711,7 @@ func (db *MySQLDb) ValidateHash(salt []byte, user string, authResponse []byte, a
 func (db *MySQLDb) Negotiate(c *mysql.Conn, user string, addr net.Addr) (mysql.Getter, error) {
 	var host string
 	var err error
-	if addr.Network() == "unix" {
+	if isLocalNetwork(addr.Network()) {
 		host = "localhost"
 	} else {
 		host, _, err = net.SplitHostPort(addr.String())
@@ -551,17 +731,23 @@ func (db *MySQLDb) Negotiate(c *mysql.Conn, user string, addr net.Addr) (mysql.G
 		if !ok {
 			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'; auth plugin %s not registered with server", user, userEntry.Plugin)
 		}
+		if err = db.checkPasswordLock(userEntry); err != nil {
+			return nil, err
+		}
 		pass, err := mysql.AuthServerReadPacketString(c)
 		if err != nil {
 			return nil, err
 		}
 		authed, err := authplugin.Authenticate(db, user, userEntry, pass)
 		if err != nil {
+			db.recordLogin(userEntry, false)
 			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v': %v", user, err)
 		}
 		if !authed {
+			db.recordLogin(userEntry, false)
 			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 		}
+		db.recordLogin(userEntry, true)
 		return connUser, nil
 	}
 	return nil, fmt.Errorf(`the only user login interface currently supported is "mysql_native_password"`)
@@ -622,6 +808,22 @@ func (db *MySQLDb) Persist(ctx *sql.Context) error {
 		return replicaSourceInfos[i].Host < replicaSourceInfos[j].Host
 	})
 
+	// Extract all password history entries from table, and sort
+	passwordHistoryEntries := db.password_history.data.ToSlice(ctx)
+	passwordHistories := make([]*PasswordHistory, len(passwordHistoryEntries))
+	for i, passwordHistoryEntry := range passwordHistoryEntries {
+		passwordHistories[i] = passwordHistoryEntry.(*PasswordHistory)
+	}
+	sort.Slice(passwordHistories, func(i, j int) bool {
+		if passwordHistories[i].Host == passwordHistories[j].Host {
+			if passwordHistories[i].User == passwordHistories[j].User {
+				return passwordHistories[i].PasswordTimestamp.Before(passwordHistories[j].PasswordTimestamp)
+			}
+			return passwordHistories[i].User < passwordHistories[j].User
+		}
+		return passwordHistories[i].Host < passwordHistories[j].Host
+	})
+
 	// TODO: serialize other tables when they exist
 
 	// Create flatbuffer
@@ -629,12 +831,14 @@ func (db *MySQLDb) Persist(ctx *sql.Context) error {
 	user := serializeUser(b, users)
 	roleEdge := serializeRoleEdge(b, roles)
 	replicaSourceInfo := serializeReplicaSourceInfo(b, replicaSourceInfos)
+	passwordHistory := serializePasswordHistory(b, passwordHistories)
 
 	// Write MySQL DB
 	serial.MySQLDbStart(b)
 	serial.MySQLDbAddUser(b, user)
 	serial.MySQLDbAddRoleEdges(b, roleEdge)
 	serial.MySQLDbAddReplicaSourceInfo(b, replicaSourceInfo)
+	serial.MySQLDbAddPasswordHistory(b, passwordHistory)
 	mysqlDbOffset := serial.MySQLDbEnd(b)
 
 	// Finish writing
@@ -659,6 +863,11 @@ func (db *MySQLDb) ReplicaSourceInfoTable() *mysqlTable {
 	return db.replica_source_info
 }
 
+// PasswordHistoryTable returns the "password_history" table.
+func (db *MySQLDb) PasswordHistoryTable() *mysqlTable {
+	return db.password_history
+}
+
 // columnTemplate takes in a column as a template, and returns a new column with a different name based on the given
 // template.
 func columnTemplate(name string, source string, isPk bool, template *sql.Column) *sql.Column {
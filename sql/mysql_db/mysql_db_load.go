@@ -108,18 +108,47 @@ func LoadUser(serialUser *serial.User) *User {
 	}
 
 	return &User{
-		User:                string(serialUser.User()),
-		Host:                string(serialUser.Host()),
-		PrivilegeSet:        *privilegeSet,
-		Plugin:              string(serialUser.Plugin()),
-		Password:            string(serialUser.Password()),
-		PasswordLastChanged: time.Unix(serialUser.PasswordLastChanged(), 0),
-		Locked:              serialUser.Locked(),
-		Attributes:          attributes,
-		Identity:            string(serialUser.Identity()),
+		User:                   string(serialUser.User()),
+		Host:                   string(serialUser.Host()),
+		PrivilegeSet:           *privilegeSet,
+		Plugin:                 string(serialUser.Plugin()),
+		Password:               string(serialUser.Password()),
+		PasswordLastChanged:    time.Unix(serialUser.PasswordLastChanged(), 0),
+		Locked:                 serialUser.Locked(),
+		Attributes:             attributes,
+		Identity:               string(serialUser.Identity()),
+		SslType:                string(serialUser.SslType()),
+		SslCipher:              string(serialUser.SslCipher()),
+		X509Issuer:             string(serialUser.X509Issuer()),
+		X509Subject:            string(serialUser.X509Subject()),
+		PasswordExpired:        serialUser.PasswordExpired(),
+		PasswordLifetime:       loadOptionalUint16(serialUser.PasswordLifetime()),
+		PasswordReuseHistory:   loadOptionalUint16(serialUser.PasswordReuseHistory()),
+		PasswordReuseTime:      loadOptionalUint16(serialUser.PasswordReuseTime()),
+		PasswordRequireCurrent: loadOptionalBool(serialUser.PasswordRequireCurrent()),
+		FailedLoginAttempts:    serialUser.FailedLoginAttempts(),
+		PasswordLockTime:       serialUser.PasswordLockTime(),
 	}
 }
 
+// loadOptionalUint16 returns the *uint16 of a value written by serializeOptionalUint16.
+func loadOptionalUint16(val int32) *uint16 {
+	if val < 0 {
+		return nil
+	}
+	v := uint16(val)
+	return &v
+}
+
+// loadOptionalBool returns the *bool of a value written by serializeOptionalBool.
+func loadOptionalBool(val int8) *bool {
+	if val < 0 {
+		return nil
+	}
+	v := val == 1
+	return &v
+}
+
 func LoadRoleEdge(serialRoleEdge *serial.RoleEdge) *RoleEdge {
 	return &RoleEdge{
 		FromHost: string(serialRoleEdge.FromHost()),
@@ -129,6 +158,15 @@ func LoadRoleEdge(serialRoleEdge *serial.RoleEdge) *RoleEdge {
 	}
 }
 
+func LoadPasswordHistory(serialPasswordHistory *serial.PasswordHistory) *PasswordHistory {
+	return &PasswordHistory{
+		Host:              string(serialPasswordHistory.Host()),
+		User:              string(serialPasswordHistory.User()),
+		PasswordTimestamp: time.UnixMicro(serialPasswordHistory.PasswordTimestamp()).UTC(),
+		Password:          string(serialPasswordHistory.Password()),
+	}
+}
+
 func LoadReplicaSourceInfo(serialReplicaSourceInfo *serial.ReplicaSourceInfo) *ReplicaSourceInfo {
 	return &ReplicaSourceInfo{
 		Host:                 string(serialReplicaSourceInfo.Host()),
@@ -142,6 +142,26 @@ func serializeAttributes(b *flatbuffers.Builder, attributes *string) flatbuffers
 	}
 }
 
+// serializeOptionalUint16 returns the value written to the flatbuffer for the given *uint16, which is -1 for nil.
+func serializeOptionalUint16(val *uint16) int32 {
+	if val == nil {
+		return -1
+	}
+	return int32(*val)
+}
+
+// serializeOptionalBool returns the value written to the flatbuffer for the given *bool, which is -1 for nil.
+func serializeOptionalBool(val *bool) int8 {
+	switch {
+	case val == nil:
+		return -1
+	case *val:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 	// Write user variables, and save offsets
 	offsets := make([]flatbuffers.UOffsetT, len(users))
@@ -153,6 +173,10 @@ func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 		password := b.CreateString(user.Password)
 		attributes := serializeAttributes(b, user.Attributes)
 		identity := b.CreateString(user.Identity)
+		sslType := b.CreateString(user.SslType)
+		sslCipher := b.CreateString(user.SslCipher)
+		x509Issuer := b.CreateString(user.X509Issuer)
+		x509Subject := b.CreateString(user.X509Subject)
 
 		serial.UserStart(b)
 		serial.UserAddUser(b, userName)
@@ -164,6 +188,17 @@ func serializeUser(b *flatbuffers.Builder, users []*User) flatbuffers.UOffsetT {
 		serial.UserAddLocked(b, user.Locked)
 		serial.UserAddAttributes(b, attributes)
 		serial.UserAddIdentity(b, identity)
+		serial.UserAddSslType(b, sslType)
+		serial.UserAddSslCipher(b, sslCipher)
+		serial.UserAddX509Issuer(b, x509Issuer)
+		serial.UserAddX509Subject(b, x509Subject)
+		serial.UserAddPasswordExpired(b, user.PasswordExpired)
+		serial.UserAddPasswordLifetime(b, serializeOptionalUint16(user.PasswordLifetime))
+		serial.UserAddPasswordReuseHistory(b, serializeOptionalUint16(user.PasswordReuseHistory))
+		serial.UserAddPasswordReuseTime(b, serializeOptionalUint16(user.PasswordReuseTime))
+		serial.UserAddPasswordRequireCurrent(b, serializeOptionalBool(user.PasswordRequireCurrent))
+		serial.UserAddFailedLoginAttempts(b, user.FailedLoginAttempts)
+		serial.UserAddPasswordLockTime(b, user.PasswordLockTime)
 
 		offsets[len(users)-i-1] = serial.UserEnd(b) // reverse order
 	}
@@ -231,3 +266,30 @@ func serializeReplicaSourceInfo(b *flatbuffers.Builder, replicaSourceInfos []*Re
 	// Write replica source info vector (already in reversed order)
 	return serializeVectorOffsets(b, serial.MySQLDbStartReplicaSourceInfoVector, offsets)
 }
+
+func serializePasswordHistory(b *flatbuffers.Builder, passwordHistories []*PasswordHistory) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(passwordHistories))
+
+	for i, passwordHistory := range passwordHistories {
+		host := b.CreateString(passwordHistory.Host)
+		user := b.CreateString(passwordHistory.User)
+		password := b.CreateString(passwordHistory.Password)
+
+		// Start PasswordHistory
+		serial.PasswordHistoryStart(b)
+
+		// Write their offsets to flatbuffer builder
+		serial.PasswordHistoryAddHost(b, host)
+		serial.PasswordHistoryAddUser(b, user)
+		serial.PasswordHistoryAddPassword(b, password)
+
+		// Write non-string fields, keeping the microseconds that tell apart the changes of the same second
+		serial.PasswordHistoryAddPasswordTimestamp(b, passwordHistory.PasswordTimestamp.UnixMicro())
+
+		// End PasswordHistory
+		offsets[len(passwordHistories)-i-1] = serial.PasswordHistoryEnd(b)
+	}
+
+	// Write password history vector (already in reversed order)
+	return serializeVectorOffsets(b, serial.MySQLDbStartPasswordHistoryVector, offsets)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// loadingPersister is a persister that loads back the data persisted last.
+type loadingPersister struct {
+	capturingPersister
+}
+
+var _ MySQLDbPersistenceLoader = &loadingPersister{}
+
+func (p *loadingPersister) Load(ctx *sql.Context) ([]byte, error) {
+	return p.data, nil
+}
+
+func TestReload(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	newUser := func(name string) *User {
+		return &User{
+			User:                name,
+			Host:                "localhost",
+			PrivilegeSet:        NewPrivilegeSet(),
+			PasswordLastChanged: time.Unix(1, 0).UTC(),
+		}
+	}
+
+	db := CreateEmptyMySQLDb()
+	db.AddRootAccount()
+	require.NoError(t, db.user.data.Put(ctx, newUser("persisted")))
+
+	// Persisters that can't load leave the data as it is
+	db.SetPersister(&capturingPersister{})
+	reloaded, err := db.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, reloaded)
+	require.NotNil(t, db.GetUser("persisted", "localhost", false))
+
+	persister := &loadingPersister{}
+	db.SetPersister(persister)
+	require.NoError(t, db.Persist(ctx))
+	require.NoError(t, db.user.data.Put(ctx, newUser("unpersisted")))
+	require.NoError(t, db.user.data.Remove(ctx, UserPrimaryKey{Host: "localhost", User: "persisted"}, nil))
+
+	reloaded, err = db.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.NotNil(t, db.GetUser("persisted", "localhost", false))
+	require.Nil(t, db.GetUser("unpersisted", "localhost", false))
+	// The super user isn't persisted, but is kept
+	require.NotNil(t, db.GetUser("root", "localhost", false))
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+// PasswordHistory represents a previous password of an account from the password_history Grant Table.
+type PasswordHistory struct {
+	Host              string
+	User              string
+	PasswordTimestamp time.Time
+	Password          string
+}
+
+var _ in_mem_table.Entry = (*PasswordHistory)(nil)
+
+// NewFromRow implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) NewFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Entry, error) {
+	if err := passwordHistoryTblSchema.CheckRow(row); err != nil {
+		return nil, err
+	}
+	password, _ := row[passwordHistoryTblColIndex_Password].(string)
+	return &PasswordHistory{
+		Host:              row[passwordHistoryTblColIndex_Host].(string),
+		User:              row[passwordHistoryTblColIndex_User].(string),
+		PasswordTimestamp: row[passwordHistoryTblColIndex_Password_timestamp].(time.Time),
+		Password:          password,
+	}, nil
+}
+
+// UpdateFromRow implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) UpdateFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Entry, error) {
+	return p.NewFromRow(ctx, row)
+}
+
+// ToRow implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) ToRow(ctx *sql.Context) sql.Row {
+	row := make(sql.Row, len(passwordHistoryTblSchema))
+	row[passwordHistoryTblColIndex_Host] = p.Host
+	row[passwordHistoryTblColIndex_User] = p.User
+	row[passwordHistoryTblColIndex_Password_timestamp] = p.PasswordTimestamp
+	row[passwordHistoryTblColIndex_Password] = p.Password
+	return row
+}
+
+// Equals implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) Equals(ctx *sql.Context, otherEntry in_mem_table.Entry) bool {
+	otherPasswordHistory, ok := otherEntry.(*PasswordHistory)
+	if !ok {
+		return false
+	}
+	return p.Host == otherPasswordHistory.Host &&
+		p.User == otherPasswordHistory.User &&
+		p.PasswordTimestamp.Equal(otherPasswordHistory.PasswordTimestamp) &&
+		p.Password == otherPasswordHistory.Password
+}
+
+// Copy implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) Copy(ctx *sql.Context) in_mem_table.Entry {
+	pp := *p
+	return &pp
+}
+
+// FromJson implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) FromJson(ctx *sql.Context, jsonStr string) (in_mem_table.Entry, error) {
+	newPasswordHistory := &PasswordHistory{}
+	if err := json.Unmarshal([]byte(jsonStr), newPasswordHistory); err != nil {
+		return nil, err
+	}
+	return newPasswordHistory, nil
+}
+
+// ToJson implements the interface in_mem_table.Entry.
+func (p *PasswordHistory) ToJson(ctx *sql.Context) (string, error) {
+	jsonData, err := json.Marshal(*p)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonData), nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const passwordHistoryTblName = "password_history"
+
+var (
+	errPasswordHistoryPkEntry = fmt.Errorf("the primary key for the `password_history` table was given an unknown entry")
+	errPasswordHistoryPkRow   = fmt.Errorf("the primary key for the `password_history` table was given a row belonging to an unknown schema")
+	errPasswordHistorySkEntry = fmt.Errorf("the secondary key for the `password_history` table was given an unknown entry")
+	errPasswordHistorySkRow   = fmt.Errorf("the secondary key for the `password_history` table was given a row belonging to an unknown schema")
+
+	passwordHistoryTblSchema sql.Schema
+)
+
+// PasswordHistoryPrimaryKey is a key that represents the primary key for the "password_history" Grant Table.
+type PasswordHistoryPrimaryKey struct {
+	Host              string
+	User              string
+	PasswordTimestamp time.Time
+}
+
+// PasswordHistorySecondaryKey is a key that represents the account columns on the "password_history" Grant Table.
+type PasswordHistorySecondaryKey struct {
+	Host string
+	User string
+}
+
+var _ in_mem_table.Key = PasswordHistoryPrimaryKey{}
+var _ in_mem_table.Key = PasswordHistorySecondaryKey{}
+
+// KeyFromEntry implements the interface in_mem_table.Key.
+func (k PasswordHistoryPrimaryKey) KeyFromEntry(ctx *sql.Context, entry in_mem_table.Entry) (in_mem_table.Key, error) {
+	passwordHistory, ok := entry.(*PasswordHistory)
+	if !ok {
+		return nil, errPasswordHistoryPkEntry
+	}
+	return PasswordHistoryPrimaryKey{
+		Host:              passwordHistory.Host,
+		User:              passwordHistory.User,
+		PasswordTimestamp: passwordHistory.PasswordTimestamp,
+	}, nil
+}
+
+// KeyFromRow implements the interface in_mem_table.Key.
+func (k PasswordHistoryPrimaryKey) KeyFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Key, error) {
+	if len(row) != len(passwordHistoryTblSchema) {
+		return k, errPasswordHistoryPkRow
+	}
+	host, ok := row[passwordHistoryTblColIndex_Host].(string)
+	if !ok {
+		return k, errPasswordHistoryPkRow
+	}
+	user, ok := row[passwordHistoryTblColIndex_User].(string)
+	if !ok {
+		return k, errPasswordHistoryPkRow
+	}
+	passwordTimestamp, ok := row[passwordHistoryTblColIndex_Password_timestamp].(time.Time)
+	if !ok {
+		return k, errPasswordHistoryPkRow
+	}
+	return PasswordHistoryPrimaryKey{
+		Host:              host,
+		User:              user,
+		PasswordTimestamp: passwordTimestamp,
+	}, nil
+}
+
+// KeyFromEntry implements the interface in_mem_table.Key.
+func (k PasswordHistorySecondaryKey) KeyFromEntry(ctx *sql.Context, entry in_mem_table.Entry) (in_mem_table.Key, error) {
+	passwordHistory, ok := entry.(*PasswordHistory)
+	if !ok {
+		return nil, errPasswordHistorySkEntry
+	}
+	return PasswordHistorySecondaryKey{
+		Host: passwordHistory.Host,
+		User: passwordHistory.User,
+	}, nil
+}
+
+// KeyFromRow implements the interface in_mem_table.Key.
+func (k PasswordHistorySecondaryKey) KeyFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Key, error) {
+	if len(row) != len(passwordHistoryTblSchema) {
+		return k, errPasswordHistorySkRow
+	}
+	host, ok := row[passwordHistoryTblColIndex_Host].(string)
+	if !ok {
+		return k, errPasswordHistorySkRow
+	}
+	user, ok := row[passwordHistoryTblColIndex_User].(string)
+	if !ok {
+		return k, errPasswordHistorySkRow
+	}
+	return PasswordHistorySecondaryKey{
+		Host: host,
+		User: user,
+	}, nil
+}
+
+// init creates the schema for the "password_history" Grant Table.
+func init() {
+	// Types
+	char32_utf8_bin := types.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	char255_ascii_general_ci := types.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+	text_utf8_bin := types.CreateText(sql.Collation_utf8_bin)
+
+	// Column Templates
+	char32_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char32_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char32_utf8_bin), char32_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_empty := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+	timestamp_not_null_default_nil := &sql.Column{
+		Type:     types.Timestamp,
+		Default:  nil,
+		Nullable: false,
+	}
+	text_utf8_bin_nullable_default_nil := &sql.Column{
+		Type:     text_utf8_bin,
+		Default:  nil,
+		Nullable: true,
+	}
+
+	passwordHistoryTblSchema = sql.Schema{
+		columnTemplate("Host", passwordHistoryTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("User", passwordHistoryTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("Password_timestamp", passwordHistoryTblName, true, timestamp_not_null_default_nil),
+		columnTemplate("Password", passwordHistoryTblName, false, text_utf8_bin_nullable_default_nil),
+	}
+}
+
+// These represent the column indexes of the "password_history" Grant Table.
+const (
+	passwordHistoryTblColIndex_Host int = iota
+	passwordHistoryTblColIndex_User
+	passwordHistoryTblColIndex_Password_timestamp
+	passwordHistoryTblColIndex_Password
+)
@@ -0,0 +1,341 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// erMustChangePasswordLogin is ER_MUST_CHANGE_PASSWORD_LOGIN, which is missing from vitess.
+	erMustChangePasswordLogin = 1862
+	// erAccountBlockedByPasswordLock is ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK, which is
+	// missing from vitess.
+	erAccountBlockedByPasswordLock = 3955
+)
+
+// day is the unit of the password lifetimes and lock times.
+const day = 24 * time.Hour
+
+// passwordLocking is the Password_locking object of the User_attributes column of the user table, which holds the
+// FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME options of the account.
+type passwordLocking struct {
+	FailedLoginAttempts  int64 `json:"failed_login_attempts"`
+	PasswordLockTimeDays int64 `json:"password_lock_time_days"`
+}
+
+// loginFailures are the consecutive failed logins of an account, which are only kept in memory like in MySQL.
+type loginFailures struct {
+	count int64
+	// lockedAt is when the account was locked, once the failed logins reach its FAILED_LOGIN_ATTEMPTS
+	lockedAt time.Time
+}
+
+// passwordExpired returns whether the password of the account given has expired, because it was marked as expired or
+// because it's older than its lifetime.
+func passwordExpired(userEntry *User) bool {
+	if userEntry.PasswordExpired {
+		return true
+	}
+	var lifetime int64
+	if userEntry.PasswordLifetime != nil {
+		lifetime = int64(*userEntry.PasswordLifetime)
+	} else if _, val, ok := sql.SystemVariables.GetGlobal("default_password_lifetime"); ok {
+		lifetime, _ = val.(int64)
+	}
+	return lifetime > 0 && time.Since(userEntry.PasswordLastChanged) > time.Duration(lifetime)*day
+}
+
+// CheckExpiredPassword returns whether the password of the account given has expired, in which case the connection
+// must be restricted to the sandbox mode until the password is changed. Clients that can't handle expired passwords
+// are refused with an error instead, unless disconnect_on_expired_password is disabled.
+func (db *MySQLDb) CheckExpiredPassword(user, host string, clientHandlesExpired bool) (bool, error) {
+	if !db.Enabled {
+		return false, nil
+	}
+	userEntry := db.GetUser(user, host, false)
+	if userEntry == nil || !passwordExpired(userEntry) {
+		return false, nil
+	}
+	if !clientHandlesExpired {
+		if _, val, ok := sql.SystemVariables.GetGlobal("disconnect_on_expired_password"); !ok || val == int8(1) {
+			return false, mysql.NewSQLError(erMustChangePasswordLogin, mysql.SSUnknownSQLState,
+				"Your password has expired. To log in you must change it using a client that supports expired passwords.")
+		}
+	}
+	return true, nil
+}
+
+// passwordReusePolicy returns the number of previous passwords and the number of days during which an account can't
+// reuse a password, from its PASSWORD HISTORY and PASSWORD REUSE INTERVAL options or from the password_history and
+// password_reuse_interval system variables.
+func passwordReusePolicy(userEntry *User) (history int64, reuseDays int64) {
+	if userEntry.PasswordReuseHistory != nil {
+		history = int64(*userEntry.PasswordReuseHistory)
+	} else if _, val, ok := sql.SystemVariables.GetGlobal("password_history"); ok {
+		history, _ = val.(int64)
+	}
+	if userEntry.PasswordReuseTime != nil {
+		reuseDays = int64(*userEntry.PasswordReuseTime)
+	} else if _, val, ok := sql.SystemVariables.GetGlobal("password_reuse_interval"); ok {
+		reuseDays, _ = val.(int64)
+	}
+	return history, reuseDays
+}
+
+// RequiresCurrentPassword returns whether the account given has to give its current password to change its own
+// password, from its PASSWORD REQUIRE CURRENT option or from the password_require_current system variable.
+func (db *MySQLDb) RequiresCurrentPassword(userEntry *User) bool {
+	if userEntry.PasswordRequireCurrent != nil {
+		return *userEntry.PasswordRequireCurrent
+	}
+	_, val, ok := sql.SystemVariables.GetGlobal("password_require_current")
+	return ok && val == int8(1)
+}
+
+// passwordHistory returns the password history of the account given, from the most recent password.
+func (db *MySQLDb) passwordHistory(userEntry *User) []*PasswordHistory {
+	entries := db.password_history.data.Get(PasswordHistorySecondaryKey{Host: userEntry.Host, User: userEntry.User})
+	history := make([]*PasswordHistory, len(entries))
+	for i, entry := range entries {
+		history[i] = entry.(*PasswordHistory)
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].PasswordTimestamp.After(history[j].PasswordTimestamp)
+	})
+	return history
+}
+
+// CheckPasswordHistory returns an error if the account given can't change its password to the one given (as stored in
+// the user table), because it's one of its last PASSWORD HISTORY passwords or it was used within its PASSWORD REUSE
+// INTERVAL. Empty passwords can always be reused.
+func (db *MySQLDb) CheckPasswordHistory(userEntry *User, password string) error {
+	if password == "" {
+		return nil
+	}
+	history, reuseDays := passwordReusePolicy(userEntry)
+	now := time.Now()
+	for i, entry := range db.passwordHistory(userEntry) {
+		recent := int64(i) < history || now.Sub(entry.PasswordTimestamp) < time.Duration(reuseDays)*day
+		if !recent {
+			break
+		}
+		if entry.Password == password {
+			return sql.ErrCredentialsContradictToHistory.New(userEntry.User, userEntry.Host)
+		}
+	}
+	return nil
+}
+
+// RecordPasswordHistory adds the current password of the account given to the password_history table, if its password
+// reuse policy needs it, and removes the entries that the policy no longer needs.
+func (db *MySQLDb) RecordPasswordHistory(ctx *sql.Context, userEntry *User) error {
+	history, reuseDays := passwordReusePolicy(userEntry)
+	data := db.password_history.data
+	if history > 0 || reuseDays > 0 {
+		err := data.Put(ctx, &PasswordHistory{
+			Host:              userEntry.Host,
+			User:              userEntry.User,
+			PasswordTimestamp: userEntry.PasswordLastChanged.Truncate(time.Microsecond),
+			Password:          userEntry.Password,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	for i, entry := range db.passwordHistory(userEntry) {
+		if int64(i) < history || now.Sub(entry.PasswordTimestamp) < time.Duration(reuseDays)*day {
+			continue
+		}
+		if err := data.Remove(ctx, nil, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemovePasswordHistory removes the password history of the account given.
+func (db *MySQLDb) RemovePasswordHistory(ctx *sql.Context, userEntry *User) error {
+	return db.password_history.data.Remove(ctx, PasswordHistorySecondaryKey{Host: userEntry.Host, User: userEntry.User}, nil)
+}
+
+// ResetLoginFailures forgets the consecutive failed logins of the account given, which unlocks it if it was locked
+// because of them.
+func (db *MySQLDb) ResetLoginFailures(userEntry *User) {
+	db.loginMu.Lock()
+	defer db.loginMu.Unlock()
+	delete(db.loginFailures, UserPrimaryKey{Host: userEntry.Host, User: userEntry.User})
+}
+
+// checkPasswordLock returns an error if the account given is locked because of its consecutive failed logins.
+func (db *MySQLDb) checkPasswordLock(userEntry *User) error {
+	if userEntry.FailedLoginAttempts <= 0 || userEntry.PasswordLockTime == 0 {
+		return nil
+	}
+	db.loginMu.Lock()
+	defer db.loginMu.Unlock()
+	key := UserPrimaryKey{Host: userEntry.Host, User: userEntry.User}
+	failures, ok := db.loginFailures[key]
+	if !ok || failures.lockedAt.IsZero() {
+		return nil
+	}
+
+	lockTime, remaining := "unlimited", "unlimited"
+	if userEntry.PasswordLockTime > 0 {
+		left := failures.lockedAt.Add(time.Duration(userEntry.PasswordLockTime) * day).Sub(time.Now())
+		if left <= 0 {
+			delete(db.loginFailures, key)
+			return nil
+		}
+		lockTime = fmt.Sprint(userEntry.PasswordLockTime)
+		remaining = fmt.Sprint(int64((left + day - 1) / day))
+	}
+	return mysql.NewSQLError(erAccountBlockedByPasswordLock, mysql.SSUnknownSQLState,
+		"Access denied for user '%v'@'%v'. Account is blocked for %s day(s) (%s day(s) remaining) due to %d consecutive failed logins.",
+		userEntry.User, userEntry.Host, lockTime, remaining, userEntry.FailedLoginAttempts)
+}
+
+// recordLogin keeps track of the consecutive failed logins of the account given, and locks the account once they reach
+// its FAILED_LOGIN_ATTEMPTS. A successful login resets the count.
+func (db *MySQLDb) recordLogin(userEntry *User, success bool) {
+	if userEntry.FailedLoginAttempts <= 0 || userEntry.PasswordLockTime == 0 {
+		return
+	}
+	db.loginMu.Lock()
+	defer db.loginMu.Unlock()
+	key := UserPrimaryKey{Host: userEntry.Host, User: userEntry.User}
+	if success {
+		delete(db.loginFailures, key)
+		return
+	}
+	if db.loginFailures == nil {
+		db.loginFailures = make(map[UserPrimaryKey]*loginFailures)
+	}
+	failures, ok := db.loginFailures[key]
+	if !ok {
+		failures = &loginFailures{}
+		db.loginFailures[key] = failures
+	}
+	failures.count++
+	if failures.count >= userEntry.FailedLoginAttempts && failures.lockedAt.IsZero() {
+		failures.lockedAt = time.Now()
+	}
+}
+
+// userAttributesToRow returns the value of the User_attributes column of the user table for the user, which adds the
+// password locking options to its attributes.
+func (u *User) userAttributesToRow() *string {
+	if u.FailedLoginAttempts == 0 && u.PasswordLockTime == 0 {
+		return u.Attributes
+	}
+	attributes := make(map[string]interface{})
+	if u.Attributes != nil {
+		if err := json.Unmarshal([]byte(*u.Attributes), &attributes); err != nil {
+			return u.Attributes
+		}
+	}
+	attributes["Password_locking"] = passwordLocking{
+		FailedLoginAttempts:  u.FailedLoginAttempts,
+		PasswordLockTimeDays: u.PasswordLockTime,
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return u.Attributes
+	}
+	str := string(data)
+	return &str
+}
+
+// userAttributesFromRow returns the attributes and the password locking options of an account in the User_attributes
+// column of the user table.
+func userAttributesFromRow(ctx *sql.Context, val interface{}) (attributes *string, failedLoginAttempts int64, passwordLockTime int64) {
+	var str string
+	switch val := val.(type) {
+	case string:
+		str = val
+	case types.JSONValue:
+		var err error
+		if str, err = val.ToString(ctx); err != nil {
+			return nil, 0, 0
+		}
+	default:
+		return nil, 0, 0
+	}
+	var parsed map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(str), &parsed); err != nil {
+		return &str, 0, 0
+	}
+	lockingData, ok := parsed["Password_locking"]
+	if !ok {
+		return &str, 0, 0
+	}
+	var locking passwordLocking
+	if err := json.Unmarshal(lockingData, &locking); err != nil {
+		return &str, 0, 0
+	}
+
+	delete(parsed, "Password_locking")
+	if len(parsed) > 0 {
+		data, err := json.Marshal(parsed)
+		if err != nil {
+			return &str, 0, 0
+		}
+		rest := string(data)
+		attributes = &rest
+	}
+	return attributes, locking.FailedLoginAttempts, locking.PasswordLockTimeDays
+}
+
+// uint16FromRow returns the value of a nullable SMALLINT UNSIGNED column of the user table.
+func uint16FromRow(val interface{}) *uint16 {
+	if v, ok := val.(uint16); ok {
+		return &v
+	}
+	return nil
+}
+
+// enumBoolFromRow returns the value of a nullable ENUM('N','Y') column of the user table.
+func enumBoolFromRow(val interface{}) *bool {
+	if v, ok := val.(uint16); ok {
+		b := v == 2
+		return &b
+	}
+	return nil
+}
+
+// enumBoolToRow returns the value of an ENUM('N','Y') column of the user table.
+func enumBoolToRow(b bool) uint16 {
+	if b {
+		return 2
+	}
+	return 1
+}
+
+func equalUint16Ptr(a, b *uint16) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+func equalBoolPtr(a, b *bool) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func nativePasswordHash(password string) string {
+	hash := sha1.Sum([]byte(password))
+	hash = sha1.Sum(hash[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func TestPasswordManagement(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	db.AddRootAccount()
+	persister := &capturingPersister{}
+	db.SetPersister(persister)
+	lifetime, history, reuseTime, requireCurrent := uint16(30), uint16(5), uint16(365), false
+	users := []*User{
+		{User: "locking", FailedLoginAttempts: 2, PasswordLockTime: 1},
+		{User: "unbounded", FailedLoginAttempts: 1, PasswordLockTime: -1},
+		{User: "expired", PasswordExpired: true},
+		{User: "old", PasswordLifetime: &lifetime, PasswordLastChanged: time.Now().Add(-31 * day)},
+		{User: "recent", PasswordLifetime: &lifetime, PasswordReuseHistory: &history, PasswordReuseTime: &reuseTime, PasswordRequireCurrent: &requireCurrent},
+	}
+	for _, u := range users {
+		u.Host = "localhost"
+		u.Plugin = "mysql_native_password"
+		u.Password = nativePasswordHash("pass")
+		u.PrivilegeSet = NewPrivilegeSet()
+		if u.PasswordLastChanged.IsZero() {
+			u.PasswordLastChanged = time.Now()
+		}
+		u.PasswordLastChanged = u.PasswordLastChanged.Truncate(time.Second)
+		require.NoError(t, db.user.data.Put(ctx, u))
+	}
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 3306}
+	login := func(user, password string) error {
+		salt, err := db.Salt()
+		require.NoError(t, err)
+		_, err = db.ValidateHash(salt, user, mysql.ScrambleMysqlNativePassword(salt, []byte(password)), addr)
+		return err
+	}
+	requireErrorCode := func(err error, code int) {
+		require.Error(t, err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(t, ok, err.Error())
+		require.Equal(t, code, sqlErr.Number(), err.Error())
+	}
+
+	// a successful login resets the count of failed logins
+	requireErrorCode(login("locking", "wrong"), mysql.ERAccessDeniedError)
+	require.NoError(t, login("locking", "pass"))
+	requireErrorCode(login("locking", "wrong"), mysql.ERAccessDeniedError)
+	requireErrorCode(login("locking", "wrong"), mysql.ERAccessDeniedError)
+	err := login("locking", "pass")
+	requireErrorCode(err, erAccountBlockedByPasswordLock)
+	require.Contains(t, err.Error(), "Account is blocked for 1 day(s) (1 day(s) remaining) due to 2 consecutive failed logins.")
+
+	// the lock ends once the lock time passes
+	db.loginFailures[UserPrimaryKey{Host: "localhost", User: "locking"}].lockedAt = time.Now().Add(-day)
+	require.NoError(t, login("locking", "pass"))
+
+	requireErrorCode(login("unbounded", "wrong"), mysql.ERAccessDeniedError)
+	err = login("unbounded", "pass")
+	requireErrorCode(err, erAccountBlockedByPasswordLock)
+	require.Contains(t, err.Error(), "Account is blocked for unlimited day(s) (unlimited day(s) remaining)")
+
+	// expired passwords still log in, but only clients that handle expired passwords are allowed to stay connected
+	require.NoError(t, login("expired", "pass"))
+	require.NoError(t, login("old", "pass"))
+	requireErrorCode(login("old", "wrong"), mysql.ERAccessDeniedError)
+	for _, user := range []string{"expired", "old"} {
+		_, err = db.CheckExpiredPassword(user, "localhost", false)
+		requireErrorCode(err, erMustChangePasswordLogin)
+		expired, err := db.CheckExpiredPassword(user, "localhost", true)
+		require.NoError(t, err)
+		require.True(t, expired, user)
+	}
+	require.NoError(t, login("recent", "pass"))
+	expired, err := db.CheckExpiredPassword("recent", "localhost", false)
+	require.NoError(t, err)
+	require.False(t, expired)
+
+	// the options are kept in the rows of the user table and in the persisted data
+	for _, u := range users {
+		entry, err := (&User{}).NewFromRow(ctx, u.ToRow(ctx))
+		require.NoError(t, err)
+		require.True(t, u.Equals(ctx, entry), u.User)
+	}
+	require.NoError(t, db.Persist(ctx))
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, persister.data))
+	for _, u := range users {
+		require.True(t, u.Equals(ctx, loaded.GetUser(u.User, u.Host, false)), u.User)
+	}
+}
+
+func TestPasswordHistory(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	persister := &capturingPersister{}
+	db.SetPersister(persister)
+	history, reuseTime := uint16(2), uint16(0)
+	u := &User{
+		User:                 "user",
+		Host:                 "localhost",
+		Plugin:               "mysql_native_password",
+		PrivilegeSet:         NewPrivilegeSet(),
+		PasswordReuseHistory: &history,
+		PasswordReuseTime:    &reuseTime,
+	}
+	changePassword := func(password string) error {
+		hash := nativePasswordHash(password)
+		if err := db.CheckPasswordHistory(u, hash); err != nil {
+			return err
+		}
+		u.Password = hash
+		u.PasswordLastChanged = u.PasswordLastChanged.Add(time.Second)
+		return db.RecordPasswordHistory(ctx, u)
+	}
+
+	u.PasswordLastChanged = time.Now().Add(-time.Hour).UTC()
+	require.NoError(t, changePassword("first"))
+	require.NoError(t, changePassword("second"))
+	require.True(t, sql.ErrCredentialsContradictToHistory.Is(changePassword("first")))
+	require.True(t, sql.ErrCredentialsContradictToHistory.Is(changePassword("second")))
+	require.NoError(t, changePassword("third"))
+	// only the last PASSWORD HISTORY passwords are kept
+	require.Len(t, db.passwordHistory(u), 2)
+	require.NoError(t, changePassword("first"))
+
+	// the history is kept in the persisted data
+	require.NoError(t, db.Persist(ctx))
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, persister.data))
+	require.True(t, sql.ErrCredentialsContradictToHistory.Is(loaded.CheckPasswordHistory(u, nativePasswordHash("third"))))
+	require.NoError(t, loaded.CheckPasswordHistory(u, nativePasswordHash("second")))
+
+	require.NoError(t, db.RemovePasswordHistory(ctx, u))
+	require.Empty(t, db.passwordHistory(u))
+}
@@ -143,6 +143,7 @@ var _ sql.TableCopierDatabase = PrivilegedDatabase{}
 var _ sql.ReadOnlyDatabase = PrivilegedDatabase{}
 var _ sql.TemporaryTableDatabase = PrivilegedDatabase{}
 var _ sql.CollatedDatabase = PrivilegedDatabase{}
+var _ sql.CommentedDatabase = PrivilegedDatabase{}
 var _ sql.ViewDatabase = PrivilegedDatabase{}
 
 // NewPrivilegedDatabase returns a new PrivilegedDatabase.
@@ -476,6 +477,22 @@ func (pdb PrivilegedDatabase) SetCollation(ctx *sql.Context, collation sql.Colla
 	return sql.ErrDatabaseCollationsNotSupported.New(pdb.db.Name())
 }
 
+// GetComment implements the interface sql.CommentedDatabase.
+func (pdb PrivilegedDatabase) GetComment(ctx *sql.Context) string {
+	if db, ok := pdb.db.(sql.CommentedDatabase); ok {
+		return db.GetComment(ctx)
+	}
+	return ""
+}
+
+// SetComment implements the interface sql.CommentedDatabase.
+func (pdb PrivilegedDatabase) SetComment(ctx *sql.Context, comment string) error {
+	if db, ok := pdb.db.(sql.CommentedDatabase); ok {
+		return db.SetComment(ctx, comment)
+	}
+	return sql.ErrDatabaseCommentsNotSupported.New(pdb.db.Name())
+}
+
 // Unwrap returns the wrapped sql.Database.
 func (pdb PrivilegedDatabase) Unwrap() sql.Database {
 	return pdb.db
@@ -716,7 +716,123 @@ func (rcv *User) Identity() []byte {
 	return nil
 }
 
-const UserNumFields = 9
+func (rcv *User) SslType() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *User) SslCipher() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *User) X509Issuer() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(26))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *User) X509Subject() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(28))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *User) PasswordExpired() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(30))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *User) MutatePasswordExpired(n bool) bool {
+	return rcv._tab.MutateBoolSlot(30, n)
+}
+
+func (rcv *User) PasswordLifetime() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(32))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordLifetime(n int32) bool {
+	return rcv._tab.MutateInt32Slot(32, n)
+}
+
+func (rcv *User) PasswordReuseHistory() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(34))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordReuseHistory(n int32) bool {
+	return rcv._tab.MutateInt32Slot(34, n)
+}
+
+func (rcv *User) PasswordReuseTime() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(36))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordReuseTime(n int32) bool {
+	return rcv._tab.MutateInt32Slot(36, n)
+}
+
+func (rcv *User) PasswordRequireCurrent() int8 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(38))
+	if o != 0 {
+		return rcv._tab.GetInt8(o + rcv._tab.Pos)
+	}
+	return -1
+}
+
+func (rcv *User) MutatePasswordRequireCurrent(n int8) bool {
+	return rcv._tab.MutateInt8Slot(38, n)
+}
+
+func (rcv *User) FailedLoginAttempts() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(40))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutateFailedLoginAttempts(n int64) bool {
+	return rcv._tab.MutateInt64Slot(40, n)
+}
+
+func (rcv *User) PasswordLockTime() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(42))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *User) MutatePasswordLockTime(n int64) bool {
+	return rcv._tab.MutateInt64Slot(42, n)
+}
+
+const UserNumFields = 20
 
 func UserStart(builder *flatbuffers.Builder) {
 	builder.StartObject(UserNumFields)
@@ -748,6 +864,39 @@ func UserAddAttributes(builder *flatbuffers.Builder, attributes flatbuffers.UOff
 func UserAddIdentity(builder *flatbuffers.Builder, identity flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(8, flatbuffers.UOffsetT(identity), 0)
 }
+func UserAddSslType(builder *flatbuffers.Builder, sslType flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(9, flatbuffers.UOffsetT(sslType), 0)
+}
+func UserAddSslCipher(builder *flatbuffers.Builder, sslCipher flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(10, flatbuffers.UOffsetT(sslCipher), 0)
+}
+func UserAddX509Issuer(builder *flatbuffers.Builder, x509Issuer flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(11, flatbuffers.UOffsetT(x509Issuer), 0)
+}
+func UserAddX509Subject(builder *flatbuffers.Builder, x509Subject flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(12, flatbuffers.UOffsetT(x509Subject), 0)
+}
+func UserAddPasswordExpired(builder *flatbuffers.Builder, passwordExpired bool) {
+	builder.PrependBoolSlot(13, passwordExpired, false)
+}
+func UserAddPasswordLifetime(builder *flatbuffers.Builder, passwordLifetime int32) {
+	builder.PrependInt32Slot(14, passwordLifetime, -1)
+}
+func UserAddPasswordReuseHistory(builder *flatbuffers.Builder, passwordReuseHistory int32) {
+	builder.PrependInt32Slot(15, passwordReuseHistory, -1)
+}
+func UserAddPasswordReuseTime(builder *flatbuffers.Builder, passwordReuseTime int32) {
+	builder.PrependInt32Slot(16, passwordReuseTime, -1)
+}
+func UserAddPasswordRequireCurrent(builder *flatbuffers.Builder, passwordRequireCurrent int8) {
+	builder.PrependInt8Slot(17, passwordRequireCurrent, -1)
+}
+func UserAddFailedLoginAttempts(builder *flatbuffers.Builder, failedLoginAttempts int64) {
+	builder.PrependInt64Slot(18, failedLoginAttempts, 0)
+}
+func UserAddPasswordLockTime(builder *flatbuffers.Builder, passwordLockTime int64) {
+	builder.PrependInt64Slot(19, passwordLockTime, 0)
+}
 func UserEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -1006,6 +1155,107 @@ func ReplicaSourceInfoEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
 
+type PasswordHistory struct {
+	_tab flatbuffers.Table
+}
+
+func InitPasswordHistoryRoot(o *PasswordHistory, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	o.Init(buf, n+offset)
+	if PasswordHistoryNumFields < o.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func TryGetRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) (*PasswordHistory, error) {
+	x := &PasswordHistory{}
+	return x, InitPasswordHistoryRoot(x, buf, offset)
+}
+
+func GetRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) *PasswordHistory {
+	x := &PasswordHistory{}
+	InitPasswordHistoryRoot(x, buf, offset)
+	return x
+}
+
+func TryGetSizePrefixedRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) (*PasswordHistory, error) {
+	x := &PasswordHistory{}
+	return x, InitPasswordHistoryRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func GetSizePrefixedRootAsPasswordHistory(buf []byte, offset flatbuffers.UOffsetT) *PasswordHistory {
+	x := &PasswordHistory{}
+	InitPasswordHistoryRoot(x, buf, offset+flatbuffers.SizeUint32)
+	return x
+}
+
+func (rcv *PasswordHistory) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *PasswordHistory) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *PasswordHistory) Host() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *PasswordHistory) User() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *PasswordHistory) PasswordTimestamp() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *PasswordHistory) MutatePasswordTimestamp(n int64) bool {
+	return rcv._tab.MutateInt64Slot(8, n)
+}
+
+func (rcv *PasswordHistory) Password() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const PasswordHistoryNumFields = 4
+
+func PasswordHistoryStart(builder *flatbuffers.Builder) {
+	builder.StartObject(PasswordHistoryNumFields)
+}
+func PasswordHistoryAddHost(builder *flatbuffers.Builder, host flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(host), 0)
+}
+func PasswordHistoryAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(user), 0)
+}
+func PasswordHistoryAddPasswordTimestamp(builder *flatbuffers.Builder, passwordTimestamp int64) {
+	builder.PrependInt64Slot(2, passwordTimestamp, 0)
+}
+func PasswordHistoryAddPassword(builder *flatbuffers.Builder, password flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(password), 0)
+}
+func PasswordHistoryEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
 type MySQLDb struct {
 	_tab flatbuffers.Table
 }
@@ -1155,7 +1405,42 @@ func (rcv *MySQLDb) ReplicaSourceInfoLength() int {
 	return 0
 }
 
-const MySQLDbNumFields = 3
+func (rcv *MySQLDb) PasswordHistory(obj *PasswordHistory, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *MySQLDb) TryPasswordHistory(obj *PasswordHistory, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if PasswordHistoryNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *MySQLDb) PasswordHistoryLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const MySQLDbNumFields = 4
 
 func MySQLDbStart(builder *flatbuffers.Builder) {
 	builder.StartObject(MySQLDbNumFields)
@@ -1178,6 +1463,12 @@ func MySQLDbAddReplicaSourceInfo(builder *flatbuffers.Builder, replicaSourceInfo
 func MySQLDbStartReplicaSourceInfoVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(4, numElems, 4)
 }
+func MySQLDbAddPasswordHistory(builder *flatbuffers.Builder, passwordHistory flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(passwordHistory), 0)
+}
+func MySQLDbStartPasswordHistoryVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func MySQLDbEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -0,0 +1,151 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"crypto/tls"
+	"crypto/x509/pkix"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// The values of the ssl_type column of the user table, which is the TLS requirement of an account set with the
+// REQUIRE clause of CREATE USER and ALTER USER.
+const (
+	// SslTypeNone is REQUIRE NONE, which is the default: the account may connect with or without TLS.
+	SslTypeNone = ""
+	// SslTypeAny is REQUIRE SSL: the account must connect with TLS.
+	SslTypeAny = "ANY"
+	// SslTypeX509 is REQUIRE X509: the account must connect with TLS, and with a valid client certificate.
+	SslTypeX509 = "X509"
+	// SslTypeSpecified is REQUIRE ISSUER, SUBJECT or CIPHER: the account must connect with TLS, and the client
+	// certificate and cipher must match the ones of the account that aren't empty.
+	SslTypeSpecified = "SPECIFIED"
+)
+
+// VerifyTLSRequirements returns an error if the connection of the account given, which is an account name and host
+// as returned by the authentication, doesn't meet the TLS requirements of the account. |state| is the state of the
+// TLS connection, or nil if the client didn't connect with TLS. Client certificates are only valid if the server
+// verified them, which requires a tls.Config with a ClientAuth of tls.VerifyClientCertIfGiven or stricter.
+func (db *MySQLDb) VerifyTLSRequirements(user, host string, state *tls.ConnectionState) error {
+	if !db.Enabled {
+		return nil
+	}
+	userEntry := db.GetUser(user, host, false)
+	if userEntry == nil || userEntry.meetsTLSRequirements(state) {
+		return nil
+	}
+	return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// meetsTLSRequirements returns whether a connection with the TLS state given meets the TLS requirements of the user.
+func (u *User) meetsTLSRequirements(state *tls.ConnectionState) bool {
+	if u.SslType == SslTypeNone {
+		return true
+	}
+	if state == nil {
+		return false
+	}
+	if u.SslType == SslTypeAny {
+		return true
+	}
+	if u.SslCipher != "" && !strings.EqualFold(u.SslCipher, tls.CipherSuiteName(state.CipherSuite)) {
+		return false
+	}
+	if u.SslType == SslTypeSpecified && u.X509Issuer == "" && u.X509Subject == "" {
+		return true
+	}
+
+	// the first certificate of a verified chain is the one of the client
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return false
+	}
+	cert := state.VerifiedChains[0][0]
+	if u.X509Issuer != "" && u.X509Issuer != DistinguishedName(cert.Issuer) {
+		return false
+	}
+	if u.X509Subject != "" && u.X509Subject != DistinguishedName(cert.Subject) {
+		return false
+	}
+	return true
+}
+
+// distinguishedNameAttributes are the short names of the attributes of distinguished names, by their object
+// identifier.
+var distinguishedNameAttributes = map[string]string{
+	"2.5.4.3":              "CN",
+	"2.5.4.5":              "serialNumber",
+	"2.5.4.6":              "C",
+	"2.5.4.7":              "L",
+	"2.5.4.8":              "ST",
+	"2.5.4.9":              "street",
+	"2.5.4.10":             "O",
+	"2.5.4.11":             "OU",
+	"2.5.4.17":             "postalCode",
+	"1.2.840.113549.1.9.1": "emailAddress",
+}
+
+// DistinguishedName formats the name of a certificate subject or issuer the way MySQL does in the ISSUER and SUBJECT
+// options of the REQUIRE clause, such as "/C=SE/ST=Stockholm/O=MySQL/CN=client". The attributes are in the order of
+// the certificate.
+func DistinguishedName(name pkix.Name) string {
+	sb := strings.Builder{}
+	for _, rdn := range name.ToRDNSequence() {
+		for _, attr := range rdn {
+			typ, ok := distinguishedNameAttributes[attr.Type.String()]
+			if !ok {
+				typ = attr.Type.String()
+			}
+			fmt.Fprintf(&sb, "/%s=%v", typ, attr.Value)
+		}
+	}
+	return sb.String()
+}
+
+// sslTypeFromRow returns the TLS requirement in the ssl_type column of a row of the user table.
+func sslTypeFromRow(val interface{}) string {
+	enumType := userTblSchema[userTblColIndex_ssl_type].Type.(sql.EnumType)
+	if idx, ok := val.(uint16); ok {
+		if sslType, ok := enumType.At(int(idx)); ok {
+			return sslType
+		}
+	}
+	return SslTypeNone
+}
+
+// sslTypeToRow returns the value of the ssl_type column of a row of the user table for the TLS requirement given.
+func sslTypeToRow(sslType string) uint16 {
+	enumType := userTblSchema[userTblColIndex_ssl_type].Type.(sql.EnumType)
+	if idx := enumType.IndexOf(sslType); idx > 0 {
+		return uint16(idx)
+	}
+	return uint16(enumType.IndexOf(SslTypeNone))
+}
+
+// blobFromRow returns the string in a BLOB column of a row of the user table.
+func blobFromRow(val interface{}) string {
+	switch val := val.(type) {
+	case []byte:
+		return string(val)
+	case string:
+		return val
+	default:
+		return ""
+	}
+}
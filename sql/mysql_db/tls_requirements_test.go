@@ -0,0 +1,116 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+type capturingPersister struct {
+	data []byte
+}
+
+func (p *capturingPersister) Persist(ctx *sql.Context, data []byte) error {
+	p.data = data
+	return nil
+}
+
+func TestTLSRequirements(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	db := CreateEmptyMySQLDb()
+	db.AddRootAccount()
+	persister := &capturingPersister{}
+	db.SetPersister(persister)
+	users := []*User{
+		{User: "none", Host: "localhost"},
+		{User: "ssl", Host: "localhost", SslType: SslTypeAny},
+		{User: "x509", Host: "localhost", SslType: SslTypeX509},
+		{User: "issuer", Host: "localhost", SslType: SslTypeSpecified, X509Issuer: "/C=SE/O=MySQL/CN=CA"},
+		{User: "subject", Host: "localhost", SslType: SslTypeSpecified, X509Subject: "/C=SE/O=MySQL/CN=client"},
+		{User: "cipher", Host: "localhost", SslType: SslTypeSpecified, SslCipher: "TLS_AES_128_GCM_SHA256"},
+	}
+	for _, u := range users {
+		u.PrivilegeSet = NewPrivilegeSet()
+		u.PasswordLastChanged = time.Unix(1, 0).UTC()
+		require.NoError(t, db.user.data.Put(ctx, u))
+	}
+
+	ca := &x509.Certificate{Subject: pkix.Name{Country: []string{"SE"}, Organization: []string{"MySQL"}, CommonName: "CA"}}
+	client := &x509.Certificate{
+		Issuer:  ca.Subject,
+		Subject: pkix.Name{Country: []string{"SE"}, Organization: []string{"MySQL"}, CommonName: "client"},
+	}
+	other := &x509.Certificate{
+		Issuer:  ca.Subject,
+		Subject: pkix.Name{Country: []string{"SE"}, Organization: []string{"MySQL"}, CommonName: "other"},
+	}
+	require.Equal(t, "/C=SE/O=MySQL/CN=client", DistinguishedName(client.Subject))
+
+	noCert := &tls.ConnectionState{CipherSuite: tls.TLS_AES_128_GCM_SHA256}
+	clientCert := &tls.ConnectionState{
+		CipherSuite:    tls.TLS_AES_256_GCM_SHA384,
+		VerifiedChains: [][]*x509.Certificate{{client, ca}},
+	}
+	otherCert := &tls.ConnectionState{
+		CipherSuite:    tls.TLS_AES_128_GCM_SHA256,
+		VerifiedChains: [][]*x509.Certificate{{other, ca}},
+	}
+	tests := []struct {
+		user    string
+		state   *tls.ConnectionState
+		allowed bool
+	}{
+		{"none", nil, true},
+		{"ssl", nil, false},
+		{"ssl", noCert, true},
+		{"x509", noCert, false},
+		{"x509", otherCert, true},
+		{"issuer", clientCert, true},
+		{"issuer", otherCert, true},
+		{"subject", clientCert, true},
+		{"subject", otherCert, false},
+		{"cipher", noCert, true},
+		{"cipher", clientCert, false},
+	}
+	for _, test := range tests {
+		err := db.VerifyTLSRequirements(test.user, "localhost", test.state)
+		if test.allowed {
+			require.NoError(t, err, test.user)
+		} else {
+			require.Error(t, err, test.user)
+		}
+	}
+
+	// the requirements are kept in the rows of the user table and in the persisted data
+	for _, u := range users {
+		entry, err := (&User{}).NewFromRow(ctx, u.ToRow(ctx))
+		require.NoError(t, err)
+		require.True(t, u.Equals(ctx, entry), u.User)
+	}
+	require.NoError(t, db.Persist(ctx))
+	loaded := CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, persister.data))
+	for _, u := range users {
+		require.True(t, u.Equals(ctx, loaded.GetUser(u.User, u.Host, false)), u.User)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // User represents a user from the user Grant Table.
@@ -35,6 +36,28 @@ type User struct {
 	Locked              bool
 	Attributes          *string
 	Identity            string
+	// SslType is the TLS requirement of the account, which is one of the SslType constants.
+	SslType     string
+	SslCipher   string
+	X509Issuer  string
+	X509Subject string
+	// PasswordExpired is whether the password was marked as expired, which must then be changed to log in.
+	PasswordExpired bool
+	// PasswordLifetime is the number of days the password is valid for, where 0 means it never expires. Nil uses the
+	// default_password_lifetime system variable.
+	PasswordLifetime *uint16
+	// PasswordReuseHistory and PasswordReuseTime are the number of previous passwords, and the number of days during
+	// which a previous password, can't be reused. Nil uses the password_history and password_reuse_interval system
+	// variables.
+	PasswordReuseHistory *uint16
+	PasswordReuseTime    *uint16
+	// PasswordRequireCurrent is whether changing the password requires the current one. Nil uses the
+	// password_require_current system variable.
+	PasswordRequireCurrent *bool
+	// FailedLoginAttempts is the number of consecutive failed logins that lock the account for PasswordLockTime days,
+	// where 0 disables the locking. A PasswordLockTime of -1 locks the account until the server restarts.
+	FailedLoginAttempts int64
+	PasswordLockTime    int64
 	IsSuperUser         bool
 	//TODO: add the remaining fields
 
@@ -51,25 +74,33 @@ func (u *User) NewFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Entry, er
 		return nil, err
 	}
 	//TODO: once the remaining fields are added, fill those in as well
-	var attributes *string
+	attributes, failedLoginAttempts, passwordLockTime := userAttributesFromRow(ctx, row[userTblColIndex_User_attributes])
 	passwordLastChanged := time.Now().UTC()
-	if val, ok := row[userTblColIndex_User_attributes].(string); ok {
-		attributes = &val
-	}
 	if val, ok := row[userTblColIndex_password_last_changed].(time.Time); ok {
 		passwordLastChanged = val
 	}
 	return &User{
-		User:                row[userTblColIndex_User].(string),
-		Host:                row[userTblColIndex_Host].(string),
-		PrivilegeSet:        u.rowToPrivSet(ctx, row),
-		Plugin:              row[userTblColIndex_plugin].(string),
-		Password:            row[userTblColIndex_authentication_string].(string),
-		PasswordLastChanged: passwordLastChanged,
-		Locked:              row[userTblColIndex_account_locked].(uint16) == 2,
-		Attributes:          attributes,
-		Identity:            row[userTblColIndex_identity].(string),
-		IsRole:              false,
+		User:                   row[userTblColIndex_User].(string),
+		Host:                   row[userTblColIndex_Host].(string),
+		PrivilegeSet:           u.rowToPrivSet(ctx, row),
+		Plugin:                 row[userTblColIndex_plugin].(string),
+		Password:               row[userTblColIndex_authentication_string].(string),
+		PasswordLastChanged:    passwordLastChanged,
+		Locked:                 row[userTblColIndex_account_locked].(uint16) == 2,
+		Attributes:             attributes,
+		Identity:               row[userTblColIndex_identity].(string),
+		SslType:                sslTypeFromRow(row[userTblColIndex_ssl_type]),
+		SslCipher:              blobFromRow(row[userTblColIndex_ssl_cipher]),
+		X509Issuer:             blobFromRow(row[userTblColIndex_x509_issuer]),
+		X509Subject:            blobFromRow(row[userTblColIndex_x509_subject]),
+		PasswordExpired:        row[userTblColIndex_password_expired].(uint16) == 2,
+		PasswordLifetime:       uint16FromRow(row[userTblColIndex_password_lifetime]),
+		PasswordReuseHistory:   uint16FromRow(row[userTblColIndex_Password_reuse_history]),
+		PasswordReuseTime:      uint16FromRow(row[userTblColIndex_Password_reuse_time]),
+		PasswordRequireCurrent: enumBoolFromRow(row[userTblColIndex_Password_require_current]),
+		FailedLoginAttempts:    failedLoginAttempts,
+		PasswordLockTime:       passwordLockTime,
+		IsRole:                 false,
 	}, nil
 }
 
@@ -100,11 +131,33 @@ func (u *User) ToRow(ctx *sql.Context) sql.Row {
 	row[userTblColIndex_authentication_string] = u.Password
 	row[userTblColIndex_password_last_changed] = u.PasswordLastChanged
 	row[userTblColIndex_identity] = u.Identity
+	row[userTblColIndex_ssl_type] = sslTypeToRow(u.SslType)
+	row[userTblColIndex_ssl_cipher] = []byte(u.SslCipher)
+	row[userTblColIndex_x509_issuer] = []byte(u.X509Issuer)
+	row[userTblColIndex_x509_subject] = []byte(u.X509Subject)
 	if u.Locked {
 		row[userTblColIndex_account_locked] = uint16(2)
 	}
-	if u.Attributes != nil {
-		row[userTblColIndex_User_attributes] = *u.Attributes
+	if u.PasswordExpired {
+		row[userTblColIndex_password_expired] = uint16(2)
+	}
+	if u.PasswordLifetime != nil {
+		row[userTblColIndex_password_lifetime] = *u.PasswordLifetime
+	}
+	if u.PasswordReuseHistory != nil {
+		row[userTblColIndex_Password_reuse_history] = *u.PasswordReuseHistory
+	}
+	if u.PasswordReuseTime != nil {
+		row[userTblColIndex_Password_reuse_time] = *u.PasswordReuseTime
+	}
+	if u.PasswordRequireCurrent != nil {
+		row[userTblColIndex_Password_require_current] = enumBoolToRow(*u.PasswordRequireCurrent)
+	}
+	if attributes := u.userAttributesToRow(); attributes != nil {
+		row[userTblColIndex_User_attributes] = *attributes
+		if doc, _, err := types.JSON.Convert(*attributes); err == nil {
+			row[userTblColIndex_User_attributes] = doc
+		}
 	}
 	u.privSetToRow(ctx, row)
 	return row
@@ -123,6 +176,17 @@ func (u *User) Equals(ctx *sql.Context, otherEntry in_mem_table.Entry) bool {
 		u.Plugin != otherUser.Plugin ||
 		u.Password != otherUser.Password ||
 		u.Identity != otherUser.Identity ||
+		u.SslType != otherUser.SslType ||
+		u.SslCipher != otherUser.SslCipher ||
+		u.X509Issuer != otherUser.X509Issuer ||
+		u.X509Subject != otherUser.X509Subject ||
+		u.PasswordExpired != otherUser.PasswordExpired ||
+		!equalUint16Ptr(u.PasswordLifetime, otherUser.PasswordLifetime) ||
+		!equalUint16Ptr(u.PasswordReuseHistory, otherUser.PasswordReuseHistory) ||
+		!equalUint16Ptr(u.PasswordReuseTime, otherUser.PasswordReuseTime) ||
+		!equalBoolPtr(u.PasswordRequireCurrent, otherUser.PasswordRequireCurrent) ||
+		u.FailedLoginAttempts != otherUser.FailedLoginAttempts ||
+		u.PasswordLockTime != otherUser.PasswordLockTime ||
 		!u.PasswordLastChanged.Equal(otherUser.PasswordLastChanged) ||
 		u.Locked != otherUser.Locked ||
 		!u.PrivilegeSet.Equals(otherUser.PrivilegeSet) ||
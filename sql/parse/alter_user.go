@@ -0,0 +1,263 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var alterUserRegex = regexp.MustCompile(`(?i)^\s*ALTER\s+USER\s`)
+
+// parseAlterUser parses the first statement of the query given if it is an ALTER USER statement, which the parser
+// doesn't support. Only the authentication, password management and account locking options are supported. Returns
+// whether the statement was an ALTER USER statement, the node for it, and the text of the statement along with the
+// remainder of the query after it.
+func parseAlterUser(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	if !alterUserRegex.MatchString(query) {
+		return false, nil, "", "", nil
+	}
+
+	t := newStatementTokenizer(query)
+	// Skip the leading ALTER and USER keywords
+	t.next()
+	t.next()
+
+	node, err := parseAlterUserStatement(ctx, t)
+	if err != nil {
+		return true, nil, query, "", err
+	}
+	if t.typ != 0 {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsed, remainder := t.split()
+	return true, node, parsed, remainder, nil
+}
+
+func parseAlterUserStatement(ctx *sql.Context, t *statementTokenizer) (sql.Node, error) {
+	node := &plan.AlterUser{MySQLDb: sql.UnresolvedDatabase("mysql")}
+	if t.keyword("IF") {
+		if !t.keyword("EXISTS") {
+			return nil, t.errorf("expected IF EXISTS")
+		}
+		node.IfExists = true
+	}
+	for {
+		user, err := parseAlterUserAccount(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		if t.keyword("IDENTIFIED") {
+			if err = parseAlterUserAuthentication(t, &user); err != nil {
+				return nil, err
+			}
+		}
+		node.Users = append(node.Users, user)
+		if !t.char(',') {
+			break
+		}
+	}
+	if err := parseAlterUserOptions(t, &node.Options); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// parseAlterUserAccount reads an account name, which may be USER() or CURRENT_USER for the session user. Names without
+// a host match any host.
+func parseAlterUserAccount(ctx *sql.Context, t *statementTokenizer) (plan.AuthenticatedUser, error) {
+	if t.typ != sqlparser.STRING && (t.keyword("USER") || t.keyword("CURRENT_USER")) {
+		if t.char('(') && !t.char(')') {
+			return plan.AuthenticatedUser{}, t.errorf("expected )")
+		}
+		client := ctx.Session.Client()
+		return plan.AuthenticatedUser{UserName: plan.UserName{
+			Name:    client.User,
+			Host:    client.Address,
+			AnyHost: client.Address == "%",
+		}}, nil
+	}
+	name, err := t.identifier()
+	if err != nil {
+		return plan.AuthenticatedUser{}, err
+	}
+	host := "%"
+	if t.char('@') {
+		if host, err = t.identifier(); err != nil {
+			return plan.AuthenticatedUser{}, err
+		}
+	}
+	return plan.AuthenticatedUser{UserName: plan.UserName{
+		Name:    name,
+		Host:    host,
+		AnyHost: host == "%",
+	}}, nil
+}
+
+// parseAlterUserAuthentication reads the authentication following IDENTIFIED into the user given, which is either
+// BY 'password' [REPLACE 'current_password'] or WITH plugin [BY 'password' [REPLACE 'current_password'] | AS 'hash'].
+func parseAlterUserAuthentication(t *statementTokenizer, user *plan.AuthenticatedUser) error {
+	plugin := ""
+	if t.keyword("WITH") {
+		var err error
+		if plugin, err = t.identifier(); err != nil {
+			return err
+		}
+		if t.keyword("AS") {
+			hash, err := parseAlterUserString(t)
+			if err != nil {
+				return err
+			}
+			user.Auth1 = plan.NewOtherAuthentication(hash, plugin)
+			return nil
+		}
+		if !t.keyword("BY") {
+			user.Auth1 = plan.NewOtherAuthentication("", plugin)
+			return nil
+		}
+	} else if !t.keyword("BY") {
+		return t.errorf("expected BY or WITH")
+	}
+	if t.keyword("RANDOM") {
+		return sql.ErrUnsupportedFeature.New("IDENTIFIED BY RANDOM PASSWORD")
+	}
+	password, err := parseAlterUserString(t)
+	if err != nil {
+		return err
+	}
+	if t.keyword("REPLACE") {
+		current, err := parseAlterUserString(t)
+		if err != nil {
+			return err
+		}
+		user.CurrentPassword = &current
+	}
+	if t.keyword("RETAIN") {
+		return sql.ErrUnsupportedFeature.New("RETAIN CURRENT PASSWORD in ALTER USER")
+	}
+	if plugin == "" || plugin == "mysql_native_password" {
+		user.Auth1 = plan.AuthenticationMysqlNativePassword(password)
+	} else {
+		user.Auth1 = plan.NewOtherAuthentication(password, plugin)
+	}
+	return nil
+}
+
+func parseAlterUserString(t *statementTokenizer) (string, error) {
+	if t.typ != sqlparser.STRING {
+		return "", t.errorf("expected string")
+	}
+	val := t.val
+	t.next()
+	return val, nil
+}
+
+// parseAlterUserOptions reads the password management and account locking options of an ALTER USER statement.
+func parseAlterUserOptions(t *statementTokenizer, options *plan.AlterUserOptions) error {
+	var err error
+	for t.typ != 0 {
+		switch {
+		case t.keyword("PASSWORD"):
+			switch {
+			case t.keyword("EXPIRE"):
+				switch {
+				case t.keyword("DEFAULT"):
+					options.PasswordLifetime = &plan.AlterUserPasswordOption{Default: true}
+				case t.keyword("NEVER"):
+					options.PasswordLifetime = &plan.AlterUserPasswordOption{}
+				case t.keyword("INTERVAL"):
+					options.PasswordLifetime, err = parseAlterUserDays(t)
+				default:
+					options.ExpirePassword = true
+				}
+			case t.keyword("HISTORY"):
+				if t.keyword("DEFAULT") {
+					options.PasswordHistory = &plan.AlterUserPasswordOption{Default: true}
+				} else {
+					var history *int64
+					if history, err = t.integer(); err == nil {
+						options.PasswordHistory = &plan.AlterUserPasswordOption{Value: *history}
+					}
+				}
+			case t.keyword("REUSE"):
+				if !t.keyword("INTERVAL") {
+					return t.errorf("expected PASSWORD REUSE INTERVAL")
+				}
+				if t.keyword("DEFAULT") {
+					options.PasswordReuseInterval = &plan.AlterUserPasswordOption{Default: true}
+				} else {
+					options.PasswordReuseInterval, err = parseAlterUserDays(t)
+				}
+			case t.keyword("REQUIRE"):
+				if !t.keyword("CURRENT") {
+					return t.errorf("expected PASSWORD REQUIRE CURRENT")
+				}
+				switch {
+				case t.keyword("DEFAULT"):
+					options.PasswordRequireCurrent = &plan.AlterUserPasswordOption{Default: true}
+				case t.keyword("OPTIONAL"):
+					options.PasswordRequireCurrent = &plan.AlterUserPasswordOption{}
+				default:
+					options.PasswordRequireCurrent = &plan.AlterUserPasswordOption{Value: 1}
+				}
+			default:
+				return t.errorf("unknown password option")
+			}
+		case t.keyword("FAILED_LOGIN_ATTEMPTS"):
+			options.FailedLoginAttempts, err = t.integer()
+		case t.keyword("PASSWORD_LOCK_TIME"):
+			if t.keyword("UNBOUNDED") {
+				unbounded := int64(-1)
+				options.PasswordLockTime = &unbounded
+			} else {
+				options.PasswordLockTime, err = t.integer()
+			}
+		case t.keyword("ACCOUNT"):
+			var locked bool
+			switch {
+			case t.keyword("LOCK"):
+				locked = true
+			case t.keyword("UNLOCK"):
+				locked = false
+			default:
+				return t.errorf("expected ACCOUNT LOCK or ACCOUNT UNLOCK")
+			}
+			options.Locked = &locked
+		default:
+			return sql.ErrUnsupportedFeature.New("ALTER USER option " + t.val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAlterUserDays reads the N DAY of a password option.
+func parseAlterUserDays(t *statementTokenizer) (*plan.AlterUserPasswordOption, error) {
+	days, err := t.integer()
+	if err != nil {
+		return nil, err
+	}
+	if !t.keyword("DAY") {
+		return nil, t.errorf("expected DAY")
+	}
+	return &plan.AlterUserPasswordOption{Value: *days}, nil
+}
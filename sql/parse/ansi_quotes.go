@@ -0,0 +1,79 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// RewriteAnsiQuotes rewrites the double-quoted strings in the query given to identifiers quoted with backticks, as
+// they're read when the ANSI_QUOTES SQL mode is set, since the parser always reads them as strings. A query like
+//
+//	SELECT "a" FROM "t" WHERE "b" = 'x'
+//
+// becomes
+//
+//	SELECT `a` FROM `t` WHERE `b` = 'x'
+//
+// Queries without double quotes are returned unchanged.
+func RewriteAnsiQuotes(query string) string {
+	if !strings.Contains(query, `"`) {
+		return query
+	}
+
+	var sb strings.Builder
+	pos := 0
+	t := newQueryTokenizer(query)
+	for tok := t.scan(); tok.typ != 0; tok = t.scan() {
+		if tok.typ == sqlparser.LEX_ERROR {
+			return query
+		}
+		if tok.typ != sqlparser.STRING || query[tok.start] != '"' {
+			continue
+		}
+		end := quotedStringEnd(query, tok.start)
+		sb.WriteString(query[pos:tok.start])
+		sb.WriteString("`")
+		sb.WriteString(strings.ReplaceAll(tok.val, "`", "``"))
+		sb.WriteString("`")
+		pos = end
+	}
+	if pos == 0 {
+		return query
+	}
+	sb.WriteString(query[pos:])
+	return sb.String()
+}
+
+// quotedStringEnd returns the position after the end of the string quoted by |query[start]|, whose quote characters
+// are escaped by doubling them or with backslashes.
+func quotedStringEnd(query string, start int) int {
+	quote := query[start]
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var backupDatabaseRegex = regexp.MustCompile(`(?i)^\s*BACKUP\s+(?:DATABASE|SCHEMA)\s`)
+
+// parseBackupDatabase parses the first statement of the query given if it is a BACKUP DATABASE statement, an extension
+// of MySQL with the syntax:
+//
+//	BACKUP {DATABASE | SCHEMA} db_name TO 'file_name'
+//	    [WITH {NO_DATA | NO_CREATE_INFO | ADD_DROP_TABLE | ROUTINES | MYSQLDUMP} [, ...]]
+//
+// Returns whether the statement was one, the node for it, and the text of the statement along with the remainder of
+// the query after it.
+func parseBackupDatabase(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	if !backupDatabaseRegex.MatchString(query) {
+		return false, nil, "", "", nil
+	}
+
+	t := newStatementTokenizer(query)
+	t.next()
+	t.next()
+	db, err := t.identifier()
+	if err != nil {
+		return true, nil, query, "", err
+	}
+	if !t.keyword("TO") {
+		return true, nil, query, "", t.errorf("expected TO")
+	}
+	if t.typ != sqlparser.STRING {
+		return true, nil, query, "", t.errorf("expected file name")
+	}
+	path := t.val
+	t.next()
+
+	var opts sql.DumpOptions
+	if t.keyword("WITH") {
+		for {
+			switch {
+			case t.keyword("NO_DATA"):
+				opts.NoData = true
+			case t.keyword("NO_CREATE_INFO"):
+				opts.NoCreateInfo = true
+			case t.keyword("ADD_DROP_TABLE"):
+				opts.AddDropTable = true
+			case t.keyword("ROUTINES"):
+				opts.Routines = true
+			case t.keyword("MYSQLDUMP"):
+				opts.MySQLDump = true
+			default:
+				return true, nil, query, "", t.errorf("unknown BACKUP DATABASE option")
+			}
+			if !t.char(',') {
+				break
+			}
+		}
+	}
+	if t.typ != 0 {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsed, remainder := t.split()
+	return true, plan.NewBackupDatabase(db, path, opts), parsed, remainder, nil
+}
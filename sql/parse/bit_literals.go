@@ -0,0 +1,61 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+var bitLiteralRegex = regexp.MustCompile(`^0b[01]+$`)
+
+// RewriteBitLiterals rewrites the bit-value literals written as 0b1010 in the query given to the b'1010' form, since
+// the parser reads the former as identifiers. Like MySQL, identifiers are only read as literals when they aren't
+// quoted. Queries without such literals are returned unchanged.
+func RewriteBitLiterals(query string) string {
+	if !strings.Contains(query, "0b") {
+		return query
+	}
+
+	var sb strings.Builder
+	pos := 0
+	tkn := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tkn.Scan()
+		if typ == 0 {
+			break
+		}
+		if typ == sqlparser.LEX_ERROR {
+			return query
+		}
+		end := tkn.Position - 1
+		if typ != sqlparser.ID || query[end-1] == '`' || !bitLiteralRegex.Match(val) {
+			continue
+		}
+		start := end - len(val)
+		sb.WriteString(query[pos:start])
+		sb.WriteString("b'")
+		sb.Write(val[2:])
+		sb.WriteString("'")
+		pos = end
+	}
+	if pos == 0 {
+		return query
+	}
+	sb.WriteString(query[pos:])
+	return sb.String()
+}
@@ -51,6 +51,10 @@ func takeNumber(chars string) (num uint, rest string, err error) {
 	return uint(parsedNum), rest, nil
 }
 
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
 func isNumeral(r rune) bool {
 	switch r {
 	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
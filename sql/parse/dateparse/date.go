@@ -65,12 +65,18 @@ func ParseDateWithFormat(date, format string) (interface{}, error) {
 	}
 
 	var result string
-	if hasDate && hasTime {
-		result = fmt.Sprintf("%s %s", evaluateDate(dt), evaluateTime(dt))
+	if hasDate {
+		d, err := evaluateDate(dt)
+		if err != nil {
+			return nil, err
+		}
+		if hasTime {
+			result = fmt.Sprintf("%s %s", d, evaluateTime(dt))
+		} else {
+			result = d
+		}
 	} else if hasTime {
-		result = fmt.Sprintf("%s", evaluateTime(dt))
-	} else if hasDate {
-		result = fmt.Sprintf("%s", evaluateDate(dt))
+		result = evaluateTime(dt)
 	} else {
 		return nil, fmt.Errorf("no value to evaluate")
 	}
@@ -157,7 +163,16 @@ type datetime struct {
 	dayOfYear  *uint
 	weekOfYear *uint
 
-	// this is completely ignored, but we still parse it for correctness
+	// weekYear is the year of the week given by %X or %x
+	weekYear *uint
+	// sundayFirst is whether weeks start on Sunday (%U, %V) or Monday (%u, %v)
+	sundayFirst bool
+	// strictWeek is whether the week is in the range 1-53 (%V, %v), which requires a week year
+	strictWeek bool
+	// weekYearSundayFirst is whether the week year was given by %X rather than %x
+	weekYearSundayFirst bool
+
+	// the weekday is only used to compute the date from a week of the year
 	weekday *time.Weekday
 
 	// true => AM, false => PM, nil => unspecified
@@ -253,14 +268,22 @@ var formatSpecifiers = map[byte]parser{
 	's': parseSecondsNumeric,
 	// %T	Time, 24-hour (hh:mm:ss)
 	'T': parse24HourTimestamp,
-	'U': nil,
-	'u': nil,
-	'V': nil,
-	'v': nil,
-	'W': nil,
-	'w': nil,
-	'X': nil,
-	'x': nil,
+	// %U	Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
+	'U': parseWeekNumeric(true, false),
+	// %u	Week (00..53), where Monday is the first day of the week; WEEK() mode 1
+	'u': parseWeekNumeric(false, false),
+	// %V	Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
+	'V': parseWeekNumeric(true, true),
+	// %v	Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
+	'v': parseWeekNumeric(false, true),
+	// %W	Weekday name (Sunday..Saturday)
+	'W': parseWeekdayName,
+	// %w	Day of the week (0=Sunday..6=Saturday)
+	'w': parseWeekdayNumeric,
+	// %X	Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
+	'X': parseWeekYearNumeric(true),
+	// %x	Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
+	'x': parseWeekYearNumeric(false),
 	// %Y	Year, numeric, four digits
 	'Y': parseYear4DigitNumeric,
 	// %y	Year, numeric (two digits)
@@ -322,16 +345,39 @@ func monthAbbrev(abbrev string) (time.Month, bool) {
 	return 0, false
 }
 
-// TODO: allow this to match partial months
-// janu should match janurary
+// Match a month name, or an unambiguous prefix of one, at the start of the given string.
 func monthName(name string) (month time.Month, charCount int, ok bool) {
-	for i := 1; i < 13; i++ {
-		m := time.Month(i)
-		if strings.HasPrefix(name, strings.ToLower(m.String())) {
-			return m, len(m.String()), true
+	i, charCount, ok := matchName(name, 12, func(i int) string { return time.Month(i + 1).String() })
+	return time.Month(i + 1), charCount, ok
+}
+
+// Match a weekday name, or an unambiguous prefix of one, at the start of the given string.
+func weekdayName(name string) (weekday time.Weekday, charCount int, ok bool) {
+	i, charCount, ok := matchName(name, 7, func(i int) string { return time.Weekday(i).String() })
+	return time.Weekday(i), charCount, ok
+}
+
+// matchName matches the leading word of the given string against the |count| names returned by |nameOf|. The word
+// may be a prefix of a name, as long as it does not match any other name.
+func matchName(str string, count int, nameOf func(int) string) (idx int, charCount int, ok bool) {
+	word, _ := takeAll(str, isLetter)
+	if len(word) == 0 {
+		return 0, 0, false
+	}
+	idx = -1
+	for i := 0; i < count; i++ {
+		name := strings.ToLower(nameOf(i))
+		if name == word {
+			return i, len(word), true
+		}
+		if strings.HasPrefix(name, word) {
+			if idx >= 0 {
+				return 0, 0, false
+			}
+			idx = i
 		}
 	}
-	return 0, 0, false
+	return idx, len(word), idx >= 0
 }
 
 // MySQL specification, valid format specifiers.
@@ -57,6 +57,19 @@ func TestParseDate(t *testing.T) {
 
 		{"date_by_year_offset", "100 20", "%j %y", "2020-04-09"},
 		{"date_by_year_offset_singledigit_year", "100 5", "%j %y", "2005-04-10"},
+
+		{"fractional_microseconds", "01/02/99 5", "%m/%e/%y %f", "1999-01-02 00:00:00.500000"},
+		{"twelve_am", "01/02/99 12:05 am", "%m/%e/%y %h:%i %p", "1999-01-02 00:05:00"},
+		{"twelve_pm", "01/02/99 12:05 pm", "%m/%e/%y %h:%i %p", "1999-01-02 12:05:00"},
+		{"adjacent_numbers", "20230415", "%Y%m%d", "2023-04-15"},
+		{"adjacent_numbers_with_time", "20230415 103059", "%Y%m%d %H%i%s", "2023-04-15 10:30:59"},
+		{"two_digit_full_year", "3 4 99", "%e %c %Y", "1999-04-03"},
+		{"month_name_prefix", "janu 3 2020", "%M %e %Y", "2020-01-03"},
+		{"weekday_name", "2013 32 Tuesday", "%X %V %W", "2013-08-13"},
+		{"weekday_name_prefix", "2013 32 tues", "%X %V %W", "2013-08-13"},
+		{"week_monday_first", "2013 32 2", "%x %v %w", "2013-08-06"},
+		{"week_sunday_first", "2013 32 0", "%Y %U %w", "2013-08-11"},
+		{"week_monday_first_non_strict", "2013 32 Sun", "%Y %u %a", "2013-08-11"},
 	}
 
 	for _, tt := range tests {
@@ -96,6 +109,10 @@ func TestConversionFailure(t *testing.T) {
 		{"unknown_format_specifier", "Jan 3", "%b %e %L", nil, `unknown format specifier "L"`},
 		{"invalid_number_hour", "0021:12:14", "%T", nil, `specifier %T failed to parse "0021:12:14": expected literal ":", got "2"`},
 		{"invalid_number_hour_2", "0012:12:14", "%r", nil, `specifier %r failed to parse "0012:12:14": expected literal ":", got "1"`},
+		{"ambiguous_month_name", "ma 3 2020", "%M %e %Y", nil, `specifier %M failed to parse "ma 3 2020": unknown month name, got "ma 3 2020"`},
+		{"strict_week_without_week_year", "2013 32 Tuesday", "%Y %V %W", nil, "week %V and %v must be used with %X and %x respectively"},
+		{"strict_week_with_wrong_week_year", "2013 32 Tuesday", "%x %V %W", nil, "week %V and %v must be used with %X and %x respectively"},
+		{"week_year_without_strict_week", "2013 32 Tuesday", "%X %U %W", nil, "week year %X and %x must be used with %V and %v respectively"},
 	}
 
 	for _, tt := range tests {
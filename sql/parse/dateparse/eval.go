@@ -5,7 +5,7 @@ import (
 	"time"
 )
 
-func evaluateDate(dt datetime) string {
+func evaluateDate(dt datetime) (string, error) {
 	var year, month, day int
 
 	if dt.year != nil {
@@ -27,17 +27,69 @@ func evaluateDate(dt datetime) string {
 		day = dayOffsetted.Day()
 	}
 
-	return fillWithZero(year, 4) + "-" + fillWithZero(month, 2) + "-" + fillWithZero(day, 2)
+	if dt.weekOfYear != nil && dt.weekday != nil {
+		date, err := evaluateWeekDate(dt, year)
+		if err != nil {
+			return "", err
+		}
+		year, month, day = date.Year(), int(date.Month()), date.Day()
+	}
+
+	return fillWithZero(year, 4) + "-" + fillWithZero(month, 2) + "-" + fillWithZero(day, 2), nil
+}
+
+// evaluateWeekDate returns the date given by a week of the year and a weekday. The strict week specifiers (%V, %v)
+// take their year from the matching week year specifiers (%X, %x), while the others use the regular year.
+func evaluateWeekDate(dt datetime, year int) (time.Time, error) {
+	if dt.strictWeek {
+		if dt.weekYear == nil || dt.weekYearSundayFirst != dt.sundayFirst {
+			return time.Time{}, fmt.Errorf("week %%V and %%v must be used with %%X and %%x respectively")
+		}
+		year = int(*dt.weekYear)
+	} else if dt.weekYear != nil {
+		return time.Time{}, fmt.Errorf("week year %%X and %%x must be used with %%V and %%v respectively")
+	}
+
+	// weekdays are numbered from Monday=1 to Sunday=7
+	weekday := int(*dt.weekday)
+	if weekday == 0 {
+		weekday = 7
+	}
+	week := int(*dt.weekOfYear)
+
+	firstDay := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var days int
+	if dt.sundayFirst {
+		// the first week starts on the first Sunday of the year
+		firstWeekday := int(firstDay.Weekday())
+		if firstWeekday != 0 {
+			days = 7
+		}
+		days += -firstWeekday + (week-1)*7 + weekday%7
+	} else {
+		// the first week is the first one with at least four days in the year
+		firstWeekday := (int(firstDay.Weekday()) + 6) % 7
+		if firstWeekday > 3 {
+			days = 7
+		}
+		days += -firstWeekday + (week-1)*7 + weekday - 1
+	}
+
+	return firstDay.AddDate(0, 0, days), nil
 }
 
 func evaluateTime(dt datetime) string {
 	var hours, minutes, seconds, milliseconds, microseconds, nanoseconds int
 
 	if dt.hours != nil {
-		if *dt.hours < 13 && dt.am != nil && !*dt.am {
-			*dt.hours += 12
-		}
 		hours = int(*dt.hours)
+		// 12 AM is midnight, and 12 PM is noon
+		if hours < 13 && dt.am != nil {
+			hours %= 12
+			if !*dt.am {
+				hours += 12
+			}
+		}
 	}
 	if dt.minutes != nil {
 		minutes = int(*dt.minutes)
@@ -62,10 +114,10 @@ func evaluateTime(dt datetime) string {
 		includeMicrosecond = true
 	}
 
-	// convert partial seconds to nanoseconds
-	nanosecondDuration := time.Microsecond*time.Duration(microseconds) + time.Millisecond*time.Duration(milliseconds) + time.Nanosecond*time.Duration(nanoseconds)
+	// convert partial seconds to microseconds
+	partialSeconds := time.Microsecond*time.Duration(microseconds) + time.Millisecond*time.Duration(milliseconds) + time.Nanosecond*time.Duration(nanoseconds)
 	if includeMicrosecond {
-		t = t + "." + fillWithZero(int(nanosecondDuration), 6)
+		t = t + "." + fillWithZero(int(partialSeconds/time.Microsecond), 6)
 	}
 
 	return t
@@ -75,7 +75,7 @@ func parseMonthAbbreviation(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -85,7 +85,7 @@ func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -94,16 +94,21 @@ func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMicrosecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	numChars, rest := takeAtMost(6, chars, isNumeral)
+	num, _, err := takeNumber(numChars)
 	if err != nil {
 		return "", err
 	}
+	// the digits are a fraction of a second, so fewer than six digits are scaled up
+	for i := len(numChars); i < 6; i++ {
+		num *= 10
+	}
 	result.microseconds = &num
 	return rest, nil
 }
 
 func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	hour, rest, err := takeNumber(chars)
+	hour, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -112,7 +117,7 @@ func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -121,7 +126,7 @@ func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseMinuteNumeric(result *datetime, chars string) (rest string, _ error) {
-	min, rest, err := takeNumber(chars)
+	min, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -171,7 +176,7 @@ func parse12HourTimestamp(result *datetime, chars string) (rest string, _ error)
 }
 
 func parseSecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	sec, rest, err := takeNumber(chars)
+	sec, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -211,20 +216,13 @@ func parseYear2DigitNumeric(result *datetime, chars string) (rest string, _ erro
 	if err != nil {
 		return "", err
 	}
-	if year >= 70 {
-		year += 1900
-	} else {
-		year += 2000
-	}
+	year = twoDigitYear(year)
 	result.year = &year
 	return rest, nil
 }
 
 func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ error) {
-	if len(chars) < 4 {
-		return "", fmt.Errorf("expected at least 4 chars, got %d", len(chars))
-	}
-	year, rest, err := takeNumber(chars)
+	year, rest, err := parseYear(chars)
 	if err != nil {
 		return "", err
 	}
@@ -232,8 +230,30 @@ func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ erro
 	return rest, nil
 }
 
+// parseYear parses a year of at most four digits. Years of one or two digits are two digit years.
+func parseYear(chars string) (year uint, rest string, err error) {
+	yearChars, rest := takeAtMost(4, chars, isNumeral)
+	year, _, err = takeNumber(yearChars)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(yearChars) <= 2 {
+		year = twoDigitYear(year)
+	}
+	return year, rest, nil
+}
+
+// twoDigitYear converts a two digit year to a four digit year. Years from 70 to 99 are in the 1900s, and those
+// below 70 are in the 2000s.
+func twoDigitYear(year uint) uint {
+	if year >= 70 {
+		return year + 1900
+	}
+	return year + 2000
+}
+
 func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -242,10 +262,63 @@ func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest stri
 }
 
 func parseDayOfYearNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(3, chars)
 	if err != nil {
 		return "", err
 	}
 	result.dayOfYear = &num
 	return rest, nil
 }
+
+func parseWeekdayName(result *datetime, chars string) (rest string, _ error) {
+	weekday, charCount, ok := weekdayName(chars)
+	if !ok {
+		return "", fmt.Errorf("unknown weekday name, got \"%s\"", chars)
+	}
+	result.weekday = &weekday
+	return trimPrefix(charCount, chars), nil
+}
+
+func parseWeekdayNumeric(result *datetime, chars string) (rest string, _ error) {
+	num, rest, err := takeNumberAtMostNChars(1, chars)
+	if err != nil {
+		return "", err
+	}
+	if num > 6 {
+		return "", fmt.Errorf("expected weekday from 0 to 6, got %d", num)
+	}
+	weekday := time.Weekday(num)
+	result.weekday = &weekday
+	return rest, nil
+}
+
+// parseWeekNumeric returns a parser for a week of the year, where weeks start on Sunday if |sundayFirst| is true.
+// If |strict| is true, the week is in the range 1-53 and must be used with the matching week year specifier.
+func parseWeekNumeric(sundayFirst, strict bool) parser {
+	return func(result *datetime, chars string) (rest string, _ error) {
+		num, rest, err := takeNumberAtMostNChars(2, chars)
+		if err != nil {
+			return "", err
+		}
+		if num > 53 || (strict && num == 0) {
+			return "", fmt.Errorf("invalid week %d", num)
+		}
+		result.weekOfYear = &num
+		result.sundayFirst = sundayFirst
+		result.strictWeek = strict
+		return rest, nil
+	}
+}
+
+// parseWeekYearNumeric returns a parser for the year of a week, where weeks start on Sunday if |sundayFirst| is true.
+func parseWeekYearNumeric(sundayFirst bool) parser {
+	return func(result *datetime, chars string) (rest string, _ error) {
+		year, rest, err := parseYear(chars)
+		if err != nil {
+			return "", err
+		}
+		result.weekYear = &year
+		result.weekYearSundayFirst = sundayFirst
+		return rest, nil
+	}
+}
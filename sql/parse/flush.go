@@ -0,0 +1,73 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var flushTablesRegex = regexp.MustCompile(`(?i)^\s*FLUSH\s+(?:(NO_WRITE_TO_BINLOG|LOCAL)\s+)?TABLES?(?:\s|;|$)`)
+
+// parseFlushTables parses the first statement of the query given if it is a FLUSH TABLES statement, which the parser
+// doesn't support. Returns whether the statement was one, the node for it, and the text of the statement along with
+// the remainder of the query after it.
+func parseFlushTables(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	m := flushTablesRegex.FindStringSubmatch(query)
+	if m == nil {
+		return false, nil, "", "", nil
+	}
+	if m[1] != "" {
+		return true, nil, query, "", fmt.Errorf("%s not supported", m[1])
+	}
+
+	t := newStatementTokenizer(query)
+	t.next()
+	if !t.keyword("TABLES") && !t.keyword("TABLE") {
+		return true, nil, query, "", t.errorf("expected TABLES")
+	}
+
+	var tables []sql.DbTable
+	for t.typ != 0 && !strings.EqualFold(t.val, "WITH") && !strings.EqualFold(t.val, "FOR") {
+		db, name, err := t.tableName()
+		if err != nil {
+			return true, nil, query, "", err
+		}
+		tables = append(tables, sql.DbTable{Db: db, Table: name})
+		if !t.char(',') {
+			break
+		}
+	}
+
+	readLock := false
+	if t.keyword("WITH") {
+		if !t.keyword("READ") || !t.keyword("LOCK") {
+			return true, nil, query, "", t.errorf("expected WITH READ LOCK")
+		}
+		readLock = true
+	} else if t.keyword("FOR") {
+		return true, nil, query, "", sql.ErrUnsupportedFeature.New("FLUSH TABLES FOR EXPORT")
+	}
+	if t.typ != 0 {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsed, remainder := t.split()
+	return true, plan.NewFlushTables(tables, readLock), parsed, remainder, nil
+}
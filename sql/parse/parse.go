@@ -38,6 +38,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/sqlfmt"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -54,6 +55,18 @@ var (
 	tableCharsetOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+CHARACTER\s+SET((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
 
 	tableCollationOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+COLLATE((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
+
+	alterTableRegex = regexp.MustCompile(`(?i)^\s*ALTER\s+TABLE\s`)
+
+	alterTableOptionRegex = regexp.MustCompile(`(?i)^\s*(ALGORITHM|LOCK)\s*=?\s*([A-Za-z]+)\s*$`)
+
+	alterTableLeadingOptionRegex = regexp.MustCompile("(?i)^(\\s*ALTER\\s+TABLE\\s+(?:`[^`]*`|[^\\s.`]+)(?:\\.(?:`[^`]*`|[^\\s.`]+))?)\\s+(ALGORITHM|LOCK)\\s*=?\\s*([A-Za-z]+)\\s*$")
+
+	showEngineRegex = regexp.MustCompile("(?is)^\\s*SHOW\\s+ENGINE\\s+(`[^`]+`|\\w+)\\s+(STATUS|MUTEX)\\s*(?:;(.*))?$")
+
+	databaseDDLRegex = regexp.MustCompile(`(?i)^\s*(?:CREATE|ALTER)\s+(?:DATABASE|SCHEMA)(?:\s|$)`)
+
+	explainAnalyzeDMLRegex = regexp.MustCompile(`(?is)^(\s*(?:EXPLAIN|DESCRIBE|DESC))\s+ANALYZE(\s+(?:INSERT|REPLACE|UPDATE|DELETE)\b)`)
 )
 
 var describeSupportedFormats = []string{"tree"}
@@ -69,17 +82,54 @@ const (
 	colKeyFulltextKey
 )
 
+// ParserOptions enable the extensions of the parser to the syntax MySQL accepts. They're off by default.
+type ParserOptions struct {
+	// EnableReturning accepts the RETURNING clause of INSERT, REPLACE and DELETE statements, as MariaDB does.
+	EnableReturning bool
+	// EnableDatabaseComments accepts the COMMENT option of CREATE and ALTER DATABASE statements, as MariaDB does.
+	EnableDatabaseComments bool
+}
+
 // Parse parses the given SQL sentence and returns the corresponding node.
 func Parse(ctx *sql.Context, query string) (sql.Node, error) {
-	n, _, _, err := parse(ctx, query, false)
+	return ParseWithOptions(ctx, query, ParserOptions{})
+}
+
+// ParseWithOptions parses the given SQL sentence with the extensions given enabled, and returns the corresponding
+// node.
+func ParseWithOptions(ctx *sql.Context, query string, options ParserOptions) (sql.Node, error) {
+	n, _, _, err := parse(ctx, query, false, options)
 	return n, err
 }
 
 func ParseOne(ctx *sql.Context, query string) (sql.Node, string, string, error) {
-	return parse(ctx, query, true)
+	return parse(ctx, query, true, ParserOptions{})
+}
+
+// ParseOneWithOptions is like ParseOne, with the extensions given enabled.
+func ParseOneWithOptions(ctx *sql.Context, query string, options ParserOptions) (sql.Node, string, string, error) {
+	return parse(ctx, query, true, options)
+}
+
+// statementParser parses the first statement of a query by hand if it is one of the statements that the parser
+// doesn't support. It returns whether the statement was one of them, the node for it, and the text of the statement
+// along with the remainder of the query after it.
+type statementParser struct {
+	// name is the name of the statement, used in errors
+	name  string
+	parse func(ctx *sql.Context, query string) (bool, sql.Node, string, string, error)
 }
 
-func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string, error) {
+// statementParsers are the statements parsed by hand, tried in order before the query is given to the parser.
+var statementParsers = []statementParser{
+	{name: "sequence", parse: parseSequenceDDL},
+	{name: "table maintenance", parse: parseTableMaintenance},
+	{name: "FLUSH TABLES", parse: parseFlushTables},
+	{name: "BACKUP DATABASE", parse: parseBackupDatabase},
+	{name: "ALTER USER", parse: parseAlterUser},
+}
+
+func parse(ctx *sql.Context, query string, multi bool, options ParserOptions) (sql.Node, string, string, error) {
 	span, ctx := ctx.Span("parse", trace.WithAttributes(attribute.String("query", query)))
 	defer span.End()
 
@@ -94,20 +144,82 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	var parsed string
 	var remainder string
 
-	parsed = s
+	for _, p := range statementParsers {
+		if ok, node, parsed, remainder, err := p.parse(ctx, s); ok {
+			if !multi && err == nil && strings.TrimSpace(remainder) != "" {
+				return nil, parsed, remainder, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected statement after %s statement", p.name))
+			}
+			return node, parsed, remainder, err
+		}
+	}
+
+	if m := showEngineRegex.FindStringSubmatch(s); m != nil {
+		// The parser doesn't support SHOW ENGINE statements
+		parsed, remainder = s, m[3]
+		if remainder != "" {
+			parsed = s[:len(s)-len(remainder)-1]
+			if !multi && strings.TrimSpace(remainder) != "" {
+				return nil, parsed, remainder, sql.ErrSyntaxError.New("unexpected statement after SHOW ENGINE statement")
+			}
+		}
+		engine := strings.Trim(m[1], "`")
+		return plan.NewShowEngineStatus(engine, strings.EqualFold(m[2], "MUTEX")), strings.TrimSpace(parsed), remainder, nil
+	}
+
+	// the statement is parsed as it's rewritten, but its text is given back as it was written
+	original := s
+	s, features, dynamicPrivileges := rewriteQuery(ctx, s)
+	var algorithm, lock string
+	if features.first == sqlparser.ALTER && features.second == sqlparser.TABLE {
+		s, algorithm, lock = extractAlterTableOptions(s)
+	}
+	var viewOpts *viewOptions
+	if (features.first == sqlparser.CREATE || features.first == sqlparser.ALTER) && features.view {
+		s, viewOpts = extractViewOptions(s)
+	}
+	var returning string
+	if options.EnableReturning && features.returning {
+		switch features.first {
+		case sqlparser.INSERT, sqlparser.REPLACE, sqlparser.DELETE:
+			s, returning = extractReturningClause(s)
+		}
+	}
+	var explainAnalyze bool
+	if features.analyze {
+		switch features.first {
+		case sqlparser.EXPLAIN, sqlparser.DESCRIBE, sqlparser.DESC:
+			s, explainAnalyze = extractExplainAnalyzeDML(s)
+		}
+	}
+	var tableOptions sql.TableOptions
+	if features.first == sqlparser.CREATE {
+		s, tableOptions = extractTableOptions(s)
+	}
+	var dbComment *string
+	if options.EnableDatabaseComments && (features.first == sqlparser.CREATE || features.first == sqlparser.ALTER) {
+		switch features.second {
+		case sqlparser.DATABASE, sqlparser.SCHEMA:
+			s, dbComment = extractDatabaseComment(s)
+		}
+	}
+	if ok, node, parsed, remainder, err := parseAlterTableOptions(ctx, s); ok {
+		parsed, remainder = OriginalStatement(original, s, len(s)-len(remainder))
+		if !multi && err == nil && strings.TrimSpace(remainder) != "" {
+			return nil, parsed, remainder, sql.ErrSyntaxError.New("unexpected statement after ALTER TABLE statement")
+		}
+		if err == nil && (algorithm != "" || lock != "") {
+			node, err = newAlterTableWithOptions(node, algorithm, lock)
+		}
+		return node, parsed, remainder, err
+	}
+	parsed = original
 	if !multi {
 		stmt, err = sqlparser.Parse(s)
 	} else {
 		var ri int
 		stmt, ri, err = sqlparser.ParseOne(s)
 		if ri != 0 && ri < len(s) {
-			parsed = s[:ri]
-			parsed = strings.TrimSpace(parsed)
-			// trim spaces and empty statements
-			parsed = strings.TrimRightFunc(parsed, func(r rune) bool {
-				return r == ';' || unicode.IsSpace(r)
-			})
-			remainder = s[ri:]
+			parsed, remainder = OriginalStatement(original, s, ri)
 		}
 	}
 
@@ -119,11 +231,217 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 		return nil, parsed, remainder, sql.ErrSyntaxError.New(err.Error())
 	}
 
-	node, err := convert(ctx, stmt, s)
+	var node sql.Node
+	if dbComment != nil {
+		node, err = convertWithDatabaseComment(ctx, stmt, s, *dbComment)
+	} else {
+		node, err = convert(ctx, stmt, s)
+	}
+	if err == nil && (algorithm != "" || lock != "") {
+		node, err = newAlterTableWithOptions(node, algorithm, lock)
+	}
+	if err == nil && viewOpts != nil {
+		node, err = newCreateViewWithOptions(node, viewOpts)
+	}
+	if err == nil && returning != "" {
+		node, err = newReturning(ctx, node, returning)
+	}
+	if err == nil && dynamicPrivileges != nil {
+		node = RestoreDynamicPrivileges(node, dynamicPrivileges)
+	}
+	if err == nil && explainAnalyze {
+		if d, ok := node.(*plan.DescribeQuery); ok {
+			d.Analyze = true
+		}
+	}
+	if err == nil && tableOptions != nil {
+		if ct, ok := node.(*plan.CreateTable); ok {
+			ct.Options = tableOptions
+		}
+	}
 
 	return node, parsed, remainder, err
 }
 
+// extractExplainAnalyzeDML removes the ANALYZE keyword from the query given if it is an EXPLAIN ANALYZE statement of
+// an INSERT, REPLACE, UPDATE or DELETE statement, since the parser only accepts it for SELECT statements. Returns the
+// query without the keyword, and whether it was removed.
+func extractExplainAnalyzeDML(query string) (string, bool) {
+	m := explainAnalyzeDMLRegex.FindStringSubmatchIndex(query)
+	if m == nil {
+		return query, false
+	}
+	return query[:m[3]] + query[m[4]:], true
+}
+
+// extractDatabaseComment removes the COMMENT option from the first statement of the query given if it is a CREATE or
+// ALTER DATABASE statement, since the parser doesn't accept it. Returns the query without the option, and the comment
+// removed, or nil if there wasn't one.
+func extractDatabaseComment(query string) (string, *string) {
+	if !databaseDDLRegex.MatchString(query) {
+		return query, nil
+	}
+
+	tkn := sqlparser.NewStringTokenizer(query)
+	prevEnd := 0
+	for {
+		typ, _ := tkn.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR || typ == ';' {
+			return query, nil
+		}
+		if typ != sqlparser.COMMENT_KEYWORD {
+			prevEnd = tkn.Position - 1
+			continue
+		}
+
+		start := prevEnd
+		typ, val := tkn.Scan()
+		if typ == '=' {
+			typ, val = tkn.Scan()
+		}
+		if typ != sqlparser.STRING {
+			return query, nil
+		}
+		end := tkn.Position - 1
+		if end > len(query) {
+			end = len(query)
+		}
+		comment := string(val)
+		return query[:start] + query[end:], &comment
+	}
+}
+
+// convertWithDatabaseComment converts the statement given, which had the comment given removed from it, and sets the
+// comment on the node converted from a CREATE or ALTER DATABASE statement. An ALTER DATABASE statement that only
+// changes the comment is converted here, since converting it requires other options.
+func convertWithDatabaseComment(ctx *sql.Context, stmt sqlparser.Statement, query string, comment string) (sql.Node, error) {
+	var node sql.Node
+	var err error
+	if ddl, ok := stmt.(*sqlparser.DBDDL); ok && strings.EqualFold(ddl.Action, sqlparser.AlterStr) &&
+		len(ddl.CharsetCollate) == 0 {
+		node = plan.NewAlterDatabase(ddl.DBName, sql.Collation_Unspecified)
+	} else if node, err = convert(ctx, stmt, query); err != nil {
+		return nil, err
+	}
+
+	switch n := node.(type) {
+	case *plan.CreateDB:
+		n.Comment = &comment
+	case *plan.AlterDB:
+		n.Comment = &comment
+	}
+	return node, nil
+}
+
+// extractAlterTableOptions removes the ALGORITHM and LOCK clauses from the first statement of the query given if it
+// is an ALTER TABLE statement, since the parser doesn't accept them. Returns the query without those clauses, and the
+// values of the clauses removed.
+func extractAlterTableOptions(query string) (string, string, string) {
+	if !alterTableRegex.MatchString(query) {
+		return query, "", ""
+	}
+
+	clauses, remainder := splitTopLevelClauses(query)
+	var algorithm, lock string
+	setOption := func(name, value string) {
+		if strings.ToUpper(name) == "ALGORITHM" {
+			algorithm = value
+		} else {
+			lock = value
+		}
+	}
+
+	kept := make([]string, 0, len(clauses))
+	prefixOnly := false
+	for i, clause := range clauses {
+		if i == 0 {
+			if m := alterTableLeadingOptionRegex.FindStringSubmatch(clause); m != nil {
+				setOption(m[2], m[3])
+				kept = append(kept, m[1])
+				prefixOnly = true
+				continue
+			}
+		} else if m := alterTableOptionRegex.FindStringSubmatch(clause); m != nil {
+			setOption(m[1], m[2])
+			continue
+		}
+		kept = append(kept, clause)
+	}
+
+	if (algorithm == "" && lock == "") || len(kept) == 0 {
+		return query, "", ""
+	}
+	// An ALTER TABLE with only ALGORITHM and LOCK clauses rebuilds the table, without changing it
+	if prefixOnly && len(kept) == 1 {
+		return kept[0] + remainder, algorithm, lock
+	}
+
+	var sb strings.Builder
+	sb.WriteString(kept[0])
+	for i, clause := range kept[1:] {
+		if i == 0 && prefixOnly {
+			sb.WriteString(" ")
+		} else {
+			sb.WriteString(",")
+		}
+		sb.WriteString(clause)
+	}
+	sb.WriteString(remainder)
+	return sb.String(), algorithm, lock
+}
+
+// splitTopLevelClauses splits the first statement of the query given on every comma that isn't quoted, in a comment or
+// nested in parentheses. Anything after the end of the first statement is returned as the remainder.
+func splitTopLevelClauses(query string) ([]string, string) {
+	var clauses []string
+	depth := 0
+	start := 0
+	t := newQueryTokenizer(query)
+	for tok := t.scan(); tok.typ != 0 && tok.typ != sqlparser.LEX_ERROR; tok = t.scan() {
+		switch tok.typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				clauses = append(clauses, query[start:tok.start])
+				start = tok.start + 1
+			}
+		case ';':
+			if depth == 0 {
+				return append(clauses, query[start:tok.start]), query[tok.start:]
+			}
+		}
+	}
+	return append(clauses, query[start:]), ""
+}
+
+// newAlterTableWithOptions wraps the clauses of the ALTER TABLE statement given in a *plan.AlterTable with the
+// ALGORITHM and LOCK clauses given.
+func newAlterTableWithOptions(node sql.Node, algorithm, lock string) (sql.Node, error) {
+	var alterAlgorithm sql.AlterAlgorithm
+	var alterLock sql.AlterLock
+	var err error
+	if algorithm != "" {
+		alterAlgorithm, err = sql.ParseAlterAlgorithm(algorithm)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if lock != "" {
+		alterLock, err = sql.ParseAlterLock(lock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if alterTable, ok := node.(*plan.AlterTable); ok {
+		return plan.NewAlterTable(alterTable.Clauses, alterAlgorithm, alterLock), nil
+	}
+	return plan.NewAlterTable([]sql.Node{node}, alterAlgorithm, alterLock), nil
+}
+
 // ParseColumnTypeString will return a SQL type for the given string that represents a column type.
 // For example, giving the string `VARCHAR(255)` will return the string SQL type with the internal type set to Varchar
 // and the length set to 255 with the default collation.
@@ -442,7 +760,9 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		)
 	}
 
-	return plan.NewDescribeQuery(explainFmt, child), nil
+	d := plan.NewDescribeQuery(explainFmt, child)
+	d.Analyze = n.Analyze
+	return d, nil
 }
 
 func convertPrepare(ctx *sql.Context, n *sqlparser.Prepare) (sql.Node, error) {
@@ -464,6 +784,7 @@ func convertPrepare(ctx *sql.Context, n *sqlparser.Prepare) (sql.Node, error) {
 		}
 	}
 
+	expr = RewriteUserVarAssignments(expr)
 	childStmt, err := sqlparser.Parse(expr)
 	if err != nil {
 		return nil, err
@@ -912,14 +1233,22 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 
 		return node, nil
-	case sqlparser.KeywordString(sqlparser.WARNINGS):
+	case sqlparser.KeywordString(sqlparser.WARNINGS), sqlparser.KeywordString(sqlparser.ERRORS):
+		isErrors := showType == sqlparser.KeywordString(sqlparser.ERRORS)
 		if s.CountStar {
-			unsupportedShow := "SHOW COUNT(*) WARNINGS"
-			return nil, sql.ErrUnsupportedFeature.New(unsupportedShow)
+			// SHOW COUNT(*) WARNINGS and SHOW COUNT(*) ERRORS are the same as selecting the matching system variable
+			if isErrors {
+				return Parse(ctx, "select @@session.error_count")
+			}
+			return Parse(ctx, "select @@session.warning_count")
 		}
 		var node sql.Node
 		var err error
-		node = plan.ShowWarnings(ctx.Session.Warnings())
+		if isErrors {
+			node = plan.NewShowErrors(ctx.Session.Warnings())
+		} else {
+			node = plan.ShowWarnings(ctx.Session.Warnings())
+		}
 		if s.Limit != nil {
 			if s.Limit.Offset != nil {
 				node, err = offsetToOffset(ctx, s.Limit.Offset, node)
@@ -1103,6 +1432,12 @@ func buildUnion(left, right sql.Node, distinct bool, limit, offset sql.Expressio
 }
 
 func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
+	if len(s.SelectExprs) == 1 {
+		if nv, ok := s.SelectExprs[0].(sqlparser.Nextval); ok {
+			return convertNextValueFor(ctx, s, nv)
+		}
+	}
+
 	node, err := tableExprsToTable(ctx, s.From)
 	if err != nil {
 		return nil, err
@@ -1305,7 +1640,7 @@ func convertDDL(ctx *sql.Context, query string, c *sqlparser.DDL, multiAlterDDL
 }
 
 // convertMultiAlterDDL converts MultiAlterDDL statements
-// If there are multiple alter statements, they are sorted in order of their precedence and placed inside a plan.Block
+// If there are multiple alter statements, they are sorted in order of their precedence and placed inside a plan.AlterTable
 // Currently, the precedence of DDL statements is:
 // 1.  RENAME COLUMN
 // 2.  DROP COLUMN
@@ -1400,7 +1735,7 @@ func convertMultiAlterDDL(ctx *sql.Context, query string, c *sqlparser.MultiAlte
 		}
 		return false
 	})
-	return plan.NewBlock(statements), nil
+	return plan.NewAlterTable(statements, sql.AlterAlgorithm_Default, sql.AlterLock_Default), nil
 }
 
 func convertDBDDL(ctx *sql.Context, c *sqlparser.DBDDL) (sql.Node, error) {
@@ -1484,8 +1819,16 @@ func convertCreateTrigger(ctx *sql.Context, query string, c *sqlparser.DDL) (sql
 	}
 	definer := getCurrentUserForDefiner(ctx, c.TriggerSpec.Definer)
 
+	// a trigger is in the database of its table
+	dbName := c.TriggerSpec.TrigName.Qualifier.String()
+	if tableDb := c.Table.Qualifier.String(); dbName == "" {
+		dbName = tableDb
+	} else if tableDb != "" && !strings.EqualFold(dbName, tableDb) {
+		return nil, sql.ErrTriggerInWrongSchema.New()
+	}
+
 	return plan.NewCreateTrigger(
-		sql.UnresolvedDatabase(c.TriggerSpec.TrigName.Qualifier.String()),
+		sql.UnresolvedDatabase(dbName),
 		c.TriggerSpec.TrigName.Name.String(),
 		c.TriggerSpec.Time,
 		c.TriggerSpec.Event,
@@ -1773,7 +2116,7 @@ func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (s
 	return plan.NewCreateProcedure(
 		sql.UnresolvedDatabase(c.ProcedureSpec.ProcName.Qualifier.String()),
 		c.ProcedureSpec.ProcName.Name.String(),
-		c.ProcedureSpec.Definer,
+		getCurrentUserForDefiner(ctx, c.ProcedureSpec.Definer),
 		params,
 		time.Now(),
 		time.Now(),
@@ -2143,6 +2486,9 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 				if c.Database == "" {
 					c.Database = ctx.GetCurrentDatabase()
 				}
+				if c.ParentDatabase == "" {
+					c.ParentDatabase = c.Database
+				}
 				return plan.NewAlterAddForeignKey(c), nil
 			case *sql.CheckConstraint:
 				return plan.NewAlterAddCheck(table, c), nil
@@ -2183,13 +2529,10 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 				if err != nil {
 					return nil, fmt.Errorf("on table %s, %w", ddl.Table.String(), err)
 				}
-				var comment string
-				if commentVal := column.Type.Comment; commentVal != nil {
-					comment = commentVal.String()
-				}
 				columns := []sql.IndexColumn{{Name: column.Name.String()}}
 				if isUnique {
-					alteredTable, err = plan.NewAlterCreateIndex(sql.UnresolvedDatabase(ddl.Table.Qualifier.String()), alteredTable, column.Name.String(), sql.IndexUsing_BTree, sql.IndexConstraint_Unique, columns, comment), nil
+					// the comment of the column is its own, not the comment of the index for it
+					alteredTable, err = plan.NewAlterCreateIndex(sql.UnresolvedDatabase(ddl.Table.Qualifier.String()), alteredTable, column.Name.String(), sql.IndexUsing_BTree, sql.IndexConstraint_Unique, columns, ""), nil
 					if err != nil {
 						return nil, err
 					}
@@ -2494,6 +2837,9 @@ func ConvertConstraintsDefs(ctx *sql.Context, tname sqlparser.TableName, spec *s
 			if constraint.Database == "" {
 				constraint.Database = ctx.GetCurrentDatabase()
 			}
+			if constraint.ParentDatabase == "" {
+				constraint.ParentDatabase = constraint.Database
+			}
 			fks = append(fks, constraint)
 		case *sql.CheckConstraint:
 			checks = append(checks, constraint)
@@ -2575,11 +2921,9 @@ func convertConstraintDefinition(ctx *sql.Context, cd *sqlparser.ConstraintDefin
 		for i, col := range fkConstraint.ReferencedColumns {
 			refColumns[i] = col.String()
 		}
+		// The database and table are set in the calling function, which also defaults the parent database to the one of
+		// the table
 		refDatabase := fkConstraint.ReferencedTable.Qualifier.String()
-		if refDatabase == "" {
-			refDatabase = ctx.GetCurrentDatabase()
-		}
-		// The database and table are set in the calling function
 		return &sql.ForeignKeyConstraint{
 			Name:           cd.Name,
 			Columns:        columns,
@@ -2640,17 +2984,24 @@ func convertCreateView(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.No
 	}
 
 	selectStr := query[c.SubStatementPositionStart:c.SubStatementPositionEnd]
+	if RewriteAnsiQuotes(selectStr) != selectStr {
+		// The definition has double-quoted strings, which would be read as identifiers if the view were used with the
+		// ANSI_QUOTES mode set, so it's stored in its canonical form instead.
+		canonical := sqlfmt.FormatAST(selectStatement)
+		query = query[:c.SubStatementPositionStart] + canonical + query[c.SubStatementPositionEnd:]
+		selectStr = canonical
+	}
 	queryAlias := plan.NewSubqueryAlias(c.ViewSpec.ViewName.Name.String(), selectStr, queryNode)
 	definer := getCurrentUserForDefiner(ctx, c.ViewSpec.Definer)
 
 	return plan.NewCreateView(
-		sql.UnresolvedDatabase(""), c.ViewSpec.ViewName.Name.String(), []string{}, queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security), nil
+		sql.UnresolvedDatabase(c.ViewSpec.ViewName.Qualifier.String()), c.ViewSpec.ViewName.Name.String(), []string{}, queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security), nil
 }
 
 func convertDropView(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 	plans := make([]sql.Node, len(c.FromViews))
 	for i, v := range c.FromViews {
-		plans[i] = plan.NewSingleDropView(sql.UnresolvedDatabase(""), v.Name.String())
+		plans[i] = plan.NewSingleDropView(sql.UnresolvedDatabase(v.Qualifier.String()), v.Name.String())
 	}
 	return plan.NewDropView(plans, c.IfExists), nil
 }
@@ -3340,6 +3691,11 @@ func convertFlush(ctx *sql.Context, f *sqlparser.Flush) (sql.Node, error) {
 	switch strings.ToLower(f.Option.Name) {
 	case "privileges":
 		return plan.NewFlushPrivileges(writesToBinlog), nil
+	case "logs":
+		return plan.NewFlushLogs(sql.LogTypes), nil
+	case "binary logs", "engine logs", "error logs", "general logs", "relay logs", "slow logs":
+		logType := strings.ToUpper(strings.Fields(f.Option.Name)[0])
+		return plan.NewFlushLogs([]sql.LogType{sql.LogType(logType)}), nil
 	default:
 		return nil, fmt.Errorf("%s not supported", f.Option.Name)
 	}
@@ -3663,11 +4019,9 @@ func orderByToSortFields(ctx *sql.Context, ob sqlparser.OrderBy) (sql.SortFields
 			so = sql.Descending
 		}
 
-		e2, _ := e.(sql.Expression2)
 		sf := sql.SortField{
-			Column:  e,
-			Column2: e2,
-			Order:   so,
+			Column: e,
+			Order:  so,
 		}
 		sortFields = append(sortFields, sf)
 	}
@@ -4015,11 +4369,20 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		}
 		return expression.NewUnresolvedColumn(v.Name.String()), nil
 	case *sqlparser.FuncExpr:
+		if v.Name.String() == UserVarAssignmentFunc {
+			return userVarAssignmentToExpression(ctx, v)
+		}
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
 			return nil, err
 		}
 
+		if sequenceFunctions[v.Name.Lowered()] && len(v.Exprs) > 0 {
+			if name, ok := sequenceNameArgument(v.Exprs[0]); ok {
+				exprs[0] = name
+			}
+		}
+
 		// NOTE: The count distinct expressions work differently due to the * syntax. eg. COUNT(*)
 		if v.Distinct && v.Name.Lowered() == "count" {
 			return aggregation.NewCountDistinct(exprs...), nil
@@ -4228,7 +4591,7 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		if v.Name == "timestampdiff" {
 			return function.NewTimestampDiff(unit, expr1, expr2), nil
 		} else if v.Name == "timestampadd" {
-			return nil, fmt.Errorf("TIMESTAMPADD() not supported")
+			return function.NewDateAdd(expr2, expression.NewInterval(expr1, v.Unit))
 		}
 		return nil, nil
 	case *sqlparser.ExtractFuncExpr:
@@ -4238,9 +4601,43 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 		return function.NewExtract(unit, expr), err
+	case *sqlparser.MatchExpr:
+		return matchExprToExpression(ctx, v)
 	}
 }
 
+func matchExprToExpression(ctx *sql.Context, v *sqlparser.MatchExpr) (sql.Expression, error) {
+	cols := make([]sql.Expression, len(v.Columns))
+	for i, selectExpr := range v.Columns {
+		// Like MySQL, only columns can be matched
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, sql.ErrInvalidArgument.New("MATCH")
+		}
+		if _, ok := aliased.Expr.(*sqlparser.ColName); !ok {
+			return nil, sql.ErrInvalidArgument.New("MATCH")
+		}
+		col, err := ExprToExpression(ctx, aliased.Expr)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = col
+	}
+	expr, err := ExprToExpression(ctx, v.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := function.MatchMode_NaturalLanguage
+	switch v.Option {
+	case sqlparser.BooleanModeStr:
+		mode = function.MatchMode_Boolean
+	case sqlparser.QueryExpansionStr, sqlparser.NaturalLanguageModeWithQueryExpansionStr:
+		mode = function.MatchMode_QueryExpansion
+	}
+	return function.NewMatchAgainst(cols, expr, mode)
+}
+
 // handleCollateExpr is meant to handle generic text-returning expressions that should be reinterpreted as a different collation.
 func handleCollateExpr(ctx *sql.Context, charSet sql.CharacterSetID, expr *sqlparser.CollateExpr) (sql.Expression, error) {
 	innerExpr, err := ExprToExpression(ctx, expr.Expr)
@@ -4464,6 +4861,9 @@ func comparisonExprToExpression(ctx *sql.Context, c *sqlparser.ComparisonExpr) (
 	case sqlparser.NotRegexpStr:
 		return expression.NewNot(expression.NewRegexp(left, right)), nil
 	case sqlparser.EqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, false); ok {
+			return eq, nil
+		}
 		return expression.NewEquals(left, right), nil
 	case sqlparser.LessThanStr:
 		return expression.NewLessThan(left, right), nil
@@ -4474,8 +4874,14 @@ func comparisonExprToExpression(ctx *sql.Context, c *sqlparser.ComparisonExpr) (
 	case sqlparser.GreaterEqualStr:
 		return expression.NewGreaterThanOrEqual(left, right), nil
 	case sqlparser.NullSafeEqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, true); ok {
+			return eq, nil
+		}
 		return expression.NewNullSafeEquals(left, right), nil
 	case sqlparser.NotEqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, false); ok {
+			return expression.NewNot(eq), nil
+		}
 		return expression.NewNot(
 			expression.NewEquals(left, right),
 		), nil
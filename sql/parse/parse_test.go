@@ -48,6 +48,11 @@ var showCollationProjection = plan.NewProject([]sql.Expression{
 	plan.NewUnresolvedTable("collations", "information_schema"),
 )
 
+func int64Ptr(i int64) *int64    { return &i }
+func stringPtr(s string) *string { return &s }
+
+func boolPtr(b bool) *bool { return &b }
+
 type parseTest struct {
 	input string
 	plan  sql.Node
@@ -1133,11 +1138,44 @@ CREATE TABLE t2
 		},
 		{
 			input: `ALTER TABLE mytable RENAME COLUMN bar TO baz, RENAME COLUMN abc TO xyz`,
-			plan: plan.NewBlock(
+			plan: plan.NewAlterTable(
 				[]sql.Node{
 					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("mytable", ""), "bar", "baz"),
 					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("mytable", ""), "abc", "xyz"),
 				},
+				sql.AlterAlgorithm_Default,
+				sql.AlterLock_Default,
+			),
+		},
+		{
+			input: `ALTER TABLE mytable RENAME COLUMN bar TO baz, ALGORITHM=INSTANT`,
+			plan: plan.NewAlterTable(
+				[]sql.Node{
+					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("mytable", ""), "bar", "baz"),
+				},
+				sql.AlterAlgorithm_Instant,
+				sql.AlterLock_Default,
+			),
+		},
+		{
+			input: "ALTER TABLE `my table` ALGORITHM = copy, LOCK SHARED, RENAME COLUMN bar TO baz, RENAME COLUMN abc TO xyz",
+			plan: plan.NewAlterTable(
+				[]sql.Node{
+					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("my table", ""), "bar", "baz"),
+					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("my table", ""), "abc", "xyz"),
+				},
+				sql.AlterAlgorithm_Copy,
+				sql.AlterLock_Shared,
+			),
+		},
+		{
+			input: "ALTER TABLE mytable RENAME COLUMN bar TO baz /* a, (b */, ALGORITHM=INSTANT",
+			plan: plan.NewAlterTable(
+				[]sql.Node{
+					plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("mytable", ""), "bar", "baz"),
+				},
+				sql.AlterAlgorithm_Instant,
+				sql.AlterLock_Default,
 			),
 		},
 		{
@@ -1808,7 +1846,6 @@ CREATE TABLE t2
 				[]sql.SortField{
 					{
 						Column:       expression.NewUnresolvedColumn("baz"),
-						Column2:      expression.NewUnresolvedColumn("baz"),
 						Order:        sql.Descending,
 						NullOrdering: sql.NullsFirst,
 					},
@@ -1847,7 +1884,6 @@ CREATE TABLE t2
 					[]sql.SortField{
 						{
 							Column:       expression.NewUnresolvedColumn("baz"),
-							Column2:      expression.NewUnresolvedColumn("baz"),
 							Order:        sql.Descending,
 							NullOrdering: sql.NullsFirst,
 						},
@@ -1869,7 +1905,6 @@ CREATE TABLE t2
 					[]sql.SortField{
 						{
 							Column:       expression.NewUnresolvedColumn("baz"),
-							Column2:      expression.NewUnresolvedColumn("baz"),
 							Order:        sql.Descending,
 							NullOrdering: sql.NullsFirst,
 						},
@@ -2626,13 +2661,11 @@ CREATE TABLE t2
 				[]sql.SortField{
 					{
 						Column:       expression.NewLiteral(int8(2), types.Int8),
-						Column2:      expression.NewLiteral(int8(2), types.Int8),
 						Order:        sql.Ascending,
 						NullOrdering: sql.NullsFirst,
 					},
 					{
 						Column:       expression.NewLiteral(int8(1), types.Int8),
-						Column2:      expression.NewLiteral(int8(1), types.Int8),
 						Order:        sql.Ascending,
 						NullOrdering: sql.NullsFirst,
 					},
@@ -2867,7 +2900,7 @@ CREATE TABLE t2
 		},
 		{
 			input: `alter table t add index (i), drop index i, add check (i = 0), drop check chk, drop constraint c, add column i int, modify column i text, drop column i, rename column i to j`,
-			plan: plan.NewBlock([]sql.Node{
+			plan: plan.NewAlterTable([]sql.Node{
 				plan.NewRenameColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), "i", "j"),
 				plan.NewDropColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), "i"),
 				plan.NewModifyColumn(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), "i", &sql.Column{Name: "i", Type: types.CreateText(sql.Collation_Unspecified), Nullable: true, Source: "t"}, nil),
@@ -2877,7 +2910,7 @@ CREATE TABLE t2
 				plan.NewAlterAddCheck(plan.NewUnresolvedTable("t", ""), &sql.CheckConstraint{Name: "", Expr: expression.NewEquals(expression.NewUnresolvedColumn("i"), expression.NewLiteral(int8(0), types.Int8)), Enforced: true}),
 				plan.NewAlterDropIndex(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), "i"),
 				plan.NewAlterCreateIndex(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), "", sql.IndexUsing_BTree, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "i", Length: 0}}, ""),
-			}),
+			}, sql.AlterAlgorithm_Default, sql.AlterLock_Default),
 		},
 		{
 			input: `DESCRIBE FORMAT=TREE SELECT * FROM foo`,
@@ -3855,7 +3888,6 @@ CREATE TABLE t2
 						}, sql.SortFields{
 							{
 								Column:       expression.NewUnresolvedColumn("x"),
-								Column2:      expression.NewUnresolvedColumn("x"),
 								Order:        sql.Ascending,
 								NullOrdering: sql.NullsFirst,
 							},
@@ -3888,7 +3920,6 @@ CREATE TABLE t2
 						expression.NewUnresolvedFunction("row_number", true, sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 							{
 								Column:       expression.NewUnresolvedColumn("x"),
-								Column2:      expression.NewUnresolvedColumn("x"),
 								Order:        sql.Ascending,
 								NullOrdering: sql.NullsFirst,
 							},
@@ -3912,7 +3943,6 @@ CREATE TABLE t2
 						expression.NewUnresolvedFunction("row_number", true, sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 							{
 								Column:       expression.NewUnresolvedColumn("x"),
-								Column2:      expression.NewUnresolvedColumn("x"),
 								Order:        sql.Ascending,
 								NullOrdering: sql.NullsFirst,
 							},
@@ -3978,7 +4008,6 @@ CREATE TABLE t2
 						expression.NewUnresolvedFunction("count", true, sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 							{
 								Column:       expression.NewUnresolvedColumn("x"),
-								Column2:      expression.NewUnresolvedColumn("x"),
 								Order:        sql.Ascending,
 								NullOrdering: sql.NullsFirst,
 							},
@@ -4015,7 +4044,6 @@ CREATE TABLE t2
 						expression.NewUnresolvedFunction("row_number", true, sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 							{
 								Column:       expression.NewUnresolvedColumn("a"),
-								Column2:      expression.NewUnresolvedColumn("a"),
 								Order:        sql.Ascending,
 								NullOrdering: sql.NullsFirst,
 							},
@@ -4291,7 +4319,6 @@ CREATE TABLE t2
 					"w1": sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 						{
 							Column:       expression.NewUnresolvedColumn("x"),
-							Column2:      expression.NewUnresolvedColumn("x"),
 							Order:        sql.Ascending,
 							NullOrdering: sql.NullsFirst,
 						},
@@ -4321,7 +4348,6 @@ CREATE TABLE t2
 					"w1": sql.NewWindowDefinition([]sql.Expression{}, sql.SortFields{
 						{
 							Column:       expression.NewUnresolvedColumn("x"),
-							Column2:      expression.NewUnresolvedColumn("x"),
 							Order:        sql.Ascending,
 							NullOrdering: sql.NullsFirst,
 						},
@@ -4830,7 +4856,6 @@ CREATE TABLE t2
 				), true, nil, nil, []sql.SortField{
 					{
 						Column:       expression.NewLiteral(int8(2), types.Int8),
-						Column2:      expression.NewLiteral(int8(2), types.Int8),
 						Order:        sql.Ascending,
 						NullOrdering: sql.NullsFirst,
 					},
@@ -4848,6 +4873,28 @@ CREATE TABLE t2
 			input: `DROP DATABASE test`,
 			plan:  plan.NewDropDatabase("test", false),
 		},
+		{
+			input: `ALTER TABLE db.t COMMENT 'a table', ENGINE = innodb SECONDARY_ENGINE = NULL`,
+			plan: plan.NewAlterTableOptions(sql.UnresolvedDatabase("db"), plan.NewUnresolvedTable("t", "db"),
+				sql.TableOptions{"COMMENT": "a table", "ENGINE": "InnoDB", "SECONDARY_ENGINE": ""}),
+		},
+		{
+			input: `ALTER TABLE t ALGORITHM=INSTANT, ROW_FORMAT=DYNAMIC`,
+			plan: plan.NewAlterTable([]sql.Node{
+				plan.NewAlterTableOptions(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""),
+					sql.TableOptions{"ROW_FORMAT": "DYNAMIC"}),
+			}, sql.AlterAlgorithm_Instant, sql.AlterLock_Default),
+		},
+		{
+			input: `ALTER TABLE t ALGORITHM=COPY`,
+			plan: plan.NewAlterTable([]sql.Node{
+				plan.NewAlterTableOptions(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t", ""), sql.TableOptions{}),
+			}, sql.AlterAlgorithm_Copy, sql.AlterLock_Default),
+		},
+		{
+			input: `ALTER TABLE db.t`,
+			plan:  plan.NewAlterTableOptions(sql.UnresolvedDatabase("db"), plan.NewUnresolvedTable("t", "db"), sql.TableOptions{}),
+		},
 		{
 			input: `DROP DATABASE IF EXISTS test`,
 			plan:  plan.NewDropDatabase("test", true),
@@ -4872,7 +4919,7 @@ END;`,
 			plan: plan.NewCreateProcedure(
 				sql.UnresolvedDatabase(""),
 				"p1",
-				"",
+				"``@``",
 				[]plan.ProcedureParam{
 					{
 						Direction: plan.ProcedureParamDirection_Inout,
@@ -5006,6 +5053,128 @@ END`,
 				false,
 			),
 		},
+		{
+			input: "CREATE SEQUENCE IF NOT EXISTS mydb.seq START WITH 5 INCREMENT BY -1 MINVALUE -10 CACHE 20 CYCLE",
+			plan: plan.NewCreateSequence(
+				sql.UnresolvedDatabase("mydb"),
+				"seq",
+				plan.SequenceOptions{
+					Start:     int64Ptr(5),
+					Increment: int64Ptr(-1),
+					MinValue:  int64Ptr(-10),
+					Cache:     int64Ptr(20),
+					Cycle:     boolPtr(true),
+				},
+				true,
+			),
+		},
+		{
+			input: "ALTER SEQUENCE mydb.seq NO MAXVALUE NOCACHE RESTART WITH 3",
+			plan: plan.NewAlterSequence(
+				sql.UnresolvedDatabase("mydb"),
+				"seq",
+				plan.SequenceOptions{
+					NoMaxValue:  true,
+					Cache:       int64Ptr(0),
+					Restart:     true,
+					RestartWith: int64Ptr(3),
+				},
+				false,
+			),
+		},
+		{
+			input: "DROP SEQUENCE IF EXISTS mydb.seq1, mydb.seq2",
+			plan:  plan.NewDropSequence(sql.UnresolvedDatabase("mydb"), []string{"seq1", "seq2"}, true),
+		},
+		{
+			input: "CHECKSUM TABLE t1, mydb.t2 EXTENDED",
+			plan:  plan.NewChecksumTable([]sql.DbTable{{Table: "t1"}, {Db: "mydb", Table: "t2"}}, false, true),
+		},
+		{
+			input: "CHECK TABLE mydb.t1 FOR UPGRADE QUICK",
+			plan:  plan.NewCheckTable([]sql.DbTable{{Db: "mydb", Table: "t1"}}, []string{"FOR UPGRADE", "QUICK"}),
+		},
+		{
+			input: "OPTIMIZE NO_WRITE_TO_BINLOG TABLES t1, `t 2`",
+			plan:  plan.NewOptimizeTable([]sql.DbTable{{Table: "t1"}, {Table: "t 2"}}, true),
+		},
+		{
+			input: "FLUSH TABLES",
+			plan:  plan.NewFlushTables(nil, false),
+		},
+		{
+			input: "FLUSH TABLE t1, mydb.t2 WITH READ LOCK",
+			plan:  plan.NewFlushTables([]sql.DbTable{{Table: "t1"}, {Db: "mydb", Table: "t2"}}, true),
+		},
+		{
+			input: "FLUSH TABLES WITH READ LOCK",
+			plan:  plan.NewFlushTables(nil, true),
+		},
+		{
+			input: "FLUSH LOGS",
+			plan:  plan.NewFlushLogs(sql.LogTypes),
+		},
+		{
+			input: "FLUSH SLOW LOGS",
+			plan:  plan.NewFlushLogs([]sql.LogType{sql.LogType_Slow}),
+		},
+		{
+			input: "BACKUP DATABASE mydb TO '/backups/mydb.sql'",
+			plan:  plan.NewBackupDatabase("mydb", "/backups/mydb.sql", sql.DumpOptions{}),
+		},
+		{
+			input: "BACKUP SCHEMA `my db` TO 'mydb.sql' WITH NO_DATA, add_drop_table, MYSQLDUMP",
+			plan:  plan.NewBackupDatabase("my db", "mydb.sql", sql.DumpOptions{NoData: true, AddDropTable: true, MySQLDump: true}),
+		},
+		{
+			input: "ALTER USER IF EXISTS 'u'@'localhost' IDENTIFIED BY 'pw', v PASSWORD EXPIRE INTERVAL 30 DAY PASSWORD HISTORY DEFAULT FAILED_LOGIN_ATTEMPTS 3 PASSWORD_LOCK_TIME UNBOUNDED ACCOUNT UNLOCK",
+			plan: &plan.AlterUser{
+				IfExists: true,
+				Users: []plan.AuthenticatedUser{
+					{UserName: plan.UserName{Name: "u", Host: "localhost"}, Auth1: plan.AuthenticationMysqlNativePassword("pw")},
+					{UserName: plan.UserName{Name: "v", Host: "%", AnyHost: true}},
+				},
+				Options: plan.AlterUserOptions{
+					PasswordLifetime:    &plan.AlterUserPasswordOption{Value: 30},
+					PasswordHistory:     &plan.AlterUserPasswordOption{Default: true},
+					FailedLoginAttempts: int64Ptr(3),
+					PasswordLockTime:    int64Ptr(-1),
+					Locked:              new(bool),
+				},
+				MySQLDb: sql.UnresolvedDatabase("mysql"),
+			},
+		},
+		{
+			input: "ALTER USER USER() IDENTIFIED BY 'new' REPLACE 'old' PASSWORD REQUIRE CURRENT OPTIONAL",
+			plan: &plan.AlterUser{
+				Users: []plan.AuthenticatedUser{
+					{UserName: plan.UserName{Name: "", Host: ""}, Auth1: plan.AuthenticationMysqlNativePassword("new"), CurrentPassword: stringPtr("old")},
+				},
+				Options: plan.AlterUserOptions{
+					PasswordRequireCurrent: &plan.AlterUserPasswordOption{},
+				},
+				MySQLDb: sql.UnresolvedDatabase("mysql"),
+			},
+		},
+		{
+			input: "SELECT NEXT VALUE FOR mydb.seq",
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("NEXT VALUE FOR mydb.seq",
+						expression.NewUnresolvedFunction("nextval", false, nil, expression.NewLiteral("mydb.seq", types.LongText)),
+					),
+				},
+				plan.NewResolvedDualTable(),
+			),
+		},
+		{
+			input: "SHOW ENGINE INNODB STATUS",
+			plan:  plan.NewShowEngineStatus("INNODB", false),
+		},
+		{
+			input: "show engine `InnoDB` mutex;",
+			plan:  plan.NewShowEngineStatus("InnoDB", true),
+		},
 		{
 			input: "INSERT INTO instance(id, setup_complete)\n  VALUES (CONVERT(UUID() USING utf8mb4), FALSE)",
 			plan: plan.NewInsertInto(
@@ -5229,25 +5398,40 @@ func assertNodesEqualWithDiff(t *testing.T, expected, actual sql.Node) bool {
 }
 
 var fixturesErrors = map[string]*errors.Kind{
-	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                      sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                      sql.ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                    sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:   sql.ErrUnsupportedSyntax,
-	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                  errInvalidDescribeFormat,
-	`CREATE TABLE test (pk int null primary key)`:               ErrPrimaryKeyOnNullField,
-	`CREATE TABLE test (pk int not null null primary key)`:      ErrPrimaryKeyOnNullField,
-	`CREATE TABLE test (pk int null, primary key(pk))`:          ErrPrimaryKeyOnNullField,
-	`CREATE TABLE test (pk int not null null, primary key(pk))`: ErrPrimaryKeyOnNullField,
-	`SELECT i, row_number() over (order by a) group by 1`:       sql.ErrUnsupportedFeature,
-	`SHOW COUNT(*) WARNINGS`:                                    sql.ErrUnsupportedFeature,
-	`SHOW ERRORS`:                                               sql.ErrUnsupportedFeature,
-	`SHOW VARIABLES WHERE Value = ''`:                           sql.ErrUnsupportedFeature,
-	`SHOW SESSION VARIABLES WHERE Value IS NOT NULL`:            sql.ErrUnsupportedFeature,
-	`KILL CONNECTION 4294967296`:                                sql.ErrUnsupportedFeature,
-	`DROP TABLE IF EXISTS curdb.foo, otherdb.bar`:               sql.ErrUnsupportedFeature,
-	`DROP TABLE curdb.t1, t2`:                                   sql.ErrUnsupportedFeature,
+	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                              sql.ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                              sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                              sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                              sql.ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                            sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:           sql.ErrUnsupportedSyntax,
+	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                          errInvalidDescribeFormat,
+	`CREATE TABLE test (pk int null primary key)`:                       ErrPrimaryKeyOnNullField,
+	`CREATE TABLE test (pk int not null null primary key)`:              ErrPrimaryKeyOnNullField,
+	`CREATE TABLE test (pk int null, primary key(pk))`:                  ErrPrimaryKeyOnNullField,
+	`CREATE TABLE test (pk int not null null, primary key(pk))`:         ErrPrimaryKeyOnNullField,
+	`SELECT i, row_number() over (order by a) group by 1`:               sql.ErrUnsupportedFeature,
+	`SHOW VARIABLES WHERE Value = ''`:                                   sql.ErrUnsupportedFeature,
+	`SHOW SESSION VARIABLES WHERE Value IS NOT NULL`:                    sql.ErrUnsupportedFeature,
+	`KILL CONNECTION 4294967296`:                                        sql.ErrUnsupportedFeature,
+	`DROP TABLE IF EXISTS curdb.foo, otherdb.bar`:                       sql.ErrUnsupportedFeature,
+	`DROP TABLE curdb.t1, t2`:                                           sql.ErrUnsupportedFeature,
+	`ALTER TABLE t DROP COLUMN c, ALGORITHM=FAST`:                       sql.ErrUnknownAlterAlgorithm,
+	`ALTER TABLE t DROP COLUMN c, LOCK=NOTHING`:                         sql.ErrUnknownAlterLock,
+	`CREATE SEQUENCE mydb.seq START WITH 1 STEP 2`:                      sql.ErrSyntaxError,
+	`DROP SEQUENCE mydb.seq; SELECT 1`:                                  sql.ErrSyntaxError,
+	`CHECKSUM TABLE t1 FAST`:                                            sql.ErrSyntaxError,
+	`CHECK LOCAL TABLE t1`:                                              sql.ErrSyntaxError,
+	`OPTIMIZE TABLE t1; SELECT 1`:                                       sql.ErrSyntaxError,
+	`SHOW ENGINE INNODB STATUS; SELECT 1`:                               sql.ErrSyntaxError,
+	`SELECT NEXT 5 VALUES FROM mydb.seq`:                                sql.ErrUnsupportedFeature,
+	`FLUSH TABLES t1 WITH READ`:                                         sql.ErrSyntaxError,
+	`FLUSH TABLES t1 FOR EXPORT`:                                        sql.ErrUnsupportedFeature,
+	`FLUSH TABLES; SELECT 1`:                                            sql.ErrSyntaxError,
+	`BACKUP DATABASE mydb TO mydb`:                                      sql.ErrSyntaxError,
+	`BACKUP DATABASE mydb TO 'mydb.sql' WITH COMPRESSED`:                sql.ErrSyntaxError,
+	`ALTER USER u@localhost PASSWORD EXPIRE INTERVAL 30`:                sql.ErrSyntaxError,
+	`ALTER USER u@localhost REQUIRE SSL`:                                sql.ErrUnsupportedFeature,
+	`ALTER USER u@localhost IDENTIFIED BY 'pw' RETAIN CURRENT PASSWORD`: sql.ErrUnsupportedFeature,
 }
 
 func TestParseOne(t *testing.T) {
@@ -5285,10 +5469,34 @@ func TestParseOne(t *testing.T) {
 			"SELECT 1; -- empty statement with comment\n; SELECT 2",
 			[]string{"SELECT 1", "-- empty statement with comment", "SELECT 2"},
 		},
+		{
+			"CREATE SEQUENCE mydb.s START WITH 5; SELECT 1",
+			[]string{"CREATE SEQUENCE mydb.s START WITH 5", "SELECT 1"},
+		},
+		{
+			"ALTER USER u ACCOUNT LOCK; SELECT 1",
+			[]string{"ALTER USER u ACCOUNT LOCK", "SELECT 1"},
+		},
+		{
+			"CHECK TABLE t1 QUICK; SELECT 1",
+			[]string{"CHECK TABLE t1 QUICK", "SELECT 1"},
+		},
 		{
 			"SELECT 1; SELECT 2; -- empty statement with comment\n",
 			[]string{"SELECT 1", "SELECT 2", "-- empty statement with comment"},
 		},
+		{
+			"SELECT 0b101, @a := 1 /* ; */; VALUES ROW(1, ';'); SELECT 2",
+			[]string{"SELECT 0b101, @a := 1 /* ; */", "VALUES ROW(1, ';')", "SELECT 2"},
+		},
+		{
+			"ALTER TABLE t1 ADD COLUMN c INT, ALGORITHM=INPLACE; SELECT 1",
+			[]string{"ALTER TABLE t1 ADD COLUMN c INT, ALGORITHM=INPLACE", "SELECT 1"},
+		},
+		{
+			"ALTER TABLE t1 COMMENT 'a;b', LOCK=NONE; SELECT 1",
+			[]string{"ALTER TABLE t1 COMMENT 'a;b', LOCK=NONE", "SELECT 1"},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -5308,6 +5516,362 @@ func TestParseOne(t *testing.T) {
 	}
 }
 
+func TestRewriteValuesStatements(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"VALUES ROW(1, 2), ROW(3, 4)",
+			"SELECT * FROM (VALUES ROW(1, 2), ROW(3, 4)) AS `values`",
+		},
+		{
+			"values row(1, (2 + 3)), row('a', 'b)') order by column_0 desc limit 1",
+			"SELECT * FROM (values row(1, (2 + 3)), row('a', 'b)')) AS `values` order by column_0 desc limit 1",
+		},
+		{
+			"SELECT 1, 2 UNION ALL VALUES ROW(3, 4) UNION (VALUES ROW(5, 6))",
+			"SELECT 1, 2 UNION ALL SELECT * FROM (VALUES ROW(3, 4)) AS `values` UNION (SELECT * FROM (VALUES ROW(5, 6)) AS `values`)",
+		},
+		{
+			"VALUES ROW(1); VALUES ROW(2)",
+			"SELECT * FROM (VALUES ROW(1)) AS `values`; SELECT * FROM (VALUES ROW(2)) AS `values`",
+		},
+		{
+			"SELECT * FROM (VALUES ROW(1, 2)) AS t",
+			"SELECT * FROM (VALUES ROW(1, 2)) AS t",
+		},
+		{
+			"INSERT INTO t VALUES ROW(1, 2)",
+			"INSERT INTO t VALUES ROW(1, 2)",
+		},
+		{
+			"VALUES (1, 2)",
+			"VALUES (1, 2)",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, RewriteValuesStatements(tc.input))
+		})
+	}
+}
+
+func TestRewriteTableSamples(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"SELECT * FROM t TABLESAMPLE BERNOULLI(10)",
+			"SELECT * FROM tablesample('t', 'BERNOULLI', 10) AS `t`",
+		},
+		{
+			"select * from db.t as x tablesample system (1 + 2) repeatable (42) where x.a = 1",
+			"select * from tablesample('db.t', 'SYSTEM', 1 + 2, 42) AS `x` where x.a = 1",
+		},
+		{
+			"select * from a join `b` y tablesample bernoulli(50) on a.i = y.i, c tablesample system(5)",
+			"select * from a join tablesample('b', 'BERNOULLI', 50) AS `y` on a.i = y.i, tablesample('c', 'SYSTEM', 5) AS `c`",
+		},
+		{
+			"SELECT tablesample FROM t",
+			"SELECT tablesample FROM t",
+		},
+		{
+			"SELECT * FROM t TABLESAMPLE BERNOULLI",
+			"SELECT * FROM t TABLESAMPLE BERNOULLI",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, RewriteTableSamples(tc.input))
+		})
+	}
+}
+
+func TestRewriteBitLiterals(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"SELECT 0b1010, 0b1 + 1 FROM t WHERE b = 0b0011",
+			"SELECT b'1010', b'1' + 1 FROM t WHERE b = b'0011'",
+		},
+		{
+			"insert into t values (1,0b11)",
+			"insert into t values (1,b'11')",
+		},
+		{
+			"SELECT `0b1010`, '0b1010', 0b12, 0B1, b'0b1' FROM t",
+			"SELECT `0b1010`, '0b1010', 0b12, 0B1, b'0b1' FROM t",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, RewriteBitLiterals(tc.input))
+		})
+	}
+}
+
+func TestRewriteUserVarAssignments(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"SELECT @r := @r + 1 AS n, i FROM t",
+			"SELECT `:=`(@r, @r + 1) AS n, i FROM t",
+		},
+		{
+			"SELECT @a := @b := 1, @c := f(x, y) c, (@d := 2) FROM dual",
+			"SELECT `:=`(@a, `:=`(@b, 1)), `:=`(@c, f(x, y)) c, (`:=`(@d, 2)) FROM dual",
+		},
+		{
+			"SELECT @k := CASE WHEN i > 1 THEN 'big' END FROM t WHERE (@w := i) > 1",
+			"SELECT `:=`(@k, CASE WHEN i > 1 THEN 'big' END) FROM t WHERE (`:=`(@w, i)) > 1",
+		},
+		{
+			"SET @a := 1, @@session.autocommit := 0, @b = (SELECT @c := 2)",
+			"SET @a = 1, @@session.autocommit = 0, @b = (SELECT `:=`(@c, 2))",
+		},
+		{
+			"UPDATE t SET v = @v := v + 1 WHERE i = 1",
+			"UPDATE t SET v = `:=`(@v, v + 1) WHERE i = 1",
+		},
+		{
+			"SELECT ':=', `a:=b`, @a = 1 FROM t",
+			"SELECT ':=', `a:=b`, @a = 1 FROM t",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, RewriteUserVarAssignments(tc.input))
+		})
+	}
+}
+
+func TestExtractViewOptions(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		opts     *viewOptions
+	}{
+		{
+			input:    "CREATE VIEW v (a, `b c`) AS SELECT 1, 2",
+			expected: "CREATE VIEW v AS SELECT 1, 2",
+			opts:     &viewOptions{columns: []string{"a", "b c"}, columnList: "(a, `b c`)", columnListPos: 13},
+		},
+		{
+			input:    "alter definer = 'u'@'%' sql security invoker view db.v as select 1",
+			expected: "CREATE definer = 'u'@'%' sql security invoker view db.v as select 1",
+			opts:     &viewOptions{isAlter: true, columnListPos: 55},
+		},
+		{
+			input:    "ALTER ALGORITHM = MERGE VIEW v(x) AS SELECT 1",
+			expected: "CREATE ALGORITHM = MERGE VIEW v AS SELECT 1",
+			opts:     &viewOptions{isAlter: true, columns: []string{"x"}, columnList: "(x)", columnListPos: 31},
+		},
+		{
+			input:    "CREATE VIEW v AS SELECT 1",
+			expected: "CREATE VIEW v AS SELECT 1",
+		},
+		{
+			input:    "ALTER TABLE t ADD COLUMN c INT",
+			expected: "ALTER TABLE t ADD COLUMN c INT",
+		},
+		{
+			input:    "ALTER DEFINER = u EVENT e DISABLE",
+			expected: "ALTER DEFINER = u EVENT e DISABLE",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			actual, opts := extractViewOptions(tc.input)
+			require.Equal(t, tc.expected, actual)
+			require.Equal(t, tc.opts, opts)
+		})
+	}
+}
+
+func TestExtractReturningClause(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		exprs    string
+	}{
+		{
+			input:    "INSERT INTO t VALUES (1) RETURNING a, b + 1",
+			expected: "INSERT INTO t VALUES (1) ",
+			exprs:    "a, b + 1",
+		},
+		{
+			input:    "delete from t where returning = 5 returning returning; select 1",
+			expected: "delete from t where returning = 5 ; select 1",
+			exprs:    "returning",
+		},
+		{
+			input:    "INSERT INTO t SELECT pk + 10, returning FROM t",
+			expected: "INSERT INTO t SELECT pk + 10, returning FROM t",
+		},
+		{
+			input:    "INSERT INTO t VALUES (1, 2) ON DUPLICATE KEY UPDATE returning = 9",
+			expected: "INSERT INTO t VALUES (1, 2) ON DUPLICATE KEY UPDATE returning = 9",
+		},
+		{
+			input:    "INSERT INTO t VALUES (1) RETURNING",
+			expected: "INSERT INTO t VALUES (1) RETURNING",
+		},
+		{
+			input:    "SELECT a FROM t RETURNING a",
+			expected: "SELECT a FROM t RETURNING a",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			actual, exprs := extractReturningClause(tc.input)
+			require.Equal(t, tc.expected, actual)
+			require.Equal(t, tc.exprs, exprs)
+		})
+	}
+}
+
+func TestParseDatabaseComments(t *testing.T) {
+	comment := func(n sql.Node, comment string) sql.Node {
+		switch n := n.(type) {
+		case *plan.CreateDB:
+			n.Comment = &comment
+		case *plan.AlterDB:
+			n.Comment = &comment
+		}
+		return n
+	}
+	cases := []struct {
+		input string
+		plan  sql.Node
+	}{
+		{
+			input: `CREATE DATABASE test COMMENT = 'it''s a test'`,
+			plan:  comment(plan.NewCreateDatabase("test", false, sql.Collation_Unspecified), "it's a test"),
+		},
+		{
+			input: `ALTER DATABASE test COMMENT 'tested'`,
+			plan:  comment(plan.NewAlterDatabase("test", sql.Collation_Unspecified), "tested"),
+		},
+		{
+			input: `ALTER DATABASE test CHARACTER SET latin1 COMMENT 'latin'`,
+			plan:  comment(plan.NewAlterDatabase("test", sql.Collation_latin1_swedish_ci), "latin"),
+		},
+		{
+			input: `CREATE SCHEMA IF NOT EXISTS test COMMENT 'schema'`,
+			plan:  comment(plan.NewCreateDatabase("test", true, sql.Collation_Unspecified), "schema"),
+		},
+	}
+	options := ParserOptions{EnableDatabaseComments: true}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			p, err := ParseWithOptions(ctx, tc.input, options)
+			require.NoError(t, err)
+			require.Equal(t, tc.plan, p)
+
+			// the option is a syntax error unless the parser enables it
+			_, err = Parse(ctx, tc.input)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+
+	// errors converting the statement aren't mistaken for a statement only changing the comment
+	ctx := sql.NewEmptyContext()
+	_, err := ParseWithOptions(ctx, `ALTER DATABASE test CHARACTER SET nosuchcharset COMMENT 'x'`, options)
+	require.Error(t, err)
+	require.False(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	_, err = ParseWithOptions(ctx, `CREATE DATABASE test COLLATE nosuchcollation COMMENT 'x'`, options)
+	require.Error(t, err)
+}
+
+func TestExtractTableOptions(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		opts     sql.TableOptions
+	}{
+		{
+			input:    "CREATE TABLE t (a int, b varchar(10)) ENGINE = InnoDB COMMENT 'a ''quoted'', commented table' ROW_FORMAT=COMPACT",
+			expected: "CREATE TABLE t (a int, b varchar(10)) ENGINE = InnoDB COMMENT 'a ''quoted'', commented table' ROW_FORMAT=COMPACT",
+			opts:     sql.TableOptions{"ENGINE": "InnoDB", "COMMENT": "a 'quoted', commented table", "ROW_FORMAT": "COMPACT"},
+		},
+		{
+			input:    "create temporary table if not exists db.t (a int) engine=innodb, secondary_engine=rapid, default character set utf8mb4 collate utf8mb4_bin",
+			expected: "create temporary table if not exists db.t (a int) engine=innodb, default character set utf8mb4 collate utf8mb4_bin",
+			opts:     sql.TableOptions{"ENGINE": "InnoDB", "SECONDARY_ENGINE": "rapid"},
+		},
+		{
+			input:    "CREATE TABLE t (a int) AUTO_INCREMENT=3, SECONDARY_ENGINE NULL PARTITION BY HASH(a)",
+			expected: "CREATE TABLE t (a int) AUTO_INCREMENT=3 PARTITION BY HASH(a)",
+			opts:     sql.TableOptions{"SECONDARY_ENGINE": ""},
+		},
+		{
+			input:    "CREATE TABLE t (a int) DATA DIRECTORY '/tmp' START TRANSACTION AS SELECT 1 AS a",
+			expected: "CREATE TABLE t (a int) DATA DIRECTORY '/tmp' START TRANSACTION AS SELECT 1 AS a",
+			opts:     sql.TableOptions{"DATA DIRECTORY": "/tmp"},
+		},
+		{
+			input:    "CREATE TABLE t (a int)",
+			expected: "CREATE TABLE t (a int)",
+		},
+		{
+			input:    "CREATE VIEW v AS SELECT 1",
+			expected: "CREATE VIEW v AS SELECT 1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			actual, opts := extractTableOptions(tc.input)
+			require.Equal(t, tc.expected, actual)
+			require.Equal(t, tc.opts, opts)
+		})
+	}
+}
+
+func TestRewriteAnsiQuotes(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			`SELECT "a" FROM "t" WHERE "b" = 'x'`,
+			"SELECT `a` FROM `t` WHERE `b` = 'x'",
+		},
+		{
+			`select "a""b", 'it''s "quoted"' from t`,
+			"select `a\"b`, 'it''s \"quoted\"' from t",
+		},
+		{
+			`select "a` + "`" + `b" from t`,
+			"select `a``b` from t",
+		},
+		{
+			"select `a`, 'b' from t",
+			"select `a`, 'b' from t",
+		},
+		{
+			`select /* it's */ "a", 'b' -- "c"` + "\n" + `from "t"`,
+			"select /* it's */ `a`, 'b' -- \"c\"\nfrom `t`",
+		},
+		{
+			`select /*!50000 "a", 'b' */ "c"`,
+			"select /*!50000 `a`, 'b' */ `c`",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, RewriteAnsiQuotes(tc.input))
+		})
+	}
+}
+
 func TestParseErrors(t *testing.T) {
 	for query, expectedError := range fixturesErrors {
 		t.Run(query, func(t *testing.T) {
@@ -5533,3 +6097,37 @@ func TestParseColumnTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestScanQueryFeatures(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected queryFeatures
+	}{
+		{
+			"SELECT 1 FROM t",
+			queryFeatures{first: sqlparser.SELECT, second: sqlparser.INTEGRAL},
+		},
+		{
+			`/* c */ SELECT "a", 0b1, @a := 1 FROM t TABLESAMPLE SYSTEM (10)`,
+			queryFeatures{first: sqlparser.SELECT, second: sqlparser.STRING, doubleQuotes: true, bitLiterals: true,
+				tableSample: true, assignments: true},
+		},
+		{
+			"SELECT '0b1', ':=', `tablesample`, \"returning\" /* VALUES ROW(1) */ -- VIEW\n",
+			queryFeatures{first: sqlparser.SELECT, second: sqlparser.STRING, doubleQuotes: true},
+		},
+		{
+			"INSERT INTO t VALUES ROW(1) RETURNING a",
+			queryFeatures{first: sqlparser.INSERT, second: sqlparser.INTO, valuesRows: true, returning: true},
+		},
+		{
+			"CREATE VIEW v AS SELECT 1; EXPLAIN ANALYZE SELECT 1",
+			queryFeatures{first: sqlparser.CREATE, second: sqlparser.VIEW, view: true, analyze: true},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, scanQueryFeatures(tc.input))
+		})
+	}
+}
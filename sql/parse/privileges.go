@@ -0,0 +1,119 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// unknownDynamicPrivileges are the dynamic privileges that the parser doesn't accept, by their lowercased names.
+var unknownDynamicPrivileges = map[string]struct{}{
+	plan.DynamicPrivilege_Unmask: {},
+}
+
+// placeholderDynamicPrivilege is a dynamic privilege the parser accepts, which replaces the unknown ones.
+const placeholderDynamicPrivilege = "SKIP_QUERY_REWRITE"
+
+// ExtractDynamicPrivileges replaces the dynamic privileges of the GRANT or REVOKE statement given that the parser
+// doesn't accept with one it does. Returns the rewritten statement, and the names of the privileges replaced by their
+// position in the privilege list. Other statements are returned unchanged, with nil names.
+func ExtractDynamicPrivileges(query string) (string, map[int]string) {
+	tkn := sqlparser.NewStringTokenizer(query)
+	scan := func() (int, string, int, int) {
+		start := tkn.Position - 1
+		if start < 0 {
+			start = 0
+		}
+		typ, val := tkn.Scan()
+		return typ, string(val), start, tkn.Position - 1
+	}
+
+	if typ, _, _, _ := scan(); typ != sqlparser.GRANT && typ != sqlparser.REVOKE {
+		return query, nil
+	}
+
+	type replacement struct {
+		start, end int
+	}
+	var replacements []replacement
+	var names map[int]string
+	idx, depth := 0, 0
+	for {
+		typ, val, start, end := scan()
+		switch typ {
+		case 0, sqlparser.LEX_ERROR, ';', sqlparser.TO, sqlparser.FROM:
+			// statements without an ON clause grant or revoke roles
+			return query, nil
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				idx++
+			}
+		case sqlparser.ID:
+			if _, ok := unknownDynamicPrivileges[strings.ToLower(val)]; ok && depth == 0 {
+				if names == nil {
+					names = make(map[int]string)
+				}
+				names[idx] = strings.ToLower(val)
+				replacements = append(replacements, replacement{start, end})
+			}
+		case sqlparser.ON:
+			if depth != 0 {
+				continue
+			}
+			if len(replacements) == 0 {
+				return query, nil
+			}
+			var sb strings.Builder
+			pos := 0
+			for _, r := range replacements {
+				sb.WriteString(query[pos:r.start])
+				sb.WriteString(" ")
+				sb.WriteString(placeholderDynamicPrivilege)
+				pos = r.end
+			}
+			sb.WriteString(query[pos:])
+			return sb.String(), names
+		}
+	}
+}
+
+// RestoreDynamicPrivileges sets the names of the dynamic privileges of the GRANT or REVOKE node given that
+// ExtractDynamicPrivileges replaced.
+func RestoreDynamicPrivileges(node sql.Node, names map[int]string) sql.Node {
+	var privileges []plan.Privilege
+	switch n := node.(type) {
+	case *plan.Grant:
+		privileges = n.Privileges
+	case *plan.Revoke:
+		privileges = n.Privileges
+	default:
+		return node
+	}
+	for i, name := range names {
+		if i < len(privileges) {
+			privileges[i].Dynamic = name
+		}
+	}
+	return node
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+const returningKeyword = "returning"
+
+// extractReturningClause removes the RETURNING clause from the first statement of the query given if it is an INSERT,
+// REPLACE or DELETE statement, since the parser doesn't accept it. Returns the query without the clause, and the list
+// of expressions of the clause, which is empty when the statement has none. Only a trailing clause, following a
+// complete statement and followed by a list of expressions, is removed, and statements that parse as they are, such as
+// those naming a column returning, are returned unchanged.
+func extractReturningClause(query string) (string, string) {
+	tkn := sqlparser.NewStringTokenizer(query)
+	switch typ, _ := tkn.Scan(); typ {
+	case sqlparser.INSERT, sqlparser.REPLACE, sqlparser.DELETE:
+	default:
+		return query, ""
+	}
+
+	// the positions of the unquoted returning identifiers outside of parentheses, any of which could start the clause
+	var starts []int
+	depth := 0
+	end := -1
+	for end < 0 {
+		typ, _ := tkn.Scan()
+		pos := tkn.Position - 1
+		if pos > len(query) {
+			pos = len(query)
+		}
+		switch typ {
+		case 0, sqlparser.LEX_ERROR:
+			end = pos
+		case ';':
+			end = pos - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sqlparser.ID:
+			// a quoted identifier named returning isn't the start of the clause
+			if depth == 0 && pos >= len(returningKeyword) &&
+				strings.EqualFold(query[pos-len(returningKeyword):pos], returningKeyword) {
+				starts = append(starts, pos-len(returningKeyword))
+			}
+		}
+	}
+	if len(starts) == 0 || depth != 0 {
+		return query, ""
+	}
+	if _, err := sqlparser.Parse(query[:end]); err == nil {
+		return query, ""
+	}
+
+	for _, start := range starts {
+		// without any expressions, the clause is left for the parser to reject
+		exprs := strings.TrimSpace(query[start+len(returningKeyword) : end])
+		if exprs == "" {
+			continue
+		}
+		if _, err := sqlparser.Parse(query[:start]); err != nil {
+			continue
+		}
+		if _, err := sqlparser.Parse("SELECT " + exprs); err != nil {
+			continue
+		}
+		return query[:start] + query[end:], exprs
+	}
+	return query, ""
+}
+
+// newReturning wraps the node given, converted from a statement with its RETURNING clause removed by
+// extractReturningClause, in a *plan.Returning node returning the expressions of the clause.
+func newReturning(ctx *sql.Context, node sql.Node, exprs string) (sql.Node, error) {
+	switch n := node.(type) {
+	case *plan.InsertInto:
+	case *plan.DeleteFrom:
+		if n.HasExplicitTargets() {
+			return nil, sql.ErrUnsupportedSyntax.New("RETURNING clause on a multiple-table DELETE statement")
+		}
+	default:
+		return nil, sql.ErrUnsupportedSyntax.New("RETURNING clause on this statement")
+	}
+
+	stmt, err := sqlparser.Parse("SELECT " + exprs)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 0 || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil, sql.ErrSyntaxError.New("invalid RETURNING clause: " + exprs)
+	}
+	projections, err := selectExprsToExpressions(ctx, sel.SelectExprs)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewReturning(projections, node), nil
+}
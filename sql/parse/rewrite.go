@@ -0,0 +1,227 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// queryToken is a token of a query, which starts at query[start].
+type queryToken struct {
+	typ   int
+	val   string
+	start int
+}
+
+// queryTokenizer reads the tokens of a query for the passes rewriting the constructs that the parser doesn't accept.
+// Unlike the tokenizer of the parser, it skips comments, and gives the position in the query each token starts at.
+type queryTokenizer struct {
+	query string
+	tkn   *sqlparser.Tokenizer
+	// end is the position in the query past the last token read, which may be past a blank following it
+	end int
+}
+
+func newQueryTokenizer(query string) *queryTokenizer {
+	return &queryTokenizer{query: query, tkn: sqlparser.NewStringTokenizer(query)}
+}
+
+// scan reads the next token of the query. At the end of the query, the token has the type 0.
+func (t *queryTokenizer) scan() queryToken {
+	for {
+		start := skipBlanks(t.query, t.end)
+		typ, val := t.tkn.Scan()
+		if end := t.tkn.Position - 1; end > t.end {
+			t.end = end
+			if t.end > len(t.query) {
+				t.end = len(t.query)
+			}
+		}
+		if typ != sqlparser.COMMENT {
+			return queryToken{typ: typ, val: string(val), start: start}
+		}
+	}
+}
+
+// skipBlanks returns the position of the first character of the query from |pos| that isn't a blank. The markers of
+// the MySQL-specific comments, whose contents are read as part of the query, are skipped as blanks.
+func skipBlanks(query string, pos int) int {
+	for pos < len(query) {
+		switch {
+		case query[pos] == ' ' || query[pos] == '\t' || query[pos] == '\r' || query[pos] == '\n':
+			pos++
+		case strings.HasPrefix(query[pos:], "/*!"):
+			// the marker is followed by the version of the server the comment applies to
+			pos += 3
+			for digits := 0; digits < 5 && pos < len(query) && query[pos] >= '0' && query[pos] <= '9'; digits++ {
+				pos++
+			}
+		case strings.HasPrefix(query[pos:], "*/"):
+			pos += 2
+		default:
+			return pos
+		}
+	}
+	return pos
+}
+
+// queryFeatures are the constructs of a query that the passes rewriting it look for, found by scanQueryFeatures with a
+// single read of its tokens, so that each pass only reads the queries that may have the construct it rewrites.
+type queryFeatures struct {
+	// first and second are the types of the first two tokens of the query
+	first, second int
+	// doubleQuotes is whether the query has strings quoted with double quotes
+	doubleQuotes bool
+	// valuesRows is whether the query has a VALUES keyword followed by a ROW constructor
+	valuesRows bool
+	// bitLiterals is whether the query has unquoted identifiers beginning with 0b
+	bitLiterals bool
+	// tableSample is whether the query has a TABLESAMPLE identifier
+	tableSample bool
+	// assignments is whether the query has := assignments
+	assignments bool
+	// view, analyze and returning are whether the query has a VIEW keyword, an ANALYZE keyword and a RETURNING
+	// identifier
+	view, analyze, returning bool
+}
+
+// scanQueryFeatures returns the constructs of the query given that the passes rewriting it look for. Constructs in
+// comments and strings aren't found.
+func scanQueryFeatures(query string) queryFeatures {
+	var f queryFeatures
+	t := newQueryTokenizer(query)
+	prev := 0
+	for i := 0; ; i++ {
+		tok := t.scan()
+		if tok.typ == 0 || tok.typ == sqlparser.LEX_ERROR {
+			return f
+		}
+		switch i {
+		case 0:
+			f.first = tok.typ
+		case 1:
+			f.second = tok.typ
+		}
+
+		switch tok.typ {
+		case sqlparser.STRING:
+			f.doubleQuotes = f.doubleQuotes || query[tok.start] == '"'
+		case sqlparser.ROW:
+			f.valuesRows = f.valuesRows || prev == sqlparser.VALUES
+		case sqlparser.ID:
+			switch {
+			case query[tok.start] == '`':
+			case strings.HasPrefix(tok.val, "0b"):
+				f.bitLiterals = true
+			case strings.EqualFold(tok.val, "tablesample"):
+				f.tableSample = true
+			case strings.EqualFold(tok.val, returningKeyword):
+				f.returning = true
+			}
+		case '=':
+			f.assignments = f.assignments || prev == ':'
+		case sqlparser.VIEW:
+			f.view = true
+		case sqlparser.ANALYZE:
+			f.analyze = true
+		}
+		prev = tok.typ
+	}
+}
+
+// RewriteQuery rewrites the constructs of the query given that the parser doesn't accept as they're written, with
+// RewriteAnsiQuotes when the ANSI_QUOTES SQL mode is set, RewriteValuesStatements, RewriteBitLiterals,
+// RewriteTableSamples, RewriteUserVarAssignments and ExtractDynamicPrivileges. Returns the rewritten query, and the
+// names of the dynamic privileges replaced as ExtractDynamicPrivileges does. Only the passes for the constructs the
+// query has are run.
+func RewriteQuery(ctx *sql.Context, query string) (string, map[int]string) {
+	query, _, dynamicPrivileges := rewriteQuery(ctx, query)
+	return query, dynamicPrivileges
+}
+
+// rewriteQuery is like RewriteQuery, and also returns the constructs of the rewritten query for the passes that only
+// this package's parser runs.
+func rewriteQuery(ctx *sql.Context, query string) (string, queryFeatures, map[int]string) {
+	f := scanQueryFeatures(query)
+	if f.doubleQuotes && sql.LoadSqlMode(ctx).AnsiQuotes() {
+		query = RewriteAnsiQuotes(query)
+		// the strings rewritten to identifiers may be read by the other passes
+		f = scanQueryFeatures(query)
+	}
+	if f.valuesRows {
+		query = RewriteValuesStatements(query)
+	}
+	if f.bitLiterals {
+		query = RewriteBitLiterals(query)
+	}
+	if f.tableSample {
+		query = RewriteTableSamples(query)
+	}
+	if f.assignments {
+		query = RewriteUserVarAssignments(query)
+	}
+	var dynamicPrivileges map[int]string
+	if f.first == sqlparser.GRANT || f.first == sqlparser.REVOKE {
+		query, dynamicPrivileges = ExtractDynamicPrivileges(query)
+	}
+	return query, f, dynamicPrivileges
+}
+
+// OriginalStatement returns the text of the first statement of the query given and the remainder of the query after
+// it, where the statement ends at |end| in the query as it was rewritten before being parsed. The rewrites neither add
+// nor remove the semicolons ending statements, so the statement ends after as many of them in the query as it does in
+// the rewritten query. An |end| of 0 is the end of the query.
+func OriginalStatement(query, rewritten string, end int) (string, string) {
+	if end <= 0 || end >= len(rewritten) {
+		return query, ""
+	}
+	if query != rewritten {
+		semicolons, last := 0, 0
+		t := newQueryTokenizer(rewritten)
+		for tok := t.scan(); tok.typ != 0 && tok.typ != sqlparser.LEX_ERROR && tok.start < end; tok = t.scan() {
+			if tok.typ == ';' {
+				semicolons, last = semicolons+1, tok.start
+			}
+		}
+		if semicolons == 0 {
+			return query, ""
+		}
+		t = newQueryTokenizer(query)
+		for tok := t.scan(); tok.typ != 0 && tok.typ != sqlparser.LEX_ERROR; tok = t.scan() {
+			if tok.typ == ';' {
+				if semicolons--; semicolons == 0 {
+					end = tok.start + end - last
+					break
+				}
+			}
+		}
+		if semicolons != 0 || end >= len(query) {
+			return query, ""
+		}
+		rewritten = query
+	}
+
+	parsed := strings.TrimSpace(rewritten[:end])
+	// trim spaces and empty statements
+	parsed = strings.TrimRightFunc(parsed, func(r rune) bool {
+		return r == ';' || unicode.IsSpace(r)
+	})
+	return parsed, rewritten[end:]
+}
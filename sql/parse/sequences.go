@@ -0,0 +1,270 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var sequenceDDLRegex = regexp.MustCompile(`(?i)^\s*(CREATE|ALTER|DROP)\s+SEQUENCE\s`)
+
+// sequenceFunctions are the functions whose first argument names a sequence.
+var sequenceFunctions = map[string]bool{
+	"nextval": true,
+	"lastval": true,
+	"setval":  true,
+}
+
+// parseSequenceDDL parses the first statement of the query given if it is a CREATE, ALTER or DROP SEQUENCE statement.
+// Returns whether the statement was a sequence statement, the node for it, and the text of the statement along with
+// the remainder of the query after it.
+func parseSequenceDDL(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	m := sequenceDDLRegex.FindStringSubmatch(query)
+	if m == nil {
+		return false, nil, "", "", nil
+	}
+
+	t := newStatementTokenizer(query)
+	// Skip the leading CREATE, ALTER or DROP keyword and the SEQUENCE keyword
+	t.next()
+	t.next()
+
+	var node sql.Node
+	var err error
+	switch strings.ToUpper(m[1]) {
+	case "CREATE":
+		node, err = parseCreateSequence(ctx, t)
+	case "ALTER":
+		node, err = parseAlterSequence(ctx, t)
+	default:
+		node, err = parseDropSequence(ctx, t)
+	}
+	if err != nil {
+		return true, nil, query, "", err
+	}
+	if t.typ != 0 {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsed, remainder := t.split()
+	return true, node, parsed, remainder, nil
+}
+
+func parseCreateSequence(ctx *sql.Context, t *statementTokenizer) (sql.Node, error) {
+	ifNotExists := false
+	if t.keyword("IF") {
+		if !t.keyword("NOT") || !t.keyword("EXISTS") {
+			return nil, t.errorf("expected IF NOT EXISTS")
+		}
+		ifNotExists = true
+	}
+	dbName, name, err := t.tableName()
+	if err != nil {
+		return nil, err
+	}
+	db, err := getUnresolvedDatabase(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	options, err := parseSequenceOptions(t, false)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewCreateSequence(db, name, options, ifNotExists), nil
+}
+
+func parseAlterSequence(ctx *sql.Context, t *statementTokenizer) (sql.Node, error) {
+	ifExists := false
+	if t.keyword("IF") {
+		if !t.keyword("EXISTS") {
+			return nil, t.errorf("expected IF EXISTS")
+		}
+		ifExists = true
+	}
+	dbName, name, err := t.tableName()
+	if err != nil {
+		return nil, err
+	}
+	db, err := getUnresolvedDatabase(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	options, err := parseSequenceOptions(t, true)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewAlterSequence(db, name, options, ifExists), nil
+}
+
+func parseDropSequence(ctx *sql.Context, t *statementTokenizer) (sql.Node, error) {
+	ifExists := false
+	if t.keyword("IF") {
+		if !t.keyword("EXISTS") {
+			return nil, t.errorf("expected IF EXISTS")
+		}
+		ifExists = true
+	}
+
+	// Sequences in the same database are dropped by the same node
+	var dbNames []string
+	namesByDb := make(map[string][]string)
+	for {
+		dbName, name, err := t.tableName()
+		if err != nil {
+			return nil, err
+		}
+		db, err := getUnresolvedDatabase(ctx, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := namesByDb[db.Name()]; !ok {
+			dbNames = append(dbNames, db.Name())
+		}
+		namesByDb[db.Name()] = append(namesByDb[db.Name()], name)
+		if !t.char(',') {
+			break
+		}
+	}
+
+	drops := make([]sql.Node, len(dbNames))
+	for i, dbName := range dbNames {
+		drops[i] = plan.NewDropSequence(sql.UnresolvedDatabase(dbName), namesByDb[dbName], ifExists)
+	}
+	if len(drops) == 1 {
+		return drops[0], nil
+	}
+	return plan.NewBlock(drops), nil
+}
+
+// parseSequenceOptions reads the options of a CREATE SEQUENCE statement, or an ALTER SEQUENCE statement if |alter| is
+// true.
+func parseSequenceOptions(t *statementTokenizer, alter bool) (plan.SequenceOptions, error) {
+	var options plan.SequenceOptions
+	var err error
+	for t.typ != 0 {
+		switch {
+		case t.keyword("START"):
+			if !t.keyword("WITH") {
+				t.char('=')
+			}
+			options.Start, err = t.integer()
+		case t.keyword("INCREMENT"):
+			if !t.keyword("BY") {
+				t.char('=')
+			}
+			options.Increment, err = t.integer()
+		case t.keyword("MINVALUE"):
+			t.char('=')
+			options.MinValue, err = t.integer()
+		case t.keyword("MAXVALUE"):
+			t.char('=')
+			options.MaxValue, err = t.integer()
+		case t.keyword("NOMINVALUE"):
+			options.NoMinValue = true
+		case t.keyword("NOMAXVALUE"):
+			options.NoMaxValue = true
+		case t.keyword("CACHE"):
+			t.char('=')
+			options.Cache, err = t.integer()
+		case t.keyword("NOCACHE"):
+			var noCache int64
+			options.Cache = &noCache
+		case t.keyword("CYCLE"):
+			cycle := true
+			options.Cycle = &cycle
+		case t.keyword("NOCYCLE"):
+			cycle := false
+			options.Cycle = &cycle
+		case t.keyword("NO"):
+			switch {
+			case t.keyword("MINVALUE"):
+				options.NoMinValue = true
+			case t.keyword("MAXVALUE"):
+				options.NoMaxValue = true
+			case t.keyword("CYCLE"):
+				cycle := false
+				options.Cycle = &cycle
+			default:
+				return options, t.errorf("unknown sequence option")
+			}
+		case alter && t.keyword("RESTART"):
+			options.Restart = true
+			if t.keyword("WITH") || t.char('=') || t.typ == sqlparser.INTEGRAL || t.typ == '-' || t.typ == '+' {
+				options.RestartWith, err = t.integer()
+			}
+		case t.char(','):
+		default:
+			return options, t.errorf("unknown sequence option")
+		}
+		if err != nil {
+			return options, err
+		}
+	}
+	return options, nil
+}
+
+// sequenceNameArgument returns the first argument of a sequence function as a literal string if it is a bare
+// sequence name, which would otherwise be resolved as a column.
+func sequenceNameArgument(e sqlparser.SelectExpr) (sql.Expression, bool) {
+	ae, ok := e.(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, false
+	}
+	col, ok := ae.Expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, false
+	}
+	name := col.Name.String()
+	if !col.Qualifier.IsEmpty() {
+		name = col.Qualifier.Name.String() + "." + name
+	}
+	return expression.NewLiteral(name, types.LongText), true
+}
+
+// convertNextValueFor converts a SELECT NEXT VALUE FOR statement, which returns the next value of a sequence.
+func convertNextValueFor(ctx *sql.Context, s *sqlparser.Select, nv sqlparser.Nextval) (sql.Node, error) {
+	if len(s.From) != 1 {
+		return nil, sql.ErrUnsupportedSyntax.New(sqlparser.String(s))
+	}
+	te, ok := s.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, sql.ErrUnsupportedSyntax.New(sqlparser.String(s))
+	}
+	tn, ok := te.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, sql.ErrUnsupportedSyntax.New(sqlparser.String(s))
+	}
+	if count, ok := nv.Expr.(*sqlparser.SQLVal); !ok || string(count.Val) != "1" {
+		return nil, sql.ErrUnsupportedFeature.New("reading more than one value from a sequence")
+	}
+
+	name := tn.Name.String()
+	if !tn.Qualifier.IsEmpty() {
+		name = tn.Qualifier.String() + "." + name
+	}
+	nextVal := expression.NewUnresolvedFunction("nextval", false, nil, expression.NewLiteral(name, types.LongText))
+	return plan.NewProject(
+		[]sql.Expression{expression.NewAlias("NEXT VALUE FOR "+name, nextVal)},
+		plan.NewResolvedDualTable(),
+	), nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// statementTokenizer reads the tokens of a statement that the parser doesn't support, for the statements parsed by
+// hand in this package.
+type statementTokenizer struct {
+	query string
+	tkn   *sqlparser.Tokenizer
+	typ   int
+	val   string
+	// end is the position in the query just past the current token
+	end int
+}
+
+func newStatementTokenizer(query string) *statementTokenizer {
+	t := &statementTokenizer{query: query, tkn: sqlparser.NewStringTokenizer(query)}
+	t.next()
+	return t
+}
+
+func (t *statementTokenizer) next() {
+	t.typ, t.val = 0, ""
+	typ, val := t.tkn.Scan()
+	if typ == ';' {
+		typ = 0
+	}
+	t.typ, t.val = typ, string(val)
+	if typ != 0 {
+		t.end = t.tkn.Position - 1
+	}
+}
+
+// keyword returns whether the current token is the keyword given, and if so moves past it.
+func (t *statementTokenizer) keyword(kw string) bool {
+	if t.typ == 0 || t.typ == sqlparser.LEX_ERROR || !strings.EqualFold(t.val, kw) {
+		return false
+	}
+	t.next()
+	return true
+}
+
+// char returns whether the current token is the character given, and if so moves past it.
+func (t *statementTokenizer) char(c byte) bool {
+	if t.typ != int(c) {
+		return false
+	}
+	t.next()
+	return true
+}
+
+func (t *statementTokenizer) errorf(format string, args ...interface{}) error {
+	near := t.val
+	if t.typ == 0 {
+		near = "end of statement"
+	}
+	return sql.ErrSyntaxError.New(fmt.Sprintf(format, args...) + fmt.Sprintf(" near '%s'", near))
+}
+
+func (t *statementTokenizer) identifier() (string, error) {
+	if t.typ == 0 || t.typ == sqlparser.LEX_ERROR || t.typ == sqlparser.INTEGRAL || (t.typ < 256 && t.typ > 0) {
+		return "", t.errorf("expected identifier")
+	}
+	val := t.val
+	t.next()
+	return val, nil
+}
+
+// tableName reads a table or sequence name, optionally qualified with its database.
+func (t *statementTokenizer) tableName() (string, string, error) {
+	name, err := t.identifier()
+	if err != nil {
+		return "", "", err
+	}
+	if !t.char('.') {
+		return "", name, nil
+	}
+	seqName, err := t.identifier()
+	return name, seqName, err
+}
+
+func (t *statementTokenizer) integer() (*int64, error) {
+	negative := false
+	if t.char('-') {
+		negative = true
+	} else {
+		t.char('+')
+	}
+	if t.typ != sqlparser.INTEGRAL {
+		return nil, t.errorf("expected integer")
+	}
+	val := t.val
+	if negative {
+		val = "-" + val
+	}
+	i, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return nil, t.errorf("integer out of range")
+	}
+	t.next()
+	return &i, nil
+}
+
+// split returns the text of the statement read so far and the remainder of the query after it. Anything after the
+// statement other than a semicolon is the start of the next statement.
+func (t *statementTokenizer) split() (string, string) {
+	if i := strings.IndexByte(t.query[t.end:], ';'); i >= 0 {
+		return strings.TrimSpace(t.query[:t.end]), t.query[t.end+i+1:]
+	}
+	return t.query, ""
+}
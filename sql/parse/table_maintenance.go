@@ -0,0 +1,100 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var tableMaintenanceRegex = regexp.MustCompile(`(?i)^\s*(CHECKSUM|CHECK|OPTIMIZE)\s+(?:(?:NO_WRITE_TO_BINLOG|LOCAL)\s+)?TABLES?\s`)
+
+// checkTableOptions are the options of CHECK TABLE, by their first keyword.
+var checkTableOptions = map[string]bool{
+	"FOR":      true,
+	"QUICK":    true,
+	"FAST":     true,
+	"MEDIUM":   true,
+	"EXTENDED": true,
+	"CHANGED":  true,
+}
+
+// parseTableMaintenance parses the first statement of the query given if it is a CHECKSUM TABLE, CHECK TABLE or
+// OPTIMIZE TABLE statement, which the parser doesn't support. Returns whether the statement was one of those, the node
+// for it, and the text of the statement along with the remainder of the query after it.
+func parseTableMaintenance(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	m := tableMaintenanceRegex.FindStringSubmatch(query)
+	if m == nil {
+		return false, nil, "", "", nil
+	}
+
+	t := newStatementTokenizer(query)
+	statement := strings.ToUpper(m[1])
+	t.next()
+	noWriteToBinlog := t.keyword("NO_WRITE_TO_BINLOG") || t.keyword("LOCAL")
+	if statement != "OPTIMIZE" && noWriteToBinlog {
+		return true, nil, query, "", sql.ErrSyntaxError.New("unexpected NO_WRITE_TO_BINLOG in " + statement + " TABLE")
+	}
+	if !t.keyword("TABLE") && !(statement == "OPTIMIZE" && t.keyword("TABLES")) {
+		return true, nil, query, "", t.errorf("expected TABLE")
+	}
+
+	var tables []sql.DbTable
+	for {
+		db, name, err := t.tableName()
+		if err != nil {
+			return true, nil, query, "", err
+		}
+		tables = append(tables, sql.DbTable{Db: db, Table: name})
+		if !t.char(',') {
+			break
+		}
+	}
+
+	var node sql.Node
+	switch statement {
+	case "CHECKSUM":
+		quick, extended := t.keyword("QUICK"), false
+		if !quick {
+			extended = t.keyword("EXTENDED")
+		}
+		node = plan.NewChecksumTable(tables, quick, extended)
+	case "CHECK":
+		var options []string
+		for t.typ != 0 && checkTableOptions[strings.ToUpper(t.val)] {
+			if t.keyword("FOR") {
+				if !t.keyword("UPGRADE") {
+					return true, nil, query, "", t.errorf("expected FOR UPGRADE")
+				}
+				options = append(options, "FOR UPGRADE")
+				continue
+			}
+			options = append(options, strings.ToUpper(t.val))
+			t.next()
+		}
+		node = plan.NewCheckTable(tables, options)
+	default:
+		node = plan.NewOptimizeTable(tables, noWriteToBinlog)
+	}
+	if t.typ != 0 {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsed, remainder := t.split()
+	return true, node, parsed, remainder, nil
+}
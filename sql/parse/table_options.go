@@ -0,0 +1,372 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// tableOptionToken is a token of the table options of a CREATE TABLE statement.
+type tableOptionToken struct {
+	typ int
+	val string
+	// start is the end of the previous token, so that removing the token also removes the whitespace before it
+	start int
+	end   int
+}
+
+// tableOption is a table option read from the tokens of a statement, along with the indexes of its first and last
+// tokens.
+type tableOption struct {
+	name  string
+	value string
+	first int
+	last  int
+}
+
+// alterableTableOptions are the table options that ALTER TABLE changes through an AlterTableOptions node. The character
+// set, collation and AUTO_INCREMENT value have nodes of their own.
+var alterableTableOptions = map[string]bool{
+	sql.TableOptionEngine:                   true,
+	sql.TableOptionRowFormat:                true,
+	sql.TableOptionComment:                  true,
+	sql.TableOptionSecondaryEngine:          true,
+	sql.TableOptionSecondaryEngineAttribute: true,
+	"AUTOEXTEND_SIZE":                       true,
+	"AVG_ROW_LENGTH":                        true,
+	"CHECKSUM":                              true,
+	"COMPRESSION":                           true,
+	"CONNECTION":                            true,
+	"DATA DIRECTORY":                        true,
+	"DELAY_KEY_WRITE":                       true,
+	"ENCRYPTION":                            true,
+	"ENGINE_ATTRIBUTE":                      true,
+	"INDEX DIRECTORY":                       true,
+	"INSERT_METHOD":                         true,
+	"KEY_BLOCK_SIZE":                        true,
+	"MAX_ROWS":                              true,
+	"MIN_ROWS":                              true,
+	"PACK_KEYS":                             true,
+	"PASSWORD":                              true,
+	"STATS_AUTO_RECALC":                     true,
+	"STATS_PERSISTENT":                      true,
+	"STATS_SAMPLE_PAGES":                    true,
+	"TABLESPACE":                            true,
+	"UNION":                                 true,
+}
+
+// extractTableOptions returns the table options of the first statement of the query given if it is a CREATE TABLE
+// statement, since the parser flattens them to a string that can't be split back into options reliably. The
+// SECONDARY_ENGINE option, which the parser doesn't accept, is removed from the returned query. Other statements, and
+// CREATE TABLE statements without table options, are returned unchanged, with nil options.
+func extractTableOptions(query string) (string, sql.TableOptions) {
+	tokens := scanCreateTableOptions(query)
+	if len(tokens) == 0 {
+		return query, nil
+	}
+	parsedOptions, ok := readTableOptions(query, tokens)
+	if !ok {
+		return query, nil
+	}
+
+	options := make(sql.TableOptions)
+	var removed [][2]int
+	for _, option := range parsedOptions {
+		switch option.name {
+		case "CHARSET", "COLLATE", "AUTO_INCREMENT", "START TRANSACTION":
+			// the engine handles these itself
+		case sql.TableOptionSecondaryEngine:
+			// the parser doesn't accept this option, so it's removed along with a comma separating it from others
+			span := [2]int{tokens[option.first].start, tokens[option.last].end}
+			if option.last+1 < len(tokens) && tokens[option.last+1].typ == ',' {
+				span[1] = tokens[option.last+1].end
+			} else if option.first > 0 && tokens[option.first-1].typ == ',' {
+				span[0] = tokens[option.first-1].start
+			}
+			removed = append(removed, span)
+			options[option.name] = option.value
+		default:
+			options[option.name] = option.value
+		}
+	}
+
+	if len(removed) > 0 {
+		var sb strings.Builder
+		prev := 0
+		for _, span := range removed {
+			sb.WriteString(query[prev:span[0]])
+			prev = span[1]
+		}
+		sb.WriteString(query[prev:])
+		query = sb.String()
+	}
+	if len(options) == 0 {
+		return query, nil
+	}
+	return query, options
+}
+
+// parseAlterTableOptions parses the first statement of the query given if it is an ALTER TABLE statement that only
+// changes table options, which the parser doesn't support. Returns whether the statement was one, the node for it, and
+// the text of the statement along with the remainder of the query after it.
+func parseAlterTableOptions(ctx *sql.Context, query string) (bool, sql.Node, string, string, error) {
+	if !alterTableRegex.MatchString(query) {
+		return false, nil, "", "", nil
+	}
+
+	t := newStatementTokenizer(query)
+	t.next()
+	t.next()
+	db, name, err := t.tableName()
+	if err != nil {
+		return false, nil, "", "", nil
+	}
+
+	// the tokens of the options, up to the end of the statement
+	prevEnd := t.end
+	var tokens []tableOptionToken
+	for ; t.typ != 0 && t.typ != sqlparser.LEX_ERROR; t.next() {
+		if t.typ == sqlparser.COMMENT {
+			continue
+		}
+		tokens = append(tokens, tableOptionToken{typ: t.typ, val: t.val, start: prevEnd, end: t.end})
+		prevEnd = t.end
+	}
+	// an ALTER TABLE without any clause changes nothing, like one that sets no options
+	if len(tokens) == 0 && t.typ == 0 {
+		parsed, remainder := t.split()
+		return true, plan.NewAlterTableOptions(sql.UnresolvedDatabase(db), plan.NewUnresolvedTable(name, db), make(sql.TableOptions)), parsed, remainder, nil
+	}
+	if len(tokens) == 0 || !alterableTableOptions[tableOptionName(tokens)] {
+		return false, nil, "", "", nil
+	}
+	if t.typ == sqlparser.LEX_ERROR {
+		return true, nil, query, "", t.errorf("unexpected token")
+	}
+
+	parsedOptions, ok := readTableOptions(query, tokens)
+	if !ok {
+		return true, nil, query, "", sql.ErrSyntaxError.New("invalid table options near '" + query[tokens[0].start:] + "'")
+	}
+	options := make(sql.TableOptions)
+	for _, option := range parsedOptions {
+		if !alterableTableOptions[option.name] {
+			return true, nil, query, "", sql.ErrUnsupportedFeature.New("ALTER TABLE with table options and " + option.name)
+		}
+		options[option.name] = option.value
+	}
+
+	parsed, remainder := t.split()
+	return true, plan.NewAlterTableOptions(sql.UnresolvedDatabase(db), plan.NewUnresolvedTable(name, db), options), parsed, remainder, nil
+}
+
+// tableOptionName returns the name of the table option starting at the first of the tokens given.
+func tableOptionName(tokens []tableOptionToken) string {
+	name := strings.ToUpper(tokens[0].val)
+	if len(tokens) > 1 {
+		switch next := strings.ToUpper(tokens[1].val); name {
+		case "DATA", "INDEX":
+			if next == "DIRECTORY" {
+				return name + " " + next
+			}
+		}
+	}
+	return name
+}
+
+// readTableOptions reads the table options from the tokens given, which are the tokens of the query given. Options
+// that are set to NULL have an empty value, and keyword values are upper cased. Returns false if the tokens aren't a
+// list of table options.
+func readTableOptions(query string, tokens []tableOptionToken) ([]tableOption, bool) {
+	var options []tableOption
+	i := 0
+	next := func() bool {
+		i++
+		return i < len(tokens)
+	}
+	for ; i < len(tokens); i++ {
+		if tokens[i].typ == ',' {
+			continue
+		}
+
+		first := i
+		name := strings.ToUpper(tokens[i].val)
+		switch name {
+		case "DEFAULT":
+			if !next() {
+				return nil, false
+			}
+			name = strings.ToUpper(tokens[i].val)
+			if name == "CHARACTER" {
+				if !next() || !strings.EqualFold(tokens[i].val, "SET") {
+					return nil, false
+				}
+				name = "CHARSET"
+			}
+		case "CHARACTER":
+			if !next() || !strings.EqualFold(tokens[i].val, "SET") {
+				return nil, false
+			}
+			name = "CHARSET"
+		case "DATA", "INDEX":
+			if !next() || !strings.EqualFold(tokens[i].val, "DIRECTORY") {
+				return nil, false
+			}
+			name += " DIRECTORY"
+		case "START":
+			// START TRANSACTION has no value
+			if !next() || !strings.EqualFold(tokens[i].val, "TRANSACTION") {
+				return nil, false
+			}
+			options = append(options, tableOption{name: "START TRANSACTION", first: first, last: i})
+			continue
+		}
+
+		if !next() {
+			return nil, false
+		}
+		if tokens[i].typ == '=' {
+			if !next() {
+				return nil, false
+			}
+		}
+		value := tokens[i].val
+		switch tokens[i].typ {
+		case sqlparser.STRING, sqlparser.ID, sqlparser.INTEGRAL:
+		case sqlparser.NULL:
+			value = ""
+		case '(':
+			// a list of tables, as given to the UNION option
+			valueStart := tokens[i].start
+			for depth := 0; ; {
+				if tokens[i].typ == '(' {
+					depth++
+				} else if tokens[i].typ == ')' {
+					depth--
+				}
+				if depth == 0 {
+					break
+				}
+				if !next() {
+					return nil, false
+				}
+			}
+			value = strings.TrimSpace(query[valueStart:tokens[i].end])
+		default:
+			// keywords, like DEFAULT or DYNAMIC
+			value = strings.ToUpper(value)
+		}
+		if name == "TABLESPACE" && i+2 < len(tokens) && strings.EqualFold(tokens[i+1].val, "STORAGE") {
+			i += 2
+		}
+		if name == sql.TableOptionEngine {
+			for _, engine := range sql.SupportedEngines {
+				if strings.EqualFold(value, engine.Name) {
+					value = engine.Name
+				}
+			}
+		}
+		options = append(options, tableOption{name: name, value: value, first: first, last: i})
+	}
+	return options, true
+}
+
+// scanCreateTableOptions returns the tokens of the table options of the first statement of the query given if it is
+// a CREATE TABLE statement, or nil otherwise.
+func scanCreateTableOptions(query string) []tableOptionToken {
+	tkn := sqlparser.NewStringTokenizer(query)
+	prevEnd := 0
+	scan := func() tableOptionToken {
+		typ, val := tkn.Scan()
+		for typ == sqlparser.COMMENT {
+			typ, val = tkn.Scan()
+		}
+		end := tkn.Position - 1
+		if end > len(query) {
+			end = len(query)
+		}
+		token := tableOptionToken{typ: typ, val: string(val), start: prevEnd, end: end}
+		prevEnd = end
+		return token
+	}
+	done := func(t tableOptionToken) bool {
+		return t.typ == 0 || t.typ == sqlparser.LEX_ERROR || t.typ == ';'
+	}
+
+	if scan().typ != sqlparser.CREATE {
+		return nil
+	}
+	t := scan()
+	if t.typ == sqlparser.TEMPORARY {
+		t = scan()
+	}
+	if t.typ != sqlparser.TABLE {
+		return nil
+	}
+	if t = scan(); t.typ == sqlparser.IF {
+		if scan().typ != sqlparser.NOT || scan().typ != sqlparser.EXISTS {
+			return nil
+		}
+		t = scan()
+	}
+
+	// the name of the table, optionally qualified
+	if done(t) {
+		return nil
+	}
+	if t = scan(); t.typ == '.' {
+		if t = scan(); done(t) {
+			return nil
+		}
+		t = scan()
+	}
+
+	// the column list
+	if t.typ == '(' {
+		for depth := 1; depth > 0; {
+			switch t = scan(); {
+			case done(t):
+				return nil
+			case t.typ == '(':
+				depth++
+			case t.typ == ')':
+				depth--
+			}
+		}
+		t = scan()
+	}
+
+	var tokens []tableOptionToken
+	for ; !done(t); t = scan() {
+		switch t.typ {
+		case sqlparser.PARTITION, sqlparser.AS, sqlparser.SELECT, sqlparser.IGNORE, sqlparser.REPLACE, sqlparser.LIKE,
+			sqlparser.WITH:
+			return tokens
+		case '(':
+			// a parenthesized SELECT statement, unless the option takes a list
+			if len(tokens) == 0 || !strings.EqualFold(tokens[len(tokens)-1].val, "UNION") &&
+				tokens[len(tokens)-1].typ != '=' {
+				return tokens
+			}
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// sampleToken is a token of a query, with the span of the query it was read from. The span begins with the blanks
+// before the token.
+type sampleToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+// RewriteTableSamples rewrites the TABLESAMPLE clauses of the table references in the query given to calls of the
+// tablesample table function, since the parser doesn't accept them. A table reference like
+//
+//	FROM db.t AS x TABLESAMPLE BERNOULLI(10) REPEATABLE(42)
+//
+// becomes
+//
+//	FROM tablesample('db.t', 'BERNOULLI', 10, 42) AS `x`
+//
+// where the alias defaults to the name of the table. Queries without such clauses are returned unchanged.
+func RewriteTableSamples(query string) string {
+	if !strings.Contains(strings.ToLower(query), "tablesample") {
+		return query
+	}
+
+	var tokens []sampleToken
+	tkn := sqlparser.NewStringTokenizer(query)
+	for {
+		start := tkn.Position - 1
+		if start < 0 {
+			start = 0
+		}
+		typ, val := tkn.Scan()
+		if typ == 0 {
+			break
+		}
+		if typ == sqlparser.LEX_ERROR {
+			return query
+		}
+		tokens = append(tokens, sampleToken{typ: typ, val: string(val), start: start, end: tkn.Position - 1})
+	}
+
+	var sb strings.Builder
+	pos := 0
+	for i, tok := range tokens {
+		if tok.typ != sqlparser.ID || !strings.EqualFold(tok.val, "tablesample") {
+			continue
+		}
+		first, name, alias, ok := scanSampledTable(tokens, i-1)
+		if !ok {
+			continue
+		}
+		method, percent, seed, last, ok := scanSampleClause(query, tokens, i+1)
+		if !ok {
+			continue
+		}
+		if alias == "" {
+			alias = name[strings.LastIndex(name, ".")+1:]
+		}
+
+		sb.WriteString(query[pos:tokens[first].start])
+		sb.WriteString(" tablesample('")
+		sb.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(name))
+		sb.WriteString("', '")
+		sb.WriteString(method)
+		sb.WriteString("', ")
+		sb.WriteString(percent)
+		if seed != "" {
+			sb.WriteString(", ")
+			sb.WriteString(seed)
+		}
+		sb.WriteString(") AS `")
+		sb.WriteString(strings.ReplaceAll(alias, "`", "``"))
+		sb.WriteString("`")
+		pos = tokens[last].end
+	}
+	if pos == 0 {
+		return query
+	}
+	sb.WriteString(query[pos:])
+	return sb.String()
+}
+
+// scanSampledTable reads backwards from |tokens[i]| the table reference a TABLESAMPLE clause follows, made of an
+// optionally qualified table name and an optional alias. Returns the index of the first token of the reference, the
+// qualified name of the table, its alias, and whether the tokens are a table reference.
+func scanSampledTable(tokens []sampleToken, i int) (first int, name, alias string, ok bool) {
+	isID := func(i int) bool {
+		return i >= 0 && tokens[i].typ == sqlparser.ID
+	}
+	if isID(i) && i > 0 && tokens[i-1].typ == sqlparser.AS && isID(i-2) {
+		alias, i = tokens[i].val, i-2
+	} else if isID(i) && isID(i-1) {
+		alias, i = tokens[i].val, i-1
+	}
+	if !isID(i) {
+		return 0, "", "", false
+	}
+	name = tokens[i].val
+	if i >= 2 && tokens[i-1].typ == '.' && isID(i-2) {
+		name, i = tokens[i-2].val+"."+name, i-2
+	}
+	if i == 0 {
+		return 0, "", "", false
+	}
+	switch tokens[i-1].typ {
+	case sqlparser.FROM, sqlparser.JOIN, sqlparser.STRAIGHT_JOIN, ',':
+		return i, name, alias, true
+	default:
+		return 0, "", "", false
+	}
+}
+
+// scanSampleClause reads the method, the percentage and the optional REPEATABLE seed of a TABLESAMPLE clause, starting
+// at |tokens[i]|. The percentage and seed are returned as they're written in |query|. Returns the index of the last
+// token of the clause, and whether the tokens are a TABLESAMPLE clause.
+func scanSampleClause(query string, tokens []sampleToken, i int) (method, percent, seed string, last int, ok bool) {
+	// methods like SYSTEM are keywords rather than identifiers
+	if i >= len(tokens) || !isWord(tokens[i].val) {
+		return "", "", "", 0, false
+	}
+	method = strings.ToUpper(tokens[i].val)
+	percent, last, ok = scanParenthesized(query, tokens, i+1)
+	if !ok {
+		return "", "", "", 0, false
+	}
+	if last+1 < len(tokens) && tokens[last+1].typ == sqlparser.REPEATABLE {
+		var seedLast int
+		if seed, seedLast, ok = scanParenthesized(query, tokens, last+2); !ok {
+			return "", "", "", 0, false
+		}
+		last = seedLast
+	}
+	return method, percent, seed, last, true
+}
+
+// scanParenthesized reads the expression between the parentheses that begin at |tokens[i]|. Returns the expression as
+// it's written in |query|, the index of the closing parenthesis, and whether the parentheses are balanced.
+func scanParenthesized(query string, tokens []sampleToken, i int) (string, int, bool) {
+	if i >= len(tokens) || tokens[i].typ != '(' {
+		return "", 0, false
+	}
+	depth := 0
+	for j := i; j < len(tokens); j++ {
+		switch tokens[j].typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 {
+			expr := strings.TrimSpace(query[tokens[i].end:tokens[j].start])
+			return expr, j, expr != ""
+		}
+	}
+	return "", 0, false
+}
+
+// isWord returns whether |s| is made only of letters and underscores.
+func isWord(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_') {
+			return false
+		}
+	}
+	return s != ""
+}
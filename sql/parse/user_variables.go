@@ -0,0 +1,212 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// UserVarAssignmentFunc is the name of the function that the @var := expr assignments are rewritten to. It's only
+// valid as a quoted identifier, so that it can't clash with a function of the same name.
+const UserVarAssignmentFunc = ":="
+
+// userVarAssignmentEndKeywords are the keywords ending the expression assigned to a user variable, when they aren't nested in
+// parentheses or a CASE expression.
+var userVarAssignmentEndKeywords = map[string]bool{
+	"as":     true,
+	"from":   true,
+	"where":  true,
+	"group":  true,
+	"having": true,
+	"order":  true,
+	"limit":  true,
+	"into":   true,
+	"union":  true,
+	"for":    true,
+	"window": true,
+	"then":   true,
+	"when":   true,
+	"else":   true,
+	"end":    true,
+	"on":     true,
+	"using":  true,
+}
+
+// userVarToken is a token of a query, which spans query[start:end].
+type userVarToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+// userVarEdit replaces query[start:end] with text.
+type userVarEdit struct {
+	start, end int
+	text       string
+}
+
+// RewriteUserVarAssignments rewrites the := assignments of the query given, which the parser doesn't support. The
+// assignments of SET statements are rewritten to =, and the assignments of user variables in expressions, written
+// @var := expr, to calls of the function `:=`(@var, expr). Like MySQL, := has the lowest precedence of the operators,
+// so that the expression assigned extends to the end of the select expression, argument or condition holding it.
+// Queries without such assignments are returned unchanged.
+func RewriteUserVarAssignments(query string) string {
+	if !strings.Contains(query, ":=") {
+		return query
+	}
+
+	var tokens []userVarToken
+	tkn := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tkn.Scan()
+		if typ == 0 {
+			break
+		}
+		if typ == sqlparser.LEX_ERROR {
+			return query
+		}
+		end := tkn.Position - 1
+		start := end - len(val)
+		if len(val) == 0 {
+			start = end - 1
+		}
+		tokens = append(tokens, userVarToken{typ: typ, val: string(val), start: start, end: end})
+	}
+
+	var edits []userVarEdit
+	depth := 0
+	// setDepth is the depth of the current SET clause, or -1 outside of one
+	setDepth := -1
+	for i, t := range tokens {
+		switch t.typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if setDepth > depth {
+				setDepth = -1
+			}
+		case ';':
+			setDepth = -1
+		case sqlparser.SET:
+			setDepth = depth
+		}
+		if t.typ != ':' || i == 0 || i+1 >= len(tokens) || tokens[i+1].typ != '=' || tokens[i+1].start != t.end {
+			continue
+		}
+
+		left := tokens[i-1]
+		if left.typ != sqlparser.ID {
+			continue
+		}
+		if setDepth == depth && i > 1 && (tokens[i-2].typ == sqlparser.SET || tokens[i-2].typ == ',') {
+			edits = append(edits, userVarEdit{start: t.start, end: tokens[i+1].end, text: "="})
+			continue
+		}
+		if query[left.start] != '@' || !strings.HasPrefix(left.val, "@") || strings.HasPrefix(left.val, "@@") {
+			continue
+		}
+		edits = append(edits,
+			userVarEdit{start: left.start, end: tokens[i+1].end, text: "`" + UserVarAssignmentFunc + "`(" + query[left.start:left.end] + ","},
+			userVarEdit{start: userVarAssignmentEnd(tokens, i+2), text: ")"},
+		)
+	}
+	if len(edits) == 0 {
+		return query
+	}
+
+	// an assignment nested in another one ends where it does, so the edits are applied in order, the insertions first
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].start != edits[j].start {
+			return edits[i].start < edits[j].start
+		}
+		return edits[i].end == 0 && edits[j].end != 0
+	})
+	var sb strings.Builder
+	pos := 0
+	for _, e := range edits {
+		sb.WriteString(query[pos:e.start])
+		sb.WriteString(e.text)
+		if e.end > e.start {
+			pos = e.end
+		} else {
+			pos = e.start
+		}
+	}
+	sb.WriteString(query[pos:])
+	return sb.String()
+}
+
+// userVarAssignmentEnd returns the position in the query of the end of the expression assigned to a user variable,
+// whose first token is tokens[i].
+func userVarAssignmentEnd(tokens []userVarToken, i int) int {
+	depth := 0
+	for j := i; j < len(tokens); j++ {
+		t := tokens[j]
+		if depth == 0 && j > i {
+			switch {
+			case t.typ == ',' || t.typ == ';' || t.typ == ')':
+				return tokens[j-1].end
+			case t.typ != sqlparser.ID && userVarAssignmentEndKeywords[strings.ToLower(t.val)]:
+				return tokens[j-1].end
+			case t.typ == sqlparser.ID && endsOperand(tokens[j-1]):
+				// an alias without AS
+				return tokens[j-1].end
+			}
+		}
+		switch {
+		case t.typ == '(' || t.typ == sqlparser.CASE:
+			depth++
+		case t.typ == ')' || t.typ == sqlparser.END:
+			depth--
+		}
+	}
+	return tokens[len(tokens)-1].end
+}
+
+// endsOperand returns whether the token given can end an operand of an expression.
+func endsOperand(t userVarToken) bool {
+	switch t.typ {
+	case sqlparser.ID, sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.DECIMAL, sqlparser.HEX,
+		sqlparser.HEXNUM, sqlparser.BIT_LITERAL, sqlparser.VALUE_ARG, sqlparser.NULL, sqlparser.TRUE, sqlparser.FALSE,
+		sqlparser.END, ')':
+		return true
+	default:
+		return false
+	}
+}
+
+// userVarAssignmentToExpression returns the expression of a call of the function that @var := expr assignments are
+// rewritten to by RewriteUserVarAssignments.
+func userVarAssignmentToExpression(ctx *sql.Context, f *sqlparser.FuncExpr) (sql.Expression, error) {
+	if len(f.Exprs) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(UserVarAssignmentFunc, 2, len(f.Exprs))
+	}
+	exprs, err := selectExprsToExpressions(ctx, f.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimPrefix(exprs[0].String(), "@")
+	if _, ok := exprs[0].(*expression.UnresolvedColumn); !ok || name == exprs[0].String() {
+		return nil, sql.ErrSyntaxError.New("the left side of := must be a user variable")
+	}
+	return expression.NewUserVarAssignment(name, exprs[1]), nil
+}
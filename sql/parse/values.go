@@ -0,0 +1,131 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// valuesStatementAlias is the alias of the derived table a VALUES statement is rewritten to. The same alias is used for
+// every VALUES statement of a query, so that the columns of UNION branches have the same names and tables.
+const valuesStatementAlias = "values"
+
+// RewriteValuesStatements rewrites the VALUES statements in the query given that begin a query expression, either as
+// a top-level statement or as a UNION branch, to selections from a derived table, since the parser only accepts them
+// as derived tables. A statement like
+//
+//	VALUES ROW(1, 2), ROW(3, 4) ORDER BY column_1 DESC
+//
+// becomes
+//
+//	SELECT * FROM (VALUES ROW(1, 2), ROW(3, 4)) AS `values` ORDER BY column_1 DESC
+//
+// Any ORDER BY or LIMIT clause following the rows applies to the selection as it would to the VALUES statement.
+// Queries without such statements are returned unchanged.
+func RewriteValuesStatements(query string) string {
+	type span struct {
+		start, end int
+	}
+	var spans []span
+
+	tkn := sqlparser.NewStringTokenizer(query)
+	// startsQuery is whether the next token begins a query expression
+	startsQuery := true
+	afterUnion := false
+	typ, val := tkn.Scan()
+	for typ != 0 {
+		switch {
+		case typ == sqlparser.VALUES && startsQuery:
+			start := tkn.Position - 1 - len(val)
+			typ, val = tkn.Scan()
+			end, ok := scanValuesRows(tkn, &typ, &val)
+			if ok {
+				spans = append(spans, span{start: start, end: end})
+			}
+			startsQuery, afterUnion = false, false
+			continue
+		case typ == sqlparser.UNION:
+			startsQuery, afterUnion = true, true
+		case (typ == sqlparser.ALL || typ == sqlparser.DISTINCT) && afterUnion:
+			afterUnion = false
+		case typ == '(' && startsQuery:
+			afterUnion = false
+		case typ == ';':
+			startsQuery, afterUnion = true, false
+		case typ == sqlparser.LEX_ERROR:
+			return query
+		default:
+			startsQuery, afterUnion = false, false
+		}
+		typ, val = tkn.Scan()
+	}
+
+	if len(spans) == 0 {
+		return query
+	}
+
+	var sb strings.Builder
+	pos := 0
+	for _, s := range spans {
+		sb.WriteString(query[pos:s.start])
+		sb.WriteString("SELECT * FROM (")
+		sb.WriteString(query[s.start:s.end])
+		sb.WriteString(") AS `")
+		sb.WriteString(valuesStatementAlias)
+		sb.WriteString("`")
+		pos = s.end
+	}
+	sb.WriteString(query[pos:])
+	return sb.String()
+}
+
+// scanValuesRows reads the ROW constructors of a VALUES statement, starting at the current token given by |typ| and
+// |val|. Returns the position in the query just past the last row, and whether the rows were well-formed. Leaves the
+// tokenizer and the current token after the last row.
+func scanValuesRows(tkn *sqlparser.Tokenizer, typ *int, val *[]byte) (int, bool) {
+	end := 0
+	for {
+		if *typ != sqlparser.ROW {
+			return 0, false
+		}
+		*typ, *val = tkn.Scan()
+		if *typ != '(' {
+			return 0, false
+		}
+		depth := 0
+		for {
+			switch *typ {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			case 0, sqlparser.LEX_ERROR:
+				return 0, false
+			}
+			if depth == 0 {
+				break
+			}
+			*typ, *val = tkn.Scan()
+		}
+		end = tkn.Position - 1
+		*typ, *val = tkn.Scan()
+		if *typ != ',' {
+			return end, true
+		}
+		*typ, *val = tkn.Scan()
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// viewOptions are the parts of a CREATE VIEW or ALTER VIEW statement that the parser doesn't accept.
+type viewOptions struct {
+	// isAlter is whether the statement is an ALTER VIEW statement
+	isAlter bool
+	// columns are the names of the columns in the column list of the view, and columnList is the list as it's written
+	columns    []string
+	columnList string
+	// columnListPos is the position in the rewritten statement the column list was removed from
+	columnListPos int
+}
+
+// extractViewOptions rewrites the CREATE VIEW or ALTER VIEW statement given to a CREATE VIEW statement the parser
+// accepts, by removing the column list of the view and replacing the ALTER keyword with CREATE. Returns the rewritten
+// statement, and the parts removed from it. Other statements are returned unchanged, with nil options.
+func extractViewOptions(query string) (string, *viewOptions) {
+	tkn := sqlparser.NewStringTokenizer(query)
+	scan := func() (int, string, int) {
+		typ, val := tkn.Scan()
+		return typ, string(val), tkn.Position - 1
+	}
+
+	typ, _, end := scan()
+	opts := &viewOptions{}
+	switch typ {
+	case sqlparser.ALTER:
+		opts.isAlter = true
+	case sqlparser.CREATE:
+	default:
+		return query, nil
+	}
+	keywordEnd := end
+
+	// the algorithm, definer and security options come before the VIEW keyword
+	for typ, _, end = scan(); typ != sqlparser.VIEW; typ, _, end = scan() {
+		switch typ {
+		case 0, sqlparser.LEX_ERROR, '(', ';', sqlparser.AS, sqlparser.TABLE, sqlparser.INDEX, sqlparser.EVENT,
+			sqlparser.TRIGGER, sqlparser.PROCEDURE, sqlparser.FUNCTION, sqlparser.DATABASE, sqlparser.SCHEMA:
+			return query, nil
+		}
+	}
+
+	// the name of the view, optionally qualified
+	if typ, _, end = scan(); typ == 0 || typ == sqlparser.LEX_ERROR {
+		return query, nil
+	}
+	nameEnd := end
+	typ, _, end = scan()
+	if typ == '.' {
+		if typ, _, end = scan(); typ == 0 || typ == sqlparser.LEX_ERROR {
+			return query, nil
+		}
+		nameEnd = end
+		typ, _, end = scan()
+	}
+
+	columnListEnd := nameEnd
+	if typ == '(' {
+		for {
+			colTyp, col, _ := scan()
+			if colTyp == 0 || colTyp == sqlparser.LEX_ERROR || colTyp == '(' || colTyp == ')' || colTyp == ',' {
+				return query, nil
+			}
+			opts.columns = append(opts.columns, col)
+			if typ, _, end = scan(); typ == ')' {
+				break
+			} else if typ != ',' {
+				return query, nil
+			}
+		}
+		columnListEnd = end
+		typ, _, _ = scan()
+	}
+	if typ != sqlparser.AS || (!opts.isAlter && len(opts.columns) == 0) {
+		return query, nil
+	}
+	opts.columnList = strings.TrimSpace(query[nameEnd:columnListEnd])
+
+	var sb strings.Builder
+	if opts.isAlter {
+		sb.WriteString("CREATE")
+	} else {
+		sb.WriteString(query[:keywordEnd])
+	}
+	sb.WriteString(query[keywordEnd:nameEnd])
+	opts.columnListPos = sb.Len()
+	sb.WriteString(query[columnListEnd:])
+	return sb.String(), opts
+}
+
+// newCreateViewWithOptions applies the parts of a CREATE VIEW or ALTER VIEW statement removed by extractViewOptions to
+// the *plan.CreateView given, converted from the rewritten statement.
+func newCreateViewWithOptions(node sql.Node, opts *viewOptions) (sql.Node, error) {
+	cv, ok := node.(*plan.CreateView)
+	if !ok {
+		return node, nil
+	}
+	if len(opts.columns) > 0 {
+		seen := make(map[string]bool)
+		for _, col := range opts.columns {
+			if seen[strings.ToLower(col)] {
+				return nil, sql.ErrDuplicateViewColumn.New(col)
+			}
+			seen[strings.ToLower(col)] = true
+		}
+		cv.Columns = opts.columns
+		definition := *cv.Definition
+		definition.Columns = opts.columns
+		cv.Definition = &definition
+		cv.Child = &definition
+		cv.CreateViewString = cv.CreateViewString[:opts.columnListPos] + " " + opts.columnList + cv.CreateViewString[opts.columnListPos:]
+	}
+	if opts.isAlter {
+		if cv.IsReplace {
+			return nil, sql.ErrSyntaxError.New("ALTER VIEW doesn't accept OR REPLACE")
+		}
+		cv.IsReplace, cv.IsAlter = true, true
+	}
+	return cv, nil
+}
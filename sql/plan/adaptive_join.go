@@ -0,0 +1,146 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AdaptiveJoin is a join that chooses how to join its children while it runs, to mitigate bad estimates of the
+// cardinality of its left side. The first Threshold rows of the left side are read before choosing: the rows are
+// joined with the lookup join if there are no more, and with the hash join otherwise. Both joins have the same
+// children and filter. The choice made is shown by EXPLAIN ANALYZE.
+type AdaptiveJoin struct {
+	Lookup    *JoinNode
+	Hash      *JoinNode
+	Threshold uint64
+	// choice is shared by the copies of this node, so that the choice made when it runs can be described
+	choice *adaptiveJoinChoice
+}
+
+// adaptiveJoinChoice is the join chosen by an AdaptiveJoin when it runs.
+type adaptiveJoinChoice struct {
+	mu   sync.Mutex
+	join *JoinNode
+	rows uint64
+}
+
+var _ sql.Node = (*AdaptiveJoin)(nil)
+var _ sql.CollationCoercible = (*AdaptiveJoin)(nil)
+
+// NewAdaptiveJoin returns a new AdaptiveJoin that chooses between the |lookup| and |hash| joins given, which join the
+// same children, after reading up to |threshold| rows of their left side.
+func NewAdaptiveJoin(lookup, hash *JoinNode, threshold uint64) *AdaptiveJoin {
+	return &AdaptiveJoin{
+		Lookup:    lookup,
+		Hash:      hash,
+		Threshold: threshold,
+		choice:    &adaptiveJoinChoice{},
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (j *AdaptiveJoin) Resolved() bool {
+	return j.Lookup.Resolved() && j.Hash.Resolved()
+}
+
+// Schema implements the sql.Node interface.
+func (j *AdaptiveJoin) Schema() sql.Schema {
+	return j.Lookup.Schema()
+}
+
+// Children implements the sql.Node interface.
+func (j *AdaptiveJoin) Children() []sql.Node {
+	return []sql.Node{j.Lookup, j.Hash}
+}
+
+// WithChildren implements the sql.Node interface.
+func (j *AdaptiveJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+	lookup, ok := children[0].(*JoinNode)
+	if !ok {
+		return nil, sql.ErrInvalidChildType.New(j, children[0], (*JoinNode)(nil))
+	}
+	hash, ok := children[1].(*JoinNode)
+	if !ok {
+		return nil, sql.ErrInvalidChildType.New(j, children[1], (*JoinNode)(nil))
+	}
+	nj := *j
+	nj.Lookup = lookup
+	nj.Hash = hash
+	return &nj, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (j *AdaptiveJoin) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return j.Lookup.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (j *AdaptiveJoin) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return j.Lookup.CollationCoercibility(ctx)
+}
+
+// Choose records that the |join| given, one of the joins of this node, was chosen after reading |rows| rows of their
+// left side, or the threshold plus one when there were more.
+func (j *AdaptiveJoin) Choose(join *JoinNode, rows uint64) {
+	j.choice.mu.Lock()
+	defer j.choice.mu.Unlock()
+	j.choice.join = join
+	j.choice.rows = rows
+}
+
+// describeChoice returns the description of the join chosen when this node last ran, or an empty string if it hasn't.
+func (j *AdaptiveJoin) describeChoice() string {
+	j.choice.mu.Lock()
+	defer j.choice.mu.Unlock()
+	switch j.choice.join {
+	case nil:
+		return ""
+	case j.Lookup:
+		return fmt.Sprintf("chosen: %s (left rows: %d)", j.Lookup.Op, j.choice.rows)
+	default:
+		return fmt.Sprintf("chosen: %s (left rows: > %d)", j.Hash.Op, j.Threshold)
+	}
+}
+
+func (j *AdaptiveJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AdaptiveJoin(threshold: %d)", j.Threshold)
+	var children []string
+	if choice := j.describeChoice(); choice != "" {
+		children = append(children, choice)
+	}
+	children = append(children, j.Lookup.String(), j.Hash.String())
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+func (j *AdaptiveJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AdaptiveJoin(threshold: %d)", j.Threshold)
+	var children []string
+	if choice := j.describeChoice(); choice != "" {
+		children = append(children, choice)
+	}
+	children = append(children, sql.DebugString(j.Lookup), sql.DebugString(j.Hash))
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
@@ -212,7 +212,7 @@ func (a *AlterEvent) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedO
 		hasPriv = hasPriv && opChecker.UserHasPrivileges(ctx,
 			sql.NewPrivilegedOperation(a.RenameToDb, "", "", sql.PrivilegeType_Event))
 	}
-	return hasPriv
+	return hasPriv && checkDefinerPrivileges(ctx, opChecker, a.Definer)
 }
 
 // Database implements the sql.Databaser interface.
@@ -107,7 +107,8 @@ func (p *CreateForeignKey) String() string {
 }
 
 // ResolveForeignKey verifies the foreign key definition and resolves the foreign key, creating indexes and validating
-// data as necessary.
+// data as necessary. The data of a foreign key that was added while foreign_key_checks was disabled isn't validated when
+// it's resolved later on, since the rows were written without the checks, as in MySQL.
 func ResolveForeignKey(ctx *sql.Context, tbl sql.ForeignKeyTable, refTbl sql.ForeignKeyTable, fkDef sql.ForeignKeyConstraint, shouldAdd, fkChecks bool) error {
 	if t, ok := tbl.(sql.TemporaryTable); ok && t.IsTemporary() {
 		return sql.ErrTemporaryTablesForeignKeySupport.New()
@@ -214,8 +215,10 @@ func ResolveForeignKey(ctx *sql.Context, tbl sql.ForeignKeyTable, refTbl sql.For
 			},
 		}
 
-		if err := reference.CheckTable(ctx, tbl); err != nil {
-			return err
+		if shouldAdd {
+			if err := reference.CheckTable(ctx, tbl); err != nil {
+				return err
+			}
 		}
 	}
 
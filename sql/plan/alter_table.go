@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -214,6 +215,9 @@ type AddColumn struct {
 	column    *sql.Column
 	order     *sql.ColumnOrder
 	targetSch sql.Schema
+	// Algorithm is the algorithm negotiated for this clause by the ALTER TABLE statement it's part of, which makes the
+	// engine copy the table when it's COPY.
+	Algorithm sql.AlterAlgorithm
 }
 
 var _ sql.Node = (*AddColumn)(nil)
@@ -438,6 +442,9 @@ type DropColumn struct {
 	Column       string
 	Checks       sql.CheckConstraints
 	targetSchema sql.Schema
+	// Algorithm is the algorithm negotiated for this clause by the ALTER TABLE statement it's part of, which makes the
+	// engine copy the table when it's COPY.
+	Algorithm sql.AlterAlgorithm
 }
 
 var _ sql.Node = (*DropColumn)(nil)
@@ -589,6 +596,9 @@ type RenameColumn struct {
 	NewColumnName string
 	Checks        sql.CheckConstraints
 	targetSchema  sql.Schema
+	// Algorithm is the algorithm negotiated for this clause by the ALTER TABLE statement it's part of, which makes the
+	// engine copy the table when it's COPY.
+	Algorithm sql.AlterAlgorithm
 }
 
 var _ sql.Node = (*RenameColumn)(nil)
@@ -697,6 +707,9 @@ type ModifyColumn struct {
 	column       *sql.Column
 	order        *sql.ColumnOrder
 	targetSchema sql.Schema
+	// Algorithm is the algorithm negotiated for this clause by the ALTER TABLE statement it's part of, which makes the
+	// engine copy the table when it's COPY.
+	Algorithm sql.AlterAlgorithm
 }
 
 var _ sql.Node = (*ModifyColumn)(nil)
@@ -934,3 +947,215 @@ func (atc *AlterTableCollation) CheckPrivileges(ctx *sql.Context, opChecker sql.
 	return opChecker.UserHasPrivileges(ctx,
 		sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(atc.Db), getTableName(atc.Table), "", sql.PrivilegeType_Alter))
 }
+
+// AlterTableOptions changes the table options of a table, other than its character set, collation and AUTO_INCREMENT
+// value. Options with an empty value are removed from the table.
+type AlterTableOptions struct {
+	ddlNode
+	Table   sql.Node
+	Options sql.TableOptions
+}
+
+var _ sql.Node = (*AlterTableOptions)(nil)
+var _ sql.Databaser = (*AlterTableOptions)(nil)
+
+// NewAlterTableOptions returns a new *AlterTableOptions
+func NewAlterTableOptions(database sql.Database, table *UnresolvedTable, options sql.TableOptions) *AlterTableOptions {
+	return &AlterTableOptions{
+		ddlNode: ddlNode{Db: database},
+		Table:   table,
+		Options: options,
+	}
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (ato *AlterTableOptions) WithDatabase(db sql.Database) (sql.Node, error) {
+	nato := *ato
+	nato.Db = db
+	return &nato, nil
+}
+
+// String implements the interface sql.Node.
+func (ato *AlterTableOptions) String() string {
+	names := make([]string, 0, len(ato.Options))
+	for name := range ato.Options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]string, len(names))
+	for i, name := range names {
+		options[i] = fmt.Sprintf("%s='%s'", name, ato.Options[name])
+	}
+	return fmt.Sprintf("alter table %s %s", ato.Table.String(), strings.Join(options, " "))
+}
+
+// DebugString implements the interface sql.Node.
+func (ato *AlterTableOptions) DebugString() string {
+	return ato.String()
+}
+
+// Resolved implements the interface sql.Node.
+func (ato *AlterTableOptions) Resolved() bool {
+	return ato.Table.Resolved() && ato.ddlNode.Resolved()
+}
+
+// Schema implements the interface sql.Node.
+func (ato *AlterTableOptions) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the interface sql.Node.
+func (ato *AlterTableOptions) Children() []sql.Node {
+	return []sql.Node{ato.Table}
+}
+
+// WithChildren implements the interface sql.Node.
+func (ato *AlterTableOptions) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ato, len(children), 1)
+	}
+	nato := *ato
+	nato.Table = children[0]
+	return &nato, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (ato *AlterTableOptions) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(ato.Db), getTableName(ato.Table), "", sql.PrivilegeType_Alter))
+}
+
+// AlterTable is an ALTER TABLE statement with multiple clauses, or with ALGORITHM or LOCK clauses. Its children are the
+// individual clauses of the statement, which are executed in order once the table has agreed to the requested algorithm
+// and lock. When the table is a sql.AtomicAlterableTable, the clauses are applied as a single schema change that is
+// rolled back if any clause fails.
+type AlterTable struct {
+	Clauses   []sql.Node
+	Algorithm sql.AlterAlgorithm
+	Lock      sql.AlterLock
+}
+
+var _ sql.Node = (*AlterTable)(nil)
+var _ sql.CollationCoercible = (*AlterTable)(nil)
+
+// NewAlterTable returns a new *AlterTable
+func NewAlterTable(clauses []sql.Node, algorithm sql.AlterAlgorithm, lock sql.AlterLock) *AlterTable {
+	return &AlterTable{
+		Clauses:   clauses,
+		Algorithm: algorithm,
+		Lock:      lock,
+	}
+}
+
+// String implements the interface sql.Node.
+func (a *AlterTable) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AlterTable(algorithm=%s, lock=%s)", a.Algorithm, a.Lock)
+	children := make([]string, len(a.Clauses))
+	for i, clause := range a.Clauses {
+		children[i] = clause.String()
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+// DebugString implements the interface sql.DebugStringer.
+func (a *AlterTable) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AlterTable(algorithm=%s, lock=%s)", a.Algorithm, a.Lock)
+	children := make([]string, len(a.Clauses))
+	for i, clause := range a.Clauses {
+		children[i] = sql.DebugString(clause)
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+// Resolved implements the interface sql.Node.
+func (a *AlterTable) Resolved() bool {
+	for _, clause := range a.Clauses {
+		if !clause.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema implements the interface sql.Node.
+func (a *AlterTable) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the interface sql.Node.
+func (a *AlterTable) Children() []sql.Node {
+	return a.Clauses
+}
+
+// WithChildren implements the interface sql.Node.
+func (a *AlterTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(a.Clauses) {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), len(a.Clauses))
+	}
+	na := *a
+	na.Clauses = children
+	return &na, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (a *AlterTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	for _, clause := range a.Clauses {
+		if !clause.CheckPrivileges(ctx, opChecker) {
+			return false
+		}
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*AlterTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// AlterOperationForClause returns the kind of change made by the ALTER TABLE clause given, or false if the node isn't
+// an ALTER TABLE clause that tables can declare an algorithm for.
+func AlterOperationForClause(n sql.Node) (sql.AlterOperation, bool) {
+	switch n := n.(type) {
+	case *AddColumn:
+		return sql.AlterOperation_AddColumn, true
+	case *DropColumn:
+		return sql.AlterOperation_DropColumn, true
+	case *ModifyColumn:
+		return sql.AlterOperation_ModifyColumn, true
+	case *RenameColumn:
+		return sql.AlterOperation_RenameColumn, true
+	case *AlterDefaultSet:
+		return sql.AlterOperation_SetDefault, true
+	case *AlterDefaultDrop:
+		return sql.AlterOperation_DropDefault, true
+	case *AlterIndex:
+		switch n.Action {
+		case IndexAction_Create:
+			return sql.AlterOperation_AddIndex, true
+		case IndexAction_Drop:
+			return sql.AlterOperation_DropIndex, true
+		case IndexAction_Rename:
+			return sql.AlterOperation_RenameIndex, true
+		}
+	case *AlterPK:
+		if n.Action == PrimaryKeyAction_Create {
+			return sql.AlterOperation_AddPrimaryKey, true
+		}
+		return sql.AlterOperation_DropPrimaryKey, true
+	case *CreateCheck:
+		return sql.AlterOperation_AddCheck, true
+	case *DropCheck:
+		return sql.AlterOperation_DropCheck, true
+	case *AlterAutoIncrement:
+		return sql.AlterOperation_AutoIncrement, true
+	case *AlterTableCollation:
+		return sql.AlterOperation_Collation, true
+	case *AlterTableOptions:
+		return sql.AlterOperation_TableOptions, true
+	}
+	return 0, false
+}
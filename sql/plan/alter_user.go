@@ -0,0 +1,214 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// AlterUser represents the statement ALTER USER. Users without an authentication keep their password.
+type AlterUser struct {
+	IfExists bool
+	Users    []AuthenticatedUser
+	Options  AlterUserOptions
+	MySQLDb  sql.Database
+}
+
+// AlterUserOptions are the account options that ALTER USER changes. Nil options are left unchanged.
+type AlterUserOptions struct {
+	// ExpirePassword is PASSWORD EXPIRE, which marks the password as expired.
+	ExpirePassword bool
+	// PasswordLifetime is PASSWORD EXPIRE {DEFAULT | NEVER | INTERVAL N DAY}, where NEVER is 0.
+	PasswordLifetime *AlterUserPasswordOption
+	// PasswordHistory is PASSWORD HISTORY {DEFAULT | N}.
+	PasswordHistory *AlterUserPasswordOption
+	// PasswordReuseInterval is PASSWORD REUSE INTERVAL {DEFAULT | N DAY}.
+	PasswordReuseInterval *AlterUserPasswordOption
+	// PasswordRequireCurrent is PASSWORD REQUIRE CURRENT [DEFAULT | OPTIONAL], where OPTIONAL is 0.
+	PasswordRequireCurrent *AlterUserPasswordOption
+	// FailedLoginAttempts is FAILED_LOGIN_ATTEMPTS N.
+	FailedLoginAttempts *int64
+	// PasswordLockTime is PASSWORD_LOCK_TIME {N | UNBOUNDED}, where UNBOUNDED is -1.
+	PasswordLockTime *int64
+	// Locked is ACCOUNT {LOCK | UNLOCK}.
+	Locked *bool
+}
+
+// AlterUserPasswordOption is the value of a password option that may be set back to its default, in which case the
+// system variable of the option applies.
+type AlterUserPasswordOption struct {
+	Default bool
+	Value   int64
+}
+
+// days returns the value of the option as stored in the user table, or nil for the default.
+func (o *AlterUserPasswordOption) days(option string) (*uint16, error) {
+	if o.Default {
+		return nil, nil
+	}
+	return passwordOptionDays(option, &o.Value)
+}
+
+// changesOnlyPassword returns whether ALTER USER only changes the passwords of the users.
+func (n *AlterUser) changesOnlyPassword() bool {
+	if n.Options != (AlterUserOptions{}) {
+		return false
+	}
+	for _, user := range n.Users {
+		if user.Auth1 == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyTo sets the options to the user given, as stored in the user table.
+func (o *AlterUserOptions) ApplyTo(user *mysql_db.User) error {
+	var err error
+	if o.ExpirePassword {
+		user.PasswordExpired = true
+	}
+	if o.PasswordLifetime != nil {
+		if user.PasswordLifetime, err = o.PasswordLifetime.days("PASSWORD EXPIRE INTERVAL"); err != nil {
+			return err
+		}
+	}
+	if o.PasswordHistory != nil {
+		if user.PasswordReuseHistory, err = o.PasswordHistory.days("PASSWORD HISTORY"); err != nil {
+			return err
+		}
+	}
+	if o.PasswordReuseInterval != nil {
+		if user.PasswordReuseTime, err = o.PasswordReuseInterval.days("PASSWORD REUSE INTERVAL"); err != nil {
+			return err
+		}
+	}
+	if o.PasswordRequireCurrent != nil {
+		if o.PasswordRequireCurrent.Default {
+			user.PasswordRequireCurrent = nil
+		} else {
+			requireCurrent := o.PasswordRequireCurrent.Value != 0
+			user.PasswordRequireCurrent = &requireCurrent
+		}
+	}
+	if o.FailedLoginAttempts != nil {
+		if *o.FailedLoginAttempts < 0 || *o.FailedLoginAttempts > maxPasswordLockingValue {
+			return fmt.Errorf("invalid FAILED_LOGIN_ATTEMPTS value: %d", *o.FailedLoginAttempts)
+		}
+		user.FailedLoginAttempts = *o.FailedLoginAttempts
+	}
+	if o.PasswordLockTime != nil {
+		if *o.PasswordLockTime < -1 || *o.PasswordLockTime > maxPasswordLockingValue {
+			return fmt.Errorf("invalid PASSWORD_LOCK_TIME value: %d", *o.PasswordLockTime)
+		}
+		user.PasswordLockTime = *o.PasswordLockTime
+	}
+	if o.Locked != nil {
+		user.Locked = *o.Locked
+	}
+	return nil
+}
+
+// ResetsLoginFailures returns whether the options forget the consecutive failed logins of the users, which MySQL does
+// when unlocking an account or changing its FAILED_LOGIN_ATTEMPTS or PASSWORD_LOCK_TIME.
+func (o *AlterUserOptions) ResetsLoginFailures() bool {
+	return o.Locked != nil && !*o.Locked || o.FailedLoginAttempts != nil || o.PasswordLockTime != nil
+}
+
+var _ sql.Node = (*AlterUser)(nil)
+var _ sql.Databaser = (*AlterUser)(nil)
+var _ sql.CollationCoercible = (*AlterUser)(nil)
+
+// Schema implements the interface sql.Node.
+func (n *AlterUser) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.Node.
+func (n *AlterUser) String() string {
+	users := make([]string, len(n.Users))
+	for i, user := range n.Users {
+		users[i] = user.UserName.String("")
+	}
+	ifExists := ""
+	if n.IfExists {
+		ifExists = "IfExists: "
+	}
+	return fmt.Sprintf("AlterUser(%s%s)", ifExists, strings.Join(users, ", "))
+}
+
+// Database implements the interface sql.Databaser.
+func (n *AlterUser) Database() sql.Database {
+	return n.MySQLDb
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *AlterUser) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.MySQLDb = db
+	return &nn, nil
+}
+
+// Resolved implements the interface sql.Node.
+func (n *AlterUser) Resolved() bool {
+	_, ok := n.MySQLDb.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// Children implements the interface sql.Node.
+func (n *AlterUser) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *AlterUser) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the interface sql.Node. Users may change their own password without any privilege.
+func (n *AlterUser) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_CreateUser)) {
+		return true
+	}
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok || !n.changesOnlyPassword() {
+		return false
+	}
+	client := ctx.Session.Client()
+	current := mysqlDb.GetUser(client.User, client.Address, false)
+	if current == nil {
+		return false
+	}
+	for _, user := range n.Users {
+		if mysqlDb.GetUser(user.Name, user.Host, false) != current {
+			return false
+		}
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*AlterUser) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// BackupDatabase represents the BACKUP DATABASE statement, an extension of MySQL that saves a logical dump of a
+// database to a file of the server, like the ones written by mysqldump.
+type BackupDatabase struct {
+	Database string
+	// Path is the path of the file, relative to the secure_file_priv directory when it's set. The file must not exist.
+	Path    string
+	Options sql.DumpOptions
+	Dumper  sql.DatabaseDumper
+}
+
+var _ sql.Node = (*BackupDatabase)(nil)
+var _ sql.CollationCoercible = (*BackupDatabase)(nil)
+
+// NewBackupDatabase returns a new BackupDatabase node.
+func NewBackupDatabase(database, path string, options sql.DumpOptions) *BackupDatabase {
+	return &BackupDatabase{Database: database, Path: path, Options: options}
+}
+
+// Schema implements the interface sql.Node.
+func (n *BackupDatabase) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.Node.
+func (n *BackupDatabase) String() string {
+	var options []string
+	if n.Options.NoCreateInfo {
+		options = append(options, "NO_CREATE_INFO")
+	}
+	if n.Options.NoData {
+		options = append(options, "NO_DATA")
+	}
+	if n.Options.AddDropTable {
+		options = append(options, "ADD_DROP_TABLE")
+	}
+	if n.Options.Routines {
+		options = append(options, "ROUTINES")
+	}
+	if n.Options.MySQLDump {
+		options = append(options, "MYSQLDUMP")
+	}
+	s := fmt.Sprintf("BACKUP DATABASE %s TO '%s'", n.Database, n.Path)
+	if len(options) > 0 {
+		s += " WITH " + strings.Join(options, ", ")
+	}
+	return s
+}
+
+// Resolved implements the interface sql.Node.
+func (n *BackupDatabase) Resolved() bool {
+	return true
+}
+
+// Children implements the interface sql.Node.
+func (n *BackupDatabase) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *BackupDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the interface sql.Node. Writing the file requires the FILE privilege, like SELECT ... INTO
+// OUTFILE, and the statements reading the database check their own privileges.
+func (n *BackupDatabase) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_File),
+		sql.NewPrivilegedOperation(n.Database, "", "", sql.PrivilegeType_Select))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*BackupDatabase) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
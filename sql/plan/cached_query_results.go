@@ -0,0 +1,73 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CachedQueryResults is a node that returns the result set of a query stored by the engine's query cache, in place of
+// the plan that would otherwise compute it.
+type CachedQueryResults struct {
+	schema sql.Schema
+	Rows   []sql.Row
+}
+
+var _ sql.Node = (*CachedQueryResults)(nil)
+var _ sql.CollationCoercible = (*CachedQueryResults)(nil)
+
+// NewCachedQueryResults returns a node returning the |rows| given, whose schema is |schema|.
+func NewCachedQueryResults(schema sql.Schema, rows []sql.Row) *CachedQueryResults {
+	return &CachedQueryResults{schema: schema, Rows: rows}
+}
+
+// Schema implements the sql.Node interface.
+func (c *CachedQueryResults) Schema() sql.Schema {
+	return c.schema
+}
+
+// Children implements the sql.Node interface.
+func (*CachedQueryResults) Children() []sql.Node {
+	return nil
+}
+
+// Resolved implements the sql.Node interface.
+func (*CachedQueryResults) Resolved() bool {
+	return true
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CachedQueryResults) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. The privileges for the query were checked when it was analyzed.
+func (*CachedQueryResults) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CachedQueryResults) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (c *CachedQueryResults) String() string {
+	return fmt.Sprintf("CachedQueryResults(%d rows)", len(c.Rows))
+}
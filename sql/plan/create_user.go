@@ -108,6 +108,10 @@ func (n *CreateUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
 	userTableData := mysqlDb.UserTable().Data()
+	tlsOptions := TLSOptions{}
+	if n.TLSOptions != nil {
+		tlsOptions = *n.TLSOptions
+	}
 	for _, user := range n.Users {
 		// replace empty host with any host
 		if user.UserName.Host == "" {
@@ -139,19 +143,29 @@ func (n *CreateUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		}
 		// TODO: attributes should probably not be nil, but setting it to &n.Attribute causes unexpected behavior
 		// TODO:validate all of the data
-		err := userTableData.Put(ctx, &mysql_db.User{
+		userEntry := &mysql_db.User{
 			User:                user.UserName.Name,
 			Host:                user.UserName.Host,
 			PrivilegeSet:        mysql_db.NewPrivilegeSet(),
 			Plugin:              plugin,
 			Password:            password,
 			PasswordLastChanged: time.Now().UTC(),
-			Locked:              false,
+			Locked:              n.Locked,
 			Attributes:          nil,
 			IsRole:              false,
 			Identity:            user.Identity,
-		})
-		if err != nil {
+			SslType:             tlsOptions.SslType(),
+			SslCipher:           tlsOptions.Cipher,
+			X509Issuer:          tlsOptions.Issuer,
+			X509Subject:         tlsOptions.Subject,
+		}
+		if err := n.PasswordOptions.ApplyTo(userEntry); err != nil {
+			return nil, sql.ErrUserCreationFailure.New(err)
+		}
+		if err := userTableData.Put(ctx, userEntry); err != nil {
+			return nil, err
+		}
+		if err := mysqlDb.RecordPasswordHistory(ctx, userEntry); err != nil {
 			return nil, err
 		}
 	}
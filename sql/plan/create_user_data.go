@@ -18,7 +18,10 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 )
 
 // UserName represents either a user or role name.
@@ -59,6 +62,8 @@ type AuthenticatedUser struct {
 	Auth3       Authentication
 	AuthInitial Authentication
 	Identity    string
+	// CurrentPassword is the current password of the user given with REPLACE by ALTER USER, or nil without one.
+	CurrentPassword *string
 }
 
 // TLSOptions represents a user's TLS options.
@@ -70,6 +75,23 @@ type TLSOptions struct {
 	Subject string
 }
 
+// SslType returns the TLS requirement that these options set, as stored in the ssl_type column of the user table.
+// Nil options are the same as REQUIRE NONE.
+func (t *TLSOptions) SslType() string {
+	switch {
+	case t == nil:
+		return mysql_db.SslTypeNone
+	case t.Cipher != "" || t.Issuer != "" || t.Subject != "":
+		return mysql_db.SslTypeSpecified
+	case t.X509:
+		return mysql_db.SslTypeX509
+	case t.SSL:
+		return mysql_db.SslTypeAny
+	default:
+		return mysql_db.SslTypeNone
+	}
+}
+
 // AccountLimits represents the limits imposed upon an account.
 type AccountLimits struct {
 	MaxQueriesPerHour     *int64
@@ -89,6 +111,58 @@ type PasswordOptions struct {
 	LockTime       *int64
 }
 
+// ApplyTo sets the password options of the user given, as stored in the user table. Nil options keep the defaults.
+func (p *PasswordOptions) ApplyTo(user *mysql_db.User) error {
+	if p == nil {
+		return nil
+	}
+	var err error
+	if user.PasswordLifetime, err = passwordOptionDays("PASSWORD EXPIRE INTERVAL", p.ExpirationTime); err != nil {
+		return err
+	}
+	if user.PasswordReuseHistory, err = passwordOptionDays("PASSWORD HISTORY", p.History); err != nil {
+		return err
+	}
+	if user.PasswordReuseTime, err = passwordOptionDays("PASSWORD REUSE INTERVAL", p.ReuseInterval); err != nil {
+		return err
+	}
+	if p.RequireCurrentOptional {
+		requireCurrent := false
+		user.PasswordRequireCurrent = &requireCurrent
+	}
+	if p.FailedAttempts != nil {
+		if *p.FailedAttempts < 0 || *p.FailedAttempts > maxPasswordLockingValue {
+			return fmt.Errorf("invalid FAILED_LOGIN_ATTEMPTS value: %d", *p.FailedAttempts)
+		}
+		user.FailedLoginAttempts = *p.FailedAttempts
+	}
+	// a nil lock time is PASSWORD_LOCK_TIME UNBOUNDED
+	user.PasswordLockTime = -1
+	if p.LockTime != nil {
+		if *p.LockTime < 0 || *p.LockTime > maxPasswordLockingValue {
+			return fmt.Errorf("invalid PASSWORD_LOCK_TIME value: %d", *p.LockTime)
+		}
+		user.PasswordLockTime = *p.LockTime
+	}
+	return nil
+}
+
+// maxPasswordLockingValue is the maximum value of FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME.
+const maxPasswordLockingValue = 32767
+
+// passwordOptionDays returns the value of a password option stored as a SMALLINT UNSIGNED in the user table, or nil
+// for the default.
+func passwordOptionDays(option string, val *int64) (*uint16, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if *val < 0 || *val > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid %s value: %d", option, *val)
+	}
+	days := uint16(*val)
+	return &days, nil
+}
+
 // AuthenticationMysqlNativePassword is an authentication type that represents "mysql_native_password".
 type AuthenticationMysqlNativePassword string
 
@@ -27,13 +27,15 @@ import (
 
 // CreateView is a node representing the creation (or replacement) of a view,
 // which is defined by the Child node. The Columns member represent the
-// explicit columns specified by the query, if any.
+// explicit columns specified by the query, if any. An ALTER VIEW statement is
+// a CreateView that replaces a view that must exist.
 type CreateView struct {
 	UnaryNode
 	database         sql.Database
 	Name             string
 	Columns          []string
 	IsReplace        bool
+	IsAlter          bool
 	Definition       *SubqueryAlias
 	CreateViewString string
 	Algorithm        string
@@ -71,7 +73,26 @@ func NewCreateView(
 
 // View returns the view that will be created by this node.
 func (cv *CreateView) View() *sql.View {
-	return cv.Definition.AsView(cv.CreateViewString)
+	securityType := cv.Security
+	if securityType == "" {
+		securityType = "DEFINER"
+	}
+	definition := cv.Definition.WithViewSecurity(cv.database.Name(), cv.Definer, securityType)
+	definition.Algorithm = strings.ToUpper(cv.Algorithm)
+	return definition.AsView(cv.CreateViewString)
+}
+
+// ViewDefinition returns the definition of the view that will be created by this node.
+func (cv *CreateView) ViewDefinition() sql.ViewDefinition {
+	return sql.ViewDefinition{
+		Name:                cv.Name,
+		TextDefinition:      cv.Definition.TextDefinition,
+		CreateViewStatement: cv.CreateViewString,
+		Columns:             cv.Columns,
+		Algorithm:           cv.Algorithm,
+		Definer:             cv.Definer,
+		SecurityType:        cv.Security,
+	}
 }
 
 // Children implements the Node interface. It returns the Child of the
@@ -94,7 +115,11 @@ func (cv *CreateView) Schema() sql.Schema { return nil }
 // generate the string.
 func (cv *CreateView) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("CreateView(%s)", cv.Name)
+	if cv.IsAlter {
+		_ = pr.WriteNode("AlterView(%s)", cv.Name)
+	} else {
+		_ = pr.WriteNode("CreateView(%s)", cv.Name)
+	}
 	_ = pr.WriteChildren(
 		fmt.Sprintf("Columns (%s)", strings.Join(cv.Columns, ", ")),
 		cv.Child.String(),
@@ -118,6 +143,7 @@ func (cv *CreateView) WithChildren(children ...sql.Node) (sql.Node, error) {
 func (cv *CreateView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
 		sql.NewPrivilegedOperation(cv.database.Name(), "", "", sql.PrivilegeType_CreateView)) &&
+		checkDefinerPrivileges(ctx, opChecker, cv.Definer) &&
 		cv.Child.CheckPrivileges(ctx, opChecker)
 }
 
@@ -29,6 +29,8 @@ type CreateDB struct {
 	DbName      string
 	IfNotExists bool
 	Collation   sql.CollationID
+	// Comment is the comment of the database, or nil if the statement doesn't give one
+	Comment *string
 }
 
 var _ sql.Node = (*CreateDB)(nil)
@@ -139,6 +141,9 @@ type AlterDB struct {
 	Catalog   sql.Catalog
 	dbName    string
 	Collation sql.CollationID
+	// Comment is the new comment of the database, or nil if the statement doesn't change it. The collation of the
+	// database is left unchanged when Collation is unspecified and Comment is set.
+	Comment *string
 }
 
 var _ sql.Node = (*AlterDB)(nil)
@@ -155,6 +160,9 @@ func (c *AlterDB) String() string {
 	if len(c.dbName) > 0 {
 		dbName = fmt.Sprintf(" %s", c.dbName)
 	}
+	if c.Collation == sql.Collation_Unspecified && c.Comment != nil {
+		return fmt.Sprintf("%s database%s comment '%s'", sqlparser.AlterStr, dbName, *c.Comment)
+	}
 	return fmt.Sprintf("%s database%s collate %s", sqlparser.AlterStr, dbName, c.Collation.Name())
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
 type IfNotExistsOption bool
@@ -98,6 +99,8 @@ type TableSpec struct {
 	ChDefs    []*sql.CheckConstraint
 	IdxDefs   []*IndexDefinition
 	Collation sql.CollationID
+	// Options are the table options of the table, other than its character set and collation.
+	Options sql.TableOptions
 }
 
 func (c *TableSpec) WithSchema(schema sql.PrimaryKeySchema) *TableSpec {
@@ -135,6 +138,7 @@ type CreateTable struct {
 	ChDefs       sql.CheckConstraints
 	IdxDefs      []*IndexDefinition
 	Collation    sql.CollationID
+	Options      sql.TableOptions
 	like         sql.Node
 	temporary    TempTableOption
 	selectNode   sql.Node
@@ -160,6 +164,7 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 		ChDefs:       tableSpec.ChDefs,
 		IdxDefs:      tableSpec.IdxDefs,
 		Collation:    tableSpec.Collation,
+		Options:      tableSpec.Options,
 		ifNotExists:  ifn,
 		temporary:    temp,
 	}
@@ -188,6 +193,7 @@ func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tab
 		FkDefs:       tableSpec.FkDefs,
 		ChDefs:       tableSpec.ChDefs,
 		IdxDefs:      tableSpec.IdxDefs,
+		Options:      tableSpec.Options,
 		name:         name,
 		selectNode:   selectNode,
 		ifNotExists:  ifn,
@@ -391,12 +397,27 @@ func (c CreateTable) WithChildren(children ...sql.Node) (sql.Node, error) {
 // CheckPrivileges implements the interface sql.Node.
 func (c *CreateTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	if c.temporary == IsTempTable {
-		return opChecker.UserHasPrivileges(ctx,
-			sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(c.Db), "", "", sql.PrivilegeType_CreateTempTable))
+		if !opChecker.UserHasPrivileges(ctx,
+			sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(c.Db), "", "", sql.PrivilegeType_CreateTempTable)) {
+			return false
+		}
 	} else {
-		return opChecker.UserHasPrivileges(ctx,
-			sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(c.Db), "", "", sql.PrivilegeType_Create))
+		if !opChecker.UserHasPrivileges(ctx,
+			sql.NewPrivilegedOperation(CheckPrivilegeNameForDatabase(c.Db), "", "", sql.PrivilegeType_Create)) {
+			return false
+		}
+	}
+	// the tables referenced by foreign keys need the REFERENCES privilege
+	for _, fkDef := range c.FkDefs {
+		if fkDef.IsSelfReferential() {
+			continue
+		}
+		if !opChecker.UserHasPrivileges(ctx,
+			sql.NewPrivilegedOperation(fkDef.ParentDatabase, fkDef.ParentTable, "", sql.PrivilegeType_References)) {
+			return false
+		}
 	}
+	return true
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -518,6 +539,7 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	ret = ret.WithIndices(c.IdxDefs)
 	ret = ret.WithCheckConstraints(c.ChDefs)
 	ret.Collation = c.Collation
+	ret.Options = c.Options
 
 	return ret
 }
@@ -577,6 +599,53 @@ func (c *CreateTable) ValidateDefaultPosition() error {
 	return nil
 }
 
+// GenerateInvisiblePrimaryKey returns |sch| with a generated invisible primary key added as its first column, the way
+// MySQL does for tables created without a primary key while sql_generate_invisible_primary_key is enabled. The
+// generated column is an auto-increment BIGINT UNSIGNED named my_row_id. An error is returned if the schema already has
+// a column with that name or an auto-increment column.
+func GenerateInvisiblePrimaryKey(tableName string, sch sql.PrimaryKeySchema) (sql.PrimaryKeySchema, error) {
+	gipk := &sql.Column{
+		Name:          sql.GeneratedInvisiblePrimaryKeyName,
+		Type:          types.Uint64,
+		AutoIncrement: true,
+		Nullable:      false,
+		Source:        tableName,
+		PrimaryKey:    true,
+		Extra:         "auto_increment INVISIBLE",
+		Invisible:     true,
+	}
+
+	newSch := make(sql.Schema, 0, len(sch.Schema)+1)
+	newSch = append(newSch, gipk)
+	for _, col := range sch.Schema {
+		if strings.EqualFold(col.Name, sql.GeneratedInvisiblePrimaryKeyName) {
+			return sql.PrimaryKeySchema{}, sql.ErrGeneratedInvisiblePrimaryKeyColumnExists.New(col.Name)
+		}
+		if col.AutoIncrement {
+			return sql.PrimaryKeySchema{}, sql.ErrGeneratedInvisiblePrimaryKeyAutoIncrementExists.New()
+		}
+		gipk.DatabaseSource = col.DatabaseSource
+
+		// Column defaults that reference other columns must be shifted past the generated column
+		newCol := *col
+		if newCol.Default != nil {
+			newDefault, _, err := transform.Expr(newCol.Default, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				if gf, ok := e.(*expression.GetField); ok {
+					return gf.WithIndex(gf.Index() + 1), transform.NewTree, nil
+				}
+				return e, transform.SameTree, nil
+			})
+			if err != nil {
+				return sql.PrimaryKeySchema{}, err
+			}
+			newCol.Default = newDefault.(*sql.ColumnDefaultValue)
+		}
+		newSch = append(newSch, &newCol)
+	}
+
+	return sql.NewPrimaryKeySchema(newSch, 0), nil
+}
+
 // DropTable is a node describing dropping one or more tables
 type DropTable struct {
 	Tables       []sql.Node
@@ -117,7 +117,8 @@ func (c *CreateEvent) WithChildren(children ...sql.Node) (sql.Node, error) {
 // CheckPrivileges implements the interface sql.Node.
 func (c *CreateEvent) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
-		sql.NewPrivilegedOperation(c.Db.Name(), "", "", sql.PrivilegeType_Event))
+		sql.NewPrivilegedOperation(c.Db.Name(), "", "", sql.PrivilegeType_Event)) &&
+		checkDefinerPrivileges(ctx, opChecker, c.Definer)
 }
 
 // Database implements the sql.Databaser interface.
@@ -107,7 +107,8 @@ func (c *CreateProcedure) WithChildren(children ...sql.Node) (sql.Node, error) {
 // CheckPrivileges implements the interface sql.Node.
 func (c *CreateProcedure) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
-		sql.NewPrivilegedOperation(c.Db.Name(), "", "", sql.PrivilegeType_CreateRoutine))
+		sql.NewPrivilegedOperation(c.Db.Name(), "", "", sql.PrivilegeType_CreateRoutine)) &&
+		checkDefinerPrivileges(ctx, opChecker, c.Definer)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -0,0 +1,283 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// SequenceOptions are the options given in a CREATE SEQUENCE or ALTER SEQUENCE statement. Options that weren't given
+// are nil.
+type SequenceOptions struct {
+	Start      *int64
+	Increment  *int64
+	MinValue   *int64
+	MaxValue   *int64
+	NoMinValue bool
+	NoMaxValue bool
+	Cache      *int64
+	Cycle      *bool
+	// Restart is whether the sequence should restart, at RestartWith if given or else at its START value.
+	Restart     bool
+	RestartWith *int64
+}
+
+// Apply returns the sequence given with these options applied. When |create| is true, options that weren't given are
+// set to the defaults for the direction of the sequence.
+func (o SequenceOptions) Apply(seq sql.Sequence, create bool) sql.Sequence {
+	if o.Increment != nil {
+		seq.Increment = *o.Increment
+	}
+	if o.Cache != nil {
+		seq.Cache = *o.Cache
+	}
+	if o.Cycle != nil {
+		seq.Cycle = *o.Cycle
+	}
+
+	if o.MinValue != nil {
+		seq.MinValue = *o.MinValue
+	} else if o.NoMinValue || (create && seq.Increment < 0) {
+		seq.MinValue = 1
+		if seq.Increment < 0 {
+			seq.MinValue = sql.DefaultSequenceMinValue
+		}
+	}
+	if o.MaxValue != nil {
+		seq.MaxValue = *o.MaxValue
+	} else if o.NoMaxValue || (create && seq.Increment < 0) {
+		seq.MaxValue = sql.DefaultSequenceMaxValue
+		if seq.Increment < 0 {
+			seq.MaxValue = -1
+		}
+	}
+
+	if o.Start != nil {
+		seq.Start = *o.Start
+	} else if create {
+		seq.Start = seq.FirstValue()
+	}
+	return seq
+}
+
+// String returns the options given in the form of a CREATE SEQUENCE statement.
+func (o SequenceOptions) String() string {
+	var opts []string
+	if o.Increment != nil {
+		opts = append(opts, fmt.Sprintf("INCREMENT BY %d", *o.Increment))
+	}
+	if o.MinValue != nil {
+		opts = append(opts, fmt.Sprintf("MINVALUE %d", *o.MinValue))
+	} else if o.NoMinValue {
+		opts = append(opts, "NO MINVALUE")
+	}
+	if o.MaxValue != nil {
+		opts = append(opts, fmt.Sprintf("MAXVALUE %d", *o.MaxValue))
+	} else if o.NoMaxValue {
+		opts = append(opts, "NO MAXVALUE")
+	}
+	if o.Start != nil {
+		opts = append(opts, fmt.Sprintf("START WITH %d", *o.Start))
+	}
+	if o.Cache != nil {
+		opts = append(opts, fmt.Sprintf("CACHE %d", *o.Cache))
+	}
+	if o.Cycle != nil {
+		if *o.Cycle {
+			opts = append(opts, "CYCLE")
+		} else {
+			opts = append(opts, "NOCYCLE")
+		}
+	}
+	if o.RestartWith != nil {
+		opts = append(opts, fmt.Sprintf("RESTART WITH %d", *o.RestartWith))
+	} else if o.Restart {
+		opts = append(opts, "RESTART")
+	}
+	return strings.Join(opts, " ")
+}
+
+// CreateSequence is a node that creates a sequence.
+type CreateSequence struct {
+	ddlNode
+	Name        string
+	Options     SequenceOptions
+	IfNotExists bool
+}
+
+var _ sql.Node = (*CreateSequence)(nil)
+var _ sql.Databaser = (*CreateSequence)(nil)
+var _ sql.CollationCoercible = (*CreateSequence)(nil)
+
+// NewCreateSequence returns a new *CreateSequence node.
+func NewCreateSequence(db sql.Database, name string, options SequenceOptions, ifNotExists bool) *CreateSequence {
+	return &CreateSequence{
+		ddlNode:     ddlNode{db},
+		Name:        name,
+		Options:     options,
+		IfNotExists: ifNotExists,
+	}
+}
+
+// String implements the sql.Node interface.
+func (c *CreateSequence) String() string {
+	ifNotExists := ""
+	if c.IfNotExists {
+		ifNotExists = "IF NOT EXISTS "
+	}
+	return strings.TrimSpace(fmt.Sprintf("CREATE SEQUENCE %s%s %s", ifNotExists, c.Name, c.Options))
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateSequence) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (c *CreateSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(c.Db.Name(), "", "", sql.PrivilegeType_Create))
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*CreateSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (c *CreateSequence) WithDatabase(database sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := database.(mysql_db.PrivilegedDatabase); ok {
+		database = privilegedDatabase.Unwrap()
+	}
+	nc := *c
+	nc.Db = database
+	return &nc, nil
+}
+
+// AlterSequence is a node that changes the options of a sequence.
+type AlterSequence struct {
+	ddlNode
+	Name     string
+	Options  SequenceOptions
+	IfExists bool
+}
+
+var _ sql.Node = (*AlterSequence)(nil)
+var _ sql.Databaser = (*AlterSequence)(nil)
+var _ sql.CollationCoercible = (*AlterSequence)(nil)
+
+// NewAlterSequence returns a new *AlterSequence node.
+func NewAlterSequence(db sql.Database, name string, options SequenceOptions, ifExists bool) *AlterSequence {
+	return &AlterSequence{
+		ddlNode:  ddlNode{db},
+		Name:     name,
+		Options:  options,
+		IfExists: ifExists,
+	}
+}
+
+// String implements the sql.Node interface.
+func (a *AlterSequence) String() string {
+	ifExists := ""
+	if a.IfExists {
+		ifExists = "IF EXISTS "
+	}
+	return strings.TrimSpace(fmt.Sprintf("ALTER SEQUENCE %s%s %s", ifExists, a.Name, a.Options))
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AlterSequence) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(a, children...)
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (a *AlterSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(a.Db.Name(), a.Name, "", sql.PrivilegeType_Alter))
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*AlterSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (a *AlterSequence) WithDatabase(database sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := database.(mysql_db.PrivilegedDatabase); ok {
+		database = privilegedDatabase.Unwrap()
+	}
+	na := *a
+	na.Db = database
+	return &na, nil
+}
+
+// DropSequence is a node that drops one or more sequences from a database.
+type DropSequence struct {
+	ddlNode
+	Names    []string
+	IfExists bool
+}
+
+var _ sql.Node = (*DropSequence)(nil)
+var _ sql.Databaser = (*DropSequence)(nil)
+var _ sql.CollationCoercible = (*DropSequence)(nil)
+
+// NewDropSequence returns a new *DropSequence node.
+func NewDropSequence(db sql.Database, names []string, ifExists bool) *DropSequence {
+	return &DropSequence{
+		ddlNode:  ddlNode{db},
+		Names:    names,
+		IfExists: ifExists,
+	}
+}
+
+// String implements the sql.Node interface.
+func (d *DropSequence) String() string {
+	ifExists := ""
+	if d.IfExists {
+		ifExists = "IF EXISTS "
+	}
+	return fmt.Sprintf("DROP SEQUENCE %s%s", ifExists, strings.Join(d.Names, ", "))
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropSequence) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (d *DropSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(d.Db.Name(), "", "", sql.PrivilegeType_Drop))
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*DropSequence) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (d *DropSequence) WithDatabase(database sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := database.(mysql_db.PrivilegedDatabase); ok {
+		database = privilegedDatabase.Unwrap()
+	}
+	nd := *d
+	nd.Db = database
+	return &nd, nil
+}
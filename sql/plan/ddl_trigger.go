@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
@@ -107,7 +108,8 @@ func (c *CreateTrigger) WithChildren(children ...sql.Node) (sql.Node, error) {
 // CheckPrivileges implements the interface sql.Node.
 func (c *CreateTrigger) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
-		sql.NewPrivilegedOperation(GetDatabaseName(c.Table), getTableName(c.Table), "", sql.PrivilegeType_Trigger))
+		sql.NewPrivilegedOperation(GetDatabaseName(c.Table), getTableName(c.Table), "", sql.PrivilegeType_Trigger)) &&
+		checkDefinerPrivileges(ctx, opChecker, c.Definer)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -132,36 +134,28 @@ func (c *CreateTrigger) DebugString() string {
 }
 
 // OrderTriggers is a utility method that first sorts triggers into their precedence. It then splits the triggers into
-// before and after pairs.
+// before and after pairs. The triggers given must be in the order they were created, which is the order they run in
+// unless a trigger declares that it PRECEDES or FOLLOWS another one, in which case it's placed directly before or
+// after that trigger. A trigger referencing one that isn't given is placed after the others.
 func OrderTriggers(triggers []*CreateTrigger) (beforeTriggers []*CreateTrigger, afterTriggers []*CreateTrigger) {
-	orderedTriggers := make([]*CreateTrigger, len(triggers))
-	copy(orderedTriggers, triggers)
-
-Top:
-	for i, trigger := range triggers {
+	orderedTriggers := make([]*CreateTrigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		pos := len(orderedTriggers)
 		if trigger.TriggerOrder != nil {
-			ref := trigger.TriggerOrder.OtherTriggerName
-			// remove the trigger from the slice
-			orderedTriggers = append(orderedTriggers[:i], orderedTriggers[i+1:]...)
-			// then find where to reinsert it
 			for j, t := range orderedTriggers {
-				if t.TriggerName == ref {
-					if trigger.TriggerOrder.PrecedesOrFollows == sqlparser.PrecedesStr {
-						orderedTriggers = append(orderedTriggers[:j], append(triggers[i:i+1], orderedTriggers[j:]...)...)
-					} else if trigger.TriggerOrder.PrecedesOrFollows == sqlparser.FollowsStr {
-						if len(orderedTriggers) == j-1 {
-							orderedTriggers = append(orderedTriggers, triggers[i])
-						} else {
-							orderedTriggers = append(orderedTriggers[:j+1], append(triggers[i:i+1], orderedTriggers[j+1:]...)...)
-						}
+				if strings.EqualFold(t.TriggerName, trigger.TriggerOrder.OtherTriggerName) {
+					if strings.EqualFold(trigger.TriggerOrder.PrecedesOrFollows, sqlparser.PrecedesStr) {
+						pos = j
 					} else {
-						panic("unexpected value for trigger order")
+						pos = j + 1
 					}
-					continue Top
+					break
 				}
 			}
-			panic(fmt.Sprintf("Referenced trigger %s not found", ref))
 		}
+		orderedTriggers = append(orderedTriggers, nil)
+		copy(orderedTriggers[pos+1:], orderedTriggers[pos:])
+		orderedTriggers[pos] = trigger
 	}
 
 	// Now that we have ordered the triggers according to precedence, split them into BEFORE / AFTER triggers
@@ -0,0 +1,176 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DeferredTableFunction is a call to a table function with bindvars in its arguments, which is instantiated with the
+// bound values of its arguments when its rows are iterated. Its schema is the one of an instance with NULL for the
+// bindvars, which must not change with their values.
+type DeferredTableFunction struct {
+	Function  sql.TableFunction
+	Args      []sql.Expression
+	db        sql.Database
+	prototype sql.Node
+}
+
+var _ sql.TableFunction = (*DeferredTableFunction)(nil)
+var _ sql.CollationCoercible = (*DeferredTableFunction)(nil)
+
+// NewDeferredTableFunction returns a new DeferredTableFunction calling the table function |fn| of the database |db|
+// with the arguments |args|.
+func NewDeferredTableFunction(ctx *sql.Context, fn sql.TableFunction, db sql.Database, args []sql.Expression) (*DeferredTableFunction, error) {
+	nullArgs := make([]sql.Expression, len(args))
+	for i, arg := range args {
+		nullArg, _, err := transform.Expr(arg, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			if _, ok := e.(*expression.BindVar); ok {
+				return expression.NewLiteral(nil, types.Null), transform.NewTree, nil
+			}
+			return e, transform.SameTree, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		nullArgs[i] = nullArg
+	}
+	nullArgs, err := evalTableFunctionArgs(ctx, nullArgs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	prototype, err := fn.NewInstance(ctx, db, nullArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &DeferredTableFunction{
+		Function:  fn,
+		Args:      args,
+		db:        db,
+		prototype: prototype,
+	}, nil
+}
+
+// Instantiate returns the instance of the table function for the values of its arguments evaluated with |row|.
+func (t *DeferredTableFunction) Instantiate(ctx *sql.Context, row sql.Row) (sql.Node, error) {
+	args, err := evalTableFunctionArgs(ctx, t.Args, row)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := t.Function.NewInstance(ctx, t.db, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(instance.Schema()) != len(t.prototype.Schema()) {
+		return nil, sql.ErrTableFunctionSchemaChanged.New(t.Name())
+	}
+	return instance, nil
+}
+
+// evalTableFunctionArgs returns the values of the arguments |args| of a table function evaluated with |row| as literals.
+func evalTableFunctionArgs(ctx *sql.Context, args []sql.Expression, row sql.Row) ([]sql.Expression, error) {
+	literals := make([]sql.Expression, len(args))
+	for i, arg := range args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		literals[i] = expression.NewLiteral(v, arg.Type())
+	}
+	return literals, nil
+}
+
+// NewInstance implements the sql.TableFunction interface.
+func (t *DeferredTableFunction) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	return NewDeferredTableFunction(ctx, t.Function, db, args)
+}
+
+// Name implements the sql.Nameable interface.
+func (t *DeferredTableFunction) Name() string {
+	return t.Function.Name()
+}
+
+// Database implements the sql.Databaser interface.
+func (t *DeferredTableFunction) Database() sql.Database {
+	return t.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (t *DeferredTableFunction) WithDatabase(db sql.Database) (sql.Node, error) {
+	nt := *t
+	nt.db = db
+	return &nt, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (t *DeferredTableFunction) Resolved() bool {
+	return expression.ExpressionsResolved(t.Args...)
+}
+
+// Schema implements the sql.Node interface.
+func (t *DeferredTableFunction) Schema() sql.Schema {
+	return t.prototype.Schema()
+}
+
+// Children implements the sql.Node interface.
+func (t *DeferredTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (t *DeferredTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(t, children...)
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (t *DeferredTableFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return t.prototype.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (t *DeferredTableFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, t.prototype)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (t *DeferredTableFunction) Expressions() []sql.Expression {
+	return t.Args
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (t *DeferredTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(t.Args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(exprs), len(t.Args))
+	}
+	nt := *t
+	nt.Args = exprs
+	return &nt, nil
+}
+
+func (t *DeferredTableFunction) String() string {
+	args := make([]string, len(t.Args))
+	for i, arg := range t.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("DeferredTableFunction(%s(%s))", t.Name(), strings.Join(args, ", "))
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DynamicPrivilege_SetUserID is the dynamic privilege required to name an account other than one's own as the definer
+// of a stored object.
+const DynamicPrivilege_SetUserID = "set_user_id"
+
+// checkDefinerPrivileges returns whether the user may create a stored object with the definer given. Stored objects
+// run with the privileges of their definer, so naming another account takes the SET_USER_ID or SUPER privilege.
+func checkDefinerPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, definer string) bool {
+	if definer == "" {
+		return true
+	}
+	dc, ok := opChecker.(sql.DefinerPrivilegedOperationChecker)
+	if !ok || dc.IsCurrentUser(ctx, definer) {
+		return true
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_SetUserID)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Super))
+}
@@ -72,6 +72,9 @@ func (d Describe) String() string {
 type DescribeQuery struct {
 	UnaryNode
 	Format string
+	// Analyze is true for EXPLAIN ANALYZE, which runs the query before describing it, so that the choices made while
+	// it runs are described
+	Analyze bool
 }
 
 var _ sql.Node = (*DescribeQuery)(nil)
@@ -109,7 +112,7 @@ var DescribeSchema = sql.Schema{
 
 // NewDescribeQuery creates a new DescribeQuery node.
 func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
-	return &DescribeQuery{UnaryNode{Child: child}, format}
+	return &DescribeQuery{UnaryNode: UnaryNode{Child: child}, Format: format}
 }
 
 // Schema implements the Node interface.
@@ -142,5 +145,7 @@ func (d *DescribeQuery) Query() sql.Node {
 
 // WithQuery returns a copy of this node with the query node given
 func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
-	return NewDescribeQuery(d.Format, child)
+	nd := NewDescribeQuery(d.Format, child)
+	nd.Analyze = d.Analyze
+	return nd
 }
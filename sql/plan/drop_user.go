@@ -143,6 +143,9 @@ func (n *DropUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err = mysqlDb.RemovePasswordHistory(ctx, existingUser); err != nil {
+			return nil, err
+		}
 	}
 	if err := mysqlDb.Persist(ctx); err != nil {
 		return nil, err
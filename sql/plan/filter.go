@@ -124,6 +124,27 @@ func (i *FilterIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 }
 
+// NextBatch implements the RowBatchIter interface.
+func (i *FilterIter) NextBatch(ctx *sql.Context, batch []sql.Row) (int, error) {
+	for {
+		n, err := sql.NextRowBatch(ctx, i.childIter, batch)
+		matched := 0
+		for j := 0; j < n; j++ {
+			res, cErr := sql.EvaluateCondition(ctx, i.cond, append(i.ParentRow, batch[j]...))
+			if cErr != nil {
+				return matched, cErr
+			}
+			if sql.IsTrue(res) {
+				batch[matched] = batch[j]
+				matched++
+			}
+		}
+		if err != nil || matched > 0 {
+			return matched, err
+		}
+	}
+}
+
 // Close implements the RowIter interface.
 func (i *FilterIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
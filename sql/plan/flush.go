@@ -15,12 +15,15 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// FlushPrivileges reads privileges from mysql tables and registers any unregistered privileges found.
+// FlushPrivileges reloads the privileges from the persister of the mysql database if it can load them, otherwise it
+// persists the privileges edited in the mysql tables.
 type FlushPrivileges struct {
 	writesToBinlog bool
 	MysqlDb        sql.Database
@@ -44,10 +47,16 @@ func (f *FlushPrivileges) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, err
 	if !ok {
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
-	err := gts.Persist(ctx)
+	// Reload the privileges from the persister if it can load them, otherwise persist the ones edited in the tables
+	reloaded, err := gts.Reload(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if !reloaded {
+		if err = gts.Persist(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
 }
@@ -101,3 +110,117 @@ func (f *FlushPrivileges) WithDatabase(db sql.Database) (sql.Node, error) {
 	fp.MysqlDb = db
 	return &fp, nil
 }
+
+// FlushTables closes the cached handles of tables, and optionally acquires a read lock on them, or the global read lock
+// when no tables are named.
+type FlushTables struct {
+	// Tables are the tables to flush, or none to flush every table.
+	Tables []sql.DbTable
+	// ReadLock is whether the statement has the WITH READ LOCK option.
+	ReadLock       bool
+	Catalog        sql.Catalog
+	GlobalReadLock *sql.GlobalReadLock
+}
+
+var _ sql.Node = (*FlushTables)(nil)
+var _ sql.CollationCoercible = (*FlushTables)(nil)
+
+// NewFlushTables creates a new FlushTables node.
+func NewFlushTables(tables []sql.DbTable, readLock bool) *FlushTables {
+	return &FlushTables{Tables: tables, ReadLock: readLock}
+}
+
+// String implements the interface sql.Node.
+func (f *FlushTables) String() string {
+	s := "FLUSH TABLES"
+	if len(f.Tables) > 0 {
+		s += " " + maintenanceTableNames(f.Tables)
+	}
+	if f.ReadLock {
+		s += " WITH READ LOCK"
+	}
+	return s
+}
+
+// WithChildren implements the interface sql.Node.
+func (f *FlushTables) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+	return f, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (f *FlushTables) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Reload)) {
+		return false
+	}
+	if f.ReadLock && len(f.Tables) > 0 {
+		return maintenanceTablePrivileges(ctx, opChecker, f.Tables, sql.PrivilegeType_Select, sql.PrivilegeType_LockTables)
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FlushTables) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Resolved implements the interface sql.Node.
+func (f *FlushTables) Resolved() bool { return true }
+
+// Children implements the sql.Node interface.
+func (*FlushTables) Children() []sql.Node { return nil }
+
+// Schema implements the sql.Node interface.
+func (*FlushTables) Schema() sql.Schema { return types.OkResultSchema }
+
+// FlushLogs rotates the server logs given to the log sinks registered for them.
+type FlushLogs struct {
+	// LogTypes are the kinds of logs to rotate, which are all of them for FLUSH LOGS.
+	LogTypes []sql.LogType
+	LogSinks *sql.LogSinkRegistry
+}
+
+var _ sql.Node = (*FlushLogs)(nil)
+var _ sql.CollationCoercible = (*FlushLogs)(nil)
+
+// NewFlushLogs creates a new FlushLogs node.
+func NewFlushLogs(logTypes []sql.LogType) *FlushLogs {
+	return &FlushLogs{LogTypes: logTypes}
+}
+
+// String implements the interface sql.Node.
+func (f *FlushLogs) String() string {
+	if len(f.LogTypes) == 1 {
+		return fmt.Sprintf("FLUSH %s LOGS", f.LogTypes[0])
+	}
+	return "FLUSH LOGS"
+}
+
+// WithChildren implements the interface sql.Node.
+func (f *FlushLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+	return f, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (f *FlushLogs) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Reload))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FlushLogs) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Resolved implements the interface sql.Node.
+func (f *FlushLogs) Resolved() bool { return true }
+
+// Children implements the sql.Node interface.
+func (*FlushLogs) Children() []sql.Node { return nil }
+
+// Schema implements the sql.Node interface.
+func (*FlushLogs) Schema() sql.Schema { return types.OkResultSchema }
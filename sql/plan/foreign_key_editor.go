@@ -44,6 +44,20 @@ type ForeignKeyRefActionData struct {
 	ChildParentMapping ChildParentMapping
 }
 
+// recordRowEvent records a change that the referential action made to a row of the child table to the context's row
+// event publisher. Since the change is recorded once the referential actions it caused are done, and the change that
+// caused it is recorded after it, the changes of the referential actions come before the change that caused them.
+func (refActionData ForeignKeyRefActionData) recordRowEvent(ctx *sql.Context, typ sql.RowEventType, before, after sql.Row) {
+	ctx.RowEvents().Record(ctx, sql.RowEvent{
+		Type:     typ,
+		Database: refActionData.ForeignKey.Database,
+		Table:    refActionData.ForeignKey.Table,
+		Schema:   refActionData.Editor.Schema,
+		Before:   before,
+		After:    after,
+	})
+}
+
 // ForeignKeyEditor handles update and delete operations, as they may have referential actions on other tables (such as
 // cascading). If this editor is Cyclical, then that means that following the referential actions will eventually lead
 // back to this same editor. Self-referential foreign keys are inherently cyclical.
@@ -167,6 +181,7 @@ func (fkEditor *ForeignKeyEditor) OnUpdateCascade(ctx *sql.Context, refActionDat
 		if err != nil {
 			return err
 		}
+		refActionData.recordRowEvent(ctx, sql.RowEventType_Update, rowToUpdate, updatedRow)
 	}
 	if err == io.EOF {
 		return nil
@@ -203,6 +218,7 @@ func (fkEditor *ForeignKeyEditor) OnUpdateSetNull(ctx *sql.Context, refActionDat
 		if err != nil {
 			return err
 		}
+		refActionData.recordRowEvent(ctx, sql.RowEventType_Update, rowToUpdate, updatedRow)
 	}
 	if err == io.EOF {
 		return nil
@@ -280,6 +296,7 @@ func (fkEditor *ForeignKeyEditor) OnDeleteCascade(ctx *sql.Context, refActionDat
 		if err != nil {
 			return err
 		}
+		refActionData.recordRowEvent(ctx, sql.RowEventType_Delete, rowToDelete, nil)
 	}
 	if err == io.EOF {
 		return nil
@@ -316,6 +333,7 @@ func (fkEditor *ForeignKeyEditor) OnDeleteSetNull(ctx *sql.Context, refActionDat
 		if err != nil {
 			return err
 		}
+		refActionData.recordRowEvent(ctx, sql.RowEventType_Update, rowToNull, nulledRow)
 	}
 	if err == io.EOF {
 		return nil
@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // NewHashLookup returns a node that performs an indexed hash lookup
@@ -45,6 +46,8 @@ type HashLookup struct {
 	LeftProbeKey  sql.Expression
 	Mutex         *sync.Mutex
 	Lookup        map[interface{}][]sql.Row
+	// BloomFilter holds the hashes of the keys of Lookup, once it's been built for a pushed down HashLookupBloomFilter
+	BloomFilter *sql.BloomFilter
 }
 
 var _ sql.Node = (*HashLookup)(nil)
@@ -147,7 +150,118 @@ func (n *HashLookup) GetHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 	return key, nil
 }
 
+// BuildLookup builds the Lookup of this node from the rows of its child, once they're cached. Must be called with the
+// Mutex held.
+func (n *HashLookup) BuildLookup(ctx *sql.Context) error {
+	if n.Lookup != nil {
+		return nil
+	}
+	// Instead of building the mapping inline here with a special
+	// RowIter, we currently make use of CachedResults and require
+	// *CachedResults to be our direct child.
+	cr := n.UnaryNode.Child.(*CachedResults)
+	if res := cr.GetCachedResults(); res != nil {
+		n.Lookup = make(map[interface{}][]sql.Row)
+		for _, row := range res {
+			// TODO: Maybe do not put nil stuff in here.
+			key, err := n.GetHashKey(ctx, n.RightEntryKey, row)
+			if err != nil {
+				return err
+			}
+			n.Lookup[key] = append(n.Lookup[key], row)
+		}
+		// CachedResult is safe to Dispose after contents are transferred
+		// to |n.lookup|
+		cr.Dispose()
+	}
+	return nil
+}
+
+// BuildBloomFilter builds the BloomFilter of the keys of the Lookup of this node, which must have been built. Must be
+// called with the Mutex held.
+func (n *HashLookup) BuildBloomFilter() error {
+	if n.BloomFilter != nil {
+		return nil
+	}
+	filter := sql.NewBloomFilter(len(n.Lookup))
+	for key := range n.Lookup {
+		hash, err := sql.HashOf(sql.Row{key})
+		if err != nil {
+			return err
+		}
+		filter.Add(hash)
+	}
+	n.BloomFilter = filter
+	return nil
+}
+
 func (n *HashLookup) Dispose() {
 	cr := n.Child.(*CachedResults)
 	cr.Dispose()
 }
+
+// HashLookupBloomFilter is a filter pushed down to the table scan of the probe side of a hash join, which is false for
+// the rows whose join key isn't in the bloom filter of the keys of the build side, and so can't match any of its rows.
+type HashLookupBloomFilter struct {
+	// Lookup is the HashLookup of the build side
+	Lookup *HashLookup
+	// Key is the LeftProbeKey of Lookup, referencing the columns of the table filtered
+	Key    sql.Expression
+	Filter *sql.BloomFilter
+}
+
+var _ sql.Expression = (*HashLookupBloomFilter)(nil)
+
+// NewHashLookupBloomFilter returns a new HashLookupBloomFilter for the build side |lookup|, whose BloomFilter must have
+// been built, and the probe |key| given.
+func NewHashLookupBloomFilter(lookup *HashLookup, key sql.Expression) *HashLookupBloomFilter {
+	return &HashLookupBloomFilter{Lookup: lookup, Key: key, Filter: lookup.BloomFilter}
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) Resolved() bool {
+	return f.Key.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) String() string {
+	return fmt.Sprintf("BLOOM_FILTER(%s)", f.Key)
+}
+
+// Type implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) Type() sql.Type {
+	return types.Boolean
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) IsNullable() bool {
+	return false
+}
+
+// Eval implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	key, err := f.Lookup.GetHashKey(ctx, f.Key, row)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := sql.HashOf(sql.Row{key})
+	if err != nil {
+		return nil, err
+	}
+	return f.Filter.MayContain(hash), nil
+}
+
+// Children implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) Children() []sql.Expression {
+	return []sql.Expression{f.Key}
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *HashLookupBloomFilter) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	nf := *f
+	nf.Key = children[0]
+	return &nf, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ImplicitCommit is how a statement ends the transaction in progress when it's run.
+type ImplicitCommit byte
+
+const (
+	// NoImplicitCommit is for statements run in the transaction in progress.
+	NoImplicitCommit ImplicitCommit = iota
+	// ImplicitCommitBefore is for statements that commit the transaction in progress before they're run, and that
+	// manage the transaction after them themselves, such as START TRANSACTION and LOCK TABLES.
+	ImplicitCommitBefore
+	// ImplicitCommitBeforeAndAfter is for statements that commit the transaction in progress before they're run, and
+	// that are run in a transaction of their own, which is committed once they're done and rolled back if they fail.
+	ImplicitCommitBeforeAndAfter
+	// ImplicitCommitDDL is for DDL statements, which commit like ImplicitCommitBeforeAndAfter statements, unless
+	// they're run in the transaction in progress by a sql.TransactionalDDLSession.
+	ImplicitCommitDDL
+)
+
+// ImplicitCommitOf returns how the statement given ends the transaction in progress, following the statements that
+// cause an implicit commit in MySQL: https://dev.mysql.com/doc/refman/8.0/en/implicit-commit.html. This is the table
+// that the transaction layer consults before and after each statement, see ImplicitCommitFor.
+func ImplicitCommitOf(node sql.Node) ImplicitCommit {
+	switch n := node.(type) {
+	// Data definition statements, except for the ones on temporary tables
+	case *CreateTable:
+		if n.Temporary() == IsTempTable {
+			return NoImplicitCommit
+		}
+		return ImplicitCommitDDL
+	case *DropTable, *Truncate, *RenameTable, *AlterTable, *ExchangePartition,
+		*AddColumn, *ModifyColumn, *DropColumn, *RenameColumn,
+		*AlterDefaultSet, *AlterDefaultDrop, *AlterAutoIncrement, *AlterTableCollation, *AlterTableOptions, *AlterPK,
+		*CreateDB, *DropDB, *AlterDB,
+		*CreateView, *DropView,
+		*CreateIndex, *AlterIndex, *DropIndex,
+		*CreateProcedure, *DropProcedure,
+		*CreateEvent, *AlterEvent, *DropEvent,
+		*CreateForeignKey, *DropForeignKey,
+		*CreateCheck, *DropCheck, *DropConstraint,
+		*CreateTrigger, *DropTrigger,
+		*CreateSequence, *AlterSequence, *DropSequence,
+		*CreateSpatialRefSys,
+		*Block: // Block as a top level node wraps a set of ALTER TABLE statements
+		return ImplicitCommitDDL
+
+	// Statements that implicitly use or modify the tables of the mysql database
+	case *CreateUser, *AlterUser, *DropUser, *RenameUser,
+		*CreateRole, *DropRole,
+		*Grant, *GrantRole, *GrantProxy,
+		*Revoke, *RevokeAll, *RevokeRole, *RevokeProxy:
+		return ImplicitCommitBeforeAndAfter
+
+	// Administrative statements
+	case *AnalyzeTable, *CheckTable, *OptimizeTable,
+		*FlushPrivileges, *FlushTables, *FlushLogs:
+		return ImplicitCommitBeforeAndAfter
+
+	// Statements that control replication
+	case *ChangeReplicationSource, *StartReplica, *StopReplica, *ResetReplica:
+		return ImplicitCommitBeforeAndAfter
+
+	// Transaction-control and locking statements. UNLOCK TABLES only commits when tables are locked, and SET
+	// autocommit = 1 commits once autocommit is on, like the other statements run with autocommit.
+	case *StartTransaction, *LockTables, *UnlockTables:
+		return ImplicitCommitBefore
+
+	default:
+		return NoImplicitCommit
+	}
+}
+
+// ImplicitCommitFor returns how the statement given ends the transaction in progress in the session of the context
+// given. Sessions that aren't a sql.TransactionSession have no transactions to commit.
+func ImplicitCommitFor(ctx *sql.Context, node sql.Node) ImplicitCommit {
+	if _, ok := ctx.Session.(sql.TransactionSession); !ok {
+		return NoImplicitCommit
+	}
+
+	switch commit := ImplicitCommitOf(node); commit {
+	case ImplicitCommitDDL:
+		if ts, ok := ctx.Session.(sql.TransactionalDDLSession); ok && ts.SupportsTransactionalDDL(ctx) {
+			return NoImplicitCommit
+		}
+		return ImplicitCommitBeforeAndAfter
+	case ImplicitCommitBefore:
+		if n, ok := node.(*UnlockTables); ok && (n.Catalog == nil || !n.Catalog.HasLockedTables(ctx.ID())) {
+			return NoImplicitCommit
+		}
+		return commit
+	default:
+		return commit
+	}
+}
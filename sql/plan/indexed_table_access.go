@@ -192,19 +192,6 @@ func (i *IndexedTableAccess) GetLookup(ctx *sql.Context, row sql.Row) (sql.Index
 	return i.lb.GetLookup(key)
 }
 
-func (i *IndexedTableAccess) getLookup2(ctx *sql.Context, row sql.Row2) (sql.IndexLookup, error) {
-	// if the lookup was provided at analysis time (static evaluation), use it.
-	if !i.lookup.IsEmpty() {
-		return i.lookup, nil
-	}
-
-	key, err := i.lb.GetKey2(ctx, row)
-	if err != nil {
-		return sql.IndexLookup{}, err
-	}
-	return i.lb.GetLookup(key)
-}
-
 func (i *IndexedTableAccess) String() string {
 	pr := sql.NewTreePrinter()
 	pr.WriteNode("IndexedTableAccess(%s)", i.ResolvedTable.Name())
@@ -235,6 +222,14 @@ func (i *IndexedTableAccess) String() string {
 		}
 	}
 
+	if conditions := i.indexConditions(); len(conditions) > 0 {
+		children = append(children, fmt.Sprintf("index condition: %v", conditions))
+	}
+
+	if offset := i.indexOffset(); offset > 0 {
+		children = append(children, fmt.Sprintf("offset: %d", offset))
+	}
+
 	if i.lookup.IsReverse {
 		children = append(children, fmt.Sprintf("reverse: %v", i.lookup.IsReverse))
 	}
@@ -243,6 +238,76 @@ func (i *IndexedTableAccess) String() string {
 	return pr.String()
 }
 
+// indexConditionExprs returns the filters pushed down to the table.
+func (i *IndexedTableAccess) indexConditionExprs() []sql.Expression {
+	if ict, ok := i.Table.(sql.IndexConditionPushdownTable); ok {
+		return ict.IndexConditions()
+	}
+	return nil
+}
+
+// indexConditions returns the filters pushed down to the table, as strings.
+func (i *IndexedTableAccess) indexConditions() []string {
+	var conditions []string
+	for _, c := range i.indexConditionExprs() {
+		conditions = append(conditions, c.String())
+	}
+	return conditions
+}
+
+// WithIndexConditions returns a copy of this node whose table only returns the rows of its lookup that match the
+// filters given, which must reference the columns of the table by their position in its schema.
+func (i IndexedTableAccess) WithIndexConditions(filters []sql.Expression) (*IndexedTableAccess, error) {
+	ict, ok := i.Table.(sql.IndexConditionPushdownTable)
+	if !ok {
+		return nil, fmt.Errorf("table %s does not support index condition pushdown", i.Name())
+	}
+	i.Table = ict.WithIndexConditions(filters)
+	return &i, nil
+}
+
+// WithReverseLookup returns a copy of this node, which must have a static lookup, that reads the rows of its lookup in
+// the reverse order of its index. The index conditions pushed down to its table are kept.
+func (i IndexedTableAccess) WithReverseLookup() (*IndexedTableAccess, error) {
+	if i.lookup.IsEmpty() {
+		return nil, fmt.Errorf("cannot reverse the lookup of table %s, which isn't static", i.Name())
+	}
+	var table = i.ResolvedTable.Table
+	if t, ok := table.(sql.TableWrapper); ok {
+		table = t.Underlying()
+	}
+	iaTable, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, fmt.Errorf("table is not index addressable: %s", table.Name())
+	}
+
+	conditions := i.indexConditionExprs()
+	i.lookup.IsReverse = true
+	i.Table = iaTable.IndexedAccess(i.lookup)
+	if len(conditions) > 0 {
+		return i.WithIndexConditions(conditions)
+	}
+	return &i, nil
+}
+
+// indexOffset returns the offset pushed down to the table, or 0 if none has been.
+func (i *IndexedTableAccess) indexOffset() uint64 {
+	if ot, ok := i.Table.(sql.IndexOffsetTable); ok {
+		return ot.IndexOffset()
+	}
+	return 0
+}
+
+// WithIndexOffset returns a copy of this node whose table skips the first |offset| rows of its lookups.
+func (i IndexedTableAccess) WithIndexOffset(offset uint64) (*IndexedTableAccess, error) {
+	ot, ok := i.Table.(sql.IndexOffsetTable)
+	if !ok {
+		return nil, fmt.Errorf("table %s does not support index offset pushdown", i.Name())
+	}
+	i.Table = ot.WithIndexOffset(offset)
+	return &i, nil
+}
+
 func formatIndexDecoratorString(idx sql.Index) string {
 	var expStrs []string
 	expStrs = append(expStrs, idx.Expressions()...)
@@ -283,6 +348,14 @@ func (i *IndexedTableAccess) DebugString() string {
 		}
 	}
 
+	if conditions := i.indexConditions(); len(conditions) > 0 {
+		children = append(children, fmt.Sprintf("index condition: %v", conditions))
+	}
+
+	if offset := i.indexOffset(); offset > 0 {
+		children = append(children, fmt.Sprintf("offset: %d", offset))
+	}
+
 	if i.lookup.IsReverse {
 		children = append(children, fmt.Sprintf("reverse: %v", i.lookup.IsReverse))
 	}
@@ -399,8 +472,7 @@ type lookupBuilderKey []interface{}
 // IndexedTableAccess nodes below an indexed join, for example. This struct is
 // also used to implement Expressioner on the IndexedTableAccess node.
 type LookupBuilder struct {
-	keyExprs  []sql.Expression
-	keyExprs2 []sql.Expression2
+	keyExprs []sql.Expression
 
 	// When building the lookup, we will use an IndexBuilder. If the
 	// extracted lookup value is NULL, but we have a non-NULL safe
@@ -524,20 +596,6 @@ func (lb *LookupBuilder) GetKey(ctx *sql.Context, row sql.Row) (lookupBuilderKey
 	return lb.key, nil
 }
 
-func (lb *LookupBuilder) GetKey2(ctx *sql.Context, row sql.Row2) (lookupBuilderKey, error) {
-	if lb.key == nil {
-		lb.key = make([]interface{}, len(lb.keyExprs))
-	}
-	for i := range lb.keyExprs {
-		var err error
-		lb.key[i], err = lb.keyExprs2[i].Eval2(ctx, row)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return lb.key, nil
-}
-
 func (lb *LookupBuilder) GetZeroKey() lookupBuilderKey {
 	key := make(lookupBuilderKey, len(lb.keyExprs))
 	for i, keyExpr := range lb.keyExprs {
@@ -322,20 +322,27 @@ func (j *JoinNode) JoinType() JoinType {
 func (j *JoinNode) Schema() sql.Schema {
 	switch {
 	case j.Op.IsLeftOuter():
-		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+		return concatSchemas(j.left.Schema(), makeNullable(j.right.Schema()))
 	case j.Op.IsRightOuter():
-		return append(makeNullable(j.left.Schema()), j.right.Schema()...)
+		return concatSchemas(makeNullable(j.left.Schema()), j.right.Schema())
 	case j.Op.IsFullOuter():
-		return append(makeNullable(j.left.Schema()), makeNullable(j.right.Schema())...)
+		return concatSchemas(makeNullable(j.left.Schema()), makeNullable(j.right.Schema()))
 	case j.Op.IsPartial():
 		return j.Left().Schema()
 	case j.Op.IsNatural():
 		panic("NaturalJoin is a placeholder, Schema called")
 	default:
-		return append(j.left.Schema(), j.right.Schema()...)
+		return concatSchemas(j.left.Schema(), j.right.Schema())
 	}
 }
 
+// concatSchemas returns a new schema with the columns of |left| followed by the ones of |right|. The schemas of the
+// children are shared, so they're never appended to.
+func concatSchemas(left, right sql.Schema) sql.Schema {
+	s := make(sql.Schema, 0, len(left)+len(right))
+	return append(append(s, left...), right...)
+}
+
 // makeNullable will return a copy of the received columns, but all of them
 // will be turned into nullable columns.
 func makeNullable(cols []*sql.Column) []*sql.Column {
@@ -138,7 +138,7 @@ func (t *UnlockTables) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 	span, ctx := ctx.Span("plan.UnlockTables")
 	defer span.End()
 
-	if err := t.Catalog.UnlockTables(ctx, ctx.ID()); err != nil {
+	if err := ReleaseTableLocks(ctx, t.Catalog); err != nil {
 		return nil, err
 	}
 
@@ -170,3 +170,30 @@ func (t *UnlockTables) CheckPrivileges(ctx *sql.Context, opChecker sql.Privilege
 func (*UnlockTables) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 7
 }
+
+// ReleaseTableLocks releases the table locks held by the session of the context given. Like in MySQL, releasing table
+// locks commits the session's transaction, which LOCK TABLES keeps open so that the writes made while holding the locks,
+// such as the rows of a table being restored from a dump, are committed together.
+func ReleaseTableLocks(ctx *sql.Context, cat sql.Catalog) error {
+	hadLocks := cat.HasLockedTables(ctx.ID())
+	if err := cat.UnlockTables(ctx, ctx.ID()); err != nil {
+		return err
+	}
+	if !hadLocks {
+		return nil
+	}
+
+	ts, ok := ctx.Session.(sql.TransactionSession)
+	if !ok {
+		return nil
+	}
+	if tx := ctx.GetTransaction(); tx != nil {
+		if err := ts.CommitTransaction(ctx, tx); err != nil {
+			return err
+		}
+		ctx.RowEvents().Commit(ctx)
+	}
+	ctx.SetIgnoreAutoCommit(false)
+	ctx.SetTransaction(nil)
+	return nil
+}
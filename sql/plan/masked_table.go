@@ -0,0 +1,115 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DynamicPrivilege_Unmask is the dynamic privilege required to read the values of the masked columns of a
+// sql.ColumnMaskingTable.
+const DynamicPrivilege_Unmask = "unmask"
+
+// MaskedTable is a table that replaces the values of some columns of another table with masks computed from its rows.
+// The analyzer replaces the sql.ColumnMaskingTable tables read by the users without the UNMASK privilege with
+// MaskedTables, so that the masked values are the ones that filters, joins and functions see. It deliberately
+// implements no other table interface, so that no index or pushed down filter can read the unmasked values.
+type MaskedTable struct {
+	table sql.Table
+	// masks are the masks of the columns of the table by index, which are nil for the columns that aren't masked. They
+	// are evaluated on the unmasked rows of the table.
+	masks []sql.Expression
+}
+
+var _ sql.Table = (*MaskedTable)(nil)
+
+// NewMaskedTable returns a new MaskedTable for the table and the masks of its columns given.
+func NewMaskedTable(table sql.Table, masks []sql.Expression) *MaskedTable {
+	return &MaskedTable{table: table, masks: masks}
+}
+
+// WithTable returns a copy of this MaskedTable which masks the table given, which must have the same schema.
+func (t *MaskedTable) WithTable(table sql.Table) *MaskedTable {
+	return &MaskedTable{table: table, masks: t.masks}
+}
+
+// Name implements the sql.Table interface.
+func (t *MaskedTable) Name() string {
+	return t.table.Name()
+}
+
+// String implements the sql.Table interface.
+func (t *MaskedTable) String() string {
+	return fmt.Sprintf("Masked(%s)", t.table.String())
+}
+
+// Schema implements the sql.Table interface.
+func (t *MaskedTable) Schema() sql.Schema {
+	return t.table.Schema()
+}
+
+// Collation implements the sql.Table interface.
+func (t *MaskedTable) Collation() sql.CollationID {
+	return t.table.Collation()
+}
+
+// Partitions implements the sql.Table interface.
+func (t *MaskedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return t.table.Partitions(ctx)
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *MaskedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.table.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	return &maskedRowIter{iter: iter, schema: t.table.Schema(), masks: t.masks}, nil
+}
+
+type maskedRowIter struct {
+	iter   sql.RowIter
+	schema sql.Schema
+	masks  []sql.Expression
+}
+
+func (i *maskedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	masked := row.Copy()
+	for idx, mask := range i.masks {
+		if mask == nil {
+			continue
+		}
+		val, err := mask.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		// the masks may be of any type, but the values must be of the type of their columns
+		if val, _, err = i.schema[idx].Type.Convert(val); err != nil {
+			return nil, err
+		}
+		masked[idx] = val
+	}
+	return masked, nil
+}
+
+func (i *maskedRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
@@ -132,6 +132,21 @@ func (t *ProcessIndexableTable) Underlying() sql.Table {
 	return t.DriverIndexableTable
 }
 
+// WithRuntimeFilter implements the sql.RuntimeFilterTable interface, when the table wrapped does.
+func (t *ProcessIndexableTable) WithRuntimeFilter(filter sql.Expression) sql.Table {
+	rft, ok := t.DriverIndexableTable.(sql.RuntimeFilterTable)
+	if !ok {
+		return t
+	}
+	table, ok := rft.WithRuntimeFilter(filter).(sql.DriverIndexableTable)
+	if !ok {
+		return t
+	}
+	nt := *t
+	nt.DriverIndexableTable = table
+	return &nt
+}
+
 // IndexKeyValues implements the sql.IndexableTable interface.
 func (t *ProcessIndexableTable) IndexKeyValues(
 	ctx *sql.Context,
@@ -179,6 +194,7 @@ func (t *ProcessIndexableTable) newPartIter(p sql.Partition, iter sql.RowIter) (
 }
 
 var _ sql.DriverIndexableTable = (*ProcessIndexableTable)(nil)
+var _ sql.RuntimeFilterTable = (*ProcessIndexableTable)(nil)
 
 // NamedNotifyFunc is a function to notify about some event with a string argument.
 type NamedNotifyFunc func(name string)
@@ -193,6 +209,8 @@ type ProcessTable struct {
 	OnRowNext        NamedNotifyFunc
 }
 
+var _ sql.RuntimeFilterTable = (*ProcessTable)(nil)
+
 // NewProcessTable returns a new ProcessTable.
 func NewProcessTable(t sql.Table, onPartitionDone, onPartitionStart, OnRowNext NamedNotifyFunc) *ProcessTable {
 	return &ProcessTable{t, onPartitionDone, onPartitionStart, OnRowNext}
@@ -203,6 +221,17 @@ func (t *ProcessTable) Underlying() sql.Table {
 	return t.Table
 }
 
+// WithRuntimeFilter implements the sql.RuntimeFilterTable interface, when the table wrapped does.
+func (t *ProcessTable) WithRuntimeFilter(filter sql.Expression) sql.Table {
+	rft, ok := t.Table.(sql.RuntimeFilterTable)
+	if !ok {
+		return t
+	}
+	nt := *t
+	nt.Table = rft.WithRuntimeFilter(filter)
+	return &nt
+}
+
 // PartitionRows implements the sql.Table interface.
 func (t *ProcessTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
 	iter, err := t.Table.PartitionRows(ctx, p)
@@ -335,6 +364,18 @@ func (i *trackedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return row, nil
 }
 
+// NextBatch implements sql.RowBatchIter.
+func (i *trackedRowIter) NextBatch(ctx *sql.Context, batch []sql.Row) (int, error) {
+	n, err := sql.NextRowBatch(ctx, i.iter, batch)
+	i.numRows += int64(n)
+	if i.onNext != nil {
+		for j := 0; j < n; j++ {
+			i.onNext()
+		}
+	}
+	return n, err
+}
+
 func (i *trackedRowIter) Close(ctx *sql.Context) error {
 	err := i.iter.Close(ctx)
 
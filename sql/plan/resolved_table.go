@@ -70,6 +70,7 @@ func (t *ResolvedTable) String() string {
 	table := seethroughTableWrapper(t)
 	children := []string{fmt.Sprintf("name: %s", t.Name())}
 
+	var pushed []string
 	if pt, ok := table.(sql.ProjectedTable); ok {
 		projections := pt.Projections()
 		if projections != nil {
@@ -77,20 +78,13 @@ func (t *ResolvedTable) String() string {
 			for i, c := range projections {
 				columns[i] = strings.ToLower(c)
 			}
-			children = append(children, fmt.Sprintf("columns: %v", columns))
-		}
-	}
-
-	if ft, ok := table.(sql.FilteredTable); ok {
-		var filters []string
-		for _, f := range ft.Filters() {
-			filters = append(filters, f.String())
-		}
-		if len(filters) > 0 {
-			children = append(children, fmt.Sprintf("filters: %v", filters))
+			pushed = append(pushed, fmt.Sprintf("columns: %v", columns))
 		}
 	}
+	pushed = append(pushed, filterAndLimitStrings(table)...)
 
+	children = append(children, shardStrings(table)...)
+	children = append(children, remoteStrings(table, pushed)...)
 	pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -114,23 +108,85 @@ func (t *ResolvedTable) DebugString() string {
 			columns[i] = strings.ToLower(c.Name)
 		}
 	}
-	children = append(children, fmt.Sprintf("columns: %v", columns))
+	// the columns read from a remote table are listed along with what's pushed down to it
+	var pushed []string
+	if _, ok := table.(sql.RemoteTable); ok {
+		pushed = append(pushed, fmt.Sprintf("columns: %v", columns))
+	} else {
+		children = append(children, fmt.Sprintf("columns: %v", columns))
+	}
 	if t.comment != "" {
 		children = append(children, fmt.Sprintf("comment: %s", t.comment))
 	}
+	pushed = append(pushed, filterAndLimitStrings(table)...)
+
+	children = append(children, shardStrings(table)...)
+	children = append(children, remoteStrings(table, pushed)...)
+	pr.WriteChildren(children...)
+	return pr.String()
+}
 
+// filterAndLimitStrings returns the descriptions of the filters, sort, limit and sample applied to |table|, if any.
+func filterAndLimitStrings(table sql.Table) []string {
+	var strs []string
 	if ft, ok := table.(sql.FilteredTable); ok {
 		var filters []string
 		for _, f := range ft.Filters() {
 			filters = append(filters, f.String())
 		}
 		if len(filters) > 0 {
-			children = append(children, fmt.Sprintf("filters: %v", filters))
+			strs = append(strs, fmt.Sprintf("filters: %v", filters))
 		}
 	}
+	return append(strs, sortAndLimitStrings(table)...)
+}
+
+// shardStrings returns the description of the shards read by |table|, if it's a sql.ShardedTable.
+func shardStrings(table sql.Table) []string {
+	if st, ok := table.(sql.ShardedTable); ok {
+		return []string{fmt.Sprintf("shards: %v", st.Shards())}
+	}
+	return nil
+}
 
-	pr.WriteChildren(children...)
-	return pr.String()
+// remoteStrings returns |pushed|, the descriptions of what's pushed down to |table|. If the table is a
+// sql.RemoteTable, they're grouped under its remote source along with its remote query, since they're evaluated there
+// rather than by the engine.
+func remoteStrings(table sql.Table, pushed []string) []string {
+	rt, ok := table.(sql.RemoteTable)
+	if !ok {
+		return pushed
+	}
+	pr := sql.NewTreePrinter()
+	pr.WriteNode("remote: %s", rt.RemoteSource())
+	if query := rt.RemoteQuery(); query != "" {
+		pushed = append(pushed, fmt.Sprintf("query: %s", query))
+	}
+	pr.WriteChildren(pushed...)
+	return []string{pr.String()}
+}
+
+// sortAndLimitStrings returns the descriptions of the sort, limit and sample applied to |table|, if any.
+func sortAndLimitStrings(table sql.Table) []string {
+	var strs []string
+	if st, ok := table.(sql.SortableTable); ok && len(st.SortFields()) > 0 {
+		fields := make([]string, len(st.SortFields()))
+		for i, f := range st.SortFields() {
+			fields[i] = fmt.Sprintf("%s %s", f.Column, f.Order)
+		}
+		strs = append(strs, fmt.Sprintf("sort: [%s]", strings.Join(fields, ", ")))
+	}
+	if lt, ok := table.(sql.LimitedTable); ok {
+		if limit, offset, ok := lt.Limit(); ok {
+			strs = append(strs, fmt.Sprintf("limit: %d, offset: %d", limit, offset))
+		}
+	}
+	if st, ok := table.(sql.SampledTable); ok {
+		if sample, ok := st.Sample(); ok {
+			strs = append(strs, fmt.Sprintf("sample: %s", sample))
+		}
+	}
+	return strs
 }
 
 // Children implements the Node interface.
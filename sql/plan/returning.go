@@ -0,0 +1,132 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// Returning is the RETURNING clause of an INSERT, REPLACE or DELETE statement. Rather than the number of rows the
+// statement affects, it returns a result set of its projections evaluated on each row the statement writes: the
+// inserted rows, or the deleted ones.
+type Returning struct {
+	UnaryNode
+	// Projections are the expressions returned for each row, which refer to the columns of the target table.
+	Projections []sql.Expression
+}
+
+var _ sql.Node = (*Returning)(nil)
+var _ sql.Expressioner = (*Returning)(nil)
+var _ sql.CollationCoercible = (*Returning)(nil)
+
+// NewReturning creates a new Returning node returning the expressions given for the rows written by |child|, which
+// is an *InsertInto or a *DeleteFrom node.
+func NewReturning(expressions []sql.Expression, child sql.Node) *Returning {
+	return &Returning{
+		UnaryNode:   UnaryNode{child},
+		Projections: expressions,
+	}
+}
+
+// Target returns the node of the table the child writes rows to, whose schema the projections are evaluated against.
+func (r *Returning) Target() sql.Node {
+	n := r.Child
+	for {
+		switch node := n.(type) {
+		case *TriggerExecutor:
+			n = node.Left()
+		case *InsertInto:
+			return node.Destination
+		case *DeleteFrom:
+			return node.Child
+		default:
+			return n
+		}
+	}
+}
+
+// Schema implements the sql.Node interface.
+func (r *Returning) Schema() sql.Schema {
+	var s = make(sql.Schema, len(r.Projections))
+	for i, e := range r.Projections {
+		s[i] = transform.ExpressionToColumn(e)
+	}
+	return s
+}
+
+// Resolved implements the sql.Resolvable interface.
+func (r *Returning) Resolved() bool {
+	return r.Child.Resolved() && expression.ExpressionsResolved(r.Projections...)
+}
+
+func (r *Returning) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Returning")
+	var exprs = make([]string, len(r.Projections))
+	for i, expr := range r.Projections {
+		exprs[i] = expr.String()
+	}
+	columns := fmt.Sprintf("columns: [%s]", strings.Join(exprs, ", "))
+	_ = pr.WriteChildren(columns, r.Child.String())
+	return pr.String()
+}
+
+func (r *Returning) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Returning")
+	var exprs = make([]string, len(r.Projections))
+	for i, expr := range r.Projections {
+		exprs[i] = sql.DebugString(expr)
+	}
+	columns := fmt.Sprintf("columns: [%s]", strings.Join(exprs, ", "))
+	_ = pr.WriteChildren(columns, sql.DebugString(r.Child))
+	return pr.String()
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (r *Returning) Expressions() []sql.Expression {
+	return r.Projections
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (r *Returning) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(r.Projections) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(exprs), len(r.Projections))
+	}
+	return NewReturning(exprs, r.Child), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (r *Returning) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+	return NewReturning(r.Projections, children[0]), nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (r *Returning) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return r.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*Returning) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -39,6 +39,11 @@ type Scope struct {
 
 	inJoin       bool
 	joinSiblings []sql.Node
+	// inDefinerView is true when the scope is within the query of a view with SQL SECURITY DEFINER
+	inDefinerView bool
+	// definer is the DEFINER of the stored program whose statements are in the scope, when they're executed with its
+	// privileges
+	definer string
 }
 
 func (s *Scope) SetJoin(b bool) {
@@ -56,6 +61,32 @@ func (s *Scope) EnforcesReadOnly() bool {
 	return s != nil && s.EnforceReadOnly
 }
 
+// InDefinerView returns whether the scope is within the query of a view with SQL SECURITY DEFINER, whose privileges are
+// those of its definer rather than the user's.
+func (s *Scope) InDefinerView() bool {
+	return s != nil && s.inDefinerView
+}
+
+// Definer returns the account whose privileges the statements in the scope are executed with, when they're the
+// statements of a stored program executed with the privileges of its definer, or an empty string otherwise.
+func (s *Scope) Definer() string {
+	if s == nil {
+		return ""
+	}
+	return s.definer
+}
+
+// WithDefiner returns a new scope identical to the receiver, whose statements are executed with the privileges of the
+// account |definer|.
+func (s *Scope) WithDefiner(definer string) *Scope {
+	var ret Scope
+	if s != nil {
+		ret = *s
+	}
+	ret.definer = definer
+	return &ret
+}
+
 // OuterRelUnresolved returns true if the relations in the
 // outer scope are not qualified and resolved.
 // note: a subquery in the outer scope is itself a scope,
@@ -79,6 +110,8 @@ func (s *Scope) NewScope(node sql.Node) *Scope {
 		recursionDepth: s.recursionDepth + 1,
 		Procedures:     s.Procedures,
 		joinSiblings:   s.joinSiblings,
+		inDefinerView:  s.inDefinerView,
+		definer:        s.definer,
 	}
 }
 
@@ -118,6 +151,8 @@ func (s *Scope) NewScopeInJoin(node sql.Node) *Scope {
 		recursionDepth: s.recursionDepth + 1,
 		Procedures:     s.Procedures,
 		joinSiblings:   newNodes,
+		inDefinerView:  s.inDefinerView,
+		definer:        s.definer,
 	}
 }
 
@@ -130,6 +165,8 @@ func (s *Scope) NewScopeNoJoin() *Scope {
 		recursionDepth:  s.recursionDepth + 1,
 		Procedures:      s.Procedures,
 		EnforceReadOnly: s.EnforceReadOnly,
+		inDefinerView:   s.inDefinerView,
+		definer:         s.definer,
 	}
 }
 
@@ -154,6 +191,11 @@ func (s *Scope) NewScopeFromSubqueryAlias(sqa *SubqueryAlias) *Scope {
 			subScope.joinSiblings = append(subScope.joinSiblings, s.joinSiblings...)
 		}
 		subScope.inJoin = s.inJoin
+		subScope.inDefinerView = s.inDefinerView
+		subScope.definer = s.definer
+	}
+	if sqa.HasDefinerSecurity() {
+		subScope.inDefinerView = true
 	}
 
 	return subScope
@@ -177,6 +219,7 @@ func (s *Scope) Memo(node sql.Node) *Scope {
 		Memos:      newNodes,
 		nodes:      s.nodes,
 		Procedures: s.Procedures,
+		definer:    s.definer,
 	}
 }
 
@@ -189,6 +232,7 @@ func (s *Scope) WithMemos(memoNodes []sql.Node) *Scope {
 		Memos:      memoNodes,
 		nodes:      s.nodes,
 		Procedures: s.Procedures,
+		definer:    s.definer,
 	}
 }
 
@@ -221,6 +265,7 @@ func (s *Scope) WithProcedureCache(cache *ProcedureCache) *Scope {
 		Memos:      s.Memos,
 		nodes:      s.nodes,
 		Procedures: cache,
+		definer:    s.definer,
 	}
 }
 
@@ -0,0 +1,204 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ShardedTableEditor is the editor of a sql.ShardedTable, which fans the rows written to the table out to the editors
+// of their shards. The changes of a statement are committed in two phases: all the shards it wrote that implement
+// sql.ShardPreparer are prepared before the statement is completed on any, and if one fails to prepare, the changes
+// are discarded on all of them.
+type ShardedTableEditor struct {
+	table sql.ShardedTable
+	// keyIdx are the positions of the shard key columns in the rows of the table
+	keyIdx []int
+	// editors are the editors of the shards written so far by shard name, and shards their names in the order they
+	// were opened
+	editors map[string]sql.TableEditor
+	shards  []string
+	// inStatement is set between the beginning of a statement and its completion, and written the shards written
+	// since it began
+	inStatement bool
+	written     []string
+}
+
+var _ sql.TableEditor = (*ShardedTableEditor)(nil)
+
+// NewShardedTableEditor returns the editor of the rows of the sharded table given.
+func NewShardedTableEditor(table sql.ShardedTable) (*ShardedTableEditor, error) {
+	sch := table.Schema()
+	keyIdx := make([]int, len(table.ShardKey()))
+	for i, col := range table.ShardKey() {
+		keyIdx[i] = sch.IndexOfColName(col)
+		if keyIdx[i] < 0 {
+			return nil, sql.ErrKeyColumnDoesNotExist.New(col)
+		}
+	}
+	return &ShardedTableEditor{
+		table:   table,
+		keyIdx:  keyIdx,
+		editors: make(map[string]sql.TableEditor),
+	}, nil
+}
+
+// StatementBegin implements the sql.EditOpenerCloser interface.
+func (s *ShardedTableEditor) StatementBegin(ctx *sql.Context) {
+	s.inStatement = true
+	s.written = s.written[:0]
+}
+
+// DiscardChanges implements the sql.EditOpenerCloser interface.
+func (s *ShardedTableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	var err error
+	for _, shard := range s.written {
+		if dErr := s.editors[shard].DiscardChanges(ctx, errorEncountered); dErr != nil && err == nil {
+			err = dErr
+		}
+	}
+	s.inStatement = false
+	s.written = s.written[:0]
+	return err
+}
+
+// StatementComplete implements the sql.EditOpenerCloser interface.
+func (s *ShardedTableEditor) StatementComplete(ctx *sql.Context) error {
+	for _, shard := range s.written {
+		preparer, ok := s.editors[shard].(sql.ShardPreparer)
+		if !ok {
+			continue
+		}
+		if err := preparer.PrepareStatement(ctx); err != nil {
+			_ = s.DiscardChanges(ctx, err)
+			return err
+		}
+	}
+
+	var err error
+	for _, shard := range s.written {
+		if cErr := s.editors[shard].StatementComplete(ctx); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+	s.inStatement = false
+	s.written = s.written[:0]
+	return err
+}
+
+// Insert implements the sql.RowInserter interface.
+func (s *ShardedTableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	editor, err := s.editorOf(ctx, row)
+	if err != nil {
+		return err
+	}
+	return editor.Insert(ctx, row)
+}
+
+// Delete implements the sql.RowDeleter interface.
+func (s *ShardedTableEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	editor, err := s.editorOf(ctx, row)
+	if err != nil {
+		return err
+	}
+	return editor.Delete(ctx, row)
+}
+
+// Update implements the sql.RowUpdater interface. A row whose shard key changes is moved to its new shard.
+func (s *ShardedTableEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	oldShard, err := s.shardOf(ctx, old)
+	if err != nil {
+		return err
+	}
+	newShard, err := s.shardOf(ctx, new)
+	if err != nil {
+		return err
+	}
+	oldEditor, err := s.editor(ctx, oldShard)
+	if err != nil {
+		return err
+	}
+	if oldShard == newShard {
+		return oldEditor.Update(ctx, old, new)
+	}
+	newEditor, err := s.editor(ctx, newShard)
+	if err != nil {
+		return err
+	}
+	if err = oldEditor.Delete(ctx, old); err != nil {
+		return err
+	}
+	return newEditor.Insert(ctx, new)
+}
+
+// Close implements the sql.Closer interface.
+func (s *ShardedTableEditor) Close(ctx *sql.Context) error {
+	var err error
+	for _, shard := range s.shards {
+		if cErr := s.editors[shard].Close(ctx); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+	s.editors = make(map[string]sql.TableEditor)
+	s.shards = nil
+	return err
+}
+
+// editorOf returns the editor of the shard of |row|.
+func (s *ShardedTableEditor) editorOf(ctx *sql.Context, row sql.Row) (sql.TableEditor, error) {
+	shard, err := s.shardOf(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return s.editor(ctx, shard)
+}
+
+// shardOf returns the name of the shard of |row|.
+func (s *ShardedTableEditor) shardOf(ctx *sql.Context, row sql.Row) (string, error) {
+	key := make(sql.Row, len(s.keyIdx))
+	for i, idx := range s.keyIdx {
+		key[i] = row[idx]
+	}
+	return s.table.Shard(ctx, key)
+}
+
+// editor returns the editor of the shard named, which is opened and joins the current statement if it hasn't yet.
+func (s *ShardedTableEditor) editor(ctx *sql.Context, shard string) (sql.TableEditor, error) {
+	editor, ok := s.editors[shard]
+	if !ok {
+		var err error
+		editor, err = s.table.ShardEditor(ctx, shard)
+		if err != nil {
+			return nil, err
+		}
+		s.editors[shard] = editor
+		s.shards = append(s.shards, shard)
+	}
+	if s.inStatement && !s.inStatementShard(shard) {
+		editor.StatementBegin(ctx)
+		s.written = append(s.written, shard)
+	}
+	return editor, nil
+}
+
+// inStatementShard returns whether the shard named was written by the current statement.
+func (s *ShardedTableEditor) inStatementShard(shard string) bool {
+	for _, written := range s.written {
+		if written == shard {
+			return true
+		}
+	}
+	return false
+}
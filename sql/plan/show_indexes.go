@@ -25,6 +25,8 @@ import (
 type ShowIndexes struct {
 	UnaryNode
 	IndexesToShow []sql.Index
+	// Stats are the statistics the cardinality of the indexes is estimated from, if any.
+	Stats sql.StatsReader
 }
 
 // NewShowIndexes creates a new ShowIndexes node. The node must represent a table.
@@ -46,6 +48,7 @@ func (n *ShowIndexes) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &ShowIndexes{
 		UnaryNode:     UnaryNode{children[0]},
 		IndexesToShow: n.IndexesToShow,
+		Stats:         n.Stats,
 	}, nil
 }
 
@@ -141,5 +141,6 @@ func (n *ShowPrivileges) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, er
 		sql.Row{"ROLE_ADMIN", "Server Admin", ""},
 		sql.Row{"REPLICATION_SLAVE_ADMIN", "Server Admin", ""},
 		sql.Row{"RESOURCE_GROUP_USER", "Server Admin", ""},
+		sql.Row{"UNMASK", "Server Admin", ""},
 	), nil
 }
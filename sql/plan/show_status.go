@@ -15,9 +15,6 @@
 package plan
 
 import (
-	"fmt"
-	"sort"
-
 	"github.com/dolthub/vitess/go/sqltypes"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -27,9 +24,7 @@ import (
 const ShowStatusVariableCol = "Variable_name"
 const ShowStatusValueCol = "Value"
 
-// ShowStatus implements the SHOW STATUS MySQL command.
-// TODO: This is just a stub implementation that returns an empty set. The actual functionality needs to be implemented
-// in the future.
+// ShowStatus implements the SHOW STATUS MySQL command, which shows the values of the status variables.
 type ShowStatus struct {
 	Modifier ShowStatusModifier
 }
@@ -62,7 +57,7 @@ func (s *ShowStatus) String() string {
 // Schema implements sql.Node interface.
 func (s *ShowStatus) Schema() sql.Schema {
 	return sql.Schema{
-		{Name: ShowStatusVariableCol, Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
+		{Name: ShowStatusVariableCol, Type: types.MustCreateString(sqltypes.VarChar, 64, sql.Collation_Information_Schema_Default), Default: nil, Nullable: false},
 		{Name: ShowStatusValueCol, Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 2048), Default: nil, Nullable: false},
 	}
 }
@@ -74,27 +69,7 @@ func (s *ShowStatus) Children() []sql.Node {
 
 // RowIter implements sql.Node interface.
 func (s *ShowStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	var names []string
-	for name := range sql.SystemVariables.NewSessionMap() {
-		names = append(names, name)
-	}
-	sort.Strings(names)
-
-	var rows []sql.Row
-	for _, name := range names {
-		sysVar, val, ok := sql.SystemVariables.GetGlobal(name)
-		if !ok {
-			return nil, fmt.Errorf("missing system variable %s", name)
-		}
-
-		if s.Modifier == ShowStatusModifier_Session && sysVar.Scope == sql.SystemVariableScope_Global ||
-			s.Modifier == ShowStatusModifier_Global && sysVar.Scope == sql.SystemVariableScope_Session {
-			continue
-		}
-
-		rows = append(rows, sql.Row{name, val})
-	}
-
+	rows := sql.StatusVariableRows(ctx, s.Modifier == ShowStatusModifier_Global)
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -112,3 +87,163 @@ func (s *ShowStatus) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedO
 func (*ShowStatus) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 7
 }
+
+// StatementStatusVariable returns the name of the Com_xxx status variable that counts the statement given, or an
+// empty string if the statement isn't counted.
+func StatementStatusVariable(node sql.Node) string {
+	switch n := node.(type) {
+	case *Project, *Filter, *Limit, *Offset, *Sort, *TopN, *GroupBy, *Having, *Distinct, *OrderedDistinct, *Window,
+		*Union, *With, *RecursiveCte, *SubqueryAlias, *JoinNode, *ResolvedTable, *UnresolvedTable, *Into:
+		return "Com_select"
+	case *InsertInto:
+		_, isValues := n.Source.(*Values)
+		switch {
+		case n.IsReplace && isValues:
+			return "Com_replace"
+		case n.IsReplace:
+			return "Com_replace_select"
+		case isValues:
+			return "Com_insert"
+		default:
+			return "Com_insert_select"
+		}
+	case *Update:
+		return "Com_update"
+	case *DeleteFrom:
+		return "Com_delete"
+	case *LoadData:
+		return "Com_load"
+	case *Truncate:
+		return "Com_truncate"
+	case *CreateTable:
+		return "Com_create_table"
+	case *DropTable:
+		return "Com_drop_table"
+	case *RenameTable:
+		return "Com_rename_table"
+	case *CreateIndex:
+		return "Com_create_index"
+	case *DropIndex:
+		return "Com_drop_index"
+	case *AlterTable, *AlterAutoIncrement, *CreateCheck, *DropCheck, *DropConstraint, *AlterDefaultSet,
+		*AlterDefaultDrop, *CreateForeignKey, *DropForeignKey, *AlterIndex, *AlterPK, *AddColumn, *DropColumn,
+		*RenameColumn, *ModifyColumn, *AlterTableCollation, *AlterTableOptions:
+		return "Com_alter_table"
+	case *CreateDB:
+		return "Com_create_db"
+	case *DropDB:
+		return "Com_drop_db"
+	case *AlterDB:
+		return "Com_alter_db"
+	case *CreateView:
+		return "Com_create_view"
+	case *DropView:
+		return "Com_drop_view"
+	case *CreateTrigger:
+		return "Com_create_trigger"
+	case *DropTrigger:
+		return "Com_drop_trigger"
+	case *CreateProcedure:
+		return "Com_create_procedure"
+	case *DropProcedure:
+		return "Com_drop_procedure"
+	case *Call:
+		return "Com_call_procedure"
+	case *CreateEvent:
+		return "Com_create_event"
+	case *AlterEvent:
+		return "Com_alter_event"
+	case *DropEvent:
+		return "Com_drop_event"
+	case *CreateUser:
+		return "Com_create_user"
+	case *DropUser:
+		return "Com_drop_user"
+	case *RenameUser:
+		return "Com_rename_user"
+	case *CreateRole:
+		return "Com_create_role"
+	case *DropRole:
+		return "Com_drop_role"
+	case *Grant, *GrantRole, *GrantProxy:
+		return "Com_grant"
+	case *Revoke, *RevokeAll, *RevokeRole, *RevokeProxy:
+		return "Com_revoke"
+	case *StartTransaction:
+		return "Com_begin"
+	case *Commit:
+		return "Com_commit"
+	case *Rollback:
+		return "Com_rollback"
+	case *CreateSavepoint:
+		return "Com_savepoint"
+	case *RollbackSavepoint:
+		return "Com_rollback_to_savepoint"
+	case *ReleaseSavepoint:
+		return "Com_release_savepoint"
+	case *Set:
+		return "Com_set_option"
+	case *Use:
+		return "Com_change_db"
+	case *LockTables:
+		return "Com_lock_tables"
+	case *UnlockTables:
+		return "Com_unlock_tables"
+	case *PrepareQuery:
+		return "Com_prepare_sql"
+	case *ExecuteQuery:
+		return "Com_execute_sql"
+	case *DeallocateQuery:
+		return "Com_dealloc_sql"
+	case *AnalyzeTable:
+		return "Com_analyze"
+	case *CheckTable:
+		return "Com_check"
+	case *ChecksumTable:
+		return "Com_checksum"
+	case *OptimizeTable:
+		return "Com_optimize"
+	case *Kill:
+		return "Com_kill"
+	case *FlushPrivileges, *FlushTables, *FlushLogs:
+		return "Com_flush"
+	case *ShowDatabases:
+		return "Com_show_databases"
+	case *ShowTables:
+		return "Com_show_tables"
+	case *ShowTableStatus:
+		return "Com_show_table_status"
+	case *ShowColumns:
+		return "Com_show_fields"
+	case *ShowIndexes:
+		return "Com_show_keys"
+	case *ShowCreateTable:
+		return "Com_show_create_table"
+	case *ShowCreateDatabase:
+		return "Com_show_create_db"
+	case *ShowCreateProcedure:
+		return "Com_show_create_proc"
+	case *ShowCreateTrigger:
+		return "Com_show_create_trigger"
+	case *ShowCreateEvent:
+		return "Com_show_create_event"
+	case *ShowTriggers:
+		return "Com_show_triggers"
+	case *ShowEvents:
+		return "Com_show_events"
+	case *ShowGrants:
+		return "Com_show_grants"
+	case *ShowProcessList:
+		return "Com_show_processlist"
+	case *ShowStatus:
+		return "Com_show_status"
+	case *ShowEngineStatus:
+		return "Com_show_engine_status"
+	case *ShowVariables:
+		return "Com_show_variables"
+	case ShowWarnings:
+		return "Com_show_warnings"
+	default:
+		return ""
+	}
+}
@@ -15,13 +15,29 @@
 package plan
 
 import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // ShowWarnings is a node that shows the session warnings
 type ShowWarnings []*sql.Warning
 
+// NewShowErrors returns a ShowWarnings node that only shows the warnings with the Error level, as SHOW ERRORS does.
+func NewShowErrors(warnings []*sql.Warning) ShowWarnings {
+	errs := make(ShowWarnings, 0, len(warnings))
+	for _, w := range warnings {
+		if w.Level == "Error" {
+			errs = append(errs, w)
+		}
+	}
+	return errs
+}
+
 var _ sql.Node = (*ShowWarnings)(nil)
 var _ sql.CollationCoercible = (*ShowWarnings)(nil)
 
@@ -65,3 +81,65 @@ func (ShowWarnings) Schema() sql.Schema {
 
 // Children implements sql.Node interface. The function always returns nil.
 func (ShowWarnings) Children() []sql.Node { return nil }
+
+// IsDiagnosticsStatement returns whether the node given is a diagnostics statement, which reads the warnings left by
+// the previous statement instead of clearing them like every other statement does. These are SHOW WARNINGS, SHOW
+// ERRORS and their COUNT(*) forms, and selects of @@warning_count and @@error_count. Empty queries don't clear the
+// warnings either.
+func IsDiagnosticsStatement(node sql.Node) bool {
+	switch n := node.(type) {
+	case ShowWarnings:
+		return true
+	case Nothing:
+		return true
+	case *Limit:
+		return IsDiagnosticsStatement(n.Child)
+	case *Offset:
+		return IsDiagnosticsStatement(n.Child)
+	case *Project:
+		if len(n.Projections) == 0 {
+			return false
+		}
+		for _, e := range n.Projections {
+			if !isDiagnosticsVariable(e) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// isDiagnosticsVariable returns whether the expression given is the session variable warning_count or error_count,
+// either resolved or not.
+func isDiagnosticsVariable(e sql.Expression) bool {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+
+	var name string
+	switch e := e.(type) {
+	case *expression.SystemVar:
+		if e.Scope != sql.SystemVariableScope_Session {
+			return false
+		}
+		name = e.Name
+	case *expression.UnresolvedColumn:
+		var scope sqlparser.SetScope
+		var err error
+		if e.Table() != "" {
+			name, scope, err = sqlparser.VarScope(e.Table(), e.Name())
+		} else {
+			name, scope, err = sqlparser.VarScope(e.Name())
+		}
+		if err != nil || scope != sqlparser.SetScope_Session {
+			return false
+		}
+	default:
+		return false
+	}
+
+	name = strings.ToLower(name)
+	return name == "warning_count" || name == "error_count"
+}
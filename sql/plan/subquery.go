@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"reflect"
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -44,6 +45,14 @@ type Subquery struct {
 	// Dispose function for the cache, if any. This would appear to violate the rule that nodes must be comparable by
 	// reflect.DeepEquals, but it's safe in practice because the function is always nil until execution.
 	disposeFunc sql.DisposeFunc
+	// Indexes of the outer scope columns referenced by a correlated subquery whose results can be memoized on their
+	// values, and the length of the outer scope row they were computed against
+	correlated         sql.FastIntSet
+	correlatedScopeLen int
+	// Memoized results of a correlated subquery, keyed on the hash of its correlated values
+	correlatedCache sql.KeyValueCache
+	// Dispose function for the correlated cache, if any
+	correlatedDisposeFunc sql.DisposeFunc
 	// Mutex to guard the caches
 	cacheMu sync.Mutex
 	// TODO convert subquery expressions into apply joins
@@ -154,7 +163,7 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return s.cache[0], nil
 	}
 
-	rows, err := s.evalMultiple(ctx, row)
+	rows, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +320,7 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 		return s.cache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	result, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -327,6 +336,91 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 	return result, nil
 }
 
+// correlatedResult is the memoized result of a correlated subquery for one set of correlated values.
+type correlatedResult struct {
+	key     sql.Row
+	rows    []interface{}
+	hasRows bool
+	// partial is set when only the existence of result rows was evaluated
+	partial bool
+}
+
+// correlatedCacheSize is the maximum number of results memoized for each correlated subquery. Results are evicted
+// least recently used first, and all of them are released when the memory manager runs out of memory.
+const correlatedCacheSize = 1024
+
+// evalCorrelated returns all rows returned by a subquery, memoized on the values of the outer scope columns it
+// references when the subquery is correlated.
+func (s *Subquery) evalCorrelated(ctx *sql.Context, row sql.Row) ([]interface{}, error) {
+	key, hash, memoize, err := s.correlatedKey(row)
+	if err != nil {
+		return nil, err
+	}
+	if memoize {
+		if res, ok := s.getCorrelated(key, hash); ok && !res.partial {
+			return res.rows, nil
+		}
+	}
+
+	result, err := s.evalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoize {
+		s.putCorrelated(ctx, hash, &correlatedResult{key: key, rows: result, hasRows: len(result) > 0})
+	}
+
+	return result, nil
+}
+
+// correlatedKey returns the correlated values of the row given and their hash, and whether the results for them can
+// be memoized.
+func (s *Subquery) correlatedKey(row sql.Row) (sql.Row, uint64, bool, error) {
+	if s.correlated.Empty() || len(row) != s.correlatedScopeLen {
+		return nil, 0, false, nil
+	}
+	key := make(sql.Row, 0, s.correlated.Len())
+	s.correlated.ForEach(func(i int) {
+		key = append(key, row[i])
+	})
+	hash, err := sql.HashOf(key)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return key, hash, true, nil
+}
+
+// getCorrelated returns the memoized result for the correlated values given, if any.
+func (s *Subquery) getCorrelated(key sql.Row, hash uint64) (*correlatedResult, bool) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if s.correlatedCache == nil {
+		return nil, false
+	}
+	v, err := s.correlatedCache.Get(hash)
+	if err != nil {
+		return nil, false
+	}
+	// Different values can share a hash, so the values themselves are compared as well
+	res := v.(*correlatedResult)
+	if !reflect.DeepEqual(res.key, key) {
+		return nil, false
+	}
+	return res, true
+}
+
+// putCorrelated memoizes the result for a set of correlated values. The result is silently dropped if there is not
+// enough memory available.
+func (s *Subquery) putCorrelated(ctx *sql.Context, hash uint64, res *correlatedResult) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if s.correlatedCache == nil {
+		s.correlatedCache, s.correlatedDisposeFunc = ctx.Memory.NewLRUCache(correlatedCacheSize)
+	}
+	_ = s.correlatedCache.Put(hash, res)
+}
+
 func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, error) {
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
@@ -379,7 +473,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		return s.hashCache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	result, err := s.evalCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -413,6 +507,29 @@ func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 		return len(s.cache) > 0, nil
 	}
 
+	key, hash, memoize, err := s.correlatedKey(row)
+	if err != nil {
+		return false, err
+	}
+	if memoize {
+		if res, ok := s.getCorrelated(key, hash); ok {
+			return res.hasRows, nil
+		}
+	}
+
+	hasRows, err := s.hasResultRow(ctx, row)
+	if err != nil {
+		return false, err
+	}
+
+	if memoize {
+		s.putCorrelated(ctx, hash, &correlatedResult{key: key, hasRows: hasRows, partial: true})
+	}
+
+	return hasRows, nil
+}
+
+func (s *Subquery) hasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
 	q, _, err := transform.Node(s.Query, prependRowInPlan(row))
@@ -550,12 +667,30 @@ func (s *Subquery) CanCacheResults() bool {
 	return s.canCacheResults
 }
 
+// WithCorrelatedCache returns the subquery with its results memoized on the values of the outer scope columns given,
+// for outer scope rows of the length given.
+func (s *Subquery) WithCorrelatedCache(cols sql.FastIntSet, scopeLen int) *Subquery {
+	ns := *s
+	ns.correlated = cols.Copy()
+	ns.correlatedScopeLen = scopeLen
+	return &ns
+}
+
+// CorrelatedColumns returns the indexes of the outer scope columns the results of this subquery are memoized on, if any.
+func (s *Subquery) CorrelatedColumns() sql.FastIntSet {
+	return s.correlated
+}
+
 // Dispose implements sql.Disposable
 func (s *Subquery) Dispose() {
 	if s.disposeFunc != nil {
 		s.disposeFunc()
 		s.disposeFunc = nil
 	}
+	if s.correlatedDisposeFunc != nil {
+		s.correlatedDisposeFunc()
+		s.correlatedCache, s.correlatedDisposeFunc = nil, nil
+	}
 	disposeNode(s.Query)
 }
 
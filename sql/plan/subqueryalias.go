@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -32,10 +33,20 @@ type SubqueryAlias struct {
 	CanCacheResults      bool
 	CacheableCTESource   bool
 	IsLateral            bool
+	// ViewDatabase, Definer and SecurityType are the database, the DEFINER and the SQL SECURITY attribute of the view
+	// this node is the definition of. They're empty for derived tables.
+	ViewDatabase string
+	Definer      string
+	SecurityType string
+	// Algorithm is the ALGORITHM attribute of the view this node is the definition of. Views with the TEMPTABLE
+	// algorithm aren't merged into the queries reading them.
+	Algorithm string
+	comment   string
 }
 
 var _ sql.Node = (*SubqueryAlias)(nil)
 var _ sql.CollationCoercible = (*SubqueryAlias)(nil)
+var _ sql.CommentedNode = (*SubqueryAlias)(nil)
 
 // NewSubqueryAlias creates a new SubqueryAlias node.
 func NewSubqueryAlias(name, textDefinition string, node sql.Node) *SubqueryAlias {
@@ -55,6 +66,18 @@ func (sq *SubqueryAlias) AsView(createViewStmt string) *sql.View {
 // Name implements the Table interface.
 func (sq *SubqueryAlias) Name() string { return sq.name }
 
+// WithComment implements sql.CommentedNode
+func (sq *SubqueryAlias) WithComment(comment string) sql.Node {
+	ret := *sq
+	ret.comment = comment
+	return &ret
+}
+
+// Comment implements sql.CommentedNode
+func (sq *SubqueryAlias) Comment() string {
+	return sq.comment
+}
+
 // Schema implements the Node interface.
 func (sq *SubqueryAlias) Schema() sql.Schema {
 	childSchema := sq.Child.Schema()
@@ -81,8 +104,34 @@ func (sq *SubqueryAlias) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &nn, nil
 }
 
-// CheckPrivileges implements the interface sql.Node.
+// WithViewSecurity returns a copy of this node as the definition of a view of the database given, with the DEFINER and
+// SQL SECURITY attributes given.
+func (sq *SubqueryAlias) WithViewSecurity(database, definer, securityType string) *SubqueryAlias {
+	ret := *sq
+	ret.ViewDatabase = database
+	ret.Definer = definer
+	ret.SecurityType = securityType
+	return &ret
+}
+
+// HasDefinerSecurity returns whether this node is the definition of a view with SQL SECURITY DEFINER, whose tables are
+// read with the privileges of its definer.
+func (sq *SubqueryAlias) HasDefinerSecurity() bool {
+	return strings.EqualFold(sq.SecurityType, "DEFINER") && sq.Definer != ""
+}
+
+// CheckPrivileges implements the interface sql.Node. The tables of a view with SQL SECURITY DEFINER are read with the
+// privileges of its definer, and the user only needs to be able to select from the view.
 func (sq *SubqueryAlias) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if !sq.HasDefinerSecurity() {
+		return sq.Child.CheckPrivileges(ctx, opChecker)
+	}
+	if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sq.ViewDatabase, sq.name, "", sql.PrivilegeType_Select)) {
+		return false
+	}
+	if definerChecker, ok := opChecker.(sql.DefinerPrivilegedOperationChecker); ok {
+		opChecker = definerChecker.DefinerChecker(sq.Definer)
+	}
 	return sq.Child.CheckPrivileges(ctx, opChecker)
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -67,8 +68,16 @@ func (tc *TableCopier) ProcessCreateTable(ctx *sql.Context, b sql.NodeExecBuilde
 		return tc.CopyTableOver(ctx, tc.Source.Schema()[0].Source, table.Name())
 	}
 
+	source := tc.Source
+	if sch := table.Schema(); len(sch) == len(source.Schema())+1 && sch[0].IsGeneratedInvisiblePrimaryKey() {
+		source, err = withGeneratedInvisiblePrimaryKey(ctx, table, source)
+		if err != nil {
+			return sql.RowsToRowIter(), err
+		}
+	}
+
 	// TODO: Improve parsing for CREATE TABLE SELECT to allow for IGNORE/REPLACE and custom specs
-	ii := NewInsertInto(tc.db, NewResolvedTable(table, tc.db, nil), tc.Source, tc.options.replace, nil, nil, tc.options.ignore)
+	ii := NewInsertInto(tc.db, NewResolvedTable(table, tc.db, nil), source, tc.options.replace, nil, nil, tc.options.ignore)
 
 	// Wrap the insert into a row update accumulator
 	roa := NewRowUpdateAccumulator(ii, UpdateTypeInsert)
@@ -76,6 +85,20 @@ func (tc *TableCopier) ProcessCreateTable(ctx *sql.Context, b sql.NodeExecBuilde
 	return b.Build(ctx, roa, row)
 }
 
+// withGeneratedInvisiblePrimaryKey returns |source| with a generated value for the invisible primary key of |table|
+// prepended to each of its rows.
+func withGeneratedInvisiblePrimaryKey(ctx *sql.Context, table sql.Table, source sql.Node) (sql.Node, error) {
+	autoInc, err := expression.NewAutoIncrement(ctx, table, expression.NewLiteral(nil, types.Null))
+	if err != nil {
+		return nil, err
+	}
+	projections := []sql.Expression{autoInc}
+	for i, col := range source.Schema() {
+		projections = append(projections, expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable))
+	}
+	return NewProject(projections, source), nil
+}
+
 // createTableSelectCanBeCopied determines whether the newly created table's data can just be copied from the Source table
 func (tc *TableCopier) createTableSelectCanBeCopied(tableNode sql.Table) bool {
 	// The differences in LIMIT between integrators prevent us from using a copy
@@ -28,6 +28,9 @@ type tableEditorIter struct {
 	openerClosers    []sql.EditOpenerCloser
 	inner            sql.RowIter
 	errorEncountered error
+	// rowEventMark is the mark of the session's row events when the statement began, to discard the row events of the
+	// statement if it fails
+	rowEventMark int
 }
 
 var _ sql.RowIter = (*tableEditorIter)(nil)
@@ -51,6 +54,7 @@ func (s *tableEditorIter) Next(ctx *sql.Context) (sql.Row, error) {
 		for _, openerCloser := range s.openerClosers {
 			openerCloser.StatementBegin(ctx)
 		}
+		s.rowEventMark = ctx.RowEvents().Mark(ctx)
 	})
 	row, err := s.inner.Next(ctx)
 	if err != nil && err != io.EOF {
@@ -77,6 +81,7 @@ func (s *tableEditorIter) Close(ctx *sql.Context) error {
 				err = tempErr
 			}
 		}
+		ctx.RowEvents().DiscardSince(ctx, s.rowEventMark)
 	} else {
 		for _, openerCloser := range s.openerClosers {
 			tempErr := openerCloser.StatementComplete(ctx)
@@ -114,8 +119,10 @@ func NewCheckpointingTableEditorIter(wrappedIter sql.RowIter, table sql.EditOpen
 
 func (c checkpointingTableEditorIter) Next(ctx *sql.Context) (sql.Row, error) {
 	c.editIter.StatementBegin(ctx)
+	rowEventMark := ctx.RowEvents().Mark(ctx)
 	row, err := c.inner.Next(ctx)
 	if err != nil && err != io.EOF {
+		ctx.RowEvents().DiscardSince(ctx, rowEventMark)
 		if dErr := c.editIter.DiscardChanges(ctx, err); dErr != nil {
 			return nil, dErr
 		}
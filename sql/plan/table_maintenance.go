@@ -0,0 +1,231 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ChecksumTable represents the CHECKSUM TABLE statement, which returns a checksum of the contents of each table given.
+type ChecksumTable struct {
+	Tables []sql.DbTable
+	// Quick is whether only the checksums maintained by sql.ChecksumTable tables are returned, given by the QUICK
+	// option. The checksum of other tables is NULL.
+	Quick bool
+	// Extended is whether the checksums are always computed from the rows of the tables, given by the EXTENDED option.
+	Extended bool
+	Catalog  sql.Catalog
+}
+
+var _ sql.Node = (*ChecksumTable)(nil)
+var _ sql.CollationCoercible = (*ChecksumTable)(nil)
+
+var checksumTableSchema = sql.Schema{
+	{Name: "Table", Type: types.LongText},
+	{Name: "Checksum", Type: types.Int64, Nullable: true},
+}
+
+// NewChecksumTable returns a new ChecksumTable node.
+func NewChecksumTable(tables []sql.DbTable, quick, extended bool) *ChecksumTable {
+	return &ChecksumTable{Tables: tables, Quick: quick, Extended: extended}
+}
+
+// Schema implements the interface sql.Node.
+func (n *ChecksumTable) Schema() sql.Schema {
+	return checksumTableSchema
+}
+
+// String implements the interface sql.Node.
+func (n *ChecksumTable) String() string {
+	s := "CHECKSUM TABLE " + maintenanceTableNames(n.Tables)
+	if n.Quick {
+		s += " QUICK"
+	} else if n.Extended {
+		s += " EXTENDED"
+	}
+	return s
+}
+
+// Resolved implements the interface sql.Node.
+func (n *ChecksumTable) Resolved() bool {
+	return true
+}
+
+// Children implements the interface sql.Node.
+func (n *ChecksumTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *ChecksumTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (n *ChecksumTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return maintenanceTablePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ChecksumTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// CheckTable represents the CHECK TABLE statement, which checks each table given for errors. Only the tables that
+// implement sql.ConsistencyCheckTable are checked.
+type CheckTable struct {
+	Tables []sql.DbTable
+	// Options are the options of the statement, such as QUICK or EXTENDED, which are accepted for compatibility but
+	// don't change how the tables are checked.
+	Options []string
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*CheckTable)(nil)
+var _ sql.CollationCoercible = (*CheckTable)(nil)
+
+// NewCheckTable returns a new CheckTable node.
+func NewCheckTable(tables []sql.DbTable, options []string) *CheckTable {
+	return &CheckTable{Tables: tables, Options: options}
+}
+
+// Schema implements the interface sql.Node.
+func (n *CheckTable) Schema() sql.Schema {
+	return analyzeSchema
+}
+
+// String implements the interface sql.Node.
+func (n *CheckTable) String() string {
+	s := "CHECK TABLE " + maintenanceTableNames(n.Tables)
+	if len(n.Options) > 0 {
+		s += " " + strings.Join(n.Options, " ")
+	}
+	return s
+}
+
+// Resolved implements the interface sql.Node.
+func (n *CheckTable) Resolved() bool {
+	return true
+}
+
+// Children implements the interface sql.Node.
+func (n *CheckTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *CheckTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (n *CheckTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return maintenanceTablePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CheckTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// OptimizeTable represents the OPTIMIZE TABLE statement, which rebuilds the storage of each table given. Only the
+// tables that implement sql.OptimizableTable are rebuilt.
+type OptimizeTable struct {
+	Tables []sql.DbTable
+	// NoWriteToBinlog is whether the statement has the NO_WRITE_TO_BINLOG or LOCAL option.
+	NoWriteToBinlog bool
+	Catalog         sql.Catalog
+}
+
+var _ sql.Node = (*OptimizeTable)(nil)
+var _ sql.CollationCoercible = (*OptimizeTable)(nil)
+
+// NewOptimizeTable returns a new OptimizeTable node.
+func NewOptimizeTable(tables []sql.DbTable, noWriteToBinlog bool) *OptimizeTable {
+	return &OptimizeTable{Tables: tables, NoWriteToBinlog: noWriteToBinlog}
+}
+
+// Schema implements the interface sql.Node.
+func (n *OptimizeTable) Schema() sql.Schema {
+	return analyzeSchema
+}
+
+// String implements the interface sql.Node.
+func (n *OptimizeTable) String() string {
+	if n.NoWriteToBinlog {
+		return "OPTIMIZE NO_WRITE_TO_BINLOG TABLE " + maintenanceTableNames(n.Tables)
+	}
+	return "OPTIMIZE TABLE " + maintenanceTableNames(n.Tables)
+}
+
+// Resolved implements the interface sql.Node.
+func (n *OptimizeTable) Resolved() bool {
+	return true
+}
+
+// Children implements the interface sql.Node.
+func (n *OptimizeTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (n *OptimizeTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (n *OptimizeTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return maintenanceTablePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*OptimizeTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func maintenanceTableNames(tables []sql.DbTable) string {
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		names[i] = t.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// maintenanceTablePrivileges returns whether the user has the privileges given on all the tables given, which are in
+// the current database when they aren't qualified.
+func maintenanceTablePrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, tables []sql.DbTable, privileges ...sql.PrivilegeType) bool {
+	for _, t := range tables {
+		db := t.Db
+		if db == "" {
+			db = ctx.GetCurrentDatabase()
+		}
+		if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(db, t.Table, "", privileges...)) {
+			return false
+		}
+	}
+	return true
+}
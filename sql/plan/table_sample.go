@@ -0,0 +1,235 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// TableSample is a node that returns a random sample of the rows of its child, a table, as given by a TABLESAMPLE
+// clause. Tables that implement sql.SampledTable sample their own rows instead, in which case the node is removed.
+type TableSample struct {
+	UnaryNode
+	Sample sql.TableSample
+	name   string
+}
+
+var _ sql.Node = (*TableSample)(nil)
+var _ sql.NameableNode = (*TableSample)(nil)
+var _ sql.CollationCoercible = (*TableSample)(nil)
+
+// NewTableSample creates a new TableSample node returning the |sample| of the rows of |child|.
+func NewTableSample(child sql.Node, sample sql.TableSample) *TableSample {
+	var name string
+	if nameable, ok := child.(sql.Nameable); ok {
+		name = nameable.Name()
+	}
+	return &TableSample{
+		UnaryNode: UnaryNode{Child: child},
+		Sample:    sample,
+		name:      name,
+	}
+}
+
+// Name implements the sql.Nameable interface. Returns the name of the table sampled.
+func (t *TableSample) Name() string {
+	return t.name
+}
+
+// WithChildren implements the Node interface.
+func (t *TableSample) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 1)
+	}
+	nt := *t
+	nt.Child = children[0]
+	return &nt, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (t *TableSample) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return t.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (t *TableSample) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, t.Child)
+}
+
+func (t *TableSample) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("TableSample(%s)", t.Sample)
+	_ = pr.WriteChildren(t.Child.String())
+	return pr.String()
+}
+
+func (t *TableSample) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("TableSample(%s)", t.Sample)
+	_ = pr.WriteChildren(sql.DebugString(t.Child))
+	return pr.String()
+}
+
+// TableSampleFunction is the tablesample table function, which returns a random sample of the rows of a table:
+//
+//	tablesample(table, method, percent[, seed])
+//
+// where |table| is the name of the table, optionally qualified by its database as in 'db.table', |method| is
+// BERNOULLI or SYSTEM, |percent| is the probability from 0 to 100 that each row or block of rows is sampled, and the
+// same rows are returned for the same |seed| if one is given. A NULL percentage samples no rows. The TABLESAMPLE
+// clause of a table reference is rewritten to a call of this function by the parser.
+type TableSampleFunction struct {
+	cat sql.Catalog
+}
+
+var _ sql.TableFunction = (*TableSampleFunction)(nil)
+var _ sql.CollationCoercible = (*TableSampleFunction)(nil)
+
+// TableSampleFunctionName is the name of the table function TableSampleFunction.
+const TableSampleFunctionName = "tablesample"
+
+// NewTableSampleFunction returns the tablesample table function, which resolves the tables it samples with |cat|.
+func NewTableSampleFunction(cat sql.Catalog) *TableSampleFunction {
+	return &TableSampleFunction{cat: cat}
+}
+
+// NewInstance implements the sql.TableFunction interface.
+func (f *TableSampleFunction) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	if len(args) < 3 || len(args) > 4 {
+		return nil, sql.ErrInvalidArgumentNumber.New(f.Name(), "3 or 4", len(args))
+	}
+	vals := make([]interface{}, len(args))
+	for i, arg := range args {
+		if !arg.Resolved() {
+			return nil, sql.ErrInvalidArgument.New(f.Name())
+		}
+		v, err := arg.Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+
+	name, ok := vals[0].(string)
+	if !ok {
+		return nil, sql.ErrInvalidArgumentDetails.New(f.Name(), fmt.Sprintf("%v is not a table name", vals[0]))
+	}
+	var sample sql.TableSample
+	switch method, _ := vals[1].(string); strings.ToUpper(method) {
+	case "BERNOULLI":
+		sample.Method = sql.TableSampleBernoulli
+	case "SYSTEM":
+		sample.Method = sql.TableSampleSystem
+	default:
+		return nil, sql.ErrInvalidTableSampleMethod.New(vals[1])
+	}
+	if vals[2] != nil {
+		percent, _, err := types.Float64.Convert(vals[2])
+		if err != nil || percent.(float64) < 0 || percent.(float64) > 100 {
+			return nil, sql.ErrInvalidTableSamplePercent.New(vals[2])
+		}
+		sample.Percent = percent.(float64)
+	}
+	if len(vals) == 4 && vals[3] != nil {
+		seed, _, err := types.Int64.Convert(vals[3])
+		if err != nil {
+			return nil, err
+		}
+		sample.Repeatable, sample.Seed = true, seed.(int64)
+	}
+
+	var table sql.Table
+	var err error
+	if dbName, tableName, ok := strings.Cut(name, "."); ok {
+		table, db, err = f.cat.Table(ctx, dbName, tableName)
+	} else if db == nil || db.Name() == "" {
+		return nil, sql.ErrNoDatabaseSelected.New()
+	} else {
+		table, db, err = f.cat.DatabaseTable(ctx, db, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewTableSample(NewResolvedTable(table, db, nil), sample), nil
+}
+
+// Name implements the sql.Nameable interface.
+func (f *TableSampleFunction) Name() string {
+	return TableSampleFunctionName
+}
+
+// Database implements the sql.Databaser interface.
+func (f *TableSampleFunction) Database() sql.Database {
+	return nil
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (f *TableSampleFunction) WithDatabase(_ sql.Database) (sql.Node, error) {
+	return f, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (f *TableSampleFunction) Expressions() []sql.Expression {
+	return nil
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (f *TableSampleFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(exprs), 0)
+	}
+	return f, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (f *TableSampleFunction) Resolved() bool {
+	return true
+}
+
+// Schema implements the sql.Node interface.
+func (f *TableSampleFunction) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (f *TableSampleFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (f *TableSampleFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+	return f, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (f *TableSampleFunction) CheckPrivileges(_ *sql.Context, _ sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*TableSampleFunction) CollationCoercibility(_ *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (f *TableSampleFunction) String() string {
+	return "tablesample()"
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // TriggerBeginEndBlock represents a BEGIN/END block specific to TRIGGER execution, which has special considerations
@@ -29,6 +30,7 @@ var _ sql.DebugStringer = (*TriggerBeginEndBlock)(nil)
 var _ sql.CollationCoercible = (*TriggerBeginEndBlock)(nil)
 var _ RepresentsLabeledBlock = (*TriggerBeginEndBlock)(nil)
 var _ RepresentsScope = (*TriggerBeginEndBlock)(nil)
+var _ expression.ProcedureReferencable = (*TriggerBeginEndBlock)(nil)
 
 // NewTriggerBeginEndBlock creates a new *TriggerBeginEndBlock node.
 func NewTriggerBeginEndBlock(block *BeginEndBlock) *TriggerBeginEndBlock {
@@ -39,7 +41,14 @@ func NewTriggerBeginEndBlock(block *BeginEndBlock) *TriggerBeginEndBlock {
 
 // WithChildren implements the sql.Node interface.
 func (b *TriggerBeginEndBlock) WithChildren(children ...sql.Node) (sql.Node, error) {
-	return NewTriggerBeginEndBlock(NewBeginEndBlock(b.BeginEndBlock.Label, NewBlock(children))), nil
+	block := NewBeginEndBlock(b.BeginEndBlock.Label, NewBlock(children))
+	block.Pref = b.Pref
+	return NewTriggerBeginEndBlock(block), nil
+}
+
+// WithParamReference implements the interface expression.ProcedureReferencable.
+func (b *TriggerBeginEndBlock) WithParamReference(pRef *expression.ProcedureReference) sql.Node {
+	return NewTriggerBeginEndBlock(b.BeginEndBlock.WithParamReference(pRef).(*BeginEndBlock))
 }
 
 // CheckPrivileges implements the interface sql.Node.
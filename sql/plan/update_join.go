@@ -15,7 +15,10 @@
 package plan
 
 import (
+	"sort"
+
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
 type UpdateJoin struct {
@@ -109,13 +112,44 @@ func (u *updatableJoinTable) Collation() sql.CollationID {
 
 // Updater implements the sql.UpdatableTable interface.
 func (u *updatableJoinTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	tableNames := make([]string, 0, len(u.updaters))
+	for tableName := range u.updaters {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
 	return &updatableJoinUpdater{
-		updaterMap: u.updaters,
-		schemaMap:  RecreateTableSchemaFromJoinSchema(u.joinNode.Schema()),
-		joinSchema: u.joinNode.Schema(),
+		updaterMap:     u.updaters,
+		tableNames:     tableNames,
+		schemaMap:      RecreateTableSchemaFromJoinSchema(u.joinNode.Schema()),
+		joinSchema:     u.joinNode.Schema(),
+		resolvedTables: resolvedTablesByName(u.joinNode),
 	}
 }
 
+// resolvedTablesByName returns the tables of |joinNode|, keyed by the name that their columns have in its schema.
+func resolvedTablesByName(joinNode sql.Node) map[string]*ResolvedTable {
+	tables := make(map[string]*ResolvedTable)
+	transform.Inspect(joinNode, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *ResolvedTable:
+			tables[n.Name()] = n
+		case *IndexedTableAccess:
+			tables[n.ResolvedTable.Name()] = n.ResolvedTable
+		case *TableAlias:
+			switch child := n.Child.(type) {
+			case *ResolvedTable:
+				tables[n.Name()] = child
+			case *IndexedTableAccess:
+				tables[n.Name()] = child.ResolvedTable
+			}
+			return false
+		}
+		return true
+	})
+	return tables
+}
+
 // RecreateTableSchemaFromJoinSchema takes a join schema and recreates each individual tables schema.
 func RecreateTableSchemaFromJoinSchema(joinSchema sql.Schema) map[string]sql.Schema {
 	ret := make(map[string]sql.Schema, 0)
@@ -136,8 +170,12 @@ func RecreateTableSchemaFromJoinSchema(joinSchema sql.Schema) map[string]sql.Sch
 // table.
 type updatableJoinUpdater struct {
 	updaterMap map[string]sql.RowUpdater
+	// tableNames are the keys of updaterMap in order, so that the tables of a row are updated in a stable order
+	tableNames []string
 	schemaMap  map[string]sql.Schema
 	joinSchema sql.Schema
+	// resolvedTables are the tables of the join, for the row events of the updates
+	resolvedTables map[string]*ResolvedTable
 }
 
 var _ sql.RowUpdater = (*updatableJoinUpdater)(nil)
@@ -179,7 +217,8 @@ func (u *updatableJoinUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row
 	tableToOldRowMap := SplitRowIntoTableRowMap(old, u.joinSchema)
 	tableToNewRowMap := SplitRowIntoTableRowMap(new, u.joinSchema)
 
-	for tableName, updater := range u.updaterMap {
+	for _, tableName := range u.tableNames {
+		updater := u.updaterMap[tableName]
 		oldRow := tableToOldRowMap[tableName]
 		newRow := tableToNewRowMap[tableName]
 		schema := u.schemaMap[tableName]
@@ -191,16 +230,34 @@ func (u *updatableJoinUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row
 
 		if !eq {
 			err = updater.Update(ctx, oldRow, newRow)
-		}
-
-		if err != nil {
-			return err
+			if err != nil {
+				return err
+			}
+			u.recordRowEvent(ctx, tableName, schema, oldRow, newRow)
 		}
 	}
 
 	return nil
 }
 
+// recordRowEvent records the update of a row of the table given to the context's row event publisher.
+func (u *updatableJoinUpdater) recordRowEvent(ctx *sql.Context, tableName string, schema sql.Schema, oldRow, newRow sql.Row) {
+	ev := sql.RowEvent{
+		Type:   sql.RowEventType_Update,
+		Table:  tableName,
+		Schema: schema,
+		Before: oldRow,
+		After:  newRow,
+	}
+	if rt, ok := u.resolvedTables[tableName]; ok {
+		ev.Table = rt.Name()
+		if rt.Database != nil {
+			ev.Database = rt.Database.Name()
+		}
+	}
+	ctx.RowEvents().Record(ctx, ev)
+}
+
 // SplitRowIntoTableRowMap takes a join table row and breaks into a map of tables and their respective row.
 func SplitRowIntoTableRowMap(row sql.Row, joinSchema sql.Schema) map[string]sql.Row {
 	ret := make(map[string]sql.Row)
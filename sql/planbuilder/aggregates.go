@@ -98,7 +98,7 @@ func (b *PlanBuilder) buildGroupingCols(fromScope, projScope *scope, groupby ast
 					break
 				}
 			}
-			if col.table == "" {
+			if col.col != "" {
 				break
 			}
 			// fallback to alias in targets
@@ -41,7 +41,7 @@ func (b *PlanBuilder) buildMultiAlterDDL(inScope *scope, query string, c *ast.Mu
 		statements[i] = alterScope.node
 	}
 	outScope = inScope.push()
-	outScope.node = plan.NewBlock(statements)
+	outScope.node = plan.NewAlterTable(statements, sql.AlterAlgorithm_Default, sql.AlterLock_Default)
 	return
 }
 
@@ -150,9 +150,9 @@ func (b *PlanBuilder) buildUpdate(inScope *scope, u *ast.Update) (outScope *scop
 	return
 }
 
-func (b *PlanBuilder) buildInto(inScope *scope, into *ast.Into, node sql.Node) (sql.Node, error) {
+func (b *PlanBuilder) buildInto(inScope *scope, into *ast.Into) {
 	if into.Outfile != "" || into.Dumpfile != "" {
-		return nil, sql.ErrUnsupportedSyntax.New("select into files is not supported yet")
+		b.handleErr(sql.ErrUnsupportedSyntax.New("select into files is not supported yet"))
 	}
 
 	vars := make([]sql.Expression, len(into.Variables))
@@ -163,5 +163,5 @@ func (b *PlanBuilder) buildInto(inScope *scope, into *ast.Into, node sql.Node) (
 			vars[i] = expression.NewUnresolvedProcedureParam(val.String())
 		}
 	}
-	return plan.NewInto(node, vars), nil
+	inScope.node = plan.NewInto(inScope.node, vars)
 }
@@ -59,11 +59,26 @@ func (b *PlanBuilder) isLateral(te ast.TableExpr) bool {
 		return true
 	case *ast.AliasedTableExpr:
 		return t.Lateral
+	case *ast.TableFuncExpr:
+		return tableFuncReferencesColumns(t)
 	default:
 		return false
 	}
 }
 
+// tableFuncReferencesColumns returns whether the arguments of a table function reference any columns, which makes it
+// lateral to the tables before it.
+func tableFuncReferencesColumns(t *ast.TableFuncExpr) bool {
+	var found bool
+	_ = ast.Walk(func(node ast.SQLNode) (bool, error) {
+		if _, ok := node.(*ast.ColName); ok {
+			found = true
+		}
+		return !found, nil
+	}, t.Exprs)
+	return found
+}
+
 func (b *PlanBuilder) buildJoin(inScope *scope, te *ast.JoinTableExpr) (outScope *scope) {
 	//TODO build individual table expressions
 	// collect column  definitions
@@ -89,7 +104,7 @@ func (b *PlanBuilder) buildJoin(inScope *scope, te *ast.JoinTableExpr) (outScope
 
 	// cross join
 	if te.Condition.On == nil || te.Condition.On == ast.BoolVal(true) {
-		if rast, ok := te.RightExpr.(*ast.AliasedTableExpr); ok && rast.Lateral {
+		if _, ok := te.RightExpr.(*ast.JSONTableExpr); !ok && b.isLateral(te.RightExpr) {
 			outScope.node = plan.NewJoin(leftScope.node, rightScope.node, plan.JoinTypeLateralCross, nil)
 		} else {
 			outScope.node = plan.NewCrossJoin(leftScope.node, rightScope.node)
@@ -239,7 +254,14 @@ func (b *PlanBuilder) buildDataSource(inScope *scope, te ast.TableExpr) (outScop
 				renameCols = columnsToStrings(e.Columns)
 				vdt = vdt.WithColumns(renameCols)
 			}
-			b.renameSource(outScope, t.As.String(), renameCols)
+			for _, c := range vdt.Schema() {
+				outScope.newColumn(scopeColumn{
+					table:    strings.ToLower(t.As.String()),
+					col:      strings.ToLower(c.Name),
+					typ:      c.Type,
+					nullable: c.Nullable,
+				})
+			}
 			outScope.node = vdt
 			return
 		default:
@@ -397,21 +419,28 @@ func (b *PlanBuilder) buildTableFunc(inScope *scope, t *ast.TableFuncExpr) (outS
 
 	database := b.currentDb()
 
-	var hasBindVarArgs bool
-	for _, arg := range utf.Arguments {
-		if _, ok := arg.(*expression.BindVar); ok {
-			hasBindVarArgs = true
-			break
+	// Arguments that reference columns of the tables before the function or of an outer query, or bindvars, can only
+	// be evaluated when its rows are iterated, which lateral table functions support. The others are instantiated then
+	// if their arguments only have bindvars.
+	var deferred bool
+	if hasColumnArgs, hasBindVarArgs := lateralArgs(utf.Arguments); hasColumnArgs || hasBindVarArgs {
+		if ltf, ok := tableFunction.(sql.LateralTableFunction); !ok || !ltf.AcceptsLateralArguments() {
+			if hasColumnArgs {
+				b.handleErr(sql.ErrTableFunctionNotLateral.New(utf.Name()))
+			}
+			deferred = true
 		}
 	}
 
 	outScope = inScope.push()
 	outScope.ast = t
-	if hasBindVarArgs {
-		// TODO deferred tableFunction
-	}
 
-	newInstance, err := tableFunction.NewInstance(b.ctx, database, utf.Arguments)
+	var newInstance sql.Node
+	if deferred {
+		newInstance, err = plan.NewDeferredTableFunction(b.ctx, tableFunction, database, utf.Arguments)
+	} else {
+		newInstance, err = tableFunction.NewInstance(b.ctx, database, utf.Arguments)
+	}
 	if err != nil {
 		b.handleErr(err)
 	}
@@ -428,7 +457,7 @@ func (b *PlanBuilder) buildTableFunc(inScope *scope, t *ast.TableFuncExpr) (outS
 	for _, c := range newAlias.Schema() {
 		outScope.newColumn(scopeColumn{
 			db:    database.Name(),
-			table: "",
+			table: strings.ToLower(newAlias.Name()),
 			col:   c.Name,
 			typ:   c.Type,
 		})
@@ -436,6 +465,23 @@ func (b *PlanBuilder) buildTableFunc(inScope *scope, t *ast.TableFuncExpr) (outS
 	return
 }
 
+// lateralArgs returns whether any of the arguments of a table function references a column, and whether any has a
+// bindvar.
+func lateralArgs(args []sql.Expression) (hasColumnArgs, hasBindVarArgs bool) {
+	for _, arg := range args {
+		transform.InspectExpr(arg, func(e sql.Expression) bool {
+			switch e.(type) {
+			case *expression.GetField:
+				hasColumnArgs = true
+			case *expression.BindVar:
+				hasBindVarArgs = true
+			}
+			return false
+		})
+	}
+	return hasColumnArgs, hasBindVarArgs
+}
+
 func (b *PlanBuilder) buildJSONTableCols(inScope *scope, jtSpec *ast.JSONTableSpec) []plan.JSONTableCol {
 	var cols []plan.JSONTableCol
 	for _, jtColDef := range jtSpec.Columns {
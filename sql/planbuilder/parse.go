@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	oldparse "github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -48,20 +49,17 @@ func parse(ctx *sql.Context, cat sql.Catalog, query string, multi bool) (sql.Nod
 	var parsed string
 	var remainder string
 
-	parsed = s
+	// the statement is parsed as it's rewritten, but its text is given back as it was written
+	original := s
+	s, dynamicPrivileges := oldparse.RewriteQuery(ctx, s)
+	parsed = original
 	if !multi {
 		stmt, err = sqlparser.Parse(s)
 	} else {
 		var ri int
 		stmt, ri, err = sqlparser.ParseOne(s)
 		if ri != 0 && ri < len(s) {
-			parsed = s[:ri]
-			parsed = strings.TrimSpace(parsed)
-			// trim spaces and empty statements
-			parsed = strings.TrimRightFunc(parsed, func(r rune) bool {
-				return r == ';' || unicode.IsSpace(r)
-			})
-			remainder = s[ri:]
+			parsed, remainder = oldparse.OriginalStatement(original, s, ri)
 		}
 	}
 
@@ -75,6 +73,9 @@ func parse(ctx *sql.Context, cat sql.Catalog, query string, multi bool) (sql.Nod
 
 	b := &PlanBuilder{ctx: ctx, cat: cat}
 	outScope := b.build(nil, stmt, s)
+	if dynamicPrivileges != nil {
+		outScope.node = oldparse.RestoreDynamicPrivileges(outScope.node, dynamicPrivileges)
+	}
 
 	return outScope.node, parsed, remainder, err
 }
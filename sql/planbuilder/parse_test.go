@@ -1604,6 +1604,11 @@ func (t *testCatalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	panic("implement me")
 }
 
+func (t *testCatalog) HasLockedTables(id uint32) bool {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (t *testCatalog) Statistics(ctx *sql.Context) (sql.StatsReadWriter, error) {
 	//TODO implement me
 	panic("implement me")
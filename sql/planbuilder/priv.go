@@ -342,6 +342,11 @@ func (b *PlanBuilder) buildFlush(inScope *scope, f *sqlparser.Flush) (sql.Node,
 	switch strings.ToLower(f.Option.Name) {
 	case "privileges":
 		return plan.NewFlushPrivileges(writesToBinlog), nil
+	case "logs":
+		return plan.NewFlushLogs(sql.LogTypes), nil
+	case "binary logs", "engine logs", "error logs", "general logs", "relay logs", "slow logs":
+		logType := strings.ToUpper(strings.Fields(f.Option.Name)[0])
+		return plan.NewFlushLogs([]sql.LogType{sql.LogType(logType)}), nil
 	default:
 		return nil, fmt.Errorf("%s not supported", f.Option.Name)
 	}
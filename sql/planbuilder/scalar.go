@@ -12,6 +12,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/encodings"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	oldparse "github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -66,10 +67,16 @@ func (b *PlanBuilder) buildScalar(inScope *scope, e ast.Expr) sql.Expression {
 	case *ast.ColName:
 		c, ok := inScope.resolveColumn(strings.ToLower(v.Qualifier.String()), strings.ToLower(v.Name.String()), true)
 		if !ok {
+			if sysVar, ok := b.buildSysVar(v); ok {
+				return sysVar
+			}
 			b.handleErr(sql.ErrColumnNotFound.New(v))
 		}
 		return c.scalarGf()
 	case *ast.FuncExpr:
+		if v.Name.String() == oldparse.UserVarAssignmentFunc {
+			return b.buildUserVarAssignment(inScope, v)
+		}
 		name := v.Name.Lowered()
 		if isAggregateFunc(name) && v.Over == nil {
 			// TODO this assumes aggregate is in the same scope
@@ -229,19 +236,55 @@ func (b *PlanBuilder) buildScalar(inScope *scope, e ast.Expr) sql.Expression {
 		if v.Name == "timestampdiff" {
 			return function.NewTimestampDiff(unit, expr1, expr2)
 		} else if v.Name == "timestampadd" {
-			return nil
+			add, err := function.NewDateAdd(expr2, expression.NewInterval(expr1, v.Unit))
+			if err != nil {
+				b.handleErr(err)
+			}
+			return add
 		}
 		return nil
 	case *ast.ExtractFuncExpr:
 		var unit sql.Expression = expression.NewLiteral(strings.ToUpper(v.Unit), types.LongText)
 		expr := b.buildScalar(inScope, v.Expr)
 		return function.NewExtract(unit, expr)
+	case *ast.MatchExpr:
+		return b.buildMatchAgainst(inScope, v)
 	default:
 		b.handleErr(sql.ErrUnsupportedSyntax.New(ast.String(e)))
 	}
 	return nil
 }
 
+func (b *PlanBuilder) buildMatchAgainst(inScope *scope, v *ast.MatchExpr) sql.Expression {
+	cols := make([]sql.Expression, len(v.Columns))
+	for i, selectExpr := range v.Columns {
+		// Like MySQL, only columns can be matched
+		aliased, ok := selectExpr.(*ast.AliasedExpr)
+		if !ok {
+			b.handleErr(sql.ErrInvalidArgument.New("MATCH"))
+		}
+		if _, ok := aliased.Expr.(*ast.ColName); !ok {
+			b.handleErr(sql.ErrInvalidArgument.New("MATCH"))
+		}
+		cols[i] = b.buildScalar(inScope, aliased.Expr)
+	}
+	expr := b.buildScalar(inScope, v.Expr)
+
+	mode := function.MatchMode_NaturalLanguage
+	switch v.Option {
+	case ast.BooleanModeStr:
+		mode = function.MatchMode_Boolean
+	case ast.QueryExpansionStr, ast.NaturalLanguageModeWithQueryExpansionStr:
+		mode = function.MatchMode_QueryExpansion
+	}
+
+	match, err := function.NewMatchAgainst(cols, expr, mode)
+	if err != nil {
+		b.handleErr(err)
+	}
+	return match
+}
+
 func (b *PlanBuilder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Expression {
 	switch strings.ToLower(e.Operator) {
 	case ast.MinusStr:
@@ -469,6 +512,9 @@ func (b *PlanBuilder) buildComparison(inScope *scope, c *ast.ComparisonExpr) sql
 	case ast.NotRegexpStr:
 		return expression.NewNot(expression.NewRegexp(left, right))
 	case ast.EqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, false); ok {
+			return eq
+		}
 		return expression.NewEquals(left, right)
 	case ast.LessThanStr:
 		return expression.NewLessThan(left, right)
@@ -479,8 +525,14 @@ func (b *PlanBuilder) buildComparison(inScope *scope, c *ast.ComparisonExpr) sql
 	case ast.GreaterEqualStr:
 		return expression.NewGreaterThanOrEqual(left, right)
 	case ast.NullSafeEqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, true); ok {
+			return eq
+		}
 		return expression.NewNullSafeEquals(left, right)
 	case ast.NotEqualStr:
+		if eq, ok := expression.SplitTupleEquality(left, right, false); ok {
+			return expression.NewNot(eq)
+		}
 		return expression.NewNot(
 			expression.NewEquals(left, right),
 		)
@@ -24,6 +24,10 @@ type scope struct {
 
 	// cols are definitions provided by this scope
 	cols []scopeColumn
+	// colIndex maps the lowercase names of cols to their positions
+	// for wide scopes, indexing the first colIndexLen columns
+	colIndex    map[string][]int
+	colIndexLen int
 	// extraCols are auxillary output columns required
 	// for sorting or grouping
 	extraCols []scopeColumn
@@ -44,10 +48,15 @@ type scope struct {
 	exprs map[string]columnId
 }
 
+// minIndexedCols is the number of columns past which a scope
+// resolves columns with an index by name rather than by scanning
+// all of them.
+const minIndexedCols = 16
+
 func (s *scope) resolveColumn(table, col string, checkParent bool) (scopeColumn, bool) {
 	var found scopeColumn
 	var foundCand bool
-	for _, c := range s.cols {
+	match := func(c scopeColumn) {
 		if strings.EqualFold(c.col, col) && (c.table == table || table == "") {
 			if foundCand {
 				err := sql.ErrAmbiguousColumnName.New(col)
@@ -57,6 +66,15 @@ func (s *scope) resolveColumn(table, col string, checkParent bool) (scopeColumn,
 			foundCand = true
 		}
 	}
+	if len(s.cols) > minIndexedCols {
+		for _, i := range s.indexedCols(col) {
+			match(s.cols[i])
+		}
+	} else {
+		for _, c := range s.cols {
+			match(c)
+		}
+	}
 	if foundCand {
 		return found, true
 	}
@@ -82,6 +100,21 @@ func (s *scope) resolveColumn(table, col string, checkParent bool) (scopeColumn,
 	return c, true
 }
 
+// indexedCols returns the positions of the columns named |col| in
+// this scope, indexing the columns added since the last call.
+func (s *scope) indexedCols(col string) []int {
+	if s.colIndex == nil || s.colIndexLen > len(s.cols) {
+		s.colIndex = make(map[string][]int, len(s.cols))
+		s.colIndexLen = 0
+	}
+	for i := s.colIndexLen; i < len(s.cols); i++ {
+		name := strings.ToLower(s.cols[i].col)
+		s.colIndex[name] = append(s.colIndex[name], i)
+	}
+	s.colIndexLen = len(s.cols)
+	return s.colIndex[strings.ToLower(col)]
+}
+
 // getExpr returns a columnId if the given expression has
 // been built.
 func (s *scope) getExpr(name string) (columnId, bool) {
@@ -164,6 +197,7 @@ func (s *scope) setColAlias(cols []string) {
 		s.cols[i].col = name
 		s.exprs[s.cols[i].String()] = ids[i]
 	}
+	s.colIndex = nil
 }
 
 // push creates a new scope referencing the current scope as a
@@ -225,6 +259,7 @@ func (s *scope) copy() *scope {
 		ret.cols = make([]scopeColumn, len(s.cols))
 		copy(ret.cols, s.cols)
 	}
+	ret.colIndex = nil
 
 	return &ret
 }
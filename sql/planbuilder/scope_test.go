@@ -0,0 +1,63 @@
+package planbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestScopeResolveWideColumns(t *testing.T) {
+	b := &PlanBuilder{}
+	s := &scope{b: b}
+	for _, table := range []string{"t1", "t2"} {
+		for i := 0; i < 100; i++ {
+			s.newColumn(scopeColumn{table: table, col: fmt.Sprintf("c%d_%s", i, table)})
+		}
+		s.newColumn(scopeColumn{table: table, col: "shared"})
+	}
+
+	c, ok := s.resolveColumn("", "C42_T2", false)
+	require.True(t, ok)
+	require.Equal(t, "t2", c.table)
+	require.Equal(t, "c42_t2", c.col)
+
+	_, ok = s.resolveColumn("t1", "c42_t2", false)
+	require.False(t, ok)
+
+	c, ok = s.resolveColumn("t1", "shared", false)
+	require.True(t, ok)
+	require.Equal(t, "t1", c.table)
+
+	func() {
+		defer func() {
+			perr, ok := recover().(parseErr)
+			require.True(t, ok)
+			require.True(t, sql.ErrAmbiguousColumnName.Is(perr.err))
+		}()
+		s.resolveColumn("", "shared", false)
+	}()
+
+	// columns added after a lookup are resolved too
+	s.newColumn(scopeColumn{table: "t3", col: "c42_t2"})
+	c, ok = s.resolveColumn("t3", "c42_t2", false)
+	require.True(t, ok)
+	require.Equal(t, "t3", c.table)
+
+	// renamed columns are resolved by their new names only
+	cp := s.copy()
+	names := make([]string, len(cp.cols))
+	for i := range names {
+		names[i] = fmt.Sprintf("r%d", i)
+	}
+	cp.setColAlias(names)
+	c, ok = cp.resolveColumn("", "r5", false)
+	require.True(t, ok)
+	require.Equal(t, s.cols[5].id, c.id)
+	_, ok = cp.resolveColumn("", "c5_t1", false)
+	require.False(t, ok)
+	_, ok = s.resolveColumn("", "c5_t1", false)
+	require.True(t, ok)
+}
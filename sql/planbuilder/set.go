@@ -141,3 +141,78 @@ func (b *PlanBuilder) setExprsToExpressions(inScope *scope, e ast.SetVarExprs) [
 	}
 	return res
 }
+
+// buildSysVar returns the user or system variable referenced by |colName|, or false if |colName| does not name a
+// variable.
+func (b *PlanBuilder) buildSysVar(colName *ast.ColName) (sql.Expression, bool) {
+	var varName string
+	var scope ast.SetScope
+	var err error
+	if !colName.Qualifier.IsEmpty() {
+		varName, scope, err = ast.VarScope(colName.Qualifier.Name.String(), colName.Name.String())
+	} else {
+		varName, scope, err = ast.VarScope(colName.Name.String())
+	}
+	if err != nil {
+		b.handleErr(err)
+	}
+	switch scope {
+	case ast.SetScope_None:
+		return nil, false
+	case ast.SetScope_Global:
+		if _, _, ok := sql.SystemVariables.GetGlobal(varName); !ok {
+			b.handleErr(sql.ErrUnknownSystemVariable.New(varName))
+		}
+		return expression.NewSystemVar(varName, sql.SystemVariableScope_Global), true
+	case ast.SetScope_Persist:
+		b.handleErr(sql.ErrUnsupportedFeature.New("PERSIST"))
+	case ast.SetScope_PersistOnly:
+		b.handleErr(sql.ErrUnsupportedFeature.New("PERSIST_ONLY"))
+	case ast.SetScope_Session:
+		if _, err := b.ctx.GetSessionVariable(b.ctx, varName); err != nil {
+			b.handleErr(err)
+		}
+		switch strings.ToLower(varName) {
+		case "character_set_database", "collation_database":
+			// These are read from the current database rather than the session, like the analyzer does when
+			// resolving them.
+			name := expression.NewSystemVar(varName, sql.SystemVariableScope_Session).String()
+			collation := sql.Collation_Default
+			if db, err := b.cat.Database(b.ctx, b.ctx.GetCurrentDatabase()); err == nil {
+				collation = plan.GetDatabaseCollation(b.ctx, db)
+			}
+			if strings.ToLower(varName) == "character_set_database" {
+				return expression.NewNamedLiteral(name, collation.CharacterSet().String(), types.Text), true
+			}
+			return expression.NewNamedLiteral(name, collation.String(), types.Text), true
+		default:
+			return expression.NewSystemVar(varName, sql.SystemVariableScope_Session), true
+		}
+	case ast.SetScope_User:
+		t, _, err := b.ctx.GetUserVariable(b.ctx, varName)
+		if err != nil {
+			b.handleErr(err)
+		}
+		return expression.NewUserVarWithType(varName, t), true
+	default: // shouldn't happen
+		b.handleErr(fmt.Errorf("unknown set scope %v", scope))
+	}
+	return nil, false
+}
+
+// buildUserVarAssignment builds an @var := expr assignment, which the parser reads as a call of the function
+// oldparse.UserVarAssignmentFunc.
+func (b *PlanBuilder) buildUserVarAssignment(inScope *scope, f *ast.FuncExpr) sql.Expression {
+	var name string
+	if len(f.Exprs) == 2 {
+		if ae, ok := f.Exprs[0].(*ast.AliasedExpr); ok {
+			if col, ok := ae.Expr.(*ast.ColName); ok && col.Qualifier.IsEmpty() {
+				name = col.Name.String()
+			}
+		}
+	}
+	if !strings.HasPrefix(name, "@") || strings.HasPrefix(name, "@@") {
+		b.handleErr(sql.ErrSyntaxError.New("the left side of := must be a user variable"))
+	}
+	return expression.NewUserVarAssignment(strings.TrimPrefix(name, "@"), b.selectExprToExpression(inScope, f.Exprs[1]))
+}
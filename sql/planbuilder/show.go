@@ -112,7 +112,7 @@ func (b *PlanBuilder) buildShow(inScope *scope, s *ast.Show, query string) (outS
 		return b.buildShowAllDatabases(inScope, s)
 	case ast.KeywordString(ast.FIELDS), ast.KeywordString(ast.COLUMNS):
 		return b.buildShowAllColumns(inScope, s)
-	case ast.KeywordString(ast.WARNINGS):
+	case ast.KeywordString(ast.WARNINGS), ast.KeywordString(ast.ERRORS):
 		return b.buildShowWarnings(inScope, s)
 	case ast.KeywordString(ast.COLLATION):
 		return b.buildShowCollation(inScope, s)
@@ -537,12 +537,26 @@ func (b *PlanBuilder) buildShowAllColumns(inScope *scope, s *ast.Show) (outScope
 
 func (b *PlanBuilder) buildShowWarnings(inScope *scope, s *ast.Show) (outScope *scope) {
 	outScope = inScope.push()
+	isErrors := strings.ToLower(s.Type) == ast.KeywordString(ast.ERRORS)
 	if s.CountStar {
-		unsupportedShow := "SHOW COUNT(*) WARNINGS"
-		b.handleErr(sql.ErrUnsupportedFeature.New(unsupportedShow))
+		// SHOW COUNT(*) WARNINGS and SHOW COUNT(*) ERRORS are the same as selecting the matching system variable
+		query := "select @@session.warning_count"
+		if isErrors {
+			query = "select @@session.error_count"
+		}
+		node, err := Parse(b.ctx, b.cat, query)
+		if err != nil {
+			b.handleErr(err)
+		}
+		outScope.node = node
+		return
 	}
 	var node sql.Node
-	node = plan.ShowWarnings(b.ctx.Session.Warnings())
+	if isErrors {
+		node = plan.NewShowErrors(b.ctx.Session.Warnings())
+	} else {
+		node = plan.ShowWarnings(b.ctx.Session.Warnings())
+	}
 	if s.Limit != nil {
 		if s.Limit.Offset != nil {
 			offset := b.buildScalar(inScope, s.Limit.Offset)
@@ -50,6 +50,18 @@ type PrivilegedOperationChecker interface {
 	UserHasPrivileges(ctx *Context, operations ...PrivilegedOperation) bool
 }
 
+// DefinerPrivilegedOperationChecker is a PrivilegedOperationChecker that can also check the privileges of the definer of
+// a stored object, such as a view with SQL SECURITY DEFINER, whose tables are read with its definer's privileges.
+type DefinerPrivilegedOperationChecker interface {
+	PrivilegedOperationChecker
+	// DefinerChecker returns a checker of the privileges of the account |definer|, written like `user`@`host`, rather
+	// than those of the user of the session.
+	DefinerChecker(definer string) PrivilegedOperationChecker
+	// IsCurrentUser returns whether the account |definer|, written like `user`@`host`, is the account of the user of
+	// the session. Naming any other account as the definer of a stored object takes the SET_USER_ID privilege.
+	IsCurrentUser(ctx *Context, definer string) bool
+}
+
 // PrivilegeSet is a set containing privileges. Integrators should not implement this interface.
 type PrivilegeSet interface {
 	// Has returns whether the given global privilege(s) exists.
@@ -94,6 +94,9 @@ type Process struct {
 	Query    string
 	Progress map[string]TableProgress
 	Kill     context.CancelFunc
+
+	// ConnectAttributes are the connection attributes the client sent when it connected.
+	ConnectAttributes map[string]string
 }
 
 // Done needs to be called when this process has finished.
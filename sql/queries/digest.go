@@ -0,0 +1,189 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queries computes digests of statements, which identify the statements that differ only by their literal
+// values, whitespace and comments, like the statement digests of MySQL.
+package queries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Digest is the fingerprint of a statement: its normalized text and the hash of the text.
+type Digest struct {
+	// Text is the normalized text of the statement, see Normalize.
+	Text string
+	// Hash is the hex-encoded SHA-256 hash of Text.
+	Hash string
+}
+
+// NewDigest returns the digest of the statement given.
+func NewDigest(query string) Digest {
+	text := Normalize(query)
+	hash := sha256.Sum256([]byte(text))
+	return Digest{
+		Text: text,
+		Hash: hex.EncodeToString(hash[:]),
+	}
+}
+
+const (
+	// valueToken is the token that replaces each literal value and bind variable in a normalized statement.
+	valueToken = "?"
+	// valueListToken replaces a parenthesized list of more than one value.
+	valueListToken = "(...)"
+	// moreRowsToken follows the first of a list of rows of values.
+	moreRowsToken = "/* , ... */"
+)
+
+// Normalize returns the normalized text of the statement given. The tokens of the normalized text are separated by a
+// single space, keywords are in upper case and identifiers are quoted with backticks. Comments are removed, and
+// literal values and bind variables are replaced with '?'. Parenthesized lists of values are reduced to "(...)", and
+// lists of rows of values to the first row followed by "/* , ... */", so that statements reading or writing a
+// different number of values have the same digest.
+func Normalize(query string) string {
+	tkn := sqlparser.NewStringTokenizer(query)
+	var tokens []string
+	// isValue is whether the token at each position is a value
+	var isValue []bool
+	add := func(token string, value bool) {
+		tokens = append(tokens, token)
+		isValue = append(isValue, value)
+	}
+
+	for {
+		end := tkn.Position - 1
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0:
+			return joinTokens(tokens)
+		case sqlparser.LEX_ERROR:
+			// the rest of the statement can't be tokenized, so only its whitespace is collapsed
+			if end >= 0 && end < len(query) {
+				add(strings.Join(strings.Fields(query[end:]), " "), false)
+			}
+			return joinTokens(tokens)
+		case sqlparser.COMMENT:
+		case ';':
+			// a trailing semicolon isn't part of the statement
+			add(";", false)
+		case sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.DECIMAL, sqlparser.HEXNUM,
+			sqlparser.HEX, sqlparser.BIT_LITERAL, sqlparser.VALUE_ARG, sqlparser.LIST_ARG:
+			// a sign is part of the value if it can't be a binary operator
+			if n := len(tokens); n > 0 && (tokens[n-1] == "-" || tokens[n-1] == "+") && !isOperand(tokens[:n-1], isValue[:n-1]) {
+				tokens, isValue = tokens[:n-1], isValue[:n-1]
+			}
+			add(valueToken, true)
+		case sqlparser.ID:
+			if strings.HasPrefix(string(val), "@") {
+				// user and system variables are kept as they're written
+				add(string(val), false)
+			} else {
+				add(sql.QuoteIdentifier(string(val)), false)
+			}
+		default:
+			if typ < 256 {
+				add(string(rune(typ)), false)
+			} else if op, ok := operators[typ]; ok {
+				add(op, false)
+			} else {
+				add(strings.ToUpper(string(val)), false)
+			}
+		}
+		tokens, isValue = reduceValueLists(tokens, isValue)
+	}
+}
+
+// operators are the tokens of more than one character that aren't keywords.
+var operators = map[int]string{
+	sqlparser.LE:                      "<=",
+	sqlparser.GE:                      ">=",
+	sqlparser.NE:                      "!=",
+	sqlparser.NULL_SAFE_EQUAL:         "<=>",
+	sqlparser.SHIFT_LEFT:              "<<",
+	sqlparser.SHIFT_RIGHT:             ">>",
+	sqlparser.AND:                     "AND",
+	sqlparser.OR:                      "OR",
+	sqlparser.JSON_EXTRACT_OP:         "->",
+	sqlparser.JSON_UNQUOTE_EXTRACT_OP: "->>",
+}
+
+// isOperand returns whether the last of the tokens given ends an operand, in which case a following sign is a binary
+// operator.
+func isOperand(tokens []string, isValue []bool) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	last := tokens[len(tokens)-1]
+	return isValue[len(tokens)-1] || last == ")" || last == valueListToken || strings.HasPrefix(last, "`") ||
+		strings.HasPrefix(last, "@")
+}
+
+// reduceValueLists reduces the parenthesized list of values the tokens given end with, if any, and the list of rows
+// it's part of.
+func reduceValueLists(tokens []string, isValue []bool) ([]string, []bool) {
+	n := len(tokens)
+	if n < 3 || tokens[n-1] != ")" {
+		return tokens, isValue
+	}
+
+	// a list of values alternates values and commas
+	start := n - 2
+	for ; start >= 0; start -= 2 {
+		if !isValue[start] {
+			return tokens, isValue
+		}
+		if start == 0 {
+			return tokens, isValue
+		}
+		if tokens[start-1] == "(" {
+			break
+		}
+		if tokens[start-1] != "," {
+			return tokens, isValue
+		}
+	}
+	if start < 0 {
+		return tokens, isValue
+	}
+	row := "(" + valueToken + ")"
+	if start != n-2 {
+		row = valueListToken
+	}
+	tokens, isValue = append(tokens[:start-1], row), append(isValue[:start-1], false)
+
+	// the rows after the first of a list are removed
+	n = len(tokens)
+	if n >= 3 && tokens[n-2] == "," && (tokens[n-3] == row || tokens[n-3] == moreRowsToken) {
+		if tokens[n-3] == row {
+			return append(tokens[:n-2], moreRowsToken), append(isValue[:n-2], false)
+		}
+		return tokens[:n-2], isValue[:n-2]
+	}
+	return tokens, isValue
+}
+
+// joinTokens joins the tokens of a normalized statement, without its trailing semicolons.
+func joinTokens(tokens []string) string {
+	for len(tokens) > 0 && tokens[len(tokens)-1] == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "select * from t where id = 1",
+			expected: "SELECT * FROM `t` WHERE `id` = ?",
+		},
+		{
+			query:    "SELECT  a,b\n\tFROM `t` WHERE a IN (1, 2, 3) and b = 'x' -- comment",
+			expected: "SELECT `a` , `b` FROM `t` WHERE `a` IN (...) AND `b` = ?",
+		},
+		{
+			query:    "insert into t values (1,'a'),(2,'b'),(3,'c');",
+			expected: "INSERT INTO `t` VALUES (...) /* , ... */",
+		},
+		{
+			query:    "insert into t values (1)",
+			expected: "INSERT INTO `t` VALUES (?)",
+		},
+		{
+			query:    "select -1, a - 1, a-1, f(-2.5), @x, @@session.autocommit",
+			expected: "SELECT ? , `a` - ? , `a` - ? , `f` (?) , @x , @@session.autocommit",
+		},
+		{
+			query:    "select /* comment */ * from t where a <= ? and b <=> :v1 and c->>'$.x' limit 10",
+			expected: "SELECT * FROM `t` WHERE `a` <= ? AND `b` <=> ? AND `c` ->> ? LIMIT ?",
+		},
+		{
+			query:    "select x'AB', 0x10, b'1', 1e5, .5, NULL, true",
+			expected: "SELECT ? , ? , ? , ? , ? , NULL , TRUE",
+		},
+		{
+			query:    "select * from t where a in (select b from u where c = 3)",
+			expected: "SELECT * FROM `t` WHERE `a` IN ( SELECT `b` FROM `u` WHERE `c` = ? )",
+		},
+		{
+			query:    "select 'unterminated",
+			expected: "SELECT 'unterminated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, Normalize(tt.query))
+		})
+	}
+}
+
+func TestNewDigest(t *testing.T) {
+	d := NewDigest("select * from t where a in (1, 2)")
+	require.Equal(t, "SELECT * FROM `t` WHERE `a` IN (...)", d.Text)
+	require.Len(t, d.Hash, 64)
+	require.Equal(t, d, NewDigest("SELECT *\nFROM t WHERE a IN (3,4,5) /* other */"))
+	require.NotEqual(t, d.Hash, NewDigest("select * from t where b in (1, 2)").Hash)
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// RowBatchSize is the number of rows that are read at once by the consumers of row batches in the engine.
+const RowBatchSize = 128
+
+// RowBatchIter is a RowIter that can return many rows with a single call, which amortizes the cost of calling Next
+// for every row of a large result. Iterators that don't implement it can still be read in batches with NextRowBatch,
+// which calls Next for every row instead.
+type RowBatchIter interface {
+	RowIter
+	// NextBatch fills |batch| with the next rows of the iterator, starting from its first element, and returns how
+	// many rows it filled. It may fill less rows than fit in |batch| even when there are rows left. When there are no
+	// rows left, it returns io.EOF, possibly along with the last rows of the iterator, so callers must handle the rows
+	// filled before checking the error, like with io.Reader. The same holds for any other error. NextBatch must not be
+	// called again after it returns an error. The rows are not reused by the iterator, so they may be kept by the
+	// caller, but the elements of |batch| are overwritten on every call.
+	NextBatch(ctx *Context, batch []Row) (int, error)
+}
+
+// NextRowBatch fills |batch| with the next rows of |iter|, as RowBatchIter.NextBatch describes. Iterators that don't
+// implement RowBatchIter are read one row at a time.
+func NextRowBatch(ctx *Context, iter RowIter, batch []Row) (int, error) {
+	if bi, ok := iter.(RowBatchIter); ok {
+		return bi.NextBatch(ctx, batch)
+	}
+
+	n := 0
+	for n < len(batch) {
+		row, err := iter.Next(ctx)
+		if err != nil {
+			return n, err
+		}
+		batch[n] = row
+		n++
+	}
+	return n, nil
+}
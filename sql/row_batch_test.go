@@ -0,0 +1,113 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rowByRowIter returns the rows given one at a time, followed by |err| if it's set.
+type rowByRowIter struct {
+	rows []Row
+	err  error
+}
+
+func (i *rowByRowIter) Next(*Context) (Row, error) {
+	if len(i.rows) == 0 {
+		if i.err != nil {
+			return nil, i.err
+		}
+		return nil, io.EOF
+	}
+	row := i.rows[0]
+	i.rows = i.rows[1:]
+	return row, nil
+}
+
+func (i *rowByRowIter) Close(*Context) error {
+	return nil
+}
+
+func TestNextRowBatch(t *testing.T) {
+	rows := []Row{{1}, {2}, {3}, {4}, {5}}
+
+	iters := map[string]func() RowIter{
+		"batch iter": func() RowIter { return RowsToRowIter(rows...) },
+		"row iter":   func() RowIter { return &rowByRowIter{rows: rows} },
+	}
+
+	for name, newIter := range iters {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := NewEmptyContext()
+			iter := newIter()
+			batch := make([]Row, 2)
+
+			n, err := NextRowBatch(ctx, iter, batch)
+			require.NoError(err)
+			require.Equal([]Row{{1}, {2}}, batch[:n])
+
+			n, err = NextRowBatch(ctx, iter, batch)
+			require.NoError(err)
+			require.Equal([]Row{{3}, {4}}, batch[:n])
+
+			n, err = NextRowBatch(ctx, iter, batch)
+			if err == nil {
+				require.Equal([]Row{{5}}, batch[:n])
+				n, err = NextRowBatch(ctx, iter, batch)
+				require.Equal(0, n)
+			} else {
+				require.Equal([]Row{{5}}, batch[:n])
+			}
+			require.Equal(io.EOF, err)
+
+			require.NoError(iter.Close(ctx))
+		})
+	}
+}
+
+func TestNextRowBatchError(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+	expectedErr := errors.New("test error")
+	iter := &rowByRowIter{rows: []Row{{1}}, err: expectedErr}
+
+	batch := make([]Row, 4)
+	n, err := NextRowBatch(ctx, iter, batch)
+	require.Equal(expectedErr, err)
+	require.Equal([]Row{{1}}, batch[:n])
+}
+
+func TestRowIterToRowsBatches(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	var rows []Row
+	for i := 0; i < RowBatchSize*2+1; i++ {
+		rows = append(rows, Row{i})
+	}
+
+	result, err := RowIterToRows(ctx, nil, RowsToRowIter(rows...))
+	require.NoError(err)
+	require.Equal(rows, result)
+
+	result, err = RowIterToRows(ctx, nil, &rowByRowIter{rows: rows})
+	require.NoError(err)
+	require.Equal(rows, result)
+}
@@ -0,0 +1,496 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// RowEventType is the kind of change that a RowEvent describes.
+type RowEventType byte
+
+const (
+	RowEventType_Insert RowEventType = iota
+	RowEventType_Update
+	RowEventType_Delete
+	RowEventType_Truncate
+)
+
+func (t RowEventType) String() string {
+	switch t {
+	case RowEventType_Insert:
+		return "INSERT"
+	case RowEventType_Update:
+		return "UPDATE"
+	case RowEventType_Delete:
+		return "DELETE"
+	case RowEventType_Truncate:
+		return "TRUNCATE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RowEvent is a change made to a single row of a table. Before is the row before the change, which is nil for inserts,
+// and After is the row after the change, which is nil for deletes. Both rows have the columns of Schema, and their
+// TIMESTAMP values are in UTC, as they're stored. A truncate has neither row, and Rows is the number of rows it removed.
+type RowEvent struct {
+	Type     RowEventType
+	Database string
+	Table    string
+	Schema   Schema
+	Before   Row
+	After    Row
+	Rows     int64
+}
+
+// RowEventTransaction is the row changes of a committed transaction, in the order they were made. Sequence numbers
+// the transactions a RowEventPublisher delivers, starting from 1, in the order they were committed.
+type RowEventTransaction struct {
+	Sequence     uint64
+	ConnectionID uint32
+	Events       []RowEvent
+}
+
+// RowEventConsumer receives the row changes of committed transactions from a RowEventPublisher.
+type RowEventConsumer interface {
+	// ConsumeRowEvents is called with each committed transaction that changed rows, one at a time and in commit order.
+	// It's called by the session committing the transaction, so it should return quickly, and it must not run queries
+	// on the engine.
+	ConsumeRowEvents(tx RowEventTransaction)
+}
+
+// RowEventConsumerFunc is a function that implements RowEventConsumer.
+type RowEventConsumerFunc func(tx RowEventTransaction)
+
+// ConsumeRowEvents implements RowEventConsumer.
+func (f RowEventConsumerFunc) ConsumeRowEvents(tx RowEventTransaction) {
+	f(tx)
+}
+
+// TableRowChanges is the number of rows of a table that a statement or transaction inserted, updated and deleted.
+type TableRowChanges struct {
+	Database string
+	Table    string
+	Inserted int64
+	Updated  int64
+	Deleted  int64
+}
+
+// RowChangeHook is called with the tables that a statement or transaction changed rows of, in the order they were
+// first changed. It's called by the session that made the changes, which waits for it to return, so it may make the
+// changes durable elsewhere before the session goes on, but it must not run queries on the engine.
+type RowChangeHook func(ctx *Context, changes []TableRowChanges)
+
+// RowEventPublisher delivers the row changes that sessions make to the consumers subscribed to it, independently of
+// the binary log. The changes of each session are held until its transaction commits, and dropped if it rolls back,
+// so that consumers only see committed changes. The changes of a statement that fails, or of a transaction rolled back
+// to a savepoint, are dropped likewise. For sessions that don't support transactions, each statement's changes are
+// delivered once it completes. Changes are only held while there are consumers or hooks, so a consumer that subscribes
+// while a transaction is in progress may receive only part of it. All methods are safe to call on a nil publisher,
+// which does nothing.
+type RowEventPublisher struct {
+	mu             sync.Mutex
+	consumers      []subscribedRowEventConsumer
+	statementHooks []registeredRowChangeHook
+	commitHooks    []registeredRowChangeHook
+	nextID         uint64
+	sessions       map[uint32]*pendingRowEvents
+	sequence       uint64
+	// deliverMu keeps the transactions from being delivered concurrently or out of order
+	deliverMu sync.Mutex
+}
+
+// subscribedRowEventConsumer is a consumer of a RowEventPublisher, with the id that unsubscribes it.
+type subscribedRowEventConsumer struct {
+	id       uint64
+	consumer RowEventConsumer
+}
+
+// registeredRowChangeHook is a hook of a RowEventPublisher, with the id that removes it.
+type registeredRowChangeHook struct {
+	id   uint64
+	hook RowChangeHook
+}
+
+// pendingRowEvents is the uncommitted row changes of a session, along with its savepoints in the order they were
+// created.
+type pendingRowEvents struct {
+	events     []RowEvent
+	savepoints []rowEventSavepoint
+	// statementStart is the number of changes the session made before its current statement began
+	statementStart int
+}
+
+// rowEventSavepoint is a savepoint of a session, with the number of changes the session made before creating it.
+type rowEventSavepoint struct {
+	name string
+	mark int
+}
+
+// NewRowEventPublisher returns a new RowEventPublisher without any consumers.
+func NewRowEventPublisher() *RowEventPublisher {
+	return &RowEventPublisher{
+		sessions: make(map[uint32]*pendingRowEvents),
+	}
+}
+
+// Subscribe registers the consumer given to receive the transactions committed from now on, and returns a function
+// that unregisters it.
+func (p *RowEventPublisher) Subscribe(consumer RowEventConsumer) (unsubscribe func()) {
+	if p == nil {
+		return func() {}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextID++
+	id := p.nextID
+	p.consumers = append(p.consumers, subscribedRowEventConsumer{id: id, consumer: consumer})
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for i, c := range p.consumers {
+			if c.id == id {
+				p.consumers = append(p.consumers[:i:i], p.consumers[i+1:]...)
+				break
+			}
+		}
+		p.dropUnobservedChanges()
+	}
+}
+
+// OnStatementComplete registers a hook that's called when a statement that changed rows completes, with the changes
+// that it made, and returns a function that removes it. In a transaction, the hook is called before the transaction
+// commits, so the changes may still be rolled back. A statement that fails may still have changed rows if the session
+// doesn't support transactions.
+func (p *RowEventPublisher) OnStatementComplete(hook RowChangeHook) (remove func()) {
+	if p == nil {
+		return func() {}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextID++
+	id := p.nextID
+	p.statementHooks = append(p.statementHooks, registeredRowChangeHook{id: id, hook: hook})
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.statementHooks = removeRowChangeHook(p.statementHooks, id)
+		p.dropUnobservedChanges()
+	}
+}
+
+// OnTransactionCommit registers a hook that's called when a transaction that changed rows commits, with the changes
+// that it made, and returns a function that removes it. The hook is called after the transaction is committed, and
+// after the consumers receive it, in commit order.
+func (p *RowEventPublisher) OnTransactionCommit(hook RowChangeHook) (remove func()) {
+	if p == nil {
+		return func() {}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextID++
+	id := p.nextID
+	p.commitHooks = append(p.commitHooks, registeredRowChangeHook{id: id, hook: hook})
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.commitHooks = removeRowChangeHook(p.commitHooks, id)
+		p.dropUnobservedChanges()
+	}
+}
+
+// removeRowChangeHook returns |hooks| without the hook with the id given.
+func removeRowChangeHook(hooks []registeredRowChangeHook, id uint64) []registeredRowChangeHook {
+	for i, h := range hooks {
+		if h.id == id {
+			return append(hooks[:i:i], hooks[i+1:]...)
+		}
+	}
+	return hooks
+}
+
+// observed returns whether any consumer or hook needs the changes of the sessions. Must be called with mu held.
+func (p *RowEventPublisher) observed() bool {
+	return len(p.consumers) > 0 || len(p.statementHooks) > 0 || len(p.commitHooks) > 0
+}
+
+// Observed returns whether any consumer or hook needs the row changes of the sessions, which are only recorded then.
+func (p *RowEventPublisher) Observed() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.observed()
+}
+
+// dropUnobservedChanges drops the changes held for the sessions if nothing needs them anymore. Must be called with mu
+// held.
+func (p *RowEventPublisher) dropUnobservedChanges() {
+	if !p.observed() {
+		p.sessions = make(map[uint32]*pendingRowEvents)
+	}
+}
+
+// Record adds the row change given to the uncommitted changes of the session of the context given. The rows of the
+// change are copied, so the caller may reuse them.
+func (p *RowEventPublisher) Record(ctx *Context, ev RowEvent) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.observed() {
+		return
+	}
+	if ev.Before != nil {
+		ev.Before = ev.Before.Copy()
+	}
+	if ev.After != nil {
+		ev.After = ev.After.Copy()
+	}
+	pending, ok := p.sessions[ctx.ID()]
+	if !ok {
+		pending = &pendingRowEvents{}
+		p.sessions[ctx.ID()] = pending
+	}
+	pending.events = append(pending.events, ev)
+}
+
+// Mark returns the number of uncommitted changes of the session of the context given, which DiscardSince takes to drop
+// the changes made after this call, such as those of a statement that fails.
+func (p *RowEventPublisher) Mark(ctx *Context) int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pending, ok := p.sessions[ctx.ID()]; ok {
+		return len(pending.events)
+	}
+	return 0
+}
+
+// DiscardSince drops the uncommitted changes that the session of the context given made after the call to Mark that
+// returned |mark|, along with the savepoints created after it.
+func (p *RowEventPublisher) DiscardSince(ctx *Context, mark int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pending, ok := p.sessions[ctx.ID()]; ok {
+		pending.truncate(mark)
+	}
+}
+
+// Commit delivers the uncommitted changes of the session of the context given to the consumers, as one transaction.
+// Nothing is delivered if the session hasn't changed any rows.
+func (p *RowEventPublisher) Commit(ctx *Context) {
+	if p == nil {
+		return
+	}
+	p.deliverMu.Lock()
+	defer p.deliverMu.Unlock()
+
+	p.mu.Lock()
+	pending, ok := p.sessions[ctx.ID()]
+	delete(p.sessions, ctx.ID())
+	if !ok || len(pending.events) == 0 {
+		p.mu.Unlock()
+		return
+	}
+	consumers := p.consumers
+	hooks := p.commitHooks
+	var tx RowEventTransaction
+	if len(consumers) > 0 {
+		p.sequence++
+		tx = RowEventTransaction{
+			Sequence:     p.sequence,
+			ConnectionID: ctx.ID(),
+			Events:       pending.events,
+		}
+	}
+	p.mu.Unlock()
+
+	for _, c := range consumers {
+		c.consumer.ConsumeRowEvents(tx)
+	}
+	if len(hooks) > 0 {
+		changes := summarizeRowEvents(pending.events)
+		for _, h := range hooks {
+			h.hook(ctx, changes)
+		}
+	}
+}
+
+// StatementBegin marks the beginning of a statement of the session of the context given, whose changes are passed
+// to the hooks registered with OnStatementComplete once StatementComplete is called.
+func (p *RowEventPublisher) StatementBegin(ctx *Context) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pending, ok := p.sessions[ctx.ID()]; ok {
+		pending.statementStart = len(pending.events)
+	}
+}
+
+// StatementComplete calls the hooks registered with OnStatementComplete with the changes that the session of the
+// context given made since StatementBegin was called, if it made any.
+func (p *RowEventPublisher) StatementComplete(ctx *Context) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	pending, ok := p.sessions[ctx.ID()]
+	if !ok || len(p.statementHooks) == 0 || pending.statementStart >= len(pending.events) {
+		p.mu.Unlock()
+		return
+	}
+	changes := summarizeRowEvents(pending.events[pending.statementStart:])
+	pending.statementStart = len(pending.events)
+	hooks := p.statementHooks
+	p.mu.Unlock()
+
+	for _, h := range hooks {
+		h.hook(ctx, changes)
+	}
+}
+
+// summarizeRowEvents returns the number of rows of each table that |events| changed, in the order the tables were
+// first changed.
+func summarizeRowEvents(events []RowEvent) []TableRowChanges {
+	var changes []TableRowChanges
+	for _, ev := range events {
+		i := 0
+		for ; i < len(changes); i++ {
+			if changes[i].Database == ev.Database && changes[i].Table == ev.Table {
+				break
+			}
+		}
+		if i == len(changes) {
+			changes = append(changes, TableRowChanges{Database: ev.Database, Table: ev.Table})
+		}
+		switch ev.Type {
+		case RowEventType_Insert:
+			changes[i].Inserted++
+		case RowEventType_Update:
+			changes[i].Updated++
+		case RowEventType_Delete:
+			changes[i].Deleted++
+		case RowEventType_Truncate:
+			changes[i].Deleted += ev.Rows
+		}
+	}
+	return changes
+}
+
+// Rollback drops the uncommitted changes of the session with the id given, such as when its transaction is rolled
+// back or the session is closed.
+func (p *RowEventPublisher) Rollback(id uint32) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.sessions, id)
+}
+
+// CreateSavepoint records a savepoint with the name given for the session of the context given, replacing any
+// savepoint with the same name.
+func (p *RowEventPublisher) CreateSavepoint(ctx *Context, name string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.sessions[ctx.ID()]
+	if !ok {
+		if !p.observed() {
+			return
+		}
+		pending = &pendingRowEvents{}
+		p.sessions[ctx.ID()] = pending
+	}
+	if i := pending.findSavepoint(name); i >= 0 {
+		pending.savepoints = append(pending.savepoints[:i:i], pending.savepoints[i+1:]...)
+	}
+	pending.savepoints = append(pending.savepoints, rowEventSavepoint{name: name, mark: len(pending.events)})
+}
+
+// RollbackToSavepoint drops the uncommitted changes that the session of the context given made after the savepoint
+// with the name given, along with the savepoints created after it.
+func (p *RowEventPublisher) RollbackToSavepoint(ctx *Context, name string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.sessions[ctx.ID()]
+	if !ok {
+		return
+	}
+	if i := pending.findSavepoint(name); i >= 0 {
+		pending.truncate(pending.savepoints[i].mark)
+		pending.savepoints = pending.savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint removes the savepoint with the name given, and the savepoints created after it, from the session
+// of the context given.
+func (p *RowEventPublisher) ReleaseSavepoint(ctx *Context, name string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.sessions[ctx.ID()]
+	if !ok {
+		return
+	}
+	if i := pending.findSavepoint(name); i >= 0 {
+		pending.savepoints = pending.savepoints[:i]
+	}
+}
+
+// findSavepoint returns the index of the savepoint with the name given, or -1 if there isn't one.
+func (p *pendingRowEvents) findSavepoint(name string) int {
+	for i, sp := range p.savepoints {
+		if strings.EqualFold(sp.name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// truncate drops the changes after the first |mark| ones, and the savepoints created after them.
+func (p *pendingRowEvents) truncate(mark int) {
+	if mark < len(p.events) {
+		p.events = p.events[:mark]
+	}
+	if p.statementStart > mark {
+		p.statementStart = mark
+	}
+	for i, sp := range p.savepoints {
+		if sp.mark > mark {
+			p.savepoints = p.savepoints[:i]
+			break
+		}
+	}
+}
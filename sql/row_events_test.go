@@ -0,0 +1,148 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowEventPublisher(t *testing.T) {
+	p := NewRowEventPublisher()
+	ctx1 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 1)))
+	ctx2 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 2)))
+	insert := func(i int) RowEvent {
+		return RowEvent{Type: RowEventType_Insert, Database: "db", Table: "t", After: Row{i}}
+	}
+
+	// changes aren't held without consumers
+	p.Record(ctx1, insert(0))
+	require.Equal(t, 0, p.Mark(ctx1))
+
+	var txs []RowEventTransaction
+	unsubscribe := p.Subscribe(RowEventConsumerFunc(func(tx RowEventTransaction) {
+		txs = append(txs, tx)
+	}))
+
+	row := Row{1}
+	p.Record(ctx1, RowEvent{Type: RowEventType_Insert, Database: "db", Table: "t", After: row})
+	row[0] = 100
+	p.Record(ctx2, insert(2))
+	p.Commit(ctx2)
+	p.Commit(ctx1)
+	require.Equal(t, []RowEventTransaction{
+		{Sequence: 1, ConnectionID: 2, Events: []RowEvent{insert(2)}},
+		{Sequence: 2, ConnectionID: 1, Events: []RowEvent{insert(1)}},
+	}, txs)
+
+	// a transaction without changes isn't delivered
+	txs = nil
+	p.Commit(ctx1)
+	require.Nil(t, txs)
+
+	// the changes after a mark are discarded, as are those after a savepoint rolled back to
+	p.Record(ctx1, insert(3))
+	mark := p.Mark(ctx1)
+	p.Record(ctx1, insert(4))
+	p.DiscardSince(ctx1, mark)
+	p.CreateSavepoint(ctx1, "s1")
+	p.Record(ctx1, insert(5))
+	p.CreateSavepoint(ctx1, "s2")
+	p.Record(ctx1, insert(6))
+	p.RollbackToSavepoint(ctx1, "S1")
+	p.Record(ctx1, insert(7))
+	// s2 was removed by rolling back to s1
+	p.RollbackToSavepoint(ctx1, "s2")
+	p.ReleaseSavepoint(ctx1, "s1")
+	p.RollbackToSavepoint(ctx1, "s1")
+	p.Commit(ctx1)
+	require.Equal(t, []RowEventTransaction{
+		{Sequence: 3, ConnectionID: 1, Events: []RowEvent{insert(3), insert(7)}},
+	}, txs)
+
+	// a rolled back transaction isn't delivered
+	txs = nil
+	p.Record(ctx1, insert(8))
+	p.Rollback(ctx1.ID())
+	p.Commit(ctx1)
+	require.Nil(t, txs)
+
+	unsubscribe()
+	p.Record(ctx1, insert(9))
+	p.Commit(ctx1)
+	require.Nil(t, txs)
+
+	// a nil publisher does nothing
+	var nilPublisher *RowEventPublisher
+	nilPublisher.Subscribe(RowEventConsumerFunc(func(tx RowEventTransaction) {}))()
+	nilPublisher.Record(ctx1, insert(10))
+	nilPublisher.Commit(ctx1)
+}
+
+func TestRowChangeHooks(t *testing.T) {
+	p := NewRowEventPublisher()
+	ctx := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 1)))
+	event := func(typ RowEventType, table string) RowEvent {
+		return RowEvent{Type: typ, Database: "db", Table: table}
+	}
+
+	var statements, commits [][]TableRowChanges
+	removeStatementHook := p.OnStatementComplete(func(ctx *Context, changes []TableRowChanges) {
+		statements = append(statements, changes)
+	})
+	removeCommitHook := p.OnTransactionCommit(func(ctx *Context, changes []TableRowChanges) {
+		commits = append(commits, changes)
+	})
+
+	p.StatementBegin(ctx)
+	p.Record(ctx, event(RowEventType_Insert, "t1"))
+	p.Record(ctx, event(RowEventType_Insert, "t2"))
+	p.Record(ctx, event(RowEventType_Update, "t1"))
+	p.StatementComplete(ctx)
+	p.StatementBegin(ctx)
+	p.Record(ctx, event(RowEventType_Delete, "t2"))
+	p.StatementComplete(ctx)
+	// a statement that doesn't change rows doesn't call the hooks
+	p.StatementBegin(ctx)
+	p.StatementComplete(ctx)
+	require.Equal(t, [][]TableRowChanges{
+		{{Database: "db", Table: "t1", Inserted: 1, Updated: 1}, {Database: "db", Table: "t2", Inserted: 1}},
+		{{Database: "db", Table: "t2", Deleted: 1}},
+	}, statements)
+	require.Nil(t, commits)
+
+	p.Commit(ctx)
+	require.Equal(t, [][]TableRowChanges{
+		{{Database: "db", Table: "t1", Inserted: 1, Updated: 1}, {Database: "db", Table: "t2", Inserted: 1, Deleted: 1}},
+	}, commits)
+
+	// the changes of a statement that are discarded aren't passed to the hooks
+	statements, commits = nil, nil
+	p.StatementBegin(ctx)
+	mark := p.Mark(ctx)
+	p.Record(ctx, event(RowEventType_Insert, "t1"))
+	p.DiscardSince(ctx, mark)
+	p.StatementComplete(ctx)
+	p.Commit(ctx)
+	require.Nil(t, statements)
+	require.Nil(t, commits)
+
+	removeStatementHook()
+	removeCommitHook()
+	p.Record(ctx, event(RowEventType_Insert, "t1"))
+	require.Equal(t, 0, p.Mark(ctx))
+}
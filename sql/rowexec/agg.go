@@ -114,6 +114,12 @@ type groupByGroupingIter struct {
 	pos           int
 	child         sql.RowIter
 	dispose       sql.DisposeFunc
+	// overflow sorts the rows of the groups that didn't fit in the tmp_table_size of the session, which are
+	// aggregated one group at a time once the groups in memory have been returned.
+	overflow     *externalSorter
+	overflowRows sql.RowIter
+	pendingRow   sql.Row
+	hasPending   bool
 }
 
 func newGroupByGroupingIter(
@@ -137,6 +143,9 @@ func (i *groupByGroupingIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 
 	if i.pos >= len(i.keys) {
+		if i.overflowRows != nil {
+			return i.nextOverflowGroup(ctx)
+		}
 		return nil, io.EOF
 	}
 
@@ -149,6 +158,8 @@ func (i *groupByGroupingIter) Next(ctx *sql.Context) (sql.Row, error) {
 }
 
 func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
+	budget := sessionUint(ctx, tmpTableSizeVar)
+	var size uint64
 	for {
 		row, err := i.child.Next(ctx)
 		if err != nil {
@@ -165,12 +176,20 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 
 		b, err := i.get(key)
 		if errors.Is(err, sql.ErrKeyNotFound) {
-			b = make([]sql.AggregationBuffer, len(i.selectedExprs))
-			for j, a := range i.selectedExprs {
-				b[j], err = newAggregationBuffer(a)
-				if err != nil {
+			// Once the groups in memory are over budget, the rows of new groups are sorted to be aggregated later
+			if i.overflow != nil || (budget > 0 && size > budget) {
+				if i.overflow == nil {
+					i.overflow = newExternalSorter(ctx, groupingSortFields(i.groupByExprs), sortBufferSizeVar)
+				}
+				if err := i.overflow.Add(row); err != nil {
 					return err
 				}
+				continue
+			}
+
+			b, err = i.newBuffers()
+			if err != nil {
+				return err
 			}
 
 			if err := i.aggregations.Put(key, b); err != nil {
@@ -178,6 +197,7 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 			}
 
 			i.keys = append(i.keys, key)
+			size += estimateRowSize(row) + uint64(16*len(b))
 		} else if err != nil {
 			return err
 		}
@@ -188,9 +208,91 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 		}
 	}
 
+	if i.overflow != nil {
+		rows, err := i.overflow.Sorted()
+		if err != nil {
+			return err
+		}
+		i.overflowRows = rows
+	}
+
 	return nil
 }
 
+func (i *groupByGroupingIter) newBuffers() ([]sql.AggregationBuffer, error) {
+	var err error
+	b := make([]sql.AggregationBuffer, len(i.selectedExprs))
+	for j, a := range i.selectedExprs {
+		b[j], err = newAggregationBuffer(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// nextOverflowGroup aggregates the next group of the rows sorted by their grouping, which are all consecutive.
+func (i *groupByGroupingIter) nextOverflowGroup(ctx *sql.Context) (sql.Row, error) {
+	row := i.pendingRow
+	if !i.hasPending {
+		var err error
+		row, err = i.overflowRows.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	i.pendingRow, i.hasPending = nil, false
+
+	key, err := groupingKey(ctx, i.groupByExprs, row)
+	if err != nil {
+		return nil, err
+	}
+
+	buffers, err := i.newBuffers()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, b := range buffers {
+			b.Dispose()
+		}
+	}()
+
+	for {
+		if err := updateBuffers(ctx, buffers, row); err != nil {
+			return nil, err
+		}
+
+		row, err = i.overflowRows.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey, err := groupingKey(ctx, i.groupByExprs, row)
+		if err != nil {
+			return nil, err
+		}
+		if nextKey != key {
+			i.pendingRow, i.hasPending = row, true
+			break
+		}
+	}
+
+	return evalBuffers(ctx, buffers)
+}
+
+// groupingSortFields returns the sort fields that put the rows of a group next to each other.
+func groupingSortFields(groupByExprs []sql.Expression) sql.SortFields {
+	sortFields := make(sql.SortFields, len(groupByExprs))
+	for j, e := range groupByExprs {
+		sortFields[j] = sql.SortField{Column: e, Order: sql.Ascending, NullOrdering: sql.NullsFirst}
+	}
+	return sortFields
+}
+
 func (i *groupByGroupingIter) get(key uint64) ([]sql.AggregationBuffer, error) {
 	v, err := i.aggregations.Get(key)
 	if err != nil {
@@ -214,6 +316,12 @@ func (i *groupByGroupingIter) Close(ctx *sql.Context) error {
 		i.dispose = nil
 	}
 
+	if i.overflow != nil {
+		i.overflow.Close()
+		i.overflow = nil
+		i.overflowRows = nil
+	}
+
 	return i.child.Close(ctx)
 }
 
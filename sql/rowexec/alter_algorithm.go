@@ -0,0 +1,615 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func (b *BaseBuilder) buildAlterTable(ctx *sql.Context, n *plan.AlterTable, row sql.Row) (sql.RowIter, error) {
+	// Every clause must agree to the requested algorithm and lock before any of them are applied
+	clauses := make([]sql.Node, len(n.Clauses))
+	copy(clauses, n.Clauses)
+	copying := false
+	for i, clause := range n.Clauses {
+		op, ok := plan.AlterOperationForClause(clause)
+		if !ok {
+			continue
+		}
+		table, ok := alterClauseTable(clause)
+		if !ok {
+			continue
+		}
+		algorithm, err := sql.NegotiateAlterAlgorithm(ctx, table, op, n.Algorithm, n.Lock)
+		if err != nil {
+			return nil, err
+		}
+		if algorithm == sql.AlterAlgorithm_Copy {
+			copying = true
+		}
+		clauses[i] = withAlterAlgorithm(clause, algorithm)
+	}
+	n = plan.NewAlterTable(clauses, n.Algorithm, n.Lock)
+
+	// A single column change copies the table by itself, and only replaces it once the copy succeeded
+	if copying && !(len(n.Clauses) == 1 && isCopyingColumnClause(n.Clauses[0])) {
+		return b.buildCopyingAlterClauses(ctx, n.Clauses, row)
+	}
+	if len(n.Clauses) == 1 {
+		return b.buildNodeExec(ctx, n.Clauses[0], row)
+	}
+
+	atomic, err := atomicAlterTable(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	if atomic == nil {
+		return b.buildBlock(ctx, plan.NewBlock(n.Clauses), row)
+	}
+
+	if err = atomic.BeginAlter(ctx); err != nil {
+		return nil, err
+	}
+	iter, err := b.buildBlock(ctx, plan.NewBlock(n.Clauses), row)
+	if err != nil {
+		if rollbackErr := atomic.RollbackAlter(ctx); rollbackErr != nil {
+			return nil, rollbackErr
+		}
+		return nil, err
+	}
+	if err = atomic.CommitAlter(ctx); err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
+
+// buildCopyingAlterClauses applies the clauses given, when some of them copy the table, to a copy of the table. The
+// copy takes the name of the table while the clauses are applied, with the table renamed aside, and the table is put
+// back in place unchanged if any of them fails. It's only dropped once every clause succeeded.
+func (b *BaseBuilder) buildCopyingAlterClauses(ctx *sql.Context, clauses []sql.Node, row sql.Row) (sql.RowIter, error) {
+	rt, ok := alterClauseResolvedTable(clauses[0])
+	if !ok || rt.Database == nil {
+		return b.buildBlock(ctx, plan.NewBlock(clauses), row)
+	}
+	db := rt.Database
+	table, err := getTableFromDatabase(ctx, db, rt)
+	if err != nil {
+		return nil, err
+	}
+	// Renaming the table may change its name, so it's kept here
+	name := table.Name()
+	for _, clause := range clauses {
+		if err = validateCopyingAlterClause(name, clause); err != nil {
+			return nil, err
+		}
+	}
+
+	sch := table.Schema()
+	projections := make([]sql.Expression, len(sch))
+	for i, col := range sch {
+		projections[i] = expression.NewGetField(i, col.Type, col.Name, col.Nullable)
+	}
+	shadowName, err := b.createShadowTable(ctx, db, table, sch, projections)
+	if err != nil {
+		return nil, err
+	}
+	oldName, err := replaceWithShadowTable(ctx, db, name, shadowName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, clause := range clauses {
+		// The copy is altered with the algorithm of the table itself, since it's been copied already. Clauses that copy
+		// it again replace it, so each clause is given the table currently named as it was.
+		clause = withAlterAlgorithm(clause, sql.AlterAlgorithm_Default)
+		clause, _, err = transform.Node(clause, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+			// tables renamed in place report the name they were renamed to
+			rt, ok := n.(*plan.ResolvedTable)
+			if !ok || rt.Database == nil || rt.Database.Name() != db.Name() ||
+				!(strings.EqualFold(rt.Name(), name) || strings.EqualFold(rt.Name(), oldName)) {
+				return n, transform.SameTree, nil
+			}
+			table, ok, err := db.GetTableInsensitive(ctx, name)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if !ok {
+				return nil, transform.SameTree, sql.ErrTableNotFound.New(name)
+			}
+			nrt := *rt
+			nrt.Table = table
+			return &nrt, transform.NewTree, nil
+		})
+		if err == nil {
+			var iter sql.RowIter
+			iter, err = b.buildNodeExec(ctx, clause, row)
+			if err == nil {
+				_, err = sql.RowIterToRows(ctx, nil, iter)
+			}
+		}
+		if err != nil {
+			if restoreErr := restoreReplacedTable(ctx, db, name, oldName); restoreErr != nil {
+				return nil, restoreErr
+			}
+			return nil, err
+		}
+	}
+
+	dropReplacedTable(ctx, db, name, oldName)
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// validateCopyingAlterClause returns an error if the ALTER TABLE clause given can't be applied to a copy of the table
+// named, because what it creates would keep referring to the copy once it replaces the table.
+func validateCopyingAlterClause(tableName string, clause sql.Node) error {
+	switch n := clause.(type) {
+	case *plan.CreateForeignKey, *plan.DropForeignKey:
+		return sql.ErrCopyAlterNotSupported.New(tableName, "table has foreign keys")
+	case *plan.AlterIndex:
+		if n.Action == plan.IndexAction_Create && n.Constraint == sql.IndexConstraint_Fulltext {
+			return sql.ErrCopyAlterNotSupported.New(tableName, "table has full-text indexes")
+		}
+	}
+	return nil
+}
+
+// isCopyingColumnClause returns whether the ALTER TABLE clause given changes a column by copying the table.
+func isCopyingColumnClause(clause sql.Node) bool {
+	switch n := clause.(type) {
+	case *plan.AddColumn:
+		return n.Algorithm == sql.AlterAlgorithm_Copy
+	case *plan.DropColumn:
+		return n.Algorithm == sql.AlterAlgorithm_Copy
+	case *plan.RenameColumn:
+		return n.Algorithm == sql.AlterAlgorithm_Copy
+	case *plan.ModifyColumn:
+		return n.Algorithm == sql.AlterAlgorithm_Copy
+	default:
+		return false
+	}
+}
+
+// atomicAlterTable returns the table altered by the statement given if it can apply all of the statement's clauses as
+// a single schema change, or nil if it cannot.
+func atomicAlterTable(ctx *sql.Context, n *plan.AlterTable) (sql.AtomicAlterableTable, error) {
+	for _, clause := range n.Clauses {
+		rt, ok := alterClauseResolvedTable(clause)
+		if !ok || rt.Database == nil {
+			continue
+		}
+		// Grab the table fresh from the database, since this is the table the clauses will modify
+		table, err := getTableFromDatabase(ctx, rt.Database, rt)
+		if err != nil {
+			return nil, err
+		}
+		atomic, _ := unwrapTable(table).(sql.AtomicAlterableTable)
+		return atomic, nil
+	}
+	return nil, nil
+}
+
+// alterClauseTable returns the table altered by the ALTER TABLE clause given, unwrapped from any table wrappers.
+func alterClauseTable(clause sql.Node) (sql.Table, bool) {
+	rt, ok := alterClauseResolvedTable(clause)
+	if !ok {
+		return nil, false
+	}
+	return unwrapTable(rt.Table), true
+}
+
+// alterClauseResolvedTable returns the resolved table altered by the ALTER TABLE clause given.
+func alterClauseResolvedTable(clause sql.Node) (*plan.ResolvedTable, bool) {
+	var rt *plan.ResolvedTable
+	transform.Inspect(clause, func(n sql.Node) bool {
+		if t, ok := n.(*plan.ResolvedTable); ok {
+			rt = t
+			return false
+		}
+		return rt == nil
+	})
+	return rt, rt != nil
+}
+
+// unwrapTable returns the table given with any table wrappers removed.
+func unwrapTable(table sql.Table) sql.Table {
+	for {
+		tw, ok := table.(sql.TableWrapper)
+		if !ok {
+			return table
+		}
+		table = tw.Underlying()
+	}
+}
+
+// withAlterAlgorithm returns the ALTER TABLE clause given with the algorithm negotiated for it, for the clauses the
+// engine can perform by copying the table. Other clauses are returned unchanged.
+func withAlterAlgorithm(clause sql.Node, algorithm sql.AlterAlgorithm) sql.Node {
+	switch n := clause.(type) {
+	case *plan.AddColumn:
+		nc := *n
+		nc.Algorithm = algorithm
+		return &nc
+	case *plan.DropColumn:
+		nc := *n
+		nc.Algorithm = algorithm
+		return &nc
+	case *plan.RenameColumn:
+		nc := *n
+		nc.Algorithm = algorithm
+		return &nc
+	case *plan.ModifyColumn:
+		nc := *n
+		nc.Algorithm = algorithm
+		return &nc
+	default:
+		return clause
+	}
+}
+
+// usesCopyAlgorithm returns whether the operation given is performed by copying the table, either because the
+// algorithm negotiated for it is COPY, or because the table given can only perform it with the COPY algorithm.
+func usesCopyAlgorithm(ctx *sql.Context, table sql.Table, op sql.AlterOperation, algorithm sql.AlterAlgorithm) bool {
+	if algorithm == sql.AlterAlgorithm_Copy {
+		return true
+	}
+	aat, ok := table.(sql.AlterAlgorithmTable)
+	return ok && aat.AlterAlgorithm(ctx, op) == sql.AlterAlgorithm_Copy
+}
+
+// copyTableNameCounter numbers the tables created by copyAlterTable, so that concurrent ALTER TABLE statements don't
+// create tables with the same name.
+var copyTableNameCounter uint64
+
+// newCopyTableName returns the name of a table that doesn't exist in the database given, to hold a copy of the table
+// named while it's altered.
+func newCopyTableName(ctx *sql.Context, db sql.Database, tableName string) (string, error) {
+	for {
+		name := fmt.Sprintf("#sql-%x-%x-%s", ctx.ID(), atomic.AddUint64(&copyTableNameCounter, 1), tableName)
+		_, exists, err := db.GetTableInsensitive(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return name, nil
+		}
+	}
+}
+
+// copyAlterTable performs an ALTER TABLE with the COPY algorithm. A shadow table with the new schema is created in
+// the table's database, every row of the table is copied into it through |projections|, and the shadow table then
+// replaces the original. Secondary indexes and check constraints are recreated on the shadow table, with |renames|
+// applied to their columns and any columns no longer in the schema removed from indexes.
+func (b *BaseBuilder) copyAlterTable(ctx *sql.Context, db sql.Database, table sql.Table, newSch sql.Schema, projections []sql.Expression, renames ...sql.ColumnRename) error {
+	shadowName, err := b.createShadowTable(ctx, db, table, newSch, projections, renames...)
+	if err != nil {
+		return err
+	}
+	return swapShadowTable(ctx, db, table.Name(), shadowName)
+}
+
+// createShadowTable creates a shadow table with the schema given in the database of |table|, and copies every row of
+// the table into it through |projections|, along with its secondary indexes, check constraints, table options and
+// AUTO_INCREMENT value. Returns the name of the shadow table. The engine can't copy foreign keys or full-text indexes,
+// which integrators' tables that have them must alter themselves.
+func (b *BaseBuilder) createShadowTable(ctx *sql.Context, db sql.Database, table sql.Table, newSch sql.Schema, projections []sql.Expression, renames ...sql.ColumnRename) (string, error) {
+	creator, ok := db.(sql.TableCreator)
+	if !ok {
+		return "", sql.ErrCopyAlterNotSupported.New(table.Name(), "database cannot create tables")
+	}
+	dropper, ok := db.(sql.TableDropper)
+	if !ok {
+		return "", sql.ErrCopyAlterNotSupported.New(table.Name(), "database cannot drop tables")
+	}
+	if _, ok = db.(sql.TableRenamer); !ok {
+		return "", sql.ErrCopyAlterNotSupported.New(table.Name(), "database cannot rename tables")
+	}
+
+	if fkTable, ok := table.(sql.ForeignKeyTable); ok {
+		declared, err := fkTable.GetDeclaredForeignKeys(ctx)
+		if err != nil {
+			return "", err
+		}
+		referenced, err := fkTable.GetReferencedForeignKeys(ctx)
+		if err != nil {
+			return "", err
+		}
+		if len(declared) > 0 || len(referenced) > 0 {
+			return "", sql.ErrCopyAlterNotSupported.New(table.Name(), "table has foreign keys")
+		}
+	}
+	indexed, ok := table.(sql.IndexAddressableTable)
+	if ok {
+		indexes, err := indexed.GetIndexes(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, index := range indexes {
+			if strings.EqualFold(index.IndexType(), "FULLTEXT") {
+				return "", sql.ErrCopyAlterNotSupported.New(table.Name(), "table has full-text indexes")
+			}
+		}
+	}
+
+	shadowName, err := newCopyTableName(ctx, db, table.Name())
+	if err != nil {
+		return "", err
+	}
+	pkSch := sql.SchemaToPrimaryKeySchema(table, newSch, renames...)
+	shadowSch := newSch.Copy()
+	for _, col := range shadowSch {
+		col.Source = shadowName
+	}
+	// Primary key columns that were dropped no longer have an ordinal
+	var pkOrdinals []int
+	for _, ord := range pkSch.PkOrdinals {
+		if ord >= 0 {
+			pkOrdinals = append(pkOrdinals, ord)
+		}
+	}
+	err = creator.CreateTable(ctx, shadowName, sql.NewPrimaryKeySchema(shadowSch, pkOrdinals...), table.Collation())
+	if err != nil {
+		return "", err
+	}
+
+	err = b.copyIntoShadowTable(ctx, db, table, shadowName, newSch, projections, renames)
+	if err != nil {
+		_ = dropper.DropTable(ctx, shadowName)
+		return "", err
+	}
+	return shadowName, nil
+}
+
+// swapShadowTable replaces the table named with the shadow table named, which is dropped if it can't replace it.
+func swapShadowTable(ctx *sql.Context, db sql.Database, name, shadowName string) error {
+	oldName, err := replaceWithShadowTable(ctx, db, name, shadowName)
+	if err != nil {
+		return err
+	}
+	dropReplacedTable(ctx, db, name, oldName)
+	return nil
+}
+
+// replaceWithShadowTable renames the table named aside, and gives its name to the shadow table named, which is dropped
+// if it can't take it. Returns the name the table was renamed to. The table is renamed aside rather than dropped, so
+// that it's never lost if a rename fails, and so that restoreReplacedTable can put it back.
+func replaceWithShadowTable(ctx *sql.Context, db sql.Database, name, shadowName string) (string, error) {
+	dropper := db.(sql.TableDropper)
+	renamer := db.(sql.TableRenamer)
+
+	oldName, err := newCopyTableName(ctx, db, name)
+	if err != nil {
+		_ = dropper.DropTable(ctx, shadowName)
+		return "", err
+	}
+	if err = renamer.RenameTable(ctx, name, oldName); err != nil {
+		_ = dropper.DropTable(ctx, shadowName)
+		return "", err
+	}
+	if err = renamer.RenameTable(ctx, shadowName, name); err != nil {
+		if rollbackErr := renamer.RenameTable(ctx, oldName, name); rollbackErr != nil {
+			return "", rollbackErr
+		}
+		_ = dropper.DropTable(ctx, shadowName)
+		return "", err
+	}
+	return oldName, nil
+}
+
+// restoreReplacedTable drops the table named, and puts back the table that replaceWithShadowTable renamed to |oldName|.
+func restoreReplacedTable(ctx *sql.Context, db sql.Database, name, oldName string) error {
+	if err := db.(sql.TableDropper).DropTable(ctx, name); err != nil {
+		return err
+	}
+	return db.(sql.TableRenamer).RenameTable(ctx, oldName, name)
+}
+
+// dropReplacedTable drops the table that replaceWithShadowTable renamed to |oldName|.
+func dropReplacedTable(ctx *sql.Context, db sql.Database, name, oldName string) {
+	if err := db.(sql.TableDropper).DropTable(ctx, oldName); err != nil {
+		// the table has been altered, so the copy of the original that's left behind only warns
+		ctx.GetLogger().Warnf("unable to drop table %s after altering table %s: %s", oldName, name, err)
+	}
+}
+
+// copyIntoShadowTable copies the rows, AUTO_INCREMENT value, check constraints and secondary indexes of |table| into
+// the shadow table named.
+func (b *BaseBuilder) copyIntoShadowTable(ctx *sql.Context, db sql.Database, table sql.Table, shadowName string, newSch sql.Schema, projections []sql.Expression, renames []sql.ColumnRename) error {
+	shadow, ok, err := db.GetTableInsensitive(ctx, shadowName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return sql.ErrTableNotFound.New(shadowName)
+	}
+	insertable, ok := shadow.(sql.InsertableTable)
+	if !ok {
+		return plan.ErrInsertIntoNotSupported.New()
+	}
+	if optionsTable, ok := table.(sql.TableOptionsTable); ok && len(optionsTable.TableOptions()) > 0 {
+		alterable, ok := shadow.(sql.TableOptionsAlterableTable)
+		if !ok {
+			return sql.ErrCopyAlterNotSupported.New(table.Name(), "table options cannot be set on the new table")
+		}
+		if err = alterable.SetTableOptions(ctx, optionsTable.TableOptions()); err != nil {
+			return err
+		}
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	rowIter := sql.NewTableRowIter(ctx, table, partitions)
+	// TIMESTAMP values are projected in the session's time zone, like in ALTER TABLE ... MODIFY COLUMN
+	tsLoc := sql.TimestampLocation(ctx)
+	oldSch := table.Schema()
+	inserter := insertable.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	for {
+		r, err := rowIter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = inserter.DiscardChanges(ctx, err)
+			_ = inserter.Close(ctx)
+			return err
+		}
+
+		newRow, err := ProjectRow(ctx, projections, sql.RowTimestampsFromUTC(oldSch, r, tsLoc))
+		if err != nil {
+			_ = inserter.DiscardChanges(ctx, err)
+			_ = inserter.Close(ctx)
+			return err
+		}
+		// values are converted to the types of the new schema, as a column modified in place converts them
+		for i, v := range newRow {
+			converted, inRange, err := newSch[i].Type.Convert(v)
+			if err == nil && !inRange {
+				err = sql.ErrValueOutOfRange.New(v, newSch[i].Type)
+			}
+			if err != nil {
+				_ = inserter.DiscardChanges(ctx, err)
+				_ = inserter.Close(ctx)
+				return err
+			}
+			newRow[i] = converted
+		}
+		newRow = sql.RowTimestampsToUTC(newSch, newRow, tsLoc)
+		if err = inserter.Insert(ctx, newRow); err != nil {
+			_ = inserter.DiscardChanges(ctx, err)
+			_ = inserter.Close(ctx)
+			return err
+		}
+	}
+	if err = rowIter.Close(ctx); err != nil {
+		return err
+	}
+	if err = inserter.StatementComplete(ctx); err != nil {
+		return err
+	}
+	if err = inserter.Close(ctx); err != nil {
+		return err
+	}
+
+	// the shadow table goes on from the AUTO_INCREMENT value of the table, rather than from the rows copied into it
+	if aiTable, ok := table.(sql.AutoIncrementTable); ok && oldSch.HasAutoIncrement() && newSch.HasAutoIncrement() {
+		shadowAI, ok := shadow.(sql.AutoIncrementTable)
+		if !ok {
+			return sql.ErrCopyAlterNotSupported.New(table.Name(), "AUTO_INCREMENT cannot be set on the new table")
+		}
+		next, err := aiTable.PeekNextAutoIncrementValue(ctx)
+		if err != nil {
+			return err
+		}
+		setter := shadowAI.AutoIncrementSetter(ctx)
+		if err = setter.SetAutoIncrementValue(ctx, next); err != nil {
+			_ = setter.Close(ctx)
+			return err
+		}
+		if err = setter.Close(ctx); err != nil {
+			return err
+		}
+	}
+
+	if checkTable, ok := table.(sql.CheckTable); ok {
+		checks, err := checkTable.GetChecks(ctx)
+		if err != nil {
+			return err
+		}
+		if len(checks) > 0 {
+			checkAlterable, ok := shadow.(sql.CheckAlterableTable)
+			if !ok {
+				return sql.ErrCopyAlterNotSupported.New(table.Name(), "check constraints cannot be created on the new table")
+			}
+			for _, check := range checks {
+				def := check
+				for _, rename := range renames {
+					if def.CheckExpression, _, err = renameColumnInCheckExpression(def.CheckExpression, rename.Before, rename.After); err != nil {
+						return err
+					}
+				}
+				if err = checkAlterable.CreateCheck(ctx, &def); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	indexed, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil
+	}
+	indexes, err := indexed.GetIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	alterable, ok := shadow.(sql.IndexAlterableTable)
+	for _, index := range indexes {
+		if index.ID() == "PRIMARY" || index.IsGenerated() {
+			continue
+		}
+		if !ok {
+			return sql.ErrCopyAlterNotSupported.New(table.Name(), "indexes cannot be created on the new table")
+		}
+
+		idxDef := sql.IndexDef{
+			Name:       index.ID(),
+			Constraint: sql.IndexConstraint_None,
+			Storage:    sql.IndexUsing_BTree,
+			Comment:    index.Comment(),
+		}
+		if strings.EqualFold(index.IndexType(), "HASH") {
+			idxDef.Storage = sql.IndexUsing_Hash
+		}
+		if index.IsUnique() {
+			idxDef.Constraint = sql.IndexConstraint_Unique
+		} else if index.IsSpatial() {
+			idxDef.Constraint = sql.IndexConstraint_Spatial
+		}
+		prefixLengths := index.PrefixLengths()
+		for i, expr := range index.Expressions() {
+			colName := expr[strings.IndexByte(expr, '.')+1:]
+			for _, rename := range renames {
+				if strings.EqualFold(rename.Before, colName) {
+					colName = rename.After
+				}
+			}
+			if !newSch.Contains(colName, table.Name()) {
+				continue
+			}
+			idxCol := sql.IndexColumn{Name: colName}
+			if i < len(prefixLengths) {
+				idxCol.Length = int64(prefixLengths[i])
+			}
+			idxDef.Columns = append(idxDef.Columns, idxCol)
+		}
+		if len(idxDef.Columns) == 0 {
+			continue
+		}
+		if err = alterable.CreateIndex(ctx, idxDef); err != nil {
+			return err
+		}
+	}
+	return nil
+}
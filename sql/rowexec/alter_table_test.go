@@ -15,12 +15,16 @@
 package rowexec
 
 import (
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -376,6 +380,171 @@ func TestModifyColumnInSchema(t *testing.T) {
 	}
 }
 
+// copyOnlyTable is a table that can only be altered with the COPY algorithm.
+type copyOnlyTable struct {
+	*memory.Table
+}
+
+func (t copyOnlyTable) AlterAlgorithm(ctx *sql.Context, op sql.AlterOperation) sql.AlterAlgorithm {
+	return sql.AlterAlgorithm_Copy
+}
+
+// copyOnlyDatabase is a database whose tables can only be altered with the COPY algorithm.
+type copyOnlyDatabase struct {
+	*memory.Database
+}
+
+func (d copyOnlyDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	tbl, ok, err := d.Database.GetTableInsensitive(ctx, tblName)
+	if !ok || err != nil {
+		return tbl, ok, err
+	}
+	return copyOnlyTable{tbl.(*memory.Table)}, true, nil
+}
+
+func TestCopyAlgorithm(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	memDb := memory.NewDatabase("mydb")
+	db := copyOnlyDatabase{memDb}
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "j", Type: types.Int64, Source: "mytable", Nullable: true},
+	})
+	require.NoError(memDb.CreateTable(ctx, "mytable", sch, sql.Collation_Default))
+	tbl, _, err := db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	require.NoError(tbl.(copyOnlyTable).CreateIndex(ctx, sql.IndexDef{
+		Name:    "idx_j",
+		Columns: []sql.IndexColumn{{Name: "j"}},
+	}))
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(tbl.(copyOnlyTable).Insert(ctx, sql.NewRow(i, i*10)))
+	}
+
+	run := func(n sql.Node) {
+		iter, err := DefaultBuilder.Build(ctx, n, nil)
+		require.NoError(err)
+		for {
+			_, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(err)
+		}
+		require.NoError(iter.Close(ctx))
+	}
+	rows := func() []sql.Row {
+		tbl, ok, err := memDb.GetTableInsensitive(ctx, "mytable")
+		require.NoError(err)
+		require.True(ok)
+		rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, tbl, mustPartitions(ctx, tbl)))
+		require.NoError(err)
+		return rows
+	}
+
+	addColumn := plan.NewAddColumnResolved(
+		plan.NewResolvedTable(tbl, db, nil),
+		sql.Column{
+			Name:    "k",
+			Type:    types.Int64,
+			Default: mustDefault(expression.NewLiteral(int64(7), types.Int64), types.Int64, true, false, false),
+		},
+		&sql.ColumnOrder{First: true},
+	)
+	n, err := addColumn.WithTargetSchema(tbl.Schema().Copy())
+	require.NoError(err)
+	run(n)
+	assert.Equal(t, []sql.Row{{int64(7), int64(1), int64(10)}, {int64(7), int64(2), int64(20)}, {int64(7), int64(3), int64(30)}}, rows())
+
+	tbl, _, err = db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	dropColumn := plan.NewDropColumnResolved(plan.NewResolvedTable(tbl, db, nil), "i")
+	n, err = dropColumn.WithTargetSchema(tbl.Schema().Copy())
+	require.NoError(err)
+	run(n)
+	assert.Equal(t, []sql.Row{{int64(7), int64(10)}, {int64(7), int64(20)}, {int64(7), int64(30)}}, rows())
+
+	tbl, _, err = db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	renameColumn := plan.NewRenameColumnResolved(plan.NewResolvedTable(tbl, db, nil), "j", "l")
+	n, err = renameColumn.WithTargetSchema(tbl.Schema().Copy())
+	require.NoError(err)
+	run(n)
+
+	tbl, _, err = memDb.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	assert.Equal(t, []string{"k", "l"}, []string{tbl.Schema()[0].Name, tbl.Schema()[1].Name})
+	indexes, err := tbl.(sql.IndexAddressableTable).GetIndexes(ctx)
+	require.NoError(err)
+	require.Len(indexes, 1)
+	assert.Equal(t, "idx_j", indexes[0].ID())
+	assert.Equal(t, []string{"mytable.l"}, indexes[0].Expressions())
+
+	names, err := memDb.GetTableNames(ctx)
+	require.NoError(err)
+	assert.Equal(t, []string{"mytable"}, names)
+}
+
+// failingRenameDatabase is a copyOnlyDatabase that fails to rename the first copy of a table back into place.
+type failingRenameDatabase struct {
+	copyOnlyDatabase
+	failed bool
+}
+
+func (d *failingRenameDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
+	if !d.failed && strings.HasPrefix(oldName, "#sql-") {
+		d.failed = true
+		return fmt.Errorf("unable to rename %s", oldName)
+	}
+	return d.copyOnlyDatabase.RenameTable(ctx, oldName, newName)
+}
+
+func TestCopyAlgorithmRenameFailure(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	memDb := memory.NewDatabase("mydb")
+	db := &failingRenameDatabase{copyOnlyDatabase: copyOnlyDatabase{memDb}}
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+	})
+	require.NoError(memDb.CreateTable(ctx, "mytable", sch, sql.Collation_Default))
+	tbl, _, err := db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	require.NoError(tbl.(copyOnlyTable).Insert(ctx, sql.NewRow(int64(1))))
+
+	addColumn := plan.NewAddColumnResolved(
+		plan.NewResolvedTable(tbl, db, nil),
+		sql.Column{Name: "j", Type: types.Int64, Nullable: true},
+		nil,
+	)
+	n, err := addColumn.WithTargetSchema(tbl.Schema().Copy())
+	require.NoError(err)
+	_, err = DefaultBuilder.Build(ctx, n, nil)
+	require.Error(err)
+
+	// the original table is back in place, with its rows, and the tables created for the copy are gone
+	names, err := memDb.GetTableNames(ctx)
+	require.NoError(err)
+	assert.Equal(t, []string{"mytable"}, names)
+	tbl, _, err = memDb.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	assert.Len(t, tbl.Schema(), 1)
+	rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, tbl, mustPartitions(ctx, tbl)))
+	require.NoError(err)
+	assert.Equal(t, []sql.Row{{int64(1)}}, rows)
+}
+
+func mustPartitions(ctx *sql.Context, tbl sql.Table) sql.PartitionIter {
+	partitions, err := tbl.Partitions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return partitions
+}
+
 // mustDefault enforces that no error occurred when constructing the column default value.
 func mustDefault(expr sql.Expression, outType sql.Type, representsLiteral bool, parenthesized bool, mayReturnNil bool) *sql.ColumnDefaultValue {
 	colDef, err := sql.NewColumnDefaultValue(expr, outType, representsLiteral, parenthesized, mayReturnNil)
@@ -384,3 +553,48 @@ func mustDefault(expr sql.Expression, outType sql.Type, representsLiteral bool,
 	}
 	return colDef
 }
+
+func TestCopyAlgorithmMultiClauseFailure(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	memDb := memory.NewDatabase("mydb")
+	db := copyOnlyDatabase{memDb}
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "j", Type: types.Int64, Source: "mytable", Nullable: true},
+	})
+	require.NoError(memDb.CreateTable(ctx, "mytable", sch, sql.Collation_Default))
+	tbl, _, err := db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	require.NoError(tbl.(copyOnlyTable).Insert(ctx, sql.NewRow(int64(1), int64(1000))))
+
+	addColumn, err := plan.NewAddColumnResolved(
+		plan.NewResolvedTable(tbl, db, nil),
+		sql.Column{Name: "k", Type: types.Int64, Source: "mytable", Nullable: true},
+		nil,
+	).WithTargetSchema(tbl.Schema().Copy())
+	require.NoError(err)
+	newSch := append(tbl.Schema().Copy(), &sql.Column{Name: "k", Type: types.Int64, Source: "mytable", Nullable: true})
+	modifyColumn, err := plan.NewModifyColumnResolved(
+		plan.NewResolvedTable(tbl, db, nil),
+		"j",
+		sql.Column{Name: "j", Type: types.Int8, Source: "mytable", Nullable: true},
+		nil,
+	).WithTargetSchema(newSch)
+	require.NoError(err)
+
+	// the column is added to a copy of the table, which is dropped when the second clause fails
+	_, err = DefaultBuilder.Build(ctx, plan.NewAlterTable([]sql.Node{addColumn, modifyColumn}, sql.AlterAlgorithm_Default, sql.AlterLock_Default), nil)
+	require.True(sql.ErrValueOutOfRange.Is(err), "unexpected error: %v", err)
+
+	names, err := memDb.GetTableNames(ctx)
+	require.NoError(err)
+	assert.Equal(t, []string{"mytable"}, names)
+	tbl, _, err = memDb.GetTableInsensitive(ctx, "mytable")
+	require.NoError(err)
+	assert.Len(t, tbl.Schema(), 2)
+	rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, tbl, mustPartitions(ctx, tbl)))
+	require.NoError(err)
+	assert.Equal(t, []sql.Row{{int64(1), int64(1000)}}, rows)
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func (b *BaseBuilder) buildBackupDatabase(ctx *sql.Context, n *plan.BackupDatabase, row sql.Row) (sql.RowIter, error) {
+	if n.Dumper == nil {
+		return nil, sql.ErrUnsupportedFeature.New("BACKUP DATABASE")
+	}
+
+	_, dir, ok := sql.SystemVariables.GetGlobal("secure_file_priv")
+	if !ok || dir == nil {
+		dir = ""
+	}
+	fileName := filepath.Join(dir.(string), n.Path)
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if errors.Is(err, fs.ErrExist) {
+		return nil, sql.ErrFileExists.New(n.Path)
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = n.Dumper.DumpDatabase(ctx, n.Database, file, n.Options)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// An incomplete dump isn't left behind
+		os.Remove(fileName)
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
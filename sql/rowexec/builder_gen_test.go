@@ -47,7 +47,14 @@ func TestGenBuilder(t *testing.T) {
 		"RenameColumn":              "*plan.RenameColumn",
 		"ModifyColumn":              "*plan.ModifyColumn",
 		"AlterTableCollation":       "*plan.AlterTableCollation",
+		"AlterTable":                "*plan.AlterTable",
+		"CreateSequence":            "*plan.CreateSequence",
+		"AlterSequence":             "*plan.AlterSequence",
+		"DropSequence":              "*plan.DropSequence",
 		"AnalyzeTable":              "*plan.AnalyzeTable",
+		"ChecksumTable":             "*plan.ChecksumTable",
+		"CheckTable":                "*plan.CheckTable",
+		"OptimizeTable":             "*plan.OptimizeTable",
 		"BeginEndBlock":             "*plan.BeginEndBlock",
 		"Block":                     "*plan.Block",
 		"CachedResults":             "*plan.CachedResults",
@@ -59,6 +66,7 @@ func TestGenBuilder(t *testing.T) {
 		"CreateIndex":               "*plan.CreateIndex",
 		"CreateRole":                "*plan.CreateRole",
 		"CreateUser":                "*plan.CreateUser",
+		"AlterUser":                 "*plan.AlterUser",
 		"CreateView":                "*plan.CreateView",
 		"CreateDB":                  "*plan.CreateDB",
 		"DropDB":                    "*plan.DropDB",
@@ -84,12 +92,17 @@ func TestGenBuilder(t *testing.T) {
 		"SingleDropView":            "*plan.SingleDropView",
 		"DropView":                  "*plan.DropView",
 		"EmptyTable":                "*plan.EmptyTable",
+		"CachedQueryResults":        "*plan.CachedQueryResults",
 		"Exchange":                  "*plan.Exchange",
 		"exchangePartition":         "*plan.exchangePartition",
 		"ExternalProcedure":         "*plan.ExternalProcedure",
 		"Fetch":                     "*plan.Fetch",
 		"Filter":                    "*plan.Filter",
+		"TableSample":               "*plan.TableSample",
 		"FlushPrivileges":           "*plan.FlushPrivileges",
+		"FlushTables":               "*plan.FlushTables",
+		"FlushLogs":                 "*plan.FlushLogs",
+		"BackupDatabase":            "*plan.BackupDatabase",
 		"ForeignKeyHandler":         "*plan.ForeignKeyHandler",
 		"Grant":                     "*plan.Grant",
 		"GrantRole":                 "*plan.GrantRole",
@@ -106,6 +119,7 @@ func TestGenBuilder(t *testing.T) {
 		"Into":                      "*plan.Into",
 		"Iterate":                   "*plan.Iterate",
 		"JoinNode":                  "*plan.JoinNode",
+		"AdaptiveJoin":              "*plan.AdaptiveJoin",
 		"JSONTable":                 "plan.JSONTable",
 		"Kill":                      "*plan.Kill",
 		"Leave":                     "*plan.Leave",
@@ -141,6 +155,7 @@ func TestGenBuilder(t *testing.T) {
 		"RevokeRole":                "*plan.RevokeRole",
 		"RevokeProxy":               "*plan.RevokeProxy",
 		"RowUpdateAccumulator":      "plan.RowUpdateAccumulator",
+		"Returning":                 "*plan.Returning",
 		"Set":                       "*plan.Set",
 		"ShowCharset":               "*plan.ShowCharset",
 		"ShowCreateDatabase":        "*plan.ShowCreateDatabase",
@@ -150,6 +165,7 @@ func TestGenBuilder(t *testing.T) {
 		"ShowGrants":                "*plan.ShowGrants",
 		"ShowIndexes":               "*plan.ShowIndexes",
 		"ShowPrivileges":            "*plan.ShowPrivileges",
+		"ShowEngineStatus":          "*plan.ShowEngineStatus",
 		"ShowReplicaStatus":         "*plan.ShowReplicaStatus",
 		"ShowStatus":                "*plan.ShowStatus",
 		"ShowTriggers":              "*plan.ShowTriggers",
@@ -184,6 +200,7 @@ func TestGenBuilder(t *testing.T) {
 		"UnresolvedTable":           "*plan.UnresolvedTable",
 		"DeferredAsOfTable":         "*plan.DeferredAsOfTable",
 		"DeferredFilteredTable":     "*plan.DeferredFilteredTable",
+		"DeferredTableFunction":     "*plan.DeferredTableFunction",
 		"Update":                    "*plan.Update",
 		"UpdateJoin":                "*plan.UpdateJoin",
 		"UpdateSource":              "*plan.UpdateSource",
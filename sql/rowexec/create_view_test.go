@@ -60,7 +60,7 @@ func TestCreateViewWithRegistry(t *testing.T) {
 	_, err := DefaultBuilder.buildNodeExec(ctx, createView, nil)
 	require.NoError(err)
 
-	expectedView := sql.NewView(createView.Name, createView.Child, createView.Definition.TextDefinition, createView.CreateViewString)
+	expectedView := createView.View()
 	actualView, ok := ctx.GetViewRegistry().View(createView.Database().Name(), createView.Name)
 	require.True(ok)
 	require.Equal(expectedView, actualView)
@@ -151,6 +151,18 @@ func (b *BaseBuilder) buildDropConstraint(ctx *sql.Context, n *plan.DropConstrai
 
 func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row sql.Row) (sql.RowIter, error) {
 	registry := ctx.GetViewRegistry()
+	if n.IsAlter {
+		exists := registry.Exists(n.Database().Name(), n.Name)
+		if vdb, ok := n.Database().(sql.ViewDatabase); ok {
+			var err error
+			if _, exists, err = vdb.GetViewDefinition(ctx, n.Name); err != nil {
+				return nil, err
+			}
+		}
+		if !exists {
+			return nil, sql.ErrViewDoesNotExist.New(n.Database().Name(), n.Name)
+		}
+	}
 	if n.IsReplace {
 		if dropper, ok := n.Database().(sql.ViewDatabase); ok {
 			err := dropper.DropView(ctx, n.Name)
@@ -177,6 +189,9 @@ func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row
 	// TODO: isUpdatable should be defined at CREATE VIEW time
 	// isUpdatable := GetIsUpdatableFromCreateView(cv)
 
+	if creator, ok := n.Database().(sql.ViewDefinitionDatabase); ok {
+		return sql.RowsToRowIter(), creator.CreateViewDefinition(ctx, n.ViewDefinition())
+	}
 	creator, ok := n.Database().(sql.ViewDatabase)
 	if ok {
 		return sql.RowsToRowIter(), creator.CreateView(ctx, n.Name, n.Definition.TextDefinition, n.CreateViewString)
@@ -242,11 +257,6 @@ func (b *BaseBuilder) buildModifyColumn(ctx *sql.Context, n *plan.ModifyColumn,
 		return nil, err
 	}
 
-	alterable, ok := tbl.(sql.AlterableTable)
-	if !ok {
-		return nil, sql.ErrAlterTableNotSupported.New(tbl.Name())
-	}
-
 	if err := n.ValidateDefaultPosition(n.TargetSchema()); err != nil {
 		return nil, err
 	}
@@ -255,20 +265,34 @@ func (b *BaseBuilder) buildModifyColumn(ctx *sql.Context, n *plan.ModifyColumn,
 	// does not create a reference to the table's collation, which may change at any point, and therefore will have no
 	// relation to this column after assignment.
 	if collatedType, ok := n.NewColumn().Type.(sql.TypeWithCollation); ok && collatedType.Collation() == sql.Collation_Unspecified {
-		n.NewColumn().Type, err = collatedType.WithNewCollation(alterable.Collation())
+		n.NewColumn().Type, err = collatedType.WithNewCollation(tbl.Collation())
 		if err != nil {
 			return nil, err
 		}
 	}
 	for _, col := range n.TargetSchema() {
 		if collatedType, ok := col.Type.(sql.TypeWithCollation); ok && collatedType.Collation() == sql.Collation_Unspecified {
-			col.Type, err = collatedType.WithNewCollation(alterable.Collation())
+			col.Type, err = collatedType.WithNewCollation(tbl.Collation())
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if usesCopyAlgorithm(ctx, tbl, sql.AlterOperation_ModifyColumn, n.Algorithm) {
+		newSch, projections, err := modifyColumnInSchema(n.TargetSchema(), n.Column(), n.NewColumn(), n.Order())
+		if err != nil {
+			return nil, err
+		}
+		rename := sql.ColumnRename{Before: n.Column(), After: n.NewColumn().Name}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), b.copyAlterTable(ctx, n.Db, tbl, newSch, projections, rename)
+	}
+
+	alterable, ok := tbl.(sql.AlterableTable)
+	if !ok {
+		return nil, sql.ErrAlterTableNotSupported.New(tbl.Name())
+	}
+
 	return &modifyColumnIter{
 		m:         n,
 		alterable: alterable,
@@ -393,6 +417,27 @@ func (b *BaseBuilder) buildCreateDB(ctx *sql.Context, n *plan.CreateDB, row sql.
 		return nil, err
 	}
 
+	if n.Comment != nil && *n.Comment != "" {
+		db, err := n.Catalog.Database(ctx, n.DbName)
+		if err != nil {
+			return nil, err
+		}
+		commentedDb, ok := db.(sql.CommentedDatabase)
+		if ok {
+			err = commentedDb.SetComment(ctx, *n.Comment)
+		}
+		if !ok || sql.ErrDatabaseCommentsNotSupported.Is(err) {
+			// the database has already been created, so an unsupported comment only warns
+			ctx.Session.Warn(&sql.Warning{
+				Level:   "Warning",
+				Code:    mysql.ERNotSupportedYet,
+				Message: sql.ErrDatabaseCommentsNotSupported.New(n.DbName).Error(),
+			})
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -456,6 +501,10 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, row
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
 	userTableData := mysqlDb.UserTable().Data()
+	tlsOptions := plan.TLSOptions{}
+	if n.TLSOptions != nil {
+		tlsOptions = *n.TLSOptions
+	}
 	for _, user := range n.Users {
 		// replace empty host with any host
 		if user.UserName.Host == "" {
@@ -487,19 +536,29 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, row
 		}
 		// TODO: attributes should probably not be nil, but setting it to &n.Attribute causes unexpected behavior
 		// TODO: validate all of the data
-		err := userTableData.Put(ctx, &mysql_db.User{
+		userEntry := &mysql_db.User{
 			User:                user.UserName.Name,
 			Host:                user.UserName.Host,
 			PrivilegeSet:        mysql_db.NewPrivilegeSet(),
 			Plugin:              plugin,
 			Password:            password,
 			PasswordLastChanged: time.Now().UTC(),
-			Locked:              false,
+			Locked:              n.Locked,
 			Attributes:          nil,
 			IsRole:              false,
 			Identity:            user.Identity,
-		})
-		if err != nil {
+			SslType:             tlsOptions.SslType(),
+			SslCipher:           tlsOptions.Cipher,
+			X509Issuer:          tlsOptions.Issuer,
+			X509Subject:         tlsOptions.Subject,
+		}
+		if err := n.PasswordOptions.ApplyTo(userEntry); err != nil {
+			return nil, sql.ErrUserCreationFailure.New(err)
+		}
+		if err := userTableData.Put(ctx, userEntry); err != nil {
+			return nil, err
+		}
+		if err := mysqlDb.RecordPasswordHistory(ctx, userEntry); err != nil {
 			return nil, err
 		}
 	}
@@ -673,11 +732,6 @@ func (b *BaseBuilder) buildRenameColumn(ctx *sql.Context, n *plan.RenameColumn,
 		return nil, err
 	}
 
-	alterable, ok := tbl.(sql.AlterableTable)
-	if !ok {
-		return nil, sql.ErrAlterTableNotSupported.New(tbl.Name())
-	}
-
 	idx := n.TargetSchema().IndexOf(n.ColumnName, tbl.Name())
 	if idx < 0 {
 		return nil, sql.ErrTableColumnNotFound.New(tbl.Name(), n.ColumnName)
@@ -687,9 +741,26 @@ func (b *BaseBuilder) buildRenameColumn(ctx *sql.Context, n *plan.RenameColumn,
 	nc.Name = n.NewColumnName
 	col := &nc
 
+	if usesCopyAlgorithm(ctx, tbl, sql.AlterOperation_RenameColumn, n.Algorithm) {
+		newSch, projections, err := modifyColumnInSchema(n.TargetSchema(), n.ColumnName, col, nil)
+		if err != nil {
+			return nil, err
+		}
+		rename := sql.ColumnRename{Before: n.ColumnName, After: n.NewColumnName}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), b.copyAlterTable(ctx, n.Db, tbl, newSch, projections, rename)
+	}
+
+	alterable, ok := tbl.(sql.AlterableTable)
+	if !ok {
+		return nil, sql.ErrAlterTableNotSupported.New(tbl.Name())
+	}
+
 	if err := updateDefaultsOnColumnRename(ctx, alterable, n.TargetSchema(), strings.ToLower(n.ColumnName), n.NewColumnName); err != nil {
 		return nil, err
 	}
+	if err := updateChecksOnColumnRename(ctx, alterable, n.ColumnName, n.NewColumnName); err != nil {
+		return nil, err
+	}
 
 	// Update the foreign key columns as well
 	if fkTable, ok := alterable.(sql.ForeignKeyTable); ok {
@@ -718,17 +789,11 @@ func (b *BaseBuilder) buildAddColumn(ctx *sql.Context, n *plan.AddColumn, row sq
 		return nil, err
 	}
 
-	alterable, ok := table.(sql.AlterableTable)
-	if !ok {
-		return nil, sql.ErrAlterTableNotSupported.New(table.Name())
-	}
-
-	tbl := alterable.(sql.Table)
 	tblSch := n.TargetSchema()
 	if n.Order() != nil && !n.Order().First {
-		idx := tblSch.IndexOf(n.Order().AfterColumn, tbl.Name())
+		idx := tblSch.IndexOf(n.Order().AfterColumn, table.Name())
 		if idx < 0 {
-			return nil, sql.ErrTableColumnNotFound.New(tbl.Name(), n.Order().AfterColumn)
+			return nil, sql.ErrTableColumnNotFound.New(table.Name(), n.Order().AfterColumn)
 		}
 	}
 
@@ -740,20 +805,33 @@ func (b *BaseBuilder) buildAddColumn(ctx *sql.Context, n *plan.AddColumn, row sq
 	// does not create a reference to the table's collation, which may change at any point, and therefore will have no
 	// relation to this column after assignment.
 	if collatedType, ok := n.Column().Type.(sql.TypeWithCollation); ok && collatedType.Collation() == sql.Collation_Unspecified {
-		n.Column().Type, err = collatedType.WithNewCollation(alterable.Collation())
+		n.Column().Type, err = collatedType.WithNewCollation(table.Collation())
 		if err != nil {
 			return nil, err
 		}
 	}
 	for _, col := range n.TargetSchema() {
 		if collatedType, ok := col.Type.(sql.TypeWithCollation); ok && collatedType.Collation() == sql.Collation_Unspecified {
-			col.Type, err = collatedType.WithNewCollation(alterable.Collation())
+			col.Type, err = collatedType.WithNewCollation(table.Collation())
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if usesCopyAlgorithm(ctx, table, sql.AlterOperation_AddColumn, n.Algorithm) {
+		newSch, projections, err := addColumnToSchema(n.TargetSchema(), n.Column(), n.Order())
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), b.copyAlterTable(ctx, n.Db, table, newSch, projections)
+	}
+
+	alterable, ok := table.(sql.AlterableTable)
+	if !ok {
+		return nil, sql.ErrAlterTableNotSupported.New(table.Name())
+	}
+
 	return &addColumnIter{
 		a:         n,
 		alterable: alterable,
@@ -771,17 +849,29 @@ func (b *BaseBuilder) buildAlterDB(ctx *sql.Context, n *plan.AlterDB, row sql.Ro
 	if err != nil {
 		return nil, err
 	}
-	collatedDb, ok := db.(sql.CollatedDatabase)
-	if !ok {
-		return nil, sql.ErrDatabaseCollationsNotSupported.New(dbName)
-	}
+	if n.Collation != sql.Collation_Unspecified || n.Comment == nil {
+		collatedDb, ok := db.(sql.CollatedDatabase)
+		if !ok {
+			return nil, sql.ErrDatabaseCollationsNotSupported.New(dbName)
+		}
 
-	collation := n.Collation
-	if collation == sql.Collation_Unspecified {
-		collation = sql.Collation_Default
+		collation := n.Collation
+		if collation == sql.Collation_Unspecified {
+			collation = sql.Collation_Default
+		}
+		if err = collatedDb.SetCollation(ctx, collation); err != nil {
+			return nil, err
+		}
 	}
-	if err = collatedDb.SetCollation(ctx, collation); err != nil {
-		return nil, err
+
+	if n.Comment != nil {
+		commentedDb, ok := db.(sql.CommentedDatabase)
+		if !ok {
+			return nil, sql.ErrDatabaseCommentsNotSupported.New(dbName)
+		}
+		if err = commentedDb.SetComment(ctx, *n.Comment); err != nil {
+			return nil, err
+		}
 	}
 
 	rows := []sql.Row{{types.OkResult{RowsAffected: 1}}}
@@ -810,6 +900,19 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		return sql.RowsToRowIter(), err
 	}
 
+	if !hasPrimaryKey(n) {
+		gipk, err := ctx.GetSessionVariable(ctx, "sql_generate_invisible_primary_key")
+		if err != nil {
+			return sql.RowsToRowIter(), err
+		}
+		if gipk.(int8) == 1 {
+			n.CreateSchema, err = plan.GenerateInvisiblePrimaryKey(n.Name(), n.CreateSchema)
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+	}
+
 	maybePrivDb := n.Db
 	if privDb, ok := maybePrivDb.(mysql_db.PrivilegedDatabase); ok {
 		maybePrivDb = privDb.Unwrap()
@@ -881,6 +984,15 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		return sql.RowsToRowIter(), sql.ErrTableCreatedNotFound.New()
 	}
 
+	if len(n.Options) > 0 {
+		if optionsTable, ok := tableNode.(sql.TableOptionsAlterableTable); ok {
+			err = optionsTable.SetTableOptions(ctx, n.Options)
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+	}
+
 	var nonPrimaryIdxes []*plan.IndexDefinition
 	for _, def := range n.IdxDefs {
 		if def.Constraint != sql.IndexConstraint_Primary {
@@ -912,6 +1024,19 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
 }
 
+// hasPrimaryKey returns whether the table created by the CREATE TABLE statement given declares a primary key.
+func hasPrimaryKey(n *plan.CreateTable) bool {
+	if len(n.CreateSchema.PkOrdinals) > 0 {
+		return true
+	}
+	for _, idxDef := range n.IdxDefs {
+		if idxDef.Constraint == sql.IndexConstraint_Primary {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *BaseBuilder) buildCreateProcedure(ctx *sql.Context, n *plan.CreateProcedure, row sql.Row) (sql.RowIter, error) {
 	return &createProcedureIter{
 		spd: sql.StoredProcedureDetails{
@@ -919,6 +1044,7 @@ func (b *BaseBuilder) buildCreateProcedure(ctx *sql.Context, n *plan.CreateProce
 			CreateStatement: n.CreateProcedureString,
 			CreatedAt:       n.CreatedAt,
 			ModifiedAt:      n.ModifiedAt,
+			Definer:         n.Definer,
 		},
 		db: n.Database(),
 	}, nil
@@ -930,6 +1056,7 @@ func (b *BaseBuilder) buildCreateTrigger(ctx *sql.Context, n *plan.CreateTrigger
 			Name:            n.TriggerName,
 			CreateStatement: n.CreateTriggerString,
 			CreatedAt:       n.CreatedAt,
+			Definer:         n.Definer,
 		},
 		db: n.Database(),
 	}, nil
@@ -946,6 +1073,14 @@ func (b *BaseBuilder) buildDropColumn(ctx *sql.Context, n *plan.DropColumn, row
 		return nil, err
 	}
 
+	if usesCopyAlgorithm(ctx, tbl, sql.AlterOperation_DropColumn, n.Algorithm) {
+		newSch, projections, err := dropColumnFromSchema(n.TargetSchema(), n.Column, tbl.Name())
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), b.copyAlterTable(ctx, n.Db, tbl, newSch, projections)
+	}
+
 	alterable, ok := tbl.(sql.AlterableTable)
 	if !ok {
 		return nil, sql.ErrAlterTableNotSupported.New(tbl.Name())
@@ -971,6 +1106,32 @@ func (b *BaseBuilder) buildAlterTableCollation(ctx *sql.Context, n *plan.AlterTa
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), alterable.ModifyDefaultCollation(ctx, n.Collation)
 }
 
+func (b *BaseBuilder) buildAlterTableOptions(ctx *sql.Context, n *plan.AlterTableOptions, row sql.Row) (sql.RowIter, error) {
+	tbl, err := getTableFromDatabase(ctx, n.Database(), n.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	alterable, ok := tbl.(sql.TableOptionsAlterableTable)
+	if !ok {
+		return nil, sql.ErrAlterTableOptionsNotSupported.New(tbl.Name())
+	}
+
+	options := make(sql.TableOptions)
+	for name, value := range alterable.TableOptions() {
+		options[name] = value
+	}
+	for name, value := range n.Options {
+		if value == "" {
+			delete(options, name)
+		} else {
+			options[name] = value
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), alterable.SetTableOptions(ctx, options)
+}
+
 func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateForeignKey, row sql.Row) (sql.RowIter, error) {
 	if n.FkDef.OnUpdate == sql.ForeignKeyReferentialAction_SetDefault || n.FkDef.OnDelete == sql.ForeignKeyReferentialAction_SetDefault {
 		return nil, sql.ErrForeignKeySetDefault.New()
@@ -987,6 +1148,26 @@ func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateFore
 		return nil, sql.ErrTableNotFound.New(n.FkDef.Table)
 	}
 
+	fkTbl, ok := tbl.(sql.ForeignKeyTable)
+	if !ok {
+		return nil, sql.ErrNoForeignKeySupport.New(n.FkDef.Table)
+	}
+
+	fkChecks, err := ctx.GetSessionVariable(ctx, "foreign_key_checks")
+	if err != nil {
+		return nil, err
+	}
+
+	// When foreign_key_checks is disabled, the referenced table may not exist yet, in which case the foreign key is
+	// left unresolved until it's used
+	if fkChecks.(int8) == 0 {
+		err = plan.ResolveForeignKey(ctx, fkTbl, nil, *n.FkDef, true, false)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+	}
+
 	refDb, err := n.DbProvider.Database(ctx, n.FkDef.ParentDatabase)
 	if err != nil {
 		return nil, err
@@ -998,25 +1179,141 @@ func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateFore
 	if !ok {
 		return nil, sql.ErrTableNotFound.New(n.FkDef.ParentTable)
 	}
-
-	fkTbl, ok := tbl.(sql.ForeignKeyTable)
-	if !ok {
-		return nil, sql.ErrNoForeignKeySupport.New(n.FkDef.Table)
-	}
 	refFkTbl, ok := refTbl.(sql.ForeignKeyTable)
 	if !ok {
 		return nil, sql.ErrNoForeignKeySupport.New(n.FkDef.ParentTable)
 	}
 
-	fkChecks, err := ctx.GetSessionVariable(ctx, "foreign_key_checks")
+	err = plan.ResolveForeignKey(ctx, fkTbl, refFkTbl, *n.FkDef, true, true)
 	if err != nil {
 		return nil, err
 	}
 
-	err = plan.ResolveForeignKey(ctx, fkTbl, refFkTbl, *n.FkDef, true, fkChecks.(int8) == 1)
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+func (b *BaseBuilder) buildCreateSequence(ctx *sql.Context, n *plan.CreateSequence, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Database().(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrSequencesNotSupported.New(n.Database().Name())
+	}
+
+	_, exists, err := db.GetSequence(ctx, n.Name)
 	if err != nil {
 		return nil, err
 	}
+	if !exists {
+		_, exists, err = db.GetTableInsensitive(ctx, n.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if exists {
+		if n.IfNotExists {
+			ctx.Session.Warn(&sql.Warning{
+				Level:   "Note",
+				Code:    mysql.ERTableExists,
+				Message: sql.ErrTableAlreadyExists.New(n.Name).Error(),
+			})
+			return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+		}
+		return nil, sql.ErrTableAlreadyExists.New(n.Name)
+	}
+
+	seq := n.Options.Apply(sql.NewSequence(n.Name), true)
+	if err = seq.Validate(db.Name()); err != nil {
+		return nil, err
+	}
+	if err = db.CreateSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+func (b *BaseBuilder) buildAlterSequence(ctx *sql.Context, n *plan.AlterSequence, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Database().(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrSequencesNotSupported.New(n.Database().Name())
+	}
+
+	seq, exists, err := db.GetSequence(ctx, n.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if n.IfExists {
+			ctx.Session.Warn(&sql.Warning{
+				Level:   "Note",
+				Code:    4091, // TODO: Needs to be added to vitess
+				Message: sql.ErrSequenceNotFound.New(db.Name() + "." + n.Name).Error(),
+			})
+			return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+		}
+		return nil, sql.ErrSequenceNotFound.New(db.Name() + "." + n.Name)
+	}
+
+	seq = n.Options.Apply(seq, false)
+	if err = seq.Validate(db.Name()); err != nil {
+		return nil, err
+	}
+	if err = db.AlterSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+	if n.Options.Restart {
+		next := seq.Start
+		if n.Options.RestartWith != nil {
+			next = *n.Options.RestartWith
+		}
+		if err = db.RestartSequence(ctx, n.Name, next); err != nil {
+			return nil, err
+		}
+	}
+
+	// Values this session reserved under the old definition must not be handed out
+	if cs, ok := ctx.Session.(sql.SequenceCacheSession); ok {
+		cs.SequenceCache().Invalidate(db.Name(), n.Name)
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
 
+func (b *BaseBuilder) buildDropSequence(ctx *sql.Context, n *plan.DropSequence, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Database().(sql.SequenceDatabase)
+	if !ok {
+		return nil, sql.ErrSequencesNotSupported.New(n.Database().Name())
+	}
+
+	// Like DROP TABLE, nothing is dropped unless every sequence exists or IF EXISTS was given
+	var existing, missing []string
+	for _, name := range n.Names {
+		_, exists, err := db.GetSequence(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			existing = append(existing, name)
+		} else {
+			missing = append(missing, db.Name()+"."+name)
+		}
+	}
+	if len(missing) > 0 {
+		err := sql.ErrSequenceNotFound.New(strings.Join(missing, ","))
+		if !n.IfExists {
+			return nil, err
+		}
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    4091, // TODO: Needs to be added to vitess
+			Message: err.Error(),
+		})
+	}
+
+	for _, name := range existing {
+		if err := db.DropSequence(ctx, name); err != nil {
+			return nil, err
+		}
+		if cs, ok := ctx.Session.(sql.SequenceCacheSession); ok {
+			cs.SequenceCache().Invalidate(db.Name(), name)
+		}
+	}
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -306,7 +307,10 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 
 	// TODO: fix me
-	if err := updateDefaultsOnColumnRename(ctx, i.alterable, i.m.TargetSchema(), i.m.Column(), i.m.NewColumn().Name); err != nil {
+	if err := updateDefaultsOnColumnRename(ctx, i.alterable, i.m.TargetSchema(), strings.ToLower(i.m.Column()), i.m.NewColumn().Name); err != nil {
+		return nil, err
+	}
+	if err := updateChecksOnColumnRename(ctx, i.alterable, i.m.Column(), i.m.NewColumn().Name); err != nil {
 		return nil, err
 	}
 
@@ -415,6 +419,89 @@ func updateDefaultsOnColumnRename(ctx *sql.Context, tbl sql.AlterableTable, sche
 	return nil
 }
 
+// updateChecksOnColumnRename updates the check constraints of the table given that reference the column renamed to
+// reference its new name.
+func updateChecksOnColumnRename(ctx *sql.Context, tbl sql.Table, oldName, newName string) error {
+	if strings.EqualFold(oldName, newName) {
+		return nil
+	}
+	checkTable, ok := tbl.(sql.CheckTable)
+	if !ok {
+		return nil
+	}
+	checkAlterable, ok := tbl.(sql.CheckAlterableTable)
+	if !ok {
+		return nil
+	}
+	tableDefs, err := checkTable.GetChecks(ctx)
+	if err != nil {
+		return err
+	}
+	// The definitions may share storage with the table's own, which dropping checks modifies
+	defs := append([]sql.CheckDefinition(nil), tableDefs...)
+
+	// Checks are replaced from the first one that changes on, so that they keep their order
+	firstChanged := -1
+	for i, def := range defs {
+		renamed, changed, err := renameColumnInCheckExpression(def.CheckExpression, oldName, newName)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		defs[i].CheckExpression = renamed
+		if firstChanged < 0 {
+			firstChanged = i
+		}
+	}
+	if firstChanged < 0 {
+		return nil
+	}
+
+	for _, def := range defs[firstChanged:] {
+		if err = checkAlterable.DropCheck(ctx, def.Name); err != nil {
+			return err
+		}
+	}
+	for i := range defs[firstChanged:] {
+		if err = checkAlterable.CreateCheck(ctx, &defs[firstChanged+i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameColumnInCheckExpression returns the check expression given with the references to the column renamed replaced
+// by its new name, and whether there were any.
+func renameColumnInCheckExpression(checkExpression, oldName, newName string) (string, bool, error) {
+	parsed, err := sqlparser.Parse("select " + checkExpression)
+	if err != nil {
+		return "", false, err
+	}
+	selectStmt, ok := parsed.(*sqlparser.Select)
+	if !ok || len(selectStmt.SelectExprs) != 1 {
+		return "", false, sql.ErrInvalidCheckConstraint.New(checkExpression)
+	}
+	ae, ok := selectStmt.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return "", false, sql.ErrInvalidCheckConstraint.New(checkExpression)
+	}
+
+	changed := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && col.Name.EqualString(oldName) {
+			col.Name = sqlparser.NewColIdent(newName)
+			changed = true
+		}
+		return true, nil
+	}, ae.Expr)
+	if !changed {
+		return checkExpression, false, nil
+	}
+	return sqlparser.String(ae.Expr), true, nil
+}
+
 func (i *modifyColumnIter) Close(context *sql.Context) error {
 	return nil
 }
@@ -475,6 +562,10 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 	}
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
+	// TIMESTAMP values are projected in the session's time zone, so that changing a column to or from TIMESTAMP keeps
+	// the wall clock of the session
+	tsLoc := sql.TimestampLocation(ctx)
+	oldSch := rwt.Schema()
 
 	for {
 		r, err := rowIter.Next(ctx)
@@ -484,10 +575,11 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 			return false, err
 		}
 
-		newRow, err := projectRowWithTypes(ctx, newSch, projections, r)
+		newRow, err := projectRowWithTypes(ctx, newSch, projections, sql.RowTimestampsFromUTC(oldSch, r, tsLoc))
 		if err != nil {
 			return false, err
 		}
+		newRow = sql.RowTimestampsToUTC(newSch, newRow, tsLoc)
 
 		err = i.validateNullability(ctx, newSch, newRow)
 		if err != nil {
@@ -1184,6 +1276,8 @@ func (i *addColumnIter) UpdateRowsWithDefaults(ctx *sql.Context, table sql.Table
 	}
 
 	updater := updatable.Updater(ctx)
+	// rows are read in the session's time zone, and must be written back in UTC
+	tsLoc := timestampWriteLocation(ctx, schema)
 
 	for {
 		r, err := tableIter.Next(ctx)
@@ -1201,7 +1295,7 @@ func (i *addColumnIter) UpdateRowsWithDefaults(ctx *sql.Context, table sql.Table
 			return err
 		}
 
-		err = updater.Update(ctx, r, updatedRow)
+		err = updater.Update(ctx, sql.RowTimestampsToUTC(schema, r, tsLoc), sql.RowTimestampsToUTC(schema, updatedRow, tsLoc))
 		if err != nil {
 			return err
 		}
@@ -1277,6 +1371,9 @@ func (i *addColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 	}
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
+	// defaults of the new column are evaluated in the session's time zone
+	tsLoc := sql.TimestampLocation(ctx)
+	oldSch := rwt.Schema()
 
 	var val uint64
 	autoIncColIdx := -1
@@ -1301,10 +1398,11 @@ func (i *addColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 			return false, err
 		}
 
-		newRow, err := ProjectRow(ctx, projections, r)
+		newRow, err := ProjectRow(ctx, projections, sql.RowTimestampsFromUTC(oldSch, r, tsLoc))
 		if err != nil {
 			return false, err
 		}
+		newRow = sql.RowTimestampsToUTC(newSch, newRow, tsLoc)
 
 		if autoIncColIdx != -1 {
 			v, _, err := i.a.Column().Type.Convert(val)
@@ -17,6 +17,7 @@ package rowexec
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -28,6 +29,12 @@ type schemaPositionDeleter struct {
 	deleter     sql.RowDeleter
 	schemaStart int
 	schemaEnd   int
+	// database, table and schema describe the table of the deleter, for the row events of its deletes
+	database string
+	table    string
+	schema   sql.Schema
+	// tsLoc is the location that TIMESTAMP values are converted from before rows are deleted, as they're stored in UTC
+	tsLoc *time.Location
 }
 
 // findSourcePosition searches the specified |schema| for the first group of columns whose source is |name|,
@@ -68,6 +75,8 @@ type deleteIter struct {
 	schema    sql.Schema
 	childIter sql.RowIter
 	closed    bool
+	// deleted counts the rows this iterator has deleted for the status variables
+	deleted int64
 }
 
 func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -92,10 +101,19 @@ func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if schemaLength < rowLength {
 			subSlice = row[(rowLength - fullSchemaLength + deleter.schemaStart):(rowLength - fullSchemaLength + deleter.schemaEnd)]
 		}
-		err = deleter.deleter.Delete(ctx, subSlice)
+		storedRow := sql.RowTimestampsToUTC(deleter.schema, subSlice, deleter.tsLoc)
+		err = deleter.deleter.Delete(ctx, storedRow)
 		if err != nil {
 			return nil, err
 		}
+		ctx.RowEvents().Record(ctx, sql.RowEvent{
+			Type:     sql.RowEventType_Delete,
+			Database: deleter.database,
+			Table:    deleter.table,
+			Schema:   deleter.schema,
+			Before:   storedRow,
+		})
+		d.deleted++
 	}
 
 	return row, nil
@@ -104,6 +122,7 @@ func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
 func (d *deleteIter) Close(ctx *sql.Context) error {
 	if !d.closed {
 		d.closed = true
+		incrementRowStatusVariables(ctx, d.deleted, "Handler_delete", "Innodb_rows_deleted")
 		var firstErr error
 		// Make sure we close all the deleters and the childIter, and track the first
 		// error seen so we can return it after safely closing all resources.
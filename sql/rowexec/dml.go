@@ -16,6 +16,7 @@ package rowexec
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -39,7 +40,16 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 	var replacer sql.RowReplacer
 	var updater sql.RowUpdater
 	// These type casts have already been asserted in the analyzer
-	if ii.IsReplace {
+	if sharded, ok, err := shardedTableEditor(insertable); err != nil {
+		return nil, err
+	} else if ok && ii.IsReplace {
+		replacer = sharded
+	} else if ok {
+		inserter = sharded
+		if len(ii.OnDupExprs) > 0 {
+			updater = sharded
+		}
+	} else if ii.IsReplace {
 		replacer = insertable.(sql.ReplaceableTable).Replacer(ctx)
 	} else {
 		inserter = insertable.Inserter(ctx)
@@ -54,9 +64,12 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 	}
 
 	insertExpressions := getInsertExpressions(ii.Source)
+	database, table := rowEventTable(ii.Destination)
 	insertIter := &insertIter{
 		schema:      dstSchema,
 		tableNode:   ii.Destination,
+		database:    database,
+		table:       table,
 		inserter:    inserter,
 		replacer:    replacer,
 		updater:     updater,
@@ -66,6 +79,8 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 		checks:      ii.Checks,
 		ctx:         ctx,
 		ignore:      ii.Ignore,
+		strict:      isStrictWrite(ctx, ii.Ignore),
+		tsLoc:       timestampWriteLocation(ctx, dstSchema),
 	}
 
 	var ed sql.EditOpenerCloser
@@ -95,7 +110,14 @@ func (b *BaseBuilder) buildDeleteFrom(ctx *sql.Context, n *plan.DeleteFrom, row
 		if err != nil {
 			return nil, err
 		}
-		deleter := deletable.Deleter(ctx)
+		var deleter sql.RowDeleter
+		if sharded, ok, err := shardedTableEditor(deletable); err != nil {
+			return nil, err
+		} else if ok {
+			deleter = sharded
+		} else {
+			deleter = deletable.Deleter(ctx)
+		}
 
 		// By default the sourceName in the schema is the table name, but if there is a
 		// table alias applied, then use that instead.
@@ -112,7 +134,16 @@ func (b *BaseBuilder) buildDeleteFrom(ctx *sql.Context, n *plan.DeleteFrom, row
 		if err != nil {
 			return nil, err
 		}
-		schemaPositionDeleters[i] = schemaPositionDeleter{deleter, int(start), int(end)}
+		database, table := rowEventTable(target)
+		schemaPositionDeleters[i] = schemaPositionDeleter{
+			deleter:     deleter,
+			schemaStart: int(start),
+			schemaEnd:   int(end),
+			database:    database,
+			table:       table,
+			schema:      deletable.Schema(),
+			tsLoc:       timestampWriteLocation(ctx, deletable.Schema()),
+		}
 	}
 	return newDeleteIter(iter, n.Child.Schema(), schemaPositionDeleters...), nil
 }
@@ -126,14 +157,75 @@ func (b *BaseBuilder) buildUpdate(ctx *sql.Context, n *plan.Update, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
-	updater := updatable.Updater(ctx)
+	var updater sql.RowUpdater
+	if sharded, ok, err := shardedTableEditor(updatable); err != nil {
+		return nil, err
+	} else if ok {
+		updater = sharded
+	} else {
+		updater = updatable.Updater(ctx)
+	}
 
 	iter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
 		return nil, err
 	}
 
-	return newUpdateIter(iter, updatable.Schema(), updater, n.Checks, n.Ignore), nil
+	// The updater of a join publishes the row events of the tables it updates itself
+	var database, table string
+	if !hasUpdateJoin(n.Child) {
+		database, table = rowEventTable(n.Child)
+	}
+	tsLoc := timestampWriteLocation(ctx, updatable.Schema())
+	return newUpdateIter(iter, updatable.Schema(), updater, n.Checks, n.Ignore, database, table, tsLoc), nil
+}
+
+// shardedTableEditor returns the editor that fans the rows written to |table| out to the editors of its shards, if
+// it's a sql.ShardedTable.
+func shardedTableEditor(table sql.Table) (*plan.ShardedTableEditor, bool, error) {
+	sharded, ok := table.(sql.ShardedTable)
+	if !ok {
+		return nil, false, nil
+	}
+	editor, err := plan.NewShardedTableEditor(sharded)
+	return editor, err == nil, err
+}
+
+// rowEventTable returns the database and table names of the table written by |n|, which the row events of the writes
+// are published with.
+func rowEventTable(n sql.Node) (database string, table string) {
+	transform.Inspect(n, func(n sql.Node) bool {
+		if table != "" {
+			return false
+		}
+		var rt *plan.ResolvedTable
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			rt = n
+		case *plan.IndexedTableAccess:
+			rt = n.ResolvedTable
+		default:
+			return true
+		}
+		if rt.Database != nil {
+			database = rt.Database.Name()
+		}
+		table = rt.Name()
+		return false
+	})
+	return database, table
+}
+
+// hasUpdateJoin returns whether |n| updates a join.
+func hasUpdateJoin(n sql.Node) bool {
+	found := false
+	transform.Inspect(n, func(n sql.Node) bool {
+		if _, ok := n.(*plan.UpdateJoin); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
 }
 
 func (b *BaseBuilder) buildDropForeignKey(ctx *sql.Context, n *plan.DropForeignKey, row sql.Row) (sql.RowIter, error) {
@@ -164,6 +256,13 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, row sq
 	var err error
 	var curdb sql.Database
 
+	// tables dropped by the same statement may reference each other
+	dropped := make(map[string]struct{})
+	for _, table := range n.Tables {
+		tbl := table.(*plan.ResolvedTable)
+		dropped[strings.ToLower(tbl.Database.Name())+"."+strings.ToLower(tbl.Name())] = struct{}{}
+	}
+
 	for _, table := range n.Tables {
 		tbl := table.(*plan.ResolvedTable)
 		curdb = tbl.Database
@@ -175,13 +274,15 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, row sq
 			if err != nil {
 				return nil, err
 			}
+			parentFks, err := fkTable.GetReferencedForeignKeys(ctx)
+			if err != nil {
+				return nil, err
+			}
 			if fkChecks.(int8) == 1 {
-				parentFks, err := fkTable.GetReferencedForeignKeys(ctx)
-				if err != nil {
-					return nil, err
-				}
-				if len(parentFks) > 0 {
-					return nil, sql.ErrForeignKeyDropTable.New(fkTable.Name(), parentFks[0].Name)
+				for _, fk := range parentFks {
+					if _, ok := dropped[strings.ToLower(fk.Database)+"."+strings.ToLower(fk.Table)]; !ok {
+						return nil, sql.ErrForeignKeyDropTable.New(fkTable.Name(), fk.Name, fk.Table)
+					}
 				}
 			}
 			fks, err := fkTable.GetDeclaredForeignKeys(ctx)
@@ -193,6 +294,15 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, row sq
 					return nil, err
 				}
 			}
+			// The foreign keys referencing the table are resolved again against the table that replaces it
+			for _, fk := range parentFks {
+				if fk.IsSelfReferential() {
+					continue
+				}
+				if err = unresolveForeignKey(ctx, tbl.Database, fk); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		err = droppable.DropTable(ctx, tbl.Name())
@@ -219,6 +329,24 @@ func (b *BaseBuilder) buildDropTable(ctx *sql.Context, n *plan.DropTable, row sq
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
 }
 
+// unresolveForeignKey marks the foreign key given as unresolved, so that it's resolved again before it's next used. Only
+// foreign keys declared by tables of the database given are updated.
+func unresolveForeignKey(ctx *sql.Context, db sql.Database, fk sql.ForeignKeyConstraint) error {
+	if !fk.IsResolved || !strings.EqualFold(fk.Database, db.Name()) {
+		return nil
+	}
+	tbl, ok, err := db.GetTableInsensitive(ctx, fk.Table)
+	if err != nil || !ok {
+		return err
+	}
+	fkTbl, err := getForeignKeyTable(tbl)
+	if err != nil {
+		return nil
+	}
+	fk.IsResolved = false
+	return fkTbl.UpdateForeignKey(ctx, fk.Name, fk)
+}
+
 func (b *BaseBuilder) buildTriggerRollback(ctx *sql.Context, n *plan.TriggerRollback, row sql.Row) (sql.RowIter, error) {
 	childIter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
@@ -234,6 +362,8 @@ func (b *BaseBuilder) buildTriggerRollback(ctx *sql.Context, n *plan.TriggerRoll
 
 	if err := ts.CreateSavepoint(ctx, ctx.GetTransaction(), SavePointName); err != nil {
 		ctx.GetLogger().WithError(err).Errorf("CreateSavepoint failed")
+	} else {
+		ctx.RowEvents().CreateSavepoint(ctx, SavePointName)
 	}
 
 	return &triggerRollbackIter{
@@ -256,6 +386,9 @@ func (b *BaseBuilder) buildTriggerBeginEndBlock(ctx *sql.Context, n *plan.Trigge
 		statements: n.Children(),
 		row:        row,
 		once:       &sync.Once{},
+		b:          b,
+		label:      n.Label,
+		pRef:       n.Pref,
 	}, nil
 }
 
@@ -332,6 +465,19 @@ func (b *BaseBuilder) buildRowUpdateAccumulator(ctx *sql.Context, n *plan.RowUpd
 	}, nil
 }
 
+func (b *BaseBuilder) buildReturning(ctx *sql.Context, n *plan.Returning, row sql.Row) (sql.RowIter, error) {
+	childIter, err := b.buildNodeExec(ctx, n.Child, row)
+	if err != nil {
+		return nil, err
+	}
+	return &returningIter{
+		childIter:   childIter,
+		projections: n.Projections,
+		schemaLen:   len(n.Target().Schema()),
+		row:         row,
+	}, nil
+}
+
 func (b *BaseBuilder) buildTruncate(ctx *sql.Context, n *plan.Truncate, row sql.Row) (sql.RowIter, error) {
 	truncatable, err := plan.GetTruncatable(n.Child)
 	if err != nil {
@@ -344,6 +490,17 @@ func (b *BaseBuilder) buildTruncate(ctx *sql.Context, n *plan.Truncate, row sql.
 	if err != nil {
 		return nil, err
 	}
+	database := n.DatabaseName()
+	if database == "" {
+		database = ctx.GetCurrentDatabase()
+	}
+	ctx.RowEvents().Record(ctx, sql.RowEvent{
+		Type:     sql.RowEventType_Truncate,
+		Database: database,
+		Table:    truncatable.Name(),
+		Schema:   truncatable.Schema(),
+		Rows:     int64(removed),
+	})
 	for _, col := range truncatable.Schema() {
 		if col.AutoIncrement {
 			aiTable, ok := truncatable.(sql.AutoIncrementTable)
@@ -379,7 +536,7 @@ func (b *BaseBuilder) buildUpdateSource(ctx *sql.Context, n *plan.UpdateSource,
 		childIter:   rowIter,
 		updateExprs: n.UpdateExprs,
 		tableSchema: schema,
-		ignore:      n.Ignore,
+		strict:      isStrictWrite(ctx, n.Ignore),
 	}, nil
 }
 
@@ -17,6 +17,7 @@ package rowexec
 import (
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -45,10 +46,13 @@ func (t *triggerRollbackIter) Next(ctx *sql.Context) (row sql.Row, returnErr err
 	if err != nil && err != io.EOF {
 		if err := ts.RollbackToSavepoint(ctx, ctx.GetTransaction(), SavePointName); err != nil {
 			ctx.GetLogger().WithError(err).Errorf("Unexpected error when calling RollbackToSavePoint during triggerRollbackIter.Next()")
+		} else {
+			ctx.RowEvents().RollbackToSavepoint(ctx, SavePointName)
 		}
 		if err := ts.ReleaseSavepoint(ctx, ctx.GetTransaction(), SavePointName); err != nil {
 			ctx.GetLogger().WithError(err).Errorf("Unexpected error when calling ReleaseSavepoint during triggerRollbackIter.Next()")
 		} else {
+			ctx.RowEvents().ReleaseSavepoint(ctx, SavePointName)
 			t.hasSavepoint = false
 		}
 	}
@@ -65,6 +69,8 @@ func (t *triggerRollbackIter) Close(ctx *sql.Context) error {
 	if t.hasSavepoint {
 		if err := ts.ReleaseSavepoint(ctx, ctx.GetTransaction(), SavePointName); err != nil {
 			ctx.GetLogger().WithError(err).Errorf("Unexpected error when calling ReleaseSavepoint during triggerRollbackIter.Close()")
+		} else {
+			ctx.RowEvents().ReleaseSavepoint(ctx, SavePointName)
 		}
 		t.hasSavepoint = false
 	}
@@ -77,6 +83,8 @@ type triggerBlockIter struct {
 	row        sql.Row
 	once       *sync.Once
 	b          *BaseBuilder
+	label      string
+	pRef       *expression.ProcedureReference
 }
 
 var _ sql.RowIter = (*triggerBlockIter)(nil)
@@ -92,11 +100,35 @@ func (i *triggerBlockIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 
+	// The local variables declared in the block are in a scope of their own, like those of a stored procedure block
+	if i.pRef != nil {
+		i.pRef.PushScope()
+	}
+	row, err := i.runStatements(ctx)
+	if i.pRef != nil {
+		if exitErr, ok := err.(expression.ProcedureBlockExitError); ok && i.pRef.CurrentHeight() == int(exitErr) {
+			err = nil
+		}
+		if nErr := i.pRef.PopScope(ctx); err == nil && nErr != nil {
+			err = nErr
+		}
+	}
+	if controlFlow, ok := err.(loopError); ok && controlFlow.IsExit && len(i.label) > 0 && strings.EqualFold(controlFlow.Label, i.label) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// runStatements runs the statements of the block, and returns the row the trigger results in.
+func (i *triggerBlockIter) runStatements(ctx *sql.Context) (sql.Row, error) {
 	row := i.row
 	for _, s := range i.statements {
 		subIter, err := i.b.buildNodeExec(ctx, s, row)
 		if err != nil {
-			return nil, err
+			return row, err
 		}
 
 		for {
@@ -104,19 +136,19 @@ func (i *triggerBlockIter) Next(ctx *sql.Context) (sql.Row, error) {
 			if err == io.EOF {
 				err := subIter.Close(ctx)
 				if err != nil {
-					return nil, err
+					return row, err
 				}
 				break
 			} else if err != nil {
 				_ = subIter.Close(ctx)
-				return nil, err
+				return row, err
 			}
 
 			// We only return the result of a trigger block statement in certain cases, specifically when we are setting the
 			// value of new.field, so that the wrapping iterator can use it for the insert / update. Otherwise, this iterator
 			// always returns its input row.
-			if shouldUseTriggerStatementForReturnRow(s) {
-				row = newRow[len(newRow)/2:]
+			if updatedRow, ok := updatedTriggerRow(s, row, newRow); ok {
+				row = updatedRow
 			}
 		}
 	}
@@ -124,6 +156,16 @@ func (i *triggerBlockIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return row, nil
 }
 
+// updatedTriggerRow returns the trigger row that the statement given, run on |row|, returned as |result|, and whether
+// it returned one. The statements that set columns of the NEW row, including blocks and loops containing them, return
+// the row they ran on with the updated row appended to it. Other statements return rows of other lengths.
+func updatedTriggerRow(stmt sql.Node, row, result sql.Row) (sql.Row, bool) {
+	if len(result) == 0 || len(result) != 2*len(row) || !shouldUseTriggerStatementForReturnRow(stmt) {
+		return nil, false
+	}
+	return result[len(result)/2:], true
+}
+
 // shouldUseTriggerStatementForReturnRow returns whether the statement has Set node that contains GetField expression,
 // which means whether there is column value update. The Set node can be inside other nodes, so need to inspect all nodes
 // of the given node.
@@ -562,6 +604,45 @@ func (a *accumulatorIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+// returningIter returns the projections of a RETURNING clause evaluated on the rows written by an INSERT, REPLACE or
+// DELETE statement, in place of the accumulator that returns the number of rows it affects.
+type returningIter struct {
+	childIter   sql.RowIter
+	projections []sql.Expression
+	// schemaLen is the number of columns of the target table
+	schemaLen int
+	// row is the row of the outer scope
+	row   sql.Row
+	count int64
+}
+
+func (r *returningIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := r.childIter.Next(ctx)
+		if err == io.EOF {
+			ctx.SetLastQueryInfo(sql.RowCount, r.count)
+			return nil, err
+		}
+		if _, ok := err.(sql.IgnorableError); ok {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// a row that replaced or updated another is the concatenation of the old row and the new one
+		if len(row) == 2*r.schemaLen {
+			row = row[r.schemaLen:]
+		}
+		r.count++
+		return ProjectRow(ctx, r.projections, r.row.Append(row))
+	}
+}
+
+func (r *returningIter) Close(ctx *sql.Context) error {
+	return r.childIter.Close(ctx)
+}
+
 type matchingAccumulator interface {
 	RowsMatched() int64
 }
@@ -570,7 +651,10 @@ type updateSourceIter struct {
 	childIter   sql.RowIter
 	updateExprs []sql.Expression
 	tableSchema sql.Schema
-	ignore      bool
+	// strict is whether values that can't be converted to the type of their column are an error rather than a warning
+	strict bool
+	// rowNum is the number of the row being updated, counting from 1, which warnings refer to
+	rowNum int64
 }
 
 func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -579,7 +663,8 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, err
 	}
 
-	newRow, err := applyUpdateExpressionsWithIgnore(ctx, u.updateExprs, u.tableSchema, oldRow, u.ignore)
+	u.rowNum++
+	newRow, err := applyUpdateExpressionsForWrite(ctx, u.updateExprs, u.tableSchema, oldRow, u.strict, u.rowNum)
 	if err != nil {
 		return nil, err
 	}
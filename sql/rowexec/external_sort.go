@@ -0,0 +1,348 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func init() {
+	// Values of these types are stored in interfaces when rows are spilled to disk, so gob needs to know about them
+	gob.Register(time.Time{})
+	gob.Register(decimal.Decimal{})
+	gob.Register(types.Timespan(0))
+	gob.Register(types.JSONDocument{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+	gob.Register(types.Point{})
+	gob.Register(types.LineString{})
+	gob.Register(types.Polygon{})
+	gob.Register(types.MultiPoint{})
+	gob.Register(types.MultiLineString{})
+	gob.Register(types.MultiPolygon{})
+	gob.Register(types.GeomColl{})
+}
+
+const (
+	// sortBufferSizeVar is the session variable with the memory budget, in bytes, of a sort before it spills to disk
+	sortBufferSizeVar = "sort_buffer_size"
+	// tmpTableSizeVar is the session variable with the memory budget, in bytes, of the groups of a GROUP BY before
+	// the rows of new groups are sorted instead
+	tmpTableSizeVar = "tmp_table_size"
+	// tmpdirVar is the system variable with the directory of the temporary files of sorts
+	tmpdirVar = "tmpdir"
+)
+
+// externalSorter sorts rows by a set of sort fields. Rows are buffered in memory until their estimated size exceeds
+// the memory budget of the sorter, at which point they are sorted and written to a temporary file as a sorted run.
+// The sorted rows are then read by merging all the runs with the rows left in memory. When rows can't be written to
+// disk, they are all kept in memory instead.
+type externalSorter struct {
+	sorter  *expression.Sorter
+	budget  uint64
+	size    uint64
+	tmpdir  string
+	runs    []*os.File
+	noSpill bool
+}
+
+// newExternalSorter returns a sorter of rows by |sortFields| whose memory budget is given by the session variable
+// |budgetVar|.
+func newExternalSorter(ctx *sql.Context, sortFields sql.SortFields, budgetVar string) *externalSorter {
+	s := &externalSorter{
+		sorter: &expression.Sorter{
+			SortFields: sortFields,
+			Ctx:        ctx,
+		},
+		budget: sessionUint(ctx, budgetVar),
+	}
+	if tmpdir, err := ctx.GetSessionVariable(ctx, tmpdirVar); err == nil {
+		s.tmpdir, _ = tmpdir.(string)
+	}
+	return s
+}
+
+// sessionUint returns the value of the unsigned session variable |name|, or 0 when it can't be read, which means no
+// limit.
+func sessionUint(ctx *sql.Context, name string) uint64 {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return 0
+	}
+	v, _, err := types.Uint64.Convert(val)
+	if err != nil {
+		return 0
+	}
+	return v.(uint64)
+}
+
+// Add adds |row| to the rows to sort, spilling the rows in memory to disk if they are over budget.
+func (s *externalSorter) Add(row sql.Row) error {
+	s.sorter.Rows = append(s.sorter.Rows, row)
+	s.size += estimateRowSize(row)
+	if s.budget > 0 && s.size > s.budget && !s.noSpill {
+		return s.spill()
+	}
+	return nil
+}
+
+// Spilled returns whether any rows have been written to disk.
+func (s *externalSorter) Spilled() bool {
+	return len(s.runs) > 0
+}
+
+// spill writes the rows in memory to a new temporary file as a sorted run.
+func (s *externalSorter) spill() error {
+	if err := s.sortRows(); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.tmpdir, "gms-sort-")
+	if err != nil {
+		s.noSpill = true
+		return nil
+	}
+
+	w := bufio.NewWriter(f)
+	enc := gob.NewEncoder(w)
+	for _, row := range s.sorter.Rows {
+		if err = enc.Encode(row); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		// Rows with values that can't be encoded, or a disk that is full, are sorted in memory
+		removeTempFile(f)
+		s.noSpill = true
+		return nil
+	}
+
+	s.runs = append(s.runs, f)
+	s.sorter.Rows = nil
+	s.size = 0
+	return nil
+}
+
+func (s *externalSorter) sortRows() error {
+	sort.Stable(s.sorter)
+	return s.sorter.LastError
+}
+
+// Sorted returns an iterator over all the rows added, in order. Rows that compare as equal are returned in the order
+// they were added.
+func (s *externalSorter) Sorted() (sql.RowIter, error) {
+	if err := s.sortRows(); err != nil {
+		return nil, err
+	}
+
+	if len(s.runs) == 0 {
+		return &sortedRowsIter{rows: s.sorter.Rows}, nil
+	}
+
+	sources := make([]rowSource, 0, len(s.runs)+1)
+	for _, f := range s.runs {
+		sources = append(sources, &runRowSource{dec: gob.NewDecoder(bufio.NewReader(f))})
+	}
+	sources = append(sources, &sortedRowsIter{rows: s.sorter.Rows})
+
+	m := &mergeRowIter{sorter: s.sorter}
+	for i, src := range sources {
+		row, err := src.next()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.heads = append(m.heads, mergeHead{row: row, source: src, order: i})
+	}
+	heap.Init(m)
+	if s.sorter.LastError != nil {
+		return nil, s.sorter.LastError
+	}
+	return m, nil
+}
+
+// Close removes the temporary files of the sorter.
+func (s *externalSorter) Close() {
+	for _, f := range s.runs {
+		removeTempFile(f)
+	}
+	s.runs = nil
+	s.sorter.Rows = nil
+}
+
+func removeTempFile(f *os.File) {
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+}
+
+// rowSource is a source of sorted rows merged by mergeRowIter.
+type rowSource interface {
+	next() (sql.Row, error)
+}
+
+// sortedRowsIter iterates over sorted rows in memory.
+type sortedRowsIter struct {
+	rows []sql.Row
+	idx  int
+}
+
+var _ sql.RowIter = (*sortedRowsIter)(nil)
+
+func (i *sortedRowsIter) next() (sql.Row, error) {
+	if i.idx >= len(i.rows) {
+		return nil, io.EOF
+	}
+	row := i.rows[i.idx]
+	i.idx++
+	return row, nil
+}
+
+func (i *sortedRowsIter) Next(*sql.Context) (sql.Row, error) {
+	return i.next()
+}
+
+func (i *sortedRowsIter) Close(*sql.Context) error {
+	i.rows = nil
+	return nil
+}
+
+// runRowSource reads the rows of a sorted run from its temporary file.
+type runRowSource struct {
+	dec *gob.Decoder
+}
+
+func (r *runRowSource) next() (sql.Row, error) {
+	var row sql.Row
+	if err := r.dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+type mergeHead struct {
+	row    sql.Row
+	source rowSource
+	order  int
+}
+
+// mergeRowIter merges sorted sources of rows into a single sorted iterator. It implements heap.Interface over the
+// next row of every source that has rows left.
+type mergeRowIter struct {
+	sorter *expression.Sorter
+	heads  []mergeHead
+}
+
+var _ sql.RowIter = (*mergeRowIter)(nil)
+
+func (m *mergeRowIter) Len() int {
+	return len(m.heads)
+}
+
+func (m *mergeRowIter) Less(i, j int) bool {
+	a, b := m.heads[i], m.heads[j]
+	if m.sorter.LessRows(a.row, b.row) {
+		return true
+	}
+	if m.sorter.LessRows(b.row, a.row) {
+		return false
+	}
+	// Equal rows are returned in the order their sources were written to keep the sort stable
+	return a.order < b.order
+}
+
+func (m *mergeRowIter) Swap(i, j int) {
+	m.heads[i], m.heads[j] = m.heads[j], m.heads[i]
+}
+
+func (m *mergeRowIter) Push(x interface{}) {
+	m.heads = append(m.heads, x.(mergeHead))
+}
+
+func (m *mergeRowIter) Pop() interface{} {
+	last := m.heads[len(m.heads)-1]
+	m.heads = m.heads[:len(m.heads)-1]
+	return last
+}
+
+func (m *mergeRowIter) Next(*sql.Context) (sql.Row, error) {
+	if len(m.heads) == 0 {
+		return nil, io.EOF
+	}
+
+	head := &m.heads[0]
+	row := head.row
+	next, err := head.source.next()
+	if err == io.EOF {
+		heap.Pop(m)
+	} else if err != nil {
+		return nil, err
+	} else {
+		head.row = next
+		heap.Fix(m, 0)
+	}
+
+	if m.sorter.LastError != nil {
+		return nil, m.sorter.LastError
+	}
+	return row, nil
+}
+
+func (m *mergeRowIter) Close(*sql.Context) error {
+	m.heads = nil
+	return nil
+}
+
+// estimateRowSize returns an estimate of the bytes of memory used by |row|.
+func estimateRowSize(row sql.Row) uint64 {
+	// The slice header plus an interface for each value
+	size := uint64(24 + 16*len(row))
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += uint64(len(v))
+		case []byte:
+			size += uint64(len(v))
+		case decimal.Decimal, time.Time:
+			size += 24
+		case types.JSONDocument:
+			// Measuring a JSON document means walking it, so a fixed size is assumed
+			size += 256
+		case nil, bool, int8, uint8, int16, uint16, int32, uint32, int64, uint64, int, uint, float32, float64:
+		default:
+			size += 64
+		}
+	}
+	return size
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestExternalSorter(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	sortFields := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "a", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+	}
+
+	const numRows = 1000
+	r := rand.New(rand.NewSource(1))
+	var rows []sql.Row
+	for i := 0; i < numRows; i++ {
+		var a interface{} = int64(r.Intn(100))
+		if i%50 == 0 {
+			a = nil
+		}
+		ts := time.Date(2020, 1, 1, 0, 0, i, 0, time.UTC)
+		rows = append(rows, sql.NewRow(a, i, fmt.Sprintf("row %d", i), decimal.NewFromInt(int64(i)), ts))
+	}
+
+	sorter := newExternalSorter(ctx, sortFields, sortBufferSizeVar)
+	sorter.budget = 4096
+	defer sorter.Close()
+
+	for _, row := range rows {
+		require.NoError(sorter.Add(row))
+	}
+	require.True(sorter.Spilled())
+
+	iter, err := sorter.Sorted()
+	require.NoError(err)
+	sorted, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+	require.Len(sorted, numRows)
+
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1], sorted[i]
+		if prev[0] == nil || cur[0] == nil {
+			require.True(prev[0] == nil || cur[0] != nil, "nulls must sort first")
+		} else {
+			require.LessOrEqual(prev[0].(int64), cur[0].(int64))
+		}
+		if prev[0] == cur[0] {
+			// Rows with the same sort key keep the order they were added in
+			require.Less(prev[1].(int), cur[1].(int))
+		}
+	}
+
+	for _, row := range sorted {
+		expected := rows[row[1].(int)]
+		require.Equal(expected[0], row[0])
+		require.Equal(expected[2], row[2])
+		require.True(expected[3].(decimal.Decimal).Equal(row[3].(decimal.Decimal)))
+		require.True(expected[4].(time.Time).Equal(row[4].(time.Time)))
+	}
+}
+
+func TestExternalSorterInMemory(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	sortFields := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "a", false), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+	}
+
+	sorter := newExternalSorter(ctx, sortFields, sortBufferSizeVar)
+	sorter.budget = 0
+	defer sorter.Close()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(sorter.Add(sql.NewRow(int64(i))))
+	}
+	require.False(sorter.Spilled())
+
+	iter, err := sorter.Sorted()
+	require.NoError(err)
+	sorted, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(9)}, {int64(8)}, {int64(7)}, {int64(6)}, {int64(5)}, {int64(4)}, {int64(3)}, {int64(2)}, {int64(1)}, {int64(0)}}, sorted)
+}
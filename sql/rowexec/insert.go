@@ -17,8 +17,8 @@ package rowexec
 import (
 	"fmt"
 	"io"
+	"time"
 
-	"github.com/dolthub/vitess/go/vt/proto/query"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -43,6 +43,18 @@ type insertIter struct {
 	tableNode           sql.Node
 	closed              bool
 	ignore              bool
+	// strict is whether values that can't be converted to the type of their column are an error rather than a warning
+	strict bool
+	// rowNum is the number of the row being inserted, counting from 1, which warnings refer to
+	rowNum int64
+	// written and updated count the rows this iterator has written and updated for the status variables
+	written int64
+	updated int64
+	// database and table are the names that the row events of the changes to the table are published with
+	database string
+	table    string
+	// tsLoc is the location that TIMESTAMP values are converted from before they're written, as they're stored in UTC
+	tsLoc *time.Location
 }
 
 func getInsertExpressions(values sql.Node) []sql.Expression {
@@ -63,6 +75,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	if err == io.EOF {
 		return nil, err
 	}
+	i.rowNum++
 
 	if err != nil {
 		return nil, i.ignoreOrClose(ctx, row, err)
@@ -90,46 +103,16 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	// Do any necessary type conversions to the target schema
 	for idx, col := range i.schema {
 		if row[idx] != nil {
-			converted, inRange, cErr := col.Type.Convert(row[idx])
-			if cErr == nil && !inRange {
-				cErr = sql.ErrValueOutOfRange.New(row[idx], col.Type)
-			}
+			converted, cErr := types.ConvertForColumn(ctx, col, row[idx], i.strict, i.rowNum)
 			if cErr != nil {
-				// Ignore individual column errors when INSERT IGNORE, UPDATE IGNORE, etc. is specified.
-				// For JSON column types, always throw an error. MySQL throws the following error even when
-				// IGNORE is specified:
-				// ERROR 3140 (22032): Invalid JSON text: "Invalid value." at position 0 in value for column
-				// 'table.column'.
-				if i.ignore && col.Type.Type() != query.Type_JSON {
-					if _, ok := col.Type.(sql.NumberType); ok {
-						if converted == nil {
-							converted = i.schema[idx].Type.Zero()
-						}
-						row[idx] = converted
-						// Add a warning instead
-						ctx.Session.Warn(&sql.Warning{
-							Level:   "Note",
-							Code:    sql.CastSQLError(cErr).Num,
-							Message: cErr.Error(),
-						})
-					} else {
-						row = convertDataAndWarn(ctx, i.schema, row, idx, cErr)
-					}
-					continue
-				} else {
-					// Fill in error with information
-					if types.ErrLengthBeyondLimit.Is(cErr) {
-						cErr = types.ErrLengthBeyondLimit.New(row[idx], col.Name)
-					} else if sql.ErrNotMatchingSRID.Is(cErr) {
-						cErr = sql.ErrNotMatchingSRIDWithColName.New(col.Name, cErr)
-					}
-					return nil, sql.NewWrappedInsertError(origRow, cErr)
-				}
+				return nil, sql.NewWrappedInsertError(origRow, cErr)
 			}
 			row[idx] = converted
 		}
 	}
 
+	storedRow := sql.RowTimestampsToUTC(i.schema, row, i.tsLoc)
+
 	if i.replacer != nil {
 		toReturn := make(sql.Row, len(row)*2)
 		for i := 0; i < len(row); i++ {
@@ -138,7 +121,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
 		for {
-			if err := i.replacer.Insert(ctx, row); err != nil {
+			if err := i.replacer.Insert(ctx, storedRow); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
 					i.rowSource.Close(ctx)
 					i.rowSource = nil
@@ -151,15 +134,18 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 					i.rowSource = nil
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
+				i.recordRowEvent(ctx, sql.RowEventType_Delete, ue.Existing, nil)
 				// the row had to be deleted, write the values into the toReturn row
-				copy(toReturn, ue.Existing)
+				copy(toReturn, sql.RowTimestampsFromUTC(i.schema, ue.Existing, i.tsLoc))
 			} else {
 				break
 			}
 		}
+		i.recordRowEvent(ctx, sql.RowEventType_Insert, nil, storedRow)
+		i.written++
 		return toReturn, nil
 	} else {
-		if err := i.inserter.Insert(ctx, row); err != nil {
+		if err := i.inserter.Insert(ctx, storedRow); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return nil, i.ignoreOrClose(ctx, row, err)
 			}
@@ -167,36 +153,27 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 			ue := err.(*errors.Error).Cause().(sql.UniqueKeyError)
 			return i.handleOnDuplicateKeyUpdate(ctx, row, ue.Existing)
 		}
+		i.recordRowEvent(ctx, sql.RowEventType_Insert, nil, storedRow)
 	}
 
+	i.written++
 	i.updateLastInsertId(ctx, row)
 
 	return row, nil
 }
 
-func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
+func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, storedRowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
 	err := i.resolveValues(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 
-	newRow := rowToUpdate
-	for _, updateExpr := range i.updateExprs {
-		val, err := updateExpr.Eval(i.ctx, newRow)
-		if err != nil {
-			if i.ignore {
-				idx, ok := getFieldIndexFromUpdateExpr(updateExpr)
-				if !ok {
-					return nil, err
-				}
-
-				val = convertDataAndWarn(ctx, i.schema, row, idx, err)
-			} else {
-				return nil, err
-			}
-		}
+	// the existing row is as stored, and the update expressions see it in the session's time zone
+	rowToUpdate := sql.RowTimestampsFromUTC(i.schema, storedRowToUpdate, i.tsLoc)
 
-		newRow = val.(sql.Row)
+	newRow, err := applyUpdateExpressionsForWrite(ctx, i.updateExprs, i.schema, rowToUpdate, i.strict, i.rowNum)
+	if err != nil {
+		return nil, err
 	}
 
 	// Should revaluate the check conditions.
@@ -205,29 +182,21 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpda
 		return nil, i.ignoreOrClose(ctx, newRow, err)
 	}
 
-	err = i.updater.Update(ctx, rowToUpdate, newRow)
+	storedNewRow := sql.RowTimestampsToUTC(i.schema, newRow, i.tsLoc)
+	err = i.updater.Update(ctx, storedRowToUpdate, storedNewRow)
 	if err != nil {
 		return nil, i.ignoreOrClose(ctx, newRow, err)
 	}
+	// like MySQL, an update that doesn't change the row isn't a row event
+	if equals, err := rowToUpdate.Equals(newRow, i.schema); err != nil || !equals {
+		i.recordRowEvent(ctx, sql.RowEventType_Update, storedRowToUpdate, storedNewRow)
+	}
+	i.updated++
 
 	// In the case that we attempted an update, return a concatenated [old,new] row just like update.
 	return rowToUpdate.Append(newRow), nil
 }
 
-func getFieldIndexFromUpdateExpr(updateExpr sql.Expression) (int, bool) {
-	setField, ok := updateExpr.(*expression.SetField)
-	if !ok {
-		return 0, false
-	}
-
-	getField, ok := setField.Left.(*expression.GetField)
-	if !ok {
-		return 0, false
-	}
-
-	return getField.Index(), true
-}
-
 // resolveValues resolves all VALUES functions.
 func (i *insertIter) resolveValues(ctx *sql.Context, insertRow sql.Row) error {
 	for _, updateExpr := range i.updateExprs {
@@ -255,6 +224,8 @@ func (i *insertIter) resolveValues(ctx *sql.Context, insertRow sql.Row) error {
 func (i *insertIter) Close(ctx *sql.Context) error {
 	if !i.closed {
 		i.closed = true
+		incrementRowStatusVariables(ctx, i.written, "Handler_write", "Innodb_rows_inserted")
+		incrementRowStatusVariables(ctx, i.updated, "Handler_update", "Innodb_rows_updated")
 		var rsErr, iErr, rErr, uErr error
 		if i.rowSource != nil {
 			rsErr = i.rowSource.Close(ctx)
@@ -284,6 +255,18 @@ func (i *insertIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+// recordRowEvent records a change this iterator made to a row of its table to the context's row event publisher.
+func (i *insertIter) recordRowEvent(ctx *sql.Context, typ sql.RowEventType, before, after sql.Row) {
+	ctx.RowEvents().Record(ctx, sql.RowEvent{
+		Type:     typ,
+		Database: i.database,
+		Table:    i.table,
+		Schema:   i.schema,
+		Before:   before,
+		After:    after,
+	})
+}
+
 func (i *insertIter) updateLastInsertId(ctx *sql.Context, row sql.Row) {
 	if i.lastInsertIdUpdated {
 		return
@@ -313,29 +296,6 @@ func (i *insertIter) ignoreOrClose(ctx *sql.Context, row sql.Row, err error) err
 	return warnOnIgnorableError(ctx, row, err)
 }
 
-// convertDataAndWarn modifies a row with data conversion issues in INSERT/UPDATE IGNORE calls
-// Per MySQL docs "Rows set to values that would cause data conversion errors are set to the closest valid values instead"
-// cc. https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html#sql-mode-strict
-func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, columnIdx int, err error) sql.Row {
-	if types.ErrLengthBeyondLimit.Is(err) {
-		maxLength := tableSchema[columnIdx].Type.(sql.StringType).MaxCharacterLength()
-		row[columnIdx] = row[columnIdx].(string)[:maxLength] // truncate string
-	} else {
-		row[columnIdx] = tableSchema[columnIdx].Type.Zero()
-	}
-
-	sqlerr := sql.CastSQLError(err)
-
-	// Add a warning instead
-	ctx.Session.Warn(&sql.Warning{
-		Level:   "Note",
-		Code:    sqlerr.Num,
-		Message: err.Error(),
-	})
-
-	return row
-}
-
 func warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 	// Check that this error is a part of the list of Ignorable Errors and create the relevant warning
 	for _, ie := range plan.IgnorableErrors {
@@ -24,7 +24,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
 func newJoinIter(ctx *sql.Context, b sql.NodeExecBuilder, j *plan.JoinNode, row sql.Row) (sql.RowIter, error) {
@@ -46,21 +48,106 @@ func newJoinIter(ctx *sql.Context, b sql.NodeExecBuilder, j *plan.JoinNode, row
 		attribute.String("right", rightName),
 	))
 
-	l, err := b.Build(ctx, j.Left(), row)
+	left := j.Left()
+	if j.Op == plan.JoinTypeHash {
+		var err error
+		left, err = pushDownBloomFilter(ctx, b, j, row)
+		if err != nil {
+			span.End()
+			return nil, err
+		}
+	}
+
+	l, err := b.Build(ctx, left, row)
 	if err != nil {
 		span.End()
 		return nil, err
 	}
-	return sql.NewSpanIter(span, &joinIter{
+	return sql.NewSpanIter(span, newJoinIterForPrimary(b, j, row, l)), nil
+}
+
+// newJoinIterForPrimary returns an iterator of the join |j| given, whose rows of the left side are returned by the
+// |primary| iterator given.
+func newJoinIterForPrimary(b sql.NodeExecBuilder, j *plan.JoinNode, row sql.Row, primary sql.RowIter) sql.RowIter {
+	if access, table, ok := batchLookupAccess(j); ok {
+		return &batchLookupJoinIter{
+			parentRow: row,
+			primary:   primary,
+			access:    access,
+			table:     table,
+			cond:      j.Filter,
+			joinType:  j.Op,
+			rowSize:   len(row) + len(j.Left().Schema()) + len(j.Right().Schema()),
+			scopeLen:  j.ScopeLen,
+		}
+	}
+	return &joinIter{
 		parentRow:         row,
-		primary:           l,
+		primary:           primary,
 		secondaryProvider: j.Right(),
 		cond:              j.Filter,
 		joinType:          j.Op,
 		rowSize:           len(row) + len(j.Left().Schema()) + len(j.Right().Schema()),
 		scopeLen:          j.ScopeLen,
 		b:                 b,
-	}), nil
+	}
+}
+
+// newAdaptiveJoinIter returns an iterator of the adaptive join |j| given, which reads up to its threshold of rows of
+// its left side before choosing which of its joins returns its rows.
+func newAdaptiveJoinIter(ctx *sql.Context, b sql.NodeExecBuilder, j *plan.AdaptiveJoin, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.AdaptiveJoin")
+
+	l, err := b.Build(ctx, j.Lookup.Left(), row)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for uint64(len(rows)) <= j.Threshold {
+		r, err := l.Next(ctx)
+		if err == io.EOF {
+			if err = l.Close(ctx); err != nil {
+				span.End()
+				return nil, err
+			}
+			j.Choose(j.Lookup, uint64(len(rows)))
+			return sql.NewSpanIter(span, newJoinIterForPrimary(b, j.Lookup, row, sql.RowsToRowIter(rows...))), nil
+		} else if err != nil {
+			_ = l.Close(ctx)
+			span.End()
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+
+	j.Choose(j.Hash, uint64(len(rows)))
+	primary := &bufferedRowIter{rows: rows, iter: l}
+	return sql.NewSpanIter(span, newJoinIterForPrimary(b, j.Hash, row, primary)), nil
+}
+
+// bufferedRowIter returns the rows read ahead from an iterator, followed by the rest of its rows.
+type bufferedRowIter struct {
+	rows []sql.Row
+	iter sql.RowIter
+}
+
+var _ sql.RowIter = (*bufferedRowIter)(nil)
+
+// Next implements the sql.RowIter interface.
+func (i *bufferedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if len(i.rows) > 0 {
+		row := i.rows[0]
+		i.rows = i.rows[1:]
+		return row, nil
+	}
+	return i.iter.Next(ctx)
+}
+
+// Close implements the sql.RowIter interface.
+func (i *bufferedRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
 }
 
 // joinIter is an iterator that iterates over every row in the primary table and performs an index lookup in
@@ -222,6 +309,311 @@ func (i *joinIter) Close(ctx *sql.Context) (err error) {
 	return err
 }
 
+// pushDownBloomFilter returns the left side of the hash join |j| given, with a bloom filter of the keys of its build
+// side pushed down to the scan of its table, when it's a table that implements sql.RuntimeFilterTable. The rows of the
+// build side are read, and their hash table built, before the left side is. Otherwise returns the left side as is.
+func pushDownBloomFilter(ctx *sql.Context, b sql.NodeExecBuilder, j *plan.JoinNode, row sql.Row) (sql.Node, error) {
+	left := j.Left()
+	hl, ok := j.Right().(*plan.HashLookup)
+	if !ok {
+		return left, nil
+	}
+	rt, ok := probeTable(left)
+	if !ok {
+		return left, nil
+	}
+	table, ok := rt.Table.(sql.RuntimeFilterTable)
+	if !ok || !supportsRuntimeFilters(rt.Table) {
+		return left, nil
+	}
+	key, ok := probeTableKey(hl.LeftProbeKey, len(row), len(left.Schema()))
+	if !ok {
+		return left, nil
+	}
+
+	cr := hl.Child.(*plan.CachedResults)
+	if cr.GetCachedResults() == nil && !cr.Finalized && !cr.NoCache {
+		// The rows of the left side aren't known yet, as the build side doesn't depend on them
+		iter, err := b.Build(ctx, cr, row.Append(make(sql.Row, len(left.Schema()))))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			_, err = iter.Next(ctx)
+			if err != nil {
+				break
+			}
+		}
+		if err != io.EOF {
+			_ = iter.Close(ctx)
+			return nil, err
+		}
+		if err = iter.Close(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	hl.Mutex.Lock()
+	defer hl.Mutex.Unlock()
+	if err := hl.BuildLookup(ctx); err != nil {
+		return nil, err
+	}
+	if hl.Lookup == nil {
+		return left, nil
+	}
+	if err := hl.BuildBloomFilter(); err != nil {
+		return nil, err
+	}
+
+	filtered, err := rt.WithTable(table.WithRuntimeFilter(plan.NewHashLookupBloomFilter(hl, key)))
+	if err != nil {
+		return nil, err
+	}
+	left, _, err = transform.Node(left, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		if n == rt {
+			return filtered, transform.NewTree, nil
+		}
+		return n, transform.SameTree, nil
+	})
+	return left, err
+}
+
+// supportsRuntimeFilters returns whether the |table| given, or the table it wraps, skips the rows that don't match the
+// filters given to sql.RuntimeFilterTable.WithRuntimeFilter, as wrappers implement it for the tables they wrap.
+func supportsRuntimeFilters(table sql.Table) bool {
+	for {
+		tw, ok := table.(sql.TableWrapper)
+		if !ok {
+			_, ok = table.(sql.RuntimeFilterTable)
+			return ok
+		}
+		table = tw.Underlying()
+	}
+}
+
+// probeTable returns the table scanned by the probe side |n| of a hash join, when its rows are those of the table.
+func probeTable(n sql.Node) (*plan.ResolvedTable, bool) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return n, true
+	case *plan.TableAlias:
+		return probeTable(n.Child)
+	case *plan.Filter:
+		return probeTable(n.Child)
+	default:
+		return nil, false
+	}
+}
+
+// probeTableKey returns the |key| of the probe side of a hash join, which references the columns of its rows after the
+// |scopeLen| columns of the outer scope, rewritten to reference the |numCols| columns of the table of the probe side.
+func probeTableKey(key sql.Expression, scopeLen, numCols int) (sql.Expression, bool) {
+	ok := true
+	key, _, _ = transform.Expr(key, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if e.Index() < scopeLen || e.Index() >= scopeLen+numCols {
+				ok = false
+				return e, transform.SameTree, nil
+			}
+			return e.WithIndex(e.Index() - scopeLen), transform.NewTree, nil
+		case *plan.Subquery, *expression.BindVar, *expression.ProcedureParam:
+			ok = false
+		}
+		return e, transform.SameTree, nil
+	})
+	return key, ok
+}
+
+// lookupJoinBatchSize is the number of rows of the outer side of a lookup join whose rows on the inner side are looked
+// up together by a batchLookupJoinIter.
+const lookupJoinBatchSize = 128
+
+// batchLookupAccess returns the IndexedTableAccess of the inner side of the join |j| given, and its table, when it's a
+// lookup join whose inner side can look up the rows matching many rows of the outer side at once.
+func batchLookupAccess(j *plan.JoinNode) (*plan.IndexedTableAccess, sql.BatchLookupTable, bool) {
+	if !j.Op.IsLookup() {
+		return nil, nil, false
+	}
+	right := j.Right()
+	if ta, ok := right.(*plan.TableAlias); ok {
+		right = ta.Child
+	}
+	access, ok := right.(*plan.IndexedTableAccess)
+	if !ok || access.Index().IsSpatial() {
+		return nil, nil, false
+	}
+	table, ok := access.Table.(sql.BatchLookupTable)
+	return access, table, ok
+}
+
+// batchLookupJoinIter is an iterator for lookup joins that reads the rows of the primary side in batches, and looks
+// up the rows of the secondary side matching each batch with a single multi-range read, rather than one lookup per
+// primary row. Rows are returned in the same order as joinIter returns them.
+type batchLookupJoinIter struct {
+	parentRow sql.Row
+	primary   sql.RowIter
+	access    *plan.IndexedTableAccess
+	table     sql.BatchLookupTable
+	cond      sql.Expression
+	joinType  plan.JoinType
+	rowSize   int
+	scopeLen  int
+
+	// primaryRows are the rows of the current batch, and lookups the position in secondaryRows of the rows matching
+	// the lookup of each of them
+	primaryRows   []sql.Row
+	lookups       []int
+	secondaryRows [][]sql.Row
+	primaryDone   bool
+
+	pos          int
+	secondaryPos int
+	foundMatch   bool
+}
+
+// loadBatch reads the next batch of primary rows, and the secondary rows matching their lookups. Returns io.EOF when
+// there are no more primary rows.
+func (i *batchLookupJoinIter) loadBatch(ctx *sql.Context) error {
+	i.primaryRows = i.primaryRows[:0]
+	i.lookups = i.lookups[:0]
+	i.pos, i.secondaryPos, i.foundMatch = 0, 0, false
+
+	var lookups []sql.IndexLookup
+	positions := make(map[string]int)
+	for !i.primaryDone && len(i.primaryRows) < lookupJoinBatchSize {
+		r, err := i.primary.Next(ctx)
+		if err == io.EOF {
+			i.primaryDone = true
+			break
+		} else if err != nil {
+			return err
+		}
+
+		row := i.parentRow.Append(r)
+		lookup, err := i.access.GetLookup(ctx, row)
+		if err != nil {
+			return err
+		}
+		// lookups of the same ranges are only made once per batch
+		key := indexLookupRangesKey(lookup)
+		pos, ok := positions[key]
+		if !ok {
+			// the ranges of the lookups built for each row share their memory
+			ranges := make(sql.RangeCollection, len(lookup.Ranges))
+			for j, rang := range lookup.Ranges {
+				ranges[j] = rang.Copy()
+			}
+			lookup.Ranges = ranges
+			pos = len(lookups)
+			positions[key] = pos
+			lookups = append(lookups, lookup)
+		}
+		i.primaryRows = append(i.primaryRows, row)
+		i.lookups = append(i.lookups, pos)
+	}
+	if len(i.primaryRows) == 0 {
+		return io.EOF
+	}
+
+	// like in buildIndexedTableAccess, the bounds on TIMESTAMP columns are converted to UTC, and the rows read from it
+	tsLoc := sql.TimestampLocation(ctx)
+	for j := range lookups {
+		lookups[j].Ranges = sql.TimestampRangesToUTC(lookups[j].Ranges, tsLoc)
+	}
+	sch := i.access.Schema()
+
+	iter, err := i.table.BatchLookup(ctx, lookups)
+	if err != nil {
+		return err
+	}
+	sql.IncrementStatusVariable(ctx, "Handler_read_key", int64(len(lookups)))
+	i.secondaryRows = make([][]sql.Row, len(lookups))
+	for {
+		row, matched, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = iter.Close(ctx)
+			return err
+		}
+		sql.IncrementStatusVariable(ctx, "Handler_read_next", 1)
+		row = sql.RowTimestampsFromUTC(sch, row, tsLoc)
+		for _, pos := range matched {
+			i.secondaryRows[pos] = append(i.secondaryRows[pos], row)
+		}
+	}
+	return iter.Close(ctx)
+}
+
+// nextPrimary moves on to the next primary row of the batch.
+func (i *batchLookupJoinIter) nextPrimary() {
+	i.pos++
+	i.secondaryPos = 0
+	i.foundMatch = false
+}
+
+func (i *batchLookupJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.pos >= len(i.primaryRows) {
+			if err := i.loadBatch(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		primary := i.primaryRows[i.pos]
+		secondaryRows := i.secondaryRows[i.lookups[i.pos]]
+		if i.secondaryPos >= len(secondaryRows) {
+			foundMatch := i.foundMatch
+			i.nextPrimary()
+			if !foundMatch && i.joinType.IsLeftOuter() {
+				row := i.buildRow(primary, nil)
+				return i.removeParentRow(row), nil
+			}
+			continue
+		}
+
+		row := i.buildRow(primary, secondaryRows[i.secondaryPos])
+		i.secondaryPos++
+		res, err := i.cond.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		if res == nil && i.joinType.IsExcludeNulls() {
+			i.nextPrimary()
+			continue
+		}
+
+		if res != true {
+			continue
+		}
+
+		i.foundMatch = true
+		return i.removeParentRow(row), nil
+	}
+}
+
+func (i *batchLookupJoinIter) removeParentRow(r sql.Row) sql.Row {
+	copy(r[i.scopeLen:], r[len(i.parentRow):])
+	r = r[:len(r)-len(i.parentRow)+i.scopeLen]
+	return r
+}
+
+// buildRow builds the result set row using the rows from the primary and secondary tables
+func (i *batchLookupJoinIter) buildRow(primary, secondary sql.Row) sql.Row {
+	row := make(sql.Row, i.rowSize)
+
+	copy(row, primary)
+	copy(row[len(primary):], secondary)
+
+	return row
+}
+
+func (i *batchLookupJoinIter) Close(ctx *sql.Context) error {
+	return i.primary.Close(ctx)
+}
+
 func newExistsIter(ctx *sql.Context, b sql.NodeExecBuilder, j *plan.JoinNode, row sql.Row) (sql.RowIter, error) {
 	leftIter, err := b.Build(ctx, j.Left(), row)
 
@@ -29,6 +29,16 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildCreateForeignKey(ctx, n, row)
 	case *plan.AlterTableCollation:
 		return b.buildAlterTableCollation(ctx, n, row)
+	case *plan.AlterTableOptions:
+		return b.buildAlterTableOptions(ctx, n, row)
+	case *plan.AlterTable:
+		return b.buildAlterTable(ctx, n, row)
+	case *plan.CreateSequence:
+		return b.buildCreateSequence(ctx, n, row)
+	case *plan.AlterSequence:
+		return b.buildAlterSequence(ctx, n, row)
+	case *plan.DropSequence:
+		return b.buildDropSequence(ctx, n, row)
 	case *plan.CreateRole:
 		return b.buildCreateRole(ctx, n, row)
 	case *plan.Loop:
@@ -39,6 +49,12 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDropColumn(ctx, n, row)
 	case *plan.AnalyzeTable:
 		return b.buildAnalyzeTable(ctx, n, row)
+	case *plan.ChecksumTable:
+		return b.buildChecksumTable(ctx, n, row)
+	case *plan.CheckTable:
+		return b.buildCheckTable(ctx, n, row)
+	case *plan.OptimizeTable:
+		return b.buildOptimizeTable(ctx, n, row)
 	case *plan.QueryProcess:
 		return b.buildQueryProcess(ctx, n, row)
 	case *plan.ShowReplicaStatus:
@@ -167,10 +183,14 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDeclareVariables(ctx, n, row)
 	case *plan.Filter:
 		return b.buildFilter(ctx, n, row)
+	case *plan.TableSample:
+		return b.buildTableSample(ctx, n, row)
 	case *plan.Kill:
 		return b.buildKill(ctx, n, row)
 	case *plan.ShowPrivileges:
 		return b.buildShowPrivileges(ctx, n, row)
+	case *plan.ShowEngineStatus:
+		return b.buildShowEngineStatus(ctx, n, row)
 	case *plan.AlterPK:
 		return b.buildAlterPK(ctx, n, row)
 	case plan.Nothing:
@@ -181,12 +201,16 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDeferredAsOfTable(ctx, n, row)
 	case *plan.CreateUser:
 		return b.buildCreateUser(ctx, n, row)
+	case *plan.AlterUser:
+		return b.buildAlterUser(ctx, n, row)
 	case *plan.DropView:
 		return b.buildDropView(ctx, n, row)
 	case *plan.GroupBy:
 		return b.buildGroupBy(ctx, n, row)
 	case *plan.RowUpdateAccumulator:
 		return b.buildRowUpdateAccumulator(ctx, n, row)
+	case *plan.Returning:
+		return b.buildReturning(ctx, n, row)
 	case *plan.Block:
 		return b.buildBlock(ctx, n, row)
 	case *plan.InsertDestination:
@@ -233,8 +257,12 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildSingleDropView(ctx, n, row)
 	case *plan.EmptyTable:
 		return b.buildEmptyTable(ctx, n, row)
+	case *plan.CachedQueryResults:
+		return b.buildCachedQueryResults(ctx, n, row)
 	case *plan.JoinNode:
 		return b.buildJoinNode(ctx, n, row)
+	case *plan.AdaptiveJoin:
+		return b.buildAdaptiveJoin(ctx, n, row)
 	case *plan.RenameUser:
 		return b.buildRenameUser(ctx, n, row)
 	case *plan.ShowCreateProcedure:
@@ -243,6 +271,8 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildCommit(ctx, n, row)
 	case *plan.DeferredFilteredTable:
 		return b.buildDeferredFilteredTable(ctx, n, row)
+	case *plan.DeferredTableFunction:
+		return b.buildDeferredTableFunction(ctx, n, row)
 	case *plan.Values:
 		return b.buildValues(ctx, n, row)
 	case *plan.DropRole:
@@ -303,6 +333,12 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDropConstraint(ctx, n, row)
 	case *plan.FlushPrivileges:
 		return b.buildFlushPrivileges(ctx, n, row)
+	case *plan.FlushTables:
+		return b.buildFlushTables(ctx, n, row)
+	case *plan.FlushLogs:
+		return b.buildFlushLogs(ctx, n, row)
+	case *plan.BackupDatabase:
+		return b.buildBackupDatabase(ctx, n, row)
 	case *plan.Leave:
 		return b.buildLeave(ctx, n, row)
 	case *plan.While:
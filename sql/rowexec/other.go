@@ -136,6 +136,14 @@ func (b *BaseBuilder) buildDeferredFilteredTable(ctx *sql.Context, n *plan.Defer
 	return nil, fmt.Errorf("%T has no execution iterator", n)
 }
 
+func (b *BaseBuilder) buildDeferredTableFunction(ctx *sql.Context, n *plan.DeferredTableFunction, row sql.Row) (sql.RowIter, error) {
+	instance, err := n.Instantiate(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return b.buildNodeExec(ctx, instance, row)
+}
+
 func (b *BaseBuilder) buildNamedWindows(ctx *sql.Context, n *plan.NamedWindows, row sql.Row) (sql.RowIter, error) {
 	return nil, fmt.Errorf("%T has no execution iterator", n)
 }
@@ -204,13 +212,26 @@ func (b *BaseBuilder) buildExchange(ctx *sql.Context, n *plan.Exchange, row sql.
 }
 
 func (b *BaseBuilder) buildExchangePartition(ctx *sql.Context, n *plan.ExchangePartition, row sql.Row) (sql.RowIter, error) {
-	return n.Table.PartitionRows(ctx, n.Partition)
+	iter, err := n.Table.PartitionRows(ctx, n.Partition)
+	if err != nil {
+		return nil, err
+	}
+	return withTimestampsFromUTC(ctx, n.Table.Schema(), iter), nil
 }
 
 func (b *BaseBuilder) buildEmptyTable(ctx *sql.Context, n *plan.EmptyTable, row sql.Row) (sql.RowIter, error) {
 	return sql.RowsToRowIter(), nil
 }
 
+func (b *BaseBuilder) buildCachedQueryResults(ctx *sql.Context, n *plan.CachedQueryResults, row sql.Row) (sql.RowIter, error) {
+	// The cached rows are shared by every query returning them, so each gets its own copies
+	rows := make([]sql.Row, len(n.Rows))
+	for i, r := range n.Rows {
+		rows[i] = r.Copy()
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
 func (b *BaseBuilder) buildDeclareCursor(ctx *sql.Context, n *plan.DeclareCursor, row sql.Row) (sql.RowIter, error) {
 	return &declareCursorIter{n}, nil
 }
@@ -248,6 +269,10 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 	var returnNode sql.Node
 	var returnSch sql.Schema
 
+	// In a trigger body, the statements following one that sets columns of the NEW row run on the updated row
+	inputRow := row
+	triggerRowUpdated := false
+
 	selectSeen := false
 	for _, s := range n.Children() {
 		// TODO: this should happen at iteration time, but this call is where the actual iteration happens
@@ -255,6 +280,7 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 		if err != nil {
 			return nil, err
 		}
+		stmtRow := row
 
 		err = func() error {
 			rowCache, disposeFunc := ctx.Memory.NewRowsCache()
@@ -293,6 +319,9 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 					break
 				} else if err != nil {
 					return err
+				} else if updatedRow, ok := updatedTriggerRow(s, stmtRow, newRow); ok {
+					row = updatedRow
+					triggerRowUpdated = true
 				} else if isSelect || !selectSeen {
 					err = rowCache.Add(newRow)
 					if err != nil {
@@ -306,6 +335,9 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 			return nil, err
 		}
 	}
+	if triggerRowUpdated && !selectSeen {
+		returnRows = []sql.Row{inputRow.Append(row)}
+	}
 
 	n.SetSchema(returnSch)
 	return &blockIter{
@@ -290,15 +290,11 @@ type exchangeRowIter struct {
 	shutdownHook func()
 	waiter       func() error
 	rows         <-chan sql.Row
-	rows2        <-chan sql.Row2
 }
 
 var _ sql.RowIter = (*exchangeRowIter)(nil)
 
 func (i *exchangeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if i.rows == nil {
-		panic("Next called for a Next2 iterator")
-	}
 	r, ok := <-i.rows
 	if !ok {
 		return nil, i.waiter()
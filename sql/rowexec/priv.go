@@ -29,14 +29,94 @@ func (b *BaseBuilder) buildFlushPrivileges(ctx *sql.Context, n *plan.FlushPrivil
 	if !ok {
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
-	err := gts.Persist(ctx)
+	// Reload the privileges from the persister if it can load them, otherwise persist the ones edited in the tables
+	reloaded, err := gts.Reload(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if !reloaded {
+		if err = gts.Persist(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
 }
 
+func (b *BaseBuilder) buildFlushTables(ctx *sql.Context, n *plan.FlushTables, row sql.Row) (sql.RowIter, error) {
+	if len(n.Tables) == 0 {
+		for _, db := range n.Catalog.AllDatabases(ctx) {
+			if privDb, ok := db.(mysql_db.PrivilegedDatabase); ok {
+				db = privDb.Unwrap()
+			}
+			if tc, ok := db.(sql.TableCacheDatabase); ok {
+				if err := tc.FlushTables(ctx, nil); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if n.ReadLock {
+			n.GlobalReadLock.Acquire(ctx.ID())
+		}
+		return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+	}
+
+	names, tables, err := maintenanceTables(ctx, n.Catalog, n.Tables)
+	if err != nil {
+		return nil, err
+	}
+	// The tables of each database are flushed together
+	var dbNames []string
+	tableNames := make(map[string][]string)
+	for i, table := range tables {
+		if table == nil {
+			return nil, sql.ErrTableNotFound.New(names[i].Table)
+		}
+		if _, ok := tableNames[names[i].Db]; !ok {
+			dbNames = append(dbNames, names[i].Db)
+		}
+		tableNames[names[i].Db] = append(tableNames[names[i].Db], table.Name())
+	}
+	for _, dbName := range dbNames {
+		db, err := n.Catalog.Database(ctx, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if privDb, ok := db.(mysql_db.PrivilegedDatabase); ok {
+			db = privDb.Unwrap()
+		}
+		if tc, ok := db.(sql.TableCacheDatabase); ok {
+			if err = tc.FlushTables(ctx, tableNames[dbName]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The tables named are read locked like with LOCK TABLES ... READ
+	if n.ReadLock {
+		for _, table := range tables {
+			lockable, err := getLockableTable(table)
+			if err != nil {
+				// If a table is not lockable, just skip it
+				ctx.Warn(0, err.Error())
+				continue
+			}
+			if err = lockable.Lock(ctx, false); err != nil {
+				return nil, err
+			}
+			n.Catalog.LockTable(ctx, lockable.Name())
+		}
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+func (b *BaseBuilder) buildFlushLogs(ctx *sql.Context, n *plan.FlushLogs, row sql.Row) (sql.RowIter, error) {
+	if err := n.LogSinks.Rotate(ctx, n.LogTypes...); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
 func (b *BaseBuilder) buildDropUser(ctx *sql.Context, n *plan.DropUser, row sql.Row) (sql.RowIter, error) {
 	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
 	if !ok {
@@ -75,6 +155,9 @@ func (b *BaseBuilder) buildDropUser(ctx *sql.Context, n *plan.DropUser, row sql.
 		if err != nil {
 			return nil, err
 		}
+		if err = mysqlDb.RemovePasswordHistory(ctx, existingUser); err != nil {
+			return nil, err
+		}
 	}
 	if err := mysqlDb.Persist(ctx); err != nil {
 		return nil, err
@@ -82,6 +165,103 @@ func (b *BaseBuilder) buildDropUser(ctx *sql.Context, n *plan.DropUser, row sql.
 	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
 }
 
+func (b *BaseBuilder) buildAlterUser(ctx *sql.Context, n *plan.AlterUser, row sql.Row) (sql.RowIter, error) {
+	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+	userTableData := mysqlDb.UserTable().Data()
+	client := ctx.Session.Client()
+	currentUser := mysqlDb.GetUser(client.User, client.Address, false)
+	for _, user := range n.Users {
+		existingUser := mysqlDb.GetUser(user.Name, user.Host, false)
+		if existingUser == nil {
+			if n.IfExists {
+				continue
+			}
+			return nil, sql.ErrUserAlterationFailure.New(user.String("'"))
+		}
+
+		userEntry := existingUser.Copy(ctx).(*mysql_db.User)
+		if err := n.Options.ApplyTo(userEntry); err != nil {
+			return nil, sql.ErrUserAlterationFailure.New(err)
+		}
+		if user.Auth1 != nil {
+			if err := checkCurrentPassword(ctx, mysqlDb, existingUser, user.CurrentPassword, existingUser == currentUser); err != nil {
+				return nil, err
+			}
+			plugin := user.Auth1.Plugin()
+			if plugin != "mysql_native_password" {
+				if err := mysqlDb.VerifyPlugin(plugin); err != nil {
+					return nil, sql.ErrUserAlterationFailure.New(err)
+				}
+			}
+			password := user.Auth1.Password()
+			if err := mysqlDb.CheckPasswordHistory(userEntry, password); err != nil {
+				return nil, err
+			}
+			userEntry.Plugin = plugin
+			userEntry.Password = password
+			userEntry.Identity = user.Identity
+			userEntry.PasswordLastChanged = time.Now().UTC()
+			// changing the password ends its expiration, unless PASSWORD EXPIRE is also given
+			userEntry.PasswordExpired = n.Options.ExpirePassword
+		}
+
+		err := userTableData.Remove(ctx, mysql_db.UserPrimaryKey{
+			Host: existingUser.Host,
+			User: existingUser.User,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err = userTableData.Put(ctx, userEntry); err != nil {
+			return nil, err
+		}
+		if user.Auth1 != nil {
+			if err = mysqlDb.RecordPasswordHistory(ctx, userEntry); err != nil {
+				return nil, err
+			}
+		}
+		if n.Options.ResetsLoginFailures() {
+			mysqlDb.ResetLoginFailures(userEntry)
+		}
+		// a session whose password has expired is no longer restricted once it changes its password
+		if existingUser == currentUser && client.PasswordExpired && !userEntry.PasswordExpired {
+			client.PasswordExpired = false
+			ctx.Session.SetClient(client)
+		}
+	}
+	if err := mysqlDb.Persist(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+// checkCurrentPassword returns an error if the password of the account given can't be changed with the current password
+// given, which is nil without a REPLACE clause. Only an account changing its own password gives its current one, which
+// it has to give when the account requires it, unless it may change the password of any account.
+func checkCurrentPassword(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb, userEntry *mysql_db.User, current *string, ownAccount bool) error {
+	if current == nil {
+		if ownAccount && mysqlDb.RequiresCurrentPassword(userEntry) &&
+			!mysqlDb.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_CreateUser)) &&
+			!mysqlDb.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("mysql", "", "", sql.PrivilegeType_Update)) {
+			return sql.ErrMissingCurrentPassword.New()
+		}
+		return nil
+	}
+	if !ownAccount {
+		return sql.ErrCurrentPasswordNotRequired.New()
+	}
+	if userEntry.Plugin != "mysql_native_password" {
+		return sql.ErrUnsupportedFeature.New("REPLACE for accounts authenticated with " + userEntry.Plugin)
+	}
+	if plan.AuthenticationMysqlNativePassword(*current).Password() != userEntry.Password {
+		return sql.ErrIncorrectCurrentPassword.New()
+	}
+	return nil
+}
+
 func (b *BaseBuilder) buildRevokeRole(ctx *sql.Context, n *plan.RevokeRole, row sql.Row) (sql.RowIter, error) {
 	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
 	if !ok {
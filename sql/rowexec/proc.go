@@ -208,6 +208,10 @@ func (b *BaseBuilder) buildLoop(ctx *sql.Context, n *plan.Loop, row sql.Row) (sq
 	// Acquiring the RowIter will actually execute the loop body once (because of how we cache/scan for the right
 	// SELECT result set to return), so we grab the iter ONLY if we're supposed to run through the loop body once
 	// before evaluating the condition
+	// In a trigger body, each iteration runs on the NEW row as updated by the iteration before it
+	inputRow := row
+	triggerRowUpdated := false
+
 	var loopBodyIter sql.RowIter
 	if n.OnceBeforeEval {
 		var err error
@@ -231,7 +235,7 @@ func (b *BaseBuilder) buildLoop(ctx *sql.Context, n *plan.Loop, row sql.Row) (sq
 		}
 
 		// If the condition is false, then we stop evaluation
-		condition, err := n.Condition.Eval(ctx, nil)
+		condition, err := n.Condition.Eval(ctx, row)
 		if err != nil {
 			return nil, err
 		}
@@ -252,7 +256,7 @@ func (b *BaseBuilder) buildLoop(ctx *sql.Context, n *plan.Loop, row sql.Row) (sq
 
 		if loopBodyIter == nil {
 			var err error
-			loopBodyIter, err = b.loopAcquireRowIter(ctx, nil, strings.ToLower(n.Label), n.Block, false)
+			loopBodyIter, err = b.loopAcquireRowIter(ctx, row, strings.ToLower(n.Label), n.Block, false)
 			if err == io.EOF {
 				break
 			} else if err != nil {
@@ -285,8 +289,14 @@ func (b *BaseBuilder) buildLoop(ctx *sql.Context, n *plan.Loop, row sql.Row) (sq
 			rowCache, disposeFunc := ctx.Memory.NewRowsCache()
 			defer disposeFunc()
 
+			iterationRow := row
 			nextRow, err := loopBodyIter.Next(ctx)
 			for ; err == nil; nextRow, err = loopBodyIter.Next(ctx) {
+				if updatedRow, ok := updatedTriggerRow(n.Block, iterationRow, nextRow); ok {
+					row = updatedRow
+					triggerRowUpdated = true
+					continue
+				}
 				rowCache.Add(nextRow)
 			}
 			if err != io.EOF {
@@ -320,6 +330,10 @@ func (b *BaseBuilder) buildLoop(ctx *sql.Context, n *plan.Loop, row sql.Row) (sq
 		}
 	}
 
+	if triggerRowUpdated && !selectSeen {
+		returnRows = []sql.Row{inputRow.Append(row)}
+	}
+
 	return &blockIter{
 		internalIter: sql.RowsToRowIter(returnRows...),
 		repNode:      returnNode,
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 
 	"github.com/dolthub/jsonpath"
 	"github.com/shopspring/decimal"
@@ -202,25 +203,8 @@ func (b *BaseBuilder) buildJSONTable(ctx *sql.Context, n *plan.JSONTable, row sq
 func (b *BaseBuilder) buildHashLookup(ctx *sql.Context, n *plan.HashLookup, row sql.Row) (sql.RowIter, error) {
 	n.Mutex.Lock()
 	defer n.Mutex.Unlock()
-	if n.Lookup == nil {
-		// Instead of building the mapping inline here with a special
-		// RowIter, we currently make use of CachedResults and require
-		// *CachedResults to be our direct child.
-		cr := n.UnaryNode.Child.(*plan.CachedResults)
-		if res := cr.GetCachedResults(); res != nil {
-			n.Lookup = make(map[interface{}][]sql.Row)
-			for _, row := range res {
-				// TODO: Maybe do not put nil stuff in here.
-				key, err := n.GetHashKey(ctx, n.RightEntryKey, row)
-				if err != nil {
-					return nil, err
-				}
-				n.Lookup[key] = append(n.Lookup[key], row)
-			}
-			// CachedResult is safe to Dispose after contents are transferred
-			// to |n.lookup|
-			cr.Dispose()
-		}
+	if err := n.BuildLookup(ctx); err != nil {
+		return nil, err
 	}
 	if n.Lookup != nil {
 		key, err := n.GetHashKey(ctx, n.LeftProbeKey, row)
@@ -270,6 +254,10 @@ func (b *BaseBuilder) buildJoinNode(ctx *sql.Context, n *plan.JoinNode, row sql.
 	}
 }
 
+func (b *BaseBuilder) buildAdaptiveJoin(ctx *sql.Context, n *plan.AdaptiveJoin, row sql.Row) (sql.RowIter, error) {
+	return newAdaptiveJoinIter(ctx, b, n, row)
+}
+
 func (b *BaseBuilder) buildOrderedDistinct(ctx *sql.Context, n *plan.OrderedDistinct, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.OrderedDistinct")
 
@@ -299,6 +287,7 @@ func (b *BaseBuilder) buildProject(ctx *sql.Context, n *plan.Project, row sql.Ro
 
 	return sql.NewSpanIter(span, &projectIter{
 		p:         n.Projections,
+		program:   projectionsProgram(n.Projections),
 		childIter: i,
 	}), nil
 }
@@ -396,6 +385,18 @@ func (b *BaseBuilder) buildFilter(ctx *sql.Context, n *plan.Filter, row sql.Row)
 	return sql.NewSpanIter(span, plan.NewFilterIter(n.Expression, i)), nil
 }
 
+func (b *BaseBuilder) buildTableSample(ctx *sql.Context, n *plan.TableSample, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.TableSample", trace.WithAttributes(attribute.Stringer("sample", n.Sample)))
+
+	i, err := b.buildNodeExec(ctx, n.Child, row)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, newTableSampleIter(n.Sample, i)), nil
+}
+
 func (b *BaseBuilder) buildDeclareVariables(ctx *sql.Context, n *plan.DeclareVariables, row sql.Row) (sql.RowIter, error) {
 	return &declareVariablesIter{n, row}, nil
 }
@@ -504,6 +505,10 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	span, ctx := ctx.Span("plan.Into")
 	defer span.End()
 
+	if len(n.Child.Schema()) != len(n.IntoVars) {
+		return nil, sql.ErrColumnNumberDoesNotMatch.New()
+	}
+
 	rowIter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
 		return nil, err
@@ -519,20 +524,19 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	}
 	if rowNum == 0 {
 		// a warning with error code 1329 occurs (No data), and make no change to variables
+		ctx.Warn(1329, "No data - zero rows fetched, selected, or processed")
 		return sql.RowsToRowIter(sql.Row{}), nil
 	}
-	if len(rows[0]) != len(n.IntoVars) {
-		return nil, sql.ErrColumnNumberDoesNotMatch.New()
-	}
 
 	var rowValues = make([]interface{}, len(rows[0]))
 
 	copy(rowValues, rows[0])
 
+	sch := n.Child.Schema()
 	for j, v := range n.IntoVars {
 		switch variable := v.(type) {
 		case *expression.UserVar:
-			varType := types.ApproximateTypeFromValue(rowValues[j])
+			varType := types.UserVariableType(sch[j].Type, rowValues[j])
 			err = ctx.SetUserVariable(ctx, variable.Name, rowValues[j], varType)
 			if err != nil {
 				return nil, err
@@ -635,13 +639,130 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 	if err != nil {
 		return nil, err
 	}
+	// the bounds on TIMESTAMP columns are in the session's time zone, and the indexed values in UTC
+	lookup.Ranges = sql.TimestampRangesToUTC(lookup.Ranges, sql.TimestampLocation(ctx))
 
-	partIter, err := n.Table.LookupPartitions(ctx, lookup)
+	build := func() (sql.RowIter, error) {
+		partIter, err := n.Table.LookupPartitions(ctx, lookup)
+		if err != nil {
+			return nil, err
+		}
+		sql.IncrementStatusVariable(ctx, "Handler_read_key", 1)
+		return newRowStatusIter(sql.NewTableRowIter(ctx, n.Table, partIter), "Handler_read_next"), nil
+	}
+
+	var iter sql.RowIter
+	if cache := ctx.IndexLookupCache(); cache != nil {
+		var key string
+		var shared bool
+		key, shared, err = indexLookupCacheKey(ctx, n, lookup)
+		if err != nil {
+			return nil, err
+		}
+		iter, err = cache.Iter(key, shared, build)
+	} else {
+		iter, err = build()
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, n.Table, partIter)), nil
+	return sql.NewSpanIter(span, withTimestampsFromUTC(ctx, n.Schema(), iter)), nil
+}
+
+// indexLookupCacheKey returns the key that the rows of the |lookup| of |n| are cached under, and whether they're kept
+// in the shared tier of the cache, across queries. Static lookups of tables that implement sql.VersionedTable, and
+// don't filter their rows with pushed down expressions, are shared and keyed by the data version of the table and the
+// offset pushed down to it.
+// Other lookups are only cached for the query, keyed by the node they're made by.
+func indexLookupCacheKey(ctx *sql.Context, n *plan.IndexedTableAccess, lookup sql.IndexLookup) (string, bool, error) {
+	ranges := indexLookupRangesKey(lookup)
+	if !n.IsStatic() || hasPushedDownFilters(n.Table) {
+		return fmt.Sprintf("%p|%s", n, ranges), false, nil
+	}
+
+	var table sql.Table = n.ResolvedTable.Table
+	for table != nil {
+		if vt, ok := table.(sql.VersionedTable); ok {
+			version, err := vt.DataVersion(ctx)
+			if err != nil {
+				return "", false, err
+			}
+			dbName := ""
+			if n.ResolvedTable.Database != nil {
+				dbName = n.ResolvedTable.Database.Name()
+			}
+			columns := make([]string, len(n.Table.Schema()))
+			for i, c := range n.Table.Schema() {
+				columns[i] = c.Name
+			}
+			var offset uint64
+			if ot, ok := n.Table.(sql.IndexOffsetTable); ok {
+				offset = ot.IndexOffset()
+			}
+			key := fmt.Sprintf("%q.%q:%s|%q|%q|%t|%d|%s", dbName, table.Name(), version, lookup.Index.ID(), columns, lookup.IsReverse, offset, ranges)
+			return key, true, nil
+		}
+		tw, ok := table.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		table = tw.Underlying()
+	}
+	return fmt.Sprintf("%p|%s", n, ranges), false, nil
+}
+
+// hasPushedDownFilters returns whether the |table| given filters the rows of its lookups with expressions, whose
+// results may not only depend on the rows.
+func hasPushedDownFilters(table sql.Table) bool {
+	if ft, ok := table.(sql.FilteredTable); ok && len(ft.Filters()) > 0 {
+		return true
+	}
+	if ict, ok := table.(sql.IndexConditionPushdownTable); ok && len(ict.IndexConditions()) > 0 {
+		return true
+	}
+	return false
+}
+
+// indexLookupRangesKey returns a string identifying the ranges of the |lookup| given. Unlike their String method, the
+// types of the values of the bounds are included, and strings are quoted.
+func indexLookupRangesKey(lookup sql.IndexLookup) string {
+	var sb strings.Builder
+	for _, rang := range lookup.Ranges {
+		sb.WriteByte('{')
+		for _, colExpr := range rang {
+			writeRangeCutKey(&sb, colExpr.LowerBound)
+			sb.WriteByte(',')
+			writeRangeCutKey(&sb, colExpr.UpperBound)
+			sb.WriteByte(';')
+		}
+		sb.WriteByte('}')
+	}
+	return sb.String()
+}
+
+func writeRangeCutKey(sb *strings.Builder, cut sql.RangeCut) {
+	switch cut := cut.(type) {
+	case sql.Above:
+		sb.WriteString("above ")
+		writeRangeKeyValue(sb, cut.Key)
+	case sql.Below:
+		sb.WriteString("below ")
+		writeRangeKeyValue(sb, cut.Key)
+	default:
+		fmt.Fprintf(sb, "%T", cut)
+	}
+}
+
+func writeRangeKeyValue(sb *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case string:
+		fmt.Fprintf(sb, "%q", v)
+	case []byte:
+		fmt.Fprintf(sb, "%q", v)
+	default:
+		fmt.Fprintf(sb, "%T(%v)", v, v)
+	}
 }
 
 func (b *BaseBuilder) buildUnion(ctx *sql.Context, u *plan.Union, row sql.Row) (sql.RowIter, error) {
@@ -728,7 +849,8 @@ func (b *BaseBuilder) buildResolvedTable(ctx *sql.Context, n *plan.ResolvedTable
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, n.Table, partitions)), nil
+	iter := newRowStatusIter(sql.NewTableRowIter(ctx, n.Table, partitions), "Handler_read_rnd_next")
+	return sql.NewSpanIter(span, withTimestampsFromUTC(ctx, n.Schema(), iter)), nil
 }
 
 func (b *BaseBuilder) buildTableCount(_ *sql.Context, n *plan.TableCountLookup, _ sql.Row) (sql.RowIter, error) {
@@ -19,8 +19,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"sort"
-	"strings"
+	"math"
+	"math/rand"
+	"time"
 
 	"github.com/dolthub/jsonpath"
 
@@ -243,6 +244,50 @@ func (i *offsetIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
 }
 
+// tableSampleIter returns a sample of the rows of its child, each of which is sampled independently of the others.
+// Rows of a repeatable sample are sampled by their hash with its seed, so that the same rows are sampled however the
+// rows of the child are ordered, which they aren't when the partitions of its table are read in parallel.
+type tableSampleIter struct {
+	sample    sql.TableSample
+	rand      *rand.Rand
+	childIter sql.RowIter
+}
+
+func newTableSampleIter(sample sql.TableSample, child sql.RowIter) *tableSampleIter {
+	return &tableSampleIter{
+		sample:    sample,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		childIter: child,
+	}
+}
+
+func (i *tableSampleIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := i.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var p float64
+		if i.sample.Repeatable {
+			h, err := sql.HashOf(append(sql.Row{i.sample.Seed}, row...))
+			if err != nil {
+				return nil, err
+			}
+			p = float64(h) / math.MaxUint64
+		} else {
+			p = i.rand.Float64()
+		}
+		if p*100 < i.sample.Percent {
+			return row, nil
+		}
+	}
+}
+
+func (i *tableSampleIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
+
 type jsonTableColOpts struct {
 	name      string
 	typ       sql.Type
@@ -504,7 +549,9 @@ func (di *orderedDistinctIter) Close(ctx *sql.Context) error {
 }
 
 type projectIter struct {
-	p         []sql.Expression
+	p []sql.Expression
+	// program is the program the projections were compiled into, if any, whose steps are shared by the projections
+	program   *expression.Program
 	childIter sql.RowIter
 }
 
@@ -514,13 +561,47 @@ func (i *projectIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, err
 	}
 
-	return ProjectRow(ctx, i.p, childRow)
+	return ProjectRow(ctx, i.p, i.withFrame(childRow))
+}
+
+// NextBatch implements sql.RowBatchIter.
+func (i *projectIter) NextBatch(ctx *sql.Context, batch []sql.Row) (int, error) {
+	n, err := sql.NextRowBatch(ctx, i.childIter, batch)
+	for j := 0; j < n; j++ {
+		row, pErr := ProjectRow(ctx, i.p, i.withFrame(batch[j]))
+		if pErr != nil {
+			return j, pErr
+		}
+		batch[j] = row
+	}
+	return n, err
 }
 
 func (i *projectIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
 }
 
+// withFrame returns the row given with the frame of the program of the projections, if they have one.
+func (i *projectIter) withFrame(row sql.Row) sql.Row {
+	if i.program == nil {
+		return row
+	}
+	return i.program.WithFrame(row)
+}
+
+// projectionsProgram returns the program the projections given were compiled into, or nil if they weren't.
+func projectionsProgram(projections []sql.Expression) *expression.Program {
+	for _, e := range projections {
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+		if o, ok := e.(*expression.ProgramOutput); ok {
+			return o.Program
+		}
+	}
+	return nil
+}
+
 // ProjectRow evaluates a set of projections.
 func ProjectRow(
 	ctx *sql.Context,
@@ -581,7 +662,7 @@ func setUserVar(ctx *sql.Context, userVar *expression.UserVar, right sql.Express
 	if err != nil {
 		return err
 	}
-	typ := types.ApproximateTypeFromValue(val)
+	typ := types.UserVariableType(right.Type(), val)
 
 	err = ctx.SetUserVariable(ctx, userVar.Name, val, typ)
 	if err != nil {
@@ -607,11 +688,7 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 			return err
 		}
 	case sql.SystemVariableScope_Persist:
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
-		}
-		err = persistSess.PersistGlobal(sysVar.Name, val)
+		persister, err := sql.SystemVariablePersisterFor(ctx)
 		if err != nil {
 			return err
 		}
@@ -619,60 +696,76 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 		if err != nil {
 			return err
 		}
+		_, val, _ = sql.SystemVariables.GetGlobal(sysVar.Name)
+		err = persister.PersistGlobal(sysVar.Name, val)
+		if err != nil {
+			return err
+		}
 	case sql.SystemVariableScope_PersistOnly:
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
+		persister, err := sql.SystemVariablePersisterFor(ctx)
+		if err != nil {
+			return err
 		}
-		err = persistSess.PersistGlobal(sysVar.Name, val)
+		val, err = persistOnlyValue(sysVar.Name, val)
 		if err != nil {
 			return err
 		}
+		return persister.PersistGlobal(sysVar.Name, val)
 	case sql.SystemVariableScope_ResetPersist:
 		// TODO: add parser support for RESET PERSIST
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
-		}
-		if sysVar.Name == "" {
-			err = persistSess.RemoveAllPersistedGlobals()
-		}
-		err = persistSess.RemovePersistedGlobal(sysVar.Name)
+		persister, err := sql.SystemVariablePersisterFor(ctx)
 		if err != nil {
 			return err
 		}
+		if sysVar.Name == "" {
+			return persister.RemoveAllPersistedGlobals()
+		}
+		return persister.RemovePersistedGlobal(sysVar.Name)
 	default: // should never be hit
 		return fmt.Errorf("unable to set `%s` due to unknown scope `%v`", sysVar.Name, sysVar.Scope)
 	}
-	// Setting `character_set_connection`, regardless of how it is set (directly or through SET NAMES) will also set
-	// `collation_connection` to the default collation for the given character set.
-	if strings.ToLower(sysVar.Name) == "character_set_connection" {
-		newSysVar := &expression.SystemVar{
-			Name:  "collation_connection",
-			Scope: sysVar.Scope,
+	return updatedSystemVar(ctx, sysVar)
+}
+
+// persistOnlyValue returns the value given for the system variable named, converted and validated, to be persisted by
+// SET PERSIST_ONLY. Unlike other SETs, SET PERSIST_ONLY may set the read only variables, which take the value persisted
+// when the server restarts.
+func persistOnlyValue(name string, val interface{}) (interface{}, error) {
+	sysVar, _, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return nil, sql.ErrUnknownSystemVariable.New(name)
+	}
+	if sysVar.Scope == sql.SystemVariableScope_Session {
+		return nil, sql.ErrSystemVariableSessionOnly.New(name)
+	}
+	if sysVar.ValueFunction != nil {
+		return nil, sql.ErrSystemVariableReadOnly.New(name)
+	}
+	val, err := sysVar.ConvertValue(val)
+	if err != nil {
+		return nil, err
+	}
+	if setType, ok := sysVar.Type.(sql.SetType); ok {
+		if bits, ok := val.(uint64); ok {
+			return setType.BitsToString(bits)
 		}
-		if val == nil {
-			err = setSystemVar(ctx, newSysVar, expression.NewLiteral("", types.LongText), row)
-			if err != nil {
-				return err
-			}
-		} else {
-			valStr, ok := val.(string)
-			if !ok {
-				return sql.ErrInvalidSystemVariableValue.New("collation_connection", val)
-			}
-			charset, err := sql.ParseCharacterSet(valStr)
-			if err != nil {
-				return err
-			}
-			charset = charset
-			err = setSystemVar(ctx, newSysVar, expression.NewLiteral(charset.DefaultCollation().Name(), types.LongText), row)
-			if err != nil {
-				return err
-			}
+	}
+	return val, nil
+}
+
+// updatedSystemVar calls the OnUpdate function of the system variable given, once a SET statement changed its value.
+func updatedSystemVar(ctx *sql.Context, sysVar *expression.SystemVar) error {
+	def, val, ok := sql.SystemVariables.GetGlobal(sysVar.Name)
+	if !ok || def.OnUpdate == nil {
+		return nil
+	}
+	if sysVar.Scope == sql.SystemVariableScope_Session {
+		var err error
+		if val, err = ctx.GetSessionVariable(ctx, sysVar.Name); err != nil {
+			return err
 		}
 	}
-	return nil
+	return def.OnUpdate(ctx, sysVar.Scope, sql.SystemVarValue{Var: def, Val: val})
 }
 
 // Applies the update expressions given to the row given, returning the new resultant row.
@@ -908,8 +1001,8 @@ func (li *limitIter) Close(ctx *sql.Context) error {
 type sortIter struct {
 	sortFields sql.SortFields
 	childIter  sql.RowIter
-	sortedRows []sql.Row
-	idx        int
+	sorter     *externalSorter
+	sortedRows sql.RowIter
 }
 
 var _ sql.RowIter = (*sortIter)(nil)
@@ -918,36 +1011,32 @@ func newSortIter(s sql.SortFields, child sql.RowIter) *sortIter {
 	return &sortIter{
 		sortFields: s,
 		childIter:  child,
-		idx:        -1,
 	}
 }
 
 func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if i.idx == -1 {
+	if i.sortedRows == nil {
 		err := i.computeSortedRows(ctx)
 		if err != nil {
 			return nil, err
 		}
-		i.idx = 0
 	}
 
-	if i.idx >= len(i.sortedRows) {
-		return nil, io.EOF
-	}
-	row := i.sortedRows[i.idx]
-	i.idx++
-	return row, nil
+	return i.sortedRows.Next(ctx)
 }
 
 func (i *sortIter) Close(ctx *sql.Context) error {
+	if i.sorter != nil {
+		i.sorter.Close()
+	}
 	i.sortedRows = nil
 	return i.childIter.Close(ctx)
 }
 
+// computeSortedRows reads all the rows of the child iterator and sorts them, spilling them to disk when they don't
+// fit in the sort_buffer_size of the session.
 func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
-	cache, dispose := ctx.Memory.NewRowsCache()
-	defer dispose()
-
+	i.sorter = newExternalSorter(ctx, i.sortFields, sortBufferSizeVar)
 	for {
 		row, err := i.childIter.Next(ctx)
 
@@ -958,23 +1047,16 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 			return err
 		}
 
-		if err := cache.Add(row); err != nil {
+		if err := i.sorter.Add(row); err != nil {
 			return err
 		}
 	}
 
-	rows := cache.Get()
-	sorter := &expression.Sorter{
-		SortFields: i.sortFields,
-		Rows:       rows,
-		LastError:  nil,
-		Ctx:        ctx,
-	}
-	sort.Stable(sorter)
-	if sorter.LastError != nil {
-		return sorter.LastError
+	sorted, err := i.sorter.Sorted()
+	if err != nil {
+		return err
 	}
-	i.sortedRows = rows
+	i.sortedRows = sorted
 	return nil
 }
 
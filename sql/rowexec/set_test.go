@@ -79,6 +79,7 @@ func TestPersistedSessionSetIterator(t *testing.T) {
 	}{
 		{"persist var", "max_connections", 10, sql.SystemVariableScope_Persist, nil, int64(10), int64(10)},
 		{"persist only", "max_connections", 10, sql.SystemVariableScope_PersistOnly, nil, int64(151), int64(10)},
+		{"persist only read only variable", "back_log", 10, sql.SystemVariableScope_PersistOnly, nil, int64(-1), int64(10)},
 		{"no persist", "auto_increment_increment", 3300, sql.SystemVariableScope_Global, nil, int64(3300), nil},
 		{"persist unknown variable", "nonexistant", 10, sql.SystemVariableScope_Persist, sql.ErrUnknownSystemVariable, nil, nil},
 		{"persist only unknown variable", "nonexistant", 10, sql.SystemVariableScope_PersistOnly, sql.ErrUnknownSystemVariable, nil, nil},
@@ -110,3 +111,56 @@ func TestPersistedSessionSetIterator(t *testing.T) {
 		})
 	}
 }
+
+type mapPersister memory.GlobalsMap
+
+var _ sql.SystemVariablePersister = mapPersister{}
+
+func (p mapPersister) PersistGlobal(sysVarName string, value interface{}) error {
+	p[sysVarName] = value
+	return nil
+}
+
+func (p mapPersister) RemovePersistedGlobal(sysVarName string) error {
+	delete(p, sysVarName)
+	return nil
+}
+
+func (p mapPersister) RemoveAllPersistedGlobals() error {
+	for k := range p {
+		delete(p, k)
+	}
+	return nil
+}
+
+func TestRegistryPersister(t *testing.T) {
+	variables.InitSystemVariables()
+	persisted := mapPersister{}
+	sql.SystemVariables.SetPersister(persisted)
+	defer sql.SystemVariables.SetPersister(nil)
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	set := func(name string, scope sql.SystemVariableScope, value interface{}) error {
+		s := plan.NewSet([]sql.Expression{
+			expression.NewSetField(expression.NewSystemVar(name, scope), expression.NewLiteral(value, types.LongText)),
+		})
+		_, err := DefaultBuilder.Build(ctx, s, nil)
+		return err
+	}
+
+	require.NoError(t, set("character_set_server", sql.SystemVariableScope_Persist, "latin1"))
+	assert.Equal(t, "latin1", persisted["character_set_server"])
+	assert.Equal(t, "latin1_swedish_ci", persisted["collation_server"])
+	_, val, _ := sql.SystemVariables.GetGlobal("collation_server")
+	assert.Equal(t, "latin1_swedish_ci", val)
+
+	require.NoError(t, set("sql_mode", sql.SystemVariableScope_PersistOnly, "ANSI"))
+	assert.Equal(t, "ANSI_QUOTES,IGNORE_SPACE,ONLY_FULL_GROUP_BY,PIPES_AS_CONCAT,REAL_AS_FLOAT,ANSI", persisted["sql_mode"])
+
+	err := set("time_zone", sql.SystemVariableScope_Persist, "bogus")
+	assert.True(t, sql.ErrUnknownTimeZone.Is(err))
+	assert.NotContains(t, persisted, "time_zone")
+
+	require.NoError(t, set("sql_mode", sql.SystemVariableScope_ResetPersist, ""))
+	assert.NotContains(t, persisted, "sql_mode")
+}
@@ -16,13 +16,17 @@ package rowexec
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -50,6 +54,35 @@ func (b *BaseBuilder) buildShowCharset(ctx *sql.Context, n *plan.ShowCharset, ro
 }
 
 func (b *BaseBuilder) buildDescribeQuery(ctx *sql.Context, n *plan.DescribeQuery, row sql.Row) (sql.RowIter, error) {
+	// EXPLAIN ANALYZE of a statement that modifies data doesn't run it, but reports the number of rows it would modify
+	dml := modifyingNode(n.Child)
+	var rowsToModify int
+	if n.Analyze && dml != nil {
+		var err error
+		rowsToModify, err = b.countRowsToModify(ctx, dml, row)
+		if err != nil {
+			return nil, err
+		}
+	} else if n.Analyze {
+		iter, err := b.Build(ctx, n.Child, row)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			_, err = iter.Next(ctx)
+			if err != nil {
+				break
+			}
+		}
+		if err != io.EOF {
+			_ = iter.Close(ctx)
+			return nil, err
+		}
+		if err = iter.Close(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	var rows []sql.Row
 	var formatString string
 	if n.Format == "debug" {
@@ -63,9 +96,87 @@ func (b *BaseBuilder) buildDescribeQuery(ctx *sql.Context, n *plan.DescribeQuery
 			rows = append(rows, sql.NewRow(l))
 		}
 	}
+	if n.Analyze && dml != nil && len(rows) > 0 {
+		rows[0] = sql.NewRow(fmt.Sprintf("%s (rows=%d)", rows[0][0], rowsToModify))
+	}
 	return sql.RowsToRowIter(rows...), nil
 }
 
+// modifyingNode returns the INSERT, REPLACE, UPDATE or DELETE node of the query plan given, or nil if the query
+// doesn't modify data.
+func modifyingNode(n sql.Node) sql.Node {
+	var dml sql.Node
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+			dml = n
+		}
+		return dml == nil
+	})
+	return dml
+}
+
+// countRowsToModify returns the number of rows that the INSERT, REPLACE, UPDATE or DELETE node given would modify,
+// by running the part of the statement that reads them, without modifying any. The rows of an UPDATE that it leaves
+// unchanged aren't counted. Triggers aren't run, so the rows modified by their statements aren't counted either.
+func (b *BaseBuilder) countRowsToModify(ctx *sql.Context, dml sql.Node, row sql.Row) (int, error) {
+	var source sql.Node
+	switch n := dml.(type) {
+	case *plan.InsertInto:
+		source = n.Source
+	case *plan.DeleteFrom:
+		source = n.Child
+	case *plan.Update:
+		transform.Inspect(n.Child, func(n sql.Node) bool {
+			if us, ok := n.(*plan.UpdateSource); ok {
+				source = us
+			}
+			return source == nil
+		})
+	}
+	if source == nil {
+		return 0, fmt.Errorf("unable to find the rows to modify of %T", dml)
+	}
+
+	source, _, err := transform.Node(source, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		if te, ok := n.(*plan.TriggerExecutor); ok {
+			return te.Left(), transform.NewTree, nil
+		}
+		return n, transform.SameTree, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	iter, err := b.Build(ctx, source, row)
+	if err != nil {
+		return 0, err
+	}
+	us, isUpdate := source.(*plan.UpdateSource)
+	count := 0
+	for {
+		r, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = iter.Close(ctx)
+			return 0, err
+		}
+		if isUpdate {
+			oldRow, newRow := r[:len(r)/2], r[len(r)/2:]
+			if equals, err := oldRow.Equals(newRow, us.Child.Schema()); err != nil {
+				_ = iter.Close(ctx)
+				return 0, err
+			} else if equals {
+				continue
+			}
+		}
+		count++
+	}
+	return count, iter.Close(ctx)
+}
+
 func (b *BaseBuilder) buildShowWarnings(ctx *sql.Context, n plan.ShowWarnings, row sql.Row) (sql.RowIter, error) {
 	var rows []sql.Row
 	for _, w := range n {
@@ -128,6 +239,11 @@ func (b *BaseBuilder) buildShowTableStatus(ctx *sql.Context, n *plan.ShowTableSt
 
 	var rows = make([]sql.Row, len(tables))
 
+	stats, err := n.Catalog.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, tName := range tables {
 		table, _, err := n.Catalog.Table(ctx, n.Database().Name(), tName)
 		if err != nil {
@@ -148,8 +264,26 @@ func (b *BaseBuilder) buildShowTableStatus(ctx *sql.Context, n *plan.ShowTableSt
 				return nil, err
 			}
 		}
+		// the row count of analyzed tables is the one of their statistics
+		if stats != nil {
+			numRows, _, err = stats.RowCount(ctx, n.Database().Name(), tName)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-		rows[i] = tableToStatusRow(tName, numRows, dataLength, table.Collation())
+		var autoInc interface{}
+		if ai, ok := table.(sql.AutoIncrementTable); ok && ai.Schema().HasAutoIncrement() {
+			next, err := ai.PeekNextAutoIncrementValue(ctx)
+			if err != nil && !errors.Is(err, sql.ErrNoAutoIncrementCol) {
+				return nil, err
+			}
+			if err == nil {
+				autoInc = int64(next)
+			}
+		}
+
+		rows[i] = tableToStatusRow(tName, numRows, dataLength, autoInc, table.Collation())
 	}
 
 	return sql.RowsToRowIter(rows...), nil
@@ -224,28 +358,43 @@ func (b *BaseBuilder) buildShowTables(ctx *sql.Context, n *plan.ShowTables, row
 }
 
 func (b *BaseBuilder) buildShowStatus(ctx *sql.Context, n *plan.ShowStatus, row sql.Row) (sql.RowIter, error) {
-	var names []string
-	for name := range sql.SystemVariables.NewSessionMap() {
-		names = append(names, name)
-	}
-	sort.Strings(names)
-
-	var rows []sql.Row
-	for _, name := range names {
-		sysVar, val, ok := sql.SystemVariables.GetGlobal(name)
-		if !ok {
-			return nil, fmt.Errorf("missing system variable %s", name)
-		}
+	rows := sql.StatusVariableRows(ctx, n.Modifier == plan.ShowStatusModifier_Global)
+	return sql.RowsToRowIter(rows...), nil
+}
 
-		if n.Modifier == plan.ShowStatusModifier_Session && sysVar.Scope == sql.SystemVariableScope_Global ||
-			n.Modifier == plan.ShowStatusModifier_Global && sysVar.Scope == sql.SystemVariableScope_Session {
-			continue
+func (b *BaseBuilder) buildShowEngineStatus(ctx *sql.Context, n *plan.ShowEngineStatus, row sql.Row) (sql.RowIter, error) {
+	var engine sql.Engine
+	found := false
+	for _, e := range sql.SupportedEngines {
+		if strings.EqualFold(e.Name, n.Engine) {
+			engine, found = e, true
+			break
 		}
-
-		rows = append(rows, sql.Row{name, val})
+	}
+	if !found {
+		return nil, sql.ErrUnknownStorageEngine.New(n.Engine)
+	}
+	if n.Mutex {
+		return sql.RowsToRowIter(), nil
 	}
 
-	return sql.RowsToRowIter(rows...), nil
+	// Only the row operation counters are tracked, so that's the only section of the monitor output
+	global := func(name string) interface{} {
+		_, val, _ := sql.StatusVariables.GetGlobal(name)
+		return val
+	}
+	var buf bytes.Buffer
+	buf.WriteString("\n=====================================\n")
+	fmt.Fprintf(&buf, "%s %s MONITOR OUTPUT\n", time.Now().Format("2006-01-02 15:04:05"), strings.ToUpper(engine.Name))
+	buf.WriteString("=====================================\n")
+	buf.WriteString("--------------\nROW OPERATIONS\n--------------\n")
+	fmt.Fprintf(&buf, "Number of rows inserted %v, updated %v, deleted %v, read %v\n",
+		global("Innodb_rows_inserted"), global("Innodb_rows_updated"), global("Innodb_rows_deleted"), global("Innodb_rows_read"))
+	buf.WriteString("----------------------------\n")
+	fmt.Fprintf(&buf, "END OF %s MONITOR OUTPUT\n", strings.ToUpper(engine.Name))
+	buf.WriteString("============================\n")
+
+	return sql.RowsToRowIter(sql.Row{engine.Name, "", buf.String()}), nil
 }
 
 func (b *BaseBuilder) buildShowCreateProcedure(ctx *sql.Context, n *plan.ShowCreateProcedure, row sql.Row) (sql.RowIter, error) {
@@ -317,6 +466,11 @@ func (b *BaseBuilder) buildShowCreateDatabase(ctx *sql.Context, n *plan.ShowCrea
 		sql.Collation_Default.CharacterSet().String(),
 		sql.Collation_Default.String(),
 	))
+	if db, ok := n.Database().(sql.CommentedDatabase); ok {
+		if comment := db.GetComment(ctx); comment != "" {
+			buf.WriteString(fmt.Sprintf(" COMMENT '%s'", strings.ReplaceAll(comment, "'", "''")))
+		}
+	}
 
 	return sql.RowsToRowIter(
 		sql.NewRow(name, buf.String()),
@@ -391,6 +545,7 @@ func (b *BaseBuilder) buildShowPrivileges(ctx *sql.Context, n *plan.ShowPrivileg
 		sql.Row{"ROLE_ADMIN", "Server Admin", ""},
 		sql.Row{"REPLICATION_SLAVE_ADMIN", "Server Admin", ""},
 		sql.Row{"RESOURCE_GROUP_USER", "Server Admin", ""},
+		sql.Row{"UNMASK", "Server Admin", ""},
 	), nil
 }
 
@@ -431,12 +586,31 @@ func (b *BaseBuilder) buildShowCreateTrigger(ctx *sql.Context, n *plan.ShowCreat
 	return nil, sql.ErrTriggerDoesNotExist.New(n.TriggerName)
 }
 
+// showGeneratedInvisiblePrimaryKeys returns whether generated invisible primary keys are included in the output of
+// SHOW statements, as controlled by show_gipk_in_create_table_and_information_schema.
+func showGeneratedInvisiblePrimaryKeys(ctx *sql.Context) (bool, error) {
+	showGIPK, err := ctx.GetSessionVariable(ctx, "show_gipk_in_create_table_and_information_schema")
+	if err != nil {
+		return false, err
+	}
+	return showGIPK.(int8) == 1, nil
+}
+
 func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, row sql.Row) (sql.RowIter, error) {
 	span, _ := ctx.Span("plan.ShowColumns")
 
+	showGIPK, err := showGeneratedInvisiblePrimaryKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	schema := n.TargetSchema()
-	var rows = make([]sql.Row, len(schema))
-	for i, col := range schema {
+	var rows = make([]sql.Row, 0, len(schema))
+	for _, col := range schema {
+		if col.IsGeneratedInvisiblePrimaryKey() && !showGIPK {
+			continue
+		}
+
 		var row sql.Row
 		var collation interface{}
 		if types.IsTextOnly(col.Type) {
@@ -507,7 +681,7 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 			}
 		}
 
-		rows[i] = row
+		rows = append(rows, row)
 	}
 
 	return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
@@ -684,6 +858,7 @@ func (b *BaseBuilder) buildShowIndexes(ctx *sql.Context, n *plan.ShowIndexes, ro
 	return &showIndexesIter{
 		table: table,
 		idxs:  newIndexesToShow(n.IndexesToShow),
+		stats: n.Stats,
 	}, nil
 }
 
@@ -711,6 +886,8 @@ func (b *BaseBuilder) buildShowReplicaStatus(ctx *sql.Context, n *plan.ShowRepli
 		return sql.RowsToRowIter(), nil
 	}
 
+	replicateDoDBs := strings.Join(status.ReplicateDoDBs, ",")
+	replicateIgnoreDBs := strings.Join(status.ReplicateIgnoreDBs, ",")
 	replicateDoTables := strings.Join(status.ReplicateDoTables, ",")
 	replicateIgnoreTables := strings.Join(status.ReplicateIgnoreTables, ",")
 
@@ -730,8 +907,8 @@ func (b *BaseBuilder) buildShowReplicaStatus(ctx *sql.Context, n *plan.ShowRepli
 		"INVALID",                // Relay_Source_Log_File
 		status.ReplicaIoRunning,  // Replica_IO_Running
 		status.ReplicaSqlRunning, // Replica_SQL_Running
-		nil,                      // Replicate_Do_DB
-		nil,                      // Replicate_Ignore_DB
+		replicateDoDBs,           // Replicate_Do_DB
+		replicateIgnoreDBs,       // Replicate_Ignore_DB
 		replicateDoTables,        // Replicate_Do_Table
 		replicateIgnoreTables,    // Replicate_Ignore_Table
 		nil,                      // Replicate_Wild_Do_Table
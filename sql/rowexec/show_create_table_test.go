@@ -260,6 +260,114 @@ func TestShowCreateTableWithIndexAndForeignKeysAndChecks(t *testing.T) {
 	require.Equal(expected, row)
 }
 
+func TestShowCreateTableWithGeneratedColumnsAndPartitions(t *testing.T) {
+	var require = require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		&sql.Column{Name: "a", Source: "test-table", Type: types.Int32, Nullable: false, PrimaryKey: true},
+		&sql.Column{Name: "b", Source: "test-table", Type: types.Int32, Nullable: true},
+		&sql.Column{Name: "c", Source: "test-table", Type: types.Int64, Nullable: true, Virtual: true, Generated: &sql.ColumnDefaultValue{
+			Expression: expression.NewPlus(expression.NewUnresolvedColumn("`a`"), expression.NewUnresolvedColumn("`b`")),
+		}},
+		&sql.Column{Name: "d", Source: "test-table", Type: types.Int64, Nullable: false, Comment: "stored", Generated: &sql.ColumnDefaultValue{
+			Expression: expression.NewMult(expression.NewUnresolvedColumn("`a`"), expression.NewLiteral(int8(2), types.Int8)),
+		}},
+	}
+	table := &definedPartitionsTable{
+		Table:           memory.NewTable("test-table", sql.NewPrimaryKeySchema(schema), nil),
+		partitions:      "PARTITION BY HASH (`a`)\nPARTITIONS 4",
+		secondaryEngine: "RAPID",
+		attribute:       `{"key": 'value'}`,
+	}
+
+	showCreateTable, err := NewShowCreateTable(NewResolvedTable(table, nil, nil), false).WithTargetSchema(schema)
+	require.NoError(err)
+
+	showCreateTable.(*ShowCreateTable).Indexes = []sql.Index{
+		&invisibleIndex{
+			mockIndex: &mockIndex{
+				db:    "testdb",
+				table: "test-table",
+				id:    "b_idx",
+				exprs: []sql.Expression{
+					expression.NewGetFieldWithTable(1, types.Int32, "test-table", "b", true),
+				},
+			},
+		},
+		&functionalIndex{
+			mockIndex: &mockIndex{
+				db:    "testdb",
+				table: "test-table",
+				id:    "func_idx",
+				exprs: []sql.Expression{
+					expression.NewGetFieldWithTable(1, types.Int32, "test-table", "b", true),
+					expression.NewGetFieldWithTable(0, types.Int32, "test-table", "!hidden!func_idx!0!0", true),
+				},
+			},
+			keyParts: []string{"", "(`a` + 1)"},
+		},
+	}
+
+	rowIter, _ := DefaultBuilder.Build(ctx, showCreateTable, nil)
+	row, err := rowIter.Next(ctx)
+	require.NoError(err)
+
+	expected := sql.NewRow(
+		table.Name(),
+		"CREATE TABLE `test-table` (\n  `a` int NOT NULL,\n"+
+			"  `b` int,\n"+
+			"  `c` bigint GENERATED ALWAYS AS ((`a` + `b`)) VIRTUAL,\n"+
+			"  `d` bigint GENERATED ALWAYS AS ((`a` * 2)) STORED NOT NULL COMMENT 'stored',\n"+
+			"  PRIMARY KEY (`a`),\n"+
+			"  KEY `b_idx` (`b`) /*!80000 INVISIBLE */,\n"+
+			"  KEY `func_idx` (`b`,((`a` + 1)))\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin SECONDARY_ENGINE=RAPID "+
+			"/*!80021 SECONDARY_ENGINE_ATTRIBUTE='{\"key\": ''value''}' */\n"+
+			"/*!50100 PARTITION BY HASH (`a`)\nPARTITIONS 4 */",
+	)
+	require.Equal(expected, row)
+
+	require.NoError(ctx.SetSessionVariable(ctx, "show_create_table_skip_secondary_engine", int8(1)))
+	rowIter, _ = DefaultBuilder.Build(ctx, showCreateTable, nil)
+	row, err = rowIter.Next(ctx)
+	require.NoError(err)
+	require.NotContains(row[1], "SECONDARY_ENGINE=")
+	require.Contains(row[1], "SECONDARY_ENGINE_ATTRIBUTE")
+}
+
+// definedPartitionsTable is a table created with a PARTITION BY clause and a secondary engine.
+type definedPartitionsTable struct {
+	*memory.Table
+	partitions      string
+	secondaryEngine string
+	attribute       string
+}
+
+var _ sql.PartitionDefinitionTable = (*definedPartitionsTable)(nil)
+var _ sql.SecondaryEngineTable = (*definedPartitionsTable)(nil)
+
+func (t *definedPartitionsTable) PartitionDefinition() string      { return t.partitions }
+func (t *definedPartitionsTable) SecondaryEngine() string          { return t.secondaryEngine }
+func (t *definedPartitionsTable) SecondaryEngineAttribute() string { return t.attribute }
+
+type invisibleIndex struct {
+	*mockIndex
+}
+
+var _ sql.VisibleIndex = (*invisibleIndex)(nil)
+
+func (i *invisibleIndex) IsVisible() bool { return false }
+
+type functionalIndex struct {
+	*mockIndex
+	keyParts []string
+}
+
+var _ sql.FunctionalIndex = (*functionalIndex)(nil)
+
+func (i *functionalIndex) ExpressionKeyParts() []string { return i.keyParts }
+
 func TestShowCreateView(t *testing.T) {
 	var require = require.New(t)
 	ctx := sql.NewEmptyContext()
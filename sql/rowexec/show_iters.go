@@ -73,7 +73,7 @@ func (p process) toRow() sql.Row {
 }
 
 // cc here: https://dev.mysql.com/doc/refman/8.0/en/show-table-status.html
-func tableToStatusRow(table string, numRows uint64, dataLength uint64, collation sql.CollationID) sql.Row {
+func tableToStatusRow(table string, numRows uint64, dataLength uint64, autoInc interface{}, collation sql.CollationID) sql.Row {
 	var avgLength float64 = 0
 	if numRows > 0 {
 		avgLength = float64(dataLength) / float64(numRows)
@@ -92,7 +92,7 @@ func tableToStatusRow(table string, numRows uint64, dataLength uint64, collation
 		uint64(0),          // Max_data_length (Unused for InnoDB)
 		int64(0),           // Index_length
 		int64(0),           // Data_free
-		nil,                // Auto_increment (always null)
+		autoInc,            // Auto_increment
 		nil,                // Create_time
 		nil,                // Update_time
 		nil,                // Check_time
@@ -181,6 +181,11 @@ func (i *indexesToShow) next() (*idxToShow, error) {
 type showIndexesIter struct {
 	table *plan.ResolvedTable
 	idxs  *indexesToShow
+	stats sql.StatsReader
+	// hist and rowCount are the statistics of the table, loaded with the first index
+	hist        sql.HistogramMap
+	rowCount    uint64
+	statsLoaded bool
 }
 
 func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -217,6 +222,11 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		nonUnique = 1
 	}
 
+	cardinality, err := i.cardinality(ctx, show)
+	if err != nil {
+		return nil, err
+	}
+
 	return sql.NewRow(
 		show.index.Table(),     // "Table" string
 		nonUnique,              // "Non_unique" int32, Values [0, 1]
@@ -224,7 +234,7 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		show.exPosition+1,      // "Seq_in_index" int32
 		columnName,             // "Column_name" string
 		nil,                    // "Collation" string, Values [A, D, NULL]
-		int64(0),               // "Cardinality" int64 (not calculated)
+		cardinality,            // "Cardinality" int64
 		nil,                    // "Sub_part" int64
 		nil,                    // "Packed" string
 		nullable,               // "Null" string, Values [YES, '']
@@ -236,6 +246,36 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 	), nil
 }
 
+// cardinality returns the estimated number of distinct values of the index columns up to the one shown, from the
+// statistics of the table.
+func (i *showIndexesIter) cardinality(ctx *sql.Context, show *idxToShow) (int64, error) {
+	if i.stats == nil {
+		return 0, nil
+	}
+	if !i.statsLoaded {
+		db, table := i.table.Database.Name(), i.table.Name()
+		rowCount, _, err := i.stats.RowCount(ctx, db, table)
+		if err != nil {
+			return 0, err
+		}
+		// tables that weren't analyzed have no histograms
+		hist, err := i.stats.Hist(ctx, db, table)
+		if err != nil {
+			hist = nil
+		}
+		i.hist, i.rowCount, i.statsLoaded = hist, rowCount, true
+	}
+
+	exprs := show.index.Expressions()
+	columns := make([]string, len(exprs))
+	for j, expr := range exprs {
+		if col := plan.GetColumnFromIndexExpr(expr, i.table); col != nil {
+			columns[j] = col.Name
+		}
+	}
+	return sql.IndexCardinality(i.hist, i.rowCount, columns, show.exPosition+1, show.index.IsUnique()), nil
+}
+
 func isFirstColInUniqueKey(s *plan.ShowColumns, col *sql.Column, table sql.Table) bool {
 	for _, idx := range s.Indexes {
 		if !idx.IsUnique() {
@@ -331,9 +371,14 @@ type NameAndSchema interface {
 }
 
 func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, table sql.Table, schema sql.Schema, pkSchema sql.PrimaryKeySchema) (string, error) {
-	colStmts := make([]string, len(schema))
+	colStmts := make([]string, 0, len(schema))
 	var primaryKeyCols []string
 
+	showGIPK, err := showGeneratedInvisiblePrimaryKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	var pkOrdinals []int
 	if len(pkSchema.Schema) > 0 {
 		pkOrdinals = pkSchema.PkOrdinals
@@ -341,6 +386,10 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 
 	// Statement creation parts for each column
 	for i, col := range schema {
+		if col.IsGeneratedInvisiblePrimaryKey() && !showGIPK {
+			continue
+		}
+
 		var colDefault string
 		// TODO: The columns that are rendered in defaults should be backticked
 		if col.Default != nil {
@@ -359,10 +408,22 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			pkOrdinals = append(pkOrdinals, i)
 		}
 
-		colStmts[i] = sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		var colStmt string
+		if col.Generated != nil {
+			colStmt = sql.GenerateCreateTableGeneratedColumnDefinition(col.Name, col.Type, col.Nullable, col.Generated.Expression.String(), !col.Virtual, col.Comment)
+		} else {
+			colStmt = sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		}
+		if col.Invisible {
+			colStmt = sql.GenerateCreateTableInvisibleColumnDefinition(colStmt)
+		}
+		colStmts = append(colStmts, colStmt)
 	}
 
 	for _, i := range pkOrdinals {
+		if schema[i].IsGeneratedInvisiblePrimaryKey() && !showGIPK {
+			continue
+		}
 		primaryKeyCols = append(primaryKeyCols, schema[i].Name)
 	}
 
@@ -377,8 +438,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 
 		prefixLengths := index.PrefixLengths()
+		var keyPartExprs []string
+		if fi, ok := index.(sql.FunctionalIndex); ok {
+			keyPartExprs = fi.ExpressionKeyParts()
+		}
 		var indexCols []string
 		for i, expr := range index.Expressions() {
+			if len(keyPartExprs) > i && keyPartExprs[i] != "" {
+				indexCols = append(indexCols, sql.GenerateCreateTableIndexExpressionKeyPart(keyPartExprs[i]))
+				continue
+			}
 			col := plan.GetColumnFromIndexExpr(expr, table)
 			if col != nil {
 				indexDef := sql.QuoteIdentifier(col.Name)
@@ -389,7 +458,11 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			}
 		}
 
-		colStmts = append(colStmts, sql.GenerateCreateTableIndexDefinition(index.IsUnique(), index.IsSpatial(), index.ID(), indexCols, index.Comment()))
+		indexStmt := sql.GenerateCreateTableIndexDefinition(index.IsUnique(), index.IsSpatial(), index.ID(), indexCols, index.Comment())
+		if vi, ok := index.(sql.VisibleIndex); ok && !vi.IsVisible() {
+			indexStmt = sql.GenerateCreateTableInvisibleIndexDefinition(indexStmt)
+		}
+		colStmts = append(colStmts, indexStmt)
 	}
 
 	fkt, err := getForeignKeyTable(table)
@@ -407,7 +480,12 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferentialAction_DefaultAction {
 				onUpdate = string(fk.OnUpdate)
 			}
-			colStmts = append(colStmts, sql.GenerateCreateTableForiegnKeyDefinition(fk.Name, fk.Columns, fk.ParentTable, fk.ParentColumns, onDelete, onUpdate))
+			// the tables referenced in other databases are qualified with the name of their database
+			parentDb := ""
+			if !strings.EqualFold(fk.ParentDatabase, fk.Database) {
+				parentDb = fk.ParentDatabase
+			}
+			colStmts = append(colStmts, sql.GenerateCreateTableQualifiedForeignKeyDefinition(fk.Name, fk.Columns, parentDb, fk.ParentTable, fk.ParentColumns, onDelete, onUpdate))
 		}
 	}
 
@@ -417,10 +495,78 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
-	return sql.GenerateCreateTableStatement(table.Name(), colStmts, table.Collation().CharacterSet().Name(), table.Collation().Name()), nil
+	var options sql.TableOptions
+	if ot, ok := getTableOptionsTable(table); ok {
+		options = ot.TableOptions()
+	}
+	stmt := sql.GenerateCreateTableStatementWithOptions(table.Name(), colStmts, table.Collation().CharacterSet().Name(), table.Collation().Name(), options)
+
+	secondaryEngine, secondaryEngineAttribute := options[sql.TableOptionSecondaryEngine], options[sql.TableOptionSecondaryEngineAttribute]
+	if st, ok := getSecondaryEngineTable(table); ok {
+		secondaryEngine, secondaryEngineAttribute = st.SecondaryEngine(), st.SecondaryEngineAttribute()
+	}
+	if secondaryEngine != "" || secondaryEngineAttribute != "" {
+		skip, err := ctx.GetSessionVariable(ctx, "show_create_table_skip_secondary_engine")
+		if err != nil {
+			return "", err
+		}
+		if skip.(int8) == 1 {
+			secondaryEngine = ""
+		}
+		stmt = sql.GenerateCreateTableSecondaryEngineOptions(stmt, secondaryEngine, secondaryEngineAttribute)
+	}
+
+	if pt, ok := getPartitionDefinitionTable(table); ok {
+		if def := pt.PartitionDefinition(); def != "" {
+			stmt = sql.GenerateCreateTablePartitionClause(stmt, def)
+		}
+	}
+
+	return stmt, nil
+}
+
+func getTableOptionsTable(t sql.Table) (sql.TableOptionsTable, bool) {
+	switch t := t.(type) {
+	case sql.TableOptionsTable:
+		return t, true
+	case sql.TableWrapper:
+		return getTableOptionsTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+func getSecondaryEngineTable(t sql.Table) (sql.SecondaryEngineTable, bool) {
+	switch t := t.(type) {
+	case sql.SecondaryEngineTable:
+		return t, true
+	case sql.TableWrapper:
+		return getSecondaryEngineTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+func getPartitionDefinitionTable(t sql.Table) (sql.PartitionDefinitionTable, bool) {
+	switch t := t.(type) {
+	case sql.PartitionDefinitionTable:
+		return t, true
+	case sql.TableWrapper:
+		return getPartitionDefinitionTable(t.Underlying())
+	default:
+		return nil, false
+	}
 }
 
 func produceCreateViewStatement(view *plan.SubqueryAlias) string {
+	if len(view.Columns) > 0 {
+		return fmt.Sprintf(
+			"CREATE VIEW `%s` (%s) AS %s",
+			view.Name(),
+			strings.Join(sql.QuoteIdentifiers(view.Columns), ","),
+			view.TextDefinition,
+		)
+	}
 	return fmt.Sprintf(
 		"CREATE VIEW `%s` AS %s",
 		view.Name(),
@@ -39,8 +39,8 @@ func TestShowStatus(t *testing.T) {
 		if err == io.EOF {
 			break
 		}
-		if res[0] == "uptime" {
-			require.True(res[1].(int) >= 0)
+		if res[0] == "Uptime" {
+			require.True(res[1].(int64) >= 0)
 		}
 		require.NoError(err)
 	}
@@ -0,0 +1,58 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// incrementRowStatusVariables adds the number of rows given to each of the status variables named. Iterators count
+// rows locally and add them to the status variables once they're closed, which keeps the cost of updating the
+// variables out of the per-row path.
+func incrementRowStatusVariables(ctx *sql.Context, rows int64, names ...string) {
+	if rows == 0 {
+		return
+	}
+	for _, name := range names {
+		sql.IncrementStatusVariable(ctx, name, rows)
+	}
+}
+
+// rowStatusIter counts the rows read from a table for the Handler_read_xxx and Innodb_rows_read status variables.
+type rowStatusIter struct {
+	sql.RowIter
+	statusVar string
+	rows      int64
+}
+
+var _ sql.RowIter = (*rowStatusIter)(nil)
+
+func newRowStatusIter(iter sql.RowIter, statusVar string) *rowStatusIter {
+	return &rowStatusIter{RowIter: iter, statusVar: statusVar}
+}
+
+func (i *rowStatusIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err == nil {
+		i.rows++
+	}
+	return row, err
+}
+
+func (i *rowStatusIter) Close(ctx *sql.Context) error {
+	incrementRowStatusVariables(ctx, i.rows, i.statusVar, "Innodb_rows_read")
+	i.rows = 0
+	return i.RowIter.Close(ctx)
+}
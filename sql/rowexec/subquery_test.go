@@ -84,3 +84,69 @@ func TestSubqueryMultipleRows(t *testing.T) {
 	require.NoError(err)
 	require.Equal(values, []interface{}{"one", "two", "three"})
 }
+
+// countingBuilder counts the number of times it builds a node.
+type countingBuilder struct {
+	builds int
+}
+
+func (b *countingBuilder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, error) {
+	b.builds++
+	return rowexec.DefaultBuilder.Build(ctx, n, r)
+}
+
+func TestSubqueryCorrelatedCache(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "t", Source: "foo", Type: types.Text},
+	}), nil)
+
+	require.NoError(table.Insert(ctx, sql.Row{"one"}))
+	require.NoError(table.Insert(ctx, sql.Row{"two"}))
+	require.NoError(table.Insert(ctx, sql.Row{"three"}))
+
+	b := &countingBuilder{}
+	subquery := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(2, types.Text, "t", false),
+		},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewGetField(2, types.Text, "t", false),
+				expression.NewGetField(1, types.Text, "u", false),
+			),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	), "select t from foo where t = u").WithCorrelatedCache(sql.NewFastIntSet(1), 2).WithExecBuilder(b)
+
+	rows := []sql.Row{{1, "one"}, {2, "two"}, {3, "one"}, {4, "four"}, {5, "two"}, {6, "four"}}
+	expected := []interface{}{"one", "two", "one", nil, "two", nil}
+	for i, row := range rows {
+		value, err := subquery.Eval(ctx, row)
+		require.NoError(err)
+		require.Equal(expected[i], value)
+	}
+	require.Equal(3, b.builds)
+
+	hasRows, err := subquery.HasResultRow(ctx, sql.Row{7, "four"})
+	require.NoError(err)
+	require.False(hasRows)
+	hasRows, err = subquery.HasResultRow(ctx, sql.Row{8, "three"})
+	require.NoError(err)
+	require.True(hasRows)
+	require.Equal(4, b.builds)
+
+	// Only the existence of a row was evaluated for these values
+	value, err := subquery.Eval(ctx, sql.Row{9, "three"})
+	require.NoError(err)
+	require.Equal("three", value)
+	require.Equal(5, b.builds)
+
+	subquery.Dispose()
+	value, err = subquery.Eval(ctx, sql.Row{10, "one"})
+	require.NoError(err)
+	require.Equal("one", value)
+	require.Equal(6, b.builds)
+}
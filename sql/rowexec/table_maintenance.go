@@ -0,0 +1,200 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// maintenanceTables returns the tables of a table maintenance statement, qualified with the current database when
+// they aren't. A table that doesn't exist is nil.
+func maintenanceTables(ctx *sql.Context, cat sql.Catalog, names []sql.DbTable) ([]sql.DbTable, []sql.Table, error) {
+	qualified := make([]sql.DbTable, len(names))
+	tables := make([]sql.Table, len(names))
+	for i, name := range names {
+		if name.Db == "" {
+			name.Db = ctx.GetCurrentDatabase()
+			if name.Db == "" {
+				return nil, nil, sql.ErrNoDatabaseSelected.New()
+			}
+		}
+		qualified[i] = name
+		table, _, err := cat.Table(ctx, name.Db, name.Table)
+		if err != nil {
+			if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		tables[i] = table
+	}
+	return qualified, tables, nil
+}
+
+func tableDoesNotExist(name sql.DbTable) string {
+	return fmt.Sprintf("Table '%s' doesn't exist", name.String())
+}
+
+func (b *BaseBuilder) buildChecksumTable(ctx *sql.Context, n *plan.ChecksumTable, row sql.Row) (sql.RowIter, error) {
+	names, tables, err := maintenanceTables(ctx, n.Catalog, n.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(tables))
+	for i, table := range tables {
+		if table == nil {
+			ctx.Warn(1146, tableDoesNotExist(names[i]))
+			rows[i] = sql.Row{names[i].String(), nil}
+			continue
+		}
+
+		var checksum interface{}
+		if ct, ok := table.(sql.ChecksumTable); ok && !n.Extended {
+			sum, err := ct.Checksum(ctx)
+			if err != nil {
+				return nil, err
+			}
+			checksum = int64(sum)
+		} else if !n.Quick {
+			sum, err := computeTableChecksum(ctx, table)
+			if err != nil {
+				return nil, err
+			}
+			checksum = int64(sum)
+		}
+		rows[i] = sql.Row{names[i].String(), checksum}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// computeTableChecksum returns the checksum of the rows of the table given, which is the sum of the CRC32 of each row.
+// Since the sum doesn't depend on the order of the rows, the same rows always have the same checksum.
+func computeTableChecksum(ctx *sql.Context, table sql.Table) (uint32, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	sch := table.Schema()
+	var checksum uint32
+	var buf []byte
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return checksum, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		// Each value is encoded as its length and its SQL representation, and NULL as a length of -1, so that
+		// different rows can't have the same encoding
+		buf = buf[:0]
+		for i, v := range row {
+			if v == nil || i >= len(sch) {
+				buf = binary.AppendVarint(buf, -1)
+				continue
+			}
+			val, err := sch[i].Type.SQL(ctx, nil, v)
+			if err != nil {
+				return 0, err
+			}
+			buf = binary.AppendVarint(buf, int64(len(val.Raw())))
+			buf = append(buf, val.Raw()...)
+		}
+		checksum += crc32.ChecksumIEEE(buf)
+	}
+}
+
+func (b *BaseBuilder) buildCheckTable(ctx *sql.Context, n *plan.CheckTable, row sql.Row) (sql.RowIter, error) {
+	names, tables, err := maintenanceTables(ctx, n.Catalog, n.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for i, table := range tables {
+		name := names[i].String()
+		if table == nil {
+			rows = append(rows,
+				sql.Row{name, "check", "Error", tableDoesNotExist(names[i])},
+				sql.Row{name, "check", "status", "Operation failed"})
+			continue
+		}
+
+		ct, ok := table.(sql.ConsistencyCheckTable)
+		if !ok {
+			rows = append(rows, sql.Row{name, "check", "note", "The storage engine for the table doesn't support check"})
+			continue
+		}
+		problems, err := ct.CheckConsistency(ctx)
+		if err != nil {
+			rows = append(rows,
+				sql.Row{name, "check", "Error", err.Error()},
+				sql.Row{name, "check", "status", "Operation failed"})
+			continue
+		}
+		if len(problems) == 0 {
+			rows = append(rows, sql.Row{name, "check", "status", "OK"})
+			continue
+		}
+		for _, problem := range problems {
+			rows = append(rows, sql.Row{name, "check", "error", problem})
+		}
+		rows = append(rows, sql.Row{name, "check", "error", "Corrupt"})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (b *BaseBuilder) buildOptimizeTable(ctx *sql.Context, n *plan.OptimizeTable, row sql.Row) (sql.RowIter, error) {
+	names, tables, err := maintenanceTables(ctx, n.Catalog, n.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for i, table := range tables {
+		name := names[i].String()
+		if table == nil {
+			rows = append(rows,
+				sql.Row{name, "optimize", "Error", tableDoesNotExist(names[i])},
+				sql.Row{name, "optimize", "status", "Operation failed"})
+			continue
+		}
+
+		ot, ok := table.(sql.OptimizableTable)
+		if !ok {
+			rows = append(rows, sql.Row{name, "optimize", "note", "The storage engine for the table doesn't support optimize"})
+			continue
+		}
+		if err := ot.Optimize(ctx); err != nil {
+			rows = append(rows,
+				sql.Row{name, "optimize", "Error", err.Error()},
+				sql.Row{name, "optimize", "status", "Operation failed"})
+			continue
+		}
+		rows = append(rows, sql.Row{name, "optimize", "status", "OK"})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
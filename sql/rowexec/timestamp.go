@@ -0,0 +1,61 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// timestampIter converts the TIMESTAMP values of the rows read from a table, which are stored in UTC, to the time
+// zone of the session.
+type timestampIter struct {
+	sql.RowIter
+	sch sql.Schema
+	loc *time.Location
+}
+
+var _ sql.RowIter = (*timestampIter)(nil)
+
+// withTimestampsFromUTC returns |iter| over rows of |sch| read from a table, converting their TIMESTAMP values to the
+// time zone of the session. |iter| is returned as is when no conversion is needed.
+func withTimestampsFromUTC(ctx *sql.Context, sch sql.Schema, iter sql.RowIter) sql.RowIter {
+	if !sql.HasTimestampColumn(sch) {
+		return iter
+	}
+	loc := sql.TimestampLocation(ctx)
+	if loc == nil {
+		return iter
+	}
+	return &timestampIter{RowIter: iter, sch: sch, loc: loc}
+}
+
+func (i *timestampIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowTimestampsFromUTC(i.sch, row, i.loc), nil
+}
+
+// timestampWriteLocation returns the location that the TIMESTAMP values of rows of |sch| are converted from before
+// they're written to a table, or nil if no conversion is needed.
+func timestampWriteLocation(ctx *sql.Context, sch sql.Schema) *time.Location {
+	if !sql.HasTimestampColumn(sch) {
+		return nil
+	}
+	return sql.TimestampLocation(ctx)
+}
@@ -40,6 +40,7 @@ func (b *BaseBuilder) buildRollbackSavepoint(ctx *sql.Context, n *plan.RollbackS
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowEvents().RollbackToSavepoint(ctx, n.Name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -60,6 +61,7 @@ func (b *BaseBuilder) buildReleaseSavepoint(ctx *sql.Context, n *plan.ReleaseSav
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowEvents().ReleaseSavepoint(ctx, n.Name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -80,6 +82,7 @@ func (b *BaseBuilder) buildCreateSavepoint(ctx *sql.Context, n *plan.CreateSavep
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowEvents().CreateSavepoint(ctx, n.Name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -99,6 +102,7 @@ func (b *BaseBuilder) buildStartTransaction(ctx *sql.Context, n *plan.StartTrans
 		if err != nil {
 			return nil, err
 		}
+		ctx.RowEvents().Commit(ctx)
 	}
 
 	transaction, err := ts.StartTransaction(ctx, n.TransChar)
@@ -126,7 +130,7 @@ func (b *BaseBuilder) buildUnlockTables(ctx *sql.Context, n *plan.UnlockTables,
 	span, ctx := ctx.Span("plan.UnlockTables")
 	defer span.End()
 
-	if err := n.Catalog.UnlockTables(ctx, ctx.ID()); err != nil {
+	if err := plan.ReleaseTableLocks(ctx, n.Catalog); err != nil {
 		return nil, err
 	}
 
@@ -149,6 +153,7 @@ func (b *BaseBuilder) buildCommit(ctx *sql.Context, n *plan.Commit, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowEvents().Commit(ctx)
 
 	ctx.SetIgnoreAutoCommit(false)
 	ctx.SetTransaction(nil)
@@ -198,6 +203,7 @@ func (b *BaseBuilder) buildRollback(ctx *sql.Context, n *plan.Rollback, row sql.
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowEvents().Rollback(ctx.ID())
 
 	// Like Commit, Rollback ends the current transaction and a new one begins with the next statement
 	ctx.SetIgnoreAutoCommit(false)
@@ -234,6 +240,18 @@ func (b *BaseBuilder) buildLockTables(ctx *sql.Context, n *plan.LockTables, row
 		}
 	}
 
+	// Until the tables are unlocked, don't commit after each statement, so that the writes made while holding the
+	// locks are committed together by UNLOCK TABLES
+	if _, ok := ctx.Session.(sql.TransactionSession); ok && n.Catalog.HasLockedTables(ctx.ID()) {
+		autocommit, err := plan.IsSessionAutocommit(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if autocommit {
+			ctx.SetIgnoreAutoCommit(true)
+		}
+	}
+
 	return sql.RowsToRowIter(), nil
 }
 
@@ -302,9 +320,25 @@ func (b *BaseBuilder) buildUse(ctx *sql.Context, n *plan.Use, row sql.Row) (sql.
 }
 
 func (b *BaseBuilder) buildTransactionCommittingNode(ctx *sql.Context, n *plan.TransactionCommittingNode, row sql.Row) (sql.RowIter, error) {
+	implicitCommit := plan.ImplicitCommitFor(ctx, n.Child())
+	switch implicitCommit {
+	case plan.ImplicitCommitBefore:
+		if err := commitImplicitly(ctx); err != nil {
+			return nil, err
+		}
+	case plan.ImplicitCommitBeforeAndAfter:
+		if err := beginAtomicStatement(ctx); err != nil {
+			return nil, err
+		}
+	}
+	atomic := implicitCommit == plan.ImplicitCommitBeforeAndAfter
+	ctx.RowEvents().StatementBegin(ctx)
 	iter, err := b.Build(ctx, n.Child(), row)
 	if err != nil {
+		if atomic {
+			return nil, endAtomicStatement(ctx, err)
+		}
 		return nil, err
 	}
-	return transactionCommittingIter{childIter: iter}, nil
+	return &transactionCommittingIter{childIter: iter, atomic: atomic}, nil
 }
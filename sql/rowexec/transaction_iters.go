@@ -72,17 +72,36 @@ func getLockableTable(table sql.Table) (sql.Lockable, error) {
 type transactionCommittingIter struct {
 	childIter           sql.RowIter
 	transactionDatabase string
+	// atomic is whether the statement is run in a transaction of its own, see plan.ImplicitCommitBeforeAndAfter
+	atomic bool
+	// err is the error the statement returned while iterating over its rows, if any
+	err error
 }
 
-func (t transactionCommittingIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return t.childIter.Next(ctx)
+func (t *transactionCommittingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := t.childIter.Next(ctx)
+	if err != nil && err != io.EOF {
+		t.err = err
+	}
+	return row, err
 }
 
-func (t transactionCommittingIter) Close(ctx *sql.Context) error {
+func (t *transactionCommittingIter) Close(ctx *sql.Context) error {
 	var err error
 	if t.childIter != nil {
 		err = t.childIter.Close(ctx)
 	}
+	ctx.RowEvents().StatementComplete(ctx)
+	// Without transactions, the changes of each statement are final once it's done, even if it failed part way
+	if _, ok := ctx.Session.(sql.TransactionSession); !ok {
+		ctx.RowEvents().Commit(ctx)
+	}
+	if t.atomic {
+		if err == nil {
+			err = t.err
+		}
+		return endAtomicStatement(ctx, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -107,6 +126,7 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		if err := ts.CommitTransaction(ctx, tx); err != nil {
 			return err
 		}
+		ctx.RowEvents().Commit(ctx)
 
 		// Clearing out the current transaction will tell us to start a new one the next time this session queries
 		ctx.SetTransaction(nil)
@@ -114,3 +134,78 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 
 	return nil
 }
+
+// commitImplicitly commits the transaction in progress before a statement that causes an implicit commit, unless it
+// was begun for this statement alone.
+func commitImplicitly(ctx *sql.Context) error {
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return nil
+	}
+	autocommit, err := plan.IsSessionAutocommit(ctx)
+	if err != nil {
+		return err
+	}
+	if autocommit && !ctx.GetIgnoreAutoCommit() {
+		return nil
+	}
+
+	ctx.GetLogger().Tracef("implicitly committing transaction %s", tx)
+	ts := ctx.Session.(sql.TransactionSession)
+	if err := ts.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+	ctx.RowEvents().Commit(ctx)
+	ctx.SetTransaction(nil)
+	// The explicit transaction is over, so the statements after this one are committed automatically again
+	ctx.SetIgnoreAutoCommit(false)
+	return nil
+}
+
+// beginAtomicStatement starts the transaction of a statement run in a transaction of its own, after committing the
+// transaction in progress.
+func beginAtomicStatement(ctx *sql.Context) error {
+	if err := commitImplicitly(ctx); err != nil {
+		return err
+	}
+	if ctx.GetTransaction() != nil {
+		return nil
+	}
+
+	ts := ctx.Session.(sql.TransactionSession)
+	tx, err := ts.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return err
+	}
+	ctx.SetTransaction(tx)
+	return nil
+}
+
+// endAtomicStatement ends the transaction of a statement run in a transaction of its own, which is committed if the
+// statement succeeded and rolled back if it failed with the error given, so that a failed statement leaves no
+// changes behind. It returns the statement's error, or the error ending its transaction.
+func endAtomicStatement(ctx *sql.Context, stmtErr error) error {
+	ts := ctx.Session.(sql.TransactionSession)
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return stmtErr
+	}
+
+	if stmtErr != nil {
+		ctx.GetLogger().Tracef("rolling back transaction %s of failed statement", tx)
+		if err := ts.Rollback(ctx, tx); err != nil {
+			return err
+		}
+		ctx.RowEvents().Rollback(ctx.ID())
+		ctx.SetTransaction(nil)
+		return stmtErr
+	}
+
+	ctx.GetLogger().Tracef("committing transaction %s", tx)
+	if err := ts.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+	ctx.RowEvents().Commit(ctx)
+	ctx.SetTransaction(nil)
+	return nil
+}
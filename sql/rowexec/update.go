@@ -17,9 +17,11 @@ package rowexec
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 type updateIter struct {
@@ -29,6 +31,14 @@ type updateIter struct {
 	checks    sql.CheckConstraints
 	closed    bool
 	ignore    bool
+	// updated counts the rows this iterator has updated for the status variables
+	updated int64
+	// database and table are the names that the row events of the updates are published with. They're empty for
+	// updates of joins, whose updater publishes the row event of each table.
+	database string
+	table    string
+	// tsLoc is the location that TIMESTAMP values are converted from before they're written, as they're stored in UTC
+	tsLoc *time.Location
 }
 
 func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -61,10 +71,23 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
 
-			err = u.updater.Update(ctx, oldRow, newRow)
+			storedOldRow := sql.RowTimestampsToUTC(u.schema, oldRow, u.tsLoc)
+			storedNewRow := sql.RowTimestampsToUTC(u.schema, newRow, u.tsLoc)
+			err = u.updater.Update(ctx, storedOldRow, storedNewRow)
 			if err != nil {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
+			if u.table != "" {
+				ctx.RowEvents().Record(ctx, sql.RowEvent{
+					Type:     sql.RowEventType_Update,
+					Database: u.database,
+					Table:    u.table,
+					Schema:   u.schema,
+					Before:   storedOldRow,
+					After:    storedNewRow,
+				})
+			}
+			u.updated++
 		}
 	} else {
 		return nil, err
@@ -73,23 +96,35 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return oldAndNewRow, nil
 }
 
-// Applies the update expressions given to the row given, returning the new resultant row. In the case that ignore is
-// provided and there is a type conversion error, this function sets the value to the zero value as per the MySQL standard.
+// applyUpdateExpressionsForWrite applies the update expressions given to the row given, returning the new resultant row. When
+// |strict| is false, a value that can't be converted to the type of its column is adjusted to the closest valid value
+// with a warning, as types.ConvertForColumn describes, instead of being an error. |rowNum| is the number of the row the
+// warnings refer to.
 // TODO: a set of update expressions should probably be its own expression type with an Eval method that does this
-func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expression, tableSchema sql.Schema, row sql.Row, ignore bool) (sql.Row, error) {
+func applyUpdateExpressionsForWrite(ctx *sql.Context, updateExprs []sql.Expression, tableSchema sql.Schema, row sql.Row, strict bool, rowNum int64) (sql.Row, error) {
 	var ok bool
 	prev := row
 	for _, updateExpr := range updateExprs {
 		val, err := updateExpr.Eval(ctx, prev)
 		if err != nil {
 			wtce, ok2 := err.(sql.WrappedTypeConversionError)
-			if !ok2 || !ignore {
+			if !ok2 || strict {
 				return nil, err
 			}
 
+			// The row can be longer than the schema when some update values come from an outer scope, which are the
+			// first values in the row
+			colIdx := wtce.OffendingIdx - (len(prev) - len(tableSchema))
+			if colIdx < 0 || colIdx >= len(tableSchema) {
+				return nil, err
+			}
+			converted, cErr := types.ConvertForColumn(ctx, tableSchema[colIdx], wtce.OffendingVal, false, rowNum)
+			if cErr != nil {
+				return nil, cErr
+			}
 			cpy := prev.Copy()
-			cpy[wtce.OffendingIdx] = wtce.OffendingVal // Needed for strings
-			val = convertDataAndWarn(ctx, tableSchema, cpy, wtce.OffendingIdx, wtce.Err)
+			cpy[wtce.OffendingIdx] = converted
+			val = cpy
 		}
 		prev, ok = val.(sql.Row)
 		if !ok {
@@ -99,6 +134,12 @@ func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expres
 	return prev, nil
 }
 
+// isStrictWrite returns whether an INSERT or UPDATE fails on values that can't be converted to the type of their
+// column, which is when a strict sql_mode is set and the statement doesn't have the IGNORE modifier.
+func isStrictWrite(ctx *sql.Context, ignore bool) bool {
+	return !ignore && sql.LoadSqlMode(ctx).Strict()
+}
+
 func (u *updateIter) validateNullability(ctx *sql.Context, row sql.Row, schema sql.Schema) error {
 	for idx := 0; idx < len(row); idx++ {
 		col := schema[idx]
@@ -119,6 +160,7 @@ func (u *updateIter) validateNullability(ctx *sql.Context, row sql.Row, schema s
 func (u *updateIter) Close(ctx *sql.Context) error {
 	if !u.closed {
 		u.closed = true
+		incrementRowStatusVariables(ctx, u.updated, "Handler_update", "Innodb_rows_updated")
 		if err := u.updater.Close(ctx); err != nil {
 			return err
 		}
@@ -141,6 +183,9 @@ func newUpdateIter(
 	updater sql.RowUpdater,
 	checks sql.CheckConstraints,
 	ignore bool,
+	database string,
+	table string,
+	tsLoc *time.Location,
 ) sql.RowIter {
 	if ignore {
 		return plan.NewCheckpointingTableEditorIter(&updateIter{
@@ -149,6 +194,9 @@ func newUpdateIter(
 			schema:    schema,
 			checks:    checks,
 			ignore:    true,
+			database:  database,
+			table:     table,
+			tsLoc:     tsLoc,
 		}, updater)
 	} else {
 		return plan.NewTableEditorIter(&updateIter{
@@ -156,6 +204,9 @@ func newUpdateIter(
 			updater:   updater,
 			schema:    schema,
 			checks:    checks,
+			database:  database,
+			table:     table,
+			tsLoc:     tsLoc,
 		}, updater)
 	}
 }
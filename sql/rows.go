@@ -18,10 +18,6 @@ import (
 	"fmt"
 	"io"
 	"strings"
-
-	"github.com/dolthub/vitess/go/vt/proto/query"
-
-	"github.com/dolthub/go-mysql-server/sql/values"
 )
 
 // Row is a tuple of values.
@@ -95,8 +91,10 @@ type RowIter interface {
 // RowIterToRows converts a row iterator to a slice of rows.
 func RowIterToRows(ctx *Context, sch Schema, i RowIter) ([]Row, error) {
 	var rows []Row
+	batch := make([]Row, RowBatchSize)
 	for {
-		row, err := i.Next(ctx)
+		n, err := NextRowBatch(ctx, i, batch)
+		rows = append(rows, batch[:n]...)
 		if err == io.EOF {
 			break
 		}
@@ -105,78 +103,11 @@ func RowIterToRows(ctx *Context, sch Schema, i RowIter) ([]Row, error) {
 			i.Close(ctx)
 			return nil, err
 		}
-
-		rows = append(rows, row)
 	}
 
 	return rows, i.Close(ctx)
 }
 
-func rowFromRow2(sch Schema, r Row2) Row {
-	row := make(Row, len(sch))
-	for i, col := range sch {
-		switch col.Type.Type() {
-		case query.Type_INT8:
-			row[i] = values.ReadInt8(r.GetField(i).Val)
-		case query.Type_UINT8:
-			row[i] = values.ReadUint8(r.GetField(i).Val)
-		case query.Type_INT16:
-			row[i] = values.ReadInt16(r.GetField(i).Val)
-		case query.Type_UINT16:
-			row[i] = values.ReadUint16(r.GetField(i).Val)
-		case query.Type_INT32:
-			row[i] = values.ReadInt32(r.GetField(i).Val)
-		case query.Type_UINT32:
-			row[i] = values.ReadUint32(r.GetField(i).Val)
-		case query.Type_INT64:
-			row[i] = values.ReadInt64(r.GetField(i).Val)
-		case query.Type_UINT64:
-			row[i] = values.ReadUint64(r.GetField(i).Val)
-		case query.Type_FLOAT32:
-			row[i] = values.ReadFloat32(r.GetField(i).Val)
-		case query.Type_FLOAT64:
-			row[i] = values.ReadFloat64(r.GetField(i).Val)
-		case query.Type_TEXT, query.Type_VARCHAR, query.Type_CHAR:
-			row[i] = values.ReadString(r.GetField(i).Val, values.ByteOrderCollation)
-		case query.Type_BLOB, query.Type_VARBINARY, query.Type_BINARY:
-			row[i] = values.ReadBytes(r.GetField(i).Val, values.ByteOrderCollation)
-		case query.Type_BIT:
-			fallthrough
-		case query.Type_ENUM:
-			fallthrough
-		case query.Type_SET:
-			fallthrough
-		case query.Type_TUPLE:
-			fallthrough
-		case query.Type_GEOMETRY:
-			fallthrough
-		case query.Type_JSON:
-			fallthrough
-		case query.Type_EXPRESSION:
-			fallthrough
-		case query.Type_INT24:
-			fallthrough
-		case query.Type_UINT24:
-			fallthrough
-		case query.Type_TIMESTAMP:
-			fallthrough
-		case query.Type_DATE:
-			fallthrough
-		case query.Type_TIME:
-			fallthrough
-		case query.Type_DATETIME:
-			fallthrough
-		case query.Type_YEAR:
-			fallthrough
-		case query.Type_DECIMAL:
-			panic(fmt.Sprintf("Unimplemented type conversion: %T", col.Type))
-		default:
-			panic(fmt.Sprintf("unknown type %T", col.Type))
-		}
-	}
-	return row
-}
-
 // RowsToRowIter creates a RowIter that iterates over the given rows.
 func RowsToRowIter(rows ...Row) RowIter {
 	return &sliceRowIter{rows: rows}
@@ -197,6 +128,19 @@ func (i *sliceRowIter) Next(*Context) (Row, error) {
 	return r.Copy(), nil
 }
 
+func (i *sliceRowIter) NextBatch(_ *Context, batch []Row) (int, error) {
+	if i.idx >= len(i.rows) {
+		return 0, io.EOF
+	}
+
+	n := 0
+	for ; n < len(batch) && i.idx < len(i.rows); n++ {
+		batch[n] = i.rows[i.idx].Copy()
+		i.idx++
+	}
+	return n, nil
+}
+
 func (i *sliceRowIter) Close(*Context) error {
 	i.rows = nil
 	return nil
@@ -17,6 +17,7 @@ package sql
 import (
 	"reflect"
 	"strings"
+	"sync"
 
 	"gopkg.in/src-d/go-errors.v1"
 )
@@ -26,7 +27,8 @@ var (
 	ErrUnexpectedType = errors.NewKind("value at %d has unexpected type: %s")
 )
 
-// Schema is the definition of a table.
+// Schema is the definition of a table. Schemas returned by tables and nodes are shared between the callers, and must
+// be copied rather than modified.
 type Schema []*Column
 
 // CheckRow checks the row conforms to the schema.
@@ -70,10 +72,8 @@ func (s Schema) Contains(column string, source string) bool {
 
 // IndexOf returns the index of the given column in the schema or -1 if it's not present.
 func (s Schema) IndexOf(column, source string) int {
-	column = strings.ToLower(column)
-	source = strings.ToLower(source)
 	for i, col := range s {
-		if strings.ToLower(col.Name) == column && strings.ToLower(col.Source) == source {
+		if strings.EqualFold(col.Name, column) && strings.EqualFold(col.Source, source) {
 			return i
 		}
 	}
@@ -83,15 +83,44 @@ func (s Schema) IndexOf(column, source string) int {
 // IndexOfColName returns the index of the given column in the schema or -1 if it's  not present. Only safe for schemas
 // corresponding to a single table, where the source of the column is irrelevant.
 func (s Schema) IndexOfColName(column string) int {
-	column = strings.ToLower(column)
 	for i, col := range s {
-		if strings.ToLower(col.Name) == column {
+		if strings.EqualFold(col.Name, column) {
 			return i
 		}
 	}
 	return -1
 }
 
+// Index returns the index of the columns of this schema. The index is built once for the columns of the schema and
+// shared with the other callers indexing them.
+func (s Schema) Index() *SchemaIndex {
+	if len(s) == 0 {
+		return NewSchemaIndex(s)
+	}
+	return schemaIndexes.get(internedSchemaKey{column: s[0], len: len(s)}, s, func() interface{} {
+		return NewSchemaIndex(s)
+	}).(*SchemaIndex)
+}
+
+// AliasSchema returns the schema given with the source of its columns replaced by |source|, as returned by a table or
+// subquery alias. The result is shared between the callers aliasing the same columns with the same source, so it must
+// be copied rather than modified, like the schema given.
+func AliasSchema(s Schema, source string) Schema {
+	alias := func() interface{} {
+		aliased := make(Schema, len(s))
+		for i, col := range s {
+			c := *col
+			c.Source = source
+			aliased[i] = &c
+		}
+		return aliased
+	}
+	if len(s) == 0 {
+		return alias().(Schema)
+	}
+	return aliasedSchemas.get(internedSchemaKey{column: s[0], len: len(s), source: source}, s, alias).(Schema)
+}
+
 // Equals checks whether the given schema is equal to this one.
 func (s Schema) Equals(s2 Schema) bool {
 	if len(s) != len(s2) {
@@ -142,6 +171,129 @@ func IsKeyless(s Schema) bool {
 	return true
 }
 
+// SchemaIndex is an immutable index of the columns of a schema, with the lowercase names and sources of the columns
+// and their positions by name computed once.
+type SchemaIndex struct {
+	schema  Schema
+	names   []string
+	sources []string
+	byName  map[string][]int
+}
+
+// NewSchemaIndex returns a new index of the columns of the schema given. Use Schema.Index to share the index of a
+// schema rather than building it again.
+func NewSchemaIndex(s Schema) *SchemaIndex {
+	idx := &SchemaIndex{
+		schema:  s,
+		names:   make([]string, len(s)),
+		sources: make([]string, len(s)),
+		byName:  make(map[string][]int, len(s)),
+	}
+	for i, col := range s {
+		idx.names[i] = strings.ToLower(col.Name)
+		idx.sources[i] = strings.ToLower(col.Source)
+		idx.byName[idx.names[i]] = append(idx.byName[idx.names[i]], i)
+	}
+	return idx
+}
+
+// Schema returns the schema indexed.
+func (idx *SchemaIndex) Schema() Schema {
+	return idx.schema
+}
+
+// Len returns the number of columns in the schema indexed.
+func (idx *SchemaIndex) Len() int {
+	return len(idx.schema)
+}
+
+// Name returns the lowercase name of the column at index |i|.
+func (idx *SchemaIndex) Name(i int) string {
+	return idx.names[i]
+}
+
+// Source returns the lowercase source of the column at index |i|.
+func (idx *SchemaIndex) Source(i int) string {
+	return idx.sources[i]
+}
+
+// IndexOf returns the index of the given column in the schema or -1 if it's not present.
+func (idx *SchemaIndex) IndexOf(column, source string) int {
+	for _, i := range idx.byName[strings.ToLower(column)] {
+		if strings.EqualFold(idx.schema[i].Source, source) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOfColName returns the index of the first column with the name given in the schema or -1 if it's not present.
+func (idx *SchemaIndex) IndexOfColName(column string) int {
+	if cols := idx.byName[strings.ToLower(column)]; len(cols) > 0 {
+		return cols[0]
+	}
+	return -1
+}
+
+// maxInternedSchemas is the number of values an internedSchemas holds before it's emptied, so that the schemas of
+// dropped tables and of nodes that build their schema on each call aren't kept forever.
+const maxInternedSchemas = 4096
+
+var (
+	schemaIndexes  = &internedSchemas{values: make(map[internedSchemaKey]internedSchema)}
+	aliasedSchemas = &internedSchemas{values: make(map[internedSchemaKey]internedSchema)}
+)
+
+// internedSchemas holds values computed from schemas, to share them between the callers computing them from the same
+// columns. Since the columns of a schema are never modified, a value is looked up by the identity of the columns.
+type internedSchemas struct {
+	mu     sync.Mutex
+	values map[internedSchemaKey]internedSchema
+}
+
+type internedSchemaKey struct {
+	column *Column
+	len    int
+	source string
+}
+
+type internedSchema struct {
+	schema Schema
+	value  interface{}
+}
+
+// get returns the value computed from the schema given with |compute|, computing it if it isn't interned yet.
+func (i *internedSchemas) get(key internedSchemaKey, s Schema, compute func() interface{}) interface{} {
+	i.mu.Lock()
+	interned, ok := i.values[key]
+	i.mu.Unlock()
+	if ok && sameColumns(interned.schema, s) {
+		return interned.value
+	}
+
+	value := compute()
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(i.values) >= maxInternedSchemas {
+		i.values = make(map[internedSchemaKey]internedSchema)
+	}
+	i.values[key] = internedSchema{schema: s, value: value}
+	return value
+}
+
+// sameColumns returns whether the schemas given are made of the same columns.
+func sameColumns(s1, s2 Schema) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // PrimaryKeySchema defines table metadata for columns and primary key ordering
 type PrimaryKeySchema struct {
 	Schema
@@ -0,0 +1,66 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaIndex(t *testing.T) {
+	require := require.New(t)
+
+	s := Schema{
+		{Name: "A", Source: "T1"},
+		{Name: "b", Source: "t1"},
+		{Name: "a", Source: "t2"},
+	}
+	idx := s.Index()
+	require.Same(idx, s.Index())
+	require.Equal(3, idx.Len())
+	require.Equal("a", idx.Name(0))
+	require.Equal("t1", idx.Source(0))
+
+	require.Equal(0, idx.IndexOf("a", "t1"))
+	require.Equal(2, idx.IndexOf("A", "T2"))
+	require.Equal(-1, idx.IndexOf("b", "t2"))
+	require.Equal(0, idx.IndexOfColName("a"))
+	require.Equal(1, idx.IndexOfColName("B"))
+	require.Equal(-1, idx.IndexOfColName("c"))
+
+	// a schema with other columns, even if equal, has its own index
+	require.NotSame(idx, s.Copy().Index())
+	require.NotSame(idx, s[:2].Index())
+}
+
+func TestAliasSchema(t *testing.T) {
+	require := require.New(t)
+
+	s := Schema{
+		{Name: "a", Source: "t1"},
+		{Name: "b", Source: "t1"},
+	}
+	aliased := AliasSchema(s, "x")
+	require.Equal(Schema{
+		{Name: "a", Source: "x"},
+		{Name: "b", Source: "x"},
+	}, aliased)
+	require.Equal("t1", s[0].Source)
+
+	require.Same(aliased[0], AliasSchema(s, "x")[0])
+	require.Equal("y", AliasSchema(s, "y")[0].Source)
+	require.NotSame(aliased[0], AliasSchema(s.Copy(), "x")[0])
+}
@@ -0,0 +1,207 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultSequenceCache is the number of values a session reserves from a sequence at a time when the sequence
+	// doesn't specify a CACHE size.
+	DefaultSequenceCache = 1000
+	// DefaultSequenceMaxValue is the largest value of an ascending sequence that doesn't specify a MAXVALUE, and the
+	// largest value any sequence can have.
+	DefaultSequenceMaxValue = math.MaxInt64 - 1
+	// DefaultSequenceMinValue is the smallest value of a descending sequence that doesn't specify a MINVALUE, and the
+	// smallest value any sequence can have.
+	DefaultSequenceMinValue = math.MinInt64 + 1
+)
+
+// Sequence is the definition of a sequence, a database object that generates a series of integers independent of
+// any table.
+type Sequence struct {
+	Name      string
+	Start     int64
+	Increment int64
+	MinValue  int64
+	MaxValue  int64
+	// Cache is the number of values reserved by a session each time it runs out of values. A session hands out the
+	// values it has reserved without going back to the database, so values are not handed out in order across sessions.
+	Cache int64
+	// Cycle is whether the sequence restarts from its first value once it runs out of values.
+	Cycle bool
+}
+
+// NewSequence returns the definition of an ascending sequence with the name given and default options.
+func NewSequence(name string) Sequence {
+	return Sequence{
+		Name:      name,
+		Start:     1,
+		Increment: 1,
+		MinValue:  1,
+		MaxValue:  DefaultSequenceMaxValue,
+		Cache:     DefaultSequenceCache,
+	}
+}
+
+// Validate returns an error if the options of this sequence are inconsistent with each other.
+func (s Sequence) Validate(dbName string) error {
+	if s.Increment == 0 || s.Cache < 0 || s.MinValue >= s.MaxValue || s.Start < s.MinValue || s.Start > s.MaxValue ||
+		s.MinValue < DefaultSequenceMinValue || s.MaxValue > DefaultSequenceMaxValue {
+		return ErrSequenceInvalidData.New(dbName, s.Name)
+	}
+	return nil
+}
+
+// FirstValue returns the value a cycling sequence restarts from once it runs out of values.
+func (s Sequence) FirstValue() int64 {
+	if s.Increment < 0 {
+		return s.MaxValue
+	}
+	return s.MinValue
+}
+
+// SequenceDatabase is a database that stores sequences. The engine handles reading values from the sequence and caching
+// them in the session; integrators only need to store the sequence definitions and hand out ranges of values.
+type SequenceDatabase interface {
+	Database
+	// GetSequence returns the sequence with the name given, and whether it exists.
+	GetSequence(ctx *Context, name string) (Sequence, bool, error)
+	// CreateSequence stores a new sequence. The integrator should return ErrTableAlreadyExists if a sequence or table
+	// with the same name already exists.
+	CreateSequence(ctx *Context, seq Sequence) error
+	// AlterSequence replaces the definition of an existing sequence, keeping the next value it will hand out.
+	AlterSequence(ctx *Context, seq Sequence) error
+	// DropSequence removes the sequence with the name given.
+	DropSequence(ctx *Context, name string) error
+	// ReserveSequenceValues reserves up to |count| of the next values of the sequence named and returns them in
+	// order. Reserved values are never handed out again, even if the transaction that reserved them is rolled back.
+	// Fewer values are returned if the sequence runs out of values, and ErrSequenceRunOut if it has none left.
+	ReserveSequenceValues(ctx *Context, name string, count int64) ([]int64, error)
+	// RestartSequence sets the next value the sequence named will hand out. A value beyond the bounds of the
+	// sequence means the sequence has run out of values.
+	RestartSequence(ctx *Context, name string, next int64) error
+}
+
+// SequenceExpression is an expression that reads or modifies a sequence, and so needs a database provider to find
+// it.
+type SequenceExpression interface {
+	Expression
+	// WithDatabaseProvider returns a copy of this expression with the database provider given.
+	WithDatabaseProvider(provider DatabaseProvider) (Expression, error)
+}
+
+// SequenceCache holds the values of sequences that a session has reserved but not yet used, along with the last value
+// the session read from each sequence. Like in MariaDB, reserved values are kept across transactions for the life of the
+// session, and are discarded if the definition of their sequence changes.
+type SequenceCache struct {
+	mu       sync.Mutex
+	reserved map[string]reservedSequenceValues
+	last     map[string]int64
+}
+
+// reservedSequenceValues are the values reserved from a sequence, along with the definition of the sequence when they
+// were reserved.
+type reservedSequenceValues struct {
+	seq  Sequence
+	vals []int64
+}
+
+// NewSequenceCache returns an empty SequenceCache.
+func NewSequenceCache() *SequenceCache {
+	return &SequenceCache{
+		reserved: make(map[string]reservedSequenceValues),
+		last:     make(map[string]int64),
+	}
+}
+
+// Invalidate discards the values reserved from the sequence given, so that the next value read from it comes
+// directly from the database.
+func (c *SequenceCache) Invalidate(dbName, seqName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.reserved, sequenceCacheKey(dbName, seqName))
+}
+
+// LastValue returns the last value this session read from the sequence given, and whether it has read one.
+func (c *SequenceCache) LastValue(dbName, seqName string) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	val, ok := c.last[sequenceCacheKey(dbName, seqName)]
+	return val, ok
+}
+
+func sequenceCacheKey(dbName, seqName string) string {
+	return strings.ToLower(dbName) + "." + strings.ToLower(seqName)
+}
+
+// SequenceCacheSession is a session that caches the values it reserves from sequences.
+type SequenceCacheSession interface {
+	SequenceCache() *SequenceCache
+}
+
+// NextSequenceValue returns the next value of the sequence given for the session in the context given. Values are
+// reserved from the database in batches of the sequence's CACHE size and handed out from the session's cache.
+func NextSequenceValue(ctx *Context, db SequenceDatabase, name string) (int64, error) {
+	seq, ok, err := db.GetSequence(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrSequenceNotFound.New(db.Name() + "." + name)
+	}
+
+	// Sessions that don't cache sequence values don't track the last value read either, see LASTVAL()
+	cs, ok := ctx.Session.(SequenceCacheSession)
+	if !ok {
+		vals, err := db.ReserveSequenceValues(ctx, name, 1)
+		if err != nil {
+			return 0, err
+		}
+		return vals[0], nil
+	}
+
+	cache := cs.SequenceCache()
+	key := sequenceCacheKey(db.Name(), name)
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	reserved, ok := cache.reserved[key]
+	if !ok || len(reserved.vals) == 0 || reserved.seq != seq {
+		// A NOCACHE sequence reserves a single value at a time
+		count := seq.Cache
+		if count < 1 {
+			count = 1
+		}
+		vals, err := db.ReserveSequenceValues(ctx, name, count)
+		if err != nil {
+			return 0, err
+		}
+		reserved = reservedSequenceValues{seq: seq, vals: vals}
+	}
+
+	val := reserved.vals[0]
+	reserved.vals = reserved.vals[1:]
+	if len(reserved.vals) == 0 {
+		delete(cache.reserved, key)
+	} else {
+		cache.reserved[key] = reserved
+	}
+	cache.last[key] = val
+	return val, nil
+}
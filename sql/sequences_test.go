@@ -0,0 +1,102 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testSequenceDatabase is a SequenceDatabase with a single sequence that counts the values reserved from it.
+type testSequenceDatabase struct {
+	SequenceDatabase
+	seq          Sequence
+	next         int64
+	reservations int
+}
+
+func (d *testSequenceDatabase) Name() string {
+	return "mydb"
+}
+
+func (d *testSequenceDatabase) GetSequence(ctx *Context, name string) (Sequence, bool, error) {
+	return d.seq, name == d.seq.Name, nil
+}
+
+func (d *testSequenceDatabase) ReserveSequenceValues(ctx *Context, name string, count int64) ([]int64, error) {
+	d.reservations++
+	vals := make([]int64, count)
+	for i := range vals {
+		vals[i] = d.next
+		d.next += d.seq.Increment
+	}
+	return vals, nil
+}
+
+type testTransaction struct{}
+
+func (testTransaction) String() string {
+	return "tx"
+}
+
+func (testTransaction) IsReadOnly() bool {
+	return false
+}
+
+func TestNextSequenceValueAcrossTransactions(t *testing.T) {
+	require := require.New(t)
+
+	seq := NewSequence("s")
+	seq.Cache = 10
+	db := &testSequenceDatabase{seq: seq, next: 1}
+	sess := NewBaseSession()
+	ctx := NewContext(context.Background(), WithSession(sess))
+
+	for i := int64(1); i <= 3; i++ {
+		sess.SetTransaction(testTransaction{})
+		val, err := NextSequenceValue(ctx, db, "s")
+		require.NoError(err)
+		require.Equal(i, val)
+		sess.SetTransaction(nil)
+	}
+	require.Equal(1, db.reservations)
+
+	last, ok := sess.SequenceCache().LastValue("mydb", "s")
+	require.True(ok)
+	require.Equal(int64(3), last)
+}
+
+func TestNextSequenceValueNoCache(t *testing.T) {
+	require := require.New(t)
+
+	seq := NewSequence("s")
+	seq.Cache = 0
+	db := &testSequenceDatabase{seq: seq, next: 1}
+	sess := NewBaseSession()
+	ctx := NewContext(context.Background(), WithSession(sess))
+
+	for i := int64(1); i <= 3; i++ {
+		val, err := NextSequenceValue(ctx, db, "s")
+		require.NoError(err)
+		require.Equal(i, val)
+
+		last, ok := sess.SequenceCache().LastValue("mydb", "s")
+		require.True(ok)
+		require.Equal(i, last)
+	}
+	require.Equal(3, db.reservations)
+}
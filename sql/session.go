@@ -20,6 +20,7 @@ import (
 	"io"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -54,6 +55,12 @@ type Client struct {
 	Address string
 	// Capabilities of the client
 	Capabilities uint32
+	// Attributes are the connection attributes the client sent when it connected, such as the name and version of
+	// its driver. Nil if the client sent none.
+	Attributes map[string]string
+	// PasswordExpired is whether the password of the user has expired, in which case the session can't run other
+	// statements than the ones changing its password.
+	PasswordExpired bool
 }
 
 // Session holds the session data.
@@ -78,6 +85,14 @@ type Session interface {
 	GetUserVariable(ctx *Context, varName string) (Type, interface{}, error)
 	// GetAllSessionVariables returns a copy of all session variable values.
 	GetAllSessionVariables() map[string]interface{}
+	// GetStatusVariable returns this session's value of the status variable with the given name, and whether the
+	// variable is counted per session.
+	GetStatusVariable(ctx *Context, statusVarName string) (interface{}, bool)
+	// IncrementStatusVariable adds the given amount to this session's value of the status variable with the given
+	// name. Variables that are not counted per session are ignored.
+	IncrementStatusVariable(ctx *Context, statusVarName string, val int64)
+	// GetAllStatusVariables returns a copy of all of this session's status variable values.
+	GetAllStatusVariables(ctx *Context) map[string]interface{}
 	// GetCurrentDatabase gets the current database for this session
 	GetCurrentDatabase() string
 	// SetCurrentDatabase sets the current database for this session
@@ -112,6 +127,11 @@ type Session interface {
 	SetIgnoreAutoCommit(ignore bool)
 	// GetIgnoreAutoCommit returns whether this session should ignore the @@autocommit variable
 	GetIgnoreAutoCommit() bool
+	// SetBypassReadOnly instructs the session to ignore the read_only and super_read_only variables, or to honor them
+	// again. Sessions that apply replicated changes set it, since they must keep writing while the server is read-only.
+	SetBypassReadOnly(bypass bool)
+	// GetBypassReadOnly returns whether this session ignores the read_only and super_read_only variables
+	GetBypassReadOnly() bool
 	// GetLogger returns the logger for this session, useful if clients want to log messages with the same format / output
 	// as the running server. Clients should instantiate their own global logger with formatting options, and session
 	// implementations should return the logger to be used for the running server.
@@ -144,6 +164,10 @@ type Session interface {
 	// value of zero will force the cache to reload. This is an internal function and is not intended to be used by
 	// integrators.
 	SetPrivilegeSet(newPs PrivilegeSet, counter uint64)
+	// SessionStateChanges returns the changes of session state tracked since it was last called, as controlled by the
+	// session_track_* system variables, to be reported in the OK packet of the statement that made them to clients
+	// that set the CLIENT_SESSION_TRACK capability.
+	SessionStateChanges() []SessionStateChange
 	// ValidateSession provides integrators a chance to do any custom validation of this session before any query is
 	// executed in it. For example, Dolt uses this hook to validate that the session's working set is valid.
 	ValidateSession(ctx *Context) error
@@ -152,16 +176,26 @@ type Session interface {
 // PersistableSession supports serializing/deserializing global system variables/
 type PersistableSession interface {
 	Session
-	// PersistGlobal writes to the persisted global system variables file
-	PersistGlobal(sysVarName string, value interface{}) error
-	// RemovePersistedGlobal deletes a variable from the persisted globals file
-	RemovePersistedGlobal(sysVarName string) error
-	// RemoveAllPersistedGlobals clears the contents of the persisted globals file
-	RemoveAllPersistedGlobals() error
+	SystemVariablePersister
 	// GetPersistedValue returns persisted value for a global system variable
 	GetPersistedValue(k string) (interface{}, error)
 }
 
+// SystemVariablePersisterFor returns the persister of the global system variables set with SET PERSIST and SET
+// PERSIST_ONLY in the session of the context given, which is the session itself if it implements PersistableSession,
+// and the persister of the system variable registry otherwise.
+func SystemVariablePersisterFor(ctx *Context) (SystemVariablePersister, error) {
+	if persistSess, ok := ctx.Session.(PersistableSession); ok {
+		return persistSess, nil
+	}
+	if SystemVariables != nil {
+		if persister := SystemVariables.Persister(); persister != nil {
+			return persister, nil
+		}
+	}
+	return nil, ErrSessionDoesNotSupportPersistence.New()
+}
+
 // TransactionSession can BEGIN, ROLLBACK and COMMIT transactions, as well as create SAVEPOINTS and restore to them.
 // Transactions can span multiple databases, and integrators must do their own error handling to prevent this if they
 // cannot support multiple databases in a single transaction. Such integrators can use Session.GetTransactionDatabase
@@ -183,6 +217,18 @@ type TransactionSession interface {
 	ReleaseSavepoint(ctx *Context, transaction Transaction, name string) error
 }
 
+// TransactionalDDLSession is a TransactionSession whose storage engine can run DDL statements in the surrounding
+// transaction, so that they're committed or rolled back along with its other statements. For other transaction
+// sessions, the engine commits the transaction in progress before a DDL statement and runs the statement in a
+// transaction of its own, which is committed once the statement is done and rolled back if it fails, so that DDL
+// statements are atomic as in MySQL 8.
+type TransactionalDDLSession interface {
+	TransactionSession
+	// SupportsTransactionalDDL returns whether DDL statements are run in the current transaction of the session
+	// rather than causing an implicit commit.
+	SupportsTransactionalDDL(ctx *Context) bool
+}
+
 type (
 	// TypedValue is a value along with its type.
 	TypedValue struct {
@@ -219,6 +265,10 @@ type Context struct {
 	queryTime   time.Time
 	tracer      trace.Tracer
 	rootSpan    trace.Span
+	rowEvents   *RowEventPublisher
+	lookups     *IndexLookupCache
+	statement   uint64
+	readOnly    bool
 	Version     AnalyzerVersion
 }
 
@@ -273,6 +323,32 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithRowEventPublisher sets the publisher that the row changes made with the context are recorded to.
+func WithRowEventPublisher(p *RowEventPublisher) ContextOption {
+	return func(ctx *Context) {
+		ctx.rowEvents = p
+	}
+}
+
+// statementIDs are the IDs given to the statements the engine runs.
+var statementIDs uint64
+
+// WithStatement marks the context as running a new statement, which is given a new ID, and only reads tables if
+// |readOnly| is set.
+func WithStatement(readOnly bool) ContextOption {
+	return func(ctx *Context) {
+		ctx.statement = atomic.AddUint64(&statementIDs, 1)
+		ctx.readOnly = readOnly
+	}
+}
+
+// WithIndexLookupCache sets the cache of the rows of the index lookups made with the context.
+func WithIndexLookupCache(c *IndexLookupCache) ContextOption {
+	return func(ctx *Context) {
+		ctx.lookups = c
+	}
+}
+
 // WithServices sets the services for the Context
 func WithServices(services Services) ContextOption {
 	return func(ctx *Context) {
@@ -396,6 +472,30 @@ func (c *Context) WithContext(ctx context.Context) *Context {
 	return &nc
 }
 
+// RowEvents returns the publisher that the row changes made with this context are recorded to, which is nil if the
+// changes aren't published.
+func (c *Context) RowEvents() *RowEventPublisher {
+	return c.rowEvents
+}
+
+// StatementID returns the ID of the statement the context runs, which is unique across sessions, or 0 if it doesn't run
+// one.
+func (c *Context) StatementID() uint64 {
+	return c.statement
+}
+
+// IsReadOnlyStatement returns whether the statement the context runs only reads tables: it doesn't write rows, change
+// schemas, sequences or accounts, lock the rows it reads or call stored procedures.
+func (c *Context) IsReadOnlyStatement() bool {
+	return c.statement != 0 && c.readOnly
+}
+
+// IndexLookupCache returns the cache of the rows of the index lookups made with this context, which is nil if they
+// aren't cached.
+func (c *Context) IndexLookupCache() *IndexLookupCache {
+	return c.lookups
+}
+
 // RootSpan returns the root span, if any.
 func (c *Context) RootSpan() trace.Span {
 	return c.rootSpan
@@ -17,8 +17,10 @@ package sql
 import (
 	"context"
 	"io"
+	"strings"
 	"testing"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,3 +100,23 @@ func TestSessionIterator(t *testing.T) {
 		counter++
 	}
 }
+
+func TestEncodeSessionStateChanges(t *testing.T) {
+	require := require.New(t)
+
+	info := EncodeSessionStateChanges([]SessionStateChange{
+		{Type: mysql.SessionTrackSystemVariables, Name: "autocommit", Value: "OFF"},
+		{Type: mysql.SessionTrackSchema, Value: "mydb"},
+		{Type: mysql.SessionTrackStateChange, Value: "1"},
+	})
+	expected := []byte{
+		0x00, 0x0f, 0x0a, 'a', 'u', 't', 'o', 'c', 'o', 'm', 'm', 'i', 't', 0x03, 'O', 'F', 'F',
+		0x01, 0x05, 0x04, 'm', 'y', 'd', 'b',
+		0x02, 0x02, 0x01, '1',
+	}
+	require.Equal(expected, info)
+
+	long := strings.Repeat("a", 300)
+	info = EncodeSessionStateChanges([]SessionStateChange{{Type: mysql.SessionTrackSchema, Value: long}})
+	require.Equal(append([]byte{0x01, 0xfc, 0x2f, 0x01, 0xfc, 0x2c, 0x01}, long...), info)
+}
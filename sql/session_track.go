@@ -0,0 +1,134 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// SessionStateChange is a change of session state, reported to clients that set the CLIENT_SESSION_TRACK capability
+// in the session state information of the OK packet of the statement that made it. Which changes are tracked is
+// controlled by the session_track_schema, session_track_system_variables and session_track_state_change system
+// variables.
+type SessionStateChange struct {
+	// Type is the type of the change, one of mysql.SessionTrackSystemVariables, mysql.SessionTrackSchema and
+	// mysql.SessionTrackStateChange.
+	Type uint8
+	// Name is the name of the system variable changed, for changes of system variables.
+	Name string
+	// Value is the new value of the system variable or the new current database, and "1" for changes of state.
+	Value string
+}
+
+// EncodeSessionStateChanges returns the session state information of an OK packet reporting the changes given, as
+// defined by the MySQL client/server protocol.
+func EncodeSessionStateChanges(changes []SessionStateChange) []byte {
+	var info []byte
+	for _, change := range changes {
+		var data []byte
+		if change.Type == mysql.SessionTrackSystemVariables {
+			data = appendLenEncString(data, change.Name)
+		}
+		data = appendLenEncString(data, change.Value)
+		info = append(info, change.Type)
+		info = appendLenEncString(info, string(data))
+	}
+	return info
+}
+
+// appendLenEncString appends the length-encoded string given to the buffer given.
+func appendLenEncString(buf []byte, s string) []byte {
+	l := uint64(len(s))
+	switch {
+	case l < 251:
+		buf = append(buf, byte(l))
+	case l < 1<<16:
+		buf = append(buf, 0xfc, byte(l), byte(l>>8))
+	case l < 1<<24:
+		buf = append(buf, 0xfd, byte(l), byte(l>>8), byte(l>>16))
+	default:
+		buf = append(buf, 0xfe)
+		buf = binary.LittleEndian.AppendUint64(buf, l)
+	}
+	return append(buf, s...)
+}
+
+// trackSystemVariable records the change of the system variable given if session_track_system_variables lists it.
+// The session's lock must be held.
+func (s *BaseSession) trackSystemVariable(ctx *Context, sysVar SystemVariable, value interface{}) {
+	s.stateChanged = true
+	name := sysVar.Name
+	tracked, ok := s.systemVars["session_track_system_variables"]
+	if !ok {
+		return
+	}
+	list, _ := tracked.Val.(string)
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.ToLower(strings.TrimSpace(v)); v == "*" || v == name {
+			for i, change := range s.trackedVars {
+				if change.Name == name {
+					s.trackedVars = append(s.trackedVars[:i], s.trackedVars[i+1:]...)
+					break
+				}
+			}
+			str := fmt.Sprint(value)
+			if sqlVal, err := sysVar.Type.SQL(ctx, nil, value); err == nil {
+				str = sqlVal.ToString()
+			}
+			s.trackedVars = append(s.trackedVars, SessionStateChange{
+				Type:  mysql.SessionTrackSystemVariables,
+				Name:  name,
+				Value: str,
+			})
+			return
+		}
+	}
+}
+
+// sessionTrackEnabled returns whether the boolean session_track_* system variable given is enabled. The session's lock
+// must be held.
+func (s *BaseSession) sessionTrackEnabled(name string) bool {
+	v, ok := s.systemVars[name]
+	if !ok {
+		return false
+	}
+	enabled, _ := v.Val.(int8)
+	return enabled == 1
+}
+
+// SessionStateChanges implements the Session interface.
+func (s *BaseSession) SessionStateChanges() []SessionStateChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changes := s.trackedVars
+	if s.currentDB != s.trackedDB {
+		s.trackedDB = s.currentDB
+		s.stateChanged = true
+		if s.sessionTrackEnabled("session_track_schema") {
+			changes = append(changes, SessionStateChange{Type: mysql.SessionTrackSchema, Value: s.currentDB})
+		}
+	}
+	if s.stateChanged && s.sessionTrackEnabled("session_track_state_change") {
+		changes = append(changes, SessionStateChange{Type: mysql.SessionTrackStateChange, Value: "1"})
+	}
+	s.trackedVars = nil
+	s.stateChanged = false
+	return changes
+}
@@ -24,8 +24,6 @@ import (
 type SortField struct {
 	// Column to order by.
 	Column Expression
-	// Column Expression2 to order by. This is always the same value as Column, but avoids a type cast
-	Column2 Expression2
 	// Order type.
 	Order SortOrder
 	// NullOrdering defining how nulls will be ordered.
@@ -50,10 +48,8 @@ func (sf SortFields) FromExpressions(exprs ...Expression) SortFields {
 	}
 
 	for i, expr := range exprs {
-		expr2, _ := expr.(Expression2)
 		fields[i] = SortField{
 			Column:       expr,
-			Column2:      expr2,
 			NullOrdering: sf[i].NullOrdering,
 			Order:        sf[i].Order,
 		}
@@ -0,0 +1,92 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+const (
+	SqlModeStrictTransTables = "STRICT_TRANS_TABLES"
+	SqlModeStrictAllTables   = "STRICT_ALL_TABLES"
+	SqlModeTraditional       = "TRADITIONAL"
+	SqlModeOnlyFullGroupBy   = "ONLY_FULL_GROUP_BY"
+	SqlModeAnsiQuotes        = "ANSI_QUOTES"
+	SqlModeNoAutoValueOnZero = "NO_AUTO_VALUE_ON_ZERO"
+	SqlModeNoZeroDate        = "NO_ZERO_DATE"
+)
+
+// SqlMode is the set of modes in the sql_mode system variable.
+type SqlMode struct {
+	modes      map[string]struct{}
+	modeString string
+}
+
+// LoadSqlMode returns the SqlMode of the session in the context given. The session value of sql_mode overrides the
+// global one. An empty SqlMode is returned when the session has no sql_mode.
+func LoadSqlMode(ctx *Context) *SqlMode {
+	if ctx == nil || ctx.Session == nil {
+		return NewSqlModeFromString("")
+	}
+	sqlMode, err := ctx.Session.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return NewSqlModeFromString("")
+	}
+	modeString, ok := sqlMode.(string)
+	if !ok {
+		return NewSqlModeFromString("")
+	}
+	return NewSqlModeFromString(modeString)
+}
+
+// NewSqlModeFromString returns the SqlMode of the comma-separated list of modes given.
+func NewSqlModeFromString(modeString string) *SqlMode {
+	modes := make(map[string]struct{})
+	for _, mode := range strings.Split(strings.ToUpper(modeString), ",") {
+		mode = strings.TrimSpace(mode)
+		if mode != "" {
+			modes[mode] = struct{}{}
+		}
+	}
+	return &SqlMode{modes: modes, modeString: modeString}
+}
+
+// ModeEnabled returns whether the mode given is set.
+func (s *SqlMode) ModeEnabled(mode string) bool {
+	_, ok := s.modes[strings.ToUpper(mode)]
+	return ok
+}
+
+// Strict returns whether a strict mode is set. In strict mode, writing a value that is out of range, too long or
+// invalid for the type of its column is an error rather than a warning.
+func (s *SqlMode) Strict() bool {
+	return s.ModeEnabled(SqlModeStrictTransTables) || s.ModeEnabled(SqlModeStrictAllTables) || s.ModeEnabled(SqlModeTraditional)
+}
+
+// NoZeroDate returns whether the NO_ZERO_DATE mode is set, in which writing the zero date '0000-00-00' is an error in
+// strict mode and a warning otherwise.
+func (s *SqlMode) NoZeroDate() bool {
+	return s.ModeEnabled(SqlModeNoZeroDate) || s.ModeEnabled(SqlModeTraditional)
+}
+
+// AnsiQuotes returns whether the ANSI_QUOTES mode is set, in which double quotes quote identifiers rather than strings.
+func (s *SqlMode) AnsiQuotes() bool {
+	return s.ModeEnabled(SqlModeAnsiQuotes)
+}
+
+// String returns the sql_mode value this SqlMode was loaded from.
+func (s *SqlMode) String() string {
+	return s.modeString
+}
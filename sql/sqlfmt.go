@@ -16,6 +16,7 @@ package sql
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -27,13 +28,98 @@ import (
 // GenerateCreateTableStatement returns 'CREATE TABLE' statement with given table names
 // and column definition statements in order and the collation and character set names for the table
 func GenerateCreateTableStatement(tblName string, colStmts []string, tblCharsetName, tblCollName string) string {
-	return fmt.Sprintf(
-		"CREATE TABLE %s (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=%s COLLATE=%s",
+	return GenerateCreateTableStatementWithOptions(tblName, colStmts, tblCharsetName, tblCollName, nil)
+}
+
+// tableOptionOrder is the order that SHOW CREATE TABLE lists the table options in, after the engine, character set
+// and collation. Options missing from it come after these, in alphabetical order.
+var tableOptionOrder = []string{
+	"MIN_ROWS",
+	"MAX_ROWS",
+	"AVG_ROW_LENGTH",
+	"PACK_KEYS",
+	"STATS_PERSISTENT",
+	"STATS_AUTO_RECALC",
+	"STATS_SAMPLE_PAGES",
+	"CHECKSUM",
+	"DELAY_KEY_WRITE",
+	TableOptionRowFormat,
+	"KEY_BLOCK_SIZE",
+	"COMPRESSION",
+	"ENCRYPTION",
+	"INSERT_METHOD",
+	"UNION",
+	"AUTOEXTEND_SIZE",
+	TableOptionComment,
+	"CONNECTION",
+	"DATA DIRECTORY",
+	"INDEX DIRECTORY",
+	"ENGINE_ATTRIBUTE",
+}
+
+// stringTableOptions are the table options whose values are string literals.
+var stringTableOptions = map[string]bool{
+	TableOptionComment: true,
+	"COMPRESSION":      true,
+	"CONNECTION":       true,
+	"DATA DIRECTORY":   true,
+	"INDEX DIRECTORY":  true,
+	"ENCRYPTION":       true,
+	"ENGINE_ATTRIBUTE": true,
+	"PASSWORD":         true,
+}
+
+// GenerateCreateTableStatementWithOptions returns the same 'CREATE TABLE' statement as GenerateCreateTableStatement,
+// with the table options given. The ENGINE option replaces the default InnoDB engine, and the options of the secondary
+// engine are left for GenerateCreateTableSecondaryEngineOptions.
+func GenerateCreateTableStatementWithOptions(tblName string, colStmts []string, tblCharsetName, tblCollName string, options TableOptions) string {
+	engine := options[TableOptionEngine]
+	if engine == "" {
+		engine = "InnoDB"
+	}
+	stmt := fmt.Sprintf(
+		"CREATE TABLE %s (\n%s\n) ENGINE=%s DEFAULT CHARSET=%s COLLATE=%s",
 		QuoteIdentifier(tblName),
 		strings.Join(colStmts, ",\n"),
+		engine,
 		tblCharsetName,
 		tblCollName,
 	)
+
+	ordered := make(map[string]bool, len(tableOptionOrder))
+	names := make([]string, 0, len(options))
+	for _, name := range tableOptionOrder {
+		ordered[name] = true
+		if _, ok := options[name]; ok {
+			names = append(names, name)
+		}
+	}
+	var others []string
+	for name := range options {
+		switch name {
+		case TableOptionEngine, TableOptionSecondaryEngine, TableOptionSecondaryEngineAttribute:
+		default:
+			if !ordered[name] {
+				others = append(others, name)
+			}
+		}
+	}
+	sort.Strings(others)
+
+	for _, name := range append(names, others...) {
+		value := options[name]
+		switch {
+		case value == "":
+			continue
+		case name == "TABLESPACE":
+			stmt = fmt.Sprintf("%s /*!50100 TABLESPACE %s */", stmt, QuoteIdentifier(value))
+			continue
+		case stringTableOptions[name]:
+			value = fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+		}
+		stmt = fmt.Sprintf("%s %s=%s", stmt, name, value)
+	}
+	return stmt
 }
 
 // GenerateCreateTableColumnDefinition returns column definition string for 'CREATE TABLE' statement for given column.
@@ -60,6 +146,31 @@ func GenerateCreateTableColumnDefinition(colName string, colType Type, nullable
 	return stmt
 }
 
+// GenerateCreateTableGeneratedColumnDefinition returns column definition string for 'CREATE TABLE' statement for given
+// generated column, whose values are computed from the expression |genExpr| and are either stored or virtual.
+// Generated columns can't have default values or auto-increment.
+func GenerateCreateTableGeneratedColumnDefinition(colName string, colType Type, nullable bool, genExpr string, stored bool, comment string) string {
+	stmt := fmt.Sprintf("  %s %s GENERATED ALWAYS AS (%s)", QuoteIdentifier(colName), colType.String(), genExpr)
+	if stored {
+		stmt = fmt.Sprintf("%s STORED", stmt)
+	} else {
+		stmt = fmt.Sprintf("%s VIRTUAL", stmt)
+	}
+	if !nullable {
+		stmt = fmt.Sprintf("%s NOT NULL", stmt)
+	}
+	if comment != "" {
+		stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, comment)
+	}
+	return stmt
+}
+
+// GenerateCreateTableInvisibleColumnDefinition returns the column definition given, as returned by
+// GenerateCreateTableColumnDefinition, marked as an invisible column.
+func GenerateCreateTableInvisibleColumnDefinition(colStmt string) string {
+	return fmt.Sprintf("%s /*!80023 INVISIBLE */", colStmt)
+}
+
 // GenerateCreateTablePrimaryKeyDefinition returns primary key definition string for 'CREATE TABLE' statement
 // for given column(s). This part comes after each column definitions.
 func GenerateCreateTablePrimaryKeyDefinition(pkCols []string) string {
@@ -85,12 +196,35 @@ func GenerateCreateTableIndexDefinition(isUnique, isSpatial bool, indexID string
 	return key
 }
 
+// GenerateCreateTableIndexExpressionKeyPart returns the key part string of an index definition for 'CREATE TABLE'
+// statement for given functional key part, which indexes the values of the expression |expr| rather than a column.
+func GenerateCreateTableIndexExpressionKeyPart(expr string) string {
+	return fmt.Sprintf("(%s)", expr)
+}
+
+// GenerateCreateTableInvisibleIndexDefinition returns the index definition given, as returned by
+// GenerateCreateTableIndexDefinition, marked as an index invisible to the optimizer.
+func GenerateCreateTableInvisibleIndexDefinition(indexStmt string) string {
+	return fmt.Sprintf("%s /*!80000 INVISIBLE */", indexStmt)
+}
+
 // GenerateCreateTableForiegnKeyDefinition returns foreign key constraint definition string for 'CREATE TABLE' statement
 // for given foreign key. This part comes after index definitions if there are any.
 func GenerateCreateTableForiegnKeyDefinition(fkName string, fkCols []string, parentTbl string, parentCols []string, onDelete, onUpdate string) string {
+	return GenerateCreateTableQualifiedForeignKeyDefinition(fkName, fkCols, "", parentTbl, parentCols, onDelete, onUpdate)
+}
+
+// GenerateCreateTableQualifiedForeignKeyDefinition returns the same foreign key constraint definition as
+// GenerateCreateTableForiegnKeyDefinition, with the parent table qualified by the name of its database |parentDb|,
+// for the foreign keys referencing tables in other databases. The parent table isn't qualified when |parentDb| is empty.
+func GenerateCreateTableQualifiedForeignKeyDefinition(fkName string, fkCols []string, parentDb, parentTbl string, parentCols []string, onDelete, onUpdate string) string {
 	keyCols := strings.Join(QuoteIdentifiers(fkCols), ",")
 	refCols := strings.Join(QuoteIdentifiers(parentCols), ",")
-	fkey := fmt.Sprintf("  CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", QuoteIdentifier(fkName), keyCols, QuoteIdentifier(parentTbl), refCols)
+	parent := QuoteIdentifier(parentTbl)
+	if parentDb != "" {
+		parent = QuoteIdentifier(parentDb) + "." + parent
+	}
+	fkey := fmt.Sprintf("  CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", QuoteIdentifier(fkName), keyCols, parent, refCols)
 	if onDelete != "" {
 		fkey = fmt.Sprintf("%s ON DELETE %s", fkey, onDelete)
 	}
@@ -110,6 +244,26 @@ func GenerateCreateTableCheckConstraintClause(checkName, checkExpr string, enfor
 	return cc
 }
 
+// GenerateCreateTableSecondaryEngineOptions returns the 'CREATE TABLE' statement given, as returned by
+// GenerateCreateTableStatement, with the table options of its secondary engine and that engine's attributes. Either
+// may be empty, in which case its option is omitted.
+func GenerateCreateTableSecondaryEngineOptions(createStmt, secondaryEngine, secondaryEngineAttribute string) string {
+	if secondaryEngine != "" {
+		createStmt = fmt.Sprintf("%s SECONDARY_ENGINE=%s", createStmt, secondaryEngine)
+	}
+	if secondaryEngineAttribute != "" {
+		createStmt = fmt.Sprintf("%s /*!80021 SECONDARY_ENGINE_ATTRIBUTE='%s' */", createStmt, strings.ReplaceAll(secondaryEngineAttribute, "'", "''"))
+	}
+	return createStmt
+}
+
+// GenerateCreateTablePartitionClause returns the 'CREATE TABLE' statement given, as returned by
+// GenerateCreateTableStatement, with the partitioning of its table given by |partitionClause|, which begins with
+// PARTITION BY. This part comes the last, after the table options.
+func GenerateCreateTablePartitionClause(createStmt, partitionClause string) string {
+	return fmt.Sprintf("%s\n/*!50100 %s */", createStmt, partitionClause)
+}
+
 // QuoteIdentifier wraps the specified identifier in backticks and escapes all occurrences of backticks in the
 // identifier by replacing them with double backticks.
 func QuoteIdentifier(id string) string {
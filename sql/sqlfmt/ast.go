@@ -0,0 +1,103 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlfmt renders parsed statements and plans back to SQL text. The text it returns is canonical: identifiers
+// are quoted with backticks when they need to be and string literals with single quotes, so that it has the same
+// meaning whether or not the ANSI_QUOTES SQL mode is enabled when it's parsed again.
+package sqlfmt
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// FormatAST returns the canonical SQL text of the parsed statement, or part of a statement, given.
+func FormatAST(node sqlparser.SQLNode) string {
+	buf := sqlparser.NewTrackedBuffer(formatASTNode)
+	buf.Myprintf("%v", node)
+	return buf.String()
+}
+
+// formatASTNode formats the nodes that the parser doesn't format back to valid SQL, and lets the others format
+// themselves.
+func formatASTNode(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	switch node := node.(type) {
+	case *sqlparser.AliasedExpr:
+		// the parser keeps the text of the expression as it was written, which names its column
+		expr := FormatAST(node.Expr)
+		switch {
+		case !node.As.IsEmpty():
+			buf.Myprintf("%s as %v", expr, node.As)
+		case node.InputExpression != "" && node.InputExpression != expr:
+			buf.Myprintf("%s as %v", expr, sqlparser.NewColIdent(node.InputExpression))
+		default:
+			buf.Myprintf("%s", expr)
+		}
+		return
+	case *sqlparser.ConvertExpr:
+		if strings.EqualFold(node.Name, "cast") {
+			buf.Myprintf("%s(%v as %v)", node.Name, node.Expr, node.Type)
+			return
+		}
+	case *sqlparser.JSONTableSpec:
+		buf.Myprintf("%v columns(", sqlparser.NewStrVal([]byte(node.Path)))
+		for i, col := range node.Columns {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", col)
+		}
+		buf.Myprintf(")")
+		return
+	case *sqlparser.JSONTableColDef:
+		switch {
+		case node.Spec != nil:
+			buf.Myprintf("nested path %v", node.Spec)
+		case bool(node.Type.Autoincrement):
+			buf.Myprintf("%v for ordinality", node.Name)
+		default:
+			buf.Myprintf("%v %v %v", node.Name, &node.Type, node.Opts)
+		}
+		return
+	case sqlparser.JSONTableColOpts:
+		if node.Exists {
+			buf.Myprintf("exists ")
+		}
+		buf.Myprintf("path %v", sqlparser.NewStrVal([]byte(node.Path)))
+		formatJSONTableColBehavior(buf, node.ValOnEmpty, node.ErrorOnEmpty, "empty")
+		formatJSONTableColBehavior(buf, node.ValOnError, node.ErrorOnError, "error")
+		return
+	}
+	node.Format(buf)
+}
+
+// formatJSONTableColBehavior formats the ON EMPTY or ON ERROR clause of a JSON_TABLE column, given by the value |val|
+// and whether it raises an error.
+func formatJSONTableColBehavior(buf *sqlparser.TrackedBuffer, val sqlparser.Expr, isError bool, event string) {
+	switch {
+	case isError:
+		buf.Myprintf(" error on %s", event)
+	case val == nil:
+	case isNullVal(val):
+		buf.Myprintf(" null on %s", event)
+	default:
+		buf.Myprintf(" default %v on %s", val, event)
+	}
+}
+
+func isNullVal(e sqlparser.Expr) bool {
+	_, ok := e.(*sqlparser.NullVal)
+	return ok
+}
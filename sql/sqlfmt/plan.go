@@ -0,0 +1,607 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlfmt
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ErrUnsupported is returned when a plan has a node or an expression that can't be rendered as SQL.
+var ErrUnsupported = errors.NewKind("cannot format %T as SQL")
+
+// aggregationNames are the SQL names of the aggregate functions whose FunctionName is different. FIRST and LAST are
+// given to the columns selected without being grouped, which ANY_VALUE selects the same way.
+var aggregationNames = map[string]string{
+	"anyvalue":  "any_value",
+	"bitand":    "bit_and",
+	"bitor":     "bit_or",
+	"bitxor":    "bit_xor",
+	"jsonarray": "json_arrayagg",
+	"first":     "any_value",
+	"last":      "any_value",
+}
+
+// FormatNode returns the SQL text of the query planned by the node given, which may be parsed or analyzed. Queries
+// are rendered as SELECT statements with joins, derived tables, subqueries and unions; an error is returned for plans
+// with other nodes, such as window functions, common table expressions and static index lookups. Filters and other
+// operations pushed down into the tables of an analyzed plan aren't rendered.
+func FormatNode(n sql.Node) (string, error) {
+	switch n := n.(type) {
+	case *plan.QueryProcess:
+		return FormatNode(n.Child())
+	case *plan.TransactionCommittingNode:
+		return FormatNode(n.Child())
+	case *plan.Union:
+		return formatUnion(n)
+	default:
+		return formatSelect(n)
+	}
+}
+
+// FormatExpression returns the SQL text of the expression given.
+func FormatExpression(e sql.Expression) (string, error) {
+	switch e := e.(type) {
+	case *expression.Literal:
+		return formatLiteral(e), nil
+	case *expression.GetField:
+		return qualifiedName(e.Table(), e.Name()), nil
+	case *expression.UnresolvedColumn:
+		return qualifiedName(e.Table(), e.Name()), nil
+	case *expression.Star:
+		if e.Table != "" {
+			return sql.QuoteIdentifier(e.Table) + ".*", nil
+		}
+		return "*", nil
+	case *expression.Alias:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s as %s", child, sql.QuoteIdentifier(e.Name())), nil
+	case *expression.Equals:
+		return formatBinary(e.Left(), "=", e.Right())
+	case *expression.NullSafeEquals:
+		return formatBinary(e.Left(), "<=>", e.Right())
+	case *expression.GreaterThan:
+		return formatBinary(e.Left(), ">", e.Right())
+	case *expression.GreaterThanOrEqual:
+		return formatBinary(e.Left(), ">=", e.Right())
+	case *expression.LessThan:
+		return formatBinary(e.Left(), "<", e.Right())
+	case *expression.LessThanOrEqual:
+		return formatBinary(e.Left(), "<=", e.Right())
+	case *expression.Regexp:
+		return formatBinary(e.Left(), "regexp", e.Right())
+	case *expression.And:
+		return formatBinary(e.Left, "and", e.Right)
+	case *expression.Or:
+		return formatBinary(e.Left, "or", e.Right)
+	case *expression.Xor:
+		return formatBinary(e.Left, "xor", e.Right)
+	case *expression.Arithmetic:
+		return formatBinary(e.Left, e.Op, e.Right)
+	case *expression.Div:
+		return formatBinary(e.Left, "/", e.Right)
+	case *expression.IntDiv:
+		return formatBinary(e.Left, "div", e.Right)
+	case *expression.Mod:
+		return formatBinary(e.Left, "%", e.Right)
+	case *expression.BitOp:
+		return formatBinary(e.Left, e.Op, e.Right)
+	case *expression.InTuple:
+		return formatBinary(e.Left(), "in", e.Right())
+	case *expression.Like:
+		like, err := formatBinary(e.Left, "like", e.Right)
+		if err != nil || e.Escape == nil {
+			return like, err
+		}
+		escape, err := FormatExpression(e.Escape)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s escape %s)", like, escape), nil
+	case *expression.Not:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(not %s)", child), nil
+	case *expression.UnaryMinus:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(-%s)", child), nil
+	case *expression.IsNull:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s is null)", child), nil
+	case *expression.Between:
+		exprs, err := formatExpressions(e.Val, e.Lower, e.Upper)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s between %s and %s)", exprs[0], exprs[1], exprs[2]), nil
+	case expression.Tuple:
+		exprs, err := formatExpressions(e...)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s)", strings.Join(exprs, ", ")), nil
+	case *expression.Case:
+		return formatCase(e)
+	case *expression.Convert:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("convert(%s, %s)", child, e.TypeString()), nil
+	case *expression.Interval:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("interval %s %s", child, e.Unit), nil
+	case *expression.DistinctExpression:
+		child, err := FormatExpression(e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("distinct %s", child), nil
+	case *plan.Subquery:
+		query, err := FormatNode(e.Query)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s)", query), nil
+	case *expression.UnresolvedFunction:
+		if e.Window != nil {
+			return "", ErrUnsupported.New(e)
+		}
+		return formatFunction(e.Name(), e.Arguments)
+	case *aggregation.CountDistinct:
+		if e.Window() != nil {
+			return "", ErrUnsupported.New(e)
+		}
+		args, err := formatExpressions(e.Children()...)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("count(distinct %s)", strings.Join(args, ", ")), nil
+	case sql.Aggregation:
+		if w, ok := e.(interface{ Window() *sql.WindowDefinition }); ok && w.Window() != nil {
+			return "", ErrUnsupported.New(e)
+		}
+		f, ok := e.(sql.FunctionExpression)
+		if !ok {
+			return "", ErrUnsupported.New(e)
+		}
+		name := strings.ToLower(f.FunctionName())
+		if sqlName, ok := aggregationNames[name]; ok {
+			name = sqlName
+		}
+		return formatFunction(name, e.Children())
+	case sql.FunctionExpression:
+		return formatFunction(e.FunctionName(), e.Children())
+	default:
+		return "", ErrUnsupported.New(e)
+	}
+}
+
+// selectBlock is the clauses of a SELECT statement, read from the nodes of a plan.
+type selectBlock struct {
+	distinct    bool
+	projections []sql.Expression
+	from        sql.Node
+	where       []sql.Expression
+	groupBy     []sql.Expression
+	having      sql.Expression
+	orderBy     sql.SortFields
+	limit       sql.Expression
+	offset      sql.Expression
+}
+
+// formatSelect returns the SELECT statement of the query planned by |n|.
+func formatSelect(n sql.Node) (string, error) {
+	var b selectBlock
+	projected := false
+loop:
+	for {
+		switch node := n.(type) {
+		case *plan.Limit:
+			if b.limit != nil || b.orderBy != nil || projected || node.CalcFoundRows {
+				return "", ErrUnsupported.New(node)
+			}
+			b.limit, n = node.Limit, node.Child
+		case *plan.Offset:
+			if b.offset != nil || b.orderBy != nil || projected {
+				return "", ErrUnsupported.New(node)
+			}
+			b.offset, n = node.Offset, node.Child
+		case *plan.Sort:
+			if b.orderBy != nil {
+				return "", ErrUnsupported.New(node)
+			}
+			b.orderBy, n = node.SortFields, node.Child
+		case *plan.Distinct, *plan.OrderedDistinct:
+			if b.distinct || projected {
+				return "", ErrUnsupported.New(node)
+			}
+			b.distinct, n = true, node.Children()[0]
+		case *plan.Having:
+			if b.having != nil || projected {
+				return "", ErrUnsupported.New(node)
+			}
+			b.having, n = node.Cond, node.Child
+		case *plan.Project:
+			if projected {
+				break loop
+			}
+			b.projections, n, projected = node.Projections, node.Child, true
+		case *plan.GroupBy:
+			if projected {
+				break loop
+			}
+			b.projections, b.groupBy, n, projected = node.SelectedExprs, node.GroupByExprs, node.Child, true
+		case *plan.Filter:
+			b.where, n = append(b.where, node.Expression), node.Child
+		default:
+			break loop
+		}
+	}
+	if b.having != nil && b.groupBy == nil {
+		return "", ErrUnsupported.New(b.having)
+	}
+	b.from = n
+
+	var sb strings.Builder
+	sb.WriteString("select ")
+	if b.distinct {
+		sb.WriteString("distinct ")
+	}
+	if len(b.projections) == 0 {
+		sb.WriteString("*")
+	} else if err := writeExpressions(&sb, b.projections); err != nil {
+		return "", err
+	}
+
+	if !isDual(b.from) {
+		from, err := formatTableExpr(b.from)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" from ")
+		sb.WriteString(from)
+	}
+	if len(b.where) > 0 {
+		sb.WriteString(" where ")
+		// filters further down the plan are applied first
+		for i := len(b.where) - 1; i >= 0; i-- {
+			cond, err := FormatExpression(b.where[i])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(cond)
+			if i > 0 {
+				sb.WriteString(" and ")
+			}
+		}
+	}
+	if len(b.groupBy) > 0 {
+		sb.WriteString(" group by ")
+		if err := writeExpressions(&sb, b.groupBy); err != nil {
+			return "", err
+		}
+	}
+	if b.having != nil {
+		having, err := FormatExpression(b.having)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" having ")
+		sb.WriteString(having)
+	}
+	if err := writeOrderByAndLimit(&sb, b.orderBy, b.limit, b.offset); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// isDual returns whether |n| is the dual table of a query without a FROM clause.
+func isDual(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return plan.IsDualTable(n.Table)
+	case *plan.UnresolvedTable:
+		return n.Name() == plan.DualTableName
+	default:
+		return false
+	}
+}
+
+// formatUnion returns the UNION of the queries planned by the children of |n|.
+func formatUnion(n *plan.Union) (string, error) {
+	left, err := FormatNode(n.Left())
+	if err != nil {
+		return "", err
+	}
+	right, err := FormatNode(n.Right())
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	sb.WriteString("(" + left + ") union ")
+	if !n.Distinct {
+		sb.WriteString("all ")
+	}
+	sb.WriteString("(" + right + ")")
+	if err := writeOrderByAndLimit(&sb, n.SortFields, n.Limit, n.Offset); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// formatTableExpr returns the table reference of the FROM clause that reads the rows of |n|.
+func formatTableExpr(n sql.Node) (string, error) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		db := ""
+		if n.Database != nil {
+			db = n.Database.Name()
+		}
+		return qualifiedName(db, n.Name()), nil
+	case *plan.UnresolvedTable:
+		return qualifiedName(n.Database().Name(), n.Name()), nil
+	case *plan.IndexedTableAccess:
+		if n.IsStatic() {
+			return "", ErrUnsupported.New(n)
+		}
+		return formatTableExpr(n.ResolvedTable)
+	case *plan.HashLookup:
+		return formatTableExpr(n.Child)
+	case *plan.CachedResults:
+		return formatTableExpr(n.Child)
+	case *plan.Exchange:
+		return formatTableExpr(n.Child)
+	case *plan.TableAlias:
+		table, err := formatTableExpr(n.Child)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(table, "(") {
+			// the child is a filtered table, already aliased with its own name
+			table = table[:strings.LastIndex(table, " as ")]
+		}
+		return fmt.Sprintf("%s as %s", table, sql.QuoteIdentifier(n.Name())), nil
+	case *plan.SubqueryAlias:
+		query, err := FormatNode(n.Child)
+		if err != nil {
+			return "", err
+		}
+		alias := sql.QuoteIdentifier(n.Name())
+		if len(n.Columns) > 0 {
+			alias += " (" + strings.Join(sql.QuoteIdentifiers(n.Columns), ", ") + ")"
+		}
+		lateral := ""
+		if n.IsLateral {
+			lateral = "lateral "
+		}
+		return fmt.Sprintf("%s(%s) as %s", lateral, query, alias), nil
+	case *plan.Filter:
+		// filters pushed down to a table are rendered as a derived table with the name of the table
+		name, ok := n.Child.(sql.Nameable)
+		if !ok {
+			return "", ErrUnsupported.New(n)
+		}
+		query, err := formatSelect(n)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s) as %s", query, sql.QuoteIdentifier(name.Name())), nil
+	case *plan.JoinNode:
+		return formatJoin(n)
+	default:
+		return "", ErrUnsupported.New(n)
+	}
+}
+
+// formatJoin returns the join of the table references of the children of |n|.
+func formatJoin(n *plan.JoinNode) (string, error) {
+	var keyword string
+	switch {
+	case n.Op.IsCross():
+		keyword = "cross join"
+	case n.Op.IsFullOuter():
+		keyword = "full outer join"
+	case n.Op.IsLeftOuter():
+		keyword = "left join"
+	case n.Op.IsRightOuter():
+		keyword = "right join"
+	case n.Op.IsInner() && !n.Op.IsLateral() && !n.Op.IsSemi() && !n.Op.IsAnti():
+		keyword = "join"
+	default:
+		return "", ErrUnsupported.New(n)
+	}
+
+	left, err := formatTableExpr(n.Left())
+	if err != nil {
+		return "", err
+	}
+	right, err := formatTableExpr(n.Right())
+	if err != nil {
+		return "", err
+	}
+	if _, ok := n.Right().(*plan.JoinNode); ok {
+		right = "(" + right + ")"
+	}
+	join := fmt.Sprintf("%s %s %s", left, keyword, right)
+	if n.Filter == nil || n.Op.IsCross() {
+		return join, nil
+	}
+	cond, err := FormatExpression(n.Filter)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s on %s", join, cond), nil
+}
+
+// writeOrderByAndLimit writes the ORDER BY and LIMIT clauses of a statement to |sb|.
+func writeOrderByAndLimit(sb *strings.Builder, orderBy sql.SortFields, limit, offset sql.Expression) error {
+	if len(orderBy) > 0 {
+		sb.WriteString(" order by ")
+		for i, field := range orderBy {
+			col, err := FormatExpression(field.Column)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(col)
+			if field.Order == sql.Descending {
+				sb.WriteString(" desc")
+			} else {
+				sb.WriteString(" asc")
+			}
+		}
+	}
+	if limit != nil {
+		l, err := FormatExpression(limit)
+		if err != nil {
+			return err
+		}
+		sb.WriteString(" limit ")
+		sb.WriteString(l)
+	}
+	if offset != nil {
+		o, err := FormatExpression(offset)
+		if err != nil {
+			return err
+		}
+		if limit == nil {
+			// MySQL doesn't have an OFFSET clause without a LIMIT
+			sb.WriteString(" limit 18446744073709551615")
+		}
+		sb.WriteString(" offset ")
+		sb.WriteString(o)
+	}
+	return nil
+}
+
+func writeExpressions(sb *strings.Builder, exprs []sql.Expression) error {
+	strs, err := formatExpressions(exprs...)
+	if err != nil {
+		return err
+	}
+	sb.WriteString(strings.Join(strs, ", "))
+	return nil
+}
+
+func formatExpressions(exprs ...sql.Expression) ([]string, error) {
+	strs := make([]string, len(exprs))
+	for i, e := range exprs {
+		var err error
+		if strs[i], err = FormatExpression(e); err != nil {
+			return nil, err
+		}
+	}
+	return strs, nil
+}
+
+func formatBinary(left sql.Expression, op string, right sql.Expression) (string, error) {
+	exprs, err := formatExpressions(left, right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", exprs[0], op, exprs[1]), nil
+}
+
+func formatFunction(name string, args []sql.Expression) (string, error) {
+	strs, err := formatExpressions(args...)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s)", strings.ToLower(name), strings.Join(strs, ", ")), nil
+}
+
+func formatCase(e *expression.Case) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("(case")
+	write := func(keyword string, e sql.Expression) error {
+		s, err := FormatExpression(e)
+		if err != nil {
+			return err
+		}
+		sb.WriteString(" " + keyword + " " + s)
+		return nil
+	}
+	if e.Expr != nil {
+		if err := write("", e.Expr); err != nil {
+			return "", err
+		}
+	}
+	for _, branch := range e.Branches {
+		if err := write("when", branch.Cond); err != nil {
+			return "", err
+		}
+		if err := write("then", branch.Value); err != nil {
+			return "", err
+		}
+	}
+	if e.Else != nil {
+		if err := write("else", e.Else); err != nil {
+			return "", err
+		}
+	}
+	sb.WriteString(" end)")
+	return strings.ReplaceAll(sb.String(), "  ", " "), nil
+}
+
+// formatLiteral returns the SQL text of the literal given, with binary strings written in hexadecimal.
+func formatLiteral(lit *expression.Literal) string {
+	switch v := lit.Value().(type) {
+	case []byte:
+		return fmt.Sprintf("X'%X'", v)
+	case string:
+		if types.IsBinaryType(lit.Type()) {
+			return fmt.Sprintf("X'%X'", v)
+		}
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	}
+	return lit.String()
+}
+
+// qualifiedName returns the identifier |name| quoted, qualified by |qualifier| if it's not empty.
+func qualifiedName(qualifier, name string) string {
+	if qualifier == "" {
+		return sql.QuoteIdentifier(name)
+	}
+	return sql.QuoteIdentifier(qualifier) + "." + sql.QuoteIdentifier(name)
+}
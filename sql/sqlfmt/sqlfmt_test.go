@@ -0,0 +1,129 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlfmt_test
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/sqlfmt"
+)
+
+func TestFormatAST(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    `SELECT "a", 'b''c' FROM T WHERE x = "y"`,
+			expected: "select 'a' as a, 'b\\'c' as `b''c` from T where x = 'y'",
+		},
+		{
+			query:    "select `select`, `a b` from `t`",
+			expected: "select `select`, `a b` from t",
+		},
+		{
+			query:    "select cast(a as signed), convert(b, char(10)) from t",
+			expected: "select cast(a as signed), convert(b, char(10)) from t",
+		},
+		{
+			query:    `select * from json_table('[{"a":1}]', "$[*]" columns(id for ordinality, a int path "$.a" default '0' on empty, nested path '$.b[*]' columns(b text exists path '$'))) as jt`,
+			expected: `select * from json_table('[{\"a\":1}]', '$[*]' columns(id for ordinality, a int path '$.a' default '0' on empty, nested path '$.b[*]' columns(b text exists path '$'))) as jt`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			formatted := sqlfmt.FormatAST(stmt)
+			require.Equal(t, tc.expected, formatted)
+
+			// the canonical text parses to the same statement
+			reparsed, err := sqlparser.Parse(formatted)
+			require.NoError(t, err)
+			require.Equal(t, formatted, sqlfmt.FormatAST(reparsed))
+		})
+	}
+}
+
+func TestFormatNode(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "select 1",
+			expected: "select 1",
+		},
+		{
+			query:    `select a, "b" as c from db.t where a > 1 and b is null`,
+			expected: "select `a`, 'b' as `c` from `db`.`t` where ((`a` > 1) and (`b` is null))",
+		},
+		{
+			query:    "select distinct t.a from t order by a desc, b limit 10 offset 2",
+			expected: "select distinct `t`.`a` from `t` order by `a` desc, `b` asc limit 10 offset 2",
+		},
+		{
+			query:    "select a, count(*), sum(b + 1) from t group by a having count(*) > 1",
+			expected: "select `a`, count(*) as `count(*)`, sum((`b` + 1)) as `sum(b + 1)` from `t` group by `a` having (count(*) > 1)",
+		},
+		{
+			query:    "select x.a from t as x join u on x.a = u.a left join (select 1 as a) as s on s.a = u.a cross join v",
+			expected: "select `x`.`a` from `t` as `x` join `u` on (`x`.`a` = `u`.`a`) left join (select 1 as `a`) as `s` on (`s`.`a` = `u`.`a`) cross join `v`",
+		},
+		{
+			query:    "select a from t where a in (1, 2) and b between 1 and 3 and c like 'x%' and not d",
+			expected: "select `a` from `t` where ((((`a` in (1, 2)) and (`b` between 1 and 3)) and (`c` like 'x%')) and (not `d`))",
+		},
+		{
+			query:    "select case when a = 1 then 'one' else 'other' end, cast(b as char(3)), (select max(c) from u) from t",
+			expected: "select (case when (`a` = 1) then 'one' else 'other' end) as `case when a = 1 then 'one' else 'other' end`, convert(`b`, char(3)) as `cast(b as char(3))`, (select max(`c`) as `max(c)` from `u`) as `(select max(c) from u)` from `t`",
+		},
+		{
+			query:    "select a from t union all select b from u order by 1 limit 1",
+			expected: "(select `a` from `t`) union all (select `b` from `u`) order by 1 asc limit 1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			n, err := parse.Parse(ctx, tc.query)
+			require.NoError(t, err)
+			formatted, err := sqlfmt.FormatNode(n)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, formatted)
+
+			// the text is formatted the same once it's parsed again
+			n, err = parse.Parse(ctx, formatted)
+			require.NoError(t, err)
+			reformatted, err := sqlfmt.FormatNode(n)
+			require.NoError(t, err)
+			require.Equal(t, formatted, reformatted)
+		})
+	}
+}
+
+func TestFormatNodeUnsupported(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	n, err := parse.Parse(ctx, "select a, row_number() over (order by a) from t")
+	require.NoError(t, err)
+	_, err = sqlfmt.FormatNode(n)
+	require.Error(t, err)
+	require.True(t, sqlfmt.ErrUnsupported.Is(err))
+}
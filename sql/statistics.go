@@ -86,3 +86,33 @@ func (ts *TableStatistics) Histogram(colName string) (*Histogram, error) {
 	}
 	return &Histogram{}, fmt.Errorf("column %s not found", colName)
 }
+
+// IndexCardinality returns an estimate of the number of distinct values of the first |prefixLen| columns of an index
+// on |columns|, which is the cardinality reported for the index by SHOW INDEX. |hist| and |rowCount| are the
+// statistics of the table, and |hist| may be nil if the table wasn't analyzed. A prefix has at least as many distinct
+// values as any of its columns, and at most one per row, which it has when it covers all the columns of a unique index.
+func IndexCardinality(hist HistogramMap, rowCount uint64, columns []string, prefixLen int, unique bool) int64 {
+	if unique && prefixLen >= len(columns) {
+		return int64(rowCount)
+	}
+
+	var cardinality uint64
+	for _, col := range columns[:prefixLen] {
+		h, ok := hist[col]
+		// the values of some types aren't counted, which leaves histograms with only nulls
+		if !ok || (h.Count == 0 && h.NullCount < rowCount) {
+			continue
+		}
+		distinct := h.DistinctCount
+		if h.NullCount > 0 {
+			distinct++
+		}
+		if distinct > cardinality {
+			cardinality = distinct
+		}
+	}
+	if cardinality > rowCount {
+		cardinality = rowCount
+	}
+	return int64(cardinality)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+var StatusVariables StatusVariableRegistry
+
+// StatusVariableRegistry is a registry of status variables, the counters the server maintains about its own operation.
+// The registry holds the global value of each variable. Each session gets its own counters for the variables with
+// session scope via the NewSessionMap() method.
+type StatusVariableRegistry interface {
+	// AddStatusVariables adds the given status variables to this registry
+	AddStatusVariables(statusVars []StatusVariable)
+	// NewSessionMap returns a map of zeroed counters for the variables with session scope, to be used by a session
+	NewSessionMap() map[string]*StatusVarValue
+	// GetGlobal returns the global value of the status variable with the given name
+	GetGlobal(name string) (StatusVariable, interface{}, bool)
+	// IncrementGlobal adds the given amount to the global value of the status variable with the given name
+	IncrementGlobal(name string, val int64)
+	// GetAllGlobalVariables returns a copy of all global status variable values.
+	GetAllGlobalVariables() map[string]interface{}
+}
+
+// StatusVariable represents a status variable.
+type StatusVariable struct {
+	// Name is the name of the status variable, as displayed by SHOW STATUS.
+	Name string
+	// Scope defines the scope of the status variable, which is either Global, Session, or Both. Variables with session
+	// scope are counted separately for every session, and variables with both scopes are also counted globally.
+	Scope SystemVariableScope
+	// ValueFunction defines an optional function that is executed to provide the global value of this status variable
+	// whenever it is requested, instead of a counter.
+	ValueFunction func() (interface{}, error)
+}
+
+// StatusVarValue is the value of a status variable, which is a counter that may be incremented concurrently.
+type StatusVarValue struct {
+	Var StatusVariable
+	val int64
+}
+
+// NewStatusVarValue returns a new counter for the status variable given, starting at zero.
+func NewStatusVarValue(statusVar StatusVariable) *StatusVarValue {
+	return &StatusVarValue{Var: statusVar}
+}
+
+// Value returns the current value of this counter.
+func (v *StatusVarValue) Value() int64 {
+	return atomic.LoadInt64(&v.val)
+}
+
+// Increment adds the given amount to this counter. Counters for values that go up and down, such as the number of
+// connected clients, are decremented with negative amounts.
+func (v *StatusVarValue) Increment(val int64) {
+	atomic.AddInt64(&v.val, val)
+}
+
+// Reset sets this counter back to zero.
+func (v *StatusVarValue) Reset() {
+	atomic.StoreInt64(&v.val, 0)
+}
+
+// IncrementStatusVariable adds the given amount to the status variable with the given name, both for the session of
+// the context given and globally, as the scope of the variable requires.
+func IncrementStatusVariable(ctx *Context, name string, val int64) {
+	if ctx != nil && ctx.Session != nil {
+		ctx.Session.IncrementStatusVariable(ctx, name, val)
+	}
+	if StatusVariables != nil {
+		StatusVariables.IncrementGlobal(name, val)
+	}
+}
+
+// StatusVariableRows returns a row with the name and value of each status variable, sorted by name. If |global| is
+// true, the rows hold the global values of the variables counted globally, as shown by SHOW GLOBAL STATUS. Otherwise
+// the rows hold the values for the session of the context given, falling back to the global value for the variables
+// only counted globally, as shown by SHOW SESSION STATUS.
+func StatusVariableRows(ctx *Context, global bool) []Row {
+	if StatusVariables == nil {
+		return nil
+	}
+	vals := StatusVariables.GetAllGlobalVariables()
+	if !global && ctx.Session != nil {
+		for name, val := range ctx.Session.GetAllStatusVariables(ctx) {
+			vals[name] = val
+		}
+	}
+
+	names := make([]string, 0, len(vals))
+	for name := range vals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rows := make([]Row, 0, len(names))
+	for _, name := range names {
+		statusVar, _, ok := StatusVariables.GetGlobal(name)
+		displayName := statusVar.Name
+		if !ok {
+			displayName = name
+		}
+		rows = append(rows, Row{displayName, vals[name]})
+	}
+	return rows
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// TableFunctionRegistry is a TableFunctionProvider for the table functions registered to it, which integrators can
+// embed in their own providers or register functions to through the analyzer's Catalog.
+type TableFunctionRegistry struct {
+	mu        sync.RWMutex
+	functions map[string]TableFunction
+}
+
+var _ TableFunctionProvider = (*TableFunctionRegistry)(nil)
+
+// NewTableFunctionRegistry returns a new registry with the table functions given.
+func NewTableFunctionRegistry(fns ...TableFunction) *TableFunctionRegistry {
+	r := &TableFunctionRegistry{functions: make(map[string]TableFunction)}
+	r.Register(fns...)
+	return r
+}
+
+// Register adds the table functions given to this registry, replacing any already registered with the same
+// case-insensitive name.
+func (r *TableFunctionRegistry) Register(fns ...TableFunction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, fn := range fns {
+		r.functions[strings.ToLower(fn.Name())] = fn
+	}
+}
+
+// TableFunction implements the interface TableFunctionProvider.
+func (r *TableFunctionRegistry) TableFunction(_ *Context, name string) (TableFunction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if fn, ok := r.functions[strings.ToLower(name)]; ok {
+		return fn, nil
+	}
+	return nil, ErrTableFunctionNotFound.New(name)
+}
@@ -26,7 +26,7 @@ type TableRowIter struct {
 	rows       RowIter
 }
 
-var _ RowIter = (*TableRowIter)(nil)
+var _ RowBatchIter = (*TableRowIter)(nil)
 
 // NewTableRowIter returns a new iterator over the rows in the partitions of the table given.
 func NewTableRowIter(ctx *Context, table Table, partitions PartitionIter) *TableRowIter {
@@ -38,16 +38,62 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 		return nil, ctx.Err()
 	}
 
+	if err := i.loadPartitionRows(ctx); err != nil {
+		return nil, err
+	}
+
+	row, err := i.rows.Next(ctx)
+	if err != nil && err == io.EOF {
+		if err = i.closePartitionRows(ctx); err != nil {
+			return nil, err
+		}
+		row, err = i.Next(ctx)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+	return row, err
+}
+
+// NextBatch implements RowBatchIter. The rows of a batch always come from a single partition.
+func (i *TableRowIter) NextBatch(ctx *Context, batch []Row) (int, error) {
+	for {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		if err := i.loadPartitionRows(ctx); err != nil {
+			return 0, err
+		}
+
+		n, err := NextRowBatch(ctx, i.rows, batch)
+		if err == io.EOF {
+			if err = i.closePartitionRows(ctx); err != nil {
+				return n, err
+			}
+			if n == 0 {
+				continue
+			}
+		}
+		return n, err
+	}
+}
+
+// loadPartitionRows opens the rows of the next partition when there is no partition being iterated, returning io.EOF
+// when there are no partitions left.
+func (i *TableRowIter) loadPartitionRows(ctx *Context) error {
 	if i.partition == nil {
 		partition, err := i.partitions.Next(ctx)
 		if err != nil {
 			if err == io.EOF {
 				if e := i.partitions.Close(ctx); e != nil {
-					return nil, e
+					return e
 				}
 			}
 
-			return nil, err
+			return err
 		}
 
 		i.partition = partition
@@ -56,28 +102,20 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 	if i.rows == nil {
 		rows, err := i.table.PartitionRows(ctx, i.partition)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		i.rows = rows
 	}
+	return nil
+}
 
-	row, err := i.rows.Next(ctx)
-	if err != nil && err == io.EOF {
-		if err = i.rows.Close(ctx); err != nil {
-			return nil, err
-		}
-
-		i.partition = nil
-		i.rows = nil
-		row, err = i.Next(ctx)
-	}
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-	}
-	return row, err
+// closePartitionRows closes the rows of the partition being iterated, so that the next partition is iterated next.
+func (i *TableRowIter) closePartitionRows(ctx *Context) error {
+	err := i.rows.Close(ctx)
+	i.partition = nil
+	i.rows = nil
+	return err
 }
 
 func (i *TableRowIter) Close(ctx *Context) error {
@@ -41,6 +41,17 @@ type TableFunction interface {
 	NewInstance(ctx *Context, db Database, args []Expression) (Node, error)
 }
 
+// LateralTableFunction is a TableFunction whose arguments can reference the columns of the tables before it in a FROM
+// clause or of an outer query, or be bind variables. NewInstance is given such arguments unevaluated, and the instance
+// must return them from Expressions and evaluate them with the row given to its RowIter, which is called again for
+// each row of the outer tables.
+type LateralTableFunction interface {
+	TableFunction
+	// AcceptsLateralArguments returns whether the function can be called with arguments that can't be evaluated
+	// until its rows are iterated.
+	AcceptsLateralArguments() bool
+}
+
 // TemporaryTable allows tables to declare that they are temporary (created by CREATE TEMPORARY TABLE).
 // Only used for validation of certain DDL operations -- in almost all respects TemporaryTables are indistinguishable
 // from persisted tables to the engine.
@@ -69,6 +80,95 @@ type FilteredTable interface {
 	WithFilters(ctx *Context, filters []Expression) Table
 }
 
+// SortableTable is a table that can return its rows sorted, so that the sort that would otherwise be done by a separate
+// Sort node is done by the table itself, for example by the remote server of a federated table.
+type SortableTable interface {
+	Table
+	// SortFields returns the sort fields that have been applied to this table, or nil if none have been.
+	SortFields() SortFields
+	// CanSort returns whether this table can return its rows sorted by the sort fields given, which reference the
+	// columns of the table by their position in its schema.
+	CanSort(sortFields SortFields) bool
+	// WithSortFields returns a table whose rows are sorted by the sort fields given. Rows must be in order across all
+	// the partitions of the table, which are read one after another in the order they are returned.
+	WithSortFields(sortFields SortFields) Table
+}
+
+// LimitedTable is a table that can skip and limit the rows it returns, so that the LIMIT and OFFSET that would
+// otherwise be applied by separate Limit and Offset nodes are applied by the table itself, and the rows it doesn't
+// return are never read.
+type LimitedTable interface {
+	Table
+	// Limit returns the limit and offset that have been applied to this table, and whether any have been.
+	Limit() (limit, offset uint64, ok bool)
+	// WithLimit returns a table that skips the first |offset| rows it would otherwise return, after sorting them when
+	// it's also a SortableTable, and returns at most |limit| of the rest. Rows are counted across all the partitions of
+	// the table, which are read one after another in the order they are returned.
+	WithLimit(limit, offset uint64) Table
+}
+
+// TableSampleMethod is the method a TABLESAMPLE clause samples the rows of a table with.
+type TableSampleMethod byte
+
+const (
+	// TableSampleBernoulli samples each row of a table independently of the others.
+	TableSampleBernoulli TableSampleMethod = iota
+	// TableSampleSystem samples blocks of rows, such as the partitions of a table, returning all the rows of the blocks
+	// sampled. Tables that can't sample their blocks are sampled row by row, as with TableSampleBernoulli.
+	TableSampleSystem
+)
+
+func (m TableSampleMethod) String() string {
+	switch m {
+	case TableSampleBernoulli:
+		return "BERNOULLI"
+	case TableSampleSystem:
+		return "SYSTEM"
+	default:
+		return fmt.Sprintf("TableSampleMethod(%d)", m)
+	}
+}
+
+// TableSample is a random sample of the rows of a table, as given by a TABLESAMPLE clause.
+type TableSample struct {
+	Method TableSampleMethod
+	// Percent is the probability, from 0 to 100, that each row or block of rows is sampled.
+	Percent float64
+	// Repeatable is whether the sample was given a Seed, in which case it must return the same rows each time the
+	// unchanged table is sampled with the same seed.
+	Repeatable bool
+	Seed       int64
+}
+
+func (s TableSample) String() string {
+	if s.Repeatable {
+		return fmt.Sprintf("%s(%v) REPEATABLE(%d)", s.Method, s.Percent, s.Seed)
+	}
+	return fmt.Sprintf("%s(%v)", s.Method, s.Percent)
+}
+
+// SampledTable is a table that can return a random sample of its rows, so that the sampling that would otherwise be
+// done by a separate TableSample node is done by the table itself, and the blocks of rows not sampled are never read.
+type SampledTable interface {
+	Table
+	// Sample returns the sample that has been applied to this table, and whether one has been.
+	Sample() (sample TableSample, ok bool)
+	// CanSample returns whether this table can sample its rows with the method given.
+	CanSample(method TableSampleMethod) bool
+	// WithSample returns a table that returns only the rows of the sample given.
+	WithSample(sample TableSample) Table
+}
+
+// VersionedTable is a table that can tell when its data changes, so that the results of queries reading it can be
+// cached by the engine until it does.
+type VersionedTable interface {
+	Table
+	// DataVersion returns an opaque version of the rows and schema of this table, such as a hash or a counter, which
+	// must change whenever either of them changes. A table that replaces another with the same name, as when it's
+	// dropped and created again, must not return the versions of the table it replaces.
+	DataVersion(ctx *Context) (string, error)
+}
+
 // ProjectedTable is a table that can return only a subset of its columns from RowIter. This provides a very large
 // efficiency gain during table scans. Tables that implement this interface must return only the projected columns
 // in future calls to Schema.
@@ -106,6 +206,64 @@ type IndexedTable interface {
 	LookupPartitions(*Context, IndexLookup) (PartitionIter, error)
 }
 
+// IndexConditionPushdownTable is an IndexedTable that can evaluate filters on the columns of the index of its lookup
+// while scanning the index, so that rows that don't match them are never materialized. This is MySQL's index
+// condition pushdown. The filters reference the columns of the table by their position in its schema.
+type IndexConditionPushdownTable interface {
+	IndexedTable
+	// HandledIndexConditions returns the subset of the filter expressions given, which only reference columns of the
+	// index given, that this table can evaluate during lookups on that index.
+	HandledIndexConditions(index Index, filters []Expression) []Expression
+	// WithIndexConditions returns a table that only returns the rows of its lookups that match all the filter
+	// expressions given.
+	WithIndexConditions(filters []Expression) IndexedTable
+	// IndexConditions returns the filter expressions that have been pushed down to this table.
+	IndexConditions() []Expression
+}
+
+// IndexOffsetTable is an IndexedTable that can skip the first rows of its lookups while scanning the index, so that
+// the OFFSET of a query whose ORDER BY matches the index is applied by the table itself. The rows skipped are only read
+// from the index, and the rest of their columns are never materialized, which makes deep pagination cheap.
+type IndexOffsetTable interface {
+	IndexedTable
+	// WithIndexOffset returns a table that skips the first |offset| rows of each of its lookups, after any index
+	// conditions are applied. Rows are counted across all the partitions of a lookup, which are read one after another
+	// in the order they are returned.
+	WithIndexOffset(offset uint64) IndexedTable
+	// IndexOffset returns the offset that has been applied to this table, or 0 if none has been.
+	IndexOffset() uint64
+}
+
+// BatchLookupTable is an IndexedTable that can make many lookups on the same index at once, as a multi-range read.
+// Lookup joins whose inner side is such a table look up the rows matching a batch of rows of their outer side with a
+// single call, rather than one per row, which saves the per-lookup overhead of remote or disk-backed tables.
+type BatchLookupTable interface {
+	IndexedTable
+	// BatchLookup returns the rows matched by any of the |lookups| given, which are all on the same index and never
+	// spatial, along with the positions in |lookups| of the lookups each row matches. The rows of each lookup must be
+	// returned in the order LookupPartitions would return them.
+	BatchLookup(ctx *Context, lookups []IndexLookup) (BatchLookupIter, error)
+}
+
+// RuntimeFilterTable is a table that can skip the rows that don't match filters computed while a query runs, such as
+// the bloom filters of the join keys of the build side of hash joins, which are pushed down to the table scans of
+// their probe side.
+type RuntimeFilterTable interface {
+	Table
+	// WithRuntimeFilter returns a table that may skip the rows for which the |filter| given doesn't evaluate to true.
+	// The filter references the columns of the table by their position in its schema. Skipping rows is only an
+	// optimization, as the rows returned are still filtered by the query.
+	WithRuntimeFilter(filter Expression) Table
+}
+
+// BatchLookupIter is an iterator of the rows returned by BatchLookupTable.BatchLookup.
+type BatchLookupIter interface {
+	// Next returns the next row, and the positions of the lookups it matches. Returns io.EOF when there are no more
+	// rows.
+	Next(ctx *Context) (Row, []int, error)
+	Closer
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -149,6 +307,98 @@ type ForeignKeyEditor interface {
 	IndexAddressable
 }
 
+// PartitionDefinitionTable is a table created with a PARTITION BY clause. Its user-defined partitions are unrelated to
+// the partitions returned by Table.Partitions.
+type PartitionDefinitionTable interface {
+	Table
+	// PartitionDefinition returns the partitioning of this table, as the SQL string of its PARTITION BY clause.
+	PartitionDefinition() string
+}
+
+// ShardedTable is a table whose rows are horizontally sharded across backends by the values of its shard key columns,
+// with a partition for each shard. The analyzer prunes the shards that can't hold the rows matched by the equalities
+// on the shard key in the filters on the table, and the engine fans the rows written to the table out to the editors
+// of their shards, whose changes it commits in two phases when they implement ShardPreparer.
+type ShardedTable interface {
+	Table
+	// ShardKey returns the names of the columns whose values determine the shard of a row.
+	ShardKey() []string
+	// Shard returns the name of the shard holding the rows whose shard key columns have the values given, in the
+	// order of ShardKey. Values that compare equal, like strings differing in case in a case-insensitive collation,
+	// must be in the same shard.
+	Shard(ctx *Context, key Row) (string, error)
+	// Shards returns the names of the shards whose partitions the table returns.
+	Shards() []string
+	// WithShards returns the table with only the partitions of the shards named, which are all the shards its rows
+	// may be in.
+	WithShards(shards []string) Table
+	// ShardEditor returns the editor of the rows of the shard named. The engine begins, completes and discards the
+	// statements on the editors of the shards that a statement writes, and closes them once it's done writing.
+	ShardEditor(ctx *Context, shard string) (TableEditor, error)
+}
+
+// RemoteTable is a table whose rows are read from a remote source, such as another server. The projections, filters,
+// sort and limit pushed down to the table are evaluated by the remote source, which plans show them grouped under,
+// while the nodes above the table are evaluated by the engine.
+type RemoteTable interface {
+	Table
+	// RemoteSource describes the source that the rows of the table are read from, such as the name of the remote
+	// table.
+	RemoteSource() string
+	// RemoteQuery returns the query sent to the remote source to read the rows of the table, or an empty string if
+	// it isn't read with a query.
+	RemoteQuery() string
+}
+
+// ShardPreparer is the editor of a shard of a ShardedTable that takes part in a two-phase commit of the changes of a
+// statement. Once a statement writing several shards is done, the engine prepares the changes on all of them before
+// completing the statement on any, and discards the changes on all of them if any fails to prepare.
+type ShardPreparer interface {
+	TableEditor
+	// PrepareStatement prepares to complete the changes made by the current statement, so that StatementComplete
+	// can't fail.
+	PrepareStatement(ctx *Context) error
+}
+
+// SecondaryEngineTable is a table with a secondary engine, given by the SECONDARY_ENGINE table option.
+type SecondaryEngineTable interface {
+	Table
+	// SecondaryEngine returns the name of the secondary engine of this table, or an empty string if it has none.
+	SecondaryEngine() string
+	// SecondaryEngineAttribute returns the SECONDARY_ENGINE_ATTRIBUTE table option, or an empty string if it's unset.
+	SecondaryEngineAttribute() string
+}
+
+// Names of the table options in TableOptions that the engine itself makes use of.
+const (
+	TableOptionEngine                   = "ENGINE"
+	TableOptionRowFormat                = "ROW_FORMAT"
+	TableOptionComment                  = "COMMENT"
+	TableOptionSecondaryEngine          = "SECONDARY_ENGINE"
+	TableOptionSecondaryEngineAttribute = "SECONDARY_ENGINE_ATTRIBUTE"
+)
+
+// TableOptions are the table options of a CREATE TABLE statement, such as ENGINE, ROW_FORMAT, COMMENT and
+// SECONDARY_ENGINE, keyed by the upper case name of each option. Values are unquoted. The character set, collation and
+// AUTO_INCREMENT options are never part of them, since the engine handles those itself.
+type TableOptions map[string]string
+
+// TableOptionsTable is a table that keeps the table options it was created with, which SHOW CREATE TABLE shows.
+type TableOptionsTable interface {
+	Table
+	// TableOptions returns the table options of this table, which may be nil.
+	TableOptions() TableOptions
+}
+
+// TableOptionsAlterableTable is a table that can keep table options. Once a table is created, the engine gives the
+// options of its CREATE TABLE statement to SetTableOptions. Tables that don't implement this interface silently ignore
+// their table options.
+type TableOptionsAlterableTable interface {
+	TableOptionsTable
+	// SetTableOptions replaces the table options of this table with the ones given.
+	SetTableOptions(ctx *Context, options TableOptions) error
+}
+
 // CheckTable is a table that declares check constraints.
 type CheckTable interface {
 	Table
@@ -156,6 +406,51 @@ type CheckTable interface {
 	GetChecks(ctx *Context) ([]CheckDefinition, error)
 }
 
+// RowAccessPolicyTable is a table that restricts the rows each user may access, like the row-level security
+// policies of other databases. The analyzer filters the rows read from the table by its policies, and rejects the rows
+// written to it that don't satisfy them, so that each user sees and writes only their own rows.
+type RowAccessPolicyTable interface {
+	Table
+	// RowAccessPolicies returns the predicates that the rows accessed by the user of the context given must satisfy,
+	// as SQL expressions on the columns of the table like the ones of check constraints, e.g. "tenant_id = 42". A
+	// user without policies may access all the rows.
+	RowAccessPolicies(ctx *Context) ([]string, error)
+}
+
+// ColumnMaskingTable is a table with columns of sensitive data, which are masked for the users without the UNMASK
+// privilege. The analyzer replaces the values of those columns with their masks whenever the table is read by such a
+// user, so that the unmasked values can't be seen or inferred through filters, joins or functions.
+type ColumnMaskingTable interface {
+	Table
+	// ColumnMasks returns the masks of the masked columns of the table by column name. A mask is an SQL expression on
+	// the columns of the table like a column default, e.g. "CONCAT('XXX-XX-', RIGHT(ssn, 4))", whose value replaces
+	// the one of the column.
+	ColumnMasks(ctx *Context) (map[string]string, error)
+}
+
+// ChecksumTable is a table that maintains a checksum of its contents, returned by CHECKSUM TABLE instead of computing
+// one from its rows.
+type ChecksumTable interface {
+	Table
+	// Checksum returns the checksum of the rows of this table, which must not depend on their order.
+	Checksum(ctx *Context) (uint64, error)
+}
+
+// ConsistencyCheckTable is a table that can verify the consistency of its rows and indexes for CHECK TABLE.
+type ConsistencyCheckTable interface {
+	Table
+	// CheckConsistency returns a description of each problem found in the table, or none if it's consistent.
+	CheckConsistency(ctx *Context) ([]string, error)
+}
+
+// OptimizableTable is a table that can rebuild its storage for OPTIMIZE TABLE, e.g. to reclaim unused space or
+// defragment its indexes.
+type OptimizableTable interface {
+	Table
+	// Optimize rebuilds the storage of this table without changing its rows.
+	Optimize(ctx *Context) error
+}
+
 // CheckAlterableTable represents a table that supports check constraints.
 type CheckAlterableTable interface {
 	Table
@@ -363,6 +658,34 @@ type AlterableTable interface {
 	ModifyColumn(ctx *Context, columnName string, column *Column, order *ColumnOrder) error
 }
 
+// AlterAlgorithmTable is a table that declares which algorithm it uses for each kind of ALTER TABLE operation. The
+// engine uses this to honor the ALGORITHM and LOCK clauses of ALTER TABLE statements.
+type AlterAlgorithmTable interface {
+	Table
+
+	// AlterAlgorithm returns the cheapest algorithm this table can use to perform the operation given. Returning
+	// AlterAlgorithm_Copy for a column change means the table cannot make the change itself, and the engine will
+	// instead copy every row into a new table with the altered schema, then replace this table with the new one. The
+	// copy keeps the table's indexes, check constraints, table options and AUTO_INCREMENT value, but the engine can't
+	// copy foreign keys or full-text indexes, so tables that have them must be able to make the change themselves.
+	AlterAlgorithm(ctx *Context, op AlterOperation) AlterAlgorithm
+}
+
+// AtomicAlterableTable is a table that can apply the clauses of a multi-clause ALTER TABLE statement as a single schema
+// change. Tables that don't implement this interface have each clause applied in turn, so a clause that fails can leave
+// the changes of earlier clauses in place.
+type AtomicAlterableTable interface {
+	Table
+
+	// BeginAlter is called before the first clause of an ALTER TABLE statement is applied.
+	BeginAlter(ctx *Context) error
+	// CommitAlter is called once every clause of the statement has been applied.
+	CommitAlter(ctx *Context) error
+	// RollbackAlter is called instead of CommitAlter when a clause fails, and must return the table to the state it was
+	// in when BeginAlter was called.
+	RollbackAlter(ctx *Context) error
+}
+
 // UnresolvedTable is a Table that is either unresolved or deferred for until an asOf resolution.
 // Used by the analyzer during planning, and is not expected to be implemented by integrators.
 type UnresolvedTable interface {
@@ -0,0 +1,235 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
+	gmstime "github.com/dolthub/go-mysql-server/internal/time"
+)
+
+// TIMESTAMP values are stored in tables in UTC, and are presented in the time zone of the session, given by the
+// time_zone system variable. Within the engine, like DATETIME values, they're time.Time values whose UTC wall clock is
+// the wall clock of the session's time zone. Rows are converted when they're read from and written to tables, so that
+// a TIMESTAMP value denotes the same instant across sessions with different time zones. DATETIME values are stored as
+// they are.
+
+// zeroTimestamp is the zero value of TIMESTAMP, 0000-00-00 00:00:00, which is never converted.
+var zeroTimestamp = time.Unix(-62167219200, 0).UTC()
+
+// timestampLocations caches the locations of named time zones, which are loaded from the time zone database.
+var timestampLocations sync.Map
+
+// TimestampLocation returns the location of the session's time zone, which TIMESTAMP values are converted to when
+// they're read from tables and from when they're written to tables. Returns nil when the time zone is UTC, or can't be
+// resolved, in which case no conversion is needed.
+func TimestampLocation(ctx *Context) *time.Location {
+	if ctx == nil || ctx.Session == nil {
+		return nil
+	}
+	val, err := ctx.GetSessionVariable(ctx, "time_zone")
+	if err != nil {
+		return nil
+	}
+	tz, ok := val.(string)
+	if !ok {
+		return nil
+	}
+	loc := locationOf(tz)
+	if loc == nil || isUTC(loc) {
+		return nil
+	}
+	return loc
+}
+
+// locationOf returns the location of a time_zone value, which is SYSTEM, an offset like +08:00, or a named zone.
+func locationOf(tz string) *time.Location {
+	if strings.EqualFold(tz, "SYSTEM") {
+		return time.Local
+	}
+	if d, err := gmstime.MySQLOffsetToDuration(tz); err == nil {
+		if d == 0 {
+			return time.UTC
+		}
+		return time.FixedZone(tz, int(d.Seconds()))
+	}
+	if loc, ok := timestampLocations.Load(tz); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil
+	}
+	timestampLocations.Store(tz, loc)
+	return loc
+}
+
+// IsValidTimeZone returns whether |tz| is a value that the time_zone system variable accepts: SYSTEM, an offset from
+// UTC between -13:59 and +14:00, or a named zone of the time zone database.
+func IsValidTimeZone(tz string) bool {
+	if strings.EqualFold(tz, "SYSTEM") {
+		return true
+	}
+	if d, err := gmstime.MySQLOffsetToDuration(tz); err == nil {
+		return d > -14*time.Hour && d <= 14*time.Hour
+	}
+	return tz != "" && !strings.EqualFold(tz, "Local") && locationOf(tz) != nil
+}
+
+// isUTC returns whether the location is UTC at all times.
+func isUTC(loc *time.Location) bool {
+	if loc == time.UTC {
+		return true
+	}
+	// time.Local is UTC when the system has no time zone configured, and a fixed zone may have no offset
+	now := time.Now()
+	_, offset := now.In(loc).Zone()
+	_, winterOffset := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, loc).Zone()
+	_, summerOffset := time.Date(now.Year(), time.July, 1, 0, 0, 0, 0, loc).Zone()
+	return offset == 0 && winterOffset == 0 && summerOffset == 0
+}
+
+// IsTimestampType returns whether the type given is TIMESTAMP.
+func IsTimestampType(typ Type) bool {
+	return typ != nil && typ.Type() == query.Type_TIMESTAMP
+}
+
+// TimestampFromUTC converts a stored TIMESTAMP value to the wall clock of |loc|.
+func TimestampFromUTC(t time.Time, loc *time.Location) time.Time {
+	if loc == nil || t.Equal(zeroTimestamp) {
+		return t
+	}
+	l := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), l.Nanosecond(), time.UTC)
+}
+
+// TimestampToUTC converts a TIMESTAMP value on the wall clock of |loc| to the UTC value it's stored as.
+func TimestampToUTC(t time.Time, loc *time.Location) time.Time {
+	if loc == nil || t.Equal(zeroTimestamp) {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+}
+
+// HasTimestampColumn returns whether any of the columns of the schema given is a TIMESTAMP.
+func HasTimestampColumn(sch Schema) bool {
+	for _, col := range sch {
+		if IsTimestampType(col.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// RowTimestampsFromUTC returns the row given with the values of its TIMESTAMP columns, according to |sch|, converted
+// from UTC to |loc|. The row is copied rather than modified when any value is converted.
+func RowTimestampsFromUTC(sch Schema, row Row, loc *time.Location) Row {
+	return convertRowTimestamps(sch, row, loc, TimestampFromUTC)
+}
+
+// RowTimestampsToUTC returns the row given with the values of its TIMESTAMP columns, according to |sch|, converted
+// from |loc| to UTC. The row is copied rather than modified when any value is converted.
+func RowTimestampsToUTC(sch Schema, row Row, loc *time.Location) Row {
+	return convertRowTimestamps(sch, row, loc, TimestampToUTC)
+}
+
+func convertRowTimestamps(sch Schema, row Row, loc *time.Location, convert func(time.Time, *time.Location) time.Time) Row {
+	if loc == nil || row == nil {
+		return row
+	}
+	// the schema may describe the last columns of a longer row
+	offset := len(row) - len(sch)
+	if offset < 0 {
+		return row
+	}
+	var converted Row
+	for i, col := range sch {
+		if !IsTimestampType(col.Type) {
+			continue
+		}
+		t, ok := row[offset+i].(time.Time)
+		if !ok {
+			continue
+		}
+		if converted == nil {
+			converted = row.Copy()
+		}
+		converted[offset+i] = convert(t, loc)
+	}
+	if converted == nil {
+		return row
+	}
+	return converted
+}
+
+// TimestampRangesToUTC returns the ranges given with their bounds on TIMESTAMP columns converted from |loc| to UTC, so
+// that they match the values stored in tables.
+func TimestampRangesToUTC(ranges RangeCollection, loc *time.Location) RangeCollection {
+	if loc == nil {
+		return ranges
+	}
+	var converted RangeCollection
+	for i, rang := range ranges {
+		for j, expr := range rang {
+			if !IsTimestampType(expr.Typ) {
+				continue
+			}
+			if converted == nil {
+				converted = make(RangeCollection, len(ranges))
+				for k := range ranges {
+					converted[k] = append(Range(nil), ranges[k]...)
+				}
+			}
+			converted[i][j] = RangeColumnExpr{
+				LowerBound: timestampRangeCutToUTC(expr.LowerBound, expr.Typ, loc),
+				UpperBound: timestampRangeCutToUTC(expr.UpperBound, expr.Typ, loc),
+				Typ:        expr.Typ,
+			}
+		}
+	}
+	if converted == nil {
+		return ranges
+	}
+	return converted
+}
+
+func timestampRangeCutToUTC(cut RangeCut, typ Type, loc *time.Location) RangeCut {
+	switch cut := cut.(type) {
+	case Above:
+		return Above{Key: timestampKeyToUTC(cut.Key, typ, loc)}
+	case Below:
+		return Below{Key: timestampKeyToUTC(cut.Key, typ, loc)}
+	default:
+		return cut
+	}
+}
+
+func timestampKeyToUTC(key interface{}, typ Type, loc *time.Location) interface{} {
+	t, ok := key.(time.Time)
+	if !ok {
+		converted, _, err := typ.Convert(key)
+		if err != nil {
+			return key
+		}
+		if t, ok = converted.(time.Time); !ok {
+			return key
+		}
+	}
+	return TimestampToUTC(t, loc)
+}
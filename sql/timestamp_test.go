@@ -0,0 +1,47 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampConversion(t *testing.T) {
+	loc := locationOf("+02:00")
+	require.NotNil(t, loc)
+	require.False(t, isUTC(loc))
+	require.True(t, isUTC(locationOf("+00:00")))
+	require.Nil(t, locationOf("Not/AZone"))
+
+	stored := time.Date(2020, time.February, 14, 23, 30, 0, 0, time.UTC)
+	session := TimestampFromUTC(stored, loc)
+	require.Equal(t, time.Date(2020, time.February, 15, 1, 30, 0, 0, time.UTC), session)
+	require.Equal(t, stored, TimestampToUTC(session, loc))
+
+	require.Equal(t, stored, TimestampFromUTC(stored, nil))
+	require.Equal(t, zeroTimestamp, TimestampFromUTC(zeroTimestamp, loc))
+	require.Equal(t, zeroTimestamp, TimestampToUTC(zeroTimestamp, loc))
+
+	named := locationOf("America/New_York")
+	require.NotNil(t, named)
+	// daylight saving time applies to the instant converted
+	require.Equal(t, time.Date(2020, time.July, 1, 8, 0, 0, 0, time.UTC),
+		TimestampFromUTC(time.Date(2020, time.July, 1, 12, 0, 0, 0, time.UTC), named))
+	require.Equal(t, time.Date(2020, time.January, 1, 7, 0, 0, 0, time.UTC),
+		TimestampFromUTC(time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC), named))
+}
@@ -145,6 +145,9 @@ type DatetimeType interface {
 	ConvertWithoutRangeCheck(v interface{}) (time.Time, error)
 	MaximumTime() time.Time
 	MinimumTime() time.Time
+	// Precision returns the number of fractional second digits that the values of the type keep. DATETIME and
+	// TIMESTAMP are implemented as DATETIME(6) and TIMESTAMP(6), while DATE has none.
+	Precision() int
 }
 
 // YearType represents the YEAR type.
@@ -67,14 +67,7 @@ func ApproximateTypeFromValue(val interface{}) sql.Type {
 	case float64:
 		return Float64
 	case string:
-		typ, err := CreateString(sqltypes.VarChar, int64(len(v)), sql.Collation_Default)
-		if err != nil {
-			typ, err = CreateString(sqltypes.Text, int64(len(v)), sql.Collation_Default)
-			if err != nil {
-				typ = LongText
-			}
-		}
-		return typ
+		return approximateStringType(v, sql.Collation_Default)
 	case []byte:
 		typ, err := CreateBinary(sqltypes.VarBinary, int64(len(v)))
 		if err != nil {
@@ -116,6 +109,29 @@ func ApproximateTypeFromValue(val interface{}) sql.Type {
 	}
 }
 
+// UserVariableType returns the type of a user variable assigned |val|, the value of an expression of type |typ|.
+// Strings keep the collation of the expression, and other values take the closest type to them.
+func UserVariableType(typ sql.Type, val interface{}) sql.Type {
+	if str, ok := val.(string); ok && !IsBinaryType(typ) {
+		if st, ok := typ.(sql.StringType); ok {
+			return approximateStringType(str, st.Collation())
+		}
+	}
+	return ApproximateTypeFromValue(val)
+}
+
+// approximateStringType returns the closest matching type to the string given, with the collation given.
+func approximateStringType(v string, collation sql.CollationID) sql.Type {
+	typ, err := CreateString(sqltypes.VarChar, int64(len(v)), collation)
+	if err != nil {
+		typ, err = CreateString(sqltypes.Text, int64(len(v)), collation)
+		if err != nil {
+			typ = CreateLongText(collation)
+		}
+	}
+	return typ
+}
+
 // ColumnTypeToType gets the column type using the column definition.
 func ColumnTypeToType(ct *sqlparser.ColumnType) (sql.Type, error) {
 	switch strings.ToLower(ct.Type) {
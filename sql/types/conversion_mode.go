@@ -0,0 +1,191 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ConvertForColumn converts |v| to the type of |col| for writing it into the row numbered |rowNum|, counting from 1,
+// of an INSERT or UPDATE. When |strict| is true, a value that is out of range, too long or invalid for the type is an
+// error. Otherwise, the value is adjusted to the closest valid value of the type and a warning is added to |ctx|:
+// numbers are clamped to the range of the type, strings are truncated to the length of the type, and anything else is
+// replaced by the zero value of the type. Values of JSON and spatial types are never adjusted. sql.BlobValue values
+// that fit BLOB and TEXT columns are returned without being read.
+func ConvertForColumn(ctx *sql.Context, col *sql.Column, v interface{}, strict bool, rowNum int64) (interface{}, error) {
+	if blob, ok := v.(sql.BlobValue); ok {
+		// Blob values that fit BLOB and TEXT columns are passed to storage without being read
+		if st, ok := col.Type.(StringType); ok && IsTextBlob(st) && blob.Size() <= st.maxByteLength {
+			return v, nil
+		}
+	}
+	converted, inRange, err := col.Type.Convert(v)
+	if err == nil && inRange == sql.InRange {
+		if IsDisallowedZeroDate(ctx, converted) {
+			if strict {
+				return nil, ErrConvertingToTime.New(v)
+			}
+			warnOutOfRange(ctx, col, rowNum)
+		}
+		return converted, nil
+	}
+
+	if strict || !isAdjustableType(col.Type) {
+		if err == nil {
+			return nil, sql.ErrValueOutOfRange.New(v, col.Type)
+		}
+		// Fill in error with information
+		if ErrLengthBeyondLimit.Is(err) {
+			return nil, ErrLengthBeyondLimit.New(v, col.Name)
+		} else if sql.ErrNotMatchingSRID.Is(err) {
+			return nil, sql.ErrNotMatchingSRIDWithColName.New(col.Name, err)
+		}
+		return nil, err
+	}
+
+	switch {
+	case err == nil:
+		// Numbers out of range are converted to the bound of the range they are beyond
+		warnOutOfRange(ctx, col, rowNum)
+		if IsUnsigned(col.Type) && isNegative(v) {
+			// Unsigned conversions wrap negative values around rather than clamping them
+			return col.Type.Zero(), nil
+		}
+		return converted, nil
+	case ErrLengthBeyondLimit.Is(err):
+		if truncated, ok := truncateForColumn(col.Type, v); ok {
+			ctx.Warn(mysql.ERWarnDataTruncated, "Data truncated for column '%s' at row %d", col.Name, rowNum)
+			return truncated, nil
+		}
+	case errBeyondMaxBit.Is(err):
+		// Bit values beyond the width of the type are clamped to the largest value it holds
+		if bt, ok := col.Type.(BitType); ok {
+			warnOutOfRange(ctx, col, rowNum)
+			return uint64(1<<bt.NumberOfBits() - 1), nil
+		}
+	case ErrConvertingToYear.Is(err):
+		// Numbers beyond the range of YEAR are written as the zero year, like other numbers out of range
+		switch v.(type) {
+		case string, []byte:
+		default:
+			warnOutOfRange(ctx, col, rowNum)
+			return col.Type.Zero(), nil
+		}
+	case ErrConvertToDecimalLimit.Is(err):
+		if bound, ok := decimalBound(col.Type.(sql.DecimalType), v); ok {
+			warnOutOfRange(ctx, col, rowNum)
+			return bound, nil
+		}
+	}
+
+	ctx.Warn(mysql.ERTruncatedWrongValueForField, "Incorrect %s value: '%v' for column '%s' at row %d",
+		typeNameForWarning(col.Type), v, col.Name, rowNum)
+	return col.Type.Zero(), nil
+}
+
+// isAdjustableType returns whether the values of the type given can be adjusted to the closest valid value of the type
+// when a write is not strict. Invalid JSON documents and geometries are always an error.
+func isAdjustableType(t sql.Type) bool {
+	return !IsJSON(t) && !IsGeometry(t)
+}
+
+// warnOutOfRange adds the warning for a value that is out of range for |col|.
+func warnOutOfRange(ctx *sql.Context, col *sql.Column, rowNum int64) {
+	ctx.Warn(mysql.ERWarnDataOutOfRange, "Out of range value for column '%s' at row %d", col.Name, rowNum)
+}
+
+// truncateForColumn truncates the string |v| to the length of the string type |t|, returning false when |v| can't be
+// truncated.
+func truncateForColumn(t sql.Type, v interface{}) (interface{}, bool) {
+	st, ok := t.(sql.StringType)
+	if !ok {
+		return nil, false
+	}
+	val, _, err := LongText.Convert(v)
+	if err != nil {
+		return nil, false
+	}
+	s := val.(string)
+
+	if st.Type() == sqltypes.Text || st.CharacterSet().MaxLength() == 1 {
+		// Lengths of TEXT types and single-byte character sets are counted in bytes
+		maxLength := st.MaxCharacterLength()
+		if st.Type() == sqltypes.Text {
+			maxLength = st.MaxByteLength()
+		}
+		if int64(len(s)) > maxLength {
+			s = s[:maxLength]
+		}
+	} else if runes := []rune(s); int64(len(runes)) > st.MaxCharacterLength() {
+		s = string(runes[:st.MaxCharacterLength()])
+	}
+
+	truncated, _, err := t.Convert(s)
+	if err != nil {
+		return nil, false
+	}
+	return truncated, true
+}
+
+// decimalBound returns the largest value of the decimal type |t| with the sign of |v|, which is beyond the range of
+// the type.
+func decimalBound(t sql.DecimalType, v interface{}) (interface{}, bool) {
+	dec, err := t.ConvertNoBoundsCheck(v)
+	if err != nil {
+		return nil, false
+	}
+	bound := t.ExclusiveUpperBound().Sub(decimal.New(1, -int32(t.Scale())))
+	if dec.Sign() < 0 {
+		bound = bound.Neg()
+	}
+	return bound, true
+}
+
+// typeNameForWarning returns the name of the type given as MySQL shows it in warnings about invalid values.
+func typeNameForWarning(t sql.Type) string {
+	switch {
+	case IsInteger(t):
+		return "integer"
+	case IsDecimal(t):
+		return "decimal"
+	case IsFloat(t):
+		return "double"
+	default:
+		return strings.ToLower(t.String())
+	}
+}
+
+// IsDisallowedZeroDate returns whether |v| is the zero date '0000-00-00' of the date and time types and the
+// NO_ZERO_DATE sql_mode is set, in which writing it is an error in strict mode and a warning otherwise.
+func IsDisallowedZeroDate(ctx *sql.Context, v interface{}) bool {
+	t, ok := v.(time.Time)
+	return ok && t.Equal(zeroTime) && sql.LoadSqlMode(ctx).NoZeroDate()
+}
+
+// isNegative returns whether |v| is a negative number.
+func isNegative(v interface{}) bool {
+	f, _, err := Float64.Convert(v)
+	if err != nil {
+		return false
+	}
+	return f.(float64) < 0
+}
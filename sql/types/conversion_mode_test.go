@@ -0,0 +1,107 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestConvertForColumn(t *testing.T) {
+	tests := []struct {
+		typ             sql.Type
+		val             interface{}
+		expectedVal     interface{}
+		expectedWarning int
+	}{
+		{Int8, int64(100), int8(100), 0},
+		{Int8, int64(1000), int8(127), mysql.ERWarnDataOutOfRange},
+		{Int8, int64(-1000), int8(-128), mysql.ERWarnDataOutOfRange},
+		{Uint8, int64(-1), uint8(0), mysql.ERWarnDataOutOfRange},
+		{Int32, "abc", int32(0), mysql.ERTruncatedWrongValueForField},
+		{MustCreateDecimalType(4, 2), "123.456", decimal.RequireFromString("99.99"), mysql.ERWarnDataOutOfRange},
+		{MustCreateDecimalType(4, 2), "-123.456", decimal.RequireFromString("-99.99"), mysql.ERWarnDataOutOfRange},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "abcdef", "abc", mysql.ERWarnDataTruncated},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "日本語です", "日本語", mysql.ERWarnDataTruncated},
+		{Datetime, "not a date", Datetime.Zero(), mysql.ERTruncatedWrongValueForField},
+		{Year, int64(1900), int16(0), mysql.ERWarnDataOutOfRange},
+		{Year, "1900", int16(0), mysql.ERTruncatedWrongValueForField},
+		{MustCreateBitType(4), int64(10), uint64(10), 0},
+		{MustCreateBitType(4), int64(16), uint64(15), mysql.ERWarnDataOutOfRange},
+		{MustCreateBitType(4), int64(-1), uint64(15), mysql.ERWarnDataOutOfRange},
+		{MustCreateBitType(4), "a", uint64(15), mysql.ERWarnDataOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			col := &sql.Column{Name: "c", Type: test.typ}
+
+			ctx := sql.NewEmptyContext()
+			val, err := ConvertForColumn(ctx, col, test.val, false, 1)
+			require.NoError(t, err)
+			if dec, ok := test.expectedVal.(decimal.Decimal); ok {
+				assert.True(t, dec.Equal(val.(decimal.Decimal)), "expected %v, got %v", dec, val)
+			} else {
+				assert.Equal(t, test.expectedVal, val)
+			}
+			if test.expectedWarning == 0 {
+				assert.Empty(t, ctx.Warnings())
+			} else {
+				require.Len(t, ctx.Warnings(), 1)
+				assert.Equal(t, test.expectedWarning, ctx.Warnings()[0].Code)
+			}
+
+			ctx = sql.NewEmptyContext()
+			val, err = ConvertForColumn(ctx, col, test.val, true, 1)
+			if test.expectedWarning == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedVal, val)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Empty(t, ctx.Warnings())
+		})
+	}
+}
+
+func TestConvertForColumnJSON(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	_, err := ConvertForColumn(ctx, &sql.Column{Name: "c", Type: JSON}, "not json", false, 1)
+	assert.True(t, sql.ErrInvalidJson.Is(err))
+}
+
+func TestConvertForColumnBlobValue(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	blob := sql.NewBlobValue([]byte("abcd"))
+
+	converted, err := ConvertForColumn(ctx, &sql.Column{Name: "c", Type: LongBlob}, blob, true, 1)
+	require.NoError(t, err)
+	assert.Equal(t, blob, converted)
+
+	converted, err = ConvertForColumn(ctx, &sql.Column{Name: "c", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 10)}, blob, true, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", converted)
+
+	_, err = ConvertForColumn(ctx, &sql.Column{Name: "c", Type: TinyBlob}, sql.NewBlobValue(make([]byte, TinyTextBlobMax+1)), true, 1)
+	assert.True(t, ErrLengthBeyondLimit.Is(err))
+}
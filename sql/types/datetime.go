@@ -334,6 +334,14 @@ func (t datetimeType) MaxTextResponseByteLength(_ *sql.Context) uint32 {
 	}
 }
 
+// Precision implements the DatetimeType interface.
+func (t datetimeType) Precision() int {
+	if t.baseType == sqltypes.Date {
+		return 0
+	}
+	return 6
+}
+
 // Promote implements the Type interface.
 func (t datetimeType) Promote() sql.Type {
 	return Datetime
@@ -344,3 +344,9 @@ func TestDatetimeZero(t *testing.T) {
 	_, ok = MustCreateDatetimeType(sqltypes.Timestamp).Zero().(time.Time)
 	require.True(t, ok)
 }
+
+func TestDatetimePrecision(t *testing.T) {
+	require.Equal(t, 0, MustCreateDatetimeType(sqltypes.Date).Precision())
+	require.Equal(t, 6, MustCreateDatetimeType(sqltypes.Datetime).Precision())
+	require.Equal(t, 6, MustCreateDatetimeType(sqltypes.Timestamp).Precision())
+}
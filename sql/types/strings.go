@@ -351,6 +351,12 @@ func ConvertToString(v interface{}, t sql.StringType) (string, error) {
 		val = s
 	case []byte:
 		val = string(s)
+	case sql.BlobValue:
+		b, err := sql.ReadBlobValue(s)
+		if err != nil {
+			return "", err
+		}
+		val = encodings.BytesToString(b)
 	case time.Time:
 		val = s.Format(sql.TimestampDatetimeLayout)
 	case decimal.Decimal:
@@ -474,7 +480,14 @@ func (t StringType) SQL(ctx *sql.Context, dest []byte, v interface{}) (sqltypes.
 	}
 
 	var val []byte
-	if IsBinaryType(t) {
+	if blob, ok := v.(sql.BlobValue); ok && t.baseType == sqltypes.Blob && blob.Size() <= t.maxByteLength {
+		// Blob values are read directly into the destination buffer rather than being materialized first
+		var err error
+		if val, err = sql.AppendBlobValue(dest, blob); err != nil {
+			return sqltypes.Value{}, err
+		}
+		val = val[len(dest):]
+	} else if IsBinaryType(t) {
 		v, _, err := t.Convert(v)
 		if err != nil {
 			return sqltypes.Value{}, err
@@ -333,6 +333,8 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 7), float64(11583.5), "11583.5", false},
 		{MustCreateStringWithDefaults(sqltypes.Char, 4), []byte("abcd"), "abcd", false},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 40), time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), "2019-12-12 12:12:12", false},
+		{MustCreateBinary(sqltypes.Blob, 4), sql.NewBlobValue([]byte("abcd")), []byte("abcd"), false},
+		{MustCreateStringWithDefaults(sqltypes.Text, 4), sql.NewBlobValue([]byte("abcd")), "abcd", false},
 
 		{MustCreateBinary(sqltypes.Binary, 3), "abcd", nil, true},
 		{MustCreateBinary(sqltypes.Blob, 3), strings.Repeat("0", TinyTextBlobMax+1), nil, true},
@@ -342,6 +344,7 @@ func TestStringConvert(t *testing.T) {
 			nil, true},
 		{MustCreateBinary(sqltypes.VarBinary, 3), []byte{01, 02, 03, 04}, nil, true},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), []byte("abcd"), nil, true},
+		{MustCreateBinary(sqltypes.Blob, 3), sql.NewBlobValue([]byte(strings.Repeat("0", TinyTextBlobMax+1))), nil, true},
 		{MustCreateStringWithDefaults(sqltypes.Char, 20), JSONDocument{Val: nil}, "null", false},
 	}
 
@@ -361,6 +364,24 @@ func TestStringConvert(t *testing.T) {
 	}
 }
 
+func TestStringSQLBlobValue(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	blob := sql.NewBlobValue([]byte("abcd"))
+
+	val, err := LongBlob.SQL(ctx, []byte("xy"), blob)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Blob, val.Type())
+	assert.Equal(t, []byte("abcd"), val.Raw())
+
+	val, err = LongText.SQL(ctx, nil, blob)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Text, val.Type())
+	assert.Equal(t, "abcd", val.ToString())
+
+	_, err = TinyBlob.SQL(ctx, nil, sql.NewBlobValue(make([]byte, TinyTextBlobMax+1)))
+	assert.Error(t, err)
+}
+
 func TestStringString(t *testing.T) {
 	tests := []struct {
 		typ         sql.Type
@@ -117,6 +117,12 @@ func (t systemEnumType) Convert(v interface{}) (interface{}, sql.ConvertInRange,
 		if idx, ok := t.valToIndex[strings.ToLower(value)]; ok {
 			return t.indexToVal[idx], sql.InRange, nil
 		}
+	case bool:
+		// ON and OFF are parsed as booleans, and are accepted for the enums that have them as values
+		if value {
+			return t.Convert("on")
+		}
+		return t.Convert("off")
 	}
 
 	return nil, sql.OutOfRange, sql.ErrInvalidSystemVariableValue.New(t.varName, v)
@@ -64,6 +64,8 @@ type TimeType interface {
 	// that will process the value based on its base-10 visual representation (for example, Convert() will interpret
 	// the value `1234` as 12 minutes and 34 seconds). This clamps the given microseconds to the allowed range.
 	MicrosecondsToTimespan(v int64) Timespan
+	// Precision returns the number of fractional second digits that the values of the type keep, which is always 6.
+	Precision() int
 }
 
 type TimespanType_ struct{}
@@ -71,6 +73,11 @@ type TimespanType_ struct{}
 var _ TimeType = TimespanType_{}
 var _ sql.CollationCoercible = TimespanType_{}
 
+// Precision implements the TimeType interface.
+func (t TimespanType_) Precision() int {
+	return 6
+}
+
 // MaxTextResponseByteLength implements the Type interface
 func (t TimespanType_) MaxTextResponseByteLength(_ *sql.Context) uint32 {
 	// 10 digits are required for a text representation without microseconds, but with microseconds
@@ -204,10 +211,14 @@ func (t TimespanType_) ConvertToTimespan(v interface{}) (Timespan, error) {
 			}
 		}
 	case decimal.Decimal:
-		return t.ConvertToTimespan(value.IntPart())
+		if value.Exponent() >= 0 {
+			return t.ConvertToTimespan(value.IntPart())
+		}
+		f, _ := value.Float64()
+		return t.ConvertToTimespan(f)
 	case decimal.NullDecimal:
 		if value.Valid {
-			return t.ConvertToTimespan(value.Decimal.IntPart())
+			return t.ConvertToTimespan(value.Decimal)
 		}
 	case string:
 		impl, err := stringToTimespan(value)
@@ -0,0 +1,321 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// maxVarcharAggregationLength is the longest VARCHAR an aggregated type may be, in characters, before it becomes a
+// LONGTEXT instead.
+const maxVarcharAggregationLength = 16383
+
+// AggregateTypes returns the type that can hold the values of all the types given, following MySQL's rules for the
+// result type of the columns of a UNION and of the CASE, IF and COALESCE expressions. NULL types are ignored, and the
+// result is NULL if every type is. The result is deferred if any type is, since the type of a bindvar is unknown until
+// it is bound. In summary:
+//   - integers aggregate to the smallest integer type that holds both, or to a DECIMAL if one is a BIGINT UNSIGNED and
+//     the other is signed
+//   - exact numbers aggregate to a DECIMAL holding the integer and fractional digits of both
+//   - DOUBLE or a FLOAT with a DECIMAL or INT aggregate to DOUBLE, a FLOAT with other numbers to FLOAT
+//   - temporal types aggregate to the same type, or to DATETIME if they differ
+//   - JSON aggregates to JSON, and anything else to a string long enough for the values of both types, which is
+//     binary if either type is binary
+func AggregateTypes(typs ...sql.Type) sql.Type {
+	var result sql.Type = Null
+	for _, typ := range typs {
+		if typ == nil || typ == Null {
+			continue
+		}
+		if IsDeferredType(typ) {
+			return typ
+		}
+		if result == Null {
+			result = typ
+			continue
+		}
+		result = aggregateTypes(result, typ)
+	}
+	return result
+}
+
+func aggregateTypes(left, right sql.Type) sql.Type {
+	if reflect.DeepEqual(left, right) {
+		return left
+	}
+	left, right = aggregationBaseType(left), aggregationBaseType(right)
+
+	switch {
+	case IsInteger(left) && IsInteger(right):
+		return aggregateIntegers(left, right)
+	case isExactNumber(left) && isExactNumber(right):
+		return aggregateDecimals(left, right)
+	case isNumeric(left) && isNumeric(right):
+		if left == Float32 && right == Float32 {
+			return Float32
+		}
+		if left == Float64 || right == Float64 || IsDecimal(left) || IsDecimal(right) ||
+			left == Int32 || right == Int32 || left == Uint32 || right == Uint32 {
+			return Float64
+		}
+		return Float32
+	case IsTime(left) && IsTime(right):
+		if left.Type() == right.Type() {
+			return left
+		}
+		return Datetime
+	case IsTimespan(left) && IsTime(right), IsTime(left) && IsTimespan(right):
+		return Datetime
+	case IsJSON(left) && IsJSON(right):
+		return JSON
+	case IsGeometry(left) && IsGeometry(right):
+		return GeometryType{}
+	}
+	return aggregateStrings(left, right)
+}
+
+// aggregationBaseType returns the type |typ| aggregates as, which is itself except for the types that aggregate like
+// another one.
+func aggregationBaseType(typ sql.Type) sql.Type {
+	switch typ.(type) {
+	case SystemBoolType:
+		return Int8
+	case BitType_:
+		return Uint64
+	case YearType_:
+		return Int16
+	}
+	return typ
+}
+
+func isExactNumber(t sql.Type) bool {
+	return IsInteger(t) || IsDecimal(t)
+}
+
+func isNumeric(t sql.Type) bool {
+	return isExactNumber(t) || IsFloat(t)
+}
+
+// integerRanks orders the integer types by the range of their values, for each signedness.
+var integerRanks = map[sql.Type]int{
+	Int8: 1, Uint8: 1,
+	Int16: 2, Uint16: 2,
+	Int24: 3, Uint24: 3,
+	Int32: 4, Uint32: 4,
+	Int64: 5, Uint64: 5,
+}
+
+var signedIntegers = []sql.Type{Int8, Int16, Int24, Int32, Int64}
+var unsignedIntegers = []sql.Type{Uint8, Uint16, Uint24, Uint32, Uint64}
+
+func aggregateIntegers(left, right sql.Type) sql.Type {
+	lRank, rRank := integerRanks[left], integerRanks[right]
+	if IsUnsigned(left) == IsUnsigned(right) {
+		rank := lRank
+		if rRank > rank {
+			rank = rRank
+		}
+		if IsUnsigned(left) {
+			return unsignedIntegers[rank-1]
+		}
+		return signedIntegers[rank-1]
+	}
+
+	// A signed type must be larger than the unsigned one to hold its values
+	if IsUnsigned(left) {
+		lRank++
+	} else {
+		rRank++
+	}
+	rank := lRank
+	if rRank > rank {
+		rank = rRank
+	}
+	if rank > len(signedIntegers) {
+		return MustCreateDecimalType(20, 0)
+	}
+	return signedIntegers[rank-1]
+}
+
+// integerDigits is the number of decimal digits of the largest values of the integer types.
+var integerDigits = map[sql.Type]uint8{
+	Int8: 3, Uint8: 3,
+	Int16: 5, Uint16: 5,
+	Int24: 7, Uint24: 8,
+	Int32: 10, Uint32: 10,
+	Int64: 19, Uint64: 20,
+}
+
+func aggregateDecimals(left, right sql.Type) sql.Type {
+	lInt, lScale := decimalDigits(left)
+	rInt, rScale := decimalDigits(right)
+	intDigits, scale := lInt, lScale
+	if rInt > intDigits {
+		intDigits = rInt
+	}
+	if rScale > scale {
+		scale = rScale
+	}
+	if scale > DecimalTypeMaxScale {
+		scale = DecimalTypeMaxScale
+	}
+	precision := intDigits + scale
+	if precision > DecimalTypeMaxPrecision {
+		precision = DecimalTypeMaxPrecision
+	}
+	return MustCreateDecimalType(uint8(precision), uint8(scale))
+}
+
+// decimalDigits returns the number of integer and fractional digits of the exact number type given.
+func decimalDigits(t sql.Type) (int, int) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return int(dt.Precision() - dt.Scale()), int(dt.Scale())
+	}
+	return int(integerDigits[t]), 0
+}
+
+func aggregateStrings(left, right sql.Type) sql.Type {
+	binary := isBinaryString(left) || isBinaryString(right)
+	if isLongString(left) || isLongString(right) {
+		if binary {
+			return LongBlob
+		}
+		return CreateLongText(aggregateCollation(left, right))
+	}
+
+	length := displayLength(left)
+	if l := displayLength(right); l > length {
+		length = l
+	}
+	if length > maxVarcharAggregationLength {
+		if binary {
+			return LongBlob
+		}
+		return CreateLongText(aggregateCollation(left, right))
+	}
+	if binary {
+		return MustCreateBinary(sqltypes.VarBinary, length)
+	}
+	return MustCreateString(sqltypes.VarChar, length, aggregateCollation(left, right))
+}
+
+// isBinaryString returns whether values of the type given are binary strings when converted to strings.
+func isBinaryString(t sql.Type) bool {
+	return IsBinaryType(t) || IsGeometry(t)
+}
+
+// isLongString returns whether the type given aggregates with any other type to a LONGTEXT or LONGBLOB.
+func isLongString(t sql.Type) bool {
+	return IsTextBlob(t) || IsJSON(t) || IsGeometry(t)
+}
+
+// aggregateCollation returns the collation of the strings a non-binary string type aggregates to.
+func aggregateCollation(left, right sql.Type) sql.CollationID {
+	for _, t := range []sql.Type{left, right} {
+		if tc, ok := t.(sql.TypeWithCollation); ok && !IsBinaryType(t) {
+			return tc.Collation()
+		}
+	}
+	return sql.Collation_Default
+}
+
+// displayLength returns the maximum number of characters of the values of the type given converted to strings.
+func displayLength(t sql.Type) int64 {
+	switch t := t.(type) {
+	case sql.StringType:
+		return t.MaxCharacterLength()
+	case sql.EnumType:
+		return valuesLength(t.Values(), false)
+	case sql.SetType:
+		return valuesLength(t.Values(), true)
+	case sql.DecimalType:
+		return int64(t.Precision()) + 2
+	}
+	switch {
+	case IsInteger(t):
+		length := int64(integerDigits[t])
+		if IsSigned(t) {
+			length++
+		}
+		return length
+	case t == Float32:
+		return 12
+	case t == Float64:
+		return 22
+	case IsDateType(t):
+		return 10
+	case IsTime(t):
+		return 26
+	case IsTimespan(t):
+		return 17
+	}
+	return maxVarcharAggregationLength + 1
+}
+
+// valuesLength returns the length of the longest of the ENUM values given, or of all the SET values given joined.
+func valuesLength(values []string, joined bool) int64 {
+	var length int64
+	for i, v := range values {
+		if joined {
+			if i > 0 {
+				length++
+			}
+			length += int64(len(v))
+		} else if int64(len(v)) > length {
+			length = int64(len(v))
+		}
+	}
+	return length
+}
+
+// ConvertToAggregatedType converts |v|, a value of type |from|, to |to|, a type returned by AggregateTypes for |from|
+// and other types. Unlike |to|.Convert, ENUM and SET values are converted from their strings rather than their
+// indexes and bits, and dates are converted to strings in the format of their type, as they are when aggregated to a
+// string type.
+func ConvertToAggregatedType(v interface{}, from, to sql.Type) (interface{}, error) {
+	if v == nil || to == nil || IsDeferredType(to) {
+		return v, nil
+	}
+	if !IsEnum(to) && !IsSet(to) {
+		switch from := from.(type) {
+		case sql.DatetimeType:
+			if IsText(to) || IsBinaryType(to) {
+				val, err := from.SQL(nil, nil, v)
+				if err != nil {
+					return nil, err
+				}
+				v = val.ToString()
+			}
+		case sql.EnumType:
+			if idx, ok := v.(uint16); ok {
+				v, _ = from.At(int(idx))
+			}
+		case sql.SetType:
+			if bits, ok := v.(uint64); ok {
+				s, err := from.BitsToString(bits)
+				if err != nil {
+					return nil, err
+				}
+				v = s
+			}
+		}
+	}
+	converted, _, err := to.Convert(v)
+	return converted, err
+}
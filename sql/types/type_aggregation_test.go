@@ -0,0 +1,96 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestAggregateTypes(t *testing.T) {
+	tests := []struct {
+		typs     []sql.Type
+		expected sql.Type
+	}{
+		{[]sql.Type{}, Null},
+		{[]sql.Type{Null, Null}, Null},
+		{[]sql.Type{Null, Int32}, Int32},
+		{[]sql.Type{Int32, nil}, Int32},
+		{[]sql.Type{Int8, Int32}, Int32},
+		{[]sql.Type{Uint8, Uint16}, Uint16},
+		{[]sql.Type{Uint8, Int8}, Int16},
+		{[]sql.Type{Int8, Uint32}, Int64},
+		{[]sql.Type{Int64, Uint64}, MustCreateDecimalType(20, 0)},
+		{[]sql.Type{Int32, MustCreateDecimalType(5, 2)}, MustCreateDecimalType(12, 2)},
+		{[]sql.Type{MustCreateDecimalType(10, 2), MustCreateDecimalType(5, 4)}, MustCreateDecimalType(12, 4)},
+		{[]sql.Type{Float32, Float32}, Float32},
+		{[]sql.Type{Float32, Int16}, Float32},
+		{[]sql.Type{Float32, Int32}, Float64},
+		{[]sql.Type{Float32, MustCreateDecimalType(5, 2)}, Float64},
+		{[]sql.Type{Float64, Int8}, Float64},
+		{[]sql.Type{Date, Date}, Date},
+		{[]sql.Type{Date, Datetime}, Datetime},
+		{[]sql.Type{Time, Datetime}, Datetime},
+		{[]sql.Type{JSON, JSON}, JSON},
+		{[]sql.Type{Int32, MustCreateStringWithDefaults(sqltypes.VarChar, 5)}, MustCreateStringWithDefaults(sqltypes.VarChar, 11)},
+		{[]sql.Type{MustCreateStringWithDefaults(sqltypes.VarChar, 5), MustCreateStringWithDefaults(sqltypes.Char, 10)}, MustCreateStringWithDefaults(sqltypes.VarChar, 10)},
+		{[]sql.Type{Int32, MustCreateBinary(sqltypes.VarBinary, 5)}, MustCreateBinary(sqltypes.VarBinary, 11)},
+		{[]sql.Type{Date, Int8}, MustCreateStringWithDefaults(sqltypes.VarChar, 10)},
+		{[]sql.Type{Int8, Text}, LongText},
+		{[]sql.Type{Blob, Text}, LongBlob},
+		{[]sql.Type{JSON, Int8}, LongText},
+		{[]sql.Type{Int8, Int16, MustCreateDecimalType(4, 1)}, MustCreateDecimalType(6, 1)},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v", test.typs), func(t *testing.T) {
+			assert.Equal(t, test.expected, AggregateTypes(test.typs...))
+		})
+	}
+}
+
+func TestConvertToAggregatedType(t *testing.T) {
+	enumType := MustCreateEnumType([]string{"a", "b"}, sql.Collation_Default)
+	setType := MustCreateSetType([]string{"a", "b"}, sql.Collation_Default)
+	varchar := MustCreateStringWithDefaults(sqltypes.VarChar, 10)
+
+	tests := []struct {
+		val      interface{}
+		from     sql.Type
+		to       sql.Type
+		expected interface{}
+	}{
+		{nil, Int8, Int64, nil},
+		{int8(5), Int8, Int64, int64(5)},
+		{int32(5), Int32, varchar, "5"},
+		{uint16(2), enumType, varchar, "b"},
+		{uint64(3), setType, varchar, "a,b"},
+		{uint16(2), enumType, enumType, uint16(2)},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v %v", test.val, test.from, test.to), func(t *testing.T) {
+			val, err := ConvertToAggregatedType(test.val, test.from, test.to)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, val)
+		})
+	}
+}
@@ -15,8 +15,10 @@
 package types
 
 import (
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -102,24 +104,27 @@ func (t YearType_) Convert(v interface{}) (interface{}, sql.ConvertInRange, erro
 	case uint64:
 		return t.Convert(int64(value))
 	case float32:
-		return t.Convert(int64(value))
+		return t.Convert(float64(value))
 	case float64:
-		return t.Convert(int64(value))
+		// Fractional years are rounded to the nearest year, as MySQL does
+		return t.Convert(int64(math.Round(value)))
 	case decimal.Decimal:
-		return t.Convert(value.IntPart())
+		return t.Convert(value.Round(0).IntPart())
 	case decimal.NullDecimal:
 		if !value.Valid {
 			return nil, sql.InRange, nil
 		}
-		return t.Convert(value.Decimal.IntPart())
+		return t.Convert(value.Decimal)
 	case string:
+		value = strings.TrimSpace(value)
 		valueLength := len(value)
 		if valueLength == 1 || valueLength == 2 || valueLength == 4 {
 			i, err := strconv.ParseInt(value, 10, 64)
 			if err != nil {
 				return nil, sql.OutOfRange, err
 			}
-			if i == 0 {
+			// Unlike the number 0, the strings '0' and '00' are the year 2000. The string '0000' is the zero year.
+			if i == 0 && valueLength < 4 {
 				return int16(2000), sql.InRange, nil
 			}
 			return t.Convert(i)
@@ -0,0 +1,184 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variables
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// globalStatusVariables is the underlying type of StatusVariables.
+type globalStatusVariables struct {
+	mutex   *sync.RWMutex
+	varVals map[string]*sql.StatusVarValue
+}
+
+var _ sql.StatusVariableRegistry = (*globalStatusVariables)(nil)
+
+// AddStatusVariables adds the given status variables to the collection. If a name is already used by an existing
+// variable, then it is overwritten with the new one. Sessions created before the variables are added don't count
+// them.
+func (sv *globalStatusVariables) AddStatusVariables(statusVars []sql.StatusVariable) {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+	for _, statusVar := range statusVars {
+		sv.varVals[strings.ToLower(statusVar.Name)] = sql.NewStatusVarValue(statusVar)
+	}
+}
+
+// NewSessionMap returns a new map of status variable counters for sessions.
+func (sv *globalStatusVariables) NewSessionMap() map[string]*sql.StatusVarValue {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	sessionVals := make(map[string]*sql.StatusVarValue)
+	for key, val := range sv.varVals {
+		if val.Var.Scope != sql.SystemVariableScope_Global {
+			sessionVals[key] = sql.NewStatusVarValue(val.Var)
+		}
+	}
+	return sessionVals
+}
+
+// GetGlobal returns the status variable definition and global value for the given name. If the variable does not
+// exist, returns false. Case-insensitive.
+func (sv *globalStatusVariables) GetGlobal(name string) (sql.StatusVariable, interface{}, bool) {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	val, ok := sv.varVals[strings.ToLower(name)]
+	if !ok {
+		return sql.StatusVariable{}, nil, false
+	}
+	return val.Var, statusValue(val), true
+}
+
+// IncrementGlobal adds the given amount to the global value of the status variable with the given name. Variables
+// that don't exist, or that are only counted per session, are ignored.
+func (sv *globalStatusVariables) IncrementGlobal(name string, val int64) {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	if statusVar, ok := sv.varVals[strings.ToLower(name)]; ok && statusVar.Var.Scope != sql.SystemVariableScope_Session {
+		statusVar.Increment(val)
+	}
+}
+
+// GetAllGlobalVariables returns a copy of all global status variable values, keyed by their lowercase names.
+func (sv *globalStatusVariables) GetAllGlobalVariables() map[string]interface{} {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	m := make(map[string]interface{}, len(sv.varVals))
+	for k, val := range sv.varVals {
+		if val.Var.Scope != sql.SystemVariableScope_Session {
+			m[k] = statusValue(val)
+		}
+	}
+	return m
+}
+
+// statusValue returns the value of the status variable given, calling its value function if it has one.
+func statusValue(val *sql.StatusVarValue) interface{} {
+	if val.Var.ValueFunction == nil {
+		return val.Value()
+	}
+	result, err := val.Var.ValueFunction()
+	if err != nil {
+		logrus.StandardLogger().Warnf("unable to get value for status variable %s: %s", val.Var.Name, err.Error())
+		return nil
+	}
+	return result
+}
+
+// InitStatusVariables resets the StatusVariables singleton in the sql package
+func InitStatusVariables() {
+	vars := &globalStatusVariables{
+		mutex:   &sync.RWMutex{},
+		varVals: make(map[string]*sql.StatusVarValue, len(statusVars)),
+	}
+	for _, statusVar := range statusVars {
+		vars.varVals[strings.ToLower(statusVar.Name)] = sql.NewStatusVarValue(statusVar)
+	}
+	sql.StatusVariables = vars
+}
+
+// init initializes StatusVariables as it functions as a global variable.
+func init() {
+	InitStatusVariables()
+}
+
+// comStatusVariables are the names of the Com_xxx status variables, which count the statements of each kind executed.
+var comStatusVariables = []string{
+	"Com_alter_db", "Com_alter_event", "Com_alter_table", "Com_analyze", "Com_begin",
+	"Com_call_procedure", "Com_change_db", "Com_check", "Com_checksum", "Com_commit", "Com_create_db", "Com_create_event", "Com_create_index",
+	"Com_create_procedure", "Com_create_role", "Com_create_table", "Com_create_trigger", "Com_create_user",
+	"Com_create_view", "Com_dealloc_sql", "Com_delete", "Com_drop_db", "Com_drop_event", "Com_drop_index",
+	"Com_drop_procedure", "Com_drop_role", "Com_drop_table", "Com_drop_trigger", "Com_drop_user", "Com_drop_view",
+	"Com_execute_sql", "Com_flush", "Com_grant", "Com_insert", "Com_insert_select", "Com_kill", "Com_load",
+	"Com_lock_tables", "Com_optimize", "Com_prepare_sql", "Com_release_savepoint", "Com_rename_table", "Com_rename_user",
+	"Com_replace", "Com_replace_select", "Com_revoke", "Com_rollback", "Com_rollback_to_savepoint", "Com_savepoint",
+	"Com_select", "Com_set_option", "Com_show_create_db", "Com_show_create_event", "Com_show_create_proc",
+	"Com_show_create_table", "Com_show_create_trigger", "Com_show_databases", "Com_show_engine_status",
+	"Com_show_events", "Com_show_fields", "Com_show_grants", "Com_show_keys",
+	"Com_show_processlist", "Com_show_status", "Com_show_table_status", "Com_show_tables", "Com_show_triggers",
+	"Com_show_variables", "Com_show_warnings", "Com_stmt_prepare",
+	"Com_truncate", "Com_unlock_tables", "Com_update",
+}
+
+// statusVars is the collection of status variables maintained by the engine. See
+// https://dev.mysql.com/doc/refman/8.0/en/server-status-variables.html
+var statusVars = append(comVars(), []sql.StatusVariable{
+	{Name: "Aborted_clients", Scope: sql.SystemVariableScope_Global},
+	{Name: "Connection_errors_max_connections", Scope: sql.SystemVariableScope_Global},
+	{Name: "Connections", Scope: sql.SystemVariableScope_Global},
+	{Name: "Handler_delete", Scope: sql.SystemVariableScope_Both},
+	{Name: "Handler_read_key", Scope: sql.SystemVariableScope_Both},
+	{Name: "Handler_read_next", Scope: sql.SystemVariableScope_Both},
+	{Name: "Handler_read_rnd_next", Scope: sql.SystemVariableScope_Both},
+	{Name: "Handler_update", Scope: sql.SystemVariableScope_Both},
+	{Name: "Handler_write", Scope: sql.SystemVariableScope_Both},
+	{Name: "Innodb_rows_deleted", Scope: sql.SystemVariableScope_Global},
+	{Name: "Innodb_rows_inserted", Scope: sql.SystemVariableScope_Global},
+	{Name: "Innodb_rows_read", Scope: sql.SystemVariableScope_Global},
+	{Name: "Innodb_rows_updated", Scope: sql.SystemVariableScope_Global},
+	{Name: "Qcache_hits", Scope: sql.SystemVariableScope_Global},
+	{Name: "Qcache_inserts", Scope: sql.SystemVariableScope_Global},
+	{Name: "Qcache_lowmem_prunes", Scope: sql.SystemVariableScope_Global},
+	{Name: "Qcache_not_cached", Scope: sql.SystemVariableScope_Global},
+	{Name: "Qcache_queries_in_cache", Scope: sql.SystemVariableScope_Global},
+	{Name: "Queries", Scope: sql.SystemVariableScope_Both},
+	{Name: "Questions", Scope: sql.SystemVariableScope_Both},
+	{Name: "Slow_queries", Scope: sql.SystemVariableScope_Both},
+	{Name: "Ssl_accepts", Scope: sql.SystemVariableScope_Global},
+	{Name: "Ssl_finished_accepts", Scope: sql.SystemVariableScope_Global},
+	{Name: "Threads_connected", Scope: sql.SystemVariableScope_Global},
+	{
+		Name:  "Uptime",
+		Scope: sql.SystemVariableScope_Global,
+		ValueFunction: func() (interface{}, error) {
+			return int64(time.Now().Sub(serverStartUpTime).Seconds()), nil
+		},
+	},
+}...)
+
+func comVars() []sql.StatusVariable {
+	vars := make([]sql.StatusVariable, len(comStatusVariables))
+	for i, name := range comStatusVariables {
+		vars[i] = sql.StatusVariable{Name: name, Scope: sql.SystemVariableScope_Both}
+	}
+	return vars
+}
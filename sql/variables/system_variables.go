@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -38,6 +39,7 @@ var serverStartUpTime = time.Now()
 type globalSystemVariables struct {
 	mutex      *sync.RWMutex
 	sysVarVals map[string]sql.SystemVarValue
+	persister  sql.SystemVariablePersister
 }
 
 var _ sql.SystemVariableRegistry = (*globalSystemVariables)(nil)
@@ -71,7 +73,7 @@ func (sv *globalSystemVariables) AssignValues(vals map[string]interface{}) error
 		if !ok {
 			return sql.ErrUnknownSystemVariable.New(varName)
 		}
-		convertedVal, _, err := sysVar.Type.Convert(val)
+		convertedVal, err := sysVar.ConvertValue(val)
 		if err != nil {
 			return err
 		}
@@ -151,7 +153,7 @@ func (sv *globalSystemVariables) SetGlobal(name string, val interface{}) error {
 	if !sysVar.Dynamic || sysVar.ValueFunction != nil {
 		return sql.ErrSystemVariableReadOnly.New(name)
 	}
-	convertedVal, _, err := sysVar.Type.Convert(val)
+	convertedVal, err := sysVar.ConvertValue(val)
 	if err != nil {
 		return err
 	}
@@ -176,6 +178,20 @@ func (sv *globalSystemVariables) GetAllGlobalVariables() map[string]interface{}
 	return m
 }
 
+// SetPersister implements sql.SystemVariableRegistry.
+func (sv *globalSystemVariables) SetPersister(persister sql.SystemVariablePersister) {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+	sv.persister = persister
+}
+
+// Persister implements sql.SystemVariableRegistry.
+func (sv *globalSystemVariables) Persister() sql.SystemVariablePersister {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	return sv.persister
+}
+
 // InitSystemVariables resets the systemVars singleton in the sql package
 func InitSystemVariables() {
 	vars := &globalSystemVariables{
@@ -210,6 +226,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("activate_all_roles_on_login"),
 		Default:           int8(0),
 	},
+	"adaptive_join_threshold": {
+		Name:              "adaptive_join_threshold",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemUintType("adaptive_join_threshold", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
 	"admin_address": {
 		Name:              "admin_address",
 		Scope:             sql.SystemVariableScope_Global,
@@ -403,6 +427,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("binlog_gtid_simple_recovery"),
 		Default:           int8(1),
 	},
+	"binlog_row_image": {
+		Name:              "binlog_row_image",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemEnumType("binlog_row_image", binlogreplication.BinlogRowImageFull, binlogreplication.BinlogRowImageMinimal, binlogreplication.BinlogRowImageNoBlob),
+		Default:           binlogreplication.BinlogRowImageFull,
+	},
 	"block_encryption_mode": {
 		Name:              "block_encryption_mode",
 		Scope:             sql.SystemVariableScope_Both,
@@ -458,6 +490,7 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_client"),
 		Default:           sql.Collation_Default.CharacterSet().String(),
+		Validate:          validateCharacterSet,
 	},
 	"character_set_connection": {
 		Name:              "character_set_connection",
@@ -466,6 +499,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_connection"),
 		Default:           sql.Collation_Default.CharacterSet().String(),
+		Validate:          validateCharacterSet,
+		OnUpdate:          setDefaultCollation("collation_connection"),
 	},
 	"character_set_database": {
 		Name:              "character_set_database",
@@ -474,6 +509,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_database"),
 		Default:           sql.Collation_Default.CharacterSet().String(),
+		Validate:          validateCharacterSet,
+		OnUpdate:          setDefaultCollation("collation_database"),
 	},
 	"character_set_filesystem": {
 		Name:              "character_set_filesystem",
@@ -482,6 +519,7 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_filesystem"),
 		Default:           "binary",
+		Validate:          validateCharacterSet,
 	},
 	"character_set_results": {
 		Name:              "character_set_results",
@@ -490,6 +528,7 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_results"),
 		Default:           sql.Collation_Default.CharacterSet().String(),
+		Validate:          validateCharacterSet,
 	},
 	"character_set_server": {
 		Name:              "character_set_server",
@@ -498,6 +537,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("character_set_server"),
 		Default:           sql.Collation_Default.CharacterSet().String(),
+		Validate:          validateCharacterSet,
+		OnUpdate:          setDefaultCollation("collation_server"),
 	},
 	"character_set_system": {
 		Name:              "character_set_system",
@@ -530,6 +571,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("collation_connection"),
 		Default:           sql.Collation_Default.String(),
+		Validate:          validateCollation,
+		OnUpdate:          setCollationCharacterSet("character_set_connection"),
 	},
 	"collation_database": {
 		Name:              "collation_database",
@@ -538,6 +581,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("collation_database"),
 		Default:           sql.Collation_Default.String(),
+		Validate:          validateCollation,
+		OnUpdate:          setCollationCharacterSet("character_set_database"),
 	},
 	"collation_server": {
 		Name:              "collation_server",
@@ -546,6 +591,8 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("collation_server"),
 		Default:           sql.Collation_Default.String(),
+		Validate:          validateCollation,
+		OnUpdate:          setCollationCharacterSet("character_set_server"),
 	},
 	"completion_type": {
 		Name:              "completion_type",
@@ -755,6 +802,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("eq_range_index_dive_limit", 0, 4294967295, false),
 		Default:           int64(200),
 	},
+	"error_count": {
+		Name:              "error_count",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("error_count", 0, 65535, false),
+		Default:           int64(0),
+	},
 	"event_scheduler": {
 		Name:              "event_scheduler",
 		Scope:             sql.SystemVariableScope_Global,
@@ -882,6 +937,9 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("gtid_executed"),
 		Default:           "",
+		ValueFunction: func() (interface{}, error) {
+			return binlogreplication.ExecutedGTIDs().String(), nil
+		},
 	},
 	"gtid_executed_compression_period": {
 		Name:              "gtid_executed_compression_period",
@@ -920,8 +978,9 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.SystemVariableScope_Global,
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              types.NewSystemStringType("gtid_purged"),
+		Type:              binlogreplication.NewGTIDPurgedType(),
 		Default:           "",
+		NotifyChanged:     binlogreplication.NotifyGTIDPurgedChanged,
 	},
 	"have_statement_timeout": {
 		Name:              "have_statement_timeout",
@@ -971,6 +1030,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemStringType("init_connect"),
 		Default:           "",
 	},
+	"in_list_semi_join_threshold": {
+		Name:              "in_list_semi_join_threshold",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemUintType("in_list_semi_join_threshold", 0, 18446744073709551615),
+		Default:           uint64(1000),
+	},
 	"information_schema_stats_expiry": {
 		Name:              "information_schema_stats_expiry",
 		Scope:             sql.SystemVariableScope_Both,
@@ -2190,6 +2257,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("show_create_table_verbosity"),
 		Default:           int8(0),
 	},
+	"show_gipk_in_create_table_and_information_schema": {
+		Name:              "show_gipk_in_create_table_and_information_schema",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("show_gipk_in_create_table_and_information_schema"),
+		Default:           int8(1),
+	},
 	"show_external_procedures": {
 		Name:              "show_external_procedures",
 		Scope:             sql.SystemVariableScope_Both,
@@ -2302,6 +2377,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("sql_buffer_result"),
 		Default:           int8(0),
 	},
+	"sql_generate_invisible_primary_key": {
+		Name:              "sql_generate_invisible_primary_key",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("sql_generate_invisible_primary_key"),
+		Default:           int8(0),
+	},
 	"sql_log_bin": {
 		Name:              "sql_log_bin",
 		Scope:             sql.SystemVariableScope_Both,
@@ -2323,8 +2406,9 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              types.NewSystemSetType("sql_mode", "ALLOW_INVALID_DATES", "ANSI_QUOTES", "ERROR_FOR_DIVISION_BY_ZERO", "HIGH_NOT_PRECEDENCE", "IGNORE_SPACE", "NO_AUTO_VALUE_ON_ZERO", "NO_BACKSLASH_ESCAPES", "NO_DIR_IN_CREATE", "NO_ENGINE_SUBSTITUTION", "NO_UNSIGNED_SUBTRACTION", "NO_ZERO_DATE", "NO_ZERO_IN_DATE", "ONLY_FULL_GROUP_BY", "PAD_CHAR_TO_FULL_LENGTH", "PIPES_AS_CONCAT", "REAL_AS_FLOAT", "STRICT_ALL_TABLES", "STRICT_TRANS_TABLES", "TIME_TRUNCATE_FRACTIONAL", "TRADITIONAL", "ANSI"),
+		Type:              sqlModeType,
 		Default:           "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY",
+		Validate:          validateSqlMode,
 	},
 	"sql_notes": {
 		Name:              "sql_notes",
@@ -2363,7 +2447,7 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              types.NewSystemIntType("sql_select_limit", -9223372036854775808, 9223372036854775807, false),
+		Type:              types.NewSystemIntType("sql_select_limit", 0, 9223372036854775807, false),
 		Default:           int64(2147483647),
 	},
 	"sql_warnings": {
@@ -2646,6 +2730,7 @@ var systemVars = map[string]sql.SystemVariable{
 		SetVarHintApplies: true,
 		Type:              types.NewSystemStringType("time_zone"),
 		Default:           "SYSTEM",
+		Validate:          validateTimeZone,
 	},
 	// TODO: this needs to utilize a function as the value is not static
 	"timestamp": {
@@ -2828,6 +2913,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("wait_timeout", 1, 31536000, false),
 		Default:           int64(28800),
 	},
+	"warning_count": {
+		Name:              "warning_count",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("warning_count", 0, 65535, false),
+		Default:           int64(0),
+	},
 	"windowing_use_high_precision": {
 		Name:              "windowing_use_high_precision",
 		Scope:             sql.SystemVariableScope_Both,
@@ -0,0 +1,133 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variables
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// sqlModeType is the type of the sql_mode system variable.
+var sqlModeType = types.NewSystemSetType("sql_mode", "ALLOW_INVALID_DATES", "ANSI_QUOTES", "ERROR_FOR_DIVISION_BY_ZERO", "HIGH_NOT_PRECEDENCE", "IGNORE_SPACE", "NO_AUTO_VALUE_ON_ZERO", "NO_BACKSLASH_ESCAPES", "NO_DIR_IN_CREATE", "NO_ENGINE_SUBSTITUTION", "NO_UNSIGNED_SUBTRACTION", "NO_ZERO_DATE", "NO_ZERO_IN_DATE", "ONLY_FULL_GROUP_BY", "PAD_CHAR_TO_FULL_LENGTH", "PIPES_AS_CONCAT", "REAL_AS_FLOAT", "STRICT_ALL_TABLES", "STRICT_TRANS_TABLES", "TIME_TRUNCATE_FRACTIONAL", "TRADITIONAL", "ANSI")
+
+// sqlModeCombinations are the SQL modes that stand for a combination of other modes.
+var sqlModeCombinations = map[string][]string{
+	"ANSI":        {"REAL_AS_FLOAT", "PIPES_AS_CONCAT", "ANSI_QUOTES", "IGNORE_SPACE", "ONLY_FULL_GROUP_BY"},
+	"TRADITIONAL": {"STRICT_TRANS_TABLES", "STRICT_ALL_TABLES", "NO_ZERO_IN_DATE", "NO_ZERO_DATE", "ERROR_FOR_DIVISION_BY_ZERO", "NO_ENGINE_SUBSTITUTION"},
+}
+
+// validateSqlMode is the Validate function of sql_mode, which adds the modes that the combination modes of a value
+// stand for, so that they take effect.
+func validateSqlMode(value interface{}) (interface{}, error) {
+	bits, ok := value.(uint64)
+	if !ok {
+		return value, nil
+	}
+	modeString, err := sqlModeType.(sql.SetType).BitsToString(bits)
+	if err != nil {
+		return nil, err
+	}
+	modes := strings.Split(modeString, ",")
+	for _, mode := range modes {
+		modes = append(modes, sqlModeCombinations[mode]...)
+	}
+	converted, _, err := sqlModeType.Convert(strings.Join(modes, ","))
+	return converted, err
+}
+
+// validateTimeZone is the Validate function of time_zone.
+func validateTimeZone(value interface{}) (interface{}, error) {
+	if tz, ok := value.(string); !ok || !sql.IsValidTimeZone(tz) {
+		return nil, sql.ErrUnknownTimeZone.New(value)
+	}
+	return value, nil
+}
+
+// validateCharacterSet is the Validate function of the system variables naming a character set.
+func validateCharacterSet(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if _, err := sql.ParseCharacterSet(value.(string)); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// validateCollation is the Validate function of the system variables naming a collation.
+func validateCollation(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	name := value.(string)
+	if _, err := sql.ParseCollation(nil, &name, false); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// setDefaultCollation returns the OnUpdate function of a system variable naming a character set, which sets the
+// system variable |collationVar| to the default collation of the character set, as MySQL does.
+func setDefaultCollation(collationVar string) func(*sql.Context, sql.SystemVariableScope, sql.SystemVarValue) error {
+	return func(ctx *sql.Context, scope sql.SystemVariableScope, value sql.SystemVarValue) error {
+		collation := ""
+		if value.Val != nil {
+			charset, err := sql.ParseCharacterSet(value.Val.(string))
+			if err != nil {
+				return err
+			}
+			collation = charset.DefaultCollation().Name()
+		}
+		return setRelated(ctx, scope, collationVar, collation)
+	}
+}
+
+// setCollationCharacterSet returns the OnUpdate function of a system variable naming a collation, which sets the system
+// variable |charsetVar| to the character set of the collation, as MySQL does.
+func setCollationCharacterSet(charsetVar string) func(*sql.Context, sql.SystemVariableScope, sql.SystemVarValue) error {
+	return func(ctx *sql.Context, scope sql.SystemVariableScope, value sql.SystemVarValue) error {
+		name, ok := value.Val.(string)
+		if !ok || name == "" {
+			return nil
+		}
+		collation, err := sql.ParseCollation(nil, &name, false)
+		if err != nil {
+			return err
+		}
+		return setRelated(ctx, scope, charsetVar, collation.CharacterSet().Name())
+	}
+}
+
+// setRelated sets the system variable named to the value given in the scope of a SET statement, for the OnUpdate
+// functions that keep related variables consistent. The OnUpdate function of the variable set isn't called.
+func setRelated(ctx *sql.Context, scope sql.SystemVariableScope, name string, value interface{}) error {
+	switch scope {
+	case sql.SystemVariableScope_Global:
+		return sql.SystemVariables.SetGlobal(name, value)
+	case sql.SystemVariableScope_Persist:
+		persister, err := sql.SystemVariablePersisterFor(ctx)
+		if err != nil {
+			return err
+		}
+		if err = sql.SystemVariables.SetGlobal(name, value); err != nil {
+			return err
+		}
+		return persister.PersistGlobal(name, value)
+	default:
+		return ctx.SetSessionVariable(ctx, name, value)
+	}
+}
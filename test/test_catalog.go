@@ -143,6 +143,10 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	return nil
 }
 
+func (c *Catalog) HasLockedTables(id uint32) bool {
+	return false
+}
+
 func (c *Catalog) Statistics(ctx *sql.Context) (sql.StatsReadWriter, error) {
 	return nil, nil
 }